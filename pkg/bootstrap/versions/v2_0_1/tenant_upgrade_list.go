@@ -19,6 +19,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/bootstrap/versions"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/frontend"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
 )
 
@@ -28,6 +29,9 @@ var tenantUpgEntries = []versions.UpgradeEntry{
 	upg_mo_user_add_login_attempts,
 	upg_mo_user_add_lock_time,
 	drop_mo_pubs,
+	upg_mo_events,
+	upg_mo_event_runs,
+	upg_mo_event_history,
 }
 
 var upg_mo_user_add_password_last_changed = versions.UpgradeEntry{
@@ -118,3 +122,34 @@ var drop_mo_pubs = versions.UpgradeEntry{
 		return !exist, nil
 	},
 }
+
+var upg_mo_events = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_EVENTS,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoEventsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_EVENTS)
+	},
+}
+
+var upg_mo_event_runs = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_EVENT_RUNS,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoEventRunsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_EVENT_RUNS)
+	},
+}
+
+var upg_mo_event_history = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_EVENT_HISTORY,
+	UpgType:   versions.CREATE_VIEW,
+	UpgSql:    frontend.MoCatalogMoEventHistoryDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, _, err := versions.CheckViewDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_EVENT_HISTORY)
+		return exists, err
+	},
+}
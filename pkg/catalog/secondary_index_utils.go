@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
@@ -31,6 +32,7 @@ const (
 	MoIndexIvfFlatAlgo  = tree.INDEX_TYPE_IVFFLAT  // used for IVF flat index on Vector/Array columns
 	MOIndexMasterAlgo   = tree.INDEX_TYPE_MASTER   // used for Master Index on VARCHAR columns
 	MOIndexFullTextAlgo = tree.INDEX_TYPE_FULLTEXT // used for Fulltext Index on VARCHAR columns
+	MoIndexHnswAlgo     = tree.INDEX_TYPE_HNSW     // used for HNSW index on Vector/Array columns
 )
 
 // ToLower is used for before comparing AlgoType and IndexAlgoParamOpType. Reason why they are strings
@@ -59,6 +61,11 @@ func IsIvfIndexAlgo(algo string) bool {
 	return _algo == MoIndexIvfFlatAlgo.ToString()
 }
 
+func IsHnswIndexAlgo(algo string) bool {
+	_algo := ToLower(algo)
+	return _algo == MoIndexHnswAlgo.ToString()
+}
+
 func IsMasterIndexAlgo(algo string) bool {
 	_algo := ToLower(algo)
 	return _algo == MOIndexMasterAlgo.ToString()
@@ -71,11 +78,28 @@ func IsFullTextIndexAlgo(algo string) bool {
 
 // ------------------------[START] IndexAlgoParams------------------------
 const (
-	IndexAlgoParamLists     = "lists"
-	IndexAlgoParamOpType    = "op_type"
-	IndexAlgoParamOpType_l2 = "vector_l2_ops"
-	//IndexAlgoParamOpType_ip  = "vector_ip_ops"
-	//IndexAlgoParamOpType_cos = "vector_cosine_ops"
+	IndexAlgoParamLists          = "lists"
+	IndexAlgoParamOpType         = "op_type"
+	IndexAlgoParamOpType_l2      = "vector_l2_ops"
+	IndexAlgoParamOpType_ip      = "vector_ip_ops"
+	IndexAlgoParamOpType_cos     = "vector_cosine_ops"
+	IndexAlgoParamOpType_hamming = "vector_hamming_ops"
+	IndexAlgoParamM              = "m"
+	IndexAlgoParamEfConstruction = "ef_construction"
+	IndexAlgoParamEfSearch       = "ef_search"
+	IndexAlgoParamParser         = "parser"
+	IndexAlgoParamAnalyzer       = "analyzer"
+	IndexAlgoParamStopwords      = "stopwords"
+	IndexAlgoParamScorer         = "scorer"
+	IndexAlgoParamBM25K1         = "bm25_k1"
+	IndexAlgoParamBM25B          = "bm25_b"
+)
+
+// HNSW default build and search parameters
+const (
+	HnswDefaultM              = 16
+	HnswDefaultEfConstruction = 64
+	HnswDefaultEfSearch       = 64
 )
 
 const (
@@ -119,12 +143,27 @@ func IndexParamsToStringList(indexParams string) (string, error) {
 		res += fmt.Sprintf(" %s = %s ", IndexAlgoParamLists, val)
 	}
 
+	// HNSW params
+	isHnsw := false
+	for _, key := range []string{IndexAlgoParamM, IndexAlgoParamEfConstruction, IndexAlgoParamEfSearch} {
+		if val, ok := result[key]; ok {
+			res += fmt.Sprintf(" %s = %s ", key, val)
+			isHnsw = true
+		}
+	}
+
 	if opType, ok := result[IndexAlgoParamOpType]; ok {
 		opType = ToLower(opType)
-		if opType != IndexAlgoParamOpType_l2 {
+		if isHnsw {
+			if !isValidHnswOpType(opType) {
+				return "", moerr.NewInternalErrorNoCtxf("invalid op_type. not of type '%s', '%s', '%s'",
+					IndexAlgoParamOpType_l2, IndexAlgoParamOpType_ip, IndexAlgoParamOpType_cos)
+			}
+		} else if !isValidIvfOpType(opType) {
 			//	opType != IndexAlgoParamOpType_ip &&
 			//	opType != IndexAlgoParamOpType_cos
-			return "", moerr.NewInternalErrorNoCtxf("invalid op_type. not of type '%s'", IndexAlgoParamOpType_l2)
+			return "", moerr.NewInternalErrorNoCtxf("invalid op_type. not of type '%s', '%s'",
+				IndexAlgoParamOpType_l2, IndexAlgoParamOpType_hamming)
 			//IndexAlgoParamOpType_ip, , IndexAlgoParamOpType_cos)
 
 		}
@@ -132,6 +171,21 @@ func IndexParamsToStringList(indexParams string) (string, error) {
 		res += fmt.Sprintf(" %s '%s' ", IndexAlgoParamOpType, opType)
 	}
 
+	// fulltext params
+	if val, ok := result[IndexAlgoParamParser]; ok {
+		res += fmt.Sprintf(" WITH PARSER %s ", val)
+	}
+	for _, key := range []string{IndexAlgoParamAnalyzer, IndexAlgoParamStopwords, IndexAlgoParamScorer} {
+		if val, ok := result[key]; ok {
+			res += fmt.Sprintf(" %s '%s' ", key, strings.ReplaceAll(val, "'", "\\'"))
+		}
+	}
+	for _, key := range []string{IndexAlgoParamBM25K1, IndexAlgoParamBM25B} {
+		if val, ok := result[key]; ok {
+			res += fmt.Sprintf(" %s = %s ", key, val)
+		}
+	}
+
 	return res, nil
 }
 
@@ -177,11 +231,36 @@ func fullTextIndexParamsToMap(def *tree.FullTextIndex) (map[string]string, error
 
 	// fulltext index here
 	if def.IndexOption != nil {
-		parsername := strings.ToLower(def.IndexOption.ParserName)
-		if parsername != "ngram" && parsername != "default" && parsername != "json" {
-			return nil, moerr.NewInternalErrorNoCtx(fmt.Sprintf("invalid parser %s", parsername))
+		opt := def.IndexOption
+		if opt.ParserName != "" {
+			parsername := strings.ToLower(opt.ParserName)
+			if parsername != "ngram" && parsername != "default" && parsername != "json" {
+				return nil, moerr.NewInternalErrorNoCtx(fmt.Sprintf("invalid parser %s", parsername))
+			}
+			res[IndexAlgoParamParser] = parsername
+		}
+
+		param := fulltext.FullTextParserParam{
+			Analyzer:  strings.ToLower(opt.AlgoParamAnalyzer),
+			Stopwords: opt.AlgoParamStopwords,
+			Scorer:    strings.ToLower(opt.AlgoParamScorer),
+			BM25K1:    opt.AlgoParamBM25K1,
+			BM25B:     opt.AlgoParamBM25B,
+		}
+		if err := param.Validate(); err != nil {
+			return nil, err
+		}
+		for key, val := range map[string]string{
+			IndexAlgoParamAnalyzer:  param.Analyzer,
+			IndexAlgoParamStopwords: param.Stopwords,
+			IndexAlgoParamScorer:    param.Scorer,
+			IndexAlgoParamBM25K1:    param.BM25K1,
+			IndexAlgoParamBM25B:     param.BM25B,
+		} {
+			if val != "" {
+				res[key] = val
+			}
 		}
-		res["parser"] = parsername
 	}
 	return res, nil
 }
@@ -216,18 +295,20 @@ func indexParamsToMap(def interface{}) (map[string]string, error) {
 
 			if len(idx.IndexOption.AlgoParamVectorOpType) > 0 {
 				opType := ToLower(idx.IndexOption.AlgoParamVectorOpType)
-				if opType != IndexAlgoParamOpType_l2 {
+				if !isValidIvfOpType(opType) {
 					//opType != IndexAlgoParamOpType_ip &&
 					//opType != IndexAlgoParamOpType_cos &&
 
-					return nil, moerr.NewInternalErrorNoCtx(fmt.Sprintf("invalid op_type. not of type '%s'",
-						IndexAlgoParamOpType_l2))
+					return nil, moerr.NewInternalErrorNoCtx(fmt.Sprintf("invalid op_type. not of type '%s', '%s'",
+						IndexAlgoParamOpType_l2, IndexAlgoParamOpType_hamming))
 					//IndexAlgoParamOpType_ip, IndexAlgoParamOpType_cos,
 				}
 				res[IndexAlgoParamOpType] = idx.IndexOption.AlgoParamVectorOpType
 			} else {
 				res[IndexAlgoParamOpType] = IndexAlgoParamOpType_l2 // set l2 as default
 			}
+		case tree.INDEX_TYPE_HNSW:
+			return hnswIndexParamsToMap(idx.IndexOption)
 		default:
 			return nil, moerr.NewInternalErrorNoCtx("invalid index alogorithm type")
 		}
@@ -237,6 +318,12 @@ func indexParamsToMap(def interface{}) (map[string]string, error) {
 	return res, moerr.NewInternalErrorNoCtx("indexParamsToMap: invalid index type")
 }
 
+// isValidIvfOpType returns true for the op types of IVFFLAT. vector_hamming_ops is only for VECBIT columns.
+func isValidIvfOpType(opType string) bool {
+	return opType == IndexAlgoParamOpType_l2 ||
+		opType == IndexAlgoParamOpType_hamming
+}
+
 func DefaultIvfIndexAlgoOptions() map[string]string {
 	res := make(map[string]string)
 	res[IndexAlgoParamLists] = "1"                      // set lists = 1 as default
@@ -244,6 +331,43 @@ func DefaultIvfIndexAlgoOptions() map[string]string {
 	return res
 }
 
+func hnswIndexParamsToMap(opt *tree.IndexOption) (map[string]string, error) {
+	res := DefaultHnswIndexAlgoOptions()
+	if opt.AlgoParamM > 0 {
+		res[IndexAlgoParamM] = strconv.FormatInt(opt.AlgoParamM, 10)
+	}
+	if opt.AlgoParamEfConstruction > 0 {
+		res[IndexAlgoParamEfConstruction] = strconv.FormatInt(opt.AlgoParamEfConstruction, 10)
+	}
+	if opt.AlgoParamEfSearch > 0 {
+		res[IndexAlgoParamEfSearch] = strconv.FormatInt(opt.AlgoParamEfSearch, 10)
+	}
+	if len(opt.AlgoParamVectorOpType) > 0 {
+		opType := ToLower(opt.AlgoParamVectorOpType)
+		if !isValidHnswOpType(opType) {
+			return nil, moerr.NewInternalErrorNoCtx(fmt.Sprintf("invalid op_type. not of type '%s', '%s', '%s'",
+				IndexAlgoParamOpType_l2, IndexAlgoParamOpType_ip, IndexAlgoParamOpType_cos))
+		}
+		res[IndexAlgoParamOpType] = opType
+	}
+	return res, nil
+}
+
+func isValidHnswOpType(opType string) bool {
+	return opType == IndexAlgoParamOpType_l2 ||
+		opType == IndexAlgoParamOpType_ip ||
+		opType == IndexAlgoParamOpType_cos
+}
+
+func DefaultHnswIndexAlgoOptions() map[string]string {
+	res := make(map[string]string)
+	res[IndexAlgoParamM] = strconv.Itoa(HnswDefaultM)
+	res[IndexAlgoParamEfConstruction] = strconv.Itoa(HnswDefaultEfConstruction)
+	res[IndexAlgoParamEfSearch] = strconv.Itoa(HnswDefaultEfSearch)
+	res[IndexAlgoParamOpType] = IndexAlgoParamOpType_l2 // set l2 as default
+	return res
+}
+
 //------------------------[END] IndexAlgoParams------------------------
 
 // ------------------------[START] Aliaser------------------------
@@ -471,6 +471,10 @@ func GenRows(bat *batch.Batch) [][]any {
 			for j := 0; j < vec.Length(); j++ {
 				rows[j][i] = vector.GetArrayAt[float64](vec, j)
 			}
+		case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+			for j := 0; j < vec.Length(); j++ {
+				rows[j][i] = vec.GetBytesAt(j)
+			}
 		default:
 			panic(fmt.Sprintf("unspported type: %v", vec.GetType()))
 		}
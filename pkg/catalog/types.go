@@ -90,6 +90,9 @@ var InternalColumns = map[string]int8{
 	SystemSI_IVFFLAT_TblCol_Entries_version:    0,
 	SystemSI_IVFFLAT_TblCol_Entries_id:         0,
 	SystemSI_IVFFLAT_TblCol_Entries_entry:      0,
+	SystemSI_HNSW_TblCol_Storage_version:       0,
+	SystemSI_HNSW_TblCol_Storage_id:            0,
+	SystemSI_HNSW_TblCol_Storage_data:          0,
 }
 
 var InternalTableNames = map[string]int8{
@@ -163,6 +166,13 @@ const (
 	MO_CDC_TASK      = "mo_cdc_task"
 	MO_CDC_WATERMARK = "mo_cdc_watermark"
 
+	// MO_EVENTS scheduled sql events of the account
+	MO_EVENTS = "mo_events"
+	// MO_EVENT_RUNS run records of the scheduled sql events
+	MO_EVENT_RUNS = "mo_event_runs"
+	// MO_EVENT_HISTORY view over the run records
+	MO_EVENT_HISTORY = "mo_event_history"
+
 	MO_DATA_KEY = "mo_data_key"
 )
 
@@ -353,7 +363,22 @@ const (
 	SystemSI_IVFFLAT_TblCol_Entries_pk      = IndexTablePrimaryColName
 	SystemSI_IVFFLAT_TblCol_Entries_entry   = "__mo_index_centroid_fk_entry"
 
-	/************ 3. FULLTEXT Index **************/
+	/************ 3. HNSW Secondary Index ************/
+
+	// HNSW Table Types
+	SystemSI_HNSW_TblType_Metadata = "hnsw_meta"
+	SystemSI_HNSW_TblType_Storage  = "hnsw_store"
+
+	// HNSW MetadataTable - Column names
+	SystemSI_HNSW_TblCol_Metadata_key = SystemSI_IVFFLAT_TblCol_Metadata_key
+	SystemSI_HNSW_TblCol_Metadata_val = SystemSI_IVFFLAT_TblCol_Metadata_val
+
+	// HNSW StorageTable - Column names
+	SystemSI_HNSW_TblCol_Storage_version = "__mo_index_hnsw_version"
+	SystemSI_HNSW_TblCol_Storage_id      = "__mo_index_hnsw_chunk_id"
+	SystemSI_HNSW_TblCol_Storage_data    = "__mo_index_hnsw_data"
+
+	/************ 4. FULLTEXT Index **************/
 
 	FullTextIndex_TabCol_Word     = "word"
 	FullTextIndex_TabCol_Id       = "doc_id"
@@ -0,0 +1,638 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
+)
+
+const (
+	FileFormatCsv     = "csv"
+	FileFormatJsonl   = "jsonl"
+	FileFormatParquet = "parquet"
+
+	// FileFormatKey chooses the file format, csv (default), jsonl or parquet
+	FileFormatKey = "format"
+	// FileMaxSizeKey is the size in bytes to roll over to a new file
+	FileMaxSizeKey = "max_file_size"
+
+	DefaultFileMaxSize = 64 * 1024 * 1024
+
+	// every data file has the op and the commit ts of the change in the
+	// first two columns
+	FileOpColumn       = "_op"
+	FileCommitTsColumn = "_commit_ts"
+	FileManifestDir    = "_manifest"
+
+	fileOpInsert = "insert"
+	fileOpDelete = "delete"
+	fileNullStr  = `\N`
+)
+
+// FileManifest is written after all data files of a [FromTs, ToTs) range are
+// written, so a range is complete if and only if its manifest exists.
+type FileManifest struct {
+	SourceDb    string              `json:"source_db"`
+	SourceTable string              `json:"source_table"`
+	FromTs      string              `json:"from_ts"`
+	ToTs        string              `json:"to_ts"`
+	Format      string              `json:"format"`
+	Columns     []string            `json:"columns"`
+	Files       []FileManifestEntry `json:"files"`
+}
+
+type FileManifestEntry struct {
+	Path string `json:"path"`
+	Rows int    `json:"rows"`
+	Size int    `json:"size"`
+}
+
+// fileRowWriter encodes change rows of one data file
+type fileRowWriter interface {
+	writeRow(ctx context.Context, op string, ts types.TS, row []any) error
+	// size is the estimated size of the file
+	size() int
+	// finish returns the content of the file and resets the writer
+	finish() ([]byte, error)
+}
+
+var _ Sinker = new(fileSinker)
+
+// fileSinker writes changes of one table as rolling files to a fileservice
+// path, usually resolved from a stage. Files are written when they exceed
+// max_file_size or when the reader commits, then a manifest for the range is
+// written. The watermark moves forward only after the manifest is written.
+// Files left by a failed round have no manifest and must be ignored.
+type fileSinker struct {
+	fs               fileservice.FileService
+	dir              string
+	dbTblInfo        *DbTableInfo
+	watermarkUpdater *WatermarkUpdater
+	format           string
+	maxFileSize      int
+
+	rowSinkSchema
+
+	writer   fileRowWriter
+	rows     int
+	fromTs   types.TS
+	toTs     types.TS
+	manifest *FileManifest
+	// attempt is unique for each round writing a range, files of a retried
+	// round must not overwrite the files of the failed one
+	attempt string
+
+	// for collect row data, allocate only once
+	insertRow []any
+	deleteRow []any
+
+	err atomic.Value
+}
+
+func NewFileSinker(
+	fs fileservice.FileService,
+	dir string,
+	sinkUri UriInfo,
+	dbTblInfo *DbTableInfo,
+	watermarkUpdater *WatermarkUpdater,
+	tableDef *plan.TableDef,
+) (Sinker, error) {
+	s := &fileSinker{
+		fs:               fs,
+		dir:              path.Join(dir, dbTblInfo.SinkDbName, dbTblInfo.SinkTblName),
+		dbTblInfo:        dbTblInfo,
+		watermarkUpdater: watermarkUpdater,
+		format:           strings.ToLower(sinkUri.Params[FileFormatKey]),
+		maxFileSize:      DefaultFileMaxSize,
+	}
+	if s.format == "" {
+		s.format = FileFormatCsv
+	}
+	if str := sinkUri.Params[FileMaxSizeKey]; str != "" {
+		size, err := strconv.Atoi(str)
+		if err != nil || size <= 0 {
+			return nil, moerr.NewInternalErrorNoCtxf("cdc fileSinker: invalid %s %s", FileMaxSizeKey, str)
+		}
+		s.maxFileSize = size
+	}
+
+	var err error
+	if s.rowSinkSchema, err = newRowSinkSchema("fileSinker", tableDef, dbTblInfo); err != nil {
+		return nil, err
+	}
+
+	switch s.format {
+	case FileFormatCsv:
+		s.writer = newCsvRowWriter(s.colNames, s.insertTypes)
+	case FileFormatJsonl:
+		s.writer = newJsonlRowWriter(s.colNames, s.insertTypes)
+	case FileFormatParquet:
+		s.writer = newParquetRowWriter(s.colNames, s.insertTypes)
+	default:
+		return nil, moerr.NewInternalErrorNoCtxf("cdc fileSinker: unsupported format %s", s.format)
+	}
+
+	s.insertRow = make([]any, len(s.insertTypes))
+	s.deleteRow = make([]any, 1)
+	s.err = atomic.Value{}
+	return s, nil
+}
+
+// Run does nothing, files are written in the goroutine of the reader
+func (s *fileSinker) Run(_ context.Context, _ *ActiveRoutine) {}
+
+func (s *fileSinker) Sink(ctx context.Context, data *DecoderOutput) {
+	watermark := s.watermarkUpdater.GetFromMem(s.dbTblInfo.SourceTblIdStr)
+	if data.toTs.LE(&watermark) {
+		logutil.Errorf("cdc fileSinker(%v): unexpected watermark: %s, current watermark: %s",
+			s.dbTblInfo, data.toTs.ToString(), watermark.ToString())
+		return
+	}
+
+	// files are flushed by SendCommit or SendDummy
+	if data.noMoreData {
+		return
+	}
+
+	start := time.Now()
+	defer func() {
+		v2.CdcSinkDurationHistogram.Observe(time.Since(start).Seconds())
+	}()
+
+	if s.manifest == nil {
+		s.fromTs, s.toTs = data.fromTs, data.toTs
+		s.attempt = uuid.NewString()
+		s.manifest = &FileManifest{
+			SourceDb:    s.dbTblInfo.SourceDbName,
+			SourceTable: s.dbTblInfo.SourceTblName,
+			FromTs:      data.fromTs.ToString(),
+			ToTs:        data.toTs.ToString(),
+			Format:      s.format,
+			Columns:     append([]string{FileOpColumn, FileCommitTsColumn}, s.colNames...),
+		}
+	}
+
+	var err error
+	if data.outputTyp == OutputTypeSnapshot {
+		err = s.sinkSnapshot(ctx, data.checkpointBat, data.toTs)
+	} else if data.outputTyp == OutputTypeTail {
+		err = s.sinkTail(ctx, data.insertAtmBatch, data.deleteAtmBatch)
+	} else {
+		err = moerr.NewInternalErrorf(ctx, "cdc fileSinker unexpected output type: %v", data.outputTyp)
+	}
+	if err != nil {
+		s.setError(err)
+	}
+}
+
+func (s *fileSinker) SendBegin() {}
+
+func (s *fileSinker) SendCommit() {
+	s.commit()
+}
+
+// SendRollback drops the buffered rows, the files already written have no
+// manifest
+func (s *fileSinker) SendRollback() {
+	s.resetRange()
+}
+
+func (s *fileSinker) SendDummy() {
+	s.commit()
+}
+
+func (s *fileSinker) Error() error {
+	if val := s.err.Load(); val == nil {
+		return nil
+	} else {
+		return val.(error)
+	}
+}
+
+// setError records the first error, all errors are stored as *moerr.Error
+// because atomic.Value only accepts values of the same type
+func (s *fileSinker) setError(err error) {
+	s.err.CompareAndSwap(nil, toMoError(err))
+}
+
+func (s *fileSinker) Reset() {
+	s.resetRange()
+	s.err = atomic.Value{}
+}
+
+func (s *fileSinker) Close() {
+	s.resetRange()
+	s.insertTypes = nil
+	s.insertRow = nil
+	s.deleteRow = nil
+}
+
+func (s *fileSinker) resetRange() {
+	_, _ = s.writer.finish()
+	s.rows = 0
+	s.manifest = nil
+}
+
+// commit writes the last file and the manifest of the range
+func (s *fileSinker) commit() {
+	if s.manifest == nil {
+		return
+	}
+	if s.Error() != nil {
+		s.resetRange()
+		return
+	}
+
+	ctx := context.Background()
+	if err := s.flushFile(ctx); err != nil {
+		s.setError(err)
+		s.resetRange()
+		return
+	}
+	if len(s.manifest.Files) > 0 {
+		data, err := json.Marshal(s.manifest)
+		if err == nil {
+			name := fmt.Sprintf("%s_%s.json", s.fromTs.ToString(), s.toTs.ToString())
+			err = s.write(ctx, path.Join(s.dir, FileManifestDir, name), data)
+		}
+		if err != nil {
+			s.setError(err)
+		}
+	}
+	s.resetRange()
+}
+
+// flushFile writes the rows in the writer into a new data file
+func (s *fileSinker) flushFile(ctx context.Context) error {
+	if s.rows == 0 {
+		return nil
+	}
+	data, err := s.writer.finish()
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s_%s_%s_%d.%s", s.fromTs.ToString(), s.toTs.ToString(), s.attempt, len(s.manifest.Files), s.format)
+	if err = s.write(ctx, path.Join(s.dir, name), data); err != nil {
+		return err
+	}
+	s.manifest.Files = append(s.manifest.Files, FileManifestEntry{
+		Path: name,
+		Rows: s.rows,
+		Size: len(data),
+	})
+	s.rows = 0
+	return nil
+}
+
+func (s *fileSinker) write(ctx context.Context, filePath string, data []byte) error {
+	return s.fs.Write(ctx, fileservice.IOVector{
+		FilePath: filePath,
+		Entries: []fileservice.IOEntry{
+			{
+				Size: int64(len(data)),
+				Data: data,
+			},
+		},
+	})
+}
+
+func (s *fileSinker) appendRow(ctx context.Context, op string, ts types.TS, row []any) (err error) {
+	if err = s.writer.writeRow(ctx, op, ts, row); err != nil {
+		return
+	}
+	s.rows++
+	if s.writer.size() >= s.maxFileSize {
+		return s.flushFile(ctx)
+	}
+	return
+}
+
+func (s *fileSinker) sinkSnapshot(ctx context.Context, bat *batch.Batch, ts types.TS) (err error) {
+	for i := 0; i < batchRowCount(bat); i++ {
+		if err = extractRowFromEveryVector(ctx, bat, i, s.insertRow); err != nil {
+			return
+		}
+		if err = s.appendRow(ctx, fileOpInsert, ts, s.insertRow); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (s *fileSinker) sinkTail(ctx context.Context, insertBatch, deleteBatch *AtomicBatch) error {
+	return sinkTailRows(ctx, insertBatch, deleteBatch, s)
+}
+
+func (s *fileSinker) sinkInsert(ctx context.Context, insertIter *atomicBatchRowIter) (err error) {
+	if err = insertIter.Row(ctx, s.insertRow); err != nil {
+		return
+	}
+	return s.appendRow(ctx, fileOpInsert, insertIter.Item().Ts, s.insertRow)
+}
+
+// sinkDelete writes the primary key of the deleted row, the other columns are
+// null
+func (s *fileSinker) sinkDelete(ctx context.Context, deleteIter *atomicBatchRowIter) (err error) {
+	if err = deleteIter.Row(ctx, s.deleteRow); err != nil {
+		return
+	}
+
+	if err = s.fillDeletedRow(s.insertRow, s.deleteRow[0]); err != nil {
+		return
+	}
+	return s.appendRow(ctx, fileOpDelete, deleteIter.Item().Ts, s.insertRow)
+}
+
+// sinkUpdate writes an update as a delete and an insert of the same ts
+func (s *fileSinker) sinkUpdate(ctx context.Context, insertIter, deleteIter *atomicBatchRowIter) (err error) {
+	if err = s.sinkDelete(ctx, deleteIter); err != nil {
+		return
+	}
+	return s.sinkInsert(ctx, insertIter)
+}
+
+// fileValueString formats a column value for text formats
+func fileValueString(ctx context.Context, typ *types.Type, data any) (string, bool, error) {
+	value, err := kafkaValue(ctx, typ, data)
+	if err != nil || value == nil {
+		return "", false, err
+	}
+	switch v := value.(type) {
+	case string:
+		return v, true, nil
+	case []byte:
+		return string(v), true, nil
+	case []float32:
+		return types.ArrayToString(v), true, nil
+	case []float64:
+		return types.ArrayToString(v), true, nil
+	default:
+		return fmt.Sprint(v), true, nil
+	}
+}
+
+var _ fileRowWriter = new(csvRowWriter)
+
+// csvRowWriter writes rfc 4180 csv with a header line, null is \N
+type csvRowWriter struct {
+	colNames    []string
+	insertTypes []*types.Type
+
+	buf    bytes.Buffer
+	w      *csv.Writer
+	record []string
+}
+
+func newCsvRowWriter(colNames []string, insertTypes []*types.Type) *csvRowWriter {
+	w := &csvRowWriter{
+		colNames:    colNames,
+		insertTypes: insertTypes,
+		record:      make([]string, len(colNames)+2),
+	}
+	w.w = csv.NewWriter(&w.buf)
+	return w
+}
+
+func (w *csvRowWriter) writeRow(ctx context.Context, op string, ts types.TS, row []any) error {
+	if w.buf.Len() == 0 {
+		if err := w.w.Write(append([]string{FileOpColumn, FileCommitTsColumn}, w.colNames...)); err != nil {
+			return err
+		}
+	}
+
+	w.record[0] = op
+	w.record[1] = ts.ToString()
+	for i := range row {
+		str, ok, err := fileValueString(ctx, w.insertTypes[i], row[i])
+		if err != nil {
+			return err
+		}
+		if !ok {
+			str = fileNullStr
+		}
+		w.record[i+2] = str
+	}
+	if err := w.w.Write(w.record); err != nil {
+		return err
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *csvRowWriter) size() int {
+	return w.buf.Len()
+}
+
+func (w *csvRowWriter) finish() ([]byte, error) {
+	data := copyBytes(w.buf.Bytes())
+	w.buf.Reset()
+	return data, nil
+}
+
+var _ fileRowWriter = new(jsonlRowWriter)
+
+// jsonlRowWriter writes one json object per line
+type jsonlRowWriter struct {
+	encoder *kafkaJsonEncoder
+	buf     []byte
+}
+
+func newJsonlRowWriter(colNames []string, insertTypes []*types.Type) *jsonlRowWriter {
+	return &jsonlRowWriter{
+		encoder: newKafkaJsonEncoder(nil, colNames, insertTypes, nil),
+		buf:     make([]byte, 0, 1024),
+	}
+}
+
+func (w *jsonlRowWriter) writeRow(ctx context.Context, op string, ts types.TS, row []any) (err error) {
+	w.buf = append(w.buf, `{"`+FileOpColumn+`":"`...)
+	w.buf = append(w.buf, op...)
+	w.buf = append(w.buf, `","`+FileCommitTsColumn+`":"`...)
+	w.buf = append(w.buf, ts.ToString()...)
+	w.buf = append(w.buf, '"')
+	for i := range row {
+		w.buf = append(w.buf, ',')
+		if w.buf, err = w.encoder.appendField(ctx, w.buf, i, row[i]); err != nil {
+			return
+		}
+	}
+	w.buf = append(w.buf, '}', '\n')
+	return
+}
+
+func (w *jsonlRowWriter) size() int {
+	return len(w.buf)
+}
+
+func (w *jsonlRowWriter) finish() ([]byte, error) {
+	data := copyBytes(w.buf)
+	w.buf = w.buf[:0]
+	return data, nil
+}
+
+var _ fileRowWriter = new(parquetRowWriter)
+
+// parquetRowWriter writes all columns as optional flat columns. The types
+// without a parquet counterpart are written as strings.
+type parquetRowWriter struct {
+	insertTypes []*types.Type
+	// kind of every user defined column in the schema
+	kinds []parquet.Kind
+	// index of every column in the leaf columns of the schema, fields of
+	// a parquet group are sorted by name
+	leafIdxes []int
+
+	buf       bytes.Buffer
+	w         *parquet.Writer
+	row       parquet.Row
+	estimated int
+}
+
+func newParquetRowWriter(colNames []string, insertTypes []*types.Type) *parquetRowWriter {
+	w := &parquetRowWriter{
+		insertTypes: insertTypes,
+		kinds:       make([]parquet.Kind, len(colNames)),
+		leafIdxes:   make([]int, len(colNames)+2),
+		row:         make(parquet.Row, len(colNames)+2),
+	}
+
+	group := parquet.Group{
+		FileOpColumn:       parquet.String(),
+		FileCommitTsColumn: parquet.String(),
+	}
+	for i, name := range colNames {
+		node := parquetNode(insertTypes[i])
+		w.kinds[i] = node.Type().Kind()
+		group[name] = parquet.Optional(node)
+	}
+	schema := parquet.NewSchema("cdc", group)
+
+	names := append([]string{FileOpColumn, FileCommitTsColumn}, colNames...)
+	for i, name := range names {
+		leaf, _ := schema.Lookup(name)
+		w.leafIdxes[i] = leaf.ColumnIndex
+	}
+	w.w = parquet.NewWriter(&w.buf, schema)
+	return w
+}
+
+func parquetNode(typ *types.Type) parquet.Node {
+	switch typ.Oid {
+	case types.T_bool:
+		return parquet.Leaf(parquet.BooleanType)
+	case types.T_int8, types.T_int16, types.T_int32, types.T_uint8, types.T_uint16:
+		return parquet.Int(32)
+	case types.T_int64, types.T_uint32:
+		return parquet.Int(64)
+	case types.T_uint64, types.T_bit:
+		return parquet.Uint(64)
+	case types.T_float32:
+		return parquet.Leaf(parquet.FloatType)
+	case types.T_float64:
+		return parquet.Leaf(parquet.DoubleType)
+	case types.T_blob, types.T_binary, types.T_varbinary:
+		return parquet.Leaf(parquet.ByteArrayType)
+	default:
+		return parquet.String()
+	}
+}
+
+func (w *parquetRowWriter) writeRow(ctx context.Context, op string, ts types.TS, row []any) error {
+	// values of a row are ordered by the column index
+	tsStr := ts.ToString()
+	w.row[w.leafIdxes[0]] = parquet.ByteArrayValue([]byte(op)).Level(0, 0, w.leafIdxes[0])
+	w.row[w.leafIdxes[1]] = parquet.ByteArrayValue([]byte(tsStr)).Level(0, 0, w.leafIdxes[1])
+	w.estimated += len(op) + len(tsStr)
+
+	for i := range row {
+		colIdx := w.leafIdxes[i+2]
+		value, err := kafkaValue(ctx, w.insertTypes[i], row[i])
+		if err != nil {
+			return err
+		}
+
+		var v parquet.Value
+		switch x := value.(type) {
+		case nil:
+			w.row[colIdx] = parquet.NullValue().Level(0, 0, colIdx)
+			continue
+		case bool:
+			v = parquet.BooleanValue(x)
+		case int64:
+			if w.kinds[i] == parquet.Int32 {
+				v = parquet.Int32Value(int32(x))
+			} else {
+				v = parquet.Int64Value(x)
+			}
+		case uint64:
+			v = parquet.Int64Value(int64(x))
+		case float32:
+			v = parquet.FloatValue(x)
+		case float64:
+			v = parquet.DoubleValue(x)
+		case []byte:
+			v = parquet.ByteArrayValue(x)
+			w.estimated += len(x)
+		default:
+			str, _, err := fileValueString(ctx, w.insertTypes[i], row[i])
+			if err != nil {
+				return err
+			}
+			v = parquet.ByteArrayValue([]byte(str))
+			w.estimated += len(str)
+		}
+		w.row[colIdx] = v.Level(0, 1, colIdx)
+		w.estimated += 8
+	}
+
+	_, err := w.w.WriteRows([]parquet.Row{w.row})
+	return err
+}
+
+func (w *parquetRowWriter) size() int {
+	return w.estimated
+}
+
+func (w *parquetRowWriter) finish() ([]byte, error) {
+	defer func() {
+		w.buf.Reset()
+		w.w.Reset(&w.buf)
+		w.estimated = 0
+	}()
+	if err := w.w.Close(); err != nil {
+		return nil, err
+	}
+	return copyBytes(w.buf.Bytes()), nil
+}
@@ -0,0 +1,294 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+)
+
+func newFileTestSinker(t *testing.T, params map[string]string) (Sinker, string) {
+	watermarkUpdater := &WatermarkUpdater{
+		watermarkMap: &sync.Map{},
+	}
+	watermarkUpdater.UpdateMem("1_0", types.BuildTS(0, 1))
+
+	root := t.TempDir()
+	sinkUri := UriInfo{
+		SinkTyp: StageSink,
+		Path:    "stage://s1/cdc",
+		FsPath:  filepath.Join(root, "cdc"),
+		Params:  params,
+	}
+	dbTblInfo := &DbTableInfo{
+		SourceDbName:   "db1",
+		SourceTblName:  "t1",
+		SourceTblIdStr: "1_0",
+		SinkDbName:     "db2",
+		SinkTblName:    "t2",
+	}
+	s, err := NewSinker(sinkUri, dbTblInfo, watermarkUpdater, newKafkaTestTableDef(), 0, 0, NewCdcActiveRoutine())
+	assert.NoError(t, err)
+	return s, filepath.Join(root, "cdc", "db2", "t2")
+}
+
+// sinkFileTestData sinks a snapshot of pk 1, 2 and a tail which updates pk 1,
+// deletes pk 2 and inserts pk 3
+func sinkFileTestData(t *testing.T, s Sinker) {
+	ctx := context.Background()
+	t1 := types.BuildTS(1, 1)
+	t2 := types.BuildTS(2, 1)
+
+	ckpBat := batch.New([]string{"id", "name"})
+	ckpBat.Vecs[0] = testutil.MakeInt32Vector([]int32{1, 2}, nil)
+	ckpBat.Vecs[1] = testutil.MakeVarcharVector([]string{"a", "b"}, nil)
+	ckpBat.SetRowCount(2)
+	s.Sink(ctx, &DecoderOutput{
+		outputTyp:     OutputTypeSnapshot,
+		fromTs:        types.BuildTS(0, 1),
+		toTs:          t1,
+		checkpointBat: ckpBat,
+	})
+	s.SendDummy()
+	assert.NoError(t, s.Error())
+
+	packer := types.NewPacker()
+	defer packer.Close()
+
+	insertAtomicBat := NewAtomicBatch(testutil.TestUtilMp)
+	insertBat := batch.New([]string{"id", "name", "ts"})
+	insertBat.Vecs[0] = testutil.MakeInt32Vector([]int32{1, 3}, nil)
+	insertBat.Vecs[1] = testutil.MakeVarcharVector([]string{"aa", "c,d"}, nil)
+	insertBat.Vecs[2] = testutil.MakeTSVector([]types.TS{t2}, nil)
+	insertBat.SetRowCount(2)
+	insertAtomicBat.Append(packer, insertBat, 2, 0)
+
+	deleteAtomicBat := NewAtomicBatch(testutil.TestUtilMp)
+	deleteBat := batch.New([]string{"id", "ts"})
+	deleteBat.Vecs[0] = testutil.MakeInt32Vector([]int32{1, 2}, nil)
+	deleteBat.Vecs[1] = testutil.MakeTSVector([]types.TS{t2}, nil)
+	deleteBat.SetRowCount(2)
+	deleteAtomicBat.Append(packer, deleteBat, 1, 0)
+
+	s.SendBegin()
+	s.Sink(ctx, &DecoderOutput{
+		outputTyp:      OutputTypeTail,
+		fromTs:         t1,
+		toTs:           t2,
+		insertAtmBatch: insertAtomicBat,
+		deleteAtmBatch: deleteAtomicBat,
+	})
+	s.SendCommit()
+	assert.NoError(t, s.Error())
+}
+
+func readFileTestManifests(t *testing.T, dir string) []FileManifest {
+	entries, err := os.ReadDir(filepath.Join(dir, FileManifestDir))
+	assert.NoError(t, err)
+
+	var manifests []FileManifest
+	for _, entry := range entries {
+		data, err := os.ReadFile(filepath.Join(dir, FileManifestDir, entry.Name()))
+		assert.NoError(t, err)
+		var manifest FileManifest
+		assert.NoError(t, json.Unmarshal(data, &manifest))
+		manifests = append(manifests, manifest)
+	}
+	return manifests
+}
+
+func TestFileSinker_Csv(t *testing.T) {
+	s, dir := newFileTestSinker(t, nil)
+	sinkFileTestData(t, s)
+	s.Close()
+
+	manifests := readFileTestManifests(t, dir)
+	assert.Equal(t, 2, len(manifests))
+	assert.Equal(t, types.BuildTS(0, 1).ToString(), manifests[0].FromTs)
+	assert.Equal(t, types.BuildTS(1, 1).ToString(), manifests[0].ToTs)
+	assert.Equal(t, []string{FileOpColumn, FileCommitTsColumn, "id", "name"}, manifests[0].Columns)
+	assert.Equal(t, types.BuildTS(2, 1).ToString(), manifests[1].ToTs)
+
+	expected := []string{
+		"_op,_commit_ts,id,name\n" +
+			"insert,1-1,1,a\n" +
+			"insert,1-1,2,b\n",
+		"_op,_commit_ts,id,name\n" +
+			"delete,2-1,1,\\N\n" +
+			"insert,2-1,1,aa\n" +
+			"delete,2-1,2,\\N\n" +
+			"insert,2-1,3,\"c,d\"\n",
+	}
+	for i, manifest := range manifests {
+		assert.Equal(t, FileFormatCsv, manifest.Format)
+		assert.Equal(t, 1, len(manifest.Files))
+		data, err := os.ReadFile(filepath.Join(dir, manifest.Files[0].Path))
+		assert.NoError(t, err)
+		assert.Equal(t, expected[i], string(data))
+		assert.Equal(t, len(data), manifest.Files[0].Size)
+		assert.Equal(t, strings.Count(expected[i], "\n")-1, manifest.Files[0].Rows)
+	}
+}
+
+func TestFileSinker_JsonlRolling(t *testing.T) {
+	s, dir := newFileTestSinker(t, map[string]string{
+		FileFormatKey:  FileFormatJsonl,
+		FileMaxSizeKey: "1",
+	})
+	sinkFileTestData(t, s)
+	s.Close()
+
+	manifests := readFileTestManifests(t, dir)
+	assert.Equal(t, 2, len(manifests))
+	// every row is written into its own file
+	assert.Equal(t, 2, len(manifests[0].Files))
+	assert.Equal(t, 4, len(manifests[1].Files))
+
+	data, err := os.ReadFile(filepath.Join(dir, manifests[1].Files[3].Path))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"_op":"insert","_commit_ts":"2-1","id":3,"name":"c,d"}`+"\n", string(data))
+
+	data, err = os.ReadFile(filepath.Join(dir, manifests[1].Files[0].Path))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"_op":"delete","_commit_ts":"2-1","id":1,"name":null}`+"\n", string(data))
+}
+
+func TestFileSinker_Parquet(t *testing.T) {
+	s, dir := newFileTestSinker(t, map[string]string{FileFormatKey: FileFormatParquet})
+	sinkFileTestData(t, s)
+	s.Close()
+
+	manifests := readFileTestManifests(t, dir)
+	assert.Equal(t, 2, len(manifests))
+
+	type row struct {
+		Op       string  `parquet:"_op"`
+		CommitTs string  `parquet:"_commit_ts"`
+		Id       *int32  `parquet:"id"`
+		Name     *string `parquet:"name"`
+	}
+	data, err := os.ReadFile(filepath.Join(dir, manifests[1].Files[0].Path))
+	assert.NoError(t, err)
+	rows, err := parquet.Read[row](bytes.NewReader(data), int64(len(data)))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(rows))
+
+	// the update of pk 1 is a delete and an insert
+	assert.Equal(t, fileOpInsert, rows[1].Op)
+	assert.Equal(t, int32(1), *rows[1].Id)
+	assert.Equal(t, "aa", *rows[1].Name)
+
+	assert.Equal(t, fileOpDelete, rows[2].Op)
+	assert.Equal(t, "2-1", rows[2].CommitTs)
+	assert.Equal(t, int32(2), *rows[2].Id)
+	assert.Nil(t, rows[2].Name)
+
+	assert.Equal(t, fileOpInsert, rows[3].Op)
+	assert.Equal(t, int32(3), *rows[3].Id)
+	assert.Equal(t, "c,d", *rows[3].Name)
+}
+
+func TestFileSinker_Rollback(t *testing.T) {
+	ctx := context.Background()
+	s, dir := newFileTestSinker(t, nil)
+
+	ckpBat := batch.New([]string{"id", "name"})
+	ckpBat.Vecs[0] = testutil.MakeInt32Vector([]int32{1}, nil)
+	ckpBat.Vecs[1] = testutil.MakeVarcharVector([]string{"a"}, nil)
+	ckpBat.SetRowCount(1)
+	s.Sink(ctx, &DecoderOutput{
+		outputTyp:     OutputTypeSnapshot,
+		fromTs:        types.BuildTS(0, 1),
+		toTs:          types.BuildTS(1, 1),
+		checkpointBat: ckpBat,
+	})
+	s.SendRollback()
+	// nothing to commit after rollback
+	s.SendDummy()
+	assert.NoError(t, s.Error())
+	s.Close()
+
+	_, err := os.Stat(filepath.Join(dir, FileManifestDir))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestFileSinker_Retry(t *testing.T) {
+	ctx := context.Background()
+	s, dir := newFileTestSinker(t, map[string]string{FileMaxSizeKey: "1"})
+
+	sinkSnapshot := func(name string) {
+		ckpBat := batch.New([]string{"id", "name"})
+		ckpBat.Vecs[0] = testutil.MakeInt32Vector([]int32{1}, nil)
+		ckpBat.Vecs[1] = testutil.MakeVarcharVector([]string{name}, nil)
+		ckpBat.SetRowCount(1)
+		s.Sink(ctx, &DecoderOutput{
+			outputTyp:     OutputTypeSnapshot,
+			fromTs:        types.BuildTS(0, 1),
+			toTs:          types.BuildTS(1, 1),
+			checkpointBat: ckpBat,
+		})
+	}
+
+	// the file of the failed round is written, but has no manifest
+	sinkSnapshot("a")
+	s.SendRollback()
+	sinkSnapshot("b")
+	s.SendDummy()
+	assert.NoError(t, s.Error())
+	s.Close()
+
+	manifests := readFileTestManifests(t, dir)
+	assert.Equal(t, 1, len(manifests))
+	assert.Equal(t, 1, len(manifests[0].Files))
+	data, err := os.ReadFile(filepath.Join(dir, manifests[0].Files[0].Path))
+	assert.NoError(t, err)
+	assert.Equal(t, "_op,_commit_ts,id,name\ninsert,1-1,1,b\n", string(data))
+
+	// the retried round does not overwrite the file of the failed one
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			files = append(files, entry.Name())
+		}
+	}
+	assert.Equal(t, 2, len(files))
+}
+
+func TestFileSinker_InvalidParams(t *testing.T) {
+	tests := []map[string]string{
+		{FileFormatKey: "xml"},
+		{FileMaxSizeKey: "0"},
+		{FileMaxSizeKey: "abc"},
+	}
+	for _, params := range tests {
+		_, err := NewFileSinker(nil, "", UriInfo{Params: params}, &DbTableInfo{}, nil, newKafkaTestTableDef())
+		assert.Error(t, err)
+	}
+}
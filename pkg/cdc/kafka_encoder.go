@@ -0,0 +1,533 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// kafkaSource is the debezium source block
+type kafkaSource struct {
+	Version   string `json:"version"`
+	Connector string `json:"connector"`
+	Name      string `json:"name"`
+	TsMs      int64  `json:"ts_ms"`
+	Snapshot  string `json:"snapshot"`
+	Db        string `json:"db"`
+	Table     string `json:"table"`
+	CommitTs  string `json:"commit_ts"`
+}
+
+func newKafkaSource(dbTblInfo *DbTableInfo, event *kafkaChangeEvent) kafkaSource {
+	snapshot := "false"
+	if event.op == kafkaOpSnapshot {
+		snapshot = "true"
+	}
+	return kafkaSource{
+		Version:   "1.0",
+		Connector: kafkaConnector,
+		Name:      kafkaConnector,
+		TsMs:      event.ts.Physical() / int64(time.Millisecond),
+		Snapshot:  snapshot,
+		Db:        dbTblInfo.SourceDbName,
+		Table:     dbTblInfo.SourceTblName,
+		CommitTs:  event.ts.ToString(),
+	}
+}
+
+// kafkaValue converts a column value extracted from a batch or decoded from
+// a composite primary key into bool, int64, uint64, float32, float64, string,
+// []byte, []float32 or []float64
+func kafkaValue(ctx context.Context, typ *types.Type, data any) (any, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	switch typ.Oid {
+	case types.T_bool, types.T_float32, types.T_float64,
+		types.T_array_float32, types.T_array_float64:
+		return data, nil
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32:
+		return kafkaInt64(ctx, data)
+	case types.T_uint64, types.T_bit:
+		if v, ok := data.(uint64); ok {
+			return v, nil
+		}
+	case types.T_char, types.T_varchar, types.T_text, types.T_datalink:
+		if v, ok := data.([]byte); ok {
+			return string(v), nil
+		}
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		if v, ok := data.(string); ok {
+			return v, nil
+		}
+	case types.T_blob, types.T_binary, types.T_varbinary:
+		return data, nil
+	case types.T_json:
+		if v, ok := data.(bytejson.ByteJson); ok {
+			return v.String(), nil
+		}
+	case types.T_date:
+		if v, ok := data.(types.Date); ok {
+			return v.String(), nil
+		}
+	case types.T_datetime:
+		if v, ok := data.(types.Datetime); ok {
+			return v.String2(typ.Scale), nil
+		}
+	case types.T_time:
+		if v, ok := data.(types.Time); ok {
+			return v.String2(typ.Scale), nil
+		}
+	case types.T_timestamp:
+		if v, ok := data.(types.Timestamp); ok {
+			return v.String2(time.UTC, typ.Scale), nil
+		}
+	case types.T_decimal64:
+		if v, ok := data.(types.Decimal64); ok {
+			return v.Format(typ.Scale), nil
+		}
+	case types.T_decimal128:
+		if v, ok := data.(types.Decimal128); ok {
+			return v.Format(typ.Scale), nil
+		}
+	case types.T_uuid:
+		if v, ok := data.(types.Uuid); ok {
+			return v.String(), nil
+		}
+	case types.T_enum:
+		switch v := data.(type) {
+		case types.Enum:
+			return v.String(), nil
+		case uint16:
+			return types.Enum(v).String(), nil
+		}
+	case types.T_Rowid, types.T_Blockid:
+		if v, ok := data.(interface{ String() string }); ok {
+			return v.String(), nil
+		}
+	case types.T_TS:
+		if v, ok := data.(types.TS); ok {
+			return v.ToString(), nil
+		}
+	default:
+		return nil, moerr.NewInternalErrorf(ctx, "cdc kafkaSinker: unsupported type %s", typ.String())
+	}
+
+	// datetime, decimal, uuid... are already formatted by extractRowFromVector
+	if v, ok := data.(string); ok {
+		return v, nil
+	}
+	return nil, moerr.NewInternalErrorf(ctx, "cdc kafkaSinker: unexpected value %v of type %s", data, typ.String())
+}
+
+func kafkaInt64(ctx context.Context, data any) (int64, error) {
+	switch v := data.(type) {
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	}
+	return 0, moerr.NewInternalErrorf(ctx, "cdc kafkaSinker: unexpected integer %v", data)
+}
+
+var _ kafkaEncoder = new(kafkaJsonEncoder)
+
+// kafkaJsonEncoder encodes events as debezium json envelopes without schema,
+// the key is a json object of the primary key columns
+type kafkaJsonEncoder struct {
+	dbTblInfo   *DbTableInfo
+	colNames    []string
+	insertTypes []*types.Type
+	pkIdxes     []int
+
+	buf []byte
+}
+
+func newKafkaJsonEncoder(
+	dbTblInfo *DbTableInfo,
+	colNames []string,
+	insertTypes []*types.Type,
+	pkIdxes []int,
+) *kafkaJsonEncoder {
+	return &kafkaJsonEncoder{
+		dbTblInfo:   dbTblInfo,
+		colNames:    colNames,
+		insertTypes: insertTypes,
+		pkIdxes:     pkIdxes,
+		buf:         make([]byte, 0, 1024),
+	}
+}
+
+func (e *kafkaJsonEncoder) encodeKey(ctx context.Context, pk []any) (_ []byte, err error) {
+	e.buf = append(e.buf[:0], '{')
+	for i, idx := range e.pkIdxes {
+		if i > 0 {
+			e.buf = append(e.buf, ',')
+		}
+		if e.buf, err = e.appendField(ctx, e.buf, idx, pk[i]); err != nil {
+			return
+		}
+	}
+	e.buf = append(e.buf, '}')
+	return copyBytes(e.buf), nil
+}
+
+func (e *kafkaJsonEncoder) encodeValue(ctx context.Context, event *kafkaChangeEvent) (_ []byte, err error) {
+	e.buf = append(e.buf[:0], `{"before":`...)
+	if e.buf, err = e.appendRow(ctx, e.buf, event.before); err != nil {
+		return
+	}
+	e.buf = append(e.buf, `,"after":`...)
+	if e.buf, err = e.appendRow(ctx, e.buf, event.after); err != nil {
+		return
+	}
+
+	source, err := json.Marshal(newKafkaSource(e.dbTblInfo, event))
+	if err != nil {
+		return
+	}
+	e.buf = append(e.buf, `,"source":`...)
+	e.buf = append(e.buf, source...)
+	e.buf = append(e.buf, `,"op":"`...)
+	e.buf = append(e.buf, event.op...)
+	e.buf = append(e.buf, `","ts_ms":`...)
+	e.buf = strconv.AppendInt(e.buf, time.Now().UnixMilli(), 10)
+	e.buf = append(e.buf, '}')
+	return copyBytes(e.buf), nil
+}
+
+func (e *kafkaJsonEncoder) appendRow(ctx context.Context, buf []byte, row []any) (_ []byte, err error) {
+	if row == nil {
+		return append(buf, "null"...), nil
+	}
+	buf = append(buf, '{')
+	for i := range row {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		if buf, err = e.appendField(ctx, buf, i, row[i]); err != nil {
+			return
+		}
+	}
+	return append(buf, '}'), nil
+}
+
+func (e *kafkaJsonEncoder) appendField(ctx context.Context, buf []byte, idx int, data any) ([]byte, error) {
+	name, err := json.Marshal(e.colNames[idx])
+	if err != nil {
+		return nil, err
+	}
+	value, err := kafkaValue(ctx, e.insertTypes[idx], data)
+	if err != nil {
+		return nil, err
+	}
+	bs, err := json.Marshal(value)
+	if err != nil {
+		return nil, moerr.NewInternalErrorf(ctx, "cdc kafkaSinker: failed to encode %s: %v", e.colNames[idx], err)
+	}
+	buf = append(buf, name...)
+	buf = append(buf, ':')
+	return append(buf, bs...), nil
+}
+
+var _ kafkaEncoder = new(kafkaAvroEncoder)
+
+// kafkaAvroEncoder encodes events as avro binary with the confluent wire
+// format: a zero magic byte and the 4 bytes schema id registered in the schema
+// registry, followed by the avro payload.
+type kafkaAvroEncoder struct {
+	dbTblInfo   *DbTableInfo
+	insertTypes []*types.Type
+	pkIdxes     []int
+
+	keySchemaId   int
+	valueSchemaId int
+
+	buf []byte
+}
+
+func newKafkaAvroEncoder(
+	registryUrl string,
+	topic string,
+	dbTblInfo *DbTableInfo,
+	colNames []string,
+	insertTypes []*types.Type,
+	pkIdxes []int,
+) (*kafkaAvroEncoder, error) {
+	if registryUrl == "" {
+		return nil, moerr.NewInternalErrorNoCtx("cdc kafkaSinker: avro format needs schema.registry")
+	}
+	client, err := schemaregistry.NewClient(schemaregistry.NewConfig(registryUrl))
+	if err != nil {
+		return nil, err
+	}
+
+	keySchema, valueSchema := kafkaAvroSchemas(dbTblInfo, colNames, insertTypes, pkIdxes)
+	e := &kafkaAvroEncoder{
+		dbTblInfo:   dbTblInfo,
+		insertTypes: insertTypes,
+		pkIdxes:     pkIdxes,
+		buf:         make([]byte, 0, 1024),
+	}
+	if e.keySchemaId, err = client.Register(topic+"-key", schemaregistry.SchemaInfo{Schema: keySchema}, false); err != nil {
+		return nil, moerr.NewInternalErrorNoCtxf("cdc kafkaSinker: failed to register key schema: %v", err)
+	}
+	if e.valueSchemaId, err = client.Register(topic+"-value", schemaregistry.SchemaInfo{Schema: valueSchema}, false); err != nil {
+		return nil, moerr.NewInternalErrorNoCtxf("cdc kafkaSinker: failed to register value schema: %v", err)
+	}
+	return e, nil
+}
+
+// kafkaAvroSchemas returns the key schema and the debezium envelope schema
+func kafkaAvroSchemas(
+	dbTblInfo *DbTableInfo,
+	colNames []string,
+	insertTypes []*types.Type,
+	pkIdxes []int,
+) (string, string) {
+	namespace := avroName(kafkaConnector) + "." + avroName(dbTblInfo.SourceDbName) + "." + avroName(dbTblInfo.SourceTblName)
+
+	keyFields := make([]map[string]any, 0, len(pkIdxes))
+	for _, idx := range pkIdxes {
+		keyFields = append(keyFields, map[string]any{
+			"name": avroName(colNames[idx]),
+			"type": avroType(insertTypes[idx]),
+		})
+	}
+	key := map[string]any{
+		"type":      "record",
+		"name":      "Key",
+		"namespace": namespace,
+		"fields":    keyFields,
+	}
+
+	valueFields := make([]map[string]any, 0, len(colNames))
+	for i := range colNames {
+		valueFields = append(valueFields, map[string]any{
+			"name":    avroName(colNames[i]),
+			"type":    []any{"null", avroType(insertTypes[i])},
+			"default": nil,
+		})
+	}
+	value := map[string]any{
+		"type":   "record",
+		"name":   "Value",
+		"fields": valueFields,
+	}
+	source := map[string]any{
+		"type": "record",
+		"name": "Source",
+		"fields": []map[string]any{
+			{"name": "version", "type": "string"},
+			{"name": "connector", "type": "string"},
+			{"name": "name", "type": "string"},
+			{"name": "ts_ms", "type": "long"},
+			{"name": "snapshot", "type": "string"},
+			{"name": "db", "type": "string"},
+			{"name": "table", "type": "string"},
+			{"name": "commit_ts", "type": "string"},
+		},
+	}
+	envelope := map[string]any{
+		"type":      "record",
+		"name":      "Envelope",
+		"namespace": namespace,
+		"fields": []map[string]any{
+			{"name": "before", "type": []any{"null", value}, "default": nil},
+			{"name": "after", "type": []any{"null", "Value"}, "default": nil},
+			{"name": "source", "type": source},
+			{"name": "op", "type": "string"},
+			{"name": "ts_ms", "type": []any{"null", "long"}, "default": nil},
+		},
+	}
+
+	keyBytes, _ := json.Marshal(key)
+	valueBytes, _ := json.Marshal(envelope)
+	return string(keyBytes), string(valueBytes)
+}
+
+func avroType(typ *types.Type) any {
+	switch typ.Oid {
+	case types.T_bool:
+		return "boolean"
+	case types.T_int8, types.T_int16, types.T_int32, types.T_uint8, types.T_uint16:
+		return "int"
+	case types.T_int64, types.T_uint32, types.T_uint64, types.T_bit:
+		return "long"
+	case types.T_float32:
+		return "float"
+	case types.T_float64:
+		return "double"
+	case types.T_blob, types.T_binary, types.T_varbinary:
+		return "bytes"
+	case types.T_array_float32:
+		return map[string]any{"type": "array", "items": "float"}
+	case types.T_array_float64:
+		return map[string]any{"type": "array", "items": "double"}
+	default:
+		return "string"
+	}
+}
+
+// avroName replaces the characters which are not allowed in avro names
+func avroName(name string) string {
+	var sb strings.Builder
+	for i, r := range name {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (i > 0 && r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteByte('_')
+		}
+	}
+	return sb.String()
+}
+
+func (e *kafkaAvroEncoder) encodeKey(ctx context.Context, pk []any) (_ []byte, err error) {
+	e.buf = appendConfluentHeader(e.buf[:0], e.keySchemaId)
+	for i, idx := range e.pkIdxes {
+		if e.buf, err = e.appendValue(ctx, e.buf, e.insertTypes[idx], pk[i]); err != nil {
+			return
+		}
+	}
+	return copyBytes(e.buf), nil
+}
+
+func (e *kafkaAvroEncoder) encodeValue(ctx context.Context, event *kafkaChangeEvent) (_ []byte, err error) {
+	e.buf = appendConfluentHeader(e.buf[:0], e.valueSchemaId)
+	if e.buf, err = e.appendRow(ctx, e.buf, event.before); err != nil {
+		return
+	}
+	if e.buf, err = e.appendRow(ctx, e.buf, event.after); err != nil {
+		return
+	}
+
+	source := newKafkaSource(e.dbTblInfo, event)
+	e.buf = appendAvroString(e.buf, source.Version)
+	e.buf = appendAvroString(e.buf, source.Connector)
+	e.buf = appendAvroString(e.buf, source.Name)
+	e.buf = binary.AppendVarint(e.buf, source.TsMs)
+	e.buf = appendAvroString(e.buf, source.Snapshot)
+	e.buf = appendAvroString(e.buf, source.Db)
+	e.buf = appendAvroString(e.buf, source.Table)
+	e.buf = appendAvroString(e.buf, source.CommitTs)
+
+	e.buf = appendAvroString(e.buf, event.op)
+	// ts_ms is a ["null", "long"] union
+	e.buf = binary.AppendVarint(e.buf, 1)
+	e.buf = binary.AppendVarint(e.buf, time.Now().UnixMilli())
+	return copyBytes(e.buf), nil
+}
+
+// appendRow encodes a ["null", "Value"] union
+func (e *kafkaAvroEncoder) appendRow(ctx context.Context, buf []byte, row []any) (_ []byte, err error) {
+	if row == nil {
+		return binary.AppendVarint(buf, 0), nil
+	}
+	buf = binary.AppendVarint(buf, 1)
+	for i := range row {
+		// every column is a ["null", type] union
+		if row[i] == nil {
+			buf = binary.AppendVarint(buf, 0)
+			continue
+		}
+		buf = binary.AppendVarint(buf, 1)
+		if buf, err = e.appendValue(ctx, buf, e.insertTypes[i], row[i]); err != nil {
+			return
+		}
+	}
+	return buf, nil
+}
+
+func (e *kafkaAvroEncoder) appendValue(ctx context.Context, buf []byte, typ *types.Type, data any) ([]byte, error) {
+	value, err := kafkaValue(ctx, typ, data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return nil, moerr.NewInternalErrorf(ctx, "cdc kafkaSinker: null value of type %s", typ.String())
+	case bool:
+		if v {
+			return append(buf, 1), nil
+		}
+		return append(buf, 0), nil
+	case int64:
+		// avro int and long share the zigzag varint encoding
+		return binary.AppendVarint(buf, v), nil
+	case uint64:
+		return binary.AppendVarint(buf, int64(v)), nil
+	case float32:
+		return binary.LittleEndian.AppendUint32(buf, math.Float32bits(v)), nil
+	case float64:
+		return binary.LittleEndian.AppendUint64(buf, math.Float64bits(v)), nil
+	case string:
+		return appendAvroString(buf, v), nil
+	case []byte:
+		buf = binary.AppendVarint(buf, int64(len(v)))
+		return append(buf, v...), nil
+	case []float32:
+		if len(v) > 0 {
+			buf = binary.AppendVarint(buf, int64(len(v)))
+			for _, f := range v {
+				buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(f))
+			}
+		}
+		return binary.AppendVarint(buf, 0), nil
+	case []float64:
+		if len(v) > 0 {
+			buf = binary.AppendVarint(buf, int64(len(v)))
+			for _, f := range v {
+				buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(f))
+			}
+		}
+		return binary.AppendVarint(buf, 0), nil
+	}
+	return nil, moerr.NewInternalErrorf(ctx, "cdc kafkaSinker: unexpected value %v of type %s", value, typ.String())
+}
+
+func appendConfluentHeader(buf []byte, schemaId int) []byte {
+	buf = append(buf, 0)
+	return binary.BigEndian.AppendUint32(buf, uint32(schemaId))
+}
+
+func appendAvroString(buf []byte, s string) []byte {
+	buf = binary.AppendVarint(buf, int64(len(s)))
+	return append(buf, s...)
+}
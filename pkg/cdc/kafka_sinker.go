@@ -0,0 +1,453 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	mokafka "github.com/matrixorigin/matrixone/pkg/stream/adapter/kafka"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
+)
+
+const (
+	// KafkaFormatKey is the sink uri param to choose the envelope encoding,
+	// json (default) or avro
+	KafkaFormatKey = "format"
+	// DefaultKafkaTopic maps every table to its own topic, {db} and {table}
+	// are replaced by the sink db and table name of the table
+	DefaultKafkaTopic = "{db}.{table}"
+
+	kafkaConnector        = "matrixone"
+	kafkaDeliveryChanSize = 1024
+	kafkaQueueFullBackoff = 100 // ms
+	// kafkaQueueFullRetry is the most times a message is produced again if
+	// the local queue is full, about 1 minute with kafkaQueueFullBackoff.
+	kafkaQueueFullRetry = 600
+)
+
+// debezium op codes
+const (
+	kafkaOpCreate   = "c"
+	kafkaOpUpdate   = "u"
+	kafkaOpDelete   = "d"
+	kafkaOpSnapshot = "r"
+)
+
+// KafkaProducer is the part of *kafka.Producer used by the kafka sinker
+type KafkaProducer interface {
+	Produce(msg *kafka.Message, deliveryChan chan kafka.Event) error
+	Flush(timeoutMs int) int
+	Close()
+}
+
+var NewKafkaProducer = func(configMap *kafka.ConfigMap) (KafkaProducer, error) {
+	producer, err := kafka.NewProducer(configMap)
+	if err != nil {
+		return nil, err
+	}
+	return producer, nil
+}
+
+// kafkaChangeEvent is one row change, before and after are rows with all
+// user defined columns, nil means the image does not exist
+type kafkaChangeEvent struct {
+	op     string
+	before []any
+	after  []any
+	ts     types.TS
+}
+
+// kafkaEncoder serializes the key and the debezium envelope of change events
+type kafkaEncoder interface {
+	encodeKey(ctx context.Context, pk []any) ([]byte, error)
+	encodeValue(ctx context.Context, event *kafkaChangeEvent) ([]byte, error)
+}
+
+var _ Sinker = new(kafkaSinker)
+
+// kafkaSinker publishes row changes of one table into a kafka topic.
+//
+// Messages are produced asynchronously, SendCommit and SendDummy block until
+// every produced message is acknowledged by the broker, so the watermark of
+// the table can only move forward after its changes are durable in kafka.
+// Kafka can not take back delivered messages, a rollback just waits for the
+// in-flight messages, consumers may see the same change more than once.
+type kafkaSinker struct {
+	producer         KafkaProducer
+	dbTblInfo        *DbTableInfo
+	watermarkUpdater *WatermarkUpdater
+	topic            string
+	encoder          kafkaEncoder
+
+	rowSinkSchema
+
+	// for collect row data, allocate only once
+	insertRow []any
+	deleteRow []any
+	keyRow    []any
+
+	deliveryCh chan kafka.Event
+
+	// mu serializes the acks of the Run goroutine with the sinker methods,
+	// acked is signaled once a message is acknowledged or failed.
+	mu       sync.Mutex
+	acked    *sync.Cond
+	inflight int
+	closed   bool
+	err      error
+}
+
+func NewKafkaSinker(
+	producer KafkaProducer,
+	sinkUri UriInfo,
+	dbTblInfo *DbTableInfo,
+	watermarkUpdater *WatermarkUpdater,
+	tableDef *plan.TableDef,
+) (Sinker, error) {
+	s := &kafkaSinker{
+		producer:         producer,
+		dbTblInfo:        dbTblInfo,
+		watermarkUpdater: watermarkUpdater,
+		topic:            kafkaTopicName(sinkUri, dbTblInfo),
+		deliveryCh:       make(chan kafka.Event, kafkaDeliveryChanSize),
+	}
+
+	var err error
+	if s.rowSinkSchema, err = newRowSinkSchema("kafkaSinker", tableDef, dbTblInfo); err != nil {
+		return nil, err
+	}
+
+	switch format := strings.ToLower(sinkUri.Params[KafkaFormatKey]); format {
+	case "", string(mokafka.JSON):
+		s.encoder = newKafkaJsonEncoder(dbTblInfo, s.colNames, s.insertTypes, s.pkIdxes)
+	case string(mokafka.AVRO):
+		if s.encoder, err = newKafkaAvroEncoder(
+			sinkUri.Params[mokafka.SchemaRegistryKey],
+			s.topic,
+			dbTblInfo,
+			s.colNames,
+			s.insertTypes,
+			s.pkIdxes,
+		); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, moerr.NewInternalErrorNoCtxf("cdc kafkaSinker: unsupported format %s", format)
+	}
+
+	s.insertRow = make([]any, len(s.insertTypes))
+	s.deleteRow = make([]any, 1)
+	s.keyRow = make([]any, len(s.pkIdxes))
+	s.acked = sync.NewCond(&s.mu)
+	return s, nil
+}
+
+// newKafkaProducerConfig builds the producer config from the sink uri, params
+// which are not consumed by the sinker itself are passed to the producer
+func newKafkaProducerConfig(sinkUri UriInfo) *kafka.ConfigMap {
+	configMap := &kafka.ConfigMap{
+		mokafka.BootstrapServersKey: strings.Join(sinkUri.Brokers, ","),
+		"acks":                      "all",
+	}
+	if sinkUri.User != "" {
+		_ = configMap.SetKey("sasl.username", sinkUri.User)
+		_ = configMap.SetKey("sasl.password", sinkUri.Password)
+	}
+	for key, value := range sinkUri.Params {
+		switch key {
+		case mokafka.TopicKey, KafkaFormatKey, mokafka.SchemaRegistryKey:
+		default:
+			_ = configMap.SetKey(key, value)
+		}
+	}
+	return configMap
+}
+
+func kafkaTopicName(sinkUri UriInfo, dbTblInfo *DbTableInfo) string {
+	topic := sinkUri.Params[mokafka.TopicKey]
+	if topic == "" {
+		topic = DefaultKafkaTopic
+	}
+	return strings.NewReplacer(
+		"{db}", dbTblInfo.SinkDbName,
+		"{table}", dbTblInfo.SinkTblName,
+	).Replace(topic)
+}
+
+func (s *kafkaSinker) Run(_ context.Context, _ *ActiveRoutine) {
+	logutil.Infof("cdc kafkaSinker(%v).Run: start", s.dbTblInfo)
+	defer func() {
+		logutil.Infof("cdc kafkaSinker(%v).Run: end", s.dbTblInfo)
+	}()
+
+	for e := range s.deliveryCh {
+		m, ok := e.(*kafka.Message)
+		if !ok {
+			continue
+		}
+		var err error
+		if m.TopicPartition.Error != nil {
+			logutil.Errorf("cdc kafkaSinker(%v) delivery failed, err: %v", s.dbTblInfo, m.TopicPartition.Error)
+			err = moerr.NewInternalErrorNoCtxf("cdc kafkaSinker delivery failed: %v", m.TopicPartition.Error)
+		}
+		s.ack(err)
+	}
+}
+
+func (s *kafkaSinker) Sink(ctx context.Context, data *DecoderOutput) {
+	watermark := s.watermarkUpdater.GetFromMem(s.dbTblInfo.SourceTblIdStr)
+	if data.toTs.LE(&watermark) {
+		logutil.Errorf("cdc kafkaSinker(%v): unexpected watermark: %s, current watermark: %s",
+			s.dbTblInfo, data.toTs.ToString(), watermark.ToString())
+		return
+	}
+
+	// messages are flushed by SendCommit or SendDummy
+	if data.noMoreData {
+		return
+	}
+
+	start := time.Now()
+	defer func() {
+		v2.CdcSinkDurationHistogram.Observe(time.Since(start).Seconds())
+	}()
+
+	var err error
+	if data.outputTyp == OutputTypeSnapshot {
+		err = s.sinkSnapshot(ctx, data.checkpointBat, data.toTs)
+	} else if data.outputTyp == OutputTypeTail {
+		err = s.sinkTail(ctx, data.insertAtmBatch, data.deleteAtmBatch)
+	} else {
+		err = moerr.NewInternalErrorf(ctx, "cdc kafkaSinker unexpected output type: %v", data.outputTyp)
+	}
+	if err != nil {
+		s.setError(err)
+	}
+}
+
+// SendBegin does nothing, kafka messages of a table are not produced in a
+// kafka transaction
+func (s *kafkaSinker) SendBegin() {}
+
+func (s *kafkaSinker) SendCommit() {
+	s.waitAcks()
+}
+
+func (s *kafkaSinker) SendRollback() {
+	s.waitAcks()
+}
+
+func (s *kafkaSinker) SendDummy() {
+	s.waitAcks()
+}
+
+func (s *kafkaSinker) Error() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// setError records the first error, all errors are returned as *moerr.Error
+func (s *kafkaSinker) setError(err error) {
+	moErr := toMoError(err)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err == nil {
+		s.err = moErr
+	}
+}
+
+// Reset clears the error once all produced messages are acknowledged, the
+// acks of the messages produced before can not set the error afterwards.
+func (s *kafkaSinker) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.waitAcksLocked()
+	s.err = nil
+}
+
+func (s *kafkaSinker) Close() {
+	s.mu.Lock()
+	s.waitAcksLocked()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.closed = true
+	s.mu.Unlock()
+
+	s.producer.Close()
+	// stop Run goroutine
+	close(s.deliveryCh)
+	s.insertTypes = nil
+	s.insertRow = nil
+	s.deleteRow = nil
+	s.keyRow = nil
+}
+
+// waitAcks blocks until all produced messages are acknowledged or failed
+func (s *kafkaSinker) waitAcks() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.waitAcksLocked()
+}
+
+func (s *kafkaSinker) waitAcksLocked() {
+	for s.inflight > 0 {
+		s.acked.Wait()
+	}
+}
+
+// addInflight counts a message being produced
+func (s *kafkaSinker) addInflight() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inflight++
+}
+
+// ack finishes a message being produced, err is recorded if it is the first
+func (s *kafkaSinker) ack(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil && s.err == nil {
+		s.err = err
+	}
+	s.inflight--
+	s.acked.Broadcast()
+}
+
+func (s *kafkaSinker) sinkSnapshot(ctx context.Context, bat *batch.Batch, ts types.TS) (err error) {
+	for i := 0; i < batchRowCount(bat); i++ {
+		if err = extractRowFromEveryVector(ctx, bat, i, s.insertRow); err != nil {
+			return
+		}
+		if err = s.produce(ctx, &kafkaChangeEvent{
+			op:    kafkaOpSnapshot,
+			after: s.insertRow,
+			ts:    ts,
+		}); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (s *kafkaSinker) sinkTail(ctx context.Context, insertBatch, deleteBatch *AtomicBatch) error {
+	return sinkTailRows(ctx, insertBatch, deleteBatch, s)
+}
+
+func (s *kafkaSinker) sinkInsert(ctx context.Context, insertIter *atomicBatchRowIter) (err error) {
+	if err = insertIter.Row(ctx, s.insertRow); err != nil {
+		return
+	}
+	return s.produce(ctx, &kafkaChangeEvent{
+		op:    kafkaOpCreate,
+		after: s.insertRow,
+		ts:    insertIter.Item().Ts,
+	})
+}
+
+func (s *kafkaSinker) sinkDelete(ctx context.Context, deleteIter *atomicBatchRowIter) (err error) {
+	var before []any
+	if before, err = s.getDeleteRow(ctx, deleteIter); err != nil {
+		return
+	}
+	return s.produce(ctx, &kafkaChangeEvent{
+		op:     kafkaOpDelete,
+		before: before,
+		ts:     deleteIter.Item().Ts,
+	})
+}
+
+func (s *kafkaSinker) sinkUpdate(ctx context.Context, insertIter, deleteIter *atomicBatchRowIter) (err error) {
+	var before []any
+	if before, err = s.getDeleteRow(ctx, deleteIter); err != nil {
+		return
+	}
+	if err = insertIter.Row(ctx, s.insertRow); err != nil {
+		return
+	}
+	return s.produce(ctx, &kafkaChangeEvent{
+		op:     kafkaOpUpdate,
+		before: before,
+		after:  s.insertRow,
+		ts:     insertIter.Item().Ts,
+	})
+}
+
+// getDeleteRow returns the before image of a deleted row, the tombstone only
+// has the primary key, so the other columns are null
+func (s *kafkaSinker) getDeleteRow(ctx context.Context, deleteIter *atomicBatchRowIter) ([]any, error) {
+	if err := deleteIter.Row(ctx, s.deleteRow); err != nil {
+		return nil, err
+	}
+
+	row := make([]any, len(s.insertTypes))
+	if err := s.fillDeletedRow(row, s.deleteRow[0]); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+func (s *kafkaSinker) produce(ctx context.Context, event *kafkaChangeEvent) (err error) {
+	image := event.after
+	if image == nil {
+		image = event.before
+	}
+	for i, idx := range s.pkIdxes {
+		s.keyRow[i] = image[idx]
+	}
+
+	msg := &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &s.topic, Partition: kafka.PartitionAny},
+	}
+	if msg.Key, err = s.encoder.encodeKey(ctx, s.keyRow); err != nil {
+		return
+	}
+	if msg.Value, err = s.encoder.encodeValue(ctx, event); err != nil {
+		return
+	}
+
+	s.addInflight()
+	for retry := 0; ; retry++ {
+		err = s.producer.Produce(msg, s.deliveryCh)
+		if kerr, ok := err.(kafka.Error); !ok || kerr.Code() != kafka.ErrQueueFull || retry >= kafkaQueueFullRetry {
+			break
+		}
+		// local queue is full, wait for the broker to catch up unless the
+		// task is cancelled or paused
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			err = ctxErr
+			break
+		}
+		s.producer.Flush(kafkaQueueFullBackoff)
+	}
+	if err != nil {
+		s.ack(nil)
+		logutil.Errorf("cdc kafkaSinker(%v) produce failed, err: %v", s.dbTblInfo, err)
+		return moerr.NewInternalErrorf(ctx, "cdc kafkaSinker produce failed: %v", err)
+	}
+	return
+}
@@ -0,0 +1,154 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// rowSinkSchema is the columns of a table whose changes are sunk row by row,
+// by the kafka sinker and the file sinker.
+type rowSinkSchema struct {
+	colNames []string
+	// only contains user defined column types, no mo meta cols
+	insertTypes []*types.Type
+	// index of pk columns in insertTypes
+	pkIdxes []int
+}
+
+func newRowSinkSchema(sinker string, tableDef *plan.TableDef, dbTblInfo *DbTableInfo) (rowSinkSchema, error) {
+	var sc rowSinkSchema
+	for _, col := range tableDef.Cols {
+		// skip internal columns
+		if _, ok := catalog.InternalColumns[col.Name]; ok {
+			continue
+		}
+
+		sc.colNames = append(sc.colNames, col.Name)
+		sc.insertTypes = append(sc.insertTypes, &types.Type{
+			Oid:   types.T(col.Typ.Id),
+			Width: col.Typ.Width,
+			Scale: col.Typ.Scale,
+		})
+	}
+	for _, name := range tableDef.Pkey.Names {
+		for i, colName := range sc.colNames {
+			if colName == name {
+				sc.pkIdxes = append(sc.pkIdxes, i)
+				break
+			}
+		}
+	}
+	if len(sc.pkIdxes) != len(tableDef.Pkey.Names) {
+		return sc, moerr.NewInternalErrorNoCtxf("cdc %s: primary key of %s not found", sinker, dbTblInfo.SourceTblName)
+	}
+	return sc, nil
+}
+
+// fillDeletedRow fills the row of a deleted row by the primary key in the
+// tombstone, the other columns are null.
+func (sc *rowSinkSchema) fillDeletedRow(row []any, pk any) error {
+	for i := range row {
+		row[i] = nil
+	}
+	if len(sc.pkIdxes) == 1 {
+		row[sc.pkIdxes[0]] = pk
+		return nil
+	}
+
+	// composite pk
+	pkTuple, _, err := unpackWithSchema(pk.([]byte))
+	if err != nil {
+		return err
+	}
+	for i, idx := range sc.pkIdxes {
+		if i < len(pkTuple) {
+			row[idx] = pkTuple[i]
+		}
+	}
+	return nil
+}
+
+// rowChangeSinker sinks the changes of the tail row by row.
+type rowChangeSinker interface {
+	sinkInsert(ctx context.Context, insertIter *atomicBatchRowIter) error
+	sinkDelete(ctx context.Context, deleteIter *atomicBatchRowIter) error
+	// sinkUpdate sinks a delete and an insert of the same pk with the same ts
+	sinkUpdate(ctx context.Context, insertIter, deleteIter *atomicBatchRowIter) error
+}
+
+// sinkTailRows sinks the rows of insertBatch and deleteBatch, which are sorted
+// by ts and pk, in the order of ts.
+func sinkTailRows(ctx context.Context, insertBatch, deleteBatch *AtomicBatch, sinker rowChangeSinker) (err error) {
+	insertIter := insertBatch.GetRowIterator().(*atomicBatchRowIter)
+	deleteIter := deleteBatch.GetRowIterator().(*atomicBatchRowIter)
+	defer func() {
+		insertIter.Close()
+		deleteIter.Close()
+	}()
+
+	insertIterHasNext, deleteIterHasNext := insertIter.Next(), deleteIter.Next()
+	for insertIterHasNext && deleteIterHasNext {
+		insertItem, deleteItem := insertIter.Item(), deleteIter.Item()
+		if insertItem.Ts.EQ(&deleteItem.Ts) && bytes.Equal(insertItem.Pk, deleteItem.Pk) {
+			if err = sinker.sinkUpdate(ctx, insertIter, deleteIter); err != nil {
+				return
+			}
+			insertIterHasNext, deleteIterHasNext = insertIter.Next(), deleteIter.Next()
+		} else if insertItem.Less(deleteItem) {
+			if err = sinker.sinkInsert(ctx, insertIter); err != nil {
+				return
+			}
+			insertIterHasNext = insertIter.Next()
+		} else {
+			if err = sinker.sinkDelete(ctx, deleteIter); err != nil {
+				return
+			}
+			deleteIterHasNext = deleteIter.Next()
+		}
+	}
+
+	for insertIterHasNext {
+		if err = sinker.sinkInsert(ctx, insertIter); err != nil {
+			return
+		}
+		insertIterHasNext = insertIter.Next()
+	}
+
+	for deleteIterHasNext {
+		if err = sinker.sinkDelete(ctx, deleteIter); err != nil {
+			return
+		}
+		deleteIterHasNext = deleteIter.Next()
+	}
+	return
+}
+
+// toMoError converts err into *moerr.Error, the sinkers keep their errors
+// as *moerr.Error.
+func toMoError(err error) *moerr.Error {
+	var moErr *moerr.Error
+	if !errors.As(err, &moErr) {
+		moErr = moerr.NewInternalErrorNoCtx(err.Error())
+	}
+	return moErr
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
@@ -65,6 +66,27 @@ func NewSinker(
 		return NewConsoleSinker(dbTblInfo, watermarkUpdater), nil
 	}
 
+	if sinkUri.SinkTyp == KafkaSink {
+		producer, err := NewKafkaProducer(newKafkaProducerConfig(sinkUri))
+		if err != nil {
+			return nil, err
+		}
+		sinker, err := NewKafkaSinker(producer, sinkUri, dbTblInfo, watermarkUpdater, tableDef)
+		if err != nil {
+			producer.Close()
+			return nil, err
+		}
+		return sinker, nil
+	}
+
+	if sinkUri.SinkTyp == StageSink {
+		fs, dir, err := fileservice.GetForETL(context.Background(), nil, sinkUri.FsPath)
+		if err != nil {
+			return nil, err
+		}
+		return NewFileSinker(fs, dir, sinkUri, dbTblInfo, watermarkUpdater, tableDef)
+	}
+
 	sink, err := NewMysqlSink(sinkUri.User, sinkUri.Password, sinkUri.Ip, sinkUri.Port, retryTimes, retryDuration)
 	if err != nil {
 		return nil, err
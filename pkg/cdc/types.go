@@ -39,8 +39,11 @@ const (
 	MysqlSink       = "mysql"
 	MatrixoneSink   = "matrixone"
 	ConsoleSink     = "console"
+	KafkaSink       = "kafka"
+	StageSink       = "stage"
 	SourceUriPrefix = "mysql://"
 	SinkUriPrefix   = "mysql://"
+	KafkaUriPrefix  = "kafka://"
 	ConsolePrefix   = "console://" //only used in testing stage
 
 	SASCommon = "common"
@@ -327,13 +330,79 @@ type UriInfo struct {
 	PasswordStart int    `json:"-"`
 	PasswordEnd   int    `json:"-"`
 	Reserved      string `json:"reserved"`
+
+	// Brokers is only used by the kafka sink
+	Brokers []string `json:"brokers,omitempty"`
+	// Path is the stage url of the stage sink, FsPath is the fileservice
+	// path resolved from the stage when the task starts
+	Path   string `json:"path,omitempty"`
+	FsPath string `json:"-"`
+	// Params are the query params of the kafka sink or the stage sink
+	Params map[string]string `json:"params,omitempty"`
 }
 
 func (info *UriInfo) GetEncodedPassword() (string, error) {
 	return AesCFBEncode([]byte(info.Password))
 }
 
+// IsSecretParam returns true if the value of the kafka param is a secret, like
+// sasl.password, ssl.key.password, ssl.key.pem or sasl.oauthbearer.client.secret
+func IsSecretParam(key string) bool {
+	key = strings.ToLower(key)
+	return strings.Contains(key, "password") ||
+		strings.Contains(key, "secret") ||
+		strings.HasSuffix(key, ".pem") ||
+		key == "sasl.jaas.config" ||
+		key == "sasl.oauthbearer.config"
+}
+
+// EncodeSecretParams returns a copy of the params with the secret values encrypted
+// in the same way as the password, since the params are stored in the sink uri
+func EncodeSecretParams(params map[string]string) (map[string]string, error) {
+	if len(params) == 0 {
+		return params, nil
+	}
+	encoded := make(map[string]string, len(params))
+	for key, value := range params {
+		if IsSecretParam(key) {
+			var err error
+			if value, err = AesCFBEncode([]byte(value)); err != nil {
+				return nil, err
+			}
+		}
+		encoded[key] = value
+	}
+	return encoded, nil
+}
+
+// DecodeSecretParams decrypts the secret values encrypted by EncodeSecretParams
+func DecodeSecretParams(ctx context.Context, params map[string]string) (map[string]string, error) {
+	if len(params) == 0 {
+		return params, nil
+	}
+	decoded := make(map[string]string, len(params))
+	for key, value := range params {
+		if IsSecretParam(key) {
+			var err error
+			if value, err = AesCFBDecode(ctx, value); err != nil {
+				return nil, err
+			}
+		}
+		decoded[key] = value
+	}
+	return decoded, nil
+}
+
 func (info *UriInfo) String() string {
+	if info.Path != "" {
+		return info.Path
+	}
+	if len(info.Brokers) > 0 {
+		if info.User == "" {
+			return fmt.Sprintf("%s%s", KafkaUriPrefix, strings.Join(info.Brokers, ","))
+		}
+		return fmt.Sprintf("%s%s:%s@%s", KafkaUriPrefix, info.User, "******", strings.Join(info.Brokers, ","))
+	}
 	return fmt.Sprintf("%s%s:%s@%s:%d", SourceUriPrefix, info.User, "******", info.Ip, info.Port)
 }
 
@@ -149,6 +149,8 @@ func extractRowFromVector(ctx context.Context, vec *vector.Vector, i int, row []
 		row[i] = vector.GetArrayAt[float32](vec, rowIndex)
 	case types.T_array_float64:
 		row[i] = vector.GetArrayAt[float64](vec, rowIndex)
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		row[i] = types.QuantizedArrayToString(vec.GetType().Oid, vec.GetBytesAt(rowIndex))
 	case types.T_date:
 		row[i] = vector.GetFixedAtWithTypeCheck[types.Date](vec, rowIndex)
 	case types.T_datetime:
@@ -288,6 +290,11 @@ func convertColIntoSql(
 		sqlBuff = appendByte(sqlBuff, '\'')
 		sqlBuff = appendString(sqlBuff, types.ArrayToString(value))
 		sqlBuff = appendByte(sqlBuff, '\'')
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		value := data.(string)
+		sqlBuff = appendByte(sqlBuff, '\'')
+		sqlBuff = appendString(sqlBuff, value)
+		sqlBuff = appendByte(sqlBuff, '\'')
 	case types.T_date:
 		value := data.(types.Date)
 		sqlBuff = appendByte(sqlBuff, '\'')
@@ -382,4 +382,12 @@ func (s *service) registerExecutorsLocked() {
 			s.storeEngine,
 			s.cdcMp,
 		))
+
+	s.task.runner.RegisterExecutor(task.TaskCode_SQLEvent,
+		frontend.RegisterEventExecutor(
+			s.logger,
+			ts,
+			s.sqlExecutor,
+			ieFactory,
+		))
 }
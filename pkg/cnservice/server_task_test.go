@@ -205,6 +205,11 @@ func (ts *testTS) QueryCronTask(ctx context.Context, condition ...taskservice.Co
 	panic("implement me")
 }
 
+func (ts *testTS) DeleteCronTask(ctx context.Context, condition ...taskservice.Condition) (int, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
 func (ts *testTS) CreateDaemonTask(ctx context.Context, value task.TaskMetadata, details *task.Details) error {
 	//TODO implement me
 	panic("implement me")
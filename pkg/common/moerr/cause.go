@@ -73,6 +73,7 @@ var (
 	CauseRemoteCacheRead = NewInternalError(context.Background(), "fileservice remote cache read")
 	//pkg/frontend
 	CauseRegisterCdc           = NewInternalError(context.Background(), "register cdc")
+	CauseRunEvent              = NewInternalError(context.Background(), "run event")
 	CauseInternalExecutorExec  = NewInternalError(context.Background(), "internal executor exec")
 	CauseInternalExecutorQuery = NewInternalError(context.Background(), "internal executor query")
 	CauseHandleRequest         = NewInternalError(context.Background(), "handle request")
@@ -200,6 +201,7 @@ var (
 	CauseTnServiceHeartbeat  = NewInternalError(context.Background(), "tn Service Heartbeat")
 	//pkg/txn/client
 	CauseSyncLatestCommitT = NewInternalError(context.Background(), "SyncLatestCommitTS")
+	CauseCheckXABranches   = NewInternalError(context.Background(), "CheckXABranches")
 	//pkg/txn/service
 	CauseStartAsyncCommitTask = NewInternalError(context.Background(), "StartAsyncCommitTask")
 	CauseTestSenderSend       = NewInternalError(context.Background(), "TestSenderSend")
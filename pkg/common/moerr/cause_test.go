@@ -73,6 +73,7 @@ var causeArray = []error{
 	CauseRemoteCacheRead,
 
 	CauseRegisterCdc,
+	CauseRunEvent,
 	CauseInternalExecutorExec,
 	CauseInternalExecutorQuery,
 	CauseHandleRequest,
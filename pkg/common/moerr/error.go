@@ -225,6 +225,23 @@ const (
 	ErrNewTxnInCNRollingRestart   uint16 = 20635
 	ErrPrevCheckpointNotFinished  uint16 = 20636
 	ErrCantDelGCChecker           uint16 = 20637
+	ErrSavepointNotExist          uint16 = 20638
+	ErrXAUnknownXID               uint16 = 20639
+	ErrXAInvalidArguments         uint16 = 20640
+	ErrXAInvalidState             uint16 = 20641
+	ErrXAOutside                  uint16 = 20642
+	ErrXADuplicateXID             uint16 = 20643
+	ErrXARollbackOnly             uint16 = 20644
+	ErrSPCursorMismatch           uint16 = 20645
+	ErrSPCursorAlreadyOpen        uint16 = 20646
+	ErrSPCursorNotOpen            uint16 = 20647
+	ErrSPWrongNoOfFetchArgs       uint16 = 20648
+	ErrSPFetchNoData              uint16 = 20649
+	ErrSPCondMismatch             uint16 = 20650
+	ErrSPBadSQLState              uint16 = 20651
+	ErrResignalWithoutHandler     uint16 = 20652
+	ErrSignal                     uint16 = 20653
+	ErrSignalBadConditionType     uint16 = 20654
 
 	// Group 7: lock service
 	// ErrDeadLockDetected lockservice has detected a deadlock and should abort the transaction if it receives this error
@@ -243,6 +260,10 @@ const (
 	ErrLockNeedUpgrade uint16 = 20707
 	// ErrCannotCommitOnInvalidCN cannot commit transaction on invalid CN
 	ErrCannotCommitOnInvalidCN uint16 = 20708
+	// ErrLockNoWait lock cannot be acquired immediately with NOWAIT
+	ErrLockNoWait uint16 = 20709
+	// ErrLockWaitTimeout lock wait timeout exceeded
+	ErrLockWaitTimeout uint16 = 20710
 
 	// Group 8: partition
 	ErrPartitionFunctionIsNotAllowed       uint16 = 20801
@@ -468,6 +489,23 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrPrevCheckpointNotFinished:  {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "prev checkpoint not finished"},
 	ErrCantCompileForPrepare:      {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "can not compile for prepare"},
 	ErrCantDelGCChecker:           {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "can't delete gc checker"},
+	ErrSavepointNotExist:          {ER_SP_DOES_NOT_EXIST, []string{"42000"}, "SAVEPOINT %s does not exist"},
+	ErrXAUnknownXID:               {ER_XAER_NOTA, []string{"XAE04"}, "XAER_NOTA: Unknown XID"},
+	ErrXAInvalidArguments:         {ER_XAER_INVAL, []string{"XAE05"}, "XAER_INVAL: Invalid arguments (or unsupported command)"},
+	ErrXAInvalidState:             {ER_XAER_RMFAIL, []string{"XAE07"}, "XAER_RMFAIL: The command cannot be executed when global transaction is in the  %s state"},
+	ErrXAOutside:                  {ER_XAER_OUTSIDE, []string{"XAE09"}, "XAER_OUTSIDE: Some work is done outside global transaction"},
+	ErrXADuplicateXID:             {ER_XAER_DUPID, []string{"XAE08"}, "XAER_DUPID: The XID already exists"},
+	ErrXARollbackOnly:             {ER_XA_RBROLLBACK, []string{"XA100"}, "XA_RBROLLBACK: Transaction branch was rolled back"},
+	ErrSPCursorMismatch:           {ER_SP_CURSOR_MISMATCH, []string{"42000"}, "Undefined CURSOR: %s"},
+	ErrSPCursorAlreadyOpen:        {ER_SP_CURSOR_ALREADY_OPEN, []string{"24000"}, "Cursor is already open"},
+	ErrSPCursorNotOpen:            {ER_SP_CURSOR_NOT_OPEN, []string{"24000"}, "Cursor is not open"},
+	ErrSPWrongNoOfFetchArgs:       {ER_SP_WRONG_NO_OF_FETCH_ARGS, []string{MySQLDefaultSqlState}, "Incorrect number of FETCH variables"},
+	ErrSPFetchNoData:              {ER_SP_FETCH_NO_DATA, []string{"02000"}, "No data - zero rows fetched, selected, or processed"},
+	ErrSPCondMismatch:             {ER_SP_COND_MISMATCH, []string{"42000"}, "Undefined CONDITION: %s"},
+	ErrSPBadSQLState:              {ER_SP_BAD_SQLSTATE, []string{"42000"}, "Bad SQLSTATE: '%s'"},
+	ErrResignalWithoutHandler:     {ER_RESIGNAL_WITHOUT_ACTIVE_HANDLER, []string{"0K000"}, "RESIGNAL when handler not active"},
+	ErrSignal:                     {ER_SIGNAL_EXCEPTION, []string{"45000"}, "%s"},
+	ErrSignalBadConditionType:     {ER_SIGNAL_BAD_CONDITION_TYPE, []string{MySQLDefaultSqlState}, "SIGNAL/RESIGNAL can only use a CONDITION defined with SQLSTATE"},
 
 	// Group 7: lock service
 	ErrDeadLockDetected:        {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "deadlock detected"},
@@ -478,6 +516,8 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrLockConflict:            {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "lock options conflict, wait policy is fast fail"},
 	ErrLockNeedUpgrade:         {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "row level lock is too large that need upgrade to table level lock"},
 	ErrCannotCommitOnInvalidCN: {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "cannot commit a orphan transaction on invalid cn"},
+	ErrLockNoWait:              {ER_LOCK_NOWAIT, []string{"HY000"}, "Statement aborted because lock(s) could not be acquired immediately and NOWAIT is set."},
+	ErrLockWaitTimeout:         {ER_LOCK_WAIT_TIMEOUT, []string{"HY000"}, "Lock wait timeout exceeded; try restarting transaction"},
 
 	// Group 8: partition
 	ErrPartitionFunctionIsNotAllowed:       {ER_PARTITION_FUNCTION_IS_NOT_ALLOWED, []string{MySQLDefaultSqlState}, "This partition function is not allowed"},
@@ -1033,6 +1073,79 @@ func NewTxnError(ctx context.Context, msg string) *Error {
 	return newError(ctx, ErrTxnError, msg)
 }
 
+func NewSavepointNotExist(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrSavepointNotExist, name)
+}
+
+func NewXAUnknownXID(ctx context.Context) *Error {
+	return newError(ctx, ErrXAUnknownXID)
+}
+
+func NewXAInvalidArguments(ctx context.Context) *Error {
+	return newError(ctx, ErrXAInvalidArguments)
+}
+
+func NewXAInvalidState(ctx context.Context, state string) *Error {
+	return newError(ctx, ErrXAInvalidState, state)
+}
+
+func NewXAOutside(ctx context.Context) *Error {
+	return newError(ctx, ErrXAOutside)
+}
+
+func NewXADuplicateXID(ctx context.Context) *Error {
+	return newError(ctx, ErrXADuplicateXID)
+}
+
+func NewXARollbackOnly(ctx context.Context) *Error {
+	return newError(ctx, ErrXARollbackOnly)
+}
+
+func NewSPCursorMismatch(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrSPCursorMismatch, name)
+}
+
+func NewSPCursorAlreadyOpen(ctx context.Context) *Error {
+	return newError(ctx, ErrSPCursorAlreadyOpen)
+}
+
+func NewSPCursorNotOpen(ctx context.Context) *Error {
+	return newError(ctx, ErrSPCursorNotOpen)
+}
+
+func NewSPWrongNoOfFetchArgs(ctx context.Context) *Error {
+	return newError(ctx, ErrSPWrongNoOfFetchArgs)
+}
+
+func NewSPFetchNoData(ctx context.Context) *Error {
+	return newError(ctx, ErrSPFetchNoData)
+}
+
+func NewSPCondMismatch(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrSPCondMismatch, name)
+}
+
+func NewSPBadSQLState(ctx context.Context, state string) *Error {
+	return newError(ctx, ErrSPBadSQLState, state)
+}
+
+func NewResignalWithoutHandler(ctx context.Context) *Error {
+	return newError(ctx, ErrResignalWithoutHandler)
+}
+
+func NewSignalBadConditionType(ctx context.Context) *Error {
+	return newError(ctx, ErrSignalBadConditionType)
+}
+
+// NewSignal returns the error raised by SIGNAL and RESIGNAL, which carries
+// the user specified SQLSTATE and MySQL error code.
+func NewSignal(ctx context.Context, sqlState string, mysqlCode uint16, msg string) *Error {
+	err := newError(ctx, ErrSignal, msg)
+	err.sqlState = sqlState
+	err.mysqlCode = mysqlCode
+	return err
+}
+
 func NewTAEErrorf(ctx context.Context, format string, args ...any) *Error {
 	return NewTAEError(ctx, fmt.Sprintf(format, args...))
 }
@@ -1292,6 +1405,14 @@ func NewLockConflict(ctx context.Context) *Error {
 	return newError(ctx, ErrLockConflict)
 }
 
+func NewLockNoWait(ctx context.Context) *Error {
+	return newError(ctx, ErrLockNoWait)
+}
+
+func NewLockWaitTimeout(ctx context.Context) *Error {
+	return newError(ctx, ErrLockWaitTimeout)
+}
+
 func NewPartitionFunctionIsNotAllowed(ctx context.Context) *Error {
 	return newError(ctx, ErrPartitionFunctionIsNotAllowed)
 }
@@ -62,6 +62,11 @@ func (c arrayCompare) Compare(veci, vecj int, vi, vj int64) int {
 		return CompareArrayFromBytes[float32](_x, _y, c.desc)
 	case types.T_array_float64:
 		return CompareArrayFromBytes[float64](_x, _y, c.desc)
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		if c.desc {
+			return types.CompareQuantizedArray(c.vs[veci].GetType().Oid, _y, _x)
+		}
+		return types.CompareQuantizedArray(c.vs[veci].GetType().Oid, _x, _y)
 	default:
 		panic("Compare Not supported")
 	}
@@ -174,6 +174,9 @@ var (
 	defaultCreateTxnOpTimeout = time.Minute
 
 	defaultConnectTimeout = time.Minute
+
+	// defaultGCTTL is the same as the default gc-ttl of the TN.
+	defaultGCTTL = time.Hour
 )
 
 // FrontendParameters of the frontend
@@ -312,6 +315,10 @@ type FrontendParameters struct {
 	// timeout of authenticating user. different from session timeout
 	// including mysql protocol handshake, checking user, loading session variables
 	ConnectTimeout toml.Duration `toml:"connectTimeout" user_setting:"advanced"`
+
+	// GCTTL is the gc-ttl of the TN, the changes of the tables are retained
+	// for it without a snapshot or pitr. It should be the same as the TN.
+	GCTTL toml.Duration `toml:"gcTTL" user_setting:"advanced"`
 }
 
 func (fp *FrontendParameters) SetDefaultValues() {
@@ -435,6 +442,10 @@ func (fp *FrontendParameters) SetDefaultValues() {
 	if fp.ConnectTimeout.Duration == 0 {
 		fp.ConnectTimeout.Duration = defaultConnectTimeout
 	}
+
+	if fp.GCTTL.Duration == 0 {
+		fp.GCTTL.Duration = defaultGCTTL
+	}
 }
 
 func (fp *FrontendParameters) SetMaxMessageSize(size uint64) {
@@ -0,0 +1,252 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"cmp"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// NOTE: vecf16, veci8 and vecbit in SQL are internally represented using T_array_float16, T_array_int8 and
+// T_array_bit. They are the quantized forms of vecf32, and are stored in 1/2, 1/4 and 1/32 of its size:
+//
+//	vecf16: IEEE 754 half precision floats, 2 bytes per element, little-endian.
+//	veci8:  int8, 1 byte per element.
+//	vecbit: 0 or 1, 8 elements per byte, the first element in the most significant bit. The dimension of
+//	        vecbit must be a multiple of 8, so that it is len(bytes)*8.
+//
+// The text format is the same as vecf32, e.g. "[1, 0, 1, 1, 0, 0, 1, 0]".
+
+// Float16 is an IEEE 754 half precision float, the element of vecf16.
+type Float16 uint16
+
+const (
+	// MaxFloat16 is the largest finite value of Float16.
+	MaxFloat16 = 65504
+)
+
+// Float16FromFloat32 rounds f to the nearest Float16, ties to even.
+func Float16FromFloat32(f float32) Float16 {
+	b := math.Float32bits(f)
+	sign := uint16(b>>16) & 0x8000
+	exp := int32(b>>23) & 0xff
+	mant := b & 0x7fffff
+
+	switch {
+	case exp == 0xff:
+		// Inf or NaN
+		if mant != 0 {
+			return Float16(sign | 0x7e00)
+		}
+		return Float16(sign | 0x7c00)
+	case exp == 0 && mant == 0:
+		return Float16(sign)
+	}
+
+	e := exp - 127 + 15
+	if e >= 0x1f {
+		// overflow to Inf
+		return Float16(sign | 0x7c00)
+	}
+	if e <= 0 {
+		// subnormal or zero
+		if e < -10 {
+			return Float16(sign)
+		}
+		mant |= 0x800000
+		shift := uint32(14 - e)
+		half := mant >> shift
+		rem := mant & (1<<shift - 1)
+		mid := uint32(1) << (shift - 1)
+		if rem > mid || (rem == mid && half&1 == 1) {
+			half++
+		}
+		return Float16(sign | uint16(half))
+	}
+
+	half := uint32(e)<<10 | mant>>13
+	rem := mant & 0x1fff
+	if rem > 0x1000 || (rem == 0x1000 && half&1 == 1) {
+		// may carry into the exponent, which is still the right result
+		half++
+	}
+	return Float16(sign | uint16(half))
+}
+
+// Float32 returns the value of h as float32, which is exact.
+func (h Float16) Float32() float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch {
+	case exp == 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	case exp == 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+		// subnormal, normalize it
+		e := uint32(127 - 15 + 1)
+		for mant&0x400 == 0 {
+			mant <<= 1
+			e--
+		}
+		return math.Float32frombits(sign | e<<23 | (mant&0x3ff)<<13)
+	}
+	return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+}
+
+// QuantizedArrayDimension returns the number of elements of an encoded vecf16, veci8 or vecbit value.
+func QuantizedArrayDimension(oid T, input []byte) int {
+	switch oid {
+	case T_array_float16:
+		return len(input) / 2
+	case T_array_bit:
+		return len(input) * 8
+	default:
+		return len(input)
+	}
+}
+
+// QuantizedArrayToFloat32 decodes a vecf16, veci8 or vecbit value. The elements of vecbit are 0 or 1.
+func QuantizedArrayToFloat32(oid T, input []byte) []float32 {
+	res := make([]float32, QuantizedArrayDimension(oid, input))
+	switch oid {
+	case T_array_float16:
+		for i, h := range DecodeSlice[Float16](input) {
+			res[i] = h.Float32()
+		}
+	case T_array_int8:
+		for i, v := range input {
+			res[i] = float32(int8(v))
+		}
+	case T_array_bit:
+		for i := range res {
+			res[i] = float32(input[i/8] >> (7 - i%8) & 1)
+		}
+	}
+	return res
+}
+
+// Float32ToQuantizedArray encodes the elements as vecf16, veci8 or vecbit. The elements of veci8 are rounded,
+// and the elements of vecbit are 1 if they are not less than 0.5. A value out of the range of the element is
+// an error.
+func Float32ToQuantizedArray(oid T, input []float32) ([]byte, error) {
+	switch oid {
+	case T_array_float16:
+		res := make([]Float16, len(input))
+		for i, v := range input {
+			if math.IsNaN(float64(v)) || math.Abs(float64(v)) > MaxFloat16 {
+				return nil, moerr.NewOutOfRangeNoCtxf("vecf16", "value %v", v)
+			}
+			res[i] = Float16FromFloat32(v)
+		}
+		return EncodeSlice(res), nil
+	case T_array_int8:
+		res := make([]byte, len(input))
+		for i, v := range input {
+			r := math.Round(float64(v))
+			if r < math.MinInt8 || r > math.MaxInt8 || math.IsNaN(r) {
+				return nil, moerr.NewOutOfRangeNoCtxf("veci8", "value %v", v)
+			}
+			res[i] = byte(int8(r))
+		}
+		return res, nil
+	case T_array_bit:
+		if len(input)%8 != 0 {
+			return nil, moerr.NewInvalidInputNoCtxf("the dimension of vecbit must be a multiple of 8, but got %d", len(input))
+		}
+		res := make([]byte, len(input)/8)
+		for i, v := range input {
+			if v >= 0.5 {
+				res[i/8] |= 1 << (7 - i%8)
+			}
+		}
+		return res, nil
+	}
+	return nil, moerr.NewInternalErrorNoCtxf("%s is not a quantized vector type", oid.String())
+}
+
+// StringToQuantizedArray parses "[1,2,3]" to the encoding of vecf16, veci8 or vecbit. Unlike the quantization of
+// Float32ToQuantizedArray, the elements of veci8 must be integers and the elements of vecbit must be 0 or 1.
+func StringToQuantizedArray(oid T, str string) ([]byte, error) {
+	a, err := StringToArray[float32](str)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range a {
+		switch oid {
+		case T_array_int8:
+			if v != float32(math.Trunc(float64(v))) {
+				return nil, moerr.NewInternalErrorNoCtxf("error while casting %v to %s", v, oid.String())
+			}
+		case T_array_bit:
+			if v != 0 && v != 1 {
+				return nil, moerr.NewInternalErrorNoCtxf("error while casting %v to %s", v, oid.String())
+			}
+		}
+	}
+	return Float32ToQuantizedArray(oid, a)
+}
+
+// QuantizedArrayToString formats a vecf16, veci8 or vecbit value as "[1, 2, 3]".
+func QuantizedArrayToString(oid T, input []byte) string {
+	var buffer bytes.Buffer
+	_, _ = io.WriteString(&buffer, "[")
+	for i, value := range QuantizedArrayToFloat32(oid, input) {
+		if i > 0 {
+			_, _ = io.WriteString(&buffer, ", ")
+		}
+		_, _ = io.WriteString(&buffer, strconv.FormatFloat(float64(value), 'f', -1, 32))
+	}
+	_, _ = io.WriteString(&buffer, "]")
+	return buffer.String()
+}
+
+// CompareQuantizedArray compares two vecf16, veci8 or vecbit values element by element, like moarray.Compare.
+func CompareQuantizedArray(oid T, v1, v2 []byte) int {
+	switch oid {
+	case T_array_float16:
+		a, b := DecodeSlice[Float16](v1), DecodeSlice[Float16](v2)
+		for i := 0; i < len(a) && i < len(b); i++ {
+			if x, y := a[i].Float32(), b[i].Float32(); x != y {
+				if x < y {
+					return -1
+				}
+				return 1
+			}
+		}
+		return cmp.Compare(len(a), len(b))
+	case T_array_int8:
+		for i := 0; i < len(v1) && i < len(v2); i++ {
+			if x, y := int8(v1[i]), int8(v2[i]); x != y {
+				if x < y {
+					return -1
+				}
+				return 1
+			}
+		}
+		return cmp.Compare(len(v1), len(v2))
+	default:
+		// the first element is the most significant bit
+		return bytes.Compare(v1, v2)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFloat16(t *testing.T) {
+	tests := []struct {
+		in   float32
+		bits Float16
+		out  float32
+	}{
+		{in: 0, bits: 0x0000, out: 0},
+		{in: 1, bits: 0x3c00, out: 1},
+		{in: -2, bits: 0xc000, out: -2},
+		{in: 0.5, bits: 0x3800, out: 0.5},
+		{in: MaxFloat16, bits: 0x7bff, out: MaxFloat16},
+		// the smallest subnormal
+		{in: 5.960464477539063e-08, bits: 0x0001, out: 5.960464477539063e-08},
+		// 1 + 2^-11 is the middle of 1 and the next Float16, ties to even
+		{in: 1 + 1.0/2048, bits: 0x3c00, out: 1},
+		{in: 1 + 3.0/2048, bits: 0x3c02, out: 1 + 4.0/2048},
+		{in: 100000, bits: 0x7c00, out: float32(math.Inf(1))},
+	}
+	for _, tt := range tests {
+		h := Float16FromFloat32(tt.in)
+		require.Equal(t, tt.bits, h, "input %v", tt.in)
+		require.Equal(t, tt.out, h.Float32(), "input %v", tt.in)
+	}
+	require.True(t, math.IsNaN(float64(Float16FromFloat32(float32(math.NaN())).Float32())))
+}
+
+func TestStringToQuantizedArray(t *testing.T) {
+	tests := []struct {
+		name    string
+		oid     T
+		input   string
+		want    []byte
+		str     string
+		wantErr bool
+	}{
+		{name: "vecf16", oid: T_array_float16, input: "[1, -2.5]", want: []byte{0x00, 0x3c, 0x00, 0xc1}, str: "[1, -2.5]"},
+		{name: "vecf16 out of range", oid: T_array_float16, input: "[70000]", wantErr: true},
+		{name: "veci8", oid: T_array_int8, input: "[1,-128,127]", want: []byte{1, 0x80, 0x7f}, str: "[1, -128, 127]"},
+		{name: "veci8 not integer", oid: T_array_int8, input: "[1.5]", wantErr: true},
+		{name: "veci8 out of range", oid: T_array_int8, input: "[128]", wantErr: true},
+		{name: "vecbit", oid: T_array_bit, input: "[1,0,1,1,0,0,1,0,0,0,0,0,0,0,0,1]", want: []byte{0b10110010, 0b00000001},
+			str: "[1, 0, 1, 1, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 1]"},
+		{name: "vecbit not 0 or 1", oid: T_array_bit, input: "[1,0,1,1,0,0,1,2]", wantErr: true},
+		{name: "vecbit dimension", oid: T_array_bit, input: "[1,0,1]", wantErr: true},
+		{name: "malformed", oid: T_array_int8, input: "1,2", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToQuantizedArray(tt.oid, tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.str, QuantizedArrayToString(tt.oid, got))
+		})
+	}
+}
+
+func TestFloat32ToQuantizedArray(t *testing.T) {
+	got, err := Float32ToQuantizedArray(T_array_int8, []float32{1.4, 1.6, -2.5})
+	require.NoError(t, err)
+	require.Equal(t, []float32{1, 2, -3}, QuantizedArrayToFloat32(T_array_int8, got))
+
+	got, err = Float32ToQuantizedArray(T_array_bit, []float32{0.2, 0.5, 0.8, 0, 0, 0, 0, 1})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0b01100001}, got)
+	require.Equal(t, 8, QuantizedArrayDimension(T_array_bit, got))
+}
+
+func TestCompareQuantizedArray(t *testing.T) {
+	f16 := func(s string) []byte {
+		b, err := StringToQuantizedArray(T_array_float16, s)
+		require.NoError(t, err)
+		return b
+	}
+	require.Equal(t, 0, CompareQuantizedArray(T_array_float16, f16("[1, 2]"), f16("[1, 2]")))
+	require.Equal(t, -1, CompareQuantizedArray(T_array_float16, f16("[-1, 2]"), f16("[1, 2]")))
+	require.Equal(t, 1, CompareQuantizedArray(T_array_float16, f16("[1, 2, 3]"), f16("[1, 2]")))
+
+	require.Equal(t, -1, CompareQuantizedArray(T_array_int8, []byte{0xff}, []byte{1}))
+	require.Equal(t, 1, CompareQuantizedArray(T_array_int8, []byte{1, 2}, []byte{1}))
+
+	require.Equal(t, 1, CompareQuantizedArray(T_array_bit, []byte{0b10000000}, []byte{0b01111111}))
+	require.Equal(t, 0, CompareQuantizedArray(T_array_bit, []byte{0b10000000}, []byte{0b10000000}))
+}
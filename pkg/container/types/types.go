@@ -91,6 +91,9 @@ const (
 	// Array/Vector family
 	T_array_float32 T = 224 // In SQL , it is vecf32
 	T_array_float64 T = 225 // In SQL , it is vecf64
+	T_array_float16 T = 226 // In SQL , it is vecf16
+	T_array_int8    T = 227 // In SQL , it is veci8
+	T_array_bit     T = 228 // In SQL , it is vecbit
 
 	//note: max value of uint8 is 255
 )
@@ -412,6 +415,9 @@ var Types = map[string]T{
 
 	"array float32": T_array_float32,
 	"array float64": T_array_float64,
+	"array float16": T_array_float16,
+	"array int8":    T_array_int8,
+	"array bit":     T_array_bit,
 }
 
 func New(oid T, width, scale int32) Type {
@@ -620,7 +626,7 @@ func (t T) ToType() Type {
 	case T_varchar:
 		typ.Size = VarlenaSize
 		typ.Width = MaxVarcharLen
-	case T_array_float32, T_array_float64:
+	case T_array_float32, T_array_float64, T_array_float16, T_array_int8, T_array_bit:
 		typ.Size = VarlenaSize
 		typ.Width = MaxArrayDimension
 	case T_binary:
@@ -720,6 +726,12 @@ func (t T) String() string {
 		return "VECF32"
 	case T_array_float64:
 		return "VECF64"
+	case T_array_float16:
+		return "VECF16"
+	case T_array_int8:
+		return "VECI8"
+	case T_array_bit:
+		return "VECBIT"
 	case T_enum:
 		return "ENUM"
 	}
@@ -799,6 +811,12 @@ func (t T) OidString() string {
 		return "T_array_float32"
 	case T_array_float64:
 		return "T_array_float64"
+	case T_array_float16:
+		return "T_array_float16"
+	case T_array_int8:
+		return "T_array_int8"
+	case T_array_bit:
+		return "T_array_bit"
 	}
 	return "unknown_type"
 }
@@ -830,7 +848,8 @@ func (t T) TypeLen() int {
 		return 4
 	case T_float64:
 		return 8
-	case T_char, T_varchar, T_json, T_blob, T_text, T_binary, T_varbinary, T_array_float32, T_array_float64, T_datalink:
+	case T_char, T_varchar, T_json, T_blob, T_text, T_binary, T_varbinary, T_array_float32, T_array_float64, T_datalink,
+		T_array_float16, T_array_int8, T_array_bit:
 		return VarlenaSize
 	case T_decimal64:
 		return 8
@@ -883,7 +902,8 @@ func (t T) FixedLength() int {
 		return RowidSize
 	case T_Blockid:
 		return BlockidSize
-	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary, T_array_float32, T_array_float64, T_datalink:
+	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary, T_array_float32, T_array_float64, T_datalink,
+		T_array_float16, T_array_int8, T_array_bit:
 		return -24
 	case T_enum:
 		return 2
@@ -970,6 +990,20 @@ func (t T) IsArrayRelate() bool {
 	return false
 }
 
+// IsQuantizedArray return true if the types.T is vecf16, veci8 or vecbit, which are stored in less bytes than
+// vecf32 and don't support the arithmetic of vectors.
+func (t T) IsQuantizedArray() bool {
+	if t == T_array_float16 || t == T_array_int8 || t == T_array_bit {
+		return true
+	}
+	return false
+}
+
+// IsVector return true if the types.T is any of the vector types.
+func (t T) IsVector() bool {
+	return t.IsArrayRelate() || t.IsQuantizedArray()
+}
+
 func (t T) IsDatalink() bool {
 	return t == T_datalink
 }
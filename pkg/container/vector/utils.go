@@ -332,6 +332,30 @@ func ArrayGetMinMax[T types.RealNumbers](vec *Vector) (minv, maxv []T) {
 	return
 }
 
+func QuantizedArrayGetMinMax(vec *Vector) (minv, maxv []byte) {
+	oid := vec.GetType().Oid
+	col, area := MustVarlenaRawData(vec)
+	first := true
+	for i, j := 0, vec.Length(); i < j; i++ {
+		if vec.IsNull(uint64(i)) {
+			continue
+		}
+		val := col[i].GetByteSlice(area)
+		if first {
+			minv, maxv = val, val
+			first = false
+		} else {
+			if types.CompareQuantizedArray(oid, minv, val) > 0 {
+				minv = val
+			}
+			if types.CompareQuantizedArray(oid, maxv, val) < 0 {
+				maxv = val
+			}
+		}
+	}
+	return
+}
+
 func typeCompatible[T any](typ types.Type) bool {
 	var t T
 	switch (any)(t).(type) {
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"slices"
 	"sort"
+	"strings"
 	"time"
 	"unsafe"
 
@@ -393,7 +394,7 @@ func GetAny(vec *Vector, i int) any {
 	case types.T_Blockid:
 		return GetFixedAtNoTypeCheck[types.Blockid](vec, i)
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text,
-		types.T_array_float32, types.T_array_float64, types.T_datalink:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit, types.T_datalink:
 		return vec.GetBytesAt(i)
 	}
 	return nil
@@ -902,7 +903,7 @@ func (v *Vector) Shrink(sels []int64, negate bool) {
 	case types.T_float64:
 		shrinkFixed[float64](v, sels, negate)
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text,
-		types.T_array_float32, types.T_array_float64, types.T_datalink:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit, types.T_datalink:
 		// XXX shrink varlena, but did not shrink area.  For our vector, this
 		// may well be the right thing.  If want to shrink area as well, we
 		// have to copy each varlena value and swizzle pointer.
@@ -970,7 +971,7 @@ func (v *Vector) ShrinkByMask(sels bitmap.Mask, negate bool) {
 	case types.T_float64:
 		shrinkFixedByMask[float64](v, sels, negate)
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text,
-		types.T_array_float32, types.T_array_float64, types.T_datalink:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit, types.T_datalink:
 		// XXX shrink varlena, but did not shrink area.  For our vector, this
 		// may well be the right thing.  If want to shrink area as well, we
 		// have to copy each varlena value and swizzle pointer.
@@ -1034,7 +1035,7 @@ func (v *Vector) Shuffle(sels []int64, mp *mpool.MPool) (err error) {
 	case types.T_float64:
 		err = shuffleFixedNoTypeCheck[float64](v, sels, mp)
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text,
-		types.T_array_float32, types.T_array_float64, types.T_datalink:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit, types.T_datalink:
 		err = shuffleFixedNoTypeCheck[types.Varlena](v, sels, mp)
 	case types.T_date:
 		err = shuffleFixedNoTypeCheck[types.Date](v, sels, mp)
@@ -1765,7 +1766,7 @@ func GetUnionAllFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector) err
 		}
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary,
 		types.T_json, types.T_blob, types.T_text,
-		types.T_array_float32, types.T_array_float64, types.T_datalink:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit, types.T_datalink:
 		return func(v, w *Vector) error {
 			if w.IsConstNull() {
 				if err := appendMultiFixed(v, 0, true, w.length, mp); err != nil {
@@ -2102,7 +2103,7 @@ func GetConstSetFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector, sel
 			return SetConstFixed(v, ws[sel], length, mp)
 		}
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary,
-		types.T_json, types.T_blob, types.T_text, types.T_array_float32, types.T_array_float64, types.T_datalink:
+		types.T_json, types.T_blob, types.T_text, types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit, types.T_datalink:
 		return func(v, w *Vector, sel int64, length int) error {
 			if w.IsConstNull() || w.nsp.Contains(uint64(sel)) {
 				return SetConstNull(v, length, mp)
@@ -2647,6 +2648,20 @@ func (v *Vector) String() string {
 			return fmt.Sprintf("%v-%s", str, v.nsp.GetBitmap().String())
 		}
 		return fmt.Sprintf("%v-%s", str, v.nsp.GetBitmap().String())
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		col, area := MustVarlenaRawData(v)
+		strs := make([]string, len(col))
+		for i := range col {
+			strs[i] = types.QuantizedArrayToString(v.typ.Oid, col[i].GetByteSlice(area))
+		}
+		if len(col) == 1 {
+			if nulls.Contains(&v.nsp, 0) {
+				return "null"
+			} else {
+				return strs[0]
+			}
+		}
+		return fmt.Sprintf("%v-%s", strings.Join(strs, types.DefaultArraysToStringSep), v.nsp.GetBitmap().String())
 	default:
 		panic("vec to string unknown types.")
 	}
@@ -2815,6 +2830,14 @@ func (v *Vector) RowToString(idx int) string {
 		return implArrayRowToString[float32](v, idx)
 	case types.T_array_float64:
 		return implArrayRowToString[float64](v, idx)
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		if v.IsConstNull() || v.nsp.Contains(uint64(idx)) || (v.IsConst() && nulls.Contains(&v.nsp, 0)) {
+			return "null"
+		}
+		if v.IsConst() {
+			idx = 0
+		}
+		return types.QuantizedArrayToString(v.typ.Oid, v.GetBytesAt(idx))
 	default:
 		panic("vec to string unknown types.")
 	}
@@ -2966,7 +2989,7 @@ func AppendAny(vec *Vector, val any, isNull bool, mp *mpool.MPool) error {
 	case types.T_Blockid:
 		return appendOneFixed(vec, val.(types.Blockid), false, mp)
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text,
-		types.T_array_float32, types.T_array_float64, types.T_datalink:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit, types.T_datalink:
 		return appendOneBytes(vec, val.([]byte), false, mp)
 	}
 	return nil
@@ -3894,6 +3917,8 @@ func (v *Vector) GetMinMaxValue() (ok bool, minv, maxv []byte) {
 		_minv, _maxv := ArrayGetMinMax[float64](v)
 		minv = types.ArrayToBytes[float64](_minv)
 		maxv = types.ArrayToBytes[float64](_maxv)
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		minv, maxv = QuantizedArrayGetMinMax(v)
 	default:
 		panic(fmt.Sprintf("unsupported type %s", v.GetType().String()))
 	}
@@ -4235,6 +4260,20 @@ func (v *Vector) InplaceSortAndCompact() {
 			cleanDataNotResetArea()
 			appendList(v, newCol, nil, nil)
 		}
+
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		oid := v.GetType().Oid
+		col, area := MustVarlenaRawData(v)
+		sort.Slice(col, func(i, j int) bool {
+			return types.CompareQuantizedArray(oid, col[i].GetByteSlice(area), col[j].GetByteSlice(area)) < 0
+		})
+		newCol := slices.CompactFunc(col, func(a, b types.Varlena) bool {
+			return types.CompareQuantizedArray(oid, a.GetByteSlice(area), b.GetByteSlice(area)) == 0
+		})
+		if len(newCol) != len(col) {
+			cleanDataNotResetArea()
+			appendList(v, newCol, nil, nil)
+		}
 	}
 }
 
@@ -4394,6 +4433,12 @@ func (v *Vector) InplaceSort() {
 				types.GetArray[float64](&col[j], area),
 			) < 0
 		})
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		oid := v.GetType().Oid
+		col, area := MustVarlenaRawData(v)
+		sort.Slice(col, func(i, j int) bool {
+			return types.CompareQuantizedArray(oid, col[i].GetByteSlice(area), col[j].GetByteSlice(area)) < 0
+		})
 	}
 }
 
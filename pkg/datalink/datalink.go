@@ -22,8 +22,6 @@ import (
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
-	"github.com/matrixorigin/matrixone/pkg/datalink/docx"
-	"github.com/matrixorigin/matrixone/pkg/datalink/pdf"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/stage"
 	"github.com/matrixorigin/matrixone/pkg/stage/stageutil"
@@ -73,15 +71,16 @@ func (d Datalink) GetPlainText(proc *process.Process) ([]byte, error) {
 		return nil, err
 	}
 
-	ext := strings.ToLower(filepath.Ext(d.Url.Path))
-	switch ext {
-	case ".pdf":
-		return pdf.GetPlainText(fileBytes)
-	case ".docx":
-		return docx.GetPlainText(fileBytes)
-	default:
+	// the content is sniffed only if the url has no extension
+	ext := filepath.Ext(d.Url.Path)
+	if ext == "" {
+		ext = SniffExtension(fileBytes)
+	}
+	extract := GetExtractor(ext)
+	if extract == nil {
 		return fileBytes, nil
 	}
+	return extract(fileBytes)
 }
 
 func (d Datalink) NewWriter(proc *process.Process) (*fileservice.FileServiceWriter, error) {
@@ -0,0 +1,124 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package epub
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/datalink/html"
+)
+
+// epub parser gets the text of EPUB books.  META-INF/container.xml points to the package document (OPF), and the
+// spine of the package document lists the XHTML content documents in reading order.  The text of each content
+// document is extracted by the html parser.
+
+const containerPath = "META-INF/container.xml"
+
+type container struct {
+	Rootfiles []struct {
+		FullPath string `xml:"full-path,attr"`
+	} `xml:"rootfiles>rootfile"`
+}
+
+type opfPackage struct {
+	Items []struct {
+		Id   string `xml:"id,attr"`
+		Href string `xml:"href,attr"`
+	} `xml:"manifest>item"`
+	ItemRefs []struct {
+		IdRef string `xml:"idref,attr"`
+	} `xml:"spine>itemref"`
+}
+
+func GetPlainText(data []byte) ([]byte, error) {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]*zip.File, len(r.File))
+	for _, f := range r.File {
+		files[f.Name] = f
+	}
+
+	content, err := readFile(files, containerPath)
+	if err != nil {
+		return nil, err
+	}
+	var c container
+	if err = xml.Unmarshal(content, &c); err != nil {
+		return nil, err
+	}
+	if len(c.Rootfiles) == 0 {
+		return nil, moerr.NewInternalErrorNoCtx("not a valid epub file: no rootfile")
+	}
+
+	opfPath := c.Rootfiles[0].FullPath
+	if content, err = readFile(files, opfPath); err != nil {
+		return nil, err
+	}
+	var opf opfPackage
+	if err = xml.Unmarshal(content, &opf); err != nil {
+		return nil, err
+	}
+
+	hrefs := make(map[string]string, len(opf.Items))
+	for _, item := range opf.Items {
+		hrefs[item.Id] = item.Href
+	}
+
+	var texts []string
+	for _, ref := range opf.ItemRefs {
+		href, ok := hrefs[ref.IdRef]
+		if !ok {
+			return nil, moerr.NewInternalErrorNoCtxf("not a valid epub file: item %s not found", ref.IdRef)
+		}
+		// href is an URL relative to the package document
+		if u, err := url.PathUnescape(href); err == nil {
+			href = u
+		}
+		if content, err = readFile(files, path.Join(path.Dir(opfPath), href)); err != nil {
+			return nil, err
+		}
+		text, err := html.GetPlainText(content)
+		if err != nil {
+			return nil, err
+		}
+		if len(text) > 0 {
+			texts = append(texts, string(text))
+		}
+	}
+	return []byte(strings.Join(texts, "\n")), nil
+}
+
+func readFile(files map[string]*zip.File, name string) ([]byte, error) {
+	f, ok := files[name]
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtxf("not a valid epub file: %s not found", name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package epub
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPlainText(t *testing.T) {
+	data := newZip(t, [][2]string{
+		{"mimetype", "application/epub+zip"},
+		{"META-INF/container.xml", `<container><rootfiles><rootfile full-path="OEBPS/content.opf" ` +
+			`media-type="application/oebps-package+xml"/></rootfiles></container>`},
+		{"OEBPS/content.opf", `<package xmlns="http://www.idpf.org/2007/opf"><manifest>` +
+			`<item id="c2" href="text/chapter%202.xhtml" media-type="application/xhtml+xml"/>` +
+			`<item id="c1" href="text/chapter1.xhtml" media-type="application/xhtml+xml"/>` +
+			`<item id="css" href="style.css" media-type="text/css"/>` +
+			`</manifest><spine><itemref idref="c1"/><itemref idref="c2"/></spine></package>`},
+		{"OEBPS/text/chapter1.xhtml", `<html><head><title>One</title></head><body><h1>Chapter 1</h1><p>It begins.</p></body></html>`},
+		{"OEBPS/text/chapter 2.xhtml", `<html><body><h1>Chapter 2</h1><p>It ends.</p></body></html>`},
+		{"OEBPS/style.css", `p { color: red; }`},
+	})
+
+	text, err := GetPlainText(data)
+	require.NoError(t, err)
+	require.Equal(t, "One\nChapter 1\nIt begins.\nChapter 2\nIt ends.", string(text))
+
+	_, err = GetPlainText(newZip(t, [][2]string{{"mimetype", "application/epub+zip"}}))
+	require.Error(t, err)
+}
+
+func newZip(t *testing.T, files [][2]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range files {
+		fw, err := w.Create(f[0])
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(f[1]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datalink
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/datalink/docx"
+	"github.com/matrixorigin/matrixone/pkg/datalink/epub"
+	"github.com/matrixorigin/matrixone/pkg/datalink/html"
+	"github.com/matrixorigin/matrixone/pkg/datalink/markdown"
+	"github.com/matrixorigin/matrixone/pkg/datalink/odt"
+	"github.com/matrixorigin/matrixone/pkg/datalink/pdf"
+	"github.com/matrixorigin/matrixone/pkg/datalink/pptx"
+	"github.com/matrixorigin/matrixone/pkg/datalink/rtf"
+	"github.com/matrixorigin/matrixone/pkg/datalink/xlsx"
+)
+
+// Extractor gets the plain text of the document
+type Extractor func(data []byte) ([]byte, error)
+
+// extractors by the lower case file extension with the leading dot
+var extractors = map[string]Extractor{
+	".pdf":      pdf.GetPlainText,
+	".docx":     docx.GetPlainText,
+	".xlsx":     xlsx.GetPlainText,
+	".pptx":     pptx.GetPlainText,
+	".odt":      odt.GetPlainText,
+	".epub":     epub.GetPlainText,
+	".rtf":      rtf.GetPlainText,
+	".html":     html.GetPlainText,
+	".htm":      html.GetPlainText,
+	".xhtml":    html.GetPlainText,
+	".md":       markdown.GetPlainText,
+	".markdown": markdown.GetPlainText,
+}
+
+// RegisterExtractor registers the extractor of the file extension, e.g. ".txt", and replaces the existing one.
+// It is not thread safe and should be called in init().
+func RegisterExtractor(ext string, fn Extractor) {
+	extractors[strings.ToLower(ext)] = fn
+}
+
+// GetExtractor returns the extractor of the file extension, or nil if there is none
+func GetExtractor(ext string) Extractor {
+	return extractors[strings.ToLower(ext)]
+}
+
+// SniffExtension detects the file extension from the content when the url has no extension.  It returns "" when
+// the content is unknown, e.g. plain text.
+func SniffExtension(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte("%PDF-")):
+		return ".pdf"
+	case bytes.HasPrefix(data, []byte("{\\rtf")):
+		return ".rtf"
+	case bytes.HasPrefix(data, []byte("PK\x03\x04")):
+		return sniffZip(data)
+	}
+
+	if strings.HasPrefix(http.DetectContentType(data), "text/html") {
+		return ".html"
+	}
+	return ""
+}
+
+// sniffZip detects the OpenDocument and EPUB files by the mimetype file, and the Office Open XML files by the main
+// part of the package
+func sniffZip(data []byte) string {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return ""
+	}
+
+	for _, f := range r.File {
+		switch f.Name {
+		case "mimetype":
+			rc, err := f.Open()
+			if err != nil {
+				return ""
+			}
+			mimetype, err := io.ReadAll(io.LimitReader(rc, 256))
+			rc.Close()
+			if err != nil {
+				return ""
+			}
+			switch strings.TrimSpace(string(mimetype)) {
+			case "application/vnd.oasis.opendocument.text":
+				return ".odt"
+			case "application/epub+zip":
+				return ".epub"
+			}
+		case "word/document.xml":
+			return ".docx"
+		case "xl/workbook.xml":
+			return ".xlsx"
+		case "ppt/presentation.xml":
+			return ".pptx"
+		}
+	}
+	return ""
+}
@@ -0,0 +1,87 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datalink
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newZip(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		fw, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestSniffExtension(t *testing.T) {
+	docx, err := os.ReadFile("docx/testfiles/test.docx")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"pdf", []byte("%PDF-1.7\n..."), ".pdf"},
+		{"rtf", []byte(`{\rtf1\ansi hello}`), ".rtf"},
+		{"html", []byte("<!DOCTYPE html><html><body>hi</body></html>"), ".html"},
+		{"html without doctype", []byte("\n  <p>paragraph</p>"), ".html"},
+		{"docx", docx, ".docx"},
+		{"xlsx", newZip(t, map[string]string{"[Content_Types].xml": "", "xl/workbook.xml": ""}), ".xlsx"},
+		{"pptx", newZip(t, map[string]string{"[Content_Types].xml": "", "ppt/presentation.xml": ""}), ".pptx"},
+		{"odt", newZip(t, map[string]string{"mimetype": "application/vnd.oasis.opendocument.text", "content.xml": ""}), ".odt"},
+		{"epub", newZip(t, map[string]string{"mimetype": "application/epub+zip", "META-INF/container.xml": ""}), ".epub"},
+		{"unknown zip", newZip(t, map[string]string{"a.txt": "text"}), ""},
+		{"broken zip", []byte("PK\x03\x04broken"), ""},
+		{"text", []byte("just some text"), ""},
+		{"empty", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, SniffExtension(tt.data))
+		})
+	}
+}
+
+func TestExtractor(t *testing.T) {
+	require.NotNil(t, GetExtractor(".PDF"))
+	require.NotNil(t, GetExtractor(".Markdown"))
+	require.Nil(t, GetExtractor(".txt"))
+	require.Nil(t, GetExtractor(""))
+
+	RegisterExtractor(".TXT", func(data []byte) ([]byte, error) {
+		return bytes.ToUpper(data), nil
+	})
+	defer delete(extractors, ".txt")
+
+	text, err := GetExtractor(".txt")([]byte("abc"))
+	require.NoError(t, err)
+	require.Equal(t, "ABC", string(text))
+
+	text, err = GetExtractor(SniffExtension([]byte(`{\rtf1 rich \b text\b0}`)))([]byte(`{\rtf1 rich \b text\b0}`))
+	require.NoError(t, err)
+	require.Equal(t, "rich text", string(text))
+}
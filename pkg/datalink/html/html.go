@@ -0,0 +1,146 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package html
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// html parser gets the text content of HTML and XHTML documents.  The text of script, style and other non-content
+// elements is skipped.  Block elements like <p>, <div> and <li> start a new line, and cells of table are separated
+// by tab.  Whitespaces are collapsed except in <pre>.
+
+var skipElements = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Noscript: true,
+	atom.Template: true,
+	atom.Svg:      true,
+	atom.Head:     true,
+}
+
+var blockElements = map[atom.Atom]bool{
+	atom.Address: true, atom.Article: true, atom.Aside: true, atom.Blockquote: true, atom.Br: true,
+	atom.Dd: true, atom.Div: true, atom.Dl: true, atom.Dt: true, atom.Figcaption: true, atom.Figure: true,
+	atom.Footer: true, atom.Form: true, atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true,
+	atom.H5: true, atom.H6: true, atom.Header: true, atom.Hr: true, atom.Li: true, atom.Main: true,
+	atom.Nav: true, atom.Ol: true, atom.P: true, atom.Pre: true, atom.Section: true, atom.Table: true,
+	atom.Title: true, atom.Tr: true, atom.Ul: true,
+}
+
+var cellElements = map[atom.Atom]bool{
+	atom.Td: true,
+	atom.Th: true,
+}
+
+func GetPlainText(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	skip := 0
+	pre := 0
+	// the title in the head is the only text kept in the head
+	title := false
+
+	z := html.NewTokenizer(bytes.NewReader(data))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			if z.Err() == io.EOF {
+				return []byte(cleanLines(buf.String())), nil
+			}
+			return nil, z.Err()
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, _ := z.TagName()
+			a := atom.Lookup(name)
+			if a == atom.Title {
+				title = tt == html.StartTagToken
+			}
+			if skipElements[a] && tt == html.StartTagToken {
+				skip++
+			}
+			if a == atom.Pre && tt == html.StartTagToken {
+				pre++
+			}
+			if blockElements[a] {
+				buf.WriteByte('\n')
+			} else if cellElements[a] {
+				buf.WriteByte('\t')
+			}
+
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			a := atom.Lookup(name)
+			if a == atom.Title {
+				title = false
+			}
+			if skipElements[a] && skip > 0 {
+				skip--
+			}
+			if a == atom.Pre && pre > 0 {
+				pre--
+			}
+			if blockElements[a] {
+				buf.WriteByte('\n')
+			}
+
+		case html.TextToken:
+			if skip > 0 && !title {
+				continue
+			}
+			text := string(z.Text())
+			if pre == 0 {
+				text = collapseSpaces(text)
+			}
+			buf.WriteString(text)
+		}
+	}
+}
+
+// collapseSpaces replaces the whitespaces including newlines with a single space
+func collapseSpaces(s string) string {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		if len(s) > 0 {
+			return " "
+		}
+		return ""
+	}
+	res := strings.Join(fields, " ")
+	if strings.TrimLeft(s[:1], " \t\r\n\f") == "" {
+		res = " " + res
+	}
+	if strings.TrimRight(s[len(s)-1:], " \t\r\n\f") == "" {
+		res += " "
+	}
+	return res
+}
+
+// cleanLines trims the lines and removes the empty ones
+func cleanLines(s string) string {
+	lines := strings.Split(s, "\n")
+	res := lines[:0]
+	for _, line := range lines {
+		if line = strings.Trim(line, " \t"); line != "" {
+			res = append(res, line)
+		}
+	}
+	return strings.Join(res, "\n")
+}
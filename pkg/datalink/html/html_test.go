@@ -0,0 +1,50 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package html
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPlainText(t *testing.T) {
+	doc := `<!DOCTYPE html>
+<html>
+<head>
+  <title>My   Page</title>
+  <style>body { color: red; }</style>
+  <script>if (a < b) { alert("x"); }</script>
+</head>
+<body>
+  <h1>Heading &amp; more</h1>
+  <p>This is a <b>bold</b>
+     paragraph.<br>Next line</p>
+  <ul><li>one</li><li>two</li></ul>
+  <table><tr><td>cell 1</td><td>cell 2</td></tr></table>
+  <pre>keep   spaces</pre>
+  <script>var hidden = 1;</script>
+</body>
+</html>`
+
+	text, err := GetPlainText([]byte(doc))
+	require.NoError(t, err)
+	require.Equal(t, "My Page\nHeading & more\nThis is a bold paragraph.\nNext line\none\ntwo\ncell 1\tcell 2\nkeep   spaces",
+		string(text))
+
+	text, err = GetPlainText([]byte("plain text without tags"))
+	require.NoError(t, err)
+	require.Equal(t, "plain text without tags", string(text))
+}
@@ -0,0 +1,120 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package markdown
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+// markdown parser removes the markup of Markdown documents line by line and keeps the text, e.g.
+//
+//	# Title                     -> Title
+//	* [link](http://a.com) text -> link text
+//	![image](a.png)             -> image
+//	| a | b |                   -> a	b
+//
+// The content of code blocks is kept as it is.
+
+var (
+	reFence       = regexp.MustCompile("^\\s*(```|~~~)")
+	reHeading     = regexp.MustCompile(`^\s{0,3}#{1,6}\s+`)
+	reHeadingEnd  = regexp.MustCompile(`\s+#+\s*$`)
+	reRule        = regexp.MustCompile(`^\s{0,3}([-*_=])(\s*[-*_=]){2,}\s*$`)
+	reQuote       = regexp.MustCompile(`^\s{0,3}(>\s?)+`)
+	reList        = regexp.MustCompile(`^\s*([-*+]|\d+[.)])\s+(\[[ xX]\]\s+)?`)
+	reTableSep    = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+	reLinkDef     = regexp.MustCompile(`^\s{0,3}\[[^\]]+\]:\s+\S+`)
+	reImage       = regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`)
+	reLink        = regexp.MustCompile(`\[([^\]]*)\](\([^)]*\)|\[[^\]]*\])`)
+	reAutoLink    = regexp.MustCompile(`<((https?|ftp|mailto):[^>]+)>`)
+	reHtmlTag     = regexp.MustCompile(`</?[a-zA-Z][^>]*>`)
+	reCode        = regexp.MustCompile("`+([^`]*)`+")
+	reEmphasis    = regexp.MustCompile(`(\*{1,3}|~~)([^\s*~](.*?[^\s*~])?)(\*{1,3}|~~)`)
+	reUnderscore  = regexp.MustCompile(`(^|[^\w])_{1,3}([^\s_](.*?[^\s_])?)_{1,3}([^\w]|$)`)
+	reEscapedChar = regexp.MustCompile(`\\([\\` + "`" + `*_{}\[\]()#+\-.!|>~])`)
+)
+
+// the escaped ASCII characters are kept in the private use area of unicode while removing the markup
+const escapedBase = rune(0xE000)
+
+func GetPlainText(data []byte) ([]byte, error) {
+	var res []string
+	code := false
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if reFence.MatchString(line) {
+			code = !code
+			continue
+		}
+		if code {
+			res = append(res, line)
+			continue
+		}
+
+		if reRule.MatchString(line) || reTableSep.MatchString(line) || reLinkDef.MatchString(line) {
+			continue
+		}
+
+		if reHeading.MatchString(line) {
+			line = reHeading.ReplaceAllString(line, "")
+			line = reHeadingEnd.ReplaceAllString(line, "")
+		}
+		line = reQuote.ReplaceAllString(line, "")
+		line = reList.ReplaceAllString(line, "")
+
+		// the escaped characters are not markup
+		line = reEscapedChar.ReplaceAllStringFunc(line, func(m string) string {
+			return string(escapedBase + rune(m[1]))
+		})
+
+		// table row
+		if strings.HasPrefix(strings.TrimSpace(line), "|") {
+			cells := strings.Split(strings.Trim(strings.TrimSpace(line), "|"), "|")
+			for i := range cells {
+				cells[i] = strings.TrimSpace(cells[i])
+			}
+			line = strings.Join(cells, "\t")
+		}
+
+		line = reImage.ReplaceAllString(line, "$1")
+		line = reLink.ReplaceAllString(line, "$1")
+		line = reAutoLink.ReplaceAllString(line, "$1")
+		line = reHtmlTag.ReplaceAllString(line, "")
+		line = reCode.ReplaceAllString(line, "$1")
+		line = reEmphasis.ReplaceAllString(line, "$2")
+		line = reUnderscore.ReplaceAllString(line, "$1$2$4")
+		line = strings.Map(func(r rune) rune {
+			if r >= escapedBase && r < escapedBase+128 {
+				return r - escapedBase
+			}
+			return r
+		}, line)
+
+		if line = strings.TrimSpace(line); line != "" {
+			res = append(res, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return []byte(strings.Join(res, "\n")), nil
+}
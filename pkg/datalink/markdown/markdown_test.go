@@ -0,0 +1,60 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPlainText(t *testing.T) {
+	doc := "# The Title #\n" +
+		"\n" +
+		"Some **bold**, _italic_ and ~~deleted~~ text with `code`.\n" +
+		"See [the docs](https://example.com/docs) and <https://example.com>.\n" +
+		"![a picture](pic.png)\n" +
+		"\n" +
+		"> quoted line\n" +
+		"- item one\n" +
+		"2. item two\n" +
+		"- [x] done\n" +
+		"\n" +
+		"---\n" +
+		"| name | value |\n" +
+		"|------|:-----:|\n" +
+		"| a    | 1     |\n" +
+		"\n" +
+		"```go\n" +
+		"x := a * b\n" +
+		"```\n" +
+		"[ref]: https://example.com\n" +
+		"Escaped \\*star\\* and snake_case_name<br/>\n"
+
+	text, err := GetPlainText([]byte(doc))
+	require.NoError(t, err)
+	require.Equal(t, "The Title\n"+
+		"Some bold, italic and deleted text with code.\n"+
+		"See the docs and https://example.com.\n"+
+		"a picture\n"+
+		"quoted line\n"+
+		"item one\n"+
+		"item two\n"+
+		"done\n"+
+		"name\tvalue\n"+
+		"a\t1\n"+
+		"x := a * b\n"+
+		"Escaped *star* and snake_case_name", string(text))
+}
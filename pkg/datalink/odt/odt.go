@@ -0,0 +1,118 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package odt
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// odt parser gets the text of OpenDocument text documents from the office:body of content.xml.  Paragraphs <text:p>
+// and headings <text:h> become lines, and the whitespaces are elements, i.e. <text:s text:c="N"/>, <text:tab/> and
+// <text:line-break/>.
+
+const contentPath = "content.xml"
+
+func GetPlainText(data []byte) ([]byte, error) {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range r.File {
+		if f.Name == contentPath {
+			rc, err := f.Open()
+			if err != nil {
+				return nil, err
+			}
+			defer rc.Close()
+			content, err := io.ReadAll(rc)
+			if err != nil {
+				return nil, err
+			}
+			return parseContent(content)
+		}
+	}
+	return nil, moerr.NewInternalErrorNoCtx("not a valid odt file")
+}
+
+func parseContent(data []byte) ([]byte, error) {
+	var lines []string
+	var sb strings.Builder
+	body := false
+	// paragraphs can be nested, e.g. in the notes
+	depth := 0
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		t, err := decoder.Token()
+		if err == io.EOF {
+			return []byte(strings.Join(lines, "\n")), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch se := t.(type) {
+		case xml.StartElement:
+			switch se.Name.Local {
+			case "body":
+				body = true
+			case "p", "h":
+				if depth == 0 {
+					sb.Reset()
+				} else {
+					sb.WriteByte(' ')
+				}
+				depth++
+			case "s":
+				n := 1
+				for _, attr := range se.Attr {
+					if attr.Name.Local == "c" {
+						if c, err := strconv.Atoi(attr.Value); err == nil && c > 0 {
+							n = c
+						}
+					}
+				}
+				sb.WriteString(strings.Repeat(" ", n))
+			case "tab":
+				sb.WriteByte('\t')
+			case "line-break":
+				sb.WriteByte('\n')
+			}
+		case xml.EndElement:
+			switch se.Name.Local {
+			case "body":
+				body = false
+			case "p", "h":
+				depth--
+				if depth == 0 {
+					if line := strings.TrimSpace(sb.String()); line != "" {
+						lines = append(lines, line)
+					}
+				}
+			}
+		case xml.CharData:
+			if body && depth > 0 {
+				sb.Write(se)
+			}
+		}
+	}
+}
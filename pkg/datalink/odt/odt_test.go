@@ -0,0 +1,58 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package odt
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPlainText(t *testing.T) {
+	data := newZip(t, [][2]string{
+		{"mimetype", "application/vnd.oasis.opendocument.text"},
+		{"content.xml", `<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" ` +
+			`xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0">` +
+			`<office:automatic-styles><style:style>not text</style:style></office:automatic-styles>` +
+			`<office:body><office:text>` +
+			`<text:h text:outline-level="1">Title</text:h>` +
+			`<text:p>a<text:s text:c="3"/>b<text:tab/>c<text:line-break/>d</text:p>` +
+			`<text:p/>` +
+			`<text:p>note<text:note><text:note-body><text:p>inner</text:p></text:note-body></text:note></text:p>` +
+			`</office:text></office:body></office:document-content>`},
+	})
+
+	text, err := GetPlainText(data)
+	require.NoError(t, err)
+	require.Equal(t, "Title\na   b\tc\nd\nnote inner", string(text))
+
+	_, err = GetPlainText(newZip(t, [][2]string{{"mimetype", "application/vnd.oasis.opendocument.text"}}))
+	require.Error(t, err)
+}
+
+func newZip(t *testing.T, files [][2]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range files {
+		fw, err := w.Create(f[0])
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(f[1]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
@@ -0,0 +1,125 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pptx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// pptx parser gets the text of PowerPoint presentations.  The slides ppt/slides/slideN.xml are read in order of N,
+// and each paragraph <a:p> of the text runs <a:t> becomes a line.
+
+const slidePrefix = "ppt/slides/slide"
+
+func GetPlainText(data []byte) ([]byte, error) {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	var slides []*zip.File
+	for _, f := range r.File {
+		if strings.HasPrefix(f.Name, slidePrefix) && strings.HasSuffix(f.Name, ".xml") {
+			slides = append(slides, f)
+		}
+	}
+	if len(slides) == 0 {
+		return nil, moerr.NewInternalErrorNoCtx("not a valid pptx file")
+	}
+	sort.Slice(slides, func(i, j int) bool {
+		return slideNumber(slides[i].Name) < slideNumber(slides[j].Name)
+	})
+
+	var lines []string
+	for _, f := range slides {
+		content, err := readFile(f)
+		if err != nil {
+			return nil, err
+		}
+		paragraphs, err := parseSlide(content)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, paragraphs...)
+	}
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+// parseSlide returns the non-empty paragraphs of the slide
+func parseSlide(data []byte) ([]string, error) {
+	var paragraphs []string
+	var sb strings.Builder
+	text := false
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		t, err := decoder.Token()
+		if err == io.EOF {
+			return paragraphs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch se := t.(type) {
+		case xml.StartElement:
+			switch se.Name.Local {
+			case "p":
+				sb.Reset()
+			case "t":
+				text = true
+			case "br":
+				sb.WriteByte('\n')
+			}
+		case xml.EndElement:
+			switch se.Name.Local {
+			case "p":
+				if p := strings.TrimSpace(sb.String()); p != "" {
+					paragraphs = append(paragraphs, p)
+				}
+			case "t":
+				text = false
+			}
+		case xml.CharData:
+			if text {
+				sb.Write(se)
+			}
+		}
+	}
+}
+
+func slideNumber(name string) int {
+	n, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, slidePrefix), ".xml"))
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+func readFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
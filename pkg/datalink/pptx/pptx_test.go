@@ -0,0 +1,58 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pptx
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPlainText(t *testing.T) {
+	slide := func(paragraphs string) string {
+		return `<p:sld xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" ` +
+			`xmlns:p="http://schemas.openxmlformats.org/presentationml/2006/main"><p:cSld><p:spTree><p:sp><p:txBody>` +
+			paragraphs + `</p:txBody></p:sp></p:spTree></p:cSld></p:sld>`
+	}
+	data := newZip(t, [][2]string{
+		{"ppt/presentation.xml", `<p:presentation/>`},
+		{"ppt/slides/slide2.xml", slide(`<a:p><a:r><a:t>Second slide</a:t></a:r></a:p>`)},
+		{"ppt/slides/slide1.xml", slide(`<a:p><a:r><a:t>Hello </a:t></a:r><a:r><a:t>World</a:t></a:r></a:p>` +
+			`<a:p></a:p><a:p><a:r><a:t>line 1</a:t></a:r><a:br/><a:r><a:t>line 2</a:t></a:r></a:p>`)},
+		{"ppt/slides/_rels/slide1.xml.rels", `<Relationships/>`},
+	})
+
+	text, err := GetPlainText(data)
+	require.NoError(t, err)
+	require.Equal(t, "Hello World\nline 1\nline 2\nSecond slide", string(text))
+
+	_, err = GetPlainText(newZip(t, [][2]string{{"ppt/presentation.xml", `<p:presentation/>`}}))
+	require.Error(t, err)
+}
+
+func newZip(t *testing.T, files [][2]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range files {
+		fw, err := w.Create(f[0])
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(f[1]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
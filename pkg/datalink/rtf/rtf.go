@@ -0,0 +1,206 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtf
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// rtf parser gets the text of Rich Text Format documents.  RTF is plain ASCII of groups {...}, control words
+// \word[N] and control symbols \x.  The text of the destination groups that are not content, e.g. the font table,
+// and the ignorable destinations {\*\dest ...} are skipped.  Non-ASCII characters are either \'hh in the code page
+// of the document, which is assumed to be Windows-1252, or \uN followed by \ucN fallback characters.
+//
+//	{\rtf1\ansi{\fonttbl{\f0 Arial;}}\f0 Hello \b world\b0 !\par caf\'e9 \u8364?}  ->  Hello world!\ncafé €
+
+// destinations without content text
+var skipDestinations = map[string]bool{
+	"fonttbl": true, "colortbl": true, "stylesheet": true, "info": true, "pict": true, "listtable": true,
+	"listoverridetable": true, "rsidtbl": true, "generator": true, "xmlnstbl": true, "themedata": true,
+	"colorschememapping": true, "latentstyles": true, "datastore": true, "fldinst": true, "filetbl": true,
+	"revtbl": true, "header": true, "footer": true, "headerl": true, "headerr": true, "headerf": true,
+	"footerl": true, "footerr": true, "footerf": true, "object": true, "mmathPr": true,
+}
+
+var controlWords = map[string]string{
+	"par": "\n", "line": "\n", "sect": "\n", "page": "\n", "row": "\n",
+	"tab": "\t", "cell": "\t",
+	"emdash": "—", "endash": "–", "bullet": "•",
+	"lquote": "‘", "rquote": "’", "ldblquote": "“", "rdblquote": "”",
+}
+
+// characters 0x80 - 0x9f of Windows-1252, and 0xa0 - 0xff are the same as unicode
+var cp1252 = [32]rune{
+	0x20ac, 0xfffd, 0x201a, 0x0192, 0x201e, 0x2026, 0x2020, 0x2021, 0x02c6, 0x2030, 0x0160, 0x2039, 0x0152, 0xfffd, 0x017d, 0xfffd,
+	0xfffd, 0x2018, 0x2019, 0x201c, 0x201d, 0x2022, 0x2013, 0x2014, 0x02dc, 0x2122, 0x0161, 0x203a, 0x0153, 0xfffd, 0x017e, 0x0178,
+}
+
+type group struct {
+	skip bool
+	// number of fallback characters after \uN
+	uc int
+}
+
+func GetPlainText(data []byte) ([]byte, error) {
+	if !strings.HasPrefix(string(data), "{\\rtf") {
+		return nil, moerr.NewInternalErrorNoCtx("not a valid rtf file")
+	}
+
+	var sb strings.Builder
+	stack := []group{{uc: 1}}
+	// the first control word of the group decides if it is a destination to skip
+	groupStart := false
+	ignorable := false
+	// fallback characters to skip after \uN
+	fallback := 0
+
+	for i := 0; i < len(data); {
+		c := data[i]
+		cur := &stack[len(stack)-1]
+
+		switch c {
+		case '{':
+			stack = append(stack, *cur)
+			groupStart = true
+			ignorable = false
+			i++
+			continue
+		case '}':
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+			groupStart = false
+			fallback = 0
+			i++
+			continue
+		case '\r', '\n':
+			i++
+			continue
+		case '\\':
+			if i+1 >= len(data) {
+				i++
+				continue
+			}
+			next := data[i+1]
+			if isLetter(next) {
+				// control word \word[N][ ]
+				j := i + 1
+				for j < len(data) && isLetter(data[j]) {
+					j++
+				}
+				word := string(data[i+1 : j])
+				k := j
+				if k < len(data) && data[k] == '-' {
+					k++
+				}
+				for k < len(data) && data[k] >= '0' && data[k] <= '9' {
+					k++
+				}
+				param, hasParam := 0, k > j
+				if hasParam {
+					param, _ = strconv.Atoi(string(data[j:k]))
+				}
+				if k < len(data) && data[k] == ' ' {
+					k++
+				}
+				i = k
+
+				if groupStart && (ignorable || skipDestinations[word]) {
+					cur.skip = true
+				}
+				groupStart = false
+
+				switch {
+				case cur.skip:
+				case word == "uc" && hasParam:
+					cur.uc = param
+				case word == "u" && hasParam:
+					if param < 0 {
+						param += 65536
+					}
+					sb.WriteRune(rune(param))
+					fallback = cur.uc
+				default:
+					if s, ok := controlWords[word]; ok {
+						sb.WriteString(s)
+					}
+				}
+				continue
+			}
+
+			// control symbol
+			i += 2
+			switch next {
+			case '*':
+				ignorable = true
+				continue
+			case '\'':
+				if i+2 <= len(data) {
+					if v, err := strconv.ParseUint(string(data[i:i+2]), 16, 8); err == nil {
+						i += 2
+						if fallback > 0 {
+							fallback--
+						} else if !cur.skip {
+							sb.WriteRune(decodeCp1252(byte(v)))
+						}
+					}
+				}
+			case '\\', '{', '}':
+				if fallback > 0 {
+					fallback--
+				} else if !cur.skip {
+					sb.WriteByte(next)
+				}
+			case '~':
+				if !cur.skip {
+					sb.WriteByte(' ')
+				}
+			case '_':
+				if !cur.skip {
+					sb.WriteByte('-')
+				}
+			}
+			groupStart = false
+			continue
+		}
+
+		// plain text
+		groupStart = false
+		i++
+		if fallback > 0 {
+			fallback--
+			continue
+		}
+		if !cur.skip {
+			sb.WriteByte(c)
+		}
+	}
+
+	return []byte(strings.TrimSpace(sb.String())), nil
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func decodeCp1252(b byte) rune {
+	if b >= 0x80 && b < 0xa0 {
+		return cp1252[b-0x80]
+	}
+	return rune(b)
+}
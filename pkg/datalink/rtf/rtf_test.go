@@ -0,0 +1,37 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPlainText(t *testing.T) {
+	doc := `{\rtf1\ansi\deff0{\fonttbl{\f0 Arial;}{\f1 Times;}}{\colortbl;\red255\green0\blue0;}
+{\*\generator Writer;}{\info{\title Secret}}
+\f0\fs24 Hello \b world\b0 !\par
+caf\'e9 \'80 \u8364? {\uc2\u20013\'d6\'d0} text\par
+a\tab b\line c\~d \{braces\} back\\slash\par
+}`
+
+	text, err := GetPlainText([]byte(doc))
+	require.NoError(t, err)
+	require.Equal(t, "Hello world!\ncafé € € 中 text\na\tb\nc d {braces} back\\slash", string(text))
+
+	_, err = GetPlainText([]byte("not rtf"))
+	require.Error(t, err)
+}
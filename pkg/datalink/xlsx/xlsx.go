@@ -0,0 +1,203 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// xlsx parser gets the text of Excel workbooks.  Each row of the worksheets becomes a line with the cells separated
+// by tab, and the worksheets are in the order of xl/worksheets/sheetN.xml.  Strings are stored in
+// xl/sharedStrings.xml and the cells refer to them by index, e.g.
+//
+//	<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1"><v>3.5</v></c></row>  ->  name	3.5
+
+const (
+	sharedStringsPath = "xl/sharedStrings.xml"
+	worksheetPrefix   = "xl/worksheets/sheet"
+)
+
+func GetPlainText(data []byte) ([]byte, error) {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	var sst []string
+	var sheets []*zip.File
+	for _, f := range r.File {
+		if f.Name == sharedStringsPath {
+			content, err := readFile(f)
+			if err != nil {
+				return nil, err
+			}
+			if sst, err = parseSharedStrings(content); err != nil {
+				return nil, err
+			}
+		} else if strings.HasPrefix(f.Name, worksheetPrefix) && strings.HasSuffix(f.Name, ".xml") {
+			sheets = append(sheets, f)
+		}
+	}
+	if len(sheets) == 0 {
+		return nil, moerr.NewInternalErrorNoCtx("not a valid xlsx file")
+	}
+	sort.Slice(sheets, func(i, j int) bool {
+		return sheetNumber(sheets[i].Name) < sheetNumber(sheets[j].Name)
+	})
+
+	var lines []string
+	for _, f := range sheets {
+		content, err := readFile(f)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := parseSheet(content, sst)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, rows...)
+	}
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+// parseSharedStrings returns the text of <si> items.  The phonetic runs <rPh> are skipped.
+func parseSharedStrings(data []byte) ([]string, error) {
+	var sst []string
+	var sb strings.Builder
+	phonetic := 0
+	text := false
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		t, err := decoder.Token()
+		if err == io.EOF {
+			return sst, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch se := t.(type) {
+		case xml.StartElement:
+			switch se.Name.Local {
+			case "si":
+				sb.Reset()
+			case "rPh":
+				phonetic++
+			case "t":
+				text = true
+			}
+		case xml.EndElement:
+			switch se.Name.Local {
+			case "si":
+				sst = append(sst, sb.String())
+			case "rPh":
+				phonetic--
+			case "t":
+				text = false
+			}
+		case xml.CharData:
+			if text && phonetic == 0 {
+				sb.Write(se)
+			}
+		}
+	}
+}
+
+// parseSheet returns the rows of the worksheet with the non-empty cells separated by tab
+func parseSheet(data []byte, sst []string) ([]string, error) {
+	var rows []string
+	var cells []string
+	var value strings.Builder
+	cellType := ""
+	text := false
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		t, err := decoder.Token()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch se := t.(type) {
+		case xml.StartElement:
+			switch se.Name.Local {
+			case "row":
+				cells = cells[:0]
+			case "c":
+				cellType = ""
+				for _, attr := range se.Attr {
+					if attr.Name.Local == "t" {
+						cellType = attr.Value
+					}
+				}
+				value.Reset()
+			case "v", "t":
+				text = true
+			}
+		case xml.EndElement:
+			switch se.Name.Local {
+			case "row":
+				if len(cells) > 0 {
+					rows = append(rows, strings.Join(cells, "\t"))
+				}
+			case "c":
+				v := value.String()
+				if cellType == "s" {
+					idx, err := strconv.Atoi(strings.TrimSpace(v))
+					if err != nil || idx < 0 || idx >= len(sst) {
+						return nil, moerr.NewInternalErrorNoCtxf("invalid shared string index %s", v)
+					}
+					v = sst[idx]
+				}
+				if v != "" {
+					cells = append(cells, v)
+				}
+			case "v", "t":
+				text = false
+			}
+		case xml.CharData:
+			if text {
+				value.Write(se)
+			}
+		}
+	}
+}
+
+func sheetNumber(name string) int {
+	n, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, worksheetPrefix), ".xml"))
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+func readFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
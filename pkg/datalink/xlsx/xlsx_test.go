@@ -0,0 +1,63 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPlainText(t *testing.T) {
+	data := newZip(t, [][2]string{
+		{"[Content_Types].xml", `<Types/>`},
+		{"xl/workbook.xml", `<workbook/>`},
+		{"xl/sharedStrings.xml", `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<si><t>name</t></si><si><t>price</t></si>` +
+			`<si><r><t>apple </t></r><r><t>pie</t></r><rPh><t>ignored</t></rPh></si></sst>`},
+		{"xl/worksheets/sheet10.xml", `<worksheet><sheetData><row r="1"><c r="A1"><v>10</v></c></row></sheetData></worksheet>`},
+		{"xl/worksheets/sheet2.xml", `<worksheet><sheetData><row r="1"><c r="A1" t="inlineStr"><is><t>second</t></is></c>` +
+			`<c r="B1" t="b"><v>1</v></c></row></sheetData></worksheet>`},
+		{"xl/worksheets/sheet1.xml", `<worksheet><sheetData>` +
+			`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>` +
+			`<row r="2"><c r="A2" t="s"><v>2</v></c><c r="B2"><f>1+2.5</f><v>3.5</v></c></row>` +
+			`<row r="3"/></sheetData></worksheet>`},
+	})
+
+	text, err := GetPlainText(data)
+	require.NoError(t, err)
+	require.Equal(t, "name\tprice\napple pie\t3.5\nsecond\t1\n10", string(text))
+
+	_, err = GetPlainText(newZip(t, [][2]string{{"word/document.xml", `<document/>`}}))
+	require.Error(t, err)
+
+	_, err = GetPlainText(newZip(t, [][2]string{{"xl/worksheets/sheet1.xml", `<worksheet><sheetData><row><c t="s"><v>5</v></c></row></sheetData></worksheet>`}}))
+	require.Error(t, err)
+}
+
+func newZip(t *testing.T, files [][2]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range files {
+		fw, err := w.Create(f[0])
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(f[1]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
@@ -136,6 +136,14 @@ func (l *LocalETLFS) write(ctx context.Context, vector IOVector) error {
 	if err != nil {
 		return err
 	}
+	// remove the temp file if it is not moved to the target path
+	moved := false
+	defer func() {
+		if !moved {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
 	var buf []byte
 	put := ioBufferPool.Get(&buf)
 	defer put.Put()
@@ -170,6 +178,7 @@ func (l *LocalETLFS) write(ctx context.Context, vector IOVector) error {
 	if err := os.Rename(f.Name(), nativePath); err != nil {
 		return err
 	}
+	moved = true
 
 	if err := l.syncDir(parentDir); err != nil {
 		return err
@@ -259,6 +259,14 @@ func (l *LocalFS) write(ctx context.Context, vector IOVector) (bytesWritten int,
 	if err != nil {
 		return 0, err
 	}
+	// remove the temp file if it is not moved to the target path
+	moved := false
+	defer func() {
+		if !moved {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
 	fileWithChecksum, put := NewFileWithChecksumOSFile(ctx, f, _BlockContentSize, l.perfCounterSets)
 	defer put.Put()
 
@@ -302,6 +310,7 @@ func (l *LocalFS) write(ctx context.Context, vector IOVector) (bytesWritten int,
 	if err := os.Rename(f.Name(), nativePath); err != nil {
 		return 0, err
 	}
+	moved = true
 
 	if err := l.syncDir(parentDir); err != nil {
 		return 0, err
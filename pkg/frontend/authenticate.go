@@ -24,6 +24,7 @@ import (
 	"math"
 	"math/bits"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -927,6 +928,9 @@ var (
 		"mo_snapshots":                0,
 		"mo_cdc_task":                 0,
 		"mo_cdc_watermark":            0,
+		catalog.MO_EVENTS:             0,
+		catalog.MO_EVENT_RUNS:         0,
+		catalog.MO_EVENT_HISTORY:      0,
 	}
 	sysAccountTables = map[string]struct{}{
 		catalog.MOVersionTable:       {},
@@ -967,6 +971,9 @@ var (
 		catalog.MO_RETENTION:          0,
 		"mo_cdc_task":                 0,
 		"mo_cdc_watermark":            0,
+		catalog.MO_EVENTS:             0,
+		catalog.MO_EVENT_RUNS:         0,
+		catalog.MO_EVENT_HISTORY:      0,
 	}
 	createDbInformationSchemaSql = "create database information_schema;"
 	createAutoTableSql           = MoCatalogMoAutoIncrTableDDL
@@ -1004,6 +1011,9 @@ var (
 		MoCatalogMoCdcTaskDDL,
 		MoCatalogMoCdcWatermarkDDL,
 		MoCatalogMoDataKeyDDL,
+		MoCatalogMoEventsDDL,
+		MoCatalogMoEventRunsDDL,
+		MoCatalogMoEventHistoryDDL,
 	}
 
 	//drop tables for the tenant
@@ -1023,6 +1033,9 @@ var (
 		`drop view if exists mo_catalog.mo_transactions;`,
 		`drop view if exists mo_catalog.mo_cache;`,
 		`drop table if exists mo_catalog.mo_snapshots;`,
+		`drop view if exists mo_catalog.mo_event_history;`,
+		`drop table if exists mo_catalog.mo_event_runs;`,
+		`drop table if exists mo_catalog.mo_events;`,
 	}
 	dropMoMysqlCompatibilityModeSql = `drop table if exists mo_catalog.mo_mysql_compatibility_mode;`
 	dropAutoIcrColSql               = fmt.Sprintf("drop table if exists mo_catalog.`%s`;", catalog.MOAutoIncrTable)
@@ -5342,6 +5355,21 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
 		writeDatabaseAndTableDirectly = true
+	case *tree.CreateEvent:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		dbName = string(st.Name.Name.SchemaName)
+	case *tree.AlterEvent:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		dbName = string(st.Name.Name.SchemaName)
+	case *tree.DropEvent:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		dbName = string(st.Name.Name.SchemaName)
 	case *tree.CallStmt: // TODO: redesign privilege for calling a procedure
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
@@ -5465,9 +5493,15 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 	case *tree.ExplainFor, *tree.ExplainAnalyze, *tree.ExplainStmt, *tree.ExplainPhyPlan:
 		objType = objectTypeNone
 		kind = privilegeKindNone
-	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+		*tree.Savepoint, *tree.RollbackToSavepoint, *tree.ReleaseSavepoint,
+		*tree.XAStart, *tree.XAEnd, *tree.XAPrepare, *tree.XACommit, *tree.XARollback:
 		objType = objectTypeNone
 		kind = privilegeKindNone
+	case *tree.XARecover:
+		objType = objectTypeNone
+		kind = privilegeKindSpecial
+		special = specialTagAdmin
 	case *tree.SetVar:
 		objType = objectTypeNone
 		kind = privilegeKindNone
@@ -5692,6 +5726,17 @@ func extractPrivilegeTipsFromPlan(p *plan2.Plan) privilegeTipsArray {
 						})
 					}
 				}
+			} else if node.NodeType == plan.Node_FUNCTION_SCAN {
+				//the table function reading a table, such as table_changes
+				if node.ObjRef != nil {
+					appendPt(privilegeTips{
+						typ:                   PrivilegeTypeSelect,
+						databaseName:          node.ObjRef.GetSchemaName(),
+						tableName:             node.ObjRef.GetObjName(),
+						isClusterTable:        isClusterTable(node.ObjRef.GetSchemaName(), node.ObjRef.GetObjName()),
+						clusterTableOperation: clusterTableSelect,
+					})
+				}
 			} else if node.NodeType == plan.Node_MULTI_UPDATE {
 				for _, updateCtx := range node.UpdateCtxList {
 					if !isIndexTable(updateCtx.ObjRef.GetObjName()) {
@@ -7131,6 +7176,12 @@ func authenticateUserCanExecuteStatementWithObjectTypeNone(ctx context.Context,
 			return tenant.IsAdminRole(), nil
 		}
 
+		checkXARecoverPrivilege := func() (bool, error) {
+			//only the moAdmin or accountAdmin can execute the xa recover,
+			//as the XA_RECOVER_ADMIN in mysql
+			return tenant.IsAdminRole(), nil
+		}
+
 		switch gp := stmt.(type) {
 		case *tree.Grant:
 			if gp.Typ == tree.GrantTypePrivilege {
@@ -7169,6 +7220,8 @@ func authenticateUserCanExecuteStatementWithObjectTypeNone(ctx context.Context,
 			return checkBackUpStartPrivilege()
 		case *tree.CreateCDC, *tree.ShowCDC, *tree.PauseCDC, *tree.DropCDC, *tree.ResumeCDC, *tree.RestartCDC:
 			return checkCdcTaskPrivilege()
+		case *tree.XARecover:
+			return checkXARecoverPrivilege()
 		}
 	}
 
@@ -8317,6 +8370,7 @@ func InitProcedure(ctx context.Context, ses *Session, tenant *TenantInfo, cp *tr
 			Name:      cp.Args[i].(*tree.ProcedureArgDecl).Name,
 			Type:      cp.Args[i].(*tree.ProcedureArgDecl).Type,
 			InOutType: cp.Args[i].(*tree.ProcedureArgDecl).InOutType,
+			Position:  i,
 		}
 	}
 	argsJson, err = json.Marshal(argList)
@@ -8641,7 +8695,9 @@ func GetVersionCompatibility(ctx context.Context, ses *Session, dbName string) (
 	return resultConfig, err
 }
 
-func doInterpretCall(ctx context.Context, ses *Session, call *tree.CallStmt) ([]ExecResult, error) {
+// doInterpretCall executes the procedure. The result sets of the procedure
+// are sent by sendResult if it is not nil, otherwise they are returned.
+func doInterpretCall(ctx context.Context, ses *Session, call *tree.CallStmt, sendResult func(ExecResult) error) ([]ExecResult, error) {
 	// fetch related
 	var spBody string
 	var dbName string
@@ -8725,13 +8781,23 @@ func doInterpretCall(ctx context.Context, ses *Session, call *tree.CallStmt) ([]
 	argsAttr = make(map[string]tree.InOutArgType)
 	argsMap = make(map[string]tree.Expr) // map arg to param
 
-	// build argsAttr and argsMap
+	// build argsAttr and argsMap, the arguments are passed in the order of
+	// the declaration
 	ses.Info(ctx, "Interpret procedure call length:"+strconv.Itoa(len(argList)))
-	i := 0
-	for curName, v := range argList {
-		argsAttr[curName] = v.InOutType
+	argNames := make([]string, 0, len(argList))
+	for curName := range argList {
+		argNames = append(argNames, curName)
+	}
+	sort.Slice(argNames, func(i, j int) bool {
+		pi, pj := argList[argNames[i]].Position, argList[argNames[j]].Position
+		if pi != pj {
+			return pi < pj
+		}
+		return argNames[i] < argNames[j]
+	})
+	for i, curName := range argNames {
+		argsAttr[curName] = argList[curName].InOutType
 		argsMap[curName] = call.Args[i]
-		i++
 	}
 
 	interpreter.ctx = ctx
@@ -8742,7 +8808,7 @@ func doInterpretCall(ctx context.Context, ses *Session, call *tree.CallStmt) ([]
 	interpreter.result = nil
 	interpreter.argsMap = argsMap
 	interpreter.argsAttr = argsAttr
-	interpreter.outParamMap = make(map[string]interface{})
+	interpreter.sendResult = sendResult
 
 	err = interpreter.ExecuteSp(stmt[0], dbName)
 	if err != nil {
@@ -17,6 +17,7 @@ package frontend
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -30,6 +31,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -5898,7 +5900,7 @@ func Test_doInterpretCall(t *testing.T) {
 		mrs := newMrsForPasswordOfUser([][]interface{}{})
 		bh.sql2result[sql] = mrs
 
-		_, err = doInterpretCall(ctx, ses, call)
+		_, err = doInterpretCall(ctx, ses, call, nil)
 		convey.So(err, convey.ShouldNotBeNil)
 	})
 
@@ -5950,7 +5952,7 @@ func Test_doInterpretCall(t *testing.T) {
 		})
 		bh.sql2result[sql] = mrs
 
-		_, err = doInterpretCall(ctx, ses, call)
+		_, err = doInterpretCall(ctx, ses, call, nil)
 		convey.So(err, convey.ShouldNotBeNil)
 	})
 
@@ -6013,11 +6015,262 @@ func Test_doInterpretCall(t *testing.T) {
 		mrs = newMrsForPasswordOfUser([][]interface{}{})
 		bh.sql2result[sql] = mrs
 
-		_, err = doInterpretCall(ctx, ses, call)
+		_, err = doInterpretCall(ctx, ses, call, nil)
 		convey.So(err, convey.ShouldBeNil)
 	})
 }
 
+// newInterpretCallTest prepares the session to call a procedure with the
+// body and an OUT parameter res, which is flushed to the user variable @res.
+func newInterpretCallTest(ctrl *gomock.Controller, bh *backgroundExecTest, body string) (context.Context, *Session, *tree.CallStmt) {
+	call := &tree.CallStmt{
+		Name: tree.NewProcedureName("test_interpret_call", tree.ObjectNamePrefix{}),
+		Args: []tree.Expr{tree.NewVarExpr("res", false, false, nil)},
+	}
+
+	priv := determinePrivilegeSetOfStatement(call)
+	ses := newSes(priv, ctrl)
+	proc := testutil.NewProcess()
+	proc.Base.FileService = getPu(ses.GetService()).FileService
+	proc.Base.SessionInfo = process.SessionInfo{Account: sysAccountName}
+	ses.SetDatabaseName("procedure_test")
+	pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+	pu.SV.SetDefaultValues()
+	ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+	ses.GetTxnCompileCtx().execCtx = &ExecCtx{reqCtx: ctx, proc: proc, ses: ses}
+
+	//no result set
+	bh.sql2result["begin;"] = nil
+	bh.sql2result["commit;"] = nil
+	bh.sql2result["rollback;"] = nil
+
+	args, err := json.Marshal(map[string]tree.ProcedureArgForMarshal{
+		"res": {InOutType: tree.TYPE_OUT},
+	})
+	convey.So(err, convey.ShouldBeNil)
+	sql, err := getSqlForSpBody(ses.GetTxnHandler().GetConnCtx(), string(call.Name.Name.ObjectName), ses.GetDatabaseName())
+	convey.So(err, convey.ShouldBeNil)
+	bh.sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+		{body, string(args)},
+	})
+
+	sql = getSqlForGetSystemVariablesWithAccount(uint64(ses.GetTenantInfo().GetTenantID()))
+	bh.sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{})
+
+	sql = getSqlForGetSystemVariableValueWithDatabase("procedure_test", "version_compatibility")
+	bh.sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+		{"0.7"},
+	})
+	return ctx, ses, call
+}
+
+func newMrsForInterpretCall(column string, rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName(column)
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	mrs.AddColumn(col1)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func getInterpretCallRes(ses *Session) interface{} {
+	v, err := ses.GetUserDefinedVar("res")
+	convey.So(err, convey.ShouldBeNil)
+	convey.So(v, convey.ShouldNotBeNil)
+	return v.Value
+}
+
+func Test_doInterpretCallCursor(t *testing.T) {
+	convey.Convey("fetch a cursor until not found", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		ctx, ses, call := newInterpretCallTest(ctrl, bh, `begin
+			declare v int default 0;
+			declare done int default 0;
+			declare c cursor for select a from t1;
+			declare continue handler for not found set done = 1;
+			open c;
+			fetch c into v;
+			fetch c into v;
+			fetch c into v;
+			close c;
+			if done = 1 then set res = v; end if;
+		end`)
+		bh.sql2result["select a from t1"] = newMrsForInterpretCall("a", [][]interface{}{
+			{int64(10)},
+			{int64(20)},
+		})
+		bh.sql2result["select done = 1"] = newMrsForInterpretCall("done", [][]interface{}{
+			{int64(1)},
+		})
+
+		_, err := doInterpretCall(ctx, ses, call, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(getInterpretCallRes(ses), convey.ShouldEqual, 20)
+	})
+
+	convey.Convey("fetch a closed cursor fail", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		ctx, ses, call := newInterpretCallTest(ctrl, bh, `begin
+			declare v int default 0;
+			declare c cursor for select a from t1;
+			open c;
+			close c;
+			fetch c into v;
+		end`)
+		bh.sql2result["select a from t1"] = newMrsForInterpretCall("a", [][]interface{}{
+			{int64(10)},
+		})
+
+		_, err := doInterpretCall(ctx, ses, call, nil)
+		convey.So(moerr.IsMoErrCode(err, moerr.ErrSPCursorNotOpen), convey.ShouldBeTrue)
+	})
+}
+
+func Test_doInterpretCallHandler(t *testing.T) {
+	convey.Convey("exit handler leaves its block and continue handler resumes", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		ctx, ses, call := newInterpretCallTest(ctrl, bh, `begin
+			declare x int default 0;
+			begin
+				declare exit handler for sqlstate '45000' set x = x + 10;
+				signal sqlstate '45000';
+				set x = x + 1;
+			end;
+			begin
+				declare continue handler for sqlstate '45000' set x = x + 100;
+				signal sqlstate '45000';
+				set x = x + 1000;
+			end;
+			set res = x;
+		end`)
+
+		_, err := doInterpretCall(ctx, ses, call, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(getInterpretCallRes(ses), convey.ShouldEqual, 1110)
+	})
+
+	convey.Convey("resignal the handled condition", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		ctx, ses, call := newInterpretCallTest(ctrl, bh, `begin
+			declare exit handler for sqlstate '45000'
+			begin
+				resignal set message_text = 'resignaled';
+			end;
+			signal sqlstate '45000' set message_text = 'raised';
+		end`)
+
+		_, err := doInterpretCall(ctx, ses, call, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldEqual, "resignaled")
+		sqlState, _ := getErrorCondition(err)
+		convey.So(sqlState, convey.ShouldEqual, "45000")
+	})
+
+	convey.Convey("unhandled warning is raised as a warning", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		ctx, ses, call := newInterpretCallTest(ctrl, bh, `begin
+			signal sqlstate '01000' set message_text = 'just a warning';
+			set res = 1;
+		end`)
+
+		_, err := doInterpretCall(ctx, ses, call, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(getInterpretCallRes(ses), convey.ShouldEqual, 1)
+
+		info := ses.GetErrInfo()
+		convey.So(info.length(), convey.ShouldEqual, 1)
+		convey.So(info.levels[0], convey.ShouldEqual, "Warning")
+		convey.So(info.codes[0], convey.ShouldEqual, moerr.ER_SIGNAL_WARN)
+		convey.So(info.msgs[0], convey.ShouldEqual, "just a warning")
+	})
+}
+
+func Test_doInterpretCallResultSets(t *testing.T) {
+	convey.Convey("every select returns a result set", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		ctx, ses, call := newInterpretCallTest(ctrl, bh, `begin
+			select a from t1;
+			select b from t2;
+			set res = 2;
+		end`)
+		t1 := newMrsForInterpretCall("a", [][]interface{}{
+			{int64(1)},
+		})
+		t2 := newMrsForInterpretCall("b", [][]interface{}{})
+		bh.sql2result["select a from t1"] = t1
+		bh.sql2result["select b from t2"] = t2
+
+		results, err := doInterpretCall(ctx, ses, call, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(results, convey.ShouldResemble, []ExecResult{t1, t2})
+		convey.So(getInterpretCallRes(ses), convey.ShouldEqual, 2)
+
+		// the result sets are sent as soon as they are produced
+		var sent []ExecResult
+		results, err = doInterpretCall(ctx, ses, call, func(er ExecResult) error {
+			sent = append(sent, er)
+			return nil
+		})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(results, convey.ShouldBeEmpty)
+		convey.So(sent, convey.ShouldResemble, []ExecResult{t1, t2})
+	})
+}
+
 func Test_initProcedure(t *testing.T) {
 	convey.Convey("init precedure fail", t, func() {
 		ctrl := gomock.NewController(t)
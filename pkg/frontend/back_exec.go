@@ -128,7 +128,9 @@ func (back *backExec) Exec(ctx context.Context, sql string) error {
 	if back.backSes.GetTxnHandler().IsShareTxn() {
 		for _, stmt := range statements {
 			switch stmt.(type) {
-			case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+			case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+				*tree.Savepoint, *tree.RollbackToSavepoint, *tree.ReleaseSavepoint,
+				*tree.XAStart, *tree.XAEnd, *tree.XAPrepare, *tree.XACommit, *tree.XARollback:
 				return moerr.NewInternalErrorf(ctx, "Exec() can not run transaction statement in share transaction, sql = %s", sql)
 			}
 		}
@@ -196,7 +198,9 @@ func (back *backExec) ExecRestore(ctx context.Context, sql string, opAccount uin
 	if back.backSes.GetTxnHandler().IsShareTxn() {
 		for _, stmt := range statements {
 			switch stmt.(type) {
-			case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+			case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+				*tree.Savepoint, *tree.RollbackToSavepoint, *tree.ReleaseSavepoint,
+				*tree.XAStart, *tree.XAEnd, *tree.XAPrepare, *tree.XACommit, *tree.XARollback:
 				return moerr.NewInternalErrorf(ctx, "Exec() can not run transaction statement in share transaction, sql = %s", sql)
 			}
 		}
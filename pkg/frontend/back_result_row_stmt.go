@@ -56,6 +56,8 @@ func executeResultRowStmtInBack(backSes *backSession,
 	if _, err = execCtx.runner.Run(0); err != nil {
 		return
 	}
+	// keep the columns of the empty result set
+	backSes.SetMysqlResultSetOfBackgroundTask(mrs)
 
 	// only log if run time is longer than 1s
 	if time.Since(runBegin) > time.Second {
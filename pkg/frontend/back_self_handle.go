@@ -28,6 +28,20 @@ func execInFrontendInBack(backSes *backSession,
 	case *tree.BeginTransaction:
 	case *tree.CommitTransaction:
 	case *tree.RollbackTransaction:
+	case *tree.Savepoint:
+		if err = handleSavepoint(backSes, execCtx, st); err != nil {
+			return
+		}
+	case *tree.RollbackToSavepoint:
+		if err = handleRollbackToSavepoint(backSes, execCtx, st); err != nil {
+			return
+		}
+	case *tree.ReleaseSavepoint:
+		if err = handleReleaseSavepoint(backSes, execCtx, st); err != nil {
+			return
+		}
+	case *tree.XAStart, *tree.XAEnd, *tree.XAPrepare, *tree.XACommit, *tree.XARollback, *tree.XARecover:
+		return moerr.NewNotSupported(execCtx.reqCtx, "XA transaction in background session")
 	case *tree.Use:
 		execCtx.ses.EnterFPrint(FPInBackUse)
 		defer execCtx.ses.ExitFPrint(FPInBackUse)
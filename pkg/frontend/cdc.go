@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"net/url"
 	"strings"
 	"time"
 
@@ -37,6 +38,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/task"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/stage"
+	"github.com/matrixorigin/matrixone/pkg/stage/stageutil"
 	"github.com/matrixorigin/matrixone/pkg/taskservice"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
@@ -120,6 +123,8 @@ const (
 		where w.account_id = %d and w.task_id = '%s'`
 
 	getDataKeyFormat = "select encrypted_key from mo_catalog.mo_data_key where account_id = %d and key_id = '%s'"
+
+	getStageFormat = "select url, stage_credentials from `mo_catalog`.`mo_stages` where stage_name = '%s'"
 )
 
 var showCdcOutputColumns = [7]Column{
@@ -231,6 +236,10 @@ func getSqlForRetrievingCdcTask(
 	return fmt.Sprintf(getCdcTaskFormat, accId, taskId)
 }
 
+func getSqlForStage(stageName string) string {
+	return fmt.Sprintf(getStageFormat, stageName)
+}
+
 func getSqlForDbIdAndTableId(accId uint64, db, table string) string {
 	return fmt.Sprintf(getDbIdAndTableIdFormat, accId, db, table)
 }
@@ -351,10 +360,22 @@ func doCreateCdc(ctx context.Context, ses *Session, create *tree.CreateCDC) (err
 		useConsole = true
 	}
 
-	if !useConsole && sinkType != cdc2.MysqlSink && sinkType != cdc2.MatrixoneSink {
+	if !useConsole && sinkType != cdc2.MysqlSink && sinkType != cdc2.MatrixoneSink &&
+		sinkType != cdc2.KafkaSink && sinkType != cdc2.StageSink {
 		return moerr.NewInternalErrorf(ctx, "unsupported sink type: %s", create.SinkType)
 	}
 
+	if sinkType == cdc2.StageSink {
+		// check the stage exists and can be resolved to a fileservice path
+		var s stage.StageDef
+		if s, err = stageutil.UrlToStageDef(create.SinkUri, ses.proc); err != nil {
+			return err
+		}
+		if _, _, err = s.ToPath(); err != nil {
+			return err
+		}
+	}
+
 	noFull := false
 	if cdcTaskOptionsMap["NoFull"] == "true" {
 		noFull = true
@@ -409,12 +430,19 @@ func doCreateCdc(ctx context.Context, ses *Session, create *tree.CreateCDC) (err
 		var encodedSinkPwd string
 		var sinkUriInfo cdc2.UriInfo
 		if !useConsole {
-			if jsonSinkUri, sinkUriInfo, err = extractUriInfo(ctx, create.SinkUri, cdc2.SinkUriPrefix); err != nil {
+			// TODO replace with creatorAccountId
+			if err = initAesKeyWrapper(ctx, tx, catalog.System_Account, service); err != nil {
 				return
 			}
 
-			// TODO replace with creatorAccountId
-			if err = initAesKeyWrapper(ctx, tx, catalog.System_Account, service); err != nil {
+			if sinkType == cdc2.KafkaSink {
+				jsonSinkUri, sinkUriInfo, err = extractKafkaUriInfo(ctx, create.SinkUri)
+			} else if sinkType == cdc2.StageSink {
+				jsonSinkUri, sinkUriInfo, err = extractStageUriInfo(ctx, create.SinkUri)
+			} else {
+				jsonSinkUri, sinkUriInfo, err = extractUriInfo(ctx, create.SinkUri, cdc2.SinkUriPrefix)
+			}
+			if err != nil {
 				return
 			}
 
@@ -1044,6 +1072,12 @@ func (cdc *CdcTask) Start(rootCtx context.Context, firstTime bool) (err error) {
 		return err
 	}
 
+	if cdc.sinkUri.SinkTyp == cdc2.StageSink {
+		if cdc.sinkUri.FsPath, err = cdc.resolveStagePath(ctx, cdc.sinkUri.Path); err != nil {
+			return err
+		}
+	}
+
 	// check table be filtered or not
 	if filterTable(&cdc.tables, &cdc.filters) == 0 {
 		return moerr.NewInternalError(ctx, "all tables has been excluded by filters. start cdc failed.")
@@ -1183,6 +1217,11 @@ func (cdc *CdcTask) retrieveCdcTask(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+
+		cdc.sinkUri.Params, err = cdc2.DecodeSecretParams(ctx, cdc.sinkUri.Params)
+		if err != nil {
+			return err
+		}
 	}
 
 	//update sink type after deserialize
@@ -1237,6 +1276,48 @@ func (cdc *CdcTask) retrieveCdcTask(ctx context.Context) error {
 	return nil
 }
 
+// resolveStagePath resolves the stage url into a fileservice path, the stages
+// are defined in the account which created the task
+func (cdc *CdcTask) resolveStagePath(ctx context.Context, stageUrl string) (string, error) {
+	u, err := url.Parse(stageUrl)
+	if err != nil {
+		return "", err
+	}
+
+	s, err := stageutil.ExpandStageUrl(u, func(stageName string) (stage.StageDef, error) {
+		res := cdc.ie.Query(ctx, getSqlForStage(stageName), ie.SessionOverrideOptions{})
+		if res.Error() != nil {
+			return stage.StageDef{}, res.Error()
+		} else if res.RowCount() < 1 {
+			return stage.StageDef{}, moerr.NewBadConfigf(ctx, "Stage %s not found", stageName)
+		}
+
+		urlStr, err := res.GetString(ctx, 0, 0)
+		if err != nil {
+			return stage.StageDef{}, err
+		}
+		cred, err := res.GetString(ctx, 0, 1)
+		if err != nil {
+			return stage.StageDef{}, err
+		}
+		credentials, err := stage.CredentialsToMap(cred)
+		if err != nil {
+			return stage.StageDef{}, err
+		}
+		stageUrl, err := url.Parse(urlStr)
+		if err != nil {
+			return stage.StageDef{}, err
+		}
+		return stage.StageDef{Name: stageName, Url: stageUrl, Credentials: credentials}, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	fsPath, _, err := s.ToPath()
+	return fsPath, err
+}
+
 func (cdc *CdcTask) retrieveTable(ctx context.Context, accId uint64, accName, dbName, tblName string, tblIdStrMap map[string]bool) (*cdc2.DbTableInfo, error) {
 	var dbId, tblId uint64
 	var err error
@@ -19,6 +19,7 @@ import (
 	"database/sql"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -813,6 +814,11 @@ func (ts *testTaskService) QueryCronTask(ctx context.Context, condition ...tasks
 	panic("implement me")
 }
 
+func (ts *testTaskService) DeleteCronTask(ctx context.Context, condition ...taskservice.Condition) (int, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
 func (ts *testTaskService) CreateDaemonTask(ctx context.Context, value task.TaskMetadata, details *task.Details) error {
 	//TODO implement me
 	panic("implement me")
@@ -3172,3 +3178,57 @@ func TestCdcTask_initAesKeyByInternalExecutor(t *testing.T) {
 	err = initAesKeyByInternalExecutor(context.Background(), cdcTask, 0)
 	assert.Error(t, err)
 }
+
+type mockStageIe struct {
+	mockIe
+	// stage name -> url, credentials
+	stages map[string][2]string
+}
+
+func (e *mockStageIe) Query(ctx context.Context, s string, options ie.SessionOverrideOptions) ie.InternalExecResult {
+	for name, stage := range e.stages {
+		if s == getSqlForStage(name) {
+			return &mockStageIeResult{mockIeResult: &mockIeResult{rowCount: 1}, row: stage}
+		}
+	}
+	return &mockIeResult{}
+}
+
+type mockStageIeResult struct {
+	*mockIeResult
+	row [2]string
+}
+
+func (r *mockStageIeResult) GetString(ctx context.Context, u uint64, u2 uint64) (string, error) {
+	return r.row[u2], nil
+}
+
+func TestCdcTask_resolveStagePath(t *testing.T) {
+	cdcTask := &CdcTask{
+		ie: &mockStageIe{
+			stages: map[string][2]string{
+				"local": {"file:///tmp/cdc", ""},
+				"sub":   {"stage://local/sub", ""},
+				"s3": {"s3://bucket/prefix",
+					"aws_key_id=id,aws_secret_key=secret,aws_region=r,provider=amazon,endpoint=e"},
+			},
+		},
+	}
+	ctx := context.Background()
+
+	fsPath, err := cdcTask.resolveStagePath(ctx, "stage://local/t1")
+	assert.NoError(t, err)
+	assert.Equal(t, "/tmp/cdc/t1", fsPath)
+
+	fsPath, err = cdcTask.resolveStagePath(ctx, "stage://sub/t1")
+	assert.NoError(t, err)
+	assert.Equal(t, "/tmp/cdc/sub/t1", fsPath)
+
+	fsPath, err = cdcTask.resolveStagePath(ctx, "stage://s3/t1")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(fsPath, "s3,e,r,bucket,id,secret,"))
+	assert.True(t, strings.HasSuffix(fsPath, ":/prefix/t1"))
+
+	_, err = cdcTask.resolveStagePath(ctx, "stage://none/t1")
+	assert.Error(t, err)
+}
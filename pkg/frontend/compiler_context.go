@@ -1079,3 +1079,7 @@ func (tcc *TxnCompilerContext) ResolveSnapshotWithSnapshotName(snapshotName stri
 func (tcc *TxnCompilerContext) CheckTimeStampValid(ts int64) (bool, error) {
 	return checkTimeStampValid(tcc.GetContext(), tcc.GetSession(), ts)
 }
+
+func (tcc *TxnCompilerContext) CheckTableChangesRetained(dbName, tableName string, ts int64) (bool, error) {
+	return checkTableChangesRetained(tcc.GetContext(), tcc.GetSession(), dbName, tableName, ts)
+}
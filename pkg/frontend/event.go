@@ -0,0 +1,1065 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+const (
+	eventTypeOneTime   = "ONE TIME"
+	eventTypeRecurring = "RECURRING"
+
+	eventStatusEnabled  = "ENABLED"
+	eventStatusDisabled = "DISABLED"
+
+	eventPreserve    = "PRESERVE"
+	eventNotPreserve = "NOT PRESERVE"
+
+	eventRunSuccess = "SUCCESS"
+	eventRunFailed  = "FAILED"
+
+	eventTimeLayout = "2006-01-02 15:04:05.000000"
+
+	// eventRunsRetention is how long the run records of an event are kept
+	eventRunsRetention = 7 * 24 * time.Hour
+	// eventMetaTimeout is the timeout of reading or updating the event metadata
+	eventMetaTimeout = 30 * time.Second
+	// eventMinTriggerDelay is the least time between creating the cron task of
+	// an event and triggering it, so that the trigger is not passed before the
+	// cron task is stored.
+	eventMinTriggerDelay = 2 * time.Second
+)
+
+const (
+	getEventFormat = `select ` +
+		`event_id, ` +
+		`definer, ` +
+		`definer_user_id, ` +
+		`definer_role_id, ` +
+		`body, ` +
+		`event_type, ` +
+		`execute_at, ` +
+		`interval_value, ` +
+		`interval_field, ` +
+		`starts, ` +
+		`ends, ` +
+		`status, ` +
+		`on_completion, ` +
+		`task_id, ` +
+		`comment, ` +
+		`last_executed ` +
+		`from mo_catalog.mo_events ` +
+		`where db_name = '%s' and event_name = '%s'`
+
+	insertEventFormat = `insert into mo_catalog.mo_events(` +
+		`event_name, db_name, definer, definer_user_id, definer_role_id, body, ` +
+		`event_type, execute_at, interval_value, interval_field, starts, ends, ` +
+		`status, on_completion, task_id, created_time, altered_time, comment) values (` +
+		`'%s', '%s', '%s', %d, %d, '%s', '%s', %s, '%s', '%s', %s, %s, '%s', '%s', '%s', '%s', '%s', '%s')`
+
+	updateEventFormat = `update mo_catalog.mo_events set ` +
+		`event_name = '%s', db_name = '%s', definer = '%s', definer_user_id = %d, definer_role_id = %d, body = '%s', ` +
+		`event_type = '%s', execute_at = %s, interval_value = '%s', interval_field = '%s', starts = %s, ends = %s, ` +
+		`status = '%s', on_completion = '%s', task_id = '%s', last_executed = %s, altered_time = '%s', comment = '%s' ` +
+		`where event_id = %d`
+
+	deleteEventFormat = `delete from mo_catalog.mo_events where event_id = %d`
+
+	getEventByTaskFormat = `select ` +
+		`event_id, ` +
+		`db_name, ` +
+		`event_name, ` +
+		`definer_user_id, ` +
+		`definer_role_id, ` +
+		`body, ` +
+		`event_type, ` +
+		`execute_at, ` +
+		`interval_value, ` +
+		`interval_field, ` +
+		`starts, ` +
+		`ends, ` +
+		`on_completion, ` +
+		`last_executed ` +
+		`from mo_catalog.mo_events ` +
+		`where task_id = '%s' and status = '` + eventStatusEnabled + `'`
+
+	disableEventFormat = `update mo_catalog.mo_events set status = '` + eventStatusDisabled + `', task_id = '' where event_id = %d`
+
+	rescheduleEventFormat = `update mo_catalog.mo_events set task_id = '%s', last_executed = %s where event_id = %d and task_id = '%s'`
+
+	markEventExecutedFormat = `update mo_catalog.mo_events set last_executed = '%s' where event_id = %d`
+
+	insertEventRunFormat = `insert into mo_catalog.mo_event_runs(` +
+		`event_id, db_name, event_name, task_id, start_time, end_time, status, error) values (` +
+		`%d, '%s', '%s', '%s', '%s', '%s', '%s', '%s')`
+
+	deleteEventRunsFormat = `delete from mo_catalog.mo_event_runs where event_id = %d and start_time < '%s'`
+)
+
+// eventDef is a row of mo_catalog.mo_events. All the times are in UTC, and
+// zero means NULL.
+type eventDef struct {
+	id            uint64
+	name          string
+	db            string
+	definer       string
+	userID        uint32
+	roleID        uint32
+	body          string
+	eventType     string
+	executeAt     time.Time
+	intervalValue int64
+	intervalField string
+	starts        time.Time
+	ends          time.Time
+	status        string
+	onCompletion  string
+	taskID        string
+	comment       string
+	lastExecuted  time.Time
+}
+
+// expired reports whether the event will never be triggered again.
+func (ev *eventDef) expired(now time.Time) bool {
+	if ev.eventType == eventTypeOneTime {
+		return !ev.executeAt.After(now)
+	}
+	return nextEventTime(ev, now).IsZero()
+}
+
+// eventTaskContext is the context of the cron task of an event. Each cron
+// task triggers the event once, at TriggerAt in unix milliseconds, and the
+// executor moves the event to a new cron task for the next trigger.
+type eventTaskContext struct {
+	AccountID uint32 `json:"account_id"`
+	TaskID    string `json:"task_id"`
+	TriggerAt int64  `json:"trigger_at"`
+}
+
+func handleCreateEvent(ses *Session, execCtx *ExecCtx, ce *tree.CreateEvent) error {
+	return doCreateEvent(execCtx.reqCtx, ses, ce)
+}
+
+func handleAlterEvent(ses *Session, execCtx *ExecCtx, ae *tree.AlterEvent) error {
+	return doAlterEvent(execCtx.reqCtx, ses, ae)
+}
+
+func handleDropEvent(ses *Session, execCtx *ExecCtx, de *tree.DropEvent) error {
+	return doDropEvent(execCtx.reqCtx, ses, de)
+}
+
+func doCreateEvent(ctx context.Context, ses *Session, ce *tree.CreateEvent) (err error) {
+	ts, err := getEventTaskService(ctx, ses)
+	if err != nil {
+		return err
+	}
+	dbName, err := getEventDbName(ses, ce.Name)
+	if err != nil {
+		return err
+	}
+
+	tenant := ses.GetTenantInfo()
+	ev := &eventDef{
+		name:         string(ce.Name.Name.ObjectName),
+		db:           dbName,
+		definer:      tenant.GetUser(),
+		userID:       tenant.GetUserID(),
+		roleID:       tenant.GetDefaultRoleID(),
+		body:         ce.BodySql,
+		status:       eventStatusEnabled,
+		onCompletion: eventNotPreserve,
+		comment:      ce.Comment.Comment,
+	}
+	if ce.Status == tree.EventStatusDisable {
+		ev.status = eventStatusDisabled
+	}
+	if ce.Completion == tree.EventCompletionPreserve {
+		ev.onCompletion = eventPreserve
+	}
+	if err = buildEventSchedule(ctx, ses, ce.Schedule, ev); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	if ev.expired(now) {
+		// same as mysql, an event that is already expired is dropped at once
+		// or disabled if it is preserved.
+		if ev.onCompletion == eventNotPreserve {
+			return nil
+		}
+		ev.status = eventStatusDisabled
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	old, err := getEventDef(ctx, bh, ev.db, ev.name)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		if ce.IfNotExists {
+			return nil
+		}
+		return moerr.NewInternalErrorf(ctx, "event %s already exists", ev.name)
+	}
+
+	if ev.status == eventStatusEnabled {
+		ev.taskID = newEventTaskID(tenant.GetTenantID())
+	}
+	createdTime := types.CurrentTimestamp().String2(time.UTC, 0)
+	sql := fmt.Sprintf(insertEventFormat,
+		eventQuote(ev.name),
+		eventQuote(ev.db),
+		eventQuote(ev.definer),
+		ev.userID,
+		ev.roleID,
+		eventQuote(ev.body),
+		ev.eventType,
+		eventTimeValue(ev.executeAt),
+		eventIntervalValue(ev.intervalValue),
+		ev.intervalField,
+		eventTimeValue(ev.starts),
+		eventTimeValue(ev.ends),
+		ev.status,
+		ev.onCompletion,
+		ev.taskID,
+		createdTime,
+		createdTime,
+		eventQuote(ev.comment),
+	)
+	if err = bh.Exec(ctx, sql); err != nil {
+		return err
+	}
+
+	// the cron task is created last, if the transaction fails to commit, the
+	// cron task is removed by the executor as it finds no event.
+	if ev.taskID != "" {
+		return createEventCronTask(ctx, ts, tenant.GetTenantID(), ev.taskID, nextEventTime(ev, now))
+	}
+	return nil
+}
+
+func doAlterEvent(ctx context.Context, ses *Session, ae *tree.AlterEvent) (err error) {
+	ts, err := getEventTaskService(ctx, ses)
+	if err != nil {
+		return err
+	}
+	dbName, err := getEventDbName(ses, ae.Name)
+	if err != nil {
+		return err
+	}
+
+	var oldTaskID string
+	tenant := ses.GetTenantInfo()
+	alter := func() (err error) {
+		bh := ses.GetBackgroundExec(ctx)
+		defer bh.Close()
+
+		err = bh.Exec(ctx, "begin;")
+		defer func() {
+			err = finishTxn(ctx, bh, err)
+		}()
+		if err != nil {
+			return err
+		}
+
+		ev, err := getEventDef(ctx, bh, dbName, string(ae.Name.Name.ObjectName))
+		if err != nil {
+			return err
+		}
+		if ev == nil {
+			return moerr.NewInternalErrorf(ctx, "unknown event %s", ae.Name.Name.ObjectName)
+		}
+		oldTaskID = ev.taskID
+
+		if ae.Rename != nil {
+			newDbName, err := getEventDbName(ses, ae.Rename)
+			if err != nil {
+				return err
+			}
+			newName := string(ae.Rename.Name.ObjectName)
+			if newDbName != ev.db || newName != ev.name {
+				other, err := getEventDef(ctx, bh, newDbName, newName)
+				if err != nil {
+					return err
+				}
+				if other != nil {
+					return moerr.NewInternalErrorf(ctx, "event %s already exists", newName)
+				}
+			}
+			ev.db, ev.name = newDbName, newName
+		}
+		if ae.Schedule != nil {
+			if err = buildEventSchedule(ctx, ses, ae.Schedule, ev); err != nil {
+				return err
+			}
+			// a one-time event that has run can be scheduled again
+			ev.lastExecuted = time.Time{}
+		}
+		switch ae.Completion {
+		case tree.EventCompletionPreserve:
+			ev.onCompletion = eventPreserve
+		case tree.EventCompletionNotPreserve:
+			ev.onCompletion = eventNotPreserve
+		}
+		switch ae.Status {
+		case tree.EventStatusEnable:
+			ev.status = eventStatusEnabled
+		case tree.EventStatusDisable:
+			ev.status = eventStatusDisabled
+		}
+		if ae.Comment.Exist {
+			ev.comment = ae.Comment.Comment
+		}
+		if ae.Body != nil {
+			ev.body = ae.BodySql
+		}
+		// the event runs as the user who altered it last
+		ev.definer = tenant.GetUser()
+		ev.userID = tenant.GetUserID()
+		ev.roleID = tenant.GetDefaultRoleID()
+
+		now := time.Now().UTC()
+		if ev.status == eventStatusEnabled && ev.expired(now) {
+			if ev.onCompletion == eventNotPreserve {
+				return bh.Exec(ctx, fmt.Sprintf(deleteEventFormat, ev.id))
+			}
+			ev.status = eventStatusDisabled
+		}
+
+		// the schedule or the definer may be changed, so the event always gets
+		// a new cron task and the old one is removed after commit.
+		ev.taskID = ""
+		if ev.status == eventStatusEnabled {
+			ev.taskID = newEventTaskID(tenant.GetTenantID())
+		}
+		sql := fmt.Sprintf(updateEventFormat,
+			eventQuote(ev.name),
+			eventQuote(ev.db),
+			eventQuote(ev.definer),
+			ev.userID,
+			ev.roleID,
+			eventQuote(ev.body),
+			ev.eventType,
+			eventTimeValue(ev.executeAt),
+			eventIntervalValue(ev.intervalValue),
+			ev.intervalField,
+			eventTimeValue(ev.starts),
+			eventTimeValue(ev.ends),
+			ev.status,
+			ev.onCompletion,
+			ev.taskID,
+			eventTimeValue(ev.lastExecuted),
+			types.CurrentTimestamp().String2(time.UTC, 0),
+			eventQuote(ev.comment),
+			ev.id,
+		)
+		if err = bh.Exec(ctx, sql); err != nil {
+			return err
+		}
+		if ev.taskID != "" {
+			return createEventCronTask(ctx, ts, tenant.GetTenantID(), ev.taskID, nextEventTime(ev, now))
+		}
+		return nil
+	}
+	if err = alter(); err != nil {
+		return err
+	}
+	removeEventCronTask(ctx, ses, ts, oldTaskID)
+	return nil
+}
+
+func doDropEvent(ctx context.Context, ses *Session, de *tree.DropEvent) (err error) {
+	ts, err := getEventTaskService(ctx, ses)
+	if err != nil {
+		return err
+	}
+	dbName, err := getEventDbName(ses, de.Name)
+	if err != nil {
+		return err
+	}
+
+	var oldTaskID string
+	drop := func() (err error) {
+		bh := ses.GetBackgroundExec(ctx)
+		defer bh.Close()
+
+		err = bh.Exec(ctx, "begin;")
+		defer func() {
+			err = finishTxn(ctx, bh, err)
+		}()
+		if err != nil {
+			return err
+		}
+
+		ev, err := getEventDef(ctx, bh, dbName, string(de.Name.Name.ObjectName))
+		if err != nil {
+			return err
+		}
+		if ev == nil {
+			if de.IfExists {
+				return nil
+			}
+			return moerr.NewInternalErrorf(ctx, "unknown event %s", de.Name.Name.ObjectName)
+		}
+		oldTaskID = ev.taskID
+		return bh.Exec(ctx, fmt.Sprintf(deleteEventFormat, ev.id))
+	}
+	if err = drop(); err != nil {
+		return err
+	}
+	removeEventCronTask(ctx, ses, ts, oldTaskID)
+	return nil
+}
+
+func getEventTaskService(ctx context.Context, ses *Session) (taskservice.TaskService, error) {
+	tenant := ses.GetTenantInfo()
+	// the internal executor runs the events of the sys account as moadmin, so
+	// only moadmin can schedule them.
+	if tenant.IsSysTenant() && !tenant.IsMoAdminRole() {
+		return nil, moerr.NewInternalError(ctx, "do not have privilege to execute the statement")
+	}
+	ts := getPu(ses.GetService()).TaskService
+	if ts == nil {
+		return nil, moerr.NewInternalError(ctx, "no task service is found")
+	}
+	return ts, nil
+}
+
+func getEventDbName(ses *Session, name *tree.EventName) (string, error) {
+	if name.Name.ExplicitSchema {
+		return string(name.Name.SchemaName), nil
+	}
+	if ses.DatabaseNameIsEmpty() {
+		return "", moerr.NewNoDBNoCtx()
+	}
+	return ses.GetDatabaseName(), nil
+}
+
+// getEventDef returns the event, or nil if it does not exist.
+func getEventDef(ctx context.Context, bh BackgroundExec, dbName, name string) (*eventDef, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getEventFormat, eventQuote(dbName), eventQuote(name))); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+
+	er := erArray[0]
+	ev := &eventDef{name: name, db: dbName}
+	if ev.id, err = er.GetUint64(ctx, 0, 0); err != nil {
+		return nil, err
+	}
+	if ev.definer, err = er.GetString(ctx, 0, 1); err != nil {
+		return nil, err
+	}
+	userID, err := er.GetUint64(ctx, 0, 2)
+	if err != nil {
+		return nil, err
+	}
+	roleID, err := er.GetUint64(ctx, 0, 3)
+	if err != nil {
+		return nil, err
+	}
+	ev.userID, ev.roleID = uint32(userID), uint32(roleID)
+	if ev.body, err = er.GetString(ctx, 0, 4); err != nil {
+		return nil, err
+	}
+	if ev.eventType, err = er.GetString(ctx, 0, 5); err != nil {
+		return nil, err
+	}
+	if ev.executeAt, err = getEventTime(ctx, er, 6); err != nil {
+		return nil, err
+	}
+	interval, err := er.GetString(ctx, 0, 7)
+	if err != nil {
+		return nil, err
+	}
+	if interval != "" {
+		if ev.intervalValue, err = strconv.ParseInt(interval, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	if ev.intervalField, err = er.GetString(ctx, 0, 8); err != nil {
+		return nil, err
+	}
+	if ev.starts, err = getEventTime(ctx, er, 9); err != nil {
+		return nil, err
+	}
+	if ev.ends, err = getEventTime(ctx, er, 10); err != nil {
+		return nil, err
+	}
+	if ev.status, err = er.GetString(ctx, 0, 11); err != nil {
+		return nil, err
+	}
+	if ev.onCompletion, err = er.GetString(ctx, 0, 12); err != nil {
+		return nil, err
+	}
+	if ev.taskID, err = er.GetString(ctx, 0, 13); err != nil {
+		return nil, err
+	}
+	if ev.comment, err = er.GetString(ctx, 0, 14); err != nil {
+		return nil, err
+	}
+	if ev.lastExecuted, err = getEventTime(ctx, er, 15); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
+
+func getEventTime(ctx context.Context, er ExecResult, col uint64) (time.Time, error) {
+	isNull, err := er.ColumnIsNull(ctx, 0, col)
+	if err != nil || isNull {
+		return time.Time{}, err
+	}
+	s, err := er.GetString(ctx, 0, col)
+	if err != nil {
+		return time.Time{}, err
+	}
+	dt, err := types.ParseDatetime(s, 6)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return dt.ConvertToGoTime(time.UTC), nil
+}
+
+// buildEventSchedule evaluates the ON SCHEDULE clause into the event.
+func buildEventSchedule(ctx context.Context, ses *Session, schedule *tree.EventSchedule, ev *eventDef) (err error) {
+	ev.executeAt, ev.starts, ev.ends = time.Time{}, time.Time{}, time.Time{}
+	ev.intervalValue, ev.intervalField = 0, ""
+
+	if schedule.At != nil {
+		ev.eventType = eventTypeOneTime
+		ev.executeAt, err = evalEventTime(ctx, ses, schedule.At)
+		return err
+	}
+
+	ev.eventType = eventTypeRecurring
+	value, err := GetSimpleExprValue(ctx, schedule.Every, ses)
+	if err != nil {
+		return err
+	}
+	if ev.intervalValue, err = toEventInterval(value); err != nil || ev.intervalValue <= 0 {
+		return moerr.NewInvalidInputf(ctx, "invalid event interval %v", value)
+	}
+	ev.intervalField = strings.TrimPrefix(strings.ToUpper(schedule.Unit), "SQL_TSI_")
+	if err = checkEventInterval(ctx, ev); err != nil {
+		return err
+	}
+	// same as mysql, STARTS is the current time if it is absent
+	ev.starts = time.Now().UTC().Truncate(time.Second)
+	if schedule.Starts != nil {
+		if ev.starts, err = evalEventTime(ctx, ses, schedule.Starts); err != nil {
+			return err
+		}
+	}
+	if schedule.Ends != nil {
+		if ev.ends, err = evalEventTime(ctx, ses, schedule.Ends); err != nil {
+			return err
+		}
+	}
+	if !ev.ends.IsZero() && !ev.ends.After(ev.starts) {
+		return moerr.NewInvalidInput(ctx, "event ENDS is either invalid or before STARTS")
+	}
+	return nil
+}
+
+// evalEventTime evaluates the time in the time zone of the session and
+// returns it in UTC.
+func evalEventTime(ctx context.Context, ses *Session, expr tree.Expr) (time.Time, error) {
+	value, err := GetSimpleExprValue(ctx, expr, ses)
+	if err != nil {
+		return time.Time{}, err
+	}
+	s, ok := value.(string)
+	if !ok {
+		return time.Time{}, moerr.NewInvalidInputf(ctx, "invalid event time %v", value)
+	}
+	dt, err := types.ParseDatetime(s, 6)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return dt.ConvertToGoTime(ses.GetTimeZone()).UTC().Truncate(time.Second), nil
+}
+
+func toEventInterval(value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		return int64(v), nil
+	case string:
+		return strconv.ParseInt(v, 10, 64)
+	}
+	return 0, moerr.NewInvalidInputNoCtxf("invalid event interval %v", value)
+}
+
+// checkEventInterval checks the interval of a recurring event can be
+// scheduled.
+func checkEventInterval(ctx context.Context, ev *eventDef) error {
+	if eventIntervalMonths(ev) > 0 {
+		if ev.intervalValue > math.MaxInt32/12 {
+			return moerr.NewInvalidInputf(ctx, "invalid event interval %d %s", ev.intervalValue, ev.intervalField)
+		}
+		return nil
+	}
+	unit := eventIntervalUnit(ev)
+	if unit == 0 {
+		return moerr.NewNotSupportedf(ctx, "event interval unit %s", ev.intervalField)
+	}
+	if ev.intervalValue > int64(math.MaxInt64/unit) {
+		return moerr.NewInvalidInputf(ctx, "invalid event interval %d %s", ev.intervalValue, ev.intervalField)
+	}
+	return nil
+}
+
+// eventIntervalMonths returns the interval of the event in months, or 0 if
+// the interval is not counted in months.
+func eventIntervalMonths(ev *eventDef) int64 {
+	switch ev.intervalField {
+	case "MONTH":
+		return ev.intervalValue
+	case "QUARTER":
+		return 3 * ev.intervalValue
+	case "YEAR":
+		return 12 * ev.intervalValue
+	}
+	return 0
+}
+
+// eventIntervalUnit returns the unit of the interval of the event, or 0 if
+// the interval is not counted in a fixed duration.
+func eventIntervalUnit(ev *eventDef) time.Duration {
+	switch ev.intervalField {
+	case "SECOND":
+		return time.Second
+	case "MINUTE":
+		return time.Minute
+	case "HOUR":
+		return time.Hour
+	case "DAY":
+		return 24 * time.Hour
+	case "WEEK":
+		return 7 * 24 * time.Hour
+	}
+	return 0
+}
+
+// nextEventTime returns the first trigger of the event that is not before
+// from, or zero if the event will never be triggered again. The triggers of
+// a recurring event are STARTS plus multiples of the interval up to ENDS.
+func nextEventTime(ev *eventDef, from time.Time) time.Time {
+	if ev.eventType == eventTypeOneTime {
+		if ev.executeAt.Before(from) {
+			return time.Time{}
+		}
+		return ev.executeAt
+	}
+
+	next := ev.starts
+	if next.Before(from) {
+		if months := eventIntervalMonths(ev); months > 0 {
+			// the trigger in the month of from or the one after it
+			k := (int64(from.Year()-ev.starts.Year())*12 + int64(from.Month()-ev.starts.Month())) / months
+			for next = addEventMonths(ev.starts, k*months); next.Before(from); next = addEventMonths(ev.starts, k*months) {
+				k++
+			}
+		} else {
+			interval := time.Duration(ev.intervalValue) * eventIntervalUnit(ev)
+			k := (from.Sub(ev.starts) + interval - 1) / interval
+			next = ev.starts.Add(k * interval)
+		}
+	}
+	if !ev.ends.IsZero() && next.After(ev.ends) {
+		return time.Time{}
+	}
+	return next
+}
+
+// addEventMonths adds the months to t. Same as mysql, the day is clipped to
+// the last day of the month, so the triggers of an event starting on Jan 31
+// every month are Feb 29, Mar 31, Apr 30 and so on.
+func addEventMonths(t time.Time, months int64) time.Time {
+	t = t.UTC()
+	m := int64(t.Month()) - 1 + months
+	year, month := t.Year()+int(m/12), time.Month(m%12+1)
+	day := t.Day()
+	if last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day(); day > last {
+		day = last
+	}
+	return time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// eventCronExpr returns the cron expression that triggers the cron task at
+// the given time. It has no year field, so it fires early if the time is more
+// than a year away, and the executor moves the event to a new cron task then.
+func eventCronExpr(at time.Time) string {
+	at = at.UTC()
+	return fmt.Sprintf("CRON_TZ=UTC %d %d %d %d %d *",
+		at.Second(), at.Minute(), at.Hour(), at.Day(), int(at.Month()))
+}
+
+func newEventTaskID(accountID uint32) string {
+	return fmt.Sprintf("event-%d-%s", accountID, uuid.New().String())
+}
+
+// createEventCronTask creates the cron task that triggers the event once at
+// the given time. A trigger that has passed while the cron task is stored is
+// still caught up by the task service, as the next time of the cron task is
+// in the past when it is loaded.
+func createEventCronTask(ctx context.Context, ts taskservice.TaskService, accountID uint32, taskID string, at time.Time) error {
+	taskCtx, err := json.Marshal(eventTaskContext{
+		AccountID: accountID,
+		TaskID:    taskID,
+		TriggerAt: at.UnixMilli(),
+	})
+	if err != nil {
+		return err
+	}
+	fireAt := at
+	if earliest := time.Now().Add(eventMinTriggerDelay); fireAt.Before(earliest) {
+		fireAt = earliest
+	}
+	return ts.CreateCronTask(ctx,
+		task.TaskMetadata{
+			ID:       taskID,
+			Executor: task.TaskCode_SQLEvent,
+			Context:  taskCtx,
+		},
+		eventCronExpr(fireAt))
+}
+
+// removeEventCronTask removes the cron task of an altered or dropped event.
+// A failure is only logged, the executor removes the cron task the next time
+// it is triggered anyway.
+func removeEventCronTask(ctx context.Context, ses *Session, ts taskservice.TaskService, taskID string) {
+	if taskID == "" {
+		return
+	}
+	if _, err := ts.DeleteCronTask(ctx, taskservice.WithTaskMetadataId(taskservice.EQ, taskID)); err != nil {
+		ses.Warn(ctx, "failed to delete the cron task of the event",
+			zap.String("task", taskID),
+			zap.Error(err))
+	}
+}
+
+func eventQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return strings.ReplaceAll(s, `'`, `\'`)
+}
+
+func eventTimeValue(t time.Time) string {
+	if t.IsZero() {
+		return "null"
+	}
+	return "'" + t.UTC().Format(eventTimeLayout) + "'"
+}
+
+func eventIntervalValue(n int64) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.FormatInt(n, 10)
+}
+
+// RegisterEventExecutor returns the executor of the cron tasks of the events.
+// It runs the body of the event as its definer and records the run in
+// mo_catalog.mo_event_runs.
+func RegisterEventExecutor(
+	logger *zap.Logger,
+	ts taskservice.TaskService,
+	sqlExecutor executor.SQLExecutor,
+	ieFactory func() ie.InternalExecutor,
+) func(ctx context.Context, task task.Task) error {
+	return func(ctx context.Context, T task.Task) error {
+		var taskCtx eventTaskContext
+		if err := json.Unmarshal(T.GetMetadata().Context, &taskCtx); err != nil {
+			return err
+		}
+		opts := executor.Options{}.
+			WithAccountID(taskCtx.AccountID).
+			WithDisableTrace()
+
+		ev, err := getEventDefByTask(ctx, sqlExecutor, opts, taskCtx.TaskID)
+		if err != nil {
+			return err
+		}
+		if ev == nil {
+			// the event is dropped, disabled or altered to a new cron task
+			logger.Info("remove the stale cron task of event",
+				zap.String("task", taskCtx.TaskID))
+			return deleteEventCronTask(ctx, ts, taskCtx.TaskID)
+		}
+
+		at := time.UnixMilli(taskCtx.TriggerAt).UTC()
+		if at.After(time.Now().Add(time.Second)) {
+			// the cron task fires a year early, wait for the trigger in a new one
+			return rescheduleEvent(ctx, ts, sqlExecutor, opts, taskCtx.AccountID, ev, at, ev.lastExecuted)
+		}
+		if ev.eventType == eventTypeOneTime && !ev.lastExecuted.IsZero() {
+			// the event has run, but failed to be completed
+			return completeEvent(ctx, ts, sqlExecutor, opts, ev)
+		}
+
+		start := time.Now().UTC()
+		runErr := ieFactory().Exec(
+			defines.AttachAccount(ctx, taskCtx.AccountID, ev.userID, ev.roleID),
+			ev.body,
+			ie.NewOptsBuilder().Database(ev.db).Finish(),
+		)
+		end := time.Now().UTC()
+
+		status, errMsg := eventRunSuccess, ""
+		if runErr != nil {
+			status, errMsg = eventRunFailed, runErr.Error()
+			logger.Error("failed to run event",
+				zap.String("db", ev.db),
+				zap.String("event", ev.name),
+				zap.Error(runErr))
+		}
+		// the insert and the pruning are separate statements, a range delete in
+		// the same txn would conflict with the other runs of the event.
+		recordCtx, cancel := context.WithTimeoutCause(ctx, eventMetaTimeout, moerr.CauseRunEvent)
+		for _, sql := range []string{
+			fmt.Sprintf(insertEventRunFormat,
+				ev.id,
+				eventQuote(ev.db),
+				eventQuote(ev.name),
+				eventQuote(T.GetMetadata().ID),
+				start.Format(eventTimeLayout),
+				end.Format(eventTimeLayout),
+				status,
+				eventQuote(errMsg),
+			),
+			fmt.Sprintf(deleteEventRunsFormat,
+				ev.id,
+				start.Add(-eventRunsRetention).Format(eventTimeLayout),
+			),
+		} {
+			res, err := sqlExecutor.Exec(recordCtx, sql, opts)
+			if err != nil {
+				logger.Error("failed to record the run of event",
+					zap.String("db", ev.db),
+					zap.String("event", ev.name),
+					zap.Error(err))
+				break
+			}
+			res.Close()
+		}
+		cancel()
+
+		// the triggers missed by a late or long run are skipped, same as mysql
+		if ev.eventType == eventTypeRecurring {
+			if next := nextEventTime(ev, end.Truncate(time.Second).Add(time.Second)); !next.IsZero() {
+				if err = rescheduleEvent(ctx, ts, sqlExecutor, opts, taskCtx.AccountID, ev, next, start); err != nil {
+					return err
+				}
+				return runErr
+			}
+		}
+		if err = markEventExecuted(ctx, sqlExecutor, opts, ev, start); err != nil {
+			return err
+		}
+		if err = completeEvent(ctx, ts, sqlExecutor, opts, ev); err != nil {
+			return err
+		}
+		return runErr
+	}
+}
+
+// getEventDefByTask returns the enabled event that owns the cron task, or nil
+// if there is no such event.
+func getEventDefByTask(
+	ctx context.Context,
+	sqlExecutor executor.SQLExecutor,
+	opts executor.Options,
+	taskID string,
+) (*eventDef, error) {
+	ctx, cancel := context.WithTimeoutCause(ctx, eventMetaTimeout, moerr.CauseRunEvent)
+	defer cancel()
+	res, err := sqlExecutor.Exec(ctx, fmt.Sprintf(getEventByTaskFormat, eventQuote(taskID)), opts)
+	if err != nil {
+		return nil, moerr.AttachCause(ctx, err)
+	}
+	defer res.Close()
+
+	var ev *eventDef
+	getTime := func(vec *vector.Vector, row int) time.Time {
+		if vec.IsNull(uint64(row)) {
+			return time.Time{}
+		}
+		return vector.GetFixedAtNoTypeCheck[types.Datetime](vec, row).ConvertToGoTime(time.UTC)
+	}
+	res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+		if rows == 0 {
+			return true
+		}
+		ev = &eventDef{
+			id:            vector.GetFixedAtNoTypeCheck[uint64](cols[0], 0),
+			db:            cols[1].GetStringAt(0),
+			name:          cols[2].GetStringAt(0),
+			userID:        vector.GetFixedAtNoTypeCheck[uint32](cols[3], 0),
+			roleID:        vector.GetFixedAtNoTypeCheck[uint32](cols[4], 0),
+			body:          cols[5].GetStringAt(0),
+			eventType:     cols[6].GetStringAt(0),
+			executeAt:     getTime(cols[7], 0),
+			intervalField: cols[9].GetStringAt(0),
+			starts:        getTime(cols[10], 0),
+			ends:          getTime(cols[11], 0),
+			onCompletion:  cols[12].GetStringAt(0),
+			taskID:        taskID,
+			lastExecuted:  getTime(cols[13], 0),
+		}
+		if interval := cols[8].GetStringAt(0); interval != "" {
+			ev.intervalValue, err = strconv.ParseInt(interval, 10, 64)
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
+
+// rescheduleEvent moves the event to a new cron task triggered at the given
+// time, and removes the current one. The event is not moved if it has been
+// altered meanwhile, and the new cron task is removed by the executor as it
+// finds no event.
+func rescheduleEvent(
+	ctx context.Context,
+	ts taskservice.TaskService,
+	sqlExecutor executor.SQLExecutor,
+	opts executor.Options,
+	accountID uint32,
+	ev *eventDef,
+	at time.Time,
+	lastExecuted time.Time,
+) error {
+	taskID := newEventTaskID(accountID)
+	if err := createEventCronTask(ctx, ts, accountID, taskID, at); err != nil {
+		return err
+	}
+	sql := fmt.Sprintf(rescheduleEventFormat,
+		eventQuote(taskID),
+		eventTimeValue(lastExecuted),
+		ev.id,
+		eventQuote(ev.taskID),
+	)
+	execCtx, cancel := context.WithTimeoutCause(ctx, eventMetaTimeout, moerr.CauseRunEvent)
+	res, err := sqlExecutor.Exec(execCtx, sql, opts)
+	err = moerr.AttachCause(execCtx, err)
+	cancel()
+	if err != nil {
+		return err
+	}
+	res.Close()
+	return deleteEventCronTask(ctx, ts, ev.taskID)
+}
+
+// markEventExecuted records that the one-time event has run, so it is not run
+// again if the cron task is triggered again before the event is completed.
+func markEventExecuted(
+	ctx context.Context,
+	sqlExecutor executor.SQLExecutor,
+	opts executor.Options,
+	ev *eventDef,
+	executed time.Time,
+) error {
+	execCtx, cancel := context.WithTimeoutCause(ctx, eventMetaTimeout, moerr.CauseRunEvent)
+	defer cancel()
+	res, err := sqlExecutor.Exec(execCtx, fmt.Sprintf(markEventExecutedFormat, executed.Format(eventTimeLayout), ev.id), opts)
+	if err != nil {
+		return moerr.AttachCause(execCtx, err)
+	}
+	res.Close()
+	return nil
+}
+
+// completeEvent drops or disables the event that will never be triggered
+// again according to ON COMPLETION, and removes its cron task.
+func completeEvent(
+	ctx context.Context,
+	ts taskservice.TaskService,
+	sqlExecutor executor.SQLExecutor,
+	opts executor.Options,
+	ev *eventDef,
+) error {
+	sql := fmt.Sprintf(disableEventFormat, ev.id)
+	if ev.onCompletion == eventNotPreserve {
+		sql = fmt.Sprintf(deleteEventFormat, ev.id)
+	}
+	execCtx, cancel := context.WithTimeoutCause(ctx, eventMetaTimeout, moerr.CauseRunEvent)
+	res, err := sqlExecutor.Exec(execCtx, sql, opts)
+	err = moerr.AttachCause(execCtx, err)
+	cancel()
+	if err != nil {
+		return err
+	}
+	res.Close()
+	return deleteEventCronTask(ctx, ts, ev.taskID)
+}
+
+func deleteEventCronTask(ctx context.Context, ts taskservice.TaskService, taskID string) error {
+	ctx, cancel := context.WithTimeoutCause(ctx, eventMetaTimeout, moerr.CauseRunEvent)
+	defer cancel()
+	_, err := ts.DeleteCronTask(ctx, taskservice.WithTaskMetadataId(taskservice.EQ, taskID))
+	return moerr.AttachCause(ctx, err)
+}
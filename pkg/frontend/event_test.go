@@ -0,0 +1,428 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+func Test_nextEventTime(t *testing.T) {
+	starts := time.Date(2024, 1, 31, 10, 30, 15, 0, time.UTC)
+	recurring := func(n int64, field string) *eventDef {
+		return &eventDef{eventType: eventTypeRecurring, intervalValue: n, intervalField: field, starts: starts}
+	}
+
+	cases := []struct {
+		ev   *eventDef
+		from time.Time
+		want time.Time
+	}{
+		{
+			ev:   &eventDef{eventType: eventTypeOneTime, executeAt: starts},
+			from: starts.Add(-time.Hour),
+			want: starts,
+		},
+		{
+			// a one-time event is triggered once
+			ev:   &eventDef{eventType: eventTypeOneTime, executeAt: starts},
+			from: starts.Add(time.Second),
+		},
+		{
+			// the first trigger is STARTS
+			ev:   recurring(7, "SECOND"),
+			from: starts.AddDate(-1, 0, 0),
+			want: starts,
+		},
+		{
+			ev:   recurring(7, "SECOND"),
+			from: starts.Add(8 * time.Second),
+			want: starts.Add(14 * time.Second),
+		},
+		{
+			ev:   recurring(2, "DAY"),
+			from: starts.Add(48 * time.Hour),
+			want: starts.Add(48 * time.Hour),
+		},
+		{
+			ev:   recurring(3, "WEEK"),
+			from: starts.Add(time.Hour),
+			want: starts.AddDate(0, 0, 21),
+		},
+		{
+			// the day is clipped to the end of the month
+			ev:   recurring(1, "MONTH"),
+			from: starts.Add(time.Second),
+			want: time.Date(2024, 2, 29, 10, 30, 15, 0, time.UTC),
+		},
+		{
+			ev:   recurring(1, "MONTH"),
+			from: time.Date(2024, 3, 31, 10, 30, 16, 0, time.UTC),
+			want: time.Date(2024, 4, 30, 10, 30, 15, 0, time.UTC),
+		},
+		{
+			// an interval that does not divide a year
+			ev:   recurring(5, "MONTH"),
+			from: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 11, 30, 10, 30, 15, 0, time.UTC),
+		},
+		{
+			ev:   recurring(5, "MONTH"),
+			from: time.Date(2024, 11, 30, 10, 30, 15, 0, time.UTC),
+			want: time.Date(2024, 11, 30, 10, 30, 15, 0, time.UTC),
+		},
+		{
+			ev:   recurring(1, "QUARTER"),
+			from: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 7, 31, 10, 30, 15, 0, time.UTC),
+		},
+		{
+			ev:   recurring(2, "YEAR"),
+			from: starts.Add(time.Second),
+			want: starts.AddDate(2, 0, 0),
+		},
+		{
+			// no trigger after ENDS
+			ev: &eventDef{
+				eventType:     eventTypeRecurring,
+				intervalValue: 1,
+				intervalField: "HOUR",
+				starts:        starts,
+				ends:          starts.Add(90 * time.Minute),
+			},
+			from: starts.Add(time.Minute + time.Hour),
+		},
+	}
+	for i, c := range cases {
+		assert.Equal(t, c.want, nextEventTime(c.ev, c.from), "case %d", i)
+	}
+}
+
+func Test_checkEventInterval(t *testing.T) {
+	ctx := context.Background()
+	for _, field := range []string{"SECOND", "MINUTE", "HOUR", "DAY", "WEEK", "MONTH", "QUARTER", "YEAR"} {
+		assert.NoError(t, checkEventInterval(ctx, &eventDef{intervalValue: 5, intervalField: field}))
+	}
+	for _, ev := range []eventDef{
+		{intervalValue: 1, intervalField: "MICROSECOND"},
+		{intervalValue: 1, intervalField: "HOUR_MINUTE"},
+		{intervalValue: math.MaxInt64, intervalField: "SECOND"},
+		{intervalValue: math.MaxInt64, intervalField: "MONTH"},
+	} {
+		assert.Error(t, checkEventInterval(ctx, &ev))
+	}
+}
+
+func Test_eventCronExpr(t *testing.T) {
+	// the same parser as the task service
+	parser := cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+	at := time.Date(2024, 5, 17, 10, 30, 15, 0, time.UTC)
+
+	expr := eventCronExpr(at)
+	assert.Equal(t, "CRON_TZ=UTC 15 30 10 17 5 *", expr)
+	schedule, err := parser.Parse(expr)
+	require.NoError(t, err)
+	assert.Equal(t, at, schedule.Next(at.Add(-time.Hour)).UTC())
+
+	ts := taskservice.NewTaskService(runtime.DefaultRuntime(), taskservice.NewMemTaskStorage())
+	defer ts.Close()
+	ctx := context.Background()
+
+	// a passed trigger is fired as soon as the cron task is stored
+	require.NoError(t, createEventCronTask(ctx, ts, 1, "event-1-a", at))
+	tasks, err := ts.QueryCronTask(ctx, taskservice.WithTaskMetadataId(taskservice.EQ, "event-1-a"))
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, task.TaskCode_SQLEvent, tasks[0].Metadata.Executor)
+	next := time.UnixMilli(tasks[0].NextTime)
+	assert.True(t, next.After(time.Now()))
+	assert.True(t, next.Before(time.Now().Add(eventMinTriggerDelay+time.Second)))
+
+	var taskCtx eventTaskContext
+	require.NoError(t, json.Unmarshal(tasks[0].Metadata.Context, &taskCtx))
+	assert.Equal(t, eventTaskContext{AccountID: 1, TaskID: "event-1-a", TriggerAt: at.UnixMilli()}, taskCtx)
+}
+
+func Test_eventDefExpired(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	hourly := func(starts, ends time.Time) *eventDef {
+		return &eventDef{eventType: eventTypeRecurring, intervalValue: 1, intervalField: "HOUR", starts: starts, ends: ends}
+	}
+	assert.True(t, (&eventDef{eventType: eventTypeOneTime, executeAt: now}).expired(now))
+	assert.False(t, (&eventDef{eventType: eventTypeOneTime, executeAt: now.Add(time.Second)}).expired(now))
+	assert.False(t, hourly(now, time.Time{}).expired(now))
+	assert.True(t, hourly(now.Add(-time.Hour), now.Add(-time.Second)).expired(now))
+	assert.False(t, hourly(now.Add(-time.Hour), now.Add(time.Second)).expired(now))
+	// no trigger is left before ENDS
+	assert.True(t, hourly(now.Add(-time.Hour), now.Add(30*time.Minute)).expired(now.Add(time.Second)))
+}
+
+// eventExecutorTest runs the executor of the events against the given event
+// rows, and records the statements and the bodies it runs.
+type eventExecutorTest struct {
+	t      *testing.T
+	mp     *mpool.MPool
+	ts     taskservice.TaskService
+	events map[string]*eventDef
+	sqls   []string
+	bodies []string
+}
+
+func newEventExecutorTest(t *testing.T) *eventExecutorTest {
+	return &eventExecutorTest{
+		t:      t,
+		mp:     mpool.MustNewZero(),
+		ts:     taskservice.NewTaskService(runtime.DefaultRuntime(), taskservice.NewMemTaskStorage()),
+		events: make(map[string]*eventDef),
+	}
+}
+
+func (et *eventExecutorTest) Exec(_ context.Context, body string, _ ie.SessionOverrideOptions) error {
+	et.bodies = append(et.bodies, body)
+	return nil
+}
+
+func (et *eventExecutorTest) Query(context.Context, string, ie.SessionOverrideOptions) ie.InternalExecResult {
+	return nil
+}
+
+func (et *eventExecutorTest) ApplySessionOverride(ie.SessionOverrideOptions) {}
+
+func (et *eventExecutorTest) mock(sql string) (executor.Result, error) {
+	et.sqls = append(et.sqls, sql)
+	res := executor.NewResult(et.mp)
+	for taskID, ev := range et.events {
+		if sql == fmt.Sprintf(getEventByTaskFormat, taskID) {
+			res.Batches = append(res.Batches, et.eventBatch(ev))
+		}
+	}
+	return res, nil
+}
+
+// eventBatch returns the row of the event read by getEventByTaskFormat.
+func (et *eventExecutorTest) eventBatch(ev *eventDef) *batch.Batch {
+	t := et.t
+	bat := batch.NewWithSize(14)
+	bat.SetRowCount(1)
+	appendFixed := func(i int, typ types.T, v any) {
+		bat.Vecs[i] = vector.NewVec(typ.ToType())
+		var err error
+		switch val := v.(type) {
+		case uint64:
+			err = vector.AppendFixed(bat.Vecs[i], val, false, et.mp)
+		case uint32:
+			err = vector.AppendFixed(bat.Vecs[i], val, false, et.mp)
+		}
+		require.NoError(t, err)
+	}
+	appendString := func(i int, v string) {
+		bat.Vecs[i] = vector.NewVec(types.T_varchar.ToType())
+		require.NoError(t, vector.AppendBytes(bat.Vecs[i], []byte(v), false, et.mp))
+	}
+	appendTime := func(i int, v time.Time) {
+		bat.Vecs[i] = vector.NewVec(types.T_datetime.ToType())
+		dt := types.DatetimeFromUnixWithNsec(time.UTC, v.Unix(), int64(v.Nanosecond()))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[i], dt, v.IsZero(), et.mp))
+	}
+	appendFixed(0, types.T_uint64, ev.id)
+	appendString(1, ev.db)
+	appendString(2, ev.name)
+	appendFixed(3, types.T_uint32, ev.userID)
+	appendFixed(4, types.T_uint32, ev.roleID)
+	appendString(5, ev.body)
+	appendString(6, ev.eventType)
+	appendTime(7, ev.executeAt)
+	appendString(8, eventIntervalValue(ev.intervalValue))
+	appendString(9, ev.intervalField)
+	appendTime(10, ev.starts)
+	appendTime(11, ev.ends)
+	appendString(12, ev.onCompletion)
+	appendTime(13, ev.lastExecuted)
+	return bat
+}
+
+// trigger creates the cron task of the event and runs it.
+func (et *eventExecutorTest) trigger(ev *eventDef, at time.Time) error {
+	t := et.t
+	ctx := context.Background()
+	et.events[ev.taskID] = ev
+	require.NoError(t, createEventCronTask(ctx, et.ts, 1, ev.taskID, at))
+	tasks, err := et.ts.QueryCronTask(ctx, taskservice.WithTaskMetadataId(taskservice.EQ, ev.taskID))
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+
+	et.sqls, et.bodies = nil, nil
+	run := RegisterEventExecutor(
+		zap.NewNop(),
+		et.ts,
+		executor.NewMemExecutor(et.mock),
+		func() ie.InternalExecutor { return et },
+	)
+	return run(ctx, &task.AsyncTask{Metadata: tasks[0].Metadata})
+}
+
+// cronTasks returns the trigger times of the cron tasks by task id.
+func (et *eventExecutorTest) cronTasks() map[string]time.Time {
+	tasks, err := et.ts.QueryCronTask(context.Background())
+	require.NoError(et.t, err)
+	triggers := make(map[string]time.Time, len(tasks))
+	for _, v := range tasks {
+		var taskCtx eventTaskContext
+		require.NoError(et.t, json.Unmarshal(v.Metadata.Context, &taskCtx))
+		triggers[v.Metadata.ID] = time.UnixMilli(taskCtx.TriggerAt).UTC()
+	}
+	return triggers
+}
+
+func (et *eventExecutorTest) hasSQL(prefix string) bool {
+	for _, sql := range et.sqls {
+		if strings.HasPrefix(sql, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func Test_eventExecutor(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+
+	t.Run("recurring event is rescheduled from STARTS", func(t *testing.T) {
+		et := newEventExecutorTest(t)
+		defer et.ts.Close()
+		starts := now.AddDate(0, -2, 0)
+		ev := &eventDef{
+			id:            1,
+			db:            "db1",
+			name:          "e1",
+			body:          "insert into t values (1)",
+			eventType:     eventTypeRecurring,
+			intervalValue: 5,
+			intervalField: "MONTH",
+			starts:        starts,
+			onCompletion:  eventNotPreserve,
+			taskID:        "event-1-a",
+		}
+		require.NoError(t, et.trigger(ev, now))
+		assert.Equal(t, []string{ev.body}, et.bodies)
+		assert.True(t, et.hasSQL("insert into mo_catalog.mo_event_runs"))
+
+		tasks := et.cronTasks()
+		require.Len(t, tasks, 1)
+		assert.NotContains(t, tasks, ev.taskID)
+		for taskID, at := range tasks {
+			assert.Equal(t, addEventMonths(starts, 5), at)
+			assert.True(t, et.hasSQL(fmt.Sprintf("update mo_catalog.mo_events set task_id = '%s', last_executed = '", taskID)))
+		}
+	})
+
+	t.Run("missed one-time event is caught up and completed", func(t *testing.T) {
+		et := newEventExecutorTest(t)
+		defer et.ts.Close()
+		ev := &eventDef{
+			id:           2,
+			body:         "insert into t values (2)",
+			eventType:    eventTypeOneTime,
+			executeAt:    now.AddDate(0, 0, -1),
+			onCompletion: eventPreserve,
+			taskID:       "event-1-b",
+		}
+		require.NoError(t, et.trigger(ev, ev.executeAt))
+		assert.Equal(t, []string{ev.body}, et.bodies)
+		assert.True(t, et.hasSQL("update mo_catalog.mo_events set last_executed = "))
+		assert.True(t, et.hasSQL(fmt.Sprintf(disableEventFormat, ev.id)))
+		assert.Empty(t, et.cronTasks())
+	})
+
+	t.Run("one-time event is not run twice", func(t *testing.T) {
+		et := newEventExecutorTest(t)
+		defer et.ts.Close()
+		ev := &eventDef{
+			id:           3,
+			body:         "insert into t values (3)",
+			eventType:    eventTypeOneTime,
+			executeAt:    now.Add(-time.Hour),
+			onCompletion: eventNotPreserve,
+			taskID:       "event-1-c",
+			lastExecuted: now.Add(-time.Hour),
+		}
+		require.NoError(t, et.trigger(ev, ev.executeAt))
+		assert.Empty(t, et.bodies)
+		assert.True(t, et.hasSQL(fmt.Sprintf(deleteEventFormat, ev.id)))
+		assert.Empty(t, et.cronTasks())
+	})
+
+	t.Run("early trigger waits for the next year", func(t *testing.T) {
+		et := newEventExecutorTest(t)
+		defer et.ts.Close()
+		ev := &eventDef{
+			id:           4,
+			body:         "insert into t values (4)",
+			eventType:    eventTypeOneTime,
+			executeAt:    now.AddDate(2, 0, 0),
+			onCompletion: eventNotPreserve,
+			taskID:       "event-1-d",
+		}
+		require.NoError(t, et.trigger(ev, ev.executeAt))
+		assert.Empty(t, et.bodies)
+		tasks := et.cronTasks()
+		require.Len(t, tasks, 1)
+		assert.NotContains(t, tasks, ev.taskID)
+		for _, at := range tasks {
+			assert.Equal(t, ev.executeAt, at)
+		}
+	})
+
+	t.Run("cron task without event is removed", func(t *testing.T) {
+		et := newEventExecutorTest(t)
+		defer et.ts.Close()
+		ev := &eventDef{taskID: "event-1-e"}
+		et.events = map[string]*eventDef{}
+		ctx := context.Background()
+		require.NoError(t, createEventCronTask(ctx, et.ts, 1, ev.taskID, now))
+		tasks, err := et.ts.QueryCronTask(ctx)
+		require.NoError(t, err)
+		run := RegisterEventExecutor(zap.NewNop(), et.ts, executor.NewMemExecutor(et.mock), func() ie.InternalExecutor { return et })
+		require.NoError(t, run(ctx, &task.AsyncTask{Metadata: tasks[0].Metadata}))
+		assert.Empty(t, et.bodies)
+		assert.Empty(t, et.cronTasks())
+	})
+}
+
+func Test_eventQuote(t *testing.T) {
+	assert.Equal(t, `insert into t values (\'a\\b\')`, eventQuote(`insert into t values ('a\b')`))
+	assert.Equal(t, "null", eventTimeValue(time.Time{}))
+	assert.Equal(t, "'2024-05-17 10:30:15.000000'",
+		eventTimeValue(time.Date(2024, 5, 17, 10, 30, 15, 0, time.UTC)))
+}
@@ -63,6 +63,11 @@ type ExportConfig struct {
 	mrs         *MysqlResultSet
 	ctx         context.Context
 	service     string
+
+	// colNames are the unique names of the result columns, used as the keys
+	// of jsonline and the fields of parquet
+	colNames []string
+	parquet  *parquetExporter
 }
 
 type writeParam struct {
@@ -106,6 +111,14 @@ func (ec *ExportConfig) needExportToFile() bool {
 	return ec != nil && ec.userConfig != nil && ec.userConfig.Outfile
 }
 
+// fileFormat returns the format of the exported files, csv by default.
+func (ec *ExportConfig) fileFormat() string {
+	if ec.userConfig.FileFormat == "" {
+		return tree.CSV
+	}
+	return ec.userConfig.FileFormat
+}
+
 func initExportFileParam(ep *ExportConfig, mrs *MysqlResultSet) {
 	ep.DefaultBufSize *= 1024 * 1024
 	n := (int)(mrs.GetColumnCount())
@@ -128,6 +141,27 @@ func initExportFileParam(ep *ExportConfig, mrs *MysqlResultSet) {
 			ep.ColumnFlag[col] = true
 		}
 	}
+
+	ep.colNames = uniqueColumnNames(mrs)
+	if ep.fileFormat() == tree.PARQUET {
+		ep.parquet = &parquetExporter{}
+	}
+}
+
+// uniqueColumnNames renames the duplicate result columns by their positions,
+// so that every column has its own key in jsonline and parquet.
+func uniqueColumnNames(mrs *MysqlResultSet) []string {
+	names := make([]string, len(mrs.Columns))
+	seen := make(map[string]struct{}, len(mrs.Columns))
+	for i, col := range mrs.Columns {
+		name := col.Name()
+		if _, ok := seen[name]; ok {
+			name = fmt.Sprintf("%s_%d", name, i)
+		}
+		seen[name] = struct{}{}
+		names[i] = name
+	}
+	return names
 }
 
 var openNewFile = func(ctx context.Context, ep *ExportConfig, mrs *MysqlResultSet) error {
@@ -186,7 +220,7 @@ var openNewFile = func(ctx context.Context, ep *ExportConfig, mrs *MysqlResultSe
 	ep.AsyncGroup, _ = errgroup.WithContext(ctx)
 	ep.AsyncGroup.Go(asyncWriteFunc)
 
-	if ep.userConfig.Header {
+	if ep.userConfig.Header && ep.fileFormat() == tree.CSV {
 		var header string
 		n := len(mrs.Columns)
 		if n == 0 {
@@ -238,6 +272,12 @@ var formatOutputString = func(oq *ExportConfig, tmp, symbol []byte, enclosed byt
 }
 
 var Close = func(ep *ExportConfig) error {
+	if ep.parquet != nil {
+		// the footer of parquet is written at last
+		if err := ep.parquet.closeFile(ep); err != nil {
+			return err
+		}
+	}
 	ep.FileCnt++
 	err := ep.AsyncWriter.Close()
 	if err != nil {
@@ -393,6 +433,10 @@ func constructByte(ctx context.Context, obj FeSession, bat *batch.Batch, index i
 				arrStr := types.BytesToArrayToString[float64](vec.GetBytesAt(i))
 				value := addEscapeToString(util2.UnsafeStringToBytes(arrStr))
 				formatOutputString(ep, value, symbol[j], closeby, true, buffer)
+			case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+				arrStr := types.QuantizedArrayToString(vec.GetType().Oid, vec.GetBytesAt(i))
+				value := addEscapeToString(util2.UnsafeStringToBytes(arrStr))
+				formatOutputString(ep, value, symbol[j], closeby, true, buffer)
 			case types.T_date:
 				val := vector.GetFixedAtNoTypeCheck[types.Date](vec, i)
 				formatOutputString(ep, []byte(val.String()), symbol[j], closeby, flag[j], buffer)
@@ -458,6 +502,148 @@ func constructByte(ctx context.Context, obj FeSession, bat *batch.Batch, index i
 
 }
 
+// constructJsonlByte converts the batch into json lines, one object per row
+// keyed by the column names. Decimals are written as json numbers with all
+// their digits, and the types without a json counterpart are strings in the
+// same text form as csv.
+func constructJsonlByte(ctx context.Context, obj FeSession, bat *batch.Batch, index int32, ByteChan chan *BatchByte, ep *ExportConfig) {
+	ses := obj.(*Session)
+	buffer := &bytes.Buffer{}
+	var err error
+
+	for i := 0; i < bat.RowCount() && err == nil; i++ {
+		buffer.WriteByte('{')
+		for j, vec := range bat.Vecs {
+			if j > 0 {
+				buffer.WriteByte(',')
+			}
+			appendJsonString(buffer, util2.UnsafeStringToBytes(ep.colNames[j]))
+			buffer.WriteByte(':')
+			if err = appendJsonValue(ctx, ses, buffer, vec, i); err != nil {
+				break
+			}
+		}
+		buffer.WriteString("}\n")
+	}
+	if err != nil {
+		ses.Error(ctx,
+			"Failed to construct json line",
+			zap.Error(err))
+		ByteChan <- &BatchByte{
+			err: err,
+		}
+		bat.Clean(ses.GetMemPool())
+		return
+	}
+
+	reslen := buffer.Len()
+	result := make([]byte, reslen)
+	copy(result, buffer.Bytes())
+
+	ByteChan <- &BatchByte{
+		index:     index,
+		writeByte: result,
+		err:       nil,
+	}
+	ses.writeCsvBytes.Add(int64(reslen))
+	bat.Clean(ses.GetMemPool())
+}
+
+func appendJsonValue(ctx context.Context, ses *Session, buffer *bytes.Buffer, vec *vector.Vector, i int) error {
+	if vec.IsNull(uint64(i)) {
+		buffer.WriteString("null")
+		return nil
+	}
+	typ := vec.GetType()
+	switch typ.Oid {
+	case types.T_json:
+		buffer.WriteString(types.DecodeJson(vec.GetBytesAt(i)).String())
+	case types.T_bool:
+		buffer.WriteString(strconv.FormatBool(vector.GetFixedAtNoTypeCheck[bool](vec, i)))
+	case types.T_bit:
+		buffer.WriteString(strconv.FormatUint(vector.GetFixedAtNoTypeCheck[uint64](vec, i), 10))
+	case types.T_int8:
+		buffer.WriteString(strconv.FormatInt(int64(vector.GetFixedAtNoTypeCheck[int8](vec, i)), 10))
+	case types.T_int16:
+		buffer.WriteString(strconv.FormatInt(int64(vector.GetFixedAtNoTypeCheck[int16](vec, i)), 10))
+	case types.T_int32:
+		buffer.WriteString(strconv.FormatInt(int64(vector.GetFixedAtNoTypeCheck[int32](vec, i)), 10))
+	case types.T_int64:
+		buffer.WriteString(strconv.FormatInt(vector.GetFixedAtNoTypeCheck[int64](vec, i), 10))
+	case types.T_uint8:
+		buffer.WriteString(strconv.FormatUint(uint64(vector.GetFixedAtNoTypeCheck[uint8](vec, i)), 10))
+	case types.T_uint16:
+		buffer.WriteString(strconv.FormatUint(uint64(vector.GetFixedAtNoTypeCheck[uint16](vec, i)), 10))
+	case types.T_uint32:
+		buffer.WriteString(strconv.FormatUint(uint64(vector.GetFixedAtNoTypeCheck[uint32](vec, i)), 10))
+	case types.T_uint64:
+		buffer.WriteString(strconv.FormatUint(vector.GetFixedAtNoTypeCheck[uint64](vec, i), 10))
+	case types.T_float32:
+		buffer.WriteString(strconv.FormatFloat(float64(vector.GetFixedAtNoTypeCheck[float32](vec, i)), 'g', -1, 32))
+	case types.T_float64:
+		buffer.WriteString(strconv.FormatFloat(vector.GetFixedAtNoTypeCheck[float64](vec, i), 'g', -1, 64))
+	case types.T_decimal64:
+		buffer.WriteString(vector.GetFixedAtNoTypeCheck[types.Decimal64](vec, i).Format(typ.Scale))
+	case types.T_decimal128:
+		buffer.WriteString(vector.GetFixedAtNoTypeCheck[types.Decimal128](vec, i).Format(typ.Scale))
+	case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_binary, types.T_varbinary, types.T_datalink:
+		appendJsonString(buffer, vec.GetBytesAt(i))
+	case types.T_array_float32:
+		appendJsonString(buffer, util2.UnsafeStringToBytes(types.BytesToArrayToString[float32](vec.GetBytesAt(i))))
+	case types.T_array_float64:
+		appendJsonString(buffer, util2.UnsafeStringToBytes(types.BytesToArrayToString[float64](vec.GetBytesAt(i))))
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		appendJsonString(buffer, util2.UnsafeStringToBytes(types.QuantizedArrayToString(typ.Oid, vec.GetBytesAt(i))))
+	case types.T_date:
+		appendJsonString(buffer, []byte(vector.GetFixedAtNoTypeCheck[types.Date](vec, i).String()))
+	case types.T_datetime:
+		appendJsonString(buffer, []byte(vector.GetFixedAtNoTypeCheck[types.Datetime](vec, i).String2(typ.Scale)))
+	case types.T_time:
+		appendJsonString(buffer, []byte(vector.GetFixedAtNoTypeCheck[types.Time](vec, i).String2(typ.Scale)))
+	case types.T_timestamp:
+		appendJsonString(buffer, []byte(vector.GetFixedAtNoTypeCheck[types.Timestamp](vec, i).String2(ses.GetTimeZone(), typ.Scale)))
+	case types.T_uuid:
+		appendJsonString(buffer, []byte(vector.GetFixedAtNoTypeCheck[types.Uuid](vec, i).String()))
+	case types.T_Rowid:
+		appendJsonString(buffer, []byte(vector.GetFixedAtNoTypeCheck[types.Rowid](vec, i).String()))
+	case types.T_Blockid:
+		val := vector.GetFixedAtNoTypeCheck[types.Blockid](vec, i)
+		appendJsonString(buffer, []byte(val.String()))
+	case types.T_enum:
+		buffer.WriteString(vector.GetFixedAtNoTypeCheck[types.Enum](vec, i).String())
+	default:
+		return moerr.NewInternalErrorf(ctx, "constructJsonlByte : unsupported type %d", typ.Oid)
+	}
+	return nil
+}
+
+// appendJsonString writes s as a json string. Only the quote, the backslash
+// and the control characters are escaped, other bytes are kept as they are.
+func appendJsonString(buffer *bytes.Buffer, s []byte) {
+	const hex = "0123456789abcdef"
+	buffer.WriteByte('"')
+	for _, c := range s {
+		switch {
+		case c == '"' || c == '\\':
+			buffer.WriteByte('\\')
+			buffer.WriteByte(c)
+		case c == '\n':
+			buffer.WriteString("\\n")
+		case c == '\r':
+			buffer.WriteString("\\r")
+		case c == '\t':
+			buffer.WriteString("\\t")
+		case c < 0x20:
+			buffer.WriteString("\\u00")
+			buffer.WriteByte(hex[c>>4])
+			buffer.WriteByte(hex[c&0xf])
+		default:
+			buffer.WriteByte(c)
+		}
+	}
+	buffer.WriteByte('"')
+}
+
 func addEscapeToString(s []byte) []byte {
 	pos := make([]int, 0)
 	for i := 0; i < len(s); i++ {
@@ -742,12 +928,27 @@ func (ec *ExportConfig) init() {
 }
 
 func (ec *ExportConfig) Write(execCtx *ExecCtx, crs *perfcounter.CounterSet, bat *batch.Batch) error {
+	if ec.parquet != nil {
+		// parquet is written in the order of batches, a row group per batch
+		if err := ec.parquet.write(ec, bat); err != nil {
+			execCtx.ses.Error(execCtx.reqCtx,
+				"Error occurred while exporting to parquet file",
+				zap.Error(err))
+			return err
+		}
+		return nil
+	}
+
 	ec.Index.Add(1)
 	copied, err := bat.Dup(execCtx.ses.GetMemPool())
 	if err != nil {
 		return err
 	}
-	go constructByte(execCtx.reqCtx, execCtx.ses, copied, ec.Index.Load(), ec.ByteChan, ec)
+	if ec.fileFormat() == tree.JSONLINE {
+		go constructJsonlByte(execCtx.reqCtx, execCtx.ses, copied, ec.Index.Load(), ec.ByteChan, ec)
+	} else {
+		go constructByte(execCtx.reqCtx, execCtx.ses, copied, ec.Index.Load(), ec.ByteChan, ec)
+	}
 
 	if err = exportDataFromBatchToCSVFile(ec); err != nil {
 		execCtx.ses.Error(execCtx.reqCtx,
@@ -0,0 +1,263 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/binary"
+
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// unixEpochMicros is the timestamp of 1970-01-01 00:00:00 UTC.
+var unixEpochMicros = int64(types.UnixMicroToTimestamp(0))
+
+// parquetExporter writes the result into parquet files. The schema is made
+// from the types of the first batch, every batch is a row group, and a file
+// is split before the next batch once its size reaches max_file_size.
+type parquetExporter struct {
+	schema *parquet.Schema
+	// index of every result column in the leaf columns of the schema, fields
+	// of a parquet group are sorted by name
+	leafIdxes []int
+	w         *parquet.Writer
+	rows      []parquet.Row
+}
+
+// exportFileWriter writes the parquet data into the current export file.
+type exportFileWriter struct {
+	ep *ExportConfig
+}
+
+func (w exportFileWriter) Write(p []byte) (int, error) {
+	if err := writeDataToCSVFile(w.ep, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (pe *parquetExporter) initSchema(names []string, nodes []parquet.Node) {
+	group := make(parquet.Group, len(names))
+	for i, name := range names {
+		group[name] = parquet.Optional(nodes[i])
+	}
+	pe.schema = parquet.NewSchema("mo", group)
+	pe.leafIdxes = make([]int, len(names))
+	for i, name := range names {
+		leaf, _ := pe.schema.Lookup(name)
+		pe.leafIdxes[i] = leaf.ColumnIndex
+	}
+}
+
+func (pe *parquetExporter) write(ep *ExportConfig, bat *batch.Batch) error {
+	if pe.schema == nil {
+		nodes := make([]parquet.Node, len(bat.Vecs))
+		for i, vec := range bat.Vecs {
+			node, err := parquetExportNode(vec.GetType())
+			if err != nil {
+				return err
+			}
+			nodes[i] = node
+		}
+		pe.initSchema(ep.colNames, nodes)
+	}
+	if bat.RowCount() == 0 {
+		return nil
+	}
+
+	if pe.w != nil && ep.userConfig.MaxFileSize != 0 && ep.CurFileSize >= ep.userConfig.MaxFileSize {
+		if err := Close(ep); err != nil {
+			return err
+		}
+		if err := openNewFile(ep.ctx, ep, ep.mrs); err != nil {
+			return err
+		}
+	}
+	if pe.w == nil {
+		pe.w = parquet.NewWriter(exportFileWriter{ep: ep}, pe.schema)
+	}
+
+	pe.rows = pe.rows[:0]
+	for i := 0; i < bat.RowCount(); i++ {
+		row := make(parquet.Row, len(bat.Vecs))
+		for j, vec := range bat.Vecs {
+			colIdx := pe.leafIdxes[j]
+			if vec.IsNull(uint64(i)) {
+				row[colIdx] = parquet.NullValue().Level(0, 0, colIdx)
+				continue
+			}
+			v, err := parquetExportValue(vec, i)
+			if err != nil {
+				return err
+			}
+			row[colIdx] = v.Level(0, 1, colIdx)
+		}
+		pe.rows = append(pe.rows, row)
+	}
+	if _, err := pe.w.WriteRows(pe.rows); err != nil {
+		return err
+	}
+	// write the row group, so that the file size is known
+	return pe.w.Flush()
+}
+
+// closeFile writes the footer of the current file. An empty result has no
+// batch to take the types from, its columns are written as strings.
+func (pe *parquetExporter) closeFile(ep *ExportConfig) error {
+	if pe.w == nil {
+		if pe.schema == nil {
+			nodes := make([]parquet.Node, len(ep.colNames))
+			for i := range nodes {
+				nodes[i] = parquet.String()
+			}
+			pe.initSchema(ep.colNames, nodes)
+		}
+		pe.w = parquet.NewWriter(exportFileWriter{ep: ep}, pe.schema)
+	}
+	err := pe.w.Close()
+	pe.w = nil
+	return err
+}
+
+// parquetExportNode returns the parquet type of the column, the types without
+// a parquet counterpart are written as strings in the same form as csv.
+func parquetExportNode(typ *types.Type) (parquet.Node, error) {
+	switch typ.Oid {
+	case types.T_bool:
+		return parquet.Leaf(parquet.BooleanType), nil
+	case types.T_int8:
+		return parquet.Int(8), nil
+	case types.T_int16:
+		return parquet.Int(16), nil
+	case types.T_int32:
+		return parquet.Int(32), nil
+	case types.T_int64:
+		return parquet.Int(64), nil
+	case types.T_uint8:
+		return parquet.Uint(8), nil
+	case types.T_uint16, types.T_enum:
+		return parquet.Uint(16), nil
+	case types.T_uint32:
+		return parquet.Uint(32), nil
+	case types.T_uint64, types.T_bit:
+		return parquet.Uint(64), nil
+	case types.T_float32:
+		return parquet.Leaf(parquet.FloatType), nil
+	case types.T_float64:
+		return parquet.Leaf(parquet.DoubleType), nil
+	case types.T_decimal64:
+		return parquet.Decimal(int(typ.Scale), decimalPrecision(typ, 18), parquet.Int64Type), nil
+	case types.T_decimal128:
+		return parquet.Decimal(int(typ.Scale), decimalPrecision(typ, 38), parquet.FixedLenByteArrayType(16)), nil
+	case types.T_date:
+		return parquet.Date(), nil
+	case types.T_time:
+		// a time ranges from -838:59:59 to 838:59:59, out of the range of
+		// the parquet TIME, it is written as plain int64 microseconds
+		return parquet.Int(64), nil
+	case types.T_datetime, types.T_timestamp:
+		// a datetime is written as the timestamp of its wall clock in UTC
+		return parquet.Timestamp(parquet.Microsecond), nil
+	case types.T_json:
+		return parquet.JSON(), nil
+	case types.T_blob, types.T_binary, types.T_varbinary:
+		return parquet.Leaf(parquet.ByteArrayType), nil
+	case types.T_char, types.T_varchar, types.T_text, types.T_datalink,
+		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
+		types.T_uuid, types.T_Rowid, types.T_Blockid:
+		return parquet.String(), nil
+	default:
+		return nil, moerr.NewNYINoCtxf("export %s to parquet", typ.String())
+	}
+}
+
+func decimalPrecision(typ *types.Type, max int) int {
+	if typ.Width <= 0 || int(typ.Width) > max {
+		return max
+	}
+	return int(typ.Width)
+}
+
+func parquetExportValue(vec *vector.Vector, i int) (parquet.Value, error) {
+	typ := vec.GetType()
+	switch typ.Oid {
+	case types.T_bool:
+		return parquet.BooleanValue(vector.GetFixedAtNoTypeCheck[bool](vec, i)), nil
+	case types.T_int8:
+		return parquet.Int32Value(int32(vector.GetFixedAtNoTypeCheck[int8](vec, i))), nil
+	case types.T_int16:
+		return parquet.Int32Value(int32(vector.GetFixedAtNoTypeCheck[int16](vec, i))), nil
+	case types.T_int32:
+		return parquet.Int32Value(vector.GetFixedAtNoTypeCheck[int32](vec, i)), nil
+	case types.T_int64:
+		return parquet.Int64Value(vector.GetFixedAtNoTypeCheck[int64](vec, i)), nil
+	case types.T_uint8:
+		return parquet.Int32Value(int32(vector.GetFixedAtNoTypeCheck[uint8](vec, i))), nil
+	case types.T_uint16:
+		return parquet.Int32Value(int32(vector.GetFixedAtNoTypeCheck[uint16](vec, i))), nil
+	case types.T_enum:
+		return parquet.Int32Value(int32(vector.GetFixedAtNoTypeCheck[types.Enum](vec, i))), nil
+	case types.T_uint32:
+		return parquet.Int32Value(int32(vector.GetFixedAtNoTypeCheck[uint32](vec, i))), nil
+	case types.T_uint64, types.T_bit:
+		return parquet.Int64Value(int64(vector.GetFixedAtNoTypeCheck[uint64](vec, i))), nil
+	case types.T_float32:
+		return parquet.FloatValue(vector.GetFixedAtNoTypeCheck[float32](vec, i)), nil
+	case types.T_float64:
+		return parquet.DoubleValue(vector.GetFixedAtNoTypeCheck[float64](vec, i)), nil
+	case types.T_decimal64:
+		return parquet.Int64Value(int64(vector.GetFixedAtNoTypeCheck[types.Decimal64](vec, i))), nil
+	case types.T_decimal128:
+		// big-endian two's complement
+		d := vector.GetFixedAtNoTypeCheck[types.Decimal128](vec, i)
+		b := make([]byte, 16)
+		binary.BigEndian.PutUint64(b[:8], d.B64_127)
+		binary.BigEndian.PutUint64(b[8:], d.B0_63)
+		return parquet.FixedLenByteArrayValue(b), nil
+	case types.T_date:
+		return parquet.Int32Value(vector.GetFixedAtNoTypeCheck[types.Date](vec, i).DaysSinceUnixEpoch()), nil
+	case types.T_time:
+		return parquet.Int64Value(int64(vector.GetFixedAtNoTypeCheck[types.Time](vec, i))), nil
+	case types.T_datetime:
+		return parquet.Int64Value(int64(vector.GetFixedAtNoTypeCheck[types.Datetime](vec, i)) - unixEpochMicros), nil
+	case types.T_timestamp:
+		return parquet.Int64Value(int64(vector.GetFixedAtNoTypeCheck[types.Timestamp](vec, i)) - unixEpochMicros), nil
+	case types.T_json:
+		return parquet.ByteArrayValue([]byte(types.DecodeJson(vec.GetBytesAt(i)).String())), nil
+	case types.T_char, types.T_varchar, types.T_text, types.T_datalink,
+		types.T_blob, types.T_binary, types.T_varbinary:
+		return parquet.ByteArrayValue(vec.GetBytesAt(i)), nil
+	case types.T_array_float32:
+		return parquet.ByteArrayValue([]byte(types.BytesToArrayToString[float32](vec.GetBytesAt(i)))), nil
+	case types.T_array_float64:
+		return parquet.ByteArrayValue([]byte(types.BytesToArrayToString[float64](vec.GetBytesAt(i)))), nil
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		return parquet.ByteArrayValue([]byte(types.QuantizedArrayToString(typ.Oid, vec.GetBytesAt(i)))), nil
+	case types.T_uuid:
+		return parquet.ByteArrayValue([]byte(vector.GetFixedAtNoTypeCheck[types.Uuid](vec, i).String())), nil
+	case types.T_Rowid:
+		return parquet.ByteArrayValue([]byte(vector.GetFixedAtNoTypeCheck[types.Rowid](vec, i).String())), nil
+	case types.T_Blockid:
+		val := vector.GetFixedAtNoTypeCheck[types.Blockid](vec, i)
+		return parquet.ByteArrayValue([]byte(val.String())), nil
+	default:
+		return parquet.Value{}, moerr.NewNYINoCtxf("export %s to parquet", typ.String())
+	}
+}
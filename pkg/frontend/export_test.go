@@ -19,11 +19,15 @@ import (
 	"context"
 	"testing"
 
+	"github.com/parquet-go/parquet-go"
 	"github.com/prashantv/gostub"
 	"github.com/smartystreets/goconvey/convey"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
@@ -285,3 +289,108 @@ func Test_exportDataToCSVFile(t *testing.T) {
 		convey.So(exportDataFromResultSetToCSVFile(ep), convey.ShouldBeNil)
 	})
 }
+
+func Test_uniqueColumnNames(t *testing.T) {
+	convey.Convey("uniqueColumnNames", t, func() {
+		mrs := &MysqlResultSet{}
+		for _, name := range []string{"a", "b", "a"} {
+			col := new(MysqlColumn)
+			col.SetName(name)
+			mrs.AddColumn(col)
+		}
+		convey.So(uniqueColumnNames(mrs), convey.ShouldResemble, []string{"a", "b", "a_2"})
+	})
+}
+
+func Test_appendJsonValue(t *testing.T) {
+	convey.Convey("appendJsonValue", t, func() {
+		mp := mpool.MustNewZero()
+		ctx := context.TODO()
+
+		buf := &bytes.Buffer{}
+		appendJsonString(buf, []byte("a\"b\\c\n\x01"))
+		convey.So(buf.String(), convey.ShouldEqual, `"a\"b\\c\n\u0001"`)
+
+		d, err := types.ParseDecimal64("-12.34", 10, 2)
+		convey.So(err, convey.ShouldBeNil)
+		vec := vector.NewVec(types.New(types.T_decimal64, 10, 2))
+		convey.So(vector.AppendFixed(vec, d, false, mp), convey.ShouldBeNil)
+		convey.So(vector.AppendFixed(vec, types.Decimal64(0), true, mp), convey.ShouldBeNil)
+		buf.Reset()
+		convey.So(appendJsonValue(ctx, nil, buf, vec, 0), convey.ShouldBeNil)
+		convey.So(buf.String(), convey.ShouldEqual, "-12.34")
+		buf.Reset()
+		convey.So(appendJsonValue(ctx, nil, buf, vec, 1), convey.ShouldBeNil)
+		convey.So(buf.String(), convey.ShouldEqual, "null")
+		vec.Free(mp)
+
+		vec = vector.NewVec(types.T_varchar.ToType())
+		convey.So(vector.AppendBytes(vec, []byte("x\ty"), false, mp), convey.ShouldBeNil)
+		buf.Reset()
+		convey.So(appendJsonValue(ctx, nil, buf, vec, 0), convey.ShouldBeNil)
+		convey.So(buf.String(), convey.ShouldEqual, `"x\ty"`)
+		vec.Free(mp)
+
+		bj, err := types.ParseStringToByteJson(`{"k": [1, 2]}`)
+		convey.So(err, convey.ShouldBeNil)
+		bs, err := types.EncodeJson(bj)
+		convey.So(err, convey.ShouldBeNil)
+		vec = vector.NewVec(types.T_json.ToType())
+		convey.So(vector.AppendBytes(vec, bs, false, mp), convey.ShouldBeNil)
+		buf.Reset()
+		convey.So(appendJsonValue(ctx, nil, buf, vec, 0), convey.ShouldBeNil)
+		convey.So(buf.String(), convey.ShouldEqual, `{"k": [1, 2]}`)
+		vec.Free(mp)
+	})
+}
+
+func Test_exportParquet(t *testing.T) {
+	convey.Convey("export parquet", t, func() {
+		mp := mpool.MustNewZero()
+		ep := &ExportConfig{
+			userConfig: &tree.ExportParam{
+				FilePath:   "test/export.parquet",
+				FileFormat: tree.PARQUET,
+			},
+			colNames: []string{"id", "name", "t"},
+			parquet:  &parquetExporter{},
+		}
+		var out bytes.Buffer
+		stubs := gostub.Stub(&writeDataToCSVFile, func(ep *ExportConfig, output []byte) error {
+			out.Write(output)
+			ep.CurFileSize += uint64(len(output))
+			return nil
+		})
+		defer stubs.Reset()
+
+		bat := batch.NewWithSize(3)
+		bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+		bat.Vecs[1] = vector.NewVec(types.T_varchar.ToType())
+		bat.Vecs[2] = vector.NewVec(types.T_time.ToType())
+		convey.So(vector.AppendFixedList(bat.Vecs[0], []int64{1, 2}, nil, mp), convey.ShouldBeNil)
+		convey.So(vector.AppendBytes(bat.Vecs[1], []byte("a"), false, mp), convey.ShouldBeNil)
+		convey.So(vector.AppendBytes(bat.Vecs[1], nil, true, mp), convey.ShouldBeNil)
+		// times out of the range of a day
+		convey.So(vector.AppendFixedList(bat.Vecs[2], []types.Time{types.TimeFromClock(false, 100, 0, 0, 0), types.TimeFromClock(true, 1, 0, 0, 0)}, nil, mp), convey.ShouldBeNil)
+		bat.SetRowCount(2)
+
+		convey.So(ep.parquet.write(ep, bat), convey.ShouldBeNil)
+		convey.So(ep.parquet.closeFile(ep), convey.ShouldBeNil)
+		bat.Clean(mp)
+
+		f, err := parquet.OpenFile(bytes.NewReader(out.Bytes()), int64(out.Len()))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(f.NumRows(), convey.ShouldEqual, 2)
+		rows := make([]parquet.Row, 2)
+		n, _ := f.RowGroups()[0].Rows().ReadRows(rows)
+		convey.So(n, convey.ShouldEqual, 2)
+		leaf, _ := f.Schema().Lookup("id")
+		convey.So(rows[1][leaf.ColumnIndex].Int64(), convey.ShouldEqual, 2)
+		leaf, _ = f.Schema().Lookup("name")
+		convey.So(string(rows[0][leaf.ColumnIndex].ByteArray()), convey.ShouldEqual, "a")
+		convey.So(rows[1][leaf.ColumnIndex].IsNull(), convey.ShouldBeTrue)
+		leaf, _ = f.Schema().Lookup("t")
+		convey.So(rows[0][leaf.ColumnIndex].Int64(), convey.ShouldEqual, int64(360000_000000))
+		convey.So(rows[1][leaf.ColumnIndex].Int64(), convey.ShouldEqual, int64(-3600_000000))
+	})
+}
@@ -780,7 +780,7 @@ func doShowErrors(ses *Session, execCtx *ExecCtx) error {
 
 	for i := info.length() - 1; i >= 0; i-- {
 		row := make([]interface{}, 3)
-		row[0] = "Error"
+		row[0] = info.levels[i]
 		row[1] = int16(info.codes[i])
 		row[2] = info.msgs[i]
 		mrs.AddRow(row)
@@ -1425,7 +1425,20 @@ func handleDropProcedure(ses FeSession, execCtx *ExecCtx, dp *tree.DropProcedure
 }
 
 func handleCallProcedure(ses FeSession, execCtx *ExecCtx, call *tree.CallStmt) error {
-	results, err := doInterpretCall(execCtx.reqCtx, ses.(*Session), call)
+	var sendResult func(ExecResult) error
+	// the result sets are sent once they are produced, the OK packet after
+	// them ends the response of CALL.
+	if resper, ok := execCtx.resper.(*MysqlResp); ok {
+		sendResult = func(result ExecResult) error {
+			mer := NewMysqlExecutionResult(0, 0, 0, 0, result.(*MysqlResultSet))
+			resp := ses.(*Session).SetNewResponse(ResultResponse, 0, int(COM_QUERY), mer, false)
+			if err := resper.mysqlRrWr.WriteResponse(execCtx.reqCtx, resp); err != nil {
+				return moerr.NewInternalErrorf(execCtx.reqCtx, "routine send response failed. error:%v ", err)
+			}
+			return nil
+		}
+	}
+	results, err := doInterpretCall(execCtx.reqCtx, ses.(*Session), call, sendResult)
 	if err != nil {
 		return err
 	}
@@ -1486,6 +1499,21 @@ func handleSwitchRole(ses FeSession, execCtx *ExecCtx, sr *tree.SetRole) error {
 	return doSwitchRole(execCtx.reqCtx, ses.(*Session), sr)
 }
 
+// handleSavepoint sets a savepoint in the workspace of the txn
+func handleSavepoint(ses FeSession, execCtx *ExecCtx, st *tree.Savepoint) error {
+	return ses.GetTxnHandler().GetTxn().GetWorkspace().Savepoint(execCtx.reqCtx, string(st.Name))
+}
+
+// handleRollbackToSavepoint rolls back the workspace of the txn to the savepoint, the txn is still active
+func handleRollbackToSavepoint(ses FeSession, execCtx *ExecCtx, st *tree.RollbackToSavepoint) error {
+	return ses.GetTxnHandler().GetTxn().GetWorkspace().RollbackToSavepoint(execCtx.reqCtx, string(st.Name))
+}
+
+// handleReleaseSavepoint removes the savepoint from the workspace of the txn
+func handleReleaseSavepoint(ses FeSession, execCtx *ExecCtx, st *tree.ReleaseSavepoint) error {
+	return ses.GetTxnHandler().GetTxn().GetWorkspace().ReleaseSavepoint(execCtx.reqCtx, string(st.Name))
+}
+
 func doKill(ses *Session, execCtx *ExecCtx, k *tree.Kill) error {
 	var err error
 	//true: kill a connection
@@ -1843,9 +1871,9 @@ func getExplainOption(reqCtx context.Context, options []tree.OptionElem) (*expla
 				if strings.EqualFold(v.Value, "TEXT") {
 					es.Format = explain.EXPLAIN_FORMAT_TEXT
 				} else if strings.EqualFold(v.Value, "JSON") {
-					return nil, moerr.NewNotSupportedf(reqCtx, "Unsupport explain format '%s'", v.Value)
+					es.Format = explain.EXPLAIN_FORMAT_JSON
 				} else if strings.EqualFold(v.Value, "DOT") {
-					return nil, moerr.NewNotSupportedf(reqCtx, "Unsupport explain format '%s'", v.Value)
+					es.Format = explain.EXPLAIN_FORMAT_DOT
 				} else {
 					return nil, moerr.NewInvalidInputf(reqCtx, "invalid explain option '%s', valud '%s'", v.Name, v.Value)
 				}
@@ -2509,6 +2537,10 @@ func executeStmtWithWorkspace(ses FeSession,
 	//special BEGIN,COMMIT,ROLLBACK
 	beginStmt := false
 	execCtx.txnOpt.Close()
+	if err = ses.GetTxnHandler().checkXAStatement(execCtx); err != nil {
+		execCtx.txnOpt.byXA = true
+		return err
+	}
 	switch execCtx.stmt.(type) {
 	case *tree.BeginTransaction:
 		execCtx.txnOpt.byBegin = true
@@ -2519,6 +2551,9 @@ func executeStmtWithWorkspace(ses FeSession,
 	case *tree.RollbackTransaction:
 		execCtx.txnOpt.byRollback = true
 		return nil
+	case *tree.XAStart, *tree.XAEnd, *tree.XAPrepare, *tree.XACommit, *tree.XARollback:
+		execCtx.txnOpt.byXA = true
+		return execXAStatement(ses, execCtx)
 	}
 
 	//in session migration, the txn forced to be autocommit.
@@ -3387,7 +3422,8 @@ func convertEngineTypeToMysqlType(ctx context.Context, engineType types.T, col *
 		col.SetColumnType(defines.MYSQL_TYPE_STRING)
 	case types.T_varchar:
 		col.SetColumnType(defines.MYSQL_TYPE_VAR_STRING)
-	case types.T_array_float32, types.T_array_float64:
+	case types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
 	case types.T_datalink:
 		col.SetColumnType(defines.MYSQL_TYPE_TEXT)
@@ -72,6 +72,15 @@ var (
 
 	getPubInfoWithPitrFormat = `select pub_name, database_name, database_id, table_list, account_list, created_time, update_time, owner, creator, comment from mo_catalog.mo_pubs {MO_TS = %d} where account_id = %d and database_name = '%s';`
 
+	// the pitrs that cover a table of the account
+	getPitrOfTableFmt = `%s where level = 'cluster' or (account_id = %d and (level = 'account' or (level = 'database' and database_name = '%s') or (level = 'table' and database_name = '%s' and table_name = '%s')))`
+
+	// the snapshots at the ts that cover a table of the account
+	getSnapshotOfTableFmt = `select snapshot_id from mo_catalog.mo_snapshots where ts = %d and (level = 'cluster' or (account_name = '%s' and (level = 'account' or (level = 'database' and database_name = '%s') or (level = 'table' and database_name = '%s' and table_name = '%s'))))`
+
+	// pitrStringEscaper escapes the string literals in the sqls of pitr
+	pitrStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `''`)
+
 	// update mo_pitr object id
 	updateMoPitrAccountObjectIdFmt = `update mo_catalog.mo_pitr set obj_id = %d, modified_time = '%s' where account_name = '%s';`
 )
@@ -1808,8 +1817,8 @@ func getPitrRecords(ctx context.Context, bh BackgroundExec, sql string) ([]*pitr
 	var records []*pitrRecord
 	if execResultArrayHasData(erArray) {
 		for _, er := range erArray {
-			var record pitrRecord
 			for row := uint64(0); row < er.GetRowCount(); row++ {
+				var record pitrRecord
 				if record.pitrId, err = er.GetString(ctx, row, 0); err != nil {
 					return nil, err
 				}
@@ -1851,8 +1860,8 @@ func getPitrRecords(ctx context.Context, bh BackgroundExec, sql string) ([]*pitr
 				if record.pitrUnit, err = er.GetString(ctx, row, 12); err != nil {
 					return nil, err
 				}
+				records = append(records, &record)
 			}
-			records = append(records, &record)
 		}
 		return records, nil
 	}
@@ -1942,6 +1951,78 @@ func checkPitrInValidDurtion(ts int64, pitrRecord *pitrRecord) (err error) {
 	return
 }
 
+// checkTableChangesRetained checks the changes of the table of the current
+// account since ts are retained, by the gc ttl, a snapshot at ts or a pitr
+// whose range contains ts.
+func checkTableChangesRetained(ctx context.Context, ses FeSession, dbName, tableName string, ts int64) (bool, error) {
+	if time.Now().UTC().UnixNano()-ts <= getPu(ses.GetService()).SV.GCTTL.Duration.Nanoseconds() {
+		return true, nil
+	}
+	if valid, err := checkTimeStampInSnapshot(ctx, ses, dbName, tableName, ts); err != nil || valid {
+		return valid, err
+	}
+	return checkTimeStampInPitr(ctx, ses, dbName, tableName, ts)
+}
+
+// checkTimeStampInSnapshot checks the table of the current account is covered
+// by a snapshot at the ts. The snapshots created by the sys account for the
+// account are checked too.
+func checkTimeStampInSnapshot(ctx context.Context, ses FeSession, dbName, tableName string, ts int64) (bool, error) {
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	ctxs := []context.Context{ctx}
+	if ses.GetAccountId() != sysAccountID {
+		ctxs = append(ctxs, defines.AttachAccountId(ctx, sysAccountID))
+	}
+	sql := fmt.Sprintf(getSnapshotOfTableFmt, ts,
+		pitrStringEscaper.Replace(ses.GetTenantName()),
+		pitrStringEscaper.Replace(dbName),
+		pitrStringEscaper.Replace(dbName),
+		pitrStringEscaper.Replace(tableName))
+	for _, ctx := range ctxs {
+		bh.ClearExecResultSet()
+		if err := bh.Exec(ctx, sql); err != nil {
+			return false, err
+		}
+		erArray, err := getResultSet(ctx, bh)
+		if err != nil {
+			return false, err
+		}
+		if execResultArrayHasData(erArray) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkTimeStampInPitr checks the table of the current account is covered by
+// a pitr whose range contains the ts
+func checkTimeStampInPitr(ctx context.Context, ses FeSession, dbName, tableName string, ts int64) (bool, error) {
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	accountId := ses.GetAccountId()
+	newCtx := ctx
+	if accountId != sysAccountID {
+		newCtx = defines.AttachAccountId(ctx, sysAccountID)
+	}
+	sql := fmt.Sprintf(getPitrOfTableFmt, getPitrFormat, accountId,
+		pitrStringEscaper.Replace(dbName),
+		pitrStringEscaper.Replace(dbName),
+		pitrStringEscaper.Replace(tableName))
+	records, err := getPitrRecords(newCtx, bh, sql)
+	if err != nil {
+		return false, err
+	}
+	for _, record := range records {
+		if checkPitrInValidDurtion(ts, record) == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // get pitr time span
 func addTimeSpan(length int, unit string) (time.Time, error) {
 	now := time.Now().UTC()
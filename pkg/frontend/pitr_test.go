@@ -114,6 +114,57 @@ func Test_checkPitrInValidDurtion(t *testing.T) {
 	})
 }
 
+func Test_checkTableChangesRetained(t *testing.T) {
+	convey.Convey("checkTableChangesRetained", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newTestSession(t, ctrl)
+		defer ses.Close()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		pu.SV.GCTTL.Duration = time.Minute
+		setPu("", pu)
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		ses.SetTenantInfo(&TenantInfo{
+			Tenant:        "acc'1",
+			User:          rootName,
+			DefaultRole:   moAdminRoleName,
+			TenantID:      1,
+			UserID:        rootID,
+			DefaultRoleID: moAdminRoleID,
+		})
+
+		// the changes are retained by the gc ttl
+		valid, err := checkTableChangesRetained(ctx, ses, "d'b", "t", time.Now().UnixNano())
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(valid, convey.ShouldBeTrue)
+
+		ts := time.Now().Add(-time.Hour).UnixNano()
+		snapshotSql := fmt.Sprintf(getSnapshotOfTableFmt, ts, "acc''1", "d''b", "d''b", "t")
+		pitrSql := fmt.Sprintf(getPitrOfTableFmt, getPitrFormat, ses.GetAccountId(), "d''b", "d''b", "t")
+		bh.sql2result[snapshotSql] = newMrsForSqlForShowDatabases([][]interface{}{})
+		bh.sql2result[pitrSql] = newMrsForSqlForShowDatabases([][]interface{}{})
+		valid, err = checkTableChangesRetained(ctx, ses, "d'b", "t", ts)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(valid, convey.ShouldBeFalse)
+
+		// the changes are retained by a snapshot at the ts
+		bh.sql2result[snapshotSql] = newMrsForSqlForShowDatabases([][]interface{}{{"snapshot_id"}})
+		valid, err = checkTableChangesRetained(ctx, ses, "d'b", "t", ts)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(valid, convey.ShouldBeTrue)
+	})
+}
+
 func Test_createPubByPitr(t *testing.T) {
 	convey.Convey("createPubByPitr success", t, func() {
 		ctrl := gomock.NewController(t)
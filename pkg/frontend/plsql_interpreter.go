@@ -16,6 +16,7 @@ package frontend
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -38,15 +39,78 @@ const (
 )
 
 type Interpreter struct {
-	ctx         context.Context
-	ses         *Session
-	bh          BackgroundExec
-	varScope    *[]map[string]interface{}
-	fmtctx      *tree.FmtCtx
-	result      []ExecResult
-	argsAttr    map[string]tree.InOutArgType // used for IN, OUT, IN/OUT check
-	argsMap     map[string]tree.Expr         // used for argument to parameter mapping
-	outParamMap map[string]interface{}       // used for storing and updating OUT type arg
+	ctx      context.Context
+	ses      *Session
+	bh       BackgroundExec
+	varScope *[]map[string]interface{}
+	fmtctx   *tree.FmtCtx
+	result   []ExecResult
+	argsAttr map[string]tree.InOutArgType // used for IN, OUT, IN/OUT check
+	argsMap  map[string]tree.Expr         // used for argument to parameter mapping
+	// sendResult sends the result set of a statement to the client as soon as
+	// it is produced. The result sets are kept in result if it is nil.
+	sendResult func(ExecResult) error
+	// blocks are the BEGIN ... END blocks being executed, the first one is
+	// the procedure itself.
+	blocks []*spBlock
+	// handlers are the condition handlers being executed.
+	handlers []*spActiveHandler
+}
+
+// spBlock holds the cursors, conditions and handlers declared in a block.
+type spBlock struct {
+	cursors    map[string]*spCursor
+	conditions map[string]*tree.ConditionValue
+	handlers   []*spHandler
+}
+
+func newSpBlock() *spBlock {
+	return &spBlock{
+		cursors:    make(map[string]*spCursor),
+		conditions: make(map[string]*tree.ConditionValue),
+	}
+}
+
+type spCursor struct {
+	stmt *tree.DeclareCursor
+	// result is nil if the cursor is not open
+	result *MysqlResultSet
+	row    uint64
+}
+
+// spHandler is a declared handler whose named conditions are resolved.
+type spHandler struct {
+	action     tree.HandlerAction
+	conditions []*tree.ConditionValue
+	body       tree.Statement
+}
+
+// spActiveHandler is a handler being executed for err. The handlers of the
+// blocks in [from, to) are not visible until it finishes.
+type spActiveHandler struct {
+	from int
+	to   int
+	err  error
+}
+
+// spExitError leaves the statements up to the block declaring the EXIT
+// handler.
+type spExitError struct {
+	block *spBlock
+}
+
+func (e *spExitError) Error() string {
+	return "exit handler"
+}
+
+// spUnhandledError is an error without handler, it goes through the
+// statements up to the procedure without being handled again.
+type spUnhandledError struct {
+	err error
+}
+
+func (e *spUnhandledError) Error() string {
+	return e.err.Error()
 }
 
 func (interpreter *Interpreter) GetResult() []ExecResult {
@@ -85,38 +149,21 @@ func (interpreter *Interpreter) SetSpVar(name string, value interface{}) error {
 			return nil
 		}
 	}
-	// loop up OUT param and SET in-place
-	if _, ok := interpreter.outParamMap[name]; ok {
-		// save at local
-		interpreter.outParamMap[name] = value
-		return nil
-	}
 	return moerr.NewNotSupported(interpreter.ctx, fmt.Sprintf("variable %s has to be declared using DECLARE.", name))
 }
 
 func (interpreter *Interpreter) FlushParam() error {
-	for k, v := range (*interpreter.varScope)[0] {
-		if _, ok := interpreter.argsMap[k]; ok && interpreter.argsAttr[k] == tree.TYPE_INOUT {
-			// save INOUT at session
-			interpreter.bh.ClearExecResultSet()
-			// system setvar execution
-			err := interpreter.ses.SetUserDefinedVar(interpreter.argsMap[k].(*tree.VarExpr).Name, v, "")
-			if err != nil {
-				return err
-			}
+	for k, arg := range interpreter.argsMap {
+		if interpreter.argsAttr[k] != tree.TYPE_INOUT && interpreter.argsAttr[k] != tree.TYPE_OUT {
+			continue
 		}
-	}
-
-	for k, v := range interpreter.outParamMap {
-		// save at session
-		interpreter.bh.ClearExecResultSet()
-		// system setvar execution
-		err := interpreter.ses.SetUserDefinedVar(interpreter.argsMap[k].(*tree.VarExpr).Name, v, "")
+		// save INOUT and OUT at session
+		v := (*interpreter.varScope)[0][strings.ToLower(k)]
+		err := interpreter.ses.SetUserDefinedVar(arg.(*tree.VarExpr).Name, v, "")
 		if err != nil {
 			return err
 		}
 	}
-
 	return nil
 }
 
@@ -125,6 +172,11 @@ func (interpreter *Interpreter) GetSimpleExprValueWithSpVar(e tree.Expr) (interf
 	if err != nil {
 		return nil, err
 	}
+	if newExpr == nil {
+		// the expression can not be rewritten locally, let the binder resolve
+		// the sp variables in it
+		return interpreter.evalExpr(e)
+	}
 	retStmt, err := parsers.ParseOne(interpreter.ctx, dialect.MYSQL, "select "+interpreter.GetExprString(newExpr), 1)
 	if err != nil {
 		return nil, err
@@ -186,6 +238,14 @@ func (interpreter *Interpreter) MatchExpr(expr tree.Expr) (tree.Expr, error) {
 		if err != nil {
 			return nil, err
 		}
+		switch v := val.(type) {
+		case nil:
+			return tree.NewNumVal("null", "null", false, tree.P_null), nil
+		case string:
+			return tree.NewNumVal(v, v, false, tree.P_char), nil
+		case []byte:
+			return tree.NewNumVal(string(v), string(v), false, tree.P_char), nil
+		}
 		retName := &tree.UnresolvedName{
 			NumParts:  e.NumParts,
 			Star:      e.Star,
@@ -199,6 +259,22 @@ func (interpreter *Interpreter) MatchExpr(expr tree.Expr) (tree.Expr, error) {
 	return nil, nil
 }
 
+// evalExpr evaluates the expression by sending it to bh with a select.
+func (interpreter *Interpreter) evalExpr(e tree.Expr) (interface{}, error) {
+	erArray, err := interpreter.execSql("select " + interpreter.GetExprString(e))
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+	mrs, ok := erArray[0].(*MysqlResultSet)
+	if !ok {
+		return nil, moerr.NewInternalError(interpreter.ctx, "unexpected result set of sp expression")
+	}
+	return mrs.GetValue(interpreter.ctx, 0, 0)
+}
+
 // Evaluate condition by sending it to bh with a select
 func (interpreter *Interpreter) EvalCond(cond string) (int, error) {
 	interpreter.bh.ClearExecResultSet()
@@ -244,12 +320,13 @@ func (interpreter *Interpreter) ExecuteSp(stmt tree.Statement, dbName string) (e
 
 	// save parameters as local variables
 	*interpreter.varScope = append(*interpreter.varScope, curScope)
+	interpreter.blocks = append(interpreter.blocks, newSpBlock())
 	for k, v := range interpreter.argsMap {
 		var value interface{}
 		if varParam, ok := v.(*tree.VarExpr); ok {
-			// For OUT type, store it in a separate map only for SET to update it and flush at the end
+			// OUT type starts with NULL, it is flushed to the session at the end
 			if interpreter.argsAttr[k] == tree.TYPE_OUT {
-				interpreter.outParamMap[k] = 0
+				(*interpreter.varScope)[len(*interpreter.varScope)-1][strings.ToLower(k)] = nil
 			} else { // For INOUT and IN type, fetch store its previous value
 				interpreter.bh.ClearExecResultSet()
 				value, _ := interpreter.ses.GetUserDefinedVar(varParam.Name)
@@ -276,7 +353,12 @@ func (interpreter *Interpreter) ExecuteSp(stmt tree.Statement, dbName string) (e
 	}
 
 	_, err = interpreter.interpret(stmt)
-
+	switch e := err.(type) {
+	case *spUnhandledError:
+		return e.err
+	case *spExitError:
+		err = nil
+	}
 	if err != nil {
 		return err
 	}
@@ -300,7 +382,244 @@ func (interpreter *Interpreter) ExecuteSp(stmt tree.Statement, dbName string) (e
 	return nil
 }
 
+// interpret executes the statement and handles the condition it raises.
 func (interpreter *Interpreter) interpret(stmt tree.Statement) (SpStatus, error) {
+	status, err := interpreter.interpretStmt(stmt)
+	if err != nil {
+		return interpreter.handleError(err)
+	}
+	return status, nil
+}
+
+// handleError executes the handler of the condition raised by a statement.
+// A CONTINUE handler resumes at the statement after the raising one, an EXIT
+// handler leaves the block declaring it.
+func (interpreter *Interpreter) handleError(err error) (SpStatus, error) {
+	switch err.(type) {
+	case *spExitError, *spUnhandledError:
+		return SpNotOk, err
+	}
+
+	sqlState, errno := getErrorCondition(err)
+	level, handler := interpreter.findHandler(sqlState, errno)
+	if handler == nil {
+		// the unhandled warning does not stop the procedure, it is raised to
+		// the client as a warning instead
+		if strings.HasPrefix(sqlState, "01") {
+			interpreter.ses.GetErrInfo().pushWarning(errno, err.Error())
+			return SpOk, nil
+		}
+		return SpNotOk, &spUnhandledError{err: err}
+	}
+
+	interpreter.handlers = append(interpreter.handlers, &spActiveHandler{
+		from: level,
+		to:   len(interpreter.blocks),
+		err:  err,
+	})
+	_, err = interpreter.interpret(handler.body)
+	interpreter.handlers = interpreter.handlers[:len(interpreter.handlers)-1]
+	if err != nil {
+		return SpNotOk, err
+	}
+	if handler.action == tree.HANDLER_EXIT {
+		return SpNotOk, &spExitError{block: interpreter.blocks[level]}
+	}
+	return SpOk, nil
+}
+
+// findHandler returns the handler in the innermost visible block for the
+// condition. In a block, the handler for the error code wins the one for the
+// SQLSTATE, which wins the ones for SQLWARNING, NOT FOUND and SQLEXCEPTION.
+func (interpreter *Interpreter) findHandler(sqlState string, errno uint16) (int, *spHandler) {
+	for i := len(interpreter.blocks) - 1; i >= 0; i-- {
+		hidden := false
+		for _, h := range interpreter.handlers {
+			if i >= h.from && i < h.to {
+				hidden = true
+				break
+			}
+		}
+		if hidden {
+			continue
+		}
+
+		var found *spHandler
+		best := 0
+		for _, h := range interpreter.blocks[i].handlers {
+			for _, c := range h.conditions {
+				if p := matchCondition(c, sqlState, errno); p > best {
+					found, best = h, p
+				}
+			}
+		}
+		if found != nil {
+			return i, found
+		}
+	}
+	return -1, nil
+}
+
+// matchCondition returns the priority of the condition if it matches.
+func matchCondition(c *tree.ConditionValue, sqlState string, errno uint16) int {
+	switch c.Type {
+	case tree.CONDITION_ERRNO:
+		if c.Errno == errno {
+			return 3
+		}
+	case tree.CONDITION_SQLSTATE:
+		if c.SqlState == sqlState {
+			return 2
+		}
+	case tree.CONDITION_SQLWARNING:
+		if strings.HasPrefix(sqlState, "01") {
+			return 1
+		}
+	case tree.CONDITION_NOT_FOUND:
+		if strings.HasPrefix(sqlState, "02") {
+			return 1
+		}
+	case tree.CONDITION_SQLEXCEPTION:
+		if !strings.HasPrefix(sqlState, "00") &&
+			!strings.HasPrefix(sqlState, "01") &&
+			!strings.HasPrefix(sqlState, "02") {
+			return 1
+		}
+	}
+	return 0
+}
+
+// getErrorCondition returns the SQLSTATE and the error code the client
+// receives for the error.
+func getErrorCondition(err error) (string, uint16) {
+	var me *moerr.Error
+	if !errors.As(err, &me) {
+		return moerr.MySQLDefaultSqlState, moerr.ER_UNKNOWN_ERROR
+	}
+	if me.MySQLCode() != moerr.ER_UNKNOWN_ERROR {
+		return me.SqlState(), me.MySQLCode()
+	}
+	return me.SqlState(), me.ErrorCode()
+}
+
+func (interpreter *Interpreter) currentBlock() *spBlock {
+	return interpreter.blocks[len(interpreter.blocks)-1]
+}
+
+func (interpreter *Interpreter) getCursor(name tree.Identifier) (*spCursor, error) {
+	key := strings.ToLower(string(name))
+	for i := len(interpreter.blocks) - 1; i >= 0; i-- {
+		if cur, ok := interpreter.blocks[i].cursors[key]; ok {
+			return cur, nil
+		}
+	}
+	return nil, moerr.NewSPCursorMismatch(interpreter.ctx, string(name))
+}
+
+// getCondition resolves the named condition.
+func (interpreter *Interpreter) getCondition(c *tree.ConditionValue) (*tree.ConditionValue, error) {
+	if c.Type != tree.CONDITION_NAME {
+		return c, nil
+	}
+	key := strings.ToLower(string(c.Name))
+	for i := len(interpreter.blocks) - 1; i >= 0; i-- {
+		if cond, ok := interpreter.blocks[i].conditions[key]; ok {
+			return cond, nil
+		}
+	}
+	return nil, moerr.NewSPCondMismatch(interpreter.ctx, string(c.Name))
+}
+
+// checkSqlState checks the SQLSTATE is five characters and is not the
+// success class.
+func checkSqlState(ctx context.Context, sqlState string) error {
+	if len(sqlState) != 5 || strings.HasPrefix(sqlState, "00") {
+		return moerr.NewSPBadSQLState(ctx, sqlState)
+	}
+	for _, c := range sqlState {
+		if (c < '0' || c > '9') && (c < 'A' || c > 'Z') {
+			return moerr.NewSPBadSQLState(ctx, sqlState)
+		}
+	}
+	return nil
+}
+
+// signal builds the error raised by SIGNAL and RESIGNAL.
+func (interpreter *Interpreter) signal(st *tree.Signal) error {
+	var (
+		sqlState string
+		errno    uint16
+		msg      string
+	)
+	if st.Resignal {
+		if len(interpreter.handlers) == 0 {
+			return moerr.NewResignalWithoutHandler(interpreter.ctx)
+		}
+		raised := interpreter.handlers[len(interpreter.handlers)-1].err
+		if st.Condition == nil && len(st.Infos) == 0 {
+			return raised
+		}
+		sqlState, errno = getErrorCondition(raised)
+		msg = raised.Error()
+	}
+
+	if st.Condition != nil {
+		cond, err := interpreter.getCondition(st.Condition)
+		if err != nil {
+			return err
+		}
+		if cond.Type != tree.CONDITION_SQLSTATE {
+			return moerr.NewSignalBadConditionType(interpreter.ctx)
+		}
+		if err = checkSqlState(interpreter.ctx, cond.SqlState); err != nil {
+			return err
+		}
+		sqlState = cond.SqlState
+		switch {
+		case strings.HasPrefix(sqlState, "01"):
+			errno = moerr.ER_SIGNAL_WARN
+		case strings.HasPrefix(sqlState, "02"):
+			errno = moerr.ER_SIGNAL_NOT_FOUND
+		default:
+			errno = moerr.ER_SIGNAL_EXCEPTION
+		}
+		msg = moerr.MysqlErrorMsgRefer[errno].ErrorMsgOrFormat
+	}
+
+	for _, info := range st.Infos {
+		value, err := interpreter.GetSimpleExprValueWithSpVar(info.Value)
+		if err != nil {
+			return err
+		}
+		switch info.Item {
+		case tree.SIGNAL_MESSAGE_TEXT:
+			msg = fmt.Sprintf("%v", value)
+		case tree.SIGNAL_MYSQL_ERRNO:
+			n, err := strconv.ParseUint(fmt.Sprintf("%v", value), 10, 16)
+			if err != nil || n == 0 {
+				return moerr.NewInvalidArg(interpreter.ctx, "mysql_errno", value)
+			}
+			errno = uint16(n)
+		}
+	}
+	return moerr.NewSignal(interpreter.ctx, sqlState, errno, msg)
+}
+
+// execSql executes the sql in the procedure, whose variables are resolved
+// by the binder.
+func (interpreter *Interpreter) execSql(sql string) ([]ExecResult, error) {
+	interpreter.bh.ClearExecResultSet()
+	// For sp variable replacement
+	interpreter.ctx = context.WithValue(interpreter.ctx, defines.VarScopeKey{}, interpreter.varScope)
+	interpreter.ctx = context.WithValue(interpreter.ctx, defines.InSp{}, true)
+	err := interpreter.bh.Exec(interpreter.ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	return getResultSet(interpreter.ctx, interpreter.bh)
+}
+
+func (interpreter *Interpreter) interpretStmt(stmt tree.Statement) (SpStatus, error) {
 	if stmt == nil {
 		return SpOk, nil
 	}
@@ -309,25 +628,43 @@ func (interpreter *Interpreter) interpret(stmt tree.Statement) (SpStatus, error)
 		// create new variable scope and push it
 		curScope := make(map[string]interface{})
 		*interpreter.varScope = append(*interpreter.varScope, curScope)
+		block := newSpBlock()
+		interpreter.blocks = append(interpreter.blocks, block)
 		interpreter.ses.Info(interpreter.ctx, "current scope level: "+strconv.Itoa(len(*interpreter.varScope)))
+		defer func() {
+			// pop current scope
+			*interpreter.varScope = (*interpreter.varScope)[:len(*interpreter.varScope)-1]
+			interpreter.blocks = interpreter.blocks[:len(interpreter.blocks)-1]
+		}()
 		// recursively execute
 		for _, innerSt := range st.Stmts {
-			_, err := interpreter.interpret(innerSt)
+			status, err := interpreter.interpret(innerSt)
 			if err != nil {
+				if e, ok := err.(*spExitError); ok && e.block == block {
+					return SpOk, nil
+				}
 				return SpNotOk, err
 			}
+			if status == SpLeaveLoop || status == SpIterLoop {
+				return status, nil
+			}
 		}
-		// pop current scope
-		*interpreter.varScope = (*interpreter.varScope)[:len(*interpreter.varScope)-1]
 		return SpOk, nil
 	case *tree.RepeatStmt:
+	repeat:
 		for {
 			// first execute body
 			for _, stmt := range st.Body {
-				_, err := interpreter.interpret(stmt)
+				status, err := interpreter.interpret(stmt)
 				if err != nil {
 					return SpNotOk, err
 				}
+				if status == SpLeaveLoop {
+					break repeat
+				}
+				if status == SpIterLoop {
+					continue repeat
+				}
 			}
 			// then evaluate condition
 			condStr := interpreter.GetExprString(st.Cond)
@@ -340,6 +677,7 @@ func (interpreter *Interpreter) interpret(stmt tree.Statement) (SpStatus, error)
 			}
 		}
 	case *tree.WhileStmt:
+	while:
 		for {
 			// first evaluate
 			condStr := interpreter.GetExprString(st.Cond)
@@ -352,10 +690,16 @@ func (interpreter *Interpreter) interpret(stmt tree.Statement) (SpStatus, error)
 			}
 			// then execute body
 			for _, stmt := range st.Body {
-				_, err := interpreter.interpret(stmt)
+				status, err := interpreter.interpret(stmt)
 				if err != nil {
 					return SpNotOk, err
 				}
+				if status == SpLeaveLoop {
+					break while
+				}
+				if status == SpIterLoop {
+					continue while
+				}
 			}
 		}
 	case *tree.LoopStmt:
@@ -456,10 +800,13 @@ func (interpreter *Interpreter) interpret(stmt tree.Statement) (SpStatus, error)
 		// any whenstmt that comes here will get executed, as we've already evaluated the condition in casestmt
 		for _, stmt := range st.Body {
 			// we use this branch
-			_, err := interpreter.interpret(stmt)
+			status, err := interpreter.interpret(stmt)
 			if err != nil {
 				return SpNotOk, err
 			}
+			if status == SpIterLoop || status == SpLeaveLoop {
+				return status, nil
+			}
 		}
 	case *tree.CaseStmt:
 		// match case expression with all of its whens
@@ -476,20 +823,19 @@ func (interpreter *Interpreter) interpret(stmt tree.Statement) (SpStatus, error)
 			}
 			if condVal == 1 {
 				// we use this branch
-				_, err := interpreter.interpret(whenStmt)
-				if err != nil {
-					return SpNotOk, err
-				}
-				return SpOk, nil
+				return interpreter.interpret(whenStmt)
 			}
 		}
 
 		// none of the WHEN branch hit, we execute ELSE
 		for _, stmt := range st.Else {
-			_, err := interpreter.interpret(stmt)
+			status, err := interpreter.interpret(stmt)
 			if err != nil {
 				return SpNotOk, err
 			}
+			if status == SpIterLoop || status == SpLeaveLoop {
+				return status, nil
+			}
 		}
 		return SpOk, nil
 	case *tree.Declare:
@@ -535,22 +881,108 @@ func (interpreter *Interpreter) interpret(stmt tree.Statement) (SpStatus, error)
 				}
 			}
 		}
-	default: // normal sql. Since we don't support SELECT INTO for now, we don't have to worry about updating variables
-		str := interpreter.GetStatementString(st)
-		interpreter.bh.ClearExecResultSet()
-		// For sp variable replacement
-		interpreter.ctx = context.WithValue(interpreter.ctx, defines.VarScopeKey{}, interpreter.varScope)
-		interpreter.ctx = context.WithValue(interpreter.ctx, defines.InSp{}, true)
-		err := interpreter.bh.Exec(interpreter.ctx, str)
+	case *tree.DeclareCursor:
+		block := interpreter.currentBlock()
+		block.cursors[strings.ToLower(string(st.Name))] = &spCursor{stmt: st}
+	case *tree.DeclareCondition:
+		if st.Value.Type == tree.CONDITION_SQLSTATE {
+			if err := checkSqlState(interpreter.ctx, st.Value.SqlState); err != nil {
+				return SpNotOk, err
+			}
+		}
+		block := interpreter.currentBlock()
+		block.conditions[strings.ToLower(string(st.Name))] = st.Value
+	case *tree.DeclareHandler:
+		handler := &spHandler{
+			action: st.Action,
+			body:   st.Body,
+		}
+		for _, c := range st.Conditions {
+			cond, err := interpreter.getCondition(c)
+			if err != nil {
+				return SpNotOk, err
+			}
+			if cond.Type == tree.CONDITION_SQLSTATE {
+				if err = checkSqlState(interpreter.ctx, cond.SqlState); err != nil {
+					return SpNotOk, err
+				}
+			}
+			handler.conditions = append(handler.conditions, cond)
+		}
+		block := interpreter.currentBlock()
+		block.handlers = append(block.handlers, handler)
+	case *tree.OpenCursor:
+		cur, err := interpreter.getCursor(st.Name)
 		if err != nil {
 			return SpNotOk, err
 		}
-		erArray, err := getResultSet(interpreter.ctx, interpreter.bh)
+		if cur.result != nil {
+			return SpNotOk, moerr.NewSPCursorAlreadyOpen(interpreter.ctx)
+		}
+		// the rows of the cursor are read when it is opened
+		erArray, err := interpreter.execSql(interpreter.GetStatementString(cur.stmt.Select))
 		if err != nil {
 			return SpNotOk, err
 		}
-		if execResultArrayHasData(erArray) {
-			interpreter.result = append(interpreter.result, erArray[0])
+		cur.result = &MysqlResultSet{}
+		if len(erArray) > 0 {
+			if mrs, ok := erArray[0].(*MysqlResultSet); ok {
+				cur.result = mrs
+			}
+		}
+		cur.row = 0
+	case *tree.FetchCursor:
+		cur, err := interpreter.getCursor(st.Name)
+		if err != nil {
+			return SpNotOk, err
+		}
+		if cur.result == nil {
+			return SpNotOk, moerr.NewSPCursorNotOpen(interpreter.ctx)
+		}
+		if cur.row >= cur.result.GetRowCount() {
+			return SpNotOk, moerr.NewSPFetchNoData(interpreter.ctx)
+		}
+		if cur.result.GetColumnCount() != uint64(len(st.Variables)) {
+			return SpNotOk, moerr.NewSPWrongNoOfFetchArgs(interpreter.ctx)
+		}
+		for i, name := range st.Variables {
+			value, err := cur.result.GetValue(interpreter.ctx, cur.row, uint64(i))
+			if err != nil {
+				return SpNotOk, err
+			}
+			if err = interpreter.SetSpVar(name, value); err != nil {
+				return SpNotOk, err
+			}
+		}
+		cur.row++
+	case *tree.CloseCursor:
+		cur, err := interpreter.getCursor(st.Name)
+		if err != nil {
+			return SpNotOk, err
+		}
+		if cur.result == nil {
+			return SpNotOk, moerr.NewSPCursorNotOpen(interpreter.ctx)
+		}
+		cur.result = nil
+	case *tree.Signal:
+		return SpNotOk, interpreter.signal(st)
+	default: // normal sql. Since we don't support SELECT INTO for now, we don't have to worry about updating variables
+		erArray, err := interpreter.execSql(interpreter.GetStatementString(st))
+		if err != nil {
+			return SpNotOk, err
+		}
+		// the statement returning rows has a result set even if it is empty
+		if len(erArray) > 0 {
+			if mrs, ok := erArray[0].(*MysqlResultSet); ok && mrs.GetColumnCount() == 0 {
+				return SpOk, nil
+			}
+			if interpreter.sendResult != nil {
+				if err = interpreter.sendResult(erArray[0]); err != nil {
+					return SpNotOk, err
+				}
+			} else {
+				interpreter.result = append(interpreter.result, erArray[0])
+			}
 		}
 		return SpOk, nil
 	}
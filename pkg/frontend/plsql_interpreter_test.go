@@ -0,0 +1,108 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func Test_matchCondition(t *testing.T) {
+	cases := []struct {
+		cond     tree.ConditionValue
+		sqlState string
+		errno    uint16
+		want     int
+	}{
+		{tree.ConditionValue{Type: tree.CONDITION_ERRNO, Errno: 1062}, "23000", 1062, 3},
+		{tree.ConditionValue{Type: tree.CONDITION_ERRNO, Errno: 1062}, "23000", 1048, 0},
+		{tree.ConditionValue{Type: tree.CONDITION_SQLSTATE, SqlState: "23000"}, "23000", 1062, 2},
+		{tree.ConditionValue{Type: tree.CONDITION_SQLSTATE, SqlState: "42S02"}, "23000", 1062, 0},
+		{tree.ConditionValue{Type: tree.CONDITION_SQLWARNING}, "01000", 1265, 1},
+		{tree.ConditionValue{Type: tree.CONDITION_SQLWARNING}, "23000", 1062, 0},
+		{tree.ConditionValue{Type: tree.CONDITION_NOT_FOUND}, "02000", 1329, 1},
+		{tree.ConditionValue{Type: tree.CONDITION_NOT_FOUND}, "23000", 1062, 0},
+		{tree.ConditionValue{Type: tree.CONDITION_SQLEXCEPTION}, "23000", 1062, 1},
+		{tree.ConditionValue{Type: tree.CONDITION_SQLEXCEPTION}, "02000", 1329, 0},
+		{tree.ConditionValue{Type: tree.CONDITION_SQLEXCEPTION}, "01000", 1265, 0},
+	}
+	for i, c := range cases {
+		assert.Equal(t, c.want, matchCondition(&c.cond, c.sqlState, c.errno), "case %d", i)
+	}
+}
+
+func Test_findHandler(t *testing.T) {
+	byClass := &spHandler{conditions: []*tree.ConditionValue{{Type: tree.CONDITION_SQLEXCEPTION}}}
+	byState := &spHandler{conditions: []*tree.ConditionValue{{Type: tree.CONDITION_SQLSTATE, SqlState: "23000"}}}
+	byErrno := &spHandler{conditions: []*tree.ConditionValue{{Type: tree.CONDITION_ERRNO, Errno: 1062}}}
+	notFound := &spHandler{conditions: []*tree.ConditionValue{{Type: tree.CONDITION_NOT_FOUND}}}
+
+	outer, inner := newSpBlock(), newSpBlock()
+	outer.handlers = []*spHandler{byClass, byErrno, notFound}
+	inner.handlers = []*spHandler{byClass, byState}
+	interpreter := &Interpreter{blocks: []*spBlock{outer, inner}}
+
+	// the innermost block wins even if the outer one has a more specific handler
+	level, h := interpreter.findHandler("23000", 1062)
+	assert.Equal(t, 1, level)
+	assert.Same(t, byState, h)
+
+	level, h = interpreter.findHandler("02000", 1329)
+	assert.Equal(t, 0, level)
+	assert.Same(t, notFound, h)
+
+	// the handlers of the blocks the running handler is declared in are not visible
+	interpreter.handlers = []*spActiveHandler{{from: 1, to: 2}}
+	level, h = interpreter.findHandler("23000", 1062)
+	assert.Equal(t, 0, level)
+	assert.Same(t, byErrno, h)
+
+	interpreter.handlers = []*spActiveHandler{{from: 0, to: 2}}
+	level, h = interpreter.findHandler("23000", 1062)
+	assert.Equal(t, -1, level)
+	assert.Nil(t, h)
+}
+
+func Test_getErrorCondition(t *testing.T) {
+	ctx := context.Background()
+
+	sqlState, errno := getErrorCondition(moerr.NewSPFetchNoData(ctx))
+	assert.Equal(t, "02000", sqlState)
+	assert.Equal(t, uint16(1329), errno)
+
+	sqlState, errno = getErrorCondition(moerr.NewSignal(ctx, "45001", 5001, "boom"))
+	assert.Equal(t, "45001", sqlState)
+	assert.Equal(t, uint16(5001), errno)
+
+	sqlState, errno = getErrorCondition(errors.New("boom"))
+	assert.Equal(t, moerr.MySQLDefaultSqlState, sqlState)
+	assert.Equal(t, uint16(moerr.ER_UNKNOWN_ERROR), errno)
+}
+
+func Test_checkSqlState(t *testing.T) {
+	ctx := context.Background()
+	require.NoError(t, checkSqlState(ctx, "45000"))
+	require.NoError(t, checkSqlState(ctx, "42S02"))
+	require.Error(t, checkSqlState(ctx, "00000"))
+	require.Error(t, checkSqlState(ctx, "4500"))
+	require.Error(t, checkSqlState(ctx, "45x00"))
+}
@@ -265,6 +265,46 @@ var (
     			primary key(account_id,task_id,table_id)
 			)`
 
+	MoCatalogMoEventsDDL = `create table mo_catalog.mo_events (
+    			event_id bigint unsigned auto_increment,
+    			event_name varchar(64) not null,
+    			db_name varchar(5000) not null,
+    			definer varchar(300),
+    			definer_user_id int unsigned,
+    			definer_role_id int unsigned,
+    			body text,
+    			event_type varchar(9),
+    			execute_at datetime,
+    			interval_value varchar(256),
+    			interval_field varchar(18),
+    			starts datetime,
+    			ends datetime,
+    			status varchar(21),
+    			on_completion varchar(12),
+    			task_id varchar(128),
+    			last_executed datetime,
+    			created_time timestamp,
+    			altered_time timestamp,
+    			comment text,
+    			primary key(event_id),
+    			unique key(db_name, event_name)
+			)`
+
+	MoCatalogMoEventRunsDDL = `create table mo_catalog.mo_event_runs (
+    			run_id bigint unsigned auto_increment,
+    			event_id bigint unsigned,
+    			db_name varchar(5000),
+    			event_name varchar(64),
+    			task_id varchar(128),
+    			start_time datetime(6),
+    			end_time datetime(6),
+    			status varchar(16),
+    			error text,
+    			primary key(run_id)
+			)`
+
+	MoCatalogMoEventHistoryDDL = `CREATE VIEW mo_catalog.mo_event_history AS SELECT db_name AS event_schema, event_name, task_id, start_time, end_time, status, error FROM mo_catalog.mo_event_runs`
+
 	MoCatalogMoSessionsDDL       = `CREATE VIEW mo_catalog.mo_sessions AS SELECT node_id, conn_id, session_id, account, user, host, db, session_start, command, info, txn_id, statement_id, statement_type, query_type, sql_source_type, query_start, client_host, role, proxy_host FROM mo_sessions() AS mo_sessions_tmp`
 	MoCatalogMoConfigurationsDDL = `CREATE VIEW mo_catalog.mo_configurations AS SELECT node_type, node_id, name, current_value, default_value, internal FROM mo_configurations() AS mo_configurations_tmp`
 	MoCatalogMoLocksDDL          = `CREATE VIEW mo_catalog.mo_locks AS SELECT cn_id, txn_id, table_id, lock_key, lock_content, lock_mode, lock_status, lock_wait FROM mo_locks() AS mo_locks_tmp`
@@ -32,6 +32,23 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (stats statistic.StatsArray,
 		RecordStatementTxnID(execCtx.reqCtx, ses)
 	case *tree.CommitTransaction:
 	case *tree.RollbackTransaction:
+	case *tree.Savepoint:
+		if err = handleSavepoint(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.RollbackToSavepoint:
+		if err = handleRollbackToSavepoint(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.ReleaseSavepoint:
+		if err = handleReleaseSavepoint(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.XAStart, *tree.XAEnd, *tree.XAPrepare, *tree.XACommit, *tree.XARollback:
+	case *tree.XARecover:
+		if err = handleXARecover(ses, execCtx, st); err != nil {
+			return
+		}
 	case *tree.SetRole:
 		ses.EnterFPrint(FPSetRole)
 		defer ses.ExitFPrint(FPSetRole)
@@ -495,6 +512,24 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (stats statistic.StatsArray,
 		if err = handleShowCdc(ses, execCtx, st); err != nil {
 			return
 		}
+	case *tree.CreateEvent:
+		ses.EnterFPrint(FPCreateEvent)
+		defer ses.ExitFPrint(FPCreateEvent)
+		if err = handleCreateEvent(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.AlterEvent:
+		ses.EnterFPrint(FPAlterEvent)
+		defer ses.ExitFPrint(FPAlterEvent)
+		if err = handleAlterEvent(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.DropEvent:
+		ses.EnterFPrint(FPDropEvent)
+		defer ses.ExitFPrint(FPDropEvent)
+		if err = handleDropEvent(ses, execCtx, st); err != nil {
+			return
+		}
 	case *tree.ShowLogserviceReplicas:
 		if err = handleShowLogserviceReplicas(execCtx, ses); err != nil {
 			return
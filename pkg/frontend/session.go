@@ -497,16 +497,28 @@ func (ses *Session) getQueryId(internalSql bool) []string {
 }
 
 type errInfo struct {
+	levels []string
 	codes  []uint16
 	msgs   []string
 	maxCnt int
 }
 
 func (e *errInfo) push(code uint16, msg string) {
+	e.pushWithLevel("Error", code, msg)
+}
+
+// pushWarning records a condition that does not stop the statement.
+func (e *errInfo) pushWarning(code uint16, msg string) {
+	e.pushWithLevel("Warning", code, msg)
+}
+
+func (e *errInfo) pushWithLevel(level string, code uint16, msg string) {
 	if e.maxCnt > 0 && len(e.codes) > e.maxCnt {
+		e.levels = e.levels[1:]
 		e.codes = e.codes[1:]
 		e.msgs = e.msgs[1:]
 	}
+	e.levels = append(e.levels, level)
 	e.codes = append(e.codes, code)
 	e.msgs = append(e.msgs, msg)
 }
@@ -539,6 +551,7 @@ func NewSession(
 			service:        service,
 		},
 		errInfo: &errInfo{
+			levels: make([]string, 0, MoDefaultErrorCount),
 			codes:  make([]uint16, 0, MoDefaultErrorCount),
 			msgs:   make([]string, 0, MoDefaultErrorCount),
 			maxCnt: MoDefaultErrorCount,
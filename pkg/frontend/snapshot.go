@@ -115,6 +115,10 @@ var (
 		catalog.MO_PITR:      1,
 
 		catalog.MO_RETENTION: 0,
+
+		catalog.MO_EVENTS:        0,
+		catalog.MO_EVENT_RUNS:    1,
+		catalog.MO_EVENT_HISTORY: 1,
 	}
 )
 
@@ -50,6 +50,9 @@ func executeStatusStmt(ses *Session, execCtx *ExecCtx) (err error) {
 
 			// open new file
 			ep.DefaultBufSize = getPu(ses.GetService()).SV.ExportDataDefaultFlushSize
+			// the files are reopened with them when split by max_file_size
+			ep.ctx = execCtx.reqCtx
+			ep.mrs = mrs
 			initExportFileParam(ep, mrs)
 			if err = openNewFile(execCtx.reqCtx, ep, mrs); err != nil {
 				return
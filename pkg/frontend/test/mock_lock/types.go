@@ -333,6 +333,20 @@ func (mr *MockLockServiceMockRecorder) Lock(ctx, tableID, rows, txnID, options a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockLockService)(nil).Lock), ctx, tableID, rows, txnID, options)
 }
 
+// MarkTxnLocks mocks base method.
+func (m *MockLockService) MarkTxnLocks(txnID []byte) lockservice.TxnLockMark {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkTxnLocks", txnID)
+	ret0, _ := ret[0].(lockservice.TxnLockMark)
+	return ret0
+}
+
+// MarkTxnLocks indicates an expected call of MarkTxnLocks.
+func (mr *MockLockServiceMockRecorder) MarkTxnLocks(txnID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkTxnLocks", reflect.TypeOf((*MockLockService)(nil).MarkTxnLocks), txnID)
+}
+
 // Unlock mocks base method.
 func (m *MockLockService) Unlock(ctx context.Context, txnID []byte, commitTS timestamp.Timestamp, mutations ...lock.ExtraMutation) error {
 	m.ctrl.T.Helper()
@@ -352,6 +366,20 @@ func (mr *MockLockServiceMockRecorder) Unlock(ctx, txnID, commitTS any, mutation
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockLockService)(nil).Unlock), varargs...)
 }
 
+// UnlockAfterMark mocks base method.
+func (m *MockLockService) UnlockAfterMark(ctx context.Context, txnID []byte, mark lockservice.TxnLockMark) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockAfterMark", ctx, txnID, mark)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockAfterMark indicates an expected call of UnlockAfterMark.
+func (mr *MockLockServiceMockRecorder) UnlockAfterMark(ctx, txnID, mark any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockAfterMark", reflect.TypeOf((*MockLockService)(nil).UnlockAfterMark), ctx, txnID, mark)
+}
+
 // MockLockTableAllocator is a mock of LockTableAllocator interface.
 type MockLockTableAllocator struct {
 	ctrl     *gomock.Controller
@@ -253,6 +253,26 @@ func (mr *MockTaskServiceMockRecorder) QueryAsyncTask(arg0 any, arg1 ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryAsyncTask", reflect.TypeOf((*MockTaskService)(nil).QueryAsyncTask), varargs...)
 }
 
+// DeleteCronTask mocks base method.
+func (m *MockTaskService) DeleteCronTask(arg0 context.Context, arg1 ...taskservice.Condition) (int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCronTask", varargs...)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCronTask indicates an expected call of DeleteCronTask.
+func (mr *MockTaskServiceMockRecorder) DeleteCronTask(arg0 any, arg1 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCronTask", reflect.TypeOf((*MockTaskService)(nil).DeleteCronTask), varargs...)
+}
+
 // QueryCronTask mocks base method.
 func (m *MockTaskService) QueryCronTask(arg0 context.Context, arg1 ...taskservice.Condition) ([]task.CronTask, error) {
 	m.ctrl.T.Helper()
@@ -656,6 +676,26 @@ func (mr *MockTaskStorageMockRecorder) QueryAsyncTask(arg0 any, arg1 ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryAsyncTask", reflect.TypeOf((*MockTaskStorage)(nil).QueryAsyncTask), varargs...)
 }
 
+// DeleteCronTask mocks base method.
+func (m *MockTaskStorage) DeleteCronTask(arg0 context.Context, arg1 ...taskservice.Condition) (int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCronTask", varargs...)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCronTask indicates an expected call of DeleteCronTask.
+func (mr *MockTaskStorageMockRecorder) DeleteCronTask(arg0 any, arg1 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCronTask", reflect.TypeOf((*MockTaskStorage)(nil).DeleteCronTask), varargs...)
+}
+
 // QueryCronTask mocks base method.
 func (m *MockTaskStorage) QueryCronTask(arg0 context.Context, arg1 ...taskservice.Condition) ([]task.CronTask, error) {
 	m.ctrl.T.Helper()
@@ -419,6 +419,50 @@ func (mr *MockTxnClientMockRecorder) WaitLogTailAppliedAt(ctx, ts interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitLogTailAppliedAt", reflect.TypeOf((*MockTxnClient)(nil).WaitLogTailAppliedAt), ctx, ts)
 }
 
+// XACommit mocks base method.
+func (m *MockTxnClient) XACommit(ctx context.Context, txnMeta txn.TxnMeta) (timestamp.Timestamp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XACommit", ctx, txnMeta)
+	ret0, _ := ret[0].(timestamp.Timestamp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// XACommit indicates an expected call of XACommit.
+func (mr *MockTxnClientMockRecorder) XACommit(ctx, txnMeta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XACommit", reflect.TypeOf((*MockTxnClient)(nil).XACommit), ctx, txnMeta)
+}
+
+// XARecover mocks base method.
+func (m *MockTxnClient) XARecover(ctx context.Context) ([]txn.TxnMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XARecover", ctx)
+	ret0, _ := ret[0].([]txn.TxnMeta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// XARecover indicates an expected call of XARecover.
+func (mr *MockTxnClientMockRecorder) XARecover(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XARecover", reflect.TypeOf((*MockTxnClient)(nil).XARecover), ctx)
+}
+
+// XARollback mocks base method.
+func (m *MockTxnClient) XARollback(ctx context.Context, txnMeta txn.TxnMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XARollback", ctx, txnMeta)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// XARollback indicates an expected call of XARollback.
+func (mr *MockTxnClientMockRecorder) XARollback(ctx, txnMeta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XARollback", reflect.TypeOf((*MockTxnClient)(nil).XARollback), ctx, txnMeta)
+}
+
 // MockTxnOperator is a mock of TxnOperator interface.
 type MockTxnOperator struct {
 	ctrl     *gomock.Controller
@@ -936,6 +980,20 @@ func (mr *MockTxnOperatorMockRecorder) WriteAndCommit(ctx, ops interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteAndCommit", reflect.TypeOf((*MockTxnOperator)(nil).WriteAndCommit), ctx, ops)
 }
 
+// XAPrepare mocks base method.
+func (m *MockTxnOperator) XAPrepare(ctx context.Context, xid []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XAPrepare", ctx, xid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// XAPrepare indicates an expected call of XAPrepare.
+func (mr *MockTxnOperatorMockRecorder) XAPrepare(ctx, xid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XAPrepare", reflect.TypeOf((*MockTxnOperator)(nil).XAPrepare), ctx, xid)
+}
+
 // MockTxnIDGenerator is a mock of TxnIDGenerator interface.
 type MockTxnIDGenerator struct {
 	ctrl     *gomock.Controller
@@ -1273,6 +1331,20 @@ func (mr *MockWorkspaceMockRecorder) Readonly() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Readonly", reflect.TypeOf((*MockWorkspace)(nil).Readonly))
 }
 
+// ReleaseSavepoint mocks base method.
+func (m *MockWorkspace) ReleaseSavepoint(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseSavepoint", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseSavepoint indicates an expected call of ReleaseSavepoint.
+func (mr *MockWorkspaceMockRecorder) ReleaseSavepoint(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSavepoint", reflect.TypeOf((*MockWorkspace)(nil).ReleaseSavepoint), ctx, name)
+}
+
 // Rollback mocks base method.
 func (m *MockWorkspace) Rollback(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -1301,6 +1373,34 @@ func (mr *MockWorkspaceMockRecorder) RollbackLastStatement(ctx interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackLastStatement", reflect.TypeOf((*MockWorkspace)(nil).RollbackLastStatement), ctx)
 }
 
+// RollbackToSavepoint mocks base method.
+func (m *MockWorkspace) RollbackToSavepoint(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackToSavepoint", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackToSavepoint indicates an expected call of RollbackToSavepoint.
+func (mr *MockWorkspaceMockRecorder) RollbackToSavepoint(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackToSavepoint", reflect.TypeOf((*MockWorkspace)(nil).RollbackToSavepoint), ctx, name)
+}
+
+// Savepoint mocks base method.
+func (m *MockWorkspace) Savepoint(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Savepoint", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Savepoint indicates an expected call of Savepoint.
+func (mr *MockWorkspaceMockRecorder) Savepoint(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Savepoint", reflect.TypeOf((*MockWorkspace)(nil).Savepoint), ctx, name)
+}
+
 // SetHaveDDL mocks base method.
 func (m *MockWorkspace) SetHaveDDL(flag bool) {
 	m.ctrl.T.Helper()
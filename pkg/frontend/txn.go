@@ -29,6 +29,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/txn/clock"
 	"github.com/matrixorigin/matrixone/pkg/txn/storage/memorystorage"
@@ -116,6 +117,12 @@ func finishTxnFunc(ses FeSession, execErr error, execCtx *ExecCtx) (err error) {
 			logStatementStatus(execCtx.reqCtx, ses, execCtx.stmt, fail, err)
 			return err
 		}
+	} else if execCtx.txnOpt.byXA {
+		//the txn has been handled by the XA statement
+		err = execErr
+		if err != nil {
+			logStatementStatus(execCtx.reqCtx, ses, execCtx.stmt, fail, err)
+		}
 	} else {
 		if execErr == nil {
 			err = commitTxnFunc(ses, execCtx)
@@ -141,6 +148,9 @@ type FeTxnOption struct {
 	//byRollback denotes the txn rolled back by the ROLLBACK.
 	//or error types that need to roll back the whole txn.
 	byRollback bool
+	//byXA denotes the txn is handled by the XA statements.
+	//the error of the XA statement does not roll back the txn.
+	byXA bool
 }
 
 func (opt *FeTxnOption) Close() {
@@ -148,6 +158,7 @@ func (opt *FeTxnOption) Close() {
 	opt.autoCommit = true
 	opt.byCommit = false
 	opt.byRollback = false
+	opt.byXA = false
 }
 
 const (
@@ -188,6 +199,12 @@ type TxnHandler struct {
 
 	//the option bits
 	optionBits uint32
+
+	//the XA txn branch of the session
+	xa struct {
+		state xaState
+		xid   tree.XID
+	}
 }
 
 func InitTxnHandler(service string, storage engine.Engine, connCtx context.Context, txnOp TxnOperator) *TxnHandler {
@@ -220,6 +237,7 @@ func (th *TxnHandler) Close() {
 	th.shareTxn = false
 	th.serverStatus = defaultServerStatus
 	th.optionBits = defaultOptionBits
+	th.resetXAUnsafe()
 }
 
 func (th *TxnHandler) GetConnCtx() context.Context {
@@ -661,6 +679,7 @@ func (th *TxnHandler) rollbackUnsafe(execCtx *ExecCtx) error {
 	}
 	execCtx.ses.EnterFPrint(FPRollbackUnsafeBeforeRollback)
 	defer execCtx.ses.ExitFPrint(FPRollbackUnsafeBeforeRollback)
+	th.xaRollbackOnlyUnsafe()
 	if th.txnOp != nil {
 		execCtx.ses.EnterFPrint(FPRollbackUnsafeBeforeRollbackWithTxn)
 		defer execCtx.ses.ExitFPrint(FPRollbackUnsafeBeforeRollbackWithTxn)
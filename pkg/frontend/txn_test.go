@@ -127,6 +127,18 @@ func (txn *testWorkspace) RollbackLastStatement(ctx context.Context) error {
 	return nil
 }
 
+func (txn *testWorkspace) Savepoint(ctx context.Context, name string) error {
+	return nil
+}
+
+func (txn *testWorkspace) RollbackToSavepoint(ctx context.Context, name string) error {
+	return nil
+}
+
+func (txn *testWorkspace) ReleaseSavepoint(ctx context.Context, name string) error {
+	return nil
+}
+
 func (t *testWorkspace) WriteOffset() uint64 {
 	//TODO implement me
 	panic("implement me")
@@ -900,6 +912,12 @@ func (txnop *testTxnOp) Rollback(ctx context.Context) error {
 	return nil
 }
 
+func (txnop *testTxnOp) XAPrepare(ctx context.Context, xid []byte) error {
+	txnop.meta.XID = xid
+	txnop.meta.Status = txn.TxnStatus_Prepared
+	return nil
+}
+
 func (txnop *testTxnOp) AddLockTable(locktable lock.LockTable) error {
 	//TODO implement me
 	panic("implement me")
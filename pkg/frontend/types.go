@@ -187,6 +187,9 @@ const (
 	FPRestartCDC
 	FPResumeCDC
 	FPShowCDC
+	FPCreateEvent
+	FPAlterEvent
+	FPDropEvent
 	FPCommitUnsafeBeforeRollbackWhenCommitPanic
 )
 
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -54,6 +55,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/stage"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memoryengine"
 )
@@ -433,6 +435,8 @@ func getValueFromVector(ctx context.Context, vec *vector.Vector, ses *Session, e
 		return vector.GetArrayAt[float32](vec, 0), nil
 	case types.T_array_float64:
 		return vector.GetArrayAt[float64](vec, 0), nil
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		return types.QuantizedArrayToString(vec.GetType().Oid, vec.GetBytesAt(0)), nil
 	case types.T_decimal64:
 		val := vector.GetFixedAtNoTypeCheck[types.Decimal64](vec, 0)
 		return val.Format(expr.Typ.Scale), nil
@@ -1627,6 +1631,151 @@ func compositedUriInfo(uri string, uriPrefix string) (bool, cdc.UriInfo) {
 	}
 }
 
+// compositedKafkaUriInfo parses kafka://[user:password@]host:port[,host:port...][?key=value&...]
+// user and password are used for sasl, params are topic, format, schema.registry
+// and producer configs
+func compositedKafkaUriInfo(uri string) (bool, cdc.UriInfo) {
+	if !uriHasPrefix(uri, cdc.KafkaUriPrefix) {
+		return false, cdc.UriInfo{}
+	}
+	rest := uri[len(cdc.KafkaUriPrefix):]
+
+	info := cdc.UriInfo{}
+	if idx := strings.Index(rest, "?"); idx >= 0 {
+		params, err := url.ParseQuery(rest[idx+1:])
+		if err != nil {
+			return false, cdc.UriInfo{}
+		}
+		info.Params = make(map[string]string, len(params))
+		for key := range params {
+			info.Params[key] = params.Get(key)
+		}
+		rest = rest[:idx]
+	}
+
+	if idx := strings.LastIndex(rest, "@"); idx >= 0 {
+		userInfo := rest[:idx]
+		sep := strings.Index(userInfo, ":")
+		if sep <= 0 {
+			return false, cdc.UriInfo{}
+		}
+		info.User = userInfo[:sep]
+		info.Password = userInfo[sep+1:]
+		info.PasswordStart = len(cdc.KafkaUriPrefix) + sep + 1
+		info.PasswordEnd = len(cdc.KafkaUriPrefix) + idx
+		rest = rest[idx+1:]
+	}
+
+	for _, broker := range strings.Split(rest, ",") {
+		seps := strings.Split(broker, ":")
+		if len(seps) != 2 || len(seps[0]) == 0 {
+			return false, cdc.UriInfo{}
+		}
+		port, err := strconv.ParseUint(seps[1], 10, 32)
+		if err != nil || port > 65535 {
+			return false, cdc.UriInfo{}
+		}
+		info.Brokers = append(info.Brokers, broker)
+	}
+	return true, info
+}
+
+func extractKafkaUriInfo(ctx context.Context, uri string) (string, cdc.UriInfo, error) {
+	ok, uriInfo := compositedKafkaUriInfo(uri)
+	if !ok {
+		return "", cdc.UriInfo{}, moerr.NewInternalErrorf(ctx, "invalid uri format: %s", maskKafkaUri(uri))
+	}
+
+	// the secret params are stored encrypted, like the password
+	storedUriInfo := uriInfo
+	var err error
+	if storedUriInfo.Params, err = cdc.EncodeSecretParams(uriInfo.Params); err != nil {
+		return "", cdc.UriInfo{}, err
+	}
+	jsonUriInfo, err := cdc.JsonEncode(&storedUriInfo)
+	if err != nil {
+		return "", cdc.UriInfo{}, err
+	}
+	return jsonUriInfo, uriInfo, nil
+}
+
+// maskKafkaUri masks the password and the secret params of the kafka uri
+func maskKafkaUri(uri string) string {
+	rest, query, hasQuery := strings.Cut(uri, "?")
+	start := 0
+	if idx := strings.Index(rest, "://"); idx >= 0 {
+		start = idx + len("://")
+	}
+	if idx := strings.LastIndex(rest, "@"); idx >= start {
+		user, _, _ := strings.Cut(rest[start:idx], ":")
+		rest = rest[:start] + user + ":******" + rest[idx:]
+	}
+	if !hasQuery {
+		return rest
+	}
+
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		return rest + "?******"
+	}
+	for key := range params {
+		if cdc.IsSecretParam(key) {
+			params.Set(key, "******")
+		}
+	}
+	return rest + "?" + params.Encode()
+}
+
+// compositedStageUriInfo parses stage://name[/path][?format=csv|jsonl|parquet&max_file_size=N]
+func compositedStageUriInfo(uri string) (bool, cdc.UriInfo) {
+	if !uriHasPrefix(uri, stage.STAGE_PROTOCOL+"://") {
+		return false, cdc.UriInfo{}
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return false, cdc.UriInfo{}
+	}
+	stageName, prefix, _, err := stage.ParseStageUrl(u)
+	if err != nil {
+		return false, cdc.UriInfo{}
+	}
+
+	info := cdc.UriInfo{
+		Path: stage.STAGE_PROTOCOL + "://" + stageName + prefix,
+	}
+	if params := u.Query(); len(params) > 0 {
+		info.Params = make(map[string]string, len(params))
+		for key := range params {
+			info.Params[strings.ToLower(key)] = params.Get(key)
+		}
+	}
+
+	switch strings.ToLower(info.Params[cdc.FileFormatKey]) {
+	case "", cdc.FileFormatCsv, cdc.FileFormatJsonl, cdc.FileFormatParquet:
+	default:
+		return false, cdc.UriInfo{}
+	}
+	if str, ok := info.Params[cdc.FileMaxSizeKey]; ok {
+		if size, err := strconv.Atoi(str); err != nil || size <= 0 {
+			return false, cdc.UriInfo{}
+		}
+	}
+	return true, info
+}
+
+func extractStageUriInfo(ctx context.Context, uri string) (string, cdc.UriInfo, error) {
+	ok, uriInfo := compositedStageUriInfo(uri)
+	if !ok {
+		return "", cdc.UriInfo{}, moerr.NewInternalErrorf(ctx, "invalid uri format: %s", uri)
+	}
+
+	jsonUriInfo, err := cdc.JsonEncode(&uriInfo)
+	if err != nil {
+		return "", cdc.UriInfo{}, err
+	}
+	return jsonUriInfo, uriInfo, nil
+}
+
 // replaceStr replaces s[start:end] by s2
 func replaceStr(s string, start, end int, s2 string) string {
 	if start >= end || start < 0 || end < 0 {
@@ -3510,6 +3510,14 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableBoolType("experimental_fulltext_index"),
 		Default:           int64(0),
 	},
+	"experimental_hnsw_index": {
+		Name:              "experimental_hnsw_index",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("experimental_hnsw_index"),
+		Default:           int64(0),
+	},
 	"validate_password": {
 		Name:              "validate_password",
 		Scope:             ScopeGlobal,
@@ -3630,6 +3638,14 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableStringType("invited_nodes"),
 		Default:           "*",
 	},
+	"query_memory_budget": {
+		Name:              "query_memory_budget",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("query_memory_budget", 0, math.MaxInt64, false),
+		Default:           int64(0),
+	},
 	"profiling_history_size": {
 		Name:              "profiling_history_size",
 		Scope:             ScopeBoth,
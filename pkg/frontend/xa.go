@@ -0,0 +1,443 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+/*
+XA transaction branch of the session.
+
+	XA START xid           NON-EXISTING -> ACTIVE
+	XA END xid             ACTIVE       -> IDLE
+	XA PREPARE xid         IDLE         -> NON-EXISTING, the branch is prepared on the TN
+	XA COMMIT xid ONE PHASE IDLE        -> NON-EXISTING, the branch is committed
+	XA ROLLBACK xid        IDLE         -> NON-EXISTING, the branch is rolled back
+
+The prepared branch is detached from the session. It survives the session
+disconnect, and is committed or rolled back by XA COMMIT xid or
+XA ROLLBACK xid from any session of the same account.
+
+If the txn of the branch is rolled back by an error, the branch is in
+ROLLBACK ONLY state until XA ROLLBACK xid.
+*/
+
+type xaState int
+
+const (
+	xaStateNonExisting xaState = iota
+	xaStateActive
+	xaStateIdle
+	xaStateRollbackOnly
+)
+
+func (s xaState) String() string {
+	switch s {
+	case xaStateActive:
+		return "ACTIVE"
+	case xaStateIdle:
+		return "IDLE"
+	case xaStateRollbackOnly:
+		return "ROLLBACK ONLY"
+	default:
+		return "NON-EXISTING"
+	}
+}
+
+const (
+	// maxXIDPartLength is the max length of the gtrid and the bqual.
+	maxXIDPartLength = 64
+	// xidHeaderSize accountID(4) | formatID(8) | len(gtrid)(1) | len(bqual)(1)
+	xidHeaderSize = 14
+)
+
+// encodeXID encodes the xid with the account. The prepared XA txn branches
+// are only visible to the account that prepared them.
+func encodeXID(accountID uint32, xid tree.XID) []byte {
+	buf := make([]byte, xidHeaderSize, xidHeaderSize+len(xid.Gtrid)+len(xid.Bqual))
+	binary.BigEndian.PutUint32(buf, accountID)
+	binary.BigEndian.PutUint64(buf[4:], xid.FormatID)
+	buf[12] = byte(len(xid.Gtrid))
+	buf[13] = byte(len(xid.Bqual))
+	buf = append(buf, xid.Gtrid...)
+	return append(buf, xid.Bqual...)
+}
+
+func decodeXID(v []byte) (uint32, tree.XID, bool) {
+	if len(v) < xidHeaderSize {
+		return 0, tree.XID{}, false
+	}
+	gtridLen, bqualLen := int(v[12]), int(v[13])
+	if len(v) != xidHeaderSize+gtridLen+bqualLen {
+		return 0, tree.XID{}, false
+	}
+	return binary.BigEndian.Uint32(v), tree.XID{
+		FormatID: binary.BigEndian.Uint64(v[4:]),
+		Gtrid:    v[xidHeaderSize : xidHeaderSize+gtridLen],
+		Bqual:    v[xidHeaderSize+gtridLen:],
+	}, true
+}
+
+func xidEqual(a, b tree.XID) bool {
+	return a.FormatID == b.FormatID &&
+		bytes.Equal(a.Gtrid, b.Gtrid) &&
+		bytes.Equal(a.Bqual, b.Bqual)
+}
+
+func checkXID(ctx context.Context, xid tree.XID) error {
+	if len(xid.Gtrid) == 0 ||
+		len(xid.Gtrid) > maxXIDPartLength ||
+		len(xid.Bqual) > maxXIDPartLength {
+		return moerr.NewXAInvalidArguments(ctx)
+	}
+	return nil
+}
+
+// checkXAStatement checks the statement can be executed in the XA state of
+// the session. The statement that commits the txn implicitly can not be
+// executed in the XA txn branch.
+func (th *TxnHandler) checkXAStatement(execCtx *ExecCtx) error {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	switch execCtx.stmt.(type) {
+	case *tree.XAStart, *tree.XAEnd, *tree.XAPrepare,
+		*tree.XACommit, *tree.XARollback, *tree.XARecover:
+		return nil
+	}
+
+	switch th.xa.state {
+	case xaStateNonExisting:
+		return nil
+	case xaStateActive:
+		switch execCtx.stmt.(type) {
+		case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+			return moerr.NewXAInvalidState(execCtx.reqCtx, th.xa.state.String())
+		}
+		if NeedToBeCommittedInActiveTransaction(execCtx.stmt) {
+			return moerr.NewXAInvalidState(execCtx.reqCtx, th.xa.state.String())
+		}
+		return nil
+	default:
+		return moerr.NewXAInvalidState(execCtx.reqCtx, th.xa.state.String())
+	}
+}
+
+// execXAStatement executes the XA statements except XA RECOVER. The error of
+// the XA statement does not roll back the txn of the session.
+func execXAStatement(ses FeSession, execCtx *ExecCtx) error {
+	th := ses.GetTxnHandler()
+	switch st := execCtx.stmt.(type) {
+	case *tree.XAStart:
+		autocommit, err := autocommitValue(ses)
+		if err != nil {
+			return err
+		}
+		execCtx.txnOpt.autoCommit = autocommit
+		return th.xaStart(execCtx, st)
+	case *tree.XAEnd:
+		return th.xaEnd(execCtx, st)
+	case *tree.XAPrepare:
+		return th.xaPrepare(execCtx, st)
+	case *tree.XACommit:
+		return th.xaCommit(execCtx, st)
+	case *tree.XARollback:
+		return th.xaRollback(execCtx, st)
+	}
+	return nil
+}
+
+func (th *TxnHandler) xaStart(execCtx *ExecCtx, st *tree.XAStart) error {
+	ctx := execCtx.reqCtx
+	if st.Join || st.Resume {
+		return moerr.NewXAInvalidArguments(ctx)
+	}
+	if err := checkXID(ctx, st.Xid); err != nil {
+		return err
+	}
+	if err := th.checkOutsideXA(ctx); err != nil {
+		return err
+	}
+	if _, ok, err := findPreparedXABranch(execCtx, st.Xid); err != nil {
+		return err
+	} else if ok {
+		return moerr.NewXADuplicateXID(ctx)
+	}
+
+	execCtx.txnOpt.byBegin = true
+	if err := th.Create(execCtx); err != nil {
+		return err
+	}
+
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	th.xa.state = xaStateActive
+	th.xa.xid = st.Xid
+	return nil
+}
+
+func (th *TxnHandler) xaEnd(execCtx *ExecCtx, st *tree.XAEnd) error {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	if st.Suspend {
+		return moerr.NewXAInvalidArguments(execCtx.reqCtx)
+	}
+	if err := th.checkXABranchUnsafe(execCtx.reqCtx, st.Xid); err != nil {
+		return err
+	}
+	switch th.xa.state {
+	case xaStateActive:
+		th.xa.state = xaStateIdle
+	case xaStateRollbackOnly:
+	default:
+		return moerr.NewXAInvalidState(execCtx.reqCtx, th.xa.state.String())
+	}
+	return nil
+}
+
+func (th *TxnHandler) xaPrepare(execCtx *ExecCtx, st *tree.XAPrepare) error {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	if err := th.checkXABranchUnsafe(execCtx.reqCtx, st.Xid); err != nil {
+		return err
+	}
+	switch th.xa.state {
+	case xaStateIdle:
+	case xaStateRollbackOnly:
+		th.resetXAUnsafe()
+		return moerr.NewXARollbackOnly(execCtx.reqCtx)
+	default:
+		return moerr.NewXAInvalidState(execCtx.reqCtx, th.xa.state.String())
+	}
+
+	accountID, err := defines.GetAccountId(execCtx.reqCtx)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeoutCause(
+		th.txnCtx,
+		th.storage.Hints().CommitOrRollbackTimeout,
+		moerr.CauseCommitUnsafe,
+	)
+	defer cancel()
+
+	// the prepared branch is detached from the session whether the prepare
+	// succeeds or not. The txn is aborted if the prepare failed.
+	execCtx.ses.SetTxnId(th.txnOp.Txn().ID)
+	err, _ = ExecuteFuncWithRecover(func() error {
+		return th.txnOp.XAPrepare(ctx, encodeXID(accountID, st.Xid))
+	})
+	if err != nil {
+		err = moerr.AttachCause(ctx, err)
+	}
+	th.resetXAUnsafe()
+	th.invalidateTxnUnsafe()
+	execCtx.ses.SetTxnId(dumpUUID[:])
+	return err
+}
+
+func (th *TxnHandler) xaCommit(execCtx *ExecCtx, st *tree.XACommit) error {
+	ctx := execCtx.reqCtx
+	if st.OnePhase {
+		th.mu.Lock()
+		defer th.mu.Unlock()
+		if err := th.checkXABranchUnsafe(ctx, st.Xid); err != nil {
+			return err
+		}
+		switch th.xa.state {
+		case xaStateIdle:
+		case xaStateRollbackOnly:
+			th.resetXAUnsafe()
+			return moerr.NewXARollbackOnly(ctx)
+		default:
+			return moerr.NewXAInvalidState(ctx, th.xa.state.String())
+		}
+		th.resetXAUnsafe()
+		return th.commitUnsafe(execCtx)
+	}
+
+	if err := th.checkOutsideXA(ctx); err != nil {
+		return err
+	}
+	txnMeta, ok, err := findPreparedXABranch(execCtx, st.Xid)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return moerr.NewXAUnknownXID(ctx)
+	}
+	commitTS, err := getPu(execCtx.ses.GetService()).TxnClient.XACommit(ctx, txnMeta)
+	if err != nil {
+		return err
+	}
+	execCtx.ses.updateLastCommitTS(commitTS)
+	return nil
+}
+
+func (th *TxnHandler) xaRollback(execCtx *ExecCtx, st *tree.XARollback) error {
+	ctx := execCtx.reqCtx
+	th.mu.Lock()
+	if th.xa.state != xaStateNonExisting && xidEqual(th.xa.xid, st.Xid) {
+		defer th.mu.Unlock()
+		if th.xa.state == xaStateActive {
+			return moerr.NewXAInvalidState(ctx, th.xa.state.String())
+		}
+		th.resetXAUnsafe()
+		return th.rollbackUnsafe(execCtx)
+	}
+	th.mu.Unlock()
+
+	if err := th.checkOutsideXA(ctx); err != nil {
+		return err
+	}
+	txnMeta, ok, err := findPreparedXABranch(execCtx, st.Xid)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return moerr.NewXAUnknownXID(ctx)
+	}
+	return getPu(execCtx.ses.GetService()).TxnClient.XARollback(ctx, txnMeta)
+}
+
+// checkOutsideXA checks the session is not in a XA txn branch or a txn
+// started by the BEGIN or the autocommit disabled.
+func (th *TxnHandler) checkOutsideXA(ctx context.Context) error {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	if th.xa.state != xaStateNonExisting {
+		return moerr.NewXAInvalidState(ctx, th.xa.state.String())
+	}
+	if th.inActiveTxnUnsafe() && bitsIsSet(th.optionBits, OPTION_BEGIN|OPTION_NOT_AUTOCOMMIT) {
+		return moerr.NewXAOutside(ctx)
+	}
+	return nil
+}
+
+// checkXABranchUnsafe checks the xid is the XA txn branch of the session.
+func (th *TxnHandler) checkXABranchUnsafe(ctx context.Context, xid tree.XID) error {
+	if th.xa.state == xaStateNonExisting {
+		return moerr.NewXAInvalidState(ctx, th.xa.state.String())
+	}
+	if !xidEqual(th.xa.xid, xid) {
+		return moerr.NewXAUnknownXID(ctx)
+	}
+	return nil
+}
+
+func (th *TxnHandler) resetXAUnsafe() {
+	th.xa.state = xaStateNonExisting
+	th.xa.xid = tree.XID{}
+}
+
+// xaRollbackOnlyUnsafe marks the XA txn branch of the session rollback only,
+// after the txn of the branch is rolled back.
+func (th *TxnHandler) xaRollbackOnlyUnsafe() {
+	if th.xa.state == xaStateActive || th.xa.state == xaStateIdle {
+		th.xa.state = xaStateRollbackOnly
+	}
+}
+
+// findPreparedXABranch finds the prepared XA txn branch of the account.
+func findPreparedXABranch(execCtx *ExecCtx, xid tree.XID) (txn.TxnMeta, bool, error) {
+	txns, err := getPreparedXABranches(execCtx)
+	if err != nil {
+		return txn.TxnMeta{}, false, err
+	}
+	for _, b := range txns {
+		if xidEqual(b.xid, xid) {
+			return b.txnMeta, true, nil
+		}
+	}
+	return txn.TxnMeta{}, false, nil
+}
+
+type xaBranch struct {
+	xid     tree.XID
+	txnMeta txn.TxnMeta
+}
+
+func getPreparedXABranches(execCtx *ExecCtx) ([]xaBranch, error) {
+	accountID, err := defines.GetAccountId(execCtx.reqCtx)
+	if err != nil {
+		return nil, err
+	}
+	txns, err := getPu(execCtx.ses.GetService()).TxnClient.XARecover(execCtx.reqCtx)
+	if err != nil {
+		return nil, err
+	}
+	var branches []xaBranch
+	for _, txnMeta := range txns {
+		id, xid, ok := decodeXID(txnMeta.XID)
+		if !ok || id != accountID {
+			continue
+		}
+		branches = append(branches, xaBranch{xid: xid, txnMeta: txnMeta})
+	}
+	return branches, nil
+}
+
+// handleXARecover lists the prepared XA txn branches of the account.
+func handleXARecover(ses FeSession, execCtx *ExecCtx, st *tree.XARecover) error {
+	branches, err := getPreparedXABranches(execCtx)
+	if err != nil {
+		return err
+	}
+
+	formatIDCol := new(MysqlColumn)
+	formatIDCol.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	formatIDCol.SetName("formatID")
+
+	gtridLenCol := new(MysqlColumn)
+	gtridLenCol.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	gtridLenCol.SetName("gtrid_length")
+
+	bqualLenCol := new(MysqlColumn)
+	bqualLenCol.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	bqualLenCol.SetName("bqual_length")
+
+	dataCol := new(MysqlColumn)
+	dataCol.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	dataCol.SetName("data")
+
+	mrs := ses.GetMysqlResultSet()
+	mrs.AddColumn(formatIDCol)
+	mrs.AddColumn(gtridLenCol)
+	mrs.AddColumn(bqualLenCol)
+	mrs.AddColumn(dataCol)
+
+	for _, b := range branches {
+		data := append(append([]byte{}, b.xid.Gtrid...), b.xid.Bqual...)
+		row := make([]interface{}, 4)
+		row[0] = int64(b.xid.FormatID)
+		row[1] = int64(len(b.xid.Gtrid))
+		row[2] = int64(len(b.xid.Bqual))
+		if st.ConvertXid {
+			row[3] = "0x" + hex.EncodeToString(data)
+		} else {
+			row[3] = string(data)
+		}
+		mrs.AddRow(row)
+	}
+	return trySaveQueryResult(execCtx.reqCtx, ses.(*Session), mrs)
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 - 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func Test_encodeXID(t *testing.T) {
+	convey.Convey("encode and decode xid", t, func() {
+		xid := tree.XID{
+			Gtrid:    []byte("gtrid"),
+			Bqual:    []byte("bqual"),
+			FormatID: 3,
+		}
+		accountID, decoded, ok := decodeXID(encodeXID(10, xid))
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(accountID, convey.ShouldEqual, 10)
+		convey.So(xidEqual(xid, decoded), convey.ShouldBeTrue)
+
+		xid.Bqual = nil
+		_, decoded, ok = decodeXID(encodeXID(0, xid))
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(xidEqual(xid, decoded), convey.ShouldBeTrue)
+
+		_, _, ok = decodeXID([]byte("xid"))
+		convey.So(ok, convey.ShouldBeFalse)
+		_, _, ok = decodeXID(encodeXID(0, xid)[:xidHeaderSize+1])
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	convey.Convey("check xid", t, func() {
+		ctx := context.TODO()
+		convey.So(checkXID(ctx, tree.XID{Gtrid: []byte("x")}), convey.ShouldBeNil)
+		err := checkXID(ctx, tree.XID{})
+		convey.So(moerr.IsMoErrCode(err, moerr.ErrXAInvalidArguments), convey.ShouldBeTrue)
+		err = checkXID(ctx, tree.XID{Gtrid: []byte(strings.Repeat("x", maxXIDPartLength+1))})
+		convey.So(moerr.IsMoErrCode(err, moerr.ErrXAInvalidArguments), convey.ShouldBeTrue)
+		err = checkXID(ctx, tree.XID{Gtrid: []byte("x"), Bqual: []byte(strings.Repeat("x", maxXIDPartLength+1))})
+		convey.So(moerr.IsMoErrCode(err, moerr.ErrXAInvalidArguments), convey.ShouldBeTrue)
+	})
+}
+
+func Test_checkXAStatement(t *testing.T) {
+	convey.Convey("check statement in xa state", t, func() {
+		th := &TxnHandler{}
+		check := func(stmt tree.Statement) error {
+			return th.checkXAStatement(&ExecCtx{reqCtx: context.TODO(), stmt: stmt})
+		}
+		isInvalidState := func(err error) bool {
+			return moerr.IsMoErrCode(err, moerr.ErrXAInvalidState)
+		}
+
+		th.xa.state = xaStateNonExisting
+		convey.So(check(&tree.CommitTransaction{}), convey.ShouldBeNil)
+		convey.So(check(&tree.SetVar{}), convey.ShouldBeNil)
+
+		th.xa.state = xaStateActive
+		convey.So(check(&tree.Select{}), convey.ShouldBeNil)
+		convey.So(check(&tree.XAEnd{}), convey.ShouldBeNil)
+		convey.So(isInvalidState(check(&tree.BeginTransaction{})), convey.ShouldBeTrue)
+		convey.So(isInvalidState(check(&tree.CommitTransaction{})), convey.ShouldBeTrue)
+		convey.So(isInvalidState(check(&tree.RollbackTransaction{})), convey.ShouldBeTrue)
+		convey.So(isInvalidState(check(&tree.CreateSequence{})), convey.ShouldBeTrue)
+
+		for _, state := range []xaState{xaStateIdle, xaStateRollbackOnly} {
+			th.xa.state = state
+			convey.So(check(&tree.XAPrepare{}), convey.ShouldBeNil)
+			convey.So(check(&tree.XARecover{}), convey.ShouldBeNil)
+			convey.So(isInvalidState(check(&tree.Select{})), convey.ShouldBeTrue)
+		}
+	})
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+/*
+The analyzer of a fulltext index normalizes the words from the tokenizer, both when the index is built and when
+the search string is parsed, so that they meet in the index table:
+
+  1. stopwords are removed.  They are neither indexed nor searched, e.g. '+the +apple' is the same as '+apple'.
+  2. the remaining words are reduced to their stem in the language of the analyzer, e.g. 'apples' matches 'apple'.
+
+The analyzer is chosen by FullTextParserParam.Analyzer:
+
+	"" or "standard": no stemming and no stopwords by default.
+	"english": Porter stemmer and the English stopwords by default.
+
+and FullTextParserParam.Stopwords overrides the default stopwords of the analyzer:
+
+	"none": no stopwords.
+	"english": the English stopwords.
+	otherwise: a comma separated list of words, e.g. "foo, bar".
+
+Words in prefix search (apple*) are not stemmed.  The positions of the words in the documents are kept, so
+phrase search still works with the stopwords in the phrase skipped.
+*/
+
+const (
+	ANALYZER_STANDARD = "standard"
+	ANALYZER_ENGLISH  = "english"
+
+	STOPWORDS_NONE = "none"
+)
+
+// the stopwords of the English analyzer of Lucene and Elasticsearch
+var englishStopwords = []string{
+	"a", "an", "and", "are", "as", "at", "be", "but", "by", "for", "if", "in", "into", "is", "it",
+	"no", "not", "of", "on", "or", "such", "that", "the", "their", "then", "there", "these",
+	"they", "this", "to", "was", "will", "with",
+}
+
+// stemmer and default stopwords of each language
+var analyzers = map[string]struct {
+	stem      func(string) string
+	stopwords []string
+}{
+	ANALYZER_STANDARD: {},
+	ANALYZER_ENGLISH:  {stem: PorterStem, stopwords: englishStopwords},
+}
+
+var namedStopwords = map[string][]string{
+	STOPWORDS_NONE:   nil,
+	ANALYZER_ENGLISH: englishStopwords,
+}
+
+type Analyzer struct {
+	stem      func(string) string
+	stopwords map[string]struct{}
+}
+
+// NewAnalyzer returns the analyzer of the parser parameters.  It returns nil if the words are used as they are.
+func NewAnalyzer(param *FullTextParserParam) (*Analyzer, error) {
+	name := strings.ToLower(param.Analyzer)
+	if name == "" {
+		name = ANALYZER_STANDARD
+	}
+	lang, ok := analyzers[name]
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtxf("invalid analyzer %s", param.Analyzer)
+	}
+
+	stopwords := lang.stopwords
+	if param.Stopwords != "" {
+		if words, ok := namedStopwords[strings.ToLower(param.Stopwords)]; ok {
+			stopwords = words
+		} else {
+			stopwords = nil
+			for _, w := range strings.Split(param.Stopwords, ",") {
+				if w = strings.ToLower(strings.TrimSpace(w)); w != "" {
+					stopwords = append(stopwords, w)
+				}
+			}
+		}
+	}
+
+	if lang.stem == nil && len(stopwords) == 0 {
+		return nil, nil
+	}
+	a := &Analyzer{stem: lang.stem, stopwords: make(map[string]struct{}, len(stopwords))}
+	for _, w := range stopwords {
+		a.stopwords[w] = struct{}{}
+	}
+	return a, nil
+}
+
+// Analyze returns the word to be indexed or searched, and false if it is a stopword.
+func (a *Analyzer) Analyze(word string) (string, bool) {
+	if a == nil {
+		return word, true
+	}
+	if _, ok := a.stopwords[word]; ok {
+		return "", false
+	}
+	if a.stem != nil {
+		word = a.stem(word)
+	}
+	return word, true
+}
+
+// AnalyzePatterns analyzes the TEXT words of the patterns.  A pattern is removed if all of its words are
+// stopwords.
+func (a *Analyzer) AnalyzePatterns(ps []*Pattern) []*Pattern {
+	if a == nil {
+		return ps
+	}
+	res := make([]*Pattern, 0, len(ps))
+	for _, p := range ps {
+		if a.analyzePattern(p) {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+func (a *Analyzer) analyzePattern(p *Pattern) bool {
+	switch p.Operator {
+	case TEXT:
+		word, ok := a.Analyze(p.Text)
+		p.Text = word
+		return ok
+	case STAR:
+		return true
+	case PHRASE:
+		p.Children = a.AnalyzePatterns(p.Children)
+		if len(p.Children) == 0 {
+			return false
+		}
+		// the position of the words is relative to the first word of the phrase
+		base := p.Children[0].Position
+		for _, c := range p.Children {
+			c.Position -= base
+		}
+		return true
+	default:
+		p.Children = a.AnalyzePatterns(p.Children)
+		return len(p.Children) > 0
+	}
+}
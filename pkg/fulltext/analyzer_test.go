@@ -0,0 +1,182 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPorterStem(t *testing.T) {
+	tests := map[string]string{
+		"caresses":        "caress",
+		"ponies":          "poni",
+		"ties":            "ti",
+		"caress":          "caress",
+		"cats":            "cat",
+		"feed":            "feed",
+		"agreed":          "agre",
+		"plastered":       "plaster",
+		"bled":            "bled",
+		"motoring":        "motor",
+		"sing":            "sing",
+		"conflated":       "conflat",
+		"troubled":        "troubl",
+		"sized":           "size",
+		"hopping":         "hop",
+		"tanned":          "tan",
+		"falling":         "fall",
+		"hissing":         "hiss",
+		"fizzed":          "fizz",
+		"failing":         "fail",
+		"filing":          "file",
+		"happy":           "happi",
+		"sky":             "sky",
+		"relational":      "relat",
+		"generalizations": "gener",
+		"oscillators":     "oscil",
+		"connection":      "connect",
+		"connected":       "connect",
+		"connecting":      "connect",
+		"running":         "run",
+		"hopeful":         "hope",
+		"goodness":        "good",
+		// not stemmed
+		"is":     "is",
+		"apple1": "apple1",
+		"中文":     "中文",
+	}
+	for word, stem := range tests {
+		assert.Equal(t, stem, PorterStem(word), word)
+	}
+}
+
+func TestNewAnalyzer(t *testing.T) {
+	// default is no analyzer
+	a, err := NewAnalyzer(&FullTextParserParam{})
+	require.Nil(t, err)
+	require.Nil(t, a)
+	word, ok := a.Analyze("the")
+	require.True(t, ok)
+	require.Equal(t, "the", word)
+
+	a, err = NewAnalyzer(&FullTextParserParam{Analyzer: "english"})
+	require.Nil(t, err)
+	_, ok = a.Analyze("the")
+	require.False(t, ok)
+	word, ok = a.Analyze("apples")
+	require.True(t, ok)
+	require.Equal(t, "appl", word)
+
+	// no stopwords
+	a, err = NewAnalyzer(&FullTextParserParam{Analyzer: "english", Stopwords: "none"})
+	require.Nil(t, err)
+	word, ok = a.Analyze("the")
+	require.True(t, ok)
+	require.Equal(t, "the", word)
+
+	// custom stopwords without stemming
+	a, err = NewAnalyzer(&FullTextParserParam{Stopwords: "Foo, bar,,"})
+	require.Nil(t, err)
+	_, ok = a.Analyze("foo")
+	require.False(t, ok)
+	_, ok = a.Analyze("bar")
+	require.False(t, ok)
+	word, ok = a.Analyze("apples")
+	require.True(t, ok)
+	require.Equal(t, "apples", word)
+
+	_, err = NewAnalyzer(&FullTextParserParam{Analyzer: "klingon"})
+	require.NotNil(t, err)
+}
+
+func TestAnalyzePatterns(t *testing.T) {
+	a, err := NewAnalyzer(&FullTextParserParam{Analyzer: "english"})
+	require.Nil(t, err)
+
+	tests := []struct {
+		pattern string
+		mode    int64
+		expect  string
+	}{
+		{
+			pattern: "the apples and bananas",
+			mode:    int64(tree.FULLTEXT_NL),
+			expect:  "(text appl) (text banana)",
+		},
+		{
+			pattern: "+the +running -jumps",
+			mode:    int64(tree.FULLTEXT_BOOLEAN),
+			expect:  "(+ (text run)) (- (text jump))",
+		},
+		{
+			pattern: "apples* >(the <cars)",
+			mode:    int64(tree.FULLTEXT_BOOLEAN),
+			expect:  "(* apples*) (> (group (< (text car))))",
+		},
+		{
+			pattern: "\"the running dogs\"",
+			mode:    int64(tree.FULLTEXT_BOOLEAN),
+			expect:  "(phrase (text 0 run) (text 8 dog))",
+		},
+		{
+			pattern: "+the",
+			mode:    int64(tree.FULLTEXT_BOOLEAN),
+			expect:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		ps, err := ParsePattern(tt.pattern, tt.mode)
+		require.Nil(t, err)
+		ps = a.AnalyzePatterns(ps)
+		if tt.pattern[0] == '"' {
+			assert.Equal(t, tt.expect, PatternListToStringWithPosition(ps), tt.pattern)
+		} else {
+			assert.Equal(t, tt.expect, PatternListToString(ps), tt.pattern)
+		}
+	}
+}
+
+func TestParserParamScoreAlgo(t *testing.T) {
+	param, err := ParseParserParam("")
+	require.Nil(t, err)
+	algo, _, _, err := param.GetScoreAlgo()
+	require.Nil(t, err)
+	require.Equal(t, ALGO_TFIDF, algo)
+
+	param, err = ParseParserParam(`{"parser":"ngram","scorer":"BM25","bm25_k1":"2","bm25_b":"0.5"}`)
+	require.Nil(t, err)
+	algo, k1, b, err := param.GetScoreAlgo()
+	require.Nil(t, err)
+	require.Equal(t, ALGO_BM25, algo)
+	require.Equal(t, 2.0, k1)
+	require.Equal(t, 0.5, b)
+
+	for _, p := range []*FullTextParserParam{
+		{Scorer: "bm26"},
+		{Scorer: "bm25", BM25K1: "-1"},
+		{Scorer: "bm25", BM25B: "1.5"},
+		{Scorer: "bm25", BM25B: "abc"},
+		{BM25K1: "1.2"},
+		{Analyzer: "klingon"},
+	} {
+		require.NotNil(t, p.Validate(), p)
+	}
+	require.Nil(t, (&FullTextParserParam{Analyzer: "english", Scorer: "bm25", BM25K1: "1.2"}).Validate())
+}
@@ -15,8 +15,10 @@
 package fulltext
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
@@ -44,14 +46,82 @@ func NewWordAccum() *WordAccum {
 	return &WordAccum{Words: make(map[any]*Word)}
 }
 
+// params is the JSON of FullTextParserParam, the algo params of the index.  It can be empty.
 func NewSearchAccum(srctbl string, tblname string, pattern string, mode int64, params string) (*SearchAccum, error) {
 
+	param, err := ParseParserParam(params)
+	if err != nil {
+		return nil, err
+	}
+
+	analyzer, err := NewAnalyzer(param)
+	if err != nil {
+		return nil, err
+	}
+
+	algo, k1, b, err := param.GetScoreAlgo()
+	if err != nil {
+		return nil, err
+	}
+
 	ps, err := ParsePattern(pattern, mode)
 	if err != nil {
 		return nil, err
 	}
+	// the words of the search string are normalized in the same way as the indexed words
+	ps = analyzer.AnalyzePatterns(ps)
 
-	return &SearchAccum{SrcTblName: srctbl, TblName: tblname, Mode: mode, Pattern: ps, Params: params, WordAccums: make(map[string]*WordAccum)}, nil
+	return &SearchAccum{SrcTblName: srctbl, TblName: tblname, Mode: mode, Pattern: ps, Params: params, WordAccums: make(map[string]*WordAccum),
+		Analyzer: analyzer, ScoreAlgo: algo, K1: k1, B: b}, nil
+}
+
+// ParseParserParam parses the JSON of FullTextParserParam.  Empty string is the default parameters.
+func ParseParserParam(params string) (*FullTextParserParam, error) {
+	param := &FullTextParserParam{}
+	if len(params) > 0 {
+		if err := json.Unmarshal([]byte(params), param); err != nil {
+			return nil, err
+		}
+	}
+	return param, nil
+}
+
+// GetScoreAlgo returns the ranking function with the parameters k1 and b of BM25
+func (param *FullTextParserParam) GetScoreAlgo() (algo FullTextScoreAlgo, k1 float64, b float64, err error) {
+	k1, b = BM25_DEFAULT_K1, BM25_DEFAULT_B
+	if param.BM25K1 != "" {
+		k1, err = strconv.ParseFloat(param.BM25K1, 64)
+		if err != nil || k1 < 0 || math.IsInf(k1, 0) {
+			return 0, 0, 0, moerr.NewInternalErrorNoCtxf("invalid bm25_k1 %s. bm25_k1 must be >= 0", param.BM25K1)
+		}
+	}
+	if param.BM25B != "" {
+		b, err = strconv.ParseFloat(param.BM25B, 64)
+		if err != nil || b < 0 || b > 1 {
+			return 0, 0, 0, moerr.NewInternalErrorNoCtxf("invalid bm25_b %s. bm25_b must be between 0 and 1", param.BM25B)
+		}
+	}
+
+	switch strings.ToLower(param.Scorer) {
+	case "", SCORER_TFIDF:
+		if param.BM25K1 != "" || param.BM25B != "" {
+			return 0, 0, 0, moerr.NewInternalErrorNoCtx("bm25_k1 and bm25_b require scorer 'bm25'")
+		}
+		return ALGO_TFIDF, k1, b, nil
+	case SCORER_BM25:
+		return ALGO_BM25, k1, b, nil
+	default:
+		return 0, 0, 0, moerr.NewInternalErrorNoCtxf("invalid scorer %s", param.Scorer)
+	}
+}
+
+// Validate checks the parameters when the index is created
+func (param *FullTextParserParam) Validate() error {
+	if _, err := NewAnalyzer(param); err != nil {
+		return err
+	}
+	_, _, _, err := param.GetScoreAlgo()
+	return err
 }
 
 func findPatternByOperator(ps []*Pattern, op int) []*Pattern {
@@ -169,6 +239,10 @@ func (p *Pattern) EvalLeaf(s *SearchAccum, weight float32, result map[any]float3
 	}
 
 	nmatch := float64(len(acc.Words))
+	if s.ScoreAlgo == ALGO_BM25 {
+		return s.evalBM25(acc, nmatch, weight, result), nil
+	}
+
 	idf := math.Log10(float64(s.Nrow) / nmatch)
 	idfSq := float32(idf * idf)
 	for doc_id := range acc.Words {
@@ -179,6 +253,23 @@ func (p *Pattern) EvalLeaf(s *SearchAccum, weight float32, result map[any]float3
 	return result, nil
 }
 
+// BM25 score of the word in each document, the same as Lucene and Elasticsearch
+//
+//	idf * tf * (k1 + 1) / (tf + k1 * (1 - b + b * doclen / avgdoclen))
+//	idf = ln(1 + (N - nmatch + 0.5) / (nmatch + 0.5))
+func (s *SearchAccum) evalBM25(acc *WordAccum, nmatch float64, weight float32, result map[any]float32) map[any]float32 {
+	idf := math.Log(1 + (float64(s.Nrow)-nmatch+0.5)/(nmatch+0.5))
+	for doc_id, w := range acc.Words {
+		tf := float64(w.DocCount)
+		norm := 1.0
+		if s.AvgDocLen > 0 {
+			norm = 1 - s.B + s.B*float64(s.DocLens[doc_id])/s.AvgDocLen
+		}
+		result[doc_id] = weight * float32(idf*tf*(s.K1+1)/(tf+s.K1*norm))
+	}
+	return result
+}
+
 // Eval Plus Plus operation.  Basically AND operation between input argument and result from the previous Eval()
 // e.g. (+ (text apple)) (+ (text banana))
 func (p *Pattern) EvalPlusPlus(s *SearchAccum, arg, result map[any]float32) (map[any]float32, error) {
@@ -15,6 +15,7 @@
 package fulltext
 
 import (
+	"math"
 	"strings"
 	"testing"
 
@@ -864,3 +865,43 @@ func TestFullTextCombine(t *testing.T) {
 	assert.Equal(t, result[2], float32(2))
 	assert.Equal(t, result[1], float32(4))
 }
+
+func TestFullTextBM25(t *testing.T) {
+
+	pattern := "the apples"
+	params := `{"parser":"default","analyzer":"english","scorer":"bm25","bm25_k1":"1.2","bm25_b":"0.75"}`
+	s, err := NewSearchAccum("src", "index", pattern, int64(tree.FULLTEXT_NL), params)
+	require.Nil(t, err)
+	require.Equal(t, ALGO_BM25, s.ScoreAlgo)
+	// "the" is a stopword and "apples" is stemmed
+	assert.Equal(t, "(text appl)", PatternListToString(s.Pattern))
+
+	word := "appl"
+	s.WordAccums[word] = &WordAccum{Words: make(map[any]*Word)}
+	s.WordAccums[word].Words[0] = &Word{DocId: 0, Position: []int32{0, 4}, DocCount: 2}
+	s.WordAccums[word].Words[1] = &Word{DocId: 1, Position: []int32{0, 4}, DocCount: 2}
+	s.WordAccums[word].Words[2] = &Word{DocId: 2, Position: []int32{0}, DocCount: 1}
+
+	s.Nrow = 100
+	s.AvgDocLen = 10
+	s.DocLens = map[any]int32{0: 5, 1: 20, 2: 5}
+
+	var result map[any]float32
+	for _, p := range s.Pattern {
+		result, err = p.Eval(s, float32(1.0), result)
+		require.Nil(t, err)
+	}
+	require.Equal(t, 3, len(result))
+
+	idf := math.Log(1 + (100-3+0.5)/(3+0.5))
+	expected := idf * 2 * 2.2 / (2 + 1.2*(0.25+0.75*0.5))
+	assert.InDelta(t, expected, float64(result[0]), 1e-4)
+
+	// the shorter document scores higher with the same term frequency
+	assert.Greater(t, result[0], result[1])
+	// term frequency saturates
+	assert.Less(t, result[0], 2*result[2])
+
+	_, err = NewSearchAccum("src", "index", pattern, int64(tree.FULLTEXT_NL), `{"parser":"default","scorer":"bm26"}`)
+	require.NotNil(t, err)
+}
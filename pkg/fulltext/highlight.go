@@ -0,0 +1,310 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/monlp/tokenizer"
+)
+
+/*
+Highlighter implements fulltext_highlight() and fulltext_snippet().  The document is tokenized in the same way as
+fulltext_index_tokenize(), so the words and byte positions are the same as the ones stored in the index table, and
+the words matching the search string are marked in the original text.
+
+The search string is parsed in boolean mode.  Words under MINUS operator are not highlighted, and the words of a
+phrase are highlighted one by one.
+
+fulltext_snippet() returns the best fragments of the document, which are the ones with the most distinct matched
+words, joined with " ... ".  The fragments are in the order of the document.
+*/
+
+const (
+	HIGHLIGHT_DEFAULT_PRE_TAG        = "<b>"
+	HIGHLIGHT_DEFAULT_POST_TAG       = "</b>"
+	SNIPPET_DEFAULT_FRAGMENT_SIZE    = 100
+	SNIPPET_DEFAULT_NUMBER_FRAGMENTS = 3
+	SNIPPET_FRAGMENT_SEPARATOR       = " ... "
+)
+
+type Highlighter struct {
+	analyzer *Analyzer
+	words    map[string]struct{}
+	prefixes []string
+}
+
+// Match is a matched word in the document at bytes [Start, End)
+type Match struct {
+	Start int
+	End   int
+	Word  string
+}
+
+// NewHighlighter parses the search string with the parser parameters of the fulltext index
+func NewHighlighter(pattern string, params string) (*Highlighter, error) {
+	param, err := ParseParserParam(params)
+	if err != nil {
+		return nil, err
+	}
+	analyzer, err := NewAnalyzer(param)
+	if err != nil {
+		return nil, err
+	}
+
+	ps, err := ParsePattern(pattern, int64(tree.FULLTEXT_BOOLEAN))
+	if err != nil {
+		return nil, err
+	}
+
+	h := &Highlighter{analyzer: analyzer, words: make(map[string]struct{})}
+	for _, p := range ps {
+		h.addPattern(p)
+	}
+	return h, nil
+}
+
+func (h *Highlighter) addPattern(p *Pattern) {
+	switch p.Operator {
+	case MINUS:
+		return
+	case TEXT:
+		// split the word the same way as the document, e.g. CJK words into ngrams
+		tok, _ := tokenizer.NewSimpleTokenizer([]byte(p.Text))
+		for t := range tok.Tokenize() {
+			slen := t.TokenBytes[0]
+			if word, ok := h.analyzer.Analyze(string(t.TokenBytes[1 : slen+1])); ok {
+				h.words[word] = struct{}{}
+			}
+		}
+	case STAR:
+		if prefix := strings.TrimSuffix(p.Text, "*"); len(prefix) > 0 {
+			h.prefixes = append(h.prefixes, prefix)
+		}
+	default:
+		for _, c := range p.Children {
+			h.addPattern(c)
+		}
+	}
+}
+
+// Matches returns the matched words in the document in the order of position.  The overlapped matches of ngrams
+// are merged.
+func (h *Highlighter) Matches(doc string) []Match {
+	var res []Match
+
+	tok, _ := tokenizer.NewSimpleTokenizer([]byte(doc))
+	for t := range tok.Tokenize() {
+		slen := t.TokenBytes[0]
+		token := string(t.TokenBytes[1 : slen+1])
+		word, ok := h.match(token)
+		if !ok {
+			continue
+		}
+
+		start := int(t.BytePos)
+		end := start + int(slen)
+		if r, _ := utf8.DecodeRuneInString(token); isLatinRune(r) {
+			// the token of latin word is in lower case and truncated to MAX_TOKEN_SIZE
+			end = latinWordEnd(doc, start)
+		}
+
+		if n := len(res); n > 0 && start <= res[n-1].End {
+			res[n-1].End = max(res[n-1].End, end)
+			continue
+		}
+		res = append(res, Match{Start: start, End: end, Word: word})
+	}
+	return res
+}
+
+func (h *Highlighter) match(token string) (string, bool) {
+	for _, prefix := range h.prefixes {
+		if strings.HasPrefix(token, prefix) {
+			return prefix, true
+		}
+	}
+	word, ok := h.analyzer.Analyze(token)
+	if !ok {
+		return "", false
+	}
+	_, ok = h.words[word]
+	return word, ok
+}
+
+// Highlight returns the document with the matched words enclosed by preTag and postTag
+func (h *Highlighter) Highlight(doc string, preTag, postTag string) string {
+	return markMatches(doc, 0, len(doc), h.Matches(doc), preTag, postTag)
+}
+
+// Snippet returns at most nfragment fragments of about fragmentSize characters with the matched words enclosed by
+// preTag and postTag.  It returns the beginning of the document if nothing is matched.
+func (h *Highlighter) Snippet(doc string, preTag, postTag string, fragmentSize, nfragment int) string {
+	matches := h.Matches(doc)
+	if len(matches) == 0 {
+		_, end := expandFragment(doc, 0, 0, fragmentSize)
+		return doc[:end]
+	}
+
+	type fragment struct {
+		first, last int // matches[first:last]
+		nword       int
+	}
+
+	// candidates start at each match and take the following matches within the fragment size
+	candidates := make([]fragment, 0, len(matches))
+	for i := range matches {
+		words := map[string]struct{}{matches[i].Word: {}}
+		j := i + 1
+		for ; j < len(matches); j++ {
+			if utf8.RuneCountInString(doc[matches[i].Start:matches[j].End]) > fragmentSize {
+				break
+			}
+			words[matches[j].Word] = struct{}{}
+		}
+		candidates = append(candidates, fragment{first: i, last: j, nword: len(words)})
+	}
+	sort.SliceStable(candidates, func(a, b int) bool {
+		ca, cb := candidates[a], candidates[b]
+		if ca.nword != cb.nword {
+			return ca.nword > cb.nword
+		}
+		return ca.last-ca.first > cb.last-cb.first
+	})
+
+	// choose the best fragments without overlap
+	var chosen []fragment
+	for _, c := range candidates {
+		if len(chosen) == nfragment {
+			break
+		}
+		overlap := false
+		for _, f := range chosen {
+			if c.first < f.last && f.first < c.last {
+				overlap = true
+				break
+			}
+		}
+		if !overlap {
+			chosen = append(chosen, c)
+		}
+	}
+	sort.Slice(chosen, func(a, b int) bool { return chosen[a].first < chosen[b].first })
+
+	// the fragments are merged if they overlap after expanded
+	type span struct{ start, end int }
+	spans := make([]span, 0, len(chosen))
+	for _, f := range chosen {
+		start, end := expandFragment(doc, matches[f.first].Start, matches[f.last-1].End, fragmentSize)
+		if n := len(spans); n > 0 && start <= spans[n-1].end {
+			spans[n-1].end = max(spans[n-1].end, end)
+			continue
+		}
+		spans = append(spans, span{start, end})
+	}
+
+	var sb strings.Builder
+	for i, sp := range spans {
+		if i > 0 {
+			sb.WriteString(SNIPPET_FRAGMENT_SEPARATOR)
+		}
+		sb.WriteString(markMatches(doc, sp.start, sp.end, matches, preTag, postTag))
+	}
+	return sb.String()
+}
+
+// expandFragment expands doc[start:end] to about size characters, half on each side if possible, and then
+// shrinks it to the word boundaries.
+func expandFragment(doc string, start, end, size int) (int, int) {
+	pad := max(size-utf8.RuneCountInString(doc[start:end]), 0)
+	s, left := runesBefore(doc, start, pad/2)
+	e, right := runesAfter(doc, end, pad-left)
+	s, _ = runesBefore(doc, s, pad-left-right)
+
+	// do not cut the words
+	if s > 0 {
+		if i := strings.IndexFunc(doc[s:start], unicode.IsSpace); i >= 0 {
+			_, sz := utf8.DecodeRuneInString(doc[s+i:])
+			s += i + sz
+		}
+	}
+	if e < len(doc) {
+		if i := strings.LastIndexFunc(doc[end:e], unicode.IsSpace); i >= 0 {
+			e = end + i
+		}
+	}
+	return s, e
+}
+
+// runesBefore moves pos backward by at most n characters and returns the new position and the number moved
+func runesBefore(doc string, pos, n int) (int, int) {
+	i := 0
+	for ; i < n && pos > 0; i++ {
+		_, sz := utf8.DecodeLastRuneInString(doc[:pos])
+		pos -= sz
+	}
+	return pos, i
+}
+
+// runesAfter moves pos forward by at most n characters and returns the new position and the number moved
+func runesAfter(doc string, pos, n int) (int, int) {
+	i := 0
+	for ; i < n && pos < len(doc); i++ {
+		_, sz := utf8.DecodeRuneInString(doc[pos:])
+		pos += sz
+	}
+	return pos, i
+}
+
+// markMatches returns doc[start:end] with the matches enclosed by preTag and postTag
+func markMatches(doc string, start, end int, matches []Match, preTag, postTag string) string {
+	var sb strings.Builder
+	for _, m := range matches {
+		if m.Start < start || m.End > end {
+			continue
+		}
+		sb.WriteString(doc[start:m.Start])
+		sb.WriteString(preTag)
+		sb.WriteString(doc[m.Start:m.End])
+		sb.WriteString(postTag)
+		start = m.End
+	}
+	sb.WriteString(doc[start:end])
+	return sb.String()
+}
+
+// the same as the breaker and latin characters of the SimpleTokenizer
+func isLatinRune(r rune) bool {
+	if r < 128 {
+		return r >= '0' && r <= '9' || r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z'
+	}
+	return r < 0x7FF && !unicode.IsPunct(r) && !unicode.IsSpace(r)
+}
+
+func latinWordEnd(doc string, start int) int {
+	end := start
+	for end < len(doc) {
+		r, sz := utf8.DecodeRuneInString(doc[end:])
+		if !isLatinRune(r) {
+			break
+		}
+		end += sz
+	}
+	return end
+}
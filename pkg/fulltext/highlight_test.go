@@ -0,0 +1,94 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		pattern string
+		params  string
+		doc     string
+		expect  string
+	}{
+		{
+			pattern: "apple banana",
+			doc:     "Apple pie, apple juice and a BANANA.",
+			expect:  "<b>Apple</b> pie, <b>apple</b> juice and a <b>BANANA</b>.",
+		},
+		{
+			pattern: "+apple -juice",
+			doc:     "Apple pie, apple juice",
+			expect:  "<b>Apple</b> pie, <b>apple</b> juice",
+		},
+		{
+			pattern: "app* \"green tea\"",
+			doc:     "green apps and tea",
+			expect:  "<b>green</b> <b>apps</b> and <b>tea</b>",
+		},
+		{
+			pattern: "running dogs",
+			params:  `{"parser":"ngram","analyzer":"english"}`,
+			doc:     "The dog runs. Dogs were running",
+			expect:  "The <b>dog</b> <b>runs</b>. <b>Dogs</b> were <b>running</b>",
+		},
+		{
+			pattern: "中文字",
+			doc:     "这是中文字符",
+			expect:  "这是<b>中文字</b>符",
+		},
+		{
+			pattern: "pear",
+			doc:     "no match",
+			expect:  "no match",
+		},
+	}
+
+	for _, tt := range tests {
+		h, err := NewHighlighter(tt.pattern, tt.params)
+		require.Nil(t, err)
+		assert.Equal(t, tt.expect, h.Highlight(tt.doc, HIGHLIGHT_DEFAULT_PRE_TAG, HIGHLIGHT_DEFAULT_POST_TAG), tt.pattern)
+	}
+
+	_, err := NewHighlighter("apple", `{"analyzer":"klingon"}`)
+	require.NotNil(t, err)
+}
+
+func TestSnippet(t *testing.T) {
+	doc := "Lorem ipsum dolor sit amet, consectetur adipiscing elit. Apple trees grow in the orchard. " +
+		"Sed do eiusmod tempor incididunt ut labore et dolore magna aliqua. A banana is yellow and an apple is red. " +
+		"Ut enim ad minim veniam, quis nostrud exercitation ullamco laboris."
+
+	h, err := NewHighlighter("apple banana", "")
+	require.Nil(t, err)
+
+	// the fragment with both words is the best
+	assert.Equal(t, "A [banana] is yellow and an [apple] is", h.Snippet(doc, "[", "]", 40, 1))
+	assert.Equal(t, "elit. [Apple] trees grow in ... A [banana] is yellow and an [apple] is",
+		h.Snippet(doc, "[", "]", 40, 3))
+
+	// the fragments are merged when they overlap
+	assert.Equal(t, "[Apple] trees grow in the orchard.", h.Snippet("Apple trees grow in the orchard.", "[", "]", 200, 3))
+
+	// the beginning of the document without match
+	h, err = NewHighlighter("pear", "")
+	require.Nil(t, err)
+	assert.Equal(t, "Lorem ipsum dolor", h.Snippet(doc, "[", "]", 20, 3))
+}
@@ -0,0 +1,293 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+/*
+PorterStem is the Porter stemming algorithm for English (M.F. Porter, 1980, An algorithm for suffix stripping),
+ported from the reference C implementation by the author, including its two departures from the paper
+(-bli to -ble and -logi to -log in step 2).
+
+b[0..k] is the word being stemmed, and j is set by ends() to the position before the matched suffix.
+*/
+
+type porterStemmer struct {
+	b []byte
+	k int
+	j int
+}
+
+// PorterStem returns the stem of an English word, e.g. "connections", "connected" and "connecting" are all
+// "connect".  The word must be in lower case.  Words shorter than 3 letters, or with characters other than
+// 'a' to 'z', are returned as they are.
+func PorterStem(word string) string {
+	if len(word) < 3 {
+		return word
+	}
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || word[i] > 'z' {
+			return word
+		}
+	}
+
+	z := &porterStemmer{b: []byte(word), k: len(word) - 1}
+	z.step1ab()
+	if z.k > 0 {
+		z.step1c()
+		z.step2()
+		z.step3()
+		z.step4()
+		z.step5()
+	}
+	return string(z.b[:z.k+1])
+}
+
+// cons is true if b[i] is a consonant.
+func (z *porterStemmer) cons(i int) bool {
+	switch z.b[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		if i == 0 {
+			return true
+		}
+		return !z.cons(i - 1)
+	default:
+		return true
+	}
+}
+
+// m measures the number of consonant sequences between 0 and j, i.e. n in [C](VC){n}[V].
+func (z *porterStemmer) m() int {
+	n, i := 0, 0
+	for {
+		if i > z.j {
+			return n
+		}
+		if !z.cons(i) {
+			break
+		}
+		i++
+	}
+	i++
+	for {
+		for {
+			if i > z.j {
+				return n
+			}
+			if z.cons(i) {
+				break
+			}
+			i++
+		}
+		i++
+		n++
+		for {
+			if i > z.j {
+				return n
+			}
+			if !z.cons(i) {
+				break
+			}
+			i++
+		}
+		i++
+	}
+}
+
+// vowelInStem is true if b[0..j] contains a vowel.
+func (z *porterStemmer) vowelInStem() bool {
+	for i := 0; i <= z.j; i++ {
+		if !z.cons(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// doublec is true if b[i-1..i] is a double consonant.
+func (z *porterStemmer) doublec(i int) bool {
+	if i < 1 || z.b[i] != z.b[i-1] {
+		return false
+	}
+	return z.cons(i)
+}
+
+// cvc is true if b[i-2..i] is consonant-vowel-consonant and the last consonant is not w, x or y, e.g.
+// cav(e), lov(e), hop(e), crim(e), but not snow, box, tray.
+func (z *porterStemmer) cvc(i int) bool {
+	if i < 2 || !z.cons(i) || z.cons(i-1) || !z.cons(i-2) {
+		return false
+	}
+	switch z.b[i] {
+	case 'w', 'x', 'y':
+		return false
+	}
+	return true
+}
+
+// ends is true if b[0..k] ends with s, and then sets j.
+func (z *porterStemmer) ends(s string) bool {
+	l := len(s)
+	if l > z.k+1 || string(z.b[z.k-l+1:z.k+1]) != s {
+		return false
+	}
+	z.j = z.k - l
+	return true
+}
+
+// setto replaces b[j+1..k] with s.
+func (z *porterStemmer) setto(s string) {
+	z.b = append(z.b[:z.j+1], s...)
+	z.k = z.j + len(s)
+}
+
+func (z *porterStemmer) r(s string) {
+	if z.m() > 0 {
+		z.setto(s)
+	}
+}
+
+// step1ab gets rid of plurals and -ed or -ing, e.g. caresses -> caress, ponies -> poni, meetings -> meet.
+func (z *porterStemmer) step1ab() {
+	if z.b[z.k] == 's' {
+		if z.ends("sses") {
+			z.k -= 2
+		} else if z.ends("ies") {
+			z.setto("i")
+		} else if z.b[z.k-1] != 's' {
+			z.k--
+		}
+	}
+	if z.ends("eed") {
+		if z.m() > 0 {
+			z.k--
+		}
+	} else if (z.ends("ed") || z.ends("ing")) && z.vowelInStem() {
+		z.k = z.j
+		if z.ends("at") {
+			z.setto("ate")
+		} else if z.ends("bl") {
+			z.setto("ble")
+		} else if z.ends("iz") {
+			z.setto("ize")
+		} else if z.doublec(z.k) {
+			z.k--
+			switch z.b[z.k] {
+			case 'l', 's', 'z':
+				z.k++
+			}
+		} else if z.m() == 1 && z.cvc(z.k) {
+			z.setto("e")
+		}
+	}
+	z.b = z.b[:z.k+1]
+}
+
+// step1c turns terminal y to i when there is another vowel in the stem.
+func (z *porterStemmer) step1c() {
+	if z.ends("y") && z.vowelInStem() {
+		z.b[z.k] = 'i'
+	}
+}
+
+// porterSuffix is a suffix and its replacement, in the order of the reference implementation.
+type porterSuffix struct {
+	suffix, to string
+}
+
+var porterStep2 = map[byte][]porterSuffix{
+	'a': {{"ational", "ate"}, {"tional", "tion"}},
+	'c': {{"enci", "ence"}, {"anci", "ance"}},
+	'e': {{"izer", "ize"}},
+	'l': {{"bli", "ble"}, {"alli", "al"}, {"entli", "ent"}, {"eli", "e"}, {"ousli", "ous"}},
+	'o': {{"ization", "ize"}, {"ation", "ate"}, {"ator", "ate"}},
+	's': {{"alism", "al"}, {"iveness", "ive"}, {"fulness", "ful"}, {"ousness", "ous"}},
+	't': {{"aliti", "al"}, {"iviti", "ive"}, {"biliti", "ble"}},
+	'g': {{"logi", "log"}},
+}
+
+var porterStep3 = map[byte][]porterSuffix{
+	'e': {{"icate", "ic"}, {"ative", ""}, {"alize", "al"}},
+	'i': {{"iciti", "ic"}},
+	'l': {{"ical", "ic"}, {"ful", ""}},
+	's': {{"ness", ""}},
+}
+
+var porterStep4 = map[byte][]string{
+	'a': {"al"},
+	'c': {"ance", "ence"},
+	'e': {"er"},
+	'i': {"ic"},
+	'l': {"able", "ible"},
+	'n': {"ant", "ement", "ment", "ent"},
+	's': {"ism"},
+	't': {"ate", "iti"},
+	'u': {"ous"},
+	'v': {"ive"},
+	'z': {"ize"},
+}
+
+// step2 maps double suffixes to single ones, e.g. -ization -> -ize, when m() > 0.
+func (z *porterStemmer) step2() {
+	for _, s := range porterStep2[z.b[z.k-1]] {
+		if z.ends(s.suffix) {
+			z.r(s.to)
+			return
+		}
+	}
+}
+
+// step3 deals with -ic-, -full, -ness etc.
+func (z *porterStemmer) step3() {
+	for _, s := range porterStep3[z.b[z.k]] {
+		if z.ends(s.suffix) {
+			z.r(s.to)
+			return
+		}
+	}
+}
+
+// step4 takes off -ant, -ence etc. in context <c>vcvc<v>.
+func (z *porterStemmer) step4() {
+	matched := false
+	if z.b[z.k-1] == 'o' {
+		// -ion is only removed after s or t
+		matched = (z.ends("ion") && z.j >= 0 && (z.b[z.j] == 's' || z.b[z.j] == 't')) || z.ends("ou")
+	} else {
+		for _, s := range porterStep4[z.b[z.k-1]] {
+			if z.ends(s) {
+				matched = true
+				break
+			}
+		}
+	}
+	if matched && z.m() > 1 {
+		z.k = z.j
+	}
+}
+
+// step5 removes a final -e if m() > 1, and changes -ll to -l if m() > 1.
+func (z *porterStemmer) step5() {
+	z.j = z.k
+	if z.b[z.k] == 'e' {
+		a := z.m()
+		if a > 1 || a == 1 && !z.cvc(z.k-1) {
+			z.k--
+		}
+	}
+	if z.b[z.k] == 'l' && z.doublec(z.k) && z.m() > 1 {
+		z.k--
+	}
+}
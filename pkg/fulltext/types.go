@@ -50,9 +50,39 @@ Find rows that contain words such as “apple”, “apples”, “applesauce”
 Find rows that contain the exact phrase “some words” (for example, rows that contain “some words of wisdom” but not “some noise words”). Note that the " characters that enclose the phrase are operator characters that delimit the phrase. They are not the quotation marks that enclose the search string itself.
 */
 
-// Parser parameters
+// Parser parameters.  They are the algo params of the fulltext index, so the values are all strings.
 type FullTextParserParam struct {
 	Parser string `json:"parser"`
+	// Analyzer and Stopwords are the normalization of the words, see analyzer.go
+	Analyzer  string `json:"analyzer,omitempty"`
+	Stopwords string `json:"stopwords,omitempty"`
+	// Scorer is the ranking function, "tfidf" (default) or "bm25" with the parameters BM25K1 and BM25B
+	Scorer string `json:"scorer,omitempty"`
+	BM25K1 string `json:"bm25_k1,omitempty"`
+	BM25B  string `json:"bm25_b,omitempty"`
+}
+
+// HybridSearchParam is the parameter of the hybrid_search table function, built by the planner for
+// ORDER BY rrf_score(MATCH() AGAINST(), dist_fn(col, query)) DESC LIMIT.  The documents that match the pattern
+// and the nearest rows of the query vector are ranked separately, and the two ranks are combined by
+// reciprocal rank fusion: score = sum(1 / (K + rank)).
+type HybridSearchParam struct {
+	SrcTable   string `json:"src"`
+	IndexTable string `json:"index"`
+	Pattern    string `json:"pattern"`
+	Mode       int64  `json:"mode"`
+	// Params is the algo params of the fulltext index, i.e. FullTextParserParam
+	Params   string `json:"params,omitempty"`
+	PkCol    string `json:"pk"`
+	VecCol   string `json:"vec"`
+	DistFunc string `json:"dist"`
+	// Desc means the nearest rows have the largest value of DistFunc (inner_product)
+	Desc bool  `json:"desc,omitempty"`
+	K    int64 `json:"k"`
+	// Depth is the number of rows ranked by each search
+	Depth int64 `json:"depth"`
+	// Filter is the WHERE clause of the vector search, i.e. the filters of the scan on the source table
+	Filter string `json:"filter,omitempty"`
 }
 
 // Word is associated with particular DocId (index.doc_id) and could have multiple positions
@@ -76,8 +106,32 @@ type SearchAccum struct {
 	Params     string
 	WordAccums map[string]*WordAccum
 	Nrow       int64
+	Analyzer   *Analyzer
+	ScoreAlgo  FullTextScoreAlgo
+	K1         float64
+	B          float64
+	// DocLens is the number of words of each matched document and AvgDocLen is the average of all documents.
+	// They are only required by BM25.
+	DocLens   map[any]int32
+	AvgDocLen float64
 }
 
+// Ranking function of the search
+type FullTextScoreAlgo int
+
+const (
+	ALGO_TFIDF FullTextScoreAlgo = iota
+	ALGO_BM25
+)
+
+const (
+	SCORER_TFIDF = "tfidf"
+	SCORER_BM25  = "bm25"
+
+	BM25_DEFAULT_K1 = 1.2
+	BM25_DEFAULT_B  = 0.75
+)
+
 // Boolean mode search string parsing
 type FullTextBooleanOperator int
 
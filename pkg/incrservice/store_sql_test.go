@@ -123,6 +123,16 @@ func (tls *testLockService) Unlock(ctx context.Context, txnID []byte, commitTS t
 	panic("implement me")
 }
 
+func (tls *testLockService) MarkTxnLocks(txnID []byte) lockservice.TxnLockMark {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (tls *testLockService) UnlockAfterMark(ctx context.Context, txnID []byte, mark lockservice.TxnLockMark) error {
+	//TODO implement me
+	panic("implement me")
+}
+
 func (tls *testLockService) IsOrphanTxn(ctx context.Context, bytes []byte) (bool, error) {
 	return false, moerr.NewInternalErrorNoCtx("return error")
 }
@@ -245,6 +255,11 @@ func (tTxnOp *testTxnOperator) Rollback(ctx context.Context) error {
 	panic("implement me")
 }
 
+func (tTxnOp *testTxnOperator) XAPrepare(ctx context.Context, xid []byte) error {
+	//TODO implement me
+	panic("implement me")
+}
+
 func (tTxnOp *testTxnOperator) AddLockTable(locktable lock.LockTable) error {
 	//TODO implement me
 	panic("implement me")
@@ -141,9 +141,18 @@ func (l *localLockTable) doLock(
 		// or other concurrent txn method.
 		oldTxnID := c.txn.txnID
 		old = c.w
+		ctx := c.ctx
+		deadline, hasDeadline := c.waitDeadline()
+		var cancel context.CancelFunc
+		if hasDeadline {
+			ctx, cancel = context.WithDeadline(c.ctx, deadline)
+		}
 		c.txn.Unlock()
-		v := c.w.wait(c.ctx, l.logger)
+		v := c.w.wait(ctx, l.logger)
 		c.txn.Lock()
+		if cancel != nil {
+			cancel()
+		}
 
 		logLocalLockWaitOnResult(l.logger, c.txn, table, c.rows[c.idx], c.opts, c.w, v)
 
@@ -153,6 +162,13 @@ func (l *localLockTable) doLock(
 			!bytes.Equal(c.w.txn.TxnID, oldTxnID)) {
 			e = ErrTxnNotFound
 		}
+		// the async lock is notified by the events, so the deadline can only be
+		// checked after the waiter is notified.
+		if hasDeadline &&
+			c.ctx.Err() == nil &&
+			(e == context.DeadlineExceeded || (e == nil && !time.Now().Before(deadline))) {
+			e = ErrLockWaitTimeout
+		}
 		if e != nil ||
 			c.txn.deadlockFound {
 			c.closed = true
@@ -313,6 +329,14 @@ func (l *localLockTable) unlock(
 	}
 }
 
+func (l *localLockTable) unlockRows(
+	txn *activeTxn,
+	ls *cowSlice,
+	mutations ...pb.ExtraMutation) error {
+	l.unlock(txn, ls, timestamp.Timestamp{}, mutations...)
+	return nil
+}
+
 func (l *localLockTable) getLock(
 	key []byte,
 	txn pb.WaitTxn,
@@ -398,6 +422,12 @@ func (l *localLockTable) acquireRowLockLocked(c *lockContext) error {
 				continue
 			}
 
+			// the row is held by others, leave it to the caller
+			if c.opts.Policy == pb.WaitPolicy_SkipLocked {
+				c.result.SkippedRows = append(c.result.SkippedRows, row)
+				continue
+			}
+
 			// need wait for prev txn closed
 			if c.w == nil {
 				c.w = acquireWaiter(c.waitTxn)
@@ -126,14 +126,36 @@ func (lp *localLockTableProxy) unlock(
 	ls *cowSlice,
 	commitTS timestamp.Timestamp,
 	_ ...pb.ExtraMutation) {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	if mutations, ok := lp.removeHolderLocked(txn, ls); ok {
+		lp.remote.unlock(txn, ls, commitTS, mutations...)
+	}
+}
+
+func (lp *localLockTableProxy) unlockRows(
+	txn *activeTxn,
+	ls *cowSlice,
+	_ ...pb.ExtraMutation) error {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	if mutations, ok := lp.removeHolderLocked(txn, ls); ok {
+		return lp.remote.unlockRows(txn, ls, mutations...)
+	}
+	return nil
+}
+
+// removeHolderLocked removes the txn from the shared locks of the rows, and returns the mutations to unlock
+// them on the remote lock table. It returns false if all the rows are held by other txns on the remote.
+func (lp *localLockTableProxy) removeHolderLocked(
+	txn *activeTxn,
+	ls *cowSlice) ([]pb.ExtraMutation, bool) {
 	rows := ls.slice()
 	defer rows.unref()
 
 	skipped := 0
 	n := rows.len()
 	var mutations []pb.ExtraMutation
-	lp.mu.Lock()
-	defer lp.mu.Unlock()
 	rows.iter(func(key []byte) bool {
 		row := util.UnsafeBytesToString(key)
 		if v, ok := lp.mu.holders[row]; ok {
@@ -173,10 +195,9 @@ func (lp *localLockTableProxy) unlock(
 
 	// all skipped
 	if skipped == rows.len() {
-		return
+		return nil, false
 	}
-
-	lp.remote.unlock(txn, ls, commitTS, mutations...)
+	return mutations, true
 }
 
 func (lp *localLockTableProxy) isRemoteHolderLocked(
@@ -84,11 +84,26 @@ func (l *remoteLockTable) lock(
 	req.Lock.ServiceID = l.serviceID
 	req.Lock.Rows = rows
 
+	// the remote lock table checks the deadline only after the waiter is notified,
+	// so we also need to stop waiting for the response at the deadline.
+	sendCtx := ctx
+	if opts.Policy == pb.WaitPolicy_Wait &&
+		opts.WaitTimeout > 0 {
+		var cancel context.CancelFunc
+		sendCtx, cancel = context.WithTimeout(ctx, time.Duration(opts.WaitTimeout))
+		defer cancel()
+	}
+
 	// rpc maybe wait too long, to avoid deadlock, we need unlock txn, and lock again
 	// after rpc completed
 	txn.Unlock()
-	resp, err := l.client.Send(ctx, req)
+	resp, err := l.client.Send(sendCtx, req)
 	txn.Lock()
+	if err != nil &&
+		sendCtx.Err() == context.DeadlineExceeded &&
+		ctx.Err() == nil {
+		err = ErrLockWaitTimeout
+	}
 
 	// txn closed
 	if !bytes.Equal(req.Lock.TxnID, txn.txnID) {
@@ -154,6 +169,33 @@ func (l *remoteLockTable) unlock(
 	}
 }
 
+func (l *remoteLockTable) unlockRows(
+	txn *activeTxn,
+	ls *cowSlice,
+	mutations ...pb.ExtraMutation) error {
+	logUnlockTableOnRemote(
+		l.logger,
+		txn,
+		l.bind,
+	)
+	rows := ls.slice()
+	defer rows.unref()
+	err := l.doUnlockRows(txn, rows.all(), mutations...)
+	if err == nil {
+		return nil
+	}
+
+	logUnlockTableOnRemoteFailed(
+		l.logger,
+		txn,
+		l.bind,
+		err,
+	)
+	// handleError returns nil meaning bind changed, then all locks on the
+	// old bind are released.
+	return l.handleError(err, false)
+}
+
 func (l *remoteLockTable) getLock(
 	key []byte,
 	txn pb.WaitTxn,
@@ -199,6 +241,30 @@ func (l *remoteLockTable) doUnlock(
 	return moerr.AttachCause(ctx, err)
 }
 
+func (l *remoteLockTable) doUnlockRows(
+	txn *activeTxn,
+	rows [][]byte,
+	mutations ...pb.ExtraMutation) error {
+	ctx, cancel := context.WithTimeoutCause(context.Background(), defaultRPCTimeout, moerr.CauseDoUnlock)
+	defer cancel()
+
+	req := acquireRequest()
+	defer releaseRequest(req)
+
+	req.Method = pb.Method_UnlockRows
+	req.LockTable = l.bind
+	req.Unlock.TxnID = txn.txnID
+	req.Unlock.Rows = rows
+	req.Unlock.Mutations = mutations
+
+	resp, err := l.client.Send(ctx, req)
+	if err == nil {
+		defer releaseResponse(resp)
+		return l.maybeHandleBindChanged(resp)
+	}
+	return moerr.AttachCause(ctx, err)
+}
+
 func (l *remoteLockTable) doGetLock(key []byte, txn pb.WaitTxn) (Lock, bool, error) {
 	ctx, cancel := context.WithTimeoutCause(context.Background(), defaultRPCTimeout, moerr.CauseDoGetLock)
 	defer cancel()
@@ -157,6 +157,7 @@ func (c *client) AsyncSend(ctx context.Context, request *pb.Request) (*morpc.Fut
 				})
 		case pb.Method_Lock,
 			pb.Method_Unlock,
+			pb.Method_UnlockRows,
 			pb.Method_GetTxnLock,
 			pb.Method_KeepRemoteLock:
 			sid = getUUIDFromServiceIdentifier(request.LockTable.ServiceID)
@@ -235,6 +235,39 @@ func (s *service) Unlock(
 	return nil
 }
 
+func (s *service) MarkTxnLocks(txnID []byte) TxnLockMark {
+	txn := s.activeTxnHolder.getActiveTxn(txnID, false, "")
+	if txn == nil {
+		return nil
+	}
+
+	txn.RLock()
+	defer txn.RUnlock()
+	if !bytes.Equal(txn.txnID, txnID) {
+		return nil
+	}
+	return txn.lockMarkLocked()
+}
+
+func (s *service) UnlockAfterMark(
+	ctx context.Context,
+	txnID []byte,
+	mark TxnLockMark) error {
+	s.wait()
+
+	txn := s.activeTxnHolder.getActiveTxn(txnID, false, "")
+	if txn == nil {
+		return nil
+	}
+
+	txn.Lock()
+	defer txn.Unlock()
+	if !bytes.Equal(txn.txnID, txnID) {
+		return nil
+	}
+	return txn.unlockAfterMarkLocked(mark, s.getLockTable, s.logger)
+}
+
 func (s *service) IsOrphanTxn(
 	ctx context.Context,
 	txn []byte,
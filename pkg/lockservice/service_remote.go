@@ -47,6 +47,7 @@ var methodVersions = map[pb.Method]int64{
 	pb.Method_GetActiveTxn:       defines.MORPCVersion2,
 	pb.Method_CheckOrphan:        defines.MORPCVersion2,
 	pb.Method_ResumeInvalidCN:    defines.MORPCVersion2,
+	pb.Method_UnlockRows:         defines.MORPCVersion4,
 }
 
 func (s *service) initRemote() {
@@ -162,6 +163,8 @@ func (s *service) initRemoteHandler() {
 		s.handleForwardLock)
 	s.remote.server.RegisterMethodHandler(pb.Method_Unlock,
 		s.handleRemoteUnlock)
+	s.remote.server.RegisterMethodHandler(pb.Method_UnlockRows,
+		s.handleRemoteUnlockRows)
 	s.remote.server.RegisterMethodHandler(pb.Method_GetTxnLock,
 		s.handleRemoteGetLock)
 	s.remote.server.RegisterMethodHandler(pb.Method_GetWaitingList,
@@ -310,6 +313,50 @@ func (s *service) handleRemoteUnlock(
 	writeResponse(s.logger, cancel, resp, err, cs)
 }
 
+func (s *service) handleRemoteUnlockRows(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	req *pb.Request,
+	resp *pb.Response,
+	cs morpc.ClientSession) {
+	l, err := s.getLocalLockTable(req, resp)
+	if err != nil ||
+		l == nil {
+		// means that the lockservice sending the lock request holds a stale lock
+		// table binding.
+		writeResponse(s.logger, cancel, resp, err, cs)
+		return
+	}
+
+	txn := s.activeTxnHolder.getActiveTxn(req.Unlock.TxnID, false, "")
+	if txn == nil {
+		writeResponse(s.logger, cancel, resp, nil, cs)
+		return
+	}
+	txn.Lock()
+	defer txn.Unlock()
+	if !bytes.Equal(txn.txnID, req.Unlock.TxnID) {
+		writeResponse(s.logger, cancel, resp, nil, cs)
+		return
+	}
+
+	rows, err := newCowSlice(txn.fsp, req.Unlock.Rows)
+	if err != nil {
+		writeResponse(s.logger, cancel, resp, err, cs)
+		return
+	}
+	defer rows.close()
+	if err = l.unlockRows(txn, rows, req.Unlock.Mutations...); err == nil {
+		removed := make(map[string]struct{}, len(req.Unlock.Rows))
+		for _, row := range req.Unlock.Rows {
+			removed[string(row)] = struct{}{}
+		}
+		bind := l.getBind()
+		txn.lockRemoved(bind.Group, bind.Table, removed)
+	}
+	writeResponse(s.logger, cancel, resp, err, cs)
+}
+
 func (s *service) handleValidateService(
 	ctx context.Context,
 	cancel context.CancelFunc,
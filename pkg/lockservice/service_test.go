@@ -2432,6 +2432,142 @@ func TestRestartInRollingRestartCN(t *testing.T) {
 	)
 }
 
+func TestUnlockAfterMark(t *testing.T) {
+	runLockServiceTests(
+		t,
+		[]string{"s1", "s2"},
+		func(alloc *lockTableAllocator, ss []*service) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			table := uint64(0)
+			option := newTestRowExclusiveOptions()
+			// bind the lock table to s1
+			_, err := ss[0].Lock(ctx, table, newTestRows(0), newTestTxnID(0), option)
+			require.NoError(t, err)
+			require.NoError(t, ss[0].Unlock(ctx, newTestTxnID(0), timestamp.Timestamp{}))
+			l, err := ss[0].getLockTable(0, table)
+			require.NoError(t, err)
+			lt := l.(*localLockTable)
+
+			// s1 locks on the local lock table, s2 on the remote one
+			for i, s := range ss {
+				txn := newTestTxnID(byte(i + 1))
+				_, err = s.Lock(ctx, table, newTestRows(byte(10*i+1)), txn, option)
+				require.NoError(t, err)
+				mark := s.MarkTxnLocks(txn)
+				require.Equal(t, 1, mark[0][table])
+
+				_, err = s.Lock(ctx, table, newTestRows(byte(10*i+1), byte(10*i+2)), txn, option)
+				require.NoError(t, err)
+				require.NoError(t, s.UnlockAfterMark(ctx, txn, mark))
+
+				checkLock(t, lt, []byte{byte(10*i + 1)}, [][]byte{txn}, nil, nil)
+				checkLock(t, lt, []byte{byte(10*i + 2)}, nil, nil, nil)
+				// the txn does not hold the released lock either
+				at := s.activeTxnHolder.getActiveTxn(txn, false, "")
+				require.Equal(t, newTestRows(byte(10*i+1)), locksAfter(at.lockHolders[0].tableKeys[table], 0, notRangeLock))
+
+				require.NoError(t, s.Unlock(ctx, txn, timestamp.Timestamp{}))
+				checkLock(t, lt, []byte{byte(10*i + 1)}, nil, nil, nil)
+			}
+		},
+	)
+}
+
+func TestUnlockAfterMarkWithRangeLock(t *testing.T) {
+	runLockServiceTests(
+		t,
+		[]string{"s1", "s2"},
+		func(alloc *lockTableAllocator, ss []*service) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			table := uint64(0)
+			rowOption := newTestRowExclusiveOptions()
+			rangeOption := newTestRangeExclusiveOptions()
+			// bind the lock table to s1
+			_, err := ss[0].Lock(ctx, table, newTestRows(0), newTestTxnID(0), rowOption)
+			require.NoError(t, err)
+			require.NoError(t, ss[0].Unlock(ctx, newTestTxnID(0), timestamp.Timestamp{}))
+			l, err := ss[0].getLockTable(0, table)
+			require.NoError(t, err)
+			lt := l.(*localLockTable)
+
+			// s1 locks on the local lock table, s2 on the remote one
+			for i, s := range ss {
+				txn := newTestTxnID(byte(i + 1))
+				k := func(v int) byte { return byte(10*i + v) }
+
+				_, err = s.Lock(ctx, table, newTestRows(k(1)), txn, rowOption)
+				require.NoError(t, err)
+				mark := s.MarkTxnLocks(txn)
+
+				// the first range lock is merged with the row lock locked before the mark
+				_, err = s.Lock(ctx, table, newTestRows(k(1), k(3)), txn, rangeOption)
+				require.NoError(t, err)
+				_, err = s.Lock(ctx, table, newTestRows(k(5), k(6)), txn, rangeOption)
+				require.NoError(t, err)
+				require.NoError(t, s.UnlockAfterMark(ctx, txn, mark))
+
+				// the merged range lock is kept, the other one is released as a whole
+				checkLock(t, lt, []byte{k(1)}, [][]byte{txn}, nil, nil)
+				checkLock(t, lt, []byte{k(3)}, [][]byte{txn}, nil, nil)
+				checkLock(t, lt, []byte{k(5)}, nil, nil, nil)
+				checkLock(t, lt, []byte{k(6)}, nil, nil, nil)
+
+				require.NoError(t, s.Unlock(ctx, txn, timestamp.Timestamp{}))
+				checkLock(t, lt, []byte{k(1)}, nil, nil, nil)
+				checkLock(t, lt, []byte{k(3)}, nil, nil, nil)
+			}
+		},
+	)
+}
+
+func TestLocksAfter(t *testing.T) {
+	fsp := newFixedSlicePool(16)
+	rangeStarts := map[string]bool{"a": true, "d": true}
+	rangeEnds := map[string]bool{"c": true, "e": true}
+	rangeKind := func(key []byte) (bool, bool) {
+		return rangeStarts[string(key)], rangeEnds[string(key)]
+	}
+	keys := func(values ...string) [][]byte {
+		var v [][]byte
+		for _, value := range values {
+			v = append(v, []byte(value))
+		}
+		return v
+	}
+
+	cases := []struct {
+		all    [][]byte
+		n      int
+		expect [][]byte
+	}{
+		{all: keys("b", "c"), n: 2, expect: nil},
+		{all: keys("b", "c"), n: 1, expect: keys("c")},
+		{all: keys("b", "b", "c"), n: 1, expect: keys("c")},
+		// the range start is merged with the locks before the mark
+		{all: keys("a", "c", "d", "e"), n: 1, expect: keys("d", "e")},
+		// the row lock before the mark is merged as the range start later
+		{all: keys("a", "a", "c", "d", "e"), n: 1, expect: keys("d", "e")},
+		{all: keys("a", "b", "a", "c"), n: 1, expect: keys("b")},
+		// the range lock holding a key locked before the mark is kept as a whole
+		{all: keys("c", "a", "c", "d", "e"), n: 1, expect: keys("d", "e")},
+		{all: keys("e", "d", "e", "a", "c"), n: 1, expect: keys("a", "c")},
+	}
+	for _, c := range cases {
+		cs, err := newCowSlice(fsp, c.all)
+		require.NoError(t, err)
+		require.Equal(t, c.expect, locksAfter(cs, c.n, rangeKind))
+		cs.close()
+	}
+}
+
+func notRangeLock([]byte) (bool, bool) {
+	return false, false
+}
+
 func TestUnlockInRollingRestartCN(t *testing.T) {
 	runLockServiceTests(
 		t,
@@ -3810,6 +3946,105 @@ func TestRangeLockWithFailFast(t *testing.T) {
 	}
 }
 
+func TestRowLockWithSkipLocked(t *testing.T) {
+	for name, runner := range runners {
+		t.Run(name, func(t *testing.T) {
+			table := uint64(0)
+			runner(
+				t,
+				table,
+				func(
+					ctx context.Context,
+					s *service,
+					lt *localLockTable) {
+					option := newTestRowExclusiveOptions()
+					option.Policy = pb.WaitPolicy_SkipLocked
+					rows := newTestRows(1, 2, 3)
+					txn1 := newTestTxnID(1)
+					txn2 := newTestTxnID(2)
+
+					_, err := s.Lock(ctx, table, rows[1:2], txn1, option)
+					require.NoError(t, err)
+					defer func() {
+						assert.NoError(t, s.Unlock(ctx, txn1, timestamp.Timestamp{}))
+					}()
+
+					res, err := s.Lock(ctx, table, rows, txn2, option)
+					require.NoError(t, err)
+					defer func() {
+						assert.NoError(t, s.Unlock(ctx, txn2, timestamp.Timestamp{}))
+					}()
+					require.Equal(t, [][]byte{rows[1]}, res.SkippedRows)
+					checkLock(t, lt, rows[0], [][]byte{txn2}, nil, nil)
+					checkLock(t, lt, rows[1], [][]byte{txn1}, nil, nil)
+					checkLock(t, lt, rows[2], [][]byte{txn2}, nil, nil)
+				})
+		})
+	}
+}
+
+func TestRowLockWithWaitTimeout(t *testing.T) {
+	for name, runner := range runners {
+		t.Run(name, func(t *testing.T) {
+			table := uint64(0)
+			runner(
+				t,
+				table,
+				func(
+					ctx context.Context,
+					s *service,
+					lt *localLockTable) {
+					option := newTestRowExclusiveOptions()
+					option.WaitTimeout = int64(time.Millisecond * 100)
+					rows := newTestRows(1)
+					txn1 := newTestTxnID(1)
+					txn2 := newTestTxnID(2)
+
+					_, err := s.Lock(ctx, table, rows, txn1, option)
+					require.NoError(t, err)
+
+					_, err = s.Lock(ctx, table, rows, txn2, option)
+					require.True(t, moerr.IsMoErrCode(err, moerr.ErrLockWaitTimeout))
+					require.NoError(t, s.Unlock(ctx, txn1, timestamp.Timestamp{}))
+
+					_, err = s.Lock(ctx, table, rows, txn2, option)
+					require.NoError(t, err)
+					require.NoError(t, s.Unlock(ctx, txn2, timestamp.Timestamp{}))
+				})
+		})
+	}
+}
+
+func TestRemoteRowLockWithWaitTimeout(t *testing.T) {
+	runLockServiceTests(
+		t,
+		[]string{"s1", "s2"},
+		func(alloc *lockTableAllocator, ss []*service) {
+			s1 := ss[0]
+			s2 := ss[1]
+			table := uint64(10)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			option := newTestRowExclusiveOptions()
+			option.WaitTimeout = int64(time.Millisecond * 100)
+			rows := newTestRows(1)
+			txn1 := newTestTxnID(1)
+			txn2 := newTestTxnID(2)
+
+			// bind the table on s1
+			_, err := s1.Lock(ctx, table, rows, txn1, option)
+			require.NoError(t, err)
+
+			_, err = s2.Lock(ctx, table, rows, txn2, option)
+			require.True(t, moerr.IsMoErrCode(err, moerr.ErrLockWaitTimeout))
+
+			require.NoError(t, s1.Unlock(ctx, txn1, timestamp.Timestamp{}))
+			require.NoError(t, s2.Unlock(ctx, txn2, timestamp.Timestamp{}))
+		},
+	)
+}
+
 func TestIssue2128(t *testing.T) {
 	runLockServiceTests(
 		t,
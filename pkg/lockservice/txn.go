@@ -206,6 +206,137 @@ func (txn *activeTxn) close(
 	return nil
 }
 
+// lockMarkLocked returns the number of locks held by the txn on each lock table.
+func (txn *activeTxn) lockMarkLocked() TxnLockMark {
+	mark := make(TxnLockMark, len(txn.lockHolders))
+	for group, h := range txn.lockHolders {
+		tables := make(map[uint64]int, len(h.tableKeys))
+		for table, cs := range h.tableKeys {
+			s := cs.slice()
+			tables[table] = s.len()
+			s.unref()
+		}
+		mark[group] = tables
+	}
+	return mark
+}
+
+// unlockAfterMarkLocked releases the locks acquired after the mark, and removes them from the txn. The
+// keys also locked before the mark are kept.
+func (txn *activeTxn) unlockAfterMarkLocked(
+	mark TxnLockMark,
+	lockTableFunc func(uint32, uint64) (lockTable, error),
+	logger *log.MOLogger,
+) error {
+	wt := pb.WaitTxn{TxnID: txn.txnID, CreatedOn: txn.remoteService}
+	for group, h := range txn.lockHolders {
+		for table, cs := range h.tableKeys {
+			n := mark[group][table]
+			if n >= cs.mustGet().len() {
+				continue
+			}
+			l, err := lockTableFunc(group, table)
+			if err != nil {
+				return err
+			}
+			if l == nil {
+				continue
+			}
+			keys := locksAfter(cs, n, func(key []byte) (rangeStart, rangeEnd bool) {
+				l.getLock(key, wt, func(lock Lock) {
+					rangeStart, rangeEnd = lock.isLockRangeStart(), lock.isLockRangeEnd()
+				})
+				return
+			})
+			if len(keys) == 0 {
+				continue
+			}
+
+			logTxnUnlockTable(logger, txn, table)
+			released, err := newCowSlice(txn.fsp, keys)
+			if err != nil {
+				return err
+			}
+			err = l.unlockRows(txn, released)
+			released.close()
+			if err != nil {
+				return err
+			}
+
+			removed := make(map[string]struct{}, len(keys))
+			for _, key := range keys {
+				removed[string(key)] = struct{}{}
+			}
+			txn.lockRemoved(group, table, removed)
+		}
+	}
+	return nil
+}
+
+// locksAfter returns the keys locked after the first n ones, without the locks held in the first n ones
+// too, and without duplicates. A range lock is stored as its start and end keys, which are always kept
+// or dropped together, and the range lock holding any key locked before is kept.
+func locksAfter(
+	cs *cowSlice,
+	n int,
+	rangeKind func(key []byte) (rangeStart, rangeEnd bool),
+) [][]byte {
+	s := cs.slice()
+	defer s.unref()
+	all := s.all()
+	if n >= len(all) {
+		return nil
+	}
+	// the mark splits a range lock merged with the locks before it
+	if n > 0 {
+		start, _ := rangeKind(all[n-1])
+		_, end := rangeKind(all[n])
+		if start && end {
+			n++
+		}
+	}
+
+	seen := make(map[string]struct{}, len(all))
+	for _, key := range all[:n] {
+		seen[util.UnsafeBytesToString(key)] = struct{}{}
+	}
+	duplicated := false
+	for _, key := range all[n:] {
+		if _, ok := seen[util.UnsafeBytesToString(key)]; ok {
+			duplicated = true
+			break
+		}
+		seen[util.UnsafeBytesToString(key)] = struct{}{}
+	}
+	if !duplicated {
+		return all[n:]
+	}
+
+	clear(seen)
+	for _, key := range all[:n] {
+		seen[util.UnsafeBytesToString(key)] = struct{}{}
+	}
+	var keys [][]byte
+	for i := n; i < len(all); i++ {
+		lock := all[i : i+1]
+		if start, _ := rangeKind(all[i]); start && i+1 < len(all) {
+			lock = all[i : i+2]
+			i++
+		}
+		held := false
+		for _, key := range lock {
+			if _, ok := seen[util.UnsafeBytesToString(key)]; ok {
+				held = true
+			}
+			seen[util.UnsafeBytesToString(key)] = struct{}{}
+		}
+		if !held {
+			keys = append(keys, lock...)
+		}
+	}
+	return keys
+}
+
 func (txn *activeTxn) reset() {
 	for g, h := range txn.lockHolders {
 		for table, cs := range h.tableKeys {
@@ -42,6 +42,8 @@ var (
 	ErrLockTableNotFound = moerr.NewLockTableNotFoundNoCtx()
 	// ErrLockConflict lock option conflict
 	ErrLockConflict = moerr.NewLockConflictNoCtx()
+	// ErrLockWaitTimeout lock wait timeout exceeded with the WaitTimeout option
+	ErrLockWaitTimeout = moerr.NewLockWaitTimeoutNoCtx()
 )
 
 // Option lockservice option
@@ -106,6 +108,11 @@ type LockService interface {
 	// Unlock release all locks associated with the transaction. If commitTS is not empty, means
 	// the txn was committed.
 	Unlock(ctx context.Context, txnID []byte, commitTS timestamp.Timestamp, mutations ...pb.ExtraMutation) error
+	// MarkTxnLocks returns the mark of the locks held by the transaction now, e.g. at a savepoint.
+	MarkTxnLocks(txnID []byte) TxnLockMark
+	// UnlockAfterMark release the locks acquired by the transaction after the mark, the locks held at
+	// the mark are kept.
+	UnlockAfterMark(ctx context.Context, txnID []byte, mark TxnLockMark) error
 	// IsOrphanTxn check txn is orphan txn
 	IsOrphanTxn(context.Context, []byte) (bool, error)
 
@@ -127,6 +134,11 @@ type LockService interface {
 	CloseRemoteLockTable(group uint32, tableID, version uint64) (bool, error)
 }
 
+// TxnLockMark is the number of locks held by a transaction on each lock table, group -> table -> count.
+// The locks of a table are kept in the order they were acquired, so the ones after the count are
+// acquired after the mark.
+type TxnLockMark map[uint32]map[uint64]int
+
 type ResumeLockService interface {
 	LockService
 
@@ -154,6 +166,8 @@ type lockTable interface {
 	lock(ctx context.Context, txn *activeTxn, rows [][]byte, options LockOptions, cb func(pb.Result, error))
 	// Unlock release a set of locks, if txn was committed, commitTS is not empty
 	unlock(txn *activeTxn, ls *cowSlice, commitTS timestamp.Timestamp, mutations ...pb.ExtraMutation)
+	// unlockRows release a part of the locks held by the txn, the txn keeps the others
+	unlockRows(txn *activeTxn, ls *cowSlice, mutations ...pb.ExtraMutation) error
 	// getLock get a lock
 	getLock(key []byte, txn pb.WaitTxn, fn func(Lock))
 	// getBind returns lock table binding
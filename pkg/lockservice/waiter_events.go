@@ -74,6 +74,16 @@ func (l *localLockTable) newLockContext(
 	return c
 }
 
+// waitDeadline returns the deadline of waiting for the conflicting locks, if
+// the lock options has a wait timeout.
+func (c *lockContext) waitDeadline() (time.Time, bool) {
+	if c.opts.Policy != pb.WaitPolicy_Wait ||
+		c.opts.WaitTimeout <= 0 {
+		return time.Time{}, false
+	}
+	return c.createAt.Add(time.Duration(c.opts.WaitTimeout)), true
+}
+
 func (c lockContext) TypeName() string {
 	return "lockservice.lockContext"
 }
@@ -81,18 +81,27 @@ func (LockMode) EnumDescriptor() ([]byte, []int) {
 type WaitPolicy int32
 
 const (
-	WaitPolicy_Wait     WaitPolicy = 0
+	// Wait wait until the conflicting locks are released, or the WaitTimeout of
+	// the lock options is reached.
+	WaitPolicy_Wait WaitPolicy = 0
+	// FastFail returns ErrLockConflict immediately if any row is held by others.
 	WaitPolicy_FastFail WaitPolicy = 1
+	// SkipLocked does not wait for the rows held by others, these rows are not
+	// locked and returned in Result.SkippedRows. Range locks cannot be skipped
+	// and still wait.
+	WaitPolicy_SkipLocked WaitPolicy = 2
 )
 
 var WaitPolicy_name = map[int32]string{
 	0: "Wait",
 	1: "FastFail",
+	2: "SkipLocked",
 }
 
 var WaitPolicy_value = map[string]int32{
-	"Wait":     0,
-	"FastFail": 1,
+	"Wait":       0,
+	"FastFail":   1,
+	"SkipLocked": 2,
 }
 
 func (x WaitPolicy) String() string {
@@ -168,6 +177,9 @@ const (
 	Method_CheckOrphan Method = 15
 	// ResumeInvalidCN resume invalid cn
 	Method_ResumeInvalidCN Method = 16
+	// UnlockRows unlock some rows of a txn from remote lock table, the txn
+	// keeps the other locks
+	Method_UnlockRows Method = 17
 )
 
 var Method_name = map[int32]string{
@@ -188,6 +200,7 @@ var Method_name = map[int32]string{
 	14: "CanRestartService",
 	15: "CheckOrphan",
 	16: "ResumeInvalidCN",
+	17: "UnlockRows",
 }
 
 var Method_value = map[string]int32{
@@ -208,6 +221,7 @@ var Method_value = map[string]int32{
 	"CanRestartService":  14,
 	"CheckOrphan":        15,
 	"ResumeInvalidCN":    16,
+	"UnlockRows":         17,
 }
 
 func (x Method) String() string {
@@ -251,18 +265,21 @@ func (Status) EnumDescriptor() ([]byte, []int) {
 
 // LockOptions lock options
 type LockOptions struct {
-	Granularity          Granularity         `protobuf:"varint,1,opt,name=Granularity,proto3,enum=lock.Granularity" json:"Granularity,omitempty"`
-	Mode                 LockMode            `protobuf:"varint,2,opt,name=Mode,proto3,enum=lock.LockMode" json:"Mode,omitempty"`
-	Policy               WaitPolicy          `protobuf:"varint,3,opt,name=Policy,proto3,enum=lock.WaitPolicy" json:"Policy,omitempty"`
-	ForwardTo            string              `protobuf:"bytes,4,opt,name=ForwardTo,proto3" json:"ForwardTo,omitempty"`
-	TableDefChanged      bool                `protobuf:"varint,5,opt,name=TableDefChanged,proto3" json:"TableDefChanged,omitempty"`
-	RetryWait            int64               `protobuf:"varint,6,opt,name=RetryWait,proto3" json:"RetryWait,omitempty"`
-	Group                uint32              `protobuf:"varint,7,opt,name=Group,proto3" json:"Group,omitempty"`
-	Sharding             Sharding            `protobuf:"varint,8,opt,name=Sharding,proto3,enum=lock.Sharding" json:"Sharding,omitempty"`
-	SnapShotTs           timestamp.Timestamp `protobuf:"bytes,9,opt,name=SnapShotTs,proto3" json:"SnapShotTs"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	Granularity     Granularity         `protobuf:"varint,1,opt,name=Granularity,proto3,enum=lock.Granularity" json:"Granularity,omitempty"`
+	Mode            LockMode            `protobuf:"varint,2,opt,name=Mode,proto3,enum=lock.LockMode" json:"Mode,omitempty"`
+	Policy          WaitPolicy          `protobuf:"varint,3,opt,name=Policy,proto3,enum=lock.WaitPolicy" json:"Policy,omitempty"`
+	ForwardTo       string              `protobuf:"bytes,4,opt,name=ForwardTo,proto3" json:"ForwardTo,omitempty"`
+	TableDefChanged bool                `protobuf:"varint,5,opt,name=TableDefChanged,proto3" json:"TableDefChanged,omitempty"`
+	RetryWait       int64               `protobuf:"varint,6,opt,name=RetryWait,proto3" json:"RetryWait,omitempty"`
+	Group           uint32              `protobuf:"varint,7,opt,name=Group,proto3" json:"Group,omitempty"`
+	Sharding        Sharding            `protobuf:"varint,8,opt,name=Sharding,proto3,enum=lock.Sharding" json:"Sharding,omitempty"`
+	SnapShotTs      timestamp.Timestamp `protobuf:"bytes,9,opt,name=SnapShotTs,proto3" json:"SnapShotTs"`
+	// WaitTimeout is the max duration to wait for conflicting locks with the Wait
+	// policy, 0 means no limit.
+	WaitTimeout          int64    `protobuf:"varint,10,opt,name=WaitTimeout,proto3" json:"WaitTimeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *LockOptions) Reset()         { *m = LockOptions{} }
@@ -361,6 +378,13 @@ func (m *LockOptions) GetSnapShotTs() timestamp.Timestamp {
 	return timestamp.Timestamp{}
 }
 
+func (m *LockOptions) GetWaitTimeout() int64 {
+	if m != nil {
+		return m.WaitTimeout
+	}
+	return 0
+}
+
 // LockTable describes which CN manages a Table's Locks.
 type LockTable struct {
 	// Table table id
@@ -1234,11 +1258,13 @@ type UnlockRequest struct {
 	TxnID []byte `protobuf:"bytes,1,opt,name=TxnID,proto3" json:"TxnID,omitempty"`
 	// CommitTS is the commit timestamp of the current txn. Empty if txn is
 	// roll backed
-	CommitTS             timestamp.Timestamp `protobuf:"bytes,2,opt,name=CommitTS,proto3" json:"CommitTS"`
-	Mutations            []ExtraMutation     `protobuf:"bytes,3,rep,name=Mutations,proto3" json:"Mutations"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	CommitTS  timestamp.Timestamp `protobuf:"bytes,2,opt,name=CommitTS,proto3" json:"CommitTS"`
+	Mutations []ExtraMutation     `protobuf:"bytes,3,rep,name=Mutations,proto3" json:"Mutations"`
+	// Rows is the rows to unlock of the UnlockRows request
+	Rows                 [][]byte `protobuf:"bytes,4,rep,name=Rows,proto3" json:"Rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UnlockRequest) Reset()         { *m = UnlockRequest{} }
@@ -1295,6 +1321,13 @@ func (m *UnlockRequest) GetMutations() []ExtraMutation {
 	return nil
 }
 
+func (m *UnlockRequest) GetRows() [][]byte {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
 // UnlockResponse unlock lock on remote lock service response. CN -> CN
 type UnlockResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2447,7 +2480,10 @@ type Result struct {
 	Waiters         uint32 `protobuf:"varint,9,opt,name=Waiters,proto3" json:"Waiters,omitempty"`
 	// NewLockAdd if true means new lock added, false means lock is added before by current
 	// txn
-	NewLockAdd           bool     `protobuf:"varint,10,opt,name=NewLockAdd,proto3" json:"NewLockAdd,omitempty"`
+	NewLockAdd bool `protobuf:"varint,10,opt,name=NewLockAdd,proto3" json:"NewLockAdd,omitempty"`
+	// SkippedRows the rows which are held by other txns and skipped with the
+	// SkipLocked wait policy.
+	SkippedRows          [][]byte `protobuf:"bytes,11,rep,name=SkippedRows,proto3" json:"SkippedRows,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2556,6 +2592,13 @@ func (m *Result) GetNewLockAdd() bool {
 	return false
 }
 
+func (m *Result) GetSkippedRows() [][]byte {
+	if m != nil {
+		return m.SkippedRows
+	}
+	return nil
+}
+
 type ExtraMutation struct {
 	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Skip                 bool     `protobuf:"varint,2,opt,name=skip,proto3" json:"skip,omitempty"`
@@ -2755,133 +2798,135 @@ func init() {
 func init() { proto.RegisterFile("lock.proto", fileDescriptor_164ad2988c7acaf1) }
 
 var fileDescriptor_164ad2988c7acaf1 = []byte{
-	// 2014 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x59, 0xcd, 0x72, 0x1b, 0xc7,
-	0x11, 0xe6, 0x02, 0x20, 0x7e, 0x1a, 0x7f, 0xcb, 0x21, 0x45, 0x2d, 0x15, 0x87, 0x42, 0xb6, 0xe4,
-	0x2a, 0x98, 0x8e, 0xc5, 0x12, 0x15, 0xd9, 0x8e, 0x1c, 0xab, 0x22, 0x81, 0x12, 0x4d, 0x4b, 0x14,
-	0x9d, 0x01, 0xa4, 0x54, 0xe5, 0xb6, 0x04, 0x46, 0xe4, 0x16, 0x81, 0x5d, 0x64, 0xb1, 0xe0, 0xcf,
-	0x03, 0xa4, 0x2a, 0x4f, 0x90, 0x73, 0x8e, 0xbe, 0xe4, 0x29, 0x92, 0x83, 0x8f, 0xae, 0xca, 0x3d,
-	0x95, 0x28, 0xaf, 0x90, 0x07, 0x48, 0xf5, 0xcc, 0x2c, 0x76, 0x66, 0x7f, 0x08, 0xdb, 0xb7, 0x9d,
-	0xee, 0x9e, 0xfe, 0xdb, 0xde, 0xaf, 0xbb, 0x01, 0x80, 0xb1, 0x3f, 0x3c, 0xbf, 0x3f, 0x0d, 0xfc,
-	0xd0, 0x27, 0x25, 0x7c, 0xbe, 0xf3, 0xc9, 0xa9, 0x1b, 0x9e, 0xcd, 0x4f, 0xee, 0x0f, 0xfd, 0xc9,
-	0xee, 0xa9, 0x7f, 0xea, 0xef, 0x72, 0xe6, 0xc9, 0xfc, 0x1d, 0x3f, 0xf1, 0x03, 0x7f, 0x12, 0x97,
-	0xee, 0xb4, 0x43, 0x77, 0xc2, 0x66, 0xa1, 0x33, 0x99, 0x0a, 0x82, 0xfd, 0xbf, 0x02, 0xd4, 0x5f,
-	0xf9, 0xc3, 0xf3, 0xe3, 0x69, 0xe8, 0xfa, 0xde, 0x8c, 0x3c, 0x84, 0xfa, 0x41, 0xe0, 0x78, 0xf3,
-	0xb1, 0x13, 0xb8, 0xe1, 0xb5, 0x65, 0x74, 0x8c, 0x6e, 0x6b, 0x6f, 0xed, 0x3e, 0xb7, 0xab, 0x30,
-	0xa8, 0x2a, 0x45, 0x6c, 0x28, 0x1d, 0xf9, 0x23, 0x66, 0x15, 0xb8, 0x74, 0x4b, 0x48, 0xa3, 0x56,
-	0xa4, 0x52, 0xce, 0x23, 0x5d, 0x28, 0x7f, 0xe3, 0x8f, 0xdd, 0xe1, 0xb5, 0x55, 0xe4, 0x52, 0xa6,
-	0x90, 0xfa, 0xbd, 0xe3, 0x86, 0x82, 0x4e, 0x25, 0x9f, 0x7c, 0x00, 0xb5, 0x17, 0x7e, 0x70, 0xe9,
-	0x04, 0xa3, 0x81, 0x6f, 0x95, 0x3a, 0x46, 0xb7, 0x46, 0x63, 0x02, 0xe9, 0x42, 0x7b, 0xe0, 0x9c,
-	0x8c, 0xd9, 0x3e, 0x7b, 0xd7, 0x3b, 0x73, 0xbc, 0x53, 0x36, 0xb2, 0x56, 0x3b, 0x46, 0xb7, 0x4a,
-	0x93, 0x64, 0xd4, 0x43, 0x59, 0x18, 0x5c, 0xa3, 0x09, 0xab, 0xdc, 0x31, 0xba, 0x45, 0x1a, 0x13,
-	0xc8, 0x06, 0xac, 0x1e, 0x04, 0xfe, 0x7c, 0x6a, 0x55, 0x3a, 0x46, 0xb7, 0x49, 0xc5, 0x81, 0xec,
-	0x40, 0xb5, 0x7f, 0xe6, 0x04, 0x23, 0xd7, 0x3b, 0xb5, 0xaa, 0x6a, 0x34, 0x11, 0x95, 0x2e, 0xf8,
-	0xe4, 0x31, 0x40, 0xdf, 0x73, 0xa6, 0xfd, 0x33, 0x3f, 0x1c, 0xcc, 0xac, 0x5a, 0xc7, 0xe8, 0xd6,
-	0xf7, 0x36, 0xee, 0xc7, 0x09, 0x1e, 0x44, 0x4f, 0xcf, 0x4a, 0xdf, 0xfd, 0xeb, 0xee, 0x0a, 0x55,
-	0xa4, 0xed, 0x7f, 0x1a, 0x50, 0xc3, 0x04, 0x71, 0x9f, 0xd1, 0x17, 0xfe, 0xc0, 0xd3, 0x5d, 0xa2,
-	0xe2, 0x80, 0xfe, 0xf7, 0x59, 0x70, 0xe1, 0x0e, 0xd9, 0xe1, 0x3e, 0x4f, 0x6d, 0x8d, 0xc6, 0x04,
-	0x62, 0x41, 0xe5, 0x2d, 0x0b, 0x66, 0xae, 0xef, 0xf1, 0x84, 0x96, 0x68, 0x74, 0x44, 0x6d, 0x6f,
-	0x9d, 0xb1, 0x3b, 0xe2, 0xb9, 0xab, 0x52, 0x71, 0x88, 0xe3, 0x5d, 0xcd, 0x8b, 0xb7, 0xbc, 0x24,
-	0xde, 0x0e, 0xd4, 0x8f, 0x03, 0xf7, 0xd4, 0xf5, 0x84, 0xaf, 0x15, 0x6e, 0x55, 0x25, 0xd9, 0xdf,
-	0xd6, 0xa0, 0x42, 0xd9, 0x1f, 0xe7, 0x6c, 0x16, 0x8a, 0xec, 0xf3, 0xc7, 0xc3, 0x7d, 0x19, 0x57,
-	0x4c, 0x20, 0x0f, 0x95, 0xf0, 0x79, 0x6c, 0xf5, 0xbd, 0x76, 0x5c, 0x36, 0x9c, 0x2c, 0xb3, 0xa6,
-	0xa4, 0xe9, 0x1e, 0x94, 0x8f, 0x58, 0x78, 0xe6, 0x8f, 0x64, 0x09, 0x35, 0xc4, 0x0d, 0x41, 0xa3,
-	0x92, 0x47, 0x3e, 0x86, 0x12, 0x5e, 0xe1, 0xd1, 0xd7, 0xa3, 0xd2, 0x45, 0x8a, 0xb4, 0x2e, 0xf5,
-	0x72, 0x21, 0xf2, 0x00, 0xca, 0x6f, 0x3c, 0x94, 0xe0, 0x69, 0xa9, 0xef, 0xad, 0x0b, 0x71, 0x41,
-	0xd3, 0x2f, 0x48, 0x41, 0xf2, 0x25, 0xc0, 0x01, 0x0b, 0x07, 0x57, 0x1e, 0xb7, 0x52, 0xe6, 0xd7,
-	0x6e, 0xcb, 0x0f, 0x64, 0x41, 0xd7, 0xaf, 0x2a, 0x17, 0xc8, 0x21, 0xb4, 0x0e, 0x58, 0x88, 0x25,
-	0xe8, 0x7a, 0xa7, 0xaf, 0xdc, 0x59, 0xc8, 0x13, 0x59, 0xdf, 0xfb, 0xd9, 0x42, 0x85, 0xc2, 0xd3,
-	0xd5, 0x24, 0x2e, 0x92, 0x5f, 0x41, 0xe5, 0x80, 0x85, 0xcf, 0x5c, 0x6f, 0xc4, 0x6b, 0x15, 0xab,
-	0x2f, 0xd2, 0x81, 0x44, 0xfd, 0x72, 0x24, 0x4a, 0x28, 0xac, 0xbd, 0x64, 0x6c, 0x1a, 0xe7, 0x19,
-	0xef, 0x8b, 0xea, 0xdd, 0x16, 0xf7, 0x53, 0x6c, 0x5d, 0x53, 0xfa, 0x3a, 0x06, 0x85, 0x44, 0xca,
-	0x26, 0x7e, 0xc8, 0x78, 0x5e, 0x40, 0x0d, 0x4a, 0xe7, 0x25, 0x82, 0xd2, 0x99, 0xe4, 0x15, 0xb4,
-	0x79, 0xc1, 0x3a, 0x21, 0x93, 0xc5, 0x6e, 0xd5, 0xb9, 0xae, 0x0f, 0x84, 0xae, 0x04, 0x53, 0x57,
-	0x96, 0xbc, 0x4a, 0x7a, 0xd0, 0xe8, 0x39, 0x9e, 0xe7, 0x87, 0x3d, 0x7f, 0x32, 0x71, 0x43, 0xab,
-	0xc1, 0x55, 0x6d, 0x09, 0x55, 0x2a, 0x47, 0xd7, 0xa3, 0x5d, 0x42, 0x25, 0x07, 0x2c, 0x7c, 0x3a,
-	0x0c, 0xdd, 0x0b, 0x36, 0xb8, 0xf2, 0xac, 0xa6, 0xaa, 0x44, 0xe5, 0x24, 0x94, 0xa8, 0x2c, 0x4c,
-	0x7b, 0x9f, 0x85, 0x14, 0x11, 0x21, 0x08, 0xa3, 0xc8, 0x5a, 0x6a, 0xda, 0x53, 0xec, 0x44, 0xda,
-	0x53, 0x7c, 0xd4, 0xd9, 0x73, 0xbc, 0x84, 0xce, 0xb6, 0xaa, 0x33, 0xc5, 0x4e, 0xe8, 0x4c, 0xf1,
-	0xc9, 0x1b, 0x20, 0x94, 0x4d, 0x1c, 0xd7, 0x1b, 0x5c, 0x79, 0x87, 0x5e, 0xa4, 0xd4, 0xe4, 0x4a,
-	0xef, 0x0a, 0xa5, 0x69, 0xbe, 0xae, 0x35, 0x43, 0x01, 0xf9, 0x2d, 0xd4, 0x7b, 0x67, 0x6c, 0x78,
-	0x7e, 0x1c, 0x4c, 0xcf, 0x1c, 0xcf, 0x5a, 0xe3, 0xfa, 0x2c, 0xe9, 0x64, 0xcc, 0xd0, 0x15, 0xa9,
-	0x57, 0xb0, 0x30, 0x28, 0x9b, 0xcd, 0x27, 0xec, 0xd0, 0xbb, 0xc0, 0xb7, 0xdc, 0x7b, 0x6d, 0x11,
-	0xb5, 0x30, 0x12, 0xcc, 0x44, 0x61, 0x24, 0xb8, 0xf6, 0x3f, 0x6a, 0x50, 0xa5, 0x6c, 0x36, 0xf5,
-	0xbd, 0x19, 0x5b, 0x82, 0x55, 0x31, 0xec, 0x14, 0x6e, 0x80, 0x9d, 0x0d, 0x58, 0x7d, 0x1e, 0x04,
-	0x7e, 0xc0, 0xb1, 0xa9, 0x41, 0xc5, 0x81, 0x7c, 0x04, 0x95, 0xd7, 0xec, 0x92, 0x7f, 0x62, 0xa5,
-	0x4c, 0x94, 0xa3, 0x11, 0x9f, 0xfc, 0x52, 0xe2, 0x96, 0x00, 0x22, 0xa2, 0xe2, 0x96, 0x70, 0x53,
-	0x03, 0xae, 0xbd, 0x05, 0x70, 0x95, 0xd5, 0x4f, 0x3f, 0x02, 0x2e, 0xed, 0x46, 0x84, 0x5c, 0x4f,
-	0x34, 0xe4, 0xaa, 0xa8, 0xaf, 0x40, 0x45, 0x2e, 0xed, 0xae, 0x0a, 0x5d, 0x5f, 0xa7, 0xa0, 0xab,
-	0xaa, 0xbe, 0x80, 0x24, 0x74, 0x69, 0x7a, 0x92, 0xd8, 0xf5, 0x28, 0xc6, 0x2e, 0x81, 0x3d, 0xb7,
-	0x12, 0xd8, 0xa5, 0xdd, 0x5e, 0x80, 0x57, 0x3f, 0x0b, 0xbc, 0x40, 0x2d, 0xce, 0x0c, 0xf0, 0xd2,
-	0x54, 0x65, 0xa0, 0xd7, 0xd7, 0x29, 0xf4, 0xd2, 0x10, 0x27, 0x89, 0x5e, 0x7a, 0x5c, 0x09, 0xf8,
-	0x3a, 0x4a, 0xc3, 0x97, 0xc0, 0x9c, 0x9f, 0xe7, 0xc0, 0x97, 0xa6, 0x2d, 0x85, 0x5f, 0xfb, 0x09,
-	0xfc, 0x12, 0xd0, 0x73, 0x27, 0x0b, 0xbf, 0x34, 0x45, 0x3a, 0x80, 0xed, 0x27, 0x00, 0xac, 0xa5,
-	0x6a, 0xd1, 0x01, 0x4c, 0xd7, 0xa2, 0x21, 0x58, 0x3f, 0x0b, 0xc1, 0xda, 0x6a, 0xee, 0x33, 0x10,
-	0x4c, 0xcf, 0x7d, 0x1a, 0xc2, 0xfa, 0x59, 0x10, 0xa6, 0xa1, 0x4d, 0x06, 0x84, 0xe9, 0x4a, 0xd3,
-	0x18, 0xf6, 0x36, 0x13, 0xc3, 0x04, 0xe6, 0x74, 0xf2, 0x31, 0x4c, 0x53, 0x9b, 0x05, 0x62, 0x4f,
-	0x75, 0x10, 0x23, 0x5a, 0x33, 0x51, 0x41, 0x4c, 0xd3, 0xa4, 0xa1, 0xd8, 0x51, 0x1a, 0xc5, 0xd6,
-	0xd5, 0xfa, 0x48, 0xa1, 0x98, 0x5e, 0x1f, 0x49, 0x18, 0xfb, 0xb3, 0x21, 0xc6, 0xf7, 0x68, 0xea,
-	0xc2, 0x49, 0xf2, 0xca, 0x93, 0x28, 0xd6, 0xa0, 0xe2, 0xb0, 0x64, 0x92, 0x24, 0x50, 0xa2, 0xfe,
-	0xe5, 0xcc, 0x2a, 0x76, 0x8a, 0xdd, 0x06, 0xe5, 0xcf, 0xe4, 0x01, 0x54, 0xe4, 0x46, 0x90, 0x9e,
-	0xa3, 0x24, 0x23, 0xfa, 0x34, 0xe5, 0xd1, 0x7e, 0x0c, 0x0d, 0xf5, 0xfb, 0x20, 0x3b, 0x50, 0x46,
-	0x6f, 0xc7, 0x21, 0xf7, 0xa5, 0x1e, 0xc1, 0xa6, 0xa0, 0x45, 0xc8, 0x24, 0x4e, 0xf6, 0x17, 0xb0,
-	0x96, 0x9a, 0x9d, 0x72, 0x62, 0x31, 0xa1, 0x48, 0xfd, 0x4b, 0x1e, 0x45, 0x83, 0xe2, 0xa3, 0xed,
-	0x00, 0x49, 0xc3, 0x97, 0x9c, 0x82, 0xe7, 0x62, 0xa6, 0x5e, 0xa5, 0xe2, 0x40, 0x1e, 0x41, 0x5d,
-	0xc5, 0xaf, 0x42, 0xa7, 0xd8, 0xad, 0xef, 0x35, 0xe3, 0x55, 0x64, 0x70, 0xe5, 0x45, 0x6f, 0x4d,
-	0x91, 0xb3, 0x9f, 0xc0, 0xad, 0xcc, 0xc1, 0x8c, 0x7c, 0x08, 0x45, 0xfc, 0xa0, 0x44, 0x84, 0x99,
-	0x7a, 0x90, 0x6f, 0x1f, 0xc3, 0x66, 0x36, 0x3a, 0x26, 0x1d, 0x32, 0x7e, 0xa0, 0x43, 0x5f, 0x42,
-	0x45, 0x72, 0xf3, 0x5f, 0x79, 0x2f, 0x60, 0x4e, 0xc8, 0x46, 0xc7, 0x5e, 0xf4, 0xca, 0x17, 0x04,
-	0xfb, 0x2f, 0x06, 0x34, 0xb5, 0x19, 0x37, 0x47, 0xcb, 0xa7, 0x50, 0x15, 0x10, 0x32, 0xe8, 0xcb,
-	0x29, 0xfd, 0xa6, 0x05, 0x67, 0x21, 0x4b, 0x3e, 0x83, 0xda, 0xd1, 0x3c, 0x74, 0x44, 0x01, 0x15,
-	0x79, 0x4c, 0x72, 0xb2, 0x7e, 0x7e, 0x15, 0x06, 0x4e, 0xc4, 0x8b, 0x46, 0xfc, 0x85, 0xac, 0x6d,
-	0x42, 0x4b, 0x6f, 0x61, 0xf6, 0xb7, 0x06, 0xef, 0x3a, 0xca, 0x18, 0xaa, 0x97, 0xb3, 0x91, 0x2c,
-	0xe7, 0xc5, 0x32, 0x55, 0x50, 0x97, 0xa9, 0xc5, 0xfa, 0x53, 0xcc, 0x5b, 0x7f, 0x4a, 0x3f, 0x6e,
-	0xfd, 0x59, 0x4d, 0xaf, 0x3f, 0x2f, 0xa0, 0x9d, 0x68, 0x5f, 0x3f, 0x69, 0xcf, 0xb1, 0xff, 0x66,
-	0x80, 0x95, 0x37, 0x83, 0x2f, 0x09, 0xfe, 0x1e, 0x94, 0xfb, 0xa1, 0x13, 0xce, 0x67, 0xfa, 0xac,
-	0x22, 0x68, 0x54, 0xf2, 0xc8, 0x26, 0x94, 0xf9, 0xfb, 0x8d, 0xbe, 0x79, 0x79, 0x22, 0x8f, 0x00,
-	0x16, 0x36, 0xf1, 0xc3, 0x2f, 0xe6, 0xbb, 0xab, 0x08, 0xda, 0xbf, 0x83, 0xad, 0xdc, 0xae, 0x4b,
-	0x5a, 0x50, 0x38, 0x7e, 0xc9, 0x1d, 0xad, 0xd2, 0xc2, 0xf1, 0xcb, 0x1f, 0xe6, 0xa1, 0xfd, 0x39,
-	0x58, 0x79, 0xe3, 0xf0, 0xcd, 0x19, 0xb0, 0x3f, 0x86, 0xad, 0xdc, 0x36, 0x94, 0x74, 0x06, 0xcd,
-	0xe4, 0x4d, 0xc8, 0xcb, 0xcd, 0xe4, 0x36, 0xa6, 0x94, 0x99, 0x5f, 0xc3, 0x56, 0xee, 0xcc, 0xbc,
-	0xc4, 0xce, 0x63, 0xb8, 0x93, 0xdf, 0xaa, 0xc4, 0xe0, 0x2a, 0xb9, 0x12, 0xe8, 0x62, 0x82, 0xfd,
-	0x08, 0x6e, 0x65, 0x6e, 0x5e, 0x4b, 0x4c, 0x76, 0x61, 0x33, 0x7b, 0xe4, 0x49, 0xc5, 0xf5, 0x29,
-	0x6c, 0x66, 0xaf, 0x63, 0x4b, 0x2c, 0x7c, 0x04, 0xb7, 0x73, 0xe6, 0xa0, 0x94, 0x09, 0x0a, 0xeb,
-	0x19, 0x6b, 0x1a, 0xf9, 0x02, 0x9a, 0xa2, 0xa1, 0x22, 0xec, 0xc7, 0xc0, 0x29, 0x8b, 0x75, 0xc1,
-	0x92, 0xc5, 0xaa, 0xcb, 0xda, 0xbf, 0x81, 0x8d, 0xac, 0xd1, 0x89, 0xdc, 0x83, 0xa6, 0xa0, 0x20,
-	0xcc, 0x8a, 0x8c, 0xe2, 0xd7, 0xa1, 0x13, 0xed, 0x87, 0xb0, 0x9e, 0xb1, 0xf3, 0x2d, 0x89, 0xf8,
-	0x09, 0x6c, 0x64, 0xcd, 0x59, 0xf1, 0x6f, 0x35, 0x86, 0xfa, 0x5b, 0x8d, 0x29, 0xba, 0x4a, 0x81,
-	0x9b, 0xe7, 0x0d, 0x64, 0x1f, 0x48, 0x7a, 0x4b, 0x5a, 0x82, 0x05, 0x0b, 0x2d, 0x46, 0xa4, 0xe5,
-	0x13, 0x58, 0xcf, 0x18, 0x53, 0x10, 0x0e, 0xe4, 0x44, 0x23, 0xbc, 0x90, 0x27, 0xfb, 0x33, 0xa8,
-	0x2d, 0x12, 0x47, 0x2c, 0xa8, 0x44, 0x83, 0x94, 0xb0, 0x14, 0x1d, 0x33, 0xbc, 0xfd, 0x53, 0x31,
-	0xea, 0xfd, 0xe4, 0x01, 0x54, 0xb1, 0x84, 0x78, 0x1b, 0x32, 0x6e, 0xc2, 0xbf, 0x85, 0x18, 0x02,
-	0xed, 0x57, 0xce, 0xac, 0xe7, 0x7b, 0xef, 0xc6, 0xee, 0x30, 0xe4, 0xfe, 0x57, 0xa9, 0x4a, 0xc2,
-	0x17, 0xf5, 0x95, 0x33, 0xfb, 0x26, 0x60, 0x17, 0x72, 0x2c, 0x2e, 0x72, 0x19, 0x9d, 0x48, 0x3e,
-	0x87, 0xda, 0xa2, 0x43, 0xc9, 0x29, 0xe6, 0xa6, 0xee, 0x15, 0x0b, 0xff, 0x88, 0xdf, 0x18, 0x3b,
-	0x50, 0x8f, 0xbc, 0x7a, 0xc9, 0xae, 0xf9, 0x2e, 0xd6, 0xa0, 0x2a, 0x49, 0x95, 0xc0, 0x2c, 0x55,
-	0x74, 0x09, 0xcc, 0xec, 0x36, 0x00, 0x7a, 0x8d, 0xfd, 0x9c, 0x05, 0x7c, 0xa5, 0x6a, 0x50, 0x85,
-	0x82, 0x99, 0x17, 0x4f, 0xe2, 0x47, 0xc6, 0x26, 0x8d, 0x8e, 0x78, 0xf3, 0x35, 0xbb, 0xc4, 0xc4,
-	0x3d, 0x1d, 0x89, 0x35, 0xa8, 0x4a, 0x15, 0x8a, 0xdd, 0x87, 0xa6, 0xd6, 0x6f, 0xf1, 0x55, 0x9d,
-	0xb3, 0x6b, 0xd9, 0xe3, 0xf1, 0x11, 0x87, 0xbf, 0xd9, 0xb9, 0x3b, 0x95, 0x59, 0xe6, 0xcf, 0x58,
-	0x56, 0x01, 0x9b, 0x8e, 0x9d, 0x21, 0x1b, 0xf8, 0x72, 0x9d, 0x8d, 0x09, 0xf8, 0xd1, 0x67, 0xaf,
-	0xda, 0x4b, 0x3e, 0x81, 0x2d, 0xb8, 0x9d, 0x33, 0xdc, 0xee, 0xfc, 0x42, 0xfb, 0xd1, 0x99, 0x54,
-	0xf8, 0x88, 0x67, 0xae, 0x90, 0x1a, 0xac, 0x52, 0xcc, 0xb3, 0x69, 0xec, 0x7c, 0x28, 0xea, 0x88,
-	0xff, 0x94, 0xdc, 0x84, 0xda, 0xf3, 0xab, 0xe1, 0x78, 0x3e, 0x73, 0x2f, 0x98, 0xb9, 0x42, 0x00,
-	0xca, 0xd8, 0xa4, 0xd9, 0xc8, 0x34, 0x76, 0xee, 0x01, 0xc4, 0xbf, 0x28, 0x93, 0x2a, 0x94, 0xf0,
-	0x64, 0xae, 0x90, 0x06, 0x54, 0x5f, 0x38, 0xb3, 0xf0, 0x85, 0xe3, 0x8e, 0x4d, 0x63, 0xe7, 0x6e,
-	0xdc, 0xf6, 0x51, 0xe6, 0xb5, 0xef, 0x31, 0x61, 0xed, 0xd9, 0x35, 0x1a, 0x36, 0x76, 0xfe, 0x5e,
-	0x88, 0x76, 0x7e, 0xe4, 0xa3, 0x61, 0x61, 0x47, 0xcc, 0x26, 0xa6, 0x41, 0x5a, 0xea, 0x2a, 0x6d,
-	0x16, 0x08, 0x49, 0xae, 0xc6, 0x66, 0x11, 0x69, 0x3a, 0x8e, 0x9a, 0x25, 0x52, 0x5f, 0xac, 0xbd,
-	0xe6, 0x2a, 0xb9, 0x95, 0xb1, 0xcc, 0x9a, 0x65, 0xd2, 0x86, 0xba, 0xfc, 0xb9, 0x9b, 0x5f, 0xaa,
-	0x90, 0x35, 0x68, 0x4a, 0x82, 0xb4, 0x5f, 0x25, 0xeb, 0xa9, 0x35, 0xd3, 0xac, 0x11, 0x53, 0x5f,
-	0x16, 0x4d, 0x40, 0x8a, 0x0a, 0x3b, 0x66, 0x1d, 0x6d, 0xa6, 0xda, 0xa3, 0xd9, 0x20, 0x9b, 0x59,
-	0x1b, 0x93, 0xd9, 0x44, 0xf1, 0x54, 0x9b, 0x33, 0x5b, 0xe8, 0xa2, 0x02, 0x24, 0x66, 0x1b, 0xfd,
-	0x49, 0xbc, 0x5c, 0xd3, 0xdc, 0x61, 0x51, 0xab, 0x17, 0x56, 0xf9, 0x65, 0x0c, 0xe9, 0xb9, 0x87,
-	0xd1, 0x9a, 0x2b, 0x68, 0x55, 0x21, 0xcb, 0xec, 0x99, 0x86, 0x22, 0xfe, 0x86, 0x27, 0xb8, 0x3f,
-	0x1f, 0x0e, 0xcd, 0x82, 0x42, 0x8e, 0x7d, 0x32, 0x8b, 0xcf, 0x7a, 0xdf, 0xff, 0x67, 0xdb, 0xf8,
-	0xee, 0xfd, 0xb6, 0xf1, 0xfd, 0xfb, 0x6d, 0xe3, 0xdf, 0xef, 0xb7, 0x57, 0xfe, 0xfa, 0xdf, 0x6d,
-	0xe3, 0x0f, 0xea, 0x1f, 0x23, 0x13, 0x27, 0x0c, 0xdc, 0x2b, 0x9f, 0x8f, 0x6a, 0xd1, 0xc1, 0x63,
-	0xbb, 0xd3, 0xf3, 0xd3, 0xdd, 0xe9, 0xc9, 0x2e, 0xa6, 0xf4, 0xa4, 0xcc, 0xff, 0x0e, 0x79, 0xf8,
-	0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x86, 0xcb, 0x66, 0x62, 0x19, 0x00, 0x00,
+	// 2040 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x59, 0x5b, 0x73, 0xdb, 0xc6,
+	0x15, 0x16, 0x48, 0x8a, 0x97, 0x03, 0x52, 0x84, 0x56, 0xb2, 0x0c, 0xb9, 0xa9, 0xcc, 0x62, 0x9c,
+	0x19, 0x46, 0x69, 0xac, 0xb1, 0x1c, 0x27, 0xa9, 0xd3, 0x78, 0x6a, 0x53, 0xb6, 0xa2, 0xfa, 0xa2,
+	0x74, 0x49, 0xbb, 0x33, 0x7d, 0x83, 0xc8, 0xb5, 0x84, 0x11, 0x09, 0xb0, 0x20, 0xa8, 0xcb, 0x3f,
+	0xe8, 0x2f, 0xc8, 0x73, 0xdf, 0x9a, 0x97, 0xfe, 0x8a, 0xf6, 0x21, 0x8f, 0x99, 0xe9, 0x7b, 0xa7,
+	0x75, 0xff, 0x48, 0xe7, 0xec, 0x2e, 0x88, 0x5d, 0x5c, 0xcc, 0x24, 0x6f, 0xd8, 0x73, 0xdf, 0xb3,
+	0x07, 0xdf, 0x9e, 0x03, 0x00, 0x8c, 0x83, 0xe1, 0xf9, 0xdd, 0x69, 0x18, 0x44, 0x01, 0xa9, 0xe0,
+	0xf3, 0xad, 0x4f, 0x4e, 0xbd, 0xe8, 0x6c, 0x7e, 0x72, 0x77, 0x18, 0x4c, 0xf6, 0x4e, 0x83, 0xd3,
+	0x60, 0x8f, 0x33, 0x4f, 0xe6, 0x6f, 0xf9, 0x8a, 0x2f, 0xf8, 0x93, 0x50, 0xba, 0xd5, 0x8e, 0xbc,
+	0x09, 0x9b, 0x45, 0xee, 0x64, 0x2a, 0x08, 0xce, 0xb7, 0x65, 0x30, 0x5f, 0x04, 0xc3, 0xf3, 0xe3,
+	0x69, 0xe4, 0x05, 0xfe, 0x8c, 0xdc, 0x07, 0xf3, 0x30, 0x74, 0xfd, 0xf9, 0xd8, 0x0d, 0xbd, 0xe8,
+	0xda, 0x36, 0x3a, 0x46, 0x77, 0x6d, 0x7f, 0xfd, 0x2e, 0xf7, 0xab, 0x30, 0xa8, 0x2a, 0x45, 0x1c,
+	0xa8, 0xbc, 0x0c, 0x46, 0xcc, 0x2e, 0x71, 0xe9, 0x35, 0x21, 0x8d, 0x56, 0x91, 0x4a, 0x39, 0x8f,
+	0x74, 0xa1, 0xfa, 0x4d, 0x30, 0xf6, 0x86, 0xd7, 0x76, 0x99, 0x4b, 0x59, 0x42, 0xea, 0x8f, 0xae,
+	0x17, 0x09, 0x3a, 0x95, 0x7c, 0xf2, 0x01, 0x34, 0x9e, 0x05, 0xe1, 0xa5, 0x1b, 0x8e, 0x06, 0x81,
+	0x5d, 0xe9, 0x18, 0xdd, 0x06, 0x4d, 0x08, 0xa4, 0x0b, 0xed, 0x81, 0x7b, 0x32, 0x66, 0x07, 0xec,
+	0x6d, 0xef, 0xcc, 0xf5, 0x4f, 0xd9, 0xc8, 0x5e, 0xed, 0x18, 0xdd, 0x3a, 0x4d, 0x93, 0xd1, 0x0e,
+	0x65, 0x51, 0x78, 0x8d, 0x2e, 0xec, 0x6a, 0xc7, 0xe8, 0x96, 0x69, 0x42, 0x20, 0x9b, 0xb0, 0x7a,
+	0x18, 0x06, 0xf3, 0xa9, 0x5d, 0xeb, 0x18, 0xdd, 0x16, 0x15, 0x0b, 0xb2, 0x0b, 0xf5, 0xfe, 0x99,
+	0x1b, 0x8e, 0x3c, 0xff, 0xd4, 0xae, 0xab, 0xbb, 0x89, 0xa9, 0x74, 0xc1, 0x27, 0x0f, 0x01, 0xfa,
+	0xbe, 0x3b, 0xed, 0x9f, 0x05, 0xd1, 0x60, 0x66, 0x37, 0x3a, 0x46, 0xd7, 0xdc, 0xdf, 0xbc, 0x9b,
+	0x24, 0x78, 0x10, 0x3f, 0x3d, 0xa9, 0x7c, 0xff, 0xef, 0xdb, 0x2b, 0x54, 0x91, 0x26, 0x1d, 0x30,
+	0x31, 0x0a, 0x14, 0x09, 0xe6, 0x91, 0x0d, 0x3c, 0x3a, 0x95, 0xe4, 0xfc, 0xcb, 0x80, 0x06, 0xa6,
+	0x90, 0xef, 0x0a, 0xa3, 0xe5, 0x0f, 0xfc, 0x40, 0x2a, 0x54, 0x2c, 0x70, 0x87, 0x7d, 0x16, 0x5e,
+	0x78, 0x43, 0x76, 0x74, 0xc0, 0x93, 0xdf, 0xa0, 0x09, 0x81, 0xd8, 0x50, 0x7b, 0xc3, 0xc2, 0x99,
+	0x17, 0xf8, 0x3c, 0xe5, 0x15, 0x1a, 0x2f, 0xd1, 0xda, 0x1b, 0x77, 0xec, 0x8d, 0x78, 0x76, 0xeb,
+	0x54, 0x2c, 0x92, 0x8c, 0xac, 0x16, 0x65, 0xa4, 0xba, 0x24, 0x23, 0x1d, 0x30, 0x8f, 0x43, 0xef,
+	0xd4, 0xf3, 0x45, 0xac, 0x35, 0xee, 0x55, 0x25, 0x39, 0xdf, 0x35, 0xa0, 0x46, 0xd9, 0x9f, 0xe7,
+	0x6c, 0x16, 0x89, 0xf3, 0xe1, 0x8f, 0x47, 0x07, 0x72, 0x5f, 0x09, 0x81, 0xdc, 0x57, 0xb6, 0xcf,
+	0xf7, 0x66, 0xee, 0xb7, 0x93, 0xc2, 0xe2, 0x64, 0x99, 0x57, 0x25, 0x4d, 0x77, 0xa0, 0xfa, 0x92,
+	0x45, 0x67, 0xc1, 0x48, 0x16, 0x59, 0x53, 0x68, 0x08, 0x1a, 0x95, 0x3c, 0xf2, 0x31, 0x54, 0x50,
+	0x85, 0xef, 0xde, 0x8c, 0x8b, 0x1b, 0x29, 0xd2, 0xbb, 0xb4, 0xcb, 0x85, 0xc8, 0x3d, 0xa8, 0xbe,
+	0xf6, 0x51, 0x82, 0xa7, 0xc5, 0xdc, 0xdf, 0x10, 0xe2, 0x82, 0xa6, 0x2b, 0x48, 0x41, 0xf2, 0x15,
+	0xc0, 0x21, 0x8b, 0x06, 0x57, 0x3e, 0xf7, 0x52, 0xe5, 0x6a, 0x37, 0xe5, 0x2b, 0xb4, 0xa0, 0xeb,
+	0xaa, 0x8a, 0x02, 0x39, 0x82, 0xb5, 0x43, 0x16, 0x61, 0x2d, 0x78, 0xfe, 0xe9, 0x0b, 0x6f, 0x16,
+	0xf1, 0x44, 0x9a, 0xfb, 0xbf, 0x58, 0x98, 0x50, 0x78, 0xba, 0x99, 0x94, 0x22, 0xf9, 0x14, 0x6a,
+	0x87, 0x2c, 0x7a, 0xe2, 0xf9, 0x23, 0xbb, 0x2e, 0xeb, 0x33, 0xb6, 0x81, 0x44, 0x5d, 0x39, 0x16,
+	0x25, 0x14, 0xd6, 0x9f, 0x33, 0x36, 0x4d, 0xf2, 0x8c, 0xfa, 0xa2, 0xbe, 0x77, 0x84, 0x7e, 0x86,
+	0xad, 0x5b, 0xca, 0xaa, 0xe3, 0xa6, 0x90, 0x48, 0xd9, 0x24, 0x88, 0x18, 0xcf, 0x0b, 0xa8, 0x9b,
+	0xd2, 0x79, 0xa9, 0x4d, 0xe9, 0x4c, 0xf2, 0x02, 0xda, 0xbc, 0x60, 0xdd, 0x88, 0xc9, 0x62, 0xb7,
+	0x4d, 0x6e, 0xeb, 0x03, 0x61, 0x2b, 0xc5, 0xd4, 0x8d, 0xa5, 0x55, 0x49, 0x0f, 0x9a, 0x3d, 0xd7,
+	0xf7, 0x83, 0xa8, 0x17, 0x4c, 0x26, 0x5e, 0x64, 0x37, 0xb9, 0xa9, 0x6d, 0x61, 0x4a, 0xe5, 0xe8,
+	0x76, 0x34, 0x25, 0x34, 0x72, 0xc8, 0xa2, 0xc7, 0xc3, 0xc8, 0xbb, 0x60, 0x83, 0x2b, 0xdf, 0x6e,
+	0xa9, 0x46, 0x54, 0x4e, 0xca, 0x88, 0xca, 0xc2, 0xb4, 0xf7, 0x59, 0x44, 0x11, 0x33, 0xc2, 0x28,
+	0xde, 0xd9, 0x9a, 0x9a, 0xf6, 0x0c, 0x3b, 0x95, 0xf6, 0x0c, 0x1f, 0x6d, 0xf6, 0x5c, 0x3f, 0x65,
+	0xb3, 0xad, 0xda, 0xcc, 0xb0, 0x53, 0x36, 0x33, 0x7c, 0xf2, 0x1a, 0x08, 0x65, 0x13, 0xd7, 0xf3,
+	0x07, 0x57, 0xfe, 0x91, 0x1f, 0x1b, 0xb5, 0xb8, 0xd1, 0xdb, 0xc2, 0x68, 0x96, 0xaf, 0x5b, 0xcd,
+	0x31, 0x40, 0x7e, 0x07, 0x66, 0xef, 0x8c, 0x0d, 0xcf, 0x8f, 0xc3, 0xe9, 0x99, 0xeb, 0xdb, 0xeb,
+	0xdc, 0x9e, 0x2d, 0x83, 0x4c, 0x18, 0xba, 0x21, 0x55, 0x05, 0x0b, 0x83, 0xb2, 0xd9, 0x7c, 0xc2,
+	0x8e, 0xfc, 0x0b, 0x3c, 0xe5, 0xde, 0x2b, 0x9b, 0xa8, 0x85, 0x91, 0x62, 0xa6, 0x0a, 0x23, 0xc5,
+	0x75, 0xfe, 0xd9, 0x80, 0x3a, 0x65, 0xb3, 0x69, 0xe0, 0xcf, 0xd8, 0x12, 0xac, 0x4a, 0x60, 0xa7,
+	0xf4, 0x1e, 0xd8, 0xd9, 0x84, 0xd5, 0xa7, 0x61, 0x18, 0x84, 0x1c, 0x9b, 0x9a, 0x54, 0x2c, 0xc8,
+	0x47, 0x50, 0x7b, 0xc5, 0x2e, 0xf9, 0x2b, 0x56, 0xc9, 0x45, 0x39, 0x1a, 0xf3, 0xc9, 0xaf, 0x25,
+	0x6e, 0x09, 0x20, 0x22, 0x2a, 0x6e, 0x89, 0x30, 0x35, 0xe0, 0xda, 0x5f, 0x00, 0x57, 0x55, 0x7d,
+	0xf5, 0x63, 0xe0, 0xd2, 0x34, 0xa4, 0x24, 0x79, 0xa4, 0x21, 0x57, 0x4d, 0x3d, 0x02, 0x15, 0xb9,
+	0x34, 0x5d, 0x45, 0x83, 0xfc, 0x3e, 0x03, 0x5d, 0x75, 0xf5, 0x00, 0xd2, 0xd0, 0xa5, 0xd9, 0x49,
+	0x69, 0x92, 0x07, 0x09, 0x76, 0x09, 0xec, 0xb9, 0x91, 0xc2, 0x2e, 0x4d, 0x3b, 0x96, 0x25, 0xfd,
+	0x3c, 0xf0, 0x02, 0xb5, 0x38, 0x73, 0xc0, 0x4b, 0x33, 0x95, 0xd5, 0xc7, 0x7d, 0xa5, 0xd0, 0x4b,
+	0x43, 0x9c, 0x34, 0x7a, 0xe9, 0xfb, 0xd2, 0xb9, 0xe4, 0x65, 0x16, 0xbe, 0x04, 0xe6, 0xfc, 0xb2,
+	0x00, 0xbe, 0x34, 0x6b, 0x69, 0x5d, 0x72, 0x90, 0xc2, 0x2f, 0x01, 0x3d, 0xb7, 0xf2, 0xf0, 0x4b,
+	0x33, 0xa4, 0x69, 0xa1, 0x15, 0x0d, 0xc0, 0xd6, 0x54, 0x2b, 0x3a, 0x80, 0xe9, 0x56, 0x54, 0x1e,
+	0xe6, 0x3e, 0x8b, 0x60, 0x6d, 0x35, 0xf7, 0x39, 0x08, 0xa6, 0xe7, 0x3e, 0x23, 0x80, 0x46, 0xb3,
+	0x10, 0xa6, 0xa1, 0x4d, 0x0e, 0x84, 0xe9, 0x46, 0x33, 0x02, 0xe4, 0x4d, 0x2e, 0x86, 0x09, 0xcc,
+	0xe9, 0x14, 0x63, 0x98, 0x66, 0x36, 0x0f, 0xc4, 0x1e, 0xeb, 0x20, 0x46, 0xb4, 0xcb, 0x44, 0x05,
+	0x31, 0xcd, 0x92, 0xaa, 0x83, 0xf5, 0x91, 0x46, 0xb1, 0x0d, 0xb5, 0x3e, 0x32, 0x28, 0xa6, 0xd7,
+	0x47, 0x1a, 0xc6, 0xfe, 0x62, 0x88, 0x06, 0x3f, 0xee, 0xba, 0xb0, 0x93, 0xbc, 0xf2, 0x25, 0x8a,
+	0x35, 0xa9, 0x58, 0x2c, 0xe9, 0x24, 0x09, 0x54, 0x68, 0x70, 0x39, 0xb3, 0xcb, 0x9d, 0x72, 0xb7,
+	0x49, 0xf9, 0x33, 0xb9, 0x07, 0x35, 0x39, 0x33, 0x64, 0xfb, 0x28, 0xc9, 0x88, 0x5f, 0x4d, 0xb9,
+	0x74, 0x1e, 0x42, 0x53, 0x7d, 0x3f, 0xc8, 0x2e, 0x54, 0x31, 0xda, 0x71, 0xc4, 0x63, 0x31, 0x63,
+	0xd8, 0x14, 0xb4, 0x18, 0x99, 0xc4, 0xca, 0xf9, 0x12, 0xd6, 0x33, 0xbd, 0x53, 0xc1, 0x5e, 0x2c,
+	0x28, 0xd3, 0xe0, 0x92, 0xef, 0xa2, 0x49, 0xf1, 0xd1, 0x71, 0x81, 0x64, 0xe1, 0x4b, 0x76, 0xc1,
+	0x73, 0xd1, 0x53, 0xaf, 0x52, 0xb1, 0x20, 0x0f, 0xc0, 0x54, 0x50, 0xc8, 0x2e, 0x75, 0xca, 0x5d,
+	0x73, 0xbf, 0x95, 0x0c, 0x2b, 0x83, 0x2b, 0x3f, 0x3e, 0x35, 0x45, 0xce, 0x79, 0x04, 0x37, 0x72,
+	0x1b, 0x33, 0xf2, 0x21, 0x94, 0xf1, 0x85, 0x12, 0x3b, 0xcc, 0xb5, 0x83, 0x7c, 0xe7, 0x18, 0xb6,
+	0xf2, 0xd1, 0x31, 0x1d, 0x90, 0xf1, 0x23, 0x03, 0xfa, 0x0a, 0x6a, 0x92, 0x5b, 0x7c, 0xe4, 0xbd,
+	0x90, 0xb9, 0x11, 0x1b, 0x1d, 0xfb, 0xf1, 0x91, 0x2f, 0x08, 0xce, 0xb7, 0x06, 0xb4, 0xb4, 0x1e,
+	0xb7, 0xc0, 0xca, 0x67, 0x50, 0x17, 0x10, 0x32, 0xe8, 0xdb, 0xa5, 0xa5, 0x23, 0xd0, 0x42, 0x96,
+	0x7c, 0x0e, 0x8d, 0x97, 0xf3, 0xc8, 0x15, 0x05, 0x54, 0xee, 0x94, 0x93, 0xce, 0xfa, 0xe9, 0x55,
+	0x14, 0xba, 0x31, 0x4f, 0xea, 0x25, 0xb2, 0x8e, 0x05, 0x6b, 0xfa, 0x15, 0xe6, 0x7c, 0x67, 0xf0,
+	0x5b, 0x47, 0x69, 0x43, 0xf5, 0x72, 0x36, 0xd2, 0xe5, 0xbc, 0x18, 0xa6, 0x4a, 0xea, 0x30, 0xb5,
+	0x18, 0x7f, 0xca, 0x45, 0xe3, 0x4f, 0xe5, 0xa7, 0x8d, 0x3f, 0xab, 0xd9, 0xf1, 0xe7, 0x19, 0xb4,
+	0x53, 0xd7, 0xd7, 0xcf, 0x9a, 0x73, 0x9c, 0xbf, 0x1b, 0x60, 0x17, 0xf5, 0xe0, 0x4b, 0x36, 0x7f,
+	0x07, 0xaa, 0xfd, 0xc8, 0x8d, 0xe6, 0x33, 0xbd, 0x57, 0x11, 0x34, 0x2a, 0x79, 0x64, 0x0b, 0xaa,
+	0xfc, 0x7c, 0xe3, 0x77, 0x5e, 0xae, 0xc8, 0x03, 0x80, 0x85, 0x4f, 0x7c, 0xf1, 0xcb, 0xc5, 0xe1,
+	0x2a, 0x82, 0xce, 0x1f, 0x60, 0xbb, 0xf0, 0xd6, 0x25, 0x6b, 0x50, 0x3a, 0x7e, 0xce, 0x03, 0xad,
+	0xd3, 0xd2, 0xf1, 0xf3, 0x1f, 0x17, 0xa1, 0xf3, 0x05, 0xd8, 0x45, 0xed, 0xf0, 0xfb, 0x33, 0xe0,
+	0x7c, 0x0c, 0xdb, 0x85, 0xd7, 0x50, 0x3a, 0x18, 0x74, 0x53, 0xd4, 0x21, 0x2f, 0x77, 0x53, 0x78,
+	0x31, 0x65, 0xdc, 0xfc, 0x06, 0xb6, 0x0b, 0x7b, 0xe6, 0x25, 0x7e, 0x1e, 0xc2, 0xad, 0xe2, 0xab,
+	0x4a, 0x34, 0xae, 0x92, 0x2b, 0x81, 0x2e, 0x21, 0x38, 0x0f, 0xe0, 0x46, 0xee, 0xe4, 0xb5, 0xc4,
+	0x65, 0x17, 0xb6, 0xf2, 0x5b, 0x9e, 0xcc, 0xbe, 0x3e, 0x83, 0xad, 0xfc, 0x71, 0x6c, 0x89, 0x87,
+	0x8f, 0xe0, 0x66, 0x41, 0x1f, 0x94, 0x71, 0x41, 0x61, 0x23, 0x67, 0x4c, 0x23, 0x5f, 0x42, 0x4b,
+	0x5c, 0xa8, 0x08, 0xfb, 0x09, 0x70, 0xca, 0x62, 0x5d, 0xb0, 0x64, 0xb1, 0xea, 0xb2, 0xce, 0x6f,
+	0x61, 0x33, 0xaf, 0x75, 0x22, 0x77, 0xa0, 0x25, 0x28, 0x08, 0xb3, 0x22, 0xa3, 0xf8, 0x76, 0xe8,
+	0x44, 0xe7, 0x3e, 0x6c, 0xe4, 0xcc, 0x7c, 0x4b, 0x76, 0xfc, 0x08, 0x36, 0xf3, 0xfa, 0xac, 0xe4,
+	0x5b, 0x8d, 0xa1, 0x7e, 0xab, 0xb1, 0xc4, 0xad, 0x52, 0xe2, 0xee, 0xf1, 0xd1, 0x39, 0x00, 0x92,
+	0x9d, 0x92, 0x96, 0x60, 0xc1, 0xc2, 0x8a, 0x11, 0x5b, 0xf9, 0x04, 0x36, 0x72, 0xda, 0x14, 0x84,
+	0x03, 0x41, 0x91, 0x51, 0xc8, 0x95, 0xf3, 0x39, 0x34, 0x16, 0x89, 0xc3, 0xef, 0x4d, 0x71, 0x23,
+	0x25, 0x3c, 0xc5, 0xcb, 0x9c, 0x68, 0xff, 0x56, 0x8e, 0xef, 0x7e, 0x72, 0x0f, 0xea, 0x58, 0x42,
+	0xfc, 0x1a, 0x32, 0xde, 0x87, 0x7f, 0x0b, 0x31, 0x04, 0xda, 0xaf, 0xdd, 0x59, 0x2f, 0xf0, 0xdf,
+	0x8e, 0xbd, 0x61, 0xc4, 0xe3, 0xaf, 0x53, 0x95, 0x84, 0x07, 0xf5, 0xb5, 0x3b, 0xfb, 0x26, 0x64,
+	0x17, 0xb2, 0x2d, 0x2e, 0x73, 0x19, 0x9d, 0x48, 0xbe, 0x80, 0xc6, 0xe2, 0x86, 0xb2, 0x2b, 0x4b,
+	0x6f, 0xaf, 0x44, 0xf8, 0x27, 0x7c, 0x85, 0xec, 0x80, 0x19, 0x47, 0xf5, 0x9c, 0x5d, 0xf3, 0x59,
+	0xac, 0x49, 0x55, 0x92, 0x2a, 0x81, 0x59, 0xaa, 0xe9, 0x12, 0x98, 0xd9, 0x1d, 0x00, 0x8c, 0x1a,
+	0xef, 0x73, 0x16, 0xf2, 0x91, 0xaa, 0x49, 0x15, 0x0a, 0x66, 0x5e, 0x3c, 0x89, 0xcf, 0x90, 0x2d,
+	0x1a, 0x2f, 0x51, 0xf3, 0x15, 0xbb, 0xc4, 0xc4, 0x3d, 0x1e, 0x89, 0x31, 0xa8, 0x4e, 0x15, 0x0a,
+	0xfa, 0xee, 0x9f, 0x7b, 0xd3, 0x29, 0x1b, 0xf1, 0x06, 0xcf, 0xe4, 0x27, 0xa4, 0x92, 0x9c, 0x3e,
+	0xb4, 0xb4, 0x1b, 0x19, 0x0f, 0xf3, 0x9c, 0x5d, 0xcb, 0x2e, 0x00, 0x1f, 0xb1, 0x3d, 0x9c, 0x9d,
+	0x7b, 0x53, 0x79, 0x0e, 0xfc, 0x19, 0x0b, 0x2f, 0x64, 0xd3, 0xb1, 0x3b, 0x64, 0x83, 0x40, 0x0e,
+	0xbc, 0x09, 0x01, 0x61, 0x21, 0x7f, 0x18, 0x5f, 0xf2, 0x92, 0x6c, 0xc3, 0xcd, 0x82, 0xf6, 0x77,
+	0xf7, 0x57, 0xda, 0x87, 0x6b, 0x52, 0xe3, 0x4d, 0xa0, 0xb5, 0x42, 0x1a, 0xb0, 0x4a, 0xf1, 0x24,
+	0x2c, 0x63, 0xf7, 0x43, 0x51, 0x69, 0xfc, 0x73, 0x74, 0x0b, 0x1a, 0x4f, 0xaf, 0x86, 0xe3, 0xf9,
+	0xcc, 0xbb, 0x60, 0xd6, 0x0a, 0x01, 0xa8, 0xe2, 0x35, 0xce, 0x46, 0x96, 0xb1, 0xfb, 0x29, 0x40,
+	0xf2, 0x55, 0x9a, 0xd4, 0xa1, 0x82, 0x2b, 0x6b, 0x85, 0x34, 0xa1, 0xfe, 0xcc, 0x9d, 0x45, 0xcf,
+	0x5c, 0x6f, 0x6c, 0x19, 0x64, 0x0d, 0x00, 0xd3, 0x24, 0x6a, 0xd2, 0x2a, 0xed, 0xde, 0x4e, 0x1a,
+	0x05, 0xd4, 0x79, 0x15, 0xf8, 0x4c, 0x78, 0x7f, 0x72, 0x8d, 0x81, 0x18, 0xbb, 0xff, 0x28, 0xc5,
+	0x5f, 0x09, 0x90, 0x8f, 0x7a, 0xc2, 0xaf, 0xe8, 0x66, 0x84, 0xc5, 0xa4, 0x4b, 0xb5, 0x4a, 0x84,
+	0xa4, 0x87, 0x69, 0xab, 0x8c, 0x34, 0x1d, 0x79, 0xad, 0x0a, 0x31, 0x17, 0x83, 0xb2, 0xb5, 0x4a,
+	0x6e, 0xe4, 0x8c, 0xbf, 0x56, 0x95, 0xb4, 0xc1, 0x94, 0x9f, 0xd0, 0xb9, 0x52, 0x8d, 0xac, 0x43,
+	0x4b, 0x12, 0xa4, 0xff, 0x3a, 0xd9, 0xc8, 0x0c, 0xa6, 0x56, 0x83, 0x58, 0xfa, 0x78, 0x69, 0x01,
+	0x52, 0x54, 0xa0, 0xb2, 0x4c, 0xf4, 0x99, 0xb9, 0x50, 0xad, 0x26, 0xd9, 0xca, 0x9b, 0xb1, 0xac,
+	0x16, 0x8a, 0x67, 0x2e, 0x46, 0x6b, 0x0d, 0x43, 0x54, 0xa0, 0xc7, 0x6a, 0x63, 0x3c, 0xa9, 0xc3,
+	0xb6, 0xac, 0x5d, 0x16, 0x37, 0x07, 0xc2, 0x2b, 0x57, 0xc6, 0x2d, 0x3d, 0xf5, 0x71, 0xb7, 0xd6,
+	0x0a, 0x7a, 0x55, 0xc8, 0x32, 0x7b, 0x96, 0xa1, 0x88, 0xbf, 0xe6, 0x09, 0xee, 0xcf, 0x87, 0x43,
+	0xab, 0xa4, 0x90, 0x93, 0x98, 0xac, 0xf2, 0x93, 0xde, 0x0f, 0xff, 0xdd, 0x31, 0xbe, 0x7f, 0xb7,
+	0x63, 0xfc, 0xf0, 0x6e, 0xc7, 0xf8, 0xcf, 0xbb, 0x9d, 0x95, 0xbf, 0xfe, 0x6f, 0xc7, 0xf8, 0x93,
+	0xfa, 0xb3, 0x65, 0xe2, 0x46, 0xa1, 0x77, 0x15, 0xf0, 0xe6, 0x2e, 0x5e, 0xf8, 0x6c, 0x6f, 0x7a,
+	0x7e, 0xba, 0x37, 0x3d, 0xd9, 0xc3, 0x94, 0x9e, 0x54, 0xf9, 0x2f, 0x96, 0xfb, 0xff, 0x1f, 0x00,
+	0x05, 0x5f, 0xdb, 0x58, 0xb6, 0x19, 0x00, 0x00,
 }
 
 func (m *LockOptions) Marshal() (dAtA []byte, err error) {
@@ -2908,6 +2953,11 @@ func (m *LockOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.WaitTimeout != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.WaitTimeout))
+		i--
+		dAtA[i] = 0x50
+	}
 	{
 		size, err := m.SnapShotTs.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3781,6 +3831,15 @@ func (m *UnlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Rows) > 0 {
+		for iNdEx := len(m.Rows) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Rows[iNdEx])
+			copy(dAtA[i:], m.Rows[iNdEx])
+			i = encodeVarintLock(dAtA, i, uint64(len(m.Rows[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Mutations) > 0 {
 		for iNdEx := len(m.Mutations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4689,6 +4748,15 @@ func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SkippedRows) > 0 {
+		for iNdEx := len(m.SkippedRows) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SkippedRows[iNdEx])
+			copy(dAtA[i:], m.SkippedRows[iNdEx])
+			i = encodeVarintLock(dAtA, i, uint64(len(m.SkippedRows[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if m.NewLockAdd {
 		i--
 		if m.NewLockAdd {
@@ -4934,6 +5002,9 @@ func (m *LockOptions) ProtoSize() (n int) {
 	}
 	l = m.SnapShotTs.ProtoSize()
 	n += 1 + l + sovLock(uint64(l))
+	if m.WaitTimeout != 0 {
+		n += 1 + sovLock(uint64(m.WaitTimeout))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5233,6 +5304,12 @@ func (m *UnlockRequest) ProtoSize() (n int) {
 			n += 1 + l + sovLock(uint64(l))
 		}
 	}
+	if len(m.Rows) > 0 {
+		for _, b := range m.Rows {
+			l = len(b)
+			n += 1 + l + sovLock(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5664,6 +5741,12 @@ func (m *Result) ProtoSize() (n int) {
 	if m.NewLockAdd {
 		n += 2
 	}
+	if len(m.SkippedRows) > 0 {
+		for _, b := range m.SkippedRows {
+			l = len(b)
+			n += 1 + l + sovLock(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5955,6 +6038,25 @@ func (m *LockOptions) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitTimeout", wireType)
+			}
+			m.WaitTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
@@ -8351,6 +8453,38 @@ func (m *UnlockRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rows = append(m.Rows, make([]byte, postIndex-iNdEx))
+			copy(m.Rows[len(m.Rows)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
@@ -10647,6 +10781,38 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NewLockAdd = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedRows", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkippedRows = append(m.SkippedRows, make([]byte, postIndex-iNdEx))
+			copy(m.SkippedRows[len(m.SkippedRows)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
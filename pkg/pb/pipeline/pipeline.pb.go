@@ -1587,19 +1587,21 @@ func (m *PostDml) GetFullText() *plan.PostDmlFullTextCtx {
 }
 
 type LockTarget struct {
-	TableId              uint64        `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	PrimaryColIdxInBat   int32         `protobuf:"varint,2,opt,name=primary_col_idx_in_bat,json=primaryColIdxInBat,proto3" json:"primary_col_idx_in_bat,omitempty"`
-	PrimaryColTyp        plan.Type     `protobuf:"bytes,3,opt,name=primary_col_typ,json=primaryColTyp,proto3" json:"primary_col_typ"`
-	RefreshTsIdxInBat    int32         `protobuf:"varint,4,opt,name=refresh_ts_idx_in_bat,json=refreshTsIdxInBat,proto3" json:"refresh_ts_idx_in_bat,omitempty"`
-	FilterColIdxInBat    int32         `protobuf:"varint,5,opt,name=filter_col_idx_in_bat,json=filterColIdxInBat,proto3" json:"filter_col_idx_in_bat,omitempty"`
-	LockTable            bool          `protobuf:"varint,6,opt,name=lock_table,json=lockTable,proto3" json:"lock_table,omitempty"`
-	ChangeDef            bool          `protobuf:"varint,7,opt,name=ChangeDef,proto3" json:"ChangeDef,omitempty"`
-	Mode                 lock.LockMode `protobuf:"varint,8,opt,name=Mode,proto3,enum=lock.LockMode" json:"Mode,omitempty"`
-	LockRows             *plan.Expr    `protobuf:"bytes,9,opt,name=lock_rows,json=lockRows,proto3" json:"lock_rows,omitempty"`
-	LockTableAtTheEnd    bool          `protobuf:"varint,10,opt,name=lock_table_at_the_end,json=lockTableAtTheEnd,proto3" json:"lock_table_at_the_end,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	TableId              uint64          `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	PrimaryColIdxInBat   int32           `protobuf:"varint,2,opt,name=primary_col_idx_in_bat,json=primaryColIdxInBat,proto3" json:"primary_col_idx_in_bat,omitempty"`
+	PrimaryColTyp        plan.Type       `protobuf:"bytes,3,opt,name=primary_col_typ,json=primaryColTyp,proto3" json:"primary_col_typ"`
+	RefreshTsIdxInBat    int32           `protobuf:"varint,4,opt,name=refresh_ts_idx_in_bat,json=refreshTsIdxInBat,proto3" json:"refresh_ts_idx_in_bat,omitempty"`
+	FilterColIdxInBat    int32           `protobuf:"varint,5,opt,name=filter_col_idx_in_bat,json=filterColIdxInBat,proto3" json:"filter_col_idx_in_bat,omitempty"`
+	LockTable            bool            `protobuf:"varint,6,opt,name=lock_table,json=lockTable,proto3" json:"lock_table,omitempty"`
+	ChangeDef            bool            `protobuf:"varint,7,opt,name=ChangeDef,proto3" json:"ChangeDef,omitempty"`
+	Mode                 lock.LockMode   `protobuf:"varint,8,opt,name=Mode,proto3,enum=lock.LockMode" json:"Mode,omitempty"`
+	LockRows             *plan.Expr      `protobuf:"bytes,9,opt,name=lock_rows,json=lockRows,proto3" json:"lock_rows,omitempty"`
+	LockTableAtTheEnd    bool            `protobuf:"varint,10,opt,name=lock_table_at_the_end,json=lockTableAtTheEnd,proto3" json:"lock_table_at_the_end,omitempty"`
+	WaitPolicy           lock.WaitPolicy `protobuf:"varint,11,opt,name=wait_policy,json=waitPolicy,proto3,enum=lock.WaitPolicy" json:"wait_policy,omitempty"`
+	WaitTimeout          int64           `protobuf:"varint,12,opt,name=wait_timeout,json=waitTimeout,proto3" json:"wait_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *LockTarget) Reset()         { *m = LockTarget{} }
@@ -1705,11 +1707,27 @@ func (m *LockTarget) GetLockTableAtTheEnd() bool {
 	return false
 }
 
+func (m *LockTarget) GetWaitPolicy() lock.WaitPolicy {
+	if m != nil {
+		return m.WaitPolicy
+	}
+	return lock.WaitPolicy_Wait
+}
+
+func (m *LockTarget) GetWaitTimeout() int64 {
+	if m != nil {
+		return m.WaitTimeout
+	}
+	return 0
+}
+
 type LockOp struct {
-	Targets              []*LockTarget `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Targets []*LockTarget `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	// limit is the most rows locked with SKIP LOCKED, 0 means no limit.
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *LockOp) Reset()         { *m = LockOp{} }
@@ -1752,6 +1770,13 @@ func (m *LockOp) GetTargets() []*LockTarget {
 	return nil
 }
 
+func (m *LockOp) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 type PreInsertUnique struct {
 	PreInsertUkCtx       *plan.PreInsertUkCtx `protobuf:"bytes,1,opt,name=pre_insert_uk_ctx,json=preInsertUkCtx,proto3" json:"pre_insert_uk_ctx,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -5902,372 +5927,372 @@ func init() {
 func init() { proto.RegisterFile("pipeline.proto", fileDescriptor_7ac67a7adf3df9c7) }
 
 var fileDescriptor_7ac67a7adf3df9c7 = []byte{
-	// 5834 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x4b, 0x8c, 0x1c, 0x47,
-	0x72, 0xa8, 0xfa, 0x5f, 0x1d, 0xfd, 0x99, 0x9e, 0xe4, 0xaf, 0x45, 0x52, 0xe4, 0xb0, 0x44, 0x52,
-	0xb3, 0x94, 0x34, 0x94, 0x46, 0xcb, 0xb7, 0x7a, 0x6f, 0xdf, 0xae, 0x76, 0x38, 0x43, 0xee, 0x8e,
-	0xc4, 0xa1, 0xe6, 0xe5, 0x0c, 0x9f, 0xe0, 0x3d, 0xb8, 0x50, 0x53, 0x95, 0xdd, 0x53, 0x3b, 0xd5,
-	0x55, 0xc5, 0xaa, 0x2c, 0x72, 0x86, 0x27, 0x03, 0xf6, 0xd5, 0x27, 0x9f, 0x0c, 0x5f, 0x8c, 0x3d,
-	0xd8, 0xf0, 0xc1, 0xb0, 0x61, 0xc3, 0x80, 0x01, 0x63, 0x4f, 0xbe, 0xac, 0x2f, 0x86, 0x4f, 0x3e,
-	0x1a, 0xc6, 0xfa, 0xe6, 0xcf, 0x6d, 0x6d, 0xf8, 0x62, 0xc0, 0x88, 0xc8, 0xac, 0x4f, 0x7f, 0x38,
-	0xfc, 0x48, 0x32, 0x76, 0x81, 0xbd, 0x65, 0x46, 0x44, 0x66, 0x65, 0x46, 0x44, 0x46, 0x46, 0x46,
-	0x46, 0x16, 0xf4, 0x23, 0x2f, 0x12, 0xbe, 0x17, 0x88, 0xb5, 0x28, 0x0e, 0x65, 0xc8, 0x8c, 0xac,
-	0x7e, 0xf1, 0xfd, 0xb1, 0x27, 0x0f, 0xd3, 0x83, 0x35, 0x27, 0x9c, 0xdc, 0x1e, 0x87, 0xe3, 0xf0,
-	0x36, 0x11, 0x1c, 0xa4, 0x23, 0xaa, 0x51, 0x85, 0x4a, 0xaa, 0xe1, 0x45, 0x88, 0x7c, 0x3b, 0xd0,
-	0xe5, 0x25, 0xe9, 0x4d, 0x44, 0x22, 0xed, 0x49, 0x94, 0x21, 0xfd, 0xd0, 0x39, 0xd2, 0xe5, 0xb6,
-	0x3c, 0xd6, 0x74, 0xe6, 0xef, 0x55, 0xa1, 0xb5, 0x23, 0x92, 0xc4, 0x1e, 0x0b, 0x66, 0x42, 0x2d,
-	0xf1, 0xdc, 0x61, 0x65, 0xa5, 0xb2, 0xda, 0x5f, 0x1f, 0xac, 0xe5, 0xc3, 0xda, 0x93, 0xb6, 0x4c,
-	0x13, 0x8e, 0x48, 0xa4, 0x71, 0x26, 0xee, 0xb0, 0x3a, 0x4b, 0xb3, 0x23, 0xe4, 0x61, 0xe8, 0x72,
-	0x44, 0xb2, 0x01, 0xd4, 0x44, 0x1c, 0x0f, 0x6b, 0x2b, 0x95, 0xd5, 0x2e, 0xc7, 0x22, 0x63, 0x50,
-	0x77, 0x6d, 0x69, 0x0f, 0xeb, 0x04, 0xa2, 0x32, 0xbb, 0x0e, 0xfd, 0x28, 0x0e, 0x1d, 0xcb, 0x0b,
-	0x46, 0xa1, 0x45, 0xd8, 0x06, 0x61, 0xbb, 0x08, 0xdd, 0x0e, 0x46, 0xe1, 0x16, 0x52, 0x0d, 0xa1,
-	0x65, 0x07, 0xb6, 0x7f, 0x92, 0x88, 0x61, 0x93, 0xd0, 0x59, 0x95, 0xf5, 0xa1, 0xea, 0xb9, 0xc3,
-	0xd6, 0x4a, 0x65, 0xb5, 0xce, 0xab, 0x9e, 0x8b, 0xdf, 0x48, 0x53, 0xcf, 0x1d, 0x1a, 0xea, 0x1b,
-	0x58, 0x66, 0x26, 0x74, 0x03, 0x21, 0xdc, 0x87, 0xa1, 0xe4, 0x22, 0xf2, 0x4f, 0x86, 0xed, 0x95,
-	0xca, 0xaa, 0xc1, 0xa7, 0x60, 0xec, 0x22, 0x18, 0xae, 0x38, 0x48, 0xc7, 0x3b, 0xc9, 0x78, 0x08,
-	0x2b, 0x95, 0xd5, 0x36, 0xcf, 0xeb, 0xe6, 0x23, 0x68, 0x6f, 0x86, 0x41, 0x20, 0x1c, 0x19, 0xc6,
-	0xec, 0x2a, 0x74, 0xb2, 0xe9, 0x5a, 0x9a, 0x4d, 0x0d, 0x0e, 0x19, 0x68, 0xdb, 0x65, 0xef, 0xc0,
-	0x92, 0x93, 0x51, 0x5b, 0x5e, 0xe0, 0x8a, 0x63, 0xe2, 0x53, 0x83, 0xf7, 0x73, 0xf0, 0x36, 0x42,
-	0xcd, 0x7f, 0xad, 0x42, 0x6b, 0xef, 0x30, 0x1d, 0x8d, 0x7c, 0xc1, 0xae, 0x43, 0x4f, 0x17, 0x37,
-	0x43, 0x7f, 0xdb, 0x3d, 0xd6, 0xfd, 0x4e, 0x03, 0xd9, 0x0a, 0x74, 0x34, 0x60, 0xff, 0x24, 0x12,
-	0xba, 0xdb, 0x32, 0x68, 0xba, 0x9f, 0x1d, 0x2f, 0x20, 0xf6, 0xd7, 0xf8, 0x34, 0x70, 0x86, 0xca,
-	0x3e, 0x26, 0x89, 0x4c, 0x53, 0xd9, 0xf4, 0xb5, 0x0d, 0xdf, 0x7b, 0x22, 0xb8, 0x18, 0x6f, 0x06,
-	0x92, 0xe4, 0xd2, 0xe0, 0x65, 0x10, 0x5b, 0x87, 0x73, 0x89, 0x6a, 0x62, 0xc5, 0x76, 0x30, 0x16,
-	0x89, 0x95, 0x7a, 0x81, 0xfc, 0x5f, 0xdf, 0x1c, 0x36, 0x57, 0x6a, 0xab, 0x75, 0x7e, 0x46, 0x23,
-	0x39, 0xe1, 0x1e, 0x11, 0x8a, 0x7d, 0x00, 0x67, 0x67, 0xda, 0xa8, 0x26, 0xad, 0x95, 0xda, 0x6a,
-	0x8d, 0xb3, 0xa9, 0x26, 0xdb, 0xd4, 0xe2, 0x1e, 0x2c, 0xc7, 0x69, 0x80, 0x9a, 0x7c, 0xdf, 0xf3,
-	0xa5, 0x88, 0xf7, 0x22, 0xe1, 0x90, 0x7c, 0x3b, 0xeb, 0x17, 0xd6, 0x48, 0xd9, 0xf9, 0x2c, 0x9a,
-	0xcf, 0xb7, 0x30, 0xff, 0xb3, 0x0a, 0xc6, 0x96, 0x97, 0x44, 0xb6, 0x74, 0x0e, 0xd9, 0x05, 0x68,
-	0x8d, 0xd2, 0xc0, 0x29, 0x24, 0xd8, 0xc4, 0xea, 0xb6, 0xcb, 0xfe, 0x2f, 0x2c, 0xf9, 0xa1, 0x63,
-	0xfb, 0x56, 0x2e, 0xac, 0x61, 0x75, 0xa5, 0xb6, 0xda, 0x59, 0x3f, 0x53, 0x68, 0x79, 0xae, 0x0c,
-	0xbc, 0x4f, 0xb4, 0x85, 0x72, 0x7c, 0x07, 0x06, 0xb1, 0x98, 0x84, 0x52, 0x94, 0x9a, 0xd7, 0xa8,
-	0x39, 0x2b, 0x9a, 0x7f, 0x11, 0xdb, 0xd1, 0xc3, 0xd0, 0x15, 0x7c, 0x49, 0xd1, 0x16, 0xcd, 0x3f,
-	0x2c, 0xf1, 0x53, 0x8c, 0x2d, 0xcf, 0x3d, 0xb6, 0xe8, 0x03, 0xc3, 0xfa, 0x4a, 0x6d, 0xb5, 0x51,
-	0x30, 0x47, 0x8c, 0xb7, 0xdd, 0xe3, 0x07, 0x88, 0x61, 0x1f, 0xc1, 0xf9, 0xd9, 0x26, 0xaa, 0xd7,
-	0x61, 0x83, 0xda, 0x9c, 0x99, 0x6a, 0xc3, 0x09, 0xc5, 0xae, 0x41, 0x37, 0x6b, 0x24, 0x51, 0x91,
-	0x9a, 0x4a, 0xb4, 0x49, 0x49, 0x91, 0x2e, 0x40, 0xcb, 0x4b, 0xac, 0xc4, 0x0b, 0x8e, 0x68, 0x71,
-	0x19, 0xbc, 0xe9, 0x25, 0x7b, 0x5e, 0x70, 0xc4, 0xde, 0x04, 0x23, 0x16, 0x8e, 0xc2, 0x18, 0x84,
-	0x69, 0xc5, 0xc2, 0x21, 0xd4, 0x05, 0xc0, 0xa2, 0xe5, 0x48, 0xa1, 0x97, 0x58, 0x33, 0x16, 0xce,
-	0xa6, 0x14, 0x66, 0x02, 0x8d, 0x1d, 0x11, 0x8f, 0x05, 0xae, 0x32, 0x6c, 0xb8, 0xe7, 0xd8, 0x01,
-	0xf1, 0xdd, 0xe0, 0x79, 0x1d, 0xd7, 0x78, 0x64, 0xc7, 0xd2, 0xb3, 0x7d, 0x52, 0x6c, 0x83, 0x67,
-	0x55, 0x76, 0x09, 0xda, 0x89, 0xb4, 0x63, 0x89, 0xb3, 0x23, 0x85, 0x6e, 0x70, 0x83, 0x00, 0xb8,
-	0x26, 0x2e, 0x40, 0x4b, 0x04, 0x2e, 0xa1, 0xea, 0x4a, 0x92, 0x22, 0x70, 0xb7, 0xdd, 0x63, 0xf3,
-	0xcf, 0x2b, 0xd0, 0xdb, 0x49, 0x7d, 0xe9, 0x6d, 0xc4, 0xe3, 0x54, 0x4c, 0x02, 0x89, 0xb6, 0x61,
-	0xcb, 0x4b, 0xa4, 0xfe, 0x32, 0x95, 0xd9, 0x2a, 0xb4, 0xbf, 0x1f, 0x87, 0x69, 0x74, 0xef, 0x38,
-	0xca, 0x24, 0x0d, 0x4a, 0xa9, 0x10, 0xc2, 0x0b, 0x24, 0x7b, 0x0f, 0x3a, 0x9f, 0xc7, 0xae, 0x88,
-	0xef, 0x9e, 0x10, 0x6d, 0x6d, 0x8e, 0xb6, 0x8c, 0x66, 0x97, 0xa1, 0xbd, 0x27, 0x22, 0x3b, 0xb6,
-	0x51, 0x05, 0xea, 0x64, 0x50, 0x0a, 0x00, 0xce, 0x95, 0x88, 0xb7, 0x5d, 0xbd, 0xac, 0xb2, 0xaa,
-	0x39, 0x86, 0xf6, 0xc6, 0x78, 0x1c, 0x8b, 0xb1, 0x2d, 0xc9, 0xb8, 0x85, 0x11, 0x0d, 0xb7, 0xc6,
-	0xab, 0x61, 0x44, 0x06, 0x14, 0x27, 0xa0, 0xf8, 0x43, 0x65, 0x76, 0x05, 0xea, 0x62, 0xf1, 0x78,
-	0x08, 0xce, 0xce, 0x43, 0xd3, 0x09, 0x83, 0x91, 0x37, 0xd6, 0x66, 0x57, 0xd7, 0xcc, 0xdf, 0xae,
-	0x41, 0x83, 0x26, 0x87, 0xec, 0x45, 0x53, 0x68, 0x89, 0x27, 0xb6, 0x9f, 0x49, 0x05, 0x01, 0xf7,
-	0x9e, 0xd8, 0x3e, 0x5b, 0x81, 0x06, 0x76, 0x93, 0x2c, 0xe0, 0x8d, 0x42, 0xb0, 0x9b, 0xd0, 0x40,
-	0x25, 0x4a, 0xa6, 0x47, 0x80, 0x4a, 0x74, 0xb7, 0xfe, 0xd3, 0x7f, 0xb8, 0xfa, 0x06, 0x57, 0x68,
-	0xf6, 0x0e, 0xd4, 0xed, 0xf1, 0x38, 0x21, 0x5d, 0x9e, 0x5a, 0x4e, 0xf9, 0x7c, 0x39, 0x11, 0xb0,
-	0x3b, 0xd0, 0x56, 0x72, 0x43, 0xea, 0x06, 0x51, 0x5f, 0x28, 0x6d, 0x31, 0x65, 0x91, 0xf2, 0x82,
-	0x12, 0x39, 0xee, 0x25, 0xda, 0x82, 0x91, 0x46, 0x1b, 0xbc, 0x00, 0xe0, 0x1e, 0x10, 0xc5, 0x62,
-	0xc3, 0xf7, 0x43, 0x67, 0xcf, 0x7b, 0x26, 0xf4, 0x8e, 0x31, 0x05, 0x63, 0x37, 0xa1, 0xbf, 0xab,
-	0x54, 0x8e, 0x8b, 0x24, 0xf5, 0x65, 0xa2, 0x77, 0x91, 0x19, 0x28, 0x5b, 0x03, 0x36, 0x05, 0xd9,
-	0xa7, 0xe9, 0xb7, 0x57, 0x6a, 0xab, 0x3d, 0xbe, 0x00, 0xc3, 0xde, 0x86, 0xde, 0x18, 0x39, 0xed,
-	0x05, 0x63, 0x6b, 0xe4, 0xdb, 0xb8, 0xc1, 0xd4, 0x70, 0x03, 0xca, 0x80, 0xf7, 0x7d, 0x7b, 0x6c,
-	0xfe, 0xbc, 0x0a, 0xcd, 0xed, 0x20, 0x11, 0xb1, 0xc4, 0x55, 0x62, 0x8f, 0x46, 0xc2, 0x91, 0x42,
-	0x59, 0xa7, 0x3a, 0xcf, 0xeb, 0x38, 0xcb, 0xfd, 0xf0, 0x8b, 0xd8, 0x93, 0x62, 0xef, 0x23, 0xad,
-	0x07, 0x05, 0x80, 0xdd, 0x82, 0x65, 0xdb, 0x75, 0xad, 0x8c, 0xda, 0x8a, 0xc3, 0xa7, 0x09, 0xad,
-	0x18, 0x83, 0x2f, 0xd9, 0xae, 0xbb, 0xa1, 0xe1, 0x3c, 0x7c, 0x9a, 0xb0, 0x6b, 0x50, 0x8b, 0xc5,
-	0x88, 0xb4, 0xa2, 0xb3, 0xbe, 0xa4, 0xa4, 0xf6, 0xf9, 0xc1, 0x8f, 0x84, 0x23, 0xb9, 0x18, 0x71,
-	0xc4, 0xb1, 0xb3, 0xd0, 0xb0, 0xa5, 0x8c, 0x95, 0x14, 0xda, 0x5c, 0x55, 0xd8, 0x1a, 0x9c, 0xa1,
-	0x95, 0x29, 0xbd, 0x30, 0xb0, 0xa4, 0x7d, 0xe0, 0xe3, 0x46, 0x98, 0x68, 0x9b, 0xbf, 0x9c, 0xa3,
-	0xf6, 0x11, 0xb3, 0xed, 0x26, 0xb8, 0x4b, 0xcc, 0xd2, 0x07, 0xf6, 0x44, 0x24, 0x64, 0xf2, 0xdb,
-	0xfc, 0xcc, 0x74, 0x8b, 0x87, 0x88, 0x42, 0x96, 0x15, 0x6d, 0x70, 0x6d, 0x1b, 0xb4, 0x4c, 0xba,
-	0x39, 0x10, 0x97, 0xfe, 0x39, 0x68, 0x7a, 0x89, 0x25, 0x02, 0x57, 0x9b, 0x9b, 0x86, 0x97, 0xdc,
-	0x0b, 0x5c, 0xf6, 0x2e, 0xb4, 0xd5, 0x57, 0x5c, 0x31, 0xa2, 0xbd, 0xbc, 0xb3, 0xde, 0xd7, 0x4a,
-	0x89, 0xe0, 0x2d, 0x31, 0xe2, 0x86, 0xd4, 0x25, 0xf3, 0x27, 0x55, 0xe8, 0x90, 0x0e, 0x3d, 0x8a,
-	0x5c, 0x5c, 0x72, 0x6f, 0x43, 0x6f, 0x9a, 0x7b, 0x4a, 0x00, 0x5d, 0xbb, 0xcc, 0xba, 0xf3, 0xd0,
-	0xdc, 0x70, 0x70, 0x14, 0x24, 0x81, 0x1e, 0xd7, 0x35, 0x5c, 0xd6, 0xdb, 0x77, 0x53, 0xe7, 0x48,
-	0x48, 0x62, 0x7a, 0x8f, 0x67, 0x55, 0xc4, 0x3c, 0xd4, 0x98, 0xba, 0xc2, 0xe8, 0x2a, 0xbb, 0x07,
-	0xb0, 0x27, 0xc6, 0x13, 0x11, 0xc8, 0x1d, 0x3b, 0xd2, 0xea, 0x7e, 0x63, 0x46, 0xdd, 0xd5, 0xd8,
-	0xd6, 0x0a, 0xba, 0x7b, 0x81, 0x8c, 0x4f, 0x78, 0xa9, 0x21, 0xfb, 0x16, 0x2c, 0xa5, 0x44, 0x65,
-	0x39, 0xf2, 0xd8, 0xf2, 0xd1, 0x4a, 0x34, 0xa9, 0x2f, 0x2d, 0x59, 0xd5, 0xc5, 0xa6, 0x3c, 0xe6,
-	0xbd, 0x34, 0x2b, 0x3e, 0xf0, 0x12, 0x79, 0xf1, 0x3b, 0xb0, 0x34, 0xd3, 0x2f, 0x7a, 0x6e, 0x47,
-	0xe2, 0x84, 0x66, 0xde, 0xe6, 0x58, 0x44, 0x45, 0x78, 0x62, 0xfb, 0x69, 0xe6, 0x72, 0xa8, 0xca,
-	0xff, 0xa9, 0x7e, 0x5c, 0x31, 0xdf, 0x82, 0xc6, 0x46, 0x1c, 0xdb, 0x44, 0x62, 0x63, 0x61, 0x58,
-	0xa1, 0x7d, 0x47, 0x55, 0x4c, 0x07, 0x6a, 0x38, 0xba, 0x1b, 0x50, 0x9d, 0x44, 0x84, 0xe9, 0xac,
-	0x9f, 0x2b, 0x4d, 0xce, 0x8e, 0xd6, 0x76, 0xf4, 0x64, 0xaa, 0x93, 0xe8, 0xe2, 0x1d, 0x68, 0xed,
-	0xbc, 0xc6, 0x18, 0xfe, 0xbd, 0x0e, 0xc6, 0x96, 0xf0, 0x05, 0xc9, 0xc0, 0x84, 0x6e, 0x59, 0xcd,
-	0x33, 0xf9, 0x4d, 0xa9, 0xbe, 0x09, 0x5d, 0xb5, 0x13, 0x52, 0x2b, 0xa1, 0xd7, 0xd1, 0x14, 0xec,
-	0xb5, 0x64, 0x79, 0x19, 0x20, 0x0e, 0x9f, 0x5a, 0x9e, 0xda, 0x8e, 0x94, 0x65, 0x37, 0xe2, 0xf0,
-	0xe9, 0x36, 0x6e, 0x48, 0xff, 0x23, 0xeb, 0xe6, 0x5b, 0x30, 0x2c, 0xad, 0x1b, 0x74, 0x33, 0x2d,
-	0x2f, 0xb0, 0x0e, 0xd0, 0xe7, 0xd1, 0x4b, 0xa8, 0xe8, 0x93, 0xbc, 0xd0, 0xed, 0xe0, 0x2e, 0x39,
-	0x44, 0xda, 0x1a, 0xb4, 0x4f, 0xb1, 0x06, 0x0b, 0x8d, 0x0b, 0x2c, 0x36, 0x2e, 0x77, 0xa7, 0xb4,
-	0xba, 0x43, 0x82, 0x37, 0x0b, 0xc1, 0x67, 0xd2, 0x3a, 0x55, 0xa5, 0xaf, 0x41, 0xd7, 0xb1, 0x03,
-	0x4b, 0xc6, 0x69, 0xe0, 0xd8, 0x52, 0x0c, 0xbb, 0xf4, 0xa9, 0x8e, 0x63, 0x07, 0xfb, 0x1a, 0x54,
-	0xb2, 0x00, 0xbd, 0xb2, 0x05, 0xb8, 0x09, 0x4b, 0x51, 0xec, 0x4d, 0xec, 0xf8, 0xc4, 0x3a, 0x12,
-	0x27, 0x24, 0x8c, 0xbe, 0xf2, 0xa7, 0x35, 0xf8, 0x33, 0x71, 0xb2, 0xed, 0x1e, 0x7f, 0x59, 0xdd,
-	0xff, 0xfb, 0x2a, 0xb4, 0x77, 0x63, 0xa1, 0xad, 0xf6, 0x55, 0xe8, 0x24, 0xce, 0xa1, 0x98, 0xd8,
-	0x24, 0x25, 0xdd, 0x03, 0x28, 0x10, 0x0a, 0x67, 0xda, 0x2e, 0x55, 0x4f, 0xb7, 0x4b, 0x38, 0x0e,
-	0xe5, 0xed, 0xe0, 0x62, 0xc2, 0x62, 0x61, 0x8c, 0xeb, 0x65, 0x63, 0xbc, 0x02, 0xdd, 0x43, 0x3b,
-	0xb1, 0xec, 0x54, 0x86, 0x96, 0x13, 0xfa, 0xa4, 0x74, 0x06, 0x87, 0x43, 0x3b, 0xd9, 0x48, 0x65,
-	0xb8, 0x19, 0x92, 0xf7, 0xe4, 0x25, 0x96, 0x5a, 0xf4, 0x7a, 0x5f, 0x34, 0xbc, 0x44, 0x9b, 0xbb,
-	0x35, 0x38, 0x23, 0x12, 0xe9, 0x4d, 0x6c, 0x2d, 0x50, 0xcb, 0x09, 0xd3, 0x40, 0xd2, 0xee, 0x58,
-	0xe3, 0xcb, 0x39, 0x8a, 0x87, 0x4f, 0x37, 0x11, 0xc1, 0x3e, 0x80, 0xbe, 0x13, 0x4e, 0x22, 0x2b,
-	0x42, 0xbe, 0x92, 0xdf, 0xa1, 0x1c, 0xf1, 0xb2, 0x5f, 0xd0, 0x45, 0x8a, 0xdd, 0x23, 0xa1, 0x1c,
-	0xa1, 0x75, 0x58, 0x72, 0xfc, 0x34, 0x91, 0x22, 0xb6, 0x0e, 0x74, 0x93, 0xf6, 0x5c, 0x93, 0x9e,
-	0x26, 0x51, 0xce, 0x13, 0x32, 0xb6, 0xb5, 0x1b, 0x26, 0x72, 0x6b, 0xe2, 0x67, 0x8a, 0x59, 0x79,
-	0x55, 0xc5, 0xac, 0x2e, 0x56, 0xcc, 0x05, 0xaa, 0x51, 0x5b, 0xa0, 0x1a, 0x6c, 0x15, 0x06, 0x65,
-	0x3a, 0x12, 0xa9, 0x72, 0xe3, 0xfa, 0x05, 0x21, 0x89, 0x55, 0xf1, 0xd7, 0x55, 0x96, 0xa4, 0x91,
-	0xf1, 0x57, 0x5b, 0x11, 0x85, 0xf4, 0x48, 0x43, 0x0a, 0xe6, 0x6b, 0x8d, 0xf9, 0xdf, 0xf0, 0x66,
-	0xde, 0xd2, 0x7a, 0xea, 0xc9, 0xc3, 0x30, 0x95, 0xd6, 0x88, 0x4e, 0x2c, 0x89, 0xf6, 0xba, 0xcf,
-	0x67, 0x3d, 0x7d, 0xa1, 0xd0, 0xea, 0x3c, 0x43, 0x3e, 0xd2, 0x28, 0xf5, 0x7d, 0x4b, 0x8a, 0x63,
-	0xa9, 0x45, 0x30, 0x54, 0xbc, 0xd1, 0x7c, 0xbb, 0x9f, 0xfa, 0xfe, 0xbe, 0x38, 0x96, 0x68, 0xf1,
-	0x8d, 0x91, 0xae, 0x98, 0x7f, 0x51, 0x03, 0x78, 0x10, 0x3a, 0x47, 0xfb, 0x76, 0x3c, 0x16, 0x12,
-	0x7d, 0xf9, 0xcc, 0x0e, 0x69, 0x3b, 0xd9, 0x92, 0xca, 0xfa, 0xb0, 0x75, 0x38, 0x9f, 0xcd, 0xdf,
-	0x09, 0x7d, 0x3a, 0x57, 0x28, 0x43, 0xa2, 0x97, 0x01, 0xd3, 0x58, 0x75, 0x32, 0x25, 0x2b, 0xc2,
-	0x3e, 0x2e, 0x78, 0x8b, 0x6d, 0xe4, 0x49, 0x44, 0xbc, 0x5d, 0xe4, 0x13, 0xf6, 0x8a, 0xe6, 0xfb,
-	0x27, 0x11, 0xfb, 0x00, 0xce, 0xc5, 0x62, 0x14, 0x8b, 0xe4, 0xd0, 0x92, 0x49, 0xf9, 0x63, 0xca,
-	0xa5, 0x5f, 0xd6, 0xc8, 0xfd, 0x24, 0xff, 0xd6, 0x07, 0x70, 0x4e, 0x71, 0x6a, 0x76, 0x78, 0xca,
-	0xea, 0x2e, 0x2b, 0x64, 0x79, 0x74, 0x6f, 0x01, 0x05, 0x3f, 0x94, 0x25, 0xcd, 0x1c, 0x44, 0x9f,
-	0x98, 0x71, 0xe0, 0x0b, 0x74, 0xac, 0x36, 0x0f, 0xf1, 0xd4, 0xb9, 0x25, 0x46, 0x9a, 0xf9, 0x05,
-	0x80, 0x99, 0x50, 0xdf, 0x09, 0x5d, 0x41, 0xac, 0xee, 0xaf, 0xf7, 0xd7, 0x28, 0x8c, 0x82, 0x9c,
-	0x44, 0x28, 0x27, 0x1c, 0x7b, 0x07, 0xa8, 0x3b, 0xa5, 0x7e, 0xf3, 0x3a, 0x6e, 0x20, 0x92, 0x74,
-	0xf0, 0x03, 0x38, 0x57, 0x8c, 0xc4, 0xb2, 0xa5, 0x25, 0x0f, 0x05, 0x19, 0x31, 0x65, 0x4c, 0x97,
-	0xf3, 0x41, 0x6d, 0xc8, 0xfd, 0x43, 0x71, 0x2f, 0x70, 0xcd, 0x8f, 0xa1, 0x89, 0x1f, 0xfb, 0x3c,
-	0x62, 0x6b, 0xd0, 0x92, 0x24, 0xbc, 0x44, 0x6f, 0xa7, 0x67, 0x0b, 0xab, 0x5a, 0x48, 0x96, 0x67,
-	0x44, 0x26, 0x87, 0xa5, 0xdc, 0x44, 0x3d, 0x0a, 0xbc, 0xc7, 0xa9, 0x60, 0x9f, 0xc0, 0x72, 0x14,
-	0x0b, 0xad, 0x94, 0x56, 0x7a, 0x84, 0x1e, 0x83, 0x5e, 0x5f, 0x67, 0xb5, 0x0e, 0xe5, 0x2d, 0x8e,
-	0x50, 0x7f, 0xfa, 0xd1, 0x54, 0xdd, 0xfc, 0x21, 0x5c, 0xc8, 0x29, 0xf6, 0x84, 0x13, 0x06, 0xae,
-	0x1d, 0x9f, 0xd0, 0x6e, 0x32, 0xd3, 0x77, 0xf2, 0x2a, 0x7d, 0xef, 0x51, 0xdf, 0x3f, 0xae, 0x41,
-	0xff, 0xf3, 0x60, 0x2b, 0x8d, 0x7c, 0x0f, 0x2d, 0xfc, 0x67, 0xca, 0x00, 0x2b, 0xc3, 0x57, 0x29,
-	0x1b, 0xbe, 0x55, 0x18, 0xe8, 0xaf, 0xa0, 0x02, 0x28, 0xb3, 0xa5, 0xe3, 0x2c, 0x0a, 0xbe, 0x19,
-	0xfa, 0xca, 0x66, 0x7d, 0x07, 0xce, 0xa5, 0x34, 0x73, 0x45, 0x79, 0x28, 0x9c, 0x23, 0xeb, 0x39,
-	0x47, 0x26, 0xa6, 0x08, 0xb1, 0x29, 0x92, 0x91, 0x01, 0xbb, 0x0a, 0x9d, 0xa2, 0x79, 0x66, 0x7d,
-	0x21, 0x27, 0xa4, 0x91, 0x84, 0x81, 0xe5, 0x66, 0x43, 0xd6, 0x7b, 0x3f, 0xda, 0xed, 0x7e, 0x58,
-	0xcc, 0x04, 0x8d, 0xca, 0xaf, 0xc1, 0xf2, 0x14, 0x25, 0x8d, 0x42, 0xb9, 0x69, 0xef, 0x17, 0x62,
-	0x9c, 0x9e, 0x7e, 0xb9, 0x8a, 0xe3, 0x51, 0xfb, 0xe4, 0x52, 0x38, 0x0d, 0xcd, 0x0c, 0xcd, 0x38,
-	0x08, 0x63, 0xa1, 0xd5, 0x17, 0x0d, 0x0d, 0xd5, 0x2f, 0x3e, 0x84, 0xb3, 0x8b, 0x7a, 0x59, 0xb0,
-	0xd9, 0xad, 0x94, 0x37, 0xbb, 0x99, 0xe3, 0x5e, 0xb1, 0xf1, 0xfd, 0x61, 0x05, 0x3a, 0xf7, 0xd3,
-	0x67, 0xcf, 0x4e, 0x94, 0x39, 0x62, 0x5d, 0xa8, 0x3c, 0xa4, 0x5e, 0xaa, 0xbc, 0xf2, 0x10, 0xbd,
-	0xe3, 0xdd, 0x23, 0x34, 0x8d, 0xd4, 0x49, 0x9b, 0xeb, 0x1a, 0x1e, 0x14, 0x77, 0x8f, 0xf6, 0x4f,
-	0x31, 0x0a, 0x0a, 0x8d, 0xc7, 0x9f, 0xbb, 0xa9, 0xe7, 0xa3, 0xcf, 0xa4, 0xd7, 0x7f, 0x5e, 0xc7,
-	0xa3, 0xd7, 0xf6, 0x48, 0xe9, 0xcb, 0xfd, 0x38, 0x9c, 0x28, 0x8d, 0xd6, 0x56, 0x77, 0x01, 0xc6,
-	0xfc, 0x9b, 0x1a, 0xd4, 0x3f, 0x0d, 0xbd, 0x40, 0x85, 0x2d, 0x7c, 0xe5, 0x18, 0x2b, 0x0f, 0xb5,
-	0x15, 0x0b, 0x1f, 0x3d, 0x60, 0x44, 0xa1, 0x62, 0xf8, 0xea, 0x64, 0x4d, 0x28, 0x27, 0x54, 0xa8,
-	0xe2, 0x70, 0x5d, 0x59, 0x78, 0xb8, 0xce, 0xcf, 0xbe, 0xf5, 0x17, 0x9d, 0x7d, 0xdb, 0xbe, 0x18,
-	0xa1, 0xaa, 0x06, 0xae, 0xf6, 0xf1, 0xa7, 0x4d, 0x83, 0x18, 0xc9, 0xcd, 0x30, 0x70, 0xd9, 0x37,
-	0x00, 0x62, 0x6f, 0x7c, 0xa8, 0x29, 0x9b, 0xf3, 0xf1, 0x08, 0xc2, 0x12, 0x29, 0x87, 0x37, 0x75,
-	0x90, 0x4b, 0xef, 0x19, 0xd6, 0x01, 0x72, 0x49, 0xcd, 0xa3, 0x95, 0x1d, 0x9b, 0x17, 0x87, 0xc7,
-	0xce, 0x4f, 0x85, 0xc7, 0x88, 0xbb, 0x34, 0xdf, 0xcb, 0x80, 0x9e, 0xc3, 0xa1, 0x15, 0x06, 0x56,
-	0x94, 0x85, 0x77, 0x0c, 0x84, 0x7c, 0x1e, 0xec, 0x1e, 0xa1, 0x05, 0xf5, 0x12, 0x4b, 0x47, 0x89,
-	0xf4, 0x99, 0xab, 0x74, 0xc4, 0x5e, 0x81, 0xee, 0x8f, 0x42, 0x2f, 0xb0, 0x26, 0x76, 0x64, 0x49,
-	0x5b, 0x85, 0x51, 0x1b, 0x1c, 0x10, 0xb6, 0x63, 0x47, 0xfb, 0xf6, 0x98, 0x5c, 0x24, 0x1d, 0x77,
-	0xc2, 0x45, 0xd2, 0x51, 0x04, 0x1a, 0x84, 0xe2, 0xbd, 0x04, 0x6d, 0xea, 0x82, 0xa2, 0x52, 0x5d,
-	0x25, 0x7b, 0x04, 0x20, 0x47, 0xcd, 0x7f, 0xae, 0x82, 0xb1, 0x11, 0x48, 0x8f, 0xe4, 0x79, 0x1e,
-	0x9a, 0x31, 0x1d, 0xb1, 0xb5, 0x34, 0x75, 0x2d, 0x97, 0x58, 0xf5, 0x39, 0x12, 0x9b, 0x92, 0x44,
-	0xed, 0xa5, 0x25, 0x51, 0x3f, 0x4d, 0x12, 0xd3, 0x5c, 0x6b, 0x9c, 0xca, 0xb5, 0xb9, 0xc0, 0xc4,
-	0xd7, 0x21, 0xc6, 0x59, 0x49, 0x18, 0x2f, 0x92, 0x44, 0x7b, 0x56, 0x12, 0xe6, 0x9f, 0xd6, 0xc0,
-	0x78, 0x20, 0x46, 0xf2, 0x57, 0x8b, 0xe7, 0x97, 0x65, 0xf1, 0x98, 0xff, 0x56, 0x83, 0x36, 0xc7,
-	0x19, 0x7e, 0x8d, 0x32, 0xbb, 0x0d, 0x40, 0xb2, 0x38, 0x5d, 0x70, 0x24, 0x2f, 0x15, 0xfb, 0xfa,
-	0x10, 0x3a, 0x4a, 0x26, 0xaa, 0x45, 0xe3, 0x39, 0x2d, 0x94, 0xe0, 0xf6, 0xe7, 0xe5, 0xdd, 0x7c,
-	0x69, 0x79, 0xb7, 0x5e, 0x5b, 0xde, 0xc6, 0x57, 0x21, 0xef, 0xf6, 0xa9, 0xf2, 0x86, 0x17, 0xc9,
-	0xbb, 0xf3, 0x22, 0x79, 0x77, 0xe7, 0xe4, 0xfd, 0xe3, 0x1a, 0xf4, 0x48, 0xde, 0x7b, 0x62, 0xf2,
-	0xe5, 0x8c, 0xe2, 0x8c, 0x90, 0x6a, 0xaf, 0x2a, 0xa4, 0xfa, 0x4b, 0x0b, 0xa9, 0xf1, 0xda, 0x42,
-	0x6a, 0x7e, 0x15, 0x42, 0x6a, 0x9d, 0x2a, 0x24, 0xe3, 0x45, 0x42, 0x6a, 0xbf, 0xfa, 0xa2, 0xcc,
-	0x85, 0xf4, 0xa5, 0x77, 0xae, 0x5f, 0x09, 0xe9, 0x2b, 0x12, 0x12, 0xcc, 0x09, 0x09, 0x3d, 0x8b,
-	0x2f, 0xbd, 0x88, 0xbe, 0x0e, 0xcf, 0xe2, 0x54, 0x66, 0x37, 0xbe, 0x0a, 0x66, 0x37, 0x4f, 0x65,
-	0x76, 0xeb, 0x45, 0xcc, 0x7e, 0x0d, 0xcf, 0xe2, 0xcf, 0x6a, 0x00, 0x7b, 0x5e, 0x30, 0xf6, 0xc5,
-	0xaf, 0x7c, 0x8b, 0x5f, 0x1a, 0xdf, 0xe2, 0xaf, 0xaa, 0x60, 0xec, 0xd8, 0xf1, 0xd1, 0x2f, 0xdc,
-	0x0a, 0x79, 0x1b, 0x5a, 0x61, 0x50, 0x5e, 0x0f, 0x65, 0xba, 0x66, 0x18, 0xfc, 0x42, 0xa8, 0xfc,
-	0x5f, 0xd7, 0xa0, 0xbd, 0x25, 0xdc, 0x34, 0x22, 0xf6, 0x4d, 0xb1, 0xa1, 0xf2, 0xd2, 0x6c, 0xa8,
-	0xbe, 0xb6, 0xce, 0xd5, 0x5e, 0x4f, 0xe7, 0xa6, 0xf9, 0x52, 0x7f, 0x11, 0x5f, 0x1a, 0x2f, 0xe2,
-	0x4b, 0x73, 0xee, 0xb8, 0xf7, 0x00, 0xce, 0x4c, 0xc5, 0x43, 0x6c, 0x75, 0xa9, 0xd6, 0xa2, 0x20,
-	0xdb, 0x65, 0x35, 0xde, 0x87, 0xa1, 0x3b, 0x15, 0x12, 0x51, 0x57, 0x6d, 0x7c, 0x39, 0x9c, 0x05,
-	0xb1, 0xeb, 0xd0, 0x77, 0x91, 0xc9, 0x14, 0xe6, 0xa1, 0x80, 0xad, 0x41, 0xf1, 0x87, 0x2e, 0x41,
-	0x37, 0x43, 0x9f, 0xa2, 0x10, 0x1f, 0xc3, 0x52, 0x41, 0x25, 0xf3, 0x9b, 0xdb, 0x85, 0x41, 0xca,
-	0xac, 0x21, 0xed, 0xa6, 0xe6, 0x6f, 0x54, 0xa0, 0xb5, 0x1b, 0x87, 0x6e, 0xea, 0xc8, 0xd7, 0xb4,
-	0x5a, 0xd3, 0x1c, 0xad, 0xbd, 0x88, 0xa3, 0xf5, 0x59, 0x8e, 0x9a, 0xbf, 0x59, 0x81, 0xb6, 0x1e,
-	0xc2, 0x83, 0xf5, 0xaf, 0xc9, 0x74, 0xbe, 0x78, 0x14, 0x4f, 0xa1, 0x4d, 0xd1, 0xbe, 0x53, 0x8d,
-	0xc1, 0xa9, 0x1a, 0x59, 0x7d, 0x2d, 0x8d, 0x34, 0x7f, 0xa7, 0x02, 0x3d, 0x0a, 0x8c, 0xde, 0x4f,
-	0x03, 0x25, 0xf3, 0xc5, 0xb1, 0xc1, 0x15, 0xa8, 0xc7, 0x42, 0x66, 0x39, 0x0b, 0x5d, 0xf5, 0x99,
-	0xcd, 0xd0, 0xdf, 0x12, 0x23, 0x4e, 0x18, 0x64, 0x82, 0x1d, 0x8f, 0x93, 0x45, 0x59, 0x13, 0x08,
-	0xc7, 0x59, 0x45, 0x76, 0x6c, 0x4f, 0x92, 0x2c, 0x6b, 0x42, 0xd5, 0x18, 0x83, 0x3a, 0x69, 0x56,
-	0x83, 0x34, 0x8b, 0xca, 0xe6, 0x06, 0x9c, 0xbb, 0x77, 0x2c, 0x45, 0x1c, 0xd8, 0xa4, 0x61, 0xeb,
-	0x9b, 0xa1, 0xaf, 0x82, 0xa1, 0x19, 0x71, 0xa5, 0x20, 0xc6, 0x01, 0x97, 0x73, 0xc2, 0x54, 0xc5,
-	0xbc, 0x01, 0x9d, 0x91, 0xe7, 0x0b, 0x2b, 0x1c, 0x8d, 0x12, 0x21, 0xf1, 0xeb, 0xaa, 0x44, 0xd3,
-	0xaa, 0x71, 0x5d, 0x33, 0x7f, 0x52, 0x87, 0x6e, 0xf6, 0x29, 0xca, 0x99, 0x59, 0x3c, 0xfd, 0x4b,
-	0xd0, 0xa6, 0xde, 0x12, 0xef, 0x99, 0x20, 0x1e, 0xd4, 0xb8, 0x81, 0x00, 0x4a, 0x72, 0xd8, 0x80,
-	0xe5, 0xd2, 0xa7, 0x2c, 0x19, 0x4a, 0xdb, 0xd7, 0x6c, 0x28, 0xdd, 0xcc, 0x96, 0x48, 0xf8, 0x12,
-	0x56, 0x3e, 0xa7, 0xf2, 0x3e, 0x52, 0x23, 0x7b, 0xf3, 0x50, 0xe8, 0x1c, 0x7b, 0x11, 0xc3, 0xbe,
-	0x0f, 0x4b, 0x38, 0xdb, 0x75, 0x15, 0x9c, 0xa7, 0xf9, 0x2a, 0xeb, 0x7c, 0xb5, 0xf8, 0xc4, 0x42,
-	0x9e, 0xf1, 0x5e, 0x30, 0xc5, 0xc2, 0xb7, 0x00, 0x9c, 0x58, 0xa0, 0x6d, 0x48, 0x1e, 0xfb, 0x64,
-	0x41, 0xda, 0xbc, 0xad, 0x20, 0x7b, 0x8f, 0xfd, 0x7c, 0xa6, 0xf9, 0xd6, 0xda, 0x56, 0x33, 0x25,
-	0x45, 0x7f, 0x1f, 0x3a, 0x61, 0xec, 0x8d, 0xbd, 0x40, 0x05, 0x6e, 0x8d, 0x05, 0xa3, 0x05, 0x45,
-	0x40, 0x61, 0x5c, 0x13, 0x9a, 0x4a, 0x51, 0x17, 0xc4, 0xee, 0x35, 0x86, 0x71, 0xe8, 0xef, 0x1f,
-	0xa0, 0x41, 0xa0, 0xb4, 0xc4, 0xcd, 0xd0, 0xa7, 0x54, 0x8e, 0xce, 0xfa, 0xad, 0xf9, 0x69, 0xa1,
-	0x7c, 0xd6, 0xa6, 0x89, 0x55, 0xe8, 0x76, 0xa6, 0x07, 0x76, 0x13, 0x96, 0x12, 0x19, 0x7b, 0x8e,
-	0xc4, 0x29, 0x5a, 0x93, 0xd0, 0x15, 0xb4, 0xff, 0x1a, 0xbc, 0xa7, 0xc0, 0x7b, 0x8f, 0xfd, 0x9d,
-	0xd0, 0x15, 0x17, 0x37, 0xe0, 0xcc, 0x82, 0xee, 0x5e, 0xe9, 0xc2, 0xd2, 0x01, 0xd8, 0x93, 0xb1,
-	0xb0, 0x27, 0xa4, 0x3c, 0xef, 0x40, 0x4b, 0x1e, 0xf8, 0x74, 0x1b, 0x59, 0x59, 0x78, 0x1b, 0xd9,
-	0x94, 0x07, 0xc8, 0xa5, 0x92, 0x3a, 0x56, 0xe9, 0x5e, 0x50, 0xd7, 0xf0, 0x43, 0xbe, 0x37, 0xf1,
-	0xa4, 0x4e, 0x32, 0x54, 0x15, 0xf3, 0x23, 0x68, 0x53, 0x0f, 0xf4, 0x8d, 0xdc, 0x0f, 0xab, 0x9c,
-	0xea, 0x87, 0x99, 0xef, 0x41, 0xfb, 0xff, 0xe3, 0x30, 0xa9, 0xd1, 0x55, 0xe8, 0xd0, 0x8d, 0xb5,
-	0x75, 0xe0, 0x87, 0xce, 0x51, 0x76, 0x93, 0x4a, 0xa0, 0xbb, 0x08, 0x31, 0x01, 0x8c, 0x47, 0x81,
-	0x17, 0x06, 0x1b, 0xbe, 0x6f, 0xfe, 0x6e, 0x1d, 0xda, 0x3f, 0xb0, 0x93, 0x43, 0xb2, 0x12, 0x6c,
-	0x05, 0x3a, 0x0f, 0x85, 0x70, 0x11, 0xb0, 0x63, 0x47, 0x3a, 0x9b, 0xa9, 0x0c, 0x62, 0x17, 0xc1,
-	0xf8, 0x81, 0xda, 0xf9, 0x3f, 0xd3, 0x77, 0x84, 0x79, 0x3d, 0x6b, 0x4d, 0x37, 0xe2, 0x22, 0x4b,
-	0x9c, 0x29, 0x83, 0xd8, 0x2d, 0x18, 0x60, 0x95, 0x72, 0x86, 0x50, 0x07, 0x85, 0x9f, 0xe8, 0x9d,
-	0x71, 0x0e, 0xce, 0x6e, 0x01, 0xe0, 0xde, 0x4c, 0x77, 0xed, 0xc9, 0x02, 0xef, 0xa4, 0x84, 0x65,
-	0x57, 0x00, 0x3e, 0xcd, 0x0d, 0x6c, 0xb6, 0x53, 0x16, 0x10, 0x76, 0x1d, 0x7a, 0xba, 0xc6, 0xc5,
-	0x68, 0x53, 0xdf, 0xd0, 0x36, 0xf8, 0x34, 0x90, 0xdd, 0x83, 0x65, 0xfe, 0xca, 0x99, 0x92, 0x73,
-	0x20, 0xdc, 0x3c, 0xe8, 0x5e, 0xd2, 0x4d, 0x23, 0xed, 0x4c, 0xb6, 0xbc, 0x84, 0xfc, 0x97, 0xe7,
-	0xed, 0xd8, 0xf0, 0x55, 0xed, 0xd8, 0x9d, 0x97, 0xdb, 0xb1, 0xbb, 0x2f, 0xb7, 0x63, 0xff, 0xbc,
-	0x06, 0x5d, 0xbd, 0xb9, 0xd2, 0xe6, 0x33, 0x25, 0xfc, 0xca, 0xe9, 0xc2, 0xaf, 0xbe, 0x9c, 0xf0,
-	0x6b, 0x2f, 0x25, 0xfc, 0xfa, 0xa9, 0xc2, 0x5f, 0x28, 0xb6, 0xc6, 0x2b, 0x8b, 0xed, 0x45, 0x3a,
-	0x74, 0x05, 0x60, 0x2f, 0xf7, 0xbd, 0xb4, 0x02, 0x95, 0x20, 0x53, 0x62, 0x37, 0x5e, 0x4a, 0xec,
-	0xed, 0xaf, 0x4a, 0xec, 0xf0, 0x72, 0x62, 0xef, 0xbc, 0x9c, 0xd8, 0xf7, 0x00, 0x68, 0xf7, 0x50,
-	0x32, 0x5f, 0xc8, 0xdd, 0xca, 0xab, 0x72, 0xd7, 0xfc, 0xaf, 0x0a, 0xc0, 0x9e, 0x3d, 0x89, 0x94,
-	0xf3, 0xc1, 0xbe, 0x07, 0x9d, 0x84, 0x6a, 0xea, 0xae, 0x42, 0x65, 0xcb, 0x97, 0x76, 0xb7, 0x82,
-	0x54, 0x17, 0x71, 0x68, 0x1c, 0x92, 0xbc, 0x4c, 0xde, 0xb1, 0xea, 0x21, 0xcf, 0x57, 0x68, 0x64,
-	0x04, 0x74, 0x4d, 0x7c, 0x03, 0xfa, 0x9a, 0x20, 0x12, 0xb1, 0x23, 0x02, 0x65, 0x67, 0x2b, 0xbc,
-	0xa7, 0xa0, 0xbb, 0x0a, 0xc8, 0x3e, 0xcc, 0xc9, 0x9c, 0xd0, 0x4f, 0x27, 0x0b, 0xb5, 0x4d, 0x37,
-	0xd9, 0x54, 0x04, 0xe6, 0x7a, 0x36, 0x15, 0x1a, 0x88, 0x01, 0x75, 0xfc, 0xde, 0xe0, 0x0d, 0xd6,
-	0x81, 0x96, 0xee, 0x75, 0x50, 0x61, 0x3d, 0x68, 0x53, 0xd2, 0x2e, 0xe1, 0xaa, 0xe6, 0x1f, 0x9c,
-	0x81, 0xce, 0x76, 0x90, 0xc8, 0x38, 0x55, 0x42, 0x2c, 0x72, 0x53, 0x1b, 0x94, 0x9b, 0xaa, 0x13,
-	0x56, 0xd4, 0x34, 0x28, 0x61, 0xe5, 0x26, 0xd4, 0xed, 0x40, 0x7a, 0xda, 0xd1, 0x2c, 0x25, 0x40,
-	0x67, 0xa1, 0x30, 0x4e, 0x78, 0xf6, 0x3e, 0xb4, 0x74, 0xb6, 0xb4, 0x4e, 0x46, 0x5c, 0x98, 0x6a,
-	0x9d, 0xd1, 0xb0, 0x35, 0x30, 0x5c, 0x9d, 0xc6, 0xad, 0x17, 0x49, 0xa9, 0xeb, 0x2c, 0xc1, 0x9b,
-	0xe7, 0x34, 0xec, 0x1a, 0xd4, 0xec, 0xb1, 0x5a, 0x0f, 0x94, 0x40, 0x92, 0x91, 0x52, 0xf2, 0x2b,
-	0x47, 0x1c, 0x33, 0xa1, 0x8e, 0xee, 0x2d, 0xad, 0x09, 0xda, 0x06, 0x33, 0x1a, 0x35, 0x4a, 0xc4,
-	0xb1, 0xdb, 0xfa, 0xd4, 0x46, 0x84, 0xc6, 0xec, 0x77, 0xb3, 0xab, 0x12, 0x75, 0x7a, 0xfb, 0x54,
-	0x37, 0x48, 0xc4, 0xc4, 0x53, 0x0d, 0xda, 0xb3, 0x0d, 0xb2, 0x70, 0x13, 0x37, 0x92, 0x2c, 0xf0,
-	0x74, 0x07, 0x3a, 0x09, 0xc5, 0x45, 0x54, 0x13, 0xc8, 0x6e, 0xcd, 0xf3, 0x26, 0x79, 0xd0, 0x84,
-	0x43, 0x52, 0x04, 0x50, 0x6e, 0x43, 0x7b, 0x62, 0xc7, 0x47, 0xaa, 0x51, 0x67, 0xf6, 0x3b, 0xd9,
-	0xa1, 0x9d, 0x1b, 0x93, 0xec, 0xf8, 0xbe, 0x0e, 0xa0, 0x16, 0x16, 0xb5, 0xe8, 0xce, 0xb2, 0x3c,
-	0x3f, 0xa8, 0xf2, 0xb6, 0x9b, 0x9f, 0x59, 0xdf, 0x85, 0x56, 0xa4, 0xce, 0x1d, 0x94, 0x69, 0xd5,
-	0x59, 0x5f, 0x2e, 0x1a, 0xe8, 0x03, 0x09, 0xcf, 0x28, 0xd8, 0x77, 0xa1, 0xaf, 0x52, 0x1b, 0x46,
-	0xda, 0x4d, 0xa7, 0xec, 0xab, 0xa9, 0x2c, 0xde, 0x29, 0x2f, 0x9e, 0xf7, 0xe4, 0x94, 0x53, 0xff,
-	0x6d, 0xe8, 0x09, 0xed, 0x45, 0x59, 0x89, 0x63, 0x07, 0xc3, 0x01, 0x35, 0x3f, 0xbf, 0xd8, 0xc9,
-	0xe2, 0x5d, 0x51, 0x76, 0x89, 0x57, 0xa1, 0xa9, 0xd3, 0x6d, 0x96, 0xa9, 0x55, 0xe9, 0x75, 0x8a,
-	0xba, 0x1d, 0xe6, 0x1a, 0xcf, 0xee, 0xce, 0xdc, 0xdb, 0xa3, 0x1b, 0xc5, 0xb2, 0x54, 0x9a, 0xc5,
-	0x97, 0xf1, 0x53, 0x37, 0xfa, 0x9f, 0x89, 0x13, 0xe4, 0x65, 0x91, 0xef, 0x30, 0x3c, 0x33, 0xcb,
-	0xcb, 0x3c, 0xd9, 0x81, 0xb7, 0xf3, 0x3c, 0x07, 0x34, 0x48, 0xe5, 0xfc, 0x0b, 0x75, 0x85, 0x7d,
-	0x96, 0x9a, 0xbe, 0xb9, 0xa0, 0xa9, 0xba, 0xc9, 0xe6, 0x4b, 0xd1, 0x4c, 0x1a, 0xc7, 0x7b, 0x60,
-	0x84, 0xb1, 0x4b, 0x69, 0x55, 0xc3, 0x73, 0xb4, 0xe2, 0x97, 0x75, 0x76, 0x94, 0x4a, 0x43, 0x27,
-	0x43, 0xd6, 0x0a, 0x55, 0x85, 0xbd, 0x0f, 0xdd, 0x28, 0x0e, 0x7f, 0x24, 0x1c, 0xa9, 0x9c, 0xe5,
-	0xf3, 0xf3, 0xe9, 0xeb, 0x1a, 0x4f, 0xbe, 0x73, 0xe1, 0x0c, 0x5f, 0x78, 0xae, 0x33, 0xbc, 0x92,
-	0xb9, 0x7f, 0xc3, 0xf9, 0x5c, 0x01, 0x42, 0x60, 0x2f, 0xda, 0x71, 0x7c, 0x73, 0xbe, 0x17, 0xed,
-	0x44, 0x0e, 0xa1, 0xe5, 0x25, 0xf7, 0xbd, 0x38, 0x91, 0xc3, 0x8b, 0xd9, 0xa6, 0x43, 0x55, 0x74,
-	0x3b, 0xbd, 0xe4, 0x81, 0x9d, 0xc8, 0xe1, 0xa5, 0xec, 0x05, 0x02, 0xd6, 0x90, 0xe7, 0x2a, 0x2c,
-	0x42, 0xfa, 0x7b, 0x79, 0x96, 0xe7, 0xf9, 0x15, 0x98, 0x8e, 0x8f, 0x90, 0xfe, 0x7e, 0x02, 0x4b,
-	0xaa, 0x4d, 0xb1, 0x24, 0xdf, 0x9a, 0xd5, 0xc9, 0xa9, 0xbb, 0x14, 0xde, 0x8b, 0xa7, 0xae, 0x56,
-	0xf2, 0x0e, 0xd0, 0x64, 0xa9, 0x0e, 0xae, 0x2c, 0xec, 0x20, 0x37, 0x6e, 0xaa, 0x83, 0x3c, 0xec,
-	0x7f, 0x0b, 0x9a, 0x3a, 0x47, 0xec, 0xea, 0x9c, 0xd1, 0xd2, 0xd9, 0x90, 0x5c, 0x53, 0xb0, 0x6f,
-	0x40, 0x8b, 0x12, 0x84, 0xc2, 0x68, 0xb8, 0x32, 0xab, 0xc4, 0x2a, 0x0f, 0x88, 0x37, 0x7d, 0x95,
-	0x0f, 0xf4, 0x2e, 0xb4, 0xb2, 0x78, 0xc2, 0xb5, 0xd9, 0x85, 0xa9, 0xf7, 0x76, 0x9e, 0x51, 0xb0,
-	0x1b, 0xd0, 0x98, 0xa0, 0x49, 0x1f, 0x9a, 0xb3, 0xc6, 0x50, 0x59, 0x7a, 0x85, 0x25, 0x43, 0x44,
-	0xc7, 0x04, 0xb5, 0xfa, 0xde, 0x9e, 0x33, 0x44, 0xf9, 0x19, 0x82, 0x43, 0x52, 0x9c, 0x27, 0x7e,
-	0x1d, 0x2e, 0x96, 0x73, 0x7f, 0xb2, 0xc4, 0x20, 0x7d, 0xfe, 0xbb, 0x4e, 0xbd, 0x5c, 0x5b, 0xa0,
-	0xe0, 0xd3, 0x29, 0x44, 0xfc, 0x42, 0xf4, 0x9c, 0xdc, 0xa2, 0x3b, 0xf9, 0x86, 0x89, 0x76, 0x65,
-	0x78, 0x63, 0x6e, 0x58, 0xf9, 0x96, 0x9b, 0x6d, 0xa3, 0xb4, 0x53, 0x7f, 0x0c, 0xdd, 0x51, 0xfa,
-	0xec, 0xd9, 0x89, 0x0e, 0x43, 0x0c, 0x6f, 0x52, 0xbb, 0xd2, 0x59, 0xb7, 0x94, 0xc9, 0xc2, 0x3b,
-	0xa3, 0x52, 0x5a, 0xcb, 0x05, 0x68, 0x39, 0x81, 0x65, 0xbb, 0x6e, 0x3c, 0x7c, 0x47, 0x65, 0xb2,
-	0x38, 0xc1, 0x86, 0xeb, 0x52, 0x4a, 0x50, 0x18, 0x09, 0x7a, 0xca, 0x61, 0x79, 0xee, 0x70, 0x55,
-	0x6d, 0xdd, 0x19, 0x68, 0xdb, 0xa5, 0x47, 0x62, 0x76, 0x6c, 0xfb, 0xbe, 0xf0, 0x91, 0xe0, 0x1b,
-	0xfa, 0x91, 0x98, 0x06, 0x6d, 0xbb, 0xec, 0x1a, 0x74, 0x27, 0xf6, 0xb1, 0x95, 0x41, 0x86, 0xb7,
-	0xd4, 0x0b, 0x9c, 0x89, 0x7d, 0xbc, 0xab, 0x41, 0xa8, 0xe6, 0x2a, 0x81, 0x97, 0x94, 0xed, 0xdd,
-	0x59, 0x35, 0xcf, 0x23, 0x30, 0xbc, 0xed, 0xe5, 0xc1, 0x18, 0x32, 0x47, 0x64, 0x84, 0x2d, 0x7f,
-	0x7d, 0xf8, 0xde, 0xbc, 0x39, 0xd2, 0xa1, 0x23, 0x34, 0x47, 0x59, 0x14, 0x69, 0x1d, 0x40, 0x59,
-	0x6b, 0x12, 0xf6, 0xfb, 0xb3, 0x6d, 0xf2, 0xb3, 0x1c, 0x57, 0xd9, 0xab, 0x24, 0xea, 0x75, 0x00,
-	0x3a, 0x55, 0xaa, 0x36, 0x6b, 0xb3, 0x6d, 0xf2, 0xa3, 0x1c, 0x6f, 0x3f, 0xc9, 0x4f, 0x75, 0xb7,
-	0xa1, 0x9d, 0xe2, 0xa1, 0xcd, 0xb2, 0x7d, 0x7f, 0x78, 0x7b, 0x76, 0x0d, 0x64, 0xe7, 0x39, 0x6e,
-	0xa4, 0xba, 0x84, 0x1f, 0xa1, 0xa8, 0x2d, 0xb9, 0x71, 0xc3, 0x0f, 0x66, 0x3f, 0x92, 0x1f, 0xfa,
-	0x78, 0xfb, 0x30, 0x3f, 0xff, 0x7d, 0x1b, 0x7a, 0x59, 0xc8, 0x51, 0x35, 0xfb, 0x70, 0x76, 0xeb,
-	0x28, 0x9f, 0x07, 0x78, 0xf6, 0x0c, 0x4a, 0x35, 0xbe, 0x03, 0x1d, 0xc5, 0x71, 0xd5, 0x74, 0x7d,
-	0x56, 0xc1, 0x0a, 0xa7, 0x92, 0x2b, 0xd1, 0xa8, 0x66, 0x37, 0xa0, 0x61, 0x47, 0x91, 0x7f, 0x32,
-	0xfc, 0x68, 0x76, 0x55, 0x6d, 0x20, 0x98, 0x2b, 0x2c, 0xea, 0xe1, 0x24, 0xf5, 0xa5, 0x97, 0xa5,
-	0xe2, 0x7e, 0x73, 0x56, 0x0f, 0x4b, 0xa9, 0xfe, 0xbc, 0x33, 0x29, 0xbd, 0x49, 0x78, 0x0f, 0x8c,
-	0x28, 0x4c, 0xa4, 0xe5, 0x4e, 0xfc, 0xe1, 0x9d, 0xb9, 0xdd, 0x57, 0xe5, 0x7b, 0xf2, 0x56, 0xa4,
-	0x0a, 0xe6, 0x1d, 0xe8, 0x6e, 0xd0, 0xe3, 0x48, 0x2f, 0x21, 0x53, 0x7e, 0x03, 0xea, 0x79, 0x84,
-	0x30, 0xdf, 0x23, 0x88, 0xe2, 0x99, 0xd8, 0x0e, 0x46, 0x21, 0x27, 0xb4, 0xf9, 0xb7, 0x35, 0x68,
-	0xee, 0x85, 0x69, 0xec, 0x88, 0x17, 0x67, 0x32, 0xbf, 0x95, 0xa9, 0x4c, 0x50, 0x64, 0x79, 0x29,
-	0xed, 0x20, 0x74, 0x39, 0xf8, 0x58, 0xa3, 0xa0, 0x4c, 0x1e, 0x7c, 0x3c, 0x0b, 0x0d, 0x75, 0xa8,
-	0x57, 0xb9, 0xb4, 0xaa, 0x42, 0xcb, 0x25, 0x4d, 0x0e, 0xdd, 0xf0, 0x69, 0x80, 0xcb, 0xa5, 0x41,
-	0xa9, 0xa8, 0x90, 0x81, 0xb6, 0x5d, 0x7a, 0x0e, 0x92, 0x11, 0xd0, 0x7a, 0x54, 0x91, 0xa0, 0x6e,
-	0x06, 0xa4, 0x55, 0x99, 0x05, 0x36, 0x5b, 0xcf, 0x09, 0x6c, 0x5e, 0x81, 0x7a, 0x90, 0xe5, 0x70,
-	0xe6, 0x78, 0x7a, 0x88, 0x47, 0x70, 0x76, 0x0b, 0xf2, 0xf4, 0x6b, 0xed, 0xaf, 0x3d, 0x3f, 0x3d,
-	0x7b, 0x1d, 0xda, 0xf9, 0xd3, 0xda, 0xdc, 0x53, 0x2b, 0x1e, 0xdb, 0xee, 0x67, 0x25, 0x5e, 0x90,
-	0x2d, 0x88, 0x88, 0x46, 0x71, 0x78, 0xa0, 0x83, 0x57, 0x9d, 0x57, 0x89, 0x88, 0xee, 0x62, 0xbb,
-	0x2c, 0xce, 0xeb, 0x25, 0x96, 0x13, 0x06, 0x89, 0xd4, 0xf9, 0xf1, 0x2d, 0x2f, 0xd9, 0xc4, 0xaa,
-	0xf9, 0x97, 0x15, 0x30, 0x70, 0x76, 0x28, 0x63, 0xc6, 0xa0, 0x3e, 0x71, 0xa2, 0x54, 0xfb, 0xeb,
-	0x54, 0xd6, 0x4f, 0x67, 0x95, 0xf4, 0xf4, 0xd3, 0x59, 0xe2, 0x6d, 0x4d, 0x85, 0x2b, 0xb1, 0xac,
-	0x1e, 0xe5, 0x9d, 0xf8, 0xa1, 0xed, 0x6a, 0x89, 0x65, 0x55, 0xf6, 0x1e, 0x30, 0xf5, 0x6a, 0xec,
-	0x38, 0xb2, 0x03, 0x57, 0xbf, 0xe5, 0xd4, 0xc9, 0x4f, 0x03, 0x7a, 0x3e, 0x46, 0x08, 0xf5, 0x90,
-	0x93, 0x9d, 0x83, 0xa6, 0x13, 0x58, 0x4e, 0x20, 0xf5, 0xb9, 0xb4, 0xe1, 0x04, 0x9b, 0x81, 0xd4,
-	0x60, 0x2f, 0x3f, 0x8e, 0x36, 0x9c, 0x60, 0xdb, 0x3d, 0x36, 0xff, 0xb8, 0x02, 0xcb, 0xbb, 0x71,
-	0xe8, 0x88, 0x24, 0x79, 0x80, 0x8e, 0x84, 0x4d, 0x6e, 0x21, 0x83, 0x3a, 0x45, 0x34, 0xd5, 0x7b,
-	0x38, 0x2a, 0xa3, 0x26, 0xaa, 0x50, 0x51, 0x7e, 0x86, 0xaa, 0xf1, 0x36, 0x41, 0xe8, 0x08, 0x95,
-	0xa3, 0xa9, 0x61, 0xad, 0x84, 0xa6, 0x58, 0xe8, 0x0d, 0xe8, 0x17, 0xaf, 0x25, 0xa8, 0x07, 0xfd,
-	0x10, 0x36, 0x87, 0x52, 0x2f, 0x57, 0xa1, 0x13, 0x0b, 0x1b, 0x5d, 0x2d, 0xea, 0xa6, 0x41, 0x34,
-	0xa0, 0x40, 0xd8, 0x8f, 0x79, 0x08, 0x83, 0xdd, 0x58, 0x44, 0x76, 0x2c, 0xd0, 0x7a, 0x4f, 0x88,
-	0xe3, 0xe7, 0xa1, 0xe9, 0x8b, 0x60, 0x2c, 0x0f, 0xf5, 0x78, 0x75, 0x2d, 0x7f, 0x04, 0x5d, 0x2d,
-	0x3d, 0x82, 0x46, 0xce, 0xc7, 0xc2, 0xd6, 0x6f, 0xa5, 0xa9, 0x8c, 0x2b, 0x25, 0x48, 0x7d, 0x1d,
-	0x65, 0x35, 0xb8, 0xaa, 0x98, 0x7f, 0x54, 0x83, 0x8e, 0xe6, 0x0c, 0x7d, 0x45, 0xc9, 0xb0, 0x92,
-	0xcb, 0x70, 0x00, 0xb5, 0xe4, 0xb1, 0xaf, 0x85, 0x8a, 0x45, 0xf6, 0x11, 0xd4, 0x7c, 0x6f, 0xa2,
-	0x0f, 0x61, 0x97, 0xa6, 0xf6, 0x82, 0x69, 0xfe, 0xea, 0xb3, 0x34, 0x52, 0xb3, 0x4b, 0x64, 0xab,
-	0x8f, 0x2d, 0x54, 0x39, 0xcd, 0x13, 0xb4, 0xcb, 0xc7, 0xa8, 0xd7, 0xc8, 0x54, 0xdb, 0xa1, 0x84,
-	0xdb, 0x6c, 0xb1, 0xf6, 0x78, 0x5b, 0x43, 0xb6, 0x5d, 0xf6, 0x4d, 0x30, 0x92, 0xc0, 0x8e, 0x92,
-	0xc3, 0x50, 0xea, 0x43, 0x17, 0x5b, 0x93, 0xc7, 0xc1, 0xda, 0xe6, 0xc3, 0xfd, 0xe3, 0x60, 0x4f,
-	0x63, 0xf4, 0xc7, 0x72, 0x4a, 0xf6, 0x5d, 0xe8, 0x26, 0x22, 0x49, 0xd4, 0xb3, 0x95, 0x51, 0xa8,
-	0x17, 0xf1, 0xb9, 0xf2, 0x81, 0x89, 0xb0, 0x38, 0x6b, 0xdd, 0xb8, 0x93, 0x14, 0x20, 0xf6, 0x03,
-	0xe8, 0x67, 0xed, 0xfd, 0x70, 0x3c, 0x16, 0xd9, 0xc3, 0x84, 0x4b, 0x73, 0x3d, 0x3c, 0x20, 0x74,
-	0xa9, 0x9f, 0x5e, 0x52, 0x46, 0xb0, 0xef, 0x43, 0x3f, 0x52, 0xc2, 0xb4, 0xf4, 0x15, 0x80, 0x32,
-	0x06, 0x17, 0xa7, 0x5c, 0x97, 0x29, 0x61, 0x17, 0x49, 0xed, 0x05, 0x3c, 0x31, 0xff, 0xa3, 0x02,
-	0x9d, 0xd2, 0xa8, 0xe9, 0x69, 0x7a, 0x22, 0xe2, 0xec, 0x3a, 0x00, 0xcb, 0x08, 0x3b, 0x0c, 0xf5,
-	0x8b, 0xce, 0x36, 0xa7, 0x32, 0xc2, 0xe2, 0x50, 0xdf, 0x0f, 0xb5, 0x39, 0x95, 0xd1, 0x00, 0xea,
-	0xf3, 0xaf, 0x7a, 0x10, 0x47, 0x42, 0xa9, 0xf3, 0x6e, 0x01, 0xdc, 0xa6, 0xe8, 0x16, 0xaa, 0xd3,
-	0x81, 0x9d, 0x64, 0x17, 0x14, 0x79, 0x1d, 0x17, 0xf2, 0x13, 0x11, 0xe3, 0x58, 0xb4, 0xed, 0xcc,
-	0xaa, 0x28, 0x6b, 0xb2, 0x49, 0xcf, 0xc2, 0x40, 0xdd, 0x7e, 0x76, 0xb9, 0x81, 0x80, 0x1f, 0x86,
-	0x01, 0x35, 0xd3, 0x92, 0xd5, 0x97, 0x69, 0x59, 0x15, 0x2d, 0xcf, 0xe3, 0x54, 0xa0, 0x7b, 0xe7,
-	0xd2, 0x05, 0x5a, 0x9b, 0xb7, 0xa8, 0xbe, 0xed, 0x9a, 0xff, 0x52, 0x81, 0xe5, 0x39, 0x66, 0xa3,
-	0x37, 0x85, 0x8c, 0xce, 0xde, 0x1a, 0x74, 0x79, 0x13, 0xab, 0xdb, 0x2e, 0x21, 0xe4, 0x84, 0x94,
-	0xa9, 0xaa, 0x11, 0x72, 0x82, 0x9a, 0x74, 0x0e, 0x9a, 0xf2, 0x98, 0x66, 0xab, 0x16, 0x46, 0x43,
-	0x1e, 0xe3, 0x34, 0x37, 0xa0, 0xed, 0x87, 0x63, 0xcb, 0x17, 0x4f, 0x84, 0x4f, 0x7c, 0xe8, 0xaf,
-	0x5f, 0x3f, 0x45, 0xca, 0x6b, 0x0f, 0xc2, 0xf1, 0x03, 0xa4, 0xe5, 0x86, 0xaf, 0x4b, 0xe6, 0xa7,
-	0x60, 0x64, 0x50, 0xd6, 0x86, 0xc6, 0x96, 0x38, 0x48, 0xc7, 0x83, 0x37, 0x98, 0x01, 0x75, 0x6c,
-	0x31, 0xa8, 0x60, 0xe9, 0x0b, 0x3b, 0x0e, 0x06, 0x55, 0x44, 0xdf, 0x8b, 0xe3, 0x30, 0x1e, 0xd4,
-	0xb0, 0xb8, 0x6b, 0x07, 0x9e, 0x33, 0xa8, 0x63, 0xf1, 0xbe, 0x2d, 0x6d, 0x7f, 0xd0, 0x30, 0xff,
-	0xa4, 0x01, 0xc6, 0xae, 0xfe, 0x3a, 0xdb, 0x82, 0x5e, 0xfe, 0x77, 0x80, 0xc5, 0x81, 0xa1, 0xdd,
-	0xd9, 0x02, 0x05, 0x86, 0xba, 0x51, 0xa9, 0x36, 0xfb, 0x8f, 0x81, 0xea, 0xdc, 0x3f, 0x06, 0x2e,
-	0x43, 0xed, 0x71, 0x7c, 0x32, 0x7d, 0x85, 0xb7, 0xeb, 0xdb, 0x01, 0x47, 0x30, 0xfb, 0x10, 0x3a,
-	0x28, 0x77, 0x2b, 0xa1, 0xed, 0x5c, 0x07, 0x55, 0xca, 0x7f, 0x72, 0x20, 0x38, 0x07, 0x24, 0xd2,
-	0x5b, 0xfe, 0x1a, 0x18, 0xce, 0xa1, 0xe7, 0xbb, 0xb1, 0x08, 0x74, 0xa4, 0x9a, 0xcd, 0x0f, 0x99,
-	0xe7, 0x34, 0xec, 0x7b, 0x94, 0x7d, 0x9f, 0x05, 0x83, 0xca, 0xc9, 0x3f, 0xe7, 0xa6, 0xce, 0xdb,
-	0x19, 0x05, 0x5f, 0x2a, 0x91, 0xd3, 0xce, 0x55, 0x3c, 0xdd, 0x6a, 0x95, 0x9f, 0x6e, 0xa9, 0x77,
-	0xe7, 0xb4, 0xe1, 0x18, 0xf9, 0xa9, 0x0f, 0xf7, 0x9b, 0x9b, 0x7a, 0xf7, 0x9e, 0x8b, 0xa4, 0x64,
-	0x7b, 0x9c, 0xde, 0xc5, 0xaf, 0x43, 0x1f, 0xbd, 0x02, 0x4b, 0x39, 0x13, 0x68, 0x4a, 0x40, 0x3f,
-	0x1d, 0x4d, 0x93, 0xc3, 0x2d, 0x74, 0x27, 0x50, 0x19, 0x6f, 0x40, 0x3f, 0x9b, 0x8b, 0x7e, 0x3b,
-	0xa0, 0x72, 0x1e, 0x7a, 0x19, 0x54, 0x3d, 0x1d, 0x58, 0x83, 0x33, 0xce, 0xa1, 0x1d, 0x04, 0xc2,
-	0xb7, 0x0e, 0xd2, 0xd1, 0x28, 0xdb, 0x01, 0xba, 0x74, 0xd3, 0xb9, 0xac, 0x51, 0x77, 0x09, 0x43,
-	0x1b, 0x8a, 0x09, 0xbd, 0xc0, 0xf3, 0xd5, 0x7b, 0x3b, 0xda, 0xed, 0x7a, 0x44, 0xd9, 0x09, 0x3c,
-	0x9f, 0x82, 0xc8, 0xb8, 0xe7, 0x7d, 0x02, 0x83, 0x34, 0xf5, 0xdc, 0xc4, 0x92, 0x61, 0xf6, 0x64,
-	0x7f, 0xd8, 0x27, 0xd6, 0x95, 0xbc, 0xd4, 0x47, 0xa9, 0xe7, 0xee, 0x87, 0xfa, 0xd1, 0x7e, 0x8f,
-	0xe8, 0xb3, 0xaa, 0xf9, 0x09, 0x74, 0xcb, 0xba, 0x83, 0xba, 0x48, 0xc7, 0xb7, 0xc1, 0x1b, 0x0c,
-	0xa0, 0xf9, 0x30, 0x8c, 0x27, 0xb6, 0x3f, 0xa8, 0x60, 0x59, 0x3d, 0x68, 0x1c, 0x54, 0x59, 0x17,
-	0x8c, 0xec, 0x5c, 0x31, 0xa8, 0x99, 0xdf, 0x06, 0x23, 0xfb, 0x07, 0x01, 0x3d, 0xfe, 0x0e, 0x5d,
-	0xa1, 0xbc, 0x2a, 0x65, 0x99, 0x0c, 0x04, 0x90, 0x47, 0x95, 0xfd, 0x4c, 0xa3, 0x5a, 0xfc, 0x4c,
-	0xc3, 0xfc, 0x7f, 0xd0, 0x2d, 0x0f, 0x2e, 0x8b, 0xfb, 0x55, 0x8a, 0xb8, 0xdf, 0x82, 0x56, 0x74,
-	0x51, 0x17, 0x87, 0x13, 0xab, 0xe4, 0x60, 0x18, 0x08, 0xc0, 0xcf, 0x98, 0xbf, 0x55, 0x81, 0x06,
-	0xb9, 0xca, 0xb4, 0xb5, 0x60, 0xa1, 0x58, 0x3b, 0x0d, 0xde, 0x26, 0x08, 0xcd, 0xb4, 0x7c, 0xe1,
-	0x5d, 0x7d, 0xfe, 0x85, 0x77, 0x6d, 0xfa, 0xc2, 0xfb, 0x25, 0x73, 0x81, 0x6e, 0x3d, 0x86, 0xa6,
-	0xfa, 0x7f, 0x09, 0x5b, 0x86, 0xde, 0xa3, 0xe0, 0x28, 0x08, 0x9f, 0x06, 0x0a, 0x30, 0x78, 0x83,
-	0x9d, 0x81, 0xa5, 0x8c, 0xe9, 0xfa, 0x47, 0x29, 0x83, 0x0a, 0x1b, 0x40, 0x97, 0xc4, 0x9a, 0x41,
-	0xaa, 0xec, 0x32, 0x0c, 0xf5, 0xe6, 0xb0, 0x15, 0x06, 0xe2, 0x61, 0x28, 0xbd, 0xd1, 0x49, 0x86,
-	0xad, 0xb1, 0x25, 0xe8, 0xec, 0xc9, 0x30, 0xda, 0x13, 0x81, 0xeb, 0x05, 0xe3, 0x41, 0xfd, 0xd6,
-	0x7d, 0x68, 0xaa, 0xdf, 0xaa, 0x94, 0x3e, 0xa9, 0x00, 0x83, 0x37, 0x90, 0xfa, 0x0b, 0xdb, 0x93,
-	0x5e, 0x30, 0x7e, 0x28, 0x8e, 0xa5, 0x32, 0x4a, 0x0f, 0xec, 0x44, 0x0e, 0xaa, 0xac, 0x0f, 0xa0,
-	0x7b, 0xbd, 0x17, 0xb8, 0x83, 0xda, 0xdd, 0xcd, 0x9f, 0xfe, 0xec, 0x4a, 0xe5, 0xef, 0x7e, 0x76,
-	0xa5, 0xf2, 0x8f, 0x3f, 0xbb, 0xf2, 0xc6, 0xef, 0xff, 0xd3, 0x95, 0xca, 0x0f, 0x3f, 0x2c, 0xfd,
-	0x34, 0x66, 0x62, 0xcb, 0xd8, 0x3b, 0x56, 0x57, 0x9d, 0x59, 0x25, 0x10, 0xb7, 0xa3, 0xa3, 0xf1,
-	0xed, 0xe8, 0xe0, 0x76, 0xa6, 0x73, 0x07, 0x4d, 0xfa, 0x17, 0xcc, 0x47, 0xff, 0x1d, 0x00, 0x00,
-	0xff, 0xff, 0x16, 0xb3, 0xa3, 0xc6, 0x8a, 0x46, 0x00, 0x00,
+	// 5830 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x4b, 0x8c, 0x24, 0xc7,
+	0x71, 0xe8, 0x76, 0x57, 0xff, 0x2a, 0xba, 0x7b, 0xa6, 0x27, 0xf7, 0xd7, 0x5c, 0x2e, 0x77, 0x87,
+	0x45, 0x2e, 0x39, 0x5a, 0x91, 0xb3, 0xe4, 0x50, 0x7c, 0xe2, 0x7b, 0x7a, 0x12, 0x35, 0x3b, 0xb3,
+	0x2b, 0x0d, 0xb5, 0xbb, 0x9c, 0x97, 0x33, 0xfb, 0x08, 0xeb, 0xe0, 0x42, 0x4d, 0x55, 0x76, 0x77,
+	0x69, 0xaa, 0xab, 0x6a, 0xeb, 0xb3, 0x3b, 0xc3, 0x93, 0x01, 0xfb, 0x66, 0xf8, 0xe4, 0x93, 0xe1,
+	0x8b, 0xa1, 0x83, 0x0d, 0x1f, 0x0c, 0x1b, 0xf6, 0xc5, 0x80, 0xa1, 0x93, 0x2f, 0xf2, 0xc5, 0xf0,
+	0xc9, 0x47, 0xc3, 0x90, 0x6f, 0xfe, 0xdc, 0x64, 0xc3, 0x17, 0x03, 0x46, 0x44, 0x66, 0x56, 0x55,
+	0x7f, 0x76, 0xf6, 0x43, 0xd2, 0x90, 0x00, 0x9d, 0x3a, 0x33, 0x22, 0x32, 0x2b, 0x33, 0x22, 0x32,
+	0x32, 0x32, 0x32, 0xb2, 0x61, 0x25, 0xf6, 0x63, 0x11, 0xf8, 0xa1, 0xd8, 0x8c, 0x93, 0x28, 0x8b,
+	0x58, 0x47, 0xd7, 0xaf, 0xbc, 0x3b, 0xf6, 0xb3, 0x49, 0x7e, 0xb4, 0xe9, 0x46, 0xd3, 0x5b, 0xe3,
+	0x68, 0x1c, 0xdd, 0x22, 0x82, 0xa3, 0x7c, 0x44, 0x35, 0xaa, 0x50, 0x49, 0x36, 0xbc, 0x02, 0x71,
+	0xe0, 0x84, 0xaa, 0xbc, 0x9a, 0xf9, 0x53, 0x91, 0x66, 0xce, 0x34, 0xd6, 0xc8, 0x20, 0x72, 0x8f,
+	0x55, 0xd9, 0xcc, 0x4e, 0x14, 0x9d, 0xf5, 0xfb, 0x75, 0x68, 0xdf, 0x17, 0x69, 0xea, 0x8c, 0x05,
+	0xb3, 0xc0, 0x48, 0x7d, 0x6f, 0x58, 0x5b, 0xaf, 0x6d, 0xac, 0x6c, 0x0d, 0x36, 0x8b, 0x61, 0x1d,
+	0x64, 0x4e, 0x96, 0xa7, 0x1c, 0x91, 0x48, 0xe3, 0x4e, 0xbd, 0x61, 0x7d, 0x9e, 0xe6, 0xbe, 0xc8,
+	0x26, 0x91, 0xc7, 0x11, 0xc9, 0x06, 0x60, 0x88, 0x24, 0x19, 0x1a, 0xeb, 0xb5, 0x8d, 0x1e, 0xc7,
+	0x22, 0x63, 0xd0, 0xf0, 0x9c, 0xcc, 0x19, 0x36, 0x08, 0x44, 0x65, 0xf6, 0x26, 0xac, 0xc4, 0x49,
+	0xe4, 0xda, 0x7e, 0x38, 0x8a, 0x6c, 0xc2, 0x36, 0x09, 0xdb, 0x43, 0xe8, 0x5e, 0x38, 0x8a, 0x76,
+	0x91, 0x6a, 0x08, 0x6d, 0x27, 0x74, 0x82, 0xd3, 0x54, 0x0c, 0x5b, 0x84, 0xd6, 0x55, 0xb6, 0x02,
+	0x75, 0xdf, 0x1b, 0xb6, 0xd7, 0x6b, 0x1b, 0x0d, 0x5e, 0xf7, 0x3d, 0xfc, 0x46, 0x9e, 0xfb, 0xde,
+	0xb0, 0x23, 0xbf, 0x81, 0x65, 0x66, 0x41, 0x2f, 0x14, 0xc2, 0x7b, 0x10, 0x65, 0x5c, 0xc4, 0xc1,
+	0xe9, 0xd0, 0x5c, 0xaf, 0x6d, 0x74, 0xf8, 0x0c, 0x8c, 0x5d, 0x81, 0x8e, 0x27, 0x8e, 0xf2, 0xf1,
+	0xfd, 0x74, 0x3c, 0x84, 0xf5, 0xda, 0x86, 0xc9, 0x8b, 0xba, 0xf5, 0x10, 0xcc, 0x9d, 0x28, 0x0c,
+	0x85, 0x9b, 0x45, 0x09, 0xbb, 0x0e, 0x5d, 0x3d, 0x5d, 0x5b, 0xb1, 0xa9, 0xc9, 0x41, 0x83, 0xf6,
+	0x3c, 0xf6, 0x36, 0xac, 0xba, 0x9a, 0xda, 0xf6, 0x43, 0x4f, 0x9c, 0x10, 0x9f, 0x9a, 0x7c, 0xa5,
+	0x00, 0xef, 0x21, 0xd4, 0xfa, 0xd7, 0x3a, 0xb4, 0x0f, 0x26, 0xf9, 0x68, 0x14, 0x08, 0xf6, 0x26,
+	0xf4, 0x55, 0x71, 0x27, 0x0a, 0xf6, 0xbc, 0x13, 0xd5, 0xef, 0x2c, 0x90, 0xad, 0x43, 0x57, 0x01,
+	0x0e, 0x4f, 0x63, 0xa1, 0xba, 0xad, 0x82, 0x66, 0xfb, 0xb9, 0xef, 0x87, 0xc4, 0x7e, 0x83, 0xcf,
+	0x02, 0xe7, 0xa8, 0x9c, 0x93, 0x61, 0x63, 0x81, 0xca, 0xa1, 0xaf, 0x6d, 0x07, 0xfe, 0x63, 0xc1,
+	0xc5, 0x78, 0x27, 0xcc, 0x48, 0x2e, 0x4d, 0x5e, 0x05, 0xb1, 0x2d, 0xb8, 0x98, 0xca, 0x26, 0x76,
+	0xe2, 0x84, 0x63, 0x91, 0xda, 0xb9, 0x1f, 0x66, 0xff, 0xeb, 0x1b, 0xc3, 0xd6, 0xba, 0xb1, 0xd1,
+	0xe0, 0xe7, 0x15, 0x92, 0x13, 0xee, 0x21, 0xa1, 0xd8, 0x7b, 0x70, 0x61, 0xae, 0x8d, 0x6c, 0xd2,
+	0x5e, 0x37, 0x36, 0x0c, 0xce, 0x66, 0x9a, 0xec, 0x51, 0x8b, 0x3b, 0xb0, 0x96, 0xe4, 0x21, 0x6a,
+	0xf2, 0x5d, 0x3f, 0xc8, 0x44, 0x72, 0x10, 0x0b, 0x97, 0xe4, 0xdb, 0xdd, 0xba, 0xbc, 0x49, 0xca,
+	0xce, 0xe7, 0xd1, 0x7c, 0xb1, 0x85, 0xf5, 0x9f, 0x75, 0xe8, 0xec, 0xfa, 0x69, 0xec, 0x64, 0xee,
+	0x84, 0x5d, 0x86, 0xf6, 0x28, 0x0f, 0xdd, 0x52, 0x82, 0x2d, 0xac, 0xee, 0x79, 0xec, 0xff, 0xc2,
+	0x6a, 0x10, 0xb9, 0x4e, 0x60, 0x17, 0xc2, 0x1a, 0xd6, 0xd7, 0x8d, 0x8d, 0xee, 0xd6, 0xf9, 0x52,
+	0xcb, 0x0b, 0x65, 0xe0, 0x2b, 0x44, 0x5b, 0xd4, 0xd9, 0xb7, 0x61, 0x90, 0x88, 0x69, 0x94, 0x89,
+	0x4a, 0x73, 0x83, 0x9a, 0xb3, 0xb2, 0xf9, 0x67, 0x89, 0x13, 0x3f, 0x88, 0x3c, 0xc1, 0x57, 0x25,
+	0x6d, 0xd9, 0xfc, 0xfd, 0x0a, 0x3f, 0xc5, 0xd8, 0xf6, 0xbd, 0x13, 0x9b, 0x3e, 0x30, 0x6c, 0xac,
+	0x1b, 0x1b, 0xcd, 0x92, 0x39, 0x62, 0xbc, 0xe7, 0x9d, 0xdc, 0x43, 0x0c, 0xfb, 0x00, 0x2e, 0xcd,
+	0x37, 0x91, 0xbd, 0x0e, 0x9b, 0xd4, 0xe6, 0xfc, 0x4c, 0x1b, 0x4e, 0x28, 0xf6, 0x3a, 0xf4, 0x74,
+	0xa3, 0xec, 0x34, 0x96, 0x6b, 0xaa, 0xc9, 0xbb, 0x69, 0x45, 0x91, 0x2e, 0x43, 0xdb, 0x4f, 0xed,
+	0xd4, 0x0f, 0x8f, 0x69, 0x71, 0x75, 0x78, 0xcb, 0x4f, 0x0f, 0xfc, 0xf0, 0x98, 0xbd, 0x02, 0x9d,
+	0x44, 0xb8, 0x12, 0xd3, 0x21, 0x4c, 0x3b, 0x11, 0x2e, 0xa1, 0x2e, 0x03, 0x16, 0x6d, 0x37, 0x13,
+	0x6a, 0x89, 0xb5, 0x12, 0xe1, 0xee, 0x64, 0xc2, 0x4a, 0xa1, 0x79, 0x5f, 0x24, 0x63, 0x81, 0xab,
+	0x0c, 0x1b, 0x1e, 0xb8, 0x4e, 0x48, 0x7c, 0xef, 0xf0, 0xa2, 0x8e, 0x6b, 0x3c, 0x76, 0x92, 0xcc,
+	0x77, 0x02, 0x52, 0xec, 0x0e, 0xd7, 0x55, 0xf6, 0x2a, 0x98, 0x69, 0xe6, 0x24, 0x19, 0xce, 0x8e,
+	0x14, 0xba, 0xc9, 0x3b, 0x04, 0xc0, 0x35, 0x71, 0x19, 0xda, 0x22, 0xf4, 0x08, 0xd5, 0x90, 0x92,
+	0x14, 0xa1, 0xb7, 0xe7, 0x9d, 0x58, 0x7f, 0x51, 0x83, 0xfe, 0xfd, 0x3c, 0xc8, 0xfc, 0xed, 0x64,
+	0x9c, 0x8b, 0x69, 0x98, 0xa1, 0x6d, 0xd8, 0xf5, 0xd3, 0x4c, 0x7d, 0x99, 0xca, 0x6c, 0x03, 0xcc,
+	0xef, 0x25, 0x51, 0x1e, 0xdf, 0x39, 0x89, 0xb5, 0xa4, 0x41, 0x2a, 0x15, 0x42, 0x78, 0x89, 0x64,
+	0xef, 0x40, 0xf7, 0xd3, 0xc4, 0x13, 0xc9, 0xed, 0x53, 0xa2, 0x35, 0x16, 0x68, 0xab, 0x68, 0x76,
+	0x15, 0xcc, 0x03, 0x11, 0x3b, 0x89, 0x83, 0x2a, 0xd0, 0x20, 0x83, 0x52, 0x02, 0x70, 0xae, 0x44,
+	0xbc, 0xe7, 0xa9, 0x65, 0xa5, 0xab, 0xd6, 0x18, 0xcc, 0xed, 0xf1, 0x38, 0x11, 0x63, 0x27, 0x23,
+	0xe3, 0x16, 0xc5, 0x34, 0x5c, 0x83, 0xd7, 0xa3, 0x98, 0x0c, 0x28, 0x4e, 0x40, 0xf2, 0x87, 0xca,
+	0xec, 0x1a, 0x34, 0xc4, 0xf2, 0xf1, 0x10, 0x9c, 0x5d, 0x82, 0x96, 0x1b, 0x85, 0x23, 0x7f, 0xac,
+	0xcc, 0xae, 0xaa, 0x59, 0xbf, 0x63, 0x40, 0x93, 0x26, 0x87, 0xec, 0x45, 0x53, 0x68, 0x8b, 0xc7,
+	0x4e, 0xa0, 0xa5, 0x82, 0x80, 0x3b, 0x8f, 0x9d, 0x80, 0xad, 0x43, 0x13, 0xbb, 0x49, 0x97, 0xf0,
+	0x46, 0x22, 0xd8, 0x5b, 0xd0, 0x44, 0x25, 0x4a, 0x67, 0x47, 0x80, 0x4a, 0x74, 0xbb, 0xf1, 0xd3,
+	0x7f, 0xb8, 0x7e, 0x8e, 0x4b, 0x34, 0x7b, 0x1b, 0x1a, 0xce, 0x78, 0x9c, 0x0e, 0x1b, 0xf3, 0xcb,
+	0xa9, 0x98, 0x2f, 0x27, 0x02, 0xf6, 0x21, 0x98, 0x52, 0x6e, 0x48, 0xdd, 0x24, 0xea, 0xcb, 0x95,
+	0x2d, 0xa6, 0x2a, 0x52, 0x5e, 0x52, 0x22, 0xc7, 0xfd, 0x54, 0x59, 0x30, 0xd2, 0xe8, 0x0e, 0x2f,
+	0x01, 0xb8, 0x07, 0xc4, 0x89, 0xd8, 0x0e, 0x82, 0xc8, 0x3d, 0xf0, 0x3f, 0x17, 0x6a, 0xc7, 0x98,
+	0x81, 0xb1, 0xb7, 0x60, 0x65, 0x5f, 0xaa, 0x1c, 0x17, 0x69, 0x1e, 0x64, 0xa9, 0xda, 0x45, 0xe6,
+	0xa0, 0x6c, 0x13, 0xd8, 0x0c, 0xe4, 0x90, 0xa6, 0x6f, 0xae, 0x1b, 0x1b, 0x7d, 0xbe, 0x04, 0xc3,
+	0xde, 0x80, 0xfe, 0x18, 0x39, 0xed, 0x87, 0x63, 0x7b, 0x14, 0x38, 0xb8, 0xc1, 0x18, 0xb8, 0x01,
+	0x69, 0xe0, 0xdd, 0xc0, 0x19, 0x5b, 0x3f, 0xaf, 0x43, 0x6b, 0x2f, 0x4c, 0x45, 0x92, 0xe1, 0x2a,
+	0x71, 0x46, 0x23, 0xe1, 0x66, 0x42, 0x5a, 0xa7, 0x06, 0x2f, 0xea, 0x38, 0xcb, 0xc3, 0xe8, 0xb3,
+	0xc4, 0xcf, 0xc4, 0xc1, 0x07, 0x4a, 0x0f, 0x4a, 0x00, 0xbb, 0x09, 0x6b, 0x8e, 0xe7, 0xd9, 0x9a,
+	0xda, 0x4e, 0xa2, 0x27, 0x29, 0xad, 0x98, 0x0e, 0x5f, 0x75, 0x3c, 0x6f, 0x5b, 0xc1, 0x79, 0xf4,
+	0x24, 0x65, 0xaf, 0x83, 0x91, 0x88, 0x11, 0x69, 0x45, 0x77, 0x6b, 0x55, 0x4a, 0xed, 0xd3, 0xa3,
+	0x1f, 0x09, 0x37, 0xe3, 0x62, 0xc4, 0x11, 0xc7, 0x2e, 0x40, 0xd3, 0xc9, 0xb2, 0x44, 0x4a, 0xc1,
+	0xe4, 0xb2, 0xc2, 0x36, 0xe1, 0x3c, 0xad, 0xcc, 0xcc, 0x8f, 0x42, 0x3b, 0x73, 0x8e, 0x02, 0xdc,
+	0x08, 0x53, 0x65, 0xf3, 0xd7, 0x0a, 0xd4, 0x21, 0x62, 0xf6, 0xbc, 0x14, 0x77, 0x89, 0x79, 0xfa,
+	0xd0, 0x99, 0x8a, 0x94, 0x4c, 0xbe, 0xc9, 0xcf, 0xcf, 0xb6, 0x78, 0xe0, 0x4c, 0x25, 0xcb, 0xca,
+	0x36, 0xb8, 0xb6, 0x3b, 0xb4, 0x4c, 0x7a, 0x05, 0x10, 0x97, 0xfe, 0x45, 0x68, 0xf9, 0xa9, 0x2d,
+	0x42, 0x4f, 0x99, 0x9b, 0xa6, 0x9f, 0xde, 0x09, 0x3d, 0xf6, 0x75, 0x30, 0xe5, 0x57, 0x3c, 0x31,
+	0xa2, 0xbd, 0xbc, 0xbb, 0xb5, 0xa2, 0x94, 0x12, 0xc1, 0xbb, 0x62, 0xc4, 0x3b, 0x99, 0x2a, 0x59,
+	0x3f, 0xa9, 0x43, 0x97, 0x74, 0xe8, 0x61, 0xec, 0xe1, 0x92, 0x7b, 0x03, 0xfa, 0xb3, 0xdc, 0x93,
+	0x02, 0xe8, 0x39, 0x55, 0xd6, 0x5d, 0x82, 0xd6, 0xb6, 0x8b, 0xa3, 0x20, 0x09, 0xf4, 0xb9, 0xaa,
+	0xe1, 0xb2, 0xde, 0xbb, 0x9d, 0xbb, 0xc7, 0x22, 0x23, 0xa6, 0xf7, 0xb9, 0xae, 0x22, 0xe6, 0x81,
+	0xc2, 0x34, 0x24, 0x46, 0x55, 0xd9, 0x1d, 0x80, 0x03, 0x31, 0x9e, 0x8a, 0x30, 0xbb, 0xef, 0xc4,
+	0x4a, 0xdd, 0x6f, 0xcc, 0xa9, 0xbb, 0x1c, 0xdb, 0x66, 0x49, 0x77, 0x27, 0xcc, 0x92, 0x53, 0x5e,
+	0x69, 0xc8, 0xbe, 0x09, 0xab, 0x39, 0x51, 0xd9, 0x6e, 0x76, 0x62, 0x07, 0x68, 0x25, 0x5a, 0xeb,
+	0x46, 0x29, 0x59, 0xd9, 0xc5, 0x4e, 0x76, 0xc2, 0xfb, 0xb9, 0x2e, 0xde, 0xf3, 0xd3, 0xec, 0xca,
+	0xb7, 0x61, 0x75, 0xae, 0x5f, 0xf4, 0xdc, 0x8e, 0xc5, 0x29, 0xcd, 0xdc, 0xe4, 0x58, 0x44, 0x45,
+	0x78, 0xec, 0x04, 0xb9, 0x76, 0x39, 0x64, 0xe5, 0xff, 0xd4, 0x3f, 0xaa, 0x59, 0xaf, 0x41, 0x73,
+	0x3b, 0x49, 0x1c, 0x22, 0x71, 0xb0, 0x30, 0xac, 0xd1, 0xbe, 0x23, 0x2b, 0x96, 0x0b, 0x06, 0x8e,
+	0xee, 0x06, 0xd4, 0xa7, 0x31, 0x61, 0xba, 0x5b, 0x17, 0x2b, 0x93, 0x73, 0xe2, 0xcd, 0xfb, 0x6a,
+	0x32, 0xf5, 0x69, 0x7c, 0xe5, 0x43, 0x68, 0xdf, 0x7f, 0x89, 0x31, 0xfc, 0x7b, 0x03, 0x3a, 0xbb,
+	0x22, 0x10, 0x24, 0x03, 0x0b, 0x7a, 0x55, 0x35, 0xd7, 0xf2, 0xab, 0xc2, 0x90, 0x46, 0xee, 0x84,
+	0xd4, 0x4a, 0xa8, 0x75, 0x34, 0x03, 0x7b, 0x29, 0x59, 0x5e, 0x05, 0x48, 0xa2, 0x27, 0xb6, 0x2f,
+	0xb7, 0x23, 0x69, 0xd9, 0x3b, 0x49, 0xf4, 0x64, 0x0f, 0x37, 0xa4, 0xff, 0x91, 0x75, 0xf3, 0x4d,
+	0x18, 0x96, 0x6d, 0xc8, 0xf9, 0xb4, 0xfd, 0xd0, 0x3e, 0x42, 0x9f, 0x47, 0x2d, 0xa1, 0xb2, 0x4f,
+	0xf2, 0x42, 0xf7, 0xc2, 0xdb, 0x88, 0xd4, 0xd6, 0xc0, 0x3c, 0xc3, 0x1a, 0x2c, 0x35, 0x2e, 0xb0,
+	0xdc, 0xb8, 0xdc, 0x9e, 0xd1, 0xea, 0x2e, 0x09, 0xde, 0x2a, 0x05, 0xaf, 0xa5, 0x75, 0xa6, 0x4a,
+	0xbf, 0x0e, 0x3d, 0xd7, 0x09, 0xed, 0x2c, 0xc9, 0x43, 0xd7, 0xc9, 0xc4, 0xb0, 0x47, 0x9f, 0xea,
+	0xba, 0x4e, 0x78, 0xa8, 0x40, 0x15, 0x0b, 0xd0, 0xaf, 0x5a, 0x80, 0xb7, 0x60, 0x35, 0x4e, 0xfc,
+	0xa9, 0x93, 0x9c, 0xda, 0xc7, 0xe2, 0x94, 0x84, 0xb1, 0x22, 0xfd, 0x69, 0x05, 0xfe, 0x81, 0x38,
+	0xdd, 0xf3, 0x4e, 0xbe, 0xa8, 0xee, 0xff, 0x7d, 0x1d, 0xcc, 0xfd, 0x44, 0x28, 0xab, 0x7d, 0x1d,
+	0xba, 0xa9, 0x3b, 0x11, 0x53, 0x87, 0xa4, 0xa4, 0x7a, 0x00, 0x09, 0x42, 0xe1, 0xcc, 0xda, 0xa5,
+	0xfa, 0xd9, 0x76, 0x09, 0xc7, 0x21, 0xbd, 0x1d, 0x5c, 0x4c, 0x58, 0x2c, 0x8d, 0x71, 0xa3, 0x6a,
+	0x8c, 0xd7, 0xa1, 0x37, 0x71, 0x52, 0xdb, 0xc9, 0xb3, 0xc8, 0x76, 0xa3, 0x80, 0x94, 0xae, 0xc3,
+	0x61, 0xe2, 0xa4, 0xdb, 0x79, 0x16, 0xed, 0x44, 0xe4, 0x3d, 0xf9, 0xa9, 0x2d, 0x17, 0xbd, 0xda,
+	0x17, 0x3b, 0x7e, 0xaa, 0xcc, 0xdd, 0x26, 0x9c, 0x17, 0x69, 0xe6, 0x4f, 0x1d, 0x25, 0x50, 0xdb,
+	0x8d, 0xf2, 0x30, 0xa3, 0xdd, 0xd1, 0xe0, 0x6b, 0x05, 0x8a, 0x47, 0x4f, 0x76, 0x10, 0xc1, 0xde,
+	0x83, 0x15, 0x37, 0x9a, 0xc6, 0x76, 0x8c, 0x7c, 0x25, 0xbf, 0x43, 0x3a, 0xe2, 0x55, 0xbf, 0xa0,
+	0x87, 0x14, 0xfb, 0xc7, 0x42, 0x3a, 0x42, 0x5b, 0xb0, 0xea, 0x06, 0x79, 0x9a, 0x89, 0xc4, 0x3e,
+	0x52, 0x4d, 0xcc, 0x85, 0x26, 0x7d, 0x45, 0x22, 0x9d, 0x27, 0x64, 0x6c, 0x7b, 0x3f, 0x4a, 0xb3,
+	0xdd, 0x69, 0xa0, 0x15, 0xb3, 0xf6, 0xa2, 0x8a, 0x59, 0x5f, 0xae, 0x98, 0x4b, 0x54, 0xc3, 0x58,
+	0xa2, 0x1a, 0x6c, 0x03, 0x06, 0x55, 0x3a, 0x12, 0xa9, 0x74, 0xe3, 0x56, 0x4a, 0x42, 0x12, 0xab,
+	0xe4, 0xaf, 0x27, 0x2d, 0x49, 0x53, 0xf3, 0x57, 0x59, 0x11, 0x89, 0xf4, 0x49, 0x43, 0x4a, 0xe6,
+	0x2b, 0x8d, 0xf9, 0xdf, 0xf0, 0x4a, 0xd1, 0xd2, 0x7e, 0xe2, 0x67, 0x93, 0x28, 0xcf, 0xec, 0x11,
+	0x9d, 0x58, 0x52, 0xe5, 0x75, 0x5f, 0xd2, 0x3d, 0x7d, 0x26, 0xd1, 0xf2, 0x3c, 0x43, 0x3e, 0xd2,
+	0x28, 0x0f, 0x02, 0x3b, 0x13, 0x27, 0x99, 0x12, 0xc1, 0x50, 0xf2, 0x46, 0xf1, 0xed, 0x6e, 0x1e,
+	0x04, 0x87, 0xe2, 0x24, 0x43, 0x8b, 0xdf, 0x19, 0xa9, 0x8a, 0xf5, 0xdb, 0x0d, 0x80, 0x7b, 0x91,
+	0x7b, 0x7c, 0xe8, 0x24, 0x63, 0x91, 0xa1, 0x2f, 0xaf, 0xed, 0x90, 0xb2, 0x93, 0xed, 0x4c, 0x5a,
+	0x1f, 0xb6, 0x05, 0x97, 0xf4, 0xfc, 0xdd, 0x28, 0xa0, 0x73, 0x85, 0x34, 0x24, 0x6a, 0x19, 0x30,
+	0x85, 0x95, 0x27, 0x53, 0xb2, 0x22, 0xec, 0x23, 0x58, 0xad, 0xb6, 0xc9, 0x4e, 0xe3, 0xa1, 0x51,
+	0x15, 0x75, 0xc5, 0x27, 0xec, 0x97, 0xcd, 0x0f, 0x4f, 0x63, 0xf6, 0x1e, 0x5c, 0x4c, 0xc4, 0x28,
+	0x11, 0xe9, 0xc4, 0xce, 0xd2, 0xea, 0xc7, 0xa4, 0x4b, 0xbf, 0xa6, 0x90, 0x87, 0x69, 0xf1, 0xad,
+	0xf7, 0xe0, 0xa2, 0xe4, 0xd4, 0xfc, 0xf0, 0xa4, 0xd5, 0x5d, 0x93, 0xc8, 0xea, 0xe8, 0x5e, 0x03,
+	0x0a, 0x7e, 0x48, 0x4b, 0xaa, 0x1d, 0xc4, 0x80, 0x98, 0x71, 0x14, 0x08, 0x74, 0xac, 0x76, 0x26,
+	0x78, 0xea, 0xdc, 0x15, 0x23, 0xc5, 0xfc, 0x12, 0xc0, 0x2c, 0x68, 0xdc, 0x8f, 0x3c, 0x41, 0xac,
+	0x5e, 0xd9, 0x5a, 0xd9, 0xc4, 0x76, 0x9b, 0xc8, 0x49, 0x84, 0x72, 0xc2, 0xb1, 0xb7, 0x81, 0xba,
+	0x93, 0xea, 0xb7, 0xa8, 0xe3, 0x1d, 0x44, 0x92, 0x0e, 0xbe, 0x07, 0x17, 0xcb, 0x91, 0xd8, 0x4e,
+	0x66, 0x67, 0x13, 0x41, 0x46, 0x4c, 0x1a, 0xd3, 0xb5, 0x62, 0x50, 0xdb, 0xd9, 0xe1, 0x44, 0xa0,
+	0x41, 0x7b, 0x1f, 0xba, 0x4f, 0x1c, 0x3f, 0xb3, 0xe3, 0x28, 0xf0, 0xdd, 0xd3, 0x61, 0x57, 0xc5,
+	0x5d, 0x68, 0x14, 0x9f, 0x39, 0x7e, 0xb6, 0x4f, 0x70, 0x0e, 0x4f, 0x8a, 0x32, 0x5a, 0x4f, 0x6a,
+	0x82, 0xa7, 0xe0, 0x28, 0xcf, 0xc8, 0x7a, 0x1a, 0x9c, 0xba, 0x39, 0x94, 0x20, 0xeb, 0x23, 0x68,
+	0xe1, 0x14, 0x3e, 0x8d, 0xd9, 0x26, 0xb4, 0x33, 0x52, 0x89, 0x54, 0x6d, 0xd2, 0x17, 0x4a, 0x5b,
+	0x5d, 0xea, 0x0b, 0xd7, 0x44, 0x16, 0x87, 0xd5, 0xc2, 0xf0, 0x3d, 0x0c, 0xfd, 0x47, 0xb9, 0x60,
+	0x1f, 0xc3, 0x5a, 0x9c, 0x08, 0xa5, 0xea, 0x76, 0x7e, 0x8c, 0x7e, 0x88, 0x5a, 0xb5, 0x17, 0x94,
+	0x66, 0x16, 0x2d, 0x8e, 0x51, 0x2b, 0x57, 0xe2, 0x99, 0xba, 0xf5, 0x43, 0xb8, 0x5c, 0x50, 0x1c,
+	0x08, 0x37, 0x0a, 0x3d, 0x27, 0x39, 0xa5, 0x3d, 0x6a, 0xae, 0xef, 0xf4, 0x45, 0xfa, 0x3e, 0xa0,
+	0xbe, 0x7f, 0x6c, 0xc0, 0xca, 0xa7, 0xe1, 0x6e, 0x1e, 0x07, 0x3e, 0xee, 0x1b, 0x3f, 0x90, 0x66,
+	0x5d, 0x9a, 0xd3, 0x5a, 0xd5, 0x9c, 0x6e, 0xc0, 0x40, 0x7d, 0x05, 0xd5, 0x4a, 0x1a, 0x43, 0x15,
+	0xbd, 0x91, 0xf0, 0x9d, 0x28, 0x90, 0x96, 0xf0, 0xdb, 0x70, 0x31, 0xa7, 0x99, 0x4b, 0xca, 0x89,
+	0x70, 0x8f, 0xed, 0xa7, 0x1c, 0xc4, 0x98, 0x24, 0xc4, 0xa6, 0x48, 0x86, 0x30, 0xdc, 0x2d, 0xca,
+	0xe6, 0xda, 0xa6, 0x43, 0x41, 0x48, 0x23, 0x89, 0x42, 0xdb, 0xd3, 0x43, 0x56, 0x1e, 0x05, 0xee,
+	0x06, 0x2b, 0x51, 0x39, 0x13, 0x34, 0x55, 0xbf, 0x06, 0x6b, 0x33, 0x94, 0x34, 0x0a, 0xe9, 0xfc,
+	0xbd, 0x5b, 0x8a, 0x71, 0x76, 0xfa, 0xd5, 0x2a, 0x8e, 0x47, 0xee, 0xbe, 0xab, 0xd1, 0x2c, 0x54,
+	0x9b, 0xaf, 0x71, 0x18, 0x25, 0x62, 0xd8, 0x2e, 0xcc, 0x17, 0xd5, 0xaf, 0x3c, 0x80, 0x0b, 0xcb,
+	0x7a, 0x59, 0xb2, 0x85, 0xae, 0x57, 0xb7, 0xd0, 0xb9, 0x43, 0x64, 0xb9, 0x9d, 0xfe, 0x51, 0x0d,
+	0xba, 0x77, 0xf3, 0xcf, 0x3f, 0x3f, 0x95, 0x46, 0x8e, 0xf5, 0xa0, 0xf6, 0x80, 0x7a, 0xa9, 0xf3,
+	0xda, 0x03, 0xf4, 0xb9, 0xf7, 0x8f, 0xd1, 0xe0, 0x52, 0x27, 0x26, 0x57, 0x35, 0x3c, 0x7e, 0xee,
+	0x1f, 0x1f, 0x9e, 0x61, 0x6a, 0x24, 0x1a, 0x0f, 0x55, 0xb7, 0x73, 0x3f, 0x40, 0x4f, 0x4c, 0x59,
+	0x95, 0xa2, 0x8e, 0x07, 0xba, 0xbd, 0x91, 0xd4, 0x97, 0xbb, 0x49, 0x34, 0x95, 0x1a, 0xad, 0x6c,
+	0xf9, 0x12, 0x8c, 0xf5, 0x37, 0x06, 0x34, 0x3e, 0x89, 0xfc, 0x50, 0x06, 0x43, 0x02, 0xe9, 0x6e,
+	0x4b, 0xbf, 0xb7, 0x9d, 0x88, 0x00, 0xfd, 0x6a, 0x44, 0xb9, 0x91, 0x42, 0xd5, 0x25, 0xca, 0x8d,
+	0x82, 0x7b, 0xb3, 0x47, 0xf6, 0xda, 0xd2, 0x23, 0x7b, 0x71, 0xa2, 0x6e, 0x3c, 0xeb, 0x44, 0x6d,
+	0x06, 0x62, 0x84, 0xaa, 0x1a, 0x7a, 0xc3, 0x66, 0x95, 0x56, 0x19, 0x1c, 0x31, 0xca, 0x76, 0xa2,
+	0xd0, 0x63, 0x5f, 0x03, 0x48, 0xfc, 0xf1, 0x44, 0x51, 0xb6, 0x16, 0x28, 0x4d, 0xc2, 0x12, 0x29,
+	0x87, 0x57, 0x54, 0xe8, 0x4c, 0xed, 0x44, 0xf6, 0x11, 0x72, 0x49, 0xce, 0xa3, 0xad, 0x0f, 0xe3,
+	0xcb, 0x83, 0x6e, 0x97, 0x66, 0x82, 0x6e, 0xc4, 0x5d, 0x9a, 0xef, 0x55, 0x40, 0x7f, 0x64, 0x62,
+	0x47, 0xa1, 0x1d, 0xeb, 0xa0, 0x51, 0x07, 0x21, 0x9f, 0x86, 0xfb, 0xc7, 0x68, 0x97, 0x31, 0xd2,
+	0xa4, 0x0e, 0xee, 0xe6, 0xfc, 0xc1, 0x7d, 0x1d, 0x7a, 0x3f, 0x8a, 0xfc, 0xd0, 0x9e, 0x3a, 0xb1,
+	0x9d, 0x39, 0x32, 0x38, 0xdb, 0xe4, 0x80, 0xb0, 0xfb, 0x4e, 0x7c, 0xe8, 0x8c, 0xc9, 0xf1, 0x92,
+	0xc4, 0xb4, 0x48, 0xba, 0x92, 0x40, 0x81, 0x50, 0xbc, 0xaf, 0x82, 0x49, 0x5d, 0x50, 0xac, 0xab,
+	0x27, 0x65, 0x8f, 0x00, 0xe4, 0xa8, 0xf5, 0xcf, 0x75, 0xe8, 0x6c, 0x87, 0x99, 0x4f, 0xf2, 0xbc,
+	0x04, 0xad, 0x84, 0x0e, 0xee, 0x4a, 0x9a, 0xaa, 0x56, 0x48, 0xac, 0xfe, 0x14, 0x89, 0xcd, 0x48,
+	0xc2, 0x78, 0x6e, 0x49, 0x34, 0xce, 0x92, 0xc4, 0x2c, 0xd7, 0x9a, 0x67, 0x72, 0x6d, 0x21, 0xdc,
+	0xf1, 0x55, 0x88, 0x71, 0x5e, 0x12, 0x9d, 0x67, 0x49, 0xc2, 0x9c, 0x97, 0x84, 0xf5, 0x67, 0x06,
+	0x74, 0xee, 0x89, 0x51, 0xf6, 0xab, 0xc5, 0xf3, 0xcb, 0xb2, 0x78, 0xac, 0x7f, 0x33, 0xc0, 0xe4,
+	0x38, 0xc3, 0xaf, 0x50, 0x66, 0xb7, 0x00, 0x48, 0x16, 0x67, 0x0b, 0x8e, 0xe4, 0x25, 0x23, 0x6a,
+	0xef, 0x43, 0x57, 0xca, 0x44, 0xb6, 0x68, 0x3e, 0xa5, 0x85, 0x14, 0xdc, 0xe1, 0xa2, 0xbc, 0x5b,
+	0xcf, 0x2d, 0xef, 0xf6, 0x4b, 0xcb, 0xbb, 0xf3, 0x65, 0xc8, 0xdb, 0x3c, 0x53, 0xde, 0xf0, 0x2c,
+	0x79, 0x77, 0x9f, 0x25, 0xef, 0xde, 0x82, 0xbc, 0x7f, 0x6c, 0x40, 0x9f, 0xe4, 0x7d, 0x20, 0xa6,
+	0x5f, 0xcc, 0x28, 0xce, 0x09, 0xc9, 0x78, 0x51, 0x21, 0x35, 0x9e, 0x5b, 0x48, 0xcd, 0x97, 0x16,
+	0x52, 0xeb, 0xcb, 0x10, 0x52, 0xfb, 0x4c, 0x21, 0x75, 0x9e, 0x25, 0x24, 0xf3, 0xc5, 0x17, 0x65,
+	0x21, 0xa4, 0x2f, 0xbc, 0x73, 0xfd, 0x4a, 0x48, 0x5f, 0x92, 0x90, 0x60, 0x41, 0x48, 0xe8, 0x59,
+	0x7c, 0xe1, 0x45, 0xf4, 0x55, 0x78, 0x16, 0x67, 0x32, 0xbb, 0xf9, 0x65, 0x30, 0xbb, 0x75, 0x26,
+	0xb3, 0xdb, 0xcf, 0x62, 0xf6, 0x4b, 0x78, 0x16, 0x7f, 0x6e, 0x00, 0x1c, 0xf8, 0xe1, 0x38, 0x10,
+	0xbf, 0xf2, 0x2d, 0x7e, 0x69, 0x7c, 0x8b, 0xbf, 0xaa, 0x43, 0xe7, 0xbe, 0x93, 0x1c, 0xff, 0xc2,
+	0xad, 0x90, 0x37, 0xa0, 0x1d, 0x85, 0xd5, 0xf5, 0x50, 0xa5, 0x6b, 0x45, 0xe1, 0x2f, 0x84, 0xca,
+	0xff, 0xb5, 0x01, 0xe6, 0xae, 0xf0, 0xf2, 0x98, 0xd8, 0x37, 0xc3, 0x86, 0xda, 0x73, 0xb3, 0xa1,
+	0xfe, 0xd2, 0x3a, 0x67, 0xbc, 0x9c, 0xce, 0xcd, 0xf2, 0xa5, 0xf1, 0x2c, 0xbe, 0x34, 0x9f, 0xc5,
+	0x97, 0xd6, 0xc2, 0x71, 0xef, 0x1e, 0x9c, 0x9f, 0x89, 0x87, 0x38, 0xf2, 0xaa, 0xae, 0x4d, 0x41,
+	0xb3, 0xab, 0x72, 0xbc, 0x98, 0x7f, 0x51, 0x8d, 0x81, 0xc8, 0x0b, 0x3c, 0xbe, 0x16, 0xcd, 0x83,
+	0x30, 0x41, 0xc9, 0x43, 0x26, 0x53, 0x98, 0x87, 0xc2, 0xc0, 0x1d, 0x8a, 0x3f, 0xf4, 0x08, 0xba,
+	0x13, 0x05, 0x14, 0x85, 0xf8, 0x08, 0x56, 0x4b, 0xaa, 0xac, 0xb8, 0x0f, 0x5e, 0x1a, 0xfa, 0xd4,
+	0x0d, 0x11, 0x96, 0x5a, 0xbf, 0x51, 0x83, 0xf6, 0x7e, 0x12, 0x79, 0xb9, 0x9b, 0xbd, 0xa4, 0xd5,
+	0x9a, 0xe5, 0xa8, 0xf1, 0x2c, 0x8e, 0x36, 0xe6, 0x39, 0x6a, 0xfd, 0x66, 0x0d, 0x4c, 0x35, 0x84,
+	0x7b, 0x5b, 0x5f, 0x91, 0xe9, 0x7c, 0xf6, 0x28, 0x9e, 0x80, 0x49, 0xd1, 0xbe, 0x33, 0x8d, 0xc1,
+	0x99, 0x1a, 0x59, 0x7f, 0x29, 0x8d, 0xb4, 0x7e, 0xb7, 0x06, 0x7d, 0x0a, 0xb7, 0xde, 0xcd, 0x43,
+	0x29, 0xf3, 0xe5, 0xb1, 0xc1, 0x75, 0x68, 0x24, 0x18, 0x21, 0x95, 0x9f, 0xe9, 0xc9, 0xcf, 0xec,
+	0x44, 0x01, 0x5e, 0xdc, 0x10, 0x06, 0x99, 0xe0, 0x24, 0xe3, 0x74, 0x59, 0x2e, 0x06, 0xc2, 0x71,
+	0x56, 0x98, 0x01, 0x32, 0x4d, 0x75, 0x2e, 0x86, 0xac, 0x61, 0x5e, 0x07, 0x69, 0x56, 0x93, 0x34,
+	0x8b, 0xca, 0xd6, 0x36, 0x5c, 0xbc, 0x73, 0x92, 0x89, 0x24, 0x74, 0x48, 0xc3, 0xb6, 0x30, 0x94,
+	0x4d, 0xc1, 0x50, 0x4d, 0x5c, 0x2b, 0x89, 0x71, 0xc0, 0xd5, 0x4c, 0x33, 0x59, 0xb1, 0x6e, 0x40,
+	0x77, 0xe4, 0x07, 0xc2, 0x8e, 0x46, 0xa3, 0x54, 0x64, 0xf8, 0x75, 0x59, 0xa2, 0x69, 0x19, 0x5c,
+	0xd5, 0xac, 0x9f, 0x34, 0xa0, 0xa7, 0x3f, 0x45, 0x99, 0x38, 0xcb, 0xa7, 0xff, 0x2a, 0x98, 0xd4,
+	0x5b, 0x8a, 0xe9, 0x13, 0x75, 0xea, 0xa1, 0x83, 0x00, 0x4a, 0x9d, 0xd8, 0x86, 0xb5, 0xca, 0xa7,
+	0xec, 0x2c, 0xca, 0x9c, 0x60, 0x68, 0xcc, 0xdf, 0xf7, 0x56, 0x48, 0xf8, 0x2a, 0x56, 0x3e, 0xa5,
+	0xf2, 0x21, 0x52, 0x23, 0x7b, 0x8b, 0x50, 0xe8, 0x02, 0x7b, 0x11, 0xc3, 0xbe, 0x07, 0xab, 0x38,
+	0xdb, 0x2d, 0x19, 0xf2, 0xa7, 0xf9, 0x4a, 0xeb, 0x7c, 0xbd, 0xfc, 0xc4, 0x52, 0x9e, 0xf1, 0x7e,
+	0x58, 0xad, 0xe2, 0x8a, 0x71, 0x13, 0x81, 0xb6, 0x21, 0x7d, 0x14, 0x90, 0x05, 0x31, 0xb9, 0x29,
+	0x21, 0x07, 0x8f, 0x82, 0x62, 0xa6, 0xc5, 0xd6, 0x6a, 0xca, 0x99, 0x92, 0xa2, 0xbf, 0x0b, 0xdd,
+	0x28, 0xf1, 0xc7, 0x7e, 0x28, 0x03, 0xb7, 0x9d, 0x25, 0xa3, 0x05, 0x49, 0x40, 0x61, 0x5c, 0x0b,
+	0x5a, 0x52, 0x51, 0x97, 0xdc, 0x08, 0x28, 0x0c, 0xe3, 0xb0, 0x72, 0x78, 0x84, 0x06, 0x81, 0x92,
+	0x1d, 0x77, 0xa2, 0x80, 0x12, 0x44, 0xba, 0x5b, 0x37, 0x17, 0xa7, 0x85, 0xf2, 0xd9, 0x9c, 0x25,
+	0x96, 0xa1, 0xdb, 0xb9, 0x1e, 0xf0, 0x9e, 0x2b, 0xcd, 0x12, 0xdf, 0xcd, 0x70, 0x8a, 0xf6, 0x14,
+	0xef, 0x2e, 0xba, 0x64, 0x19, 0xfa, 0x12, 0x7c, 0xf0, 0x28, 0xc0, 0x4b, 0x8b, 0x2b, 0xdb, 0x70,
+	0x7e, 0x49, 0x77, 0x2f, 0x74, 0x0d, 0xea, 0x02, 0x1c, 0x64, 0x89, 0x70, 0xa6, 0xa4, 0x3c, 0x6f,
+	0x43, 0x3b, 0x3b, 0x0a, 0xe8, 0x8e, 0xb3, 0xb6, 0xf4, 0x8e, 0xb3, 0x95, 0x1d, 0x21, 0x97, 0x2a,
+	0xea, 0x58, 0xa7, 0xab, 0x09, 0x55, 0xc3, 0x0f, 0x05, 0xfe, 0xd4, 0xcf, 0x54, 0xea, 0xa2, 0xac,
+	0x58, 0x1f, 0x80, 0x49, 0x3d, 0xd0, 0x37, 0x0a, 0x3f, 0xac, 0x76, 0xa6, 0x1f, 0x66, 0xbd, 0x03,
+	0xe6, 0xff, 0xc7, 0x61, 0x52, 0xa3, 0xeb, 0xd0, 0xa5, 0x7b, 0x70, 0xfb, 0x08, 0xaf, 0x4d, 0xd4,
+	0xd4, 0x80, 0x40, 0xb7, 0x11, 0x62, 0x01, 0x74, 0x1e, 0x86, 0x7e, 0x14, 0x6e, 0x07, 0x81, 0xf5,
+	0x7b, 0x0d, 0x30, 0xbf, 0xef, 0xa4, 0x13, 0xb2, 0x12, 0x98, 0x09, 0xf9, 0x40, 0x08, 0x0f, 0x01,
+	0x78, 0x9d, 0x2d, 0x73, 0xa4, 0xaa, 0x20, 0x8c, 0x2e, 0x7f, 0x5f, 0xee, 0xfc, 0x3f, 0x50, 0x37,
+	0x8f, 0x45, 0x5d, 0xb7, 0xa6, 0x7b, 0x76, 0xa1, 0xd3, 0x71, 0xaa, 0x20, 0x76, 0x13, 0x06, 0x58,
+	0xa5, 0x4c, 0x24, 0xd4, 0x41, 0x11, 0xa4, 0x6a, 0x67, 0x5c, 0x80, 0xb3, 0x9b, 0x00, 0xb8, 0x37,
+	0xd3, 0x0d, 0x7e, 0xba, 0xc4, 0x3b, 0xa9, 0x60, 0xd9, 0x35, 0x80, 0x4f, 0x0a, 0x03, 0xab, 0x77,
+	0xca, 0x12, 0x82, 0x79, 0xa0, 0xaa, 0xc6, 0xc5, 0x68, 0x47, 0xdd, 0xfb, 0x36, 0xf9, 0x2c, 0x10,
+	0xf3, 0x2f, 0xf9, 0x0b, 0xe7, 0x5f, 0x2e, 0x80, 0x70, 0xf3, 0xa0, 0xdb, 0x4e, 0x2f, 0x8f, 0x95,
+	0x33, 0xd9, 0xc6, 0xcb, 0x4d, 0x2f, 0x8f, 0x9f, 0xb6, 0x63, 0xc3, 0x97, 0xb5, 0x63, 0x77, 0x9f,
+	0x6f, 0xc7, 0xee, 0x3d, 0xdf, 0x8e, 0xfd, 0x73, 0x03, 0x7a, 0x6a, 0x73, 0xa5, 0xcd, 0x67, 0x46,
+	0xf8, 0xb5, 0xb3, 0x85, 0x5f, 0x7f, 0x3e, 0xe1, 0x1b, 0xcf, 0x25, 0xfc, 0xc6, 0x99, 0xc2, 0x5f,
+	0x2a, 0xb6, 0xe6, 0x0b, 0x8b, 0xed, 0x59, 0x3a, 0x74, 0x0d, 0xe0, 0xa0, 0xf0, 0xbd, 0x94, 0x02,
+	0x55, 0x20, 0x33, 0x62, 0xef, 0x3c, 0x97, 0xd8, 0xcd, 0x2f, 0x4b, 0xec, 0xf0, 0x7c, 0x62, 0xef,
+	0x3e, 0x9f, 0xd8, 0x0f, 0x00, 0x68, 0xf7, 0x90, 0x32, 0x5f, 0xca, 0xdd, 0xda, 0x8b, 0x72, 0xd7,
+	0xfa, 0xaf, 0x1a, 0xc0, 0x81, 0x33, 0x8d, 0xa5, 0xf3, 0xc1, 0xbe, 0x0b, 0xdd, 0x94, 0x6a, 0x34,
+	0x34, 0x95, 0x83, 0x5f, 0xd9, 0xdd, 0x4a, 0x52, 0x55, 0xc4, 0xa1, 0x71, 0x48, 0x8b, 0x32, 0x79,
+	0xc7, 0xb2, 0x87, 0x22, 0x0b, 0xa2, 0xa9, 0x09, 0xe8, 0xf2, 0xf9, 0x06, 0xac, 0x28, 0x82, 0x58,
+	0x24, 0xae, 0x08, 0xa5, 0x9d, 0xad, 0xf1, 0xbe, 0x84, 0xee, 0x4b, 0x20, 0x7b, 0xbf, 0x20, 0x73,
+	0xa3, 0x20, 0x9f, 0x2e, 0xd5, 0x36, 0xd5, 0x64, 0x47, 0x12, 0x58, 0x5b, 0x7a, 0x2a, 0x34, 0x90,
+	0x0e, 0x34, 0xf0, 0x7b, 0x83, 0x73, 0xac, 0x0b, 0x6d, 0xd5, 0xeb, 0xa0, 0xc6, 0xfa, 0x60, 0x52,
+	0x2a, 0x30, 0xe1, 0xea, 0xd6, 0x1f, 0x9e, 0x87, 0xee, 0x5e, 0x98, 0x66, 0x49, 0x2e, 0x85, 0x58,
+	0x66, 0xbc, 0x36, 0x29, 0xe3, 0x55, 0xa5, 0xc1, 0xc8, 0x69, 0x60, 0x91, 0xbd, 0x05, 0x0d, 0x27,
+	0xcc, 0x7c, 0xe5, 0x68, 0x56, 0xd2, 0xaa, 0x75, 0x28, 0x8c, 0x13, 0x9e, 0xbd, 0x0b, 0x6d, 0x95,
+	0x83, 0xad, 0x52, 0x1c, 0x97, 0x26, 0x70, 0x6b, 0x1a, 0xb6, 0x09, 0x1d, 0x4f, 0x25, 0x87, 0x0f,
+	0x9b, 0xf3, 0x5d, 0xeb, 0xb4, 0x71, 0x5e, 0xd0, 0x60, 0x5a, 0x8a, 0x33, 0x96, 0xeb, 0x81, 0xd2,
+	0x52, 0x34, 0x29, 0xa5, 0xd4, 0x72, 0xc4, 0x61, 0xce, 0x00, 0xba, 0xb7, 0xc3, 0xb6, 0xde, 0x06,
+	0x35, 0x8d, 0x1c, 0x25, 0xe2, 0xd8, 0x2d, 0x75, 0x6a, 0x23, 0xc2, 0xce, 0xfc, 0x77, 0xf5, 0x55,
+	0x89, 0x3c, 0xbd, 0x7d, 0xa2, 0x1a, 0xa4, 0x62, 0xea, 0xcb, 0x06, 0xe6, 0x7c, 0x03, 0x1d, 0x6e,
+	0xe2, 0x9d, 0x54, 0x95, 0xd8, 0x87, 0xd0, 0x4d, 0x29, 0x2e, 0x22, 0x9b, 0x80, 0xbe, 0x35, 0x2f,
+	0x9a, 0x14, 0x41, 0x13, 0x0e, 0x69, 0x51, 0xc6, 0xef, 0x4c, 0x9d, 0xe4, 0x58, 0x36, 0xea, 0xce,
+	0x7f, 0x47, 0x1f, 0xda, 0x79, 0x67, 0xaa, 0x4a, 0x6c, 0x0b, 0x40, 0x2e, 0x2c, 0x6a, 0xd1, 0x9b,
+	0x67, 0x79, 0x71, 0x50, 0xe5, 0xa6, 0xa7, 0x8b, 0xec, 0xeb, 0xd0, 0x8e, 0xe5, 0xb9, 0x83, 0xf2,
+	0xb7, 0xba, 0x5b, 0x6b, 0x65, 0x03, 0x75, 0x20, 0xe1, 0x9a, 0x82, 0x7d, 0x07, 0x56, 0x64, 0xc2,
+	0xc4, 0x48, 0xb9, 0xe9, 0xc3, 0x15, 0xbd, 0xdc, 0x74, 0x9b, 0x19, 0x2f, 0x9e, 0xf7, 0xb3, 0x6a,
+	0x95, 0x7d, 0x0b, 0xfa, 0x42, 0x79, 0x51, 0x76, 0x8a, 0x09, 0xe8, 0x03, 0x6a, 0x7e, 0x69, 0xb9,
+	0x93, 0xc5, 0x7b, 0xa2, 0x52, 0x63, 0x1b, 0xd0, 0x52, 0x49, 0x3c, 0x6b, 0xd4, 0xaa, 0xf2, 0xe6,
+	0x45, 0xde, 0x0e, 0x73, 0x85, 0x67, 0xb7, 0xe7, 0xee, 0xed, 0xd1, 0x8d, 0x62, 0x3a, 0x41, 0x67,
+	0xf9, 0x65, 0xfc, 0xcc, 0x8d, 0x3e, 0xe6, 0x26, 0x6c, 0x01, 0x94, 0xf9, 0x0e, 0xc3, 0xf3, 0xf3,
+	0xbc, 0x2c, 0x92, 0x1d, 0xb8, 0x59, 0xe4, 0x39, 0xa0, 0x41, 0xaa, 0xe6, 0x5f, 0xc8, 0x2b, 0xec,
+	0x0b, 0xd4, 0xf4, 0x95, 0x25, 0x4d, 0xe5, 0x4d, 0x36, 0x5f, 0x8d, 0x67, 0x01, 0xec, 0x1d, 0xe8,
+	0x44, 0x89, 0x47, 0xc9, 0x5a, 0xc3, 0x8b, 0xb4, 0xe2, 0xd7, 0x54, 0xce, 0x95, 0x4c, 0x6e, 0x27,
+	0x43, 0xd6, 0x8e, 0x64, 0x85, 0xbd, 0x8b, 0x59, 0xd5, 0x11, 0x26, 0x63, 0x49, 0x67, 0xf9, 0xd2,
+	0x62, 0x52, 0xbc, 0xc2, 0x93, 0xef, 0x5c, 0x3a, 0xc3, 0x97, 0x9f, 0xea, 0x0c, 0xaf, 0x6b, 0xf7,
+	0x6f, 0xb8, 0x40, 0x22, 0x11, 0xd8, 0x8b, 0x72, 0x1c, 0x5f, 0x59, 0xec, 0x45, 0x62, 0x30, 0x47,
+	0xd3, 0x4f, 0xef, 0xfa, 0x49, 0x9a, 0x0d, 0xaf, 0xe8, 0x4d, 0x87, 0xaa, 0xe8, 0x76, 0xfa, 0xe9,
+	0x3d, 0x27, 0xcd, 0x86, 0xaf, 0xea, 0x77, 0x0d, 0x58, 0x43, 0x9e, 0xcb, 0xb0, 0x08, 0xe9, 0xef,
+	0xd5, 0x79, 0x9e, 0x17, 0x57, 0x60, 0x2a, 0x3e, 0x82, 0x45, 0xf6, 0x31, 0xac, 0xca, 0x36, 0xe5,
+	0x92, 0x7c, 0x6d, 0x5e, 0x27, 0x67, 0xee, 0x52, 0x78, 0x3f, 0xa9, 0x56, 0xcb, 0x0e, 0xd0, 0x64,
+	0xc9, 0x0e, 0xae, 0x2d, 0xed, 0xa0, 0x30, 0x6e, 0xfd, 0xa4, 0x5a, 0x65, 0x37, 0xa1, 0xa5, 0x32,
+	0xcf, 0xae, 0x2f, 0x18, 0x2d, 0x95, 0x63, 0xc9, 0x15, 0x05, 0xfb, 0x1a, 0xb4, 0x29, 0xed, 0x28,
+	0x8a, 0x87, 0xeb, 0xf3, 0x4a, 0x2c, 0xf3, 0x80, 0x78, 0x2b, 0xa0, 0x5f, 0x5c, 0x98, 0x3a, 0x9e,
+	0xf0, 0xfa, 0xfc, 0xc2, 0x54, 0x7b, 0x3b, 0xd7, 0x14, 0xec, 0x06, 0x34, 0xa7, 0x68, 0xd2, 0x87,
+	0xd6, 0xbc, 0x31, 0x94, 0x96, 0x5e, 0x62, 0xc9, 0x10, 0xd1, 0x31, 0x41, 0xae, 0xbe, 0x37, 0x16,
+	0x0c, 0x51, 0x71, 0x86, 0xe0, 0x90, 0x16, 0x65, 0xf6, 0xeb, 0x70, 0xa5, 0x9a, 0xfb, 0xa3, 0x13,
+	0x83, 0xd4, 0xf9, 0xef, 0x4d, 0xea, 0xe5, 0xf5, 0x25, 0x0a, 0x3e, 0x9b, 0x42, 0xc4, 0x2f, 0xc7,
+	0xcb, 0x11, 0x34, 0x2c, 0xb9, 0xd1, 0xa1, 0x5d, 0x19, 0xde, 0x58, 0x18, 0x56, 0xb1, 0xe5, 0xea,
+	0x6d, 0x14, 0xcb, 0xec, 0x23, 0xe8, 0x8d, 0x30, 0x57, 0x45, 0x85, 0x21, 0x86, 0x6f, 0xad, 0xd7,
+	0x66, 0xcf, 0xba, 0x95, 0x4c, 0x16, 0xde, 0x1d, 0x95, 0x15, 0x7c, 0xb0, 0xe2, 0x86, 0xb6, 0xe3,
+	0x79, 0xc9, 0xf0, 0x6d, 0x99, 0xc9, 0xe2, 0x86, 0xdb, 0x9e, 0x47, 0x29, 0x41, 0x51, 0x2c, 0xe8,
+	0x81, 0x08, 0x26, 0xe4, 0x6d, 0xc8, 0xad, 0x5b, 0x83, 0xf6, 0x3c, 0x24, 0xc0, 0x80, 0x41, 0x10,
+	0x08, 0xcc, 0x78, 0x1b, 0x7e, 0x4d, 0x12, 0x68, 0xd0, 0x9e, 0x87, 0x39, 0x5f, 0x53, 0xe7, 0xc4,
+	0xd6, 0x90, 0xe1, 0x4d, 0xa2, 0xe8, 0x4e, 0x9d, 0x93, 0x7d, 0x05, 0x42, 0x35, 0x97, 0x69, 0xc1,
+	0xa4, 0x6c, 0x5f, 0x9f, 0x57, 0xf3, 0x22, 0x02, 0xc3, 0x4d, 0x5f, 0x17, 0xa5, 0x39, 0x22, 0x23,
+	0x6c, 0x07, 0x5b, 0xc3, 0x77, 0x16, 0xcd, 0x91, 0x0a, 0x1d, 0xa1, 0x39, 0x52, 0x45, 0x6c, 0x23,
+	0xad, 0x35, 0x09, 0xfb, 0xdd, 0xf9, 0x36, 0xc5, 0x59, 0x8e, 0x9b, 0x99, 0x2e, 0x62, 0x1b, 0x3a,
+	0x55, 0xca, 0x36, 0x9b, 0xf3, 0x6d, 0x8a, 0xa3, 0x1c, 0x37, 0x1f, 0xeb, 0x22, 0xee, 0x53, 0x79,
+	0xe8, 0x47, 0xa1, 0xed, 0x04, 0xc1, 0xf0, 0xd6, 0xfc, 0x1a, 0xd0, 0xe7, 0x39, 0xde, 0xc9, 0x55,
+	0x09, 0x3f, 0x42, 0x51, 0x5b, 0x72, 0xe3, 0x86, 0xef, 0xcd, 0x7f, 0xa4, 0x38, 0xf4, 0x71, 0x73,
+	0xa2, 0x8b, 0xb8, 0x75, 0xe8, 0x90, 0xa3, 0x6c, 0xf6, 0xfe, 0xfc, 0xd6, 0x51, 0x3d, 0x0f, 0x70,
+	0xfd, 0xb8, 0x4a, 0x36, 0xfe, 0x10, 0xba, 0x92, 0xe3, 0xb2, 0xe9, 0xd6, 0xbc, 0x82, 0x95, 0x4e,
+	0x25, 0x97, 0xa2, 0x91, 0xcd, 0x6e, 0x40, 0xd3, 0x89, 0xf1, 0xb5, 0xe2, 0x07, 0xf3, 0xab, 0x6a,
+	0x1b, 0xc1, 0x5c, 0x62, 0x51, 0x0f, 0xa7, 0xf8, 0x44, 0x40, 0x27, 0xf8, 0x7e, 0x63, 0x5e, 0x0f,
+	0x2b, 0x0f, 0x08, 0x78, 0x77, 0x5a, 0x56, 0xd0, 0xd2, 0xc7, 0x51, 0x9a, 0xd9, 0xde, 0x34, 0x18,
+	0x7e, 0xb8, 0xb0, 0xfb, 0xca, 0x2c, 0x52, 0xde, 0x8e, 0x65, 0xc1, 0xfa, 0x10, 0x7a, 0xdb, 0xf4,
+	0xe4, 0xd2, 0x4f, 0xc9, 0x94, 0xdf, 0x80, 0x46, 0x11, 0x21, 0x2c, 0xf6, 0x08, 0xa2, 0xf8, 0x5c,
+	0xe0, 0xb3, 0x4d, 0x4e, 0x68, 0xeb, 0x6f, 0x0d, 0x68, 0x1d, 0x44, 0x79, 0xe2, 0x8a, 0x67, 0xe7,
+	0x47, 0xbf, 0xa6, 0x55, 0x26, 0x2c, 0xb3, 0xbc, 0xa4, 0x76, 0x10, 0xba, 0x1a, 0x7c, 0x34, 0x28,
+	0x28, 0x53, 0x04, 0x1f, 0x2f, 0x40, 0x53, 0x1e, 0xea, 0x65, 0x86, 0xae, 0xac, 0xd0, 0x72, 0xc9,
+	0xd3, 0x89, 0x17, 0x3d, 0x09, 0x71, 0xb9, 0x34, 0x29, 0xc1, 0x15, 0x34, 0x68, 0xcf, 0xa3, 0x47,
+	0x26, 0x9a, 0x80, 0xd6, 0xa3, 0x8c, 0x04, 0xf5, 0x34, 0x90, 0x56, 0xa5, 0x0e, 0x6c, 0xb6, 0x9f,
+	0x12, 0xd8, 0xbc, 0x06, 0x8d, 0x50, 0x67, 0x86, 0x16, 0x78, 0x7a, 0xde, 0x47, 0x70, 0x76, 0x13,
+	0x8a, 0xa4, 0xee, 0xa1, 0xb9, 0x34, 0x20, 0x52, 0xe0, 0xd9, 0x16, 0x98, 0xc5, 0x83, 0xdd, 0xc2,
+	0x53, 0x2b, 0x20, 0x9b, 0x87, 0xba, 0xc4, 0x4b, 0xb2, 0x25, 0x11, 0xd1, 0x38, 0x89, 0x8e, 0x54,
+	0xf0, 0xaa, 0xfb, 0x22, 0x11, 0xd1, 0x7d, 0x6c, 0xa7, 0xe3, 0xbc, 0x7e, 0x8a, 0xf7, 0x03, 0x69,
+	0xa6, 0xb2, 0xee, 0xdb, 0x7e, 0xba, 0x83, 0x55, 0xeb, 0x2f, 0x6b, 0xd0, 0xc1, 0xd9, 0xa1, 0x8c,
+	0x31, 0x14, 0x39, 0x75, 0xe3, 0x5c, 0xf9, 0xeb, 0x54, 0x56, 0x0f, 0x72, 0xa5, 0xf4, 0xd4, 0x83,
+	0x5c, 0xe2, 0xad, 0x41, 0x10, 0x2a, 0xcb, 0xa7, 0x7e, 0xa7, 0x41, 0xe4, 0x78, 0x4a, 0x62, 0xba,
+	0xca, 0xde, 0x01, 0x26, 0xdf, 0xa2, 0x9d, 0xc4, 0x4e, 0xe8, 0xa9, 0x17, 0xa2, 0x2a, 0xf9, 0x69,
+	0x80, 0x98, 0x3b, 0x84, 0x90, 0xcf, 0x43, 0x31, 0xfd, 0xdf, 0x0d, 0x6d, 0x37, 0xcc, 0xd4, 0xb9,
+	0xb4, 0xe9, 0x86, 0x18, 0xb0, 0x90, 0x60, 0xbf, 0x38, 0x8e, 0x36, 0x5d, 0x7c, 0x2e, 0x64, 0xfd,
+	0x49, 0x0d, 0xd6, 0xf6, 0x93, 0xc8, 0x15, 0x69, 0x7a, 0x0f, 0x1d, 0x09, 0x87, 0xdc, 0x42, 0x06,
+	0x0d, 0x8a, 0x68, 0xca, 0x57, 0x76, 0x54, 0x46, 0x4d, 0x94, 0xa1, 0xa2, 0xe2, 0x0c, 0x65, 0x70,
+	0x93, 0x20, 0x74, 0x84, 0x2a, 0xd0, 0xd4, 0xd0, 0xa8, 0xa0, 0x29, 0x16, 0x7a, 0x03, 0x56, 0xca,
+	0x37, 0x18, 0xd4, 0x83, 0x7a, 0x5e, 0x5b, 0x40, 0xa9, 0x97, 0xeb, 0xd0, 0x4d, 0x84, 0x83, 0xae,
+	0x16, 0x75, 0xd3, 0x24, 0x1a, 0x90, 0x20, 0xec, 0xc7, 0x9a, 0xc0, 0x60, 0x3f, 0x11, 0xb1, 0x93,
+	0x08, 0xb4, 0xde, 0x53, 0xe2, 0xf8, 0x25, 0x68, 0x05, 0x22, 0x1c, 0x67, 0x13, 0x35, 0x5e, 0x55,
+	0x2b, 0x9e, 0x56, 0xd7, 0x2b, 0x4f, 0xab, 0x91, 0xf3, 0x89, 0x70, 0xd4, 0x0b, 0x6c, 0x2a, 0xe3,
+	0x4a, 0x09, 0xf3, 0x40, 0x45, 0x59, 0x3b, 0x5c, 0x56, 0xac, 0x3f, 0x36, 0xa0, 0xab, 0x38, 0x43,
+	0x5f, 0x91, 0x32, 0xac, 0x15, 0x32, 0x1c, 0x80, 0x81, 0x81, 0x52, 0x29, 0x54, 0x2c, 0xb2, 0x0f,
+	0xc0, 0x08, 0xfc, 0xa9, 0x3a, 0x84, 0xbd, 0x3a, 0xb3, 0x17, 0xcc, 0xf2, 0x57, 0x9d, 0xa5, 0x91,
+	0x1a, 0xe3, 0xaa, 0x79, 0xe8, 0x9f, 0x50, 0x4a, 0xb2, 0xe2, 0x09, 0xda, 0xe5, 0x13, 0xd4, 0x6b,
+	0x64, 0xaa, 0xe3, 0x52, 0xc2, 0xad, 0x5e, 0xac, 0x7d, 0x6e, 0x2a, 0xc8, 0x9e, 0xc7, 0xbe, 0x01,
+	0x9d, 0x34, 0x74, 0xe2, 0x74, 0x12, 0x65, 0xea, 0xd0, 0xc5, 0x36, 0xf1, 0xfd, 0xfa, 0xce, 0x83,
+	0xc3, 0x93, 0xf0, 0x40, 0x61, 0xd4, 0xc7, 0x0a, 0x4a, 0xf6, 0x1d, 0xe8, 0xa5, 0x22, 0x4d, 0xe5,
+	0x63, 0x98, 0x51, 0x34, 0x6c, 0xcf, 0x5b, 0xc7, 0x03, 0x89, 0xc5, 0x59, 0xab, 0xc6, 0xdd, 0xb4,
+	0x04, 0xb1, 0xef, 0xc3, 0x8a, 0x6e, 0x1f, 0x44, 0xe3, 0xb1, 0xd0, 0xcf, 0x1d, 0x5e, 0x5d, 0xe8,
+	0xe1, 0x1e, 0xa1, 0x2b, 0xfd, 0xf4, 0xd3, 0x2a, 0x82, 0x7d, 0x0f, 0xdf, 0xb9, 0x93, 0x30, 0x6d,
+	0x75, 0x05, 0x20, 0x8d, 0xc1, 0x95, 0x19, 0xd7, 0x65, 0x46, 0xd8, 0x65, 0xaa, 0x7c, 0x09, 0x4f,
+	0xad, 0xff, 0xa8, 0x41, 0xb7, 0x32, 0x6a, 0x7a, 0xf0, 0x9e, 0x8a, 0x44, 0x5f, 0x07, 0x60, 0x19,
+	0x61, 0x93, 0x48, 0xbd, 0x13, 0x35, 0x39, 0x95, 0x11, 0x96, 0x44, 0xea, 0x7e, 0xc8, 0xe4, 0x54,
+	0x46, 0x03, 0xa8, 0xce, 0xbf, 0xc4, 0x21, 0xb9, 0x1a, 0x1b, 0xbc, 0x57, 0x02, 0xf7, 0x28, 0xba,
+	0x85, 0xea, 0x74, 0xe4, 0xa4, 0xfa, 0x82, 0xa2, 0xa8, 0xe3, 0x42, 0x7e, 0x2c, 0x12, 0x1c, 0x8b,
+	0xb2, 0x9d, 0xba, 0x8a, 0xb2, 0x26, 0x9b, 0xf4, 0x79, 0x14, 0xca, 0xdb, 0xcf, 0x1e, 0xef, 0x20,
+	0xe0, 0x87, 0x51, 0x48, 0xcd, 0x94, 0x64, 0xd5, 0x65, 0x9a, 0xae, 0xa2, 0xe5, 0x79, 0x94, 0x0b,
+	0x74, 0xef, 0x3c, 0xba, 0x40, 0x33, 0x79, 0x9b, 0xea, 0x7b, 0x9e, 0xf5, 0x2f, 0x35, 0x58, 0x5b,
+	0x60, 0x36, 0x7a, 0x53, 0xc8, 0x68, 0xfd, 0x82, 0xa1, 0xc7, 0x5b, 0x58, 0xdd, 0xf3, 0x08, 0x91,
+	0x4d, 0x49, 0x99, 0xea, 0x0a, 0x91, 0x4d, 0x51, 0x93, 0x2e, 0x42, 0x2b, 0x3b, 0xa1, 0xd9, 0xca,
+	0x85, 0xd1, 0xcc, 0x4e, 0x70, 0x9a, 0xdb, 0x98, 0xbd, 0x3f, 0xb6, 0x03, 0xf1, 0x58, 0x04, 0xc4,
+	0x87, 0x95, 0xad, 0x37, 0xcf, 0x90, 0xf2, 0xe6, 0xbd, 0x68, 0x7c, 0x0f, 0x69, 0x31, 0xaf, 0x5f,
+	0x96, 0xac, 0x4f, 0xa0, 0xa3, 0xa1, 0xcc, 0x84, 0xe6, 0x2e, 0xfe, 0x7f, 0xc0, 0xe0, 0x1c, 0x46,
+	0x42, 0xb0, 0xc5, 0xa0, 0x86, 0xa5, 0xcf, 0x9c, 0x24, 0x1c, 0xd4, 0x11, 0x7d, 0x27, 0x49, 0xa2,
+	0x64, 0x60, 0x60, 0x71, 0xdf, 0x09, 0x7d, 0x77, 0xd0, 0xc0, 0xe2, 0x5d, 0x27, 0x73, 0x82, 0x41,
+	0xd3, 0xfa, 0xd3, 0x26, 0x74, 0xf6, 0xd5, 0xd7, 0xd9, 0x2e, 0xf4, 0xf5, 0x48, 0x9e, 0x12, 0x18,
+	0xda, 0x9f, 0x2f, 0x50, 0x60, 0xa8, 0x17, 0x57, 0x6a, 0xf3, 0xff, 0x5c, 0x50, 0x5f, 0xf8, 0xe7,
+	0x82, 0xab, 0x60, 0x3c, 0x4a, 0x4e, 0x67, 0xaf, 0xf0, 0xf6, 0x03, 0x27, 0xe4, 0x08, 0xc6, 0x4c,
+	0x21, 0x94, 0xbb, 0x9d, 0xd2, 0x76, 0x3e, 0x6c, 0xcc, 0x1f, 0x21, 0xe4, 0x36, 0xcf, 0x01, 0x89,
+	0x64, 0x19, 0x83, 0x2a, 0xee, 0xc4, 0x0f, 0xbc, 0x44, 0x84, 0x2a, 0x52, 0xcd, 0x16, 0x87, 0xcc,
+	0x0b, 0x1a, 0xf6, 0x5d, 0xca, 0xbe, 0xd7, 0xc1, 0xa0, 0x6a, 0xf2, 0xcf, 0xc5, 0x99, 0xf3, 0xb6,
+	0xa6, 0xe0, 0xab, 0x15, 0x72, 0xda, 0xb9, 0xca, 0x07, 0x61, 0xed, 0xea, 0x83, 0x30, 0xf9, 0x9a,
+	0x9d, 0x36, 0x9c, 0x4e, 0x71, 0xea, 0xc3, 0xfd, 0xe6, 0x2d, 0xb5, 0x7b, 0x2f, 0x44, 0x52, 0xf4,
+	0x1e, 0xa7, 0x76, 0x71, 0xfc, 0x9b, 0x8a, 0x3c, 0x9d, 0xd8, 0xd2, 0x99, 0x40, 0x53, 0x02, 0xea,
+	0x41, 0x6a, 0x9e, 0x4e, 0x76, 0xd1, 0x9d, 0x40, 0x65, 0xbc, 0x01, 0x2b, 0x7a, 0x2e, 0xea, 0xed,
+	0x80, 0xcc, 0x79, 0xe8, 0x6b, 0xa8, 0x7c, 0x3a, 0xb0, 0x09, 0xe7, 0xdd, 0x89, 0x13, 0x86, 0x22,
+	0xb0, 0x8f, 0xf2, 0xd1, 0x48, 0xef, 0x00, 0x3d, 0xba, 0xe9, 0x5c, 0x53, 0xa8, 0xdb, 0x84, 0xa1,
+	0x0d, 0xc5, 0x82, 0x7e, 0xe8, 0x07, 0xf2, 0x15, 0x1f, 0xed, 0x76, 0x7d, 0xa2, 0xec, 0x86, 0x7e,
+	0x40, 0x41, 0x64, 0xdc, 0xf3, 0x3e, 0x86, 0x01, 0xfe, 0xd7, 0x45, 0x6a, 0x67, 0x91, 0xfe, 0x23,
+	0x80, 0xe1, 0xca, 0xba, 0x31, 0xeb, 0xa5, 0x3e, 0xcc, 0x7d, 0xef, 0x30, 0x52, 0x7f, 0x05, 0xd0,
+	0x27, 0x7a, 0x5d, 0xb5, 0x3e, 0x86, 0x5e, 0x55, 0x77, 0x50, 0x17, 0xe9, 0xf8, 0x36, 0x38, 0xc7,
+	0x00, 0x5a, 0x0f, 0xa2, 0x64, 0xea, 0x04, 0x83, 0x1a, 0x96, 0xe5, 0x33, 0xc9, 0x41, 0x9d, 0xf5,
+	0xa0, 0xa3, 0xcf, 0x15, 0x03, 0xc3, 0xfa, 0x16, 0x74, 0xf4, 0x3f, 0x1b, 0xe0, 0xea, 0x47, 0xb6,
+	0x49, 0xaf, 0x4a, 0x5a, 0xa6, 0x0e, 0x02, 0xc8, 0xa3, 0xd2, 0x7f, 0xd1, 0x51, 0x2f, 0xff, 0xa2,
+	0xc3, 0xfa, 0x7f, 0xd0, 0xab, 0x0e, 0x4e, 0xc7, 0xfd, 0x6a, 0x65, 0xdc, 0x6f, 0x49, 0x2b, 0xfc,
+	0xcc, 0x28, 0x89, 0xa6, 0x76, 0xc5, 0xc1, 0xe8, 0x20, 0x00, 0x3f, 0x63, 0xfd, 0x56, 0x0d, 0x9a,
+	0xe4, 0x2a, 0xd3, 0xd6, 0x82, 0x85, 0x72, 0xed, 0x34, 0xb9, 0x49, 0x10, 0x9a, 0x69, 0xf5, 0xc2,
+	0xbb, 0xfe, 0xf4, 0x0b, 0x6f, 0x63, 0xf6, 0xc2, 0xfb, 0x39, 0x73, 0x81, 0x6e, 0x3e, 0x82, 0x96,
+	0xfc, 0x57, 0x14, 0xb6, 0x06, 0xfd, 0x87, 0xe1, 0x71, 0x18, 0x3d, 0x09, 0x25, 0x60, 0x70, 0x8e,
+	0x9d, 0x87, 0x55, 0xcd, 0x74, 0xf5, 0xf7, 0x2b, 0x83, 0x1a, 0x1b, 0x40, 0x8f, 0xc4, 0xaa, 0x21,
+	0x75, 0x76, 0x15, 0x86, 0x6a, 0x73, 0xd8, 0x8d, 0x42, 0xf1, 0x20, 0xca, 0xfc, 0xd1, 0xa9, 0xc6,
+	0x1a, 0x6c, 0x15, 0xba, 0x07, 0x59, 0x14, 0x1f, 0x88, 0xd0, 0xf3, 0xc3, 0xf1, 0xa0, 0x71, 0xf3,
+	0x2e, 0xb4, 0xe4, 0x9f, 0xb5, 0x54, 0x3e, 0x29, 0x01, 0x83, 0x73, 0x48, 0x8d, 0x2f, 0x86, 0xfc,
+	0x70, 0xfc, 0x40, 0x9c, 0x64, 0xd2, 0x28, 0x61, 0x00, 0x64, 0x50, 0x67, 0x2b, 0x00, 0xaa, 0xd7,
+	0x3b, 0xa1, 0x37, 0x30, 0x6e, 0xef, 0xfc, 0xf4, 0x67, 0xd7, 0x6a, 0x7f, 0xf7, 0xb3, 0x6b, 0xb5,
+	0x7f, 0xfc, 0xd9, 0xb5, 0x73, 0x7f, 0xf0, 0x4f, 0xd7, 0x6a, 0x3f, 0x7c, 0xbf, 0xf2, 0x57, 0x34,
+	0x53, 0x27, 0x4b, 0xfc, 0x13, 0x79, 0xd5, 0xa9, 0x2b, 0xa1, 0xb8, 0x15, 0x1f, 0x8f, 0x6f, 0xc5,
+	0x47, 0xb7, 0xb4, 0xce, 0x1d, 0xb5, 0xe8, 0x1f, 0x66, 0x3e, 0xf8, 0xef, 0x01, 0x00, 0x9d, 0x9c,
+	0x2d, 0x6d, 0xe0, 0x46, 0x00, 0x00,
 }
 
 func (m *Message) Marshal() (dAtA []byte, err error) {
@@ -7676,6 +7701,16 @@ func (m *LockTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.WaitTimeout != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.WaitTimeout))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.WaitPolicy != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.WaitPolicy))
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.LockTableAtTheEnd {
 		i--
 		if m.LockTableAtTheEnd {
@@ -7780,6 +7815,11 @@ func (m *LockOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Limit != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Targets) > 0 {
 		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -12883,6 +12923,12 @@ func (m *LockTarget) ProtoSize() (n int) {
 	if m.LockTableAtTheEnd {
 		n += 2
 	}
+	if m.WaitPolicy != 0 {
+		n += 1 + sovPipeline(uint64(m.WaitPolicy))
+	}
+	if m.WaitTimeout != 0 {
+		n += 1 + sovPipeline(uint64(m.WaitTimeout))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12901,6 +12947,9 @@ func (m *LockOp) ProtoSize() (n int) {
 			n += 1 + l + sovPipeline(uint64(l))
 		}
 	}
+	if m.Limit != 0 {
+		n += 1 + sovPipeline(uint64(m.Limit))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -19058,6 +19107,44 @@ func (m *LockTarget) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.LockTableAtTheEnd = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitPolicy", wireType)
+			}
+			m.WaitPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitPolicy |= lock.WaitPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitTimeout", wireType)
+			}
+			m.WaitTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -19143,6 +19230,25 @@ func (m *LockOp) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
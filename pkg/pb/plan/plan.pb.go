@@ -394,7 +394,7 @@ func (x ForeignKeyDef_RefAction) String() string {
 }
 
 func (ForeignKeyDef_RefAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{28, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{29, 0}
 }
 
 type OrderBySpec_OrderByFlag int32
@@ -431,24 +431,27 @@ func (x OrderBySpec_OrderByFlag) String() string {
 }
 
 func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{47, 0}
 }
 
 type FrameClause_FrameType int32
 
 const (
-	FrameClause_ROWS  FrameClause_FrameType = 0
-	FrameClause_RANGE FrameClause_FrameType = 1
+	FrameClause_ROWS   FrameClause_FrameType = 0
+	FrameClause_RANGE  FrameClause_FrameType = 1
+	FrameClause_GROUPS FrameClause_FrameType = 2
 )
 
 var FrameClause_FrameType_name = map[int32]string{
 	0: "ROWS",
 	1: "RANGE",
+	2: "GROUPS",
 }
 
 var FrameClause_FrameType_value = map[string]int32{
-	"ROWS":  0,
-	"RANGE": 1,
+	"ROWS":   0,
+	"RANGE":  1,
+	"GROUPS": 2,
 }
 
 func (x FrameClause_FrameType) String() string {
@@ -456,7 +459,7 @@ func (x FrameClause_FrameType) String() string {
 }
 
 func (FrameClause_FrameType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{50, 0}
 }
 
 type FrameBound_BoundType int32
@@ -484,7 +487,7 @@ func (x FrameBound_BoundType) String() string {
 }
 
 func (FrameBound_BoundType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{51, 0}
 }
 
 type Node_NodeType int32
@@ -652,7 +655,7 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{59, 0}
 }
 
 type Node_JoinType int32
@@ -704,7 +707,7 @@ func (x Node_JoinType) String() string {
 }
 
 func (Node_JoinType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58, 1}
+	return fileDescriptor_2d655ab2f7683c23, []int{59, 1}
 }
 
 type Node_AggMode int32
@@ -732,7 +735,7 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58, 2}
+	return fileDescriptor_2d655ab2f7683c23, []int{59, 2}
 }
 
 type Node_FillType int32
@@ -769,7 +772,7 @@ func (x Node_FillType) String() string {
 }
 
 func (Node_FillType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58, 3}
+	return fileDescriptor_2d655ab2f7683c23, []int{59, 3}
 }
 
 type Node_OnDuplicateAction int32
@@ -797,7 +800,7 @@ func (x Node_OnDuplicateAction) String() string {
 }
 
 func (Node_OnDuplicateAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58, 4}
+	return fileDescriptor_2d655ab2f7683c23, []int{59, 4}
 }
 
 type Node_ApplyType int32
@@ -822,7 +825,7 @@ func (x Node_ApplyType) String() string {
 }
 
 func (Node_ApplyType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58, 5}
+	return fileDescriptor_2d655ab2f7683c23, []int{59, 5}
 }
 
 type Query_StatementType int32
@@ -862,7 +865,7 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{72, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{73, 0}
 }
 
 type TransationControl_TclType int32
@@ -890,7 +893,7 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{73, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{74, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -918,7 +921,7 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{74, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{75, 0}
 }
 
 type DataControl_DclType int32
@@ -967,7 +970,7 @@ func (x DataControl_DclType) String() string {
 }
 
 func (DataControl_DclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{79, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{80, 0}
 }
 
 type DataDefinition_DdlType int32
@@ -1094,7 +1097,7 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{80, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{81, 0}
 }
 
 type AlterTableDrop_Typ int32
@@ -1105,6 +1108,7 @@ const (
 	AlterTableDrop_KEY         AlterTableDrop_Typ = 2
 	AlterTableDrop_PRIMARY_KEY AlterTableDrop_Typ = 3
 	AlterTableDrop_FOREIGN_KEY AlterTableDrop_Typ = 4
+	AlterTableDrop_CHECK       AlterTableDrop_Typ = 5
 )
 
 var AlterTableDrop_Typ_name = map[int32]string{
@@ -1113,6 +1117,7 @@ var AlterTableDrop_Typ_name = map[int32]string{
 	2: "KEY",
 	3: "PRIMARY_KEY",
 	4: "FOREIGN_KEY",
+	5: "CHECK",
 }
 
 var AlterTableDrop_Typ_value = map[string]int32{
@@ -1121,6 +1126,7 @@ var AlterTableDrop_Typ_value = map[string]int32{
 	"KEY":         2,
 	"PRIMARY_KEY": 3,
 	"FOREIGN_KEY": 4,
+	"CHECK":       5,
 }
 
 func (x AlterTableDrop_Typ) String() string {
@@ -1128,7 +1134,7 @@ func (x AlterTableDrop_Typ) String() string {
 }
 
 func (AlterTableDrop_Typ) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{88, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{89, 0}
 }
 
 type AlterTable_AlgorithmType int32
@@ -1159,7 +1165,7 @@ func (x AlterTable_AlgorithmType) String() string {
 }
 
 func (AlterTable_AlgorithmType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{100, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{102, 0}
 }
 
 type MetadataScanInfo_MetadataScanInfoType int32
@@ -1217,7 +1223,7 @@ func (x MetadataScanInfo_MetadataScanInfoType) String() string {
 }
 
 func (MetadataScanInfo_MetadataScanInfoType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{123, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{125, 0}
 }
 
 type Type struct {
@@ -1375,6 +1381,7 @@ func (m *MsgHeader) GetMsgType() int32 {
 type Literal struct {
 	Isnull bool `protobuf:"varint,1,opt,name=isnull,proto3" json:"isnull,omitempty"`
 	// Types that are valid to be assigned to Value:
+	//
 	//	*Literal_I8Val
 	//	*Literal_I16Val
 	//	*Literal_I32Val
@@ -2583,6 +2590,7 @@ func (m *Function) GetArgs() []*Expr {
 type Expr struct {
 	Typ Type `protobuf:"bytes,1,opt,name=typ,proto3" json:"typ"`
 	// Types that are valid to be assigned to Expr:
+	//
 	//	*Expr_Lit
 	//	*Expr_P
 	//	*Expr_V
@@ -3145,10 +3153,12 @@ type ColDef struct {
 	TblName   string `protobuf:"bytes,17,opt,name=tbl_name,json=tblName,proto3" json:"tbl_name,omitempty"`
 	DbName    string `protobuf:"bytes,18,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// get origin_name by ColDef.GetOriginCaseName(), letter case: origin
-	OriginName           string   `protobuf:"bytes,19,opt,name=origin_name,json=originName,proto3" json:"origin_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	OriginName string `protobuf:"bytes,19,opt,name=origin_name,json=originName,proto3" json:"origin_name,omitempty"`
+	// set for the GENERATED ALWAYS AS column
+	Generated            *GeneratedColDef `protobuf:"bytes,20,opt,name=generated,proto3" json:"generated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *ColDef) Reset()         { *m = ColDef{} }
@@ -3317,6 +3327,87 @@ func (m *ColDef) GetOriginName() string {
 	return ""
 }
 
+func (m *ColDef) GetGenerated() *GeneratedColDef {
+	if m != nil {
+		return m.Generated
+	}
+	return nil
+}
+
+type GeneratedColDef struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the generation expression, whose column refs are bound by name
+	Expr *Expr `protobuf:"bytes,2,opt,name=expr,proto3" json:"expr,omitempty"`
+	// the generation expression as written, for SHOW CREATE TABLE
+	ExprStr string `protobuf:"bytes,3,opt,name=expr_str,json=exprStr,proto3" json:"expr_str,omitempty"`
+	// STORED columns are kept in storage, VIRTUAL ones are computed when read
+	Stored               bool     `protobuf:"varint,4,opt,name=stored,proto3" json:"stored,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GeneratedColDef) Reset()         { *m = GeneratedColDef{} }
+func (m *GeneratedColDef) String() string { return proto.CompactTextString(m) }
+func (*GeneratedColDef) ProtoMessage()    {}
+func (*GeneratedColDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{23}
+}
+func (m *GeneratedColDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeneratedColDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GeneratedColDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GeneratedColDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeneratedColDef.Merge(m, src)
+}
+func (m *GeneratedColDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GeneratedColDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeneratedColDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeneratedColDef proto.InternalMessageInfo
+
+func (m *GeneratedColDef) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GeneratedColDef) GetExpr() *Expr {
+	if m != nil {
+		return m.Expr
+	}
+	return nil
+}
+
+func (m *GeneratedColDef) GetExprStr() string {
+	if m != nil {
+		return m.ExprStr
+	}
+	return ""
+}
+
+func (m *GeneratedColDef) GetStored() bool {
+	if m != nil {
+		return m.Stored
+	}
+	return false
+}
+
 type Default struct {
 	Expr         *Expr  `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
 	OriginString string `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
@@ -3331,7 +3422,7 @@ func (m *Default) Reset()         { *m = Default{} }
 func (m *Default) String() string { return proto.CompactTextString(m) }
 func (*Default) ProtoMessage()    {}
 func (*Default) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{23}
+	return fileDescriptor_2d655ab2f7683c23, []int{24}
 }
 func (m *Default) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3393,7 +3484,7 @@ func (m *OnUpdate) Reset()         { *m = OnUpdate{} }
 func (m *OnUpdate) String() string { return proto.CompactTextString(m) }
 func (*OnUpdate) ProtoMessage()    {}
 func (*OnUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{24}
+	return fileDescriptor_2d655ab2f7683c23, []int{25}
 }
 func (m *OnUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3449,7 +3540,7 @@ func (m *IndexOption) Reset()         { *m = IndexOption{} }
 func (m *IndexOption) String() string { return proto.CompactTextString(m) }
 func (*IndexOption) ProtoMessage()    {}
 func (*IndexOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{25}
+	return fileDescriptor_2d655ab2f7683c23, []int{26}
 }
 func (m *IndexOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3521,7 +3612,7 @@ func (m *PrimaryKeyDef) Reset()         { *m = PrimaryKeyDef{} }
 func (m *PrimaryKeyDef) String() string { return proto.CompactTextString(m) }
 func (*PrimaryKeyDef) ProtoMessage()    {}
 func (*PrimaryKeyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{26}
+	return fileDescriptor_2d655ab2f7683c23, []int{27}
 }
 func (m *PrimaryKeyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3617,7 +3708,7 @@ func (m *IndexDef) Reset()         { *m = IndexDef{} }
 func (m *IndexDef) String() string { return proto.CompactTextString(m) }
 func (*IndexDef) ProtoMessage()    {}
 func (*IndexDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{27}
+	return fileDescriptor_2d655ab2f7683c23, []int{28}
 }
 func (m *IndexDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3749,7 +3840,7 @@ func (m *ForeignKeyDef) Reset()         { *m = ForeignKeyDef{} }
 func (m *ForeignKeyDef) String() string { return proto.CompactTextString(m) }
 func (*ForeignKeyDef) ProtoMessage()    {}
 func (*ForeignKeyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{28}
+	return fileDescriptor_2d655ab2f7683c23, []int{29}
 }
 func (m *ForeignKeyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3823,7 +3914,12 @@ func (m *ForeignKeyDef) GetOnUpdate() ForeignKeyDef_RefAction {
 type CheckDef struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// Name for anonymous constraints, __mo_chk_[INDEX_ID]
-	Check                *Expr    `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	Check *Expr `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	// NOT ENFORCED checks are kept in the definition but not validated.
+	// It is negated so that a check missing the field is enforced.
+	NotEnforced bool `protobuf:"varint,3,opt,name=not_enforced,json=notEnforced,proto3" json:"not_enforced,omitempty"`
+	// the check expression as written, for SHOW CREATE TABLE
+	ExprStr              string   `protobuf:"bytes,4,opt,name=expr_str,json=exprStr,proto3" json:"expr_str,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3833,7 +3929,7 @@ func (m *CheckDef) Reset()         { *m = CheckDef{} }
 func (m *CheckDef) String() string { return proto.CompactTextString(m) }
 func (*CheckDef) ProtoMessage()    {}
 func (*CheckDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{29}
+	return fileDescriptor_2d655ab2f7683c23, []int{30}
 }
 func (m *CheckDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3876,6 +3972,20 @@ func (m *CheckDef) GetCheck() *Expr {
 	return nil
 }
 
+func (m *CheckDef) GetNotEnforced() bool {
+	if m != nil {
+		return m.NotEnforced
+	}
+	return false
+}
+
+func (m *CheckDef) GetExprStr() string {
+	if m != nil {
+		return m.ExprStr
+	}
+	return ""
+}
+
 type ClusterByDef struct {
 	// XXX: Deprecated and to be removed soon. letter case: lower ?
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -3890,7 +4000,7 @@ func (m *ClusterByDef) Reset()         { *m = ClusterByDef{} }
 func (m *ClusterByDef) String() string { return proto.CompactTextString(m) }
 func (*ClusterByDef) ProtoMessage()    {}
 func (*ClusterByDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{30}
+	return fileDescriptor_2d655ab2f7683c23, []int{31}
 }
 func (m *ClusterByDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3945,7 +4055,7 @@ func (m *PropertyDef) Reset()         { *m = PropertyDef{} }
 func (m *PropertyDef) String() string { return proto.CompactTextString(m) }
 func (*PropertyDef) ProtoMessage()    {}
 func (*PropertyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{31}
+	return fileDescriptor_2d655ab2f7683c23, []int{32}
 }
 func (m *PropertyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4000,7 +4110,7 @@ func (m *Property) Reset()         { *m = Property{} }
 func (m *Property) String() string { return proto.CompactTextString(m) }
 func (*Property) ProtoMessage()    {}
 func (*Property) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{32}
+	return fileDescriptor_2d655ab2f7683c23, []int{33}
 }
 func (m *Property) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4054,7 +4164,7 @@ func (m *PropertiesDef) Reset()         { *m = PropertiesDef{} }
 func (m *PropertiesDef) String() string { return proto.CompactTextString(m) }
 func (*PropertiesDef) ProtoMessage()    {}
 func (*PropertiesDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{33}
+	return fileDescriptor_2d655ab2f7683c23, []int{34}
 }
 func (m *PropertiesDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4110,7 +4220,7 @@ func (m *PartitionByDef) Reset()         { *m = PartitionByDef{} }
 func (m *PartitionByDef) String() string { return proto.CompactTextString(m) }
 func (*PartitionByDef) ProtoMessage()    {}
 func (*PartitionByDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{34}
+	return fileDescriptor_2d655ab2f7683c23, []int{35}
 }
 func (m *PartitionByDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4221,7 +4331,7 @@ func (m *PartitionExpr) Reset()         { *m = PartitionExpr{} }
 func (m *PartitionExpr) String() string { return proto.CompactTextString(m) }
 func (*PartitionExpr) ProtoMessage()    {}
 func (*PartitionExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{35}
+	return fileDescriptor_2d655ab2f7683c23, []int{36}
 }
 func (m *PartitionExpr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4277,7 +4387,7 @@ func (m *PartitionColumns) Reset()         { *m = PartitionColumns{} }
 func (m *PartitionColumns) String() string { return proto.CompactTextString(m) }
 func (*PartitionColumns) ProtoMessage()    {}
 func (*PartitionColumns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{36}
+	return fileDescriptor_2d655ab2f7683c23, []int{37}
 }
 func (m *PartitionColumns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4337,7 +4447,7 @@ func (m *PartitionItem) Reset()         { *m = PartitionItem{} }
 func (m *PartitionItem) String() string { return proto.CompactTextString(m) }
 func (*PartitionItem) ProtoMessage()    {}
 func (*PartitionItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{37}
+	return fileDescriptor_2d655ab2f7683c23, []int{38}
 }
 func (m *PartitionItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4426,7 +4536,7 @@ func (m *ViewDef) Reset()         { *m = ViewDef{} }
 func (m *ViewDef) String() string { return proto.CompactTextString(m) }
 func (*ViewDef) ProtoMessage()    {}
 func (*ViewDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{38}
+	return fileDescriptor_2d655ab2f7683c23, []int{39}
 }
 func (m *ViewDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4500,7 +4610,7 @@ func (m *TableDef) Reset()         { *m = TableDef{} }
 func (m *TableDef) String() string { return proto.CompactTextString(m) }
 func (*TableDef) ProtoMessage()    {}
 func (*TableDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{39}
+	return fileDescriptor_2d655ab2f7683c23, []int{40}
 }
 func (m *TableDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4726,7 +4836,7 @@ func (m *TableDef_DefType) Reset()         { *m = TableDef_DefType{} }
 func (m *TableDef_DefType) String() string { return proto.CompactTextString(m) }
 func (*TableDef_DefType) ProtoMessage()    {}
 func (*TableDef_DefType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{39, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{40, 0}
 }
 func (m *TableDef_DefType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4800,7 +4910,7 @@ func (m *TableFunction) Reset()         { *m = TableFunction{} }
 func (m *TableFunction) String() string { return proto.CompactTextString(m) }
 func (*TableFunction) ProtoMessage()    {}
 func (*TableFunction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{40}
+	return fileDescriptor_2d655ab2f7683c23, []int{41}
 }
 func (m *TableFunction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4869,7 +4979,7 @@ func (m *HashMapStats) Reset()         { *m = HashMapStats{} }
 func (m *HashMapStats) String() string { return proto.CompactTextString(m) }
 func (*HashMapStats) ProtoMessage()    {}
 func (*HashMapStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{41}
+	return fileDescriptor_2d655ab2f7683c23, []int{42}
 }
 func (m *HashMapStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5000,7 +5110,7 @@ func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{42}
+	return fileDescriptor_2d655ab2f7683c23, []int{43}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5097,7 +5207,7 @@ func (m *RowsetExpr) Reset()         { *m = RowsetExpr{} }
 func (m *RowsetExpr) String() string { return proto.CompactTextString(m) }
 func (*RowsetExpr) ProtoMessage()    {}
 func (*RowsetExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{43}
+	return fileDescriptor_2d655ab2f7683c23, []int{44}
 }
 func (m *RowsetExpr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5151,7 +5261,7 @@ func (m *ColData) Reset()         { *m = ColData{} }
 func (m *ColData) String() string { return proto.CompactTextString(m) }
 func (*ColData) ProtoMessage()    {}
 func (*ColData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44}
+	return fileDescriptor_2d655ab2f7683c23, []int{45}
 }
 func (m *ColData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5199,7 +5309,7 @@ func (m *RowsetData) Reset()         { *m = RowsetData{} }
 func (m *RowsetData) String() string { return proto.CompactTextString(m) }
 func (*RowsetData) ProtoMessage()    {}
 func (*RowsetData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45}
+	return fileDescriptor_2d655ab2f7683c23, []int{46}
 }
 func (m *RowsetData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5255,7 +5365,7 @@ func (m *OrderBySpec) Reset()         { *m = OrderBySpec{} }
 func (m *OrderBySpec) String() string { return proto.CompactTextString(m) }
 func (*OrderBySpec) ProtoMessage()    {}
 func (*OrderBySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46}
+	return fileDescriptor_2d655ab2f7683c23, []int{47}
 }
 func (m *OrderBySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5306,21 +5416,23 @@ func (m *OrderBySpec) GetFlag() OrderBySpec_OrderByFlag {
 }
 
 type WindowSpec struct {
-	WindowFunc           *Expr          `protobuf:"bytes,1,opt,name=window_func,json=windowFunc,proto3" json:"window_func,omitempty"`
-	PartitionBy          []*Expr        `protobuf:"bytes,2,rep,name=partition_by,json=partitionBy,proto3" json:"partition_by,omitempty"`
-	OrderBy              []*OrderBySpec `protobuf:"bytes,3,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Frame                *FrameClause   `protobuf:"bytes,4,opt,name=frame,proto3" json:"frame,omitempty"`
-	Name                 string         `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	WindowFunc  *Expr          `protobuf:"bytes,1,opt,name=window_func,json=windowFunc,proto3" json:"window_func,omitempty"`
+	PartitionBy []*Expr        `protobuf:"bytes,2,rep,name=partition_by,json=partitionBy,proto3" json:"partition_by,omitempty"`
+	OrderBy     []*OrderBySpec `protobuf:"bytes,3,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Frame       *FrameClause   `protobuf:"bytes,4,opt,name=frame,proto3" json:"frame,omitempty"`
+	Name        string         `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// ignore_nulls is set for the value window functions with IGNORE NULLS.
+	IgnoreNulls          bool     `protobuf:"varint,6,opt,name=ignore_nulls,json=ignoreNulls,proto3" json:"ignore_nulls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *WindowSpec) Reset()         { *m = WindowSpec{} }
 func (m *WindowSpec) String() string { return proto.CompactTextString(m) }
 func (*WindowSpec) ProtoMessage()    {}
 func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{47}
+	return fileDescriptor_2d655ab2f7683c23, []int{48}
 }
 func (m *WindowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5384,6 +5496,13 @@ func (m *WindowSpec) GetName() string {
 	return ""
 }
 
+func (m *WindowSpec) GetIgnoreNulls() bool {
+	if m != nil {
+		return m.IgnoreNulls
+	}
+	return false
+}
+
 type SampleFuncSpec struct {
 	Rows                 int32    `protobuf:"varint,1,opt,name=Rows,proto3" json:"Rows,omitempty"`
 	Percent              float64  `protobuf:"fixed64,2,opt,name=Percent,proto3" json:"Percent,omitempty"`
@@ -5397,7 +5516,7 @@ func (m *SampleFuncSpec) Reset()         { *m = SampleFuncSpec{} }
 func (m *SampleFuncSpec) String() string { return proto.CompactTextString(m) }
 func (*SampleFuncSpec) ProtoMessage()    {}
 func (*SampleFuncSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48}
+	return fileDescriptor_2d655ab2f7683c23, []int{49}
 }
 func (m *SampleFuncSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5460,7 +5579,7 @@ func (m *FrameClause) Reset()         { *m = FrameClause{} }
 func (m *FrameClause) String() string { return proto.CompactTextString(m) }
 func (*FrameClause) ProtoMessage()    {}
 func (*FrameClause) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49}
+	return fileDescriptor_2d655ab2f7683c23, []int{50}
 }
 func (m *FrameClause) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5523,7 +5642,7 @@ func (m *FrameBound) Reset()         { *m = FrameBound{} }
 func (m *FrameBound) String() string { return proto.CompactTextString(m) }
 func (*FrameBound) ProtoMessage()    {}
 func (*FrameBound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50}
+	return fileDescriptor_2d655ab2f7683c23, []int{51}
 }
 func (m *FrameBound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5594,7 +5713,7 @@ func (m *OnDuplicateKeyCtx) Reset()         { *m = OnDuplicateKeyCtx{} }
 func (m *OnDuplicateKeyCtx) String() string { return proto.CompactTextString(m) }
 func (*OnDuplicateKeyCtx) ProtoMessage()    {}
 func (*OnDuplicateKeyCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51}
+	return fileDescriptor_2d655ab2f7683c23, []int{52}
 }
 func (m *OnDuplicateKeyCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5711,7 +5830,7 @@ func (m *UpdateCtx) Reset()         { *m = UpdateCtx{} }
 func (m *UpdateCtx) String() string { return proto.CompactTextString(m) }
 func (*UpdateCtx) ProtoMessage()    {}
 func (*UpdateCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{52}
+	return fileDescriptor_2d655ab2f7683c23, []int{53}
 }
 func (m *UpdateCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5814,7 +5933,7 @@ func (m *InsertCtx) Reset()         { *m = InsertCtx{} }
 func (m *InsertCtx) String() string { return proto.CompactTextString(m) }
 func (*InsertCtx) ProtoMessage()    {}
 func (*InsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{53}
+	return fileDescriptor_2d655ab2f7683c23, []int{54}
 }
 func (m *InsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5912,7 +6031,7 @@ func (m *ReplaceCtx) Reset()         { *m = ReplaceCtx{} }
 func (m *ReplaceCtx) String() string { return proto.CompactTextString(m) }
 func (*ReplaceCtx) ProtoMessage()    {}
 func (*ReplaceCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{54}
+	return fileDescriptor_2d655ab2f7683c23, []int{55}
 }
 func (m *ReplaceCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6040,7 +6159,7 @@ func (m *AnalyzeInfo) Reset()         { *m = AnalyzeInfo{} }
 func (m *AnalyzeInfo) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeInfo) ProtoMessage()    {}
 func (*AnalyzeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{55}
+	return fileDescriptor_2d655ab2f7683c23, []int{56}
 }
 func (m *AnalyzeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6249,7 +6368,7 @@ func (m *PartitionPrune) Reset()         { *m = PartitionPrune{} }
 func (m *PartitionPrune) String() string { return proto.CompactTextString(m) }
 func (*PartitionPrune) ProtoMessage()    {}
 func (*PartitionPrune) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56}
+	return fileDescriptor_2d655ab2f7683c23, []int{57}
 }
 func (m *PartitionPrune) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6304,7 +6423,7 @@ func (m *OriginTableMessageForFuzzy) Reset()         { *m = OriginTableMessageFo
 func (m *OriginTableMessageForFuzzy) String() string { return proto.CompactTextString(m) }
 func (*OriginTableMessageForFuzzy) ProtoMessage()    {}
 func (*OriginTableMessageForFuzzy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{57}
+	return fileDescriptor_2d655ab2f7683c23, []int{58}
 }
 func (m *OriginTableMessageForFuzzy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6435,7 +6554,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58}
+	return fileDescriptor_2d655ab2f7683c23, []int{59}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6941,7 +7060,7 @@ func (m *Snapshot) Reset()         { *m = Snapshot{} }
 func (m *Snapshot) String() string { return proto.CompactTextString(m) }
 func (*Snapshot) ProtoMessage()    {}
 func (*Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{59}
+	return fileDescriptor_2d655ab2f7683c23, []int{60}
 }
 func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6997,7 +7116,7 @@ func (m *SnapshotTenant) Reset()         { *m = SnapshotTenant{} }
 func (m *SnapshotTenant) String() string { return proto.CompactTextString(m) }
 func (*SnapshotTenant) ProtoMessage()    {}
 func (*SnapshotTenant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60}
+	return fileDescriptor_2d655ab2f7683c23, []int{61}
 }
 func (m *SnapshotTenant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7060,7 +7179,7 @@ func (m *ExternScan) Reset()         { *m = ExternScan{} }
 func (m *ExternScan) String() string { return proto.CompactTextString(m) }
 func (*ExternScan) ProtoMessage()    {}
 func (*ExternScan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{61}
+	return fileDescriptor_2d655ab2f7683c23, []int{62}
 }
 func (m *ExternScan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7160,30 +7279,32 @@ func (m *ExternScan) GetTbColToDataCol() map[string]int32 {
 }
 
 type LockTarget struct {
-	TableId              uint64        `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	PrimaryColIdxInBat   int32         `protobuf:"varint,2,opt,name=primary_col_idx_in_bat,json=primaryColIdxInBat,proto3" json:"primary_col_idx_in_bat,omitempty"`
-	PrimaryColTyp        Type          `protobuf:"bytes,3,opt,name=primary_col_typ,json=primaryColTyp,proto3" json:"primary_col_typ"`
-	RefreshTsIdxInBat    int32         `protobuf:"varint,4,opt,name=refresh_ts_idx_in_bat,json=refreshTsIdxInBat,proto3" json:"refresh_ts_idx_in_bat,omitempty"`
-	FilterColIdxInBat    int32         `protobuf:"varint,5,opt,name=filter_col_idx_in_bat,json=filterColIdxInBat,proto3" json:"filter_col_idx_in_bat,omitempty"`
-	LockTable            bool          `protobuf:"varint,6,opt,name=lock_table,json=lockTable,proto3" json:"lock_table,omitempty"`
-	IsPartitionTable     bool          `protobuf:"varint,7,opt,name=is_partition_table,json=isPartitionTable,proto3" json:"is_partition_table,omitempty"`
-	PartitionTableIds    []uint64      `protobuf:"varint,8,rep,packed,name=partition_table_ids,json=partitionTableIds,proto3" json:"partition_table_ids,omitempty"`
-	Block                bool          `protobuf:"varint,9,opt,name=block,proto3" json:"block,omitempty"`
-	Mode                 lock.LockMode `protobuf:"varint,10,opt,name=Mode,proto3,enum=lock.LockMode" json:"Mode,omitempty"`
-	PrimaryColRelPos     int32         `protobuf:"varint,11,opt,name=primary_col_rel_pos,json=primaryColRelPos,proto3" json:"primary_col_rel_pos,omitempty"`
-	FilterColRelPos      int32         `protobuf:"varint,12,opt,name=filter_col_rel_pos,json=filterColRelPos,proto3" json:"filter_col_rel_pos,omitempty"`
-	LockRows             *Expr         `protobuf:"bytes,13,opt,name=lock_rows,json=lockRows,proto3" json:"lock_rows,omitempty"`
-	LockTableAtTheEnd    bool          `protobuf:"varint,14,opt,name=lock_table_at_the_end,json=lockTableAtTheEnd,proto3" json:"lock_table_at_the_end,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	TableId              uint64          `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	PrimaryColIdxInBat   int32           `protobuf:"varint,2,opt,name=primary_col_idx_in_bat,json=primaryColIdxInBat,proto3" json:"primary_col_idx_in_bat,omitempty"`
+	PrimaryColTyp        Type            `protobuf:"bytes,3,opt,name=primary_col_typ,json=primaryColTyp,proto3" json:"primary_col_typ"`
+	RefreshTsIdxInBat    int32           `protobuf:"varint,4,opt,name=refresh_ts_idx_in_bat,json=refreshTsIdxInBat,proto3" json:"refresh_ts_idx_in_bat,omitempty"`
+	FilterColIdxInBat    int32           `protobuf:"varint,5,opt,name=filter_col_idx_in_bat,json=filterColIdxInBat,proto3" json:"filter_col_idx_in_bat,omitempty"`
+	LockTable            bool            `protobuf:"varint,6,opt,name=lock_table,json=lockTable,proto3" json:"lock_table,omitempty"`
+	IsPartitionTable     bool            `protobuf:"varint,7,opt,name=is_partition_table,json=isPartitionTable,proto3" json:"is_partition_table,omitempty"`
+	PartitionTableIds    []uint64        `protobuf:"varint,8,rep,packed,name=partition_table_ids,json=partitionTableIds,proto3" json:"partition_table_ids,omitempty"`
+	Block                bool            `protobuf:"varint,9,opt,name=block,proto3" json:"block,omitempty"`
+	Mode                 lock.LockMode   `protobuf:"varint,10,opt,name=Mode,proto3,enum=lock.LockMode" json:"Mode,omitempty"`
+	PrimaryColRelPos     int32           `protobuf:"varint,11,opt,name=primary_col_rel_pos,json=primaryColRelPos,proto3" json:"primary_col_rel_pos,omitempty"`
+	FilterColRelPos      int32           `protobuf:"varint,12,opt,name=filter_col_rel_pos,json=filterColRelPos,proto3" json:"filter_col_rel_pos,omitempty"`
+	LockRows             *Expr           `protobuf:"bytes,13,opt,name=lock_rows,json=lockRows,proto3" json:"lock_rows,omitempty"`
+	LockTableAtTheEnd    bool            `protobuf:"varint,14,opt,name=lock_table_at_the_end,json=lockTableAtTheEnd,proto3" json:"lock_table_at_the_end,omitempty"`
+	WaitPolicy           lock.WaitPolicy `protobuf:"varint,15,opt,name=wait_policy,json=waitPolicy,proto3,enum=lock.WaitPolicy" json:"wait_policy,omitempty"`
+	WaitTimeout          int64           `protobuf:"varint,16,opt,name=wait_timeout,json=waitTimeout,proto3" json:"wait_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *LockTarget) Reset()         { *m = LockTarget{} }
 func (m *LockTarget) String() string { return proto.CompactTextString(m) }
 func (*LockTarget) ProtoMessage()    {}
 func (*LockTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{62}
+	return fileDescriptor_2d655ab2f7683c23, []int{63}
 }
 func (m *LockTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7310,6 +7431,20 @@ func (m *LockTarget) GetLockTableAtTheEnd() bool {
 	return false
 }
 
+func (m *LockTarget) GetWaitPolicy() lock.WaitPolicy {
+	if m != nil {
+		return m.WaitPolicy
+	}
+	return lock.WaitPolicy_Wait
+}
+
+func (m *LockTarget) GetWaitTimeout() int64 {
+	if m != nil {
+		return m.WaitTimeout
+	}
+	return 0
+}
+
 type PreInsertUkCtx struct {
 	// index of columns(parts of unique key) in pre batch
 	Columns              []int32  `protobuf:"varint,1,rep,packed,name=columns,proto3" json:"columns,omitempty"`
@@ -7325,7 +7460,7 @@ func (m *PreInsertUkCtx) Reset()         { *m = PreInsertUkCtx{} }
 func (m *PreInsertUkCtx) String() string { return proto.CompactTextString(m) }
 func (*PreInsertUkCtx) ProtoMessage()    {}
 func (*PreInsertUkCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{63}
+	return fileDescriptor_2d655ab2f7683c23, []int{64}
 }
 func (m *PreInsertUkCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7394,7 +7529,7 @@ func (m *PreDeleteCtx) Reset()         { *m = PreDeleteCtx{} }
 func (m *PreDeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*PreDeleteCtx) ProtoMessage()    {}
 func (*PreDeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{64}
+	return fileDescriptor_2d655ab2f7683c23, []int{65}
 }
 func (m *PreDeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7446,7 +7581,7 @@ func (m *PreInsertCtx) Reset()         { *m = PreInsertCtx{} }
 func (m *PreInsertCtx) String() string { return proto.CompactTextString(m) }
 func (*PreInsertCtx) ProtoMessage()    {}
 func (*PreInsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{65}
+	return fileDescriptor_2d655ab2f7683c23, []int{66}
 }
 func (m *PreInsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7531,7 +7666,7 @@ func (m *RuntimeFilterSpec) Reset()         { *m = RuntimeFilterSpec{} }
 func (m *RuntimeFilterSpec) String() string { return proto.CompactTextString(m) }
 func (*RuntimeFilterSpec) ProtoMessage()    {}
 func (*RuntimeFilterSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{66}
+	return fileDescriptor_2d655ab2f7683c23, []int{67}
 }
 func (m *RuntimeFilterSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7599,7 +7734,7 @@ func (m *IdList) Reset()         { *m = IdList{} }
 func (m *IdList) String() string { return proto.CompactTextString(m) }
 func (*IdList) ProtoMessage()    {}
 func (*IdList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{67}
+	return fileDescriptor_2d655ab2f7683c23, []int{68}
 }
 func (m *IdList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7646,7 +7781,7 @@ func (m *ColPosMap) Reset()         { *m = ColPosMap{} }
 func (m *ColPosMap) String() string { return proto.CompactTextString(m) }
 func (*ColPosMap) ProtoMessage()    {}
 func (*ColPosMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{68}
+	return fileDescriptor_2d655ab2f7683c23, []int{69}
 }
 func (m *ColPosMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7704,7 +7839,7 @@ func (m *DeleteCtx) Reset()         { *m = DeleteCtx{} }
 func (m *DeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*DeleteCtx) ProtoMessage()    {}
 func (*DeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69}
+	return fileDescriptor_2d655ab2f7683c23, []int{70}
 }
 func (m *DeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7824,7 +7959,7 @@ func (m *PostDmlFullTextCtx) Reset()         { *m = PostDmlFullTextCtx{} }
 func (m *PostDmlFullTextCtx) String() string { return proto.CompactTextString(m) }
 func (*PostDmlFullTextCtx) ProtoMessage()    {}
 func (*PostDmlFullTextCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{70}
+	return fileDescriptor_2d655ab2f7683c23, []int{71}
 }
 func (m *PostDmlFullTextCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7899,7 +8034,7 @@ func (m *PostDmlCtx) Reset()         { *m = PostDmlCtx{} }
 func (m *PostDmlCtx) String() string { return proto.CompactTextString(m) }
 func (*PostDmlCtx) ProtoMessage()    {}
 func (*PostDmlCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{71}
+	return fileDescriptor_2d655ab2f7683c23, []int{72}
 }
 func (m *PostDmlCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8011,7 +8146,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{72}
+	return fileDescriptor_2d655ab2f7683c23, []int{73}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8114,7 +8249,7 @@ func (m *TransationControl) Reset()         { *m = TransationControl{} }
 func (m *TransationControl) String() string { return proto.CompactTextString(m) }
 func (*TransationControl) ProtoMessage()    {}
 func (*TransationControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{73}
+	return fileDescriptor_2d655ab2f7683c23, []int{74}
 }
 func (m *TransationControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8218,7 +8353,7 @@ func (m *TransationBegin) Reset()         { *m = TransationBegin{} }
 func (m *TransationBegin) String() string { return proto.CompactTextString(m) }
 func (*TransationBegin) ProtoMessage()    {}
 func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{74}
+	return fileDescriptor_2d655ab2f7683c23, []int{75}
 }
 func (m *TransationBegin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8265,7 +8400,7 @@ func (m *TransationCommit) Reset()         { *m = TransationCommit{} }
 func (m *TransationCommit) String() string { return proto.CompactTextString(m) }
 func (*TransationCommit) ProtoMessage()    {}
 func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{75}
+	return fileDescriptor_2d655ab2f7683c23, []int{76}
 }
 func (m *TransationCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8312,7 +8447,7 @@ func (m *TransationRollback) Reset()         { *m = TransationRollback{} }
 func (m *TransationRollback) String() string { return proto.CompactTextString(m) }
 func (*TransationRollback) ProtoMessage()    {}
 func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{76}
+	return fileDescriptor_2d655ab2f7683c23, []int{77}
 }
 func (m *TransationRollback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8367,7 +8502,7 @@ func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{77}
+	return fileDescriptor_2d655ab2f7683c23, []int{78}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8490,7 +8625,7 @@ func (m *Column) Reset()         { *m = Column{} }
 func (m *Column) String() string { return proto.CompactTextString(m) }
 func (*Column) ProtoMessage()    {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{78}
+	return fileDescriptor_2d655ab2f7683c23, []int{79}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8546,7 +8681,7 @@ func (m *DataControl) Reset()         { *m = DataControl{} }
 func (m *DataControl) String() string { return proto.CompactTextString(m) }
 func (*DataControl) ProtoMessage()    {}
 func (*DataControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{79}
+	return fileDescriptor_2d655ab2f7683c23, []int{80}
 }
 func (m *DataControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8701,7 +8836,7 @@ func (m *DataDefinition) Reset()         { *m = DataDefinition{} }
 func (m *DataDefinition) String() string { return proto.CompactTextString(m) }
 func (*DataDefinition) ProtoMessage()    {}
 func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{80}
+	return fileDescriptor_2d655ab2f7683c23, []int{81}
 }
 func (m *DataDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9005,7 +9140,7 @@ func (m *SubscriptionOption) Reset()         { *m = SubscriptionOption{} }
 func (m *SubscriptionOption) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionOption) ProtoMessage()    {}
 func (*SubscriptionOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{81}
+	return fileDescriptor_2d655ab2f7683c23, []int{82}
 }
 func (m *SubscriptionOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9062,7 +9197,7 @@ func (m *CreateDatabase) Reset()         { *m = CreateDatabase{} }
 func (m *CreateDatabase) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabase) ProtoMessage()    {}
 func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{82}
+	return fileDescriptor_2d655ab2f7683c23, []int{83}
 }
 func (m *CreateDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9131,7 +9266,7 @@ func (m *AlterDatabase) Reset()         { *m = AlterDatabase{} }
 func (m *AlterDatabase) String() string { return proto.CompactTextString(m) }
 func (*AlterDatabase) ProtoMessage()    {}
 func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{83}
+	return fileDescriptor_2d655ab2f7683c23, []int{84}
 }
 func (m *AlterDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9193,7 +9328,7 @@ func (m *DropDatabase) Reset()         { *m = DropDatabase{} }
 func (m *DropDatabase) String() string { return proto.CompactTextString(m) }
 func (*DropDatabase) ProtoMessage()    {}
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{84}
+	return fileDescriptor_2d655ab2f7683c23, []int{85}
 }
 func (m *DropDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9269,7 +9404,7 @@ func (m *FkColName) Reset()         { *m = FkColName{} }
 func (m *FkColName) String() string { return proto.CompactTextString(m) }
 func (*FkColName) ProtoMessage()    {}
 func (*FkColName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{85}
+	return fileDescriptor_2d655ab2f7683c23, []int{86}
 }
 func (m *FkColName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9335,7 +9470,7 @@ func (m *ForeignKeyInfo) Reset()         { *m = ForeignKeyInfo{} }
 func (m *ForeignKeyInfo) String() string { return proto.CompactTextString(m) }
 func (*ForeignKeyInfo) ProtoMessage()    {}
 func (*ForeignKeyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{86}
+	return fileDescriptor_2d655ab2f7683c23, []int{87}
 }
 func (m *ForeignKeyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9428,7 +9563,7 @@ func (m *CreateTable) Reset()         { *m = CreateTable{} }
 func (m *CreateTable) String() string { return proto.CompactTextString(m) }
 func (*CreateTable) ProtoMessage()    {}
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{87}
+	return fileDescriptor_2d655ab2f7683c23, []int{88}
 }
 func (m *CreateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9568,7 +9703,7 @@ func (m *AlterTableDrop) Reset()         { *m = AlterTableDrop{} }
 func (m *AlterTableDrop) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDrop) ProtoMessage()    {}
 func (*AlterTableDrop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{88}
+	return fileDescriptor_2d655ab2f7683c23, []int{89}
 }
 func (m *AlterTableDrop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9633,7 +9768,7 @@ func (m *AlterTableAddFk) Reset()         { *m = AlterTableAddFk{} }
 func (m *AlterTableAddFk) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddFk) ProtoMessage()    {}
 func (*AlterTableAddFk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{89}
+	return fileDescriptor_2d655ab2f7683c23, []int{90}
 }
 func (m *AlterTableAddFk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9705,7 +9840,7 @@ func (m *AlterTableAddIndex) Reset()         { *m = AlterTableAddIndex{} }
 func (m *AlterTableAddIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddIndex) ProtoMessage()    {}
 func (*AlterTableAddIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{90}
+	return fileDescriptor_2d655ab2f7683c23, []int{91}
 }
 func (m *AlterTableAddIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9783,7 +9918,7 @@ func (m *AlterTableDropIndex) Reset()         { *m = AlterTableDropIndex{} }
 func (m *AlterTableDropIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDropIndex) ProtoMessage()    {}
 func (*AlterTableDropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{91}
+	return fileDescriptor_2d655ab2f7683c23, []int{92}
 }
 func (m *AlterTableDropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9854,7 +9989,7 @@ func (m *AlterTableAlterIndex) Reset()         { *m = AlterTableAlterIndex{} }
 func (m *AlterTableAlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAlterIndex) ProtoMessage()    {}
 func (*AlterTableAlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{92}
+	return fileDescriptor_2d655ab2f7683c23, []int{93}
 }
 func (m *AlterTableAlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9911,6 +10046,61 @@ func (m *AlterTableAlterIndex) GetVisible() bool {
 	return false
 }
 
+type AlterTableAlterCheck struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Enforced             bool     `protobuf:"varint,2,opt,name=enforced,proto3" json:"enforced,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableAlterCheck) Reset()         { *m = AlterTableAlterCheck{} }
+func (m *AlterTableAlterCheck) String() string { return proto.CompactTextString(m) }
+func (*AlterTableAlterCheck) ProtoMessage()    {}
+func (*AlterTableAlterCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{94}
+}
+func (m *AlterTableAlterCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableAlterCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableAlterCheck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableAlterCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableAlterCheck.Merge(m, src)
+}
+func (m *AlterTableAlterCheck) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableAlterCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableAlterCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableAlterCheck proto.InternalMessageInfo
+
+func (m *AlterTableAlterCheck) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AlterTableAlterCheck) GetEnforced() bool {
+	if m != nil {
+		return m.Enforced
+	}
+	return false
+}
+
 type AlterTableAlterReIndex struct {
 	DbName               string   `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	TableName            string   `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
@@ -9925,7 +10115,7 @@ func (m *AlterTableAlterReIndex) Reset()         { *m = AlterTableAlterReIndex{}
 func (m *AlterTableAlterReIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAlterReIndex) ProtoMessage()    {}
 func (*AlterTableAlterReIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{93}
+	return fileDescriptor_2d655ab2f7683c23, []int{95}
 }
 func (m *AlterTableAlterReIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9995,7 +10185,7 @@ func (m *AlterTableAddPartition) Reset()         { *m = AlterTableAddPartition{}
 func (m *AlterTableAddPartition) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddPartition) ProtoMessage()    {}
 func (*AlterTableAddPartition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{94}
+	return fileDescriptor_2d655ab2f7683c23, []int{96}
 }
 func (m *AlterTableAddPartition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10056,7 +10246,7 @@ func (m *AlterTableComment) Reset()         { *m = AlterTableComment{} }
 func (m *AlterTableComment) String() string { return proto.CompactTextString(m) }
 func (*AlterTableComment) ProtoMessage()    {}
 func (*AlterTableComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{95}
+	return fileDescriptor_2d655ab2f7683c23, []int{97}
 }
 func (m *AlterTableComment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10104,7 +10294,7 @@ func (m *AlterTableName) Reset()         { *m = AlterTableName{} }
 func (m *AlterTableName) String() string { return proto.CompactTextString(m) }
 func (*AlterTableName) ProtoMessage()    {}
 func (*AlterTableName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{96}
+	return fileDescriptor_2d655ab2f7683c23, []int{98}
 }
 func (m *AlterTableName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10161,7 +10351,7 @@ func (m *AlterAddColumn) Reset()         { *m = AlterAddColumn{} }
 func (m *AlterAddColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterAddColumn) ProtoMessage()    {}
 func (*AlterAddColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{97}
+	return fileDescriptor_2d655ab2f7683c23, []int{99}
 }
 func (m *AlterAddColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10230,7 +10420,7 @@ func (m *AlterDropColumn) Reset()         { *m = AlterDropColumn{} }
 func (m *AlterDropColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterDropColumn) ProtoMessage()    {}
 func (*AlterDropColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{98}
+	return fileDescriptor_2d655ab2f7683c23, []int{100}
 }
 func (m *AlterDropColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10284,7 +10474,7 @@ func (m *RenameTable) Reset()         { *m = RenameTable{} }
 func (m *RenameTable) String() string { return proto.CompactTextString(m) }
 func (*RenameTable) ProtoMessage()    {}
 func (*RenameTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{99}
+	return fileDescriptor_2d655ab2f7683c23, []int{101}
 }
 func (m *RenameTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10344,7 +10534,7 @@ func (m *AlterTable) Reset()         { *m = AlterTable{} }
 func (m *AlterTable) String() string { return proto.CompactTextString(m) }
 func (*AlterTable) ProtoMessage()    {}
 func (*AlterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{100}
+	return fileDescriptor_2d655ab2f7683c23, []int{102}
 }
 func (m *AlterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10463,6 +10653,8 @@ type AlterTable_Action struct {
 	//	*AlterTable_Action_DropColumn
 	//	*AlterTable_Action_AlterReindex
 	//	*AlterTable_Action_AddPartition
+	//	*AlterTable_Action_AddCheck
+	//	*AlterTable_Action_AlterCheck
 	Action               isAlterTable_Action_Action `protobuf_oneof:"action"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
@@ -10473,7 +10665,7 @@ func (m *AlterTable_Action) Reset()         { *m = AlterTable_Action{} }
 func (m *AlterTable_Action) String() string { return proto.CompactTextString(m) }
 func (*AlterTable_Action) ProtoMessage()    {}
 func (*AlterTable_Action) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{100, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{102, 0}
 }
 func (m *AlterTable_Action) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10538,6 +10730,12 @@ type AlterTable_Action_AlterReindex struct {
 type AlterTable_Action_AddPartition struct {
 	AddPartition *AlterTableAddPartition `protobuf:"bytes,10,opt,name=addPartition,proto3,oneof" json:"addPartition,omitempty"`
 }
+type AlterTable_Action_AddCheck struct {
+	AddCheck *CheckDef `protobuf:"bytes,11,opt,name=add_check,json=addCheck,proto3,oneof" json:"add_check,omitempty"`
+}
+type AlterTable_Action_AlterCheck struct {
+	AlterCheck *AlterTableAlterCheck `protobuf:"bytes,12,opt,name=alter_check,json=alterCheck,proto3,oneof" json:"alter_check,omitempty"`
+}
 
 func (*AlterTable_Action_Drop) isAlterTable_Action_Action()         {}
 func (*AlterTable_Action_AddFk) isAlterTable_Action_Action()        {}
@@ -10549,6 +10747,8 @@ func (*AlterTable_Action_AddColumn) isAlterTable_Action_Action()    {}
 func (*AlterTable_Action_DropColumn) isAlterTable_Action_Action()   {}
 func (*AlterTable_Action_AlterReindex) isAlterTable_Action_Action() {}
 func (*AlterTable_Action_AddPartition) isAlterTable_Action_Action() {}
+func (*AlterTable_Action_AddCheck) isAlterTable_Action_Action()     {}
+func (*AlterTable_Action_AlterCheck) isAlterTable_Action_Action()   {}
 
 func (m *AlterTable_Action) GetAction() isAlterTable_Action_Action {
 	if m != nil {
@@ -10627,6 +10827,20 @@ func (m *AlterTable_Action) GetAddPartition() *AlterTableAddPartition {
 	return nil
 }
 
+func (m *AlterTable_Action) GetAddCheck() *CheckDef {
+	if x, ok := m.GetAction().(*AlterTable_Action_AddCheck); ok {
+		return x.AddCheck
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetAlterCheck() *AlterTableAlterCheck {
+	if x, ok := m.GetAction().(*AlterTable_Action_AlterCheck); ok {
+		return x.AlterCheck
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -10640,6 +10854,8 @@ func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 		(*AlterTable_Action_DropColumn)(nil),
 		(*AlterTable_Action_AlterReindex)(nil),
 		(*AlterTable_Action_AddPartition)(nil),
+		(*AlterTable_Action_AddCheck)(nil),
+		(*AlterTable_Action_AlterCheck)(nil),
 	}
 }
 
@@ -10668,7 +10884,7 @@ func (m *DropTable) Reset()         { *m = DropTable{} }
 func (m *DropTable) String() string { return proto.CompactTextString(m) }
 func (*DropTable) ProtoMessage()    {}
 func (*DropTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{101}
+	return fileDescriptor_2d655ab2f7683c23, []int{103}
 }
 func (m *DropTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10795,7 +11011,7 @@ func (m *CreateView) Reset()         { *m = CreateView{} }
 func (m *CreateView) String() string { return proto.CompactTextString(m) }
 func (*CreateView) ProtoMessage()    {}
 func (*CreateView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{102}
+	return fileDescriptor_2d655ab2f7683c23, []int{104}
 }
 func (m *CreateView) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10865,7 +11081,7 @@ func (m *AlterView) Reset()         { *m = AlterView{} }
 func (m *AlterView) String() string { return proto.CompactTextString(m) }
 func (*AlterView) ProtoMessage()    {}
 func (*AlterView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{103}
+	return fileDescriptor_2d655ab2f7683c23, []int{105}
 }
 func (m *AlterView) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10928,7 +11144,7 @@ func (m *CreateSequence) Reset()         { *m = CreateSequence{} }
 func (m *CreateSequence) String() string { return proto.CompactTextString(m) }
 func (*CreateSequence) ProtoMessage()    {}
 func (*CreateSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{104}
+	return fileDescriptor_2d655ab2f7683c23, []int{106}
 }
 func (m *CreateSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10992,7 +11208,7 @@ func (m *DropSequence) Reset()         { *m = DropSequence{} }
 func (m *DropSequence) String() string { return proto.CompactTextString(m) }
 func (*DropSequence) ProtoMessage()    {}
 func (*DropSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{105}
+	return fileDescriptor_2d655ab2f7683c23, []int{107}
 }
 func (m *DropSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11062,7 +11278,7 @@ func (m *AlterSequence) Reset()         { *m = AlterSequence{} }
 func (m *AlterSequence) String() string { return proto.CompactTextString(m) }
 func (*AlterSequence) ProtoMessage()    {}
 func (*AlterSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{106}
+	return fileDescriptor_2d655ab2f7683c23, []int{108}
 }
 func (m *AlterSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11128,7 +11344,7 @@ func (m *CreateIndex) Reset()         { *m = CreateIndex{} }
 func (m *CreateIndex) String() string { return proto.CompactTextString(m) }
 func (*CreateIndex) ProtoMessage()    {}
 func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{107}
+	return fileDescriptor_2d655ab2f7683c23, []int{109}
 }
 func (m *CreateIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11210,7 +11426,7 @@ func (m *AlterIndex) Reset()         { *m = AlterIndex{} }
 func (m *AlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterIndex) ProtoMessage()    {}
 func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{108}
+	return fileDescriptor_2d655ab2f7683c23, []int{110}
 }
 func (m *AlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11260,7 +11476,7 @@ func (m *DropIndex) Reset()         { *m = DropIndex{} }
 func (m *DropIndex) String() string { return proto.CompactTextString(m) }
 func (*DropIndex) ProtoMessage()    {}
 func (*DropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{109}
+	return fileDescriptor_2d655ab2f7683c23, []int{111}
 }
 func (m *DropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11335,7 +11551,7 @@ func (m *TruncateTable) Reset()         { *m = TruncateTable{} }
 func (m *TruncateTable) String() string { return proto.CompactTextString(m) }
 func (*TruncateTable) ProtoMessage()    {}
 func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{110}
+	return fileDescriptor_2d655ab2f7683c23, []int{112}
 }
 func (m *TruncateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11433,7 +11649,7 @@ func (m *ClusterTable) Reset()         { *m = ClusterTable{} }
 func (m *ClusterTable) String() string { return proto.CompactTextString(m) }
 func (*ClusterTable) ProtoMessage()    {}
 func (*ClusterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{111}
+	return fileDescriptor_2d655ab2f7683c23, []int{113}
 }
 func (m *ClusterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11495,7 +11711,7 @@ func (m *ShowVariables) Reset()         { *m = ShowVariables{} }
 func (m *ShowVariables) String() string { return proto.CompactTextString(m) }
 func (*ShowVariables) ProtoMessage()    {}
 func (*ShowVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{112}
+	return fileDescriptor_2d655ab2f7683c23, []int{114}
 }
 func (m *ShowVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11549,7 +11765,7 @@ func (m *SetVariables) Reset()         { *m = SetVariables{} }
 func (m *SetVariables) String() string { return proto.CompactTextString(m) }
 func (*SetVariables) ProtoMessage()    {}
 func (*SetVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{113}
+	return fileDescriptor_2d655ab2f7683c23, []int{115}
 }
 func (m *SetVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11600,7 +11816,7 @@ func (m *SetVariablesItem) Reset()         { *m = SetVariablesItem{} }
 func (m *SetVariablesItem) String() string { return proto.CompactTextString(m) }
 func (*SetVariablesItem) ProtoMessage()    {}
 func (*SetVariablesItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{114}
+	return fileDescriptor_2d655ab2f7683c23, []int{116}
 }
 func (m *SetVariablesItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11678,7 +11894,7 @@ func (m *Prepare) Reset()         { *m = Prepare{} }
 func (m *Prepare) String() string { return proto.CompactTextString(m) }
 func (*Prepare) ProtoMessage()    {}
 func (*Prepare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{115}
+	return fileDescriptor_2d655ab2f7683c23, []int{117}
 }
 func (m *Prepare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11747,7 +11963,7 @@ func (m *Execute) Reset()         { *m = Execute{} }
 func (m *Execute) String() string { return proto.CompactTextString(m) }
 func (*Execute) ProtoMessage()    {}
 func (*Execute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{116}
+	return fileDescriptor_2d655ab2f7683c23, []int{118}
 }
 func (m *Execute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11801,7 +12017,7 @@ func (m *Deallocate) Reset()         { *m = Deallocate{} }
 func (m *Deallocate) String() string { return proto.CompactTextString(m) }
 func (*Deallocate) ProtoMessage()    {}
 func (*Deallocate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{117}
+	return fileDescriptor_2d655ab2f7683c23, []int{119}
 }
 func (m *Deallocate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11848,7 +12064,7 @@ func (m *OtherDCL) Reset()         { *m = OtherDCL{} }
 func (m *OtherDCL) String() string { return proto.CompactTextString(m) }
 func (*OtherDCL) ProtoMessage()    {}
 func (*OtherDCL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{118}
+	return fileDescriptor_2d655ab2f7683c23, []int{120}
 }
 func (m *OtherDCL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11896,7 +12112,7 @@ func (m *TableLockInfo) Reset()         { *m = TableLockInfo{} }
 func (m *TableLockInfo) String() string { return proto.CompactTextString(m) }
 func (*TableLockInfo) ProtoMessage()    {}
 func (*TableLockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{119}
+	return fileDescriptor_2d655ab2f7683c23, []int{121}
 }
 func (m *TableLockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11950,7 +12166,7 @@ func (m *LockTables) Reset()         { *m = LockTables{} }
 func (m *LockTables) String() string { return proto.CompactTextString(m) }
 func (*LockTables) ProtoMessage()    {}
 func (*LockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{120}
+	return fileDescriptor_2d655ab2f7683c23, []int{122}
 }
 func (m *LockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11996,7 +12212,7 @@ func (m *UnLockTables) Reset()         { *m = UnLockTables{} }
 func (m *UnLockTables) String() string { return proto.CompactTextString(m) }
 func (*UnLockTables) ProtoMessage()    {}
 func (*UnLockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{121}
+	return fileDescriptor_2d655ab2f7683c23, []int{123}
 }
 func (m *UnLockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12036,7 +12252,7 @@ func (m *MetadataScanInfos) Reset()         { *m = MetadataScanInfos{} }
 func (m *MetadataScanInfos) String() string { return proto.CompactTextString(m) }
 func (*MetadataScanInfos) ProtoMessage()    {}
 func (*MetadataScanInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{122}
+	return fileDescriptor_2d655ab2f7683c23, []int{124}
 }
 func (m *MetadataScanInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12093,7 +12309,7 @@ func (m *MetadataScanInfo) Reset()         { *m = MetadataScanInfo{} }
 func (m *MetadataScanInfo) String() string { return proto.CompactTextString(m) }
 func (*MetadataScanInfo) ProtoMessage()    {}
 func (*MetadataScanInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{123}
+	return fileDescriptor_2d655ab2f7683c23, []int{125}
 }
 func (m *MetadataScanInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12251,6 +12467,7 @@ func init() {
 	proto.RegisterType((*Decimal128)(nil), "plan.decimal128")
 	proto.RegisterType((*ResultColDef)(nil), "plan.ResultColDef")
 	proto.RegisterType((*ColDef)(nil), "plan.ColDef")
+	proto.RegisterType((*GeneratedColDef)(nil), "plan.GeneratedColDef")
 	proto.RegisterType((*Default)(nil), "plan.Default")
 	proto.RegisterType((*OnUpdate)(nil), "plan.OnUpdate")
 	proto.RegisterType((*IndexOption)(nil), "plan.IndexOption")
@@ -12326,6 +12543,7 @@ func init() {
 	proto.RegisterType((*AlterTableAddIndex)(nil), "plan.AlterTableAddIndex")
 	proto.RegisterType((*AlterTableDropIndex)(nil), "plan.AlterTableDropIndex")
 	proto.RegisterType((*AlterTableAlterIndex)(nil), "plan.AlterTableAlterIndex")
+	proto.RegisterType((*AlterTableAlterCheck)(nil), "plan.AlterTableAlterCheck")
 	proto.RegisterType((*AlterTableAlterReIndex)(nil), "plan.AlterTableAlterReIndex")
 	proto.RegisterType((*AlterTableAddPartition)(nil), "plan.AlterTableAddPartition")
 	proto.RegisterType((*AlterTableComment)(nil), "plan.AlterTableComment")
@@ -12364,736 +12582,739 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 11657 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0xbd, 0x4d, 0x8c, 0x23, 0xd7,
-	0x76, 0x18, 0xdc, 0x6c, 0xfe, 0x1f, 0xb2, 0x39, 0xd5, 0x77, 0xfe, 0x38, 0xa3, 0xd1, 0x4c, 0x4f,
-	0x69, 0x24, 0x8d, 0x46, 0xd2, 0x48, 0x9a, 0xd1, 0xcf, 0x48, 0xdf, 0x7b, 0x7e, 0x62, 0x93, 0xec,
-	0x69, 0x6a, 0xd8, 0x64, 0xbf, 0x22, 0x7b, 0x46, 0x7a, 0xc6, 0xf7, 0x15, 0x8a, 0xac, 0x62, 0x77,
-	0xa9, 0x8b, 0x55, 0x54, 0x55, 0x71, 0xba, 0x5b, 0x80, 0x81, 0xf7, 0x7d, 0x06, 0xfc, 0xc5, 0xd9,
-	0x06, 0xf0, 0x2a, 0x0e, 0x9e, 0xbd, 0x0a, 0x8c, 0x78, 0x15, 0x03, 0x36, 0x82, 0xec, 0x92, 0x85,
-	0x63, 0x04, 0x41, 0x80, 0x2c, 0x82, 0x24, 0x80, 0x13, 0xbc, 0x2c, 0xbc, 0x4a, 0xbc, 0x70, 0x36,
-	0x01, 0xb2, 0x08, 0xce, 0xb9, 0xb7, 0xaa, 0x6e, 0x91, 0x6c, 0x8d, 0x46, 0xef, 0x19, 0x49, 0x36,
-	0xdd, 0x75, 0xcf, 0xcf, 0xfd, 0xbf, 0xe7, 0x9e, 0x73, 0xee, 0xb9, 0x97, 0x00, 0x33, 0xc7, 0x70,
-	0xef, 0xcf, 0x7c, 0x2f, 0xf4, 0x58, 0x0e, 0xbf, 0xaf, 0xbf, 0x7b, 0x68, 0x87, 0x47, 0xf3, 0xd1,
-	0xfd, 0xb1, 0x37, 0x7d, 0xef, 0xd0, 0x3b, 0xf4, 0xde, 0x23, 0xe4, 0x68, 0x3e, 0xa1, 0x14, 0x25,
-	0xe8, 0x8b, 0x33, 0x5d, 0x07, 0xc7, 0x1b, 0x1f, 0x8b, 0xef, 0x0b, 0xa1, 0x3d, 0xb5, 0x82, 0xd0,
-	0x98, 0xce, 0x38, 0x40, 0xfd, 0xd3, 0x0c, 0xe4, 0x86, 0x67, 0x33, 0x8b, 0xd5, 0x60, 0xdd, 0x36,
-	0xeb, 0x99, 0xad, 0xcc, 0xdd, 0xbc, 0xb6, 0x6e, 0x9b, 0x6c, 0x0b, 0x2a, 0xae, 0x17, 0xf6, 0xe6,
-	0x8e, 0x63, 0x8c, 0x1c, 0xab, 0xbe, 0xbe, 0x95, 0xb9, 0x5b, 0xd2, 0x64, 0x10, 0x7b, 0x05, 0xca,
-	0xc6, 0x3c, 0xf4, 0x74, 0xdb, 0x1d, 0xfb, 0xf5, 0x2c, 0xe1, 0x4b, 0x08, 0xe8, 0xb8, 0x63, 0x9f,
-	0x5d, 0x82, 0xfc, 0x89, 0x6d, 0x86, 0x47, 0xf5, 0x1c, 0xe5, 0xc8, 0x13, 0x08, 0x0d, 0xc6, 0x86,
-	0x63, 0xd5, 0xf3, 0x1c, 0x4a, 0x09, 0x84, 0x86, 0x54, 0x48, 0x61, 0x2b, 0x73, 0xb7, 0xac, 0xf1,
-	0x04, 0xbb, 0x09, 0x60, 0xb9, 0xf3, 0xe9, 0x73, 0xc3, 0x99, 0x5b, 0x41, 0xbd, 0x48, 0x28, 0x09,
-	0xa2, 0xfe, 0x04, 0xca, 0xd3, 0xe0, 0x70, 0xd7, 0x32, 0x4c, 0xcb, 0x67, 0x57, 0xa1, 0x38, 0x0d,
-	0x0e, 0xf5, 0xd0, 0x38, 0x14, 0x4d, 0x28, 0x4c, 0x83, 0xc3, 0xa1, 0x71, 0xc8, 0xae, 0x41, 0x89,
-	0x10, 0x67, 0x33, 0xde, 0x86, 0xbc, 0x86, 0x84, 0xd8, 0x62, 0xf5, 0xaf, 0xf3, 0x50, 0xec, 0xda,
-	0xa1, 0xe5, 0x1b, 0x0e, 0xbb, 0x02, 0x05, 0x3b, 0x70, 0xe7, 0x8e, 0x43, 0xec, 0x25, 0x4d, 0xa4,
-	0xd8, 0x15, 0xc8, 0xdb, 0x8f, 0x9e, 0x1b, 0x0e, 0xe7, 0xdd, 0x5d, 0xd3, 0x78, 0x92, 0xd5, 0xa1,
-	0x60, 0x7f, 0xf0, 0x31, 0x22, 0xb2, 0x02, 0x21, 0xd2, 0x84, 0x79, 0xf8, 0x00, 0x31, 0xb9, 0x18,
-	0x43, 0x69, 0xc2, 0x7c, 0xfc, 0x21, 0x62, 0xb0, 0xf5, 0x59, 0xc2, 0x50, 0x1a, 0x4b, 0x99, 0x53,
-	0x29, 0xd8, 0x01, 0x1b, 0x58, 0xca, 0x3c, 0x2a, 0x65, 0xce, 0x4b, 0x29, 0x0a, 0x84, 0x48, 0x13,
-	0x86, 0x97, 0x52, 0x8a, 0x31, 0x71, 0x29, 0x73, 0x5e, 0x4a, 0x79, 0x2b, 0x73, 0x37, 0x47, 0x18,
-	0x5e, 0xca, 0x25, 0xc8, 0x99, 0x08, 0x87, 0xad, 0xcc, 0xdd, 0xcc, 0xee, 0x9a, 0x46, 0x29, 0x84,
-	0x06, 0x08, 0xad, 0x60, 0x07, 0x23, 0x34, 0x10, 0xd0, 0x11, 0x42, 0xab, 0xd8, 0x1b, 0x08, 0x1d,
-	0x09, 0xe8, 0x04, 0xa1, 0x1b, 0x5b, 0x99, 0xbb, 0xeb, 0x08, 0xc5, 0x14, 0xbb, 0x0e, 0x45, 0xd3,
-	0x08, 0x2d, 0x44, 0xd4, 0x44, 0x93, 0x23, 0x00, 0xe2, 0x70, 0xc6, 0x21, 0xee, 0x82, 0x68, 0x74,
-	0x04, 0x60, 0x2a, 0x54, 0x90, 0x2c, 0xc2, 0x2b, 0x02, 0x2f, 0x03, 0xd9, 0x47, 0x50, 0x35, 0xad,
-	0xb1, 0x3d, 0x35, 0x1c, 0xde, 0xa6, 0xcd, 0xad, 0xcc, 0xdd, 0xca, 0x83, 0x0b, 0xf7, 0x69, 0x4d,
-	0xc4, 0x98, 0xdd, 0x35, 0x2d, 0x45, 0xc6, 0x1e, 0xc1, 0x86, 0x48, 0x7f, 0xf0, 0x80, 0x3a, 0x96,
-	0x11, 0x9f, 0x92, 0xe2, 0xfb, 0xe0, 0xc1, 0xa3, 0xdd, 0x35, 0x2d, 0x4d, 0xc8, 0xee, 0x40, 0x35,
-	0x5e, 0x22, 0xc8, 0x78, 0x51, 0xd4, 0x2a, 0x05, 0xc5, 0x66, 0x7d, 0x1d, 0x78, 0x2e, 0x12, 0x5c,
-	0x12, 0xfd, 0x16, 0x01, 0xd8, 0x16, 0x80, 0x69, 0x4d, 0x8c, 0xb9, 0x13, 0x22, 0xfa, 0xb2, 0xe8,
-	0x40, 0x09, 0xc6, 0x6e, 0x42, 0x79, 0x3e, 0xc3, 0x56, 0x3e, 0x35, 0x9c, 0xfa, 0x15, 0x41, 0x90,
-	0x80, 0x30, 0x77, 0x9c, 0xe7, 0x88, 0xbd, 0x2a, 0x46, 0x37, 0x02, 0xe0, 0x5a, 0xb1, 0x83, 0x6d,
-	0xdb, 0xad, 0xd7, 0x69, 0x9e, 0xf2, 0x04, 0xbb, 0x01, 0xd9, 0xc0, 0x1f, 0xd7, 0xaf, 0x51, 0x2b,
-	0x81, 0xb7, 0xb2, 0x7d, 0x3a, 0xf3, 0x35, 0x04, 0x6f, 0x17, 0x21, 0x4f, 0x6b, 0x46, 0xbd, 0x01,
-	0xa5, 0x7d, 0xc3, 0x37, 0xa6, 0x9a, 0x35, 0x61, 0x0a, 0x64, 0x67, 0x5e, 0x20, 0x56, 0x0b, 0x7e,
-	0xaa, 0x5d, 0x28, 0x3c, 0x35, 0x7c, 0xc4, 0x31, 0xc8, 0xb9, 0xc6, 0xd4, 0x22, 0x64, 0x59, 0xa3,
-	0x6f, 0x5c, 0x21, 0xc1, 0x59, 0x10, 0x5a, 0x53, 0x21, 0x0a, 0x44, 0x0a, 0xe1, 0x87, 0x8e, 0x37,
-	0x12, 0x2b, 0xa1, 0xa4, 0x89, 0x94, 0xfa, 0xff, 0x65, 0xa0, 0xd0, 0xf4, 0x1c, 0xcc, 0xee, 0x2a,
-	0x14, 0x7d, 0xcb, 0xd1, 0x93, 0xe2, 0x0a, 0xbe, 0xe5, 0xec, 0x7b, 0x01, 0x22, 0xc6, 0x1e, 0x47,
-	0xf0, 0xb5, 0x59, 0x18, 0x7b, 0x84, 0x88, 0x2a, 0x90, 0x95, 0x2a, 0x70, 0x0d, 0x4a, 0xe1, 0xc8,
-	0xd1, 0x09, 0x9e, 0x23, 0x78, 0x31, 0x1c, 0x39, 0x3d, 0x44, 0x5d, 0x85, 0xa2, 0x39, 0xe2, 0x98,
-	0x3c, 0x61, 0x0a, 0xe6, 0x08, 0x11, 0xea, 0xa7, 0x50, 0xd6, 0x8c, 0x13, 0x51, 0x8d, 0xcb, 0x50,
-	0xc0, 0x0c, 0x84, 0x94, 0xcb, 0x69, 0xf9, 0x70, 0xe4, 0x74, 0x4c, 0x04, 0x63, 0x25, 0x6c, 0x93,
-	0xea, 0x90, 0xd3, 0xf2, 0x63, 0xcf, 0xe9, 0x98, 0xea, 0x10, 0xa0, 0xe9, 0xf9, 0xfe, 0x0f, 0x6e,
-	0xc2, 0x25, 0xc8, 0x9b, 0xd6, 0x2c, 0x3c, 0xe2, 0x02, 0x42, 0xe3, 0x09, 0xf5, 0x1e, 0x94, 0x70,
-	0x5c, 0xba, 0x76, 0x10, 0xb2, 0x9b, 0x90, 0x73, 0xec, 0x20, 0xac, 0x67, 0xb6, 0xb2, 0x0b, 0xa3,
-	0x46, 0x70, 0x75, 0x0b, 0x4a, 0x7b, 0xc6, 0xe9, 0x53, 0x1c, 0x39, 0xcc, 0x8d, 0x86, 0x50, 0x0c,
-	0x89, 0x18, 0xcf, 0x2a, 0xc0, 0xd0, 0xf0, 0x0f, 0xad, 0x90, 0xe4, 0xd9, 0xdf, 0x64, 0xa0, 0x32,
-	0x98, 0x8f, 0xbe, 0x99, 0x5b, 0xfe, 0x19, 0xd6, 0xf9, 0x2e, 0x64, 0xc3, 0xb3, 0x19, 0x71, 0xd4,
-	0x1e, 0x5c, 0xe1, 0xd9, 0x4b, 0xf8, 0xfb, 0xc8, 0xa4, 0x21, 0x09, 0x36, 0xc2, 0xf5, 0x4c, 0x2b,
-	0xea, 0x83, 0xbc, 0x56, 0xc0, 0x64, 0xc7, 0xc4, 0x4d, 0xc1, 0x9b, 0x89, 0x51, 0x58, 0xf7, 0x66,
-	0x6c, 0x0b, 0xf2, 0xe3, 0x23, 0xdb, 0x31, 0x69, 0x00, 0xd2, 0x75, 0xe6, 0x08, 0x1c, 0x25, 0xdf,
-	0x3b, 0xd1, 0x03, 0xfb, 0xdb, 0x48, 0xc8, 0x17, 0x7d, 0xef, 0x64, 0x60, 0x7f, 0x6b, 0xa9, 0x43,
-	0xb1, 0xd3, 0x00, 0x14, 0x06, 0xcd, 0x46, 0xb7, 0xa1, 0x29, 0x6b, 0xf8, 0xdd, 0xfe, 0xb2, 0x33,
-	0x18, 0x0e, 0x94, 0x0c, 0xab, 0x01, 0xf4, 0xfa, 0x43, 0x5d, 0xa4, 0xd7, 0x59, 0x01, 0xd6, 0x3b,
-	0x3d, 0x25, 0x8b, 0x34, 0x08, 0xef, 0xf4, 0x94, 0x1c, 0x2b, 0x42, 0xb6, 0xd1, 0xfb, 0x4a, 0xc9,
-	0xd3, 0x47, 0xb7, 0xab, 0x14, 0xd4, 0x3f, 0x5a, 0x87, 0x72, 0x7f, 0xf4, 0xb5, 0x35, 0x0e, 0xb1,
-	0xcd, 0x38, 0x4b, 0x2d, 0xff, 0xb9, 0xe5, 0x53, 0xb3, 0xb3, 0x9a, 0x48, 0x61, 0x43, 0xcc, 0x11,
-	0x35, 0x2e, 0xab, 0xad, 0x9b, 0x23, 0xa2, 0x1b, 0x1f, 0x59, 0x53, 0x83, 0x1a, 0x87, 0x74, 0x94,
-	0xc2, 0x55, 0xe1, 0x8d, 0xbe, 0xa6, 0xe6, 0x65, 0x35, 0xfc, 0x64, 0xb7, 0xa0, 0xc2, 0xf3, 0x90,
-	0xe7, 0x17, 0x70, 0xd0, 0xe2, 0xe4, 0x2b, 0xc8, 0x93, 0x8f, 0x38, 0x29, 0x57, 0x8e, 0x14, 0x3b,
-	0x18, 0x07, 0xf5, 0xc4, 0x8c, 0xf6, 0x46, 0x5f, 0x73, 0x6c, 0x89, 0xcf, 0x68, 0x6f, 0xf4, 0x35,
-	0xa1, 0xde, 0x86, 0xcd, 0x60, 0x3e, 0x0a, 0xc6, 0xbe, 0x3d, 0x0b, 0x6d, 0xcf, 0xe5, 0x34, 0x65,
-	0xa2, 0x51, 0x64, 0x04, 0x11, 0xdf, 0x85, 0xd2, 0x6c, 0x3e, 0xd2, 0x6d, 0x77, 0xe2, 0x91, 0x70,
-	0xaf, 0x3c, 0xd8, 0xe0, 0x03, 0xb3, 0x3f, 0x1f, 0x75, 0xdc, 0x89, 0xa7, 0x15, 0x67, 0xfc, 0x43,
-	0x7d, 0x03, 0x8a, 0x02, 0x86, 0xbb, 0x77, 0x68, 0xb9, 0x86, 0x1b, 0xea, 0xf1, 0xb6, 0x5f, 0xe2,
-	0x80, 0x8e, 0xa9, 0xfe, 0x49, 0x06, 0x94, 0x81, 0x54, 0xcc, 0x9e, 0x15, 0x1a, 0x2b, 0xa5, 0xc2,
-	0xab, 0x00, 0xc6, 0x78, 0xec, 0xcd, 0x79, 0x36, 0x7c, 0xf2, 0x94, 0x05, 0xa4, 0x63, 0xca, 0x7d,
-	0x93, 0x4d, 0xf5, 0xcd, 0x6d, 0xa8, 0x46, 0x7c, 0xd2, 0x82, 0xae, 0x08, 0x58, 0xd4, 0x3b, 0xc1,
-	0x3c, 0xb5, 0xaa, 0x8b, 0xc1, 0x9c, 0x73, 0x5f, 0x81, 0x02, 0xe9, 0x08, 0x41, 0xd4, 0xe3, 0x3c,
-	0xa5, 0xfe, 0xdd, 0x75, 0x28, 0xed, 0xcc, 0xdd, 0x31, 0x56, 0x99, 0xbd, 0x06, 0xb9, 0xc9, 0xdc,
-	0x1d, 0x53, 0x75, 0xe3, 0x2d, 0x23, 0x9e, 0x29, 0x1a, 0x21, 0x71, 0x0d, 0x1a, 0xfe, 0x21, 0xae,
-	0xdd, 0xa5, 0x35, 0x88, 0x70, 0xf5, 0xcf, 0x32, 0x3c, 0xc7, 0x1d, 0xc7, 0x38, 0x64, 0x25, 0xc8,
-	0xf5, 0xfa, 0xbd, 0xb6, 0xb2, 0xc6, 0xaa, 0x50, 0xea, 0xf4, 0x86, 0x6d, 0xad, 0xd7, 0xe8, 0x2a,
-	0x19, 0x9a, 0xd0, 0xc3, 0xc6, 0x76, 0xb7, 0xad, 0xac, 0x23, 0xe6, 0x69, 0xbf, 0xdb, 0x18, 0x76,
-	0xba, 0x6d, 0x25, 0xc7, 0x31, 0x5a, 0xa7, 0x39, 0x54, 0x4a, 0x4c, 0x81, 0xea, 0xbe, 0xd6, 0x6f,
-	0x1d, 0x34, 0xdb, 0x7a, 0xef, 0xa0, 0xdb, 0x55, 0x14, 0x76, 0x11, 0x2e, 0xc4, 0x90, 0x3e, 0x07,
-	0x6e, 0x21, 0xcb, 0xd3, 0x86, 0xd6, 0xd0, 0x1e, 0x2b, 0x9f, 0xb3, 0x12, 0x64, 0x1b, 0x8f, 0x1f,
-	0x2b, 0x3f, 0xc7, 0xb5, 0x51, 0x7e, 0xd6, 0xe9, 0xe9, 0x4f, 0x1b, 0xdd, 0x83, 0xb6, 0xf2, 0xf3,
-	0xf5, 0x28, 0xdd, 0xd7, 0x5a, 0x6d, 0x4d, 0xf9, 0x79, 0x8e, 0x6d, 0x42, 0xf5, 0x67, 0xfd, 0x5e,
-	0x7b, 0xaf, 0xb1, 0xbf, 0x4f, 0x15, 0xf9, 0x79, 0x49, 0xfd, 0x2f, 0x39, 0xc8, 0x61, 0x4b, 0x98,
-	0x9a, 0xc8, 0x81, 0xb8, 0x89, 0xb8, 0x10, 0xb7, 0x73, 0x7f, 0xfe, 0x97, 0xb7, 0xd6, 0xb8, 0x04,
-	0xb8, 0x0d, 0x59, 0xc7, 0x0e, 0x69, 0x00, 0xe3, 0xd9, 0x23, 0x74, 0xa3, 0xdd, 0x35, 0x0d, 0x71,
-	0xec, 0x26, 0x64, 0xb8, 0x28, 0xa8, 0x3c, 0xa8, 0x89, 0xe9, 0x25, 0xf6, 0x92, 0xdd, 0x35, 0x2d,
-	0x33, 0x63, 0x37, 0x20, 0xf3, 0x5c, 0xc8, 0x85, 0x2a, 0xc7, 0xf3, 0xdd, 0x04, 0xb1, 0xcf, 0xd9,
-	0x16, 0x64, 0xc7, 0x1e, 0xd7, 0x7c, 0x62, 0x3c, 0x97, 0xad, 0x98, 0xff, 0xd8, 0x73, 0xd8, 0x6b,
-	0x90, 0xf5, 0x8d, 0x13, 0x1a, 0xd1, 0x78, 0xb8, 0x62, 0xe1, 0x8d, 0x44, 0xbe, 0x71, 0x82, 0x95,
-	0x98, 0xd0, 0x4a, 0x8a, 0x2b, 0x11, 0x8d, 0x37, 0x16, 0x33, 0x61, 0x5b, 0x90, 0x39, 0xa1, 0xb5,
-	0x14, 0x6f, 0xf6, 0xcf, 0x6c, 0xd7, 0xf4, 0x4e, 0x06, 0x33, 0x6b, 0x8c, 0x14, 0x27, 0xec, 0x75,
-	0xc8, 0x06, 0xf3, 0x11, 0xad, 0xa5, 0xca, 0x83, 0xcd, 0x25, 0xa9, 0x88, 0x05, 0x05, 0xf3, 0x11,
-	0x7b, 0x03, 0x72, 0x63, 0xcf, 0xf7, 0xc5, 0x7a, 0x52, 0xa2, 0x0a, 0x47, 0x1b, 0x02, 0x2a, 0x3f,
-	0x88, 0xc7, 0x02, 0x43, 0xd2, 0x9d, 0x62, 0xa2, 0x44, 0x22, 0x63, 0x81, 0x21, 0xbb, 0x23, 0xc4,
-	0x7c, 0x55, 0xae, 0x75, 0xb4, 0x09, 0x60, 0x3e, 0x88, 0xc5, 0x41, 0x9a, 0x1a, 0xa7, 0xa4, 0x59,
-	0xc5, 0x44, 0x91, 0xf4, 0xc7, 0x3a, 0x4d, 0x8d, 0x53, 0x76, 0x07, 0xb2, 0xcf, 0xad, 0x31, 0x29,
-	0x59, 0x71, 0x69, 0x62, 0x90, 0x9e, 0x52, 0xf3, 0x10, 0x4d, 0xf3, 0xde, 0x73, 0x4c, 0xd2, 0xb7,
-	0xe2, 0xb1, 0xdc, 0xf1, 0x1c, 0xf3, 0x29, 0x8d, 0x25, 0x21, 0x71, 0xd3, 0x33, 0xe6, 0xa7, 0xb8,
-	0x66, 0x15, 0xbe, 0x3d, 0x19, 0xf3, 0xd3, 0x8e, 0x89, 0xe2, 0xcf, 0x35, 0x9f, 0x93, 0x96, 0x95,
-	0xd1, 0xf0, 0x13, 0xcd, 0x80, 0xc0, 0x72, 0xac, 0x71, 0x68, 0x3f, 0xb7, 0xc3, 0x33, 0xd2, 0xa3,
-	0x32, 0x9a, 0x0c, 0xda, 0x2e, 0x40, 0xce, 0x3a, 0x9d, 0xf9, 0xea, 0x2e, 0x14, 0x45, 0x29, 0x4b,
-	0xb6, 0xc4, 0x35, 0x28, 0xd9, 0x81, 0x3e, 0xf6, 0xdc, 0x20, 0x14, 0xda, 0x43, 0xd1, 0x0e, 0x9a,
-	0x98, 0x44, 0xa1, 0x62, 0x1a, 0x21, 0x17, 0xc3, 0x55, 0x8d, 0xbe, 0xd5, 0x07, 0x00, 0x49, 0xb3,
-	0xb0, 0x4e, 0x8e, 0xe5, 0x46, 0x8a, 0x8a, 0x63, 0xb9, 0x31, 0xcf, 0xba, 0xc4, 0x73, 0x0d, 0xca,
-	0xb1, 0x06, 0xc8, 0xaa, 0x90, 0x31, 0xc4, 0x06, 0x90, 0x31, 0xd4, 0xbb, 0xa8, 0x90, 0x45, 0x3a,
-	0x5e, 0x1a, 0x87, 0xa9, 0x68, 0x5b, 0xc8, 0x8c, 0xd4, 0x1f, 0x41, 0x55, 0xb3, 0x82, 0xb9, 0x13,
-	0x36, 0x3d, 0xa7, 0x65, 0x4d, 0xd8, 0x3b, 0x00, 0x71, 0x3a, 0x10, 0xfb, 0x74, 0x32, 0x77, 0x5b,
-	0xd6, 0x44, 0x93, 0xf0, 0xea, 0x3f, 0xcc, 0x91, 0xc6, 0xd3, 0xe2, 0xaa, 0x86, 0xd0, 0x29, 0x32,
-	0x92, 0x4e, 0x11, 0x4b, 0xd0, 0xf5, 0xb4, 0x5e, 0x75, 0x64, 0x9b, 0xa6, 0xe5, 0x46, 0xfa, 0x13,
-	0x4f, 0xe1, 0x60, 0x1b, 0xce, 0x21, 0x2d, 0xa8, 0xda, 0x03, 0x16, 0x15, 0x3a, 0x9d, 0xf9, 0x56,
-	0x10, 0xf0, 0x9d, 0xdb, 0x70, 0x0e, 0xa3, 0xb5, 0x9d, 0xff, 0xae, 0xb5, 0x7d, 0x0d, 0x4a, 0xae,
-	0x17, 0xea, 0x64, 0xdd, 0x14, 0x78, 0xef, 0x0b, 0x33, 0x8e, 0xbd, 0x09, 0x45, 0xa1, 0x97, 0x8a,
-	0x45, 0x25, 0xa6, 0x4b, 0x8b, 0x03, 0xb5, 0x08, 0xcb, 0xea, 0xa8, 0xe6, 0x4c, 0xa7, 0x96, 0x1b,
-	0x46, 0x3b, 0x95, 0x48, 0xb2, 0xb7, 0xa1, 0xec, 0xb9, 0x3a, 0x57, 0x5e, 0xc5, 0xaa, 0x12, 0xd3,
-	0xb7, 0xef, 0x1e, 0x10, 0x54, 0x2b, 0x79, 0xe2, 0x0b, 0xab, 0xe2, 0x78, 0x27, 0xfa, 0xd8, 0xf0,
-	0x4d, 0x5a, 0x59, 0x25, 0xad, 0xe8, 0x78, 0x27, 0x4d, 0xc3, 0x37, 0xf9, 0xce, 0xfd, 0x8d, 0x3b,
-	0x9f, 0xd2, 0x6a, 0xda, 0xd0, 0x44, 0x8a, 0xdd, 0x80, 0xf2, 0xd8, 0x99, 0x07, 0xa1, 0xe5, 0x6f,
-	0x9f, 0x71, 0x73, 0x44, 0x4b, 0x00, 0x58, 0xaf, 0x99, 0x6f, 0x4f, 0x0d, 0xff, 0x8c, 0x96, 0x4e,
-	0x49, 0x8b, 0x92, 0xa8, 0x31, 0xcd, 0x8e, 0x6d, 0xf3, 0x94, 0xdb, 0x24, 0x1a, 0x4f, 0x20, 0xfd,
-	0x11, 0x59, 0x8c, 0x01, 0xad, 0x8f, 0x92, 0x16, 0x25, 0x69, 0x1c, 0xe8, 0x93, 0x56, 0x44, 0x59,
-	0x13, 0xa9, 0x94, 0xda, 0xb9, 0x79, 0xae, 0xda, 0xc9, 0x16, 0x77, 0x7e, 0xcf, 0xb7, 0x0f, 0x6d,
-	0xb1, 0x6f, 0x5f, 0xe4, 0x3b, 0x3f, 0x07, 0x91, 0x5e, 0xfa, 0x0d, 0x14, 0x45, 0x17, 0xe3, 0x0e,
-	0x84, 0xcb, 0x27, 0x2d, 0x9e, 0xf9, 0x0e, 0x84, 0x70, 0xf6, 0x1a, 0x6c, 0x88, 0xbc, 0x82, 0xd0,
-	0xb7, 0xdd, 0x43, 0x31, 0x79, 0xaa, 0x1c, 0x38, 0x20, 0x18, 0x6e, 0xa7, 0x38, 0xbc, 0xba, 0x31,
-	0xb2, 0x1d, 0x5c, 0xa6, 0x59, 0x61, 0xad, 0xcf, 0x1d, 0xa7, 0xc1, 0x41, 0x6a, 0x1f, 0x4a, 0xd1,
-	0x80, 0xfc, 0x5a, 0xca, 0x54, 0x7f, 0x27, 0x03, 0x95, 0x8e, 0x6b, 0x5a, 0xa7, 0x7d, 0x52, 0x11,
-	0xd8, 0x3b, 0xc0, 0xc6, 0xbe, 0x65, 0x84, 0x96, 0x6e, 0x9d, 0x86, 0xbe, 0xa1, 0x73, 0x93, 0x9e,
-	0x9b, 0xd3, 0x0a, 0xc7, 0xb4, 0x11, 0x31, 0x24, 0xeb, 0xfe, 0x16, 0x54, 0x66, 0x86, 0x1f, 0x44,
-	0x6a, 0x15, 0x2f, 0x00, 0x38, 0x48, 0x28, 0x35, 0x8a, 0x7b, 0xe8, 0x1b, 0x53, 0x3d, 0xf4, 0x8e,
-	0x2d, 0x97, 0x2b, 0x94, 0x5c, 0x95, 0xae, 0x11, 0x7c, 0x88, 0x60, 0xd2, 0x2b, 0xff, 0x7d, 0x06,
-	0x36, 0xf6, 0xf9, 0xa8, 0x3f, 0xb1, 0xce, 0x5a, 0xdc, 0x7e, 0x19, 0x47, 0x2b, 0x36, 0xa7, 0xd1,
-	0x37, 0xbb, 0x09, 0x95, 0xd9, 0xb1, 0x75, 0xa6, 0xa7, 0x74, 0xfd, 0x32, 0x82, 0x9a, 0xb4, 0x36,
-	0xdf, 0x82, 0x82, 0x47, 0x0d, 0x11, 0x7b, 0x9c, 0xd8, 0x1a, 0xa4, 0x16, 0x6a, 0x82, 0x80, 0xa9,
-	0xb0, 0x11, 0x67, 0x25, 0x6b, 0x2f, 0x22, 0x33, 0xaa, 0xfe, 0x25, 0xc8, 0x23, 0x2a, 0xa8, 0xe7,
-	0xb7, 0xb2, 0xa8, 0xb0, 0x53, 0x82, 0xbd, 0x0f, 0x1b, 0x63, 0x6f, 0x3a, 0xd3, 0x23, 0x76, 0xb1,
-	0xdb, 0xa5, 0x65, 0x4a, 0x05, 0x49, 0xf6, 0x79, 0x5e, 0xea, 0xef, 0x65, 0xa1, 0x44, 0x75, 0x10,
-	0x62, 0xc5, 0x36, 0x4f, 0x23, 0xb1, 0x52, 0xd6, 0xf2, 0xb6, 0x89, 0x52, 0xfb, 0x55, 0x00, 0x1b,
-	0x49, 0xe4, 0xae, 0x2c, 0x13, 0x24, 0xaa, 0xca, 0xcc, 0xf0, 0xc3, 0xa0, 0x9e, 0xe5, 0x55, 0xa1,
-	0x04, 0xce, 0xf7, 0xb9, 0x6b, 0x7f, 0x33, 0xe7, 0xb5, 0x2f, 0x69, 0x22, 0x85, 0xfd, 0xce, 0x33,
-	0xa3, 0xf1, 0x93, 0xd5, 0xaf, 0x1a, 0xc1, 0x69, 0xf8, 0xa2, 0x59, 0xce, 0x69, 0xac, 0x53, 0xdc,
-	0xdf, 0xb8, 0x68, 0x01, 0x02, 0xb5, 0x11, 0x22, 0x0b, 0x8d, 0x62, 0x5a, 0x68, 0xd4, 0xa1, 0xf8,
-	0xdc, 0x0e, 0x6c, 0x9c, 0x20, 0x25, 0xbe, 0x0c, 0x45, 0x52, 0x1a, 0x86, 0xf2, 0x8b, 0x86, 0x21,
-	0x6e, 0xb6, 0xe1, 0x1c, 0x72, 0xc5, 0x37, 0x6a, 0x76, 0xc3, 0x39, 0xf4, 0xd8, 0x07, 0x70, 0x39,
-	0x41, 0x8b, 0xd6, 0x90, 0x1b, 0x88, 0x3c, 0x1d, 0x1a, 0x8b, 0x29, 0xa9, 0x45, 0x64, 0x99, 0xdc,
-	0x83, 0x4d, 0x89, 0x65, 0x86, 0xea, 0x4d, 0x40, 0x32, 0xa7, 0xac, 0x5d, 0x88, 0xc9, 0x49, 0xeb,
-	0x09, 0xd4, 0x7f, 0xb1, 0x0e, 0x1b, 0x3b, 0x9e, 0x6f, 0xd9, 0x87, 0x6e, 0x32, 0xeb, 0x96, 0xf4,
-	0xe3, 0x68, 0x26, 0xae, 0x4b, 0x33, 0xf1, 0x16, 0x54, 0x26, 0x9c, 0x51, 0x0f, 0x47, 0xdc, 0x6c,
-	0xce, 0x69, 0x20, 0x40, 0xc3, 0x91, 0x83, 0xab, 0x39, 0x22, 0x20, 0xe6, 0x1c, 0x31, 0x47, 0x4c,
-	0xb8, 0xd7, 0xb0, 0xcf, 0x48, 0xea, 0x9a, 0x96, 0x63, 0x85, 0x7c, 0x78, 0x6a, 0x0f, 0x5e, 0x8d,
-	0x76, 0x7a, 0xa9, 0x4e, 0xf7, 0x35, 0x6b, 0xd2, 0x20, 0xf5, 0x08, 0x85, 0x70, 0x8b, 0xc8, 0x05,
-	0xaf, 0x90, 0xd8, 0x85, 0xef, 0xc9, 0xcb, 0x25, 0x87, 0x3a, 0x84, 0x72, 0x0c, 0x46, 0x5d, 0x57,
-	0x6b, 0x0b, 0xfd, 0x76, 0x8d, 0x55, 0xa0, 0xd8, 0x6c, 0x0c, 0x9a, 0x8d, 0x56, 0x5b, 0xc9, 0x20,
-	0x6a, 0xd0, 0x1e, 0x72, 0x9d, 0x76, 0x9d, 0x5d, 0x80, 0x0a, 0xa6, 0x5a, 0xed, 0x9d, 0xc6, 0x41,
-	0x77, 0xa8, 0x64, 0xd9, 0x06, 0x94, 0x7b, 0x7d, 0xbd, 0xd1, 0x1c, 0x76, 0xfa, 0x3d, 0x25, 0xa7,
-	0x7e, 0x0e, 0xa5, 0xe6, 0x91, 0x35, 0x3e, 0x3e, 0xaf, 0x17, 0xc9, 0xec, 0xb4, 0xc6, 0xc7, 0x42,
-	0x3f, 0x5d, 0x30, 0x3b, 0xad, 0xf1, 0xb1, 0xfa, 0x14, 0xaa, 0xcd, 0x68, 0x53, 0x38, 0x2f, 0x97,
-	0x07, 0x50, 0xa3, 0xc5, 0x37, 0x1e, 0x45, 0xab, 0x6f, 0x7d, 0xc5, 0xea, 0xab, 0x22, 0x4d, 0x73,
-	0x24, 0x96, 0xdf, 0x47, 0x50, 0xd9, 0xf7, 0xbd, 0x99, 0xe5, 0x87, 0x94, 0xad, 0x02, 0xd9, 0x63,
-	0xeb, 0x4c, 0xe4, 0x8a, 0x9f, 0x89, 0x61, 0xbe, 0x2e, 0x1b, 0xe6, 0x0f, 0xa0, 0x14, 0xb1, 0x7d,
-	0x6f, 0x9e, 0x9f, 0xa0, 0x14, 0x23, 0x1e, 0xdb, 0x0a, 0xb0, 0xb0, 0xfb, 0x00, 0xb3, 0x18, 0x20,
-	0xb4, 0x8f, 0x48, 0xf3, 0x16, 0x99, 0x6b, 0x12, 0x85, 0xfa, 0x37, 0x59, 0xa8, 0xed, 0x1b, 0x7e,
-	0x68, 0xe3, 0xe0, 0xf0, 0x6e, 0x78, 0x13, 0x72, 0x34, 0xe5, 0xb9, 0x0f, 0xe0, 0x62, 0xac, 0xb6,
-	0x73, 0x1a, 0x52, 0x23, 0x88, 0x80, 0x7d, 0x06, 0xb5, 0x59, 0x04, 0xd6, 0x69, 0x6f, 0xe0, 0x7d,
-	0xb3, 0xc8, 0x42, 0x7d, 0xbe, 0x31, 0x93, 0x93, 0xec, 0xc7, 0x70, 0x29, 0xcd, 0x6b, 0x05, 0x41,
-	0x22, 0x47, 0xe5, 0xc1, 0xba, 0x98, 0x62, 0xe4, 0x64, 0xac, 0x09, 0x9b, 0x09, 0xfb, 0xd8, 0x73,
-	0xe6, 0x53, 0x37, 0x10, 0x76, 0xc4, 0x95, 0x85, 0xd2, 0x9b, 0x1c, 0xab, 0x29, 0xb3, 0x05, 0x08,
-	0x53, 0xa1, 0x1a, 0xc3, 0x7a, 0xf3, 0x29, 0x2d, 0x89, 0x9c, 0x96, 0x82, 0xb1, 0x87, 0x00, 0x71,
-	0x1a, 0x2d, 0xc7, 0xec, 0x8a, 0xf6, 0x75, 0x42, 0x6b, 0xaa, 0x49, 0x64, 0xa8, 0x7e, 0xa0, 0x30,
-	0xf0, 0xed, 0xf0, 0x68, 0x4a, 0x52, 0x2c, 0xab, 0x25, 0x00, 0x12, 0x96, 0x81, 0x8e, 0x66, 0x6a,
-	0xcc, 0x22, 0x04, 0x5a, 0xcd, 0x0e, 0x06, 0xf3, 0x51, 0x9c, 0x2f, 0x6e, 0xa9, 0x49, 0x2b, 0xa7,
-	0xc1, 0xa1, 0x30, 0xe6, 0x93, 0x1a, 0xee, 0x05, 0x87, 0xec, 0x01, 0x5c, 0x4e, 0x88, 0x12, 0xf9,
-	0x1b, 0xd4, 0x81, 0x24, 0x77, 0xd2, 0x7d, 0xb1, 0x10, 0x0e, 0xd4, 0x2f, 0x60, 0x23, 0x35, 0x3a,
-	0x2f, 0xdc, 0xdc, 0xaf, 0x41, 0x09, 0xff, 0xe3, 0xd6, 0x2e, 0x26, 0x60, 0x11, 0xd3, 0x83, 0xd0,
-	0x57, 0x2d, 0x50, 0x16, 0xfb, 0x9a, 0xdd, 0x21, 0x07, 0x17, 0x0d, 0xca, 0xb2, 0xa3, 0x2a, 0x42,
-	0xb1, 0xb7, 0x57, 0x0d, 0xe2, 0x3a, 0xd5, 0x7a, 0x69, 0xb0, 0xd4, 0x3f, 0x58, 0x97, 0xea, 0x8c,
-	0x3d, 0xce, 0x5e, 0x97, 0xa7, 0x9f, 0xb4, 0x70, 0x93, 0x3e, 0xa3, 0x1d, 0xe7, 0x2d, 0x50, 0x3c,
-	0xdf, 0xb4, 0x5d, 0x83, 0x1c, 0x6e, 0xbc, 0xbb, 0xd7, 0x49, 0x5b, 0xbc, 0x20, 0xe0, 0xfb, 0x02,
-	0x8c, 0x76, 0x8b, 0x69, 0xc5, 0xfe, 0x0b, 0xe1, 0x7d, 0x90, 0x41, 0xf2, 0xee, 0x94, 0x4b, 0xef,
-	0x4e, 0x6f, 0x42, 0xd9, 0xb1, 0x82, 0x40, 0x0f, 0x8f, 0x0c, 0x97, 0xf6, 0xef, 0x74, 0xa3, 0x4b,
-	0x88, 0x1c, 0x1e, 0x19, 0x2e, 0x12, 0xda, 0xae, 0x2e, 0x4e, 0x28, 0x0a, 0xcb, 0x84, 0xb6, 0x4b,
-	0xf6, 0x1b, 0xee, 0xfb, 0x97, 0x56, 0x0d, 0xac, 0xd8, 0x16, 0xd9, 0xf2, 0xb8, 0xaa, 0xaf, 0x42,
-	0xf1, 0xa9, 0x6d, 0x9d, 0x08, 0x59, 0xf6, 0xdc, 0xb6, 0x4e, 0x22, 0x59, 0x86, 0xdf, 0xea, 0x7f,
-	0x2d, 0x41, 0x89, 0x88, 0x5b, 0xe7, 0x3b, 0x36, 0x5f, 0xc6, 0xda, 0xd8, 0x12, 0xfb, 0x54, 0x6e,
-	0x85, 0x8d, 0xc3, 0x77, 0xad, 0x57, 0x01, 0xa4, 0x3d, 0x94, 0x6b, 0x04, 0xe5, 0x30, 0xde, 0x3a,
-	0x51, 0x4d, 0x27, 0x1d, 0x2f, 0xf8, 0xc6, 0x11, 0x5e, 0x99, 0x04, 0xc0, 0xee, 0x73, 0x25, 0x9a,
-	0xfc, 0x31, 0x45, 0x59, 0xb0, 0x50, 0x1b, 0x22, 0x13, 0x9e, 0x34, 0x6b, 0x4c, 0x90, 0x7e, 0x60,
-	0xf9, 0x41, 0xb4, 0x9c, 0x36, 0xb4, 0x28, 0x89, 0x12, 0x0d, 0x95, 0x27, 0x61, 0x72, 0x47, 0xcb,
-	0x57, 0xd6, 0xfe, 0x34, 0x22, 0x60, 0x77, 0xa1, 0x48, 0x5b, 0xb6, 0x85, 0x3b, 0xb8, 0x24, 0x3a,
-	0x23, 0x65, 0x4a, 0x8b, 0xd0, 0xec, 0x2d, 0xc8, 0x4f, 0x8e, 0xad, 0xb3, 0xa0, 0xbe, 0x21, 0x8b,
-	0x84, 0xd4, 0x5e, 0xa8, 0x71, 0x0a, 0x76, 0x07, 0x6a, 0xbe, 0x35, 0xd1, 0xc9, 0xd5, 0x89, 0x9b,
-	0x77, 0x50, 0xaf, 0xd1, 0xde, 0x5c, 0xf5, 0xad, 0x49, 0x13, 0x81, 0xc3, 0x91, 0x13, 0xb0, 0x37,
-	0xa0, 0x40, 0xbb, 0x12, 0xda, 0x18, 0x52, 0xc9, 0xd1, 0x16, 0xa7, 0x09, 0x2c, 0x7b, 0x00, 0xe5,
-	0x44, 0x6c, 0x5c, 0xa6, 0x06, 0x5d, 0x5a, 0x90, 0x47, 0x24, 0xc6, 0xb5, 0x84, 0x8c, 0x7d, 0x00,
-	0x20, 0xac, 0x1f, 0x7d, 0x74, 0x46, 0x87, 0x07, 0x95, 0xd8, 0x3a, 0x94, 0x36, 0x40, 0xd9, 0x46,
-	0x7a, 0x13, 0xf2, 0xb8, 0x4b, 0x04, 0xf5, 0xab, 0x54, 0x9b, 0xcd, 0xf4, 0x16, 0x42, 0xad, 0x23,
-	0x3c, 0xbb, 0x0b, 0x25, 0x9c, 0x5c, 0x3a, 0x0e, 0x61, 0x5d, 0x36, 0x07, 0xc5, 0x4c, 0x44, 0x2d,
-	0xcd, 0x3a, 0x19, 0x7c, 0xe3, 0xb0, 0x7b, 0x90, 0x33, 0xad, 0x49, 0x50, 0xbf, 0x46, 0x39, 0x5e,
-	0x91, 0xc6, 0x12, 0x15, 0x87, 0x96, 0x35, 0xe1, 0x5b, 0x0b, 0xd2, 0xb0, 0x5d, 0xa8, 0xe1, 0xd4,
-	0x7b, 0x40, 0x8a, 0x37, 0x76, 0x79, 0xfd, 0x3a, 0x71, 0xdd, 0x5e, 0xe0, 0xea, 0x09, 0x22, 0x1a,
-	0xa0, 0xb6, 0x1b, 0xfa, 0x67, 0xda, 0x86, 0x2b, 0xc3, 0xd8, 0x75, 0x28, 0xd9, 0x41, 0xd7, 0x1b,
-	0x1f, 0x5b, 0x66, 0xfd, 0x15, 0x7e, 0xde, 0x18, 0xa5, 0xd9, 0xa7, 0xb0, 0x41, 0x93, 0x11, 0x93,
-	0x58, 0x78, 0xfd, 0x86, 0xbc, 0xe5, 0x0d, 0x65, 0x94, 0x96, 0xa6, 0x44, 0x75, 0xcb, 0x0e, 0xf4,
-	0xd0, 0x9a, 0xce, 0x3c, 0x1f, 0x0d, 0xc9, 0x57, 0xb9, 0xf1, 0x64, 0x07, 0xc3, 0x08, 0x84, 0x72,
-	0x3e, 0x3e, 0xea, 0xd4, 0xbd, 0xc9, 0x24, 0xb0, 0xc2, 0xfa, 0x4d, 0x5a, 0x6b, 0xb5, 0xe8, 0xc4,
-	0xb3, 0x4f, 0x50, 0x52, 0x4a, 0x03, 0xdd, 0x3c, 0x73, 0x8d, 0xa9, 0x3d, 0xae, 0xdf, 0xe2, 0xf6,
-	0xaa, 0x1d, 0xb4, 0x38, 0x40, 0x36, 0x19, 0xb7, 0x52, 0x26, 0xe3, 0x45, 0xc8, 0x9b, 0x23, 0x5c,
-	0xc2, 0xb7, 0x29, 0xdb, 0x9c, 0x39, 0xea, 0x98, 0xd7, 0x1f, 0x93, 0x99, 0x48, 0x95, 0xfc, 0x68,
-	0x41, 0x19, 0x48, 0xcd, 0x7e, 0x49, 0x6b, 0xd8, 0x5d, 0x93, 0x75, 0x82, 0xed, 0x3c, 0x64, 0x4d,
-	0x6b, 0x72, 0xfd, 0x73, 0x60, 0xcb, 0xdd, 0xfb, 0x22, 0xcd, 0x24, 0x2f, 0x34, 0x93, 0xcf, 0xd6,
-	0x1f, 0x65, 0xd4, 0x4f, 0x61, 0x23, 0xb5, 0x56, 0x57, 0x6a, 0x58, 0xdc, 0xd2, 0x30, 0xa6, 0xc2,
-	0x33, 0xc3, 0x13, 0xea, 0xbf, 0xca, 0x42, 0x75, 0xd7, 0x08, 0x8e, 0xf6, 0x8c, 0xd9, 0x20, 0x34,
-	0xc2, 0x00, 0x3b, 0xfc, 0xc8, 0x08, 0x8e, 0xa6, 0xc6, 0x8c, 0x9b, 0x75, 0x19, 0xee, 0x54, 0x12,
-	0x30, 0xb4, 0xe9, 0x70, 0xa8, 0x31, 0xd9, 0x77, 0xf7, 0x9f, 0x08, 0x8f, 0x51, 0x9c, 0x46, 0xe1,
-	0x10, 0x1c, 0xcd, 0x27, 0x13, 0xc7, 0x12, 0x42, 0x2c, 0x4a, 0xb2, 0x3b, 0xb0, 0x21, 0x3e, 0xc9,
-	0xa6, 0x3b, 0x15, 0x87, 0xcf, 0x69, 0x20, 0x7b, 0x08, 0x15, 0x01, 0x18, 0x46, 0xa2, 0xac, 0x16,
-	0x7b, 0x02, 0x13, 0x84, 0x26, 0x53, 0xb1, 0x9f, 0xc2, 0x65, 0x29, 0xb9, 0xe3, 0xf9, 0x7b, 0x73,
-	0x27, 0xb4, 0x9b, 0x3d, 0xa1, 0x40, 0xbf, 0xb2, 0xc4, 0x9e, 0x90, 0x68, 0xab, 0x39, 0xd3, 0xb5,
-	0xdd, 0xb3, 0x5d, 0xa1, 0x5e, 0xa4, 0x81, 0x0b, 0x54, 0xc6, 0x29, 0x09, 0xc4, 0x34, 0x95, 0x71,
-	0x8a, 0xd3, 0x5f, 0x00, 0xf6, 0xac, 0xf0, 0xc8, 0x33, 0x49, 0xbd, 0x88, 0xa7, 0xff, 0x40, 0x46,
-	0x69, 0x69, 0x4a, 0xec, 0x4e, 0x77, 0xee, 0x38, 0x63, 0x37, 0x24, 0x1b, 0x2a, 0xab, 0x45, 0x49,
-	0xdc, 0x2c, 0x7c, 0xc3, 0x3d, 0xb4, 0x82, 0x7a, 0x65, 0x2b, 0x7b, 0x37, 0xa3, 0x89, 0x94, 0xfa,
-	0xff, 0xae, 0x43, 0x9e, 0x8f, 0xe4, 0x2b, 0x50, 0x1e, 0x39, 0xde, 0xf8, 0x58, 0x77, 0xe7, 0xd3,
-	0xe8, 0x10, 0x81, 0x00, 0xa8, 0x6f, 0x91, 0xed, 0x23, 0x3c, 0x7e, 0x19, 0x8d, 0xbe, 0x31, 0x4b,
-	0x6f, 0x1e, 0x62, 0x59, 0x59, 0x82, 0x8a, 0x14, 0x56, 0xc2, 0xf7, 0x4e, 0x68, 0x36, 0xe4, 0x08,
-	0x11, 0x25, 0xe9, 0x9c, 0x82, 0xf6, 0x1d, 0x64, 0xca, 0x13, 0xae, 0x44, 0x80, 0xa6, 0x1b, 0x2e,
-	0x7a, 0x27, 0x0b, 0x4b, 0xde, 0x49, 0x76, 0x13, 0xd0, 0xb2, 0x1a, 0x5b, 0x7d, 0xd7, 0x6a, 0xf6,
-	0xa8, 0x87, 0x4b, 0x9a, 0x04, 0x61, 0x1f, 0xc7, 0x73, 0x91, 0x5a, 0x24, 0x7c, 0xc7, 0x42, 0xa2,
-	0xca, 0xb3, 0x56, 0x4b, 0xd1, 0xa9, 0x6d, 0x00, 0xcd, 0x3b, 0x09, 0xac, 0x90, 0x74, 0xae, 0xab,
-	0x54, 0xfd, 0xd4, 0xf1, 0xa0, 0x77, 0xb2, 0xef, 0x05, 0xb1, 0x32, 0xb6, 0xbe, 0x5a, 0x19, 0x53,
-	0xdf, 0x83, 0x22, 0xee, 0xb2, 0x46, 0x68, 0xb0, 0x3b, 0xc2, 0xab, 0xc9, 0xb5, 0x2c, 0xe1, 0xde,
-	0x4d, 0xca, 0x10, 0x7e, 0xce, 0x6e, 0x54, 0x2e, 0xf1, 0xdc, 0x96, 0x1c, 0x1d, 0xb1, 0xb4, 0x16,
-	0x19, 0x8a, 0x7d, 0xfb, 0x15, 0x28, 0x63, 0xd5, 0xe8, 0x5c, 0x45, 0x2c, 0xeb, 0x92, 0xef, 0x9d,
-	0x34, 0x31, 0xad, 0xfe, 0x87, 0x0c, 0x54, 0xfa, 0xbe, 0x89, 0xdb, 0xc4, 0x60, 0x66, 0x8d, 0x5f,
-	0xa8, 0x3b, 0xe2, 0x2e, 0xef, 0x39, 0x8e, 0x11, 0x6b, 0x5e, 0xb8, 0xcb, 0x47, 0x00, 0xf6, 0x01,
-	0xe4, 0x26, 0x8e, 0x71, 0x48, 0x43, 0x1b, 0xdb, 0x94, 0x52, 0xf6, 0xd1, 0xf7, 0x8e, 0x63, 0x1c,
-	0x6a, 0x44, 0xaa, 0xfe, 0x66, 0x5c, 0x3e, 0x9d, 0xb0, 0xc8, 0xe7, 0x2a, 0x6b, 0x74, 0xc6, 0x37,
-	0x68, 0x2a, 0x19, 0x56, 0x82, 0x5c, 0xab, 0x3d, 0x68, 0x72, 0x4b, 0x12, 0x6d, 0xca, 0x81, 0xbe,
-	0xd3, 0xd1, 0x06, 0x43, 0x25, 0x47, 0x87, 0x86, 0x04, 0xe8, 0x36, 0x06, 0x43, 0xa5, 0xc4, 0x00,
-	0x0a, 0x07, 0xbd, 0xce, 0x4f, 0x0f, 0xda, 0x8a, 0xa2, 0xfe, 0x9b, 0x0c, 0x40, 0xe2, 0xfe, 0x67,
-	0x6f, 0x43, 0xe5, 0x84, 0x52, 0xba, 0x74, 0x2e, 0x24, 0xb7, 0x11, 0x38, 0x9a, 0x34, 0x90, 0x77,
-	0x25, 0x83, 0x02, 0x77, 0xda, 0xe5, 0x03, 0xa2, 0xca, 0x2c, 0xd9, 0xa4, 0xd9, 0x3b, 0x50, 0xf2,
-	0xb0, 0x1d, 0x48, 0x9a, 0x95, 0xb7, 0x59, 0xa9, 0xf9, 0x5a, 0xd1, 0xe3, 0x09, 0xdc, 0x91, 0x27,
-	0x7e, 0xe4, 0x38, 0x8a, 0x49, 0x77, 0x10, 0xd4, 0x74, 0x8c, 0x79, 0x60, 0x69, 0x1c, 0x1f, 0x0b,
-	0xd9, 0x7c, 0x22, 0x64, 0xd5, 0x9f, 0x41, 0x6d, 0x60, 0x4c, 0x67, 0x5c, 0x14, 0x53, 0xc3, 0x18,
-	0xe4, 0x70, 0x4e, 0x88, 0xa9, 0x47, 0xdf, 0xb8, 0xa0, 0xf6, 0x2d, 0x7f, 0x6c, 0xb9, 0xd1, 0xfa,
-	0x8b, 0x92, 0x28, 0x5a, 0x0f, 0x02, 0xdb, 0x3d, 0xd4, 0xbc, 0x93, 0x28, 0x6a, 0x27, 0x4a, 0xab,
-	0xff, 0x28, 0x03, 0x15, 0xa9, 0x1a, 0xec, 0xbd, 0x94, 0xfd, 0xf8, 0xca, 0x52, 0x3d, 0xf9, 0xb7,
-	0x64, 0x47, 0xbe, 0x01, 0xf9, 0x20, 0x34, 0xfc, 0xe8, 0x24, 0x49, 0x91, 0x38, 0xb6, 0xbd, 0xb9,
-	0x6b, 0x6a, 0x1c, 0xcd, 0x54, 0xc8, 0x5a, 0xae, 0x29, 0x4c, 0xc4, 0x65, 0x2a, 0x44, 0xaa, 0x5b,
-	0x50, 0x8e, 0xb3, 0xc7, 0x29, 0xa0, 0xf5, 0x9f, 0x0d, 0x94, 0x35, 0x56, 0x86, 0xbc, 0xd6, 0xe8,
-	0x3d, 0x6e, 0x2b, 0x19, 0xf5, 0x4f, 0x32, 0x00, 0x09, 0x17, 0xbb, 0x9f, 0xaa, 0xed, 0xf5, 0xc5,
-	0x5c, 0xef, 0xd3, 0x5f, 0xa9, 0xb2, 0x37, 0xa0, 0x3c, 0x77, 0x09, 0x68, 0x99, 0x62, 0x97, 0x49,
-	0x00, 0xec, 0x06, 0x64, 0xa3, 0xf8, 0x9e, 0x85, 0x98, 0x8a, 0xe7, 0x86, 0xa3, 0x7e, 0x06, 0xe5,
-	0x38, 0x3b, 0xb6, 0x01, 0xe5, 0x9d, 0x7e, 0xb7, 0xdb, 0x7f, 0xd6, 0xe9, 0x3d, 0x56, 0xd6, 0x30,
-	0xb9, 0xaf, 0xb5, 0x9b, 0xed, 0x16, 0x26, 0x33, 0x38, 0x67, 0x9b, 0x07, 0x9a, 0xd6, 0xee, 0x0d,
-	0x75, 0xad, 0xff, 0x4c, 0x59, 0x57, 0x7f, 0x3b, 0x07, 0x9b, 0x7d, 0xb7, 0x35, 0x9f, 0x39, 0xf6,
-	0xd8, 0x08, 0xad, 0x27, 0xd6, 0x59, 0x33, 0x3c, 0xc5, 0xcd, 0xd3, 0x08, 0x43, 0x9f, 0x2f, 0xe6,
-	0xb2, 0xc6, 0x13, 0xdc, 0x1d, 0x17, 0x58, 0x7e, 0x48, 0xde, 0x46, 0x79, 0x15, 0xd7, 0x38, 0xbc,
-	0xe9, 0x39, 0xb4, 0x96, 0xd9, 0x8f, 0xe1, 0x32, 0x77, 0xe1, 0x71, 0x4a, 0x54, 0x31, 0xb9, 0x25,
-	0x9f, 0x5d, 0x9a, 0xba, 0x8c, 0x13, 0x22, 0x2b, 0x92, 0x91, 0x08, 0xbb, 0x05, 0x95, 0x84, 0x9d,
-	0x1b, 0x02, 0x65, 0x0d, 0x62, 0x42, 0xaa, 0x89, 0xe7, 0xea, 0x66, 0x54, 0x6b, 0xdd, 0x36, 0x4f,
-	0xc9, 0x38, 0xca, 0x6b, 0x35, 0x2f, 0x69, 0x0c, 0x6e, 0xb0, 0x5f, 0xc2, 0x66, 0x8a, 0x92, 0x6a,
-	0xc1, 0xcd, 0xa3, 0x77, 0xa2, 0xa3, 0x81, 0x85, 0xd6, 0xcb, 0x10, 0xac, 0x0e, 0xd7, 0xff, 0x2e,
-	0x78, 0x69, 0x28, 0x0a, 0x33, 0x3b, 0xd0, 0xed, 0x43, 0xd7, 0xf3, 0x2d, 0x21, 0xcc, 0x4b, 0x76,
-	0xd0, 0xa1, 0x74, 0x62, 0xa1, 0x48, 0x07, 0xea, 0x7c, 0xef, 0x88, 0xce, 0x93, 0x39, 0xda, 0xe6,
-	0xbb, 0x63, 0x4e, 0x2b, 0x52, 0xba, 0x63, 0xa2, 0x71, 0xce, 0x51, 0x91, 0xd1, 0x01, 0x64, 0x74,
-	0x54, 0x09, 0xf8, 0x94, 0xc3, 0xae, 0xf7, 0xe0, 0xd2, 0xaa, 0x4a, 0xae, 0xd0, 0xa2, 0xb6, 0x64,
-	0x2d, 0x6a, 0xc1, 0x5d, 0x95, 0x68, 0x54, 0x7f, 0x27, 0x0b, 0x65, 0xee, 0x55, 0xc3, 0xd1, 0xbf,
-	0x0b, 0x45, 0x6f, 0xf4, 0xb5, 0xee, 0x5b, 0x93, 0xf3, 0x0e, 0xac, 0x0b, 0xde, 0xe8, 0x6b, 0xcd,
-	0x9a, 0xb0, 0xb7, 0xa3, 0x0d, 0xd1, 0xb4, 0x26, 0xa2, 0x84, 0x5a, 0x5a, 0x95, 0x16, 0x1b, 0x24,
-	0xf7, 0x21, 0x5d, 0x5c, 0x34, 0x3c, 0x6d, 0x93, 0x7b, 0x82, 0x73, 0xda, 0x66, 0xda, 0xee, 0xec,
-	0x98, 0xc1, 0xf9, 0x1e, 0x88, 0xdc, 0xb9, 0x1e, 0x08, 0x76, 0x0f, 0x36, 0x3d, 0xc7, 0x4c, 0x3c,
-	0x20, 0x62, 0x66, 0xe0, 0x1c, 0xbd, 0xe0, 0x39, 0x66, 0x62, 0xe9, 0x9b, 0xa7, 0x48, 0xeb, 0x5a,
-	0x27, 0x0b, 0xb4, 0x05, 0x4e, 0xeb, 0x5a, 0x27, 0x29, 0xda, 0x87, 0x50, 0x49, 0xa6, 0x7e, 0x50,
-	0x2f, 0x2e, 0x98, 0xa6, 0x9a, 0x35, 0x11, 0xa7, 0x5c, 0x10, 0xaf, 0x84, 0x00, 0x99, 0xb8, 0x57,
-	0x94, 0x33, 0x95, 0xce, 0x67, 0xe2, 0x64, 0x74, 0x72, 0xf7, 0x4f, 0xd6, 0xa1, 0xdc, 0xe1, 0x79,
-	0x84, 0xa7, 0xec, 0x36, 0x64, 0xbf, 0x63, 0x18, 0x10, 0x87, 0xcd, 0x30, 0x4c, 0x53, 0x37, 0x26,
-	0x13, 0x6b, 0x1c, 0x5a, 0xa6, 0x8e, 0xca, 0x8a, 0x90, 0x20, 0x17, 0x0c, 0xd3, 0x6c, 0x08, 0x38,
-	0x49, 0x62, 0xee, 0x23, 0x8a, 0x8c, 0x36, 0x7e, 0x2a, 0x92, 0x8d, 0x7c, 0x44, 0xc2, 0x66, 0xe3,
-	0x67, 0x22, 0xa9, 0x91, 0xcd, 0xfd, 0xb0, 0x91, 0xcd, 0xbf, 0xf4, 0xc8, 0x16, 0xce, 0x1f, 0xd9,
-	0x94, 0xd3, 0x0a, 0x47, 0xaa, 0x48, 0x23, 0x95, 0xec, 0x8c, 0x1d, 0xf3, 0x54, 0xfd, 0x07, 0x59,
-	0x00, 0xcd, 0x9a, 0x39, 0xc6, 0xd8, 0xfa, 0x3f, 0xa7, 0xf7, 0x6e, 0x49, 0xd3, 0xc4, 0x35, 0xa3,
-	0xa8, 0x9e, 0x68, 0x4a, 0xd0, 0x5e, 0xb2, 0xb2, 0x7b, 0x0b, 0x2f, 0xdd, 0xbd, 0xc5, 0x97, 0xe8,
-	0xde, 0xd2, 0x72, 0xf7, 0xb2, 0xcf, 0xe1, 0x55, 0xdf, 0x3a, 0xf1, 0xed, 0xd0, 0xd2, 0x27, 0xbe,
-	0x37, 0xd5, 0x53, 0x92, 0x15, 0x05, 0x4f, 0x99, 0x7a, 0xe3, 0x9a, 0x20, 0xda, 0xf1, 0xbd, 0x69,
-	0x5a, 0xba, 0xaa, 0x7f, 0x56, 0x80, 0x4a, 0xc3, 0x35, 0x9c, 0xb3, 0x6f, 0x2d, 0x8a, 0xfc, 0xa1,
-	0x73, 0x93, 0xd9, 0x3c, 0xe4, 0xfd, 0xce, 0x8f, 0xc2, 0xcb, 0x04, 0xa1, 0x1e, 0xbf, 0x05, 0x15,
-	0x6f, 0x1e, 0xc6, 0x78, 0x7e, 0x38, 0x0e, 0x1c, 0x44, 0x04, 0x31, 0x7f, 0x7c, 0x26, 0x17, 0xf1,
-	0x93, 0xe9, 0x96, 0xf0, 0xc7, 0xea, 0x7c, 0xcc, 0x4f, 0x04, 0x28, 0x6d, 0xed, 0x29, 0xf5, 0x7c,
-	0x30, 0x9f, 0x5a, 0xbc, 0xf7, 0xb3, 0x3c, 0xc2, 0xb2, 0x29, 0x60, 0x98, 0xcb, 0xd4, 0x9a, 0x7a,
-	0xfe, 0x19, 0xcf, 0xa5, 0xc0, 0x73, 0xe1, 0x20, 0xca, 0xe5, 0x1d, 0x60, 0x27, 0x86, 0x1d, 0xea,
-	0xe9, 0xac, 0xb8, 0x09, 0xa5, 0x20, 0x66, 0x28, 0x67, 0x77, 0x05, 0x0a, 0xa6, 0x1d, 0x1c, 0x77,
-	0xfa, 0xc2, 0x7c, 0x12, 0x29, 0x6c, 0x4b, 0x30, 0x36, 0x50, 0xc3, 0x0b, 0xad, 0x80, 0xba, 0x32,
-	0xab, 0x95, 0x11, 0xb2, 0x8d, 0x00, 0xd4, 0x10, 0x5c, 0x2b, 0x3c, 0xf1, 0x7c, 0xe4, 0xe4, 0xd6,
-	0x51, 0x02, 0x40, 0x4d, 0x0a, 0x49, 0xb1, 0x20, 0xf2, 0x47, 0x65, 0xb5, 0x38, 0x8d, 0x76, 0x07,
-	0x97, 0x4a, 0x84, 0xad, 0xf2, 0xea, 0x27, 0x10, 0x76, 0x07, 0x6a, 0x54, 0x7d, 0xb2, 0x9e, 0xb0,
-	0x0d, 0x74, 0x7e, 0x9d, 0xd5, 0xaa, 0x08, 0x25, 0xd7, 0x04, 0x52, 0x7d, 0x0a, 0xd7, 0x52, 0xed,
-	0xd3, 0x0d, 0xdf, 0x37, 0xce, 0xf4, 0xa9, 0xf1, 0xb5, 0xe7, 0x93, 0xeb, 0x29, 0xab, 0x5d, 0x91,
-	0xbb, 0xad, 0x81, 0xe8, 0x3d, 0xc4, 0x9e, 0xcb, 0x6a, 0xbb, 0x9e, 0x4f, 0x7e, 0xa9, 0x95, 0xac,
-	0x88, 0x25, 0x87, 0x08, 0x0d, 0x30, 0x99, 0x72, 0x01, 0x8f, 0xcc, 0xd5, 0x2a, 0x04, 0xdb, 0x26,
-	0x10, 0x1a, 0x3c, 0xc1, 0x43, 0x9d, 0xe2, 0x5a, 0x36, 0x45, 0x00, 0xdd, 0x43, 0x0a, 0x6a, 0xe4,
-	0x88, 0x23, 0xcb, 0x30, 0xe9, 0x4c, 0x9c, 0x10, 0xbb, 0x96, 0x41, 0x11, 0x27, 0xc1, 0x43, 0x7d,
-	0x36, 0x0f, 0x79, 0x48, 0xad, 0x96, 0x0f, 0x1e, 0xee, 0xcf, 0x43, 0x01, 0x3e, 0xb4, 0x42, 0x0a,
-	0xa4, 0x25, 0xf0, 0x63, 0x2b, 0xc4, 0x8d, 0x3e, 0x78, 0x18, 0x9d, 0x6f, 0x5d, 0x16, 0x7d, 0xfb,
-	0x50, 0x1c, 0x60, 0xa9, 0xb0, 0x11, 0x23, 0xf5, 0xe9, 0x9c, 0xc7, 0xd0, 0x66, 0xb5, 0x4a, 0x44,
-	0xb0, 0x37, 0xa7, 0x33, 0x34, 0x5c, 0x0f, 0xa1, 0xe5, 0xf2, 0x69, 0x7c, 0x95, 0x93, 0x08, 0x18,
-	0xcd, 0xe3, 0xdb, 0x50, 0xe5, 0x79, 0x08, 0x09, 0x54, 0xe7, 0x24, 0x02, 0x86, 0x24, 0xaa, 0x2f,
-	0x9d, 0xa8, 0xec, 0xfb, 0x73, 0xd7, 0xe2, 0x3e, 0x28, 0xfa, 0x34, 0xc5, 0xd9, 0x76, 0x9c, 0x66,
-	0x2d, 0xb8, 0xc8, 0x4d, 0x4f, 0x4b, 0xda, 0x0d, 0xa3, 0xd8, 0xb2, 0x95, 0x27, 0x0d, 0x2c, 0xa2,
-	0x8f, 0xc1, 0x81, 0xfa, 0xf3, 0x0c, 0x5c, 0xef, 0xd3, 0x41, 0x3b, 0x89, 0x8a, 0x3d, 0x2b, 0x08,
-	0x8c, 0x43, 0x6b, 0xc7, 0xf3, 0x77, 0xe6, 0xdf, 0x7e, 0x7b, 0xc6, 0xee, 0xc2, 0x85, 0x7d, 0xc3,
-	0xb7, 0xdc, 0x30, 0x16, 0x24, 0x42, 0xf5, 0x58, 0x04, 0xb3, 0x47, 0xe4, 0xcd, 0xb7, 0xdc, 0xf0,
-	0x20, 0x56, 0xe2, 0x44, 0x5d, 0xd2, 0xfe, 0xdd, 0x25, 0x2a, 0xf5, 0x7f, 0x6c, 0x41, 0xae, 0xe7,
-	0x99, 0x16, 0x7b, 0x1f, 0xca, 0x14, 0x18, 0xba, 0x7c, 0x88, 0x84, 0x68, 0xfa, 0x43, 0xfa, 0x74,
-	0xc9, 0x15, 0x5f, 0xe7, 0x87, 0x92, 0xde, 0x26, 0xcb, 0x80, 0x4e, 0xa1, 0x51, 0x34, 0x57, 0x84,
-	0x67, 0x82, 0x4c, 0x6b, 0x8e, 0xc1, 0xbe, 0x25, 0xcf, 0xaa, 0x6f, 0xb9, 0xa4, 0x6f, 0xe4, 0xb5,
-	0x38, 0x4d, 0xf6, 0x98, 0xef, 0xe1, 0x36, 0xc2, 0x67, 0x5d, 0x7e, 0x85, 0x3d, 0xc6, 0xf1, 0x34,
-	0x0d, 0xdf, 0x87, 0xf2, 0xd7, 0x9e, 0xed, 0xf2, 0x8a, 0x17, 0x96, 0x2a, 0xfe, 0x85, 0x67, 0xf3,
-	0xd3, 0xaf, 0xd2, 0xd7, 0xe2, 0x8b, 0xbd, 0x06, 0x45, 0xcf, 0xe5, 0x79, 0x17, 0x97, 0xf2, 0x2e,
-	0x78, 0x6e, 0x97, 0x47, 0x69, 0x6d, 0x8c, 0xe6, 0xb6, 0x63, 0xa2, 0x14, 0x76, 0xac, 0x49, 0x28,
-	0x0e, 0x7b, 0x2a, 0x04, 0xec, 0xbb, 0x5d, 0x6b, 0x12, 0xa2, 0x99, 0x39, 0xb1, 0x1d, 0xdc, 0xad,
-	0x28, 0xb3, 0xf2, 0x52, 0x66, 0xc0, 0xd1, 0x94, 0xe1, 0xeb, 0x50, 0x3a, 0xf4, 0xbd, 0xf9, 0x0c,
-	0xed, 0x46, 0x58, 0x3e, 0x5e, 0x21, 0xdc, 0xf6, 0x19, 0x8a, 0x4c, 0xfa, 0xb4, 0xdd, 0x43, 0x9d,
-	0x4c, 0xec, 0xca, 0x56, 0xf6, 0x6e, 0x49, 0xab, 0x46, 0x40, 0x32, 0x9e, 0x5f, 0x87, 0x92, 0x71,
-	0x78, 0xa8, 0x8b, 0x60, 0xb3, 0xa5, 0xbc, 0x8c, 0xc3, 0x43, 0x2a, 0xf2, 0x3e, 0x6c, 0x9c, 0xd8,
-	0xae, 0x1e, 0xcc, 0xac, 0x31, 0xa7, 0xdd, 0x58, 0xee, 0xca, 0x13, 0xdb, 0x45, 0xcb, 0x92, 0xe8,
-	0x65, 0xd3, 0xb6, 0xf6, 0x42, 0xd3, 0x76, 0x0b, 0xf2, 0x8e, 0x3d, 0xb5, 0x43, 0x11, 0x7e, 0x96,
-	0xd2, 0x7d, 0x09, 0xc1, 0x54, 0x28, 0x08, 0x0f, 0xaa, 0xb2, 0x44, 0x22, 0x30, 0xe9, 0xbd, 0x7c,
-	0xf3, 0x05, 0x7b, 0xb9, 0xa4, 0x3a, 0xb3, 0xef, 0x56, 0x9d, 0x3f, 0xa2, 0x63, 0x26, 0xcb, 0x0d,
-	0xf5, 0x88, 0xe1, 0xe2, 0x6a, 0x86, 0x2a, 0x27, 0xeb, 0x73, 0xb6, 0x0f, 0xa0, 0xe2, 0x93, 0xcf,
-	0x45, 0x27, 0x07, 0xcd, 0x25, 0xd9, 0x68, 0x4d, 0x9c, 0x31, 0x1a, 0xf8, 0x89, 0x63, 0xe6, 0x11,
-	0x30, 0xa1, 0xbb, 0xca, 0xda, 0xe8, 0xe5, 0xa5, 0x9e, 0x16, 0xc6, 0x5d, 0x2b, 0xd6, 0x45, 0x71,
-	0xa8, 0x79, 0xfc, 0x0c, 0x8f, 0x72, 0x08, 0x48, 0xb8, 0x95, 0xb5, 0x2a, 0x01, 0x79, 0x04, 0x44,
-	0xc0, 0xee, 0x03, 0x44, 0xf9, 0x86, 0xa7, 0x24, 0xdb, 0xe2, 0x46, 0x88, 0xac, 0xc2, 0x53, 0xad,
-	0x6c, 0x46, 0x9f, 0x28, 0xea, 0x46, 0xb6, 0x6b, 0xe2, 0xf4, 0x09, 0x8d, 0x43, 0x14, 0x75, 0xb8,
-	0xba, 0x2a, 0x02, 0x36, 0x34, 0x0e, 0x03, 0xf6, 0x21, 0x54, 0x0d, 0xae, 0x24, 0xf0, 0x40, 0xe2,
-	0x6b, 0xb2, 0x6b, 0x42, 0x52, 0x1f, 0xb4, 0x8a, 0x21, 0xe9, 0x12, 0x9f, 0x00, 0x8b, 0x0e, 0x76,
-	0xc8, 0xcc, 0xe3, 0x33, 0xea, 0xfa, 0x52, 0x3b, 0x2f, 0x88, 0x93, 0x9d, 0x38, 0xf8, 0xfd, 0x13,
-	0xd8, 0x48, 0x2b, 0x75, 0x37, 0x56, 0x1c, 0x65, 0xd0, 0x60, 0x6b, 0xd5, 0xb1, 0xac, 0xe6, 0xbd,
-	0x06, 0x1b, 0xae, 0x17, 0xea, 0x63, 0x63, 0x7c, 0x64, 0x11, 0x23, 0x77, 0xd7, 0x57, 0x5d, 0x2f,
-	0x6c, 0x46, 0x30, 0xec, 0x9f, 0xc8, 0x74, 0x08, 0x4f, 0xc9, 0x53, 0x1f, 0xf7, 0x4f, 0xac, 0xe7,
-	0xa3, 0xce, 0x12, 0xa9, 0xfc, 0x38, 0xc2, 0x5c, 0x85, 0x25, 0x86, 0x5b, 0xa9, 0x11, 0x8e, 0x75,
-	0x5b, 0x0d, 0xfc, 0x44, 0xcf, 0xbd, 0x05, 0x95, 0xc0, 0x9b, 0xfb, 0x63, 0x4b, 0x0f, 0x42, 0x6b,
-	0x56, 0xdf, 0xa2, 0x1e, 0x05, 0x0e, 0x1a, 0x84, 0xd6, 0x8c, 0x3d, 0x82, 0xda, 0xcc, 0xb7, 0x74,
-	0x69, 0x9c, 0x6e, 0xcb, 0x4d, 0xdc, 0xf7, 0xad, 0x64, 0xa8, 0xaa, 0x33, 0x29, 0x15, 0x71, 0x4a,
-	0x2d, 0x50, 0x17, 0x38, 0x93, 0x46, 0x20, 0x67, 0x62, 0xba, 0xfc, 0x04, 0x36, 0x25, 0xce, 0xf9,
-	0x31, 0x31, 0xbf, 0x96, 0x3a, 0x59, 0x8a, 0xc8, 0x0f, 0x8e, 0x91, 0xbd, 0x36, 0x4b, 0xa5, 0x59,
-	0x63, 0xc1, 0xc8, 0x47, 0x05, 0xf3, 0x0e, 0xf1, 0x5f, 0x3d, 0xc7, 0x72, 0x4f, 0x59, 0xff, 0x4f,
-	0xf8, 0x19, 0x42, 0x27, 0x68, 0xbb, 0x66, 0xfd, 0x75, 0x7e, 0x43, 0x85, 0x12, 0xec, 0x21, 0x54,
-	0xb9, 0xaa, 0x43, 0xd1, 0xb1, 0x41, 0xfd, 0x0d, 0xd9, 0xcb, 0x49, 0xfa, 0x0e, 0x21, 0xb4, 0x8a,
-	0x13, 0x7f, 0x07, 0xec, 0x63, 0xd8, 0xe4, 0xee, 0x65, 0x59, 0xa0, 0xbe, 0xb9, 0x3c, 0xb9, 0x88,
-	0x68, 0x27, 0x91, 0xaa, 0x1a, 0x5c, 0xf3, 0xe7, 0x2e, 0xa9, 0x3f, 0x82, 0x73, 0xe6, 0x7b, 0x23,
-	0x8b, 0xf3, 0xdf, 0x25, 0x7e, 0xd1, 0x1c, 0x8d, 0x93, 0x71, 0x5e, 0x92, 0x64, 0x57, 0x7c, 0x19,
-	0xb4, 0x8f, 0x7c, 0xe7, 0xe4, 0xc9, 0x77, 0x02, 0xca, 0xf3, 0xad, 0x97, 0xc9, 0x73, 0x1b, 0xf9,
-	0x28, 0x4f, 0x06, 0xb9, 0xf9, 0xdc, 0x36, 0xeb, 0xf7, 0x78, 0x20, 0x2b, 0x7e, 0xb3, 0xd7, 0xa1,
-	0xe6, 0x5b, 0xe3, 0xb9, 0x1f, 0xd8, 0xcf, 0x2d, 0x3d, 0xb0, 0xdd, 0xe3, 0xfa, 0xdb, 0xd4, 0x8f,
-	0x1b, 0x31, 0x74, 0x60, 0xbb, 0xc7, 0x38, 0x63, 0xad, 0xd3, 0xd0, 0xf2, 0x5d, 0x1d, 0x55, 0xce,
-	0xfa, 0x3b, 0xf2, 0x8c, 0x6d, 0x13, 0x62, 0x30, 0x36, 0x5c, 0x0d, 0xac, 0xf8, 0x9b, 0xfd, 0x18,
-	0x2e, 0x24, 0xe6, 0xc6, 0x0c, 0x55, 0x96, 0xfa, 0xbb, 0x2b, 0x0f, 0x1d, 0x49, 0x9d, 0xd1, 0x92,
-	0x13, 0x79, 0xae, 0xf9, 0xa4, 0xe7, 0x56, 0xc0, 0xe7, 0xd6, 0xfd, 0xef, 0x35, 0xb7, 0x06, 0x34,
-	0xb7, 0xde, 0x80, 0x92, 0xed, 0x86, 0x96, 0xff, 0xdc, 0x70, 0xea, 0xef, 0x2d, 0x89, 0xfe, 0x18,
-	0xc7, 0xee, 0x40, 0x31, 0x70, 0x6c, 0x14, 0x4c, 0xf5, 0xf7, 0x97, 0xc8, 0x22, 0x14, 0xbb, 0x0b,
-	0xe5, 0xf8, 0x4a, 0x56, 0xfd, 0x83, 0x25, 0xba, 0x04, 0xc9, 0x6e, 0x42, 0xee, 0x04, 0xe7, 0xe3,
-	0x83, 0x65, 0xa7, 0x36, 0xc2, 0x51, 0x57, 0x98, 0xd8, 0x8e, 0xc3, 0x75, 0x85, 0x87, 0x4b, 0xba,
-	0xc2, 0x8e, 0xed, 0x38, 0x5c, 0x57, 0x98, 0x88, 0x2f, 0xdc, 0x69, 0x89, 0x03, 0x5b, 0xf2, 0xe1,
-	0xf2, 0x4e, 0x8b, 0xb8, 0xa7, 0x74, 0x79, 0xad, 0x12, 0x90, 0xa7, 0x96, 0x3b, 0x9c, 0x3f, 0x92,
-	0xfb, 0x2a, 0xed, 0xc2, 0xd5, 0x20, 0x88, 0xd3, 0x68, 0x93, 0x08, 0x3f, 0x35, 0xda, 0x84, 0x1f,
-	0xf3, 0x3b, 0x15, 0x1c, 0x82, 0x06, 0xe1, 0xfb, 0xb0, 0x11, 0x85, 0x63, 0x61, 0x71, 0x41, 0xfd,
-	0x93, 0xa5, 0x1a, 0xa4, 0x09, 0x58, 0x0b, 0xaa, 0x13, 0xd4, 0x1d, 0xa7, 0x5c, 0x95, 0xac, 0x3f,
-	0xa2, 0x8a, 0x6c, 0x45, 0xbb, 0xf8, 0x79, 0xaa, 0xa6, 0x96, 0xe2, 0x62, 0xf7, 0x81, 0xd9, 0x13,
-	0x3e, 0x9e, 0x68, 0x64, 0x72, 0x75, 0xb1, 0xfe, 0x29, 0x4d, 0xce, 0x15, 0x18, 0xf6, 0x10, 0x36,
-	0x02, 0xcb, 0x35, 0xf5, 0x69, 0x20, 0x74, 0x92, 0xcf, 0xa8, 0x9e, 0x42, 0x0c, 0xc7, 0x57, 0x37,
-	0xb5, 0x0a, 0x52, 0xed, 0x05, 0x5c, 0x39, 0x79, 0x08, 0x38, 0xcf, 0x9f, 0x27, 0x4c, 0xff, 0xd7,
-	0x39, 0x4c, 0x48, 0x15, 0x31, 0x7d, 0x02, 0x17, 0x78, 0x34, 0x1b, 0x4e, 0x49, 0xce, 0xf6, 0x63,
-	0x99, 0x2d, 0xf6, 0xb2, 0x69, 0x1b, 0xf3, 0xe8, 0x33, 0x2a, 0x8d, 0xac, 0xbf, 0xc0, 0x35, 0x66,
-	0xc1, 0x91, 0x17, 0xd6, 0x7f, 0x43, 0x56, 0x35, 0x06, 0x02, 0xaa, 0x55, 0x91, 0x28, 0x4a, 0xe1,
-	0x06, 0x94, 0x2c, 0xd0, 0x71, 0x68, 0xd5, 0x7f, 0xc2, 0x37, 0xa0, 0x18, 0xd8, 0x0c, 0xb1, 0xf1,
-	0x60, 0xcc, 0x66, 0xce, 0x19, 0x9f, 0x54, 0x9f, 0xd3, 0xa4, 0xba, 0x24, 0x4d, 0xaa, 0x06, 0x22,
-	0x69, 0x56, 0x95, 0x8d, 0xe8, 0x93, 0x3d, 0x80, 0xea, 0xcc, 0x0b, 0x42, 0xdd, 0x9c, 0x3a, 0xb4,
-	0xb8, 0x1a, 0xf2, 0xa2, 0xde, 0xf7, 0x82, 0xb0, 0x35, 0x75, 0x68, 0x1b, 0x9a, 0xc5, 0xdf, 0xac,
-	0x0b, 0x17, 0x53, 0x02, 0xdb, 0xa0, 0xd3, 0xd9, 0xfa, 0x36, 0x95, 0x78, 0x43, 0x2a, 0x51, 0x12,
-	0xdc, 0x22, 0xaa, 0x6f, 0xd3, 0x5b, 0x04, 0xa1, 0x55, 0x6a, 0x5a, 0xe6, 0x7c, 0x96, 0x84, 0xb6,
-	0x36, 0xb9, 0xf6, 0x41, 0xd0, 0x28, 0xb6, 0xf5, 0x11, 0x5c, 0x48, 0xa8, 0xb0, 0x81, 0x41, 0xbd,
-	0x25, 0xcf, 0x41, 0x29, 0x00, 0x7d, 0x23, 0x62, 0x44, 0x58, 0xa0, 0xfe, 0x45, 0x1e, 0x4a, 0x91,
-	0xd1, 0xc0, 0x2a, 0x50, 0x3c, 0xe8, 0x3d, 0xe9, 0xf5, 0x9f, 0xf5, 0xf8, 0x45, 0xb0, 0xc6, 0x60,
-	0xd0, 0xd6, 0x86, 0x8a, 0xc9, 0x6a, 0x00, 0x74, 0xd1, 0x45, 0x1f, 0x34, 0x1b, 0x3d, 0x7e, 0x31,
-	0x8c, 0xae, 0xd7, 0xf0, 0xf4, 0x3a, 0xdb, 0x84, 0x8d, 0x9d, 0x83, 0x1e, 0x05, 0x0f, 0x72, 0x50,
-	0x16, 0x41, 0xed, 0x2f, 0xf9, 0xc1, 0x11, 0x07, 0xe5, 0x10, 0xb4, 0xd7, 0x18, 0xb6, 0xb5, 0x4e,
-	0x04, 0xca, 0x53, 0x1c, 0x62, 0xff, 0x40, 0x6b, 0x8a, 0x9c, 0x0a, 0xec, 0x32, 0x6c, 0xc6, 0x6c,
-	0x51, 0x96, 0x4a, 0x11, 0x6b, 0xb6, 0xaf, 0xf5, 0xbf, 0x68, 0x37, 0x87, 0x0a, 0xd0, 0x29, 0xd4,
-	0xe3, 0xc7, 0x4a, 0x85, 0x55, 0xa1, 0xd4, 0xea, 0x0c, 0x86, 0x9d, 0x5e, 0x73, 0xa8, 0x54, 0xb1,
-	0xc2, 0x3b, 0x9d, 0xee, 0xb0, 0xad, 0x29, 0x1b, 0xac, 0x04, 0xb9, 0x2f, 0xfa, 0x9d, 0x9e, 0x52,
-	0xa3, 0x0b, 0x3f, 0x8d, 0xbd, 0xfd, 0x6e, 0x5b, 0xb9, 0x80, 0xd0, 0x41, 0x5f, 0x1b, 0x2a, 0x0a,
-	0x42, 0x9f, 0x75, 0x7a, 0xad, 0xfe, 0x33, 0x65, 0x93, 0x95, 0x21, 0x7f, 0xd0, 0xc3, 0x62, 0x18,
-	0xdb, 0x80, 0x32, 0x7d, 0xea, 0x8d, 0x6e, 0x57, 0xb9, 0x28, 0x1d, 0x5d, 0x5d, 0x42, 0x14, 0x1d,
-	0x84, 0x0d, 0xb0, 0x0e, 0x97, 0xb1, 0x2d, 0x71, 0x92, 0xa8, 0xaf, 0x60, 0x3e, 0x7b, 0x9d, 0xde,
-	0xc1, 0x40, 0xb9, 0x8a, 0xc4, 0xf4, 0x49, 0x98, 0x3a, 0xe6, 0xd3, 0xe9, 0x51, 0x57, 0xde, 0xc4,
-	0xef, 0x56, 0xbb, 0xdb, 0x1e, 0xb6, 0x95, 0x5b, 0xd8, 0x2a, 0xad, 0xbd, 0xdf, 0x6d, 0x34, 0xdb,
-	0xca, 0x16, 0x26, 0xba, 0xfd, 0xe6, 0x13, 0xbd, 0xbf, 0xaf, 0xdc, 0x66, 0x97, 0x40, 0xe9, 0xf7,
-	0xf4, 0xd6, 0xc1, 0x7e, 0xb7, 0xd3, 0x6c, 0x0c, 0xdb, 0xfa, 0x93, 0xf6, 0x57, 0x8a, 0x8a, 0xdd,
-	0xbe, 0xaf, 0xb5, 0x75, 0x91, 0xd7, 0x6b, 0x51, 0x5a, 0xe4, 0x77, 0x87, 0x29, 0x50, 0xdd, 0x39,
-	0xf8, 0xd9, 0xcf, 0xbe, 0xd2, 0x45, 0x3f, 0xbc, 0x8e, 0xd5, 0x4c, 0x38, 0xf4, 0x83, 0x27, 0xca,
-	0x1b, 0x0b, 0xa0, 0xc1, 0x13, 0xe5, 0x4d, 0xec, 0xc7, 0x68, 0x60, 0x94, 0xbb, 0x48, 0xa0, 0xb5,
-	0x9b, 0x07, 0xda, 0xa0, 0xf3, 0xb4, 0xad, 0x37, 0x87, 0x6d, 0xe5, 0x2d, 0xea, 0xb8, 0x4e, 0xef,
-	0x89, 0x72, 0x0f, 0x5b, 0x86, 0x5f, 0x7c, 0xb8, 0xde, 0x66, 0x0c, 0x6a, 0x09, 0x2d, 0xc1, 0xde,
-	0x41, 0x92, 0x6d, 0xad, 0xdf, 0x68, 0x35, 0x1b, 0x83, 0xa1, 0xf2, 0x2e, 0x76, 0xcb, 0x60, 0xbf,
-	0xdb, 0x19, 0x2a, 0xf7, 0xb1, 0xed, 0x8f, 0x1b, 0xc3, 0xdd, 0xb6, 0xa6, 0xbc, 0x87, 0x23, 0x3f,
-	0xec, 0xec, 0xb5, 0x75, 0x31, 0x0c, 0x0f, 0xb0, 0x8c, 0x9d, 0x4e, 0xb7, 0xab, 0x3c, 0xa4, 0xd3,
-	0x9a, 0x86, 0x36, 0xec, 0xd0, 0xd8, 0x7f, 0x88, 0x19, 0x34, 0xf6, 0xf7, 0xbb, 0x5f, 0x29, 0x1f,
-	0x61, 0x03, 0xf7, 0x0e, 0xba, 0xc3, 0x8e, 0x7e, 0xb0, 0xdf, 0x6a, 0x0c, 0xdb, 0xca, 0xc7, 0x34,
-	0x31, 0xfa, 0x83, 0x61, 0x6b, 0xaf, 0xab, 0x7c, 0xa2, 0xfe, 0x16, 0x94, 0x22, 0x3b, 0x12, 0xb9,
-	0x3a, 0xbd, 0x5e, 0x5b, 0x53, 0xd6, 0x30, 0xe7, 0x6e, 0x7b, 0x67, 0xa8, 0x64, 0xe8, 0xa4, 0xaa,
-	0xf3, 0x78, 0x77, 0xa8, 0xac, 0xe3, 0x67, 0xff, 0x00, 0x3b, 0x29, 0x4b, 0xad, 0x6b, 0xef, 0x75,
-	0x94, 0x1c, 0x7e, 0x35, 0x7a, 0xc3, 0x8e, 0x92, 0xa7, 0x69, 0xd3, 0xe9, 0x3d, 0xee, 0xb6, 0x95,
-	0x02, 0x42, 0xf7, 0x1a, 0xda, 0x13, 0xa5, 0xc8, 0x33, 0x6d, 0xb5, 0xbf, 0x54, 0x4a, 0xac, 0x00,
-	0xeb, 0xdd, 0x07, 0x4a, 0x19, 0x41, 0xad, 0x76, 0xeb, 0x60, 0x5f, 0x01, 0xf5, 0x2e, 0x14, 0x1b,
-	0x87, 0x87, 0x7b, 0x68, 0xa6, 0x63, 0x63, 0x0e, 0xba, 0x5d, 0xbe, 0x8c, 0xb6, 0xfb, 0xc3, 0x61,
-	0x7f, 0x4f, 0xc9, 0xe0, 0xc4, 0x1d, 0xf6, 0xf7, 0x95, 0x75, 0xb5, 0x03, 0xa5, 0x68, 0x13, 0x93,
-	0xee, 0xb0, 0x95, 0x20, 0xb7, 0xaf, 0xb5, 0x9f, 0xf2, 0xe3, 0xd5, 0x5e, 0xfb, 0x4b, 0xac, 0x26,
-	0x7e, 0x61, 0x46, 0x59, 0x2c, 0x88, 0x5f, 0x36, 0xa3, 0x4b, 0x6c, 0xdd, 0x4e, 0xaf, 0xdd, 0xd0,
-	0x94, 0xbc, 0xfa, 0x71, 0xea, 0xe4, 0x4a, 0x48, 0x8d, 0x32, 0xe4, 0xdb, 0x9a, 0xd6, 0x17, 0xf7,
-	0x39, 0x3b, 0x8f, 0x7b, 0x7d, 0xad, 0xcd, 0xaf, 0xc5, 0x89, 0x8e, 0x5b, 0x57, 0xdf, 0x86, 0x72,
-	0x2c, 0xf2, 0x70, 0x22, 0x35, 0xb5, 0xfe, 0x60, 0xc0, 0xfb, 0x79, 0x0d, 0xd3, 0xd4, 0x39, 0x3c,
-	0x9d, 0x51, 0xff, 0x1f, 0x28, 0xc5, 0xd2, 0xf6, 0x0e, 0xac, 0x0f, 0x07, 0xc2, 0x9b, 0x7c, 0xe9,
-	0x7e, 0xf2, 0x7a, 0xc1, 0x30, 0xfa, 0xd2, 0xd6, 0x87, 0x03, 0xf6, 0x0e, 0x14, 0xf8, 0xdd, 0x45,
-	0x71, 0x20, 0x72, 0x29, 0x2d, 0xc1, 0x87, 0x84, 0xd3, 0x04, 0x8d, 0xda, 0x85, 0x5a, 0x1a, 0xc3,
-	0x6e, 0x02, 0x70, 0x9c, 0xe4, 0x4f, 0x91, 0x20, 0xec, 0x3a, 0x44, 0x77, 0x23, 0x5b, 0x22, 0xe0,
-	0x30, 0x4e, 0xab, 0x7f, 0x3f, 0x0b, 0x90, 0x68, 0x5c, 0xa8, 0xd3, 0xc5, 0xde, 0x92, 0xbc, 0x38,
-	0x68, 0x7c, 0x05, 0xca, 0x8e, 0x67, 0x98, 0xf2, 0x2b, 0x04, 0x25, 0x04, 0x50, 0x6f, 0xc8, 0x37,
-	0xa0, 0xca, 0xfc, 0x94, 0x9f, 0x5d, 0x81, 0xc2, 0xc4, 0xf3, 0xa7, 0x46, 0x14, 0x9a, 0x28, 0x52,
-	0xb8, 0xf7, 0xf0, 0xc3, 0x2f, 0xd4, 0x3b, 0x5d, 0xba, 0x5d, 0x40, 0x71, 0xae, 0x02, 0xd8, 0x45,
-	0x18, 0x5a, 0x26, 0x96, 0x3b, 0x76, 0xbc, 0xc0, 0x32, 0xd1, 0x66, 0x2f, 0x90, 0x72, 0x09, 0x11,
-	0x68, 0xfb, 0x8c, 0xb7, 0xd6, 0x9f, 0xda, 0xae, 0x11, 0x0a, 0x97, 0x29, 0xb5, 0x36, 0x82, 0x60,
-	0x75, 0xbf, 0x0e, 0x3c, 0xe1, 0x3c, 0xe1, 0xe7, 0x68, 0x25, 0x04, 0x50, 0x75, 0x5f, 0x05, 0xb0,
-	0x82, 0xb1, 0x31, 0xe3, 0x99, 0x97, 0x29, 0xf3, 0xb2, 0x80, 0x6c, 0x9f, 0xb1, 0x2e, 0xd4, 0x86,
-	0x23, 0x94, 0xf7, 0x1e, 0xda, 0xc1, 0x4d, 0xcf, 0x11, 0x6e, 0x8d, 0x3b, 0x8b, 0xaa, 0xe9, 0xfd,
-	0x34, 0x19, 0x3f, 0xf0, 0x5b, 0xe0, 0xbd, 0xde, 0x80, 0x8b, 0x2b, 0xc8, 0x5e, 0x2a, 0x70, 0xe9,
-	0xaf, 0x72, 0x00, 0x89, 0x7d, 0x91, 0x3a, 0x05, 0xcc, 0xa4, 0x4f, 0x01, 0x1f, 0xc0, 0x15, 0x71,
-	0x79, 0x48, 0x5c, 0x12, 0x39, 0xd5, 0x6d, 0x57, 0x1f, 0x19, 0xd1, 0x81, 0x2b, 0x13, 0x58, 0x1e,
-	0x46, 0xd4, 0x71, 0xb7, 0x8d, 0x10, 0xb7, 0x42, 0x99, 0x27, 0x3c, 0x9b, 0xa5, 0x0f, 0x8c, 0xe5,
-	0xad, 0x30, 0x61, 0x1f, 0x9e, 0xcd, 0xd8, 0xfb, 0x70, 0xd9, 0xb7, 0x26, 0xbe, 0x15, 0x1c, 0xe9,
-	0x61, 0x20, 0x17, 0xc6, 0x63, 0x96, 0x36, 0x05, 0x72, 0x18, 0xc4, 0x65, 0xbd, 0x0f, 0x97, 0x85,
-	0xe5, 0xb1, 0x50, 0x3d, 0x7e, 0xd6, 0xb6, 0xc9, 0x91, 0x72, 0xed, 0x5e, 0x05, 0x10, 0x46, 0x57,
-	0xf4, 0xba, 0x46, 0x49, 0x2b, 0x73, 0x03, 0x0b, 0xad, 0xe4, 0x77, 0x80, 0xd9, 0x81, 0xbe, 0x70,
-	0x6c, 0x21, 0x8e, 0x55, 0x15, 0x3b, 0xd8, 0x4f, 0x1d, 0x59, 0x9c, 0x77, 0x22, 0x52, 0x3a, 0xef,
-	0x44, 0xe4, 0x12, 0xe4, 0xc9, 0x2e, 0x13, 0x07, 0x14, 0x3c, 0xc1, 0x54, 0xc8, 0xa1, 0xc8, 0x22,
-	0x67, 0x7a, 0xed, 0x41, 0xed, 0x3e, 0xbd, 0x4d, 0x82, 0xe3, 0x83, 0x50, 0x8d, 0x70, 0xec, 0x5d,
-	0xb8, 0x28, 0x77, 0x6a, 0x74, 0xf1, 0xbe, 0x42, 0xcd, 0x54, 0x92, 0x6e, 0xd4, 0xf8, 0x15, 0xfc,
-	0xb7, 0x81, 0x49, 0xfd, 0x12, 0x51, 0x57, 0xf9, 0xa1, 0x62, 0xdc, 0x29, 0x82, 0xf8, 0x4d, 0xa0,
-	0x0e, 0xe0, 0x1e, 0xdf, 0x8d, 0x65, 0x2b, 0x04, 0x91, 0xe4, 0x1d, 0x7e, 0x1f, 0x2e, 0x27, 0x7d,
-	0xa7, 0x1b, 0xa1, 0x1e, 0x1e, 0x59, 0xba, 0xe5, 0x9a, 0x74, 0x9f, 0xac, 0xa4, 0x6d, 0xc6, 0xdd,
-	0xd8, 0x08, 0x87, 0x47, 0x56, 0xdb, 0x35, 0xd5, 0xdf, 0xcf, 0x40, 0x2d, 0x6d, 0x02, 0xf1, 0x18,
-	0xe3, 0x24, 0x78, 0x3a, 0x9f, 0x04, 0x4c, 0xbf, 0x02, 0xe5, 0xd9, 0xb1, 0x88, 0x94, 0x8e, 0x44,
-	0xc2, 0xec, 0x98, 0x47, 0x48, 0xb3, 0xb7, 0xa0, 0x38, 0x3b, 0xe6, 0xcb, 0xef, 0xbc, 0xd9, 0x54,
-	0x98, 0xf1, 0xe0, 0xc5, 0xb7, 0xa0, 0x38, 0x17, 0xa4, 0xb9, 0xf3, 0x48, 0xe7, 0x44, 0xaa, 0x6e,
-	0x41, 0x55, 0x76, 0x3a, 0xe0, 0x2a, 0x42, 0x03, 0x83, 0x57, 0x0c, 0x3f, 0xd5, 0xdf, 0x5e, 0x27,
-	0x92, 0x97, 0x3a, 0x0a, 0x7d, 0xa9, 0xe3, 0xe8, 0x2d, 0x8a, 0xb6, 0xd2, 0x29, 0x96, 0x72, 0xec,
-	0x45, 0x4f, 0x46, 0xc0, 0x91, 0x11, 0x34, 0xe6, 0xa1, 0xd7, 0xf4, 0x1c, 0x71, 0xc2, 0x2f, 0x2e,
-	0xa7, 0xe4, 0x22, 0x07, 0xbb, 0xb8, 0xb7, 0xf6, 0xbe, 0xb8, 0xc1, 0x41, 0xd7, 0xa7, 0x28, 0xaa,
-	0x20, 0xbf, 0x34, 0x82, 0xd5, 0xe8, 0xf6, 0x14, 0x05, 0x0c, 0x3c, 0x80, 0x0b, 0x49, 0xb8, 0x6c,
-	0x14, 0x88, 0xb0, 0xc8, 0xb2, 0x11, 0xc7, 0xca, 0x62, 0x52, 0xfd, 0xdd, 0x0c, 0x6c, 0x2e, 0xd9,
-	0xf0, 0xd8, 0x5b, 0xc9, 0xeb, 0x32, 0xf8, 0xc9, 0x6e, 0x43, 0x75, 0x6a, 0x84, 0xe3, 0x23, 0x7d,
-	0xe6, 0x5b, 0x13, 0xfb, 0x34, 0x7a, 0x22, 0x87, 0x60, 0xfb, 0x04, 0xa2, 0xa0, 0x8a, 0xd9, 0x8c,
-	0x3c, 0x17, 0x53, 0x3b, 0x14, 0xf7, 0xd7, 0x80, 0x40, 0x5d, 0x72, 0x86, 0x46, 0x01, 0x57, 0xb9,
-	0x73, 0xe2, 0xc3, 0x6e, 0x40, 0xa1, 0x13, 0xfb, 0x0a, 0xe2, 0xd7, 0x22, 0xb2, 0xe2, 0x85, 0x08,
-	0x0f, 0xca, 0x4d, 0x7a, 0x6d, 0x62, 0xcf, 0x98, 0xb1, 0x7b, 0x90, 0x9d, 0x1a, 0x33, 0x11, 0x0a,
-	0x56, 0x8f, 0x3d, 0xfc, 0x1c, 0x7b, 0x7f, 0xcf, 0x98, 0x71, 0x11, 0x8b, 0x44, 0xd7, 0x3f, 0x86,
-	0x52, 0x04, 0x78, 0x29, 0x61, 0xfa, 0x1f, 0xb3, 0x50, 0x6e, 0xc9, 0x5e, 0x45, 0xb4, 0x9e, 0x42,
-	0x7f, 0xee, 0xa2, 0x36, 0x20, 0xce, 0x43, 0x2a, 0x63, 0xc3, 0x1d, 0x0a, 0x50, 0x34, 0x81, 0xd6,
-	0xbf, 0x63, 0x02, 0xdd, 0x00, 0xf0, 0xc9, 0xd6, 0x25, 0x73, 0x37, 0x1b, 0x47, 0xa8, 0x75, 0x4c,
-	0x11, 0x30, 0xb0, 0x7c, 0x56, 0x9c, 0xfb, 0xfe, 0x67, 0xc5, 0xf9, 0x95, 0x67, 0xc5, 0xff, 0xdb,
-	0x9c, 0xee, 0xbe, 0x91, 0xec, 0x1f, 0x38, 0xa7, 0x91, 0xac, 0xcc, 0x63, 0x56, 0x67, 0x71, 0x4c,
-	0x3b, 0xd2, 0x7d, 0x06, 0xb5, 0xa8, 0x9b, 0x45, 0xc3, 0x20, 0x15, 0x46, 0x2f, 0x70, 0xdc, 0x61,
-	0xba, 0x11, 0xca, 0xc9, 0xf4, 0x0a, 0xad, 0x7c, 0xf7, 0x0a, 0x55, 0xff, 0x20, 0x03, 0x4c, 0x98,
-	0x9a, 0x3b, 0x73, 0xc7, 0x19, 0x5a, 0xa7, 0x24, 0x08, 0xee, 0xc1, 0xa6, 0xf0, 0x76, 0x4a, 0x21,
-	0x36, 0xe2, 0xdc, 0x89, 0x23, 0x92, 0x73, 0xa7, 0x55, 0x37, 0x08, 0xd7, 0x57, 0xde, 0x20, 0x5c,
-	0x7d, 0x33, 0xf1, 0x16, 0x54, 0xe4, 0xfb, 0x77, 0x5c, 0x03, 0x02, 0x23, 0xb9, 0x7a, 0xf7, 0xef,
-	0xd6, 0x01, 0x12, 0x73, 0xf8, 0xd7, 0x1d, 0x71, 0xb0, 0x62, 0x48, 0xb2, 0xab, 0x86, 0xe4, 0x2e,
-	0x28, 0x32, 0x9d, 0x74, 0x11, 0xb4, 0x96, 0x10, 0x52, 0x33, 0xb9, 0x4c, 0x93, 0x2e, 0xeb, 0x91,
-	0x4c, 0x13, 0x87, 0x99, 0x22, 0xa4, 0x89, 0x44, 0xae, 0xd8, 0xa2, 0x4b, 0x76, 0xc0, 0x45, 0x30,
-	0xfb, 0x14, 0xae, 0xc5, 0x9c, 0xfa, 0x89, 0x1d, 0x1e, 0x79, 0xf3, 0x50, 0xb8, 0x1f, 0x03, 0xb1,
-	0x51, 0x5f, 0x89, 0x72, 0x7a, 0xc6, 0xd1, 0x5c, 0x64, 0x05, 0xec, 0x23, 0x28, 0x4f, 0xe6, 0x8e,
-	0xa3, 0x87, 0xd6, 0x69, 0x28, 0xa2, 0x5a, 0xeb, 0x29, 0x4f, 0x82, 0x34, 0xbc, 0x5a, 0x69, 0x22,
-	0x12, 0xea, 0x7f, 0x5f, 0x87, 0xfc, 0x4f, 0xe7, 0x96, 0x7f, 0xc6, 0x3e, 0x86, 0x72, 0x10, 0x4e,
-	0x43, 0xf9, 0xec, 0xef, 0x1a, 0xcf, 0x80, 0xf0, 0x74, 0x74, 0x67, 0x4d, 0x2d, 0x37, 0xe4, 0xce,
-	0x31, 0xa4, 0xa5, 0x1d, 0xe9, 0x12, 0xe4, 0x83, 0xd0, 0x9a, 0xf1, 0xb3, 0xc6, 0xbc, 0xc6, 0x13,
-	0x6c, 0x0b, 0xf2, 0xae, 0x67, 0x5a, 0x41, 0x3a, 0x1a, 0xad, 0x87, 0x9b, 0x3e, 0x47, 0x30, 0x15,
-	0x0a, 0xf1, 0x88, 0x2f, 0x9d, 0xbf, 0x71, 0x0c, 0x85, 0x85, 0x5b, 0x86, 0x69, 0xbb, 0x87, 0xd1,
-	0xc5, 0xda, 0x38, 0x8d, 0x7b, 0x2d, 0xe9, 0xd4, 0xc6, 0x61, 0x74, 0xcb, 0x5d, 0x24, 0xd9, 0x16,
-	0x54, 0xf0, 0xf3, 0x99, 0x6f, 0x87, 0xd6, 0xe0, 0xa1, 0xe8, 0x37, 0x19, 0x84, 0x1a, 0xb1, 0x69,
-	0x85, 0xd6, 0x38, 0x1c, 0x7c, 0x23, 0x82, 0x86, 0x28, 0x1a, 0x24, 0x82, 0xa8, 0x26, 0x6c, 0xa4,
-	0x9a, 0xbb, 0xe4, 0xbb, 0x18, 0xb4, 0xbb, 0x68, 0xa9, 0x67, 0x24, 0xe3, 0x7b, 0x5d, 0x36, 0xb8,
-	0xb3, 0x92, 0x25, 0x9e, 0x93, 0x2c, 0xa3, 0x3c, 0xd9, 0xf1, 0x6d, 0xed, 0x71, 0x5b, 0x29, 0xa8,
-	0x7f, 0xb8, 0x0e, 0x9b, 0x43, 0xdf, 0x70, 0x03, 0x83, 0xdf, 0x96, 0x72, 0x43, 0xdf, 0x73, 0xd8,
-	0x67, 0x50, 0x0a, 0xc7, 0x8e, 0x3c, 0x0c, 0xb7, 0xa2, 0x45, 0xbf, 0x40, 0x7a, 0x7f, 0x38, 0xe6,
-	0x9e, 0xca, 0x62, 0xc8, 0x3f, 0xd8, 0xbb, 0x90, 0x1f, 0x59, 0x87, 0xb6, 0x2b, 0x04, 0xf0, 0xe5,
-	0x45, 0xc6, 0x6d, 0x44, 0xee, 0xae, 0x69, 0x9c, 0x8a, 0xbd, 0x0f, 0x85, 0xb1, 0x37, 0x8d, 0x76,
-	0xaa, 0xe4, 0x62, 0x87, 0x54, 0x10, 0x62, 0x77, 0xd7, 0x34, 0x41, 0xc7, 0x3e, 0x86, 0x92, 0xef,
-	0x39, 0xce, 0xc8, 0x18, 0x1f, 0x8b, 0x3d, 0xac, 0xbe, 0xc8, 0xa3, 0x09, 0xfc, 0xee, 0x9a, 0x16,
-	0xd3, 0xaa, 0xf7, 0xa1, 0x28, 0x2a, 0x8b, 0x1d, 0xb0, 0xdd, 0x7e, 0xdc, 0x11, 0x1d, 0xd9, 0xec,
-	0xef, 0xed, 0x75, 0x86, 0xfc, 0x06, 0xa9, 0xd6, 0xef, 0x76, 0xb7, 0x1b, 0xcd, 0x27, 0xca, 0xfa,
-	0x76, 0x09, 0x0a, 0xdc, 0xb3, 0xa5, 0xfe, 0x4e, 0x06, 0x2e, 0x2c, 0x34, 0x80, 0x3d, 0x82, 0xdc,
-	0x14, 0x95, 0x4a, 0xde, 0x3d, 0x77, 0x56, 0xb6, 0x52, 0x4a, 0x73, 0x55, 0x13, 0x39, 0xd4, 0x4f,
-	0xa1, 0x96, 0x86, 0x4b, 0x06, 0xf2, 0x06, 0x94, 0xb5, 0x76, 0xa3, 0xa5, 0xf7, 0x7b, 0x68, 0x95,
-	0xa2, 0x95, 0x4a, 0xc9, 0x67, 0x5a, 0x87, 0x4c, 0xda, 0xdf, 0x04, 0x65, 0xb1, 0x63, 0xd8, 0x63,
-	0xb8, 0x80, 0xea, 0x87, 0x63, 0xf1, 0x8d, 0x22, 0x19, 0xb2, 0x9b, 0x2b, 0x7a, 0x52, 0x90, 0xd1,
-	0x88, 0xd5, 0xc6, 0xa9, 0xb4, 0xfa, 0x7f, 0x03, 0x5b, 0xee, 0xc1, 0x5f, 0x5f, 0xf6, 0xff, 0x2d,
-	0x03, 0xb9, 0x7d, 0xc7, 0x70, 0xd9, 0x6b, 0x90, 0xa7, 0x97, 0x4f, 0x84, 0xf4, 0xac, 0x48, 0x0b,
-	0x1c, 0xa7, 0x05, 0xe1, 0xd8, 0xdb, 0x90, 0x0d, 0xc7, 0xd1, 0x6d, 0xd9, 0xab, 0xe7, 0x4c, 0xbe,
-	0xdd, 0x35, 0x0d, 0xa9, 0xd8, 0x5d, 0xc8, 0x9a, 0x66, 0x14, 0x36, 0x2b, 0xec, 0x70, 0x34, 0xde,
-	0x5a, 0xd6, 0xc4, 0x76, 0x6d, 0xf1, 0x52, 0x0b, 0x92, 0xb0, 0xd7, 0x21, 0x6b, 0x8e, 0x9d, 0x74,
-	0x0c, 0x34, 0x37, 0xf3, 0xe2, 0x0c, 0xcd, 0xb1, 0xc3, 0x54, 0xd8, 0x08, 0xfd, 0x33, 0xdd, 0x9f,
-	0xbb, 0x14, 0x27, 0x13, 0x08, 0x73, 0xa7, 0x82, 0xca, 0xcc, 0x9c, 0x82, 0x6d, 0x02, 0x71, 0xeb,
-	0x66, 0xe6, 0x5b, 0x33, 0xc3, 0x8f, 0x0d, 0x1d, 0x3b, 0xd8, 0xe7, 0x80, 0xed, 0x02, 0xd0, 0xc3,
-	0x89, 0xea, 0x3b, 0xf4, 0x2c, 0x07, 0x6a, 0xd8, 0x6a, 0xf4, 0xb5, 0xe2, 0x52, 0xa3, 0xc0, 0xa8,
-	0x7f, 0x99, 0x85, 0x8a, 0x54, 0x1f, 0xf6, 0x21, 0x94, 0xcc, 0xf4, 0x42, 0xbc, 0xb6, 0x54, 0xe9,
-	0xfb, 0xad, 0x68, 0x09, 0x9a, 0x62, 0x7a, 0x7f, 0x0a, 0x1b, 0x81, 0x15, 0xea, 0xcf, 0x0d, 0xdf,
-	0xe6, 0x4f, 0x16, 0xad, 0xcb, 0x67, 0x79, 0x03, 0x2b, 0x7c, 0x1a, 0x61, 0x76, 0xd7, 0xb4, 0x6a,
-	0x20, 0xa5, 0xc9, 0x0c, 0x10, 0x4d, 0xca, 0xa6, 0x9e, 0x75, 0xe2, 0xc0, 0xdd, 0x35, 0x2d, 0xc2,
-	0x23, 0xa9, 0x75, 0x6a, 0x8d, 0xe7, 0x61, 0x64, 0x06, 0x6c, 0x44, 0x0d, 0x22, 0x20, 0xbd, 0x20,
-	0xc7, 0x3f, 0xd9, 0x03, 0x94, 0x75, 0x86, 0xe3, 0x78, 0xa4, 0xb3, 0xe5, 0x65, 0x1f, 0x73, 0x2b,
-	0x86, 0xf3, 0x17, 0xeb, 0xa2, 0x14, 0x7b, 0x03, 0xf2, 0x5e, 0x78, 0x64, 0x45, 0xca, 0x73, 0xf4,
-	0xc0, 0x07, 0x82, 0x5a, 0xcd, 0x2e, 0xce, 0x14, 0x42, 0xab, 0xbf, 0xc8, 0x40, 0x51, 0xf4, 0x00,
-	0xdb, 0x84, 0x8d, 0x41, 0x7b, 0xa8, 0x3f, 0x6d, 0x68, 0x9d, 0xc6, 0x76, 0xb7, 0x2d, 0x42, 0xb7,
-	0x1f, 0x6b, 0x8d, 0x9e, 0x90, 0x93, 0x5a, 0xfb, 0x69, 0xff, 0x49, 0x9b, 0x7b, 0x9d, 0x5a, 0xed,
-	0xde, 0x57, 0x4a, 0x96, 0x3b, 0x5e, 0xdb, 0xfb, 0x0d, 0x0d, 0xa5, 0x64, 0x05, 0x8a, 0xed, 0x2f,
-	0xdb, 0xcd, 0x03, 0x12, 0x93, 0x35, 0x80, 0x56, 0xbb, 0xd1, 0xed, 0xf6, 0x9b, 0x28, 0x36, 0x0b,
-	0x8c, 0x41, 0xad, 0xa9, 0xb5, 0x1b, 0xc3, 0xb6, 0xde, 0x68, 0x36, 0xfb, 0x07, 0xbd, 0xa1, 0x52,
-	0xc4, 0x12, 0x1b, 0xdd, 0x61, 0x5b, 0x8b, 0x41, 0xf4, 0xe8, 0x52, 0x4b, 0xeb, 0xef, 0xc7, 0x90,
-	0xf2, 0x76, 0x19, 0x4d, 0x32, 0x1a, 0x2b, 0xf5, 0xaf, 0x6a, 0x50, 0x4b, 0x4f, 0x4d, 0xf6, 0x09,
-	0x94, 0x4c, 0x33, 0x35, 0xc6, 0x37, 0x56, 0x4d, 0xe1, 0xfb, 0x2d, 0x33, 0x1a, 0x66, 0xfe, 0xc1,
-	0x6e, 0x47, 0x0b, 0x69, 0x7d, 0x69, 0x21, 0x45, 0xcb, 0xe8, 0x27, 0x70, 0x41, 0x3c, 0x90, 0x61,
-	0x1a, 0xa1, 0x31, 0x32, 0x02, 0x2b, 0xbd, 0x4a, 0x9a, 0x84, 0x6c, 0x09, 0xdc, 0xee, 0x9a, 0x56,
-	0x1b, 0xa7, 0x20, 0xec, 0x47, 0x50, 0x33, 0xc8, 0xce, 0x8d, 0xf9, 0x73, 0xb2, 0x12, 0xd8, 0x40,
-	0x9c, 0xc4, 0xbe, 0x61, 0xc8, 0x00, 0x9c, 0x88, 0xa6, 0xef, 0xcd, 0x12, 0xe6, 0xbc, 0x3c, 0x11,
-	0x5b, 0xbe, 0x37, 0x93, 0x78, 0xab, 0xa6, 0x94, 0x66, 0x1f, 0x43, 0x55, 0xd4, 0x3c, 0xf1, 0x24,
-	0xc4, 0x4b, 0x96, 0x57, 0x9b, 0x94, 0xba, 0xdd, 0x35, 0xad, 0x32, 0x4e, 0x92, 0xec, 0x21, 0x6a,
-	0x72, 0x89, 0x2e, 0x5e, 0x94, 0xe7, 0x1a, 0xd5, 0x36, 0xe2, 0x02, 0x23, 0x4e, 0xb1, 0xf7, 0x01,
-	0xa8, 0x9e, 0x9c, 0xa7, 0x94, 0x8a, 0x6d, 0xf0, 0xbd, 0x59, 0xc4, 0x52, 0x36, 0xa3, 0x84, 0x54,
-	0x3d, 0x7e, 0xbf, 0xb0, 0xbc, 0x5c, 0x3d, 0xba, 0xf5, 0x96, 0x54, 0x8f, 0xdf, 0x27, 0x8c, 0xab,
-	0xc7, 0xd9, 0x60, 0xa9, 0x7a, 0x11, 0x17, 0xaf, 0x1e, 0x67, 0x8a, 0xaa, 0xc7, 0x79, 0x2a, 0x8b,
-	0xd5, 0x8b, 0x58, 0xa8, 0x7a, 0x9c, 0xe3, 0x47, 0x4b, 0xba, 0x7b, 0xf5, 0x5c, 0xdd, 0x1d, 0x87,
-	0x2d, 0xad, 0xbd, 0xff, 0x08, 0x6a, 0xc1, 0x91, 0x77, 0x22, 0x09, 0x90, 0x0d, 0x99, 0x7b, 0x70,
-	0xe4, 0x9d, 0xc8, 0x12, 0x64, 0x23, 0x90, 0x01, 0x58, 0x5b, 0xde, 0x44, 0xba, 0x41, 0x5c, 0x93,
-	0x6b, 0x4b, 0x2d, 0x7c, 0x6a, 0x5b, 0x27, 0x58, 0x5b, 0x23, 0x4a, 0x60, 0xa7, 0x24, 0x7e, 0x8f,
-	0x40, 0x84, 0xf1, 0xa4, 0xce, 0xe9, 0x45, 0x49, 0x10, 0x7b, 0x40, 0x02, 0x9c, 0x5b, 0x73, 0x57,
-	0x66, 0x53, 0xe4, 0xb9, 0x75, 0xe0, 0xa6, 0x18, 0xab, 0x9c, 0x54, 0xb0, 0x26, 0xab, 0x22, 0xb0,
-	0xbe, 0x99, 0x5b, 0xee, 0xd8, 0x12, 0x01, 0x3f, 0xa9, 0x55, 0x31, 0x10, 0xb8, 0x64, 0x55, 0x44,
-	0x90, 0x78, 0x5e, 0xc7, 0xec, 0x6c, 0x71, 0x5e, 0x4b, 0xcc, 0x34, 0xaf, 0x63, 0xd6, 0x78, 0x41,
-	0xc5, 0xbc, 0x17, 0x97, 0x16, 0x94, 0xc4, 0xcc, 0x17, 0x54, 0xcc, 0xfd, 0x10, 0xc4, 0x6c, 0xe2,
-	0x9d, 0x9b, 0x0a, 0x0b, 0xe2, 0xb5, 0x16, 0xbd, 0x0b, 0xe3, 0x38, 0x85, 0x73, 0xd5, 0xb7, 0xd0,
-	0x56, 0x10, 0x53, 0xe1, 0xb2, 0x3c, 0x57, 0x35, 0xc2, 0xc4, 0x4b, 0xc9, 0x4f, 0x92, 0xea, 0x1f,
-	0xe7, 0xa1, 0x28, 0x84, 0x0e, 0xbb, 0x08, 0x17, 0x84, 0xec, 0x6b, 0x35, 0x86, 0x8d, 0xed, 0xc6,
-	0x00, 0xb5, 0x15, 0x06, 0x35, 0x2e, 0xfc, 0x62, 0x58, 0x06, 0x05, 0x22, 0x49, 0xbf, 0x18, 0xb4,
-	0x8e, 0x02, 0x51, 0xf0, 0xf2, 0x17, 0xeb, 0xb2, 0xec, 0x02, 0x54, 0x38, 0x23, 0x07, 0xd0, 0x95,
-	0x2a, 0xe2, 0xe2, 0xe9, 0xbc, 0xc4, 0xc2, 0x4f, 0x23, 0x0a, 0x09, 0x0b, 0x07, 0x14, 0x63, 0x96,
-	0xe8, 0xb8, 0x82, 0x41, 0x6d, 0xa8, 0x1d, 0xf4, 0x9a, 0x49, 0x39, 0x65, 0xba, 0x06, 0xc3, 0xb3,
-	0x79, 0xda, 0x69, 0x3f, 0x53, 0x00, 0x99, 0x78, 0x2e, 0x94, 0xae, 0xa0, 0xbe, 0x45, 0x99, 0x50,
-	0xb2, 0xca, 0xae, 0xc2, 0xc5, 0xc1, 0x6e, 0xff, 0x99, 0xce, 0x99, 0xe2, 0x26, 0x6c, 0xb0, 0x4b,
-	0xa0, 0x48, 0x08, 0x9e, 0x7d, 0x0d, 0x8b, 0x24, 0x68, 0x44, 0x38, 0x50, 0x2e, 0xd0, 0x79, 0x1f,
-	0xc2, 0x86, 0x7c, 0x03, 0x52, 0xb0, 0x29, 0x9c, 0xb5, 0xdf, 0x3d, 0xd8, 0xeb, 0x0d, 0x94, 0x4d,
-	0xac, 0x04, 0x41, 0x78, 0xcd, 0x59, 0x9c, 0x4d, 0xb2, 0x6d, 0x5d, 0xa4, 0x9d, 0x0c, 0x61, 0xcf,
-	0x1a, 0x5a, 0xaf, 0xd3, 0x7b, 0x3c, 0x50, 0x2e, 0xc5, 0x39, 0xd3, 0xb9, 0xc7, 0x40, 0xb9, 0x1c,
-	0x03, 0x06, 0xc3, 0xc6, 0xf0, 0x60, 0xa0, 0x5c, 0x89, 0x6b, 0xb9, 0xaf, 0xf5, 0x9b, 0xed, 0xc1,
-	0xa0, 0xdb, 0x19, 0x0c, 0x95, 0xab, 0xec, 0x32, 0x6c, 0x26, 0x35, 0x8a, 0x88, 0xeb, 0x52, 0x45,
-	0xb5, 0xc7, 0xed, 0xa1, 0x72, 0x2d, 0xae, 0x46, 0xb3, 0xdf, 0xed, 0x36, 0xe8, 0x64, 0xea, 0x3a,
-	0x12, 0xd1, 0x91, 0x9d, 0x68, 0xcd, 0x2b, 0x58, 0xaf, 0x83, 0x9e, 0x0c, 0xba, 0x21, 0x4d, 0x8d,
-	0x41, 0xfb, 0xa7, 0x07, 0xed, 0x5e, 0xb3, 0xad, 0xbc, 0x9a, 0x4c, 0x8d, 0x18, 0x76, 0x33, 0x9e,
-	0x1a, 0x31, 0xe8, 0x56, 0x5c, 0x66, 0x04, 0x1a, 0x28, 0x5b, 0x98, 0x9f, 0xa8, 0x47, 0xaf, 0xd7,
-	0x6e, 0x0e, 0xb1, 0xad, 0xb7, 0xe3, 0x5e, 0x3c, 0xd8, 0x7f, 0xac, 0x35, 0x5a, 0x6d, 0x45, 0x45,
-	0x88, 0xd6, 0xee, 0x35, 0xf6, 0xa2, 0xd1, 0x7e, 0x6d, 0xbb, 0x4a, 0xaf, 0xe0, 0x8a, 0xed, 0x52,
-	0xfd, 0x02, 0x98, 0xfc, 0x9c, 0xa4, 0x78, 0x31, 0x8a, 0x41, 0x6e, 0xe2, 0x7b, 0xd3, 0xe8, 0x0a,
-	0x31, 0x7e, 0xa3, 0xad, 0x36, 0x9b, 0x8f, 0xe8, 0x78, 0x29, 0xb9, 0x63, 0x28, 0x83, 0xd4, 0x3f,
-	0xce, 0x40, 0x2d, 0xbd, 0x55, 0xa2, 0x8a, 0x68, 0x4f, 0x74, 0xd7, 0x0b, 0xf9, 0x53, 0x44, 0x41,
-	0xe4, 0x89, 0xb2, 0x27, 0x3d, 0x2f, 0xa4, 0xb7, 0x88, 0xc8, 0x74, 0x8c, 0x77, 0x3e, 0x9e, 0x6b,
-	0x9c, 0x66, 0x1d, 0xb8, 0x98, 0x7a, 0x6d, 0x33, 0xf5, 0x10, 0x54, 0x3d, 0x7e, 0x23, 0x70, 0xa1,
-	0xfe, 0x1a, 0x0b, 0x96, 0xdb, 0xa4, 0x40, 0x36, 0xf8, 0xc6, 0x11, 0x8e, 0x00, 0xfc, 0x54, 0x77,
-	0x61, 0x23, 0xb5, 0x33, 0x93, 0xc5, 0x3f, 0x49, 0xd7, 0xb4, 0x64, 0x4f, 0x5e, 0x5c, 0x4d, 0xf5,
-	0x8f, 0x32, 0x50, 0x95, 0xf7, 0xe9, 0x1f, 0x9c, 0x13, 0x5d, 0x7f, 0x10, 0xdf, 0xba, 0x6d, 0x46,
-	0x4f, 0x10, 0x45, 0xa0, 0x0e, 0xbd, 0xfe, 0xcd, 0x7d, 0xb0, 0x3b, 0xc7, 0x83, 0xb8, 0x39, 0x32,
-	0x08, 0x4d, 0x66, 0xba, 0x63, 0xb6, 0xf3, 0x04, 0x09, 0xc4, 0x05, 0x8a, 0x04, 0xa2, 0xde, 0x82,
-	0xf2, 0xce, 0x71, 0x14, 0x31, 0x20, 0x3f, 0xc8, 0x55, 0xe6, 0x17, 0x53, 0xd5, 0x3f, 0xcd, 0x40,
-	0x2d, 0x79, 0x64, 0x81, 0xa2, 0x09, 0xf9, 0x2b, 0xad, 0x7c, 0x3a, 0xac, 0x9b, 0xa3, 0xe4, 0x61,
-	0xf0, 0x75, 0xf9, 0x61, 0xf0, 0xd7, 0x44, 0x66, 0x59, 0x79, 0x37, 0x8b, 0xcb, 0x12, 0xd7, 0x5e,
-	0x1f, 0x42, 0x15, 0xff, 0x6b, 0xd6, 0xc4, 0xf2, 0x7d, 0x2b, 0x7a, 0xb0, 0x76, 0x89, 0x38, 0x45,
-	0x44, 0x16, 0x89, 0x35, 0x11, 0x8a, 0xd1, 0xca, 0x77, 0x20, 0x10, 0xaf, 0xfe, 0xd3, 0x1c, 0x54,
-	0x24, 0xad, 0xe7, 0x7b, 0x4d, 0xbf, 0x1b, 0x50, 0x4e, 0x5e, 0x18, 0x10, 0x77, 0x0d, 0x63, 0x40,
-	0x6a, 0xac, 0xb2, 0x0b, 0x63, 0x55, 0x87, 0xa2, 0x08, 0x3b, 0x14, 0x7e, 0xcf, 0x28, 0x99, 0x76,
-	0xec, 0xe5, 0x5f, 0xe0, 0x7a, 0xff, 0x00, 0xaa, 0x92, 0x57, 0x2e, 0x7a, 0xae, 0x64, 0x91, 0xbe,
-	0x92, 0x78, 0xe8, 0x02, 0x76, 0x19, 0x0a, 0x93, 0x63, 0xdd, 0x1c, 0x45, 0x6e, 0xce, 0xfc, 0xe4,
-	0xb8, 0x35, 0xa2, 0xa3, 0x8b, 0x49, 0xbc, 0xd1, 0x73, 0x5f, 0x49, 0x69, 0x12, 0x6d, 0xe7, 0x77,
-	0xa1, 0x38, 0x39, 0xe6, 0xd1, 0xae, 0x65, 0x39, 0x06, 0x27, 0xe9, 0xf2, 0xc2, 0xe4, 0x98, 0x02,
-	0x5d, 0x3f, 0x05, 0x65, 0xc1, 0xa7, 0x1a, 0x88, 0xb3, 0xc2, 0xc5, 0x4a, 0x5d, 0x48, 0xbb, 0x57,
-	0x03, 0xf6, 0x1e, 0x5c, 0x12, 0x3b, 0xaf, 0x11, 0xe8, 0x3c, 0x84, 0x9e, 0x1e, 0xad, 0xe0, 0x2f,
-	0x7b, 0x6d, 0x72, 0x5c, 0x23, 0x18, 0x10, 0x06, 0x27, 0xab, 0x0a, 0x55, 0x69, 0xee, 0xf2, 0x17,
-	0x41, 0xca, 0x5a, 0x0a, 0xc6, 0x1e, 0x41, 0x75, 0x72, 0xcc, 0xe7, 0xc2, 0xd0, 0xdb, 0xb3, 0x44,
-	0x58, 0xf4, 0xa5, 0xc5, 0x59, 0x40, 0x31, 0xb0, 0x29, 0x4a, 0xf6, 0x2e, 0x30, 0xdf, 0x0a, 0x2d,
-	0x97, 0x5a, 0x62, 0x5a, 0x86, 0xe9, 0xd8, 0xae, 0x45, 0xca, 0x56, 0x56, 0xdb, 0x8c, 0x31, 0x2d,
-	0x81, 0x50, 0xff, 0x59, 0x06, 0x6a, 0x89, 0xf6, 0x8b, 0x0b, 0x9a, 0xdd, 0x93, 0x9f, 0x6a, 0xae,
-	0x2f, 0x2a, 0xc8, 0x48, 0x72, 0x7f, 0x78, 0x36, 0xe3, 0xcf, 0x39, 0xae, 0x7a, 0xd6, 0x65, 0x95,
-	0xcb, 0x35, 0xbb, 0xca, 0xe5, 0xaa, 0x3e, 0x86, 0xec, 0xf0, 0x6c, 0xc6, 0x3d, 0x2d, 0xb8, 0x07,
-	0x72, 0xab, 0x8c, 0xef, 0x7e, 0x14, 0x32, 0xf0, 0xa4, 0xfd, 0x15, 0xbf, 0x67, 0xbd, 0xaf, 0x75,
-	0xf6, 0x1a, 0xda, 0x57, 0x14, 0x0c, 0x42, 0x5a, 0xc2, 0x4e, 0x5f, 0x6b, 0x77, 0x1e, 0xf7, 0x08,
-	0x90, 0x23, 0x3f, 0x4c, 0x52, 0xc5, 0x86, 0x69, 0xee, 0x1c, 0xcb, 0xaf, 0x5b, 0x64, 0x52, 0xaf,
-	0x5b, 0xa4, 0xaf, 0x66, 0xae, 0x2f, 0x5e, 0xcd, 0x64, 0xf1, 0x8a, 0x8e, 0xc5, 0x03, 0x7b, 0x13,
-	0x72, 0x93, 0x63, 0xeb, 0x2c, 0x6d, 0xe2, 0xa4, 0x17, 0x23, 0x11, 0xa8, 0xbf, 0xcc, 0x00, 0x4b,
-	0x55, 0x84, 0x6b, 0xdd, 0x3f, 0xb4, 0x2e, 0x9f, 0x40, 0x5d, 0xbc, 0x7d, 0xc8, 0xa9, 0x24, 0x1f,
-	0xaf, 0xe8, 0xd2, 0xcb, 0x5e, 0x12, 0x32, 0x97, 0xbc, 0x3c, 0xc3, 0xde, 0x03, 0xfe, 0xf8, 0x1c,
-	0x4e, 0x90, 0xb4, 0x53, 0x43, 0x92, 0x15, 0x5a, 0x42, 0x93, 0xbc, 0x36, 0x27, 0xbf, 0xa2, 0xc7,
-	0xdd, 0xc3, 0x17, 0x92, 0x51, 0x23, 0xf9, 0xa1, 0xfe, 0x5e, 0x06, 0x2e, 0xa6, 0x27, 0xc4, 0xaf,
-	0xd6, 0xca, 0xf4, 0x93, 0x81, 0xd9, 0xc5, 0x27, 0x03, 0x57, 0xcd, 0xa7, 0xdc, 0xca, 0xf9, 0xf4,
-	0xff, 0x67, 0xe0, 0x92, 0xd4, 0xfb, 0x89, 0x9d, 0xf4, 0xb7, 0x54, 0x33, 0xe9, 0xe5, 0xc0, 0x5c,
-	0xea, 0xe5, 0x40, 0xf5, 0x0f, 0x33, 0x70, 0x65, 0xa1, 0x26, 0x9a, 0xf5, 0xb7, 0x5a, 0x97, 0xf4,
-	0x0b, 0x83, 0xe4, 0xa2, 0xe6, 0xd1, 0x87, 0xfc, 0xce, 0x1b, 0x4b, 0x3f, 0x19, 0xd8, 0xc5, 0x71,
-	0xfc, 0xe7, 0xe9, 0x4a, 0x9a, 0xc9, 0xc5, 0x1f, 0xf6, 0x11, 0x54, 0x12, 0x8d, 0x29, 0x7a, 0xd2,
-	0x61, 0xe5, 0xad, 0x21, 0x99, 0x6e, 0xa5, 0x18, 0x5d, 0xff, 0x7e, 0x62, 0xf4, 0x11, 0x54, 0xe3,
-	0x8c, 0x5b, 0xd6, 0x24, 0xed, 0x8d, 0x58, 0x78, 0x82, 0x28, 0x45, 0xa9, 0x7e, 0x08, 0x9b, 0x49,
-	0x2b, 0x9a, 0xe2, 0xd9, 0xac, 0x5b, 0x50, 0x71, 0xad, 0x13, 0x3d, 0x7a, 0x54, 0x4b, 0x44, 0xd1,
-	0xb8, 0xd6, 0x89, 0x20, 0x50, 0x77, 0x64, 0xb9, 0x17, 0xbf, 0x80, 0xee, 0x98, 0xf2, 0xc8, 0x14,
-	0x3d, 0xc7, 0x8c, 0x50, 0x98, 0x9b, 0x34, 0x30, 0x45, 0xd7, 0x3a, 0xa1, 0x39, 0x77, 0x22, 0xf2,
-	0x69, 0x98, 0xa6, 0x38, 0x30, 0x5f, 0xf5, 0x18, 0xcd, 0x35, 0x28, 0xcd, 0xfc, 0xd4, 0xc8, 0x16,
-	0x67, 0x3e, 0x2f, 0xf6, 0x8e, 0x88, 0xd1, 0x39, 0xef, 0x70, 0x9d, 0x47, 0xed, 0x88, 0x5f, 0x48,
-	0xc8, 0x25, 0xbf, 0x90, 0xf0, 0x91, 0x10, 0x79, 0xb8, 0xfe, 0x44, 0xc9, 0xf1, 0x21, 0x7a, 0xe6,
-	0xee, 0x06, 0x1d, 0xa2, 0x93, 0x06, 0x68, 0x7d, 0x23, 0xc2, 0x84, 0xf0, 0x53, 0xdd, 0x86, 0x8a,
-	0x64, 0xd9, 0xa1, 0x6a, 0x22, 0x79, 0x45, 0x82, 0xf4, 0x83, 0x1f, 0x49, 0x07, 0x69, 0x95, 0xc4,
-	0x29, 0x12, 0xa8, 0xbf, 0x0f, 0x00, 0x09, 0x2e, 0xa5, 0x30, 0x64, 0x16, 0x14, 0x86, 0x97, 0x3a,
-	0x91, 0xff, 0x10, 0x6a, 0x63, 0x6f, 0x76, 0xa6, 0x27, 0x1c, 0xd9, 0x95, 0x1c, 0x55, 0xa4, 0x1a,
-	0x26, 0x57, 0x6e, 0x96, 0x4f, 0x5a, 0x73, 0x2b, 0x4f, 0x5a, 0x3f, 0x80, 0x22, 0x77, 0xdc, 0x07,
-	0xe2, 0xca, 0xd6, 0xd5, 0xc5, 0x76, 0xde, 0x17, 0xe1, 0xa8, 0x11, 0x1d, 0x6b, 0xa3, 0x55, 0x2e,
-	0x5e, 0xd8, 0x93, 0x2f, 0x70, 0xdd, 0x5c, 0xe6, 0x8c, 0xc8, 0xf8, 0xb3, 0x4e, 0x86, 0x9c, 0x94,
-	0x94, 0x84, 0x70, 0x2a, 0xbc, 0x49, 0xa4, 0x24, 0x14, 0x65, 0x25, 0x61, 0x38, 0xe5, 0x3e, 0x24,
-	0x54, 0x12, 0xde, 0x85, 0x8b, 0x22, 0xb8, 0x1d, 0x19, 0xb0, 0x3b, 0x89, 0x9e, 0x07, 0x40, 0x89,
-	0x8b, 0x3a, 0xc3, 0x29, 0x69, 0xdf, 0x48, 0xfe, 0x25, 0x5c, 0x1a, 0x1f, 0x19, 0xee, 0xa1, 0xa5,
-	0x87, 0x23, 0x47, 0xa7, 0x07, 0x9e, 0xf5, 0xa9, 0x31, 0x13, 0x6a, 0xcf, 0x9b, 0x4b, 0x95, 0x6d,
-	0x12, 0xf1, 0x70, 0xe4, 0x50, 0x84, 0x4e, 0x7c, 0x1e, 0xbf, 0x39, 0x5e, 0x84, 0x2f, 0x9c, 0x46,
-	0xc1, 0xe2, 0x69, 0xd4, 0x92, 0x36, 0x53, 0x59, 0xd6, 0x66, 0xae, 0xff, 0xdb, 0x1c, 0x14, 0x44,
-	0x78, 0xde, 0x3d, 0xc8, 0x99, 0xbe, 0x37, 0x8b, 0x83, 0xe8, 0x56, 0x68, 0x17, 0xf4, 0x6b, 0x30,
-	0xa8, 0x88, 0xdc, 0x87, 0x82, 0x61, 0x9a, 0xfa, 0xe4, 0x38, 0x7d, 0x62, 0xb4, 0xb0, 0xd1, 0xef,
-	0xae, 0x69, 0x79, 0x83, 0x76, 0xfc, 0x4f, 0xa0, 0x8c, 0xf4, 0xdc, 0x19, 0x96, 0xb2, 0x97, 0x96,
-	0xb7, 0xe4, 0xdd, 0x35, 0xad, 0x64, 0x44, 0xdb, 0xf3, 0x8f, 0xd3, 0xbe, 0x37, 0xbe, 0x5f, 0x5e,
-	0x5f, 0x62, 0x3d, 0xcf, 0x0b, 0xf7, 0x1b, 0xc0, 0x9d, 0x31, 0xb1, 0xb4, 0xc9, 0xcb, 0x87, 0x13,
-	0x4b, 0xb2, 0x69, 0x77, 0x4d, 0xe3, 0x6b, 0x2e, 0x92, 0x55, 0x1f, 0x45, 0x7e, 0xb1, 0xf8, 0x77,
-	0x1b, 0x56, 0xf4, 0x0c, 0xca, 0x8a, 0xd8, 0x39, 0x46, 0x82, 0x03, 0xd9, 0x4c, 0x33, 0x0a, 0xdb,
-	0x29, 0x2e, 0xb1, 0xc5, 0x12, 0x89, 0xd8, 0x62, 0xf1, 0xf4, 0x08, 0x2a, 0xe4, 0xa2, 0x12, 0x7c,
-	0xa5, 0xa5, 0xae, 0x4d, 0x04, 0x0a, 0x39, 0xde, 0x13, 0xf1, 0xd2, 0x8c, 0xda, 0xe9, 0x5b, 0xb2,
-	0x6f, 0xf3, 0xc6, 0xca, 0x8e, 0xd2, 0x62, 0x37, 0x27, 0x6f, 0xac, 0xc6, 0x79, 0xd8, 0x36, 0x54,
-	0x0d, 0x69, 0xa7, 0x11, 0x8e, 0xce, 0x1b, 0x2b, 0xc6, 0x29, 0xa6, 0xa1, 0x3c, 0xa4, 0x74, 0x72,
-	0x00, 0x77, 0x5d, 0x83, 0x2b, 0xab, 0xa7, 0xb2, 0x1c, 0x49, 0x92, 0xe3, 0x91, 0x24, 0x6a, 0xfa,
-	0x25, 0x8c, 0xf4, 0xbd, 0x53, 0x29, 0xae, 0xe4, 0x73, 0xb4, 0x91, 0xe5, 0xc5, 0x5b, 0x81, 0x62,
-	0xf4, 0x5a, 0x2c, 0x45, 0xaa, 0x36, 0xfb, 0xfb, 0x5f, 0x29, 0x19, 0x04, 0x77, 0x7a, 0x83, 0x61,
-	0xa3, 0x27, 0x8e, 0x57, 0x3b, 0x3d, 0x71, 0xbc, 0xaa, 0xfe, 0xcb, 0x2c, 0x94, 0x63, 0xf7, 0xf0,
-	0x0f, 0x37, 0x8c, 0x63, 0x8b, 0x33, 0x2b, 0x5b, 0x9c, 0x0b, 0x9a, 0x9a, 0xfc, 0x22, 0xc6, 0x85,
-	0xb4, 0x3e, 0x14, 0x2c, 0x5f, 0x6c, 0xcb, 0x7f, 0xcf, 0x8b, 0x6d, 0x72, 0x64, 0x62, 0x21, 0x1d,
-	0x99, 0xb8, 0xf0, 0x62, 0x70, 0x91, 0xc2, 0x54, 0xe4, 0x17, 0x83, 0xcf, 0x8d, 0x4f, 0x29, 0x9d,
-	0x1f, 0x9f, 0x42, 0x3f, 0x79, 0xf5, 0xd4, 0xb6, 0x4e, 0x44, 0x80, 0x9e, 0x48, 0xa5, 0xb7, 0x0f,
-	0x78, 0xc1, 0xf6, 0xf1, 0x3d, 0x44, 0x11, 0x7b, 0x00, 0x97, 0x26, 0xc7, 0xf1, 0xeb, 0x88, 0x89,
-	0x81, 0x55, 0xa5, 0x66, 0xac, 0xc4, 0xa9, 0x7f, 0x2f, 0x03, 0x90, 0xf8, 0x50, 0x7f, 0x65, 0x07,
-	0x8f, 0x64, 0x43, 0x67, 0xbf, 0xc3, 0x86, 0x7e, 0xc1, 0xab, 0x11, 0xea, 0x37, 0x50, 0x8e, 0xbd,
-	0xe6, 0x3f, 0x7c, 0x8e, 0xbd, 0x54, 0x91, 0xbf, 0x15, 0x39, 0xbb, 0x62, 0xb7, 0xf3, 0xaf, 0xda,
-	0x17, 0xa9, 0xe2, 0xb3, 0x2f, 0x28, 0xfe, 0x94, 0x7b, 0x9c, 0xe2, 0xc2, 0x7f, 0xcd, 0x0b, 0x4b,
-	0x9e, 0xf3, 0xb9, 0xd4, 0x9c, 0x57, 0xe7, 0xc2, 0x6d, 0xf6, 0xab, 0x17, 0xfd, 0x52, 0x0d, 0xfe,
-	0xeb, 0x4c, 0xe4, 0xdb, 0x89, 0xdf, 0x9c, 0x3c, 0x57, 0xd1, 0x5a, 0xed, 0x9e, 0x7a, 0x99, 0xe2,
-	0xbe, 0xd3, 0xda, 0xcc, 0x7d, 0x97, 0xb5, 0xf9, 0x26, 0xe4, 0xf9, 0x86, 0x90, 0x3f, 0xcf, 0xd2,
-	0xe4, 0xf8, 0x17, 0xbe, 0xd2, 0xae, 0xaa, 0x42, 0xb1, 0xe4, 0xed, 0xbd, 0x14, 0xe5, 0x1b, 0xbd,
-	0x30, 0x8f, 0x09, 0x34, 0xf6, 0xcb, 0x89, 0xd1, 0xf9, 0xf2, 0x7d, 0xf2, 0x6b, 0x33, 0x37, 0xff,
-	0xf1, 0x3a, 0x6c, 0xa4, 0x0e, 0xcc, 0x7e, 0x40, 0x65, 0x56, 0x4a, 0xf3, 0xec, 0x6a, 0x69, 0xfe,
-	0x43, 0xde, 0x43, 0xfa, 0x5f, 0xb2, 0x03, 0xa4, 0x62, 0xcc, 0x4a, 0xe9, 0x18, 0x33, 0x94, 0xa6,
-	0xd5, 0x94, 0x56, 0xbe, 0x4a, 0x7f, 0xcf, 0xac, 0xd4, 0xdf, 0x6f, 0xc6, 0x3f, 0xf0, 0xd4, 0x69,
-	0x71, 0xc3, 0x72, 0x43, 0x93, 0x20, 0xec, 0x53, 0xb8, 0xc6, 0xb5, 0x1a, 0xae, 0xc8, 0xe9, 0xde,
-	0x44, 0x8f, 0x7f, 0xfe, 0x49, 0xc4, 0xcd, 0x5d, 0xe1, 0x04, 0xfc, 0x09, 0xff, 0x49, 0x23, 0xc2,
-	0xaa, 0x1d, 0xd8, 0x48, 0x9d, 0x5e, 0x4a, 0x3f, 0x25, 0x97, 0x91, 0x7f, 0x4a, 0x8e, 0x6d, 0x41,
-	0xfe, 0xe4, 0xc8, 0xf2, 0xad, 0x15, 0x8f, 0xf0, 0x71, 0x84, 0xfa, 0x23, 0xa8, 0xca, 0x91, 0x14,
-	0xec, 0x1d, 0xc8, 0xdb, 0xa1, 0x35, 0x8d, 0x6c, 0xab, 0x2b, 0xcb, 0xc1, 0x16, 0x64, 0x48, 0x73,
-	0x22, 0xf5, 0x17, 0x19, 0x50, 0x16, 0x71, 0xd2, 0xef, 0xdd, 0x65, 0xce, 0xf9, 0xbd, 0xbb, 0xf5,
-	0x54, 0x25, 0x57, 0xfd, 0x64, 0x5d, 0xfc, 0x10, 0x58, 0xee, 0x9c, 0x87, 0xc0, 0xd8, 0x1b, 0x50,
-	0xf2, 0x2d, 0xfa, 0x31, 0x31, 0x73, 0x45, 0x2c, 0x73, 0x8c, 0x53, 0x7f, 0x37, 0x03, 0x45, 0x11,
-	0xf6, 0xb1, 0xd2, 0xd8, 0x7d, 0x0b, 0x8a, 0xfc, 0x87, 0xc5, 0x22, 0xe3, 0x7f, 0x29, 0x0e, 0x32,
-	0xc2, 0xb3, 0x9b, 0x3c, 0x18, 0x26, 0x6d, 0xfc, 0xee, 0x3b, 0x86, 0xab, 0x11, 0x5c, 0xfc, 0x32,
-	0x87, 0x31, 0x15, 0x37, 0xfb, 0xf8, 0x43, 0x1c, 0x40, 0x20, 0x7e, 0x89, 0xef, 0xc7, 0x50, 0x14,
-	0x61, 0x25, 0x2b, 0xab, 0xf2, 0xa2, 0x9f, 0xd4, 0xda, 0x02, 0x48, 0xe2, 0x4c, 0x56, 0xe5, 0xa0,
-	0xde, 0x83, 0x52, 0x14, 0x5a, 0x82, 0xf3, 0x2f, 0x29, 0x5a, 0xc4, 0xaa, 0xcb, 0x95, 0x71, 0xc4,
-	0xbb, 0xb4, 0x5d, 0x6f, 0x7c, 0x4c, 0x5e, 0xb5, 0xf7, 0x80, 0x62, 0xf8, 0x87, 0x4b, 0x2f, 0x96,
-	0xa4, 0xdf, 0x00, 0x8e, 0x89, 0xd8, 0x3d, 0x88, 0xc5, 0xf1, 0x8b, 0xac, 0x65, 0xb5, 0x11, 0xdd,
-	0x25, 0xa1, 0x59, 0xf6, 0x50, 0x78, 0x8f, 0xba, 0xf4, 0x4a, 0x4e, 0xca, 0x61, 0x93, 0xaa, 0x93,
-	0x26, 0x91, 0xa9, 0x35, 0xa8, 0xca, 0xe7, 0xe1, 0x6a, 0x03, 0x36, 0xf7, 0xac, 0xd0, 0x40, 0x99,
-	0x35, 0x18, 0x1b, 0x2e, 0xd2, 0xf3, 0xf9, 0x8b, 0x1f, 0xe9, 0xf9, 0xbb, 0x48, 0xa7, 0x71, 0x22,
-	0xf5, 0x17, 0x39, 0x50, 0x16, 0x71, 0x28, 0x4c, 0xe2, 0x4b, 0x9c, 0x99, 0xe8, 0x7d, 0x73, 0x27,
-	0xfe, 0x79, 0x1a, 0x9a, 0x17, 0xa9, 0xdf, 0x5e, 0xe1, 0x20, 0x29, 0x60, 0x35, 0xf5, 0x50, 0x78,
-	0xc9, 0x0e, 0x76, 0xf9, 0x53, 0xe1, 0x57, 0xf9, 0x73, 0x1b, 0x8e, 0x37, 0xa6, 0x69, 0x5d, 0xa5,
-	0xd7, 0x35, 0xba, 0xde, 0x18, 0xb9, 0x22, 0x83, 0x9b, 0x07, 0x69, 0x55, 0xb5, 0x92, 0xb0, 0xb2,
-	0xe9, 0xd0, 0x40, 0x84, 0xb1, 0x86, 0x81, 0xb8, 0x92, 0x54, 0xe2, 0x80, 0x61, 0x10, 0x3d, 0x9f,
-	0x3a, 0x16, 0x3f, 0x14, 0x92, 0xa5, 0xe7, 0x53, 0x9b, 0x2e, 0xdd, 0xd6, 0xa1, 0xdf, 0xb5, 0x19,
-	0x8b, 0xdf, 0x1d, 0x12, 0x8f, 0xd3, 0x22, 0xea, 0x35, 0xfe, 0x53, 0x2a, 0xbe, 0x15, 0x04, 0xfc,
-	0x89, 0x28, 0xfe, 0x78, 0x53, 0x35, 0x02, 0xc6, 0x6f, 0x51, 0x89, 0x1f, 0xb2, 0x41, 0x12, 0x10,
-	0x6f, 0x51, 0xf1, 0x9f, 0xb1, 0x41, 0x82, 0x6b, 0x50, 0xfa, 0xd6, 0x73, 0x2d, 0x32, 0xdc, 0x2b,
-	0x54, 0xab, 0x22, 0xa6, 0xf7, 0x8c, 0x99, 0xfa, 0x17, 0x19, 0xb8, 0xb4, 0xd8, 0xab, 0x34, 0x61,
-	0xaa, 0x50, 0x6a, 0xf6, 0xbb, 0x7a, 0xaf, 0xb1, 0xd7, 0x56, 0xd6, 0xd8, 0x05, 0xa8, 0xf4, 0xb7,
-	0xbf, 0x68, 0x37, 0x87, 0x1c, 0x90, 0xa1, 0xab, 0x9b, 0x03, 0x7d, 0xb7, 0xd3, 0x6a, 0xb5, 0x7b,
-	0xdc, 0x4a, 0xe9, 0x6f, 0x7f, 0xa1, 0x77, 0xfb, 0x4d, 0xfe, 0xbb, 0x17, 0xd1, 0xe9, 0xfb, 0x40,
-	0xc9, 0xd1, 0x89, 0x37, 0xc5, 0x84, 0x62, 0x32, 0xcf, 0x43, 0x1e, 0x9f, 0x0d, 0xf4, 0x66, 0x6f,
-	0xa8, 0x14, 0x30, 0xd5, 0x3b, 0xe8, 0x76, 0x29, 0x45, 0xb1, 0x4d, 0xcd, 0xfe, 0xde, 0xbe, 0xd6,
-	0x1e, 0x0c, 0xf4, 0x41, 0xe7, 0x67, 0x6d, 0xa5, 0x44, 0x25, 0x6b, 0x9d, 0xc7, 0x9d, 0x1e, 0x07,
-	0x94, 0x59, 0x11, 0xb2, 0x7b, 0x9d, 0x1e, 0xbf, 0xb2, 0xba, 0xd7, 0xf8, 0x52, 0xa9, 0xe0, 0xc7,
-	0xe0, 0x60, 0x4f, 0xa9, 0xde, 0xbb, 0x0d, 0x55, 0xf9, 0xc7, 0xa3, 0x28, 0xca, 0xd1, 0x73, 0x2d,
-	0xfe, 0xc8, 0x6a, 0xf7, 0xdb, 0x0f, 0x95, 0xcc, 0xbd, 0xdf, 0x92, 0x1e, 0xe5, 0x27, 0x1a, 0x71,
-	0x18, 0x40, 0xf7, 0xf3, 0xf8, 0x05, 0x40, 0x72, 0xfd, 0xd3, 0x7d, 0xc1, 0xdd, 0xc6, 0x60, 0x97,
-	0x1f, 0x13, 0x08, 0x0c, 0x01, 0xb2, 0xc9, 0xe3, 0x9c, 0x74, 0xff, 0x96, 0x3e, 0xe3, 0xc3, 0xf6,
-	0x3c, 0x5d, 0x8d, 0xec, 0x0c, 0xb0, 0x71, 0x0a, 0x54, 0xf1, 0x2b, 0xc6, 0x15, 0xef, 0xa9, 0x50,
-	0x91, 0x5e, 0x4f, 0xa6, 0x32, 0x8c, 0xe0, 0x48, 0xbc, 0xf7, 0x89, 0xe6, 0xa6, 0x92, 0xb9, 0xf7,
-	0x06, 0xee, 0x18, 0xf2, 0xdb, 0xc5, 0x00, 0x85, 0x9e, 0xe7, 0x4f, 0x0d, 0x47, 0xd0, 0x59, 0xf3,
-	0x00, 0xe9, 0xde, 0x83, 0xcb, 0x2b, 0x5f, 0x62, 0xa6, 0x48, 0x5d, 0x7b, 0x3a, 0x73, 0x2c, 0x1e,
-	0x6c, 0xba, 0x7b, 0x36, 0xf2, 0x6d, 0x53, 0xc9, 0xdc, 0x7b, 0x14, 0x5d, 0xe1, 0x8b, 0xca, 0xee,
-	0xf6, 0x1b, 0x2d, 0x3e, 0xb8, 0xf1, 0xfd, 0xe0, 0xe1, 0x36, 0x7f, 0xcb, 0x53, 0x6b, 0x0f, 0x0e,
-	0xba, 0x43, 0x71, 0x17, 0xf9, 0xde, 0xe7, 0x50, 0x3f, 0x2f, 0xea, 0x12, 0x6b, 0xd4, 0xdc, 0x6d,
-	0x50, 0x64, 0x2b, 0x0e, 0x66, 0x5f, 0xe7, 0xa9, 0x0c, 0x0f, 0x0c, 0xee, 0xb6, 0x29, 0x22, 0xe3,
-	0xde, 0xcf, 0x33, 0x92, 0x08, 0x8b, 0x22, 0xe7, 0x62, 0x80, 0x18, 0x25, 0x19, 0xa4, 0x59, 0x86,
-	0xa9, 0x64, 0xd8, 0x15, 0x60, 0x29, 0x50, 0xd7, 0x1b, 0x1b, 0x8e, 0xb2, 0x4e, 0xb1, 0x17, 0x11,
-	0x9c, 0xe2, 0x9b, 0x95, 0x2c, 0x7b, 0x15, 0xae, 0xc5, 0xb0, 0xae, 0x77, 0xb2, 0xef, 0xdb, 0x68,
-	0x6b, 0x9f, 0x71, 0x74, 0x6e, 0xfb, 0x27, 0x7f, 0xfe, 0xcb, 0x9b, 0x99, 0x7f, 0xfd, 0xcb, 0x9b,
-	0x99, 0xff, 0xf4, 0xcb, 0x9b, 0x6b, 0xbf, 0xf8, 0xcf, 0x37, 0x33, 0x3f, 0x93, 0x7f, 0x62, 0x7a,
-	0x6a, 0x84, 0xbe, 0x7d, 0xca, 0x17, 0x4d, 0x94, 0x70, 0xad, 0xf7, 0x66, 0xc7, 0x87, 0xef, 0xcd,
-	0x46, 0xef, 0xa1, 0x64, 0x1a, 0x15, 0xe8, 0xc7, 0xa4, 0x1f, 0xfe, 0xcf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x32, 0x29, 0xd1, 0xe1, 0xac, 0x7a, 0x00, 0x00,
+	// 11709 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0xbd, 0x5b, 0x8c, 0x23, 0x47,
+	0x96, 0x18, 0xda, 0x7c, 0x93, 0x87, 0x8f, 0xca, 0xca, 0xae, 0xee, 0x66, 0xb7, 0x5a, 0xad, 0x52,
+	0x4a, 0x23, 0xb5, 0x5a, 0x52, 0x4b, 0xaa, 0xd6, 0xa3, 0xa5, 0xbb, 0xb3, 0x33, 0x2c, 0x16, 0xab,
+	0x9b, 0xd3, 0x2c, 0xb2, 0x26, 0xc9, 0xea, 0xd6, 0xcc, 0xe2, 0xde, 0x44, 0x92, 0x99, 0xac, 0x4a,
+	0x55, 0x32, 0x93, 0xca, 0x4c, 0x76, 0x55, 0x09, 0xd8, 0x8b, 0xb9, 0x77, 0x01, 0x3f, 0x3f, 0x0d,
+	0xec, 0x97, 0x6d, 0xec, 0xee, 0xe7, 0x02, 0xfb, 0x65, 0x03, 0x5e, 0x18, 0xf6, 0x9f, 0x0d, 0xac,
+	0x17, 0xc6, 0xc2, 0xbf, 0xb6, 0xe1, 0xb5, 0x31, 0xfe, 0xf0, 0x97, 0xbd, 0x1f, 0x6b, 0x18, 0x30,
+	0xe0, 0x0f, 0xe3, 0x9c, 0x88, 0xc8, 0x8c, 0x24, 0x59, 0x6a, 0xb5, 0x66, 0x16, 0xb6, 0x7f, 0xaa,
+	0x22, 0xce, 0x39, 0x11, 0x19, 0xcf, 0x13, 0xe7, 0x15, 0x41, 0x80, 0xb9, 0x6b, 0x7a, 0xf7, 0xe7,
+	0x81, 0x1f, 0xf9, 0x6a, 0x1e, 0xd3, 0xb7, 0xde, 0x3f, 0x76, 0xa2, 0x93, 0xc5, 0xf8, 0xfe, 0xc4,
+	0x9f, 0x7d, 0x70, 0xec, 0x1f, 0xfb, 0x1f, 0x10, 0x72, 0xbc, 0x98, 0x52, 0x8e, 0x32, 0x94, 0x62,
+	0x85, 0x6e, 0x81, 0xeb, 0x4f, 0x4e, 0x79, 0x7a, 0x23, 0x72, 0x66, 0x76, 0x18, 0x99, 0xb3, 0x39,
+	0x03, 0x68, 0xff, 0x28, 0x03, 0xf9, 0xd1, 0xc5, 0xdc, 0x56, 0x1b, 0x90, 0x75, 0xac, 0x66, 0x66,
+	0x3b, 0x73, 0xb7, 0xa0, 0x67, 0x1d, 0x4b, 0xdd, 0x86, 0xaa, 0xe7, 0x47, 0xfd, 0x85, 0xeb, 0x9a,
+	0x63, 0xd7, 0x6e, 0x66, 0xb7, 0x33, 0x77, 0xcb, 0xba, 0x0c, 0x52, 0x5f, 0x81, 0x8a, 0xb9, 0x88,
+	0x7c, 0xc3, 0xf1, 0x26, 0x41, 0x33, 0x47, 0xf8, 0x32, 0x02, 0xba, 0xde, 0x24, 0x50, 0xb7, 0xa0,
+	0x70, 0xe6, 0x58, 0xd1, 0x49, 0x33, 0x4f, 0x35, 0xb2, 0x0c, 0x42, 0xc3, 0x89, 0xe9, 0xda, 0xcd,
+	0x02, 0x83, 0x52, 0x06, 0xa1, 0x11, 0x7d, 0xa4, 0xb8, 0x9d, 0xb9, 0x5b, 0xd1, 0x59, 0x46, 0xbd,
+	0x03, 0x60, 0x7b, 0x8b, 0xd9, 0x73, 0xd3, 0x5d, 0xd8, 0x61, 0xb3, 0x44, 0x28, 0x09, 0xa2, 0xfd,
+	0x08, 0x2a, 0xb3, 0xf0, 0xf8, 0xb1, 0x6d, 0x5a, 0x76, 0xa0, 0xde, 0x80, 0xd2, 0x2c, 0x3c, 0x36,
+	0x22, 0xf3, 0x98, 0x77, 0xa1, 0x38, 0x0b, 0x8f, 0x47, 0xe6, 0xb1, 0x7a, 0x13, 0xca, 0x84, 0xb8,
+	0x98, 0xb3, 0x3e, 0x14, 0x74, 0x24, 0xc4, 0x1e, 0x6b, 0x7f, 0x51, 0x80, 0x52, 0xcf, 0x89, 0xec,
+	0xc0, 0x74, 0xd5, 0xeb, 0x50, 0x74, 0x42, 0x6f, 0xe1, 0xba, 0x54, 0xbc, 0xac, 0xf3, 0x9c, 0x7a,
+	0x1d, 0x0a, 0xce, 0xc3, 0xe7, 0xa6, 0xcb, 0xca, 0x3e, 0xbe, 0xa2, 0xb3, 0xac, 0xda, 0x84, 0xa2,
+	0xf3, 0xd1, 0xa7, 0x88, 0xc8, 0x71, 0x04, 0xcf, 0x13, 0xe6, 0xc1, 0x0e, 0x62, 0xf2, 0x31, 0xe6,
+	0xc1, 0x8e, 0xc0, 0x7c, 0xfa, 0x31, 0x62, 0xb0, 0xf7, 0x39, 0xc2, 0x50, 0x1e, 0xbf, 0xb2, 0xa0,
+	0xaf, 0xe0, 0x00, 0xd4, 0xf1, 0x2b, 0x0b, 0xf1, 0x95, 0x05, 0xfb, 0x4a, 0x89, 0x23, 0x78, 0x9e,
+	0x30, 0xec, 0x2b, 0xe5, 0x18, 0x13, 0x7f, 0x65, 0xc1, 0xbe, 0x52, 0xd9, 0xce, 0xdc, 0xcd, 0x13,
+	0x86, 0x7d, 0x65, 0x0b, 0xf2, 0x16, 0xc2, 0x61, 0x3b, 0x73, 0x37, 0xf3, 0xf8, 0x8a, 0x9e, 0xb7,
+	0x38, 0x34, 0x44, 0x68, 0x15, 0x07, 0x18, 0xa1, 0x21, 0x87, 0x8e, 0x11, 0x5a, 0xc3, 0xd1, 0x40,
+	0xe8, 0x98, 0x43, 0xa7, 0x08, 0xad, 0x6f, 0x67, 0xee, 0x66, 0x11, 0x8a, 0x39, 0xf5, 0x16, 0x94,
+	0x2c, 0x33, 0xb2, 0x11, 0xd1, 0xe0, 0x5d, 0x16, 0x00, 0xc4, 0xe1, 0x8a, 0x43, 0xdc, 0x06, 0xef,
+	0xb4, 0x00, 0xa8, 0x1a, 0x54, 0x91, 0x4c, 0xe0, 0x15, 0x8e, 0x97, 0x81, 0xea, 0x27, 0x50, 0xb3,
+	0xec, 0x89, 0x33, 0x33, 0x5d, 0xd6, 0xa7, 0xcd, 0xed, 0xcc, 0xdd, 0xea, 0xce, 0xc6, 0x7d, 0xda,
+	0x13, 0x31, 0xe6, 0xf1, 0x15, 0x3d, 0x45, 0xa6, 0x3e, 0x84, 0x3a, 0xcf, 0x7f, 0xb4, 0x43, 0x03,
+	0xab, 0x52, 0x39, 0x25, 0x55, 0xee, 0xa3, 0x9d, 0x87, 0x8f, 0xaf, 0xe8, 0x69, 0x42, 0xf5, 0x4d,
+	0xa8, 0xc5, 0x5b, 0x04, 0x0b, 0x5e, 0xe5, 0xad, 0x4a, 0x41, 0xb1, 0x5b, 0x5f, 0x85, 0xbe, 0x87,
+	0x04, 0x5b, 0x7c, 0xdc, 0x04, 0x40, 0xdd, 0x06, 0xb0, 0xec, 0xa9, 0xb9, 0x70, 0x23, 0x44, 0x5f,
+	0xe3, 0x03, 0x28, 0xc1, 0xd4, 0x3b, 0x50, 0x59, 0xcc, 0xb1, 0x97, 0x4f, 0x4d, 0xb7, 0x79, 0x9d,
+	0x13, 0x24, 0x20, 0xac, 0x1d, 0xd7, 0x39, 0x62, 0x6f, 0xf0, 0xd9, 0x15, 0x00, 0xdc, 0x2b, 0x4e,
+	0xb8, 0xeb, 0x78, 0xcd, 0x26, 0xad, 0x53, 0x96, 0x51, 0x6f, 0x43, 0x2e, 0x0c, 0x26, 0xcd, 0x9b,
+	0xd4, 0x4b, 0x60, 0xbd, 0xec, 0x9c, 0xcf, 0x03, 0x1d, 0xc1, 0xbb, 0x25, 0x28, 0xd0, 0x9e, 0xd1,
+	0x6e, 0x43, 0xf9, 0xd0, 0x0c, 0xcc, 0x99, 0x6e, 0x4f, 0x55, 0x05, 0x72, 0x73, 0x3f, 0xe4, 0xbb,
+	0x05, 0x93, 0x5a, 0x0f, 0x8a, 0x4f, 0xcd, 0x00, 0x71, 0x2a, 0xe4, 0x3d, 0x73, 0x66, 0x13, 0xb2,
+	0xa2, 0x53, 0x1a, 0x77, 0x48, 0x78, 0x11, 0x46, 0xf6, 0x8c, 0xb3, 0x02, 0x9e, 0x43, 0xf8, 0xb1,
+	0xeb, 0x8f, 0xf9, 0x4e, 0x28, 0xeb, 0x3c, 0xa7, 0xfd, 0xff, 0x19, 0x28, 0xb6, 0x7d, 0x17, 0xab,
+	0xbb, 0x01, 0xa5, 0xc0, 0x76, 0x8d, 0xe4, 0x73, 0xc5, 0xc0, 0x76, 0x0f, 0xfd, 0x10, 0x11, 0x13,
+	0x9f, 0x21, 0xd8, 0xde, 0x2c, 0x4e, 0x7c, 0x42, 0x88, 0x06, 0xe4, 0xa4, 0x06, 0xdc, 0x84, 0x72,
+	0x34, 0x76, 0x0d, 0x82, 0xe7, 0x09, 0x5e, 0x8a, 0xc6, 0x6e, 0x1f, 0x51, 0x37, 0xa0, 0x64, 0x8d,
+	0x19, 0xa6, 0x40, 0x98, 0xa2, 0x35, 0x46, 0x84, 0xf6, 0x39, 0x54, 0x74, 0xf3, 0x8c, 0x37, 0xe3,
+	0x1a, 0x14, 0xb1, 0x02, 0xce, 0xe5, 0xf2, 0x7a, 0x21, 0x1a, 0xbb, 0x5d, 0x0b, 0xc1, 0xd8, 0x08,
+	0xc7, 0xa2, 0x36, 0xe4, 0xf5, 0xc2, 0xc4, 0x77, 0xbb, 0x96, 0x36, 0x02, 0x68, 0xfb, 0x41, 0xf0,
+	0xbd, 0xbb, 0xb0, 0x05, 0x05, 0xcb, 0x9e, 0x47, 0x27, 0x8c, 0x41, 0xe8, 0x2c, 0xa3, 0xdd, 0x83,
+	0x32, 0xce, 0x4b, 0xcf, 0x09, 0x23, 0xf5, 0x0e, 0xe4, 0x5d, 0x27, 0x8c, 0x9a, 0x99, 0xed, 0xdc,
+	0xd2, 0xac, 0x11, 0x5c, 0xdb, 0x86, 0xf2, 0x81, 0x79, 0xfe, 0x14, 0x67, 0x4e, 0xdd, 0xe2, 0x53,
+	0xc8, 0xa7, 0x84, 0xcf, 0x67, 0x0d, 0x60, 0x64, 0x06, 0xc7, 0x76, 0x44, 0xfc, 0xec, 0x2f, 0x33,
+	0x50, 0x1d, 0x2e, 0xc6, 0x5f, 0x2f, 0xec, 0xe0, 0x02, 0xdb, 0x7c, 0x17, 0x72, 0xd1, 0xc5, 0x9c,
+	0x4a, 0x34, 0x76, 0xae, 0xb3, 0xea, 0x25, 0xfc, 0x7d, 0x2c, 0xa4, 0x23, 0x09, 0x76, 0xc2, 0xf3,
+	0x2d, 0x5b, 0x8c, 0x41, 0x41, 0x2f, 0x62, 0xb6, 0x6b, 0xe1, 0xa1, 0xe0, 0xcf, 0xf9, 0x2c, 0x64,
+	0xfd, 0xb9, 0xba, 0x0d, 0x85, 0xc9, 0x89, 0xe3, 0x5a, 0x34, 0x01, 0xe9, 0x36, 0x33, 0x04, 0xce,
+	0x52, 0xe0, 0x9f, 0x19, 0xa1, 0xf3, 0x8d, 0x60, 0xf2, 0xa5, 0xc0, 0x3f, 0x1b, 0x3a, 0xdf, 0xd8,
+	0xda, 0x88, 0x9f, 0x34, 0x00, 0xc5, 0x61, 0xbb, 0xd5, 0x6b, 0xe9, 0xca, 0x15, 0x4c, 0x77, 0xbe,
+	0xec, 0x0e, 0x47, 0x43, 0x25, 0xa3, 0x36, 0x00, 0xfa, 0x83, 0x91, 0xc1, 0xf3, 0x59, 0xb5, 0x08,
+	0xd9, 0x6e, 0x5f, 0xc9, 0x21, 0x0d, 0xc2, 0xbb, 0x7d, 0x25, 0xaf, 0x96, 0x20, 0xd7, 0xea, 0xff,
+	0x4c, 0x29, 0x50, 0xa2, 0xd7, 0x53, 0x8a, 0xda, 0x1f, 0x66, 0xa1, 0x32, 0x18, 0x7f, 0x65, 0x4f,
+	0x22, 0xec, 0x33, 0xae, 0x52, 0x3b, 0x78, 0x6e, 0x07, 0xd4, 0xed, 0x9c, 0xce, 0x73, 0xd8, 0x11,
+	0x6b, 0x4c, 0x9d, 0xcb, 0xe9, 0x59, 0x6b, 0x4c, 0x74, 0x93, 0x13, 0x7b, 0x66, 0x36, 0x73, 0x9c,
+	0x8e, 0x72, 0xb8, 0x2b, 0xfc, 0xf1, 0x57, 0xd4, 0xbd, 0x9c, 0x8e, 0x49, 0xf5, 0x35, 0xa8, 0xb2,
+	0x3a, 0xe4, 0xf5, 0x05, 0x0c, 0xb4, 0xbc, 0xf8, 0x8a, 0xf2, 0xe2, 0xa3, 0x92, 0x54, 0x2b, 0x43,
+	0xf2, 0x13, 0x8c, 0x81, 0xfa, 0x7c, 0x45, 0xfb, 0xe3, 0xaf, 0x18, 0xb6, 0xcc, 0x56, 0xb4, 0x3f,
+	0xfe, 0x8a, 0x50, 0xef, 0xc2, 0x66, 0xb8, 0x18, 0x87, 0x93, 0xc0, 0x99, 0x47, 0x8e, 0xef, 0x31,
+	0x9a, 0x0a, 0xd1, 0x28, 0x32, 0x82, 0x88, 0xef, 0x42, 0x79, 0xbe, 0x18, 0x1b, 0x8e, 0x37, 0xf5,
+	0x89, 0xb9, 0x57, 0x77, 0xea, 0x6c, 0x62, 0x0e, 0x17, 0xe3, 0xae, 0x37, 0xf5, 0xf5, 0xd2, 0x9c,
+	0x25, 0xb4, 0xb7, 0xa0, 0xc4, 0x61, 0x78, 0x7a, 0x47, 0xb6, 0x67, 0x7a, 0x91, 0x11, 0x1f, 0xfb,
+	0x65, 0x06, 0xe8, 0x5a, 0xda, 0x3f, 0xcc, 0x80, 0x32, 0x94, 0x3e, 0x73, 0x60, 0x47, 0xe6, 0x5a,
+	0xae, 0xf0, 0x2a, 0x80, 0x39, 0x99, 0xf8, 0x0b, 0x56, 0x0d, 0x5b, 0x3c, 0x15, 0x0e, 0xe9, 0x5a,
+	0xf2, 0xd8, 0xe4, 0x52, 0x63, 0xf3, 0x3a, 0xd4, 0x44, 0x39, 0x69, 0x43, 0x57, 0x39, 0x4c, 0x8c,
+	0x4e, 0xb8, 0x48, 0xed, 0xea, 0x52, 0xb8, 0x60, 0xa5, 0xaf, 0x43, 0x91, 0x64, 0x84, 0x50, 0x8c,
+	0x38, 0xcb, 0x69, 0x7f, 0x2b, 0x0b, 0xe5, 0xfd, 0x85, 0x37, 0xc1, 0x26, 0xab, 0x6f, 0x40, 0x7e,
+	0xba, 0xf0, 0x26, 0xcd, 0x8c, 0x7c, 0x64, 0xc4, 0x2b, 0x45, 0x27, 0x24, 0xee, 0x41, 0x33, 0x38,
+	0xc6, 0xbd, 0xbb, 0xb2, 0x07, 0x11, 0xae, 0xfd, 0x71, 0x86, 0xd5, 0xb8, 0xef, 0x9a, 0xc7, 0x6a,
+	0x19, 0xf2, 0xfd, 0x41, 0xbf, 0xa3, 0x5c, 0x51, 0x6b, 0x50, 0xee, 0xf6, 0x47, 0x1d, 0xbd, 0xdf,
+	0xea, 0x29, 0x19, 0x5a, 0xd0, 0xa3, 0xd6, 0x6e, 0xaf, 0xa3, 0x64, 0x11, 0xf3, 0x74, 0xd0, 0x6b,
+	0x8d, 0xba, 0xbd, 0x8e, 0x92, 0x67, 0x18, 0xbd, 0xdb, 0x1e, 0x29, 0x65, 0x55, 0x81, 0xda, 0xa1,
+	0x3e, 0xd8, 0x3b, 0x6a, 0x77, 0x8c, 0xfe, 0x51, 0xaf, 0xa7, 0x28, 0xea, 0x55, 0xd8, 0x88, 0x21,
+	0x03, 0x06, 0xdc, 0xc6, 0x22, 0x4f, 0x5b, 0x7a, 0x4b, 0x7f, 0xa4, 0xfc, 0x58, 0x2d, 0x43, 0xae,
+	0xf5, 0xe8, 0x91, 0xf2, 0x0b, 0xdc, 0x1b, 0x95, 0x67, 0xdd, 0xbe, 0xf1, 0xb4, 0xd5, 0x3b, 0xea,
+	0x28, 0xbf, 0xc8, 0x8a, 0xfc, 0x40, 0xdf, 0xeb, 0xe8, 0xca, 0x2f, 0xf2, 0xea, 0x26, 0xd4, 0x7e,
+	0x3e, 0xe8, 0x77, 0x0e, 0x5a, 0x87, 0x87, 0xd4, 0x90, 0x5f, 0x94, 0xb5, 0xff, 0x9c, 0x87, 0x3c,
+	0xf6, 0x44, 0xd5, 0x12, 0x3e, 0x10, 0x77, 0x11, 0x37, 0xe2, 0x6e, 0xfe, 0x4f, 0xfe, 0xfc, 0xb5,
+	0x2b, 0x8c, 0x03, 0xbc, 0x0e, 0x39, 0xd7, 0x89, 0x9a, 0x59, 0x79, 0xf5, 0x70, 0xd9, 0xe8, 0xf1,
+	0x15, 0x1d, 0x71, 0xea, 0x1d, 0xc8, 0x30, 0x56, 0x50, 0xdd, 0x69, 0xf0, 0xe5, 0xc5, 0xcf, 0x92,
+	0xc7, 0x57, 0xf4, 0xcc, 0x5c, 0xbd, 0x0d, 0x99, 0xe7, 0x9c, 0x2f, 0xd4, 0x18, 0x9e, 0x9d, 0x26,
+	0x88, 0x7d, 0xae, 0x6e, 0x43, 0x6e, 0xe2, 0x33, 0xc9, 0x27, 0xc6, 0x33, 0xde, 0x8a, 0xf5, 0x4f,
+	0x7c, 0x57, 0x7d, 0x03, 0x72, 0x81, 0x79, 0xd6, 0x2c, 0xca, 0xd3, 0x15, 0x33, 0x6f, 0x24, 0x0a,
+	0xcc, 0x33, 0x6c, 0xc4, 0xb4, 0x59, 0x92, 0x1b, 0x21, 0xe6, 0x1b, 0x3f, 0x33, 0x55, 0xb7, 0x21,
+	0x73, 0xd6, 0x2c, 0xcb, 0x87, 0xfd, 0x33, 0xc7, 0xb3, 0xfc, 0xb3, 0xe1, 0xdc, 0x9e, 0x20, 0xc5,
+	0x99, 0xfa, 0x03, 0xc8, 0x85, 0x8b, 0x31, 0xed, 0xa5, 0xea, 0xce, 0xe6, 0x0a, 0x57, 0xc4, 0x0f,
+	0x85, 0x8b, 0xb1, 0xfa, 0x16, 0xe4, 0x27, 0x7e, 0x10, 0x34, 0x41, 0xae, 0x2b, 0x39, 0x10, 0x50,
+	0xf8, 0x41, 0x3c, 0x7e, 0x30, 0x6a, 0x56, 0x65, 0xa2, 0x84, 0x23, 0xe3, 0x07, 0x23, 0xf5, 0x4d,
+	0xce, 0xe6, 0x6b, 0x72, 0xab, 0xc5, 0x21, 0x80, 0xf5, 0x20, 0x16, 0x27, 0x69, 0x66, 0x9e, 0x37,
+	0xeb, 0x32, 0x91, 0xe0, 0xfe, 0xd8, 0xa6, 0x99, 0x79, 0xae, 0xbe, 0x09, 0xb9, 0xe7, 0xf6, 0xa4,
+	0xd9, 0x90, 0xbf, 0xc6, 0x27, 0xe9, 0x29, 0x75, 0x0f, 0xd1, 0xb4, 0xee, 0x7d, 0xd7, 0x6a, 0x6e,
+	0xc8, 0x73, 0xb9, 0xef, 0xbb, 0xd6, 0x53, 0x9a, 0x4b, 0x42, 0xe2, 0xa1, 0x67, 0x2e, 0xce, 0x71,
+	0xcf, 0x2a, 0xec, 0x78, 0x32, 0x17, 0xe7, 0x5d, 0x0b, 0xd9, 0x9f, 0x67, 0x3d, 0x27, 0x29, 0x2b,
+	0xa3, 0x63, 0x12, 0xd5, 0x80, 0xd0, 0x76, 0xed, 0x49, 0xe4, 0x3c, 0x77, 0xa2, 0x0b, 0x92, 0xa3,
+	0x32, 0xba, 0x0c, 0xda, 0x2d, 0x42, 0xde, 0x3e, 0x9f, 0x07, 0xda, 0x63, 0x28, 0xf1, 0xaf, 0xac,
+	0xe8, 0x12, 0x37, 0xa1, 0xec, 0x84, 0xc6, 0xc4, 0xf7, 0xc2, 0x88, 0x4b, 0x0f, 0x25, 0x27, 0x6c,
+	0x63, 0x16, 0x99, 0x8a, 0x65, 0x46, 0x8c, 0x0d, 0xd7, 0x74, 0x4a, 0x6b, 0x3b, 0x00, 0x49, 0xb7,
+	0xb0, 0x4d, 0xae, 0xed, 0x09, 0x41, 0xc5, 0xb5, 0xbd, 0xb8, 0x4c, 0x56, 0x2a, 0x73, 0x13, 0x2a,
+	0xb1, 0x04, 0xa8, 0xd6, 0x20, 0x63, 0xf2, 0x03, 0x20, 0x63, 0x6a, 0x77, 0x01, 0x38, 0xea, 0xa3,
+	0x9d, 0x87, 0x69, 0x1c, 0xe6, 0xc4, 0xb1, 0x90, 0x19, 0x6b, 0xbf, 0x01, 0x35, 0xdd, 0x0e, 0x17,
+	0x6e, 0xd4, 0xf6, 0xdd, 0x3d, 0x7b, 0xaa, 0xbe, 0x07, 0x10, 0xe7, 0x43, 0x7e, 0x4e, 0x27, 0x6b,
+	0x77, 0xcf, 0x9e, 0xea, 0x12, 0x5e, 0xfb, 0x77, 0x79, 0x28, 0xf2, 0x82, 0x89, 0x4c, 0x91, 0x91,
+	0x64, 0x8a, 0x98, 0x83, 0x66, 0xd3, 0x72, 0xd5, 0x89, 0x63, 0x59, 0xb6, 0x27, 0xe4, 0x27, 0x96,
+	0xc3, 0xc9, 0x36, 0xdd, 0x63, 0xda, 0x50, 0x8d, 0x1d, 0x55, 0x7c, 0x74, 0x36, 0x0f, 0xec, 0x30,
+	0x64, 0x27, 0xb7, 0xe9, 0x1e, 0x8b, 0xbd, 0x5d, 0xf8, 0xb6, 0xbd, 0x7d, 0x13, 0xca, 0x9e, 0x1f,
+	0x19, 0xa4, 0xdd, 0x14, 0xd9, 0xe8, 0x73, 0x35, 0x4e, 0x7d, 0x1b, 0x4a, 0x5c, 0x2e, 0x6d, 0x96,
+	0xe4, 0xe5, 0xb2, 0xc7, 0x80, 0xba, 0xc0, 0xaa, 0x4d, 0x14, 0x73, 0x66, 0x33, 0xdb, 0x8b, 0xc4,
+	0x49, 0xc5, 0xb3, 0xea, 0xbb, 0x50, 0xf1, 0x3d, 0x83, 0x09, 0xaf, 0xcd, 0x8a, 0xbc, 0x7c, 0x07,
+	0xde, 0x11, 0x41, 0xf5, 0xb2, 0xcf, 0x53, 0xd8, 0x14, 0xd7, 0x3f, 0x33, 0x26, 0x66, 0x60, 0xd1,
+	0xce, 0x2a, 0xeb, 0x25, 0xd7, 0x3f, 0x6b, 0x9b, 0x81, 0xc5, 0x4e, 0xee, 0xaf, 0xbd, 0xc5, 0x8c,
+	0x76, 0x53, 0x5d, 0xe7, 0x39, 0xf5, 0x36, 0x54, 0x26, 0xee, 0x22, 0x8c, 0xec, 0x60, 0xf7, 0x82,
+	0xa9, 0x23, 0x7a, 0x02, 0xc0, 0x76, 0xcd, 0x03, 0x67, 0x66, 0x06, 0x17, 0xb4, 0x75, 0xca, 0xba,
+	0xc8, 0xa2, 0xc4, 0x34, 0x3f, 0x75, 0xac, 0x73, 0xa6, 0x93, 0xe8, 0x2c, 0x83, 0xf4, 0x27, 0xa4,
+	0x31, 0x86, 0xb4, 0x3f, 0xca, 0xba, 0xc8, 0xd2, 0x3c, 0x50, 0x92, 0x76, 0x44, 0x45, 0xe7, 0xb9,
+	0x94, 0xd8, 0xb9, 0x79, 0xa9, 0xd8, 0xa9, 0x2e, 0x9f, 0xfc, 0x7e, 0xe0, 0x1c, 0x3b, 0xfc, 0xdc,
+	0xbe, 0x4a, 0x48, 0x60, 0x20, 0x22, 0x78, 0x00, 0x95, 0x63, 0xdb, 0xb3, 0x03, 0x33, 0xb2, 0x2d,
+	0xd2, 0x20, 0xaa, 0x3b, 0xd7, 0xd8, 0xa0, 0x3d, 0x12, 0x60, 0xbe, 0xc0, 0x12, 0x3a, 0xed, 0x1c,
+	0x36, 0x96, 0xb0, 0x6b, 0x8f, 0xe4, 0x3b, 0x6c, 0x3f, 0x36, 0xb3, 0xf2, 0x9a, 0x60, 0x47, 0x1a,
+	0xc2, 0xb1, 0x43, 0xf8, 0xdf, 0x08, 0xa3, 0x80, 0x1f, 0xca, 0x25, 0xcc, 0x0f, 0xa3, 0x80, 0xe6,
+	0x20, 0xf2, 0x03, 0x9b, 0xc9, 0x77, 0x65, 0x9d, 0xe7, 0xb4, 0xaf, 0xa1, 0xc4, 0x57, 0x44, 0x5c,
+	0x7b, 0xe6, 0x92, 0xda, 0xdf, 0x80, 0x3a, 0xef, 0x7a, 0x18, 0x05, 0x8e, 0x77, 0xcc, 0xd7, 0x7a,
+	0x8d, 0x01, 0x87, 0x04, 0xc3, 0xd3, 0x1f, 0x57, 0xa3, 0x61, 0x8e, 0x1d, 0x17, 0xb9, 0x4a, 0x8e,
+	0x1b, 0x17, 0x16, 0xae, 0xdb, 0x62, 0x20, 0x6d, 0x00, 0x65, 0xb1, 0x7e, 0x7e, 0x2d, 0xdf, 0xd4,
+	0xfe, 0x5a, 0x06, 0xaa, 0x5d, 0xcf, 0xb2, 0xcf, 0x07, 0x24, 0xd1, 0xa8, 0xef, 0x81, 0x3a, 0x09,
+	0x6c, 0x33, 0xb2, 0x0d, 0xfb, 0x3c, 0x0a, 0x4c, 0x83, 0x59, 0x20, 0x98, 0xf6, 0xaf, 0x30, 0x4c,
+	0x07, 0x11, 0x23, 0x84, 0xe3, 0x8c, 0xce, 0xcd, 0x20, 0x14, 0x52, 0x20, 0xfb, 0x00, 0x30, 0x10,
+	0x97, 0xc1, 0x14, 0xef, 0x38, 0x30, 0x67, 0x46, 0xe4, 0x9f, 0xda, 0x1e, 0x93, 0x7f, 0x99, 0xe4,
+	0xdf, 0x20, 0xf8, 0x08, 0xc1, 0x24, 0x06, 0xff, 0x9b, 0x0c, 0xd4, 0x0f, 0xd9, 0x22, 0x7d, 0x62,
+	0x5f, 0xf0, 0x59, 0x9c, 0x08, 0x06, 0x93, 0xd7, 0x29, 0xad, 0xde, 0x81, 0xea, 0xfc, 0xd4, 0xbe,
+	0x30, 0x52, 0xaa, 0x49, 0x05, 0x41, 0x6d, 0x62, 0x25, 0xef, 0x40, 0xd1, 0xa7, 0x8e, 0x34, 0x73,
+	0xf2, 0x49, 0x26, 0xf5, 0x50, 0xe7, 0x04, 0xaa, 0x06, 0xf5, 0xb8, 0x2a, 0x59, 0xd8, 0xe2, 0x95,
+	0x51, 0xf3, 0xb7, 0xa0, 0x80, 0xa8, 0xb0, 0x59, 0xd8, 0xce, 0xa1, 0x7e, 0x41, 0x19, 0xf5, 0x43,
+	0xa8, 0x4f, 0xfc, 0xd9, 0xdc, 0x10, 0xc5, 0xf9, 0xe1, 0x9c, 0x66, 0x81, 0x55, 0x24, 0x39, 0x64,
+	0x75, 0x69, 0xbf, 0x9b, 0x83, 0x32, 0xb5, 0x81, 0x73, 0x41, 0xc7, 0x3a, 0x17, 0x5c, 0xb0, 0xa2,
+	0x17, 0x1c, 0x0b, 0x0f, 0x99, 0x57, 0x01, 0x1c, 0x24, 0x91, 0x87, 0xb2, 0x42, 0x10, 0xd1, 0x94,
+	0xb9, 0x19, 0x44, 0x61, 0x33, 0xc7, 0x9a, 0x42, 0x19, 0x5c, 0x9a, 0x0b, 0xcf, 0xf9, 0x7a, 0x61,
+	0x8b, 0xa5, 0xc9, 0x72, 0x38, 0xee, 0xac, 0x32, 0x9a, 0x3f, 0x59, 0x5a, 0x6c, 0x10, 0x9c, 0xa6,
+	0x4f, 0x6c, 0x4a, 0x46, 0x63, 0x9f, 0xe3, 0x71, 0xcc, 0x38, 0x21, 0x10, 0xa8, 0x83, 0x10, 0x99,
+	0xc7, 0x95, 0xd2, 0x3c, 0xae, 0x09, 0xa5, 0xe7, 0x4e, 0xe8, 0xe0, 0x02, 0x29, 0x33, 0xae, 0xc1,
+	0xb3, 0xd2, 0x34, 0x54, 0x5e, 0x34, 0x0d, 0x71, 0xb7, 0x4d, 0xf7, 0x98, 0xc9, 0xe9, 0xa2, 0xdb,
+	0x2d, 0xf7, 0xd8, 0x57, 0x3f, 0x82, 0x6b, 0x09, 0x9a, 0xf7, 0x86, 0xac, 0x56, 0x64, 0x98, 0xd1,
+	0xd5, 0x98, 0x92, 0x7a, 0x44, 0x8a, 0xd4, 0x3d, 0xd8, 0x94, 0x8a, 0xcc, 0x51, 0x1a, 0x0b, 0x89,
+	0x45, 0x56, 0xf4, 0x8d, 0x98, 0x9c, 0x84, 0xb4, 0x50, 0xfb, 0x17, 0x59, 0xa8, 0xef, 0xfb, 0x81,
+	0xed, 0x1c, 0x7b, 0xc9, 0xaa, 0x5b, 0xe1, 0x1d, 0x62, 0x25, 0x66, 0xa5, 0x95, 0xf8, 0x1a, 0x54,
+	0xa7, 0xac, 0xa0, 0x11, 0x8d, 0x99, 0x96, 0x9f, 0xd7, 0x81, 0x83, 0x46, 0x63, 0x17, 0x77, 0xb3,
+	0x20, 0xa0, 0xc2, 0x79, 0x2a, 0x2c, 0x0a, 0xe1, 0xd1, 0xa8, 0x7e, 0x41, 0x87, 0x84, 0x65, 0xbb,
+	0x76, 0xc4, 0xa6, 0xa7, 0xb1, 0xf3, 0xaa, 0x10, 0x4c, 0xa4, 0x36, 0xdd, 0xd7, 0xed, 0x69, 0x8b,
+	0xa4, 0x39, 0x3c, 0x33, 0xf6, 0x88, 0x5c, 0xfd, 0x42, 0x3e, 0x60, 0x8a, 0xdf, 0xb1, 0x2c, 0xe3,
+	0x1c, 0xda, 0x08, 0x2a, 0x31, 0x18, 0x45, 0x73, 0xbd, 0xc3, 0xc5, 0xf1, 0x2b, 0x6a, 0x15, 0x4a,
+	0xed, 0xd6, 0xb0, 0xdd, 0xda, 0xeb, 0x28, 0x19, 0x44, 0x0d, 0x3b, 0x23, 0x26, 0x82, 0x67, 0xd5,
+	0x0d, 0xa8, 0x62, 0x6e, 0xaf, 0xb3, 0xdf, 0x3a, 0xea, 0x8d, 0x94, 0x9c, 0x5a, 0x87, 0x4a, 0x7f,
+	0x60, 0xb4, 0xda, 0xa3, 0xee, 0xa0, 0xaf, 0xe4, 0xb5, 0xff, 0x17, 0xca, 0xed, 0x13, 0x7b, 0x72,
+	0x7a, 0xd9, 0x28, 0x92, 0x96, 0x6c, 0x4f, 0x4e, 0xd7, 0xb0, 0x60, 0x86, 0x20, 0x06, 0xe8, 0x47,
+	0x86, 0xed, 0x4d, 0xfd, 0x60, 0x62, 0x5b, 0x31, 0x03, 0xf4, 0xa3, 0x0e, 0x07, 0xa5, 0xd8, 0x74,
+	0x3e, 0xc5, 0xa6, 0xb5, 0xa7, 0x50, 0x6b, 0x8b, 0x13, 0xf0, 0xb2, 0x36, 0xec, 0x40, 0x83, 0xb6,
+	0xee, 0x64, 0x2c, 0xf6, 0x6e, 0x76, 0xcd, 0xde, 0xad, 0x21, 0x4d, 0x7b, 0xcc, 0x37, 0xef, 0x27,
+	0x50, 0x3d, 0x0c, 0xfc, 0xb9, 0x1d, 0x44, 0x54, 0xad, 0x02, 0xb9, 0x53, 0xfb, 0x82, 0xd7, 0x8a,
+	0xc9, 0xc4, 0x0a, 0x91, 0x95, 0xad, 0x10, 0x3b, 0x50, 0x16, 0xc5, 0xbe, 0x73, 0x99, 0x1f, 0x41,
+	0x9d, 0x97, 0x71, 0xec, 0x10, 0x3f, 0x76, 0x1f, 0x60, 0x1e, 0x03, 0xb8, 0xa8, 0x25, 0xd4, 0x0c,
+	0x5e, 0xb9, 0x2e, 0x51, 0x68, 0x7f, 0x99, 0x83, 0xc6, 0xa1, 0x19, 0x44, 0x0e, 0x4e, 0x2d, 0x1b,
+	0x86, 0xb7, 0x21, 0x4f, 0x1b, 0x86, 0x19, 0x3c, 0xae, 0xc6, 0x3a, 0x0a, 0xa3, 0x21, 0x99, 0x89,
+	0x08, 0xd4, 0x2f, 0xa0, 0x31, 0x17, 0x60, 0x43, 0x3a, 0x2b, 0x97, 0x8b, 0xd0, 0x8c, 0xd5, 0xe7,
+	0x72, 0x56, 0xfd, 0x21, 0x6c, 0xa5, 0xcb, 0xda, 0x61, 0x98, 0x70, 0x61, 0x79, 0xaa, 0xaf, 0xa6,
+	0x0a, 0x32, 0x32, 0xb5, 0x0d, 0x9b, 0x49, 0xf1, 0x89, 0xef, 0x2e, 0x66, 0x5e, 0xc8, 0x95, 0xa6,
+	0xeb, 0x4b, 0x5f, 0x6f, 0x33, 0xac, 0xae, 0xcc, 0x97, 0x20, 0xaa, 0x06, 0xb5, 0x18, 0xd6, 0x5f,
+	0xcc, 0x68, 0x43, 0xe5, 0xf5, 0x14, 0x4c, 0x7d, 0x00, 0x10, 0xe7, 0x51, 0x4d, 0xce, 0xad, 0xe9,
+	0x5f, 0x37, 0xb2, 0x67, 0xba, 0x44, 0x86, 0xb2, 0x16, 0xb2, 0x92, 0xc0, 0x89, 0x4e, 0x66, 0xc4,
+	0x03, 0x73, 0x7a, 0x02, 0x20, 0x56, 0x1b, 0x1a, 0xa8, 0x93, 0xc7, 0x45, 0x38, 0x3b, 0x6c, 0x38,
+	0xe1, 0x70, 0x31, 0x8e, 0xeb, 0xc5, 0x03, 0x39, 0xe9, 0xe5, 0x2c, 0x3c, 0xe6, 0x96, 0x8b, 0xa4,
+	0x85, 0x07, 0xe1, 0xb1, 0xba, 0x03, 0xd7, 0x12, 0xa2, 0x84, 0x7b, 0x87, 0x4d, 0x20, 0xbe, 0x9f,
+	0x0c, 0x5f, 0xcc, 0xc2, 0x43, 0xed, 0x27, 0x50, 0x4f, 0xcd, 0xce, 0x0b, 0x45, 0x03, 0x79, 0x17,
+	0x65, 0xd3, 0xbb, 0xc8, 0x06, 0x65, 0x79, 0xac, 0xd5, 0x37, 0xc9, 0x9a, 0x87, 0xc9, 0x35, 0x56,
+	0x39, 0x81, 0x42, 0xe3, 0xcc, 0xea, 0x24, 0x66, 0xa9, 0xd5, 0x2b, 0x93, 0xa5, 0xfd, 0x7e, 0x16,
+	0xea, 0xa9, 0x11, 0x57, 0x7f, 0x20, 0x2f, 0x3f, 0x69, 0xe3, 0x26, 0x63, 0x46, 0xe7, 0xd5, 0x3b,
+	0xa0, 0xf8, 0x81, 0xe5, 0x78, 0x26, 0x59, 0x17, 0xd9, 0x70, 0x67, 0x49, 0x34, 0xde, 0xe0, 0xf0,
+	0x43, 0x0e, 0x46, 0x25, 0xcd, 0xb2, 0x63, 0x63, 0x0d, 0x97, 0xea, 0x64, 0x90, 0x7c, 0xb6, 0xe5,
+	0xd3, 0x67, 0xdb, 0xdb, 0x50, 0x71, 0xed, 0x30, 0x34, 0xa2, 0x13, 0xd3, 0x6b, 0x16, 0x56, 0x3a,
+	0x5d, 0x46, 0xe4, 0xe8, 0xc4, 0xf4, 0x90, 0xd0, 0xf1, 0x0c, 0xee, 0x8e, 0x29, 0xae, 0x12, 0x3a,
+	0x1e, 0x29, 0xab, 0x28, 0x35, 0x6c, 0xad, 0x9b, 0x58, 0x7e, 0xa8, 0xaa, 0xab, 0xf3, 0xaa, 0xbd,
+	0x0a, 0xa5, 0xa7, 0x8e, 0x7d, 0xc6, 0x79, 0xd9, 0x73, 0xc7, 0x3e, 0x13, 0xbc, 0x0c, 0xd3, 0xda,
+	0x7f, 0x29, 0x43, 0x99, 0x88, 0xf7, 0x2e, 0xb7, 0xe2, 0xbe, 0x8c, 0x6a, 0xb5, 0x0d, 0xf9, 0xf8,
+	0xa0, 0x5a, 0xe6, 0x88, 0x84, 0xc1, 0xb3, 0x5a, 0x3a, 0x81, 0x99, 0x3c, 0x51, 0x89, 0xe2, 0x83,
+	0x17, 0x75, 0x12, 0x92, 0x10, 0xc3, 0xaf, 0x5d, 0x6e, 0x82, 0x4a, 0x00, 0xea, 0x7d, 0xa6, 0x31,
+	0x90, 0xf1, 0xa9, 0x24, 0x33, 0x16, 0xea, 0x83, 0xb0, 0x57, 0x90, 0x1a, 0x81, 0x19, 0x92, 0x2e,
+	0xec, 0x20, 0x14, 0xdb, 0xa9, 0xae, 0x8b, 0x2c, 0x72, 0x34, 0x14, 0xbd, 0x9a, 0x55, 0xb9, 0x96,
+	0x94, 0xec, 0xa8, 0x13, 0x81, 0x7a, 0x17, 0x4a, 0x74, 0xe0, 0xdb, 0x78, 0xfe, 0x4b, 0xac, 0x53,
+	0x88, 0x62, 0xba, 0x40, 0xab, 0xef, 0x40, 0x61, 0x7a, 0x6a, 0x5f, 0x84, 0xcd, 0xba, 0xcc, 0x12,
+	0x52, 0x27, 0xa9, 0xce, 0x28, 0xd4, 0x37, 0xa1, 0x11, 0xd8, 0x53, 0x83, 0xec, 0xba, 0x78, 0xf4,
+	0x87, 0xcd, 0x06, 0x9d, 0xec, 0xb5, 0xc0, 0x9e, 0xb6, 0x11, 0x38, 0x1a, 0xbb, 0xa1, 0xfa, 0x16,
+	0x14, 0xe9, 0x4c, 0x43, 0x85, 0x4a, 0xfa, 0xb2, 0x38, 0x20, 0x75, 0x8e, 0x55, 0x77, 0xa0, 0x92,
+	0xb0, 0x8d, 0x6b, 0xd4, 0xa1, 0xad, 0x25, 0x7e, 0x44, 0x6c, 0x5c, 0x4f, 0xc8, 0xd4, 0x8f, 0x00,
+	0xb8, 0xaa, 0x67, 0x8c, 0x2f, 0xc8, 0x53, 0x52, 0x8d, 0x55, 0x61, 0xe9, 0x00, 0x94, 0x15, 0xc2,
+	0xb7, 0xa1, 0x80, 0xa7, 0x44, 0xd8, 0xbc, 0xb1, 0x9d, 0x4b, 0xe4, 0x31, 0xe9, 0x58, 0xd3, 0x19,
+	0x1e, 0x8d, 0xa6, 0xb8, 0xb8, 0x0c, 0x9c, 0xc2, 0xa6, 0xac, 0xfb, 0xf2, 0x95, 0x88, 0x32, 0x9e,
+	0x7d, 0x36, 0xfc, 0xda, 0x55, 0xef, 0x41, 0xde, 0xb2, 0xa7, 0x61, 0xf3, 0xe6, 0x76, 0x2e, 0x61,
+	0xd3, 0x62, 0x3d, 0xa2, 0xaa, 0xcc, 0x8e, 0x16, 0xa4, 0x51, 0x1f, 0x43, 0x03, 0x97, 0xde, 0x0e,
+	0x89, 0xed, 0x38, 0xe4, 0xcd, 0x5b, 0x54, 0xea, 0xf5, 0xa5, 0x52, 0x7d, 0x4e, 0x44, 0x13, 0xd4,
+	0xf1, 0xa2, 0xe0, 0x42, 0xaf, 0x7b, 0x32, 0x4c, 0xbd, 0x85, 0x36, 0x93, 0x9e, 0x3f, 0x39, 0xb5,
+	0xad, 0xe6, 0x2b, 0xcc, 0xb9, 0x2a, 0xf2, 0xea, 0xe7, 0x50, 0xa7, 0xc5, 0x88, 0x59, 0xfc, 0x78,
+	0xf3, 0xb6, 0x7c, 0xe4, 0x8d, 0x64, 0x94, 0x9e, 0xa6, 0x44, 0xc9, 0xc3, 0x09, 0x8d, 0xc8, 0x9e,
+	0xcd, 0xfd, 0x00, 0xb5, 0xe6, 0x57, 0x99, 0xe4, 0xe1, 0x84, 0x23, 0x01, 0x42, 0x3e, 0x1f, 0xfb,
+	0x75, 0x0d, 0x7f, 0x3a, 0x0d, 0xed, 0xa8, 0x79, 0x87, 0xf6, 0x5a, 0x43, 0xb8, 0x77, 0x07, 0x04,
+	0x25, 0x91, 0x36, 0x34, 0xac, 0x0b, 0xcf, 0x9c, 0x39, 0x93, 0xe6, 0x6b, 0x4c, 0x39, 0x77, 0xc2,
+	0x3d, 0x06, 0x90, 0xf5, 0xe3, 0xed, 0x94, 0x7e, 0x7c, 0x15, 0x0a, 0xd6, 0x18, 0xb7, 0xf0, 0xeb,
+	0x54, 0x6d, 0xde, 0x1a, 0x77, 0xad, 0x5b, 0x8f, 0x48, 0xc9, 0xa4, 0x46, 0x7e, 0xb2, 0x24, 0x0c,
+	0xa4, 0x56, 0xbf, 0x24, 0x35, 0xa0, 0x5f, 0x2d, 0x21, 0xdc, 0x2d, 0x40, 0xce, 0xb2, 0xa7, 0xb7,
+	0x7e, 0x0c, 0xea, 0xea, 0xf0, 0xbe, 0x48, 0x32, 0x29, 0x70, 0xc9, 0xe4, 0x8b, 0xec, 0xc3, 0x8c,
+	0xf6, 0x39, 0xd4, 0x53, 0x7b, 0x75, 0xad, 0x84, 0xc5, 0xf4, 0x14, 0x73, 0xc6, 0xcd, 0x50, 0x2c,
+	0xa3, 0xfd, 0x59, 0x0e, 0x6a, 0x8f, 0xcd, 0xf0, 0xe4, 0xc0, 0x9c, 0x0f, 0x23, 0x33, 0x0a, 0x71,
+	0xc0, 0x4f, 0xcc, 0xf0, 0x64, 0x66, 0xce, 0x99, 0x52, 0x98, 0x61, 0x16, 0x34, 0x0e, 0x43, 0x8d,
+	0x10, 0xa7, 0x1a, 0xb3, 0x03, 0xef, 0xf0, 0x09, 0x37, 0x8f, 0xc5, 0x79, 0x64, 0x0e, 0xe1, 0xc9,
+	0x62, 0x3a, 0x75, 0x6d, 0xce, 0xc4, 0x44, 0x56, 0x7d, 0x13, 0xea, 0x3c, 0x49, 0x1a, 0xe1, 0x39,
+	0xf7, 0xb4, 0xa7, 0x81, 0xea, 0x03, 0xa8, 0x72, 0xc0, 0x48, 0xb0, 0xb2, 0x46, 0x6c, 0xf6, 0x4c,
+	0x10, 0xba, 0x4c, 0xa5, 0xfe, 0x14, 0xae, 0x49, 0xd9, 0x7d, 0x3f, 0x38, 0x58, 0xb8, 0x91, 0xd3,
+	0xee, 0x73, 0xf1, 0xfb, 0x95, 0x95, 0xe2, 0x09, 0x89, 0xbe, 0xbe, 0x64, 0xba, 0xb5, 0x07, 0x8e,
+	0xc7, 0xc5, 0x8b, 0x34, 0x70, 0x89, 0xca, 0x3c, 0x6f, 0x96, 0x57, 0xa8, 0xcc, 0x73, 0x5c, 0xfe,
+	0x1c, 0x70, 0x60, 0x47, 0x27, 0xbe, 0xd5, 0xac, 0xc8, 0xcb, 0x7f, 0x28, 0xa3, 0xf4, 0x34, 0x25,
+	0x0e, 0x27, 0x5a, 0x19, 0x26, 0x5e, 0x44, 0x1a, 0x58, 0x4e, 0x17, 0x59, 0x3c, 0x2c, 0x02, 0xd3,
+	0x3b, 0xb6, 0xc3, 0x66, 0x75, 0x3b, 0x77, 0x37, 0xa3, 0xf3, 0x9c, 0xf6, 0xff, 0x65, 0xa1, 0xc0,
+	0x66, 0xf2, 0x15, 0xa8, 0x8c, 0x31, 0x94, 0xc2, 0x40, 0x23, 0x15, 0xf7, 0x98, 0x10, 0x00, 0xe5,
+	0x2d, 0xd2, 0x9c, 0xb8, 0x79, 0x33, 0xa3, 0x53, 0x1a, 0xab, 0xf4, 0x17, 0x11, 0x7e, 0x2b, 0x47,
+	0x50, 0x9e, 0xc3, 0x46, 0x04, 0xfe, 0x19, 0xad, 0x86, 0x3c, 0x21, 0x44, 0x16, 0x3f, 0xc1, 0xce,
+	0x1d, 0x2c, 0x54, 0x20, 0x5c, 0x99, 0x00, 0x6d, 0x2f, 0x5a, 0x36, 0xc5, 0x16, 0x57, 0x4c, 0xb1,
+	0x18, 0x32, 0x41, 0xda, 0xc3, 0xc0, 0xb3, 0xdb, 0x7d, 0x1a, 0xe1, 0xb2, 0x2e, 0x41, 0xd4, 0x4f,
+	0xe3, 0xb5, 0x48, 0x3d, 0x6a, 0x96, 0x65, 0x8e, 0x2a, 0xaf, 0x5a, 0x3d, 0x45, 0xa7, 0x75, 0x00,
+	0x74, 0xff, 0x2c, 0xb4, 0x23, 0x92, 0xb9, 0x6e, 0x50, 0xf3, 0x53, 0xbe, 0x50, 0xff, 0x0c, 0x5d,
+	0x9e, 0x2f, 0xb0, 0x3c, 0x69, 0x1f, 0x40, 0x09, 0x4f, 0x59, 0x33, 0x32, 0xd1, 0x28, 0x4e, 0x26,
+	0x5c, 0x26, 0x65, 0x71, 0x5b, 0x76, 0xf2, 0x0d, 0x6e, 0xd4, 0xed, 0x89, 0xef, 0x52, 0x99, 0xd7,
+	0x25, 0x33, 0x49, 0xcc, 0xad, 0x79, 0x85, 0xfc, 0xdc, 0x7e, 0x05, 0x2a, 0xd8, 0x34, 0x72, 0x22,
+	0xf1, 0x6d, 0x8d, 0xee, 0xc8, 0x36, 0xe6, 0xb5, 0x7f, 0x9b, 0x81, 0xea, 0x20, 0xb0, 0xf0, 0x98,
+	0x40, 0x77, 0xc0, 0x0b, 0x65, 0x47, 0x3c, 0xe5, 0x7d, 0xd7, 0x35, 0x63, 0xc9, 0xab, 0xa2, 0x27,
+	0x00, 0xf5, 0x23, 0xc8, 0x4f, 0x5d, 0xf3, 0xb8, 0x99, 0x93, 0x35, 0x52, 0xa9, 0x7a, 0x91, 0x46,
+	0xcf, 0x91, 0x4e, 0xa4, 0xda, 0x6f, 0x41, 0x55, 0x02, 0xa6, 0x9c, 0x48, 0x57, 0xc8, 0xa1, 0x39,
+	0x6c, 0x2b, 0x19, 0xf4, 0x32, 0xed, 0x75, 0x86, 0x6d, 0xa6, 0x87, 0xa2, 0x46, 0x3a, 0x34, 0xf6,
+	0xbb, 0xfa, 0x70, 0xa4, 0xe4, 0xc9, 0x43, 0x4a, 0x80, 0x5e, 0x6b, 0x88, 0x2e, 0x25, 0x80, 0xe2,
+	0x51, 0xbf, 0xfb, 0xd3, 0xa3, 0x8e, 0xa2, 0x68, 0xff, 0x2d, 0x03, 0x90, 0xf8, 0x3a, 0xd4, 0x77,
+	0xa1, 0x7a, 0x46, 0x39, 0x43, 0x72, 0x82, 0xc9, 0x7d, 0x04, 0x86, 0x26, 0x09, 0xe4, 0x7d, 0x49,
+	0xa1, 0xc0, 0x93, 0x76, 0xd5, 0x1b, 0x56, 0x9d, 0x27, 0x87, 0xb4, 0xfa, 0x1e, 0x94, 0x7d, 0xec,
+	0x07, 0x92, 0xe6, 0xe4, 0x63, 0x56, 0xea, 0xbe, 0x5e, 0xf2, 0x03, 0x4b, 0x9c, 0xc8, 0xd3, 0x40,
+	0x98, 0x9d, 0x62, 0xd2, 0x7d, 0x04, 0xb5, 0x5d, 0x73, 0x11, 0xda, 0x3a, 0xc3, 0xc7, 0x4c, 0xb6,
+	0x20, 0x31, 0x59, 0x3c, 0xae, 0x8e, 0x3d, 0x3f, 0xb0, 0xc9, 0x7c, 0x1d, 0x72, 0xab, 0x4d, 0x95,
+	0xc1, 0xd0, 0x84, 0x1d, 0x6a, 0x3f, 0x87, 0xc6, 0xd0, 0x9c, 0xcd, 0x19, 0xb7, 0xa6, 0xbe, 0xab,
+	0x90, 0xc7, 0x65, 0xc3, 0x57, 0x27, 0xa5, 0x71, 0xcf, 0x1d, 0xda, 0xc1, 0xc4, 0xf6, 0xc4, 0x16,
+	0x15, 0x59, 0xe4, 0xbe, 0x47, 0xa1, 0xe3, 0x1d, 0xeb, 0xfe, 0x99, 0x88, 0x62, 0x12, 0x79, 0x74,
+	0xff, 0x55, 0xa5, 0x96, 0xaa, 0x1f, 0xa4, 0x54, 0xcc, 0x57, 0x56, 0xba, 0xc2, 0xd2, 0x92, 0xaa,
+	0xf9, 0x16, 0x14, 0xc2, 0xc8, 0x0c, 0x84, 0x67, 0x4d, 0x91, 0x4a, 0xec, 0xfa, 0x0b, 0xcf, 0xd2,
+	0x19, 0x1a, 0xed, 0xf8, 0xb6, 0x67, 0x35, 0x73, 0x97, 0x50, 0x21, 0x52, 0x7b, 0x0f, 0x2a, 0x71,
+	0xf5, 0xb8, 0x4a, 0xf4, 0xc1, 0xb3, 0xa1, 0x72, 0x45, 0xad, 0x40, 0x41, 0x6f, 0xf5, 0x1f, 0x75,
+	0x98, 0x23, 0xf2, 0x91, 0x3e, 0x38, 0x3a, 0x1c, 0x2a, 0x59, 0x74, 0xe1, 0x42, 0x52, 0x83, 0x7a,
+	0x3f, 0xd5, 0xf2, 0x5b, 0xcb, 0x5f, 0xb8, 0x4f, 0x7f, 0xa5, 0x86, 0xdf, 0x86, 0xca, 0xc2, 0x23,
+	0xa0, 0x6d, 0xf1, 0x43, 0x29, 0x01, 0x60, 0xbc, 0x89, 0x88, 0x7d, 0x5a, 0x8a, 0x37, 0x79, 0x6e,
+	0xba, 0xda, 0x17, 0x50, 0x89, 0xab, 0x43, 0xdb, 0xc9, 0xfe, 0xa0, 0xd7, 0x1b, 0x3c, 0xeb, 0xf6,
+	0x1f, 0x29, 0x57, 0x30, 0x7b, 0xa8, 0x77, 0xda, 0x9d, 0x3d, 0xcc, 0x66, 0x70, 0x89, 0xb7, 0x8f,
+	0x74, 0xbd, 0xd3, 0x1f, 0x19, 0xfa, 0xe0, 0x99, 0x92, 0xd5, 0x7e, 0x27, 0x0f, 0x9b, 0x03, 0x6f,
+	0x6f, 0x31, 0x77, 0x9d, 0x89, 0x19, 0xd9, 0x4f, 0xec, 0x8b, 0x76, 0x74, 0x8e, 0x67, 0xad, 0x19,
+	0x45, 0x01, 0xdb, 0xfb, 0x15, 0x9d, 0x65, 0x98, 0xed, 0x2f, 0xb4, 0x83, 0x88, 0x4c, 0x9b, 0xf2,
+	0xa6, 0x6f, 0x30, 0x78, 0xdb, 0x77, 0x69, 0xeb, 0xab, 0x3f, 0x84, 0x6b, 0xcc, 0x5e, 0xc8, 0x28,
+	0x51, 0x22, 0x65, 0x8a, 0x7f, 0x6e, 0x65, 0xa5, 0xab, 0x8c, 0x10, 0x8b, 0x22, 0x19, 0xc2, 0xd0,
+	0x04, 0x96, 0x14, 0x67, 0x7a, 0x43, 0x45, 0x87, 0x98, 0x90, 0x5a, 0x82, 0xf6, 0x2d, 0xd1, 0x6a,
+	0x03, 0xfd, 0x0e, 0xa8, 0x4b, 0x15, 0xf4, 0x86, 0x9f, 0x74, 0x06, 0xcf, 0xe3, 0x2f, 0x61, 0x33,
+	0x45, 0x49, 0xad, 0x60, 0xda, 0xd4, 0x7b, 0xc2, 0x6d, 0xb2, 0xd4, 0x7b, 0x19, 0x82, 0xcd, 0x61,
+	0xe2, 0xe2, 0x86, 0x9f, 0x86, 0x22, 0xef, 0x73, 0x42, 0x83, 0xed, 0x0c, 0xce, 0xfb, 0xcb, 0x4e,
+	0xd8, 0xa5, 0x7c, 0xa2, 0xd0, 0x48, 0xc1, 0x06, 0xec, 0xa8, 0x11, 0xbe, 0x76, 0x86, 0x76, 0xd8,
+	0x61, 0x9a, 0xd7, 0x4b, 0x94, 0xef, 0x5a, 0xa8, 0xcb, 0x33, 0x94, 0xd0, 0x51, 0x80, 0x74, 0x94,
+	0x1a, 0x01, 0x9f, 0x32, 0xd8, 0xad, 0x3e, 0x6c, 0xad, 0x6b, 0xe4, 0x1a, 0xa1, 0x6b, 0x5b, 0x16,
+	0xba, 0x96, 0x6c, 0x63, 0x89, 0x00, 0xf6, 0x37, 0x72, 0x50, 0x61, 0x26, 0x3c, 0x9c, 0xfd, 0xbb,
+	0x80, 0x71, 0x11, 0x46, 0x60, 0x4f, 0x2f, 0x73, 0xe6, 0x17, 0xfd, 0xf1, 0x57, 0x18, 0xfe, 0xf1,
+	0xae, 0x38, 0x3f, 0x2d, 0x7b, 0xca, 0xbf, 0xd0, 0x48, 0x4b, 0xde, 0xfc, 0x3c, 0x65, 0x26, 0xa7,
+	0xab, 0xcb, 0x7a, 0xaa, 0x63, 0x31, 0xb3, 0x73, 0x5e, 0xdf, 0x4c, 0xab, 0xa9, 0x5d, 0x2b, 0xbc,
+	0xdc, 0x60, 0x91, 0xbf, 0xd4, 0x60, 0x81, 0x26, 0x5a, 0xdf, 0xb5, 0x12, 0x83, 0x09, 0x5f, 0x19,
+	0xb8, 0x46, 0x37, 0x7c, 0xd7, 0x4a, 0x0c, 0x03, 0xd6, 0x39, 0xd2, 0x7a, 0xf6, 0xd9, 0x12, 0x6d,
+	0x91, 0xd1, 0x7a, 0xf6, 0x59, 0x8a, 0xf6, 0x01, 0x54, 0x93, 0xa5, 0x8f, 0xd1, 0x91, 0xb9, 0x65,
+	0xb7, 0x3a, 0xf7, 0x00, 0x42, 0xbc, 0x13, 0x42, 0x2c, 0xc4, 0x4c, 0xb0, 0xac, 0x50, 0xf9, 0xf2,
+	0x42, 0x8c, 0x8c, 0xbc, 0x9a, 0xff, 0x38, 0x0b, 0x95, 0x2e, 0xab, 0x23, 0x3a, 0xc7, 0x38, 0x81,
+	0x6f, 0x99, 0x06, 0xc4, 0x61, 0x37, 0x4c, 0xcb, 0x32, 0xcc, 0xe9, 0xd4, 0x9e, 0x44, 0xb6, 0x65,
+	0xa0, 0x6c, 0xc3, 0x39, 0xc8, 0x86, 0x69, 0x59, 0x2d, 0x0e, 0x27, 0xae, 0xcc, 0x4c, 0x4a, 0x42,
+	0xc7, 0x63, 0x2e, 0x98, 0x9c, 0x30, 0x29, 0x71, 0x15, 0x8f, 0x39, 0x60, 0x52, 0x33, 0x9b, 0xff,
+	0x7e, 0x33, 0x5b, 0x78, 0xe9, 0x99, 0x2d, 0x5e, 0x3e, 0xb3, 0x29, 0x1b, 0x17, 0xce, 0x54, 0x89,
+	0x66, 0x2a, 0x39, 0x48, 0xbb, 0xd6, 0xb9, 0xf6, 0xf7, 0x73, 0xe8, 0x41, 0x9e, 0xbb, 0xe6, 0xc4,
+	0xfe, 0x3f, 0x67, 0xf4, 0x5e, 0x93, 0x96, 0x89, 0x67, 0x89, 0x88, 0x27, 0xb1, 0x24, 0xe8, 0x2c,
+	0x59, 0x3b, 0xbc, 0xc5, 0x97, 0x1e, 0xde, 0xd2, 0x4b, 0x0c, 0x6f, 0x79, 0x75, 0x78, 0xd5, 0x1f,
+	0xc3, 0xab, 0x81, 0x7d, 0x16, 0x38, 0x91, 0x6d, 0x4c, 0x03, 0x7f, 0x66, 0xa4, 0x38, 0x2b, 0x32,
+	0x9e, 0x0a, 0x8d, 0xc6, 0x4d, 0x4e, 0xb4, 0x1f, 0xf8, 0xb3, 0x34, 0x77, 0xd5, 0xfe, 0xb8, 0x08,
+	0xd5, 0x96, 0x67, 0xba, 0x17, 0xdf, 0xd8, 0x14, 0x15, 0x45, 0x4e, 0x9a, 0xf9, 0x22, 0x62, 0xe3,
+	0xce, 0xc2, 0x04, 0x2a, 0x04, 0xa1, 0x11, 0x47, 0xc7, 0xee, 0x22, 0x8a, 0xf1, 0x2c, 0x70, 0x00,
+	0x18, 0x88, 0x08, 0xe2, 0xf2, 0xb1, 0x03, 0x50, 0x94, 0x27, 0x4d, 0x2f, 0x29, 0x1f, 0x4b, 0xff,
+	0x71, 0x79, 0x22, 0x40, 0x6e, 0xeb, 0xcc, 0x68, 0xe4, 0xc3, 0xc5, 0xcc, 0x66, 0xa3, 0x9f, 0x63,
+	0xd1, 0xa7, 0x6d, 0x0e, 0xc3, 0x5a, 0x66, 0xf6, 0xcc, 0x0f, 0x2e, 0x58, 0x2d, 0x45, 0x56, 0x0b,
+	0x03, 0x51, 0x2d, 0xef, 0x81, 0x7a, 0x66, 0x3a, 0x91, 0x91, 0xae, 0x8a, 0x69, 0x5c, 0x0a, 0x62,
+	0x46, 0x72, 0x75, 0xd7, 0xa1, 0x68, 0x39, 0xe1, 0x69, 0x77, 0xc0, 0xb5, 0x2d, 0x9e, 0xc3, 0xbe,
+	0x84, 0x13, 0x13, 0x05, 0xc2, 0xc8, 0x0e, 0x69, 0x28, 0x73, 0x7a, 0x05, 0x21, 0xbb, 0x08, 0x40,
+	0x09, 0xc1, 0xb3, 0xa3, 0x33, 0x3f, 0xc0, 0x92, 0x4c, 0x99, 0x4a, 0x00, 0x28, 0x55, 0x21, 0x29,
+	0x7e, 0x88, 0xcc, 0x57, 0x39, 0x3d, 0xce, 0xa3, 0x9a, 0xc2, 0xb8, 0x12, 0x61, 0x6b, 0xac, 0xf9,
+	0x09, 0x04, 0x0d, 0x4f, 0xd4, 0x7c, 0x52, 0xb6, 0xb0, 0x0f, 0xe4, 0xdb, 0xcf, 0xe9, 0x35, 0x84,
+	0x92, 0x25, 0x03, 0xa9, 0x3e, 0x87, 0x9b, 0xa9, 0xfe, 0x19, 0x66, 0x10, 0x98, 0x17, 0xc6, 0xcc,
+	0xfc, 0xca, 0x0f, 0xc8, 0x52, 0x95, 0xd3, 0xaf, 0xcb, 0xc3, 0xd6, 0x42, 0xf4, 0x01, 0x62, 0x2f,
+	0x2d, 0xea, 0x78, 0x7e, 0xd0, 0xdc, 0xb8, 0xac, 0x28, 0x62, 0x49, 0x20, 0xa5, 0x09, 0x26, 0xcd,
+	0x2f, 0x64, 0x51, 0xcb, 0x7a, 0x95, 0x60, 0xbb, 0x04, 0x42, 0xfd, 0x28, 0x7c, 0x60, 0x50, 0xcc,
+	0xcf, 0x26, 0x1b, 0xd0, 0xf0, 0x01, 0x05, 0x7c, 0x32, 0x04, 0xc6, 0x15, 0x34, 0x55, 0x81, 0xc0,
+	0xf8, 0x75, 0xb4, 0x69, 0x86, 0x0f, 0x8c, 0xf9, 0x22, 0x62, 0xe1, 0xc6, 0x7a, 0x21, 0x7c, 0x70,
+	0xb8, 0x88, 0x38, 0xf8, 0xd8, 0x8e, 0x9a, 0x5b, 0x02, 0xfc, 0xc8, 0x8e, 0xf0, 0xa0, 0x0f, 0x1f,
+	0x08, 0x67, 0xda, 0x35, 0x3e, 0xb6, 0x0f, 0xb8, 0xb7, 0x4c, 0x83, 0x7a, 0x8c, 0x34, 0x66, 0x0b,
+	0x16, 0x5f, 0x9c, 0xd3, 0xab, 0x82, 0xe0, 0x60, 0x41, 0x0e, 0x3b, 0xdc, 0x0f, 0x91, 0xed, 0xb1,
+	0x65, 0x7c, 0x83, 0x91, 0x70, 0x18, 0xad, 0xe3, 0xd7, 0x31, 0xee, 0xda, 0xb5, 0x63, 0x0e, 0xd4,
+	0x64, 0x24, 0x1c, 0x86, 0x24, 0x5a, 0x20, 0x39, 0x60, 0x0e, 0x83, 0x85, 0x67, 0x33, 0x93, 0x15,
+	0x25, 0x2d, 0xee, 0x48, 0x8f, 0xf3, 0xea, 0x1e, 0x5c, 0x65, 0x9a, 0xaa, 0x2d, 0x9d, 0x86, 0x22,
+	0xee, 0x6e, 0xad, 0x63, 0x42, 0x15, 0xf4, 0x31, 0x38, 0xd4, 0x7e, 0x91, 0x81, 0x5b, 0x03, 0xf2,
+	0xea, 0x13, 0xab, 0x38, 0xb0, 0xc3, 0xd0, 0x3c, 0x46, 0x33, 0xc3, 0xfe, 0xe2, 0x9b, 0x6f, 0xd0,
+	0x72, 0xb5, 0x71, 0x68, 0x06, 0xb6, 0x17, 0xc5, 0x8c, 0x84, 0x8b, 0x1e, 0xcb, 0x60, 0xf5, 0x21,
+	0x19, 0xff, 0x6d, 0x2f, 0x3a, 0x8a, 0x85, 0xb8, 0x66, 0x76, 0xe9, 0x3c, 0x44, 0xae, 0xb8, 0x42,
+	0xa5, 0xfd, 0x8f, 0x6d, 0xc8, 0xf7, 0x7d, 0xcb, 0x56, 0x3f, 0x84, 0x0a, 0x05, 0xcd, 0xae, 0xfa,
+	0x9c, 0x10, 0x4d, 0x7f, 0x48, 0x9e, 0x2e, 0x7b, 0x3c, 0x75, 0x79, 0x98, 0xed, 0xeb, 0xa4, 0x25,
+	0x90, 0xcb, 0x1b, 0x59, 0x73, 0x95, 0x1b, 0x32, 0x10, 0xa4, 0x33, 0x0c, 0x8e, 0x2d, 0x19, 0x62,
+	0x03, 0xdb, 0x23, 0x79, 0xa3, 0xa0, 0xc7, 0x79, 0x52, 0xdf, 0x02, 0x1f, 0x8f, 0x11, 0xb6, 0xea,
+	0x0a, 0x6b, 0xd4, 0x37, 0x86, 0xa7, 0x65, 0xf8, 0x21, 0x54, 0xbe, 0xf2, 0x1d, 0x8f, 0x35, 0xbc,
+	0xb8, 0xd2, 0xf0, 0x9f, 0xf8, 0x0e, 0x73, 0x96, 0x95, 0xbf, 0xe2, 0x29, 0xf5, 0x0d, 0x28, 0xf9,
+	0x1e, 0xab, 0xbb, 0xb4, 0x52, 0x77, 0xd1, 0xf7, 0x7a, 0x2c, 0x82, 0xad, 0x3e, 0x5e, 0xa0, 0xa9,
+	0x18, 0x49, 0xed, 0x69, 0xc4, 0x7d, 0x43, 0x55, 0x02, 0x0e, 0xbc, 0x9e, 0x3d, 0xc5, 0x60, 0xa1,
+	0xea, 0xd4, 0x71, 0xf1, 0xb4, 0xa2, 0xca, 0x2a, 0x2b, 0x95, 0x01, 0x43, 0x53, 0x85, 0x3f, 0x80,
+	0xf2, 0x71, 0xe0, 0x2f, 0xe6, 0xa8, 0x66, 0xc2, 0x0a, 0x65, 0x89, 0x70, 0xbb, 0x17, 0xc8, 0x32,
+	0x29, 0xe9, 0x78, 0xc7, 0x06, 0x69, 0xe4, 0x68, 0xbf, 0x29, 0xeb, 0x35, 0x01, 0x24, 0x5d, 0xfb,
+	0x07, 0x50, 0x36, 0x8f, 0x8f, 0x0d, 0x1e, 0x88, 0xb7, 0x52, 0x97, 0x79, 0x7c, 0x4c, 0x9f, 0xbc,
+	0x0f, 0xf5, 0x33, 0x0c, 0x23, 0x99, 0xdb, 0x13, 0x46, 0x5b, 0x5f, 0x1d, 0xca, 0x33, 0xc7, 0x43,
+	0x2d, 0x93, 0xe8, 0x65, 0x4d, 0xb8, 0xf1, 0x42, 0x4d, 0x78, 0x1b, 0x0a, 0xae, 0x33, 0x73, 0x22,
+	0x1e, 0x9a, 0x97, 0x92, 0x7d, 0x09, 0xa1, 0x6a, 0x50, 0xe4, 0x06, 0x57, 0x65, 0x85, 0x84, 0x63,
+	0xd2, 0x67, 0xf9, 0xe6, 0x0b, 0xce, 0x72, 0x49, 0x74, 0x56, 0xbf, 0x5d, 0x74, 0xfe, 0x84, 0xbc,
+	0x52, 0xb6, 0x17, 0x19, 0xa2, 0xc0, 0xd5, 0xf5, 0x05, 0x6a, 0x8c, 0x6c, 0xc0, 0x8a, 0x7d, 0x04,
+	0xd5, 0x80, 0x4c, 0x34, 0x06, 0xd9, 0x73, 0xb6, 0x64, 0x05, 0x36, 0xb1, 0xdd, 0xe8, 0x10, 0xc4,
+	0x69, 0xf5, 0x21, 0xa8, 0x5c, 0x76, 0x95, 0xa5, 0xd1, 0x6b, 0x2b, 0x23, 0xcd, 0x95, 0xbb, 0xbd,
+	0x58, 0x16, 0xc5, 0xa9, 0x66, 0xc1, 0x3a, 0x2c, 0xa4, 0x22, 0x24, 0xe6, 0x56, 0xd1, 0x6b, 0x04,
+	0x64, 0xe1, 0x16, 0x21, 0x7a, 0x92, 0x45, 0xbd, 0xd1, 0x79, 0xf3, 0x86, 0xdc, 0x09, 0x5e, 0x55,
+	0x74, 0xae, 0x57, 0x2c, 0x91, 0x44, 0x56, 0x37, 0x76, 0x3c, 0x0b, 0x97, 0x4f, 0x64, 0x1e, 0x23,
+	0xab, 0xc3, 0xdd, 0x55, 0xe5, 0xb0, 0x91, 0x79, 0x1c, 0xaa, 0x1f, 0x43, 0xcd, 0x64, 0x42, 0x02,
+	0x0b, 0xb2, 0xbe, 0x29, 0x5b, 0x32, 0x24, 0xf1, 0x41, 0xaf, 0x9a, 0x49, 0x46, 0xfd, 0x0c, 0x54,
+	0xe1, 0x07, 0x22, 0x35, 0x8f, 0xad, 0xa8, 0x5b, 0x2b, 0xfd, 0xdc, 0xe0, 0x8e, 0xa0, 0xf8, 0x62,
+	0xc0, 0x67, 0x50, 0x4f, 0x0b, 0x75, 0xb7, 0xd7, 0x78, 0x3e, 0x68, 0xb2, 0xf5, 0xda, 0x44, 0xca,
+	0xe1, 0xf8, 0x60, 0x58, 0xc1, 0xc4, 0x9c, 0x9c, 0xd8, 0x54, 0x90, 0x59, 0xf7, 0x31, 0xd6, 0xa0,
+	0x2d, 0x60, 0x38, 0x3e, 0x42, 0x75, 0x88, 0xce, 0x9b, 0x77, 0xe4, 0xf1, 0x89, 0xe5, 0x7c, 0x94,
+	0x59, 0x78, 0x92, 0x66, 0x98, 0x89, 0xb0, 0x54, 0xe0, 0xb5, 0xd4, 0x0c, 0xc7, 0xb2, 0xad, 0x0e,
+	0x41, 0x9c, 0xa6, 0xc8, 0x77, 0x7f, 0x11, 0x4c, 0x6c, 0x23, 0x8c, 0xec, 0x79, 0x73, 0x9b, 0x46,
+	0x14, 0x18, 0x68, 0x18, 0xd9, 0x73, 0xf5, 0x21, 0x34, 0xe6, 0x81, 0x6d, 0x48, 0xf3, 0xf4, 0xba,
+	0xdc, 0xc5, 0xc3, 0xc0, 0x4e, 0xa6, 0xaa, 0x36, 0x97, 0x72, 0xa2, 0xa4, 0xd4, 0x03, 0x6d, 0xa9,
+	0x64, 0xd2, 0x89, 0xda, 0x5c, 0xca, 0xa9, 0x3f, 0x82, 0x4d, 0xa9, 0xe4, 0xe2, 0x94, 0x0a, 0xbf,
+	0x91, 0x72, 0x44, 0x09, 0xf2, 0xa3, 0x53, 0x2c, 0xde, 0x98, 0xa7, 0xf2, 0x6a, 0x0b, 0x94, 0x15,
+	0x01, 0xf3, 0x4d, 0x2a, 0x7f, 0xe3, 0x12, 0xcd, 0x3d, 0xa5, 0xfd, 0x3f, 0x61, 0x2e, 0x87, 0x6e,
+	0xd8, 0xf1, 0xac, 0xe6, 0x0f, 0xd8, 0xed, 0x1d, 0xca, 0xa8, 0x0f, 0xa0, 0xc6, 0x44, 0x1d, 0x8a,
+	0x1c, 0x0e, 0x9b, 0x6f, 0xc9, 0x46, 0x51, 0x92, 0x77, 0x08, 0xa1, 0x57, 0xdd, 0x38, 0x1d, 0xaa,
+	0x9f, 0xc2, 0x26, 0xb3, 0x46, 0xcb, 0x0c, 0xf5, 0xed, 0xd5, 0xc5, 0x45, 0x44, 0xfb, 0x09, 0x57,
+	0xd5, 0xe1, 0x66, 0xb0, 0xf0, 0x48, 0xfc, 0xe1, 0x25, 0xe7, 0x81, 0x3f, 0xb6, 0x59, 0xf9, 0xbb,
+	0xdb, 0xb9, 0xa4, 0x3b, 0x3a, 0x23, 0x63, 0x65, 0x89, 0x93, 0x5d, 0x0f, 0x64, 0xd0, 0x21, 0x96,
+	0xbb, 0xa4, 0x4e, 0x76, 0x12, 0x50, 0x9d, 0xef, 0xbc, 0x4c, 0x9d, 0xbb, 0x58, 0x8e, 0xea, 0x54,
+	0x21, 0xbf, 0x58, 0x38, 0x56, 0xf3, 0x1e, 0x0b, 0xf2, 0xc5, 0x34, 0x7a, 0xce, 0x03, 0x7b, 0xb2,
+	0x08, 0x42, 0xe7, 0xb9, 0x6d, 0x84, 0x8e, 0x77, 0xda, 0x7c, 0x97, 0xc6, 0xb1, 0x1e, 0x43, 0x87,
+	0x8e, 0x77, 0x8a, 0x2b, 0xd6, 0x3e, 0x8f, 0xec, 0xc0, 0x33, 0x50, 0xe4, 0x6c, 0xbe, 0x27, 0xaf,
+	0xd8, 0x0e, 0x21, 0x86, 0x13, 0xd3, 0xd3, 0xc1, 0x8e, 0xd3, 0xea, 0x0f, 0x61, 0x23, 0x51, 0x37,
+	0xe6, 0x28, 0xb2, 0x34, 0xdf, 0x5f, 0xeb, 0xa3, 0x24, 0x71, 0x46, 0x6f, 0xcc, 0x53, 0xf9, 0xa5,
+	0xb5, 0x15, 0xb2, 0xb5, 0x75, 0xff, 0x3b, 0xad, 0xad, 0x21, 0xe6, 0xd5, 0xb7, 0xa0, 0xec, 0x78,
+	0x91, 0x1d, 0xa0, 0x55, 0xed, 0x83, 0x15, 0xd6, 0x1f, 0xe3, 0x30, 0x40, 0x21, 0x74, 0x1d, 0x64,
+	0x4c, 0xcd, 0x0f, 0x57, 0xc8, 0x04, 0x4a, 0xbd, 0x0b, 0x95, 0xf8, 0xba, 0x5a, 0xf3, 0xa3, 0x15,
+	0xba, 0x04, 0x89, 0x36, 0xf0, 0x33, 0x5c, 0x8f, 0x3b, 0x2b, 0x44, 0x04, 0x47, 0x59, 0x61, 0xea,
+	0xb8, 0x2e, 0x93, 0x15, 0x1e, 0xac, 0xc8, 0x0a, 0xfb, 0x8e, 0xeb, 0x32, 0x59, 0x61, 0xca, 0x53,
+	0x78, 0xd2, 0x52, 0x09, 0xec, 0xc9, 0xc7, 0xab, 0x27, 0x2d, 0xe2, 0x9e, 0xd2, 0xc5, 0xbe, 0x6a,
+	0x48, 0x56, 0x5b, 0x66, 0x9f, 0xfe, 0x44, 0x1e, 0xab, 0xb4, 0x39, 0x57, 0x87, 0x30, 0xce, 0xa3,
+	0x4e, 0xc2, 0xcd, 0xda, 0xa8, 0x13, 0x7e, 0xca, 0xee, 0x9b, 0x30, 0x08, 0x2a, 0x84, 0x1f, 0x42,
+	0x5d, 0xc4, 0x7e, 0xe1, 0xe7, 0xc2, 0xe6, 0x67, 0x2b, 0x2d, 0x48, 0x13, 0xa8, 0x7b, 0x50, 0x9b,
+	0xa2, 0xec, 0x38, 0x63, 0xa2, 0x64, 0xf3, 0x21, 0x35, 0x64, 0x5b, 0x9c, 0xe2, 0x97, 0x89, 0x9a,
+	0x7a, 0xaa, 0x94, 0x7a, 0x1f, 0x54, 0x67, 0xca, 0xe6, 0x13, 0x95, 0x4c, 0x26, 0x2e, 0x36, 0x3f,
+	0xa7, 0xc5, 0xb9, 0x06, 0xa3, 0x3e, 0x80, 0x7a, 0x68, 0x7b, 0x16, 0xc6, 0xc6, 0xb0, 0x4d, 0xf2,
+	0xc5, 0x76, 0x2e, 0x61, 0xc3, 0xf1, 0xb5, 0x56, 0xf4, 0xee, 0x78, 0xd6, 0x41, 0xc8, 0x84, 0x93,
+	0x07, 0x80, 0xeb, 0xfc, 0x79, 0x52, 0xe8, 0xff, 0xba, 0xa4, 0x10, 0x52, 0x89, 0x42, 0x9f, 0xc1,
+	0x06, 0x0b, 0x9d, 0xc3, 0x25, 0xc9, 0x8a, 0xfd, 0x50, 0x2e, 0x16, 0x5b, 0xd9, 0xf4, 0xfa, 0x42,
+	0x24, 0xc5, 0xd7, 0x48, 0xfb, 0x0b, 0x3d, 0x73, 0x1e, 0x9e, 0xf8, 0x51, 0xf3, 0x37, 0x65, 0x51,
+	0x63, 0xc8, 0xa1, 0x7a, 0x0d, 0x89, 0x44, 0x0e, 0x0f, 0xa0, 0x64, 0x83, 0x4e, 0x22, 0xbb, 0xf9,
+	0x23, 0x76, 0x00, 0xc5, 0xc0, 0x76, 0x84, 0x9d, 0x07, 0x73, 0x3e, 0x77, 0x2f, 0xd8, 0xa2, 0xfa,
+	0x31, 0x2d, 0xaa, 0x2d, 0x69, 0x51, 0xb5, 0x10, 0x49, 0xab, 0xaa, 0x62, 0x8a, 0xa4, 0xba, 0x03,
+	0xb5, 0xb9, 0x1f, 0x46, 0x86, 0x35, 0x73, 0x69, 0x73, 0xb5, 0xe4, 0x4d, 0x7d, 0xe8, 0x87, 0xd1,
+	0xde, 0xcc, 0xa5, 0x63, 0x68, 0x1e, 0xa7, 0xd5, 0x1e, 0x5c, 0x4d, 0x31, 0x6c, 0x93, 0x9c, 0xb9,
+	0xcd, 0x5d, 0xfa, 0xe2, 0x6d, 0xe9, 0x8b, 0x12, 0xe3, 0xe6, 0x21, 0x84, 0x9b, 0xfe, 0x32, 0x08,
+	0xb5, 0x52, 0xcb, 0xb6, 0x16, 0xf3, 0x24, 0x8e, 0xb6, 0xcd, 0xa4, 0x0f, 0x82, 0x8a, 0x40, 0xda,
+	0x87, 0xb0, 0x91, 0x50, 0x61, 0x07, 0xc3, 0xe6, 0x9e, 0xbc, 0x06, 0xa5, 0xe0, 0xfc, 0xba, 0x28,
+	0x88, 0xb0, 0x50, 0xfb, 0xd3, 0x02, 0x94, 0x85, 0xd2, 0x80, 0xd1, 0x89, 0x47, 0xfd, 0x27, 0xfd,
+	0xc1, 0xb3, 0x3e, 0xbb, 0x24, 0xd7, 0x1a, 0x0e, 0x3b, 0xfa, 0x48, 0xc1, 0x1b, 0x79, 0x40, 0x97,
+	0x80, 0x8c, 0x61, 0xbb, 0xd5, 0x67, 0x97, 0xe6, 0xe8, 0xea, 0x11, 0xcb, 0x67, 0xd5, 0x4d, 0xa8,
+	0xef, 0x1f, 0xf5, 0x29, 0x52, 0x91, 0x81, 0x72, 0x08, 0xea, 0x7c, 0xc9, 0xfc, 0x4c, 0x0c, 0x84,
+	0xd7, 0x85, 0xea, 0x07, 0xad, 0x51, 0x47, 0xef, 0x0a, 0x50, 0x81, 0x82, 0x1e, 0x07, 0x47, 0x7a,
+	0x9b, 0xd7, 0x54, 0x54, 0xaf, 0xc1, 0x66, 0x5c, 0x4c, 0x54, 0xa9, 0x94, 0xb0, 0x65, 0x87, 0xfa,
+	0xe0, 0x27, 0x9d, 0xf6, 0x48, 0x01, 0x72, 0x5a, 0x3d, 0x7a, 0xa4, 0x54, 0xd1, 0x97, 0xb5, 0xd7,
+	0x1d, 0x8e, 0xba, 0xfd, 0xf6, 0x48, 0xa9, 0x61, 0x83, 0xf7, 0xbb, 0xbd, 0x51, 0x47, 0x57, 0xea,
+	0xe8, 0xa8, 0xf8, 0xc9, 0xa0, 0xdb, 0x57, 0x1a, 0x08, 0x1d, 0xb6, 0x0e, 0x0e, 0x7b, 0x1d, 0x65,
+	0x03, 0xa1, 0xc3, 0x81, 0x3e, 0x52, 0x14, 0x84, 0x3e, 0xeb, 0xf6, 0xf7, 0x06, 0xcf, 0x94, 0x4d,
+	0x74, 0x65, 0x1c, 0xf5, 0xf1, 0x33, 0x2a, 0xfa, 0x09, 0x28, 0x69, 0xe0, 0x2d, 0xbf, 0xab, 0x92,
+	0xa7, 0x6b, 0x0b, 0x51, 0xe4, 0x37, 0x1b, 0x62, 0x1b, 0xae, 0x61, 0x5f, 0xe2, 0x2c, 0x51, 0x5f,
+	0xc7, 0x7a, 0x0e, 0xba, 0xfd, 0xa3, 0xa1, 0x72, 0x03, 0x89, 0x29, 0x49, 0x98, 0x26, 0xd6, 0xd3,
+	0xed, 0xd3, 0x50, 0xde, 0xc1, 0xf4, 0x5e, 0xa7, 0xd7, 0x19, 0x75, 0x94, 0xd7, 0xb0, 0x57, 0x7a,
+	0xe7, 0xb0, 0xd7, 0x6a, 0x77, 0x94, 0x6d, 0xcc, 0xf4, 0x06, 0xed, 0x27, 0xc6, 0xe0, 0x50, 0x79,
+	0x5d, 0xdd, 0x02, 0x65, 0xd0, 0x37, 0xf6, 0x8e, 0x0e, 0x7b, 0xdd, 0x76, 0x6b, 0xd4, 0x31, 0x9e,
+	0x74, 0x7e, 0xa6, 0x68, 0x38, 0xec, 0x87, 0x7a, 0xc7, 0xe0, 0x75, 0xbd, 0x21, 0xf2, 0xbc, 0xbe,
+	0x37, 0xf1, 0xb2, 0xd7, 0xfe, 0xd1, 0xcf, 0x7f, 0xfe, 0x33, 0x83, 0x8f, 0xc3, 0x0f, 0xb0, 0x99,
+	0x49, 0x09, 0xe3, 0xe8, 0x89, 0xf2, 0xd6, 0x12, 0x68, 0xf8, 0x44, 0x79, 0x1b, 0xc7, 0x51, 0x4c,
+	0x8c, 0x72, 0x17, 0x09, 0xf4, 0x4e, 0xfb, 0x48, 0x1f, 0x76, 0x9f, 0x76, 0x8c, 0xf6, 0xa8, 0xa3,
+	0xbc, 0x43, 0x03, 0xd7, 0xed, 0x3f, 0x51, 0xee, 0x61, 0xcf, 0x30, 0xc5, 0xa6, 0xeb, 0x5d, 0x55,
+	0x85, 0x46, 0x42, 0x4b, 0xb0, 0xf7, 0x90, 0x64, 0x57, 0x1f, 0xb4, 0xf6, 0xda, 0xe8, 0x2e, 0x7c,
+	0x1f, 0x87, 0x65, 0x78, 0xd8, 0xeb, 0x8e, 0x94, 0xfb, 0xe4, 0x29, 0x6a, 0x8d, 0x1e, 0x77, 0x74,
+	0xe5, 0x03, 0x9c, 0xf9, 0x51, 0xf7, 0xa0, 0x63, 0xf0, 0x69, 0xd8, 0xc1, 0x6f, 0xec, 0x77, 0x7b,
+	0x3d, 0xe5, 0x01, 0x79, 0x6b, 0x5a, 0xfa, 0xa8, 0x4b, 0x73, 0xff, 0x31, 0x56, 0xd0, 0x3a, 0x3c,
+	0xec, 0xfd, 0x4c, 0xf9, 0x04, 0x3b, 0x78, 0x70, 0xd4, 0x1b, 0x75, 0x8d, 0xa3, 0xc3, 0xbd, 0xd6,
+	0xa8, 0xa3, 0x7c, 0x4a, 0x0b, 0x63, 0x30, 0x1c, 0xed, 0x1d, 0xf4, 0x94, 0xcf, 0xb4, 0xdf, 0x86,
+	0xb2, 0xd0, 0x23, 0xb1, 0x54, 0xb7, 0xdf, 0xef, 0xe0, 0x75, 0xcf, 0x32, 0xe4, 0x7b, 0x9d, 0xfd,
+	0x91, 0x92, 0x41, 0xa0, 0xde, 0x7d, 0xf4, 0x78, 0xa4, 0x64, 0x31, 0x39, 0x38, 0xc2, 0x41, 0xca,
+	0x51, 0xef, 0x3a, 0x07, 0x5d, 0x25, 0x8f, 0xa9, 0x56, 0x7f, 0xd4, 0x55, 0x0a, 0xb4, 0x6c, 0xba,
+	0xfd, 0x47, 0xbd, 0x8e, 0x52, 0x44, 0xe8, 0x41, 0x4b, 0x7f, 0xa2, 0x94, 0x58, 0xa5, 0x7b, 0x9d,
+	0x2f, 0x95, 0x32, 0xde, 0x13, 0xed, 0xed, 0x28, 0x15, 0x04, 0xed, 0x75, 0xf6, 0x8e, 0x0e, 0x15,
+	0xd0, 0xee, 0x42, 0xa9, 0x75, 0x7c, 0x7c, 0x80, 0x6a, 0x3a, 0x76, 0x06, 0xc3, 0x7a, 0x69, 0x1b,
+	0xed, 0x0e, 0x46, 0xa3, 0xc1, 0x81, 0x92, 0xc1, 0x85, 0x3b, 0x1a, 0x1c, 0x2a, 0x59, 0xad, 0x0b,
+	0x65, 0x71, 0x88, 0x49, 0xf7, 0xfb, 0xca, 0x90, 0x3f, 0xd4, 0x3b, 0x4f, 0x99, 0x37, 0xb6, 0xdf,
+	0xf9, 0x12, 0x9b, 0x89, 0x29, 0xac, 0x28, 0x87, 0x1f, 0x62, 0x17, 0xf1, 0xe8, 0x82, 0x5f, 0xaf,
+	0xdb, 0xef, 0xb4, 0x74, 0xa5, 0xa0, 0x7d, 0x9a, 0xf2, 0x5c, 0x71, 0xae, 0x51, 0x81, 0x42, 0x47,
+	0xd7, 0x07, 0xfc, 0xae, 0x6b, 0xf7, 0x51, 0x7f, 0xa0, 0x73, 0x4f, 0x1d, 0x1f, 0xb8, 0xac, 0xf6,
+	0x2e, 0x54, 0x62, 0x96, 0x87, 0x0b, 0xa9, 0xad, 0x0f, 0x86, 0x43, 0x36, 0xce, 0x57, 0x30, 0x4f,
+	0x83, 0xc3, 0xf2, 0x19, 0xed, 0xff, 0x81, 0x72, 0xcc, 0x6d, 0xdf, 0x84, 0xec, 0x68, 0xc8, 0xad,
+	0xc9, 0x5b, 0xf7, 0x93, 0x97, 0x1d, 0x46, 0x22, 0xa5, 0x67, 0x47, 0x43, 0xf5, 0x3d, 0x28, 0xb2,
+	0x7b, 0x9d, 0xdc, 0x21, 0xb2, 0x95, 0xe6, 0xe0, 0x23, 0xc2, 0xe9, 0x9c, 0x46, 0xeb, 0x41, 0x23,
+	0x8d, 0x41, 0x6b, 0x1d, 0xc3, 0x49, 0xf6, 0x14, 0x09, 0x82, 0x96, 0x09, 0x96, 0xeb, 0xee, 0xf1,
+	0xf8, 0xc4, 0x38, 0xaf, 0xfd, 0xdd, 0x1c, 0x40, 0x22, 0x71, 0xa1, 0x4c, 0x17, 0x5b, 0x4b, 0x0a,
+	0xdc, 0xd1, 0xf8, 0x0a, 0x54, 0x5c, 0xdf, 0xb4, 0xe4, 0x17, 0x1a, 0xca, 0x08, 0xa0, 0xd1, 0x90,
+	0x6f, 0x87, 0x55, 0x58, 0x50, 0x00, 0x9a, 0x2b, 0xa7, 0x7e, 0x30, 0x33, 0x45, 0x24, 0x23, 0xcf,
+	0xe1, 0xd9, 0xc3, 0x9c, 0x5f, 0x28, 0x77, 0x7a, 0x74, 0x95, 0x81, 0xc2, 0x62, 0x39, 0xb0, 0x87,
+	0x30, 0xd4, 0x4c, 0x6c, 0x6f, 0xe2, 0xfa, 0xa1, 0x6d, 0xa1, 0xce, 0x5e, 0x24, 0xe1, 0x12, 0x04,
+	0x68, 0xf7, 0x82, 0xf5, 0x36, 0x98, 0x39, 0x9e, 0x19, 0x71, 0x93, 0x69, 0x45, 0x97, 0x20, 0xd8,
+	0x5c, 0xbc, 0xe8, 0xcf, 0x9a, 0xcb, 0xfc, 0x68, 0x65, 0x04, 0x50, 0x73, 0x5f, 0x05, 0xb0, 0xc3,
+	0x89, 0x39, 0x67, 0x95, 0x57, 0xa8, 0xf2, 0x0a, 0x87, 0xec, 0x5e, 0xa8, 0x3d, 0x68, 0x8c, 0xc6,
+	0xc8, 0xef, 0x7d, 0xd4, 0x83, 0xdb, 0xbe, 0xcb, 0xcd, 0x1a, 0x6f, 0x2e, 0x8b, 0xa6, 0xf7, 0xd3,
+	0x64, 0xcc, 0xe1, 0xb7, 0x54, 0xf6, 0x56, 0x0b, 0xae, 0xae, 0x21, 0x7b, 0xa9, 0x38, 0xa7, 0x7f,
+	0x5a, 0x00, 0x48, 0xf4, 0x8b, 0x94, 0x17, 0x30, 0x93, 0xf6, 0x02, 0xee, 0xc0, 0x75, 0x7e, 0xb1,
+	0x8a, 0xdf, 0x48, 0x39, 0x37, 0x1c, 0xcf, 0x18, 0x9b, 0xc2, 0xe1, 0xaa, 0x72, 0x2c, 0x8b, 0x3a,
+	0xea, 0x7a, 0xbb, 0x66, 0x84, 0x47, 0xa1, 0x5c, 0x06, 0xef, 0xa9, 0xe5, 0x2e, 0xb9, 0xa7, 0x56,
+	0x4f, 0x8a, 0x8f, 0x2e, 0xe6, 0xea, 0x87, 0x70, 0x2d, 0xb0, 0xa7, 0x81, 0x1d, 0x9e, 0x18, 0x51,
+	0x28, 0x7f, 0x8c, 0x85, 0x38, 0x6d, 0x72, 0xe4, 0x28, 0x8c, 0xbf, 0xf5, 0x21, 0x5c, 0xe3, 0x9a,
+	0xc7, 0x52, 0xf3, 0x98, 0xaf, 0x6d, 0x93, 0x21, 0xe5, 0xd6, 0xbd, 0x0a, 0xc0, 0x95, 0x2e, 0xf1,
+	0xf2, 0x48, 0x59, 0xaf, 0x30, 0x05, 0x0b, 0xb5, 0xe4, 0xf7, 0x40, 0x75, 0x42, 0x63, 0xc9, 0x6d,
+	0xc1, 0xdd, 0xaa, 0x8a, 0x13, 0x1e, 0xa6, 0x5c, 0x16, 0x97, 0x79, 0x44, 0xca, 0x97, 0x79, 0x44,
+	0xb6, 0xa0, 0x40, 0x7a, 0x19, 0x77, 0x50, 0xb0, 0x8c, 0xaa, 0x41, 0x1e, 0x59, 0x16, 0x19, 0xd3,
+	0x1b, 0x3b, 0x8d, 0xfb, 0x08, 0x24, 0xfd, 0x0f, 0xa1, 0x3a, 0xe1, 0xd4, 0xf7, 0xe1, 0xaa, 0x3c,
+	0xa8, 0xe2, 0x51, 0x82, 0x2a, 0x75, 0x53, 0x49, 0x86, 0x51, 0x67, 0xcf, 0x13, 0xbc, 0x0b, 0xaa,
+	0x34, 0x2e, 0x82, 0xba, 0xc6, 0x9c, 0x8a, 0xf1, 0xa0, 0x70, 0x62, 0x0c, 0x05, 0xc6, 0x21, 0x21,
+	0x8b, 0x6f, 0x7d, 0x55, 0x0b, 0x41, 0x24, 0x59, 0x87, 0x3f, 0x84, 0x6b, 0xc9, 0xd8, 0x19, 0x66,
+	0x64, 0x44, 0x27, 0xb6, 0x81, 0xf1, 0x0b, 0x0d, 0xea, 0xce, 0x66, 0x3c, 0x8c, 0xad, 0x68, 0x74,
+	0x62, 0xa3, 0x1e, 0xf1, 0x11, 0x54, 0xc9, 0xa4, 0x3f, 0xf7, 0x5d, 0x67, 0x72, 0x41, 0x06, 0xb0,
+	0xc6, 0x8e, 0xc2, 0x7a, 0xf8, 0xcc, 0x74, 0xa2, 0x43, 0x82, 0xeb, 0x70, 0x16, 0xa7, 0xc9, 0x4a,
+	0x2d, 0x9c, 0x18, 0xfe, 0x22, 0x12, 0x96, 0x76, 0xe1, 0xbe, 0xf0, 0x17, 0x91, 0xf6, 0xf7, 0x32,
+	0xd0, 0x48, 0x2b, 0x56, 0x2c, 0xd0, 0x39, 0x89, 0xe0, 0x2e, 0x24, 0x51, 0xdb, 0xaf, 0x40, 0x65,
+	0x7e, 0xca, 0xc3, 0xb5, 0x05, 0xa3, 0x99, 0x9f, 0xb2, 0x30, 0x6d, 0xf5, 0x1d, 0x28, 0xcd, 0x4f,
+	0xd9, 0xa6, 0xbe, 0x6c, 0x8d, 0x16, 0xe7, 0x2c, 0x82, 0xf2, 0x1d, 0x28, 0x2d, 0x38, 0x69, 0xfe,
+	0x32, 0xd2, 0x05, 0x91, 0x6a, 0xdb, 0x50, 0x93, 0x4d, 0x19, 0xb8, 0x37, 0x51, 0x6d, 0x61, 0x0d,
+	0xc3, 0xa4, 0xf6, 0x3b, 0x59, 0xa8, 0xc5, 0x3d, 0xf8, 0x8e, 0x2e, 0xc2, 0x97, 0x72, 0x72, 0x6f,
+	0x53, 0xc8, 0x97, 0x41, 0x01, 0x9d, 0x78, 0x0b, 0x84, 0xf9, 0x07, 0xe1, 0xc4, 0x0c, 0x5b, 0x8b,
+	0xc8, 0x6f, 0xfb, 0x2e, 0x8f, 0x1b, 0xe0, 0xf7, 0x6b, 0xf2, 0xc2, 0x6c, 0xcf, 0xaf, 0xde, 0x7d,
+	0xc8, 0xaf, 0x91, 0xd0, 0x0d, 0x30, 0x8a, 0x55, 0x28, 0xac, 0xac, 0x8b, 0x9a, 0xb8, 0x00, 0x86,
+	0x39, 0x75, 0x07, 0x36, 0x92, 0x98, 0x5d, 0x11, 0xde, 0xb0, 0x5c, 0xa4, 0x1e, 0x07, 0xec, 0x62,
+	0x56, 0xfb, 0x9b, 0x19, 0xd8, 0x5c, 0xb1, 0x0c, 0xe0, 0x68, 0x25, 0xef, 0xf9, 0x60, 0x12, 0x97,
+	0xc4, 0xcc, 0x8c, 0x26, 0x27, 0xc6, 0x3c, 0xb0, 0xa7, 0xce, 0xb9, 0x78, 0x94, 0x88, 0x60, 0x87,
+	0x04, 0xa2, 0x50, 0x8d, 0xf9, 0x9c, 0xec, 0x21, 0x68, 0x69, 0x65, 0x57, 0xf0, 0x80, 0x40, 0x3d,
+	0x84, 0xc4, 0x51, 0x5f, 0xf9, 0x4b, 0x82, 0xd4, 0x6e, 0x43, 0xb1, 0x1b, 0x5b, 0x20, 0xe2, 0xf7,
+	0x39, 0x72, 0xfc, 0x4d, 0x0e, 0x1f, 0x2a, 0x6d, 0x7a, 0xdf, 0xe3, 0xc0, 0x9c, 0xab, 0xf7, 0xf0,
+	0xce, 0xf6, 0x9c, 0xc7, 0xa3, 0x35, 0x63, 0xbf, 0x01, 0xc3, 0xde, 0x3f, 0x30, 0xe7, 0x8c, 0x71,
+	0x23, 0xd1, 0xad, 0x4f, 0xa1, 0x2c, 0x00, 0x2f, 0xc5, 0xa2, 0xff, 0x7d, 0x0e, 0x2a, 0x7b, 0xb2,
+	0xad, 0x12, 0x75, 0xb2, 0x28, 0x58, 0x78, 0x28, 0x63, 0x70, 0x2f, 0x4b, 0x15, 0x1d, 0x6b, 0x1c,
+	0x24, 0x16, 0x50, 0xf6, 0x5b, 0x16, 0xd0, 0x6d, 0x40, 0x73, 0xac, 0xe1, 0x58, 0xa4, 0x44, 0xe7,
+	0xe2, 0x30, 0xb9, 0xae, 0xc5, 0xc3, 0x10, 0x56, 0x3d, 0xd0, 0xf9, 0xef, 0xee, 0x81, 0x2e, 0xac,
+	0xf5, 0x40, 0xff, 0x6f, 0xe3, 0x33, 0x7e, 0x2b, 0x39, 0x95, 0x70, 0x4d, 0x23, 0x59, 0x85, 0xc8,
+	0xc4, 0x19, 0xf4, 0xc4, 0xbe, 0x40, 0xba, 0x2f, 0xa0, 0x21, 0x86, 0x99, 0x77, 0x0c, 0x52, 0xb1,
+	0xfc, 0x1c, 0x47, 0x9f, 0xd7, 0xeb, 0x91, 0x9c, 0x4d, 0xef, 0xd0, 0xea, 0xb7, 0xef, 0x50, 0xed,
+	0xf7, 0x33, 0xa0, 0x72, 0x05, 0x76, 0x7f, 0xe1, 0xba, 0x23, 0xfb, 0x9c, 0x18, 0xc1, 0x3d, 0xd8,
+	0xe4, 0x36, 0xd4, 0xa4, 0xf7, 0xc2, 0x9b, 0xc5, 0x10, 0x71, 0xcf, 0xd7, 0x5e, 0x82, 0xcc, 0xae,
+	0xbd, 0x04, 0xb9, 0xfe, 0x72, 0xe5, 0x6b, 0x50, 0x95, 0xaf, 0x10, 0x32, 0xb9, 0x0a, 0xcc, 0xe4,
+	0xf6, 0xe0, 0xbf, 0xce, 0x02, 0x24, 0x4a, 0xf6, 0xaf, 0x3b, 0x8e, 0x61, 0xcd, 0x94, 0xe4, 0xd6,
+	0x4d, 0xc9, 0x5d, 0x50, 0x64, 0x3a, 0xe9, 0x2e, 0x6b, 0x23, 0x21, 0xa4, 0x6e, 0x32, 0x9e, 0x26,
+	0xdd, 0x37, 0x24, 0x9e, 0xc6, 0x5d, 0xa4, 0x0c, 0xc9, 0x6c, 0x75, 0xcd, 0x62, 0x1c, 0x28, 0x45,
+	0x79, 0x74, 0x0d, 0xc7, 0x25, 0x8d, 0x33, 0x27, 0x3a, 0xf1, 0x17, 0x11, 0x37, 0x6a, 0x86, 0xfc,
+	0xf8, 0xbf, 0x2e, 0x6a, 0x7a, 0xc6, 0xd0, 0x8c, 0x65, 0x85, 0xea, 0x27, 0x50, 0x99, 0xe2, 0xad,
+	0xe6, 0xc8, 0x3e, 0x8f, 0x78, 0x68, 0x6d, 0x33, 0x65, 0x9f, 0x90, 0xa6, 0x57, 0x2f, 0x4f, 0x79,
+	0x46, 0xfb, 0xef, 0x59, 0x28, 0xfc, 0x14, 0x5f, 0x9f, 0x50, 0x3f, 0x85, 0x4a, 0x18, 0xcd, 0x22,
+	0xd9, 0xa3, 0x78, 0x93, 0x55, 0x40, 0x78, 0x72, 0x08, 0xda, 0x78, 0x69, 0x87, 0x99, 0xdc, 0x90,
+	0x16, 0x53, 0x38, 0xa9, 0x68, 0x67, 0x67, 0x1e, 0xcc, 0x82, 0xce, 0x32, 0xe8, 0x6d, 0x42, 0xf7,
+	0x62, 0x98, 0x8e, 0x71, 0x43, 0x0b, 0x83, 0xce, 0x10, 0xe8, 0x6d, 0x8a, 0x67, 0x7c, 0xc5, 0xab,
+	0xc7, 0x30, 0x14, 0x9b, 0x6e, 0x9b, 0x68, 0x55, 0x14, 0x77, 0x83, 0xe3, 0x3c, 0x9e, 0xb5, 0x24,
+	0xa9, 0x9b, 0xc7, 0xe2, 0x5d, 0x01, 0x9e, 0xc5, 0x50, 0x65, 0x4c, 0x3e, 0x0b, 0x9c, 0xc8, 0x1e,
+	0x3e, 0xe0, 0xe3, 0x26, 0x83, 0x50, 0xce, 0xb6, 0xec, 0xc8, 0x9e, 0x44, 0xc3, 0xaf, 0x79, 0x28,
+	0x52, 0x45, 0x97, 0x20, 0x9a, 0x05, 0xf5, 0x54, 0x77, 0x57, 0x2c, 0x22, 0xc3, 0x4e, 0x0f, 0xf5,
+	0xff, 0x8c, 0xa4, 0xd2, 0x67, 0x65, 0x35, 0x3e, 0x27, 0xe9, 0xf7, 0x79, 0x49, 0xdf, 0x2a, 0x90,
+	0x75, 0xa0, 0xa3, 0x3f, 0xea, 0x28, 0x45, 0xed, 0x0f, 0xb2, 0xb0, 0x39, 0x0a, 0x4c, 0x2f, 0x34,
+	0xd9, 0x95, 0x2d, 0x2f, 0x0a, 0x7c, 0x57, 0xfd, 0x02, 0xca, 0xd1, 0xc4, 0x95, 0xa7, 0xe1, 0x35,
+	0xb1, 0xe9, 0x97, 0x48, 0xef, 0x8f, 0x26, 0xcc, 0xfe, 0x59, 0x8a, 0x58, 0x42, 0x7d, 0x1f, 0x0a,
+	0x63, 0xfb, 0xd8, 0xf1, 0x9a, 0x59, 0xf9, 0x56, 0x7f, 0x52, 0x70, 0x17, 0x91, 0xf8, 0xc2, 0x1b,
+	0x51, 0xa9, 0x1f, 0xe2, 0x43, 0x11, 0x33, 0x71, 0x52, 0x25, 0xb7, 0x4b, 0xa4, 0x0f, 0x21, 0x16,
+	0x5f, 0x71, 0x63, 0x74, 0xea, 0xa7, 0xf8, 0xc0, 0x92, 0xeb, 0x8e, 0xcd, 0xc9, 0x69, 0x33, 0x2f,
+	0x2f, 0xb2, 0xa4, 0x8c, 0xce, 0xf1, 0x8f, 0xaf, 0xe8, 0x31, 0xad, 0x76, 0x1f, 0x4a, 0xbc, 0xb1,
+	0x38, 0x00, 0xbb, 0x9d, 0x47, 0x5d, 0x3e, 0x90, 0xed, 0xc1, 0xc1, 0x41, 0x77, 0xc4, 0x2e, 0xc1,
+	0xea, 0x83, 0x5e, 0x6f, 0xb7, 0xd5, 0x7e, 0xa2, 0x64, 0x77, 0xcb, 0x50, 0x64, 0xf6, 0x32, 0xbc,
+	0x39, 0xbf, 0xb1, 0xd4, 0x01, 0xf5, 0x21, 0xe4, 0x67, 0xbe, 0x25, 0x86, 0xe7, 0xcd, 0xb5, 0xbd,
+	0x94, 0xf2, 0x4c, 0x80, 0xc5, 0x12, 0xda, 0xe7, 0xd0, 0x48, 0xc3, 0x25, 0xb5, 0xbb, 0x0e, 0x15,
+	0xbd, 0xd3, 0xda, 0x33, 0x06, 0x7d, 0xd4, 0x75, 0x51, 0xf7, 0xa5, 0xec, 0x33, 0xbd, 0x4b, 0x8a,
+	0xf2, 0x6f, 0x81, 0xb2, 0x3c, 0x30, 0xea, 0x23, 0xd8, 0x40, 0xf1, 0xc3, 0xb5, 0xd9, 0x41, 0x91,
+	0x4c, 0xd9, 0x9d, 0x35, 0x23, 0xc9, 0xc9, 0x68, 0xc6, 0x1a, 0x93, 0x54, 0x5e, 0xfb, 0xbf, 0x41,
+	0x5d, 0x1d, 0xc1, 0x5f, 0x5f, 0xf5, 0xff, 0x35, 0x03, 0xf9, 0x43, 0xd7, 0xc4, 0xbb, 0x91, 0x05,
+	0x7a, 0x6b, 0xa6, 0x99, 0x91, 0x7d, 0xfd, 0xb4, 0xc1, 0x71, 0x59, 0x10, 0x4e, 0x7d, 0x17, 0x72,
+	0xd1, 0x44, 0x5c, 0xd9, 0xbd, 0x71, 0xc9, 0xe2, 0xc3, 0x07, 0x5f, 0xa2, 0x89, 0x8b, 0xef, 0x7c,
+	0x59, 0x96, 0x08, 0xc6, 0xe5, 0xda, 0x3d, 0xaa, 0x84, 0x7b, 0xf6, 0xd4, 0xf1, 0x1c, 0xfe, 0x36,
+	0x0e, 0x92, 0xe0, 0xdb, 0x37, 0xd6, 0xc4, 0x4d, 0x07, 0x62, 0x33, 0xe5, 0x31, 0xae, 0xd0, 0x9a,
+	0xe0, 0xc3, 0x7c, 0xf5, 0x28, 0xb8, 0x30, 0x82, 0x85, 0x47, 0xc2, 0x77, 0xc8, 0x95, 0xa8, 0x2a,
+	0x0a, 0x33, 0x0b, 0x0a, 0xe1, 0x09, 0xf9, 0xd5, 0x9f, 0x79, 0x60, 0xcf, 0xcd, 0x20, 0x56, 0x9f,
+	0x30, 0xda, 0x83, 0x00, 0xf8, 0x28, 0x0c, 0xd6, 0xae, 0xbd, 0x87, 0xeb, 0x9b, 0x24, 0x6c, 0x4d,
+	0xa4, 0xd6, 0xdc, 0xac, 0xe4, 0x18, 0xed, 0xcf, 0x73, 0x50, 0x95, 0xda, 0xa3, 0x7e, 0x0c, 0x65,
+	0x6b, 0xe2, 0xae, 0xe1, 0x87, 0x12, 0xd1, 0xfd, 0x3d, 0xb1, 0x05, 0x2d, 0x96, 0xa0, 0xeb, 0x21,
+	0x76, 0x64, 0x3c, 0x37, 0x03, 0x87, 0x3d, 0x12, 0x95, 0x95, 0x3d, 0x84, 0x43, 0x3b, 0x7a, 0x2a,
+	0x30, 0xf8, 0xae, 0x5f, 0x28, 0xe5, 0x49, 0x0d, 0xe0, 0x5d, 0xca, 0xa5, 0x1e, 0xd2, 0x62, 0x40,
+	0x7c, 0x88, 0x8f, 0xe3, 0x91, 0xd4, 0x3e, 0xb7, 0x27, 0x8b, 0x48, 0xa8, 0x01, 0x75, 0xd1, 0x21,
+	0x02, 0x22, 0x29, 0xc7, 0xab, 0x3b, 0xc8, 0xeb, 0x4c, 0xd7, 0xf5, 0x49, 0x66, 0x2b, 0xc8, 0x96,
+	0xeb, 0xbd, 0x18, 0xce, 0xde, 0x08, 0x14, 0x39, 0x0c, 0x1c, 0xf7, 0xa3, 0x13, 0x5b, 0x08, 0xcf,
+	0xe2, 0x49, 0x15, 0x04, 0xed, 0xb5, 0x7b, 0xb8, 0x52, 0x08, 0xad, 0xfd, 0x5e, 0x06, 0x4a, 0x7c,
+	0x04, 0xd0, 0x5c, 0x88, 0xf7, 0xd6, 0x9f, 0xb6, 0xf4, 0x2e, 0x9a, 0x84, 0x79, 0x70, 0xf8, 0x23,
+	0xbd, 0xd5, 0xe7, 0x7c, 0x52, 0xef, 0x3c, 0x1d, 0x3c, 0xe9, 0x30, 0x5b, 0xd6, 0x5e, 0xa7, 0xff,
+	0x33, 0x25, 0xc7, 0xcc, 0xb9, 0x9d, 0xc3, 0x96, 0x8e, 0x5c, 0xb2, 0x0a, 0xa5, 0xce, 0x97, 0x9d,
+	0xf6, 0x11, 0xb1, 0xc9, 0x06, 0xc0, 0x5e, 0xa7, 0xd5, 0xeb, 0x0d, 0xd0, 0xec, 0xa9, 0x14, 0xd1,
+	0xc0, 0xd8, 0xd6, 0x3b, 0x68, 0x02, 0x6d, 0xb5, 0xdb, 0x83, 0xa3, 0xfe, 0x48, 0x29, 0xe1, 0x17,
+	0x5b, 0x68, 0xdf, 0x8c, 0x41, 0xf4, 0xcc, 0xd5, 0x9e, 0x3e, 0x38, 0x8c, 0x21, 0x95, 0xdd, 0x0a,
+	0xaa, 0x64, 0x34, 0x57, 0xda, 0x7f, 0x6a, 0x40, 0x23, 0xbd, 0x34, 0xd5, 0xcf, 0xa0, 0x6c, 0x59,
+	0xa9, 0x39, 0xbe, 0xbd, 0x6e, 0x09, 0xdf, 0xdf, 0xb3, 0xc4, 0x34, 0xb3, 0x04, 0x06, 0xcd, 0xb0,
+	0x8d, 0x94, 0x5d, 0xd9, 0x48, 0x62, 0x1b, 0xfd, 0x08, 0x36, 0xf8, 0x1b, 0x1f, 0x68, 0x39, 0x1a,
+	0x9b, 0xa1, 0x9d, 0xde, 0x25, 0x6d, 0x42, 0xee, 0x71, 0xdc, 0xe3, 0x2b, 0x7a, 0x63, 0x92, 0x82,
+	0xa8, 0xbf, 0x01, 0x0d, 0x93, 0xb4, 0xe7, 0xb8, 0x7c, 0x5e, 0x16, 0x02, 0x5b, 0x88, 0x93, 0x8a,
+	0xd7, 0x4d, 0x19, 0x80, 0x0b, 0xd1, 0x0a, 0xfc, 0x79, 0x52, 0xb8, 0x20, 0x2f, 0xc4, 0xbd, 0xc0,
+	0x9f, 0x4b, 0x65, 0x6b, 0x96, 0x94, 0xc7, 0x9b, 0x3a, 0xbc, 0xe5, 0x89, 0x7d, 0x22, 0xde, 0xb2,
+	0xac, 0xd9, 0x24, 0xd4, 0xe1, 0x7b, 0x99, 0x93, 0x24, 0x8b, 0x21, 0xbe, 0xac, 0xc1, 0x89, 0xbd,
+	0x22, 0x5e, 0x6b, 0xd4, 0x5a, 0x51, 0x0a, 0xcc, 0x38, 0xa7, 0x7e, 0x08, 0x40, 0xed, 0x64, 0x65,
+	0xca, 0xa9, 0x88, 0x89, 0xc0, 0x9f, 0x8b, 0x22, 0x15, 0x4b, 0x64, 0xa4, 0xe6, 0xb1, 0x4b, 0x8e,
+	0x95, 0xd5, 0xe6, 0xd1, 0xd5, 0xbb, 0xa4, 0x79, 0x94, 0x4d, 0x9a, 0xc7, 0x8a, 0xc1, 0x4a, 0xf3,
+	0x44, 0x29, 0x30, 0xe3, 0x5c, 0xdc, 0x3c, 0x56, 0xa6, 0xba, 0xdc, 0x3c, 0x51, 0xa4, 0x62, 0x89,
+	0x0c, 0x4e, 0xdb, 0x92, 0xec, 0x5e, 0xbb, 0x54, 0x76, 0xc7, 0x69, 0x4b, 0x4b, 0xef, 0xbf, 0x01,
+	0x8d, 0xf0, 0xc4, 0x3f, 0x93, 0x18, 0x48, 0x5d, 0x2e, 0x3d, 0x3c, 0xf1, 0xcf, 0x64, 0x0e, 0x52,
+	0x0f, 0x65, 0x00, 0xb6, 0x96, 0x75, 0x91, 0xae, 0x31, 0x37, 0xe4, 0xd6, 0x52, 0x0f, 0xf1, 0x7a,
+	0x29, 0xb6, 0xd6, 0x14, 0x19, 0x1c, 0x94, 0xc4, 0x9a, 0x12, 0x36, 0x37, 0xe4, 0x41, 0xe9, 0x09,
+	0x4b, 0x0a, 0x7e, 0x09, 0x62, 0xbb, 0x4a, 0x88, 0x6b, 0x6b, 0xe1, 0xc9, 0xc5, 0x14, 0x79, 0x6d,
+	0x1d, 0x79, 0xa9, 0x82, 0x35, 0x46, 0xca, 0x8b, 0x26, 0xbb, 0x22, 0xb4, 0xbf, 0x5e, 0xd8, 0xde,
+	0xc4, 0x6e, 0x6e, 0xae, 0xee, 0x8a, 0x21, 0xc7, 0x25, 0xbb, 0x42, 0x40, 0xe2, 0x75, 0x1d, 0x17,
+	0x57, 0x97, 0xd7, 0xb5, 0x54, 0xb8, 0x66, 0x49, 0xf9, 0x64, 0x43, 0xc5, 0x65, 0xaf, 0xae, 0x6c,
+	0x28, 0xa9, 0x70, 0xdd, 0x94, 0x01, 0x38, 0x52, 0xbc, 0xe5, 0x34, 0xb8, 0xa9, 0x60, 0x23, 0xd6,
+	0x6a, 0x3e, 0xba, 0x30, 0x89, 0x73, 0xb8, 0x56, 0x03, 0x1b, 0x75, 0x05, 0xbe, 0x14, 0xae, 0xc9,
+	0x6b, 0x55, 0x27, 0x4c, 0xbc, 0x95, 0x82, 0x24, 0xab, 0xfd, 0x51, 0x01, 0x4a, 0x9c, 0xe9, 0xe0,
+	0x4b, 0x7d, 0x9c, 0xf7, 0xed, 0xb5, 0x46, 0xad, 0xdd, 0xd6, 0x10, 0xa5, 0x15, 0x15, 0x1a, 0x8c,
+	0xf9, 0xc5, 0xb0, 0x0c, 0x32, 0x44, 0xe2, 0x7e, 0x31, 0x28, 0x8b, 0x0c, 0x91, 0x97, 0x65, 0x6f,
+	0x04, 0xe6, 0xd0, 0xe1, 0xc2, 0x0a, 0x32, 0x00, 0xdd, 0xeb, 0xa2, 0x52, 0x2c, 0x5f, 0x90, 0x8a,
+	0x30, 0x1f, 0x47, 0x31, 0x29, 0xc2, 0x00, 0xa5, 0xb8, 0x88, 0x70, 0x82, 0xa8, 0xd0, 0x18, 0xe9,
+	0x47, 0xfd, 0x76, 0xf2, 0x9d, 0x0a, 0x16, 0xe2, 0xd5, 0x3c, 0xed, 0x76, 0x9e, 0x29, 0x80, 0x85,
+	0x58, 0x2d, 0x94, 0xaf, 0xa2, 0xbc, 0x45, 0x95, 0x50, 0xb6, 0xa6, 0xde, 0x80, 0xab, 0xc3, 0xc7,
+	0x83, 0x67, 0x06, 0x2b, 0x14, 0x77, 0xa1, 0x8e, 0x3e, 0x30, 0x09, 0xc1, 0xaa, 0x6f, 0xe0, 0x27,
+	0x09, 0x2a, 0x08, 0x87, 0xca, 0x06, 0x79, 0x11, 0x11, 0x36, 0x62, 0x07, 0x90, 0x82, 0x5d, 0x61,
+	0x45, 0x07, 0xbd, 0xa3, 0x83, 0xfe, 0x50, 0xd9, 0xc4, 0x46, 0x10, 0x84, 0xb5, 0x5c, 0x8d, 0xab,
+	0x49, 0x8e, 0xad, 0xab, 0x74, 0x92, 0x21, 0xec, 0x59, 0x4b, 0xef, 0x77, 0xfb, 0x8f, 0x86, 0xca,
+	0x56, 0x5c, 0x33, 0x79, 0x53, 0x86, 0xca, 0xb5, 0x18, 0x30, 0x1c, 0xb5, 0x46, 0x47, 0x43, 0xe5,
+	0x7a, 0xdc, 0xca, 0x43, 0x7d, 0xd0, 0xee, 0x0c, 0x87, 0xbd, 0xee, 0x70, 0xa4, 0xdc, 0x40, 0x37,
+	0x66, 0xd2, 0x22, 0x41, 0xdc, 0x94, 0x1a, 0xaa, 0x3f, 0xea, 0x8c, 0x94, 0x9b, 0x71, 0x33, 0xda,
+	0x83, 0x1e, 0x3e, 0xdf, 0x38, 0xe8, 0x2b, 0xb7, 0x90, 0x88, 0x1c, 0x81, 0xbc, 0x37, 0xaf, 0x60,
+	0xbb, 0x8e, 0xfa, 0x32, 0xe8, 0xb6, 0xb4, 0x34, 0x86, 0x9d, 0x9f, 0x1e, 0x75, 0xfa, 0xed, 0x8e,
+	0xf2, 0x6a, 0xb2, 0x34, 0x62, 0xd8, 0x9d, 0x78, 0x69, 0xc4, 0xa0, 0xd7, 0xe2, 0x6f, 0x0a, 0xd0,
+	0x50, 0xd9, 0xc6, 0xfa, 0x78, 0x3b, 0xfa, 0xfd, 0x4e, 0x7b, 0x84, 0x7d, 0x7d, 0x3d, 0x1e, 0xc5,
+	0xa3, 0xc3, 0x47, 0x3a, 0xbe, 0x58, 0xa3, 0x21, 0x44, 0xef, 0xf4, 0x5b, 0x07, 0x62, 0xb6, 0xdf,
+	0xd8, 0xad, 0xd1, 0xbb, 0xc3, 0xfc, 0xb8, 0xd4, 0x7e, 0x02, 0xaa, 0xfc, 0x80, 0x27, 0x7f, 0xf4,
+	0x4a, 0x85, 0x3c, 0x06, 0xca, 0x8b, 0x7b, 0xcc, 0x98, 0x46, 0x5d, 0x6d, 0xbe, 0x18, 0x93, 0xd3,
+	0x2a, 0xb9, 0xe8, 0x28, 0x83, 0xb4, 0x3f, 0xca, 0x40, 0x23, 0x7d, 0x54, 0xa2, 0x88, 0xe8, 0x4c,
+	0x0d, 0x7a, 0xc3, 0x06, 0xdf, 0x4e, 0x0a, 0x85, 0x25, 0xca, 0x99, 0xf6, 0xfd, 0x88, 0x9e, 0x53,
+	0x22, 0xd5, 0x31, 0x3e, 0xf9, 0x58, 0xad, 0x71, 0x5e, 0xed, 0xc2, 0xd5, 0xd4, 0xfb, 0xa6, 0xa9,
+	0xb7, 0xac, 0x9a, 0xf1, 0xab, 0x8c, 0x4b, 0xed, 0xd7, 0xd5, 0x70, 0xb5, 0x4f, 0x0a, 0xe4, 0xf0,
+	0x0e, 0x3f, 0x33, 0x04, 0x60, 0x52, 0x7b, 0x0c, 0xf5, 0xd4, 0xc9, 0x4c, 0x1a, 0xff, 0x34, 0xdd,
+	0xd2, 0xb2, 0x33, 0x7d, 0x71, 0x33, 0xb5, 0x3f, 0xcc, 0x40, 0x4d, 0x3e, 0xa7, 0xbf, 0x77, 0x4d,
+	0x74, 0xa9, 0x82, 0xa7, 0xd1, 0xbd, 0xc2, 0x5f, 0x51, 0x12, 0xa0, 0x2e, 0xbd, 0xb7, 0xce, 0x6c,
+	0xb0, 0xfb, 0xa7, 0xc3, 0xb8, 0x3b, 0x32, 0x08, 0x55, 0x66, 0xba, 0xb9, 0xb6, 0xff, 0x04, 0x09,
+	0xf8, 0xb5, 0x8c, 0x04, 0xa2, 0xbd, 0x06, 0x95, 0xfd, 0x53, 0x11, 0x87, 0x20, 0xbf, 0x29, 0x56,
+	0x61, 0xb7, 0x63, 0xf1, 0xad, 0xf7, 0x46, 0xf2, 0xd2, 0x03, 0xc5, 0x28, 0xb2, 0x77, 0x71, 0xd9,
+	0x72, 0xc0, 0x77, 0x71, 0xe3, 0xa7, 0xd8, 0xb3, 0xf2, 0x53, 0xec, 0x6f, 0xf0, 0xca, 0x72, 0xf2,
+	0x69, 0x16, 0x7f, 0x8b, 0xd5, 0x8e, 0x51, 0x6c, 0xf8, 0x5f, 0xb7, 0xa7, 0x76, 0x20, 0x9e, 0x90,
+	0x5b, 0x43, 0x9c, 0x22, 0x22, 0x8d, 0xc4, 0x9e, 0x36, 0x0b, 0xf2, 0x21, 0x90, 0x7e, 0x8c, 0x02,
+	0xf1, 0xda, 0x3f, 0xc9, 0x43, 0x55, 0x92, 0x7a, 0xbe, 0xd3, 0xf2, 0xbb, 0x8d, 0x0f, 0xdc, 0x8a,
+	0x67, 0x0e, 0xf8, 0x0d, 0xc6, 0x18, 0x90, 0x9a, 0xab, 0xdc, 0xd2, 0x5c, 0xe1, 0xfd, 0x6c, 0x16,
+	0xcc, 0xc8, 0xed, 0x9e, 0x22, 0x9b, 0x36, 0xec, 0x15, 0x5e, 0x60, 0x7a, 0xff, 0x08, 0x6a, 0x92,
+	0x55, 0x4e, 0xbc, 0x99, 0xb2, 0x4c, 0x5f, 0x4d, 0x2c, 0x74, 0x21, 0x46, 0xfc, 0x4f, 0x4f, 0x0d,
+	0x6b, 0x2c, 0xcc, 0x9c, 0x85, 0xe9, 0xe9, 0xde, 0x98, 0x5c, 0x17, 0xd3, 0xf8, 0xa0, 0x67, 0xb6,
+	0x92, 0xf2, 0x54, 0x1c, 0xe7, 0x77, 0xa1, 0x34, 0x3d, 0x65, 0x31, 0xb4, 0x95, 0xed, 0xdc, 0xba,
+	0x21, 0x2f, 0x4e, 0x4f, 0x29, 0x7c, 0xf6, 0x73, 0x50, 0x96, 0x6c, 0xaa, 0x61, 0x13, 0xd6, 0x36,
+	0x6a, 0x23, 0x6d, 0x5e, 0x0d, 0xd5, 0x0f, 0x60, 0x8b, 0x9f, 0xbc, 0x66, 0x68, 0xb0, 0xc0, 0x7c,
+	0x7a, 0x39, 0x83, 0x3d, 0x4e, 0xb6, 0xc9, 0x70, 0xad, 0x70, 0x48, 0x18, 0x5c, 0xac, 0x1a, 0xd4,
+	0xa4, 0xb5, 0xcb, 0x9e, 0x25, 0xa9, 0xe8, 0x29, 0x98, 0xfa, 0x10, 0x6a, 0xd3, 0x53, 0xb6, 0x16,
+	0x46, 0xfe, 0x81, 0xcd, 0x83, 0xad, 0xb7, 0x96, 0x57, 0x01, 0x45, 0xd6, 0xa6, 0x28, 0xd5, 0xf7,
+	0x41, 0x0d, 0xec, 0xc8, 0xf6, 0xa8, 0x27, 0x96, 0x6d, 0x5a, 0xe8, 0xf1, 0x25, 0x61, 0x2b, 0xa7,
+	0x6f, 0xc6, 0x98, 0x3d, 0x8e, 0xd0, 0xfe, 0x2c, 0x03, 0x8d, 0x44, 0xfa, 0xc5, 0x0d, 0x8d, 0xb6,
+	0xfb, 0xe4, 0x71, 0xec, 0xe6, 0xb2, 0x80, 0x8c, 0x24, 0xe8, 0xd0, 0x61, 0x0f, 0x68, 0xae, 0x7b,
+	0x5b, 0x66, 0x9d, 0xc9, 0x35, 0xb7, 0xce, 0xe4, 0xaa, 0xe9, 0x90, 0x43, 0x9f, 0x26, 0x59, 0x5a,
+	0xf0, 0x0c, 0x64, 0x5a, 0x19, 0x3b, 0xfd, 0x28, 0x10, 0x01, 0x23, 0x4a, 0xe8, 0xb2, 0xf7, 0xa1,
+	0xde, 0x3d, 0x68, 0xe9, 0x3f, 0xa3, 0x10, 0x13, 0x92, 0x12, 0xf6, 0x07, 0x7a, 0xa7, 0xfb, 0xa8,
+	0x4f, 0x80, 0x3c, 0x96, 0x6a, 0x3f, 0xee, 0xb4, 0x9f, 0x28, 0x05, 0x32, 0xc9, 0x24, 0xad, 0x6d,
+	0x59, 0xd6, 0xfe, 0xa9, 0xfc, 0xda, 0x46, 0x26, 0xf5, 0xda, 0x46, 0xfa, 0xee, 0x67, 0x76, 0xf9,
+	0xee, 0xa7, 0x1a, 0x6f, 0xee, 0x98, 0x53, 0xe0, 0xc3, 0x33, 0xf8, 0x06, 0x4c, 0x5a, 0xdb, 0x49,
+	0xef, 0x4b, 0x22, 0xd0, 0x7e, 0x99, 0x01, 0x35, 0xd5, 0x10, 0x26, 0x80, 0x7f, 0xdf, 0xb6, 0x7c,
+	0x06, 0x4d, 0xfe, 0x92, 0x23, 0xa3, 0x92, 0xcc, 0xbd, 0x7c, 0x74, 0xaf, 0xf9, 0x49, 0x4c, 0x5e,
+	0xf2, 0x12, 0x8e, 0xfa, 0x01, 0xb0, 0xa7, 0xf4, 0x70, 0xad, 0xa4, 0xed, 0x1b, 0x12, 0xdb, 0xd0,
+	0x13, 0x9a, 0xe4, 0xed, 0x3c, 0xf9, 0x4d, 0x40, 0x66, 0x29, 0xde, 0x48, 0x26, 0x90, 0x58, 0x89,
+	0xf6, 0xbb, 0x19, 0xb8, 0x9a, 0x5e, 0x1b, 0xbf, 0x5a, 0x2f, 0xd3, 0x0f, 0x20, 0xe6, 0x96, 0x1f,
+	0x40, 0x5c, 0xb7, 0xb4, 0xf2, 0x6b, 0x97, 0xd6, 0x5f, 0xcf, 0xc0, 0x96, 0x34, 0xfa, 0x89, 0xca,
+	0xf4, 0x57, 0xd4, 0x32, 0xe9, 0x1d, 0xc4, 0x7c, 0xea, 0x1d, 0x44, 0x6d, 0x7f, 0xa5, 0x21, 0x74,
+	0x7b, 0x7a, 0xed, 0xc3, 0x29, 0xb7, 0xa0, 0x1c, 0x3f, 0x7c, 0xc7, 0x9f, 0x3b, 0x11, 0x79, 0xed,
+	0x0f, 0x32, 0x70, 0x7d, 0xa9, 0x22, 0xdd, 0xfe, 0x2b, 0xed, 0x53, 0xfa, 0xdd, 0x45, 0xb2, 0x7a,
+	0xb3, 0x30, 0x49, 0x76, 0x39, 0x4f, 0x4d, 0x3f, 0xa4, 0x88, 0x8e, 0x41, 0xed, 0x9f, 0xa5, 0x1b,
+	0x69, 0x25, 0x37, 0x94, 0x30, 0xac, 0x35, 0x11, 0xc2, 0xc4, 0x53, 0x15, 0x6b, 0xaf, 0x37, 0xc9,
+	0x74, 0x6b, 0x39, 0x73, 0xf6, 0xbb, 0x71, 0xe6, 0x87, 0x50, 0x8b, 0x2b, 0xde, 0xb3, 0xa7, 0x69,
+	0x03, 0xc7, 0xd2, 0xd3, 0x4a, 0x29, 0x4a, 0xed, 0x63, 0xd8, 0x4c, 0x7a, 0xd1, 0xe6, 0xcf, 0x81,
+	0xbd, 0x06, 0x55, 0xbc, 0x84, 0x2c, 0x1e, 0x0b, 0x63, 0x23, 0x0d, 0x9e, 0x7d, 0xc6, 0x09, 0xb4,
+	0x7d, 0x99, 0x95, 0xc6, 0xcf, 0xd8, 0xbb, 0x96, 0x3c, 0x33, 0x25, 0xdf, 0xb5, 0x04, 0x0a, 0x6b,
+	0x93, 0x26, 0xa6, 0xe4, 0xd9, 0x67, 0xb4, 0x76, 0xcf, 0x78, 0x3d, 0x2d, 0xcb, 0xe2, 0x3e, 0xf8,
+	0x75, 0x6b, 0xe5, 0x26, 0x94, 0x31, 0xb0, 0x5a, 0xae, 0x60, 0x1e, 0xb0, 0xcf, 0xbe, 0xc9, 0x83,
+	0x89, 0x2e, 0xf3, 0xd7, 0x13, 0x56, 0xfc, 0xcc, 0x45, 0x3e, 0xf9, 0x99, 0x8b, 0x4f, 0x38, 0xeb,
+	0xc4, 0x7d, 0xcc, 0xbf, 0x1c, 0xfb, 0xe5, 0x31, 0x7a, 0x09, 0x93, 0x08, 0x09, 0xed, 0xaf, 0x79,
+	0x3c, 0x13, 0x26, 0xb5, 0x5d, 0xa8, 0x4a, 0xca, 0x22, 0x4a, 0x3b, 0x92, 0xa1, 0x25, 0x4c, 0x3f,
+	0x64, 0x92, 0x0c, 0x90, 0x5e, 0x4d, 0xec, 0x2c, 0xa1, 0xf6, 0xb7, 0xab, 0x00, 0x09, 0x2e, 0x25,
+	0x83, 0x64, 0x96, 0x64, 0x90, 0x97, 0x72, 0xf2, 0x7f, 0x8c, 0x5e, 0xfa, 0xf9, 0x85, 0x91, 0x94,
+	0xc8, 0xad, 0x2d, 0x51, 0x43, 0xaa, 0x51, 0x72, 0x37, 0x68, 0xd5, 0x79, 0x9b, 0x5f, 0xeb, 0xbc,
+	0xfd, 0x08, 0x4a, 0xcc, 0x17, 0x10, 0xf2, 0xbb, 0x65, 0x37, 0x96, 0xfb, 0x79, 0x9f, 0xc7, 0xcd,
+	0x0a, 0x3a, 0xb5, 0x03, 0x8d, 0xf8, 0xe5, 0x40, 0xf9, 0xa6, 0xd9, 0x9d, 0xd5, 0x92, 0x82, 0x8c,
+	0x3d, 0x57, 0x65, 0xca, 0x59, 0x49, 0xee, 0x88, 0x66, 0xdc, 0x40, 0x45, 0x72, 0x47, 0x49, 0x96,
+	0x3b, 0x46, 0x33, 0x66, 0x96, 0x42, 0xb9, 0xe3, 0x7d, 0xb8, 0xca, 0xa3, 0xf0, 0xb1, 0x00, 0x0e,
+	0x27, 0xd1, 0xb3, 0x48, 0x2d, 0x7e, 0xa3, 0x68, 0x34, 0x23, 0x81, 0x1e, 0xc9, 0xbf, 0x84, 0xad,
+	0xc9, 0x09, 0x3e, 0xf4, 0x83, 0x0f, 0x9c, 0x19, 0xf4, 0x4a, 0xb7, 0x81, 0x3e, 0x7d, 0x26, 0x49,
+	0xbd, 0xbd, 0xd2, 0xd8, 0x36, 0x11, 0x8f, 0xc6, 0x2e, 0x85, 0x12, 0xc5, 0x2e, 0xfe, 0xcd, 0xc9,
+	0x32, 0x7c, 0xc9, 0xc1, 0x05, 0xcb, 0x0e, 0xae, 0x15, 0x01, 0xa9, 0xba, 0x2a, 0x20, 0xdd, 0xfa,
+	0xe7, 0x05, 0x28, 0xb2, 0x81, 0xa5, 0x47, 0xc8, 0x02, 0x7f, 0x1e, 0x47, 0xfb, 0xad, 0x11, 0x58,
+	0xe8, 0x27, 0x7d, 0x50, 0xb6, 0xb9, 0x0f, 0x45, 0xf4, 0xbd, 0x4e, 0x4f, 0xd3, 0x4e, 0xa8, 0x25,
+	0x81, 0x01, 0x6d, 0xc8, 0x26, 0x26, 0xd4, 0xcf, 0xa0, 0x82, 0xf4, 0xcc, 0xbe, 0x96, 0x52, 0xc1,
+	0x56, 0x8f, 0x76, 0xf4, 0x29, 0x99, 0x3c, 0xad, 0xfe, 0x30, 0x6d, 0xce, 0x63, 0xe7, 0xee, 0xad,
+	0x95, 0xa2, 0x97, 0x19, 0xf6, 0x7e, 0x13, 0x98, 0x7d, 0x27, 0xe6, 0x36, 0x05, 0xd9, 0xdf, 0xb1,
+	0xc2, 0x9b, 0xd0, 0x98, 0x64, 0xb2, 0x88, 0x25, 0xca, 0xe3, 0x33, 0x61, 0xac, 0x7c, 0xfc, 0xe3,
+	0x1b, 0x6b, 0x46, 0x06, 0x79, 0x45, 0x6c, 0x6f, 0xc3, 0x0c, 0x15, 0xb3, 0x2c, 0x11, 0x09, 0x54,
+	0x5a, 0x29, 0x16, 0x73, 0x24, 0x2a, 0x26, 0x32, 0xea, 0x43, 0xa8, 0x92, 0xd5, 0x8b, 0x97, 0x2b,
+	0xaf, 0x0c, 0x6d, 0xc2, 0x50, 0xc8, 0x96, 0x1f, 0xe7, 0xd4, 0xb6, 0xe8, 0x67, 0x60, 0xcb, 0xe6,
+	0xd2, 0xdb, 0x6b, 0x07, 0x4a, 0x8f, 0x2d, 0xa7, 0xac, 0xb3, 0x3a, 0x2b, 0xa3, 0xee, 0x42, 0xcd,
+	0x94, 0x4e, 0x9a, 0x26, 0x5c, 0x52, 0x87, 0x44, 0x43, 0x75, 0x48, 0x79, 0xf5, 0x7d, 0x36, 0xd1,
+	0xec, 0x71, 0xda, 0x54, 0x5c, 0x82, 0x78, 0xae, 0x8f, 0x4f, 0x2f, 0x65, 0x93, 0xe9, 0x65, 0x05,
+	0x6a, 0xdf, 0x32, 0xbd, 0x54, 0x20, 0x9e, 0x5e, 0xca, 0x25, 0x1e, 0xc4, 0x5b, 0x3a, 0x5c, 0x5f,
+	0xbf, 0x71, 0xe4, 0x50, 0x98, 0x3c, 0x0b, 0x85, 0xd1, 0xd2, 0x0f, 0x84, 0xa4, 0xaf, 0xe3, 0x4a,
+	0x81, 0x31, 0x3f, 0x46, 0x25, 0x5f, 0x66, 0x15, 0x55, 0x28, 0x89, 0x17, 0x7b, 0x29, 0x80, 0xb7,
+	0x3d, 0x38, 0x44, 0x27, 0x62, 0x15, 0x4a, 0xdd, 0xfe, 0x70, 0xd4, 0xea, 0x73, 0xff, 0x70, 0xb7,
+	0xcf, 0xfd, 0xc3, 0xda, 0xbf, 0xc4, 0xd0, 0x9a, 0xd8, 0xa4, 0xfd, 0xbd, 0x35, 0xfb, 0x58, 0x65,
+	0xce, 0xc9, 0x2a, 0xf3, 0x92, 0x7c, 0x29, 0x3f, 0x14, 0xb2, 0x91, 0x96, 0xe2, 0xc2, 0xd5, 0xfb,
+	0x7e, 0x85, 0xef, 0x78, 0xdf, 0x4f, 0x0e, 0xd8, 0x2c, 0xa6, 0x03, 0x36, 0x97, 0x5e, 0x6d, 0x2e,
+	0x51, 0x9c, 0x8d, 0xfc, 0x6a, 0xf3, 0xa5, 0x01, 0x36, 0xe5, 0xcb, 0x03, 0x6c, 0xe8, 0x57, 0xd2,
+	0xd0, 0xa8, 0xca, 0xe3, 0x16, 0x79, 0x2e, 0x7d, 0x58, 0xc1, 0x0b, 0x0e, 0xab, 0xef, 0xc0, 0xf8,
+	0xd4, 0x1d, 0xd8, 0x9a, 0x9e, 0xc6, 0x6f, 0x4c, 0x26, 0x1a, 0x62, 0x8d, 0xba, 0xb1, 0x16, 0xa7,
+	0xfd, 0x9d, 0x0c, 0x40, 0x62, 0x04, 0xfe, 0x95, 0x2d, 0x54, 0x92, 0x11, 0x20, 0xf7, 0x2d, 0x46,
+	0x80, 0x17, 0x3c, 0xa6, 0xa1, 0x7d, 0x0d, 0x95, 0xd8, 0xec, 0xff, 0xfd, 0xd7, 0xd8, 0x4b, 0x7d,
+	0xf2, 0xb7, 0x85, 0xb5, 0x2e, 0xb6, 0x9b, 0xff, 0xaa, 0x63, 0x91, 0xfa, 0x7c, 0xee, 0x05, 0x9f,
+	0x3f, 0x67, 0x26, 0xb3, 0xf8, 0xe3, 0xbf, 0xe6, 0x8d, 0x25, 0xaf, 0xf9, 0x7c, 0x6a, 0xcd, 0x6b,
+	0x0b, 0x6e, 0xf7, 0xfb, 0xd5, 0x3f, 0xfd, 0x52, 0x1d, 0xfe, 0x8b, 0x8c, 0x30, 0x4e, 0xc5, 0x2f,
+	0x77, 0x5e, 0x2a, 0xd6, 0xad, 0xb7, 0xaf, 0xbd, 0xcc, 0xe7, 0xbe, 0x55, 0x47, 0xce, 0x7f, 0x9b,
+	0x8e, 0xfc, 0x36, 0x14, 0xd8, 0xf1, 0x53, 0xb8, 0x4c, 0x3f, 0x66, 0xf8, 0x17, 0xbe, 0x94, 0xaf,
+	0x69, 0x5c, 0x8c, 0x65, 0xfd, 0xdd, 0x12, 0xf5, 0x8a, 0x57, 0xfe, 0x31, 0x83, 0x26, 0x8a, 0x4a,
+	0xa2, 0x2a, 0xbf, 0xfc, 0x98, 0xfc, 0xda, 0x94, 0xe4, 0x7f, 0x90, 0x85, 0x7a, 0xca, 0xe3, 0xf7,
+	0x3d, 0x1a, 0xb3, 0x96, 0x9b, 0xe7, 0xd6, 0x73, 0xf3, 0xef, 0xf3, 0x4c, 0xd4, 0xff, 0x92, 0x13,
+	0x20, 0x15, 0x24, 0x57, 0x4e, 0x07, 0xc9, 0x21, 0x37, 0xad, 0xc9, 0xdf, 0x5d, 0xab, 0x2d, 0x64,
+	0xd6, 0x6a, 0x0b, 0x77, 0xe2, 0xdf, 0x04, 0xeb, 0xee, 0x31, 0x35, 0xb6, 0xae, 0x4b, 0x10, 0x0c,
+	0xb1, 0x63, 0x32, 0x14, 0x13, 0x1b, 0x0d, 0x7f, 0x6a, 0x08, 0xac, 0xc5, 0x03, 0xff, 0xae, 0x33,
+	0x02, 0xf6, 0x33, 0x0a, 0xd3, 0x96, 0xc0, 0x6a, 0x5d, 0xa8, 0xa7, 0xdc, 0xaf, 0xd2, 0xaf, 0x0f,
+	0x66, 0xe4, 0x5f, 0x1f, 0xc4, 0xe0, 0xb7, 0xb3, 0x13, 0x3b, 0xb0, 0xd7, 0x3c, 0x65, 0xc8, 0x10,
+	0xf8, 0x5b, 0x3f, 0x72, 0x28, 0x88, 0xfa, 0x1e, 0x14, 0x9c, 0xc8, 0x9e, 0x09, 0x4d, 0xee, 0xfa,
+	0x6a, 0xb4, 0x08, 0xa9, 0xed, 0x8c, 0x08, 0xc3, 0x2e, 0x94, 0x65, 0x9c, 0xf4, 0x13, 0x89, 0x99,
+	0x4b, 0x7e, 0x22, 0x31, 0x9b, 0x6a, 0xe4, 0xba, 0x5f, 0x39, 0x8c, 0xdf, 0x47, 0xcb, 0x5f, 0xf2,
+	0x3e, 0x1a, 0x5e, 0x15, 0x0e, 0x6c, 0xfa, 0xfd, 0x39, 0x6b, 0x4d, 0x30, 0x76, 0x8c, 0xc3, 0xa0,
+	0xea, 0x12, 0x8f, 0x5b, 0x59, 0xab, 0x5a, 0xbf, 0x03, 0x25, 0xf6, 0x5b, 0x74, 0xc2, 0xd4, 0xb0,
+	0x12, 0xc8, 0x29, 0xf0, 0x18, 0x33, 0x8d, 0xa8, 0xb4, 0xaa, 0x8d, 0xd1, 0x4c, 0x3a, 0xc1, 0xf9,
+	0xaf, 0xa3, 0x98, 0x33, 0x7e, 0xe1, 0x91, 0xbd, 0x4f, 0x02, 0x04, 0x62, 0x77, 0x1b, 0x7f, 0x08,
+	0x25, 0x1e, 0x17, 0x73, 0xd9, 0x4f, 0xd6, 0x7c, 0xeb, 0xaf, 0xb0, 0x6d, 0x03, 0x24, 0x81, 0x32,
+	0xeb, 0x6a, 0xc0, 0xdf, 0x55, 0x14, 0xb1, 0x31, 0xb8, 0xfe, 0x92, 0x4f, 0xf3, 0x60, 0x7b, 0xb9,
+	0x31, 0x2e, 0x7f, 0xdd, 0x17, 0x5d, 0xe4, 0x64, 0x0b, 0xfc, 0x00, 0xe8, 0x6a, 0xc3, 0x68, 0xe5,
+	0x21, 0x97, 0xf4, 0x4b, 0xca, 0x31, 0x91, 0x7a, 0x0f, 0x62, 0x76, 0xfc, 0x22, 0xdd, 0x5c, 0x6b,
+	0x89, 0x2b, 0x36, 0xb4, 0xca, 0x1e, 0x70, 0x5b, 0x55, 0x8f, 0x1e, 0x0f, 0x4a, 0x99, 0x87, 0x52,
+	0x6d, 0xd2, 0x25, 0x32, 0xad, 0x01, 0x35, 0xd9, 0xa1, 0xaf, 0xb5, 0x60, 0x13, 0x7f, 0x90, 0x0f,
+	0x79, 0x16, 0xde, 0x16, 0x42, 0x7a, 0xb6, 0x7e, 0x31, 0x91, 0x5e, 0xbf, 0xcb, 0x74, 0x3a, 0x23,
+	0xd2, 0x7e, 0x2f, 0x0f, 0xca, 0x32, 0x0e, 0x99, 0x49, 0x7c, 0xb7, 0x35, 0x23, 0x5e, 0x89, 0x77,
+	0xe3, 0x5f, 0x34, 0xa2, 0x75, 0x91, 0xfa, 0xfd, 0x1b, 0x06, 0x92, 0x22, 0x6e, 0x53, 0xcf, 0xad,
+	0x97, 0x9d, 0xf0, 0x31, 0xe5, 0xd1, 0x74, 0x87, 0x8f, 0x8a, 0xb8, 0xfe, 0x84, 0x96, 0x75, 0x8d,
+	0x1e, 0x1d, 0xe9, 0xf9, 0x13, 0x2c, 0x25, 0xd4, 0x7b, 0x16, 0x65, 0x56, 0xd3, 0xcb, 0x0c, 0x30,
+	0x22, 0xaf, 0x07, 0x8f, 0xc3, 0x8d, 0x42, 0x7e, 0x53, 0xab, 0xcc, 0x00, 0xa3, 0x50, 0x3c, 0x42,
+	0x3b, 0xe1, 0x3f, 0xd6, 0x92, 0xa3, 0x47, 0x68, 0xf1, 0x95, 0x5c, 0x34, 0x39, 0x61, 0x14, 0xee,
+	0x84, 0xff, 0x54, 0x15, 0x7f, 0xe2, 0x17, 0x51, 0x6f, 0xb0, 0x9f, 0xb3, 0x09, 0xec, 0x30, 0x64,
+	0x2f, 0x67, 0xb1, 0x37, 0xad, 0x6a, 0x02, 0x18, 0x3f, 0xd1, 0xc5, 0x7f, 0x4c, 0x08, 0x49, 0x80,
+	0x3f, 0xd1, 0x45, 0x20, 0x22, 0xb8, 0x09, 0xe5, 0x6f, 0x7c, 0xcf, 0x26, 0x33, 0x41, 0x95, 0x5a,
+	0x55, 0xc2, 0xfc, 0x81, 0x39, 0xd7, 0xfe, 0x34, 0x03, 0x5b, 0xcb, 0xa3, 0x4a, 0x0b, 0xa6, 0x06,
+	0xe5, 0xf6, 0xa0, 0x67, 0xa0, 0xbf, 0x56, 0xb9, 0x82, 0x96, 0xfd, 0xc1, 0x2e, 0xde, 0xa0, 0x65,
+	0x80, 0x0c, 0xdd, 0x68, 0x1d, 0x1a, 0x8f, 0xbb, 0x7b, 0x7b, 0x9d, 0x3e, 0xd3, 0x52, 0x06, 0xbb,
+	0x3f, 0x31, 0x7a, 0x83, 0x36, 0xfb, 0xed, 0x11, 0x11, 0x3e, 0x30, 0x54, 0xf2, 0x98, 0x65, 0x41,
+	0xad, 0x98, 0x2d, 0xb0, 0x98, 0xcd, 0x67, 0x43, 0xa3, 0xdd, 0x1f, 0x29, 0x45, 0xcc, 0xe1, 0x15,
+	0x45, 0xa3, 0x2d, 0x82, 0xb3, 0xda, 0x83, 0x83, 0x43, 0xbd, 0x33, 0x1c, 0x1a, 0xc3, 0xee, 0xcf,
+	0x3b, 0x4a, 0x99, 0xbe, 0xac, 0x77, 0x1f, 0x75, 0xfb, 0x0c, 0x50, 0x41, 0xf7, 0xc3, 0x41, 0xb7,
+	0xcf, 0x6e, 0xf2, 0x1e, 0xb4, 0xbe, 0x54, 0xaa, 0x98, 0x18, 0x1e, 0x1d, 0x28, 0xb5, 0x7b, 0xaf,
+	0x43, 0x4d, 0xfe, 0xbd, 0x31, 0x0a, 0xd3, 0xf4, 0x3d, 0x9b, 0x3d, 0x55, 0xdb, 0xfb, 0xe6, 0x63,
+	0x25, 0x73, 0xef, 0xb7, 0xa5, 0x9f, 0x36, 0x20, 0x1a, 0xee, 0xcd, 0xa0, 0x6b, 0x8b, 0xec, 0x5e,
+	0x24, 0xf9, 0x2e, 0xe8, 0x1a, 0xe5, 0xe3, 0xd6, 0xf0, 0x31, 0xf3, 0x73, 0x70, 0x0c, 0x01, 0x72,
+	0xc9, 0xfb, 0xa5, 0x74, 0x2d, 0x99, 0x92, 0x71, 0xb4, 0x40, 0x01, 0x0b, 0x92, 0x23, 0xbf, 0x88,
+	0x1e, 0x6f, 0x4c, 0xc5, 0xb8, 0xd2, 0x3d, 0x0d, 0xaa, 0xd2, 0x1b, 0xd4, 0xf4, 0x0d, 0x33, 0x3c,
+	0xe1, 0x4f, 0xa2, 0xa2, 0xba, 0xa9, 0x64, 0xee, 0xbd, 0x05, 0x75, 0x4e, 0xc3, 0x5f, 0x80, 0xc6,
+	0x5f, 0x13, 0xc5, 0x0b, 0x83, 0x2e, 0xa7, 0xb3, 0x17, 0x21, 0xd2, 0x7d, 0x00, 0xd7, 0xd6, 0xbe,
+	0x67, 0x8d, 0xf4, 0x43, 0x07, 0x43, 0x39, 0x59, 0xb4, 0xec, 0xe3, 0x8b, 0x71, 0xe0, 0x58, 0x4a,
+	0xe6, 0xde, 0x43, 0x71, 0xb3, 0x51, 0x7c, 0xbb, 0x37, 0x68, 0xed, 0xb1, 0xc9, 0x8d, 0xaf, 0x4d,
+	0x8f, 0x76, 0xd9, 0x13, 0xa7, 0x7a, 0x67, 0x78, 0xd4, 0x1b, 0xf1, 0x2b, 0xda, 0xf7, 0x7e, 0x0c,
+	0xcd, 0xcb, 0xc2, 0x46, 0x99, 0x8f, 0xa7, 0x45, 0xa1, 0xb9, 0x38, 0x99, 0x03, 0x83, 0xe5, 0x32,
+	0x2c, 0xb2, 0xb9, 0xd7, 0xa1, 0x90, 0x92, 0x7b, 0xbf, 0xc8, 0x48, 0x2c, 0x4c, 0x84, 0xfe, 0xc5,
+	0x00, 0x3e, 0x4b, 0x32, 0x48, 0xb7, 0x4d, 0x4b, 0xc9, 0xa8, 0xd7, 0x41, 0x4d, 0x81, 0x7a, 0xfe,
+	0xc4, 0x74, 0x95, 0x2c, 0x05, 0x8f, 0x08, 0x38, 0x05, 0x68, 0x2b, 0x39, 0xf5, 0x55, 0xb8, 0x19,
+	0xc3, 0x7a, 0xfe, 0xd9, 0x61, 0xe0, 0xa0, 0xae, 0x7d, 0xc1, 0xd0, 0xf9, 0xdd, 0x1f, 0xfd, 0xc9,
+	0x2f, 0xef, 0x64, 0xfe, 0xd5, 0x2f, 0xef, 0x64, 0xfe, 0xc3, 0x2f, 0xef, 0x5c, 0xf9, 0xbd, 0xff,
+	0x78, 0x27, 0xf3, 0x73, 0xf9, 0x57, 0xc9, 0x67, 0x66, 0x14, 0x38, 0xe7, 0x6c, 0xd3, 0x88, 0x8c,
+	0x67, 0x7f, 0x30, 0x3f, 0x3d, 0xfe, 0x60, 0x3e, 0xfe, 0x00, 0x39, 0xd3, 0xb8, 0x48, 0xbf, 0x3f,
+	0xfe, 0xe0, 0x7f, 0x0e, 0x00, 0x57, 0xd1, 0x44, 0x7d, 0xdf, 0x7c, 0x00, 0x00,
 }
 
 func (m *Type) Marshal() (dAtA []byte, err error) {
@@ -14817,6 +15038,20 @@ func (m *ColDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Generated != nil {
+		{
+			size, err := m.Generated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
 	if len(m.OriginName) > 0 {
 		i -= len(m.OriginName)
 		copy(dAtA[i:], m.OriginName)
@@ -14984,6 +15219,69 @@ func (m *ColDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GeneratedColDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeneratedColDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeneratedColDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Stored {
+		i--
+		if m.Stored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ExprStr) > 0 {
+		i -= len(m.ExprStr)
+		copy(dAtA[i:], m.ExprStr)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ExprStr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Expr != nil {
+		{
+			size, err := m.Expr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Default) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -15205,20 +15503,20 @@ func (m *PrimaryKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x10
 	}
 	if len(m.Cols) > 0 {
-		dAtA30 := make([]byte, len(m.Cols)*10)
-		var j29 int
+		dAtA32 := make([]byte, len(m.Cols)*10)
+		var j31 int
 		for _, num := range m.Cols {
 			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j29++
+				j31++
 			}
-			dAtA30[j29] = uint8(num)
-			j29++
+			dAtA32[j31] = uint8(num)
+			j31++
 		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintPlan(dAtA, i, uint64(j29))
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintPlan(dAtA, i, uint64(j31))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -15387,20 +15685,20 @@ func (m *ForeignKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if len(m.ForeignCols) > 0 {
-		dAtA33 := make([]byte, len(m.ForeignCols)*10)
-		var j32 int
+		dAtA35 := make([]byte, len(m.ForeignCols)*10)
+		var j34 int
 		for _, num := range m.ForeignCols {
 			for num >= 1<<7 {
-				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j32++
+				j34++
 			}
-			dAtA33[j32] = uint8(num)
-			j32++
+			dAtA35[j34] = uint8(num)
+			j34++
 		}
-		i -= j32
-		copy(dAtA[i:], dAtA33[:j32])
-		i = encodeVarintPlan(dAtA, i, uint64(j32))
+		i -= j34
+		copy(dAtA[i:], dAtA35[:j34])
+		i = encodeVarintPlan(dAtA, i, uint64(j34))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -15410,20 +15708,20 @@ func (m *ForeignKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.Cols) > 0 {
-		dAtA35 := make([]byte, len(m.Cols)*10)
-		var j34 int
+		dAtA37 := make([]byte, len(m.Cols)*10)
+		var j36 int
 		for _, num := range m.Cols {
 			for num >= 1<<7 {
-				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA37[j36] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j34++
+				j36++
 			}
-			dAtA35[j34] = uint8(num)
-			j34++
+			dAtA37[j36] = uint8(num)
+			j36++
 		}
-		i -= j34
-		copy(dAtA[i:], dAtA35[:j34])
-		i = encodeVarintPlan(dAtA, i, uint64(j34))
+		i -= j36
+		copy(dAtA[i:], dAtA37[:j36])
+		i = encodeVarintPlan(dAtA, i, uint64(j36))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -15461,6 +15759,23 @@ func (m *CheckDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ExprStr) > 0 {
+		i -= len(m.ExprStr)
+		copy(dAtA[i:], m.ExprStr)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ExprStr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NotEnforced {
+		i--
+		if m.NotEnforced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Check != nil {
 		{
 			size, err := m.Check.MarshalToSizedBuffer(dAtA[:i])
@@ -16186,20 +16501,20 @@ func (m *TableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.RefChildTbls) > 0 {
-		dAtA46 := make([]byte, len(m.RefChildTbls)*10)
-		var j45 int
+		dAtA48 := make([]byte, len(m.RefChildTbls)*10)
+		var j47 int
 		for _, num := range m.RefChildTbls {
 			for num >= 1<<7 {
-				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA48[j47] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j45++
+				j47++
 			}
-			dAtA46[j45] = uint8(num)
-			j45++
+			dAtA48[j47] = uint8(num)
+			j47++
 		}
-		i -= j45
-		copy(dAtA[i:], dAtA46[:j45])
-		i = encodeVarintPlan(dAtA, i, uint64(j45))
+		i -= j47
+		copy(dAtA[i:], dAtA48[:j47])
+		i = encodeVarintPlan(dAtA, i, uint64(j47))
 		i--
 		dAtA[i] = 0x72
 	}
@@ -16437,9 +16752,9 @@ func (m *HashMapStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	if len(m.Ranges) > 0 {
 		for iNdEx := len(m.Ranges) - 1; iNdEx >= 0; iNdEx-- {
-			f50 := math.Float64bits(float64(m.Ranges[iNdEx]))
+			f52 := math.Float64bits(float64(m.Ranges[iNdEx]))
 			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f50))
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f52))
 		}
 		i = encodeVarintPlan(dAtA, i, uint64(len(m.Ranges)*8))
 		i--
@@ -16799,6 +17114,16 @@ func (m *WindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.IgnoreNulls {
+		i--
+		if m.IgnoreNulls {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -17097,21 +17422,21 @@ func (m *OnDuplicateKeyCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnDuplicateIdx) > 0 {
-		dAtA61 := make([]byte, len(m.OnDuplicateIdx)*10)
-		var j60 int
+		dAtA63 := make([]byte, len(m.OnDuplicateIdx)*10)
+		var j62 int
 		for _, num1 := range m.OnDuplicateIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA61[j60] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA63[j62] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j60++
+				j62++
 			}
-			dAtA61[j60] = uint8(num)
-			j60++
+			dAtA63[j62] = uint8(num)
+			j62++
 		}
-		i -= j60
-		copy(dAtA[i:], dAtA61[:j60])
-		i = encodeVarintPlan(dAtA, i, uint64(j60))
+		i -= j62
+		copy(dAtA[i:], dAtA63[:j62])
+		i = encodeVarintPlan(dAtA, i, uint64(j62))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -17227,20 +17552,20 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA63 := make([]byte, len(m.PartitionTableIds)*10)
-		var j62 int
+		dAtA65 := make([]byte, len(m.PartitionTableIds)*10)
+		var j64 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA63[j62] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA65[j64] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j62++
+				j64++
 			}
-			dAtA63[j62] = uint8(num)
-			j62++
+			dAtA65[j64] = uint8(num)
+			j64++
 		}
-		i -= j62
-		copy(dAtA[i:], dAtA63[:j62])
-		i = encodeVarintPlan(dAtA, i, uint64(j62))
+		i -= j64
+		copy(dAtA[i:], dAtA65[:j64])
+		i = encodeVarintPlan(dAtA, i, uint64(j64))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -17310,20 +17635,20 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA67 := make([]byte, len(m.PartitionTableIds)*10)
-		var j66 int
+		dAtA69 := make([]byte, len(m.PartitionTableIds)*10)
+		var j68 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA67[j66] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA69[j68] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j66++
+				j68++
 			}
-			dAtA67[j66] = uint8(num)
-			j66++
+			dAtA69[j68] = uint8(num)
+			j68++
 		}
-		i -= j66
-		copy(dAtA[i:], dAtA67[:j66])
-		i = encodeVarintPlan(dAtA, i, uint64(j66))
+		i -= j68
+		copy(dAtA[i:], dAtA69[:j68])
+		i = encodeVarintPlan(dAtA, i, uint64(j68))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -17423,20 +17748,20 @@ func (m *ReplaceCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA71 := make([]byte, len(m.PartitionTableIds)*10)
-		var j70 int
+		dAtA73 := make([]byte, len(m.PartitionTableIds)*10)
+		var j72 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA71[j70] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA73[j72] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j70++
+				j72++
 			}
-			dAtA71[j70] = uint8(num)
-			j70++
+			dAtA73[j72] = uint8(num)
+			j72++
 		}
-		i -= j70
-		copy(dAtA[i:], dAtA71[:j70])
-		i = encodeVarintPlan(dAtA, i, uint64(j70))
+		i -= j72
+		copy(dAtA[i:], dAtA73[:j72])
+		i = encodeVarintPlan(dAtA, i, uint64(j72))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -17582,40 +17907,40 @@ func (m *AnalyzeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x80
 	}
 	if len(m.TimeConsumedArrayMinor) > 0 {
-		dAtA75 := make([]byte, len(m.TimeConsumedArrayMinor)*10)
-		var j74 int
+		dAtA77 := make([]byte, len(m.TimeConsumedArrayMinor)*10)
+		var j76 int
 		for _, num1 := range m.TimeConsumedArrayMinor {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA75[j74] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA77[j76] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j74++
+				j76++
 			}
-			dAtA75[j74] = uint8(num)
-			j74++
+			dAtA77[j76] = uint8(num)
+			j76++
 		}
-		i -= j74
-		copy(dAtA[i:], dAtA75[:j74])
-		i = encodeVarintPlan(dAtA, i, uint64(j74))
+		i -= j76
+		copy(dAtA[i:], dAtA77[:j76])
+		i = encodeVarintPlan(dAtA, i, uint64(j76))
 		i--
 		dAtA[i] = 0x7a
 	}
 	if len(m.TimeConsumedArrayMajor) > 0 {
-		dAtA77 := make([]byte, len(m.TimeConsumedArrayMajor)*10)
-		var j76 int
+		dAtA79 := make([]byte, len(m.TimeConsumedArrayMajor)*10)
+		var j78 int
 		for _, num1 := range m.TimeConsumedArrayMajor {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA77[j76] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA79[j78] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j76++
+				j78++
 			}
-			dAtA77[j76] = uint8(num)
-			j76++
+			dAtA79[j78] = uint8(num)
+			j78++
 		}
-		i -= j76
-		copy(dAtA[i:], dAtA77[:j76])
-		i = encodeVarintPlan(dAtA, i, uint64(j76))
+		i -= j78
+		copy(dAtA[i:], dAtA79[:j78])
+		i = encodeVarintPlan(dAtA, i, uint64(j78))
 		i--
 		dAtA[i] = 0x72
 	}
@@ -18275,21 +18600,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x8a
 	}
 	if len(m.SourceStep) > 0 {
-		dAtA94 := make([]byte, len(m.SourceStep)*10)
-		var j93 int
+		dAtA96 := make([]byte, len(m.SourceStep)*10)
+		var j95 int
 		for _, num1 := range m.SourceStep {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA94[j93] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA96[j95] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j93++
+				j95++
 			}
-			dAtA94[j93] = uint8(num)
-			j93++
+			dAtA96[j95] = uint8(num)
+			j95++
 		}
-		i -= j93
-		copy(dAtA[i:], dAtA94[:j93])
-		i = encodeVarintPlan(dAtA, i, uint64(j93))
+		i -= j95
+		copy(dAtA[i:], dAtA96[:j95])
+		i = encodeVarintPlan(dAtA, i, uint64(j95))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -18380,21 +18705,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xca
 	}
 	if len(m.BindingTags) > 0 {
-		dAtA100 := make([]byte, len(m.BindingTags)*10)
-		var j99 int
+		dAtA102 := make([]byte, len(m.BindingTags)*10)
+		var j101 int
 		for _, num1 := range m.BindingTags {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA100[j99] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA102[j101] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j99++
+				j101++
 			}
-			dAtA100[j99] = uint8(num)
-			j99++
+			dAtA102[j101] = uint8(num)
+			j101++
 		}
-		i -= j99
-		copy(dAtA[i:], dAtA100[:j99])
-		i = encodeVarintPlan(dAtA, i, uint64(j99))
+		i -= j101
+		copy(dAtA[i:], dAtA102[:j101])
+		i = encodeVarintPlan(dAtA, i, uint64(j101))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -18648,21 +18973,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Children) > 0 {
-		dAtA109 := make([]byte, len(m.Children)*10)
-		var j108 int
+		dAtA111 := make([]byte, len(m.Children)*10)
+		var j110 int
 		for _, num1 := range m.Children {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA109[j108] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA111[j110] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j108++
+				j110++
 			}
-			dAtA109[j108] = uint8(num)
-			j108++
+			dAtA111[j110] = uint8(num)
+			j110++
 		}
-		i -= j108
-		copy(dAtA[i:], dAtA109[:j108])
-		i = encodeVarintPlan(dAtA, i, uint64(j108))
+		i -= j110
+		copy(dAtA[i:], dAtA111[:j110])
+		i = encodeVarintPlan(dAtA, i, uint64(j110))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -18906,6 +19231,18 @@ func (m *LockTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.WaitTimeout != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.WaitTimeout))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.WaitPolicy != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.WaitPolicy))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.LockTableAtTheEnd {
 		i--
 		if m.LockTableAtTheEnd {
@@ -18954,20 +19291,20 @@ func (m *LockTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x48
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA115 := make([]byte, len(m.PartitionTableIds)*10)
-		var j114 int
+		dAtA117 := make([]byte, len(m.PartitionTableIds)*10)
+		var j116 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA115[j114] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA117[j116] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j114++
+				j116++
 			}
-			dAtA115[j114] = uint8(num)
-			j114++
+			dAtA117[j116] = uint8(num)
+			j116++
 		}
-		i -= j114
-		copy(dAtA[i:], dAtA115[:j114])
-		i = encodeVarintPlan(dAtA, i, uint64(j114))
+		i -= j116
+		copy(dAtA[i:], dAtA117[:j116])
+		i = encodeVarintPlan(dAtA, i, uint64(j116))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -19074,21 +19411,21 @@ func (m *PreInsertUkCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x10
 	}
 	if len(m.Columns) > 0 {
-		dAtA120 := make([]byte, len(m.Columns)*10)
-		var j119 int
+		dAtA122 := make([]byte, len(m.Columns)*10)
+		var j121 int
 		for _, num1 := range m.Columns {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA120[j119] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA122[j121] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j119++
+				j121++
 			}
-			dAtA120[j119] = uint8(num)
-			j119++
+			dAtA122[j121] = uint8(num)
+			j121++
 		}
-		i -= j119
-		copy(dAtA[i:], dAtA120[:j119])
-		i = encodeVarintPlan(dAtA, i, uint64(j119))
+		i -= j121
+		copy(dAtA[i:], dAtA122[:j121])
+		i = encodeVarintPlan(dAtA, i, uint64(j121))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -19120,21 +19457,21 @@ func (m *PreDeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Idx) > 0 {
-		dAtA122 := make([]byte, len(m.Idx)*10)
-		var j121 int
+		dAtA124 := make([]byte, len(m.Idx)*10)
+		var j123 int
 		for _, num1 := range m.Idx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA122[j121] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA124[j123] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j121++
+				j123++
 			}
-			dAtA122[j121] = uint8(num)
-			j121++
+			dAtA124[j123] = uint8(num)
+			j123++
 		}
-		i -= j121
-		copy(dAtA[i:], dAtA122[:j121])
-		i = encodeVarintPlan(dAtA, i, uint64(j121))
+		i -= j123
+		copy(dAtA[i:], dAtA124[:j123])
+		i = encodeVarintPlan(dAtA, i, uint64(j123))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -19320,21 +19657,21 @@ func (m *IdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.List) > 0 {
-		dAtA129 := make([]byte, len(m.List)*10)
-		var j128 int
+		dAtA131 := make([]byte, len(m.List)*10)
+		var j130 int
 		for _, num1 := range m.List {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA129[j128] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA131[j130] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j128++
+				j130++
 			}
-			dAtA129[j128] = uint8(num)
-			j128++
+			dAtA131[j130] = uint8(num)
+			j130++
 		}
-		i -= j128
-		copy(dAtA[i:], dAtA129[:j128])
-		i = encodeVarintPlan(dAtA, i, uint64(j128))
+		i -= j130
+		copy(dAtA[i:], dAtA131[:j130])
+		i = encodeVarintPlan(dAtA, i, uint64(j130))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -19453,20 +19790,20 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA133 := make([]byte, len(m.PartitionTableIds)*10)
-		var j132 int
+		dAtA135 := make([]byte, len(m.PartitionTableIds)*10)
+		var j134 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA133[j132] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA135[j134] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j132++
+				j134++
 			}
-			dAtA133[j132] = uint8(num)
-			j132++
+			dAtA135[j134] = uint8(num)
+			j134++
 		}
-		i -= j132
-		copy(dAtA[i:], dAtA133[:j132])
-		i = encodeVarintPlan(dAtA, i, uint64(j132))
+		i -= j134
+		copy(dAtA[i:], dAtA135[:j134])
+		i = encodeVarintPlan(dAtA, i, uint64(j134))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -19771,21 +20108,21 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Steps) > 0 {
-		dAtA138 := make([]byte, len(m.Steps)*10)
-		var j137 int
+		dAtA140 := make([]byte, len(m.Steps)*10)
+		var j139 int
 		for _, num1 := range m.Steps {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA138[j137] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA140[j139] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j137++
+				j139++
 			}
-			dAtA138[j137] = uint8(num)
-			j137++
+			dAtA140[j139] = uint8(num)
+			j139++
 		}
-		i -= j137
-		copy(dAtA[i:], dAtA138[:j137])
-		i = encodeVarintPlan(dAtA, i, uint64(j137))
+		i -= j139
+		copy(dAtA[i:], dAtA140[:j139])
+		i = encodeVarintPlan(dAtA, i, uint64(j139))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -21569,6 +21906,50 @@ func (m *AlterTableAlterIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterTableAlterCheck) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableAlterCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableAlterCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Enforced {
+		i--
+		if m.Enforced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTableAlterReIndex) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -22287,6 +22668,48 @@ func (m *AlterTable_Action_AddPartition) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *AlterTable_Action_AddCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_AddCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AddCheck != nil {
+		{
+			size, err := m.AddCheck.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_AlterCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_AlterCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AlterCheck != nil {
+		{
+			size, err := m.AlterCheck.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DropTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -22312,20 +22735,20 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.FkChildTblsReferToMe) > 0 {
-		dAtA194 := make([]byte, len(m.FkChildTblsReferToMe)*10)
-		var j193 int
+		dAtA198 := make([]byte, len(m.FkChildTblsReferToMe)*10)
+		var j197 int
 		for _, num := range m.FkChildTblsReferToMe {
 			for num >= 1<<7 {
-				dAtA194[j193] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA198[j197] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j193++
+				j197++
 			}
-			dAtA194[j193] = uint8(num)
-			j193++
+			dAtA198[j197] = uint8(num)
+			j197++
 		}
-		i -= j193
-		copy(dAtA[i:], dAtA194[:j193])
-		i = encodeVarintPlan(dAtA, i, uint64(j193))
+		i -= j197
+		copy(dAtA[i:], dAtA198[:j197])
+		i = encodeVarintPlan(dAtA, i, uint64(j197))
 		i--
 		dAtA[i] = 0x62
 	}
@@ -22370,20 +22793,20 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA197 := make([]byte, len(m.ForeignTbl)*10)
-		var j196 int
+		dAtA201 := make([]byte, len(m.ForeignTbl)*10)
+		var j200 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA197[j196] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA201[j200] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j196++
+				j200++
 			}
-			dAtA197[j196] = uint8(num)
-			j196++
+			dAtA201[j200] = uint8(num)
+			j200++
 		}
-		i -= j196
-		copy(dAtA[i:], dAtA197[:j196])
-		i = encodeVarintPlan(dAtA, i, uint64(j196))
+		i -= j200
+		copy(dAtA[i:], dAtA201[:j200])
+		i = encodeVarintPlan(dAtA, i, uint64(j200))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -22936,20 +23359,20 @@ func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x40
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA206 := make([]byte, len(m.ForeignTbl)*10)
-		var j205 int
+		dAtA210 := make([]byte, len(m.ForeignTbl)*10)
+		var j209 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA206[j205] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA210[j209] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j205++
+				j209++
 			}
-			dAtA206[j205] = uint8(num)
-			j205++
+			dAtA210[j209] = uint8(num)
+			j209++
 		}
-		i -= j205
-		copy(dAtA[i:], dAtA206[:j205])
-		i = encodeVarintPlan(dAtA, i, uint64(j205))
+		i -= j209
+		copy(dAtA[i:], dAtA210[:j209])
+		i = encodeVarintPlan(dAtA, i, uint64(j209))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -23035,20 +23458,20 @@ func (m *ClusterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.AccountIDs) > 0 {
-		dAtA209 := make([]byte, len(m.AccountIDs)*10)
-		var j208 int
+		dAtA213 := make([]byte, len(m.AccountIDs)*10)
+		var j212 int
 		for _, num := range m.AccountIDs {
 			for num >= 1<<7 {
-				dAtA209[j208] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA213[j212] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j208++
+				j212++
 			}
-			dAtA209[j208] = uint8(num)
-			j208++
+			dAtA213[j212] = uint8(num)
+			j212++
 		}
-		i -= j208
-		copy(dAtA[i:], dAtA209[:j208])
-		i = encodeVarintPlan(dAtA, i, uint64(j208))
+		i -= j212
+		copy(dAtA[i:], dAtA213[:j212])
+		i = encodeVarintPlan(dAtA, i, uint64(j212))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -23260,21 +23683,21 @@ func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA213 := make([]byte, len(m.ParamTypes)*10)
-		var j212 int
+		dAtA217 := make([]byte, len(m.ParamTypes)*10)
+		var j216 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA213[j212] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA217[j216] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j212++
+				j216++
 			}
-			dAtA213[j212] = uint8(num)
-			j212++
+			dAtA217[j216] = uint8(num)
+			j216++
 		}
-		i -= j212
-		copy(dAtA[i:], dAtA213[:j212])
-		i = encodeVarintPlan(dAtA, i, uint64(j212))
+		i -= j216
+		copy(dAtA[i:], dAtA217[:j216])
+		i = encodeVarintPlan(dAtA, i, uint64(j216))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -23421,21 +23844,21 @@ func (m *OtherDCL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA216 := make([]byte, len(m.ParamTypes)*10)
-		var j215 int
+		dAtA220 := make([]byte, len(m.ParamTypes)*10)
+		var j219 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA216[j215] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA220[j219] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j215++
+				j219++
 			}
-			dAtA216[j215] = uint8(num)
-			j215++
+			dAtA220[j219] = uint8(num)
+			j219++
 		}
-		i -= j215
-		copy(dAtA[i:], dAtA216[:j215])
-		i = encodeVarintPlan(dAtA, i, uint64(j215))
+		i -= j219
+		copy(dAtA[i:], dAtA220[:j219])
+		i = encodeVarintPlan(dAtA, i, uint64(j219))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -24646,6 +25069,37 @@ func (m *ColDef) ProtoSize() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPlan(uint64(l))
 	}
+	if m.Generated != nil {
+		l = m.Generated.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GeneratedColDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Expr != nil {
+		l = m.Expr.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.ExprStr)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Stored {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -24869,6 +25323,13 @@ func (m *CheckDef) ProtoSize() (n int) {
 		l = m.Check.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	if m.NotEnforced {
+		n += 2
+	}
+	l = len(m.ExprStr)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -25474,6 +25935,9 @@ func (m *WindowSpec) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	if m.IgnoreNulls {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -26342,6 +26806,12 @@ func (m *LockTarget) ProtoSize() (n int) {
 	if m.LockTableAtTheEnd {
 		n += 2
 	}
+	if m.WaitPolicy != 0 {
+		n += 1 + sovPlan(uint64(m.WaitPolicy))
+	}
+	if m.WaitTimeout != 0 {
+		n += 2 + sovPlan(uint64(m.WaitTimeout))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -27544,6 +28014,25 @@ func (m *AlterTableAlterIndex) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterTableAlterCheck) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Enforced {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTableAlterReIndex) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -27900,6 +28389,30 @@ func (m *AlterTable_Action_AddPartition) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *AlterTable_Action_AddCheck) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddCheck != nil {
+		l = m.AddCheck.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_AlterCheck) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AlterCheck != nil {
+		l = m.AlterCheck.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
 func (m *DropTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -32756,6 +33269,213 @@ func (m *ColDef) Unmarshal(dAtA []byte) error {
 			}
 			m.OriginName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Generated == nil {
+				m.Generated = &GeneratedColDef{}
+			}
+			if err := m.Generated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeneratedColDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeneratedColDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeneratedColDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expr == nil {
+				m.Expr = &Expr{}
+			}
+			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExprStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExprStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stored = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -34232,6 +34952,58 @@ func (m *CheckDef) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotEnforced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NotEnforced = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExprStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExprStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -37841,6 +38613,26 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreNulls", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreNulls = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -43854,6 +44646,44 @@ func (m *LockTarget) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.LockTableAtTheEnd = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitPolicy", wireType)
+			}
+			m.WaitPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitPolicy |= lock.WaitPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitTimeout", wireType)
+			}
+			m.WaitTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -49946,6 +50776,109 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AlterTableAlterCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableAlterCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableAlterCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enforced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enforced = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AlterTableAlterReIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -51656,6 +52589,76 @@ func (m *AlterTable_Action) Unmarshal(dAtA []byte) error {
 			}
 			m.Action = &AlterTable_Action_AddPartition{v}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddCheck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CheckDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_AddCheck{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterCheck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableAlterCheck{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_AlterCheck{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
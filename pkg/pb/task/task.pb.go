@@ -113,6 +113,8 @@ const (
 	TaskCode_Retention TaskCode = 6
 	// Init cdc task
 	TaskCode_InitCdc TaskCode = 7
+	// SQLEvent runs the sql of a scheduled event
+	TaskCode_SQLEvent TaskCode = 8
 )
 
 var TaskCode_name = map[int32]string{
@@ -124,6 +126,7 @@ var TaskCode_name = map[int32]string{
 	5: "MergeObject",
 	6: "Retention",
 	7: "InitCdc",
+	8: "SQLEvent",
 }
 
 var TaskCode_value = map[string]int32{
@@ -135,6 +138,7 @@ var TaskCode_value = map[string]int32{
 	"MergeObject":        5,
 	"Retention":          6,
 	"InitCdc":            7,
+	"SQLEvent":           8,
 }
 
 func (x TaskCode) String() string {
@@ -1247,93 +1251,93 @@ func init() {
 func init() { proto.RegisterFile("task.proto", fileDescriptor_ce5d8dd45b4a91ff) }
 
 var fileDescriptor_ce5d8dd45b4a91ff = []byte{
-	// 1370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcb, 0x6e, 0x1b, 0xc7,
-	0x12, 0xd5, 0xf0, 0xcd, 0xe2, 0xc3, 0xe3, 0xb6, 0x21, 0x0c, 0x08, 0x5f, 0x99, 0xe0, 0xf5, 0xc5,
-	0x55, 0x04, 0x98, 0x4a, 0x14, 0x27, 0x88, 0x0d, 0x24, 0xb0, 0x4c, 0x2a, 0xb0, 0x62, 0xcb, 0x36,
-	0x5a, 0xd4, 0x26, 0xbb, 0xe6, 0xb0, 0x3d, 0x9e, 0x90, 0xec, 0xa1, 0x7b, 0x7a, 0x1c, 0xf1, 0x17,
-	0xb4, 0xca, 0x2e, 0xc8, 0x42, 0x80, 0xf7, 0x01, 0xf2, 0x0b, 0xd9, 0x7a, 0xe9, 0x2f, 0xc8, 0xc3,
-	0xc9, 0x27, 0x64, 0x1b, 0x20, 0xe8, 0xc7, 0xf4, 0x0c, 0xe9, 0x24, 0x80, 0x00, 0xef, 0xa6, 0x4e,
-	0x55, 0x75, 0x57, 0x9d, 0x7a, 0xb0, 0x09, 0x20, 0x48, 0x3c, 0xed, 0x2f, 0x78, 0x24, 0x22, 0x54,
-	0x92, 0xdf, 0x9d, 0x9b, 0x41, 0x28, 0x9e, 0x25, 0xe3, 0xbe, 0x1f, 0xcd, 0x77, 0x83, 0x28, 0x88,
-	0x76, 0x95, 0x72, 0x9c, 0x3c, 0x55, 0x92, 0x12, 0xd4, 0x97, 0x76, 0xea, 0x5c, 0x0f, 0xa2, 0x28,
-	0x98, 0xd1, 0xcc, 0x4a, 0x84, 0x73, 0x1a, 0x0b, 0x32, 0x5f, 0x18, 0x83, 0xf6, 0x9c, 0x0a, 0x32,
-	0x21, 0x82, 0x68, 0xb9, 0xf7, 0xad, 0x03, 0xcd, 0x11, 0x89, 0xa7, 0x47, 0x06, 0x46, 0x6d, 0x28,
-	0x1c, 0x0e, 0x3d, 0xa7, 0xeb, 0x6c, 0xd7, 0x71, 0xe1, 0x70, 0x88, 0x76, 0xa0, 0x76, 0x70, 0x4a,
-	0xfd, 0x44, 0x44, 0xdc, 0x2b, 0x74, 0x9d, 0xed, 0xf6, 0x5e, 0xbb, 0xaf, 0xa2, 0x94, 0x5e, 0x83,
-	0x68, 0x42, 0xb1, 0xd5, 0x23, 0x0f, 0xaa, 0x83, 0x88, 0x09, 0x7a, 0x2a, 0xbc, 0x62, 0xd7, 0xd9,
-	0x6e, 0xe2, 0x54, 0x44, 0x1f, 0x40, 0xf5, 0xf1, 0x42, 0x84, 0x11, 0x8b, 0xbd, 0x52, 0xd7, 0xd9,
-	0x6e, 0xec, 0x5d, 0xce, 0x0e, 0x31, 0x8a, 0x7b, 0xa5, 0x57, 0x3f, 0x5d, 0xdf, 0xc0, 0xa9, 0x5d,
-	0xef, 0xc7, 0x02, 0x34, 0x72, 0x6a, 0x74, 0x03, 0x5a, 0x47, 0xe4, 0x14, 0x53, 0xc1, 0x97, 0x23,
-	0x99, 0x94, 0x8a, 0xb1, 0x85, 0x57, 0x41, 0x69, 0xa5, 0xa4, 0x43, 0x26, 0x28, 0x7f, 0x41, 0x66,
-	0x2a, 0xe6, 0x22, 0x5e, 0x05, 0xa5, 0xd5, 0x90, 0xce, 0xc8, 0x72, 0x98, 0x70, 0x22, 0x4f, 0x57,
-	0xe1, 0x16, 0xf1, 0x2a, 0x88, 0xba, 0xd0, 0x18, 0x44, 0xcc, 0x4f, 0x38, 0xa7, 0xcc, 0x5f, 0xaa,
-	0xc0, 0x5b, 0x38, 0x0f, 0xa1, 0x8f, 0xa0, 0xf2, 0x90, 0x8c, 0xe9, 0x2c, 0xf6, 0xca, 0xdd, 0xe2,
-	0x76, 0x63, 0xef, 0x3f, 0x6f, 0x65, 0xd5, 0xd7, 0xfa, 0x03, 0x26, 0xf8, 0x12, 0x1b, 0x63, 0xc9,
-	0x29, 0xa6, 0x71, 0x94, 0x70, 0x9f, 0x7a, 0x15, 0x45, 0x87, 0xe1, 0x34, 0x45, 0xb1, 0xd5, 0x77,
-	0x6e, 0x43, 0x23, 0x77, 0x04, 0x72, 0xa1, 0x38, 0xa5, 0x4b, 0x53, 0x1f, 0xf9, 0x89, 0xae, 0x42,
-	0xf9, 0x05, 0x99, 0x25, 0x54, 0x65, 0x5a, 0xc7, 0x5a, 0xb8, 0x53, 0xf8, 0xc4, 0xe9, 0xdd, 0xca,
-	0xae, 0x91, 0x7e, 0x83, 0x27, 0x27, 0xca, 0xaf, 0x84, 0xe5, 0x27, 0xda, 0x84, 0xca, 0x11, 0x9d,
-	0x47, 0x7c, 0xa9, 0x1c, 0x4b, 0xd8, 0x48, 0xbd, 0x07, 0xd0, 0xd2, 0x05, 0xa5, 0x98, 0xc6, 0xc9,
-	0x4c, 0xa0, 0x1b, 0x50, 0x92, 0x75, 0x56, 0xbe, 0xed, 0x3d, 0xd7, 0x46, 0x9a, 0xcc, 0x84, 0xaa,
-	0xbf, 0xd2, 0xca, 0x30, 0x0e, 0x38, 0x37, 0x4d, 0x52, 0xc7, 0x5a, 0xe8, 0xfd, 0x51, 0x80, 0xfa,
-	0x7e, 0xbc, 0x64, 0xbe, 0xa4, 0x24, 0xd7, 0x5b, 0x25, 0xd5, 0x5b, 0xb7, 0xa0, 0x96, 0xf6, 0x9d,
-	0x72, 0x6b, 0xec, 0xa1, 0x8c, 0xc0, 0x54, 0x63, 0xfa, 0xc2, 0x5a, 0xa2, 0x1e, 0x34, 0x9f, 0x10,
-	0x4e, 0x99, 0x90, 0x56, 0x87, 0x43, 0x55, 0xbb, 0x3a, 0x5e, 0xc1, 0xd0, 0x36, 0x54, 0x8e, 0x05,
-	0x11, 0x89, 0x6e, 0x37, 0x1b, 0xb5, 0xd4, 0x6a, 0x1c, 0x1b, 0x3d, 0xda, 0x02, 0x90, 0x28, 0x4e,
-	0x18, 0xa3, 0xdc, 0x2b, 0xab, 0xb3, 0x72, 0x88, 0xca, 0x6b, 0x11, 0xf9, 0xcf, 0x54, 0xa1, 0x5a,
-	0x58, 0x0b, 0xb2, 0x81, 0x1e, 0x92, 0x58, 0xdc, 0xa7, 0x84, 0x8b, 0x31, 0x25, 0xc2, 0xab, 0xea,
-	0x06, 0x5a, 0x01, 0x51, 0x07, 0x6a, 0x03, 0x4e, 0x89, 0xa0, 0xfb, 0xc2, 0xab, 0x29, 0x03, 0x2b,
-	0xeb, 0xe6, 0x9a, 0x2f, 0x66, 0x54, 0xd0, 0xc9, 0xbe, 0xf0, 0xea, 0x4a, 0x9d, 0x87, 0xd0, 0xed,
-	0xb5, 0x42, 0x78, 0xa0, 0x28, 0xba, 0xa2, 0x53, 0x59, 0x51, 0xe1, 0x55, 0xcb, 0xde, 0xef, 0x8e,
-	0xbc, 0x39, 0x62, 0xef, 0x90, 0xf5, 0x8e, 0x3e, 0xf1, 0xe0, 0x74, 0xc1, 0x0d, 0xe3, 0x56, 0x96,
-	0xba, 0x47, 0xf4, 0x54, 0xc8, 0x09, 0x54, 0x7c, 0x17, 0xb1, 0x95, 0x65, 0xb5, 0x46, 0x3c, 0x0c,
-	0x02, 0xca, 0xf5, 0xd4, 0x96, 0x55, 0x1c, 0x2b, 0xd8, 0x0a, 0x4f, 0x95, 0x35, 0x9e, 0x3a, 0x50,
-	0x3b, 0x59, 0x4c, 0xb4, 0x4e, 0x93, 0x6c, 0xe5, 0xde, 0xf7, 0x0e, 0xb8, 0x83, 0x88, 0x31, 0xea,
-	0x8b, 0x88, 0x0f, 0xa9, 0x20, 0xe1, 0x2c, 0x46, 0xd7, 0xa0, 0x3e, 0x22, 0xe3, 0x19, 0x7d, 0x44,
-	0xe6, 0xd4, 0xcc, 0x49, 0x06, 0xa0, 0x4f, 0xb3, 0x45, 0x54, 0x50, 0x23, 0xfb, 0x5f, 0x9d, 0xfb,
-	0xfa, 0x31, 0x7d, 0x63, 0xa5, 0x07, 0x37, 0xf5, 0xe9, 0xdc, 0x81, 0x66, 0x5e, 0x71, 0xa1, 0x71,
-	0xbc, 0x09, 0xd5, 0x7d, 0xdf, 0x8f, 0x12, 0x26, 0x54, 0x49, 0x26, 0xb6, 0x24, 0x13, 0x84, 0xa0,
-	0xa4, 0xc2, 0xd5, 0x3e, 0xea, 0xbb, 0xf7, 0x1c, 0x5c, 0x4d, 0xc2, 0x60, 0xe2, 0xa7, 0xb9, 0x6d,
-	0x42, 0x45, 0x35, 0xf8, 0xc4, 0xdc, 0x68, 0x24, 0x49, 0x92, 0xfc, 0xca, 0x9d, 0x61, 0x65, 0xf4,
-	0x1e, 0xd4, 0xcc, 0xb5, 0xb1, 0x57, 0x54, 0x29, 0xb7, 0x74, 0xca, 0x06, 0xc5, 0x56, 0xdd, 0x43,
-	0xe0, 0x62, 0x2a, 0x28, 0x93, 0x09, 0x9a, 0x2b, 0x7b, 0xaf, 0x0a, 0x50, 0x4d, 0xaf, 0xef, 0x42,
-	0x63, 0x48, 0x63, 0x9f, 0x87, 0x8a, 0x02, 0x13, 0x43, 0x1e, 0x92, 0xe4, 0x9b, 0xd3, 0x0e, 0x87,
-	0x2a, 0x92, 0x16, 0xce, 0x00, 0xf9, 0xfb, 0x60, 0x04, 0xd3, 0x42, 0x96, 0x10, 0x59, 0xe5, 0x98,
-	0x72, 0x46, 0x4c, 0x07, 0xd5, 0xb1, 0x95, 0xb3, 0xcd, 0x52, 0xce, 0x6d, 0x16, 0xf4, 0x31, 0xd4,
-	0x6d, 0xcd, 0xcc, 0x64, 0x6c, 0xfe, 0x7d, 0x29, 0xef, 0x6f, 0xe0, 0xcc, 0x54, 0xfa, 0xd9, 0x1c,
-	0xbd, 0x46, 0xde, 0x6f, 0x3d, 0x75, 0xe9, 0x67, 0x31, 0x75, 0x5f, 0x5a, 0x0e, 0xaf, 0xb9, 0x72,
-	0xdf, 0x5a, 0x95, 0xd4, 0x7d, 0x29, 0x76, 0xaf, 0x6e, 0xe9, 0xeb, 0xfd, 0x59, 0x02, 0x18, 0x12,
-	0x3a, 0x7f, 0xa7, 0x73, 0xb9, 0xc2, 0x78, 0xf1, 0x5f, 0x18, 0x2f, 0xad, 0x32, 0xbe, 0xa3, 0x5b,
-	0x66, 0xb4, 0x5c, 0x50, 0x45, 0xec, 0xca, 0xef, 0xba, 0x44, 0xb1, 0xd5, 0xaf, 0xed, 0xc8, 0xca,
-	0x5b, 0x3b, 0xf2, 0x7d, 0xad, 0x37, 0x1b, 0xb7, 0xfa, 0x0f, 0x1b, 0x37, 0x67, 0x83, 0xbe, 0x58,
-	0xdf, 0x9f, 0x35, 0x95, 0x70, 0xa7, 0xaf, 0xdf, 0x2f, 0xfd, 0xf4, 0xfd, 0xd2, 0x1f, 0xa5, 0xef,
-	0x97, 0x7b, 0x35, 0x99, 0xf8, 0x37, 0x3f, 0x5f, 0x77, 0xd6, 0xb7, 0xec, 0xff, 0x2d, 0xc3, 0x6a,
-	0x8b, 0xda, 0xfe, 0x36, 0x20, 0xb6, 0xed, 0x7b, 0x37, 0xb7, 0x66, 0xe0, 0x02, 0xf7, 0x65, 0xcb,
-	0xe8, 0x6e, 0x6e, 0x19, 0x35, 0x2e, 0x72, 0x42, 0xea, 0x85, 0xee, 0x40, 0xf9, 0x80, 0xc9, 0x85,
-	0xdf, 0xbc, 0x80, 0xbb, 0x76, 0x41, 0x9f, 0x41, 0x55, 0x66, 0x8e, 0x13, 0xe6, 0xb5, 0x2e, 0xe0,
-	0x9d, 0x3a, 0xed, 0xfc, 0xe0, 0xe4, 0xeb, 0x84, 0x1a, 0x50, 0xd5, 0x89, 0x4d, 0xdc, 0x0d, 0x29,
-	0xc8, 0x62, 0x86, 0x2c, 0x70, 0x1d, 0xd4, 0x92, 0xb3, 0x65, 0x7e, 0x88, 0xdc, 0x02, 0x02, 0xa8,
-	0x3c, 0x21, 0x49, 0x4c, 0x27, 0x6e, 0x11, 0xd5, 0xcd, 0x30, 0xba, 0x25, 0xd4, 0x84, 0xda, 0x80,
-	0x30, 0x9f, 0xce, 0xe8, 0xc4, 0x2d, 0xa3, 0x2b, 0x70, 0x49, 0xfe, 0xf8, 0xcc, 0x29, 0xa6, 0xcf,
-	0x13, 0x1a, 0x4b, 0xcf, 0x0a, 0x42, 0xd0, 0x56, 0x9e, 0x19, 0x56, 0x95, 0x86, 0xda, 0x2d, 0x03,
-	0x6b, 0xe8, 0xaa, 0xdc, 0x3c, 0xb1, 0x20, 0x5c, 0x64, 0x68, 0x7d, 0xe7, 0x3b, 0x47, 0x37, 0xa9,
-	0x7a, 0x60, 0x34, 0xa1, 0x36, 0xa2, 0xb1, 0x78, 0xcc, 0x66, 0x4b, 0x77, 0x03, 0xb5, 0x01, 0x8e,
-	0x97, 0xb1, 0xa0, 0xf3, 0x43, 0x16, 0x0a, 0xd7, 0x91, 0x37, 0x1d, 0x51, 0xc1, 0x43, 0xff, 0x61,
-	0x14, 0x1c, 0x51, 0x1e, 0x50, 0xb7, 0x80, 0x36, 0x01, 0x69, 0xec, 0x58, 0x44, 0x9c, 0x04, 0xf4,
-	0x24, 0x26, 0x01, 0x75, 0x8b, 0x12, 0xb7, 0xfb, 0xe0, 0x01, 0x79, 0x3a, 0x25, 0xc7, 0x21, 0x9b,
-	0xba, 0x25, 0x74, 0x09, 0x1a, 0xca, 0xf5, 0xf1, 0xf8, 0x2b, 0xea, 0x0b, 0xb7, 0x2c, 0x79, 0xb0,
-	0x0b, 0xc0, 0xad, 0x48, 0x8e, 0xe4, 0x6d, 0x83, 0x89, 0xef, 0x56, 0x77, 0xfe, 0x07, 0x90, 0xbd,
-	0x81, 0xa4, 0xea, 0x38, 0xf1, 0x7d, 0x1a, 0xc7, 0xee, 0x86, 0xe4, 0xeb, 0x73, 0x12, 0x4a, 0x5a,
-	0x9c, 0x9d, 0x97, 0x4e, 0x36, 0x67, 0xe8, 0x1a, 0x54, 0x4f, 0xd8, 0x94, 0x45, 0x5f, 0x33, 0x77,
-	0xa3, 0x73, 0xe9, 0xec, 0xbc, 0xdb, 0x90, 0xb0, 0x81, 0xd0, 0x1e, 0x20, 0x1b, 0x8d, 0x8d, 0xcf,
-	0x75, 0x3a, 0x9d, 0xb3, 0xf3, 0xee, 0xa6, 0x34, 0x7c, 0x5b, 0x6b, 0x9e, 0xbb, 0x3a, 0x42, 0x69,
-	0xe2, 0x16, 0x3a, 0x97, 0xcf, 0xce, 0xbb, 0x2d, 0x35, 0xc0, 0x76, 0x77, 0x5d, 0xcb, 0xed, 0x2e,
-	0xb7, 0xd8, 0x69, 0x9d, 0x9d, 0x77, 0x73, 0x1b, 0x6a, 0xf0, 0xfa, 0xd7, 0x2d, 0xe7, 0xd5, 0x9b,
-	0x2d, 0xe7, 0xf5, 0x9b, 0x2d, 0xe7, 0x97, 0x37, 0x5b, 0x1b, 0x2f, 0x7f, 0xdb, 0x72, 0xbe, 0xcc,
-	0xff, 0xf1, 0x98, 0x13, 0xc1, 0xc3, 0xd3, 0x88, 0x87, 0x41, 0xc8, 0x52, 0x81, 0xd1, 0xdd, 0xc5,
-	0x34, 0xd8, 0x5d, 0x8c, 0x77, 0xe5, 0xb0, 0x8d, 0x2b, 0xaa, 0x05, 0x3f, 0xfc, 0x2b, 0x00, 0x00,
-	0xff, 0xff, 0xd3, 0xeb, 0xfb, 0x47, 0xc2, 0x0c, 0x00, 0x00,
+	// 1375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x8e, 0x1b, 0x45,
+	0x10, 0xde, 0xf1, 0xbf, 0xcb, 0xf6, 0x66, 0xd2, 0x89, 0x56, 0x23, 0x2b, 0x6c, 0x2c, 0x13, 0xc4,
+	0xb2, 0x52, 0xbc, 0xb0, 0x04, 0x44, 0x22, 0x81, 0xd8, 0xd8, 0x8b, 0xb2, 0x64, 0x37, 0x09, 0xbd,
+	0xde, 0x0b, 0xb7, 0xf6, 0xb8, 0x33, 0x19, 0x6c, 0xf7, 0x38, 0x3d, 0x3d, 0x61, 0xfd, 0x0a, 0x7b,
+	0xe2, 0xc6, 0x69, 0xa5, 0xdc, 0x38, 0x20, 0xf1, 0x0a, 0x5c, 0x73, 0xcc, 0x13, 0xf0, 0x13, 0x78,
+	0x04, 0xae, 0x48, 0xa8, 0x7f, 0xa6, 0x67, 0xec, 0x00, 0xd2, 0x4a, 0xb9, 0x75, 0x7d, 0x55, 0xd5,
+	0x55, 0xf5, 0x55, 0x75, 0x79, 0x0c, 0x20, 0x48, 0x3c, 0xe9, 0xcd, 0x79, 0x24, 0x22, 0x54, 0x92,
+	0xe7, 0xf6, 0xcd, 0x20, 0x14, 0x4f, 0x92, 0x51, 0xcf, 0x8f, 0x66, 0x3b, 0x41, 0x14, 0x44, 0x3b,
+	0x4a, 0x39, 0x4a, 0x1e, 0x2b, 0x49, 0x09, 0xea, 0xa4, 0x9d, 0xda, 0xd7, 0x83, 0x28, 0x0a, 0xa6,
+	0x34, 0xb3, 0x12, 0xe1, 0x8c, 0xc6, 0x82, 0xcc, 0xe6, 0xc6, 0x60, 0x7d, 0x46, 0x05, 0x19, 0x13,
+	0x41, 0xb4, 0xdc, 0xfd, 0xde, 0x81, 0xe6, 0x90, 0xc4, 0x93, 0x23, 0x03, 0xa3, 0x75, 0x28, 0x1c,
+	0x0c, 0x3c, 0xa7, 0xe3, 0x6c, 0xd5, 0x71, 0xe1, 0x60, 0x80, 0xb6, 0xa1, 0xb6, 0x7f, 0x4a, 0xfd,
+	0x44, 0x44, 0xdc, 0x2b, 0x74, 0x9c, 0xad, 0xf5, 0xdd, 0xf5, 0x9e, 0xca, 0x52, 0x7a, 0xf5, 0xa3,
+	0x31, 0xc5, 0x56, 0x8f, 0x3c, 0xa8, 0xf6, 0x23, 0x26, 0xe8, 0xa9, 0xf0, 0x8a, 0x1d, 0x67, 0xab,
+	0x89, 0x53, 0x11, 0x7d, 0x00, 0xd5, 0x87, 0x73, 0x11, 0x46, 0x2c, 0xf6, 0x4a, 0x1d, 0x67, 0xab,
+	0xb1, 0x7b, 0x39, 0xbb, 0xc4, 0x28, 0xee, 0x96, 0x5e, 0xfc, 0x72, 0x7d, 0x0d, 0xa7, 0x76, 0xdd,
+	0x9f, 0x0b, 0xd0, 0xc8, 0xa9, 0xd1, 0x0d, 0x68, 0x1d, 0x91, 0x53, 0x4c, 0x05, 0x5f, 0x0c, 0x65,
+	0x51, 0x2a, 0xc7, 0x16, 0x5e, 0x06, 0xa5, 0x95, 0x92, 0x0e, 0x98, 0xa0, 0xfc, 0x19, 0x99, 0xaa,
+	0x9c, 0x8b, 0x78, 0x19, 0x94, 0x56, 0x03, 0x3a, 0x25, 0x8b, 0x41, 0xc2, 0x89, 0xbc, 0x5d, 0xa5,
+	0x5b, 0xc4, 0xcb, 0x20, 0xea, 0x40, 0xa3, 0x1f, 0x31, 0x3f, 0xe1, 0x9c, 0x32, 0x7f, 0xa1, 0x12,
+	0x6f, 0xe1, 0x3c, 0x84, 0x3e, 0x82, 0xca, 0x21, 0x19, 0xd1, 0x69, 0xec, 0x95, 0x3b, 0xc5, 0xad,
+	0xc6, 0xee, 0x5b, 0xaf, 0x55, 0xd5, 0xd3, 0xfa, 0x7d, 0x26, 0xf8, 0x02, 0x1b, 0x63, 0xc9, 0x29,
+	0xa6, 0x71, 0x94, 0x70, 0x9f, 0x7a, 0x15, 0x45, 0x87, 0xe1, 0x34, 0x45, 0xb1, 0xd5, 0xb7, 0x6f,
+	0x43, 0x23, 0x77, 0x05, 0x72, 0xa1, 0x38, 0xa1, 0x0b, 0xd3, 0x1f, 0x79, 0x44, 0x57, 0xa1, 0xfc,
+	0x8c, 0x4c, 0x13, 0xaa, 0x2a, 0xad, 0x63, 0x2d, 0xdc, 0x29, 0x7c, 0xe2, 0x74, 0x6f, 0x65, 0x61,
+	0xa4, 0x5f, 0xff, 0xd1, 0x89, 0xf2, 0x2b, 0x61, 0x79, 0x44, 0x1b, 0x50, 0x39, 0xa2, 0xb3, 0x88,
+	0x2f, 0x94, 0x63, 0x09, 0x1b, 0xa9, 0x7b, 0x1f, 0x5a, 0xba, 0xa1, 0x14, 0xd3, 0x38, 0x99, 0x0a,
+	0x74, 0x03, 0x4a, 0xb2, 0xcf, 0xca, 0x77, 0x7d, 0xd7, 0xb5, 0x99, 0x26, 0x53, 0x21, 0x71, 0xac,
+	0xb4, 0x32, 0x8d, 0x7d, 0xce, 0xcd, 0x90, 0xd4, 0xb1, 0x16, 0xba, 0x7f, 0x15, 0xa0, 0xbe, 0x17,
+	0x2f, 0x98, 0x2f, 0x29, 0xc9, 0xcd, 0x56, 0x49, 0xcd, 0xd6, 0x2d, 0xa8, 0xa5, 0x73, 0xa7, 0xdc,
+	0x1a, 0xbb, 0x28, 0x23, 0x30, 0xd5, 0x98, 0xb9, 0xb0, 0x96, 0xa8, 0x0b, 0xcd, 0x47, 0x84, 0x53,
+	0x26, 0xa4, 0xd5, 0xc1, 0x40, 0xf5, 0xae, 0x8e, 0x97, 0x30, 0xb4, 0x05, 0x95, 0x63, 0x41, 0x44,
+	0xa2, 0xc7, 0xcd, 0x66, 0x2d, 0xb5, 0x1a, 0xc7, 0x46, 0x8f, 0x36, 0x01, 0x24, 0x8a, 0x13, 0xc6,
+	0x28, 0xf7, 0xca, 0xea, 0xae, 0x1c, 0xa2, 0xea, 0x9a, 0x47, 0xfe, 0x13, 0xd5, 0xa8, 0x16, 0xd6,
+	0x82, 0x1c, 0xa0, 0x43, 0x12, 0x8b, 0x7b, 0x94, 0x70, 0x31, 0xa2, 0x44, 0x78, 0x55, 0x3d, 0x40,
+	0x4b, 0x20, 0x6a, 0x43, 0xad, 0xcf, 0x29, 0x11, 0x74, 0x4f, 0x78, 0x35, 0x65, 0x60, 0x65, 0x3d,
+	0x5c, 0xb3, 0xf9, 0x94, 0x0a, 0x3a, 0xde, 0x13, 0x5e, 0x5d, 0xa9, 0xf3, 0x10, 0xba, 0xbd, 0xd2,
+	0x08, 0x0f, 0x14, 0x45, 0x57, 0x74, 0x29, 0x4b, 0x2a, 0xbc, 0x6c, 0xd9, 0xfd, 0xd3, 0x91, 0x91,
+	0x23, 0xf6, 0x06, 0x59, 0x6f, 0xeb, 0x1b, 0xf7, 0x4f, 0xe7, 0xdc, 0x30, 0x6e, 0x65, 0xa9, 0x7b,
+	0x40, 0x4f, 0x85, 0x7c, 0x81, 0x8a, 0xef, 0x22, 0xb6, 0xb2, 0xec, 0xd6, 0x90, 0x87, 0x41, 0x40,
+	0xb9, 0x7e, 0xb5, 0x65, 0x95, 0xc7, 0x12, 0xb6, 0xc4, 0x53, 0x65, 0x85, 0xa7, 0x36, 0xd4, 0x4e,
+	0xe6, 0x63, 0xad, 0xd3, 0x24, 0x5b, 0xb9, 0xfb, 0xa3, 0x03, 0x6e, 0x3f, 0x62, 0x8c, 0xfa, 0x22,
+	0xe2, 0x03, 0x2a, 0x48, 0x38, 0x8d, 0xd1, 0x35, 0xa8, 0x0f, 0xc9, 0x68, 0x4a, 0x1f, 0x90, 0x19,
+	0x35, 0xef, 0x24, 0x03, 0xd0, 0xa7, 0xd9, 0x22, 0x2a, 0xa8, 0x27, 0xfb, 0xb6, 0xae, 0x7d, 0xf5,
+	0x9a, 0x9e, 0xb1, 0xd2, 0x0f, 0x37, 0xf5, 0x69, 0xdf, 0x81, 0x66, 0x5e, 0x71, 0xa1, 0xe7, 0x78,
+	0x13, 0xaa, 0x7b, 0xbe, 0x1f, 0x25, 0x4c, 0xa8, 0x96, 0x8c, 0x6d, 0x4b, 0xc6, 0x08, 0x41, 0x49,
+	0xa5, 0xab, 0x7d, 0xd4, 0xb9, 0xfb, 0x14, 0x5c, 0x4d, 0x42, 0x7f, 0xec, 0xa7, 0xb5, 0x6d, 0x40,
+	0x45, 0x0d, 0xf8, 0xd8, 0x44, 0x34, 0x92, 0x24, 0x49, 0x9e, 0x72, 0x77, 0x58, 0x19, 0xbd, 0x07,
+	0x35, 0x13, 0x36, 0xf6, 0x8a, 0xaa, 0xe4, 0x96, 0x2e, 0xd9, 0xa0, 0xd8, 0xaa, 0xbb, 0x08, 0x5c,
+	0x4c, 0x05, 0x65, 0xb2, 0x40, 0x13, 0xb2, 0xfb, 0xa2, 0x00, 0xd5, 0x34, 0x7c, 0x07, 0x1a, 0x03,
+	0x1a, 0xfb, 0x3c, 0x54, 0x14, 0x98, 0x1c, 0xf2, 0x90, 0x24, 0xdf, 0xdc, 0x76, 0x30, 0x50, 0x99,
+	0xb4, 0x70, 0x06, 0xc8, 0xdf, 0x07, 0x23, 0x98, 0x11, 0x4a, 0x45, 0xd5, 0xe5, 0x98, 0x72, 0x46,
+	0xcc, 0x04, 0xd5, 0xb1, 0x95, 0xb3, 0xcd, 0x52, 0xce, 0x6d, 0x16, 0xf4, 0x31, 0xd4, 0x6d, 0xcf,
+	0xcc, 0xcb, 0xd8, 0xf8, 0xf7, 0x56, 0xde, 0x5b, 0xc3, 0x99, 0xa9, 0xf4, 0xb3, 0x35, 0x7a, 0x8d,
+	0xbc, 0xdf, 0x6a, 0xe9, 0xd2, 0xcf, 0x62, 0x2a, 0x5e, 0xda, 0x0e, 0xaf, 0xb9, 0x14, 0x6f, 0xa5,
+	0x4b, 0x2a, 0x5e, 0x8a, 0xdd, 0xad, 0x5b, 0xfa, 0xba, 0x7f, 0x97, 0x00, 0x06, 0x84, 0xce, 0xde,
+	0xe8, 0xbb, 0x5c, 0x62, 0xbc, 0xf8, 0x3f, 0x8c, 0x97, 0x96, 0x19, 0xdf, 0xd6, 0x23, 0x33, 0x5c,
+	0xcc, 0xa9, 0x57, 0x5e, 0xfd, 0x5d, 0x97, 0x28, 0xb6, 0xfa, 0x95, 0x1d, 0x59, 0x79, 0x6d, 0x47,
+	0xbe, 0xaf, 0xf5, 0x66, 0xe3, 0x56, 0xff, 0x63, 0xe3, 0xe6, 0x6c, 0xd0, 0x97, 0xab, 0xfb, 0xb3,
+	0xa6, 0x0a, 0x6e, 0xf7, 0xf4, 0xf7, 0x4b, 0x2f, 0xfd, 0x7e, 0xe9, 0x0d, 0xd3, 0xef, 0x97, 0xbb,
+	0x35, 0x59, 0xf8, 0x77, 0xbf, 0x5e, 0x77, 0x56, 0xb7, 0xec, 0xbb, 0x96, 0x61, 0xb5, 0x45, 0xed,
+	0x7c, 0x1b, 0x10, 0xa7, 0x5a, 0xf4, 0x79, 0x6e, 0xcd, 0xc0, 0x05, 0xe2, 0x59, 0x2f, 0x79, 0x83,
+	0x5d, 0x46, 0x8d, 0x8b, 0xdc, 0x90, 0x7a, 0xa1, 0x3b, 0x50, 0xde, 0x67, 0x72, 0xe1, 0x37, 0x2f,
+	0xe0, 0xae, 0x5d, 0xd0, 0x67, 0x50, 0x95, 0x95, 0xe3, 0x84, 0x79, 0xad, 0x0b, 0x78, 0xa7, 0x4e,
+	0xdb, 0x3f, 0x39, 0xf9, 0x3e, 0xa1, 0x06, 0x54, 0x75, 0x61, 0x63, 0x77, 0x4d, 0x0a, 0xb2, 0x99,
+	0x21, 0x0b, 0x5c, 0x07, 0xb5, 0xa0, 0x6e, 0x7f, 0x88, 0xdc, 0x02, 0x02, 0xa8, 0x3c, 0x22, 0x49,
+	0x4c, 0xc7, 0x6e, 0x11, 0xd5, 0xcd, 0x63, 0x74, 0x4b, 0xa8, 0x09, 0xb5, 0x3e, 0x61, 0x3e, 0x9d,
+	0xd2, 0xb1, 0x5b, 0x46, 0x57, 0xe0, 0x92, 0xfc, 0xf1, 0x99, 0x51, 0x4c, 0x9f, 0x26, 0x34, 0x96,
+	0x9e, 0x15, 0x84, 0x60, 0x5d, 0x79, 0x66, 0x58, 0x55, 0x1a, 0x6a, 0xb7, 0x0c, 0xac, 0xa1, 0xab,
+	0x72, 0xf3, 0xc4, 0x82, 0x70, 0x91, 0xa1, 0xf5, 0xed, 0x1f, 0x1c, 0x3d, 0xa4, 0xea, 0x03, 0xa3,
+	0x09, 0xb5, 0x21, 0x8d, 0xc5, 0x43, 0x36, 0x5d, 0xb8, 0x6b, 0x68, 0x1d, 0xe0, 0x78, 0x11, 0x0b,
+	0x3a, 0x3b, 0x60, 0xa1, 0x70, 0x1d, 0x19, 0xe9, 0x88, 0x0a, 0x1e, 0xfa, 0x87, 0x51, 0x70, 0x44,
+	0x79, 0x40, 0xdd, 0x02, 0xda, 0x00, 0xa4, 0xb1, 0x63, 0x11, 0x71, 0x12, 0xd0, 0x93, 0x98, 0x04,
+	0xd4, 0x2d, 0x4a, 0xdc, 0xee, 0x83, 0xfb, 0xe4, 0xf1, 0x84, 0x1c, 0x87, 0x6c, 0xe2, 0x96, 0xd0,
+	0x25, 0x68, 0x28, 0xd7, 0x87, 0xa3, 0x6f, 0xa8, 0x2f, 0xdc, 0xb2, 0xe4, 0xc1, 0x2e, 0x00, 0xb7,
+	0x22, 0x39, 0x92, 0xd1, 0xfa, 0x63, 0xdf, 0xad, 0xca, 0x74, 0x8e, 0xbf, 0x3a, 0xdc, 0x7f, 0x46,
+	0x99, 0x70, 0x6b, 0xdb, 0xef, 0x00, 0x64, 0x5f, 0x44, 0xd2, 0xf0, 0x38, 0xf1, 0x7d, 0x1a, 0xc7,
+	0xee, 0x9a, 0x64, 0xef, 0x0b, 0x12, 0x4a, 0x92, 0x9c, 0xed, 0xe7, 0x4e, 0xf6, 0xea, 0xd0, 0x35,
+	0xa8, 0x9e, 0xb0, 0x09, 0x8b, 0xbe, 0x65, 0xee, 0x5a, 0xfb, 0xd2, 0xd9, 0x79, 0xa7, 0x21, 0x61,
+	0x03, 0xa1, 0x5d, 0x40, 0x36, 0x37, 0x9b, 0xad, 0xeb, 0xb4, 0xdb, 0x67, 0xe7, 0x9d, 0x0d, 0x69,
+	0xf8, 0xba, 0xd6, 0x7c, 0xfc, 0xea, 0x7c, 0xa5, 0x89, 0x5b, 0x68, 0x5f, 0x3e, 0x3b, 0xef, 0xb4,
+	0xe4, 0xd9, 0x2a, 0xe4, 0xc6, 0xb0, 0xeb, 0xc9, 0x2d, 0xb6, 0x5b, 0x67, 0xe7, 0x9d, 0xdc, 0xbe,
+	0xea, 0xbf, 0xfc, 0x7d, 0xd3, 0x79, 0xf1, 0x6a, 0xd3, 0x79, 0xf9, 0x6a, 0xd3, 0xf9, 0xed, 0xd5,
+	0xe6, 0xda, 0xf3, 0x3f, 0x36, 0x9d, 0xaf, 0xf3, 0x7f, 0x43, 0x66, 0x44, 0xf0, 0xf0, 0x34, 0xe2,
+	0x61, 0x10, 0xb2, 0x54, 0x60, 0x74, 0x67, 0x3e, 0x09, 0x76, 0xe6, 0xa3, 0x1d, 0xf9, 0xf4, 0x46,
+	0x15, 0x35, 0x90, 0x1f, 0xfe, 0x33, 0x00, 0x15, 0x98, 0xf4, 0xb0, 0xd0, 0x0c, 0x00, 0x00,
 }
 
 func (m *TaskMetadata) Marshal() (dAtA []byte, err error) {
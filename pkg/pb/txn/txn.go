@@ -140,6 +140,11 @@ func (m TxnMeta) DebugString() string {
 		buffer.WriteString(m.CommitTS.DebugString())
 	}
 
+	if len(m.XID) > 0 {
+		buffer.WriteString("/X:")
+		buffer.WriteString(hex.EncodeToString(m.XID))
+	}
+
 	n := len(m.TNShards)
 	var buf bytes.Buffer
 	buf.WriteString("/<")
@@ -170,6 +175,8 @@ func (m TxnRequest) GetTargetTN() metadata.TNShard {
 		return m.CommitTNShardRequest.TNShard
 	case TxnMethod_RollbackTNShard:
 		return m.RollbackTNShardRequest.TNShard
+	case TxnMethod_XARecover:
+		return m.XARecoverRequest.TNShard
 	default:
 		panic(fmt.Sprintf("unknown txn request method: %v", m.Method))
 	}
@@ -194,19 +194,22 @@ const (
 	TxnMethod_RemoveMedata TxnMethod = 8
 	// DEBUG used to send debug request from cn to tn, and received response from tn to cn
 	TxnMethod_DEBUG TxnMethod = 9
+	// XARecover list the XA transaction branches in prepared state on a TN
+	TxnMethod_XARecover TxnMethod = 10
 )
 
 var TxnMethod_name = map[int32]string{
-	0: "Read",
-	1: "Write",
-	2: "Commit",
-	3: "Rollback",
-	4: "Prepare",
-	5: "GetStatus",
-	6: "CommitTNShard",
-	7: "RollbackTNShard",
-	8: "RemoveMedata",
-	9: "DEBUG",
+	0:  "Read",
+	1:  "Write",
+	2:  "Commit",
+	3:  "Rollback",
+	4:  "Prepare",
+	5:  "GetStatus",
+	6:  "CommitTNShard",
+	7:  "RollbackTNShard",
+	8:  "RemoveMedata",
+	9:  "DEBUG",
+	10: "XARecover",
 }
 
 var TxnMethod_value = map[string]int32{
@@ -220,6 +223,7 @@ var TxnMethod_value = map[string]int32{
 	"RollbackTNShard": 7,
 	"RemoveMedata":    8,
 	"DEBUG":           9,
+	"XARecover":       10,
 }
 
 func (x TxnMethod) String() string {
@@ -260,7 +264,10 @@ type TxnMeta struct {
 	// Mirror is mirror is true, means the current txn is not created on current node.
 	Mirror bool `protobuf:"varint,10,opt,name=Mirror,proto3" json:"Mirror,omitempty"`
 	// LockService lock service's service address. Empty if is not pessimistic txn.
-	LockService          string   `protobuf:"bytes,11,opt,name=LockService,proto3" json:"LockService,omitempty"`
+	LockService string `protobuf:"bytes,11,opt,name=LockService,proto3" json:"LockService,omitempty"`
+	// XID the xid of the XA transaction branch, set when the txn is prepared by XA PREPARE.
+	// The prepared XA txn is kept on the TN until XA COMMIT or XA ROLLBACK.
+	XID                  []byte   `protobuf:"bytes,12,opt,name=XID,proto3" json:"XID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -376,6 +383,13 @@ func (m *TxnMeta) GetLockService() string {
 	return ""
 }
 
+func (m *TxnMeta) GetXID() []byte {
+	if m != nil {
+		return m.XID
+	}
+	return nil
+}
+
 // CNTxnSnapshot snapshot of the cn txn operation.
 type CNTxnSnapshot struct {
 	// ID txn id
@@ -628,10 +642,12 @@ type TxnRequest struct {
 	// TxnRemoveMetadataRequest  corresponds to TxnMethod.RemoveMetadata
 	RemoveMetadata *TxnRemoveMetadataRequest `protobuf:"bytes,12,opt,name=RemoveMetadata,proto3" json:"RemoveMetadata,omitempty"`
 	// TxnRequestOptions request options
-	Options              *TxnRequestOptions `protobuf:"bytes,13,opt,name=Options,proto3" json:"Options,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Options *TxnRequestOptions `protobuf:"bytes,13,opt,name=Options,proto3" json:"Options,omitempty"`
+	// TxnXARecoverRequest corresponds to TxnMethod.XARecover
+	XARecoverRequest     *TxnXARecoverRequest `protobuf:"bytes,14,opt,name=XARecoverRequest,proto3" json:"XARecoverRequest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *TxnRequest) Reset()         { *m = TxnRequest{} }
@@ -758,6 +774,13 @@ func (m *TxnRequest) GetOptions() *TxnRequestOptions {
 	return nil
 }
 
+func (m *TxnRequest) GetXARecoverRequest() *TxnXARecoverRequest {
+	if m != nil {
+		return m.XARecoverRequest
+	}
+	return nil
+}
+
 // TxnRequestOptions txn options
 type TxnRequestOptions struct {
 	// RetryCodes when TN processes TxnRequest and encounters the specified error, it needs to retry
@@ -847,10 +870,12 @@ type TxnResponse struct {
 	// TxnRollbackTNShardResponse corresponds to TxnMethod.RollbackTNShard response
 	RollbackTNShardResponse *TxnRollbackTNShardResponse `protobuf:"bytes,12,opt,name=RollbackTNShardResponse,proto3" json:"RollbackTNShardResponse,omitempty"`
 	// TxnRemoveMetadataResponse  corresponds to TxnMethod.RemoveMetadata
-	RemoveMetadata       *TxnRemoveMetadataResponse `protobuf:"bytes,13,opt,name=RemoveMetadata,proto3" json:"RemoveMetadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	RemoveMetadata *TxnRemoveMetadataResponse `protobuf:"bytes,13,opt,name=RemoveMetadata,proto3" json:"RemoveMetadata,omitempty"`
+	// TxnXARecoverResponse corresponds to TxnMethod.XARecover response
+	XARecoverResponse    *TxnXARecoverResponse `protobuf:"bytes,14,opt,name=XARecoverResponse,proto3" json:"XARecoverResponse,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *TxnResponse) Reset()         { *m = TxnResponse{} }
@@ -977,13 +1002,23 @@ func (m *TxnResponse) GetRemoveMetadata() *TxnRemoveMetadataResponse {
 	return nil
 }
 
+func (m *TxnResponse) GetXARecoverResponse() *TxnXARecoverResponse {
+	if m != nil {
+		return m.XARecoverResponse
+	}
+	return nil
+}
+
 // TxnCommitRequest CN sent the commit request to coordinator TN.
 type TxnCommitRequest struct {
-	Payload              []*TxnRequest `protobuf:"bytes,1,rep,name=Payload,proto3" json:"Payload,omitempty"`
-	Disable1PCOpt        bool          `protobuf:"varint,2,opt,name=Disable1PCOpt,proto3" json:"Disable1PCOpt,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Payload       []*TxnRequest `protobuf:"bytes,1,rep,name=Payload,proto3" json:"Payload,omitempty"`
+	Disable1PCOpt bool          `protobuf:"varint,2,opt,name=Disable1PCOpt,proto3" json:"Disable1PCOpt,omitempty"`
+	// XAPrepare prepare the txn as a XA transaction branch instead of committing it.
+	// TxnMeta.XID is the xid of the branch.
+	XAPrepare            bool     `protobuf:"varint,3,opt,name=XAPrepare,proto3" json:"XAPrepare,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *TxnCommitRequest) Reset()         { *m = TxnCommitRequest{} }
@@ -1033,6 +1068,13 @@ func (m *TxnCommitRequest) GetDisable1PCOpt() bool {
 	return false
 }
 
+func (m *TxnCommitRequest) GetXAPrepare() bool {
+	if m != nil {
+		return m.XAPrepare
+	}
+	return false
+}
+
 // TxnCommitResponse response of TxnCommitRequest.
 type TxnCommitResponse struct {
 	InvalidLockTables    []uint64 `protobuf:"varint,1,rep,packed,name=InvalidLockTables,proto3" json:"InvalidLockTables,omitempty"`
@@ -1609,6 +1651,104 @@ func (m *TxnRemoveMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TxnRemoveMetadataResponse proto.InternalMessageInfo
 
+// TxnXARecoverRequest list the prepared XA transaction branches on TNShard
+type TxnXARecoverRequest struct {
+	// TNShard target TN
+	TNShard              metadata.TNShard `protobuf:"bytes,1,opt,name=TNShard,proto3" json:"TNShard"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TxnXARecoverRequest) Reset()         { *m = TxnXARecoverRequest{} }
+func (m *TxnXARecoverRequest) String() string { return proto.CompactTextString(m) }
+func (*TxnXARecoverRequest) ProtoMessage()    {}
+func (*TxnXARecoverRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f782e76b37adb9a, []int{21}
+}
+func (m *TxnXARecoverRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxnXARecoverRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxnXARecoverRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxnXARecoverRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnXARecoverRequest.Merge(m, src)
+}
+func (m *TxnXARecoverRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TxnXARecoverRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnXARecoverRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxnXARecoverRequest proto.InternalMessageInfo
+
+func (m *TxnXARecoverRequest) GetTNShard() metadata.TNShard {
+	if m != nil {
+		return m.TNShard
+	}
+	return metadata.TNShard{}
+}
+
+// TxnXARecoverResponse response of TxnXARecoverRequest
+type TxnXARecoverResponse struct {
+	// Txns the metadata of the prepared XA transaction branches
+	Txns                 []TxnMeta `protobuf:"bytes,1,rep,name=Txns,proto3" json:"Txns"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *TxnXARecoverResponse) Reset()         { *m = TxnXARecoverResponse{} }
+func (m *TxnXARecoverResponse) String() string { return proto.CompactTextString(m) }
+func (*TxnXARecoverResponse) ProtoMessage()    {}
+func (*TxnXARecoverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f782e76b37adb9a, []int{22}
+}
+func (m *TxnXARecoverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxnXARecoverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxnXARecoverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxnXARecoverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnXARecoverResponse.Merge(m, src)
+}
+func (m *TxnXARecoverResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TxnXARecoverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnXARecoverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxnXARecoverResponse proto.InternalMessageInfo
+
+func (m *TxnXARecoverResponse) GetTxns() []TxnMeta {
+	if m != nil {
+		return m.Txns
+	}
+	return nil
+}
+
 // TxnError all explicit errors in transaction operations.
 type TxnError struct {
 	// Code moerr code, used to special error handle without unmarshal moerr
@@ -1633,7 +1773,7 @@ func (m *TxnError) Reset()         { *m = TxnError{} }
 func (m *TxnError) String() string { return proto.CompactTextString(m) }
 func (*TxnError) ProtoMessage()    {}
 func (*TxnError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f782e76b37adb9a, []int{21}
+	return fileDescriptor_4f782e76b37adb9a, []int{23}
 }
 func (m *TxnError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1710,7 +1850,7 @@ func (m *TxnOptions) Reset()         { *m = TxnOptions{} }
 func (m *TxnOptions) String() string { return proto.CompactTextString(m) }
 func (*TxnOptions) ProtoMessage()    {}
 func (*TxnOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f782e76b37adb9a, []int{22}
+	return fileDescriptor_4f782e76b37adb9a, []int{24}
 }
 func (m *TxnOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1884,6 +2024,8 @@ func init() {
 	proto.RegisterType((*TxnRollbackTNShardResponse)(nil), "txn.TxnRollbackTNShardResponse")
 	proto.RegisterType((*TxnRemoveMetadataRequest)(nil), "txn.TxnRemoveMetadataRequest")
 	proto.RegisterType((*TxnRemoveMetadataResponse)(nil), "txn.TxnRemoveMetadataResponse")
+	proto.RegisterType((*TxnXARecoverRequest)(nil), "txn.TxnXARecoverRequest")
+	proto.RegisterType((*TxnXARecoverResponse)(nil), "txn.TxnXARecoverResponse")
 	proto.RegisterType((*TxnError)(nil), "txn.TxnError")
 	proto.RegisterType((*TxnOptions)(nil), "txn.TxnOptions")
 }
@@ -1891,106 +2033,111 @@ func init() {
 func init() { proto.RegisterFile("txn.proto", fileDescriptor_4f782e76b37adb9a) }
 
 var fileDescriptor_4f782e76b37adb9a = []byte{
-	// 1577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0x36, 0x75, 0x25, 0x8f, 0x2e, 0xa6, 0x26, 0x8e, 0xc3, 0xf8, 0xcf, 0xaf, 0x08, 0x44, 0x10,
-	0x28, 0x46, 0x7e, 0xeb, 0x4f, 0x82, 0x74, 0xd1, 0x02, 0x01, 0x6c, 0xd9, 0x4e, 0x05, 0x44, 0xb2,
-	0x31, 0x52, 0x5a, 0xa4, 0x9b, 0x82, 0x92, 0x26, 0x32, 0x61, 0x89, 0x54, 0xc8, 0x91, 0x21, 0x3f,
-	0x4b, 0x37, 0xed, 0x2b, 0xf4, 0x29, 0xb2, 0xcc, 0x13, 0x14, 0x6d, 0x8a, 0x6e, 0xba, 0xed, 0x0b,
-	0x14, 0x33, 0x9c, 0xe1, 0x4d, 0x52, 0x52, 0xb8, 0x2b, 0xf1, 0xdc, 0xbe, 0x33, 0x3c, 0x73, 0xbe,
-	0x33, 0x1c, 0x81, 0x46, 0x97, 0xce, 0xc1, 0xdc, 0x73, 0xa9, 0x8b, 0xb2, 0x74, 0xe9, 0xec, 0xfd,
-	0x6f, 0x62, 0xd3, 0x8b, 0xc5, 0xf0, 0x60, 0xe4, 0xce, 0x5a, 0x13, 0x77, 0xe2, 0xb6, 0xb8, 0x6d,
-	0xb8, 0x78, 0xcb, 0x25, 0x2e, 0xf0, 0xa7, 0x20, 0x66, 0x6f, 0x9b, 0xda, 0x33, 0xe2, 0x53, 0x6b,
-	0x36, 0x17, 0x8a, 0xea, 0x8c, 0x50, 0x6b, 0x6c, 0x51, 0x4b, 0xc8, 0x30, 0x75, 0x47, 0x97, 0xc1,
-	0xb3, 0xf9, 0x67, 0x16, 0x8a, 0x83, 0xa5, 0xd3, 0x25, 0xd4, 0x42, 0x55, 0xc8, 0x74, 0x8e, 0x0d,
-	0xa5, 0xa1, 0x34, 0xcb, 0x38, 0xd3, 0x39, 0x46, 0x0f, 0xa1, 0xd0, 0xa7, 0x16, 0x5d, 0xf8, 0x46,
-	0xa6, 0xa1, 0x34, 0xab, 0x4f, 0xab, 0x07, 0x6c, 0x61, 0x83, 0xa5, 0x13, 0x68, 0xb1, 0xb0, 0xa2,
-	0x2f, 0x01, 0xfa, 0x8e, 0x35, 0xf7, 0x2f, 0x5c, 0x3a, 0xe8, 0x1b, 0xd9, 0x86, 0xd2, 0x2c, 0x3d,
-	0xdd, 0x39, 0x88, 0x56, 0x31, 0x90, 0x4f, 0x47, 0xb9, 0xf7, 0xbf, 0xdc, 0xdf, 0xc2, 0x31, 0x6f,
-	0x16, 0x7b, 0xee, 0x91, 0xb9, 0xe5, 0x91, 0xf1, 0xa0, 0x6f, 0xe4, 0x3e, 0x1f, 0x1b, 0x79, 0xa3,
-	0x2f, 0x40, 0x6d, 0xbb, 0xb3, 0x99, 0xcd, 0xb2, 0xe6, 0x3f, 0x1b, 0x19, 0xfa, 0xa2, 0x67, 0xa0,
-	0x0e, 0x7a, 0xfd, 0x0b, 0xcb, 0x1b, 0xfb, 0x46, 0xa1, 0x91, 0x6d, 0x96, 0x9e, 0xd6, 0x0e, 0xc2,
-	0x12, 0x09, 0x8b, 0x0c, 0x92, 0x8e, 0xe8, 0x39, 0xc0, 0x2b, 0x77, 0x74, 0x39, 0xb0, 0x86, 0x53,
-	0xe2, 0x1b, 0x45, 0x1e, 0xb6, 0x7d, 0xc0, 0x2b, 0x19, 0xea, 0xe5, 0x1a, 0x23, 0x47, 0xd4, 0x80,
-	0x5c, 0xd7, 0x1d, 0x13, 0x43, 0xe5, 0x15, 0x2c, 0xcb, 0x0a, 0x32, 0x1d, 0xe6, 0x16, 0xd4, 0x02,
-	0xad, 0xe3, 0xbb, 0x53, 0x8b, 0xda, 0xae, 0x63, 0x68, 0xdc, 0xad, 0x26, 0xdd, 0x42, 0x03, 0x8e,
-	0x7c, 0xd0, 0x2e, 0x14, 0xba, 0xb6, 0xe7, 0xb9, 0x9e, 0x01, 0x0d, 0xa5, 0xa9, 0x62, 0x21, 0xa1,
-	0x06, 0x94, 0x58, 0xe2, 0x3e, 0xf1, 0xae, 0xec, 0x11, 0x31, 0x4a, 0x0d, 0xa5, 0xa9, 0xe1, 0xb8,
-	0xca, 0xfc, 0x29, 0x03, 0x95, 0x76, 0x8f, 0x6d, 0xa0, 0xd8, 0x00, 0xf4, 0x00, 0xb2, 0x83, 0xa5,
-	0xc3, 0xf7, 0xbc, 0x14, 0x5b, 0x1d, 0xa1, 0x96, 0x78, 0x17, 0x66, 0x46, 0xf7, 0x40, 0xc3, 0xc4,
-	0x1a, 0x5f, 0x9f, 0x39, 0xd3, 0x6b, 0xde, 0x0b, 0x2a, 0x8e, 0x14, 0x68, 0x1f, 0xf4, 0x13, 0x87,
-	0xbd, 0x6d, 0xdb, 0x1a, 0x5d, 0x90, 0x6f, 0x3d, 0x9b, 0x12, 0xde, 0x04, 0x2a, 0x5e, 0xd1, 0xa3,
-	0x07, 0x50, 0x39, 0xb6, 0x7d, 0xa6, 0x7c, 0x72, 0xde, 0x3e, 0x9b, 0x53, 0xbe, 0xe3, 0x2a, 0x4e,
-	0x2a, 0x53, 0xb5, 0xce, 0xff, 0xd3, 0x5a, 0xb7, 0xa0, 0x78, 0x36, 0x67, 0x25, 0x62, 0xdb, 0xaa,
-	0xf0, 0x18, 0xf1, 0x42, 0x42, 0x2d, 0x62, 0xa4, 0x17, 0x42, 0x90, 0x3b, 0x9d, 0x5a, 0x13, 0xa3,
-	0xd8, 0x50, 0x9a, 0x15, 0xcc, 0x9f, 0xcd, 0x39, 0x94, 0xda, 0xbd, 0xb3, 0x39, 0x26, 0xef, 0x16,
-	0xc4, 0xa7, 0xac, 0xd8, 0x67, 0xf3, 0x36, 0xdb, 0x41, 0x85, 0x3b, 0x09, 0x09, 0x19, 0x50, 0x3c,
-	0xb7, 0xae, 0xa7, 0xae, 0x35, 0xe6, 0x05, 0x29, 0x63, 0x29, 0xa2, 0x16, 0x14, 0x06, 0x96, 0x37,
-	0x21, 0x54, 0x30, 0x61, 0x63, 0x6f, 0x09, 0x37, 0xb3, 0x09, 0xe5, 0x20, 0xa3, 0x3f, 0x77, 0x1d,
-	0x3f, 0x01, 0xad, 0x24, 0xa0, 0xcd, 0x3f, 0xf2, 0x00, 0x83, 0xa5, 0x23, 0xd7, 0xc6, 0xb7, 0x85,
-	0x3f, 0x0a, 0xda, 0xe6, 0x70, 0xa4, 0x90, 0x5b, 0x9b, 0xf9, 0xf4, 0xd6, 0x3e, 0x84, 0x42, 0x97,
-	0xd0, 0x0b, 0x77, 0xcc, 0x57, 0x1b, 0xe3, 0x78, 0xa0, 0xc5, 0xc2, 0x1a, 0x96, 0x2a, 0x17, 0x95,
-	0x0a, 0x1d, 0x80, 0xd6, 0xee, 0x89, 0x84, 0x82, 0x80, 0x3a, 0x0f, 0x8f, 0x15, 0x10, 0x47, 0x2e,
-	0xe8, 0x2b, 0xa8, 0x04, 0x1c, 0x94, 0x31, 0xc1, 0x2e, 0xdd, 0x96, 0x29, 0x13, 0x46, 0x9c, 0xf4,
-	0x45, 0x87, 0xb0, 0x8d, 0xdd, 0xe9, 0x74, 0x68, 0x8d, 0x2e, 0x65, 0x78, 0x91, 0x87, 0xdf, 0x91,
-	0xe1, 0x29, 0x33, 0x4e, 0xfb, 0xa3, 0x17, 0x50, 0x15, 0xd3, 0x43, 0x22, 0xa8, 0x1c, 0x61, 0x57,
-	0x22, 0x24, 0xad, 0x38, 0xe5, 0x8d, 0x8e, 0x41, 0x7f, 0x49, 0xa8, 0x18, 0x7e, 0x02, 0x41, 0xe3,
-	0x08, 0x86, 0x44, 0x48, 0xdb, 0xf1, 0x4a, 0x04, 0x3a, 0x87, 0x1d, 0x31, 0x89, 0x82, 0x6e, 0x90,
-	0x48, 0xc0, 0x91, 0xee, 0x25, 0x8b, 0x91, 0xf4, 0xc1, 0x6b, 0x23, 0xd1, 0x37, 0xb0, 0x2b, 0x5f,
-	0x35, 0x85, 0x59, 0xe2, 0x98, 0xf5, 0x74, 0x85, 0x52, 0xa8, 0x1b, 0xa2, 0xd1, 0x09, 0x54, 0x31,
-	0x99, 0xb9, 0x57, 0xa4, 0x2b, 0x1a, 0xd8, 0x28, 0x73, 0xbc, 0xff, 0x86, 0x78, 0x09, 0x6b, 0x58,
-	0xb6, 0xa4, 0x1a, 0xfd, 0x3f, 0xa2, 0x65, 0x25, 0x59, 0x6f, 0x11, 0x21, 0xac, 0x21, 0x2f, 0xcd,
-	0x37, 0x50, 0x5b, 0xb1, 0xa2, 0x3a, 0x00, 0x26, 0xd4, 0xbb, 0x66, 0xf4, 0xf3, 0x0d, 0xa5, 0x91,
-	0x6d, 0xe6, 0x71, 0x4c, 0xc3, 0x46, 0x0b, 0x97, 0x3a, 0x0e, 0x25, 0xde, 0x95, 0x35, 0xe5, 0x9d,
-	0x9f, 0xc5, 0x49, 0xa5, 0xf9, 0x57, 0x1e, 0x4a, 0x1c, 0x5b, 0x90, 0xed, 0xd3, 0x1c, 0xaa, 0x6f,
-	0xe4, 0xd0, 0xbf, 0x67, 0xcf, 0x23, 0x50, 0x07, 0x4b, 0xe7, 0x84, 0x0f, 0xf2, 0x80, 0x3c, 0x15,
-	0x19, 0xcd, 0x95, 0x38, 0x34, 0xa3, 0xe7, 0xc9, 0x09, 0x21, 0x78, 0x53, 0x8b, 0x71, 0x2d, 0x30,
-	0xe0, 0xe4, 0x20, 0x79, 0x01, 0x55, 0xc9, 0x21, 0x11, 0x58, 0x4c, 0xd6, 0x3f, 0x69, 0xc5, 0x29,
-	0x6f, 0xd6, 0xef, 0x11, 0x85, 0x04, 0x82, 0x9a, 0xec, 0xf7, 0xb4, 0x1d, 0xaf, 0x44, 0x30, 0xe2,
-	0x86, 0x3c, 0x12, 0x20, 0x5a, 0x92, 0xb8, 0x29, 0x33, 0x4e, 0xfb, 0xa3, 0x97, 0x50, 0x8b, 0xd1,
-	0x48, 0x80, 0x04, 0x7c, 0xb9, 0xbb, 0x86, 0x79, 0x02, 0x66, 0x35, 0x06, 0xf5, 0xe1, 0x76, 0x8a,
-	0x41, 0x02, 0xac, 0x94, 0x6c, 0xec, 0xb5, 0x4e, 0x78, 0x7d, 0x2c, 0x7a, 0x03, 0x77, 0x56, 0x08,
-	0x24, 0x60, 0x03, 0xbe, 0xdc, 0xdf, 0xc8, 0x3f, 0x01, 0xbc, 0x29, 0x1e, 0x9d, 0xae, 0x30, 0xb0,
-	0x92, 0x62, 0x74, 0x8a, 0x81, 0x72, 0x27, 0x93, 0x7a, 0x73, 0x04, 0x7a, 0x7a, 0xbe, 0xa2, 0x47,
-	0xf1, 0x63, 0x26, 0x1b, 0x3f, 0x2d, 0x25, 0x91, 0xc3, 0x23, 0x6d, 0xe5, 0xd4, 0xce, 0xac, 0x39,
-	0xb5, 0xcd, 0x43, 0xce, 0xda, 0x54, 0x0f, 0x3d, 0x86, 0x5a, 0xc7, 0xb9, 0xb2, 0xa6, 0xf6, 0x38,
-	0x76, 0xa2, 0xb3, 0x7c, 0x39, 0xbc, 0x6a, 0x30, 0x77, 0x00, 0xad, 0x0e, 0x72, 0xf3, 0x36, 0xdc,
-	0x5a, 0xd3, 0x6a, 0xe6, 0x29, 0xcf, 0x97, 0x9a, 0xd1, 0x4f, 0xa0, 0x28, 0x8a, 0x28, 0x3e, 0x6a,
-	0x36, 0x1e, 0xbf, 0xd2, 0x4f, 0x24, 0x4d, 0xf5, 0x9c, 0xf9, 0x35, 0x4f, 0xba, 0x32, 0xbd, 0x6f,
-	0x80, 0xbf, 0x0b, 0x3b, 0xeb, 0xfa, 0xd3, 0x7c, 0x05, 0x77, 0x36, 0xcc, 0xf9, 0x9b, 0x64, 0xd9,
-	0x03, 0x63, 0x53, 0xe3, 0x9a, 0x3d, 0xb8, 0xbb, 0x71, 0xfa, 0xdf, 0x24, 0xd7, 0x3d, 0xd8, 0xdb,
-	0xdc, 0xcd, 0x66, 0x97, 0xaf, 0x64, 0xed, 0xd9, 0x70, 0x93, 0x64, 0xff, 0x09, 0x16, 0xbf, 0xb6,
-	0xd1, 0xcd, 0x41, 0x34, 0x44, 0xd9, 0x90, 0x8d, 0x7d, 0xa8, 0xf1, 0x67, 0xb4, 0x03, 0xf9, 0x60,
-	0xc2, 0x06, 0x1f, 0x69, 0x81, 0xc0, 0x8e, 0x92, 0x20, 0x8a, 0xfb, 0x67, 0xb9, 0x7f, 0x4c, 0x63,
-	0xfe, 0x9c, 0xe3, 0x0e, 0xf2, 0xe4, 0xd9, 0x03, 0xf5, 0x94, 0x58, 0x74, 0xe1, 0xf1, 0xd6, 0x65,
-	0xce, 0xa1, 0xcc, 0xee, 0x4c, 0xed, 0x1e, 0x47, 0xd7, 0x70, 0xa6, 0xdd, 0x63, 0xe7, 0x49, 0x9f,
-	0xf8, 0xbe, 0xed, 0x3a, 0x9d, 0x63, 0x8e, 0xac, 0xe1, 0x48, 0xc1, 0xac, 0x87, 0xa3, 0x91, 0xbb,
-	0x70, 0xd8, 0x69, 0x13, 0x1c, 0x06, 0x91, 0x02, 0x99, 0x50, 0x6e, 0xbb, 0x8e, 0x43, 0x46, 0x34,
-	0x08, 0xcf, 0x73, 0x87, 0x84, 0x8e, 0xad, 0xe5, 0xb5, 0x4f, 0xbc, 0x9e, 0x35, 0x0b, 0x8e, 0x01,
-	0x0d, 0x87, 0x32, 0x7a, 0x08, 0xd5, 0xfe, 0xa5, 0x3d, 0x4f, 0x5d, 0x53, 0x72, 0x38, 0xa5, 0x45,
-	0x2f, 0x00, 0x25, 0x34, 0x5d, 0x7e, 0xa2, 0xaa, 0x8d, 0x2c, 0x3f, 0xc1, 0xc2, 0xcf, 0x6c, 0x7e,
-	0x47, 0x59, 0xe3, 0xc9, 0x3e, 0x50, 0xf9, 0x92, 0x89, 0xc7, 0x27, 0xb9, 0x86, 0xa5, 0xc8, 0xae,
-	0x20, 0xbe, 0x78, 0x59, 0xe7, 0xad, 0xcb, 0x47, 0xb4, 0x86, 0xe3, 0x2a, 0xb6, 0x7e, 0xdb, 0xc1,
-	0x0b, 0xa7, 0xff, 0x6e, 0xca, 0x87, 0xae, 0x8a, 0x43, 0x39, 0xb0, 0x05, 0x1d, 0xcc, 0x27, 0x27,
-	0xb7, 0x05, 0x32, 0xdb, 0x32, 0x3b, 0xec, 0x38, 0x3e, 0x05, 0x55, 0x1c, 0xd3, 0xb0, 0x35, 0x0d,
-	0xaf, 0x8f, 0xc8, 0xc4, 0x76, 0x8c, 0x2a, 0x37, 0x4a, 0x91, 0x45, 0x5a, 0x0b, 0xea, 0x8e, 0x02,
-	0xdc, 0xed, 0x20, 0x32, 0xd2, 0x04, 0xc8, 0x1d, 0x67, 0xe4, 0xf5, 0xe9, 0x8c, 0x1a, 0xba, 0x44,
-	0x96, 0x1a, 0x56, 0xd5, 0x28, 0x0f, 0xf7, 0xa9, 0x71, 0x9f, 0x94, 0x76, 0xbf, 0x0e, 0xe5, 0xf8,
-	0x8d, 0x0d, 0x15, 0x20, 0xd3, 0xef, 0xe8, 0x5b, 0xec, 0x17, 0xb7, 0x75, 0x65, 0x7f, 0x3f, 0xb8,
-	0x68, 0xb3, 0xae, 0xac, 0x02, 0xb0, 0xde, 0x9a, 0xd9, 0x3e, 0xb5, 0x47, 0xfa, 0x16, 0xda, 0x86,
-	0xd2, 0x39, 0xab, 0x91, 0x50, 0x28, 0xfb, 0xdf, 0x83, 0x16, 0x5e, 0xb3, 0x11, 0x40, 0xe1, 0x70,
-	0x44, 0xed, 0x2b, 0xa2, 0x6f, 0xa1, 0x32, 0xa8, 0xf2, 0x02, 0xac, 0x2b, 0x0c, 0x27, 0x28, 0x0f,
-	0xb5, 0x9d, 0x89, 0x9e, 0x41, 0x15, 0xd0, 0x84, 0x4c, 0xc6, 0x7a, 0x96, 0x39, 0x1f, 0x0e, 0x5d,
-	0x8f, 0x1b, 0x73, 0xa8, 0x04, 0x45, 0x2e, 0x91, 0xb1, 0x9e, 0xdf, 0xff, 0x41, 0xe1, 0x19, 0xc4,
-	0xe7, 0x89, 0x0a, 0x39, 0x76, 0x9d, 0xd3, 0xb7, 0x90, 0x06, 0x79, 0x7e, 0x51, 0xd3, 0x15, 0x96,
-	0x36, 0x00, 0xd3, 0x33, 0x0c, 0x49, 0xbe, 0xab, 0x9e, 0x65, 0x48, 0x62, 0x11, 0x7a, 0x8e, 0xe5,
-	0x0c, 0x47, 0x9b, 0x9e, 0x47, 0x35, 0xf9, 0x8d, 0x2f, 0xe8, 0xab, 0x17, 0xd0, 0xad, 0xe8, 0xcb,
-	0x5d, 0x2a, 0x8b, 0x48, 0x87, 0xb2, 0xa4, 0x34, 0x23, 0xb4, 0xae, 0xb2, 0xd4, 0xc7, 0x27, 0x47,
-	0xaf, 0x5f, 0xea, 0xda, 0xd1, 0xd1, 0x87, 0xdf, 0xea, 0xca, 0xfb, 0x8f, 0x75, 0xe5, 0xc3, 0xc7,
-	0xba, 0xf2, 0xeb, 0xc7, 0xfa, 0xd6, 0x8f, 0xbf, 0xd7, 0x95, 0xef, 0x1e, 0xc7, 0xfe, 0x06, 0x99,
-	0x59, 0xd4, 0xb3, 0x97, 0xae, 0x67, 0x4f, 0x6c, 0x47, 0x0a, 0x0e, 0x69, 0xcd, 0x2f, 0x27, 0xad,
-	0xf9, 0xb0, 0x45, 0x97, 0xce, 0xb0, 0xc0, 0xff, 0xdf, 0x78, 0xf6, 0x77, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x28, 0x6b, 0x1e, 0x0e, 0x4d, 0x11, 0x00, 0x00,
+	// 1662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x6e, 0xdb, 0xca,
+	0x15, 0x36, 0xf5, 0x4b, 0x1e, 0xfd, 0x98, 0x9a, 0x38, 0x0e, 0xe3, 0xba, 0x8a, 0x40, 0x04, 0x86,
+	0x62, 0xa4, 0x56, 0xe3, 0x20, 0x5d, 0xb4, 0x40, 0x00, 0x5b, 0xb2, 0x53, 0x01, 0xb1, 0x6c, 0x8c,
+	0x94, 0x36, 0xe9, 0xa6, 0xa0, 0xa4, 0x89, 0x4c, 0x58, 0x22, 0x15, 0x72, 0x64, 0xc8, 0xcb, 0xbe,
+	0x49, 0xbb, 0x6d, 0x77, 0x5d, 0xf7, 0x01, 0xb2, 0xcc, 0x13, 0x14, 0x6d, 0xee, 0x6b, 0xdc, 0xc5,
+	0xc5, 0x0c, 0x67, 0xf8, 0x27, 0x29, 0xb9, 0xf0, 0x5d, 0x89, 0xe7, 0xef, 0x3b, 0xc3, 0x73, 0xe6,
+	0x9b, 0x33, 0x14, 0x68, 0x74, 0xe9, 0x1c, 0xcd, 0x3d, 0x97, 0xba, 0x28, 0x4b, 0x97, 0xce, 0xde,
+	0x6f, 0x26, 0x36, 0xbd, 0x5e, 0x0c, 0x8f, 0x46, 0xee, 0xac, 0x35, 0x71, 0x27, 0x6e, 0x8b, 0xdb,
+	0x86, 0x8b, 0x8f, 0x5c, 0xe2, 0x02, 0x7f, 0x0a, 0x62, 0xf6, 0xb6, 0xa9, 0x3d, 0x23, 0x3e, 0xb5,
+	0x66, 0x73, 0xa1, 0xa8, 0xce, 0x08, 0xb5, 0xc6, 0x16, 0xb5, 0x84, 0x0c, 0x53, 0x77, 0x74, 0x13,
+	0x3c, 0x9b, 0x3f, 0x66, 0xa1, 0x38, 0x58, 0x3a, 0x17, 0x84, 0x5a, 0xa8, 0x0a, 0x99, 0x6e, 0xc7,
+	0x50, 0x1a, 0x4a, 0xb3, 0x8c, 0x33, 0xdd, 0x0e, 0x3a, 0x80, 0x42, 0x9f, 0x5a, 0x74, 0xe1, 0x1b,
+	0x99, 0x86, 0xd2, 0xac, 0x1e, 0x57, 0x8f, 0xd8, 0xc2, 0x06, 0x4b, 0x27, 0xd0, 0x62, 0x61, 0x45,
+	0xbf, 0x07, 0xe8, 0x3b, 0xd6, 0xdc, 0xbf, 0x76, 0xe9, 0xa0, 0x6f, 0x64, 0x1b, 0x4a, 0xb3, 0x74,
+	0xbc, 0x73, 0x14, 0xad, 0x62, 0x20, 0x9f, 0x4e, 0x73, 0x9f, 0xff, 0xfb, 0x64, 0x0b, 0xc7, 0xbc,
+	0x59, 0xec, 0x95, 0x47, 0xe6, 0x96, 0x47, 0xc6, 0x83, 0xbe, 0x91, 0xfb, 0x7e, 0x6c, 0xe4, 0x8d,
+	0x7e, 0x07, 0x6a, 0xdb, 0x9d, 0xcd, 0x6c, 0x96, 0x35, 0xff, 0xdd, 0xc8, 0xd0, 0x17, 0xbd, 0x04,
+	0x75, 0xd0, 0xeb, 0x5f, 0x5b, 0xde, 0xd8, 0x37, 0x0a, 0x8d, 0x6c, 0xb3, 0x74, 0x5c, 0x3b, 0x0a,
+	0x4b, 0x24, 0x2c, 0x32, 0x48, 0x3a, 0xa2, 0x57, 0x00, 0x6f, 0xdd, 0xd1, 0xcd, 0xc0, 0x1a, 0x4e,
+	0x89, 0x6f, 0x14, 0x79, 0xd8, 0xf6, 0x11, 0xaf, 0x64, 0xa8, 0x97, 0x6b, 0x8c, 0x1c, 0x51, 0x03,
+	0x72, 0x17, 0xee, 0x98, 0x18, 0x2a, 0xaf, 0x60, 0x59, 0x56, 0x90, 0xe9, 0x30, 0xb7, 0xa0, 0x16,
+	0x68, 0x5d, 0xdf, 0x9d, 0x5a, 0xd4, 0x76, 0x1d, 0x43, 0xe3, 0x6e, 0x35, 0xe9, 0x16, 0x1a, 0x70,
+	0xe4, 0x83, 0x76, 0xa1, 0x70, 0x61, 0x7b, 0x9e, 0xeb, 0x19, 0xd0, 0x50, 0x9a, 0x2a, 0x16, 0x12,
+	0x6a, 0x40, 0x89, 0x25, 0xee, 0x13, 0xef, 0xd6, 0x1e, 0x11, 0xa3, 0xd4, 0x50, 0x9a, 0x1a, 0x8e,
+	0xab, 0x90, 0x0e, 0xd9, 0xf7, 0xdd, 0x8e, 0x51, 0xe6, 0x1d, 0x66, 0x8f, 0xe6, 0x3f, 0x32, 0x50,
+	0x69, 0xf7, 0x58, 0x4b, 0x45, 0x4b, 0xd0, 0x53, 0xc8, 0x0e, 0x96, 0x0e, 0xdf, 0x05, 0xa5, 0xd8,
+	0x7a, 0x09, 0xb5, 0xc4, 0xdb, 0x31, 0x33, 0xda, 0x07, 0x0d, 0x13, 0x6b, 0x7c, 0x77, 0xe9, 0x4c,
+	0xef, 0xf8, 0xee, 0x50, 0x71, 0xa4, 0x40, 0x87, 0xa0, 0x9f, 0x39, 0xec, 0xfd, 0xdb, 0xd6, 0xe8,
+	0x9a, 0xfc, 0xd9, 0xb3, 0x29, 0xe1, 0xdb, 0x42, 0xc5, 0x2b, 0x7a, 0xf4, 0x14, 0x2a, 0x1d, 0xdb,
+	0x67, 0xca, 0x17, 0x57, 0xed, 0xcb, 0x39, 0xe5, 0x7b, 0x40, 0xc5, 0x49, 0x65, 0xaa, 0xfa, 0xf9,
+	0x9f, 0x5b, 0xfd, 0x16, 0x14, 0x2f, 0xe7, 0xac, 0x68, 0xac, 0xd1, 0x0a, 0x8f, 0x11, 0x2f, 0x24,
+	0xd4, 0x22, 0x46, 0x7a, 0x21, 0x04, 0xb9, 0xf3, 0xa9, 0x35, 0x31, 0x8a, 0x0d, 0xa5, 0x59, 0xc1,
+	0xfc, 0xd9, 0x9c, 0x43, 0xa9, 0xdd, 0xbb, 0x9c, 0x63, 0xf2, 0x69, 0x41, 0x7c, 0xca, 0xca, 0x7f,
+	0x39, 0x6f, 0xb3, 0x9e, 0x2a, 0xdc, 0x49, 0x48, 0xc8, 0x80, 0xe2, 0x95, 0x75, 0x37, 0x75, 0xad,
+	0x31, 0x2f, 0x48, 0x19, 0x4b, 0x11, 0xb5, 0xa0, 0x30, 0xb0, 0xbc, 0x09, 0xa1, 0x82, 0x1b, 0x1b,
+	0x77, 0x9b, 0x70, 0x33, 0x9b, 0x50, 0x0e, 0x32, 0xfa, 0x73, 0xd7, 0xf1, 0x13, 0xd0, 0x4a, 0x02,
+	0xda, 0xfc, 0x57, 0x01, 0x60, 0xb0, 0x74, 0xe4, 0xda, 0x78, 0x5b, 0xf8, 0xa3, 0x20, 0x72, 0x0e,
+	0x47, 0x0a, 0xd9, 0xda, 0xcc, 0xb7, 0x5b, 0x7b, 0x00, 0x85, 0x0b, 0x42, 0xaf, 0xdd, 0xb1, 0x91,
+	0x4d, 0xb2, 0x3e, 0xd0, 0x62, 0x61, 0x0d, 0x4b, 0x95, 0x8b, 0x4a, 0x85, 0x8e, 0x40, 0x6b, 0xf7,
+	0x44, 0x42, 0x41, 0x49, 0x9d, 0x87, 0xc7, 0x0a, 0x88, 0x23, 0x17, 0xf4, 0x07, 0xa8, 0x04, 0xac,
+	0x94, 0x31, 0x41, 0x97, 0x1e, 0xca, 0x94, 0x09, 0x23, 0x4e, 0xfa, 0xa2, 0x13, 0xd8, 0xc6, 0xee,
+	0x74, 0x3a, 0xb4, 0x46, 0x37, 0x32, 0xbc, 0xc8, 0xc3, 0x1f, 0xc9, 0xf0, 0x94, 0x19, 0xa7, 0xfd,
+	0xd1, 0x6b, 0xa8, 0x8a, 0xf3, 0x44, 0x22, 0xa8, 0x1c, 0x61, 0x57, 0x22, 0x24, 0xad, 0x38, 0xe5,
+	0x8d, 0x3a, 0xa0, 0xbf, 0x21, 0x54, 0x1c, 0x87, 0x02, 0x41, 0xe3, 0x08, 0x86, 0x44, 0x48, 0xdb,
+	0xf1, 0x4a, 0x04, 0xba, 0x82, 0x1d, 0x71, 0x36, 0x05, 0xbb, 0x41, 0x22, 0x01, 0x47, 0xda, 0x4f,
+	0x16, 0x23, 0xe9, 0x83, 0xd7, 0x46, 0xa2, 0x3f, 0xc1, 0xae, 0x7c, 0xd5, 0x14, 0x66, 0x89, 0x63,
+	0xd6, 0xd3, 0x15, 0x4a, 0xa1, 0x6e, 0x88, 0x46, 0x67, 0x50, 0xc5, 0x64, 0xe6, 0xde, 0x92, 0x0b,
+	0xb1, 0x81, 0xf9, 0x59, 0x52, 0x3a, 0xfe, 0x75, 0x88, 0x97, 0xb0, 0x86, 0x65, 0x4b, 0xaa, 0xd1,
+	0x6f, 0x23, 0x5a, 0x56, 0x92, 0xf5, 0x16, 0x11, 0xc2, 0x1a, 0xf1, 0xb2, 0x03, 0xfa, 0xfb, 0x13,
+	0x4c, 0x46, 0xee, 0x2d, 0xf1, 0xe4, 0xab, 0x54, 0x93, 0x85, 0x4e, 0xdb, 0xf1, 0x4a, 0x84, 0xf9,
+	0x01, 0x6a, 0x2b, 0x39, 0x50, 0x1d, 0x00, 0x13, 0xea, 0xdd, 0x31, 0x12, 0xfb, 0x86, 0xd2, 0xc8,
+	0x36, 0xf3, 0x38, 0xa6, 0x61, 0x07, 0x14, 0x97, 0xba, 0x0e, 0x25, 0xde, 0xad, 0x35, 0xe5, 0xfc,
+	0xc9, 0xe2, 0xa4, 0xd2, 0xfc, 0x4f, 0x01, 0x4a, 0x1c, 0x5b, 0x50, 0xf6, 0xdb, 0x4c, 0xac, 0x6f,
+	0x64, 0xe2, 0x2f, 0xe7, 0xe0, 0x33, 0x50, 0x07, 0x4b, 0xe7, 0x8c, 0x0f, 0x88, 0x80, 0x82, 0x15,
+	0x19, 0xcd, 0x95, 0x38, 0x34, 0xa3, 0x57, 0xc9, 0x73, 0x46, 0xb0, 0xaf, 0x16, 0x63, 0x6c, 0x60,
+	0xc0, 0x09, 0x37, 0xc6, 0x1a, 0xc9, 0x44, 0x11, 0x58, 0x4c, 0x76, 0x31, 0x69, 0xc5, 0x29, 0x6f,
+	0xd6, 0xcc, 0x88, 0x88, 0x02, 0x41, 0x4d, 0x36, 0x33, 0x6d, 0xc7, 0x2b, 0x11, 0x8c, 0xfe, 0x21,
+	0x1b, 0x05, 0x88, 0x96, 0xa4, 0x7f, 0xca, 0x8c, 0xd3, 0xfe, 0xe8, 0x0d, 0xd4, 0x62, 0x64, 0x14,
+	0x20, 0x01, 0xeb, 0x1e, 0xaf, 0xe1, 0xaf, 0x80, 0x59, 0x8d, 0x41, 0x7d, 0x78, 0x98, 0xe2, 0xa1,
+	0x00, 0x2b, 0x25, 0xe9, 0xb1, 0xd6, 0x09, 0xaf, 0x8f, 0x45, 0x1f, 0xe0, 0xd1, 0x0a, 0x0d, 0x05,
+	0x6c, 0xc0, 0xba, 0x27, 0x1b, 0x59, 0x2c, 0x80, 0x37, 0xc5, 0xa3, 0xf3, 0x15, 0x1e, 0x57, 0x52,
+	0xe7, 0x42, 0x8a, 0xc7, 0xb2, 0x93, 0x49, 0x3d, 0x2b, 0x60, 0x8c, 0x64, 0x62, 0x71, 0xd5, 0x64,
+	0x01, 0x57, 0x1c, 0xf0, 0x6a, 0x8c, 0xf9, 0x37, 0x05, 0xf4, 0xf4, 0x79, 0x8f, 0x9e, 0xc5, 0xc7,
+	0x5e, 0x36, 0x3e, 0xbd, 0x85, 0x47, 0x34, 0x62, 0x57, 0x6e, 0x11, 0x99, 0x75, 0xb7, 0x88, 0x7d,
+	0xd0, 0xde, 0x9f, 0x88, 0x4d, 0x20, 0x2e, 0x24, 0x91, 0xc2, 0x3c, 0x81, 0x5a, 0x6c, 0x09, 0xa2,
+	0x52, 0xcf, 0xa1, 0xd6, 0x75, 0x6e, 0xad, 0xa9, 0x3d, 0x8e, 0xdd, 0x3f, 0xd8, 0x6a, 0x72, 0x78,
+	0xd5, 0x60, 0xee, 0x00, 0x5a, 0x1d, 0x3b, 0xe6, 0x43, 0x78, 0xb0, 0x66, 0x4b, 0x9b, 0xe7, 0x3c,
+	0x5f, 0x6a, 0xa2, 0xbc, 0x80, 0xa2, 0x68, 0x96, 0xa1, 0x7c, 0xfb, 0xb2, 0x20, 0xfd, 0x44, 0xd2,
+	0xd4, 0xde, 0x36, 0xff, 0xc8, 0x93, 0xae, 0xcc, 0x9a, 0x7b, 0xe0, 0xef, 0xc2, 0xce, 0x3a, 0x1e,
+	0x98, 0x6f, 0xe1, 0xd1, 0x86, 0xa9, 0x74, 0x9f, 0x2c, 0x7b, 0x60, 0x6c, 0x22, 0x88, 0xd9, 0x83,
+	0xc7, 0x1b, 0x67, 0xd5, 0x7d, 0x72, 0xed, 0xc3, 0xde, 0x66, 0xd6, 0x98, 0x17, 0x7c, 0x25, 0x6b,
+	0x27, 0xd9, 0x7d, 0x92, 0xfd, 0x0a, 0x1e, 0xaf, 0x81, 0x4b, 0x74, 0x29, 0x3d, 0xa8, 0xee, 0x93,
+	0xe6, 0x35, 0xec, 0x24, 0x91, 0xc4, 0x06, 0x3e, 0x80, 0xdc, 0x60, 0xe9, 0xf8, 0x82, 0x41, 0xeb,
+	0x6e, 0x7d, 0xdc, 0x6e, 0x0e, 0xa2, 0xb1, 0xc1, 0xc6, 0x4a, 0xec, 0x82, 0xcb, 0x9f, 0xd1, 0x0e,
+	0xe4, 0xb9, 0x51, 0x5c, 0x6e, 0x03, 0x81, 0x0d, 0xcf, 0x20, 0x8a, 0xfb, 0x67, 0xb9, 0x7f, 0x4c,
+	0x63, 0xfe, 0x3b, 0xc7, 0x1d, 0xe4, 0xac, 0xdd, 0x03, 0xf5, 0x9c, 0x58, 0x74, 0xe1, 0x71, 0x12,
+	0x31, 0xe7, 0x50, 0x66, 0x5f, 0x9f, 0xed, 0x1e, 0x47, 0xd7, 0x70, 0xa6, 0xdd, 0x63, 0x64, 0xed,
+	0x13, 0xdf, 0xb7, 0x5d, 0xa7, 0xdb, 0xe1, 0xc8, 0x1a, 0x8e, 0x14, 0xcc, 0x7a, 0x32, 0x1a, 0xb9,
+	0x0b, 0x87, 0xcd, 0xd7, 0x60, 0xfc, 0x45, 0x0a, 0x64, 0x42, 0xb9, 0xed, 0x3a, 0x0e, 0x19, 0xd1,
+	0x20, 0x3c, 0xcf, 0x1d, 0x12, 0x3a, 0xb6, 0x96, 0x77, 0x3e, 0xf1, 0x7a, 0xd6, 0x2c, 0x18, 0x7c,
+	0x1a, 0x0e, 0x65, 0x74, 0x00, 0xd5, 0xfe, 0x8d, 0x3d, 0x4f, 0x7d, 0xf0, 0xe5, 0x70, 0x4a, 0x8b,
+	0x5e, 0x03, 0x4a, 0x68, 0x2e, 0xf8, 0x1d, 0x42, 0x6d, 0x64, 0xf9, 0xcc, 0x0e, 0x3f, 0x4f, 0x98,
+	0x1a, 0xaf, 0xf1, 0x64, 0x17, 0x7b, 0xbe, 0x64, 0xe2, 0xf1, 0xd9, 0xa5, 0x61, 0x29, 0xb2, 0x8f,
+	0x39, 0x5f, 0xbc, 0xac, 0xf3, 0xd1, 0xe5, 0x43, 0x49, 0xc3, 0x71, 0x15, 0x5b, 0xbf, 0xed, 0xe0,
+	0x85, 0xd3, 0xff, 0x34, 0xe5, 0x63, 0x46, 0xc5, 0xa1, 0x1c, 0xd8, 0x02, 0x2e, 0x19, 0x65, 0x69,
+	0x0b, 0x64, 0xd6, 0x32, 0x3b, 0xdc, 0xfb, 0xfc, 0xdc, 0x57, 0x71, 0x4c, 0xc3, 0xd6, 0x34, 0xbc,
+	0x3b, 0x25, 0x13, 0xdb, 0xe1, 0x27, 0xb9, 0x8a, 0xa5, 0xc8, 0x22, 0xad, 0x05, 0x75, 0x47, 0x01,
+	0xee, 0x76, 0x10, 0x19, 0x69, 0x02, 0xe4, 0xae, 0x33, 0xf2, 0xfa, 0x74, 0x46, 0x0d, 0x5d, 0x22,
+	0x4b, 0x0d, 0xab, 0x6a, 0x94, 0x87, 0xfb, 0xd4, 0xb8, 0x4f, 0x4a, 0x7b, 0x58, 0x87, 0x72, 0xfc,
+	0xdb, 0x17, 0x15, 0x20, 0xd3, 0xef, 0xea, 0x5b, 0xec, 0x17, 0xb7, 0x75, 0xe5, 0xf0, 0x30, 0xf8,
+	0xcb, 0x82, 0xed, 0xca, 0x2a, 0x00, 0xdb, 0x5b, 0x33, 0xdb, 0xa7, 0xf6, 0x48, 0xdf, 0x42, 0xdb,
+	0x50, 0xba, 0x62, 0x35, 0x12, 0x0a, 0xe5, 0xf0, 0xaf, 0xa0, 0x85, 0x7f, 0x58, 0x20, 0x80, 0xc2,
+	0xc9, 0x88, 0xda, 0xb7, 0x44, 0xdf, 0x42, 0x65, 0x50, 0xe5, 0x5f, 0x09, 0xba, 0xc2, 0x70, 0x82,
+	0xf2, 0x50, 0xdb, 0x99, 0xe8, 0x19, 0x54, 0x01, 0x4d, 0xc8, 0x64, 0xac, 0x67, 0x99, 0xf3, 0xc9,
+	0xd0, 0xf5, 0xb8, 0x31, 0x87, 0x4a, 0x50, 0xe4, 0x12, 0x19, 0xeb, 0xf9, 0xc3, 0x7f, 0x2a, 0x3c,
+	0x83, 0xb8, 0x90, 0xa9, 0x90, 0x63, 0x9f, 0xc1, 0xfa, 0x16, 0xd2, 0x20, 0xcf, 0x3f, 0x70, 0x75,
+	0x85, 0xa5, 0x0d, 0xc0, 0xf4, 0x0c, 0x43, 0x92, 0xef, 0xaa, 0x67, 0x19, 0x92, 0x58, 0x84, 0x9e,
+	0x63, 0x39, 0xc3, 0x43, 0x56, 0xcf, 0xa3, 0x9a, 0xfc, 0x36, 0x12, 0x0c, 0xd7, 0x0b, 0xe8, 0x41,
+	0xf4, 0xc5, 0x23, 0x95, 0x45, 0xa4, 0x43, 0x59, 0x1e, 0x2e, 0xec, 0x7c, 0xd0, 0x55, 0x96, 0xba,
+	0x73, 0x76, 0xfa, 0xee, 0x8d, 0xae, 0x31, 0xcc, 0xf0, 0x48, 0xd0, 0xe1, 0xf4, 0xf4, 0xcb, 0xff,
+	0xeb, 0xca, 0xe7, 0xaf, 0x75, 0xe5, 0xcb, 0xd7, 0xba, 0xf2, 0xbf, 0xaf, 0xf5, 0xad, 0xbf, 0xff,
+	0x50, 0x57, 0xfe, 0xf2, 0x3c, 0xf6, 0xff, 0xd2, 0xcc, 0xa2, 0x9e, 0xbd, 0x74, 0x3d, 0x7b, 0x62,
+	0x3b, 0x52, 0x70, 0x48, 0x6b, 0x7e, 0x33, 0x69, 0xcd, 0x87, 0x2d, 0xba, 0x74, 0x86, 0x05, 0xfe,
+	0xc7, 0xd1, 0xcb, 0x9f, 0x06, 0x00, 0xd2, 0x83, 0xb6, 0x2a, 0xa6, 0x12, 0x00, 0x00,
 }
 
 func (m *TxnMeta) Marshal() (dAtA []byte, err error) {
@@ -2017,6 +2164,13 @@ func (m *TxnMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.XID) > 0 {
+		i -= len(m.XID)
+		copy(dAtA[i:], m.XID)
+		i = encodeVarintTxn(dAtA, i, uint64(len(m.XID)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.LockService) > 0 {
 		i -= len(m.LockService)
 		copy(dAtA[i:], m.LockService)
@@ -2320,6 +2474,18 @@ func (m *TxnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.XARecoverRequest != nil {
+		{
+			size, err := m.XARecoverRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxn(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.Options != nil {
 		{
 			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
@@ -2486,21 +2652,21 @@ func (m *TxnRequestOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x10
 	}
 	if len(m.RetryCodes) > 0 {
-		dAtA18 := make([]byte, len(m.RetryCodes)*10)
-		var j17 int
+		dAtA19 := make([]byte, len(m.RetryCodes)*10)
+		var j18 int
 		for _, num1 := range m.RetryCodes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j17++
+				j18++
 			}
-			dAtA18[j17] = uint8(num)
-			j17++
+			dAtA19[j18] = uint8(num)
+			j18++
 		}
-		i -= j17
-		copy(dAtA[i:], dAtA18[:j17])
-		i = encodeVarintTxn(dAtA, i, uint64(j17))
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintTxn(dAtA, i, uint64(j18))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2531,6 +2697,18 @@ func (m *TxnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.XARecoverResponse != nil {
+		{
+			size, err := m.XARecoverResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxn(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.RemoveMetadata != nil {
 		{
 			size, err := m.RemoveMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -2693,6 +2871,16 @@ func (m *TxnCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.XAPrepare {
+		i--
+		if m.XAPrepare {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Disable1PCOpt {
 		i--
 		if m.Disable1PCOpt {
@@ -2745,20 +2933,20 @@ func (m *TxnCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.InvalidLockTables) > 0 {
-		dAtA30 := make([]byte, len(m.InvalidLockTables)*10)
-		var j29 int
+		dAtA32 := make([]byte, len(m.InvalidLockTables)*10)
+		var j31 int
 		for _, num := range m.InvalidLockTables {
 			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j29++
+				j31++
 			}
-			dAtA30[j29] = uint8(num)
-			j29++
+			dAtA32[j31] = uint8(num)
+			j31++
 		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintTxn(dAtA, i, uint64(j29))
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintTxn(dAtA, i, uint64(j31))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -3139,6 +3327,84 @@ func (m *TxnRemoveMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *TxnXARecoverRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnXARecoverRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnXARecoverRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size, err := m.TNShard.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTxn(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TxnXARecoverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnXARecoverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnXARecoverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Txns) > 0 {
+		for iNdEx := len(m.Txns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTxn(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TxnError) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -3296,38 +3562,38 @@ func (m *TxnOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4a
 	}
 	if len(m.SkipLockTableModes) > 0 {
-		dAtA37 := make([]byte, len(m.SkipLockTableModes)*10)
-		var j36 int
+		dAtA40 := make([]byte, len(m.SkipLockTableModes)*10)
+		var j39 int
 		for _, num := range m.SkipLockTableModes {
 			for num >= 1<<7 {
-				dAtA37[j36] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA40[j39] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j36++
+				j39++
 			}
-			dAtA37[j36] = uint8(num)
-			j36++
+			dAtA40[j39] = uint8(num)
+			j39++
 		}
-		i -= j36
-		copy(dAtA[i:], dAtA37[:j36])
-		i = encodeVarintTxn(dAtA, i, uint64(j36))
+		i -= j39
+		copy(dAtA[i:], dAtA40[:j39])
+		i = encodeVarintTxn(dAtA, i, uint64(j39))
 		i--
 		dAtA[i] = 0x42
 	}
 	if len(m.SkipLockTables) > 0 {
-		dAtA39 := make([]byte, len(m.SkipLockTables)*10)
-		var j38 int
+		dAtA42 := make([]byte, len(m.SkipLockTables)*10)
+		var j41 int
 		for _, num := range m.SkipLockTables {
 			for num >= 1<<7 {
-				dAtA39[j38] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j38++
+				j41++
 			}
-			dAtA39[j38] = uint8(num)
-			j38++
+			dAtA42[j41] = uint8(num)
+			j41++
 		}
-		i -= j38
-		copy(dAtA[i:], dAtA39[:j38])
-		i = encodeVarintTxn(dAtA, i, uint64(j38))
+		i -= j41
+		copy(dAtA[i:], dAtA42[:j41])
+		i = encodeVarintTxn(dAtA, i, uint64(j41))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -3425,6 +3691,10 @@ func (m *TxnMeta) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTxn(uint64(l))
 	}
+	l = len(m.XID)
+	if l > 0 {
+		n += 1 + l + sovTxn(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3555,6 +3825,10 @@ func (m *TxnRequest) ProtoSize() (n int) {
 		l = m.Options.ProtoSize()
 		n += 1 + l + sovTxn(uint64(l))
 	}
+	if m.XARecoverRequest != nil {
+		l = m.XARecoverRequest.ProtoSize()
+		n += 1 + l + sovTxn(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3638,6 +3912,10 @@ func (m *TxnResponse) ProtoSize() (n int) {
 		l = m.RemoveMetadata.ProtoSize()
 		n += 1 + l + sovTxn(uint64(l))
 	}
+	if m.XARecoverResponse != nil {
+		l = m.XARecoverResponse.ProtoSize()
+		n += 1 + l + sovTxn(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3659,6 +3937,9 @@ func (m *TxnCommitRequest) ProtoSize() (n int) {
 	if m.Disable1PCOpt {
 		n += 2
 	}
+	if m.XAPrepare {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3838,6 +4119,38 @@ func (m *TxnRemoveMetadataResponse) ProtoSize() (n int) {
 	return n
 }
 
+func (m *TxnXARecoverRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TNShard.ProtoSize()
+	n += 1 + l + sovTxn(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxnXARecoverResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txns) > 0 {
+		for _, e := range m.Txns {
+			l = e.ProtoSize()
+			n += 1 + l + sovTxn(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *TxnError) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -4281,6 +4594,40 @@ func (m *TxnMeta) Unmarshal(dAtA []byte) error {
 			}
 			m.LockService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTxn
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XID = append(m.XID[:0], dAtA[iNdEx:postIndex]...)
+			if m.XID == nil {
+				m.XID = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxn(dAtA[iNdEx:])
@@ -5198,6 +5545,42 @@ func (m *TxnRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XARecoverRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.XARecoverRequest == nil {
+				m.XARecoverRequest = &TxnXARecoverRequest{}
+			}
+			if err := m.XARecoverRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxn(dAtA[iNdEx:])
@@ -5812,6 +6195,42 @@ func (m *TxnResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XARecoverResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.XARecoverResponse == nil {
+				m.XARecoverResponse = &TxnXARecoverResponse{}
+			}
+			if err := m.XARecoverResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxn(dAtA[iNdEx:])
@@ -5917,6 +6336,26 @@ func (m *TxnCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Disable1PCOpt = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XAPrepare", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.XAPrepare = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxn(dAtA[iNdEx:])
@@ -6843,6 +7282,175 @@ func (m *TxnRemoveMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TxnXARecoverRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnXARecoverRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnXARecoverRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TNShard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TNShard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnXARecoverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnXARecoverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnXARecoverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txns = append(m.Txns, TxnMeta{})
+			if err := m.Txns[len(m.Txns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TxnError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -272,6 +272,18 @@ func Sort(desc, nullsLast, hasNull bool, os []int64, vec *vector.Vector) {
 		} else {
 			genericSort(col, os, arrayGreater[float64])
 		}
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		// sort the decoded elements, which is in the same order as types.CompareQuantizedArray
+		data, area := vector.MustVarlenaRawData(vec)
+		col := make([][]float32, len(data))
+		for i := range data {
+			col[i] = types.QuantizedArrayToFloat32(vec.GetType().Oid, data[i].GetByteSlice(area))
+		}
+		if !desc {
+			genericSort(col, os, arrayLess[float32])
+		} else {
+			genericSort(col, os, arrayGreater[float32])
+		}
 	case types.T_TS:
 		col := vector.MustFixedColNoTypeCheck[types.TS](vec)
 		if !desc {
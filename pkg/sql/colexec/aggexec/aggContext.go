@@ -85,6 +85,14 @@ func (a *AggContext) growsGroupContext(
 	}
 }
 
+// size returns the estimated memory of the group contexts.
+func (a *AggContext) size() int64 {
+	if a == nil || !a.hasGroupContext {
+		return 0
+	}
+	return int64(len(a.groupContext)) * groupStateSize
+}
+
 func (a *AggContext) getCommonContext() AggCommonExecContext {
 	return a.commonContext
 }
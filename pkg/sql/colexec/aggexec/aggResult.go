@@ -107,6 +107,18 @@ func (r *basicResult) flush() *vector.Vector {
 	return result
 }
 
+// size returns the memory of the results and their empty flags.
+func (r *basicResult) size() int64 {
+	var size int64
+	if r.res != nil {
+		size += int64(r.res.Size())
+	}
+	if r.ess != nil {
+		size += int64(r.ess.Size())
+	}
+	return size
+}
+
 func (r *basicResult) free() {
 	if r.mg == nil {
 		return
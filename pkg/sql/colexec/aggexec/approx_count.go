@@ -21,6 +21,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 )
 
+// hllSketchSize is the memory of a dense sketch of precision 14, a sketch is
+// counted by the most memory it uses.
+const hllSketchSize = 1 << 13
+
 // approx_count() returns the approximate number of count(distinct) values in a group.
 type approxCountFixedExec[T types.FixedSizeTExceptStrType] struct {
 	singleAggInfo
@@ -317,6 +321,10 @@ func (exec *approxCountFixedExec[T]) Free() {
 	exec.groups = nil
 }
 
+func (exec *approxCountFixedExec[T]) Size() int64 {
+	return exec.ret.size() + int64(len(exec.groups))*hllSketchSize
+}
+
 func (exec *approxCountVarExec) GroupGrow(more int) error {
 	oldLen, newLen := len(exec.groups), len(exec.groups)+more
 	if cap(exec.groups) >= newLen {
@@ -455,3 +463,7 @@ func (exec *approxCountVarExec) Free() {
 	exec.ret.free()
 	exec.groups = nil
 }
+
+func (exec *approxCountVarExec) Size() int64 {
+	return exec.ret.size() + int64(len(exec.groups))*hllSketchSize
+}
@@ -42,10 +42,13 @@ const (
 var (
 	ClusterCentersSupportTypes = []types.T{
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
 	}
 
 	distTypeStrToEnum = map[string]kmeans.DistanceType{
 		"vector_l2_ops": kmeans.L2Distance,
+		// the L2 distance of two vectors of 0 and 1 is the square root of their Hamming distance.
+		"vector_hamming_ops": kmeans.L2Distance,
 	}
 
 	initTypeStrToEnum = map[string]kmeans.InitType{
@@ -287,6 +290,10 @@ func (exec *clusterCentersExec) Flush() (*vector.Vector, error) {
 		if err := exec.flushArray64(); err != nil {
 			return nil, err
 		}
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		if err := exec.flushQuantizedArray(); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, moerr.NewInternalErrorNoCtxf(
 			"unsupported type '%s' for cluster_centers", exec.singleAggInfo.argType.String())
@@ -358,6 +365,40 @@ func (exec *clusterCentersExec) flushArray64() error {
 	return nil
 }
 
+func (exec *clusterCentersExec) flushQuantizedArray() error {
+	oid := exec.singleAggInfo.argType.Oid
+	for i, group := range exec.groupData {
+		exec.ret.groupToSet = i
+
+		if group == nil || group.Length() == 0 {
+			continue
+		}
+
+		bts, area := vector.MustVarlenaRawData(group)
+		f64s := make([][]float64, group.Length())
+		for i := range f64s {
+			f32s := types.QuantizedArrayToFloat32(oid, bts[i].GetByteSlice(area))
+			f64s[i] = make([]float64, len(f32s))
+			for j := range f32s {
+				f64s[i][j] = float64(f32s[j])
+			}
+		}
+
+		centers, err := exec.getCentersByKmeansAlgorithm(f64s)
+		if err != nil {
+			return err
+		}
+		res, err := exec.arraysToString(centers)
+		if err != nil {
+			return err
+		}
+		if err = exec.ret.aggSet(util.UnsafeStringToBytes(res)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (exec *clusterCentersExec) getCentersByKmeansAlgorithm(f64s [][]float64) ([][]float64, error) {
 	var clusterer kmeans.Clusterer
 	var centers [][]float64
@@ -400,6 +441,23 @@ func (exec *clusterCentersExec) arraysToString(centers [][]float64) (res string,
 
 	case types.T_array_float64:
 		res = fmt.Sprintf("[ %s ]", types.ArraysToString[float64](centers, ","))
+
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		// the centers are quantized to the type of the column, e.g. an element of a vecbit center is 1
+		// if most of the vectors in the cluster have 1 there.
+		strs := make([]string, len(centers))
+		for i, center := range centers {
+			f32s, err := moarray.Cast[float64, float32](center)
+			if err != nil {
+				return "", err
+			}
+			bts, err := types.Float32ToQuantizedArray(exec.singleAggInfo.argType.Oid, f32s)
+			if err != nil {
+				return "", err
+			}
+			strs[i] = types.QuantizedArrayToString(exec.singleAggInfo.argType.Oid, bts)
+		}
+		res = fmt.Sprintf("[ %s ]", strings.Join(strs, ","))
 	}
 	return res, nil
 }
@@ -418,6 +476,16 @@ func (exec *clusterCentersExec) Free() {
 	}
 }
 
+func (exec *clusterCentersExec) Size() int64 {
+	size := exec.ret.size()
+	for _, vec := range exec.groupData {
+		if vec != nil {
+			size += int64(vec.Size())
+		}
+	}
+	return size
+}
+
 func (exec *clusterCentersExec) SetExtraInformation(partialResult any, groupIndex int) error {
 	if bts, ok := partialResult.([]byte); ok {
 		k, distType, initType, normalize, err := decodeConfig(bts)
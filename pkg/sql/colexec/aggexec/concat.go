@@ -202,6 +202,10 @@ func (exec *groupConcatExec) Free() {
 	exec.ret.free()
 }
 
+func (exec *groupConcatExec) Size() int64 {
+	return exec.ret.size() + exec.distinctHash.size()
+}
+
 var GroupConcatUnsupportedTypes = []types.T{
 	types.T_tuple,
 }
@@ -249,6 +253,9 @@ var oidToConcatFunc = map[types.T]func(*vector.Vector, int, []byte) ([]byte, err
 	types.T_Blockid:       concatFixedTypeChecked[types.Blockid],
 	types.T_array_float32: concatVar,
 	types.T_array_float64: concatVar,
+	types.T_array_float16: concatVar,
+	types.T_array_int8:    concatVar,
+	types.T_array_bit:     concatVar,
 }
 
 func concatFixedTypeChecked[T types.FixedSizeTExceptStrType](v *vector.Vector, row int, src []byte) ([]byte, error) {
@@ -239,6 +239,10 @@ func (exec *countColumnExec) Free() {
 	exec.distinctHash.free()
 }
 
+func (exec *countColumnExec) Size() int64 {
+	return exec.ret.size() + exec.distinctHash.size()
+}
+
 type countStarExec struct {
 	singleAggInfo
 	singleAggExecExtraInformation
@@ -333,3 +337,7 @@ func (exec *countStarExec) Flush() (*vector.Vector, error) {
 func (exec *countStarExec) Free() {
 	exec.ret.free()
 }
+
+func (exec *countStarExec) Size() int64 {
+	return exec.ret.size()
+}
@@ -41,25 +41,45 @@ func newDistinctHash(mp *mpool.MPool, containNullValue bool) distinctHash {
 	}
 }
 
+// grows adds more groups, the hash map of a group is created at its first insertion.
 func (d *distinctHash) grows(more int) error {
-	oldLen, newLen := len(d.maps), len(d.maps)+more
 	d.maps = append(d.maps, make([]*hashmap.StrHashMap, more)...)
 	d.itrs = append(d.itrs, make([]hashmap.Iterator, more)...)
+	return nil
+}
 
-	var err error
-	for i := oldLen; i < newLen; i++ {
-		if d.maps[i], err = hashmap.NewStrMap(true); err != nil {
-			return err
+func (d *distinctHash) iterator(group int) (hashmap.Iterator, error) {
+	if d.itrs[group] == nil {
+		m, err := hashmap.NewStrMap(true)
+		if err != nil {
+			return nil, err
 		}
-		d.itrs[i] = d.maps[i].NewIterator()
+		d.maps[group] = m
+		d.itrs[group] = m.NewIterator()
 	}
-	return nil
+	return d.itrs[group], nil
 }
 
 // fill inserts the row into the hash map.
 // return true if this is a new value.
 func (d *distinctHash) fill(group int, vs []*vector.Vector, row int) (bool, error) {
-	return d.itrs[group].DetectDup(vs, row)
+	iterator, err := d.iterator(group)
+	if err != nil {
+		return false, err
+	}
+	return iterator.DetectDup(vs, row)
+}
+
+// ReleaseDistinctGroup frees the hash map of a group which will not be filled anymore.
+//
+// the window operator fills the groups one by one, and each row is a group,
+// releasing the finished ones makes it only hold one hash map at a time.
+func (d *distinctHash) ReleaseDistinctGroup(group int) {
+	if group < len(d.maps) && d.maps[group] != nil {
+		d.maps[group].Free()
+		d.maps[group] = nil
+		d.itrs[group] = nil
+	}
 }
 
 func (d *distinctHash) bulkFill(group int, vs []*vector.Vector) ([]bool, error) {
@@ -74,7 +94,10 @@ func (d *distinctHash) bulkFill(group int, vs []*vector.Vector) ([]bool, error)
 	d.bs = d.bs[:rowCount]
 	d.bs1 = d.bs1[:hashmap.UnitLimit]
 
-	iterator := d.itrs[group]
+	iterator, err := d.iterator(group)
+	if err != nil {
+		return nil, err
+	}
 
 	for i := 0; i < rowCount; i += hashmap.UnitLimit {
 		n := rowCount - i
@@ -151,6 +174,17 @@ func (d *distinctHash) merge(next *distinctHash) error {
 	return nil
 }
 
+// size returns the memory of the hash maps of all groups.
+func (d *distinctHash) size() int64 {
+	var size int64
+	for _, m := range d.maps {
+		if m != nil {
+			size += m.Size()
+		}
+	}
+	return size
+}
+
 func (d *distinctHash) free() {
 	for _, m := range d.maps {
 		if m != nil {
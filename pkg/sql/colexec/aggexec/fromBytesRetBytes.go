@@ -525,3 +525,7 @@ func (exec *singleAggFuncExecNew4) Free() {
 	exec.ret.free()
 	exec.distinctHash.free()
 }
+
+func (exec *singleAggFuncExecNew4) Size() int64 {
+	return exec.ret.size() + exec.distinctHash.size() + exec.execContext.size()
+}
@@ -626,3 +626,7 @@ func (exec *singleAggFuncExecNew3[to]) Free() {
 	exec.ret.free()
 	exec.distinctHash.free()
 }
+
+func (exec *singleAggFuncExecNew3[to]) Size() int64 {
+	return exec.ret.size() + exec.distinctHash.size() + exec.execContext.size()
+}
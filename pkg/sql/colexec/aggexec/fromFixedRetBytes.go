@@ -642,3 +642,7 @@ func (exec *singleAggFuncExecNew2[from]) Free() {
 	exec.ret.free()
 	exec.distinctHash.free()
 }
+
+func (exec *singleAggFuncExecNew2[from]) Size() int64 {
+	return exec.ret.size() + exec.distinctHash.size() + exec.execContext.size()
+}
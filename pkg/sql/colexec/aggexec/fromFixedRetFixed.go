@@ -695,3 +695,7 @@ func (exec *singleAggFuncExecNew1[from, to]) Free() {
 	exec.ret.free()
 	exec.distinctHash.free()
 }
+
+func (exec *singleAggFuncExecNew1[from, to]) Size() int64 {
+	return exec.ret.size() + exec.distinctHash.size() + exec.execContext.size()
+}
@@ -338,6 +338,16 @@ func (exec *medianColumnExecSelf[T, R]) Free() {
 	exec.distinctHash.free()
 }
 
+func (exec *medianColumnExecSelf[T, R]) Size() int64 {
+	size := exec.ret.size() + exec.distinctHash.size()
+	for _, vec := range exec.groups {
+		if vec != nil {
+			size += int64(vec.Size())
+		}
+	}
+	return size
+}
+
 type medianColumnNumericExec[T numeric] struct {
 	medianColumnExecSelf[T, float64]
 }
@@ -410,6 +410,10 @@ func (exec *multiAggFuncExec1[T]) Free() {
 	exec.ret.free()
 }
 
+func (exec *multiAggFuncExec1[T]) Size() int64 {
+	return exec.ret.size() + int64(len(exec.groups))*groupStateSize
+}
+
 func (exec *multiAggFuncExec2) init(
 	mg AggMemoryManager,
 	info multiAggInfo,
@@ -616,3 +620,7 @@ func (exec *multiAggFuncExec2) Flush() (*vector.Vector, error) {
 func (exec *multiAggFuncExec2) Free() {
 	exec.ret.free()
 }
+
+func (exec *multiAggFuncExec2) Size() int64 {
+	return exec.ret.size() + int64(len(exec.groups))*groupStateSize
+}
@@ -72,6 +72,46 @@ func RegisterDenseRankWin(id int64) {
 	winIdOfDenseRank = id
 }
 
+func RegisterNtileWin(id int64) {
+	specialAgg[id] = true
+	winIdOfNtile = id
+}
+
+func RegisterPercentRankWin(id int64) {
+	specialAgg[id] = true
+	winIdOfPercentRank = id
+}
+
+func RegisterCumeDistWin(id int64) {
+	specialAgg[id] = true
+	winIdOfCumeDist = id
+}
+
+func RegisterLagWin(id int64) {
+	specialAgg[id] = true
+	winIdOfLag = id
+}
+
+func RegisterLeadWin(id int64) {
+	specialAgg[id] = true
+	winIdOfLead = id
+}
+
+func RegisterFirstValueWin(id int64) {
+	specialAgg[id] = true
+	winIdOfFirstValue = id
+}
+
+func RegisterLastValueWin(id int64) {
+	specialAgg[id] = true
+	winIdOfLastValue = id
+}
+
+func RegisterNthValueWin(id int64) {
+	specialAgg[id] = true
+	winIdOfNthValue = id
+}
+
 type registeredAggInfo struct {
 	isSingleAgg          bool
 	acceptNull           bool
@@ -112,6 +152,14 @@ var (
 	winIdOfRowNumber      = int64(-7)
 	winIdOfRank           = int64(-8)
 	winIdOfDenseRank      = int64(-9)
+	winIdOfNtile          = int64(-10)
+	winIdOfPercentRank    = int64(-11)
+	winIdOfCumeDist       = int64(-12)
+	winIdOfLag            = int64(-13)
+	winIdOfLead           = int64(-14)
+	winIdOfFirstValue     = int64(-15)
+	winIdOfLastValue      = int64(-16)
+	winIdOfNthValue       = int64(-17)
 	groupConcatSep        = ","
 	getCroupConcatRet     = func(args ...types.Type) types.Type {
 		for _, p := range args {
@@ -107,6 +107,19 @@ type AggFuncExec interface {
 
 	// Free clean the resource and reuse the aggregation if possible.
 	Free()
+
+	// Size returns the memory held by the aggregation state.
+	Size() int64
+}
+
+// groupStateSize is the estimated memory of the state of a group which is
+// kept on the go heap instead of the mpool.
+const groupStateSize = 32
+
+// DistinctGroupReleaser is implemented by the executors which support `distinct`.
+// it is used by the window operator to release the groups which are already filled.
+type DistinctGroupReleaser interface {
+	ReleaseDistinctGroup(groupIndex int)
 }
 
 // indicate who implements the AggFuncExec interface.
@@ -249,9 +262,15 @@ func makeSpecialAggExec(
 		case aggIdOfClusterCenters:
 			exec, err := makeClusterCenters(mg, id, isDistinct, params[0])
 			return exec, true, err
-		case winIdOfRowNumber, winIdOfRank, winIdOfDenseRank:
+		case winIdOfRowNumber, winIdOfRank, winIdOfDenseRank, winIdOfNtile:
 			exec, err := makeWindowExec(mg, id, isDistinct)
 			return exec, true, err
+		case winIdOfPercentRank, winIdOfCumeDist:
+			exec, err := makeWindowDistributionExec(mg, id, isDistinct)
+			return exec, true, err
+		case winIdOfLag, winIdOfLead, winIdOfFirstValue, winIdOfLastValue, winIdOfNthValue:
+			exec, err := makeWindowValueExec(mg, id, isDistinct, params[0])
+			return exec, true, err
 		}
 	}
 	return nil, false, nil
@@ -331,3 +350,35 @@ func makeWindowExec(
 	}
 	return makeRankDenseRankRowNumber(mg, info), nil
 }
+
+func makeWindowDistributionExec(
+	mg AggMemoryManager, aggID int64, isDistinct bool) (AggFuncExec, error) {
+	if isDistinct {
+		return nil, moerr.NewInternalErrorNoCtx("window function does not support `distinct`")
+	}
+
+	info := singleAggInfo{
+		aggID:     aggID,
+		distinct:  false,
+		argType:   types.T_int64.ToType(),
+		retType:   types.T_float64.ToType(),
+		emptyNull: false,
+	}
+	return makePercentRankCumeDist(mg, info), nil
+}
+
+func makeWindowValueExec(
+	mg AggMemoryManager, aggID int64, isDistinct bool, param types.Type) (AggFuncExec, error) {
+	if isDistinct {
+		return nil, moerr.NewInternalErrorNoCtx("window function does not support `distinct`")
+	}
+
+	info := singleAggInfo{
+		aggID:     aggID,
+		distinct:  false,
+		argType:   param,
+		retType:   param,
+		emptyNull: true,
+	}
+	return makeValueWindow(mg, info), nil
+}
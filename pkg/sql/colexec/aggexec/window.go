@@ -31,6 +31,8 @@ type singleWindowExec struct {
 	ret aggFuncResult[int64]
 
 	groups [][]int64
+	// buckets records the argument of ntile for each partition.
+	buckets []int64
 }
 
 func makeRankDenseRankRowNumber(mg AggMemoryManager, info singleAggInfo) AggFuncExec {
@@ -42,6 +44,9 @@ func makeRankDenseRankRowNumber(mg AggMemoryManager, info singleAggInfo) AggFunc
 
 func (exec *singleWindowExec) GroupGrow(more int) error {
 	exec.groups = append(exec.groups, make([][]int64, more)...)
+	if exec.singleAggInfo.aggID == winIdOfNtile {
+		exec.buckets = append(exec.buckets, make([]int64, more)...)
+	}
 	return exec.ret.grows(more)
 }
 
@@ -51,6 +56,17 @@ func (exec *singleWindowExec) PreAllocateGroups(more int) error {
 
 func (exec *singleWindowExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
 	value := vector.MustFixedColWithTypeCheck[int64](vectors[0])[row]
+	// the argument of ntile is evaluated at the first row of the partition.
+	if exec.singleAggInfo.aggID == winIdOfNtile && len(exec.groups[groupIndex]) == 0 {
+		if vectors[1].IsNull(uint64(value)) {
+			return moerr.NewInvalidArgNoCtx("ntile", "NULL")
+		}
+		n := vector.GetFixedAtWithTypeCheck[int64](vectors[1], int(value))
+		if n <= 0 {
+			return moerr.NewInvalidArgNoCtx("ntile", n)
+		}
+		exec.buckets[groupIndex] = n
+	}
 	exec.groups[groupIndex] = append(exec.groups[groupIndex], value)
 	return nil
 }
@@ -127,6 +143,8 @@ func (exec *singleWindowExec) Flush() (*vector.Vector, error) {
 		return exec.flushDenseRank()
 	case winIdOfRowNumber:
 		return exec.flushRowNumber()
+	case winIdOfNtile:
+		return exec.flushNtile()
 	}
 	return nil, moerr.NewInternalErrorNoCtx("invalid window function")
 }
@@ -135,6 +153,14 @@ func (exec *singleWindowExec) Free() {
 	exec.ret.free()
 }
 
+func (exec *singleWindowExec) Size() int64 {
+	size := exec.ret.size() + int64(len(exec.buckets))*8
+	for _, g := range exec.groups {
+		size += int64(len(g)) * 8
+	}
+	return size
+}
+
 func (exec *singleWindowExec) flushRank() (*vector.Vector, error) {
 	values := exec.ret.values
 
@@ -196,3 +222,310 @@ func (exec *singleWindowExec) flushRowNumber() (*vector.Vector, error) {
 	}
 	return exec.ret.flush(), nil
 }
+
+// flushNtile divides each partition into buckets as evenly as possible,
+// the first (rows % n) buckets hold one more row than the others.
+func (exec *singleWindowExec) flushNtile() (*vector.Vector, error) {
+	values := exec.ret.values
+
+	idx := 0
+	for i, group := range exec.groups {
+		if len(group) == 0 {
+			continue
+		}
+
+		rows := group[len(group)-1] - group[0]
+		n := exec.buckets[i]
+		size, remain := rows/n, rows%n
+		for j := int64(0); j < rows; j++ {
+			if j < remain*(size+1) {
+				values[idx] = j/(size+1) + 1
+			} else {
+				values[idx] = (j-remain)/size + 1
+			}
+			idx++
+		}
+	}
+	return exec.ret.flush(), nil
+}
+
+func WindowDistributionReturnType(_ []types.Type) types.Type {
+	return types.T_float64.ToType()
+}
+
+// windowDistributionExec is the executor of percent_rank and cume_dist.
+// like singleWindowExec, it receives the peer boundaries of each partition.
+type windowDistributionExec struct {
+	singleAggInfo
+	ret aggFuncResult[float64]
+
+	groups [][]int64
+}
+
+func makePercentRankCumeDist(mg AggMemoryManager, info singleAggInfo) AggFuncExec {
+	return &windowDistributionExec{
+		singleAggInfo: info,
+		ret:           initFixedAggFuncResult[float64](mg, info.retType, info.emptyNull),
+	}
+}
+
+func (exec *windowDistributionExec) GroupGrow(more int) error {
+	exec.groups = append(exec.groups, make([][]int64, more)...)
+	return exec.ret.grows(more)
+}
+
+func (exec *windowDistributionExec) PreAllocateGroups(more int) error {
+	return exec.ret.preAllocate(more)
+}
+
+func (exec *windowDistributionExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	value := vector.MustFixedColWithTypeCheck[int64](vectors[0])[row]
+	exec.groups[groupIndex] = append(exec.groups[groupIndex], value)
+	return nil
+}
+
+func (exec *windowDistributionExec) marshal() ([]byte, error) {
+	d := exec.singleAggInfo.getEncoded()
+	r, err := exec.ret.marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := &EncodedAgg{
+		Info:   d,
+		Result: r,
+		Groups: nil,
+	}
+	if len(exec.groups) > 0 {
+		encoded.Groups = make([][]byte, len(exec.groups))
+		for i := range encoded.Groups {
+			encoded.Groups[i] = types.EncodeSlice[int64](exec.groups[i])
+		}
+	}
+	return encoded.Marshal()
+}
+
+func (exec *windowDistributionExec) unmarshal(mp *mpool.MPool, result []byte, groups [][]byte) error {
+	if len(groups) > 0 {
+		exec.groups = make([][]int64, len(groups))
+		for i := range exec.groups {
+			if len(groups[i]) > 0 {
+				exec.groups[i] = types.DecodeSlice[int64](groups[i])
+			}
+		}
+	}
+	return exec.ret.unmarshal(result)
+}
+
+func (exec *windowDistributionExec) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	panic("implement me")
+}
+
+func (exec *windowDistributionExec) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	panic("implement me")
+}
+
+func (exec *windowDistributionExec) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	other := next.(*windowDistributionExec)
+	exec.groups[groupIdx1] = append(exec.groups[groupIdx1], other.groups[groupIdx2]...)
+	return nil
+}
+
+func (exec *windowDistributionExec) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	other := next.(*windowDistributionExec)
+	for i := range groups {
+		if groups[i] != GroupNotMatched {
+			groupIdx1 := int(groups[i] - 1)
+			groupIdx2 := i + offset
+
+			exec.groups[groupIdx1] = append(exec.groups[groupIdx1], other.groups[groupIdx2]...)
+		}
+	}
+	return nil
+}
+
+func (exec *windowDistributionExec) SetExtraInformation(partialResult any, groupIndex int) error {
+	panic("window function do not support the extra information")
+}
+
+func (exec *windowDistributionExec) Flush() (*vector.Vector, error) {
+	switch exec.singleAggInfo.aggID {
+	case winIdOfPercentRank:
+		return exec.flushPercentRank()
+	case winIdOfCumeDist:
+		return exec.flushCumeDist()
+	}
+	return nil, moerr.NewInternalErrorNoCtx("invalid window function")
+}
+
+func (exec *windowDistributionExec) Free() {
+	exec.ret.free()
+}
+
+func (exec *windowDistributionExec) Size() int64 {
+	size := exec.ret.size()
+	for _, g := range exec.groups {
+		size += int64(len(g)) * 8
+	}
+	return size
+}
+
+// flushPercentRank sets (rank - 1) / (rows - 1) for each row, 0 for a partition with only one row.
+func (exec *windowDistributionExec) flushPercentRank() (*vector.Vector, error) {
+	values := exec.ret.values
+
+	idx := 0
+	for _, group := range exec.groups {
+		if len(group) == 0 {
+			continue
+		}
+
+		rows := group[len(group)-1] - group[0]
+		for i := 1; i < len(group); i++ {
+			v := float64(0)
+			if rows > 1 {
+				v = float64(group[i-1]-group[0]) / float64(rows-1)
+			}
+			for k := idx + int(group[i]-group[i-1]); idx < k; idx++ {
+				values[idx] = v
+			}
+		}
+	}
+	return exec.ret.flush(), nil
+}
+
+// flushCumeDist sets the number of rows preceding or peer with the current row divided by the partition rows.
+func (exec *windowDistributionExec) flushCumeDist() (*vector.Vector, error) {
+	values := exec.ret.values
+
+	idx := 0
+	for _, group := range exec.groups {
+		if len(group) == 0 {
+			continue
+		}
+
+		rows := float64(group[len(group)-1] - group[0])
+		for i := 1; i < len(group); i++ {
+			v := float64(group[i]-group[0]) / rows
+			for k := idx + int(group[i]-group[i-1]); idx < k; idx++ {
+				values[idx] = v
+			}
+		}
+	}
+	return exec.ret.flush(), nil
+}
+
+func WindowValueReturnType(args []types.Type) types.Type {
+	return args[0]
+}
+
+// windowValueExec is the executor of lag, lead, first_value, last_value and nth_value.
+// the window operator picks the row for each group, and the executor only
+// remembers where the value is, the result is built at Flush.
+type windowValueExec struct {
+	singleAggInfo
+	mp *mpool.MPool
+
+	sources []windowValueSource
+}
+
+type windowValueSource struct {
+	vec *vector.Vector
+	row int
+}
+
+func makeValueWindow(mg AggMemoryManager, info singleAggInfo) AggFuncExec {
+	var mp *mpool.MPool
+	if mg != nil {
+		mp = mg.Mp()
+	}
+	return &windowValueExec{
+		singleAggInfo: info,
+		mp:            mp,
+	}
+}
+
+func (exec *windowValueExec) GroupGrow(more int) error {
+	exec.sources = append(exec.sources, make([]windowValueSource, more)...)
+	return nil
+}
+
+func (exec *windowValueExec) PreAllocateGroups(more int) error {
+	if cap(exec.sources)-len(exec.sources) < more {
+		sources := make([]windowValueSource, len(exec.sources), len(exec.sources)+more)
+		copy(sources, exec.sources)
+		exec.sources = sources
+	}
+	return nil
+}
+
+// Fill sets the value of the group to the row of the first vector,
+// the later one overwrites the former.
+func (exec *windowValueExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	exec.sources[groupIndex] = windowValueSource{vec: vectors[0], row: row}
+	return nil
+}
+
+func (exec *windowValueExec) marshal() ([]byte, error) {
+	return nil, moerr.NewNotSupportedNoCtx("marshal value window function")
+}
+
+func (exec *windowValueExec) unmarshal(mp *mpool.MPool, result []byte, groups [][]byte) error {
+	return moerr.NewNotSupportedNoCtx("unmarshal value window function")
+}
+
+func (exec *windowValueExec) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	panic("implement me")
+}
+
+func (exec *windowValueExec) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	panic("implement me")
+}
+
+func (exec *windowValueExec) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	panic("value window function do not support merge")
+}
+
+func (exec *windowValueExec) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	panic("value window function do not support merge")
+}
+
+func (exec *windowValueExec) SetExtraInformation(partialResult any, groupIndex int) error {
+	panic("window function do not support the extra information")
+}
+
+func (exec *windowValueExec) Flush() (*vector.Vector, error) {
+	// the argument is a NULL literal.
+	if exec.singleAggInfo.retType.Oid == types.T_any {
+		return vector.NewConstNull(exec.singleAggInfo.retType, len(exec.sources), exec.mp), nil
+	}
+
+	ret := vector.NewVec(exec.singleAggInfo.retType)
+	for _, src := range exec.sources {
+		var err error
+		if src.vec == nil {
+			err = ret.UnionNull(exec.mp)
+		} else {
+			err = ret.UnionOne(src.vec, int64(src.row), exec.mp)
+		}
+		if err != nil {
+			ret.Free(exec.mp)
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func (exec *windowValueExec) Free() {
+	exec.sources = nil
+}
+
+func (exec *windowValueExec) Size() int64 {
+	var size int64
+	for _, src := range exec.sources {
+		if src.vec != nil {
+			size += int64(src.vec.Size())
+		}
+	}
+	return size
+}
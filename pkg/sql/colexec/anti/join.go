@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashmap_util"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -87,23 +88,71 @@ func (antiJoin *AntiJoin) Call(proc *process.Process) (vm.CallResult, error) {
 			if err != nil {
 				return result, err
 			}
-			ctr.state = Probe
+			if ctr.grace != nil {
+				ctr.state = SpillProbe
+			} else {
+				ctr.state = Probe
+			}
 
-		case Probe:
+		case SpillProbe:
 			input, err = vm.ChildrenCall(antiJoin.GetChildren(0), proc, analyzer)
 			if err != nil {
 				return result, err
 			}
-			inbat := input.Batch
-			if inbat == nil {
-				ctr.state = End
+			bat := input.Batch
+			if bat == nil {
+				if err = ctr.grace.FinishProbe(proc); err != nil {
+					return result, err
+				}
+				ctr.state = GraceProbe
 				continue
 			}
-			if inbat.Last() {
-				result.Batch = inbat
+			if bat.Last() {
+				result.Batch = bat
 				analyzer.Output(result.Batch)
 				return result, nil
 			}
+			if bat.IsEmpty() {
+				continue
+			}
+			if err = ctr.grace.AddProbe(proc, bat); err != nil {
+				return result, err
+			}
+
+		case Probe, GraceProbe:
+			var inbat *batch.Batch
+			if ctr.state == GraceProbe {
+				inbat, err = ctr.grace.NextProbe(proc)
+				if err != nil {
+					return result, err
+				}
+				if inbat == nil {
+					ok, err := ctr.nextGracePartition(proc)
+					if err != nil {
+						return result, err
+					}
+					if !ok {
+						analyzer.Spill(ctr.grace.SpillSize())
+						ctr.state = End
+					}
+					continue
+				}
+			} else {
+				input, err = vm.ChildrenCall(antiJoin.GetChildren(0), proc, analyzer)
+				if err != nil {
+					return result, err
+				}
+				inbat = input.Batch
+				if inbat == nil {
+					ctr.state = End
+					continue
+				}
+				if inbat.Last() {
+					result.Batch = inbat
+					analyzer.Output(result.Batch)
+					return result, nil
+				}
+			}
 			if inbat.IsEmpty() {
 				continue
 			}
@@ -147,6 +196,20 @@ func (antiJoin *AntiJoin) Call(proc *process.Process) (vm.CallResult, error) {
 	}
 }
 
+// nextGracePartition switches to the next partition of the grace join, the
+// join map of a partition without build rows is empty but not nil, so that
+// all the probe rows of it with non null keys are output.
+func (ctr *container) nextGracePartition(proc *process.Process) (bool, error) {
+	mp, err := ctr.grace.NextPartition(proc)
+	ctr.mp = mp
+	if err != nil || mp == nil {
+		return false, err
+	}
+	ctr.itr = nil
+	ctr.batchRowCount = mp.GetRowCount()
+	return true, nil
+}
+
 func (antiJoin *AntiJoin) build(analyzer process.Analyzer, proc *process.Process) (err error) {
 	ctr := &antiJoin.ctr
 	start := time.Now()
@@ -155,6 +218,13 @@ func (antiJoin *AntiJoin) build(analyzer process.Analyzer, proc *process.Process
 	if err != nil {
 		return err
 	}
+	if ctr.mp.GetSpilled() != nil {
+		// the build side is spilled, join it as a grace hash join
+		mp := ctr.mp
+		ctr.mp = nil
+		ctr.grace, err = hashmap_util.NewGraceJoin(proc, mp, antiJoin.Conditions[0])
+		return err
+	}
 	if ctr.mp != nil {
 		ctr.maxAllocSize = max(ctr.maxAllocSize, ctr.mp.Size())
 	}
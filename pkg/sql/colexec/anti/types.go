@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashmap_util"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -31,6 +32,8 @@ var _ vm.Operator = new(AntiJoin)
 const (
 	Build = iota
 	Probe
+	SpillProbe
+	GraceProbe
 	End
 )
 
@@ -54,6 +57,9 @@ type container struct {
 	vecs     []*vector.Vector
 
 	mp *message.JoinMap
+	// grace joins a spilled build side partition by partition, mp is the
+	// join map of the current partition which is owned by grace.
+	grace *hashmap_util.GraceJoin
 
 	maxAllocSize int64
 }
@@ -109,6 +115,7 @@ func (antiJoin *AntiJoin) Reset(proc *process.Process, pipelineFailed bool, err
 	ctr.itr = nil
 	ctr.resetExecutor()
 	ctr.resetExprExecutor()
+	ctr.cleanGrace(proc)
 	ctr.cleanHashMap()
 	ctr.state = Build
 	ctr.batchRowCount = 0
@@ -134,6 +141,7 @@ func (antiJoin *AntiJoin) Free(proc *process.Process, pipelineFailed bool, err e
 	ctr.eligible = nil
 	ctr.cleanExecutor()
 	ctr.cleanExprExecutor()
+	ctr.cleanGrace(proc)
 	ctr.cleanBatch(proc)
 
 	if antiJoin.ProjectList != nil {
@@ -166,6 +174,14 @@ func (ctr *container) cleanBatch(proc *process.Process) {
 	}
 }
 
+func (ctr *container) cleanGrace(proc *process.Process) {
+	if ctr.grace != nil {
+		ctr.mp = nil
+		ctr.grace.Free(proc)
+		ctr.grace = nil
+	}
+}
+
 func (ctr *container) cleanHashMap() {
 	if ctr.mp != nil {
 		ctr.mp.Free()
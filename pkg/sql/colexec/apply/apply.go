@@ -158,6 +158,7 @@ func (ctr *container) probe(ap *Apply, proc *process.Process, result *vm.CallRes
 							return err
 						}
 					}
+					ctr.rbat.AddRowCount(1)
 				}
 				ctr.tfFinish = true
 				break
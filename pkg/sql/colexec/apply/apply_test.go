@@ -19,9 +19,15 @@ import (
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/table_function"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
 )
 
 type applyTestCase struct {
@@ -36,6 +42,7 @@ var (
 func init() {
 	tcs = []applyTestCase{
 		newTestCase(CROSS),
+		newTestCase(OUTER),
 	}
 }
 
@@ -47,6 +54,51 @@ func TestString(t *testing.T) {
 }
 
 func TestApply(t *testing.T) {
+	// generate_series(1, a, 1) for a in (2, 0, 1)
+	for _, tc := range []struct {
+		applyType int
+		left      []int64
+		right     []int64
+		nulls     []uint64
+	}{
+		{applyType: CROSS, left: []int64{2, 2, 1}, right: []int64{1, 2, 1}},
+		{applyType: OUTER, left: []int64{2, 2, 0, 1}, right: []int64{1, 2, 0, 1}, nulls: []uint64{2}},
+	} {
+		tc := tc
+		c := newTestCase(tc.applyType)
+		c.arg.Result = []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)}
+		c.arg.Typs = []types.Type{types.T_int64.ToType()}
+		c.arg.TableFunction.FuncName = "generate_series"
+		c.arg.TableFunction.Attrs = []string{"result"}
+		c.arg.TableFunction.Rets = []*plan.ColDef{{Name: "result", Typ: plan.Type{Id: int32(types.T_int64)}}}
+		one := &plan.Expr{
+			Typ:  plan.Type{Id: int32(types.T_int64)},
+			Expr: &plan.Expr_Lit{Lit: &plan.Literal{Value: &plan.Literal_I64Val{I64Val: 1}}},
+		}
+		c.arg.TableFunction.Args = []*plan.Expr{one, {
+			Typ:  plan.Type{Id: int32(types.T_int64)},
+			Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0}},
+		}, one}
+
+		bat := batch.NewWithSize(1)
+		bat.Vecs[0] = testutil.MakeInt64Vector([]int64{2, 0, 1}, nil)
+		bat.SetRowCount(3)
+		c.arg.AppendChild(colexec.NewMockOperator().WithBatchs([]*batch.Batch{bat}))
+
+		require.NoError(t, c.arg.Prepare(c.proc))
+		res, err := c.arg.Call(c.proc)
+		require.NoError(t, err)
+		require.Equal(t, len(tc.left), res.Batch.RowCount())
+		require.Equal(t, tc.left, vector.MustFixedColWithTypeCheck[int64](res.Batch.Vecs[0]))
+		require.Equal(t, tc.right, vector.MustFixedColWithTypeCheck[int64](res.Batch.Vecs[1]))
+		for _, row := range tc.nulls {
+			require.True(t, res.Batch.Vecs[1].IsNull(row))
+		}
+
+		c.arg.Reset(c.proc, false, nil)
+		c.arg.Free(c.proc, false, nil)
+		c.proc.Free()
+	}
 }
 
 func newTestCase(applyType int) applyTestCase {
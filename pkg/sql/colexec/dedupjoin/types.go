@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashmap_util"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -32,7 +33,10 @@ var _ vm.Operator = new(DedupJoin)
 const (
 	Build = iota
 	Probe
+	SpillProbe
+	GraceProbe
 	Finalize
+	GraceFinalize
 	End
 )
 
@@ -54,6 +58,9 @@ type container struct {
 	vecs  []*vector.Vector
 
 	mp *message.JoinMap
+	// grace joins a spilled build side partition by partition, mp, batches
+	// and matched are of the current partition which is owned by grace.
+	grace *hashmap_util.GraceJoin
 
 	matched     *bitmap.Bitmap
 	handledLast bool
@@ -127,6 +134,7 @@ func (dedupJoin *DedupJoin) Reset(proc *process.Process, pipelineFailed bool, er
 	ctr.maxAllocSize = 0
 
 	ctr.cleanBuf(proc)
+	ctr.cleanGrace(proc)
 	ctr.cleanHashMap()
 	ctr.resetExprExecutor()
 	ctr.resetEvalVectors()
@@ -138,6 +146,7 @@ func (dedupJoin *DedupJoin) Reset(proc *process.Process, pipelineFailed bool, er
 func (dedupJoin *DedupJoin) Free(proc *process.Process, pipelineFailed bool, err error) {
 	ctr := &dedupJoin.ctr
 	ctr.cleanBuf(proc)
+	ctr.cleanGrace(proc)
 	ctr.cleanEvalVectors()
 	ctr.cleanHashMap()
 	ctr.cleanExprExecutor()
@@ -169,6 +178,16 @@ func (ctr *container) cleanBuf(proc *process.Process) {
 	ctr.buf = nil
 }
 
+func (ctr *container) cleanGrace(proc *process.Process) {
+	if ctr.grace != nil {
+		ctr.mp = nil
+		ctr.batches = nil
+		ctr.matched = nil
+		ctr.grace.Free(proc)
+		ctr.grace = nil
+	}
+}
+
 func (ctr *container) cleanHashMap() {
 	if ctr.mp != nil {
 		ctr.mp.Free()
@@ -814,6 +814,12 @@ func generateConstExpressionExecutor(proc *process.Process, typ types.Type, con
 					return nil, err1
 				}
 				vec, err = vector.NewConstArray(typ, array, 1, proc.Mp())
+			} else if typ.Oid.IsQuantizedArray() {
+				array, err1 := types.StringToQuantizedArray(typ.Oid, sval)
+				if err1 != nil {
+					return nil, err1
+				}
+				vec, err = vector.NewConstBytes(typ, array, 1, proc.Mp())
 			} else if typ.Oid == types.T_datalink {
 				_, _, err1 := datalink.ParseDatalink(sval, proc)
 				if err1 != nil {
@@ -667,6 +667,11 @@ func isLegalLine(param *tree.ExternParam, cols []*plan.ColDef, fields []csvparse
 			if err != nil {
 				return false
 			}
+		case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+			_, err := types.StringToQuantizedArray(types.T(col.Typ.Id), field.Val)
+			if err != nil {
+				return false
+			}
 		case types.T_json:
 			if param.Format == tree.CSV {
 				field.Val = fmt.Sprintf("%v", strings.Trim(field.Val, "\""))
@@ -1562,6 +1567,14 @@ func getColData(bat *batch.Batch, line []csvparser.Field, rowIdx int, param *Ext
 		if err = vector.AppendBytes(vec, arrBytes, false, mp); err != nil {
 			return err
 		}
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		arrBytes, err := types.StringToQuantizedArray(vec.GetType().Oid, field.Val)
+		if err != nil {
+			return err
+		}
+		if err = vector.AppendBytes(vec, arrBytes, false, mp); err != nil {
+			return err
+		}
 	case types.T_json:
 		var jsonBytes []byte
 		if param.Extern.Format != tree.CSV {
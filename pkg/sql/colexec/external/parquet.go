@@ -17,6 +17,7 @@ package external
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"errors"
 	"io"
 
@@ -105,21 +106,26 @@ func (h *ParquetHandler) openFile(param *ExternalParam) error {
 func (h *ParquetHandler) prepare(param *ExternalParam) error {
 	h.cols = make([]*parquet.Column, len(param.Attrs))
 	h.mappers = make([]*columnMapper, len(param.Attrs))
+	h.nested = make([]*nestedMapper, len(param.Attrs))
 	for colIdx, attr := range param.Attrs {
 		def := param.Cols[colIdx]
 		if def.Hidden {
 			continue
 		}
 
-		col := h.file.Root().Column(attr)
+		col, path := lookupParquetColumn(h.file.Root(), attr)
 		if col == nil {
 			return moerr.NewInvalidInputf(param.Ctx, "column %s not found", attr)
 		}
-		if !col.Leaf() {
-			return moerr.NewNYIf(param.Ctx, "load group type column %s", attr)
-		}
 
 		h.cols[colIdx] = col
+		if !col.Leaf() || col.MaxRepetitionLevel() > 0 {
+			h.nested[colIdx] = h.getNestedMapper(path, def.Typ)
+			if h.nested[colIdx] == nil {
+				return moerr.NewNYIf(param.Ctx, "load nested column %s to %s", attr, types.T(def.Typ.Id).String())
+			}
+			continue
+		}
 		fn := h.getMapper(col, def.Typ)
 		if fn == nil {
 			st := col.Type().String()
@@ -147,12 +153,14 @@ func (*ParquetHandler) getMapper(sc *parquet.Column, dt plan.Type) *columnMapper
 	if st.PhysicalType() == nil {
 		return nil
 	}
-	if sc.Optional() && dt.NotNullable {
+	// a leaf of an optional group is null if the group is
+	srcNull := sc.MaxDefinitionLevel() > 0
+	if srcNull && dt.NotNullable {
 		return nil
 	}
 
 	mp := &columnMapper{
-		srcNull:            sc.Optional(),
+		srcNull:            srcNull,
 		dstNull:            !dt.NotNullable,
 		maxDefinitionLevel: byte(sc.MaxDefinitionLevel()),
 	}
@@ -196,6 +204,82 @@ func (*ParquetHandler) getMapper(sc *parquet.Column, dt plan.Type) *columnMapper
 			data := page.Data()
 			return copyPageToVec(mp, page, proc, vec, data.Int32())
 		}
+	case types.T_int8:
+		if st.Kind() != parquet.Int32 {
+			break
+		}
+		mp.mapper = func(mp *columnMapper, page parquet.Page, proc *process.Process, vec *vector.Vector) error {
+			if page.Dictionary() != nil {
+				return moerr.NewNYIf(proc.Ctx, "indexed %s page", st)
+			}
+			data := page.Data()
+			return copyPageToVecMap(mp, page, proc, vec, data.Int32(), func(v int32) int8 {
+				return int8(v)
+			})
+		}
+	case types.T_int16:
+		if st.Kind() != parquet.Int32 {
+			break
+		}
+		mp.mapper = func(mp *columnMapper, page parquet.Page, proc *process.Process, vec *vector.Vector) error {
+			if page.Dictionary() != nil {
+				return moerr.NewNYIf(proc.Ctx, "indexed %s page", st)
+			}
+			data := page.Data()
+			return copyPageToVecMap(mp, page, proc, vec, data.Int32(), func(v int32) int16 {
+				return int16(v)
+			})
+		}
+	case types.T_uint8:
+		if st.Kind() != parquet.Int32 {
+			break
+		}
+		mp.mapper = func(mp *columnMapper, page parquet.Page, proc *process.Process, vec *vector.Vector) error {
+			if page.Dictionary() != nil {
+				return moerr.NewNYIf(proc.Ctx, "indexed %s page", st)
+			}
+			data := page.Data()
+			return copyPageToVecMap(mp, page, proc, vec, data.Uint32(), func(v uint32) uint8 {
+				return uint8(v)
+			})
+		}
+	case types.T_uint16:
+		if st.Kind() != parquet.Int32 {
+			break
+		}
+		mp.mapper = func(mp *columnMapper, page parquet.Page, proc *process.Process, vec *vector.Vector) error {
+			if page.Dictionary() != nil {
+				return moerr.NewNYIf(proc.Ctx, "indexed %s page", st)
+			}
+			data := page.Data()
+			return copyPageToVecMap(mp, page, proc, vec, data.Uint32(), func(v uint32) uint16 {
+				return uint16(v)
+			})
+		}
+	case types.T_enum:
+		if st.Kind() == parquet.ByteArray {
+			enumValues := dt.Enumvalues
+			mp.mapper = parsedStrMapper(func(proc *process.Process, vec *vector.Vector, data []byte) error {
+				v, err := types.ParseEnum(enumValues, string(data))
+				if err != nil {
+					return err
+				}
+				return vector.AppendFixed(vec, v, false, proc.Mp())
+			})
+			break
+		}
+		if st.Kind() != parquet.Int32 {
+			break
+		}
+		mp.mapper = func(mp *columnMapper, page parquet.Page, proc *process.Process, vec *vector.Vector) error {
+			if page.Dictionary() != nil {
+				return moerr.NewNYIf(proc.Ctx, "indexed %s page", st)
+			}
+			data := page.Data()
+			return copyPageToVecMap(mp, page, proc, vec, data.Uint32(), func(v uint32) types.Enum {
+				return types.Enum(v)
+			})
+		}
 	case types.T_int64:
 		if st.Kind() != parquet.Int64 {
 			break
@@ -218,7 +302,7 @@ func (*ParquetHandler) getMapper(sc *parquet.Column, dt plan.Type) *columnMapper
 			data := page.Data()
 			return copyPageToVec(mp, page, proc, vec, data.Uint32())
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		if st.Kind() != parquet.Int64 {
 			break
 		}
@@ -266,9 +350,23 @@ func (*ParquetHandler) getMapper(sc *parquet.Column, dt plan.Type) *columnMapper
 				return moerr.NewNYIf(proc.Ctx, "indexed %s page", dateT)
 			}
 			data := page.Data()
-			bs, _ := data.Data()
-			ls := types.DecodeSlice[types.Date](bs)
-			return copyPageToVec(mp, page, proc, vec, ls)
+			return copyPageToVecMap(mp, page, proc, vec, data.Int32(), func(v int32) types.Date {
+				return types.DateFromUnixEpochDays(v)
+			})
+		}
+	case types.T_decimal64, types.T_decimal128:
+		lt := st.LogicalType()
+		if lt == nil {
+			break
+		}
+		// https://github.com/apache/parquet-format/blob/master/LogicalTypes.md#decimal
+		decT := lt.Decimal
+		if decT == nil || decT.Scale != dt.Scale {
+			break
+		}
+		mp.mapper = decimalMapper(st, types.T(dt.Id))
+		if mp.mapper == nil {
+			break
 		}
 	case types.T_timestamp:
 		lt := st.LogicalType()
@@ -336,13 +434,23 @@ func (*ParquetHandler) getMapper(sc *parquet.Column, dt plan.Type) *columnMapper
 	case types.T_time:
 		// https://github.com/apache/parquet-format/blob/master/LogicalTypes.md#time
 		lt := st.LogicalType()
-		if lt == nil {
+		if lt == nil || lt.Time == nil {
+			// a plain int64 is the microseconds of a time, it is how the
+			// times out of the range of a day are exported
+			if st.Kind() != parquet.Int64 {
+				break
+			}
+			mp.mapper = func(mp *columnMapper, page parquet.Page, proc *process.Process, vec *vector.Vector) error {
+				if page.Dictionary() != nil {
+					return moerr.NewNYI(proc.Ctx, "indexed int64 time page")
+				}
+				data := page.Data()
+				bs, _ := data.Data()
+				return copyPageToVec(mp, page, proc, vec, types.DecodeSlice[types.Time](bs))
+			}
 			break
 		}
 		timeT := lt.Time
-		if timeT == nil {
-			break
-		}
 		mp.mapper = func(mp *columnMapper, page parquet.Page, proc *process.Process, vec *vector.Vector) error {
 			if page.Dictionary() != nil {
 				return moerr.NewNYIf(proc.Ctx, "indexed %s page", timeT)
@@ -364,7 +472,56 @@ func (*ParquetHandler) getMapper(sc *parquet.Column, dt plan.Type) *columnMapper
 				return moerr.NewInternalError(proc.Ctx, "unknown unit")
 			}
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_json:
+		if st.Kind() != parquet.ByteArray {
+			break
+		}
+		mp.mapper = parsedStrMapper(func(proc *process.Process, vec *vector.Vector, data []byte) error {
+			bj, err := types.ParseSliceToByteJson(data)
+			if err != nil {
+				return err
+			}
+			bs, err := types.EncodeJson(bj)
+			if err != nil {
+				return err
+			}
+			return vector.AppendBytes(vec, bs, false, proc.Mp())
+		})
+	case types.T_array_float32:
+		if st.Kind() != parquet.ByteArray {
+			break
+		}
+		mp.mapper = parsedStrMapper(func(proc *process.Process, vec *vector.Vector, data []byte) error {
+			bs, err := types.StringToArrayToBytes[float32](string(data))
+			if err != nil {
+				return err
+			}
+			return vector.AppendBytes(vec, bs, false, proc.Mp())
+		})
+	case types.T_array_float64:
+		if st.Kind() != parquet.ByteArray {
+			break
+		}
+		mp.mapper = parsedStrMapper(func(proc *process.Process, vec *vector.Vector, data []byte) error {
+			bs, err := types.StringToArrayToBytes[float64](string(data))
+			if err != nil {
+				return err
+			}
+			return vector.AppendBytes(vec, bs, false, proc.Mp())
+		})
+	case types.T_uuid:
+		if st.Kind() != parquet.ByteArray {
+			break
+		}
+		mp.mapper = parsedStrMapper(func(proc *process.Process, vec *vector.Vector, data []byte) error {
+			v, err := types.ParseUuid(string(data))
+			if err != nil {
+				return err
+			}
+			return vector.AppendFixed(vec, v, false, proc.Mp())
+		})
+	case types.T_char, types.T_varchar, types.T_text, types.T_datalink,
+		types.T_blob, types.T_binary, types.T_varbinary:
 		if st.Kind() != parquet.ByteArray && st.Kind() != parquet.FixedLenByteArray {
 			break
 		}
@@ -436,6 +593,105 @@ func (*ParquetHandler) getMapper(sc *parquet.Column, dt plan.Type) *columnMapper
 	return nil
 }
 
+// decimalMapper maps the unscaled integer of a parquet decimal, which is an
+// INT32, INT64 or big-endian two's complement bytes.
+func decimalMapper(st parquet.Type, dt types.T) func(mp *columnMapper, page parquet.Page, proc *process.Process, vec *vector.Vector) error {
+	switch st.Kind() {
+	case parquet.Int32:
+		return func(mp *columnMapper, page parquet.Page, proc *process.Process, vec *vector.Vector) error {
+			if page.Dictionary() != nil {
+				return moerr.NewNYIf(proc.Ctx, "indexed %s page", st)
+			}
+			data := page.Data()
+			if dt == types.T_decimal64 {
+				return copyPageToVecMap(mp, page, proc, vec, data.Int32(), func(v int32) types.Decimal64 {
+					return types.Decimal64(int64(v))
+				})
+			}
+			return copyPageToVecMap(mp, page, proc, vec, data.Int32(), func(v int32) types.Decimal128 {
+				return decimal128FromInt64(int64(v))
+			})
+		}
+	case parquet.Int64:
+		return func(mp *columnMapper, page parquet.Page, proc *process.Process, vec *vector.Vector) error {
+			if page.Dictionary() != nil {
+				return moerr.NewNYIf(proc.Ctx, "indexed %s page", st)
+			}
+			data := page.Data()
+			if dt == types.T_decimal64 {
+				return copyPageToVecMap(mp, page, proc, vec, data.Int64(), func(v int64) types.Decimal64 {
+					return types.Decimal64(v)
+				})
+			}
+			return copyPageToVecMap(mp, page, proc, vec, data.Int64(), decimal128FromInt64)
+		}
+	case parquet.ByteArray, parquet.FixedLenByteArray:
+		if dt != types.T_decimal128 {
+			return nil
+		}
+		return parsedStrMapper(func(proc *process.Process, vec *vector.Vector, data []byte) error {
+			v, err := decimal128FromBytes(proc.Ctx, data)
+			if err != nil {
+				return err
+			}
+			return vector.AppendFixed(vec, v, false, proc.Mp())
+		})
+	}
+	return nil
+}
+
+// decimal128FromBytes sign-extends a big-endian two's complement integer of
+// at most 16 bytes.
+func decimal128FromBytes(ctx context.Context, data []byte) (types.Decimal128, error) {
+	if len(data) > 16 {
+		return types.Decimal128{}, moerr.NewNYIf(ctx, "decimal of %d bytes", len(data))
+	}
+	var b [16]byte
+	if len(data) > 0 && data[0]&0x80 != 0 {
+		for i := range b {
+			b[i] = 0xff
+		}
+	}
+	copy(b[16-len(data):], data)
+	return types.Decimal128{
+		B0_63:   binary.BigEndian.Uint64(b[8:]),
+		B64_127: binary.BigEndian.Uint64(b[:8]),
+	}, nil
+}
+
+func decimal128FromInt64(v int64) types.Decimal128 {
+	d := types.Decimal128{B0_63: uint64(v)}
+	if v < 0 {
+		d.B64_127 = ^uint64(0)
+	}
+	return d
+}
+
+// parsedStrMapper maps every non null string of the page by fn.
+func parsedStrMapper(fn func(proc *process.Process, vec *vector.Vector, data []byte) error) func(mp *columnMapper, page parquet.Page, proc *process.Process, vec *vector.Vector) error {
+	return func(mp *columnMapper, page parquet.Page, proc *process.Process, vec *vector.Vector) error {
+		p := make([]parquet.Value, page.NumValues())
+		n, err := page.Values().ReadValues(p)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return moerr.ConvertGoError(proc.Ctx, err)
+		}
+		if n != int(page.NumValues()) {
+			return moerr.NewInternalError(proc.Ctx, "short read string")
+		}
+		for _, v := range p {
+			if v.IsNull() {
+				err = vector.AppendBytes(vec, nil, true, proc.Mp())
+			} else {
+				err = fn(proc, vec, v.ByteArray())
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
 type strLoader struct {
 	buf     []byte
 	offsets []uint32
@@ -517,44 +773,19 @@ func (h *ParquetHandler) getData(bat *batch.Batch, param *ExternalParam, proc *p
 		}
 
 		vec := bat.Vecs[colIdx]
-		pages := col.Pages()
-		n := h.batchCnt
-		o := h.offset
-	L:
-		for n > 0 {
-			page, err := pages.ReadPage()
-			switch {
-			case errors.Is(err, io.EOF):
-				finish = true
-				break L
-			case err != nil:
-				return moerr.ConvertGoError(param.Ctx, err)
-			}
-
-			nr := page.NumRows()
-			if nr < o {
-				o -= nr
-				continue
-			}
-
-			if len(page.RepetitionLevels()) != 0 {
-				return moerr.NewNYI(param.Ctx, "page has repetition")
-			}
-
-			if o > 0 || o+n < nr {
-				page = page.Slice(o, min(n+o, nr))
-			}
-			o = 0
-			n -= page.NumRows()
-
-			err = h.mappers[colIdx].mapping(page, proc, vec)
-			if err != nil {
-				return err
-			}
+		var err error
+		if nm := h.nested[colIdx]; nm != nil {
+			finish, err = nm.mapping(h, proc, vec)
+		} else {
+			finish, err = h.readPages(param.Ctx, col, func(page parquet.Page) error {
+				if len(page.RepetitionLevels()) != 0 {
+					return moerr.NewNYI(param.Ctx, "page has repetition")
+				}
+				return h.mappers[colIdx].mapping(page, proc, vec)
+			})
 		}
-		err := pages.Close()
 		if err != nil {
-			return moerr.ConvertGoError(param.Ctx, err)
+			return err
 		}
 		length = vec.Length()
 	}
@@ -574,6 +805,48 @@ func (h *ParquetHandler) getData(bat *batch.Batch, param *ExternalParam, proc *p
 	return nil
 }
 
+// readPages calls fn with the pages of the leaf column which hold the rows
+// of the current batch, it returns true if the column reaches its end.
+func (h *ParquetHandler) readPages(ctx context.Context, col *parquet.Column, fn func(page parquet.Page) error) (bool, error) {
+	finish := false
+	pages := col.Pages()
+	n := h.batchCnt
+	o := h.offset
+L:
+	for n > 0 {
+		page, err := pages.ReadPage()
+		switch {
+		case errors.Is(err, io.EOF):
+			finish = true
+			break L
+		case err != nil:
+			return false, moerr.ConvertGoError(ctx, err)
+		}
+
+		nr := page.NumRows()
+		if nr < o {
+			o -= nr
+			continue
+		}
+
+		if o > 0 || o+n < nr {
+			page = page.Slice(o, min(n+o, nr))
+		}
+		o = 0
+		n -= page.NumRows()
+
+		err = fn(page)
+		if err != nil {
+			return false, err
+		}
+	}
+	err := pages.Close()
+	if err != nil {
+		return false, moerr.ConvertGoError(ctx, err)
+	}
+	return finish, nil
+}
+
 func (mp *columnMapper) pageIsNull(ctx context.Context, page parquet.Page, index int) (bool, error) {
 	if !mp.srcNull || !mp.dstNull {
 		return false, nil
@@ -0,0 +1,521 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/deprecated"
+	"github.com/parquet-go/parquet-go/format"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+type groupAnnotation int
+
+const (
+	groupStruct groupAnnotation = iota
+	groupList
+	groupMap
+)
+
+// nestedMapper maps a group column or a repeated leaf column. The rows are
+// assembled from the repetition and definition levels of its leaf columns,
+// and written as json, or as a vector for a list of numbers.
+type nestedMapper struct {
+	// path from the top level column to the loaded column, the groups above
+	// the loaded column are only walked through
+	path   []*parquet.Column
+	leaves []*parquet.Column
+	// number of leaves under every group column
+	leafCnt map[*parquet.Column]int
+	groups  map[*parquet.Column]groupAnnotation
+	dt      types.T
+	width   int32
+	dstNull bool
+}
+
+// lookupParquetColumn finds the column by its name, or by the dot separated
+// path of a nested field, e.g. `address.city`.
+func lookupParquetColumn(root *parquet.Column, attr string) (*parquet.Column, []*parquet.Column) {
+	if col := root.Column(attr); col != nil {
+		return col, []*parquet.Column{col}
+	}
+	var path []*parquet.Column
+	col := root
+	for _, name := range strings.Split(attr, ".") {
+		if col = col.Column(name); col == nil {
+			return nil, nil
+		}
+		path = append(path, col)
+	}
+	return col, path
+}
+
+// groupAnnotations returns the LIST and MAP annotations of the group columns,
+// parquet.Column only keeps the one of MAP.
+func groupAnnotations(f *parquet.File) map[*parquet.Column]groupAnnotation {
+	elems := f.Metadata().Schema
+	ret := make(map[*parquet.Column]groupAnnotation)
+	idx := 0
+	var walk func(col *parquet.Column)
+	walk = func(col *parquet.Column) {
+		if idx >= len(elems) {
+			return
+		}
+		elem := &elems[idx]
+		idx++
+		if col.Leaf() {
+			return
+		}
+		switch {
+		case isListElement(elem):
+			ret[col] = groupList
+		case isMapElement(elem):
+			ret[col] = groupMap
+		}
+		for _, child := range col.Columns() {
+			walk(child)
+		}
+	}
+	walk(f.Root())
+	return ret
+}
+
+func isListElement(elem *format.SchemaElement) bool {
+	if elem.LogicalType != nil && elem.LogicalType.List != nil {
+		return true
+	}
+	return elem.ConvertedType != nil && *elem.ConvertedType == deprecated.List
+}
+
+func isMapElement(elem *format.SchemaElement) bool {
+	if elem.LogicalType != nil && elem.LogicalType.Map != nil {
+		return true
+	}
+	return elem.ConvertedType != nil &&
+		(*elem.ConvertedType == deprecated.Map || *elem.ConvertedType == deprecated.MapKeyValue)
+}
+
+func (h *ParquetHandler) getNestedMapper(path []*parquet.Column, dt plan.Type) *nestedMapper {
+	col := path[len(path)-1]
+	nullable := false
+	for _, c := range path {
+		// a repeated column is an empty list rather than null
+		if c.Optional() {
+			nullable = true
+		}
+	}
+	if nullable && dt.NotNullable {
+		return nil
+	}
+	if h.groups == nil {
+		h.groups = groupAnnotations(h.file)
+	}
+
+	m := &nestedMapper{
+		path:    path,
+		leafCnt: make(map[*parquet.Column]int),
+		groups:  h.groups,
+		dt:      types.T(dt.Id),
+		width:   dt.Width,
+		dstNull: !dt.NotNullable,
+	}
+	m.countLeaves(col)
+
+	switch m.dt {
+	case types.T_json:
+	case types.T_array_float32, types.T_array_float64:
+		// a list of numbers
+		if len(m.leaves) != 1 || m.leaves[0].MaxRepetitionLevel() == 0 {
+			return nil
+		}
+		switch m.leaves[0].Type().Kind() {
+		case parquet.Int32, parquet.Int64, parquet.Float, parquet.Double:
+		default:
+			return nil
+		}
+	default:
+		return nil
+	}
+	return m
+}
+
+func (m *nestedMapper) countLeaves(col *parquet.Column) int {
+	if col.Leaf() {
+		m.leaves = append(m.leaves, col)
+		return 1
+	}
+	n := 0
+	for _, child := range col.Columns() {
+		n += m.countLeaves(child)
+	}
+	m.leafCnt[col] = n
+	return n
+}
+
+// mapping reads the leaf values of the rows in the batch, and appends the
+// assembled rows to vec.
+func (m *nestedMapper) mapping(h *ParquetHandler, proc *process.Process, vec *vector.Vector) (bool, error) {
+	var finish bool
+	values := make([][]parquet.Value, len(m.leaves))
+	for i, leaf := range m.leaves {
+		var err error
+		finish, err = h.readPages(proc.Ctx, leaf, func(page parquet.Page) error {
+			p := make([]parquet.Value, page.NumValues())
+			n, err := page.Values().ReadValues(p)
+			if err != nil && !errors.Is(err, io.EOF) {
+				return moerr.ConvertGoError(proc.Ctx, err)
+			}
+			if n != int(page.NumValues()) {
+				return moerr.NewInternalError(proc.Ctx, "short read nested column")
+			}
+			// the values may refer to the buffer of the page
+			for j := range p {
+				p[j] = p[j].Clone()
+			}
+			values[i] = append(values[i], p...)
+			return nil
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	// every leaf starts a row by repetition level 0
+	starts := make([][]int, len(m.leaves))
+	for i, vs := range values {
+		for j, v := range vs {
+			if v.RepetitionLevel() == 0 {
+				starts[i] = append(starts[i], j)
+			}
+		}
+		starts[i] = append(starts[i], len(vs))
+		if len(starts[i]) != len(starts[0]) {
+			return false, moerr.NewInvalidInput(proc.Ctx, "malformed nested column")
+		}
+	}
+
+	row := make([][]parquet.Value, len(m.leaves))
+	for r := 0; r+1 < len(starts[0]); r++ {
+		for i := range row {
+			row[i] = values[i][starts[i][r]:starts[i][r+1]]
+		}
+		v, err := m.decode(proc.Ctx, 0, row)
+		if err != nil {
+			return false, err
+		}
+		if err = m.appendValue(proc, vec, v); err != nil {
+			return false, err
+		}
+	}
+	return finish, nil
+}
+
+func (m *nestedMapper) appendValue(proc *process.Process, vec *vector.Vector, v any) error {
+	if v == nil {
+		if !m.dstNull {
+			return moerr.NewConstraintViolationf(proc.Ctx, "Column '%s' cannot be null", m.path[len(m.path)-1].Name())
+		}
+		return vector.AppendBytes(vec, nil, true, proc.Mp())
+	}
+
+	switch m.dt {
+	case types.T_array_float32:
+		arr, err := numberList[float32](proc.Ctx, v, m.width)
+		if err != nil {
+			return err
+		}
+		return vector.AppendBytes(vec, types.ArrayToBytes(arr), false, proc.Mp())
+	case types.T_array_float64:
+		arr, err := numberList[float64](proc.Ctx, v, m.width)
+		if err != nil {
+			return err
+		}
+		return vector.AppendBytes(vec, types.ArrayToBytes(arr), false, proc.Mp())
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return moerr.ConvertGoError(proc.Ctx, err)
+		}
+		bj, err := types.ParseSliceToByteJson(data)
+		if err != nil {
+			return err
+		}
+		data, err = types.EncodeJson(bj)
+		if err != nil {
+			return err
+		}
+		return vector.AppendBytes(vec, data, false, proc.Mp())
+	}
+}
+
+func numberList[T types.RealNumbers](ctx context.Context, v any, width int32) ([]T, error) {
+	list := v.([]any)
+	if width > 0 && len(list) != int(width) {
+		return nil, moerr.NewArrayDefMismatchNoCtx(int(width), len(list))
+	}
+	arr := make([]T, len(list))
+	for i, e := range list {
+		switch n := e.(type) {
+		case int64:
+			arr[i] = T(n)
+		case uint64:
+			arr[i] = T(n)
+		case float32:
+			arr[i] = T(n)
+		case float64:
+			arr[i] = T(n)
+		default:
+			return nil, moerr.NewInvalidInputf(ctx, "vector element %v", e)
+		}
+	}
+	return arr, nil
+}
+
+// decode assembles the value of path[depth] from the leaf values of one
+// instance of its parent. A repeated column becomes a list of its elements.
+func (m *nestedMapper) decode(ctx context.Context, depth int, values [][]parquet.Value) (any, error) {
+	col := m.path[depth]
+	if depth == len(m.path)-1 {
+		return m.decodeColumn(ctx, col, values)
+	}
+	// the groups above the loaded column are only walked through
+	return m.decodeRepeated(ctx, col, values, func(values [][]parquet.Value) (any, error) {
+		if col.Optional() && values[0][0].DefinitionLevel() < col.MaxDefinitionLevel() {
+			return nil, nil
+		}
+		return m.decode(ctx, depth+1, values)
+	})
+}
+
+func (m *nestedMapper) decodeColumn(ctx context.Context, col *parquet.Column, values [][]parquet.Value) (any, error) {
+	return m.decodeRepeated(ctx, col, values, func(values [][]parquet.Value) (any, error) {
+		return m.decodeElement(ctx, col, values)
+	})
+}
+
+// decodeRepeated splits the values of a repeated column into its elements.
+func (m *nestedMapper) decodeRepeated(
+	ctx context.Context,
+	col *parquet.Column,
+	values [][]parquet.Value,
+	decodeElem func(values [][]parquet.Value) (any, error)) (any, error) {
+	if !col.Repeated() {
+		return decodeElem(values)
+	}
+	// an empty list, or the list of a null group
+	if values[0][0].DefinitionLevel() < col.MaxDefinitionLevel() {
+		return []any{}, nil
+	}
+
+	maxRep := col.MaxRepetitionLevel()
+	rest := append([][]parquet.Value(nil), values...)
+	list := []any{}
+	for len(rest[0]) > 0 {
+		elem := make([][]parquet.Value, len(rest))
+		for i, vs := range rest {
+			n := 1
+			for n < len(vs) && vs[n].RepetitionLevel() > maxRep {
+				n++
+			}
+			elem[i], rest[i] = vs[:n], vs[n:]
+		}
+		v, err := decodeElem(elem)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+func (m *nestedMapper) decodeElement(ctx context.Context, col *parquet.Column, values [][]parquet.Value) (any, error) {
+	if col.Optional() && values[0][0].DefinitionLevel() < col.MaxDefinitionLevel() {
+		return nil, nil
+	}
+	if col.Leaf() {
+		return leafJsonValue(ctx, col, values[0][0])
+	}
+
+	children := col.Columns()
+	fields := make([]any, len(children))
+	off := 0
+	for i, child := range children {
+		n := 1
+		if !child.Leaf() {
+			n = m.leafCnt[child]
+		}
+		v, err := m.decodeColumn(ctx, child, values[off:off+n])
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = v
+		off += n
+	}
+
+	switch m.groups[col] {
+	case groupList:
+		// https://github.com/apache/parquet-format/blob/master/LogicalTypes.md#lists
+		list, ok := fields[0].([]any)
+		if len(children) != 1 || !ok {
+			break
+		}
+		repeated := children[0]
+		if repeated.Leaf() || len(repeated.Columns()) != 1 ||
+			repeated.Name() == "array" || repeated.Name() == col.Name()+"_tuple" {
+			return list, nil
+		}
+		for i := range list {
+			if elem, ok := list[i].(map[string]any); ok {
+				list[i] = elem[repeated.Columns()[0].Name()]
+			}
+		}
+		return list, nil
+	case groupMap:
+		// https://github.com/apache/parquet-format/blob/master/LogicalTypes.md#maps
+		list, ok := fields[0].([]any)
+		if len(children) != 1 || !ok || children[0].Leaf() || len(children[0].Columns()) == 0 {
+			break
+		}
+		kv := children[0].Columns()
+		obj := make(map[string]any, len(list))
+		for _, e := range list {
+			entry := e.(map[string]any)
+			key := entry[kv[0].Name()]
+			keyStr, ok := key.(string)
+			if !ok {
+				keyStr = fmt.Sprint(key)
+			}
+			if len(kv) > 1 {
+				obj[keyStr] = entry[kv[1].Name()]
+			} else {
+				obj[keyStr] = nil
+			}
+		}
+		return obj, nil
+	}
+
+	obj := make(map[string]any, len(children))
+	for i, child := range children {
+		obj[child.Name()] = fields[i]
+	}
+	return obj, nil
+}
+
+// leafJsonValue converts a leaf value by its logical type, the numbers are
+// kept exact and the temporal types are written as strings.
+func leafJsonValue(ctx context.Context, col *parquet.Column, v parquet.Value) (any, error) {
+	st := col.Type()
+	lt := st.LogicalType()
+	if lt != nil && lt.Decimal != nil {
+		var d types.Decimal128
+		switch st.Kind() {
+		case parquet.Int32:
+			d = decimal128FromInt64(int64(v.Int32()))
+		case parquet.Int64:
+			d = decimal128FromInt64(v.Int64())
+		default:
+			var err error
+			if d, err = decimal128FromBytes(ctx, v.ByteArray()); err != nil {
+				return nil, err
+			}
+		}
+		return json.Number(d.Format(lt.Decimal.Scale)), nil
+	}
+
+	switch st.Kind() {
+	case parquet.Boolean:
+		return v.Boolean(), nil
+	case parquet.Int32:
+		switch {
+		case lt != nil && lt.Date != nil:
+			return types.DateFromUnixEpochDays(v.Int32()).String(), nil
+		case lt != nil && lt.Time != nil:
+			return types.Time(int64(v.Int32()) * 1000).String2(3), nil
+		case lt != nil && lt.Integer != nil && !lt.Integer.IsSigned:
+			return uint64(v.Uint32()), nil
+		}
+		return int64(v.Int32()), nil
+	case parquet.Int64:
+		switch {
+		case lt != nil && lt.Timestamp != nil:
+			ts, err := unixToTimestamp(ctx, lt.Timestamp.Unit, v.Int64())
+			if err != nil {
+				return nil, err
+			}
+			return types.Datetime(ts).String2(6), nil
+		case lt != nil && lt.Time != nil:
+			t := v.Int64()
+			if lt.Time.Unit.Nanos != nil {
+				t /= 1000
+			}
+			return types.Time(t).String2(6), nil
+		case lt != nil && lt.Integer != nil && !lt.Integer.IsSigned:
+			return v.Uint64(), nil
+		}
+		return v.Int64(), nil
+	case parquet.Float:
+		f := v.Float()
+		if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+			return nil, moerr.NewInvalidInputf(ctx, "float value %v in json", f)
+		}
+		return f, nil
+	case parquet.Double:
+		f := v.Double()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, moerr.NewInvalidInputf(ctx, "float value %v in json", f)
+		}
+		return f, nil
+	case parquet.Int96:
+		return v.Int96().String(), nil
+	default:
+		data := v.ByteArray()
+		switch {
+		case lt != nil && lt.Json != nil:
+			if !json.Valid(data) {
+				return nil, moerr.NewInvalidInputf(ctx, "invalid json %s", data)
+			}
+			return json.RawMessage(data), nil
+		case lt != nil && lt.UUID != nil && len(data) == 16:
+			return types.Uuid(data).String(), nil
+		}
+		return string(data), nil
+	}
+}
+
+func unixToTimestamp(ctx context.Context, unit format.TimeUnit, v int64) (types.Timestamp, error) {
+	switch {
+	case unit.Nanos != nil:
+		return types.UnixNanoToTimestamp(v), nil
+	case unit.Micros != nil:
+		return types.UnixMicroToTimestamp(v), nil
+	case unit.Millis != nil:
+		return types.UnixMicroToTimestamp(v * 1000), nil
+	default:
+		return 0, moerr.NewInternalError(ctx, "unknown unit")
+	}
+}
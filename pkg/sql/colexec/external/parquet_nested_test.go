@@ -0,0 +1,144 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/require"
+)
+
+type nestedItem struct {
+	Name  string  `parquet:"name"`
+	Price float64 `parquet:"price"`
+}
+
+type nestedAddr struct {
+	City string `parquet:"city"`
+	Zip  int32  `parquet:"zip"`
+}
+
+type nestedRow struct {
+	ID    int64            `parquet:"id"`
+	Tags  []string         `parquet:"tags,list"`
+	Emb   []float32        `parquet:"emb,list"`
+	Attrs map[string]int64 `parquet:"attrs"`
+	Addr  *nestedAddr      `parquet:"addr,optional"`
+	Items []nestedItem     `parquet:"items,list"`
+}
+
+func Test_nestedMapper(t *testing.T) {
+	proc := testutil.NewProc()
+
+	var buf bytes.Buffer
+	w := parquet.NewGenericWriter[nestedRow](&buf)
+	_, err := w.Write([]nestedRow{
+		{
+			ID:    1,
+			Tags:  []string{"a", "b"},
+			Emb:   []float32{1, 2, 3},
+			Attrs: map[string]int64{"x": 1},
+			Addr:  &nestedAddr{City: "sh", Zip: 200000},
+			Items: []nestedItem{{Name: "p", Price: 1.5}, {Name: "q", Price: 2}},
+		},
+		{
+			ID:  2,
+			Emb: []float32{4, 5, 6},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	attrs := []string{"id", "tags", "emb", "attrs", "addr", "addr.city", "items", "items.list.element.price"}
+	typs := []types.Type{
+		types.T_int64.ToType(),
+		types.T_json.ToType(),
+		types.New(types.T_array_float32, 3, 0),
+		types.T_json.ToType(),
+		types.T_json.ToType(),
+		types.T_varchar.ToType(),
+		types.T_json.ToType(),
+		types.T_json.ToType(),
+	}
+	param := &ExternalParam{
+		ExParamConst: ExParamConst{
+			Attrs:    attrs,
+			FileSize: []int64{int64(buf.Len())},
+			Ctx:      context.Background(),
+			Extern: &tree.ExternParam{
+				ExParamConst: tree.ExParamConst{
+					ScanType: tree.INLINE,
+					Data:     buf.String(),
+				},
+			},
+		},
+		ExParam: ExParam{
+			Fileparam: &ExFileparam{FileIndex: 1, FileCnt: 1},
+		},
+	}
+	bat := batch.NewWithSize(len(attrs))
+	for i, typ := range typs {
+		param.Cols = append(param.Cols, &plan.ColDef{
+			Typ: plan.Type{
+				Id:    int32(typ.Oid),
+				Width: typ.Width,
+			},
+		})
+		bat.Vecs[i] = vector.NewVec(typ)
+	}
+
+	param.parqh, err = newParquetHandler(param)
+	require.NoError(t, err)
+	require.NoError(t, param.parqh.getData(bat, param, proc))
+	require.Equal(t, 2, bat.RowCount())
+	require.True(t, param.Fileparam.End)
+
+	json := func(col, row int) string {
+		vec := bat.Vecs[col]
+		if vec.IsNull(uint64(row)) {
+			return "NULL"
+		}
+		return types.DecodeJson(vec.GetBytesAt(row)).String()
+	}
+	require.Equal(t, `["a", "b"]`, json(1, 0))
+	require.Equal(t, `[]`, json(1, 1))
+	require.Equal(t, []float32{1, 2, 3}, types.BytesToArray[float32](bat.Vecs[2].GetBytesAt(0)))
+	require.Equal(t, []float32{4, 5, 6}, types.BytesToArray[float32](bat.Vecs[2].GetBytesAt(1)))
+	require.Equal(t, `{"x": 1}`, json(3, 0))
+	require.Equal(t, `{}`, json(3, 1))
+	require.Equal(t, `{"city": "sh", "zip": 200000}`, json(4, 0))
+	require.Equal(t, "NULL", json(4, 1))
+	require.Equal(t, "sh", bat.Vecs[5].GetStringAt(0))
+	require.True(t, bat.Vecs[5].IsNull(1))
+	require.Equal(t, `[{"name": "p", "price": 1.5}, {"name": "q", "price": 2}]`, json(6, 0))
+	require.Equal(t, `[1.5, 2]`, json(7, 0))
+	require.Equal(t, `[]`, json(7, 1))
+	bat.Clean(proc.Mp())
+
+	// a list of strings is not a vector
+	param.Cols[1].Typ = plan.Type{Id: int32(types.T_array_float32)}
+	param.Fileparam = &ExFileparam{FileIndex: 1, FileCnt: 1}
+	_, err = newParquetHandler(param)
+	require.Error(t, err)
+}
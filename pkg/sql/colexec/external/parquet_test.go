@@ -95,6 +95,54 @@ func Test_getMapper(t *testing.T) {
 		}
 	})
 
+	t.Run("decimal and json", func(t *testing.T) {
+		var buf bytes.Buffer
+		schema := parquet.NewSchema("x", parquet.Group{
+			"d64":  parquet.Decimal(2, 18, parquet.Int64Type),
+			"d128": parquet.Decimal(2, 38, parquet.FixedLenByteArrayType(16)),
+			"j":    parquet.JSON(),
+		})
+		w := parquet.NewWriter(&buf, schema)
+		neg := bytes.Repeat([]byte{0xff}, 16)
+		// the columns are sorted by name
+		_, err := w.WriteRows([]parquet.Row{{
+			parquet.FixedLenByteArrayValue(neg).Level(0, 0, 0),
+			parquet.Int64Value(-1234).Level(0, 0, 1),
+			parquet.ByteArrayValue([]byte(`{"a":1}`)).Level(0, 0, 2),
+		}})
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		f, err := parquet.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.NoError(t, err)
+
+		var h ParquetHandler
+		read := func(name string, typ types.Type) *vector.Vector {
+			col := f.Root().Column(name)
+			mp := h.getMapper(col, plan.Type{
+				Id:          int32(typ.Oid),
+				Scale:       typ.Scale,
+				NotNullable: true,
+			})
+			require.NotNil(t, mp)
+			page, err := col.Pages().ReadPage()
+			require.NoError(t, err)
+			vec := vector.NewVec(typ)
+			require.NoError(t, mp.mapping(page, proc, vec))
+			return vec
+		}
+
+		vec := read("d64", types.New(types.T_decimal64, 18, 2))
+		require.Equal(t, "-12.34", vector.GetFixedAtNoTypeCheck[types.Decimal64](vec, 0).Format(2))
+		vec = read("d128", types.New(types.T_decimal128, 38, 2))
+		require.Equal(t, "-0.01", vector.GetFixedAtNoTypeCheck[types.Decimal128](vec, 0).Format(2))
+		vec = read("j", types.T_json.ToType())
+		require.Equal(t, `{"a": 1}`, types.DecodeJson(vec.GetBytesAt(0)).String())
+
+		// the scale must match
+		require.Nil(t, h.getMapper(f.Root().Column("d64"), plan.Type{Id: int32(types.T_decimal64)}))
+	})
+
 	tests := []struct {
 		st          parquet.Type
 		numValues   int
@@ -124,6 +172,20 @@ func Test_getMapper(t *testing.T) {
 			dt:          types.T_int64,
 			expectedOpt: "[0 2 7 0]-[0 3]",
 		},
+		{
+			st:          parquet.Int(16).Type(),
+			numValues:   2,
+			values:      encoding.Int32Values([]int32{-3, 300}),
+			dt:          types.T_int16,
+			expectedOpt: "[0 -3 300 0]-[0 3]",
+		},
+		{
+			st:          parquet.Uint(8).Type(),
+			numValues:   2,
+			values:      encoding.Uint32Values([]uint32{200, 3}),
+			dt:          types.T_uint8,
+			expectedOpt: "[0 200 3 0]-[0 3]",
+		},
 		{
 			st:          parquet.Uint(32).Type(),
 			numValues:   2,
@@ -181,8 +243,8 @@ func Test_getMapper(t *testing.T) {
 			numValues:   2,
 			values:      encoding.Int32Values([]int32{357, 1245}),
 			dt:          types.T_date,
-			expected:    "[0001-12-24 0004-05-30]",
-			expectedOpt: "[0001-01-01 0001-12-24 0004-05-30 0001-01-01]-[0 3]",
+			expected:    "[1970-12-24 1973-05-30]",
+			expectedOpt: "[0001-01-01 1970-12-24 1973-05-30 0001-01-01]-[0 3]",
 		},
 		{
 			st:          parquet.Time(parquet.Nanosecond).Type(),
@@ -208,6 +270,14 @@ func Test_getMapper(t *testing.T) {
 			expected:    "[05:13:03 07:09:43]",
 			expectedOpt: "[00:00:00 05:13:03 07:09:43 00:00:00]-[0 3]",
 		},
+		{
+			st:          parquet.Int(64).Type(),
+			numValues:   2,
+			values:      encoding.Int64Values([]int64{360000_000000, -3600_000000}),
+			dt:          types.T_time,
+			expected:    "[100:00:00 -01:00:00]",
+			expectedOpt: "[00:00:00 100:00:00 -01:00:00 00:00:00]-[0 3]",
+		},
 		{
 			st:          parquet.Timestamp(parquet.Nanosecond).Type(),
 			numValues:   2,
@@ -241,6 +241,9 @@ type ParquetHandler struct {
 	batchCnt int64
 	cols     []*parquet.Column
 	mappers  []*columnMapper
+	nested   []*nestedMapper
+	// LIST and MAP annotations of the group columns
+	groups map[*parquet.Column]groupAnnotation
 }
 
 type columnMapper struct {
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -101,6 +102,8 @@ func (group *Group) Prepare(proc *process.Process) (err error) {
 							width = 128 * 4
 						case types.T_array_float64:
 							width = 128 * 8
+						case types.T_array_float16:
+							width = 128 * 2
 						default:
 							width = 128
 						}
@@ -110,6 +113,8 @@ func (group *Group) Prepare(proc *process.Process) (err error) {
 							width = int(typ.Width) * 4
 						case types.T_array_float64:
 							width = int(typ.Width) * 8
+						case types.T_array_float16:
+							width = int(typ.Width) * 2
 						default:
 							width = int(typ.Width)
 						}
@@ -138,6 +143,7 @@ func (group *Group) Prepare(proc *process.Process) (err error) {
 	}
 
 	group.ctr.state = vm.Build
+	group.ctr.budget = spill.NewBudget(proc)
 	// init the agg.
 	if len(group.Exprs) == 0 {
 		if err = group.ctr.aggWithoutGroupByCannotEmptySet(proc, group); err != nil {
@@ -227,26 +233,29 @@ func (ctr *container) processGroupByAndAgg(ap *Group, proc *process.Process, ana
 					// no group-by clause.
 					err = ctr.processH0()
 
+				} else if ctr.frozen {
+					err = ctr.processFrozen(proc, analyzer, bat.RowCount())
 				} else {
 					// with group-by clause
-					switch ctr.typ {
-					case H8:
-						err = ctr.processH8(bat, proc)
-					case HStr:
-						err = ctr.processHStr(bat, proc)
-					default:
-						err = moerr.NewInternalError(proc.Ctx, "unexpected hashmap typ for group-operator.")
-					}
+					err = ctr.processGroupBy(bat, proc)
 				}
 
 				if err != nil {
 					return result, err
 				}
+
+				if err = ctr.checkBudget(proc, ap, analyzer); err != nil {
+					return result, err
+				}
 			}
 
 		// return the result one by one. todo: I have not modify that, we send result as a big batch now.
 		// if NeedEval the agg, we should flush the agg first.
 		case vm.Eval:
+			if ctr.spiller != nil {
+				return ctr.evalSpilled(proc, ap, analyzer)
+			}
+
 			// the result was empty.
 			if ctr.bat.IsEmpty() {
 				ctr.state = vm.End
@@ -321,6 +330,18 @@ func (ctr *container) processH0() error {
 	return nil
 }
 
+// processGroupBy do group by aggregation with the hashmap of the group-by columns.
+func (ctr *container) processGroupBy(bat *batch.Batch, proc *process.Process) error {
+	switch ctr.typ {
+	case H8:
+		return ctr.processH8(bat, proc)
+	case HStr:
+		return ctr.processHStr(bat, proc)
+	default:
+		return moerr.NewInternalError(proc.Ctx, "unexpected hashmap typ for group-operator.")
+	}
+}
+
 // processH8 do group by aggregation with int hashmap.
 func (ctr *container) processH8(bat *batch.Batch, proc *process.Process) error {
 	count := bat.RowCount()
@@ -328,6 +328,142 @@ func TestGroupOperatorBehavior2(t *testing.T) {
 	}
 }
 
+func TestGroupOperatorSpill(t *testing.T) {
+	// sum(b) group by a with a memory budget of 1 byte, the groups are spilled
+	// after every batch and merged by partitions at the end.
+	proc := testutil.NewProcess()
+	proc.SetResolveVariableFunc(func(string, bool, bool) (interface{}, error) {
+		return int64(1), nil
+	})
+
+	OperatorArgument := &Group{
+		NeedEval: true,
+		Exprs:    []*plan.Expr{newColumnExpression(0, types.T_int64.ToType())},
+		Aggs: []aggexec.AggFuncExecExpression{
+			aggexec.MakeAggFunctionExpression(
+				function.AggSumOverloadID, false,
+				[]*plan.Expr{newColumnExpression(1, types.T_int64.ToType())}, nil),
+		},
+	}
+
+	for k := 2; k > 0; k-- {
+		inputs := []*batch.Batch{
+			{
+				Vecs: []*vector.Vector{
+					testutil.NewInt64Vector(4, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 3, 1}),
+					testutil.NewInt64Vector(4, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 3, 4}),
+				},
+			},
+			{
+				Vecs: []*vector.Vector{
+					testutil.NewInt64Vector(3, types.T_int64.ToType(), proc.Mp(), false, []int64{2, 4, 1}),
+					testutil.NewInt64Vector(3, types.T_int64.ToType(), proc.Mp(), false, []int64{10, 20, 30}),
+				},
+			},
+			nil,
+		}
+		inputs[0].SetRowCount(4)
+		inputs[1].SetRowCount(3)
+		resetChildren(OperatorArgument, inputs)
+
+		require.NoError(t, OperatorArgument.Prepare(proc))
+
+		sums := make(map[int64]int64)
+		for {
+			result, err := OperatorArgument.Call(proc)
+			require.NoError(t, err)
+
+			if result.Status == vm.ExecStop || result.Batch == nil {
+				break
+			}
+			require.Equal(t, 2, len(result.Batch.Vecs))
+			vs0 := vector.MustFixedColWithTypeCheck[int64](result.Batch.Vecs[0])
+			vs1 := vector.MustFixedColWithTypeCheck[int64](result.Batch.Vecs[1])
+			for i := range vs0 {
+				_, ok := sums[vs0[i]]
+				require.False(t, ok, "group %d is output twice", vs0[i])
+				sums[vs0[i]] = vs1[i]
+			}
+		}
+		require.Equal(t, map[int64]int64{1: 1 + 4 + 30, 2: 2 + 10, 3: 3, 4: 20}, sums)
+		require.NotNil(t, OperatorArgument.ctr.spiller)
+
+		OperatorArgument.Reset(proc, false, nil)
+		OperatorArgument.GetChildren(0).Free(proc, false, nil)
+	}
+
+	OperatorArgument.Free(proc, false, nil)
+	OperatorArgument.GetChildren(0).Free(proc, false, nil)
+	proc.Free()
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
+
+func TestGroupOperatorSpillDistinct(t *testing.T) {
+	// sum(distinct b) group by a with a memory budget of 1 byte, the groups in
+	// memory are frozen after the first batch, and the rows of new groups are
+	// spilled as they are.
+	proc := testutil.NewProcess()
+	proc.SetResolveVariableFunc(func(string, bool, bool) (interface{}, error) {
+		return int64(1), nil
+	})
+
+	OperatorArgument := &Group{
+		NeedEval: true,
+		Exprs:    []*plan.Expr{newColumnExpression(0, types.T_int64.ToType())},
+		Aggs: []aggexec.AggFuncExecExpression{
+			aggexec.MakeAggFunctionExpression(
+				function.AggSumOverloadID, true,
+				[]*plan.Expr{newColumnExpression(1, types.T_int64.ToType())}, nil),
+		},
+	}
+
+	inputs := []*batch.Batch{
+		{
+			Vecs: []*vector.Vector{
+				testutil.NewInt64Vector(4, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 3, 1}),
+				testutil.NewInt64Vector(4, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 3, 1}),
+			},
+		},
+		{
+			Vecs: []*vector.Vector{
+				testutil.NewInt64Vector(5, types.T_int64.ToType(), proc.Mp(), false, []int64{2, 4, 1, 4, 5}),
+				testutil.NewInt64Vector(5, types.T_int64.ToType(), proc.Mp(), false, []int64{2, 20, 30, 20, 7}),
+			},
+		},
+		nil,
+	}
+	inputs[0].SetRowCount(4)
+	inputs[1].SetRowCount(5)
+	resetChildren(OperatorArgument, inputs)
+
+	require.NoError(t, OperatorArgument.Prepare(proc))
+
+	sums := make(map[int64]int64)
+	for {
+		result, err := OperatorArgument.Call(proc)
+		require.NoError(t, err)
+
+		if result.Status == vm.ExecStop || result.Batch == nil {
+			break
+		}
+		vs0 := vector.MustFixedColWithTypeCheck[int64](result.Batch.Vecs[0])
+		vs1 := vector.MustFixedColWithTypeCheck[int64](result.Batch.Vecs[1])
+		for i := range vs0 {
+			_, ok := sums[vs0[i]]
+			require.False(t, ok, "group %d is output twice", vs0[i])
+			sums[vs0[i]] = vs1[i]
+		}
+	}
+	require.Equal(t, map[int64]int64{1: 1 + 30, 2: 2, 3: 3, 4: 20, 5: 7}, sums)
+	require.NotNil(t, OperatorArgument.ctr.spiller)
+
+	OperatorArgument.Reset(proc, false, nil)
+	OperatorArgument.GetChildren(0).Free(proc, false, nil)
+	OperatorArgument.Free(proc, false, nil)
+	proc.Free()
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
+
 func newColumnExpression(pos int32, typ types.Type) *plan.Expr {
 	return &plan.Expr{
 		Typ: plan.Type{Id: int32(typ.Oid)},
@@ -72,6 +72,7 @@ func (group *Group) Reset(
 	}
 
 	group.ctr.cleanHashMap()
+	group.ctr.cleanSpill(proc)
 	if group.ProjectList != nil {
 		if group.OpAnalyzer != nil {
 			group.OpAnalyzer.Alloc(group.ProjectAllocSize)
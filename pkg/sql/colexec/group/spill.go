@@ -0,0 +1,568 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"hash"
+	"hash/fnv"
+
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	// spillPartitions is the number of partitions the groups are spilled
+	// into, every partition is merged back in memory alone.
+	spillPartitions = 16
+	// maxSpillLevel is the most times a partition is partitioned again, the
+	// rows left after that likely have the same keys.
+	maxSpillLevel = 4
+)
+
+// spilledPartition is the spilled files of a partition. The files hold the
+// groups with their aggregation states, or the rows as they are if there
+// is a distinct aggregation.
+type spilledPartition struct {
+	level int
+	files []string
+}
+
+// canSpill returns true if the group state can be spilled. The groups of
+// rollup are not spilled as rows.
+func (ctr *container) canSpill(ap *Group) bool {
+	if !ctr.budget.Enabled() || len(ap.Exprs) == 0 {
+		return false
+	}
+	if ap.AnyDistinctAgg() {
+		for _, flag := range ap.GroupingFlag {
+			if !flag {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// memSize returns the memory used by the group-by columns, the hashmap, the
+// aggregation states and the rows waiting to be spilled.
+func (ctr *container) memSize() int64 {
+	size := int64(ctr.bat.Size())
+	for _, ag := range ctr.bat.Aggs {
+		if ag != nil {
+			size += ag.Size()
+		}
+	}
+	if ctr.intHashMap != nil {
+		size += ctr.intHashMap.Size()
+	}
+	if ctr.strHashMap != nil {
+		size += ctr.strHashMap.Size()
+	}
+	for _, buf := range ctr.rawBufs {
+		if buf != nil {
+			size += int64(buf.Size())
+		}
+	}
+	return size
+}
+
+// checkBudget reports the memory of the operator to the budget of the
+// query, and makes room if the query exceeds the budget.
+func (ctr *container) checkBudget(proc *process.Process, ap *Group, analyzer process.Analyzer) error {
+	if !ctr.budget.Enabled() {
+		return nil
+	}
+	if !ctr.budget.Update(ctr.memSize()) || !ctr.canSpill(ap) || ctr.frozen || ctr.spillLevel >= maxSpillLevel {
+		return nil
+	}
+	return ctr.makeRoom(proc, ap, analyzer)
+}
+
+// makeRoom spills the groups in memory. The state of a distinct aggregation
+// cannot be merged, so the groups in memory are frozen instead: they take
+// the rows of their own until the end, and the rows of new groups are
+// spilled as they are.
+func (ctr *container) makeRoom(proc *process.Process, ap *Group, analyzer process.Analyzer) error {
+	if err := ctr.initSpill(proc); err != nil {
+		return err
+	}
+	if ap.AnyDistinctAgg() {
+		ctr.frozen = true
+		return nil
+	}
+	return ctr.spillGroups(proc, ap, analyzer)
+}
+
+func (ctr *container) initSpill(proc *process.Process) (err error) {
+	if ctr.spiller == nil {
+		ctr.spiller, err = spill.NewSpiller(proc, opName)
+	}
+	return err
+}
+
+// writingPartitions returns the partitions the groups in memory are spilled
+// into, they are of the next level.
+func (ctr *container) writingPartitions() []*spilledPartition {
+	if ctr.spillWriting == nil {
+		ctr.spillWriting = make([]*spilledPartition, spillPartitions)
+		for i := range ctr.spillWriting {
+			ctr.spillWriting[i] = &spilledPartition{level: ctr.spillLevel + 1}
+		}
+	}
+	return ctr.spillWriting
+}
+
+// partitionOf returns the partition of the row by the hash of its keys, the
+// hash of every level is different.
+func partitionOf(h hash.Hash32, vecs []*vector.Vector, row int, level int) int {
+	h.Reset()
+	_, _ = h.Write([]byte{byte(level)})
+	for _, vec := range vecs {
+		i := row
+		if vec.IsConst() {
+			i = 0
+		}
+		if vec.IsNull(uint64(i)) {
+			_, _ = h.Write([]byte{0})
+			continue
+		}
+		_, _ = h.Write([]byte{1})
+		_, _ = h.Write(vec.GetRawBytesAt(i))
+	}
+	return int(h.Sum32() % spillPartitions)
+}
+
+// spillGroups partitions the groups in memory by the hash of their keys,
+// spills every partition with its aggregation states, and resets the
+// state of the operator.
+func (ctr *container) spillGroups(proc *process.Process, ap *Group, analyzer process.Analyzer) (err error) {
+	rows := ctr.bat.RowCount()
+	if rows == 0 {
+		return nil
+	}
+	if err = ctr.initSpill(proc); err != nil {
+		return err
+	}
+	parts := ctr.writingPartitions()
+	spilled := ctr.spiller.Size()
+
+	sels := make([][]int64, spillPartitions)
+	h := fnv.New32a()
+	for i := 0; i < rows; i++ {
+		p := partitionOf(h, ctr.bat.Vecs[:len(ap.Exprs)], i, ctr.spillLevel)
+		sels[p] = append(sels[p], int64(i))
+	}
+
+	groups := make([]uint64, rows)
+	for p := range sels {
+		if len(sels[p]) == 0 {
+			continue
+		}
+		for i := range groups {
+			groups[i] = aggexec.GroupNotMatched
+		}
+		for k, sel := range sels[p] {
+			groups[sel] = uint64(k + 1)
+		}
+
+		bat, err := ctr.partitionBatch(proc, ap, sels[p], groups)
+		if err == nil {
+			var name string
+			if name, err = ctr.spiller.Write(proc.Ctx, bat); err == nil {
+				parts[p].files = append(parts[p].files, name)
+			}
+		}
+		bat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	analyzer.Spill(ctr.spiller.Size() - spilled)
+
+	if err = ctr.resetGroupState(proc, ap); err != nil {
+		return err
+	}
+	ctr.budget.Update(ctr.memSize())
+	return nil
+}
+
+// partitionBatch copies the groups of sels and their aggregation states into
+// a new batch, groups[i] is the position + 1 of the i-th group in the batch.
+func (ctr *container) partitionBatch(proc *process.Process, ap *Group, sels []int64, groups []uint64) (*batch.Batch, error) {
+	bat := batch.NewOffHeapWithSize(len(ap.Exprs))
+	for i, vec := range ctr.bat.Vecs[:len(ap.Exprs)] {
+		bat.Vecs[i] = vector.NewOffHeapVecWithType(*vec.GetType())
+		if err := bat.Vecs[i].Union(vec, sels, proc.Mp()); err != nil {
+			return bat, err
+		}
+	}
+	bat.SetRowCount(len(sels))
+
+	bat.Aggs = make([]aggexec.AggFuncExec, len(ap.Aggs))
+	for i, ag := range ap.Aggs {
+		bat.Aggs[i] = aggexec.MakeAgg(proc, ag.GetAggID(), ag.IsDistinct(), ctr.aggVecs[i].Typ...)
+		if config := ag.GetExtraConfig(); config != nil {
+			if err := bat.Aggs[i].SetExtraInformation(config, 0); err != nil {
+				return bat, err
+			}
+		}
+		if err := bat.Aggs[i].GroupGrow(len(sels)); err != nil {
+			return bat, err
+		}
+		if err := bat.Aggs[i].BatchMerge(ctr.bat.Aggs[i], 0, groups); err != nil {
+			return bat, err
+		}
+	}
+	return bat, nil
+}
+
+// processFrozen fills the rows of the groups in memory, and spills the rows
+// of the new groups as they are.
+func (ctr *container) processFrozen(proc *process.Process, analyzer process.Analyzer, count int) error {
+	if ctr.itr == nil {
+		if ctr.typ == H8 {
+			ctr.itr = ctr.intHashMap.NewIterator()
+		} else {
+			ctr.itr = ctr.strHashMap.NewIterator()
+		}
+	}
+	var sels []int64
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := min(count-i, hashmap.UnitLimit)
+		vals, _ := ctr.itr.Find(i, n, ctr.groupVecs.Vec)
+
+		found := false
+		for k, v := range vals[:n] {
+			if v == 0 {
+				sels = append(sels, int64(i+k))
+			} else {
+				found = true
+			}
+		}
+		if !found {
+			continue
+		}
+		for j, ag := range ctr.bat.Aggs {
+			if err := ag.BatchFill(i, vals[:n], ctr.aggVecs[j].Vec); err != nil {
+				return err
+			}
+		}
+	}
+	return ctr.spillRows(proc, analyzer, sels)
+}
+
+// rawVecs returns the group-by columns and the aggregation arguments, which
+// are the columns of the rows spilled as they are.
+func (ctr *container) rawVecs() []*vector.Vector {
+	vecs := append([]*vector.Vector{}, ctr.groupVecs.Vec...)
+	for i := range ctr.aggVecs {
+		vecs = append(vecs, ctr.aggVecs[i].Vec...)
+	}
+	return vecs
+}
+
+// spillRows partitions the rows of sels by the hash of their keys, and
+// spills them once a partition has a full batch.
+func (ctr *container) spillRows(proc *process.Process, analyzer process.Analyzer, sels []int64) error {
+	if len(sels) == 0 {
+		return nil
+	}
+	parts := ctr.writingPartitions()
+	if ctr.rawBufs == nil {
+		ctr.rawBufs = make([]*batch.Batch, spillPartitions)
+	}
+
+	vecs := ctr.rawVecs()
+	partSels := make([][]int64, spillPartitions)
+	h := fnv.New32a()
+	for _, sel := range sels {
+		p := partitionOf(h, ctr.groupVecs.Vec, int(sel), ctr.spillLevel)
+		partSels[p] = append(partSels[p], sel)
+	}
+	for p, ps := range partSels {
+		if len(ps) == 0 {
+			continue
+		}
+		buf := ctr.rawBufs[p]
+		if buf == nil {
+			buf = batch.NewOffHeapWithSize(len(vecs))
+			for i, vec := range vecs {
+				buf.Vecs[i] = vector.NewOffHeapVecWithType(*vec.GetType())
+			}
+			ctr.rawBufs[p] = buf
+		}
+		for i, vec := range vecs {
+			if err := buf.Vecs[i].Union(vec, ps, proc.Mp()); err != nil {
+				return err
+			}
+		}
+		buf.AddRowCount(len(ps))
+		if buf.RowCount() >= colexec.DefaultBatchSize {
+			if err := ctr.flushRows(proc, analyzer, parts[p], buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (ctr *container) flushRows(proc *process.Process, analyzer process.Analyzer, part *spilledPartition, buf *batch.Batch) error {
+	spilled := ctr.spiller.Size()
+	name, err := ctr.spiller.Write(proc.Ctx, buf)
+	if err != nil {
+		return err
+	}
+	part.files = append(part.files, name)
+	analyzer.Spill(ctr.spiller.Size() - spilled)
+	buf.CleanOnlyData()
+	return nil
+}
+
+// finishSpillWriting spills the rows left, and queues the partitions written
+// to be merged next.
+func (ctr *container) finishSpillWriting(proc *process.Process, analyzer process.Analyzer) error {
+	for p, buf := range ctr.rawBufs {
+		if buf == nil {
+			continue
+		}
+		if buf.RowCount() > 0 {
+			if err := ctr.flushRows(proc, analyzer, ctr.spillWriting[p], buf); err != nil {
+				return err
+			}
+		}
+		buf.Clean(proc.Mp())
+	}
+	ctr.rawBufs = nil
+	for p := len(ctr.spillWriting) - 1; p >= 0; p-- {
+		if len(ctr.spillWriting[p].files) > 0 {
+			ctr.spillQueue = append(ctr.spillQueue, ctr.spillWriting[p])
+		}
+	}
+	ctr.spillWriting = nil
+	return nil
+}
+
+// resetGroupState drops all groups in memory and their aggregation states.
+func (ctr *container) resetGroupState(proc *process.Process, ap *Group) error {
+	// drop the flushed aggregation results
+	for i := len(ap.Exprs); i < len(ctr.bat.Vecs); i++ {
+		ctr.bat.Vecs[i].Free(proc.Mp())
+	}
+	ctr.bat.Vecs = ctr.bat.Vecs[:len(ap.Exprs)]
+	for _, vec := range ctr.bat.Vecs {
+		vec.CleanOnlyData()
+	}
+	ctr.bat.SetRowCount(0)
+	for _, ag := range ctr.bat.Aggs {
+		if ag != nil {
+			ag.Free()
+		}
+	}
+	ctr.bat.Aggs = make([]aggexec.AggFuncExec, len(ap.Aggs))
+	if err := ctr.generateAggStructures(proc, ap); err != nil {
+		return err
+	}
+
+	ctr.itr = nil
+	ctr.frozen = false
+	ctr.cleanHashMap()
+	return ctr.initHashMap(proc, ap)
+}
+
+// mergeSpilledPartition reads the next spilled partition and merges its
+// groups in memory, it returns false if all partitions have been merged. A
+// partition still exceeding the budget is partitioned again by the next
+// level, and its partitions are merged first.
+func (ctr *container) mergeSpilledPartition(proc *process.Process, ap *Group, analyzer process.Analyzer) (bool, error) {
+	for len(ctr.spillQueue) > 0 {
+		part := ctr.spillQueue[len(ctr.spillQueue)-1]
+		ctr.spillQueue = ctr.spillQueue[:len(ctr.spillQueue)-1]
+
+		if err := ctr.resetGroupState(proc, ap); err != nil {
+			return false, err
+		}
+		ctr.spillLevel = part.level
+		for _, name := range part.files {
+			bat, err := ctr.spiller.Read(proc, name)
+			if err != nil {
+				return false, err
+			}
+			if ap.AnyDistinctAgg() {
+				err = ctr.processSpilledRows(proc, analyzer, bat)
+			} else {
+				err = ctr.mergeSpilledBatch(proc, bat)
+			}
+			bat.Clean(proc.Mp())
+			if err != nil {
+				return false, err
+			}
+			if err = ctr.checkBudget(proc, ap, analyzer); err != nil {
+				return false, err
+			}
+		}
+
+		if ctr.spillWriting != nil {
+			if !ctr.frozen {
+				if err := ctr.spillGroups(proc, ap, analyzer); err != nil {
+					return false, err
+				}
+			}
+			if err := ctr.finishSpillWriting(proc, analyzer); err != nil {
+				return false, err
+			}
+			// nothing is left in memory
+			if !ctr.frozen {
+				continue
+			}
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func (ctr *container) mergeSpilledBatch(proc *process.Process, bat *batch.Batch) error {
+	var itr hashmap.Iterator
+	if ctr.typ == H8 {
+		itr = ctr.intHashMap.NewIterator()
+	} else {
+		itr = ctr.strHashMap.NewIterator()
+	}
+
+	count := bat.RowCount()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := min(count-i, hashmap.UnitLimit)
+
+		var rows uint64
+		if ctr.typ == H8 {
+			rows = ctr.intHashMap.GroupCount()
+		} else {
+			rows = ctr.strHashMap.GroupCount()
+		}
+		vals, _, err := itr.Insert(i, n, bat.Vecs)
+		if err != nil {
+			return err
+		}
+
+		cnt := 0
+		copy(ctr.inserted[:n], ctr.zInserted[:n])
+		for k, v := range vals[:n] {
+			if v > rows {
+				ctr.inserted[k] = 1
+				rows++
+				cnt++
+			}
+		}
+		if cnt > 0 {
+			ctr.bat.AddRowCount(cnt)
+			for j, vec := range ctr.bat.Vecs {
+				if err = vec.UnionBatch(bat.Vecs[j], int64(i), cnt, ctr.inserted[:n], proc.Mp()); err != nil {
+					return err
+				}
+			}
+			for _, ag := range ctr.bat.Aggs {
+				if err = ag.GroupGrow(cnt); err != nil {
+					return err
+				}
+			}
+		}
+		for j, ag := range ctr.bat.Aggs {
+			if err = ag.BatchMerge(bat.Aggs[j], i, vals[:n]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// processSpilledRows groups the rows spilled as they are.
+func (ctr *container) processSpilledRows(proc *process.Process, analyzer process.Analyzer, bat *batch.Batch) error {
+	n := copy(ctr.groupVecs.Vec, bat.Vecs)
+	for i := range ctr.aggVecs {
+		n += copy(ctr.aggVecs[i].Vec, bat.Vecs[n:])
+	}
+	if ctr.frozen {
+		return ctr.processFrozen(proc, analyzer, bat.RowCount())
+	}
+	return ctr.processGroupBy(bat, proc)
+}
+
+// evalSpilled spills the groups left in memory at the first call, and then
+// returns the result of one spilled partition per call. The frozen groups
+// have got all their rows at the end, they are returned first.
+func (ctr *container) evalSpilled(proc *process.Process, ap *Group, analyzer process.Analyzer) (vm.CallResult, error) {
+	result := vm.NewCallResult()
+	if !ctr.spillMerging {
+		ctr.spillMerging = true
+		if !ctr.frozen {
+			if err := ctr.spillGroups(proc, ap, analyzer); err != nil {
+				return result, err
+			}
+		}
+		if err := ctr.finishSpillWriting(proc, analyzer); err != nil {
+			return result, err
+		}
+		if ctr.frozen && ctr.bat.RowCount() > 0 {
+			return ctr.outputGroups(ap, analyzer)
+		}
+	}
+
+	ok, err := ctr.mergeSpilledPartition(proc, ap, analyzer)
+	if err != nil || !ok {
+		ctr.state = vm.End
+		return result, err
+	}
+	return ctr.outputGroups(ap, analyzer)
+}
+
+func (ctr *container) outputGroups(ap *Group, analyzer process.Analyzer) (vm.CallResult, error) {
+	result := vm.NewCallResult()
+	if ap.NeedEval {
+		aggVectors, err := ctr.getAggResult()
+		if err != nil {
+			return result, err
+		}
+		ctr.bat.Vecs = append(ctr.bat.Vecs, aggVectors...)
+		for _, vec := range aggVectors {
+			analyzer.Alloc(int64(vec.Size()))
+		}
+	}
+	result.Batch = ctr.bat
+	return result, nil
+}
+
+func (ctr *container) cleanSpill(proc *process.Process) {
+	for _, buf := range ctr.rawBufs {
+		if buf != nil {
+			buf.Clean(proc.Mp())
+		}
+	}
+	ctr.rawBufs = nil
+	if ctr.spiller != nil {
+		ctr.spiller.Close(proc.Ctx)
+		ctr.spiller = nil
+	}
+	ctr.spillWriting = nil
+	ctr.spillQueue = nil
+	ctr.spillLevel = 0
+	ctr.spillMerging = false
+	ctr.frozen = false
+	ctr.budget.Release()
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -96,6 +97,22 @@ type container struct {
 	groupVecsNullable bool
 
 	bat *batch.Batch
+
+	// budget is the memory budget of the query, the groups are spilled by
+	// partitions once the query exceeds the budget.
+	budget  spill.Budget
+	spiller *spill.Spiller
+	// spillLevel is the times the groups in memory have been partitioned.
+	spillLevel int
+	// spillWriting is the partitions being spilled into, and spillQueue is
+	// the partitions waiting to be merged.
+	spillWriting []*spilledPartition
+	spillQueue   []*spilledPartition
+	spillMerging bool
+	// frozen is true if no new group is added in memory, the rows of new
+	// groups are buffered in rawBufs and spilled as they are.
+	frozen  bool
+	rawBufs []*batch.Batch
 }
 
 type Group struct {
@@ -167,6 +184,7 @@ func (group *Group) Free(proc *process.Process, pipelineFailed bool, err error)
 	group.ctr.cleanHashMap()
 	group.ctr.cleanAggVectors()
 	group.ctr.cleanGroupVectors()
+	group.ctr.cleanSpill(proc)
 	group.ctr.skipInitReusableMem = false
 
 	group.FreeProjection(proc)
@@ -19,6 +19,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -42,6 +43,10 @@ func (hashBuild *HashBuild) Prepare(proc *process.Process) (err error) {
 		hashBuild.OpAnalyzer.Reset()
 	}
 
+	hashBuild.ctr.budget = spill.Budget{}
+	if hashBuild.CanSpill {
+		hashBuild.ctr.budget = spill.NewBudget(proc)
+	}
 	if hashBuild.NeedHashMap {
 		hashBuild.ctr.hashmapBuilder.IsDedup = hashBuild.IsDedup
 		hashBuild.ctr.hashmapBuilder.OnDuplicateAction = hashBuild.OnDuplicateAction
@@ -83,7 +88,12 @@ func (hashBuild *HashBuild) Call(proc *process.Process) (vm.CallResult, error) {
 
 		case SendJoinMap:
 			var jm *message.JoinMap
-			if ctr.hashmapBuilder.InputBatchRowCount > 0 {
+			if ctr.spilledBuild != nil {
+				jm = message.NewSpilledJoinMap(ctr.spilledBuild, proc.Mp())
+				jm.SetRowCount(int64(ctr.hashmapBuilder.InputBatchRowCount))
+				jm.IncRef(ap.JoinMapRefCnt)
+				ctr.spilledBuild = nil
+			} else if ctr.hashmapBuilder.InputBatchRowCount > 0 {
 				jm = message.NewJoinMap(ctr.hashmapBuilder.MultiSels, ctr.hashmapBuilder.IntHashMap, ctr.hashmapBuilder.StrHashMap, ctr.hashmapBuilder.Batches.Buf, proc.Mp())
 				jm.SetPushedRuntimeFilterIn(ctr.runtimeFilterIn)
 				//jm.SetIgnoreRows(ctr.hashmapBuilder.IgnoreRows)
@@ -122,7 +132,17 @@ func (ctr *container) collectBuildBatches(hashBuild *HashBuild, proc *process.Pr
 
 		analyzer.Alloc(int64(result.Batch.Size()))
 		ctr.hashmapBuilder.InputBatchRowCount += result.Batch.RowCount()
-		err = ctr.hashmapBuilder.Batches.CopyIntoBatches(result.Batch, proc)
+		if ctr.hashmapBuilder.IsSpilled() {
+			err = ctr.hashmapBuilder.SpillBatch(proc, result.Batch)
+		} else {
+			err = ctr.hashmapBuilder.Batches.CopyIntoBatches(result.Batch, proc)
+			ctr.batchesSize += int64(result.Batch.Size())
+			if err == nil && ctr.budget.Update(ctr.batchesSize) {
+				err = ctr.hashmapBuilder.Spill(proc)
+				ctr.batchesSize = 0
+				ctr.budget.Update(0)
+			}
+		}
 		if err != nil {
 			return err
 		}
@@ -135,6 +155,15 @@ func (ctr *container) build(ap *HashBuild, proc *process.Process, analyzer proce
 	if err != nil {
 		return err
 	}
+	if ctr.hashmapBuilder.IsSpilled() {
+		// the join builds the hashmap of every partition itself
+		ctr.spilledBuild, err = ctr.hashmapBuilder.FinishSpill(proc, ap.Conditions, ap.HashOnPK, ap.NeedAllocateSels)
+		if err != nil {
+			return err
+		}
+		analyzer.Spill(ctr.spilledBuild.Size())
+		return nil
+	}
 	if ap.NeedHashMap {
 		needUniqueVec := true
 		if ap.RuntimeFilterSpec == nil || ap.RuntimeFilterSpec.Expr == nil {
@@ -160,7 +189,8 @@ func (ctr *container) handleRuntimeFilter(ap *HashBuild, proc *process.Process)
 	var runtimeFilter message.RuntimeFilterMessage
 	runtimeFilter.Tag = ap.RuntimeFilterSpec.Tag
 
-	if ap.RuntimeFilterSpec.Expr == nil {
+	// the join keys of a spilled build side are not kept in memory
+	if ap.RuntimeFilterSpec.Expr == nil || ctr.spilledBuild != nil {
 		runtimeFilter.Typ = message.RuntimeFilter_PASS
 		message.SendRuntimeFilter(runtimeFilter, ap.RuntimeFilterSpec, proc.GetMessageBoard())
 		return nil
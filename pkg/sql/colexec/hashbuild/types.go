@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/reuse"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashmap_util"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -36,6 +37,13 @@ type container struct {
 	state           int
 	runtimeFilterIn bool
 	hashmapBuilder  hashmap_util.HashmapBuilder
+
+	// budget is the memory budget of the query, the build side is
+	// partitioned to disk once the query exceeds the budget if CanSpill.
+	// batchesSize is the memory of the collected batches.
+	budget       spill.Budget
+	batchesSize  int64
+	spilledBuild *hashmap_util.SpilledBuild
 }
 
 type HashBuild struct {
@@ -48,6 +56,9 @@ type HashBuild struct {
 	JoinMapTag        int32
 	JoinMapRefCnt     int32
 	RuntimeFilterSpec *plan.RuntimeFilterSpec
+	// CanSpill is true if the join can probe a spilled build side
+	// partition by partition, as a grace hash join.
+	CanSpill bool
 
 	IsDedup           bool
 	OnDuplicateAction plan.Node_OnDuplicateAction
@@ -93,6 +104,7 @@ func (hashBuild *HashBuild) Reset(proc *process.Process, pipelineFailed bool, er
 	mapSucceed := hashBuild.ctr.state == SendSucceed
 
 	hashBuild.ctr.hashmapBuilder.Reset(proc, !mapSucceed)
+	hashBuild.ctr.cleanSpilledBuild()
 	hashBuild.ctr.state = BuildHashMap
 	hashBuild.ctr.runtimeFilterIn = false
 	message.FinalizeRuntimeFilter(hashBuild.RuntimeFilterSpec, runtimeSucceed, proc.GetMessageBoard())
@@ -100,4 +112,16 @@ func (hashBuild *HashBuild) Reset(proc *process.Process, pipelineFailed bool, er
 }
 func (hashBuild *HashBuild) Free(proc *process.Process, pipelineFailed bool, err error) {
 	hashBuild.ctr.hashmapBuilder.Free(proc)
+	hashBuild.ctr.cleanSpilledBuild()
+}
+
+// cleanSpilledBuild removes the spilled build side if it was not sent, and
+// returns the memory of the build side to the budget.
+func (ctr *container) cleanSpilledBuild() {
+	if ctr.spilledBuild != nil {
+		ctr.spilledBuild.Free()
+		ctr.spilledBuild = nil
+	}
+	ctr.batchesSize = 0
+	ctr.budget.Release()
 }
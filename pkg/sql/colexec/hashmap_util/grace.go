@@ -0,0 +1,696 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashmap_util
+
+import (
+	"context"
+	"hash"
+	"hash/fnv"
+
+	"github.com/matrixorigin/matrixone/pkg/common/bitmap"
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
+	"github.com/matrixorigin/matrixone/pkg/vm/message"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	// SpillPartitions is the number of partitions of every level of the
+	// grace hash join.
+	SpillPartitions = 16
+	// maxSpillLevel limits the recursive partitioning, the partitions of the
+	// last level are joined in memory whatever their size.
+	maxSpillLevel = 3
+)
+
+type spillFile struct {
+	name string
+	size int64
+	rows int
+	// probe is true if the file is written by the spiller of the probe
+	// side, including the build partitions of the levels after the first.
+	probe bool
+}
+
+func filesSize(files []spillFile) int64 {
+	var size int64
+	for _, f := range files {
+		size += f.size
+	}
+	return size
+}
+
+func filesRows(files []spillFile) int64 {
+	var rows int64
+	for _, f := range files {
+		rows += int64(f.rows)
+	}
+	return rows
+}
+
+// partitionOf returns the partition of the row by the hash of its join keys.
+// The level is mixed into the hash, so that the rows of one partition are
+// split again by the next level.
+func partitionOf(h hash.Hash32, keys []*vector.Vector, row int, level int) int {
+	h.Reset()
+	_, _ = h.Write([]byte{byte(level)})
+	for _, vec := range keys {
+		r := row
+		if vec.IsConst() {
+			r = 0
+		}
+		if vec.IsNull(uint64(r)) {
+			_, _ = h.Write([]byte{0})
+			continue
+		}
+		_, _ = h.Write([]byte{1})
+		_, _ = h.Write(vec.GetRawBytesAt(r))
+	}
+	return int(h.Sum32() % SpillPartitions)
+}
+
+// partitioner splits batches by the hash of their join keys, and spills
+// every partition once it is large enough.
+type partitioner struct {
+	level     int
+	probeSide bool
+	h         hash.Hash32
+	sels      [][]int64
+	bufs      []*batch.Batch
+	files     [][]spillFile
+}
+
+func newPartitioner(level int, probeSide bool) *partitioner {
+	return &partitioner{
+		level:     level,
+		probeSide: probeSide,
+		h:         fnv.New32a(),
+		sels:      make([][]int64, SpillPartitions),
+		bufs:      make([]*batch.Batch, SpillPartitions),
+		files:     make([][]spillFile, SpillPartitions),
+	}
+}
+
+func (p *partitioner) add(proc *process.Process, s *spill.Spiller, bat *batch.Batch, keys []*vector.Vector) error {
+	for i := range p.sels {
+		p.sels[i] = p.sels[i][:0]
+	}
+	for row := 0; row < bat.RowCount(); row++ {
+		k := partitionOf(p.h, keys, row, p.level)
+		p.sels[k] = append(p.sels[k], int64(row))
+	}
+
+	for k, sels := range p.sels {
+		if len(sels) == 0 {
+			continue
+		}
+		if p.bufs[k] == nil {
+			p.bufs[k] = batch.NewWithSize(len(bat.Vecs))
+			for j, vec := range bat.Vecs {
+				p.bufs[k].Vecs[j] = vector.NewVec(*vec.GetType())
+			}
+		}
+		for j, vec := range bat.Vecs {
+			if err := p.bufs[k].Vecs[j].Union(vec, sels, proc.Mp()); err != nil {
+				return err
+			}
+		}
+		p.bufs[k].AddRowCount(len(sels))
+		if p.bufs[k].RowCount() >= colexec.DefaultBatchSize {
+			if err := p.spill(proc, s, k); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *partitioner) spill(proc *process.Process, s *spill.Spiller, k int) error {
+	bat := p.bufs[k]
+	size := s.Size()
+	name, err := s.Write(proc.Ctx, bat)
+	if err != nil {
+		return err
+	}
+	p.files[k] = append(p.files[k], spillFile{name: name, size: s.Size() - size, rows: bat.RowCount(), probe: p.probeSide})
+	bat.CleanOnlyData()
+	return nil
+}
+
+// finish spills the rest of all partitions.
+func (p *partitioner) finish(proc *process.Process, s *spill.Spiller) error {
+	for k, bat := range p.bufs {
+		if bat != nil && bat.RowCount() > 0 {
+			if err := p.spill(proc, s, k); err != nil {
+				return err
+			}
+		}
+	}
+	p.free(proc)
+	return nil
+}
+
+func (p *partitioner) free(proc *process.Process) {
+	for k, bat := range p.bufs {
+		if bat != nil {
+			bat.Clean(proc.Mp())
+			p.bufs[k] = nil
+		}
+	}
+}
+
+// SpilledBuild is the build side of a grace hash join, it is partitioned to
+// the local disk by the hash of the join keys.
+type SpilledBuild struct {
+	spiller    *spill.Spiller
+	partitions [][]spillFile
+
+	conditions       []*plan.Expr
+	hashOnPK         bool
+	needAllocateSels bool
+
+	// the partitions of dedup join are deduplicated when they are built
+	isDedup           bool
+	onDuplicateAction plan.Node_OnDuplicateAction
+	dedupColName      string
+	dedupColTypes     []plan.Type
+}
+
+var _ message.SpilledJoinMap = new(SpilledBuild)
+
+// Size returns the bytes of the spilled build side.
+func (sb *SpilledBuild) Size() int64 {
+	return sb.spiller.Size()
+}
+
+// Free removes the spilled files. It is called when the last join releases
+// the join map, which may be after the query context is canceled.
+func (sb *SpilledBuild) Free() {
+	sb.spiller.Close(context.Background())
+}
+
+// IsSpilled returns true if the build side has been partitioned to disk.
+func (hb *HashmapBuilder) IsSpilled() bool {
+	return hb.partitioner != nil
+}
+
+// Spill partitions the collected batches to disk, the batches received after
+// should be added by SpillBatch.
+func (hb *HashmapBuilder) Spill(proc *process.Process) (err error) {
+	if hb.spiller, err = spill.NewSpiller(proc, "hash_build"); err != nil {
+		return err
+	}
+	hb.partitioner = newPartitioner(0, false)
+	for _, bat := range hb.Batches.Buf {
+		if err = hb.SpillBatch(proc, bat); err != nil {
+			return err
+		}
+	}
+	hb.Batches.Clean(proc.Mp())
+	return nil
+}
+
+// SpillBatch adds the batch into the spilled partitions.
+func (hb *HashmapBuilder) SpillBatch(proc *process.Process, bat *batch.Batch) error {
+	keys, err := hb.evalKeys(proc, bat)
+	if err != nil {
+		return err
+	}
+	return hb.partitioner.add(proc, hb.spiller, bat, keys)
+}
+
+// FinishSpill spills the rest of the partitions and returns the spilled build
+// side, which owns the spilled files from now on.
+func (hb *HashmapBuilder) FinishSpill(proc *process.Process, conditions []*plan.Expr, hashOnPK, needAllocateSels bool) (*SpilledBuild, error) {
+	if err := hb.partitioner.finish(proc, hb.spiller); err != nil {
+		return nil, err
+	}
+	sb := &SpilledBuild{
+		spiller:           hb.spiller,
+		partitions:        hb.partitioner.files,
+		conditions:        conditions,
+		hashOnPK:          hashOnPK,
+		needAllocateSels:  needAllocateSels,
+		isDedup:           hb.IsDedup,
+		onDuplicateAction: hb.OnDuplicateAction,
+		dedupColName:      hb.DedupColName,
+		dedupColTypes:     hb.DedupColTypes,
+	}
+	hb.spiller = nil
+	hb.partitioner = nil
+	return sb, nil
+}
+
+func (hb *HashmapBuilder) evalKeys(proc *process.Process, bat *batch.Batch) ([]*vector.Vector, error) {
+	keys := make([]*vector.Vector, len(hb.executor))
+	for i, executor := range hb.executor {
+		vec, err := executor.Eval(proc, []*batch.Batch{bat}, nil)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = vec
+	}
+	return keys, nil
+}
+
+func (hb *HashmapBuilder) cleanSpill(proc *process.Process) {
+	if hb.partitioner != nil {
+		hb.partitioner.free(proc)
+		hb.partitioner = nil
+	}
+	if hb.spiller != nil {
+		hb.spiller.Close(proc.Ctx)
+		hb.spiller = nil
+	}
+}
+
+type graceTask struct {
+	level int
+	build []spillFile
+	probe []spillFile
+}
+
+// GraceJoin joins the probe side with a spilled build side partition by
+// partition. The probe side is partitioned in the same way as the build
+// side, and a partition whose build side still exceeds the memory budget is
+// partitioned again by the next level.
+type GraceJoin struct {
+	build     *SpilledBuild
+	buildMap  *message.JoinMap
+	memBudget int64
+
+	spiller        *spill.Spiller
+	probeExecutors []colexec.ExpressionExecutor
+	probe          *partitioner
+	builder        HashmapBuilder
+	tasks          []graceTask
+
+	// the join map and probe side of the current partition
+	jm         *message.JoinMap
+	probeFiles []spillFile
+	nextProbe  int
+	probeBat   *batch.Batch
+
+	// keepBuild is set by the joins which output the build rows, see
+	// KeepBuild. leaves are the build side of the partitions joined so far,
+	// matched are their matched rows, nil if a partition has no probe rows.
+	keepBuild bool
+	leaves    [][]spillFile
+	matched   []*bitmap.Bitmap
+	nextLeaf  int
+}
+
+// NewGraceJoin returns a grace join of the spilled join map, it takes the
+// ownership of the join map.
+func NewGraceJoin(proc *process.Process, jm *message.JoinMap, probeConditions []*plan.Expr) (*GraceJoin, error) {
+	g := &GraceJoin{
+		build:     jm.GetSpilled().(*SpilledBuild),
+		buildMap:  jm,
+		memBudget: spill.GetMemoryBudget(proc),
+		probe:     newPartitioner(0, true),
+	}
+
+	var err error
+	if g.spiller, err = spill.NewSpiller(proc, "hash_probe"); err != nil {
+		g.Free(proc)
+		return nil, err
+	}
+	if g.probeExecutors, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, probeConditions); err != nil {
+		g.Free(proc)
+		return nil, err
+	}
+	g.builder.IsDedup = g.build.isDedup
+	g.builder.OnDuplicateAction = g.build.onDuplicateAction
+	g.builder.DedupColName = g.build.dedupColName
+	g.builder.DedupColTypes = g.build.dedupColTypes
+	if err = g.builder.Prepare(g.build.conditions, proc); err != nil {
+		g.Free(proc)
+		return nil, err
+	}
+	return g, nil
+}
+
+func (g *GraceJoin) evalProbeKeys(proc *process.Process, bat *batch.Batch) ([]*vector.Vector, error) {
+	keys := make([]*vector.Vector, len(g.probeExecutors))
+	for i, executor := range g.probeExecutors {
+		vec, err := executor.Eval(proc, []*batch.Batch{bat}, nil)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = vec
+	}
+	return keys, nil
+}
+
+// KeepBuild makes the grace join keep every partition of the build side, for
+// the joins which output the build rows, the right, right semi, right anti
+// and dedup joins. The parallel probes of a join partition the same build
+// side in the same way, so the partitions are joined in the same order, and
+// the position of a build row in MatchedRows is the same for all of them.
+func (g *GraceJoin) KeepBuild() {
+	g.keepBuild = true
+}
+
+// AddProbe adds a batch of the probe side into the spilled partitions.
+func (g *GraceJoin) AddProbe(proc *process.Process, bat *batch.Batch) error {
+	keys, err := g.evalProbeKeys(proc, bat)
+	if err != nil {
+		return err
+	}
+	return g.probe.add(proc, g.spiller, bat, keys)
+}
+
+// FinishProbe is called after all batches of the probe side are added.
+func (g *GraceJoin) FinishProbe(proc *process.Process) error {
+	if err := g.probe.finish(proc, g.spiller); err != nil {
+		return err
+	}
+	for k := SpillPartitions - 1; k >= 0; k-- {
+		g.tasks = append(g.tasks, graceTask{
+			level: 0,
+			build: g.build.partitions[k],
+			probe: g.probe.files[k],
+		})
+	}
+	g.probe = nil
+	return nil
+}
+
+// SpillSize returns the bytes spilled by the probe side and repartitioning,
+// the spilled build side is counted by the hash build.
+func (g *GraceJoin) SpillSize() int64 {
+	return g.spiller.Size()
+}
+
+// NextPartition releases the current partition, and returns the join map of
+// the next partition, or nil if all partitions are joined. The join map is
+// owned by the grace join.
+func (g *GraceJoin) NextPartition(proc *process.Process) (*message.JoinMap, error) {
+	g.freePartition(proc)
+
+	for len(g.tasks) > 0 {
+		task := g.tasks[len(g.tasks)-1]
+		g.tasks = g.tasks[:len(g.tasks)-1]
+
+		// the join operators only output rows of the probe side
+		if len(task.probe) == 0 && !g.keepBuild {
+			continue
+		}
+		if g.memBudget > 0 && task.level < maxSpillLevel && filesSize(task.build) > g.memBudget {
+			if err := g.repartition(proc, task); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if len(task.probe) == 0 {
+			// nothing to probe, all rows of the build side are unmatched
+			g.leaves = append(g.leaves, task.build)
+			g.matched = append(g.matched, nil)
+			continue
+		}
+
+		jm, err := g.buildJoinMap(proc, task.build)
+		if err != nil {
+			return nil, err
+		}
+		g.jm = jm
+		g.probeFiles = task.probe
+		g.nextProbe = 0
+		if g.keepBuild {
+			matched := &bitmap.Bitmap{}
+			matched.InitWithSize(filesRows(task.build))
+			g.leaves = append(g.leaves, task.build)
+			g.matched = append(g.matched, matched)
+		}
+		return jm, nil
+	}
+	return nil, nil
+}
+
+// Matched returns the matched rows of the build side of the current
+// partition, it is only valid if KeepBuild.
+func (g *GraceJoin) Matched() *bitmap.Bitmap {
+	return g.matched[len(g.matched)-1]
+}
+
+// MatchedRows returns the matched rows of the build side of all partitions,
+// a partition takes as many positions as the rows spilled.
+func (g *GraceJoin) MatchedRows() *bitmap.Bitmap {
+	var rows int64
+	for _, files := range g.leaves {
+		rows += filesRows(files)
+	}
+	all := &bitmap.Bitmap{}
+	all.InitWithSize(rows)
+
+	var offset uint64
+	for i, files := range g.leaves {
+		if m := g.matched[i]; m != nil {
+			itr := m.Iterator()
+			for itr.HasNext() {
+				all.Add(offset + itr.Next())
+			}
+		}
+		offset += uint64(filesRows(files))
+	}
+	return all
+}
+
+// MergeMatched replaces the matched rows of all partitions by the matched
+// rows merged from all parallel probes, which is returned by MatchedRows.
+func (g *GraceJoin) MergeMatched(all *bitmap.Bitmap) {
+	var offset uint64
+	for i, files := range g.leaves {
+		rows := uint64(filesRows(files))
+		m := &bitmap.Bitmap{}
+		m.InitWithSize(int64(rows))
+		for row := uint64(0); row < rows; row++ {
+			if all.Contains(offset + row) {
+				m.Add(row)
+			}
+		}
+		g.matched[i] = m
+		offset += rows
+	}
+}
+
+// NextBuild rebuilds the join map of the partitions joined before one by one,
+// and returns it with its matched rows, or nil if there is no more partition.
+// It is called after all partitions are joined, for the joins which output
+// the build rows. The join map is owned by the grace join.
+func (g *GraceJoin) NextBuild(proc *process.Process) (*message.JoinMap, *bitmap.Bitmap, error) {
+	g.freePartition(proc)
+	if g.nextLeaf >= len(g.leaves) {
+		return nil, nil, nil
+	}
+	jm, err := g.buildJoinMap(proc, g.leaves[g.nextLeaf])
+	if err != nil {
+		return nil, nil, err
+	}
+	g.jm = jm
+
+	// the build side of dedup join may shrink when it is built again, the
+	// bitmap is cut to the rows of the join map
+	var rows int64
+	for _, bat := range jm.GetBatches() {
+		rows += int64(bat.RowCount())
+	}
+	matched := &bitmap.Bitmap{}
+	matched.InitWithSize(rows)
+	if m := g.matched[g.nextLeaf]; m != nil {
+		itr := m.Iterator()
+		for itr.HasNext() {
+			if row := itr.Next(); row < uint64(rows) {
+				matched.Add(row)
+			}
+		}
+	}
+	g.nextLeaf++
+	return jm, matched, nil
+}
+
+// NextProbe returns the next batch of the probe side of the current
+// partition, or nil if there is no more batch. The batch is valid until the
+// next call.
+func (g *GraceJoin) NextProbe(proc *process.Process) (*batch.Batch, error) {
+	if g.probeBat != nil {
+		g.probeBat.Clean(proc.Mp())
+		g.probeBat = nil
+	}
+	if g.nextProbe >= len(g.probeFiles) {
+		return nil, nil
+	}
+	bat, err := g.spiller.Read(proc, g.probeFiles[g.nextProbe].name)
+	if err != nil {
+		return nil, err
+	}
+	g.nextProbe++
+	g.probeBat = bat
+	return bat, nil
+}
+
+func (g *GraceJoin) repartition(proc *process.Process, task graceTask) error {
+	// the build partitions of the next level are written by the spiller of
+	// the grace join
+	build := newPartitioner(task.level+1, true)
+	defer build.free(proc)
+	for _, f := range task.build {
+		bat, err := g.readBuild(proc, f)
+		if err != nil {
+			return err
+		}
+		keys, err := g.builder.evalKeys(proc, bat)
+		if err == nil {
+			err = build.add(proc, g.spiller, bat, keys)
+		}
+		bat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	if err := build.finish(proc, g.spiller); err != nil {
+		return err
+	}
+
+	probe := newPartitioner(task.level+1, true)
+	defer probe.free(proc)
+	for _, f := range task.probe {
+		bat, err := g.spiller.Read(proc, f.name)
+		if err != nil {
+			return err
+		}
+		keys, err := g.evalProbeKeys(proc, bat)
+		if err == nil {
+			err = probe.add(proc, g.spiller, bat, keys)
+		}
+		bat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	if err := probe.finish(proc, g.spiller); err != nil {
+		return err
+	}
+
+	for k := SpillPartitions - 1; k >= 0; k-- {
+		g.tasks = append(g.tasks, graceTask{
+			level: task.level + 1,
+			build: build.files[k],
+			probe: probe.files[k],
+		})
+	}
+	return nil
+}
+
+func (g *GraceJoin) readBuild(proc *process.Process, f spillFile) (*batch.Batch, error) {
+	if f.probe {
+		return g.spiller.Read(proc, f.name)
+	}
+	return g.build.spiller.Read(proc, f.name)
+}
+
+// buildJoinMap builds the hashmap of one partition of the build side.
+func (g *GraceJoin) buildJoinMap(proc *process.Process, files []spillFile) (*message.JoinMap, error) {
+	hb := &g.builder
+	for _, f := range files {
+		bat, err := g.readBuild(proc, f)
+		if err != nil {
+			return nil, err
+		}
+		hb.InputBatchRowCount += bat.RowCount()
+		err = hb.Batches.CopyIntoBatches(bat, proc)
+		bat.Clean(proc.Mp())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var jm *message.JoinMap
+	if hb.InputBatchRowCount == 0 {
+		// a join map without any row, so that the probe rows of the partition
+		// are joined as unmatched
+		var err error
+		if hb.keyWidth <= 8 {
+			hb.IntHashMap, err = hashmap.NewIntHashMap(false)
+		} else {
+			hb.StrHashMap, err = hashmap.NewStrMap(false)
+		}
+		if err != nil {
+			return nil, err
+		}
+		jm = message.NewJoinMap(message.JoinSels{}, hb.IntHashMap, hb.StrHashMap, nil, proc.Mp())
+	} else {
+		if err := hb.BuildHashmap(g.build.hashOnPK, g.build.needAllocateSels, false, proc); err != nil {
+			hb.Reset(proc, true)
+			return nil, err
+		}
+		jm = message.NewJoinMap(hb.MultiSels, hb.IntHashMap, hb.StrHashMap, hb.Batches.Buf, proc.Mp())
+		jm.SetRowCount(int64(hb.InputBatchRowCount))
+	}
+	jm.IncRef(1)
+
+	// the hashmap and batches are owned by the join map now
+	hb.IntHashMap = nil
+	hb.StrHashMap = nil
+	hb.Batches.Reset()
+	hb.Reset(proc, false)
+	return jm, nil
+}
+
+func (g *GraceJoin) freePartition(proc *process.Process) {
+	if g.probeBat != nil {
+		g.probeBat.Clean(proc.Mp())
+		g.probeBat = nil
+	}
+	if g.jm != nil {
+		g.jm.Free()
+		g.jm = nil
+	}
+	g.probeFiles = nil
+	g.nextProbe = 0
+}
+
+// Free releases the current partition, the spilled probe side and the
+// spilled join map.
+func (g *GraceJoin) Free(proc *process.Process) {
+	g.freePartition(proc)
+	if g.probe != nil {
+		g.probe.free(proc)
+		g.probe = nil
+	}
+	g.builder.Free(proc)
+	for _, executor := range g.probeExecutors {
+		if executor != nil {
+			executor.Free()
+		}
+	}
+	g.probeExecutors = nil
+	if g.spiller != nil {
+		g.spiller.Close(proc.Ctx)
+		g.spiller = nil
+	}
+	if g.buildMap != nil {
+		g.buildMap.Free()
+		g.buildMap = nil
+	}
+	g.tasks = nil
+	g.leaves = nil
+	g.matched = nil
+	g.nextLeaf = 0
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -49,6 +50,10 @@ type HashmapBuilder struct {
 	DedupColTypes     []plan.Type
 
 	IgnoreRows *bitmap.Bitmap
+
+	// the build side partitioned to disk by the grace hash join.
+	spiller     *spill.Spiller
+	partitioner *partitioner
 }
 
 func (hb *HashmapBuilder) GetSize() int64 {
@@ -119,6 +124,7 @@ func (hb *HashmapBuilder) Reset(proc *process.Process, hashTableHasNotSent bool)
 	}
 	hb.UniqueJoinKeys = nil
 	hb.MultiSels.Free()
+	hb.cleanSpill(proc)
 	for i := range hb.executor {
 		if hb.executor[i] != nil {
 			hb.executor[i].ResetForNextQuery()
@@ -128,6 +134,7 @@ func (hb *HashmapBuilder) Reset(proc *process.Process, hashTableHasNotSent bool)
 
 func (hb *HashmapBuilder) Free(proc *process.Process) {
 	hb.needDupVec = false
+	hb.cleanSpill(proc)
 	hb.Batches.Reset()
 	hb.IntHashMap = nil
 	hb.StrHashMap = nil
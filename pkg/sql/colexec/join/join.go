@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashmap_util"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -88,13 +89,62 @@ func (innerJoin *InnerJoin) Call(proc *process.Process) (vm.CallResult, error) {
 				return result, err
 			}
 
-			if ctr.mp == nil && !innerJoin.IsShuffle {
+			if ctr.grace != nil {
+				ctr.state = SpillProbe
+			} else if ctr.mp == nil && !innerJoin.IsShuffle {
 				// for inner ,right and semi join, if hashmap is empty, we can finish this pipeline
 				// shuffle join can't stop early for this moment
 				ctr.state = End
 			} else {
 				ctr.state = Probe
 			}
+		case SpillProbe:
+			input, err = vm.ChildrenCall(innerJoin.Children[0], proc, analyzer)
+			if err != nil {
+				return input, err
+			}
+			bat := input.Batch
+			if bat == nil {
+				if err = ctr.grace.FinishProbe(proc); err != nil {
+					return result, err
+				}
+				ctr.state = GraceProbe
+				continue
+			}
+			if bat.Last() {
+				result.Batch = bat
+				analyzer.Output(result.Batch)
+				return result, nil
+			}
+			if bat.IsEmpty() {
+				continue
+			}
+			if err = ctr.grace.AddProbe(proc, bat); err != nil {
+				return result, err
+			}
+
+		case GraceProbe:
+			if ctr.inbat == nil {
+				bat, err := ctr.grace.NextProbe(proc)
+				if err != nil {
+					return result, err
+				}
+				if bat == nil {
+					ok, err := ctr.nextGracePartition(proc)
+					if err != nil {
+						return result, err
+					}
+					if !ok {
+						analyzer.Spill(ctr.grace.SpillSize())
+						ctr.state = End
+					}
+					continue
+				}
+				ctr.inbat = bat
+				ctr.lastRow = 0
+			}
+			fallthrough
+
 		case Probe:
 			if innerJoin.ctr.inbat == nil {
 				input, err = vm.ChildrenCall(innerJoin.Children[0], proc, analyzer)
@@ -147,6 +197,23 @@ func (innerJoin *InnerJoin) Call(proc *process.Process) (vm.CallResult, error) {
 	}
 }
 
+// nextGracePartition switches to the next partition of the grace join which
+// has any row of the build side, it returns false if all partitions are joined.
+func (ctr *container) nextGracePartition(proc *process.Process) (bool, error) {
+	for {
+		mp, err := ctr.grace.NextPartition(proc)
+		ctr.mp = mp
+		if err != nil || mp == nil {
+			return false, err
+		}
+		if mp.GetRowCount() > 0 {
+			ctr.itr = nil
+			ctr.batchRowCount = mp.GetRowCount()
+			return true, nil
+		}
+	}
+}
+
 func (innerJoin *InnerJoin) build(analyzer process.Analyzer, proc *process.Process) (err error) {
 	ctr := &innerJoin.ctr
 	start := time.Now()
@@ -155,6 +222,13 @@ func (innerJoin *InnerJoin) build(analyzer process.Analyzer, proc *process.Proce
 	if err != nil {
 		return err
 	}
+	if ctr.mp.GetSpilled() != nil {
+		// the build side is spilled, join it as a grace hash join
+		mp := ctr.mp
+		ctr.mp = nil
+		ctr.grace, err = hashmap_util.NewGraceJoin(proc, mp, innerJoin.Conditions[0])
+		return err
+	}
 	if ctr.mp != nil {
 		ctr.maxAllocSize = max(ctr.maxAllocSize, ctr.mp.Size())
 	}
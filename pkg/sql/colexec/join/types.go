@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashmap_util"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -31,6 +32,8 @@ var _ vm.Operator = new(InnerJoin)
 const (
 	Build = iota
 	Probe
+	SpillProbe
+	GraceProbe
 	End
 )
 
@@ -56,6 +59,9 @@ type container struct {
 	vecs     []*vector.Vector
 
 	mp *message.JoinMap
+	// grace joins a spilled build side partition by partition, mp is the
+	// join map of the current partition which is owned by grace.
+	grace *hashmap_util.GraceJoin
 
 	maxAllocSize int64
 }
@@ -124,6 +130,7 @@ func (innerJoin *InnerJoin) Reset(proc *process.Process, pipelineFailed bool, er
 	ctr.itr = nil
 	ctr.resetExecutor()
 	ctr.resetExprExecutor()
+	ctr.cleanGrace(proc)
 	ctr.cleanHashMap()
 	ctr.inbat = nil
 	ctr.lastRow = 0
@@ -150,6 +157,7 @@ func (innerJoin *InnerJoin) Free(proc *process.Process, pipelineFailed bool, err
 
 	ctr.cleanExecutor()
 	ctr.cleanExprExecutor()
+	ctr.cleanGrace(proc)
 	ctr.cleanBatch(proc)
 
 	innerJoin.FreeProjection(proc)
@@ -181,6 +189,14 @@ func (ctr *container) cleanBatch(proc *process.Process) {
 	}
 }
 
+func (ctr *container) cleanGrace(proc *process.Process) {
+	if ctr.grace != nil {
+		ctr.mp = nil
+		ctr.grace.Free(proc)
+		ctr.grace = nil
+	}
+}
+
 func (ctr *container) cleanHashMap() {
 	if ctr.mp != nil {
 		ctr.mp.Free()
@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashmap_util"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -87,7 +88,58 @@ func (leftJoin *LeftJoin) Call(proc *process.Process) (vm.CallResult, error) {
 			if err != nil {
 				return result, err
 			}
-			ctr.state = Probe
+			if ctr.grace != nil {
+				ctr.state = SpillProbe
+			} else {
+				ctr.state = Probe
+			}
+
+		case SpillProbe:
+			input, err = vm.ChildrenCall(leftJoin.GetChildren(0), proc, analyzer)
+			if err != nil {
+				return result, err
+			}
+			bat := input.Batch
+			if bat == nil {
+				if err = ctr.grace.FinishProbe(proc); err != nil {
+					return result, err
+				}
+				ctr.state = GraceProbe
+				continue
+			}
+			if bat.Last() {
+				result.Batch = bat
+				analyzer.Output(result.Batch)
+				return result, nil
+			}
+			if bat.IsEmpty() {
+				continue
+			}
+			if err = ctr.grace.AddProbe(proc, bat); err != nil {
+				return result, err
+			}
+
+		case GraceProbe:
+			if ctr.inbat == nil {
+				bat, err := ctr.grace.NextProbe(proc)
+				if err != nil {
+					return result, err
+				}
+				if bat == nil {
+					ok, err := ctr.nextGracePartition(proc)
+					if err != nil {
+						return result, err
+					}
+					if !ok {
+						analyzer.Spill(ctr.grace.SpillSize())
+						ctr.state = End
+					}
+					continue
+				}
+				ctr.inbat = bat
+				ctr.lastRow = 0
+			}
+			fallthrough
 
 		case Probe:
 			if leftJoin.ctr.inbat == nil {
@@ -158,6 +210,20 @@ func (leftJoin *LeftJoin) Call(proc *process.Process) (vm.CallResult, error) {
 	}
 }
 
+// nextGracePartition switches to the next partition of the grace join, the
+// join map of a partition without build rows is empty but not nil, so that
+// all the probe rows of it are output with nulls.
+func (ctr *container) nextGracePartition(proc *process.Process) (bool, error) {
+	mp, err := ctr.grace.NextPartition(proc)
+	ctr.mp = mp
+	if err != nil || mp == nil {
+		return false, err
+	}
+	ctr.itr = nil
+	ctr.batchRowCount = mp.GetRowCount()
+	return true, nil
+}
+
 func (leftJoin *LeftJoin) build(analyzer process.Analyzer, proc *process.Process) (err error) {
 	ctr := &leftJoin.ctr
 	start := time.Now()
@@ -166,6 +232,13 @@ func (leftJoin *LeftJoin) build(analyzer process.Analyzer, proc *process.Process
 	if err != nil {
 		return err
 	}
+	if ctr.mp.GetSpilled() != nil {
+		// the build side is spilled, join it as a grace hash join
+		mp := ctr.mp
+		ctr.mp = nil
+		ctr.grace, err = hashmap_util.NewGraceJoin(proc, mp, leftJoin.Conditions[0])
+		return err
+	}
 	if ctr.mp != nil {
 		ctr.maxAllocSize = max(ctr.maxAllocSize, ctr.mp.Size())
 	}
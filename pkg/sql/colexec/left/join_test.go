@@ -192,6 +192,56 @@ func TestJoin(t *testing.T) {
 		}
 	}
 */
+func TestJoinSpill(t *testing.T) {
+	tc := newTestCase([]bool{false}, []types.Type{types.T_int32.ToType()}, []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)},
+		[][]*plan.Expr{
+			{
+				newExpr(0, types.T_int32.ToType()),
+			},
+			{
+				newExpr(0, types.T_int32.ToType()),
+			},
+		})
+	// spill the build side and repartition it to the deepest level
+	tc.proc.SetResolveVariableFunc(func(string, bool, bool) (interface{}, error) {
+		return int64(1), nil
+	})
+	tc.barg.CanSpill = true
+
+	resetChildren(tc.arg)
+	// all the join keys of the build side are null, every probe row is unmatched
+	tc.barg.Children = nil
+	tc.barg.AppendChild(colexec.NewMockOperator().WithBatchs([]*batch.Batch{colexec.MakeMockBatchsWithNullVec()}))
+	err := tc.arg.Prepare(tc.proc)
+	require.NoError(t, err)
+	err = tc.barg.Prepare(tc.proc)
+	require.NoError(t, err)
+
+	res, err := tc.barg.Call(tc.proc)
+	require.NoError(t, err)
+	require.Equal(t, res.Batch == nil, true)
+
+	rows := 0
+	for {
+		res, err = tc.arg.Call(tc.proc)
+		require.NoError(t, err)
+		if res.Batch == nil {
+			break
+		}
+		rows += res.Batch.RowCount()
+		require.True(t, res.Batch.Vecs[1].IsConstNull() || res.Batch.Vecs[1].GetNulls().Count() == res.Batch.RowCount())
+	}
+	require.Equal(t, tc.resultBatch.RowCount(), rows)
+	require.NotNil(t, tc.arg.ctr.grace)
+
+	tc.arg.Reset(tc.proc, false, nil)
+	tc.barg.Reset(tc.proc, false, nil)
+	tc.arg.Free(tc.proc, false, nil)
+	tc.barg.Free(tc.proc, false, nil)
+	tc.proc.Free()
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
 func newExpr(pos int32, typ types.Type) *plan.Expr {
 	return &plan.Expr{
 		Typ: plan.Type{
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashmap_util"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -32,6 +33,8 @@ var _ vm.Operator = new(LeftJoin)
 const (
 	Build = iota
 	Probe
+	SpillProbe
+	GraceProbe
 	End
 )
 
@@ -55,6 +58,9 @@ type container struct {
 	vecs     []*vector.Vector
 
 	mp *message.JoinMap
+	// grace joins a spilled build side partition by partition, mp is the
+	// join map of the current partition which is owned by grace.
+	grace *hashmap_util.GraceJoin
 
 	maxAllocSize int64
 }
@@ -112,6 +118,7 @@ func (leftJoin *LeftJoin) Reset(proc *process.Process, pipelineFailed bool, err
 	ctr.itr = nil
 	ctr.resetExecutor()
 	ctr.resetExprExecutor()
+	ctr.cleanGrace(proc)
 	ctr.cleanHashMap()
 	ctr.inbat = nil
 	ctr.lastRow = 0
@@ -137,6 +144,7 @@ func (leftJoin *LeftJoin) Free(proc *process.Process, pipelineFailed bool, err e
 
 	ctr.cleanExecutor()
 	ctr.cleanExprExecutor()
+	ctr.cleanGrace(proc)
 	ctr.cleanBatch(proc)
 
 	leftJoin.FreeProjection(proc)
@@ -167,6 +175,14 @@ func (ctr *container) cleanBatch(proc *process.Process) {
 	}
 }
 
+func (ctr *container) cleanGrace(proc *process.Process) {
+	if ctr.grace != nil {
+		ctr.mp = nil
+		ctr.grace.Free(proc)
+		ctr.grace = nil
+	}
+}
+
 func (ctr *container) cleanHashMap() {
 	if ctr.mp != nil {
 		ctr.mp.Free()
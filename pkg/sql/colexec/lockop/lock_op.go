@@ -18,6 +18,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
+	"slices"
 	"strings"
 	"time"
 
@@ -26,6 +28,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/reuse"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/common/util"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -120,6 +123,13 @@ func callNonBlocking(
 	analyzer.Start()
 	defer analyzer.Stop()
 
+	// all the rows required by the limit are locked, no more rows are needed.
+	if lockOp.limit > 0 && lockOp.ctr.lockedRows >= lockOp.limit {
+		result := vm.NewCallResult()
+		result.Status = vm.ExecStop
+		return result, nil
+	}
+
 	result, err := vm.ChildrenCall(lockOp.GetChildren(0), proc, analyzer)
 	if err != nil {
 		return result, err
@@ -138,9 +148,21 @@ func callNonBlocking(
 	}
 
 	lockOp.ctr.lockCount += int64(result.Batch.RowCount())
+	if lockOp.limit > 0 {
+		if result.Batch, err = lockOp.lockWithLimit(proc, analyzer, result.Batch); err != nil {
+			return result, err
+		}
+		analyzer.Output(result.Batch)
+		return result, nil
+	}
 	if err = performLock(result.Batch, proc, lockOp, analyzer, -1); err != nil {
 		return result, err
 	}
+	if len(lockOp.ctr.skippedSels) > 0 {
+		if result.Batch, err = lockOp.ctr.removeSkippedRows(proc, result.Batch); err != nil {
+			return result, err
+		}
+	}
 
 	analyzer.Output(result.Batch)
 	return result, nil
@@ -154,6 +176,7 @@ func performLock(
 	targetIdx int,
 ) error {
 	needRetry := false
+	lockOp.ctr.skippedSels = lockOp.ctr.skippedSels[:0]
 	for idx, target := range lockOp.targets {
 		if targetIdx != -1 && targetIdx != idx {
 			continue
@@ -178,7 +201,7 @@ func performLock(
 				}
 			}
 		}
-		locked, defChanged, refreshTS, err := doLock(
+		locked, defChanged, refreshTS, skipped, err := doLock(
 			proc.Ctx,
 			lockOp.engine,
 			analyzer,
@@ -188,11 +211,12 @@ func performLock(
 			priVec,
 			target.primaryColumnType,
 			DefaultLockOptions(lockOp.ctr.parker).
-				WithLockMode(lock.LockMode_Exclusive).
+				WithLockMode(target.mode).
 				WithFetchLockRowsFunc(lockOp.ctr.fetchers[idx]).
 				WithMaxBytesPerLock(int(proc.GetLockService().GetConfig().MaxLockRowCount)).
 				WithFilterRows(target.filter, filterCols).
 				WithLockTable(target.lockTable, target.changeDef).
+				WithWaitPolicy(target.waitPolicy, target.waitTimeout).
 				WithHasNewVersionInRangeFunc(lockOp.ctr.hasNewVersionInRange),
 		)
 		if lockOp.logger.Enabled(zap.DebugLevel) {
@@ -206,6 +230,13 @@ func performLock(
 		if err != nil {
 			return err
 		}
+		if len(skipped) > 0 {
+			lockOp.ctr.markSkippedRows(
+				priVec,
+				target.primaryColumnType,
+				lockOp.ctr.fetchers[idx],
+				skipped)
+		}
 		if !locked {
 			continue
 		}
@@ -256,6 +287,22 @@ func LockTable(
 	if !txnOp.Txn().IsPessimistic() {
 		return nil
 	}
+	return lockTableWithOptions(
+		eng,
+		proc,
+		tableID,
+		pkType,
+		DefaultLockOptions(nil).WithLockTable(true, changeDef))
+}
+
+// lockTableWithOptions is similar to LockTable, but the lock mode and wait policy
+// can be specified by opts.
+func lockTableWithOptions(
+	eng engine.Engine,
+	proc *process.Process,
+	tableID uint64,
+	pkType types.Type,
+	opts LockOptions) error {
 	parker := types.NewPacker()
 	defer parker.Close()
 
@@ -274,10 +321,9 @@ func LockTable(
 		})
 	}()
 
-	opts := DefaultLockOptions(parker).
-		WithLockTable(true, changeDef).
-		WithFetchLockRowsFunc(GetFetchRowsFunc(pkType))
-	_, defChanged, refreshTS, err := doLock(
+	opts.parker = parker
+	opts = opts.WithFetchLockRowsFunc(GetFetchRowsFunc(pkType))
+	_, defChanged, refreshTS, _, err := doLock(
 		proc.Ctx,
 		eng,
 		analyzer,
@@ -342,7 +388,7 @@ func LockRows(
 		WithLockMode(lockMode).
 		WithLockGroup(group).
 		WithFetchLockRowsFunc(GetFetchRowsFunc(pkType))
-	_, defChanged, refreshTS, err := doLock(
+	_, defChanged, refreshTS, _, err := doLock(
 		proc.Ctx,
 		eng,
 		analyzer,
@@ -369,6 +415,8 @@ func LockRows(
 // The data is described by the primary key. When the returned timestamp.IsEmpty
 // is false, it means there is a conflict with other transactions and the data to
 // be manipulated has been modified, you need to get the latest data at timestamp.
+// With the SkipLocked wait policy, the lock rows held by other transactions are
+// not locked and returned.
 func doLock(
 	ctx context.Context,
 	eng engine.Engine,
@@ -379,13 +427,13 @@ func doLock(
 	vec *vector.Vector,
 	pkType types.Type,
 	opts LockOptions,
-) (bool, bool, timestamp.Timestamp, error) {
+) (bool, bool, timestamp.Timestamp, [][]byte, error) {
 	txnOp := proc.GetTxnOperator()
 	txnClient := proc.Base.TxnClient
 	lockService := proc.GetLockService()
 
 	if !txnOp.Txn().IsPessimistic() {
-		return false, false, timestamp.Timestamp{}, nil
+		return false, false, timestamp.Timestamp{}, nil, nil
 	}
 
 	if runtime.InTesting(proc.GetService()) {
@@ -410,7 +458,7 @@ func doLock(
 	//    here MO will use 't1 left join hidden_tbl' to fetch the PK in hidden table to lock,
 	//    but the result will be ConstNull vector
 	if vec != nil && vec.IsConstNull() {
-		return false, false, timestamp.Timestamp{}, nil
+		return false, false, timestamp.Timestamp{}, nil, nil
 	}
 
 	if opts.maxCountPerLock == 0 {
@@ -430,7 +478,7 @@ func doLock(
 		opts.filter,
 		opts.filterCols)
 	if !has {
-		return false, false, timestamp.Timestamp{}, nil
+		return false, false, timestamp.Timestamp{}, nil, nil
 	}
 
 	txn := txnOp.Txn()
@@ -442,6 +490,10 @@ func doLock(
 		Sharding:        opts.sharding,
 		Group:           opts.group,
 		SnapShotTs:      txnOp.CreateTS(),
+		WaitTimeout:     int64(opts.waitTimeout),
+	}
+	if opts.waitPolicy != lock.WaitPolicy_Wait {
+		options.Policy = opts.waitPolicy
 	}
 	if txn.Mirror {
 		options.ForwardTo = txn.LockService
@@ -478,7 +530,12 @@ func doLock(
 		pkType,
 	)
 	if err != nil {
-		return false, false, timestamp.Timestamp{}, err
+		// NOWAIT is required by the statement, use the same error as mysql.
+		if opts.waitPolicy == lock.WaitPolicy_FastFail &&
+			moerr.IsMoErrCode(err, moerr.ErrLockConflict) {
+			err = moerr.NewLockNoWait(ctx)
+		}
+		return false, false, timestamp.Timestamp{}, nil, err
 	}
 	// Record lock waiting time
 	analyzeLockWaitTime(analyzer, start)
@@ -518,7 +575,7 @@ func doLock(
 
 	// add bind locks
 	if err = txnOp.AddLockTable(result.LockedOn); err != nil {
-		return false, false, timestamp.Timestamp{}, err
+		return false, false, timestamp.Timestamp{}, nil, err
 	}
 
 	snapshotTS := txnOp.Txn().SnapshotTS
@@ -535,7 +592,7 @@ func doLock(
 		// wait last committed logtail applied, (IO wait not related to FileService)
 		newSnapshotTS, err := txnClient.WaitLogTailAppliedAt(ctx, lockedTS)
 		if err != nil {
-			return false, false, timestamp.Timestamp{}, err
+			return false, false, timestamp.Timestamp{}, nil, err
 		}
 		// Record logtail waiting time
 		analyzeLockWaitTime(analyzer, start)
@@ -548,7 +605,7 @@ func doLock(
 		// if [snapshotTS, newSnapshotTS] has been modified, need retry at new snapshot ts
 		changed, err := fn(proc, rel, analyzer, tableID, eng, vec, snapshotTS, newSnapshotTS)
 		if err != nil {
-			return false, false, timestamp.Timestamp{}, err
+			return false, false, timestamp.Timestamp{}, nil, err
 		}
 
 		if changed {
@@ -558,9 +615,9 @@ func doLock(
 				lockedTS,
 				newSnapshotTS)
 			if err := txnOp.UpdateSnapshot(ctx, newSnapshotTS); err != nil {
-				return false, false, timestamp.Timestamp{}, err
+				return false, false, timestamp.Timestamp{}, nil, err
 			}
-			return true, false, newSnapshotTS, nil
+			return true, false, newSnapshotTS, result.SkippedRows, nil
 		}
 	}
 
@@ -568,9 +625,9 @@ func doLock(
 	// current txn can read and write normally
 	if !result.HasConflict ||
 		!result.HasPrevCommit {
-		return true, false, timestamp.Timestamp{}, nil
+		return true, false, timestamp.Timestamp{}, result.SkippedRows, nil
 	} else if lockedTS.Less(snapshotTS) {
-		return true, false, timestamp.Timestamp{}, nil
+		return true, false, timestamp.Timestamp{}, result.SkippedRows, nil
 	}
 
 	// Arriving here means that at least one of the conflicting
@@ -585,7 +642,7 @@ func doLock(
 	// is modified between [snapshotTS,prev.commits] and raise the SnapshotTS of
 	// the SI transaction to eliminate conflicts)
 	if !txnOp.Txn().IsRCIsolation() {
-		return false, false, timestamp.Timestamp{}, moerr.NewTxnWWConflict(ctx, tableID, "SI not support retry")
+		return false, false, timestamp.Timestamp{}, nil, moerr.NewTxnWWConflict(ctx, tableID, "SI not support retry")
 	}
 
 	// forward rc's snapshot ts
@@ -596,9 +653,9 @@ func doLock(
 		tableID,
 		snapshotTS)
 	if err := txnOp.UpdateSnapshot(ctx, snapshotTS); err != nil {
-		return false, false, timestamp.Timestamp{}, err
+		return false, false, timestamp.Timestamp{}, nil, err
 	}
-	return true, result.TableDefChanged, snapshotTS, nil
+	return true, result.TableDefChanged, snapshotTS, result.SkippedRows, nil
 }
 
 const defaultWaitTimeOnRetryLock = time.Second
@@ -750,6 +807,16 @@ func (opts LockOptions) WithFilterRows(
 	return opts
 }
 
+// WithWaitPolicy set the wait policy if the lock rows are held by other txns.
+// The waitTimeout is only used by the Wait policy, 0 means no limit.
+func (opts LockOptions) WithWaitPolicy(
+	policy lock.WaitPolicy,
+	waitTimeout time.Duration) LockOptions {
+	opts.waitPolicy = policy
+	opts.waitTimeout = waitTimeout
+	return opts
+}
+
 // WithHasNewVersionInRangeFunc setup hasNewVersionInRange func
 func (opts LockOptions) WithHasNewVersionInRangeFunc(fn hasNewVersionInRangeFunc) LockOptions {
 	opts.hasNewVersionInRangeFunc = fn
@@ -778,6 +845,8 @@ func (lockOp *LockOp) CopyToPipelineTarget() []*pipeline.LockTarget {
 			Mode:               target.mode,
 			LockRows:           plan.DeepCopyExpr(target.lockRows),
 			LockTableAtTheEnd:  target.lockTableAtTheEnd,
+			WaitPolicy:         target.waitPolicy,
+			WaitTimeout:        int64(target.waitTimeout),
 		}
 	}
 	return targets
@@ -849,6 +918,33 @@ func (lockOp *LockOp) LockTableWithMode(
 	return lockOp
 }
 
+// SetWaitPolicy set the wait policy of the lock target, used by NOWAIT, WAIT n
+// and SKIP LOCKED of the select ... for update/share.
+func (lockOp *LockOp) SetWaitPolicy(
+	tableID uint64,
+	policy lock.WaitPolicy,
+	waitTimeout time.Duration) *LockOp {
+	for idx := range lockOp.targets {
+		if lockOp.targets[idx].tableID == tableID {
+			lockOp.targets[idx].waitPolicy = policy
+			lockOp.targets[idx].waitTimeout = waitTimeout
+		}
+	}
+	return lockOp
+}
+
+// SetLimit set the most rows locked by SKIP LOCKED, the rows are locked by
+// windows until the limit is reached, and no more rows are read after that.
+func (lockOp *LockOp) SetLimit(limit int64) *LockOp {
+	lockOp.limit = limit
+	return lockOp
+}
+
+// GetLimit returns the most rows locked by SKIP LOCKED, 0 means no limit.
+func (lockOp *LockOp) GetLimit() int64 {
+	return lockOp.limit
+}
+
 // AddLockTargetWithPartition add lock targets for partition tables. Our partitioned table implementation
 // has each partition as a separate table. So when modifying data, these rows may belong to different
 // partitions. For lock op does not care about the logic of data and partition mapping calculation, the
@@ -923,11 +1019,17 @@ func (lockOp *LockOp) Reset(proc *process.Process, pipelineFailed bool, err erro
 	lockOp.resetParker()
 	lockOp.ctr.retryError = nil
 	lockOp.ctr.defChanged = false
+	lockOp.ctr.skippedSels = lockOp.ctr.skippedSels[:0]
+	lockOp.ctr.lockedRows = 0
 }
 
 // Free free mem
 func (lockOp *LockOp) Free(proc *process.Process, pipelineFailed bool, err error) {
 	lockOp.cleanParker()
+	if lockOp.ctr.buf != nil {
+		lockOp.ctr.buf.Clean(proc.Mp())
+		lockOp.ctr.buf = nil
+	}
 }
 
 func (lockOp *LockOp) resetParker() {
@@ -943,6 +1045,102 @@ func (lockOp *LockOp) cleanParker() {
 	}
 }
 
+// markSkippedRows finds the rows of the primary key vector whose lock rows are
+// skipped by the lockservice.
+func (ctr *state) markSkippedRows(
+	vec *vector.Vector,
+	pkType types.Type,
+	fetchFunc FetchLockRowsFunc,
+	skipped [][]byte) {
+	keys := make(map[string]struct{}, len(skipped))
+	for _, row := range skipped {
+		keys[util.UnsafeBytesToString(row)] = struct{}{}
+	}
+
+	// encode every row without converting to range lock to keep the row
+	// positions.
+	_, rows, _ := fetchFunc(vec, ctr.parker, pkType, math.MaxInt, false, nil, nil)
+	for i, row := range rows {
+		if _, ok := keys[util.UnsafeBytesToString(row)]; ok {
+			ctr.skippedSels = append(ctr.skippedSels, int64(i))
+		}
+	}
+}
+
+// removeSkippedRows removes the skipped rows from the batch, the skipped rows
+// are not locked, so they cannot be returned to the next operator.
+func (ctr *state) removeSkippedRows(
+	proc *process.Process,
+	bat *batch.Batch) (*batch.Batch, error) {
+	sels := ctr.lockedSels(bat.RowCount())
+	if len(sels) == 0 {
+		return batch.EmptyBatch, nil
+	}
+	ctr.resetBuf(bat)
+	if err := ctr.buf.Union(bat, sels, proc.Mp()); err != nil {
+		return nil, err
+	}
+	return ctr.buf, nil
+}
+
+// lockedSels returns the rows of the n rows which are not skipped.
+func (ctr *state) lockedSels(n int) []int64 {
+	slices.Sort(ctr.skippedSels)
+	ctr.skippedSels = slices.Compact(ctr.skippedSels)
+
+	sels := make([]int64, 0, max(n-len(ctr.skippedSels), 0))
+	for i, j := 0, 0; i < n; i++ {
+		if j < len(ctr.skippedSels) && ctr.skippedSels[j] == int64(i) {
+			j++
+			continue
+		}
+		sels = append(sels, int64(i))
+	}
+	return sels
+}
+
+func (ctr *state) resetBuf(bat *batch.Batch) {
+	if ctr.buf == nil {
+		ctr.buf = batch.NewWithSize(len(bat.Vecs))
+		ctr.buf.SetAttributes(bat.Attrs)
+		for i, vec := range bat.Vecs {
+			ctr.buf.Vecs[i] = vector.NewVec(*vec.GetType())
+		}
+	} else {
+		ctr.buf.CleanOnlyData()
+	}
+}
+
+// lockWithLimit locks the rows of the batch by windows of the rows still
+// required by the limit, so SKIP LOCKED locks no more rows than the limit
+// even if some rows are skipped. The rows locked are returned.
+func (lockOp *LockOp) lockWithLimit(
+	proc *process.Process,
+	analyzer process.Analyzer,
+	bat *batch.Batch) (*batch.Batch, error) {
+	ctr := &lockOp.ctr
+	ctr.resetBuf(bat)
+
+	n := bat.RowCount()
+	for start := 0; start < n && ctr.lockedRows < lockOp.limit; {
+		end := min(n, start+int(lockOp.limit-ctr.lockedRows))
+		win, err := bat.Window(start, end)
+		if err != nil {
+			return nil, err
+		}
+		if err = performLock(win, proc, lockOp, analyzer, -1); err != nil {
+			return nil, err
+		}
+		sels := ctr.lockedSels(end - start)
+		if err = ctr.buf.Union(win, sels, proc.Mp()); err != nil {
+			return nil, err
+		}
+		ctr.lockedRows += int64(len(sels))
+		start = end
+	}
+	return ctr.buf, nil
+}
+
 func getRowsFilter(
 	tableID uint64,
 	partitionTables []uint64) RowsFilter {
@@ -1042,7 +1240,15 @@ func lockTalbeIfLockCountIsZero(
 				return rt.retryError
 			}
 		} else {
-			err := LockTable(lockOp.engine, proc, target.tableID, target.primaryColumnType, false)
+			err := lockTableWithOptions(
+				lockOp.engine,
+				proc,
+				target.tableID,
+				target.primaryColumnType,
+				DefaultLockOptions(nil).
+					WithLockTable(true, false).
+					WithLockMode(target.mode).
+					WithWaitPolicy(target.waitPolicy, target.waitTimeout))
 			if err != nil {
 				return err
 			}
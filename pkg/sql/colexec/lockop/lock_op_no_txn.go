@@ -76,7 +76,7 @@ func LockTableWithUniqueID(
 			return false, nil
 		}).
 		WithFetchLockRowsFunc(GetFetchRowsFunc(pkType))
-	_, _, _, err = doLock(
+	_, _, _, _, err = doLock(
 		proc.Ctx,
 		eng,
 		nil,
@@ -122,6 +122,142 @@ func TestCallLockOpWithConflict(t *testing.T) {
 	)
 }
 
+func TestCallLockOpWithSkipLocked(t *testing.T) {
+	tableID := uint64(10)
+	runLockOpTest(
+		t,
+		func(proc *process.Process) {
+			pkType := types.New(types.T_int32, 0, 0)
+			bat := batch.NewWithSize(2)
+			bat.Vecs[0] = vector.NewVec(pkType)
+			require.NoError(t, vector.AppendFixedList(bat.Vecs[0], []int32{0, 1, 2}, nil, proc.Mp()))
+			bat.Vecs[1] = vector.NewVec(pkType)
+			require.NoError(t, vector.AppendFixedList(bat.Vecs[1], []int32{10, 11, 12}, nil, proc.Mp()))
+			bat.SetRowCount(3)
+			defer bat.Clean(proc.Mp())
+
+			arg := NewArgumentByEngine(nil)
+			arg.AddLockTarget(tableID, 0, pkType, -1, nil, false)
+			arg.SetWaitPolicy(tableID, lock.WaitPolicy_SkipLocked, 0)
+			resetChildren(arg, bat)
+			require.NoError(t, arg.Prepare(proc))
+			arg.ctr.hasNewVersionInRange = testFunc
+
+			arg.ctr.parker.Reset()
+			arg.ctr.parker.EncodeInt32(1)
+			conflictRow := arg.ctr.parker.Bytes()
+			_, err := proc.GetLockService().Lock(
+				proc.Ctx,
+				tableID,
+				[][]byte{conflictRow},
+				[]byte("txn01"),
+				lock.LockOptions{})
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, proc.GetLockService().Unlock(proc.Ctx, []byte("txn01"), timestamp.Timestamp{}))
+			}()
+
+			result, err := arg.Call(proc)
+			require.NoError(t, err)
+			require.Equal(t, 2, result.Batch.RowCount())
+			require.Equal(t, []int32{0, 2}, vector.MustFixedColWithTypeCheck[int32](result.Batch.GetVector(0)))
+			require.Equal(t, []int32{10, 12}, vector.MustFixedColWithTypeCheck[int32](result.Batch.GetVector(1)))
+			arg.Free(proc, false, nil)
+		},
+		client.WithEnableRefreshExpression(),
+	)
+}
+
+func TestCallLockOpWithSkipLockedAndLimit(t *testing.T) {
+	tableID := uint64(10)
+	runLockOpTest(
+		t,
+		func(proc *process.Process) {
+			pkType := types.New(types.T_int32, 0, 0)
+			bat := batch.NewWithSize(1)
+			bat.Vecs[0] = vector.NewVec(pkType)
+			require.NoError(t, vector.AppendFixedList(bat.Vecs[0], []int32{0, 1, 2, 3}, nil, proc.Mp()))
+			bat.SetRowCount(4)
+			defer bat.Clean(proc.Mp())
+
+			arg := NewArgumentByEngine(nil)
+			arg.AddLockTarget(tableID, 0, pkType, -1, nil, false)
+			arg.SetWaitPolicy(tableID, lock.WaitPolicy_SkipLocked, 0)
+			arg.SetLimit(2)
+			resetChildren(arg, bat)
+			require.NoError(t, arg.Prepare(proc))
+			arg.ctr.hasNewVersionInRange = testFunc
+
+			encode := func(v int32) []byte {
+				arg.ctr.parker.Reset()
+				arg.ctr.parker.EncodeInt32(v)
+				return append([]byte(nil), arg.ctr.parker.Bytes()...)
+			}
+			_, err := proc.GetLockService().Lock(
+				proc.Ctx,
+				tableID,
+				[][]byte{encode(1)},
+				[]byte("txn01"),
+				lock.LockOptions{})
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, proc.GetLockService().Unlock(proc.Ctx, []byte("txn01"), timestamp.Timestamp{}))
+			}()
+
+			result, err := arg.Call(proc)
+			require.NoError(t, err)
+			require.Equal(t, []int32{0, 2}, vector.MustFixedColWithTypeCheck[int32](result.Batch.GetVector(0)))
+
+			// the row after the limit is not locked.
+			_, err = proc.GetLockService().Lock(
+				proc.Ctx,
+				tableID,
+				[][]byte{encode(3)},
+				[]byte("txn02"),
+				lock.LockOptions{Policy: lock.WaitPolicy_FastFail})
+			require.NoError(t, err)
+			require.NoError(t, proc.GetLockService().Unlock(proc.Ctx, []byte("txn02"), timestamp.Timestamp{}))
+
+			result, err = arg.Call(proc)
+			require.NoError(t, err)
+			require.Equal(t, vm.ExecStop, result.Status)
+			arg.Free(proc, false, nil)
+		},
+		client.WithEnableRefreshExpression(),
+	)
+}
+
+func TestCallLockOpWithNoWait(t *testing.T) {
+	tableID := uint64(10)
+	runLockNonBlockingOpTest(
+		t,
+		[]uint64{tableID},
+		[][]int32{{0, 1, 2}},
+		func(proc *process.Process, arg *LockOp) {
+			arg.SetWaitPolicy(tableID, lock.WaitPolicy_FastFail, 0)
+			require.NoError(t, arg.Prepare(proc))
+			arg.ctr.hasNewVersionInRange = testFunc
+
+			arg.ctr.parker.Reset()
+			arg.ctr.parker.EncodeInt32(1)
+			conflictRow := arg.ctr.parker.Bytes()
+			_, err := proc.GetLockService().Lock(
+				proc.Ctx,
+				tableID,
+				[][]byte{conflictRow},
+				[]byte("txn01"),
+				lock.LockOptions{})
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, proc.GetLockService().Unlock(proc.Ctx, []byte("txn01"), timestamp.Timestamp{}))
+			}()
+
+			_, err = arg.Call(proc)
+			require.True(t, moerr.IsMoErrCode(err, moerr.ErrLockNoWait))
+		},
+	)
+}
+
 func TestCallLockOpWithConflictWithRefreshNotEnabled(t *testing.T) {
 	tableID := uint64(10)
 	runLockNonBlockingOpTest(
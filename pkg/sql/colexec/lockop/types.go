@@ -15,8 +15,11 @@
 package lockop
 
 import (
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/common/log"
 	"github.com/matrixorigin/matrixone/pkg/common/reuse"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/lock"
@@ -59,6 +62,8 @@ type LockOptions struct {
 	filter                   RowsFilter
 	filterCols               []int32
 	hasNewVersionInRangeFunc hasNewVersionInRangeFunc
+	waitPolicy               lock.WaitPolicy
+	waitTimeout              time.Duration
 }
 
 // LockOp lock op argument.
@@ -69,6 +74,8 @@ type LockOp struct {
 	ctr     state
 	engine  engine.Engine
 	targets []lockTarget
+	// limit is the most rows locked with SKIP LOCKED, 0 means no limit.
+	limit int64
 }
 
 func (lockOp *LockOp) GetOperatorBase() *vm.OperatorBase {
@@ -114,6 +121,8 @@ type lockTarget struct {
 	mode                         lock.LockMode
 	lockRows                     *plan.Expr
 	lockTableAtTheEnd            bool
+	waitPolicy                   lock.WaitPolicy
+	waitTimeout                  time.Duration
 }
 
 // RowsFilter used to filter row from primary vector. The row will not lock if filter return false.
@@ -135,4 +144,10 @@ type state struct {
 	fetchers             []FetchLockRowsFunc
 	hasNewVersionInRange hasNewVersionInRangeFunc
 	lockCount            int64
+	// skippedSels are the rows of current batch which are held by other txns
+	// and skipped by SKIP LOCKED.
+	skippedSels []int64
+	buf         *batch.Batch
+	// lockedRows is the rows locked so far if the limit is set.
+	lockedRows int64
 }
@@ -151,6 +151,8 @@ func (ctr *container) process(bat *batch.Batch, proc *process.Process) error {
 						width = 128 * 4
 					case types.T_array_float64:
 						width = 128 * 8
+					case types.T_array_float16:
+						width = 128 * 2
 					default:
 						width = 128
 					}
@@ -160,6 +162,8 @@ func (ctr *container) process(bat *batch.Batch, proc *process.Process) error {
 						width = int(vec.GetType().Width) * 4
 					case types.T_array_float64:
 						width = int(vec.GetType().Width) * 8
+					case types.T_array_float16:
+						width = int(vec.GetType().Width) * 2
 					default:
 						width = int(vec.GetType().Width)
 					}
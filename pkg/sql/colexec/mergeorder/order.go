@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	plan2 "github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -167,6 +168,84 @@ func (ctr *container) removeBatch(proc *process.Process, index int) {
 	ctr.orderCols = append(ctr.orderCols[:index], ctr.orderCols[index+1:]...)
 }
 
+// newMergerOfBatchList moves all received batches into a new merger, the
+// order columns of the batches are released because the merger evaluates
+// them itself.
+func (ctr *container) newMergerOfBatchList(proc *process.Process, specs []*plan.OrderBySpec) (*spill.Merger, error) {
+	merger, err := spill.NewMerger(proc, specs)
+	if err != nil {
+		return nil, err
+	}
+	for i, bat := range ctr.batchList {
+		owned := make(map[*vector.Vector]struct{}, len(bat.Vecs))
+		for _, vec := range bat.Vecs {
+			owned[vec] = struct{}{}
+		}
+		for _, vec := range ctr.orderCols[i] {
+			if _, ok := owned[vec]; !ok && vec != nil {
+				vec.Free(proc.Mp())
+			}
+		}
+		ctr.orderCols[i] = nil
+		ctr.batchList[i] = nil
+		if err = merger.AddSource(proc, spill.NewBatchSource(bat)); err != nil {
+			// the batches not added yet are released by cleanBatchAndCol
+			merger.Free(proc)
+			return nil, err
+		}
+	}
+	ctr.batchList = ctr.batchList[:0]
+	ctr.orderCols = ctr.orderCols[:0]
+	ctr.memSize = 0
+	return merger, nil
+}
+
+// spillBatchList merges all received batches and spills them as a run.
+func (ctr *container) spillBatchList(proc *process.Process, specs []*plan.OrderBySpec, analyzer process.Analyzer) (err error) {
+	if ctr.spiller == nil {
+		if ctr.spiller, err = spill.NewSpiller(proc, opName); err != nil {
+			return err
+		}
+	}
+	merger, err := ctr.newMergerOfBatchList(proc, specs)
+	if err != nil {
+		return err
+	}
+	defer merger.Free(proc)
+
+	spilled := ctr.spiller.Size()
+	run := spill.NewRun(ctr.spiller)
+	for {
+		bat, err := merger.Next(proc, colexec.DefaultBatchSize)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			break
+		}
+		if err = run.Append(proc.Ctx, bat); err != nil {
+			return err
+		}
+	}
+	ctr.runs = append(ctr.runs, run)
+	analyzer.Spill(ctr.spiller.Size() - spilled)
+	ctr.budget.Update(ctr.memSize)
+	return nil
+}
+
+// startMerge merges the spilled runs with the batches left in memory.
+func (ctr *container) startMerge(proc *process.Process, specs []*plan.OrderBySpec) (err error) {
+	if ctr.merger, err = ctr.newMergerOfBatchList(proc, specs); err != nil {
+		return err
+	}
+	for _, run := range ctr.runs {
+		if err = ctr.merger.AddSource(proc, run); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (mergeOrder *MergeOrder) String(buf *bytes.Buffer) {
 	buf.WriteString(opName)
 	ap := mergeOrder
@@ -204,6 +283,7 @@ func (mergeOrder *MergeOrder) Prepare(proc *process.Process) (err error) {
 			}
 		}
 	}
+	ctr.budget = spill.NewBudget(proc)
 	return nil
 }
 
@@ -225,6 +305,14 @@ func (mergeOrder *MergeOrder) Call(proc *process.Process) (vm.CallResult, error)
 			}
 
 			if input.Batch == nil {
+				if len(ctr.runs) > 0 {
+					ctr.status = spillSending
+					if err = ctr.startMerge(proc, mergeOrder.OrderBySpecs); err != nil {
+						return vm.CancelResult, err
+					}
+					continue
+				}
+
 				// if number of block is less than 2, no need to do merge sort.
 				ctr.status = normalSending
 
@@ -254,6 +342,13 @@ func (mergeOrder *MergeOrder) Call(proc *process.Process) (vm.CallResult, error)
 				return vm.CancelResult, err
 			}
 
+			ctr.memSize += int64(bat.Size())
+			if ctr.budget.Update(ctr.memSize) {
+				if err = ctr.spillBatchList(proc, mergeOrder.OrderBySpecs, analyzer); err != nil {
+					return vm.CancelResult, err
+				}
+			}
+
 		case normalSending:
 			if len(ctr.batchList) == 0 {
 				return vm.CancelResult, nil
@@ -285,6 +380,21 @@ func (mergeOrder *MergeOrder) Call(proc *process.Process) (vm.CallResult, error)
 			analyzer.Output(result.Batch)
 			return result, err
 
+		case spillSending:
+			bat, err := ctr.merger.Next(proc, colexec.DefaultBatchSize)
+			if err != nil {
+				return vm.CancelResult, err
+			}
+			if bat == nil {
+				ctr.status = finish
+				continue
+			}
+			result := vm.NewCallResult()
+			result.Batch = bat
+			result.Status = vm.ExecHasMore
+			analyzer.Output(result.Batch)
+			return result, nil
+
 		case finish:
 			return vm.CancelResult, nil
 		}
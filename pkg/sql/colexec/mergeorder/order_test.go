@@ -133,6 +133,44 @@ func TestOrder(t *testing.T) {
 	}
 }
 
+func TestOrderSpill(t *testing.T) {
+	tc := newTestCase([]types.Type{types.T_int8.ToType(), types.T_int64.ToType()}, []*plan.OrderBySpec{{Expr: newExpression(1, types.T_int64), Flag: 0}})
+	// spill every batch
+	tc.proc.SetResolveVariableFunc(func(string, bool, bool) (interface{}, error) {
+		return int64(1), nil
+	})
+	bats := []*batch.Batch{
+		newIntBatch(tc.types, tc.proc, Rows, tc.arg.OrderBySpecs),
+		newIntBatch(tc.types, tc.proc, Rows, tc.arg.OrderBySpecs),
+		batch.EmptyBatch,
+		newIntBatch(tc.types, tc.proc, Rows, tc.arg.OrderBySpecs),
+	}
+	resetChildren(tc.arg, bats)
+	require.NoError(t, tc.arg.Prepare(tc.proc))
+
+	var vals []int64
+	for {
+		result, err := tc.arg.Call(tc.proc)
+		require.NoError(t, err)
+		if result.Batch == nil {
+			break
+		}
+		vals = append(vals, vector.MustFixedColWithTypeCheck[int64](result.Batch.Vecs[1])...)
+	}
+	require.Equal(t, 3, len(tc.arg.ctr.runs))
+	require.Equal(t, 3*Rows, len(vals))
+	for j := 1; j < len(vals); j++ {
+		require.True(t, vals[j] >= vals[j-1])
+	}
+
+	tc.arg.Children[0].Free(tc.proc, false, nil)
+	tc.arg.Free(tc.proc, false, nil)
+	// the memory is returned to the budget of the query
+	require.Equal(t, int64(0), tc.proc.GetQueryMemory().Used())
+	tc.proc.Free()
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
 func BenchmarkOrder(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs := []orderTestCase{
@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -35,6 +36,7 @@ const (
 	receiving = iota
 	normalSending
 	pickUpSending
+	spillSending
 	finish
 )
 
@@ -92,6 +94,14 @@ type container struct {
 	compares  []compare.Compare
 
 	buf *batch.Batch
+
+	// budget is the memory budget of the query, the received batches are
+	// merged and spilled as a run once the query exceeds the budget.
+	budget  spill.Budget
+	memSize int64
+	spiller *spill.Spiller
+	runs    []*spill.Run
+	merger  *spill.Merger
 }
 
 func (mergeOrder *MergeOrder) Reset(proc *process.Process, pipelineFailed bool, err error) {
@@ -101,6 +111,8 @@ func (mergeOrder *MergeOrder) Reset(proc *process.Process, pipelineFailed bool,
 	ctr.orderCols = ctr.orderCols[:0]
 	ctr.indexList = nil
 	ctr.status = receiving
+	ctr.memSize = 0
+	ctr.cleanSpill(proc)
 
 	for i := range ctr.executors {
 		if ctr.executors[i] != nil {
@@ -117,6 +129,7 @@ func (mergeOrder *MergeOrder) Free(proc *process.Process, pipelineFailed bool, e
 	ctr := &mergeOrder.ctr
 	ctr.batchList = nil
 	ctr.orderCols = nil
+	ctr.cleanSpill(proc)
 	for i := range ctr.executors {
 		if ctr.executors[i] != nil {
 			ctr.executors[i].Free()
@@ -149,3 +162,16 @@ func (mergeOrder *MergeOrder) cleanBatchAndCol(proc *process.Process) {
 		}
 	}
 }
+
+func (ctr *container) cleanSpill(proc *process.Process) {
+	if ctr.merger != nil {
+		ctr.merger.Free(proc)
+		ctr.merger = nil
+	}
+	if ctr.spiller != nil {
+		ctr.spiller.Close(proc.Ctx)
+		ctr.spiller = nil
+	}
+	ctr.runs = nil
+	ctr.budget.Release()
+}
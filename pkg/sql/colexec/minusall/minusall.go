@@ -0,0 +1,222 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package minusall
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	Build = iota
+	Probe
+	End
+)
+
+const opName = "minus_all"
+
+func (minusAll *MinusAll) String(buf *bytes.Buffer) {
+	buf.WriteString(opName)
+	buf.WriteString(": minus all ")
+}
+
+func (minusAll *MinusAll) OpType() vm.OpType {
+	return vm.MinusAll
+}
+
+func (minusAll *MinusAll) Prepare(proc *process.Process) error {
+	var err error
+
+	if minusAll.OpAnalyzer == nil {
+		minusAll.OpAnalyzer = process.NewAnalyzer(minusAll.GetIdx(), minusAll.IsFirst, minusAll.IsLast, "minusAll")
+	} else {
+		minusAll.OpAnalyzer.Reset()
+	}
+
+	if minusAll.ctr.hashTable, err = hashmap.NewStrMap(true); err != nil {
+		return err
+	}
+	if len(minusAll.ctr.inserted) == 0 {
+		minusAll.ctr.inserted = make([]uint8, hashmap.UnitLimit)
+		minusAll.ctr.resetInserted = make([]uint8, hashmap.UnitLimit)
+	}
+	return nil
+}
+
+// Call is the execute method of `minus all` operator
+// it built a hash table for right relation first.
+// and use an array to record how many times each key appears in right relation.
+// use values from left relation to probe and update the array.
+// preserve values that do not exist in the hash table.
+// throw away values that exist in the hash table as many times as they appear in right relation,
+// so a row appearing m times in left relation and n times in right relation is preserved max(m-n, 0) times.
+func (minusAll *MinusAll) Call(proc *process.Process) (vm.CallResult, error) {
+	if err, isCancel := vm.CancelCheck(proc); isCancel {
+		return vm.CancelResult, err
+	}
+
+	analyzer := minusAll.OpAnalyzer
+	analyzer.Start()
+	defer analyzer.Stop()
+
+	var err error
+	for {
+		switch minusAll.ctr.state {
+		case Build:
+			if err = minusAll.build(proc, analyzer); err != nil {
+				return vm.CancelResult, err
+			}
+			if minusAll.ctr.hashTable != nil {
+				analyzer.Alloc(minusAll.ctr.hashTable.Size())
+			}
+			minusAll.ctr.state = Probe
+
+		case Probe:
+			last := false
+			result := vm.NewCallResult()
+			last, err = minusAll.probe(proc, analyzer, &result)
+			if err != nil {
+				return result, err
+			}
+			if last {
+				minusAll.ctr.state = End
+				continue
+			}
+			analyzer.Output(result.Batch)
+			return result, nil
+
+		case End:
+			return vm.CancelResult, nil
+		}
+	}
+}
+
+// build use all batches from proc.Reg.MergeReceiver[1](right relation) to build the hash map.
+func (minusAll *MinusAll) build(proc *process.Process, analyzer process.Analyzer) error {
+	ctr := &minusAll.ctr
+	for {
+		input, err := vm.ChildrenCall(minusAll.GetChildren(1), proc, analyzer)
+		if err != nil {
+			return err
+		}
+
+		if input.Batch == nil {
+			break
+		}
+		if input.Batch.IsEmpty() {
+			continue
+		}
+
+		// build hashTable and a counter to record how many times each key appears
+		itr := ctr.hashTable.NewIterator()
+		count := input.Batch.RowCount()
+		for i := 0; i < count; i += hashmap.UnitLimit {
+			n := count - i
+			if n > hashmap.UnitLimit {
+				n = hashmap.UnitLimit
+			}
+			vs, _, err := itr.Insert(i, n, input.Batch.Vecs)
+			if err != nil {
+				return err
+			}
+			if uint64(len(ctr.counter)) < ctr.hashTable.GroupCount() {
+				gap := ctr.hashTable.GroupCount() - uint64(len(ctr.counter))
+				ctr.counter = append(ctr.counter, make([]uint64, gap)...)
+			}
+			for _, v := range vs {
+				if v == 0 {
+					continue
+				}
+				ctr.counter[v-1]++
+			}
+		}
+	}
+	return nil
+}
+
+// probe uses a batch from proc.Reg.MergeReceivers[0](left relation) to probe the hash map and update the counter.
+// If a row of the batch doesn't appear in the hash table, send it to the next operator.
+// If a row of the batch appears in the hash table and the value of it in the ctr.counter is greater than 0,
+// throw it away and counter--; else, send it to the next operator.
+// if batch is the last one, return true, else return false.
+func (minusAll *MinusAll) probe(proc *process.Process, analyzer process.Analyzer, result *vm.CallResult) (bool, error) {
+	ctr := &minusAll.ctr
+	for {
+		input, err := vm.ChildrenCall(minusAll.GetChildren(0), proc, analyzer)
+		if err != nil {
+			return false, err
+		}
+		if input.Batch == nil {
+			return true, nil
+		}
+		if input.Batch.Last() {
+			result.Batch = input.Batch
+			return false, nil
+		}
+		if input.Batch.IsEmpty() {
+			continue
+		}
+
+		if ctr.buf == nil {
+			ctr.buf = batch.NewWithSize(len(input.Batch.Vecs))
+			for i := range input.Batch.Vecs {
+				ctr.buf.Vecs[i] = vector.NewVec(*input.Batch.Vecs[i].GetType())
+			}
+		}
+		ctr.buf.CleanOnlyData()
+
+		// probe hashTable
+		itr := ctr.hashTable.NewIterator()
+		count := input.Batch.RowCount()
+		for i := 0; i < count; i += hashmap.UnitLimit {
+			n := count - i
+			if n > hashmap.UnitLimit {
+				n = hashmap.UnitLimit
+			}
+
+			copy(ctr.inserted[:n], ctr.resetInserted[:n])
+			cnt := 0
+
+			vs, _ := itr.Find(i, n, input.Batch.Vecs)
+			for j, v := range vs {
+				// the row is removed by one of the same rows of right relation
+				if v != 0 && ctr.counter[v-1] > 0 {
+					ctr.counter[v-1]--
+					continue
+				}
+				ctr.inserted[j] = 1
+				cnt++
+			}
+			ctr.buf.AddRowCount(cnt)
+
+			if cnt > 0 {
+				for colNum := range input.Batch.Vecs {
+					if err := ctr.buf.Vecs[colNum].UnionBatch(input.Batch.Vecs[colNum], int64(i), cnt, ctr.inserted[:n], proc.Mp()); err != nil {
+						return false, err
+					}
+				}
+			}
+		}
+
+		analyzer.Alloc(int64(ctr.buf.Size()))
+		result.Batch = ctr.buf
+		return false, nil
+	}
+}
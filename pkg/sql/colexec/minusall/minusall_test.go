@@ -0,0 +1,152 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package minusall
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+type minusAllTestCase struct {
+	proc *process.Process
+	arg  *MinusAll
+}
+
+func TestMinusAll(t *testing.T) {
+	proc := testutil.NewProcess()
+	// [3 rows + 2 rows, 2 columns] minus all [1 row + 2 rows, 2 columns]
+	/*
+		{1, 2}				{1, 2}	  {1, 2}
+		{1, 2}				{3, 4} ==> {1, 2}
+		{1, 2} minus all	{null, 5}  {3, 4}
+		{3, 4}						   {null, 5}
+		{3, 4}
+		{null, 5}
+		{null, 5}
+	*/
+	c := newMinusAllTestCase(proc)
+
+	setProcForTest(proc, c.arg)
+	require.NoError(t, c.arg.Prepare(c.proc))
+	require.Equal(t, []int64{1, 1, 3}, runMinusAll(t, c))
+
+	for _, child := range c.arg.Children {
+		child.Reset(proc, false, nil)
+	}
+	c.arg.Reset(c.proc, false, nil)
+
+	setProcForTest(proc, c.arg)
+	require.NoError(t, c.arg.Prepare(c.proc))
+	require.Equal(t, []int64{1, 1, 3}, runMinusAll(t, c))
+
+	for _, child := range c.arg.Children {
+		child.Reset(proc, false, nil)
+		child.Free(proc, false, nil)
+	}
+	c.arg.Reset(c.proc, false, nil)
+	c.arg.Free(c.proc, false, nil)
+	c.proc.Free()
+	require.Equal(t, int64(0), c.proc.Mp().CurrNB())
+}
+
+// runMinusAll returns the first column of the result, the nulls are skipped.
+func runMinusAll(t *testing.T, c minusAllTestCase) []int64 {
+	var ret []int64
+	cnt := 0
+	for {
+		end, err := c.arg.Call(c.proc)
+		require.NoError(t, err)
+		result := end.Batch
+		if result == nil || result.IsEmpty() {
+			break
+		}
+		require.Equal(t, 2, len(result.Vecs))
+		cnt += result.RowCount()
+		vs := vector.MustFixedColNoTypeCheck[int64](result.Vecs[0])
+		for i := range vs {
+			if !result.Vecs[0].IsNull(uint64(i)) {
+				ret = append(ret, vs[i])
+			}
+		}
+	}
+	require.Equal(t, 4, cnt)
+	return ret
+}
+
+func newMinusAllTestCase(proc *process.Process) minusAllTestCase {
+	arg := new(MinusAll)
+	arg.OperatorBase.OperatorInfo = vm.OperatorInfo{
+		Idx:     0,
+		IsFirst: false,
+		IsLast:  false,
+	}
+	return minusAllTestCase{
+		proc: proc,
+		arg:  arg,
+	}
+}
+
+func setProcForTest(proc *process.Process, minusAll *MinusAll) {
+	for _, child := range minusAll.Children {
+		child.Free(proc, false, nil)
+	}
+	minusAll.Children = nil
+	leftBatches := []*batch.Batch{
+		testutil.NewBatchWithVectors(
+			[]*vector.Vector{
+				testutil.NewVector(3, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 1, 1}),
+				testutil.NewVector(3, types.T_int64.ToType(), proc.Mp(), false, []int64{2, 2, 2}),
+			}, nil),
+		testutil.NewBatchWithVectors(
+			[]*vector.Vector{
+				newInt64VectorWithNull(proc, []int64{3, 3, 0, 0}, []uint64{2, 3}),
+				testutil.NewVector(4, types.T_int64.ToType(), proc.Mp(), false, []int64{4, 4, 5, 5}),
+			}, nil),
+	}
+
+	rightBatches := []*batch.Batch{
+		testutil.NewBatchWithVectors(
+			[]*vector.Vector{
+				testutil.NewVector(1, types.T_int64.ToType(), proc.Mp(), false, []int64{1}),
+				testutil.NewVector(1, types.T_int64.ToType(), proc.Mp(), false, []int64{2}),
+			}, nil),
+		testutil.NewBatchWithVectors(
+			[]*vector.Vector{
+				newInt64VectorWithNull(proc, []int64{3, 0}, []uint64{1}),
+				testutil.NewVector(2, types.T_int64.ToType(), proc.Mp(), false, []int64{4, 5}),
+			}, nil),
+	}
+
+	leftChild := colexec.NewMockOperator().WithBatchs(leftBatches)
+	rightChild := colexec.NewMockOperator().WithBatchs(rightBatches)
+	minusAll.AppendChild(leftChild)
+	minusAll.AppendChild(rightChild)
+}
+
+func newInt64VectorWithNull(proc *process.Process, vs []int64, nulls []uint64) *vector.Vector {
+	vec := testutil.NewVector(len(vs), types.T_int64.ToType(), proc.Mp(), false, vs)
+	for _, row := range nulls {
+		vec.GetNulls().Add(row)
+	}
+	return vec
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package minusall
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/common/reuse"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var _ vm.Operator = new(MinusAll)
+
+type container struct {
+	// operator state: Build, Probe or End
+	state int
+
+	// how many times each key of the right relation is left to be removed
+	counter []uint64
+
+	// built for the right relation
+	hashTable *hashmap.StrHashMap
+
+	inserted      []uint8
+	resetInserted []uint8
+
+	buf *batch.Batch
+}
+
+type MinusAll struct {
+	// execution container
+	ctr container
+
+	vm.OperatorBase
+}
+
+func (minusAll *MinusAll) GetOperatorBase() *vm.OperatorBase {
+	return &minusAll.OperatorBase
+}
+
+func init() {
+	reuse.CreatePool[MinusAll](
+		func() *MinusAll {
+			return &MinusAll{}
+		},
+		func(a *MinusAll) {
+			*a = MinusAll{}
+		},
+		reuse.DefaultOptions[MinusAll]().
+			WithEnableChecker(),
+	)
+}
+
+func (minusAll MinusAll) TypeName() string {
+	return opName
+}
+
+func NewArgument() *MinusAll {
+	return reuse.Alloc[MinusAll](nil)
+}
+
+func (minusAll *MinusAll) Release() {
+	if minusAll != nil {
+		reuse.Free[MinusAll](minusAll, nil)
+	}
+}
+
+func (minusAll *MinusAll) Reset(proc *process.Process, pipelineFailed bool, err error) {
+	ctr := &minusAll.ctr
+	ctr.state = Build
+	ctr.cleanHashMap()
+	if ctr.buf != nil {
+		ctr.buf.CleanOnlyData()
+	}
+	ctr.counter = nil
+}
+
+func (minusAll *MinusAll) Free(proc *process.Process, pipelineFailed bool, err error) {
+	ctr := &minusAll.ctr
+	ctr.cleanHashMap()
+	if ctr.buf != nil {
+		ctr.buf.Clean(proc.Mp())
+		ctr.buf = nil
+	}
+}
+
+func (ctr *container) cleanHashMap() {
+	if ctr.hashTable != nil {
+		ctr.hashTable.Free()
+		ctr.hashTable = nil
+	}
+}
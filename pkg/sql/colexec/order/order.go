@@ -23,6 +23,7 @@ import (
 	pbplan "github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sort"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -43,70 +44,141 @@ func (ctr *container) appendBatch(proc *process.Process, bat *batch.Batch) (enou
 	return all >= maxBatchSizeToSort, nil
 }
 
-func (ctr *container) sortAndSend(proc *process.Process, result *vm.CallResult) (err error) {
-	if ctr.batWaitForSort != nil {
-		for i := range ctr.sortExprExecutor {
-			ctr.sortVectors[i], err = ctr.sortExprExecutor[i].Eval(proc, []*batch.Batch{ctr.batWaitForSort}, nil)
-			if err != nil {
-				return err
-			}
+// sortBatch sorts the batch waiting for sort in place.
+func (ctr *container) sortBatch(proc *process.Process) (err error) {
+	for i := range ctr.sortExprExecutor {
+		ctr.sortVectors[i], err = ctr.sortExprExecutor[i].Eval(proc, []*batch.Batch{ctr.batWaitForSort}, nil)
+		if err != nil {
+			return err
 		}
+	}
 
-		firstVec := ctr.sortVectors[0]
-		if cap(ctr.resultOrderList) >= ctr.batWaitForSort.RowCount() {
-			ctr.resultOrderList = ctr.resultOrderList[:ctr.batWaitForSort.RowCount()]
-		} else {
-			ctr.resultOrderList = make([]int64, ctr.batWaitForSort.RowCount())
-		}
+	firstVec := ctr.sortVectors[0]
+	if cap(ctr.resultOrderList) >= ctr.batWaitForSort.RowCount() {
+		ctr.resultOrderList = ctr.resultOrderList[:ctr.batWaitForSort.RowCount()]
+	} else {
+		ctr.resultOrderList = make([]int64, ctr.batWaitForSort.RowCount())
+	}
 
-		for i := range ctr.resultOrderList {
-			ctr.resultOrderList[i] = int64(i)
-		}
+	for i := range ctr.resultOrderList {
+		ctr.resultOrderList[i] = int64(i)
+	}
 
-		// skip sort for const vector
-		if !firstVec.IsConst() {
-			nullCnt := firstVec.GetNulls().Count()
-			if nullCnt < firstVec.Length() {
-				sort.Sort(ctr.desc[0], ctr.nullsLast[0], nullCnt > 0, ctr.resultOrderList, firstVec)
-			}
+	// skip sort for const vector
+	if !firstVec.IsConst() {
+		nullCnt := firstVec.GetNulls().Count()
+		if nullCnt < firstVec.Length() {
+			sort.Sort(ctr.desc[0], ctr.nullsLast[0], nullCnt > 0, ctr.resultOrderList, firstVec)
 		}
+	}
+
+	sels := ctr.resultOrderList
+	ovec := firstVec
+	if len(ctr.sortVectors) != 1 {
+		ps := make([]int64, 0, 16)
+		ds := make([]bool, len(sels))
+		for i, j := 1, len(ctr.sortVectors); i < j; i++ {
+			vec := ctr.sortVectors[i]
+			ps = partition.Partition(sels, ds, ps, ovec)
 
-		sels := ctr.resultOrderList
-		ovec := firstVec
-		if len(ctr.sortVectors) != 1 {
-			ps := make([]int64, 0, 16)
-			ds := make([]bool, len(sels))
-			for i, j := 1, len(ctr.sortVectors); i < j; i++ {
-				vec := ctr.sortVectors[i]
-				ps = partition.Partition(sels, ds, ps, ovec)
-
-				// skip sort for const vector
-				if !vec.IsConst() {
-					desc := ctr.desc[i]
-					nullsLast := ctr.nullsLast[i]
-
-					nullCnt := vec.GetNulls().Count()
-					if nullCnt < vec.Length() {
-						for m, n := 0, len(ps); m < n; m++ {
-							if m == n-1 {
-								sort.Sort(desc, nullsLast, nullCnt > 0, sels[ps[m]:], vec)
-							} else {
-								sort.Sort(desc, nullsLast, nullCnt > 0, sels[ps[m]:ps[m+1]], vec)
-							}
+			// skip sort for const vector
+			if !vec.IsConst() {
+				desc := ctr.desc[i]
+				nullsLast := ctr.nullsLast[i]
+
+				nullCnt := vec.GetNulls().Count()
+				if nullCnt < vec.Length() {
+					for m, n := 0, len(ps); m < n; m++ {
+						if m == n-1 {
+							sort.Sort(desc, nullsLast, nullCnt > 0, sels[ps[m]:], vec)
+						} else {
+							sort.Sort(desc, nullsLast, nullCnt > 0, sels[ps[m]:ps[m+1]], vec)
 						}
 					}
 				}
-				ovec = vec
 			}
+			ovec = vec
 		}
+	}
 
-		if err = ctr.batWaitForSort.Shuffle(ctr.resultOrderList, proc.Mp()); err != nil {
+	return ctr.batWaitForSort.Shuffle(ctr.resultOrderList, proc.Mp())
+}
+
+func (ctr *container) sortAndSend(proc *process.Process, result *vm.CallResult) (err error) {
+	if ctr.batWaitForSort != nil {
+		if err = ctr.sortBatch(proc); err != nil {
 			return err
 		}
 	}
 	ctr.rbat = ctr.batWaitForSort
 	result.Batch = ctr.rbat
 	ctr.batWaitForSort = nil
+	ctr.budget.Release()
+	return nil
+}
+
+// needSpill reports the batch waiting for sort to the memory budget of the
+// query, and returns true if it should be spilled to make room.
+func (ctr *container) needSpill() bool {
+	if !ctr.budget.Enabled() || ctr.batWaitForSort == nil {
+		return false
+	}
+	return ctr.budget.Update(int64(ctr.batWaitForSort.Size()))
+}
+
+// spillBatch sorts the batch waiting for sort and spills it as a run.
+func (ctr *container) spillBatch(proc *process.Process, analyzer process.Analyzer) (err error) {
+	if ctr.spiller == nil {
+		if ctr.spiller, err = spill.NewSpiller(proc, opName); err != nil {
+			return err
+		}
+	}
+	if err = ctr.sortBatch(proc); err != nil {
+		return err
+	}
+
+	spilled := ctr.spiller.Size()
+	run := spill.NewRun(ctr.spiller)
+	bat := ctr.batWaitForSort
+	for start := 0; start < bat.RowCount(); start += colexec.DefaultBatchSize {
+		end := min(start+colexec.DefaultBatchSize, bat.RowCount())
+		window, err := bat.Window(start, end)
+		if err != nil {
+			return err
+		}
+		if err = run.Append(proc.Ctx, window); err != nil {
+			return err
+		}
+	}
+	ctr.runs = append(ctr.runs, run)
+	analyzer.Spill(ctr.spiller.Size() - spilled)
+
+	// keep the batch to reuse its memory
+	ctr.batWaitForSort.CleanOnlyData()
+	ctr.budget.Update(int64(ctr.batWaitForSort.Size()))
+	return nil
+}
+
+// startMerge sorts the last batch and merges it with the spilled runs.
+func (ctr *container) startMerge(proc *process.Process, orderBySpec []*pbplan.OrderBySpec) (err error) {
+	if ctr.merger, err = spill.NewMerger(proc, orderBySpec); err != nil {
+		return err
+	}
+	for _, run := range ctr.runs {
+		if err = ctr.merger.AddSource(proc, run); err != nil {
+			return err
+		}
+	}
+	if ctr.batWaitForSort != nil && ctr.batWaitForSort.RowCount() > 0 {
+		if err = ctr.sortBatch(proc); err != nil {
+			return err
+		}
+		bat := ctr.batWaitForSort
+		ctr.batWaitForSort = nil
+		if err = ctr.merger.AddSource(proc, spill.NewBatchSource(bat)); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -150,7 +222,6 @@ func (order *Order) Prepare(proc *process.Process) (err error) {
 			}
 		}
 
-		ctr.sortVectors = make([]*vector.Vector, len(order.OrderBySpec))
 		ctr.sortExprExecutor = make([]colexec.ExpressionExecutor, len(order.OrderBySpec))
 		for i := range ctr.sortVectors {
 			ctr.sortExprExecutor[i], err = colexec.NewExpressionExecutor(proc, order.OrderBySpec[i].Expr)
@@ -159,6 +230,7 @@ func (order *Order) Prepare(proc *process.Process) (err error) {
 			}
 		}
 	}
+	ctr.budget = spill.NewBudget(proc)
 
 	return nil
 }
@@ -202,6 +274,13 @@ func (order *Order) Call(proc *process.Process) (vm.CallResult, error) {
 				return vm.CancelResult, err
 			}
 
+			if ctr.needSpill() {
+				if err = ctr.spillBatch(proc, analyzer); err != nil {
+					return vm.CancelResult, err
+				}
+				continue
+			}
+
 			if enoughToSend {
 				err := ctr.sortAndSend(proc, &input)
 				if err != nil {
@@ -214,6 +293,25 @@ func (order *Order) Call(proc *process.Process) (vm.CallResult, error) {
 	}
 
 	result := vm.NewCallResult()
+	if ctr.state == vm.Eval && len(ctr.runs) > 0 {
+		if ctr.merger == nil {
+			if err := ctr.startMerge(proc, order.OrderBySpec); err != nil {
+				return vm.CancelResult, err
+			}
+		}
+		bat, err := ctr.merger.Next(proc, colexec.DefaultBatchSize)
+		if err != nil {
+			return vm.CancelResult, err
+		}
+		if bat == nil {
+			ctr.state = vm.End
+			return vm.CancelResult, nil
+		}
+		result.Batch = bat
+		analyzer.Output(result.Batch)
+		return result, nil
+	}
+
 	if ctr.state == vm.Eval {
 		err := ctr.sortAndSend(proc, &result)
 		if err != nil {
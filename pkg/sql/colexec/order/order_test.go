@@ -16,11 +16,13 @@ package order
 
 import (
 	"bytes"
+	"slices"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
@@ -98,6 +100,43 @@ func TestOrder(t *testing.T) {
 	}
 }
 
+func TestOrderSpill(t *testing.T) {
+	tc := newTestCase([]types.Type{types.T_int64.ToType()}, []*plan.OrderBySpec{{Expr: newExpression(0), Flag: 0}})
+	// spill every batch
+	tc.proc.SetResolveVariableFunc(func(string, bool, bool) (interface{}, error) {
+		return int64(1), nil
+	})
+	err := tc.arg.Prepare(tc.proc)
+	require.NoError(t, err)
+	bats := []*batch.Batch{
+		newBatch(tc.types, tc.proc, Rows),
+		newBatch(tc.types, tc.proc, Rows),
+		newBatch(tc.types, tc.proc, Rows),
+		batch.EmptyBatch,
+	}
+	resetChildren(tc.arg, bats)
+
+	var vals []int64
+	for {
+		result, err := tc.arg.Call(tc.proc)
+		require.NoError(t, err)
+		if result.Batch == nil {
+			break
+		}
+		vals = append(vals, vector.MustFixedColNoTypeCheck[int64](result.Batch.Vecs[0])...)
+	}
+	require.Equal(t, 3*Rows, len(vals))
+	require.True(t, slices.IsSorted(vals))
+	require.Equal(t, 3, len(tc.arg.ctr.runs))
+
+	tc.arg.GetChildren(0).Free(tc.proc, false, nil)
+	tc.arg.Free(tc.proc, false, nil)
+	// the memory is returned to the budget of the query
+	require.Equal(t, int64(0), tc.proc.GetQueryMemory().Used())
+	tc.proc.Free()
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
 func BenchmarkOrder(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs = []orderTestCase{
@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -79,6 +80,14 @@ type container struct {
 	sortExprExecutor []colexec.ExpressionExecutor
 	sortVectors      []*vector.Vector
 	resultOrderList  []int64
+
+	// budget is the memory budget of the query, the sorted batch is spilled
+	// as a run once the query exceeds the budget, and all runs are merged
+	// after all batches are received.
+	budget  spill.Budget
+	spiller *spill.Spiller
+	runs    []*spill.Run
+	merger  *spill.Merger
 }
 
 func (order *Order) Reset(proc *process.Process, pipelineFailed bool, err error) {
@@ -95,6 +104,7 @@ func (order *Order) Reset(proc *process.Process, pipelineFailed bool, err error)
 		ctr.rbat.Clean(proc.Mp())
 		ctr.rbat = nil
 	}
+	ctr.cleanSpill(proc)
 	ctr.state = vm.Build
 	for i := range ctr.sortExprExecutor {
 		if ctr.sortExprExecutor[i] != nil {
@@ -107,6 +117,7 @@ func (order *Order) Reset(proc *process.Process, pipelineFailed bool, err error)
 func (order *Order) Free(proc *process.Process, _ bool, err error) {
 	order.cleanBatch(proc)
 	ctr := &order.ctr
+	ctr.cleanSpill(proc)
 	for i := range ctr.sortExprExecutor {
 		if ctr.sortExprExecutor[i] != nil {
 			ctr.sortExprExecutor[i].Free()
@@ -128,3 +139,16 @@ func (order *Order) cleanBatch(proc *process.Process) {
 		ctr.rbat = nil
 	}
 }
+
+func (ctr *container) cleanSpill(proc *process.Process) {
+	if ctr.merger != nil {
+		ctr.merger.Free(proc)
+		ctr.merger = nil
+	}
+	if ctr.spiller != nil {
+		ctr.spiller.Close(proc.Ctx)
+		ctr.spiller = nil
+	}
+	ctr.runs = nil
+	ctr.budget.Release()
+}
@@ -98,7 +98,8 @@ func (postdml *PostDml) runPostDml(proc *process.Process, result vm.CallResult)
 				types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json,
 				types.T_blob, types.T_text, types.T_datalink:
 				pkey = "'" + pkey + "'"
-			case types.T_array_float32, types.T_array_float64:
+			case types.T_array_float32, types.T_array_float64,
+				types.T_array_float16, types.T_array_int8, types.T_array_bit:
 				return moerr.NewInternalError(proc.Ctx, "array cannot be primary key")
 			}
 
@@ -198,7 +199,7 @@ func GetAnyAsString(vec *vector.Vector, i int) (string, error) {
 	case types.T_Blockid:
 		return "", moerr.NewInternalErrorNoCtx("GetAnyAsString: block_id not supported") // vector.GetFixedAtNoTypeCheck[types.Blockid](vec, i)
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text,
-		types.T_array_float32, types.T_array_float64, types.T_datalink:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit, types.T_datalink:
 		return string(vec.GetBytesAt(i)), nil
 	}
 	return "", moerr.NewInternalErrorNoCtx("GetAnyAsString: invalid type")
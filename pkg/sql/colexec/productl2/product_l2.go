@@ -267,6 +267,40 @@ func (ctr *container) probe(ap *Productl2, proc *process.Process, result *vm.Cal
 			}
 			//*normalizeTblEmbeddingPtrF64 = normalizeTblEmbeddingF64
 			//arrayF64Pool.Put(normalizeTblEmbeddingPtrF64)
+		case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+			oid := ctr.bat.Vecs[centroidColPos].GetType().Oid
+			tblEmbeddingIsNull := ctr.inBat.Vecs[tblColPos].IsNull(uint64(j))
+			var tblEmbedding []byte
+			if !tblEmbeddingIsNull {
+				tblEmbedding = ctr.inBat.Vecs[tblColPos].GetBytesAt(j)
+				if oid != types.T_array_bit {
+					tblEmbeddingF32 = types.QuantizedArrayToFloat32(oid, tblEmbedding)
+				}
+			}
+
+			for i = 0; i < buildCount; i++ {
+				if tblEmbeddingIsNull || ctr.bat.Vecs[centroidColPos].IsNull(uint64(i)) {
+					leastDistance = 0
+					leastClusterIndex = i
+				} else {
+					var dist float64
+					var err error
+					if oid == types.T_array_bit {
+						// same order as the L2 distance of the bits
+						dist, err = moarray.HammingDistance(ctr.bat.Vecs[centroidColPos].GetBytesAt(i), tblEmbedding)
+					} else {
+						clusterEmbeddingF32 = types.QuantizedArrayToFloat32(oid, ctr.bat.Vecs[centroidColPos].GetBytesAt(i))
+						dist, err = moarray.L2DistanceSq[float32](clusterEmbeddingF32, tblEmbeddingF32)
+					}
+					if err != nil {
+						return err
+					}
+					if dist < leastDistance {
+						leastDistance = dist
+						leastClusterIndex = i
+					}
+				}
+			}
 		}
 		for k, rp := range ap.Result {
 			if rp.Rel == 0 {
@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashmap_util"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -83,7 +84,9 @@ func (rightJoin *RightJoin) Call(proc *process.Process) (vm.CallResult, error) {
 			if err != nil {
 				return result, err
 			}
-			if ctr.mp == nil && !rightJoin.IsShuffle {
+			if ctr.grace != nil {
+				ctr.state = SpillProbe
+			} else if ctr.mp == nil && !rightJoin.IsShuffle {
 				// for inner ,right and semi join, if hashmap is empty, we can finish this pipeline
 				// shuffle join can't stop early for this moment
 				ctr.state = End
@@ -91,6 +94,48 @@ func (rightJoin *RightJoin) Call(proc *process.Process) (vm.CallResult, error) {
 				ctr.state = Probe
 			}
 
+		case SpillProbe:
+			result, err = vm.ChildrenCall(rightJoin.GetChildren(0), proc, analyzer)
+			if err != nil {
+				return result, err
+			}
+			bat := result.Batch
+			if bat == nil {
+				if err = ctr.grace.FinishProbe(proc); err != nil {
+					return result, err
+				}
+				ctr.state = GraceProbe
+				continue
+			}
+			if bat.IsEmpty() {
+				continue
+			}
+			if err = ctr.grace.AddProbe(proc, bat); err != nil {
+				return result, err
+			}
+
+		case GraceProbe:
+			if ctr.buf == nil {
+				bat, err := ctr.grace.NextProbe(proc)
+				if err != nil {
+					return result, err
+				}
+				if bat == nil {
+					ok, err := ctr.nextGracePartition(proc)
+					if err != nil {
+						return result, err
+					}
+					if !ok {
+						analyzer.Spill(ctr.grace.SpillSize())
+						ctr.state = Finalize
+					}
+					continue
+				}
+				ctr.buf = bat
+				ctr.lastPos = 0
+			}
+			fallthrough
+
 		case Probe:
 			if rightJoin.ctr.buf == nil {
 				result, err = vm.ChildrenCall(rightJoin.GetChildren(0), proc, analyzer)
@@ -126,6 +171,14 @@ func (rightJoin *RightJoin) Call(proc *process.Process) (vm.CallResult, error) {
 			return result, nil
 
 		case Finalize:
+			if ctr.grace != nil {
+				if !ctr.mergeGraceMatched(rightJoin, proc) {
+					ctr.state = End
+					continue
+				}
+				ctr.state = GraceFinalize
+				continue
+			}
 			err := ctr.finalize(rightJoin, proc, &result)
 			if err != nil {
 				return result, err
@@ -140,6 +193,25 @@ func (rightJoin *RightJoin) Call(proc *process.Process) (vm.CallResult, error) {
 			analyzer.Output(result.Batch)
 			return result, nil
 
+		case GraceFinalize:
+			ok, err := ctr.nextGraceBuild(proc)
+			if err != nil {
+				return result, err
+			}
+			if !ok {
+				ctr.state = End
+				continue
+			}
+			if err = ctr.outputUnmatched(rightJoin, proc, &result); err != nil {
+				return result, err
+			}
+			if result.Batch.RowCount() == 0 {
+				continue
+			}
+			result.Status = vm.ExecNext
+			analyzer.Output(result.Batch)
+			return result, nil
+
 		default:
 			result.Batch = nil
 			result.Status = vm.ExecStop
@@ -156,6 +228,16 @@ func (rightJoin *RightJoin) build(analyzer process.Analyzer, proc *process.Proce
 	if err != nil {
 		return err
 	}
+	if ctr.mp.GetSpilled() != nil {
+		// the build side is spilled, join it as a grace hash join
+		mp := ctr.mp
+		ctr.mp = nil
+		if ctr.grace, err = hashmap_util.NewGraceJoin(proc, mp, rightJoin.Conditions[0]); err != nil {
+			return err
+		}
+		ctr.grace.KeepBuild()
+		return nil
+	}
 	if ctr.mp != nil {
 		ctr.maxAllocSize = max(ctr.maxAllocSize, ctr.mp.Size())
 	}
@@ -168,33 +250,85 @@ func (rightJoin *RightJoin) build(analyzer process.Analyzer, proc *process.Proce
 	return nil
 }
 
-func (ctr *container) finalize(ap *RightJoin, proc *process.Process, result *vm.CallResult) error {
-	ctr.handledLast = true
+// nextGracePartition switches to the next partition of the grace join, the
+// partitions without build rows are skipped because they output nothing.
+func (ctr *container) nextGracePartition(proc *process.Process) (bool, error) {
+	for {
+		mp, err := ctr.grace.NextPartition(proc)
+		ctr.mp = mp
+		if err != nil || mp == nil {
+			return false, err
+		}
+		if mp.GetRowCount() == 0 {
+			continue
+		}
+		ctr.itr = nil
+		ctr.batches = mp.GetBatches()
+		ctr.batchRowCount = mp.GetRowCount()
+		ctr.matched = ctr.grace.Matched()
+		return true, nil
+	}
+}
 
-	if ctr.matched == nil {
-		result.Batch = nil
-		return nil
+// nextGraceBuild switches to the build side of the next partition of the
+// grace join, to output its unmatched rows.
+func (ctr *container) nextGraceBuild(proc *process.Process) (bool, error) {
+	mp, matched, err := ctr.grace.NextBuild(proc)
+	ctr.mp = mp
+	if err != nil || mp == nil {
+		return false, err
+	}
+	ctr.batches = mp.GetBatches()
+	ctr.batchRowCount = matched.Len()
+	ctr.matched = matched
+	return true, nil
+}
+
+// mergeGraceMatched merges the matched rows of all partitions of all
+// parallel probes, it returns false if there is nothing to output by this
+// probe.
+func (ctr *container) mergeGraceMatched(ap *RightJoin, proc *process.Process) bool {
+	ctr.handledLast = true
+	matched := ctr.grace.MatchedRows()
+	if !ctr.mergeMatched(ap, proc, matched) {
+		return false
 	}
+	ctr.grace.MergeMatched(matched)
+	return true
+}
 
+// mergeMatched merges the matched rows of the parallel probes into the
+// merger, it returns false if this is not the merger or a probe failed.
+func (ctr *container) mergeMatched(ap *RightJoin, proc *process.Process, matched *bitmap.Bitmap) bool {
 	if ap.NumCPU > 1 {
 		if !ap.IsMerger {
-			ap.Channel <- ctr.matched
-			result.Batch = nil
-			return nil
-		} else {
-			for cnt := 1; cnt < int(ap.NumCPU); cnt++ {
-				v := colexec.ReceiveBitmapFromChannel(proc.Ctx, ap.Channel)
-				if v != nil {
-					ctr.matched.Or(v)
-				} else {
-					result.Batch = nil
-					return nil
-				}
+			ap.Channel <- matched
+			return false
+		}
+		for cnt := 1; cnt < int(ap.NumCPU); cnt++ {
+			v := colexec.ReceiveBitmapFromChannel(proc.Ctx, ap.Channel)
+			if v == nil {
+				return false
 			}
-			close(ap.Channel)
+			matched.Or(v)
 		}
+		close(ap.Channel)
 	}
+	return true
+}
+
+func (ctr *container) finalize(ap *RightJoin, proc *process.Process, result *vm.CallResult) error {
+	ctr.handledLast = true
+
+	if ctr.matched == nil || !ctr.mergeMatched(ap, proc, ctr.matched) {
+		result.Batch = nil
+		return nil
+	}
+	return ctr.outputUnmatched(ap, proc, result)
+}
 
+// outputUnmatched outputs the unmatched rows of the build side with nulls.
+func (ctr *container) outputUnmatched(ap *RightJoin, proc *process.Process, result *vm.CallResult) error {
 	count := ctr.batchRowCount - int64(ctr.matched.Count())
 	ctr.matched.Negate()
 	sels := make([]int32, 0, count)
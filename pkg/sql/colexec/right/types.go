@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashmap_util"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -33,7 +34,10 @@ var _ vm.Operator = new(RightJoin)
 const (
 	Build = iota
 	Probe
+	SpillProbe
+	GraceProbe
 	Finalize
+	GraceFinalize
 	End
 )
 
@@ -64,6 +68,9 @@ type container struct {
 	vecs  []*vector.Vector
 
 	mp *message.JoinMap
+	// grace joins a spilled build side partition by partition, mp, batches
+	// and matched are of the current partition which is owned by grace.
+	grace *hashmap_util.GraceJoin
 
 	matched *bitmap.Bitmap
 
@@ -129,6 +136,7 @@ func (rightJoin *RightJoin) Reset(proc *process.Process, pipelineFailed bool, er
 	if !ctr.handledLast && rightJoin.NumCPU > 1 && !rightJoin.IsMerger {
 		rightJoin.Channel <- nil
 	}
+	ctr.cleanGrace(proc)
 	ctr.cleanHashMap()
 	ctr.resetExprExecutor()
 	ctr.resetEvalVectors()
@@ -146,6 +154,7 @@ func (rightJoin *RightJoin) Reset(proc *process.Process, pipelineFailed bool, er
 
 func (rightJoin *RightJoin) Free(proc *process.Process, pipelineFailed bool, err error) {
 	ctr := &rightJoin.ctr
+	ctr.cleanGrace(proc)
 	ctr.cleanBatch(proc)
 	ctr.cleanHashMap()
 	ctr.cleanExprExecutor()
@@ -181,6 +190,17 @@ func (ctr *container) cleanBatch(proc *process.Process) {
 	}
 }
 
+func (ctr *container) cleanGrace(proc *process.Process) {
+	if ctr.grace != nil {
+		ctr.mp = nil
+		ctr.batches = nil
+		ctr.matched = nil
+		ctr.buf = nil
+		ctr.grace.Free(proc)
+		ctr.grace = nil
+	}
+}
+
 func (ctr *container) cleanHashMap() {
 	if ctr.mp != nil {
 		ctr.mp.Free()
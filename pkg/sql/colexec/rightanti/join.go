@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashmap_util"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -85,12 +86,54 @@ func (rightAnti *RightAnti) Call(proc *process.Process) (vm.CallResult, error) {
 			}
 			// for inner ,right and semi join, if hashmap is empty, we can finish this pipeline
 			// shuffle join can't stop early for this moment
-			if ctr.mp == nil && !rightAnti.IsShuffle {
+			if ctr.grace != nil {
+				ctr.state = SpillProbe
+			} else if ctr.mp == nil && !rightAnti.IsShuffle {
 				ctr.state = End
 			} else {
 				ctr.state = Probe
 			}
 
+		case SpillProbe:
+			result, err = vm.ChildrenCall(rightAnti.GetChildren(0), proc, analyzer)
+			if err != nil {
+				return result, err
+			}
+			bat := result.Batch
+			if bat == nil {
+				if err = ctr.grace.FinishProbe(proc); err != nil {
+					return result, err
+				}
+				ctr.state = GraceProbe
+				continue
+			}
+			if bat.IsEmpty() {
+				continue
+			}
+			if err = ctr.grace.AddProbe(proc, bat); err != nil {
+				return result, err
+			}
+
+		case GraceProbe:
+			bat, err := ctr.grace.NextProbe(proc)
+			if err != nil {
+				return result, err
+			}
+			if bat == nil {
+				ok, err := ctr.nextGracePartition(proc)
+				if err != nil {
+					return result, err
+				}
+				if !ok {
+					analyzer.Spill(ctr.grace.SpillSize())
+					ctr.state = SendLast
+				}
+				continue
+			}
+			if err = ctr.probe(bat, rightAnti, proc, analyzer); err != nil {
+				return result, err
+			}
+
 		case Probe:
 			result, err = vm.ChildrenCall(rightAnti.GetChildren(0), proc, analyzer)
 			if err != nil {
@@ -117,6 +160,14 @@ func (rightAnti *RightAnti) Call(proc *process.Process) (vm.CallResult, error) {
 			continue
 
 		case SendLast:
+			if ctr.grace != nil {
+				if ctr.mergeGraceMatched(rightAnti, proc) {
+					ctr.state = GraceFinalize
+				} else {
+					ctr.state = End
+				}
+				continue
+			}
 			if ctr.buf == nil {
 				ctr.lastPos = 0
 				err := ctr.finalize(rightAnti, proc)
@@ -136,6 +187,33 @@ func (rightAnti *RightAnti) Call(proc *process.Process) (vm.CallResult, error) {
 			analyzer.Output(result.Batch)
 			return result, nil
 
+		case GraceFinalize:
+			if ctr.lastPos >= len(ctr.buf) {
+				ctr.cleanBuf(proc)
+				ok, err := ctr.nextGraceBuild(proc)
+				if err != nil {
+					return result, err
+				}
+				if !ok {
+					ctr.state = End
+					continue
+				}
+				ctr.lastPos = 0
+				if err = ctr.outputUnmatched(rightAnti, proc); err != nil {
+					return result, err
+				}
+				continue
+			}
+
+			result.Batch = ctr.buf[ctr.lastPos]
+			ctr.lastPos++
+			if result.Batch.RowCount() == 0 {
+				continue
+			}
+			result.Status = vm.ExecHasMore
+			analyzer.Output(result.Batch)
+			return result, nil
+
 		default:
 			result.Batch = nil
 			result.Status = vm.ExecStop
@@ -152,6 +230,16 @@ func (rightAnti *RightAnti) build(analyzer process.Analyzer, proc *process.Proce
 	if err != nil {
 		return err
 	}
+	if ctr.mp.GetSpilled() != nil {
+		// the build side is spilled, join it as a grace hash join
+		mp := ctr.mp
+		ctr.mp = nil
+		if ctr.grace, err = hashmap_util.NewGraceJoin(proc, mp, rightAnti.Conditions[0]); err != nil {
+			return err
+		}
+		ctr.grace.KeepBuild()
+		return nil
+	}
 	if ctr.mp != nil {
 		ctr.maxAllocSize = max(ctr.maxAllocSize, ctr.mp.Size())
 	}
@@ -164,30 +252,84 @@ func (rightAnti *RightAnti) build(analyzer process.Analyzer, proc *process.Proce
 	return nil
 }
 
-func (ctr *container) finalize(ap *RightAnti, proc *process.Process) error {
-	ctr.handledLast = true
+// nextGracePartition switches to the next partition of the grace join, the
+// partitions without build rows are skipped because they output nothing.
+func (ctr *container) nextGracePartition(proc *process.Process) (bool, error) {
+	for {
+		mp, err := ctr.grace.NextPartition(proc)
+		ctr.mp = mp
+		if err != nil || mp == nil {
+			return false, err
+		}
+		if mp.GetRowCount() == 0 {
+			continue
+		}
+		ctr.itr = nil
+		ctr.batches = mp.GetBatches()
+		ctr.batchRowCount = mp.GetRowCount()
+		ctr.matched = ctr.grace.Matched()
+		return true, nil
+	}
+}
 
-	if ctr.matched == nil {
-		return nil
+// nextGraceBuild switches to the build side of the next partition of the
+// grace join, to output its rows.
+func (ctr *container) nextGraceBuild(proc *process.Process) (bool, error) {
+	mp, matched, err := ctr.grace.NextBuild(proc)
+	ctr.mp = mp
+	if err != nil || mp == nil {
+		return false, err
+	}
+	ctr.batches = mp.GetBatches()
+	ctr.batchRowCount = matched.Len()
+	ctr.matched = matched
+	return true, nil
+}
+
+// mergeGraceMatched merges the matched rows of all partitions of all
+// parallel probes, it returns false if there is nothing to output by this
+// probe.
+func (ctr *container) mergeGraceMatched(ap *RightAnti, proc *process.Process) bool {
+	ctr.handledLast = true
+	matched := ctr.grace.MatchedRows()
+	if !ctr.mergeMatched(ap, proc, matched) {
+		return false
 	}
+	ctr.grace.MergeMatched(matched)
+	return true
+}
 
+// mergeMatched merges the matched rows of the parallel probes into the
+// merger, it returns false if this is not the merger or a probe failed.
+func (ctr *container) mergeMatched(ap *RightAnti, proc *process.Process, matched *bitmap.Bitmap) bool {
 	if ap.NumCPU > 1 {
 		if !ap.IsMerger {
-			ap.Channel <- ctr.matched
-			return nil
-		} else {
-			for cnt := 1; cnt < int(ap.NumCPU); cnt++ {
-				v := colexec.ReceiveBitmapFromChannel(proc.Ctx, ap.Channel)
-				if v != nil {
-					ctr.matched.Or(v)
-				} else {
-					return nil
-				}
+			ap.Channel <- matched
+			return false
+		}
+		for cnt := 1; cnt < int(ap.NumCPU); cnt++ {
+			v := colexec.ReceiveBitmapFromChannel(proc.Ctx, ap.Channel)
+			if v == nil {
+				return false
 			}
-			close(ap.Channel)
+			matched.Or(v)
 		}
+		close(ap.Channel)
+	}
+	return true
+}
+
+func (ctr *container) finalize(ap *RightAnti, proc *process.Process) error {
+	ctr.handledLast = true
+
+	if ctr.matched == nil || !ctr.mergeMatched(ap, proc, ctr.matched) {
+		return nil
 	}
+	return ctr.outputUnmatched(ap, proc)
+}
 
+// outputUnmatched outputs the unmatched rows of the build side.
+func (ctr *container) outputUnmatched(ap *RightAnti, proc *process.Process) error {
 	count := ctr.batchRowCount - int64(ctr.matched.Count())
 	ctr.matched.Negate()
 	sels := make([]int32, 0, count)
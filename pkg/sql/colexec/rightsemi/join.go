@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashmap_util"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -83,7 +84,9 @@ func (rightSemi *RightSemi) Call(proc *process.Process) (vm.CallResult, error) {
 			if err != nil {
 				return result, err
 			}
-			if ctr.mp == nil && !rightSemi.IsShuffle {
+			if ctr.grace != nil {
+				ctr.state = SpillProbe
+			} else if ctr.mp == nil && !rightSemi.IsShuffle {
 				// for inner ,right and semi join, if hashmap is empty, we can finish this pipeline
 				// shuffle join can't stop early for this moment
 				ctr.state = End
@@ -91,6 +94,46 @@ func (rightSemi *RightSemi) Call(proc *process.Process) (vm.CallResult, error) {
 				ctr.state = Probe
 			}
 
+		case SpillProbe:
+			result, err = vm.ChildrenCall(rightSemi.GetChildren(0), proc, analyzer)
+			if err != nil {
+				return result, err
+			}
+			bat := result.Batch
+			if bat == nil {
+				if err = ctr.grace.FinishProbe(proc); err != nil {
+					return result, err
+				}
+				ctr.state = GraceProbe
+				continue
+			}
+			if bat.IsEmpty() {
+				continue
+			}
+			if err = ctr.grace.AddProbe(proc, bat); err != nil {
+				return result, err
+			}
+
+		case GraceProbe:
+			bat, err := ctr.grace.NextProbe(proc)
+			if err != nil {
+				return result, err
+			}
+			if bat == nil {
+				ok, err := ctr.nextGracePartition(proc)
+				if err != nil {
+					return result, err
+				}
+				if !ok {
+					analyzer.Spill(ctr.grace.SpillSize())
+					ctr.state = Finalize
+				}
+				continue
+			}
+			if err = ctr.probe(bat, rightSemi, proc, analyzer); err != nil {
+				return result, err
+			}
+
 		case Probe:
 			result, err = vm.ChildrenCall(rightSemi.GetChildren(0), proc, analyzer)
 			if err != nil {
@@ -116,6 +159,14 @@ func (rightSemi *RightSemi) Call(proc *process.Process) (vm.CallResult, error) {
 			continue
 
 		case Finalize:
+			if ctr.grace != nil {
+				if ctr.mergeGraceMatched(rightSemi, proc) {
+					ctr.state = GraceFinalize
+				} else {
+					ctr.state = End
+				}
+				continue
+			}
 			if ctr.buf == nil {
 				ctr.lastPos = 0
 				err := ctr.finalize(rightSemi, proc)
@@ -135,6 +186,33 @@ func (rightSemi *RightSemi) Call(proc *process.Process) (vm.CallResult, error) {
 			analyzer.Output(result.Batch)
 			return result, nil
 
+		case GraceFinalize:
+			if ctr.lastPos >= len(ctr.buf) {
+				ctr.cleanBuf(proc)
+				ok, err := ctr.nextGraceBuild(proc)
+				if err != nil {
+					return result, err
+				}
+				if !ok {
+					ctr.state = End
+					continue
+				}
+				ctr.lastPos = 0
+				if err = ctr.outputMatched(rightSemi, proc); err != nil {
+					return result, err
+				}
+				continue
+			}
+
+			result.Batch = ctr.buf[ctr.lastPos]
+			ctr.lastPos++
+			if result.Batch.RowCount() == 0 {
+				continue
+			}
+			result.Status = vm.ExecHasMore
+			analyzer.Output(result.Batch)
+			return result, nil
+
 		default:
 			result.Batch = nil
 			result.Status = vm.ExecStop
@@ -151,6 +229,16 @@ func (rightSemi *RightSemi) build(analyzer process.Analyzer, proc *process.Proce
 	if err != nil {
 		return err
 	}
+	if ctr.mp.GetSpilled() != nil {
+		// the build side is spilled, join it as a grace hash join
+		mp := ctr.mp
+		ctr.mp = nil
+		if ctr.grace, err = hashmap_util.NewGraceJoin(proc, mp, rightSemi.Conditions[0]); err != nil {
+			return err
+		}
+		ctr.grace.KeepBuild()
+		return nil
+	}
 	if ctr.mp != nil {
 		ctr.maxAllocSize = max(ctr.maxAllocSize, ctr.mp.Size())
 	}
@@ -163,30 +251,84 @@ func (rightSemi *RightSemi) build(analyzer process.Analyzer, proc *process.Proce
 	return nil
 }
 
-func (ctr *container) finalize(ap *RightSemi, proc *process.Process) error {
-	ctr.handledLast = true
+// nextGracePartition switches to the next partition of the grace join, the
+// partitions without build rows are skipped because they output nothing.
+func (ctr *container) nextGracePartition(proc *process.Process) (bool, error) {
+	for {
+		mp, err := ctr.grace.NextPartition(proc)
+		ctr.mp = mp
+		if err != nil || mp == nil {
+			return false, err
+		}
+		if mp.GetRowCount() == 0 {
+			continue
+		}
+		ctr.itr = nil
+		ctr.batches = mp.GetBatches()
+		ctr.batchRowCount = mp.GetRowCount()
+		ctr.matched = ctr.grace.Matched()
+		return true, nil
+	}
+}
 
-	if ctr.matched == nil {
-		return nil
+// nextGraceBuild switches to the build side of the next partition of the
+// grace join, to output its rows.
+func (ctr *container) nextGraceBuild(proc *process.Process) (bool, error) {
+	mp, matched, err := ctr.grace.NextBuild(proc)
+	ctr.mp = mp
+	if err != nil || mp == nil {
+		return false, err
 	}
+	ctr.batches = mp.GetBatches()
+	ctr.batchRowCount = matched.Len()
+	ctr.matched = matched
+	return true, nil
+}
 
+// mergeGraceMatched merges the matched rows of all partitions of all
+// parallel probes, it returns false if there is nothing to output by this
+// probe.
+func (ctr *container) mergeGraceMatched(ap *RightSemi, proc *process.Process) bool {
+	ctr.handledLast = true
+	matched := ctr.grace.MatchedRows()
+	if !ctr.mergeMatched(ap, proc, matched) {
+		return false
+	}
+	ctr.grace.MergeMatched(matched)
+	return true
+}
+
+// mergeMatched merges the matched rows of the parallel probes into the
+// merger, it returns false if this is not the merger or a probe failed.
+func (ctr *container) mergeMatched(ap *RightSemi, proc *process.Process, matched *bitmap.Bitmap) bool {
 	if ap.NumCPU > 1 {
 		if !ap.IsMerger {
-			ap.Channel <- ctr.matched
-			return nil
-		} else {
-			for cnt := 1; cnt < int(ap.NumCPU); cnt++ {
-				v := colexec.ReceiveBitmapFromChannel(proc.Ctx, ap.Channel)
-				if v != nil {
-					ctr.matched.Or(v)
-				} else {
-					return nil
-				}
+			ap.Channel <- matched
+			return false
+		}
+		for cnt := 1; cnt < int(ap.NumCPU); cnt++ {
+			v := colexec.ReceiveBitmapFromChannel(proc.Ctx, ap.Channel)
+			if v == nil {
+				return false
 			}
-			close(ap.Channel)
+			matched.Or(v)
 		}
+		close(ap.Channel)
+	}
+	return true
+}
+
+func (ctr *container) finalize(ap *RightSemi, proc *process.Process) error {
+	ctr.handledLast = true
+
+	if ctr.matched == nil || !ctr.mergeMatched(ap, proc, ctr.matched) {
+		return nil
 	}
+	return ctr.outputMatched(ap, proc)
+}
 
+// outputMatched outputs the matched rows of the build side.
+func (ctr *container) outputMatched(ap *RightSemi, proc *process.Process) error {
 	count := ctr.matched.Count()
 	sels := make([]int32, 0, count)
 	itr := ctr.matched.Iterator()
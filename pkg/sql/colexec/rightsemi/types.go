@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashmap_util"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -33,7 +34,10 @@ var _ vm.Operator = new(RightSemi)
 const (
 	Build = iota
 	Probe
+	SpillProbe
+	GraceProbe
 	Finalize
+	GraceFinalize
 	End
 )
 
@@ -63,6 +67,9 @@ type container struct {
 	vecs  []*vector.Vector
 
 	mp *message.JoinMap
+	// grace joins a spilled build side partition by partition, mp, batches
+	// and matched are of the current partition which is owned by grace.
+	grace *hashmap_util.GraceJoin
 
 	matched *bitmap.Bitmap
 
@@ -138,6 +145,7 @@ func (rightSemi *RightSemi) Reset(proc *process.Process, pipelineFailed bool, er
 	ctr.maxAllocSize = 0
 
 	ctr.cleanBuf(proc)
+	ctr.cleanGrace(proc)
 	ctr.cleanHashMap()
 	ctr.resetExprExecutor()
 	ctr.resetEvalVectors()
@@ -149,6 +157,8 @@ func (rightSemi *RightSemi) Reset(proc *process.Process, pipelineFailed bool, er
 
 func (rightSemi *RightSemi) Free(proc *process.Process, pipelineFailed bool, err error) {
 	ctr := &rightSemi.ctr
+	ctr.cleanBuf(proc)
+	ctr.cleanGrace(proc)
 	ctr.cleanBatch(proc)
 	ctr.cleanEvalVectors()
 	ctr.cleanExprExecutor()
@@ -196,6 +206,16 @@ func (ctr *container) cleanBatch(proc *process.Process) {
 	}
 }
 
+func (ctr *container) cleanGrace(proc *process.Process) {
+	if ctr.grace != nil {
+		ctr.mp = nil
+		ctr.batches = nil
+		ctr.matched = nil
+		ctr.grace.Free(proc)
+		ctr.grace = nil
+	}
+}
+
 func (ctr *container) cleanHashMap() {
 	if ctr.mp != nil {
 		ctr.mp.Free()
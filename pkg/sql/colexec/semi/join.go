@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashmap_util"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -91,7 +92,9 @@ func (semiJoin *SemiJoin) Call(proc *process.Process) (vm.CallResult, error) {
 			if err != nil {
 				return result, err
 			}
-			if ctr.mp == nil && !semiJoin.IsShuffle {
+			if ctr.grace != nil {
+				ctr.state = SpillProbe
+			} else if ctr.mp == nil && !semiJoin.IsShuffle {
 				// for inner ,right and semi join, if hashmap is empty, we can finish this pipeline
 				// shuffle join can't stop early for this moment
 				ctr.state = End
@@ -102,16 +105,60 @@ func (semiJoin *SemiJoin) Call(proc *process.Process) (vm.CallResult, error) {
 				ctr.skipProbe = true
 			}
 
-		case Probe:
+		case SpillProbe:
 			input, err = vm.ChildrenCall(semiJoin.GetChildren(0), proc, analyzer)
 			if err != nil {
 				return result, err
 			}
 			bat := input.Batch
 			if bat == nil {
-				ctr.state = End
+				if err = ctr.grace.FinishProbe(proc); err != nil {
+					return result, err
+				}
+				ctr.state = GraceProbe
+				continue
+			}
+			if bat.Last() {
+				result.Batch = bat
+				analyzer.Output(result.Batch)
+				return result, nil
+			}
+			if bat.IsEmpty() {
 				continue
 			}
+			if err = ctr.grace.AddProbe(proc, bat); err != nil {
+				return result, err
+			}
+
+		case Probe, GraceProbe:
+			var bat *batch.Batch
+			if ctr.state == GraceProbe {
+				bat, err = ctr.grace.NextProbe(proc)
+				if err != nil {
+					return result, err
+				}
+				if bat == nil {
+					ok, err := ctr.nextGracePartition(proc)
+					if err != nil {
+						return result, err
+					}
+					if !ok {
+						analyzer.Spill(ctr.grace.SpillSize())
+						ctr.state = End
+					}
+					continue
+				}
+			} else {
+				input, err = vm.ChildrenCall(semiJoin.GetChildren(0), proc, analyzer)
+				if err != nil {
+					return result, err
+				}
+				bat = input.Batch
+				if bat == nil {
+					ctr.state = End
+					continue
+				}
+			}
 			if bat.IsEmpty() {
 				continue
 			}
@@ -171,6 +218,22 @@ func (semiJoin *SemiJoin) Call(proc *process.Process) (vm.CallResult, error) {
 	}
 }
 
+// nextGracePartition switches to the next partition of the grace join which
+// has any row of the build side, it returns false if all partitions are joined.
+func (ctr *container) nextGracePartition(proc *process.Process) (bool, error) {
+	for {
+		mp, err := ctr.grace.NextPartition(proc)
+		ctr.mp = mp
+		if err != nil || mp == nil {
+			return false, err
+		}
+		if mp.GetRowCount() > 0 {
+			ctr.itr = nil
+			return true, nil
+		}
+	}
+}
+
 func (semiJoin *SemiJoin) build(analyzer process.Analyzer, proc *process.Process) (err error) {
 	ctr := &semiJoin.ctr
 	start := time.Now()
@@ -179,6 +242,13 @@ func (semiJoin *SemiJoin) build(analyzer process.Analyzer, proc *process.Process
 	if err != nil {
 		return err
 	}
+	if ctr.mp.GetSpilled() != nil {
+		// the build side is spilled, join it as a grace hash join
+		mp := ctr.mp
+		ctr.mp = nil
+		ctr.grace, err = hashmap_util.NewGraceJoin(proc, mp, semiJoin.Conditions[0])
+		return err
+	}
 	if ctr.mp != nil {
 		ctr.maxAllocSize = max(ctr.maxAllocSize, ctr.mp.Size())
 	}
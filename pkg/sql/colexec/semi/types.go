@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashmap_util"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -31,6 +32,8 @@ var _ vm.Operator = new(SemiJoin)
 const (
 	Build = iota
 	Probe
+	SpillProbe
+	GraceProbe
 	End
 )
 
@@ -53,6 +56,9 @@ type container struct {
 
 	mp        *message.JoinMap
 	skipProbe bool
+	// grace joins a spilled build side partition by partition, mp is the
+	// join map of the current partition which is owned by grace.
+	grace *hashmap_util.GraceJoin
 
 	maxAllocSize int64
 }
@@ -109,6 +115,7 @@ func (semiJoin *SemiJoin) Reset(proc *process.Process, pipelineFailed bool, err
 	ctr.eligible = ctr.eligible[:0]
 	ctr.resetExecutor()
 	ctr.resetExprExecutor()
+	ctr.cleanGrace(proc)
 	ctr.cleanHashMap()
 	ctr.state = Build
 	ctr.skipProbe = false
@@ -134,6 +141,7 @@ func (semiJoin *SemiJoin) Free(proc *process.Process, pipelineFailed bool, err e
 	ctr.eligible = nil
 	ctr.cleanExecutor()
 	ctr.cleanExprExecutor()
+	ctr.cleanGrace(proc)
 	ctr.cleanBatch(proc)
 
 	semiJoin.FreeProjection(proc)
@@ -164,6 +172,14 @@ func (ctr *container) cleanBatch(proc *process.Process) {
 	}
 }
 
+func (ctr *container) cleanGrace(proc *process.Process) {
+	if ctr.grace != nil {
+		ctr.mp = nil
+		ctr.grace.Free(proc)
+		ctr.grace = nil
+	}
+}
+
 func (ctr *container) cleanHashMap() {
 	if ctr.mp != nil {
 		ctr.mp.Free()
@@ -17,6 +17,7 @@ package shufflebuild
 import (
 	"bytes"
 
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -43,6 +44,10 @@ func (shuffleBuild *ShuffleBuild) Prepare(proc *process.Process) (err error) {
 	if shuffleBuild.RuntimeFilterSpec == nil {
 		panic("there must be runtime filter in shuffle build!")
 	}
+	shuffleBuild.ctr.budget = spill.Budget{}
+	if shuffleBuild.CanSpill {
+		shuffleBuild.ctr.budget = spill.NewBudget(proc)
+	}
 	shuffleBuild.ctr.hashmapBuilder.IsDedup = shuffleBuild.IsDedup
 	shuffleBuild.ctr.hashmapBuilder.OnDuplicateAction = shuffleBuild.OnDuplicateAction
 	shuffleBuild.ctr.hashmapBuilder.DedupColName = shuffleBuild.DedupColName
@@ -76,6 +81,18 @@ func (shuffleBuild *ShuffleBuild) Call(proc *process.Process) (vm.CallResult, er
 			}
 			ctr.state = BuildHashMap
 		case BuildHashMap:
+			if ctr.hashmapBuilder.IsSpilled() {
+				// the join builds the hashmap of every partition itself
+				var err error
+				ctr.spilledBuild, err = ctr.hashmapBuilder.FinishSpill(proc, ap.Conditions, ap.HashOnPK, ap.NeedAllocateSels)
+				if err != nil {
+					analyzer.Output(result.Batch)
+					return result, err
+				}
+				analyzer.Spill(ctr.spilledBuild.Size())
+				ctr.state = SendJoinMap
+				continue
+			}
 			err := ctr.hashmapBuilder.BuildHashmap(ap.HashOnPK, ap.NeedAllocateSels, false, proc)
 			if err != nil {
 				analyzer.Output(result.Batch)
@@ -93,7 +110,12 @@ func (shuffleBuild *ShuffleBuild) Call(proc *process.Process) (vm.CallResult, er
 				panic("wrong joinmap message tag!")
 			}
 			var jm *message.JoinMap
-			if ctr.hashmapBuilder.InputBatchRowCount > 0 {
+			if ctr.spilledBuild != nil {
+				jm = message.NewSpilledJoinMap(ctr.spilledBuild, proc.Mp())
+				jm.SetRowCount(int64(ctr.hashmapBuilder.InputBatchRowCount))
+				jm.IncRef(1)
+				ctr.spilledBuild = nil
+			} else if ctr.hashmapBuilder.InputBatchRowCount > 0 {
 				jm = message.NewJoinMap(ctr.hashmapBuilder.MultiSels, ctr.hashmapBuilder.IntHashMap, ctr.hashmapBuilder.StrHashMap, ctr.hashmapBuilder.Batches.Buf, proc.Mp())
 				if ap.NeedBatches {
 					jm.SetRowCount(int64(ctr.hashmapBuilder.InputBatchRowCount))
@@ -127,7 +149,17 @@ func (ctr *container) collectBuildBatches(shuffleBuild *ShuffleBuild, proc *proc
 
 		analyzer.Alloc(int64(result.Batch.Size()))
 		ctr.hashmapBuilder.InputBatchRowCount += result.Batch.RowCount()
-		err = ctr.hashmapBuilder.Batches.CopyIntoBatches(result.Batch, proc)
+		if ctr.hashmapBuilder.IsSpilled() {
+			err = ctr.hashmapBuilder.SpillBatch(proc, result.Batch)
+		} else {
+			err = ctr.hashmapBuilder.Batches.CopyIntoBatches(result.Batch, proc)
+			ctr.batchesSize += int64(result.Batch.Size())
+			if err == nil && ctr.budget.Update(ctr.batchesSize) {
+				err = ctr.hashmapBuilder.Spill(proc)
+				ctr.batchesSize = 0
+				ctr.budget.Update(0)
+			}
+		}
 		if err != nil {
 			return err
 		}
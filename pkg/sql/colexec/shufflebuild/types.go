@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/reuse"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashmap_util"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -35,6 +36,13 @@ const (
 type container struct {
 	state          int
 	hashmapBuilder hashmap_util.HashmapBuilder
+
+	// budget is the memory budget of the query, the build side is
+	// partitioned to disk once the query exceeds the budget if CanSpill.
+	// batchesSize is the memory of the collected batches.
+	budget       spill.Budget
+	batchesSize  int64
+	spilledBuild *hashmap_util.SpilledBuild
 }
 
 type ShuffleBuild struct {
@@ -46,6 +54,9 @@ type ShuffleBuild struct {
 	RuntimeFilterSpec *plan.RuntimeFilterSpec
 	JoinMapTag        int32
 	ShuffleIdx        int32
+	// CanSpill is true if the join can probe a spilled build side
+	// partition by partition, as a grace hash join.
+	CanSpill bool
 
 	IsDedup           bool
 	OnDuplicateAction plan.Node_OnDuplicateAction
@@ -91,6 +102,7 @@ func (shuffleBuild *ShuffleBuild) Reset(proc *process.Process, pipelineFailed bo
 	mapSucceed := shuffleBuild.ctr.state == SendSucceed
 
 	shuffleBuild.ctr.hashmapBuilder.Reset(proc, !mapSucceed)
+	shuffleBuild.ctr.cleanSpilledBuild()
 	shuffleBuild.ctr.state = ReceiveBatch
 	message.FinalizeRuntimeFilter(shuffleBuild.RuntimeFilterSpec, runtimeSucceed, proc.GetMessageBoard())
 	message.FinalizeJoinMapMessage(proc.GetMessageBoard(), shuffleBuild.JoinMapTag, true, shuffleBuild.ShuffleIdx, mapSucceed)
@@ -98,4 +110,16 @@ func (shuffleBuild *ShuffleBuild) Reset(proc *process.Process, pipelineFailed bo
 
 func (shuffleBuild *ShuffleBuild) Free(proc *process.Process, pipelineFailed bool, err error) {
 	shuffleBuild.ctr.hashmapBuilder.Free(proc)
+	shuffleBuild.ctr.cleanSpilledBuild()
+}
+
+// cleanSpilledBuild removes the spilled build side if it was not sent, and
+// returns the memory of the build side to the budget.
+func (ctr *container) cleanSpilledBuild() {
+	if ctr.spilledBuild != nil {
+		ctr.spilledBuild.Free()
+		ctr.spilledBuild = nil
+	}
+	ctr.batchesSize = 0
+	ctr.budget.Release()
 }
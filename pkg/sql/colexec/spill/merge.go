@@ -0,0 +1,237 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/compare"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// SortedSource is a sequence of batches sorted by the order by specs.
+type SortedSource interface {
+	// Next returns the next batch, or nil if there is no more batch.
+	// The merger takes the ownership of the batch.
+	Next(proc *process.Process) (*batch.Batch, error)
+}
+
+var _ SortedSource = new(Run)
+var _ SortedSource = new(batchSource)
+
+type batchSource struct {
+	bat *batch.Batch
+}
+
+// NewBatchSource returns a source of one sorted batch in memory.
+func NewBatchSource(bat *batch.Batch) SortedSource {
+	return &batchSource{bat: bat}
+}
+
+func (s *batchSource) Next(_ *process.Process) (*batch.Batch, error) {
+	bat := s.bat
+	s.bat = nil
+	return bat, nil
+}
+
+// Merger merges sorted sources into one sorted sequence, it keeps only the
+// current batch of every source in memory.
+type Merger struct {
+	executors []colexec.ExpressionExecutor
+	compares  []compare.Compare
+
+	sources []SortedSource
+	bats    []*batch.Batch
+	cols    [][]*vector.Vector
+	// idx[i] is the next row of bats[i] to merge
+	idx []int64
+
+	buf *batch.Batch
+}
+
+func NewMerger(proc *process.Process, specs []*plan.OrderBySpec) (*Merger, error) {
+	m := &Merger{
+		executors: make([]colexec.ExpressionExecutor, len(specs)),
+		compares:  make([]compare.Compare, len(specs)),
+	}
+	for i, spec := range specs {
+		executor, err := colexec.NewExpressionExecutor(proc, spec.Expr)
+		if err != nil {
+			m.Free(proc)
+			return nil, err
+		}
+		m.executors[i] = executor
+
+		desc := spec.Flag&plan.OrderBySpec_DESC != 0
+		nullsLast := desc
+		if spec.Flag&plan.OrderBySpec_NULLS_FIRST != 0 {
+			nullsLast = false
+		} else if spec.Flag&plan.OrderBySpec_NULLS_LAST != 0 {
+			nullsLast = true
+		}
+		typ := types.New(types.T(spec.Expr.Typ.Id), spec.Expr.Typ.Width, spec.Expr.Typ.Scale)
+		m.compares[i] = compare.New(typ, desc, nullsLast)
+	}
+	return m, nil
+}
+
+// AddSource adds a source and loads its first batch.
+func (m *Merger) AddSource(proc *process.Process, src SortedSource) error {
+	m.sources = append(m.sources, src)
+	m.bats = append(m.bats, nil)
+	m.cols = append(m.cols, nil)
+	m.idx = append(m.idx, 0)
+	return m.load(proc, len(m.sources)-1)
+}
+
+// load reads the next non-empty batch of the i-th source, and removes the
+// source if it is exhausted.
+func (m *Merger) load(proc *process.Process, i int) error {
+	for {
+		bat, err := m.sources[i].Next(proc)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			m.remove(i)
+			return nil
+		}
+		if bat.IsEmpty() {
+			bat.Clean(proc.Mp())
+			continue
+		}
+
+		m.bats[i] = bat
+		m.idx[i] = 0
+		m.cols[i] = make([]*vector.Vector, len(m.executors))
+		for k, executor := range m.executors {
+			if m.cols[i][k], err = executor.EvalWithoutResultReusing(proc, []*batch.Batch{bat}, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func (m *Merger) remove(i int) {
+	m.sources = append(m.sources[:i], m.sources[i+1:]...)
+	m.bats = append(m.bats[:i], m.bats[i+1:]...)
+	m.cols = append(m.cols[:i], m.cols[i+1:]...)
+	m.idx = append(m.idx[:i], m.idx[i+1:]...)
+}
+
+// freeCurrent frees the current batch of the i-th source and its order
+// columns, an order column may be a vector of the batch.
+func (m *Merger) freeCurrent(proc *process.Process, i int) {
+	bat := m.bats[i]
+	if bat == nil {
+		return
+	}
+	owned := make(map[*vector.Vector]struct{}, len(bat.Vecs))
+	for _, vec := range bat.Vecs {
+		owned[vec] = struct{}{}
+	}
+	for _, vec := range m.cols[i] {
+		if vec == nil {
+			continue
+		}
+		if _, ok := owned[vec]; !ok {
+			vec.Free(proc.Mp())
+		}
+	}
+	bat.Clean(proc.Mp())
+	m.bats[i] = nil
+	m.cols[i] = nil
+}
+
+func (m *Merger) pick() int {
+	choice := 0
+	for j := 1; j < len(m.bats); j++ {
+		for k := range m.compares {
+			m.compares[k].Set(0, m.cols[choice][k])
+			m.compares[k].Set(1, m.cols[j][k])
+			result := m.compares[k].Compare(0, 1, m.idx[choice], m.idx[j])
+			if result > 0 {
+				choice = j
+			}
+			if result != 0 {
+				break
+			}
+		}
+	}
+	return choice
+}
+
+// Next returns the next merged batch with at most maxRows rows, or nil if
+// all sources are exhausted. The batch is reused by the next call.
+func (m *Merger) Next(proc *process.Process, maxRows int) (*batch.Batch, error) {
+	if len(m.bats) == 0 {
+		return nil, nil
+	}
+
+	mp := proc.Mp()
+	if m.buf == nil {
+		m.buf = batch.NewWithSize(m.bats[0].VectorCount())
+		for i := range m.buf.Vecs {
+			m.buf.Vecs[i] = vector.NewVec(*m.bats[0].Vecs[i].GetType())
+		}
+	} else {
+		m.buf.CleanOnlyData()
+	}
+
+	rows := 0
+	for rows < maxRows && len(m.bats) > 0 {
+		i := m.pick()
+		for j := range m.buf.Vecs {
+			if err := m.buf.Vecs[j].UnionOne(m.bats[i].Vecs[j], m.idx[i], mp); err != nil {
+				return nil, err
+			}
+		}
+		rows++
+
+		m.idx[i]++
+		if m.idx[i] == int64(m.bats[i].RowCount()) {
+			m.freeCurrent(proc, i)
+			if err := m.load(proc, i); err != nil {
+				return nil, err
+			}
+		}
+	}
+	m.buf.SetRowCount(rows)
+	return m.buf, nil
+}
+
+func (m *Merger) Free(proc *process.Process) {
+	for i := range m.bats {
+		m.freeCurrent(proc, i)
+	}
+	m.sources = nil
+	m.bats = nil
+	m.cols = nil
+	m.idx = nil
+	for _, executor := range m.executors {
+		if executor != nil {
+			executor.Free()
+		}
+	}
+	m.executors = nil
+	if m.buf != nil {
+		m.buf.Clean(proc.Mp())
+		m.buf = nil
+	}
+}
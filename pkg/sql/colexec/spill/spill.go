@@ -0,0 +1,233 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spill writes the state of the memory hungry operators (group,
+// order and merge order) to the local fileservice once it exceeds the
+// memory budget of the query, and reads it back batch by batch.
+package spill
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// MemoryBudgetVar is the system variable of the memory budget, in bytes,
+// of a query. 0 means no limit and the operators never spill.
+const MemoryBudgetVar = "query_memory_budget"
+
+const spillDir = "spill"
+
+// GetMemoryBudget returns the memory budget of the query, 0 if not set.
+func GetMemoryBudget(proc *process.Process) int64 {
+	resolve := proc.GetResolveVariableFunc()
+	if resolve == nil {
+		return 0
+	}
+	val, err := resolve(MemoryBudgetVar, true, false)
+	if err != nil || val == nil {
+		return 0
+	}
+	switch v := val.(type) {
+	case int64:
+		return v
+	case uint64:
+		return int64(v)
+	case int:
+		return int64(v)
+	}
+	return 0
+}
+
+// Budget is the share of an operator in the memory budget of the query.
+// The operator reports the memory it holds by Update, and spills once the
+// memory held by all the operators of the query exceeds the budget.
+type Budget struct {
+	limit int64
+	query *process.QueryMemory
+	size  int64
+}
+
+// NewBudget returns the budget of an operator of the query, it never asks
+// to spill if the memory budget of the query is not set.
+func NewBudget(proc *process.Process) Budget {
+	b := Budget{limit: GetMemoryBudget(proc), query: proc.GetQueryMemory()}
+	if b.query == nil {
+		b.query = &process.QueryMemory{}
+	}
+	return b
+}
+
+// Enabled returns true if the memory budget of the query is set.
+func (b *Budget) Enabled() bool {
+	return b.limit > 0
+}
+
+// Update sets the memory held by the operator, and returns true if the
+// operator should spill: the query exceeds the budget, and the operator
+// holds at least its fair share of the budget. The operators holding less
+// do not spill, they would only spill tiny runs over and over again.
+func (b *Budget) Update(size int64) bool {
+	if b.query == nil {
+		return false
+	}
+	used, operators := b.query.Update(b.size, size)
+	b.size = size
+	return b.limit > 0 && used > b.limit && size*max(operators, 1) >= b.limit
+}
+
+// Release returns the memory held by the operator to the budget.
+func (b *Budget) Release() {
+	b.Update(0)
+}
+
+// Spiller writes batches to the local fileservice, one file per batch.
+// Files are written at once because the fileservice does not support
+// appending.
+type Spiller struct {
+	fs     fileservice.FileService
+	prefix string
+	seq    int
+	files  []string
+	size   int64
+}
+
+func NewSpiller(proc *process.Process, opName string) (*Spiller, error) {
+	fs, err := fileservice.Get[fileservice.FileService](proc.GetFileService(), defines.LocalFileServiceName)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	return &Spiller{
+		fs:     fs,
+		prefix: fmt.Sprintf("%s/%s/%s", spillDir, opName, id.String()),
+	}, nil
+}
+
+// Write writes the batch, including its aggregation states, to a new file.
+func (s *Spiller) Write(ctx context.Context, bat *batch.Batch) (string, error) {
+	data, err := bat.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("%s/%d", s.prefix, s.seq)
+	s.seq++
+	if err = s.fs.Write(ctx, fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{
+				Size: int64(len(data)),
+				Data: data,
+			},
+		},
+	}); err != nil {
+		return "", err
+	}
+	s.files = append(s.files, name)
+	s.size += int64(len(data))
+	return name, nil
+}
+
+// Read reads a batch written by Write, the memory of the batch is
+// allocated from the mpool of the process.
+func (s *Spiller) Read(proc *process.Process, name string) (*batch.Batch, error) {
+	vec := &fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   -1,
+			},
+		},
+	}
+	if err := s.fs.Read(proc.Ctx, vec); err != nil {
+		return nil, err
+	}
+	bat := batch.NewOffHeapEmpty()
+	if err := bat.UnmarshalBinaryWithAnyMp(vec.Entries[0].Data, proc.Mp()); err != nil {
+		bat.Clean(proc.Mp())
+		return nil, err
+	}
+	// the vectors refer to the read buffer, copy them into the mpool to
+	// keep the memory accounted
+	for i, v := range bat.Vecs {
+		dup, err := v.Dup(proc.Mp())
+		if err != nil {
+			bat.Clean(proc.Mp())
+			return nil, err
+		}
+		bat.Vecs[i] = dup
+	}
+	return bat, nil
+}
+
+// Size returns the bytes written by the spiller.
+func (s *Spiller) Size() int64 {
+	return s.size
+}
+
+// Close removes all spilled files.
+func (s *Spiller) Close(ctx context.Context) {
+	if len(s.files) == 0 {
+		return
+	}
+	if err := s.fs.Delete(ctx, s.files...); err != nil {
+		logutil.Warnf("failed to remove spilled files of %s: %v", s.prefix, err)
+	}
+	s.files = nil
+}
+
+// Run is a sorted sequence of batches spilled in order.
+type Run struct {
+	spiller *Spiller
+	files   []string
+	next    int
+}
+
+func NewRun(s *Spiller) *Run {
+	return &Run{spiller: s}
+}
+
+// Append spills the next batch of the run.
+func (r *Run) Append(ctx context.Context, bat *batch.Batch) error {
+	name, err := r.spiller.Write(ctx, bat)
+	if err != nil {
+		return err
+	}
+	r.files = append(r.files, name)
+	return nil
+}
+
+// Next reads the next batch of the run, nil if all batches have been read.
+func (r *Run) Next(proc *process.Process) (*batch.Batch, error) {
+	if r.next >= len(r.files) {
+		return nil, nil
+	}
+	bat, err := r.spiller.Read(proc, r.files[r.next])
+	if err != nil {
+		return nil, err
+	}
+	r.next++
+	return bat, nil
+}
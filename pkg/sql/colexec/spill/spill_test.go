@@ -0,0 +1,177 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func newTestBatch(t *testing.T, proc *process.Process, vals []int64) *batch.Batch {
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixedList(bat.Vecs[0], vals, nil, proc.Mp()))
+	bat.SetRowCount(len(vals))
+	return bat
+}
+
+func newTestOrderBySpecs(desc bool) []*plan.OrderBySpec {
+	spec := &plan.OrderBySpec{
+		Expr: &plan.Expr{
+			Typ:  plan.Type{Id: int32(types.T_int64)},
+			Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0}},
+		},
+	}
+	if desc {
+		spec.Flag = plan.OrderBySpec_DESC
+	}
+	return []*plan.OrderBySpec{spec}
+}
+
+func TestGetMemoryBudget(t *testing.T) {
+	proc := testutil.NewProcessWithMPool("", mpool.MustNewZero())
+	require.Equal(t, int64(0), GetMemoryBudget(proc))
+
+	proc.SetResolveVariableFunc(func(name string, _, _ bool) (any, error) {
+		require.Equal(t, MemoryBudgetVar, name)
+		return int64(1024), nil
+	})
+	require.Equal(t, int64(1024), GetMemoryBudget(proc))
+}
+
+func TestBudget(t *testing.T) {
+	proc := testutil.NewProcessWithMPool("", mpool.MustNewZero())
+	b1 := NewBudget(proc)
+	require.False(t, b1.Enabled())
+	require.False(t, b1.Update(1<<30))
+	b1.Release()
+
+	proc.SetResolveVariableFunc(func(string, bool, bool) (any, error) {
+		return int64(100), nil
+	})
+	b1, b2 := NewBudget(proc), NewBudget(proc)
+	require.True(t, b1.Enabled())
+	require.False(t, b1.Update(10))
+	// the query exceeds the budget, and the operator holds its fair share.
+	require.True(t, b2.Update(95))
+	// the query exceeds the budget, but the operator holds less than its fair share.
+	require.False(t, b1.Update(20))
+	require.Equal(t, int64(115), proc.GetQueryMemory().Used())
+
+	b1.Release()
+	b2.Release()
+	require.Equal(t, int64(0), proc.GetQueryMemory().Used())
+}
+
+func TestSpillerRun(t *testing.T) {
+	proc := testutil.NewProcessWithMPool("", mpool.MustNewZero())
+	s, err := NewSpiller(proc, "test")
+	require.NoError(t, err)
+
+	run := NewRun(s)
+	for _, vals := range [][]int64{{1, 2}, {3}} {
+		bat := newTestBatch(t, proc, vals)
+		require.NoError(t, run.Append(proc.Ctx, bat))
+		bat.Clean(proc.Mp())
+	}
+	require.Less(t, int64(0), s.Size())
+
+	var got []int64
+	for {
+		bat, err := run.Next(proc)
+		require.NoError(t, err)
+		if bat == nil {
+			break
+		}
+		got = append(got, vector.MustFixedColNoTypeCheck[int64](bat.Vecs[0])...)
+		bat.Clean(proc.Mp())
+	}
+	require.Equal(t, []int64{1, 2, 3}, got)
+
+	s.Close(proc.Ctx)
+	_, err = s.Read(proc, s.prefix+"/0")
+	require.Error(t, err)
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
+
+func TestMerger(t *testing.T) {
+	for _, desc := range []bool{false, true} {
+		proc := testutil.NewProcessWithMPool("", mpool.MustNewZero())
+		s, err := NewSpiller(proc, "test")
+		require.NoError(t, err)
+
+		inputs := [][][]int64{
+			{{1, 4}, {7, 10}},
+			{{2, 5, 8}},
+			{{3, 6, 9}},
+		}
+		m, err := NewMerger(proc, newTestOrderBySpecs(desc))
+		require.NoError(t, err)
+		for i, input := range inputs {
+			if desc {
+				// reverse the input to keep it sorted
+				for l, r := 0, len(input)-1; l < r; l, r = l+1, r-1 {
+					input[l], input[r] = input[r], input[l]
+				}
+				for _, vals := range input {
+					for l, r := 0, len(vals)-1; l < r; l, r = l+1, r-1 {
+						vals[l], vals[r] = vals[r], vals[l]
+					}
+				}
+			}
+			// the last input stays in memory
+			if i == len(inputs)-1 {
+				require.NoError(t, m.AddSource(proc, NewBatchSource(newTestBatch(t, proc, input[0]))))
+				continue
+			}
+			run := NewRun(s)
+			for _, vals := range input {
+				bat := newTestBatch(t, proc, vals)
+				require.NoError(t, run.Append(proc.Ctx, bat))
+				bat.Clean(proc.Mp())
+			}
+			require.NoError(t, m.AddSource(proc, run))
+		}
+
+		var got []int64
+		for {
+			bat, err := m.Next(proc, 4)
+			require.NoError(t, err)
+			if bat == nil {
+				break
+			}
+			require.LessOrEqual(t, bat.RowCount(), 4)
+			got = append(got, vector.MustFixedColNoTypeCheck[int64](bat.Vecs[0])...)
+		}
+		expected := []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+		if desc {
+			expected = []int64{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
+		}
+		require.Equal(t, expected, got)
+
+		m.Free(proc)
+		s.Close(proc.Ctx)
+		require.Equal(t, int64(0), proc.Mp().CurrNB())
+	}
+}
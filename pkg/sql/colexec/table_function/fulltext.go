@@ -31,6 +31,7 @@ import (
 
 const (
 	countstar_sql = "SELECT COUNT(*) FROM %s"
+	doclen_sql    = "SELECT doc_id, COUNT(*) FROM %s WHERE doc_id IN (SELECT doc_id FROM %s WHERE %s) GROUP BY doc_id"
 )
 
 type fulltextState struct {
@@ -68,7 +69,7 @@ func (u *fulltextState) start(tf *TableFunction, proc *process.Process, nthRow i
 	}
 	mode := vector.GetFixedAtNoTypeCheck[int64](v, 0)
 
-	err = fulltextIndexMatch(proc, tf, source_table, index_table, pattern, mode, u.batch)
+	err = fulltextIndexMatch(proc, tf, source_table, index_table, pattern, mode, string(tf.Params), u.batch)
 
 	return err
 }
@@ -106,6 +107,8 @@ func ft_runSql(proc *process.Process, sql string) (executor.Result, error) {
 // run SQL to get the (doc_id, pos) of all patterns (words) in the search string
 func runWordStats(proc *process.Process, s *fulltext.SearchAccum) error {
 	var union []string
+	// the conditions of the words, to get the length of the matched documents
+	var filters []string
 
 	var keywords []string
 	for _, p := range s.Pattern {
@@ -116,11 +119,12 @@ func runWordStats(proc *process.Process, s *fulltext.SearchAccum) error {
 	}
 
 	if len(keywords) > 0 {
-		union = append(union, fmt.Sprintf("SELECT doc_id, pos, word FROM %s WHERE word IN (%s)",
-			s.TblName, strings.Join(keywords, ",")))
+		filter := fmt.Sprintf("word IN (%s)", strings.Join(keywords, ","))
+		union = append(union, fmt.Sprintf("SELECT doc_id, pos, word FROM %s WHERE %s", s.TblName, filter))
+		filters = append(filters, filter)
 	}
 
-	sqlfmt := "SELECT doc_id, pos, '%s' FROM %s WHERE prefix_eq(word, '%s')"
+	sqlfmt := "SELECT doc_id, pos, '%s' FROM %s WHERE %s"
 	for _, p := range s.Pattern {
 		ssStar := p.GetLeafText(fulltext.STAR)
 		for _, w := range ssStar {
@@ -131,10 +135,17 @@ func runWordStats(proc *process.Process, s *fulltext.SearchAccum) error {
 			}
 			// prefix search
 			prefix := w[0 : slen-1]
-			union = append(union, fmt.Sprintf(sqlfmt, w, s.TblName, prefix))
+			filter := fmt.Sprintf("prefix_eq(word, '%s')", prefix)
+			union = append(union, fmt.Sprintf(sqlfmt, w, s.TblName, filter))
+			filters = append(filters, filter)
 		}
 	}
 
+	if len(union) == 0 {
+		// all words of the search string are stopwords
+		return nil
+	}
+
 	sql := strings.Join(union, " UNION ")
 	//logutil.Infof("SQL is %s", sql)
 
@@ -184,16 +195,50 @@ func runWordStats(proc *process.Process, s *fulltext.SearchAccum) error {
 
 	}
 
+	if s.ScoreAlgo == fulltext.ALGO_BM25 {
+		return runDocLenStats(proc, s, strings.Join(filters, " OR "))
+	}
+
 	// we got all results for all words required.  Evaluate the Pattern against the WordAccums to get answer.
 
 	return nil
 }
 
-// Run SQL to get number of records in source table
-func runCountStar(proc *process.Process, s *fulltext.SearchAccum) (int64, error) {
+// run SQL to get the number of words of the matched documents, and the average number of words of all documents
+func runDocLenStats(proc *process.Process, s *fulltext.SearchAccum, filter string) error {
+	nword, err := runCountStar(proc, s.TblName)
+	if err != nil {
+		return err
+	}
+	if s.Nrow > 0 {
+		s.AvgDocLen = float64(nword) / float64(s.Nrow)
+	}
+
+	res, err := ft_runSql(proc, fmt.Sprintf(doclen_sql, s.TblName, s.TblName, filter))
+	if err != nil {
+		return err
+	}
+	defer res.Close()
+
+	s.DocLens = make(map[any]int32)
+	for _, bat := range res.Batches {
+		for i := 0; i < bat.RowCount(); i++ {
+			doc_id := vector.GetAny(bat.Vecs[0], i)
+			if bytes, ok := doc_id.([]byte); ok {
+				// change it to string
+				doc_id = string(bytes)
+			}
+			s.DocLens[doc_id] = int32(vector.GetFixedAtWithTypeCheck[int64](bat.Vecs[1], i))
+		}
+	}
+	return nil
+}
+
+// Run SQL to get number of records in the table
+func runCountStar(proc *process.Process, tblname string) (int64, error) {
 	var nrow int64
 	nrow = 0
-	sql := fmt.Sprintf(countstar_sql, s.SrcTblName)
+	sql := fmt.Sprintf(countstar_sql, tblname)
 
 	res, err := ft_runSql(proc, sql)
 	if err != nil {
@@ -214,29 +259,35 @@ func runCountStar(proc *process.Process, s *fulltext.SearchAccum) (int64, error)
 	return nrow, nil
 }
 
-func fulltextIndexMatch(proc *process.Process, tableFunction *TableFunction, srctbl, tblname, pattern string, mode int64, bat *batch.Batch) (err error) {
+// get the score of the documents that match the search string. The key is doc_id, with []byte changed to string.
+func fulltextScores(proc *process.Process, srctbl, tblname, pattern string, mode int64, params string) (map[any]float32, error) {
 
 	// parse the search string to []Pattern and create SearchAccum
-	s, err := fulltext.NewSearchAccum(srctbl, tblname, pattern, mode, "")
+	s, err := fulltext.NewSearchAccum(srctbl, tblname, pattern, mode, params)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// count(*) to get number of records in source table
-	nrow, err := runCountStar(proc, s)
+	nrow, err := runCountStar(proc, s.SrcTblName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	s.Nrow = nrow
 
 	// get the statistic of search string ([]Pattern) and store in SearchAccum
 	err = runWordStats(proc, s)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// compute the ranking
-	scoremap, err := s.Eval()
+	return s.Eval()
+}
+
+func fulltextIndexMatch(proc *process.Process, tableFunction *TableFunction, srctbl, tblname, pattern string, mode int64, params string, bat *batch.Batch) (err error) {
+
+	scoremap, err := fulltextScores(proc, srctbl, tblname, pattern, mode, params)
 	if err != nil {
 		return err
 	}
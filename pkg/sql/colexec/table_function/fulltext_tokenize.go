@@ -42,9 +42,10 @@ type Document struct {
 }
 
 type tokenizeState struct {
-	inited bool
-	called bool
-	param  fulltext.FullTextParserParam
+	inited   bool
+	called   bool
+	param    fulltext.FullTextParserParam
+	analyzer *fulltext.Analyzer
 	// holding one call batch, tokenizedState owns it.
 	batch *batch.Batch
 }
@@ -98,6 +99,12 @@ func (u *tokenizeState) start(tf *TableFunction, proc *process.Process, nthRow i
 			}
 		}
 
+		analyzer, err := fulltext.NewAnalyzer(&u.param)
+		if err != nil {
+			return err
+		}
+		u.analyzer = analyzer
+
 		u.batch = tf.createResultBatch()
 		u.inited = true
 	}
@@ -158,7 +165,11 @@ func (u *tokenizeState) start(tf *TableFunction, proc *process.Process, nthRow i
 		for t := range tok.Tokenize() {
 
 			slen := t.TokenBytes[0]
-			word := string(t.TokenBytes[1 : slen+1])
+			word, ok := u.analyzer.Analyze(string(t.TokenBytes[1 : slen+1]))
+			if !ok {
+				// stopword
+				continue
+			}
 
 			doc.Words = append(doc.Words, FullTextEntry{DocId: id, Word: word, Pos: t.BytePos})
 		}
@@ -188,7 +199,11 @@ func (u *tokenizeState) start(tf *TableFunction, proc *process.Process, nthRow i
 				tok, _ := tokenizer.NewSimpleTokenizer([]byte(value))
 				for tt := range tok.Tokenize() {
 					tslen := tt.TokenBytes[0]
-					word := string(tt.TokenBytes[1 : tslen+1])
+					word, ok := u.analyzer.Analyze(string(tt.TokenBytes[1 : tslen+1]))
+					if !ok {
+						// stopword
+						continue
+					}
 					doc.Words = append(doc.Words, FullTextEntry{DocId: id, Word: word, Pos: joffset + voffset + tt.BytePos})
 				}
 				voffset += int32(jslen)
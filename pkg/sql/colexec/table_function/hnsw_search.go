@@ -0,0 +1,480 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"container/list"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vectorindex/hnsw"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	hnswSearchColPk       = "pk"
+	hnswSearchColDistance = "distance"
+
+	// hnswCacheSize is the number of graphs kept in memory by a CN.
+	hnswCacheSize = 8
+)
+
+/*
+hnsw_search returns the candidates of a vector search on a HNSW index.
+
+The graph is persisted in the storage table of the index by CREATE INDEX and REINDEX, together with the
+snapshot it was built from (build_ts in the meta table). When a graph is loaded, the changes committed
+after build_ts are read from the logtail (Relation.CollectChanges) and applied to it. The loaded graph is
+cached, so the following searches only apply the changes committed since the previous one.
+
+If the logtail does not go back to build_ts any more, or no graph is stored yet (the index was created
+with the table), the graph is rebuilt from a snapshot of the table.
+*/
+
+type hnswSearchState struct {
+	simpleOneBatchState
+	inited bool
+	param  hnsw.SearchParam
+	cfg    hnsw.Config
+
+	// pre-filtering: the primary keys of the input rows, and the query vector.
+	keys  map[string]struct{}
+	query []float32
+}
+
+func (u *hnswSearchState) reset(tf *TableFunction, proc *process.Process) {
+	u.simpleOneBatchState.reset(tf, proc)
+	u.keys = nil
+	u.query = nil
+}
+
+func hnswSearchPrepare(proc *process.Process, tableFunction *TableFunction) (tvfState, error) {
+	var err error
+	st := &hnswSearchState{}
+	tableFunction.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, tableFunction.Args)
+	tableFunction.ctr.argVecs = make([]*vector.Vector, len(tableFunction.Args))
+	return st, err
+}
+
+func (u *hnswSearchState) start(tf *TableFunction, proc *process.Process, nthRow int, analyzer process.Analyzer) error {
+	u.startPreamble(tf, proc, nthRow)
+
+	if !u.inited {
+		if err := json.Unmarshal(tf.Params, &u.param); err != nil {
+			return err
+		}
+		algoParams, err := catalog.IndexParamsStringToMap(u.param.AlgoParams)
+		if err != nil {
+			return err
+		}
+		if u.cfg, err = hnsw.ConfigFromParams(algoParams); err != nil {
+			return err
+		}
+		u.inited = true
+	}
+
+	v := tf.ctr.argVecs[0]
+	if v.IsNull(uint64(nthRow)) {
+		return nil
+	}
+	query, err := hnsw.GetVector(v, nthRow)
+	if err != nil {
+		return err
+	}
+
+	if u.param.Filtered {
+		// the search is done by end, once the keys of all the rows are known.
+		if u.keys == nil {
+			u.keys = make(map[string]struct{})
+			u.query = append([]float32(nil), query...)
+		}
+		if pk := tf.ctr.argVecs[1]; !pk.IsNull(uint64(nthRow)) {
+			u.keys[string(pk.GetRawBytesAt(nthRow))] = struct{}{}
+		}
+		return nil
+	}
+
+	results, err := hnswSearch(proc, &u.param, u.cfg, query, nil)
+	if err != nil {
+		return err
+	}
+	return u.appendResults(tf, proc, results)
+}
+
+func (u *hnswSearchState) end(tf *TableFunction, proc *process.Process) error {
+	if !u.param.Filtered {
+		u.called = true
+		return nil
+	}
+	u.startPreamble(tf, proc, 0)
+	if u.keys == nil {
+		return nil
+	}
+	results, err := hnswSearch(proc, &u.param, u.cfg, u.query, u.keys)
+	if err != nil {
+		return err
+	}
+	return u.appendResults(tf, proc, results)
+}
+
+func (u *hnswSearchState) appendResults(tf *TableFunction, proc *process.Process, results []hnsw.Result) (err error) {
+	mp := proc.Mp()
+	for i, attr := range tf.Attrs {
+		vec := u.batch.Vecs[i]
+		switch strings.ToLower(attr) {
+		case hnswSearchColPk:
+			for _, r := range results {
+				if err = hnsw.AppendKey(vec, r.Key, mp); err != nil {
+					return err
+				}
+			}
+		case hnswSearchColDistance:
+			for _, r := range results {
+				if err = vector.AppendFixed(vec, float64(r.Distance), false, mp); err != nil {
+					return err
+				}
+			}
+		default:
+			return moerr.NewInvalidArg(proc.Ctx, "hnsw_search: invalid column name", attr)
+		}
+	}
+	u.batch.SetRowCount(len(results))
+	return nil
+}
+
+// hnswSearch searches the graph of the index, up to date with the snapshot of the txn. If keys is not nil,
+// the search is restricted to them.
+func hnswSearch(proc *process.Process, param *hnsw.SearchParam, cfg hnsw.Config, query []float32,
+	keys map[string]struct{}) ([]hnsw.Result, error) {
+	e := proc.Ctx.Value(defines.EngineKey{}).(engine.Engine)
+	db, err := e.Database(proc.Ctx, param.DbName, proc.GetTxnOperator())
+	if err != nil {
+		return nil, err
+	}
+	rel, err := db.Relation(proc.Ctx, param.SrcTable, proc)
+	if err != nil {
+		return nil, err
+	}
+
+	version, buildTS, err := hnswLoadMeta(proc, param)
+	if err != nil {
+		return nil, err
+	}
+	snapshotTS := types.TimestampToTS(proc.GetTxnOperator().SnapshotTS())
+
+	// the table id changes on truncate, so a graph is never reused across truncates.
+	key := fmt.Sprintf("%d/%s/%d", rel.GetTableID(proc.Ctx), param.StorageTable, version)
+	g := hnswGraphs.get(key)
+	g.Lock()
+	defer g.Unlock()
+
+	var idx *hnsw.Index
+	if g.idx == nil || snapshotTS.LT(&g.ts) {
+		// not loaded yet, or the snapshot of the query is older than the cached graph.
+		var ts types.TS
+		if idx, ts, err = hnswLoadGraph(proc, rel, param, cfg, version, buildTS, snapshotTS); err != nil {
+			return nil, err
+		}
+		if g.idx == nil {
+			g.idx, g.ts = idx, ts
+		}
+	} else {
+		if err = g.refresh(proc, rel, param, cfg, snapshotTS); err != nil {
+			return nil, err
+		}
+		idx = g.idx
+	}
+
+	if keys != nil {
+		return idx.SearchFiltered(query, max(int(param.Limit), cfg.EfSearch), 0, keys)
+	}
+	return idx.Search(query, max(int(param.Limit), cfg.EfSearch), 0)
+}
+
+// hnswLoadMeta reads the version of the stored graph and the snapshot it was built from. buildTS is empty
+// if the graph has never been built.
+func hnswLoadMeta(proc *process.Process, param *hnsw.SearchParam) (version int64, buildTS types.TS, err error) {
+	sql := fmt.Sprintf("SELECT `%s`, `%s` FROM `%s`.`%s` WHERE `%s` IN ('version', 'build_ts')",
+		catalog.SystemSI_HNSW_TblCol_Metadata_key,
+		catalog.SystemSI_HNSW_TblCol_Metadata_val,
+		param.DbName,
+		param.MetaTable,
+		catalog.SystemSI_HNSW_TblCol_Metadata_key,
+	)
+	res, err := ft_runSql(proc, sql)
+	if err != nil {
+		return 0, buildTS, err
+	}
+	defer res.Close()
+
+	for _, bat := range res.Batches {
+		for i := 0; i < bat.RowCount(); i++ {
+			val := bat.Vecs[1].GetStringAt(i)
+			switch bat.Vecs[0].GetStringAt(i) {
+			case "version":
+				if _, err = fmt.Sscanf(val, "%d", &version); err != nil {
+					return 0, buildTS, moerr.NewInternalErrorf(proc.Ctx, "hnsw: invalid index version '%s'", val)
+				}
+			case "build_ts":
+				buildTS = types.StringToTS(val)
+			}
+		}
+	}
+	return version, buildTS, nil
+}
+
+// hnswLoadGraph loads the stored graph and applies the changes up to snapshotTS. It returns the graph and
+// the timestamp it is up to date with.
+func hnswLoadGraph(proc *process.Process, rel engine.Relation, param *hnsw.SearchParam, cfg hnsw.Config,
+	version int64, buildTS types.TS, snapshotTS types.TS) (*hnsw.Index, types.TS, error) {
+	if buildTS.IsEmpty() {
+		return hnswRebuild(proc, rel, param, cfg, snapshotTS)
+	}
+
+	sql := fmt.Sprintf("SELECT `%s` FROM `%s`.`%s` WHERE `%s` = %d ORDER BY `%s`",
+		catalog.SystemSI_HNSW_TblCol_Storage_data,
+		param.DbName,
+		param.StorageTable,
+		catalog.SystemSI_HNSW_TblCol_Storage_version,
+		version,
+		catalog.SystemSI_HNSW_TblCol_Storage_id,
+	)
+	res, err := ft_runSql(proc, sql)
+	if err != nil {
+		return nil, buildTS, err
+	}
+	var data []byte
+	for _, bat := range res.Batches {
+		for i := 0; i < bat.RowCount(); i++ {
+			data = append(data, bat.Vecs[0].GetBytesAt(i)...)
+		}
+	}
+	res.Close()
+	if len(data) == 0 {
+		return hnswRebuild(proc, rel, param, cfg, snapshotTS)
+	}
+
+	idx, err := hnsw.Unmarshal(data)
+	if err != nil {
+		return nil, buildTS, err
+	}
+	g := &hnswGraph{idx: idx, ts: buildTS}
+	if err = g.refresh(proc, rel, param, cfg, snapshotTS); err != nil {
+		return nil, buildTS, err
+	}
+	return g.idx, g.ts, nil
+}
+
+// hnswRebuild builds the graph from a snapshot of the table.
+func hnswRebuild(proc *process.Process, rel engine.Relation, param *hnsw.SearchParam, cfg hnsw.Config,
+	snapshotTS types.TS) (*hnsw.Index, types.TS, error) {
+	idx, err := hnsw.NewIndex(cfg)
+	if err != nil {
+		return nil, snapshotTS, err
+	}
+	if err = hnswApplyChanges(proc, rel, param, idx, types.TS{}, snapshotTS); err != nil {
+		return nil, snapshotTS, err
+	}
+	return idx, snapshotTS, nil
+}
+
+type hnswChange struct {
+	ts     types.TS
+	delete bool
+	vec    []float32
+}
+
+// hnswApplyChanges applies the changes committed in [from, to] to idx. An empty from reads a snapshot of
+// the table at to.
+func hnswApplyChanges(proc *process.Process, rel engine.Relation, param *hnsw.SearchParam, idx *hnsw.Index,
+	from, to types.TS) error {
+	tableDef := rel.GetTableDef(proc.Ctx)
+	pkIdx, ok := tableDef.Name2ColIndex[param.PkCol]
+	if !ok {
+		return moerr.NewInternalErrorf(proc.Ctx, "hnsw: column '%s' not found", param.PkCol)
+	}
+	vecIdx, ok := tableDef.Name2ColIndex[param.VecCol]
+	if !ok {
+		return moerr.NewInternalErrorf(proc.Ctx, "hnsw: column '%s' not found", param.VecCol)
+	}
+
+	mp := proc.Mp()
+	handle, err := rel.CollectChanges(proc.Ctx, from, to, mp)
+	if err != nil {
+		return err
+	}
+	defer handle.Close()
+
+	// Only the last change of a row is applied. An update is a delete and an insert with the same
+	// commit ts, so the insert wins over the delete of the same ts.
+	changes := make(map[string]*hnswChange)
+	record := func(key []byte, c *hnswChange) {
+		if old, ok := changes[string(key)]; ok {
+			if c.ts.LT(&old.ts) || (c.ts.EQ(&old.ts) && c.delete) {
+				return
+			}
+		}
+		changes[string(key)] = c
+	}
+
+	for {
+		data, tombstone, _, err := handle.Next(proc.Ctx, mp)
+		if err != nil {
+			return err
+		}
+		if data == nil && tombstone == nil {
+			break
+		}
+		err = hnswCollectBatches(data, tombstone, int(pkIdx), int(vecIdx), record)
+		cleanChangesBatches(data, tombstone, mp)
+		if err != nil {
+			return err
+		}
+	}
+
+	for key, c := range changes {
+		if c.delete || c.vec == nil {
+			idx.Remove([]byte(key))
+		} else if err = idx.Add([]byte(key), c.vec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func hnswCollectBatches(data, tombstone *batch.Batch, pkIdx, vecIdx int, record func([]byte, *hnswChange)) error {
+	if n := changesRowCount(data); n > 0 {
+		tsVec := data.Vecs[len(data.Vecs)-1]
+		for i := 0; i < n; i++ {
+			c := &hnswChange{ts: commitTSAt(tsVec, i)}
+			if !data.Vecs[vecIdx].IsNull(uint64(i)) {
+				vec, err := hnsw.GetVector(data.Vecs[vecIdx], i)
+				if err != nil {
+					return err
+				}
+				c.vec = append([]float32(nil), vec...)
+			}
+			record(hnsw.GetKey(data.Vecs[pkIdx], i), c)
+		}
+	}
+	if n := changesRowCount(tombstone); n > 0 {
+		for i := 0; i < n; i++ {
+			record(hnsw.GetKey(tombstone.Vecs[0], i), &hnswChange{ts: commitTSAt(tombstone.Vecs[1], i), delete: true})
+		}
+	}
+	return nil
+}
+
+// commitTSAt returns the commit ts of the i-th row. A snapshot of the table has a constant commit ts.
+func commitTSAt(vec *vector.Vector, i int) types.TS {
+	if vec.IsConst() {
+		return vector.GetFixedAtNoTypeCheck[types.TS](vec, 0)
+	}
+	return vector.GetFixedAtNoTypeCheck[types.TS](vec, i)
+}
+
+// changesRowCount returns the row count of a batch of CollectChanges, which does not set the row count
+// of the batch.
+func changesRowCount(bat *batch.Batch) int {
+	if bat == nil || len(bat.Vecs) == 0 {
+		return 0
+	}
+	return bat.Vecs[0].Length()
+}
+
+func cleanChangesBatches(data, tombstone *batch.Batch, mp *mpool.MPool) {
+	if data != nil {
+		data.Clean(mp)
+	}
+	if tombstone != nil {
+		tombstone.Clean(mp)
+	}
+}
+
+// hnswGraph is a cached graph, up to date with the changes committed until ts.
+type hnswGraph struct {
+	sync.Mutex
+	idx *hnsw.Index
+	ts  types.TS
+}
+
+// refresh applies the changes committed after g.ts to the graph.
+func (g *hnswGraph) refresh(proc *process.Process, rel engine.Relation, param *hnsw.SearchParam, cfg hnsw.Config,
+	snapshotTS types.TS) error {
+	if !g.ts.LT(&snapshotTS) {
+		return nil
+	}
+	err := hnswApplyChanges(proc, rel, param, g.idx, g.ts.Next(), snapshotTS)
+	if err != nil && !moerr.IsMoErrCode(err, moerr.ErrStaleRead) {
+		return err
+	}
+	// The logtail does not go back to g.ts any more, or the graph is mostly made of tombstones.
+	if err != nil || g.idx.Deleted() > g.idx.Len() {
+		idx, ts, err := hnswRebuild(proc, rel, param, cfg, snapshotTS)
+		if err != nil {
+			return err
+		}
+		g.idx = idx
+		g.ts = ts
+		return nil
+	}
+	g.ts = snapshotTS
+	return nil
+}
+
+// hnswGraphCache keeps the recently used graphs.
+type hnswGraphCache struct {
+	sync.Mutex
+	lru    *list.List
+	graphs map[string]*list.Element
+}
+
+type hnswCacheEntry struct {
+	key   string
+	graph *hnswGraph
+}
+
+var hnswGraphs = &hnswGraphCache{
+	lru:    list.New(),
+	graphs: make(map[string]*list.Element),
+}
+
+func (c *hnswGraphCache) get(key string) *hnswGraph {
+	c.Lock()
+	defer c.Unlock()
+	if e, ok := c.graphs[key]; ok {
+		c.lru.MoveToFront(e)
+		return e.Value.(*hnswCacheEntry).graph
+	}
+	g := &hnswGraph{}
+	c.graphs[key] = c.lru.PushFront(&hnswCacheEntry{key: key, graph: g})
+	for c.lru.Len() > hnswCacheSize {
+		e := c.lru.Back()
+		c.lru.Remove(e)
+		delete(c.graphs, e.Value.(*hnswCacheEntry).key)
+	}
+	return g
+}
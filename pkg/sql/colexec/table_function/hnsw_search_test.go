@@ -0,0 +1,68 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+func TestHnswCollectBatches(t *testing.T) {
+	mp := mpool.MustNewZero()
+	ts1 := types.BuildTS(1, 0)
+	ts2 := types.BuildTS(2, 0)
+
+	// a snapshot of the table has a constant commit ts, and the row count of the batches is not set
+	data := batch.NewWithSize(3)
+	data.Vecs[0] = vector.NewVec(types.T_int32.ToType())
+	data.Vecs[1] = vector.NewVec(types.New(types.T_array_float32, 2, 0))
+	for i, v := range [][]float32{{1, 0}, {0, 1}, nil} {
+		require.NoError(t, vector.AppendFixed(data.Vecs[0], int32(i), false, mp))
+		require.NoError(t, vector.AppendArray(data.Vecs[1], v, v == nil, mp))
+	}
+	ts, err := vector.NewConstFixed(types.T_TS.ToType(), ts1, 3, mp)
+	require.NoError(t, err)
+	data.Vecs[2] = ts
+
+	tombstone := batch.NewWithSize(2)
+	tombstone.Vecs[0] = vector.NewVec(types.T_int32.ToType())
+	tombstone.Vecs[1] = vector.NewVec(types.T_TS.ToType())
+	require.NoError(t, vector.AppendFixed(tombstone.Vecs[0], int32(1), false, mp))
+	require.NoError(t, vector.AppendFixed(tombstone.Vecs[1], ts2, false, mp))
+
+	changes := make(map[int32]*hnswChange)
+	err = hnswCollectBatches(data, tombstone, 0, 1, func(key []byte, c *hnswChange) {
+		k := types.DecodeInt32(key)
+		if old, ok := changes[k]; !ok || old.ts.LT(&c.ts) {
+			changes[k] = c
+		}
+	})
+	require.NoError(t, err)
+	cleanChangesBatches(data, tombstone, mp)
+
+	require.Len(t, changes, 3)
+	require.Equal(t, []float32{1, 0}, changes[0].vec)
+	require.True(t, changes[0].ts.EQ(&ts1))
+	require.True(t, changes[1].delete)
+	require.True(t, changes[1].ts.EQ(&ts2))
+	require.Nil(t, changes[2].vec)
+	require.False(t, changes[2].delete)
+}
@@ -0,0 +1,186 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	hybrid_vector_sql = "SELECT `%s` FROM %s%s ORDER BY %s(`%s`, '%s')%s LIMIT %d"
+)
+
+/*
+hybrid_search returns the (pk, score) of the rows ranked by the fulltext search or the vector search.
+
+The fulltext ranking is the score of fulltext_index_scan, and the vector ranking is the result of a top-k
+query on the source table, which is planned on the vector index if there is one.  The two ranks are fused
+by reciprocal rank fusion, so the scores of the two searches do not have to be on the same scale.
+*/
+
+type hybridSearchState struct {
+	simpleOneBatchState
+	inited bool
+	param  fulltext.HybridSearchParam
+}
+
+func hybridSearchPrepare(proc *process.Process, tableFunction *TableFunction) (tvfState, error) {
+	var err error
+	st := &hybridSearchState{}
+	tableFunction.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, tableFunction.Args)
+	tableFunction.ctr.argVecs = make([]*vector.Vector, len(tableFunction.Args))
+	return st, err
+}
+
+func (u *hybridSearchState) start(tf *TableFunction, proc *process.Process, nthRow int, analyzer process.Analyzer) error {
+	u.startPreamble(tf, proc, nthRow)
+
+	if !u.inited {
+		if err := json.Unmarshal(tf.Params, &u.param); err != nil {
+			return err
+		}
+		u.inited = true
+	}
+
+	v := tf.ctr.argVecs[0]
+	if v.IsNull(uint64(nthRow)) {
+		return nil
+	}
+	query, err := vectorToString(v, nthRow)
+	if err != nil {
+		return err
+	}
+
+	scores, err := fulltextScores(proc, u.param.SrcTable, u.param.IndexTable, u.param.Pattern, u.param.Mode, u.param.Params)
+	if err != nil {
+		return err
+	}
+	textRank := rankByScore(scores, int(u.param.Depth))
+
+	vectorRank, err := runVectorRank(proc, &u.param, query)
+	if err != nil {
+		return err
+	}
+
+	fused := rrfFuse(u.param.K, textRank, vectorRank)
+	for key, score := range fused {
+		pk := key
+		if str, ok := pk.(string); ok {
+			pk = []byte(str)
+		}
+		// type of id follow primary key column
+		if err = vector.AppendAny(u.batch.Vecs[0], pk, false, proc.Mp()); err != nil {
+			return err
+		}
+		if err = vector.AppendFixed(u.batch.Vecs[1], score, false, proc.Mp()); err != nil {
+			return err
+		}
+	}
+	u.batch.SetRowCount(len(fused))
+	return nil
+}
+
+// vectorToString returns the query vector in the text format of a vector literal. A string is parsed as a
+// vector and formatted again, so the literal in the SQL of the vector search only has numbers.
+func vectorToString(v *vector.Vector, i int) (string, error) {
+	switch v.GetType().Oid {
+	case types.T_array_float32:
+		return types.ArrayToString(vector.GetArrayAt[float32](v, i)), nil
+	case types.T_array_float64:
+		return types.ArrayToString(vector.GetArrayAt[float64](v, i)), nil
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		return types.QuantizedArrayToString(v.GetType().Oid, v.GetBytesAt(i)), nil
+	case types.T_char, types.T_varchar, types.T_text:
+		arr, err := types.StringToArray[float64](v.GetStringAt(i))
+		if err != nil {
+			return "", err
+		}
+		return types.ArrayToString(arr), nil
+	default:
+		return "", moerr.NewInvalidInputNoCtxf("hybrid_search: unsupported vector type %s", v.GetType().String())
+	}
+}
+
+// run SQL to get the primary keys of the nearest rows, nearest first
+func runVectorRank(proc *process.Process, param *fulltext.HybridSearchParam, query string) ([]any, error) {
+	order := ""
+	if param.Desc {
+		order = " DESC"
+	}
+	where := ""
+	if param.Filter != "" {
+		where = " WHERE " + param.Filter
+	}
+	sql := fmt.Sprintf(hybrid_vector_sql, escapeIdent(param.PkCol), param.SrcTable, where,
+		param.DistFunc, escapeIdent(param.VecCol), query, order, param.Depth)
+
+	res, err := ft_runSql(proc, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	var keys []any
+	for _, bat := range res.Batches {
+		for i := 0; i < bat.RowCount(); i++ {
+			key := vector.GetAny(bat.Vecs[0], i)
+			if bytes, ok := key.([]byte); ok {
+				// change it to string
+				key = string(bytes)
+			}
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// rankByScore returns at most depth keys of scores, the highest score first.
+func rankByScore(scores map[any]float32, depth int) []any {
+	keys := make([]any, 0, len(scores))
+	for key := range scores {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if scores[keys[i]] != scores[keys[j]] {
+			return scores[keys[i]] > scores[keys[j]]
+		}
+		// break the tie to get consistent result
+		return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+	})
+	if depth > 0 && len(keys) > depth {
+		keys = keys[:depth]
+	}
+	return keys
+}
+
+// rrfFuse sums 1 / (k + rank) of every key over the rankings, where rank starts from 1.
+func rrfFuse(k int64, rankings ...[]any) map[any]float64 {
+	fused := make(map[any]float64)
+	for _, ranking := range rankings {
+		for i, key := range ranking {
+			fused[key] += 1 / float64(k+int64(i)+1)
+		}
+	}
+	return fused
+}
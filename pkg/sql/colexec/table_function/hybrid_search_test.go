@@ -0,0 +1,55 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankByScore(t *testing.T) {
+	scores := map[any]float32{"a": 0.5, "b": 2, "c": 0.5, "d": 1}
+	require.Equal(t, []any{"b", "d", "a", "c"}, rankByScore(scores, 0))
+	require.Equal(t, []any{"b", "d"}, rankByScore(scores, 2))
+	require.Empty(t, rankByScore(nil, 10))
+}
+
+func TestRrfFuse(t *testing.T) {
+	fused := rrfFuse(1, []any{int32(5), int32(1), int32(3)}, []any{int32(1), int32(4), int32(3)})
+	require.Len(t, fused, 4)
+	require.InDelta(t, 1.0/3+1.0/2, fused[int32(1)], 1e-9)
+	require.InDelta(t, 1.0/2, fused[int32(5)], 1e-9)
+	require.InDelta(t, 1.0/4+1.0/4, fused[int32(3)], 1e-9)
+	require.InDelta(t, 1.0/3, fused[int32(4)], 1e-9)
+}
+
+func TestVectorToString(t *testing.T) {
+	mp := mpool.MustNewZero()
+	vec := vector.NewVec(types.T_varchar.ToType())
+	defer vec.Free(mp)
+	require.NoError(t, vector.AppendBytes(vec, []byte(" [1, 2.5,3] "), false, mp))
+	require.NoError(t, vector.AppendBytes(vec, []byte("[1,2]') OR 1=1 -- "), false, mp))
+
+	str, err := vectorToString(vec, 0)
+	require.NoError(t, err)
+	require.Equal(t, "[1, 2.5, 3]", str)
+
+	_, err = vectorToString(vec, 1)
+	require.Error(t, err)
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+/*
+lateral_subquery runs a LATERAL derived table which can't be turned into a join, for every row of the
+left side of the APPLY node. The columns of the left row referenced by the derived table are passed as
+constant derived tables named as the tables of the left side, which the planner binds as their values:
+
+	SELECT mo_lateral_subquery.* FROM (SELECT CAST('1' AS INT) AS `a`) AS `t1`
+	CROSS JOIN LATERAL (SELECT ... WHERE t2.a = t1.a LIMIT 1) AS mo_lateral_subquery
+*/
+
+var lateralStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `''`)
+
+type lateralSubqueryState struct {
+	inited bool
+	param  plan2.LateralSubqueryParam
+
+	// the result of the derived table for the current row, and the next batch of it to return.
+	res  executor.Result
+	next int
+}
+
+func lateralSubqueryPrepare(proc *process.Process, tableFunction *TableFunction) (tvfState, error) {
+	var err error
+	st := &lateralSubqueryState{}
+	tableFunction.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, tableFunction.Args)
+	tableFunction.ctr.argVecs = make([]*vector.Vector, len(tableFunction.Args))
+	return st, err
+}
+
+func (s *lateralSubqueryState) reset(tf *TableFunction, proc *process.Process) {
+	s.closeResult()
+}
+
+func (s *lateralSubqueryState) free(tf *TableFunction, proc *process.Process, pipelineFailed bool, err error) {
+	s.closeResult()
+}
+
+func (s *lateralSubqueryState) closeResult() {
+	s.res.Close()
+	s.res = executor.Result{}
+	s.next = 0
+}
+
+func (s *lateralSubqueryState) start(tf *TableFunction, proc *process.Process, nthRow int, analyzer process.Analyzer) error {
+	if !s.inited {
+		if err := json.Unmarshal(tf.Params, &s.param); err != nil {
+			return err
+		}
+		s.inited = true
+	}
+	s.closeResult()
+
+	sql, err := lateralSubquerySql(proc, &s.param, tf.ctr.argVecs, nthRow)
+	if err != nil {
+		return err
+	}
+	if s.res, err = ft_runSql(proc, sql); err != nil {
+		return err
+	}
+
+	// the apply operator copies the columns of the result as the types it is planned with
+	for _, bat := range s.res.Batches {
+		if len(bat.Vecs) != len(tf.ctr.retSchema) {
+			return moerr.NewInternalErrorf(proc.Ctx, "lateral_subquery: %d columns returned, %d expected", len(bat.Vecs), len(tf.ctr.retSchema))
+		}
+		for i, vec := range bat.Vecs {
+			if vec.GetType().Oid != tf.ctr.retSchema[i].Oid {
+				return moerr.NewInternalErrorf(proc.Ctx, "lateral_subquery: column %d returned as %s, %s expected",
+					i+1, vec.GetType().String(), tf.ctr.retSchema[i].String())
+			}
+		}
+	}
+	return nil
+}
+
+func (s *lateralSubqueryState) call(tf *TableFunction, proc *process.Process) (vm.CallResult, error) {
+	for s.next < len(s.res.Batches) {
+		bat := s.res.Batches[s.next]
+		s.next++
+		if bat.RowCount() > 0 {
+			return vm.CallResult{Status: vm.ExecNext, Batch: bat}, nil
+		}
+	}
+	return vm.CancelResult, nil
+}
+
+// lateralSubquerySql returns the SQL running the derived table for the nth row of the args.
+func lateralSubquerySql(proc *process.Process, param *plan2.LateralSubqueryParam, argVecs []*vector.Vector, nthRow int) (string, error) {
+	cols := make([][]string, len(param.Tables))
+	for i, arg := range param.Args {
+		value, err := lateralSubqueryValue(proc, argVecs[i], nthRow)
+		if err != nil {
+			return "", err
+		}
+		cols[arg.Table] = append(cols[arg.Table], fmt.Sprintf("CAST(%s AS %s) AS `%s`", value, arg.Type, escapeIdent(arg.Col)))
+	}
+
+	tables := make([]string, len(param.Tables))
+	for i, table := range param.Tables {
+		tables[i] = fmt.Sprintf("(SELECT %s) AS `%s`", strings.Join(cols[i], ", "), escapeIdent(table))
+	}
+	return fmt.Sprintf("SELECT %s.* FROM %s CROSS JOIN %s",
+		plan2.LateralSubqueryAlias, strings.Join(tables, " CROSS JOIN "), param.Sql), nil
+}
+
+// lateralSubqueryValue returns the value of the nth row of the vector as a string literal, which is cast
+// to the type of the column.
+func lateralSubqueryValue(proc *process.Process, vec *vector.Vector, nthRow int) (string, error) {
+	if vec.IsConst() {
+		nthRow = 0
+	}
+	if vec.IsNull(uint64(nthRow)) {
+		return "NULL", nil
+	}
+
+	var str string
+	switch vec.GetType().Oid {
+	case types.T_json:
+		str = types.DecodeJson(vec.GetBytesAt(nthRow)).String()
+	case types.T_timestamp:
+		// the SQL is run in the time zone of the session
+		str = vector.GetFixedAtNoTypeCheck[types.Timestamp](vec, nthRow).String2(proc.GetSessionInfo().TimeZone, vec.GetType().Scale)
+	case types.T_time:
+		str = vector.GetFixedAtNoTypeCheck[types.Time](vec, nthRow).String2(vec.GetType().Scale)
+	case types.T_enum:
+		return "", moerr.NewNotSupported(proc.Ctx, "ENUM column referenced by LATERAL derived table")
+	default:
+		str = vec.RowToString(nthRow)
+	}
+	return "'" + lateralStringEscaper.Replace(str) + "'", nil
+}
+
+func escapeIdent(name string) string {
+	return strings.ReplaceAll(name, "`", "``")
+}
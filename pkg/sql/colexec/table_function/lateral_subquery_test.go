@@ -0,0 +1,65 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+)
+
+func TestLateralSubquerySql(t *testing.T) {
+	proc := testutil.NewProcess()
+	mp := proc.Mp()
+
+	ids := vector.NewVec(types.T_int32.ToType())
+	names := vector.NewVec(types.T_varchar.ToType())
+	keys := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixed(ids, int32(1), false, mp))
+	require.NoError(t, vector.AppendFixed(ids, int32(0), true, mp))
+	require.NoError(t, vector.AppendBytes(names, []byte(`it's \`), false, mp))
+	require.NoError(t, vector.AppendBytes(names, []byte("b"), false, mp))
+	require.NoError(t, vector.AppendFixed(keys, int64(7), false, mp))
+	require.NoError(t, vector.AppendFixed(keys, int64(8), false, mp))
+	defer ids.Free(mp)
+	defer names.Free(mp)
+	defer keys.Free(mp)
+
+	param := &plan2.LateralSubqueryParam{
+		Sql:    "lateral (select a from t3 where t3.a = t1.id and t3.b = t2.k limit 1) as mo_lateral_subquery",
+		Tables: []string{"t1", "t`2"},
+		Args: []plan2.LateralSubqueryArg{
+			{Table: 0, Col: "id", Type: "INT"},
+			{Table: 0, Col: "name", Type: "VARCHAR(20)"},
+			{Table: 1, Col: "k", Type: "BIGINT"},
+		},
+	}
+	argVecs := []*vector.Vector{ids, names, keys}
+
+	sql, err := lateralSubquerySql(proc, param, argVecs, 0)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT mo_lateral_subquery.* FROM "+
+		"(SELECT CAST('1' AS INT) AS `id`, CAST('it''s \\\\' AS VARCHAR(20)) AS `name`) AS `t1` CROSS JOIN "+
+		"(SELECT CAST('7' AS BIGINT) AS `k`) AS `t``2` CROSS JOIN "+param.Sql, sql)
+
+	sql, err = lateralSubquerySql(proc, param, argVecs, 1)
+	require.NoError(t, err)
+	require.Contains(t, sql, "CAST(NULL AS INT) AS `id`")
+}
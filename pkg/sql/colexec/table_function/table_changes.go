@@ -0,0 +1,415 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/engine_util"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+/*
+table_changes returns the rows inserted and deleted by the txns committed in [from, to], ordered by the
+commit ts. An update is a delete and an insert with the same commit ts.
+
+The changes are read from the logtail (Relation.CollectChanges). A tombstone only carries the primary key,
+so the image of a deleted row is the last insert of the key before the delete, or if the key was not
+inserted in the range, the row read from a snapshot of the table just before from. If neither is found,
+only the primary key of the deleted row is returned.
+
+All the changes are kept in memory until the function ends.
+*/
+
+type tableChange struct {
+	ts     types.TS
+	delete bool
+	// row is the position of the image in rows, -1 if the image of a deleted row is not found.
+	row int
+	// key is the position of the primary key of a deleted row in keys.
+	key int
+}
+
+type tableChangesState struct {
+	inited bool
+	param  plan2.TableChangesParam
+
+	// cols are the positions of the columns in the batches of CollectChanges, the primary key is the last one.
+	cols []int
+	// rows holds the images of the changed rows, with the columns of cols.
+	rows *batch.Batch
+	// keys holds the primary keys of the deleted rows.
+	keys    *vector.Vector
+	changes []tableChange
+	next    int
+
+	// result batch, we own it.
+	batch *batch.Batch
+}
+
+func tableChangesPrepare(proc *process.Process, tableFunction *TableFunction) (tvfState, error) {
+	return &tableChangesState{}, nil
+}
+
+func (s *tableChangesState) reset(tf *TableFunction, proc *process.Process) {
+	s.cleanChanges(proc)
+	if s.batch != nil {
+		s.batch.CleanOnlyData()
+	}
+}
+
+func (s *tableChangesState) free(tf *TableFunction, proc *process.Process, pipelineFailed bool, err error) {
+	s.cleanChanges(proc)
+	if s.batch != nil {
+		s.batch.Clean(proc.Mp())
+	}
+}
+
+func (s *tableChangesState) cleanChanges(proc *process.Process) {
+	if s.rows != nil {
+		s.rows.Clean(proc.Mp())
+		s.rows = nil
+	}
+	if s.keys != nil {
+		s.keys.Free(proc.Mp())
+		s.keys = nil
+	}
+	s.changes = nil
+	s.next = 0
+}
+
+func (s *tableChangesState) start(tf *TableFunction, proc *process.Process, nthRow int, analyzer process.Analyzer) error {
+	if !s.inited {
+		if err := json.Unmarshal(tf.Params, &s.param); err != nil {
+			return err
+		}
+		s.inited = true
+	}
+	s.cleanChanges(proc)
+	if s.batch == nil {
+		s.batch = tf.createResultBatch()
+	}
+
+	txnOp := proc.GetTxnOperator()
+	from := types.TimestampToTS(s.param.From)
+	to := types.TimestampToTS(txnOp.SnapshotTS())
+	if !s.param.To.IsEmpty() {
+		if paramTo := types.TimestampToTS(s.param.To); paramTo.LT(&to) {
+			to = paramTo
+		}
+	}
+	if to.LT(&from) {
+		return nil
+	}
+
+	rel, err := tableChangesRelation(proc, txnOp, &s.param)
+	if err != nil {
+		return err
+	}
+	tableDef := rel.GetTableDef(proc.Ctx)
+	s.cols = make([]int, 0, len(s.param.Cols)+1)
+	for _, name := range append(s.param.Cols, tableDef.Pkey.PkeyColName) {
+		idx, ok := tableDef.Name2ColIndex[name]
+		if !ok {
+			return moerr.NewInternalErrorf(proc.Ctx, "table_changes: column '%s' not found", name)
+		}
+		s.cols = append(s.cols, int(idx))
+	}
+
+	if err = s.collectChanges(proc, rel, from, to); err != nil {
+		return err
+	}
+	if err = s.resolveImages(proc, from); err != nil {
+		return err
+	}
+	s.sortChanges()
+	return nil
+}
+
+// sortChanges orders the changes by the commit ts. The delete of an update comes before the insert.
+func (s *tableChangesState) sortChanges() {
+	sort.SliceStable(s.changes, func(i, j int) bool {
+		if s.changes[i].ts.EQ(&s.changes[j].ts) {
+			return s.changes[i].delete && !s.changes[j].delete
+		}
+		return s.changes[i].ts.LT(&s.changes[j].ts)
+	})
+}
+
+func tableChangesRelation(proc *process.Process, txnOp client.TxnOperator, param *plan2.TableChangesParam) (engine.Relation, error) {
+	e := proc.Ctx.Value(defines.EngineKey{}).(engine.Engine)
+	db, err := e.Database(proc.Ctx, param.DbName, txnOp)
+	if err != nil {
+		return nil, err
+	}
+	return db.Relation(proc.Ctx, param.TableName, proc)
+}
+
+// collectChanges reads the changes committed in [from, to].
+func (s *tableChangesState) collectChanges(proc *process.Process, rel engine.Relation, from, to types.TS) error {
+	mp := proc.Mp()
+	handle, err := rel.CollectChanges(proc.Ctx, from, to, mp)
+	if err != nil {
+		return err
+	}
+	defer handle.Close()
+
+	for {
+		data, tombstone, _, err := handle.Next(proc.Ctx, mp)
+		if err != nil {
+			return err
+		}
+		if data == nil && tombstone == nil {
+			return nil
+		}
+		err = s.appendChanges(proc, data, tombstone)
+		cleanChangesBatches(data, tombstone, mp)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// appendChanges appends the changes of the batches of CollectChanges.
+func (s *tableChangesState) appendChanges(proc *process.Process, data, tombstone *batch.Batch) error {
+	mp := proc.Mp()
+	if n := changesRowCount(data); n > 0 {
+		s.initRows(func(i int) *vector.Vector { return data.Vecs[s.cols[i]] })
+		base := s.rows.RowCount()
+		for i, idx := range s.cols {
+			if err := s.rows.Vecs[i].UnionBatch(data.Vecs[idx], 0, n, nil, mp); err != nil {
+				return err
+			}
+		}
+		s.rows.SetRowCount(base + n)
+		tsVec := data.Vecs[len(data.Vecs)-1]
+		for i := 0; i < n; i++ {
+			s.changes = append(s.changes, tableChange{ts: commitTSAt(tsVec, i), row: base + i})
+		}
+	}
+	if n := changesRowCount(tombstone); n > 0 {
+		if s.keys == nil {
+			s.keys = vector.NewVec(*tombstone.Vecs[0].GetType())
+		}
+		base := s.keys.Length()
+		if err := s.keys.UnionBatch(tombstone.Vecs[0], 0, n, nil, mp); err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			s.changes = append(s.changes, tableChange{
+				ts:     commitTSAt(tombstone.Vecs[1], i),
+				delete: true,
+				row:    -1,
+				key:    base + i,
+			})
+		}
+	}
+	return nil
+}
+
+// initRows makes the batch of the images, the column i is in vecs(i).
+func (s *tableChangesState) initRows(vecs func(i int) *vector.Vector) {
+	if s.rows != nil {
+		return
+	}
+	s.rows = batch.NewWithSize(len(s.cols))
+	for i := range s.cols {
+		s.rows.Vecs[i] = vector.NewVec(*vecs(i).GetType())
+	}
+}
+
+// resolveImages finds the images of the deleted rows.
+func (s *tableChangesState) resolveImages(proc *process.Process, from types.TS) error {
+	pk := len(s.cols) - 1
+	inserts := make(map[string][]int)
+	for i, c := range s.changes {
+		if !c.delete {
+			key := string(s.rows.Vecs[pk].GetRawBytesAt(c.row))
+			inserts[key] = append(inserts[key], i)
+		}
+	}
+
+	// the deleted rows which were not inserted in the range.
+	missing := make(map[string][]int)
+	for i := range s.changes {
+		c := &s.changes[i]
+		if !c.delete {
+			continue
+		}
+		key := string(s.keys.GetRawBytesAt(c.key))
+		var image *tableChange
+		for _, j := range inserts[key] {
+			if ins := &s.changes[j]; ins.ts.LT(&c.ts) && (image == nil || image.ts.LT(&ins.ts)) {
+				image = ins
+			}
+		}
+		if image != nil {
+			c.row = image.row
+		} else {
+			missing[key] = append(missing[key], i)
+		}
+	}
+	if len(missing) == 0 || from.Physical() <= 0 {
+		return nil
+	}
+	return s.readSnapshotImages(proc, from.Prev(), missing)
+}
+
+// readSnapshotImages reads the images of the missing keys from a snapshot of the table at ts. Only the blocks
+// and rows matching the missing keys are read.
+func (s *tableChangesState) readSnapshotImages(proc *process.Process, ts types.TS, missing map[string][]int) error {
+	rel, err := tableChangesRelation(proc, proc.GetTxnOperator().CloneSnapshotOp(ts.ToTimestamp()), &s.param)
+	if err != nil {
+		return err
+	}
+	mp := proc.Mp()
+	keys := vector.NewVec(*s.keys.GetType())
+	defer keys.Free(mp)
+	for _, deletes := range missing {
+		if err = keys.UnionOne(s.keys, int64(s.changes[deletes[0]].key), mp); err != nil {
+			return err
+		}
+	}
+	keys.InplaceSort()
+
+	tableDef := rel.GetTableDef(proc.Ctx)
+	filter := engine_util.ConstructInExpr(proc.Ctx, tableDef.Pkey.PkeyColName, keys)
+	relData, err := rel.Ranges(proc.Ctx, []*plan.Expr{filter}, 2, 0, engine.Policy_CollectCommittedData)
+	if err != nil {
+		return err
+	}
+	readers, err := rel.BuildReaders(
+		proc.Ctx,
+		proc,
+		filter,
+		relData,
+		1,
+		0,
+		false,
+		engine.Policy_CheckCommittedOnly,
+		engine.FilterHint{Must: true},
+	)
+	if err != nil {
+		return err
+	}
+	defer readers[0].Close()
+
+	attrs := make([]string, len(s.cols))
+	attrTypes := make([]types.Type, len(s.cols))
+	for i, idx := range s.cols {
+		attrs[i] = tableDef.Cols[idx].Name
+		attrTypes[i] = plan2.ExprType2Type(&tableDef.Cols[idx].Typ)
+	}
+	bat := batch.NewWithSchema(true, attrs, attrTypes)
+	defer bat.Clean(mp)
+	s.initRows(func(i int) *vector.Vector { return bat.Vecs[i] })
+
+	pk := len(s.cols) - 1
+	for len(missing) > 0 {
+		bat.CleanOnlyData()
+		isEnd, err := readers[0].Read(proc.Ctx, attrs, filter, mp, bat)
+		if err != nil {
+			return err
+		}
+		if isEnd {
+			return nil
+		}
+		for i := 0; i < bat.RowCount(); i++ {
+			key := string(bat.Vecs[pk].GetRawBytesAt(i))
+			deletes, ok := missing[key]
+			if !ok {
+				continue
+			}
+			row := s.rows.RowCount()
+			for j := range s.cols {
+				if err = s.rows.Vecs[j].UnionOne(bat.Vecs[j], int64(i), mp); err != nil {
+					return err
+				}
+			}
+			s.rows.SetRowCount(row + 1)
+			for _, d := range deletes {
+				s.changes[d].row = row
+			}
+			delete(missing, key)
+		}
+	}
+	return nil
+}
+
+func (s *tableChangesState) call(tf *TableFunction, proc *process.Process) (vm.CallResult, error) {
+	s.batch.CleanOnlyData()
+	if s.next >= len(s.changes) {
+		return vm.CancelResult, nil
+	}
+
+	mp := proc.Mp()
+	end := min(s.next+8192, len(s.changes))
+	for i, attr := range tf.Attrs {
+		vec := s.batch.Vecs[i]
+		col := -1
+		switch name := strings.ToLower(attr); name {
+		case plan2.TableChangesCommitTSCol, plan2.TableChangesTypeCol:
+		default:
+			for j, c := range s.param.Cols {
+				if strings.ToLower(c) == name {
+					col = j
+					break
+				}
+			}
+			if col < 0 {
+				return vm.CancelResult, moerr.NewInvalidArg(proc.Ctx, "table_changes: invalid column name", attr)
+			}
+		}
+
+		var err error
+		for _, c := range s.changes[s.next:end] {
+			switch {
+			case strings.EqualFold(attr, plan2.TableChangesCommitTSCol):
+				err = vector.AppendFixed(vec, c.ts, false, mp)
+			case strings.EqualFold(attr, plan2.TableChangesTypeCol):
+				typ := plan2.TableChangesInsert
+				if c.delete {
+					typ = plan2.TableChangesDelete
+				}
+				err = vector.AppendBytes(vec, []byte(typ), false, mp)
+			case c.row >= 0:
+				err = vec.UnionOne(s.rows.Vecs[col], int64(c.row), mp)
+			case s.cols[col] == s.cols[len(s.cols)-1]:
+				// the image is not found, only the primary key is known.
+				err = vec.UnionOne(s.keys, int64(c.key), mp)
+			default:
+				err = vec.UnionNull(mp)
+			}
+			if err != nil {
+				return vm.CancelResult, err
+			}
+		}
+	}
+	s.batch.SetRowCount(end - s.next)
+	s.next = end
+	return vm.CallResult{Status: vm.ExecNext, Batch: s.batch}, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+)
+
+func TestTableChanges(t *testing.T) {
+	proc := testutil.NewProcess()
+	mp := proc.Mp()
+	ts1, ts2, ts3 := types.BuildTS(1, 0), types.BuildTS(2, 0), types.BuildTS(3, 0)
+
+	// the batches of CollectChanges do not set the row count
+	data := batch.NewWithSize(3)
+	data.Vecs[0] = vector.NewVec(types.T_int32.ToType())
+	data.Vecs[1] = vector.NewVec(types.T_varchar.ToType())
+	data.Vecs[2] = vector.NewVec(types.T_TS.ToType())
+	for _, r := range []struct {
+		id int32
+		v  string
+		ts types.TS
+	}{{1, "a", ts1}, {2, "b", ts1}, {1, "aa", ts2}} {
+		require.NoError(t, vector.AppendFixed(data.Vecs[0], r.id, false, mp))
+		require.NoError(t, vector.AppendBytes(data.Vecs[1], []byte(r.v), false, mp))
+		require.NoError(t, vector.AppendFixed(data.Vecs[2], r.ts, false, mp))
+	}
+	tombstone := batch.NewWithSize(2)
+	tombstone.Vecs[0] = vector.NewVec(types.T_int32.ToType())
+	tombstone.Vecs[1] = vector.NewVec(types.T_TS.ToType())
+	for _, r := range []struct {
+		id int32
+		ts types.TS
+	}{{1, ts2}, {2, ts3}, {3, ts3}} {
+		require.NoError(t, vector.AppendFixed(tombstone.Vecs[0], r.id, false, mp))
+		require.NoError(t, vector.AppendFixed(tombstone.Vecs[1], r.ts, false, mp))
+	}
+
+	s := &tableChangesState{
+		param: plan2.TableChangesParam{Cols: []string{"id", "v"}},
+		cols:  []int{0, 1, 0},
+	}
+	require.NoError(t, s.appendChanges(proc, data, tombstone))
+	cleanChangesBatches(data, tombstone, mp)
+	// id 3 is not inserted in the range, and the snapshot is not read for an empty from.
+	require.NoError(t, s.resolveImages(proc, types.TS{}))
+	s.sortChanges()
+
+	tf := &TableFunction{Attrs: []string{"id", "V", plan2.TableChangesCommitTSCol, plan2.TableChangesTypeCol}}
+	tf.ctr.retSchema = []types.Type{types.T_int32.ToType(), types.T_varchar.ToType(), types.T_TS.ToType(), types.T_varchar.ToType()}
+	s.batch = tf.createResultBatch()
+
+	res, err := s.call(tf, proc)
+	require.NoError(t, err)
+	require.Equal(t, 6, res.Batch.RowCount())
+	vecs := res.Batch.Vecs
+	expected := []struct {
+		id  int32
+		v   string
+		ts  types.TS
+		typ string
+	}{
+		{1, "a", ts1, plan2.TableChangesInsert},
+		{2, "b", ts1, plan2.TableChangesInsert},
+		{1, "a", ts2, plan2.TableChangesDelete},
+		{1, "aa", ts2, plan2.TableChangesInsert},
+		{2, "b", ts3, plan2.TableChangesDelete},
+		{3, "", ts3, plan2.TableChangesDelete},
+	}
+	for i, e := range expected {
+		require.Equal(t, e.id, vector.GetFixedAtNoTypeCheck[int32](vecs[0], i))
+		if e.v == "" {
+			require.True(t, vecs[1].IsNull(uint64(i)))
+		} else {
+			require.Equal(t, e.v, vecs[1].GetStringAt(i))
+		}
+		require.Equal(t, e.ts, vector.GetFixedAtNoTypeCheck[types.TS](vecs[2], i))
+		require.Equal(t, e.typ, vecs[3].GetStringAt(i))
+	}
+
+	res, err = s.call(tf, proc)
+	require.NoError(t, err)
+	require.Equal(t, vm.CancelResult, res)
+
+	s.free(tf, proc, false, nil)
+	require.Equal(t, int64(0), mp.CurrNB())
+}
@@ -50,6 +50,9 @@ func (tableFunction *TableFunction) Call(proc *process.Process) (vm.CallResult,
 
 	// loop
 	for {
+		if tableFunction.ctr.inputEnded {
+			return vm.CancelResult, nil
+		}
 		if tableFunction.ctr.inputBatch.IsDone() || tableFunction.ctr.nextRow >= tableFunction.ctr.inputBatch.RowCount() {
 			// get to next input batch
 			input, err := vm.ChildrenCall(tableFunction.GetChildren(0), proc, analyzer)
@@ -59,6 +62,21 @@ func (tableFunction *TableFunction) Call(proc *process.Process) (vm.CallResult,
 
 			tableFunction.ctr.inputBatch = input.Batch
 			if input.Batch.IsDone() {
+				if es, ok := tableFunction.ctr.state.(tvfEndState); ok {
+					// the result of the whole input
+					tableFunction.ctr.inputEnded = true
+					if err = es.end(tableFunction, proc); err != nil {
+						return vm.CancelResult, err
+					}
+					res, err := tableFunction.ctr.state.call(tableFunction, proc)
+					if err != nil {
+						return vm.CancelResult, err
+					}
+					if !res.Batch.IsDone() {
+						analyzer.Output(res.Batch)
+						return res, nil
+					}
+				}
 				analyzer.Output(input.Batch)
 				return input, nil
 			}
@@ -151,6 +169,14 @@ func (tableFunction *TableFunction) Prepare(proc *process.Process) error {
 		tblArg.ctr.state, err = fulltextIndexTokenizePrepare(proc, tblArg)
 	case "stage_list":
 		tblArg.ctr.state, err = stageListPrepare(proc, tblArg)
+	case "hnsw_search":
+		tblArg.ctr.state, err = hnswSearchPrepare(proc, tblArg)
+	case "hybrid_search":
+		tblArg.ctr.state, err = hybridSearchPrepare(proc, tblArg)
+	case "table_changes":
+		tblArg.ctr.state, err = tableChangesPrepare(proc, tblArg)
+	case "lateral_subquery":
+		tblArg.ctr.state, err = lateralSubqueryPrepare(proc, tblArg)
 	default:
 		tblArg.ctr.state = nil
 		err = moerr.NewNotSupported(proc.Ctx, fmt.Sprintf("table function %s is not supported", tblArg.FuncName))
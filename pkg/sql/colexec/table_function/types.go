@@ -78,6 +78,12 @@ type tvfState interface {
 	free(tf *TableFunction, proc *process.Process, pipelineFailed bool, err error)
 }
 
+// tvfEndState is implemented by the table functions that consume all the input rows before they
+// produce a result. end is called once when the input is exhausted, and its result is returned by call.
+type tvfEndState interface {
+	end(tf *TableFunction, proc *process.Process) error
+}
+
 type container struct {
 	// schema
 	retSchema        []types.Type
@@ -87,6 +93,8 @@ type container struct {
 	// calls, we do not own it and do not free it.
 	nextRow    int
 	inputBatch *batch.Batch
+	// inputEnded is set once the input is exhausted and end of a tvfEndState is called.
+	inputEnded bool
 	// hold arg vectors, we do not own them and do not free them.
 	argVecs []*vector.Vector
 
@@ -97,6 +105,7 @@ type container struct {
 func (tableFunction *TableFunction) Reset(proc *process.Process, pipelineFailed bool, err error) {
 	tableFunction.ctr.nextRow = 0
 	tableFunction.ctr.inputBatch = nil
+	tableFunction.ctr.inputEnded = false
 	for i := range tableFunction.ctr.executorsForArgs {
 		if tableFunction.ctr.executorsForArgs[i] != nil {
 			tableFunction.ctr.argVecs[i] = nil
@@ -16,6 +16,7 @@ package window
 
 import (
 	"bytes"
+	"slices"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggexec"
@@ -214,7 +215,8 @@ func (ctr *container) resetResultBatch(bat *batch.Batch, vec *vector.Vector) *ba
 func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, analyzer process.Analyzer) error {
 	var err error
 	n := ctr.bat.Vecs[0].Length()
-	isWinOrder := function.GetFunctionIsWinOrderFunByName(ap.WinSpecList[idx].Expr.(*plan.Expr_W).W.Name)
+	w := ap.WinSpecList[idx].Expr.(*plan.Expr_W).W
+	isWinOrder := function.GetFunctionIsWinOrderFunByName(w.Name)
 	if isWinOrder {
 		if ctr.ps == nil {
 			ctr.ps = append(ctr.ps, 0)
@@ -233,6 +235,8 @@ func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, an
 		if err = vector.AppendFixedList(vec, ctr.os, nil, proc.Mp()); err != nil {
 			return err
 		}
+		// the arguments follow the peer boundaries, e.g. the bucket number of ntile.
+		vecs := append([]*vector.Vector{vec}, ctr.aggVecs[idx].Vec...)
 
 		o := 0
 		for p := 1; p < len(ctr.ps); p++ {
@@ -240,7 +244,7 @@ func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, an
 
 				if ctr.os[o] <= ctr.ps[p] {
 
-					if err = ctr.bat.Aggs[idx].Fill(p-1, o, []*vector.Vector{vec}); err != nil {
+					if err = ctr.bat.Aggs[idx].Fill(p-1, o, vecs); err != nil {
 						return err
 					}
 
@@ -251,6 +255,10 @@ func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, an
 
 			}
 		}
+	} else if isValueFunc(w.Name) {
+		if err = ctr.processValueFunc(idx, w, n); err != nil {
+			return err
+		}
 	} else {
 		//nullVec := vector.NewConstNull(*ctr.aggVecs[idx].Vec[0].GetType(), 1, proc.Mp())
 		//defer nullVec.Free(proc.Mp())
@@ -264,7 +272,7 @@ func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, an
 				start, end = buildPartitionInterval(ctr.ps, j, n)
 			}
 
-			left, right, err := ctr.buildInterval(j, start, end, ap.WinSpecList[idx].Expr.(*plan.Expr_W).W.Frame)
+			left, right, err := ctr.buildInterval(j, start, end, w.Frame)
 			if err != nil {
 				return err
 			}
@@ -290,6 +298,10 @@ func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, an
 				}
 			}
 
+			// the group of this row is done, no need to keep its distinct values.
+			if releaser, ok := ctr.bat.Aggs[idx].(aggexec.DistinctGroupReleaser); ok && ctr.bat.Aggs[idx].IsDistinct() {
+				releaser.ReleaseDistinctGroup(j)
+			}
 		}
 	}
 
@@ -312,6 +324,116 @@ func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, an
 	return nil
 }
 
+func isValueFunc(name string) bool {
+	switch name {
+	case "lag", "lead", "first_value", "last_value", "nth_value":
+		return true
+	}
+	return false
+}
+
+// processValueFunc picks the source row for every row and fills it into the agg.
+// lag and lead count the rows from the current one and ignore the frame,
+// first_value, last_value and nth_value pick the row inside the frame.
+func (ctr *container) processValueFunc(idx int, w *plan.WindowSpec, n int) error {
+	vecs := ctr.aggVecs[idx].Vec
+	agg := ctr.bat.Aggs[idx]
+
+	for j := 0; j < n; j++ {
+		start, end := 0, n
+		if ctr.ps != nil {
+			start, end = buildPartitionInterval(ctr.ps, j, n)
+		}
+
+		row := -1
+		switch w.Name {
+		case "lag", "lead":
+			offset := int64(1)
+			if len(vecs) > 1 {
+				if vecs[1].IsNull(uint64(j)) {
+					return moerr.NewInvalidArgNoCtx(w.Name+" offset", "NULL")
+				}
+				if offset = vector.GetFixedAtWithTypeCheck[int64](vecs[1], j); offset < 0 {
+					return moerr.NewInvalidArgNoCtx(w.Name+" offset", offset)
+				}
+			}
+			if offset == 0 {
+				row = j
+			} else if w.Name == "lag" {
+				row = nthRow(vecs[0], j-1, -1, offset, start, end, w.IgnoreNulls)
+			} else {
+				row = nthRow(vecs[0], j+1, 1, offset, start, end, w.IgnoreNulls)
+			}
+			// use the default value of the current row if out of the partition.
+			if row < 0 && len(vecs) > 2 {
+				if err := agg.Fill(j, j, vecs[2:]); err != nil {
+					return err
+				}
+				continue
+			}
+		default:
+			left, right, err := ctr.buildInterval(j, start, end, w.Frame)
+			if err != nil {
+				return err
+			}
+			if left < start {
+				left = start
+			}
+			if right > end {
+				right = end
+			}
+			if left >= right {
+				continue
+			}
+
+			switch w.Name {
+			case "first_value":
+				row = nthRow(vecs[0], left, 1, 1, left, right, w.IgnoreNulls)
+			case "last_value":
+				row = nthRow(vecs[0], right-1, -1, 1, left, right, w.IgnoreNulls)
+			case "nth_value":
+				if vecs[1].IsNull(uint64(j)) {
+					continue
+				}
+				nth := vector.GetFixedAtWithTypeCheck[int64](vecs[1], j)
+				if nth <= 0 {
+					return moerr.NewInvalidArgNoCtx("nth_value", nth)
+				}
+				row = nthRow(vecs[0], left, 1, nth, left, right, w.IgnoreNulls)
+			}
+		}
+
+		if row >= 0 {
+			if err := agg.Fill(j, row, vecs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// nthRow returns the nth row walking from begin by step inside [lower, upper),
+// rows with null value are not counted if ignoreNulls is set.
+// it returns -1 if there is no such row.
+func nthRow(vec *vector.Vector, begin, step int, nth int64, lower, upper int, ignoreNulls bool) int {
+	if !ignoreNulls {
+		k := int64(begin) + int64(step)*(nth-1)
+		if k < int64(lower) || k >= int64(upper) {
+			return -1
+		}
+		return int(k)
+	}
+	for k := begin; k >= lower && k < upper; k += step {
+		if vec.IsNull(uint64(k)) {
+			continue
+		}
+		if nth--; nth == 0 {
+			return k
+		}
+	}
+	return -1
+}
+
 func (ctr *container) buildInterval(rowIdx, start, end int, frame *plan.FrameClause) (int, int, error) {
 	// FrameClause_ROWS
 	if frame.Type == plan.FrameClause_ROWS {
@@ -319,6 +441,12 @@ func (ctr *container) buildInterval(rowIdx, start, end int, frame *plan.FrameCla
 		return start, end, nil
 	}
 
+	// FrameClause_GROUPS
+	if frame.Type == plan.FrameClause_GROUPS {
+		start, end = ctr.buildGroupsInterval(rowIdx, start, end, frame)
+		return start, end, nil
+	}
+
 	if len(ctr.orderVecs) == 0 {
 		return start, end, nil
 	}
@@ -356,6 +484,62 @@ func (ctr *container) buildRowsInterval(rowIdx int, start, end int, frame *plan.
 	return start, end
 }
 
+// buildGroupsInterval counts the offsets of the frame in peer groups,
+// ctr.os holds the first row of each peer group.
+func (ctr *container) buildGroupsInterval(rowIdx int, start, end int, frame *plan.FrameClause) (int, int) {
+	peers := ctr.os
+	if len(peers) == 0 {
+		// without order by, all rows of the partition are peers.
+		peers = []int64{int64(start)}
+	}
+	// the peer group of the current row.
+	g, _ := slices.BinarySearch(peers, int64(rowIdx)+1)
+	g--
+
+	// groupStart returns the first row of the ith peer group.
+	groupStart := func(i int) int {
+		if i < 0 {
+			return start
+		}
+		if i >= len(peers) {
+			return end
+		}
+		return int(peers[i])
+	}
+
+	left, right := start, end
+	switch frame.Start.Type {
+	case plan.FrameBound_CURRENT_ROW:
+		left = groupStart(g)
+	case plan.FrameBound_PRECEDING:
+		if !frame.Start.UnBounded {
+			pre := frame.Start.Val.Expr.(*plan.Expr_Lit).Lit.Value.(*plan.Literal_U64Val).U64Val
+			left = groupStart(g - int(pre))
+		}
+	case plan.FrameBound_FOLLOWING:
+		fol := frame.Start.Val.Expr.(*plan.Expr_Lit).Lit.Value.(*plan.Literal_U64Val).U64Val
+		left = groupStart(g + int(fol))
+	}
+
+	switch frame.End.Type {
+	case plan.FrameBound_CURRENT_ROW:
+		right = groupStart(g + 1)
+	case plan.FrameBound_PRECEDING:
+		pre := frame.End.Val.Expr.(*plan.Expr_Lit).Lit.Value.(*plan.Literal_U64Val).U64Val
+		if g-int(pre) < 0 {
+			right = start
+		} else {
+			right = groupStart(g - int(pre) + 1)
+		}
+	case plan.FrameBound_FOLLOWING:
+		if !frame.End.UnBounded {
+			fol := frame.End.Val.Expr.(*plan.Expr_Lit).Lit.Value.(*plan.Literal_U64Val).U64Val
+			right = groupStart(g + int(fol) + 1)
+		}
+	}
+	return left, right
+}
+
 func (ctr *container) buildRangeInterval(rowIdx int, start, end int, frame *plan.FrameClause) (int, int, error) {
 	var err error
 	switch frame.Start.Type {
@@ -575,8 +759,8 @@ func (ctr *container) processOrder(idx int, ap *Window, bat *batch.Batch, proc *
 
 	// shuffle agg vector
 	for k := idx; k < len(ctr.aggVecs); k++ {
-		if len(ctr.aggVecs[k].Vec) > 0 {
-			if err := ctr.aggVecs[k].Vec[0].Shuffle(ctr.sels, proc.Mp()); err != nil {
+		for _, vec := range ctr.aggVecs[k].Vec {
+			if err := vec.Shuffle(ctr.sels, proc.Mp()); err != nil {
 				panic(err)
 			}
 		}
@@ -29,6 +29,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -156,3 +157,219 @@ func newFunExpr() *plan.Expr {
 		},
 	}
 }
+
+func TestValueAndDistributionWindow(t *testing.T) {
+	proc := testutil.NewProcessWithMPool("", mpool.MustNewZero())
+	// a: 1, null, 3, null, 5
+	a := newColExpr(0)
+	a.Typ = plan.Type{Id: int32(types.T_int64)}
+	values := []int64{1, 0, 3, 0, 5}
+	nulls := []bool{false, true, false, true, false}
+	unbounded := &plan.FrameClause{
+		Type:  plan.FrameClause_ROWS,
+		Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, UnBounded: true},
+		End:   &plan.FrameBound{Type: plan.FrameBound_FOLLOWING, UnBounded: true},
+	}
+
+	cases := []struct {
+		name        string
+		args        []*plan.Expr
+		ignoreNulls bool
+		expected    []any
+	}{
+		{name: "lag", args: []*plan.Expr{a},
+			expected: []any{nil, int64(1), nil, int64(3), nil}},
+		{name: "lag", args: []*plan.Expr{a, newI64Expr(1), newI64Expr(-1)}, ignoreNulls: true,
+			expected: []any{int64(-1), int64(1), int64(1), int64(3), int64(3)}},
+		{name: "lead", args: []*plan.Expr{a, newI64Expr(2)},
+			expected: []any{int64(3), nil, int64(5), nil, nil}},
+		{name: "lead", args: []*plan.Expr{a, newI64Expr(0)}, ignoreNulls: true,
+			expected: []any{int64(1), nil, int64(3), nil, int64(5)}},
+		{name: "first_value", args: []*plan.Expr{a},
+			expected: []any{int64(1), int64(1), int64(1), int64(1), int64(1)}},
+		{name: "last_value", args: []*plan.Expr{a},
+			expected: []any{int64(5), int64(5), int64(5), int64(5), int64(5)}},
+		{name: "nth_value", args: []*plan.Expr{a, newI64Expr(2)},
+			expected: []any{nil, nil, nil, nil, nil}},
+		{name: "nth_value", args: []*plan.Expr{a, newI64Expr(2)}, ignoreNulls: true,
+			expected: []any{int64(3), int64(3), int64(3), int64(3), int64(3)}},
+		{name: "ntile", args: []*plan.Expr{newI64Expr(2)},
+			expected: []any{int64(1), int64(1), int64(1), int64(2), int64(2)}},
+		{name: "percent_rank",
+			expected: []any{float64(0), float64(0), float64(0), float64(0), float64(0)}},
+		{name: "cume_dist",
+			expected: []any{float64(1), float64(1), float64(1), float64(1), float64(1)}},
+	}
+
+	for _, c := range cases {
+		argTypes := make([]types.Type, len(c.args))
+		for i, arg := range c.args {
+			argTypes[i] = types.T(arg.Typ.Id).ToType()
+		}
+		f, err := function.GetFunctionByName(context.Background(), c.name, argTypes)
+		require.NoError(t, err)
+
+		var typ types.Type
+		if len(argTypes) > 0 {
+			typ = argTypes[0]
+		}
+		arg := &Window{
+			WinSpecList: []*plan.Expr{{
+				Expr: &plan.Expr_W{
+					W: &plan.WindowSpec{
+						Name:        c.name,
+						WindowFunc:  newFunExpr(),
+						Frame:       unbounded,
+						IgnoreNulls: c.ignoreNulls,
+					},
+				},
+			}},
+			Types: []types.Type{typ},
+			Aggs:  []aggexec.AggFuncExecExpression{aggexec.MakeAggFunctionExpression(f.GetEncodedOverloadID(), false, c.args, nil)},
+		}
+
+		vec := vector.NewVec(types.T_int64.ToType())
+		require.NoError(t, vector.AppendFixedList(vec, values, nulls, proc.Mp()))
+		bat := batch.NewWithSize(1)
+		bat.Vecs[0] = vec
+		bat.SetRowCount(vec.Length())
+		arg.AppendChild(colexec.NewMockOperator().WithBatchs([]*batch.Batch{bat}))
+
+		require.NoError(t, arg.Prepare(proc))
+		result, err := arg.Call(proc)
+		require.NoError(t, err, c.name)
+		ret := result.Batch.Vecs[len(result.Batch.Vecs)-1]
+		for i, v := range c.expected {
+			if v == nil {
+				require.True(t, ret.IsNull(uint64(i)), "%s row %d", c.name, i)
+				continue
+			}
+			require.False(t, ret.IsNull(uint64(i)), "%s row %d", c.name, i)
+			switch v := v.(type) {
+			case int64:
+				require.Equal(t, v, vector.GetFixedAtWithTypeCheck[int64](ret, i), "%s row %d", c.name, i)
+			case float64:
+				require.Equal(t, v, vector.GetFixedAtWithTypeCheck[float64](ret, i), "%s row %d", c.name, i)
+			}
+		}
+		arg.Free(proc, false, nil)
+		bat.Clean(proc.Mp())
+	}
+	proc.Free()
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
+
+func newI64Expr(v int64) *plan.Expr {
+	return &plan.Expr{
+		Typ: plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_Lit{
+			Lit: &plan.Literal{Value: &plan.Literal_I64Val{I64Val: v}},
+		},
+	}
+}
+
+func TestGroupsFrameAndDistinct(t *testing.T) {
+	proc := testutil.NewProcessWithMPool("", mpool.MustNewZero())
+	a := newColExpr(0)
+	a.Typ = plan.Type{Id: int32(types.T_int64)}
+	// peer groups: {1, 1}, {2}, {3, 3, 3}
+	values := []int64{1, 1, 2, 3, 3, 3}
+
+	cases := []struct {
+		name     string
+		distinct bool
+		frame    *plan.FrameClause
+		expected []int64
+	}{
+		{
+			name: "sum",
+			frame: &plan.FrameClause{
+				Type:  plan.FrameClause_GROUPS,
+				Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Val: newU64Expr(1)},
+				End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+			},
+			expected: []int64{2, 2, 4, 11, 11, 11},
+		},
+		{
+			name: "sum",
+			frame: &plan.FrameClause{
+				Type:  plan.FrameClause_GROUPS,
+				Start: &plan.FrameBound{Type: plan.FrameBound_FOLLOWING, Val: newU64Expr(1)},
+				End:   &plan.FrameBound{Type: plan.FrameBound_FOLLOWING, UnBounded: true},
+			},
+			expected: []int64{11, 11, 9, 0, 0, 0},
+		},
+		{
+			name:     "count",
+			distinct: true,
+			frame: &plan.FrameClause{
+				Type:  plan.FrameClause_GROUPS,
+				Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, UnBounded: true},
+				End:   &plan.FrameBound{Type: plan.FrameBound_FOLLOWING, Val: newU64Expr(1)},
+			},
+			expected: []int64{2, 2, 3, 3, 3, 3},
+		},
+		{
+			name:     "count",
+			distinct: true,
+			frame: &plan.FrameClause{
+				Type:  plan.FrameClause_ROWS,
+				Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Val: newU64Expr(2)},
+				End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+			},
+			expected: []int64{1, 1, 2, 3, 2, 1},
+		},
+	}
+
+	for _, c := range cases {
+		f, err := function.GetFunctionByName(context.Background(), c.name, []types.Type{types.T_int64.ToType()})
+		require.NoError(t, err)
+
+		arg := &Window{
+			WinSpecList: []*plan.Expr{{
+				Expr: &plan.Expr_W{
+					W: &plan.WindowSpec{
+						Name:       c.name,
+						WindowFunc: newFunExpr(),
+						OrderBy:    []*plan.OrderBySpec{{Expr: a, Flag: plan.OrderBySpec_ASC}},
+						Frame:      c.frame,
+					},
+				},
+			}},
+			Types: []types.Type{types.T_int64.ToType()},
+			Aggs:  []aggexec.AggFuncExecExpression{aggexec.MakeAggFunctionExpression(f.GetEncodedOverloadID(), c.distinct, []*plan.Expr{a}, nil)},
+		}
+
+		vec := vector.NewVec(types.T_int64.ToType())
+		require.NoError(t, vector.AppendFixedList(vec, values, nil, proc.Mp()))
+		bat := batch.NewWithSize(1)
+		bat.Vecs[0] = vec
+		bat.SetRowCount(vec.Length())
+		arg.AppendChild(colexec.NewMockOperator().WithBatchs([]*batch.Batch{bat}))
+
+		require.NoError(t, arg.Prepare(proc))
+		result, err := arg.Call(proc)
+		require.NoError(t, err)
+		ret := result.Batch.Vecs[len(result.Batch.Vecs)-1]
+		for i, v := range c.expected {
+			if ret.IsNull(uint64(i)) {
+				require.Equal(t, int64(0), v, "%s row %d", c.name, i)
+				continue
+			}
+			require.Equal(t, v, vector.GetFixedAtWithTypeCheck[int64](ret, i), "%s row %d", c.name, i)
+		}
+		arg.Free(proc, false, nil)
+		bat.Clean(proc.Mp())
+	}
+	proc.Free()
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
+
+func newU64Expr(v uint64) *plan.Expr {
+	return &plan.Expr{
+		Typ: plan.Type{Id: int32(types.T_uint64)},
+		Expr: &plan.Expr_Lit{
+			Lit: &plan.Literal{Value: &plan.Literal_U64Val{U64Val: v}},
+		},
+	}
+}
@@ -64,6 +64,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergedelete"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergerecursive"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/minus"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/minusall"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/multi_update"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/sample"
@@ -1138,7 +1139,7 @@ func (c *Compile) compilePlanScope(step int32, curNodeIdx int32, ns []*plan.Node
 		c.setAnalyzeCurrent(right, int(curNodeIdx))
 		ss = c.compileSort(n, c.compileUnion(n, left, right))
 		return ss, nil
-	case plan.Node_MINUS, plan.Node_INTERSECT, plan.Node_INTERSECT_ALL:
+	case plan.Node_MINUS, plan.Node_MINUS_ALL, plan.Node_INTERSECT, plan.Node_INTERSECT_ALL:
 		left, err = c.compilePlanScope(step, n.Children[0], ns)
 		if err != nil {
 			return nil, err
@@ -1274,7 +1275,7 @@ func (c *Compile) compilePlanScope(step int32, curNodeIdx int32, ns []*plan.Node
 		if err != nil {
 			return nil, err
 		}
-		ss = c.compileProjection(n, ss)
+		ss = c.compileProjection(n, c.compileSort(n, ss))
 		return ss, nil
 	case plan.Node_FUNCTION_SCAN:
 		ss, err = c.compilePlanScope(step, n.Children[0], ns)
@@ -2215,6 +2216,11 @@ func (c *Compile) compileTpMinusAndIntersect(left []*Scope, right []*Scope, node
 		arg.SetAnalyzeControl(c.anal.curNodeIdx, currentFirstFlag)
 		rs[0].setRootOperator(arg)
 		arg.AppendChild(merge1)
+	case plan.Node_MINUS_ALL:
+		arg := minusall.NewArgument()
+		arg.SetAnalyzeControl(c.anal.curNodeIdx, currentFirstFlag)
+		rs[0].setRootOperator(arg)
+		arg.AppendChild(merge1)
 	}
 	c.anal.isFirst = false
 	return rs
@@ -2224,6 +2230,17 @@ func (c *Compile) compileMinusAndIntersect(n *plan.Node, left []*Scope, right []
 	if c.IsSingleScope(left) && c.IsSingleScope(right) {
 		return c.compileTpMinusAndIntersect(left, right, nodeType)
 	}
+	// without shuffle, every parallel scope would get all rows of both sides
+	// and output the same result, so do it in one scope.
+	if !n.Stats.HashmapStats.Shuffle {
+		if !c.IsSingleScope(left) {
+			left = []*Scope{c.newMergeScope(left)}
+		}
+		if !c.IsSingleScope(right) {
+			right = []*Scope{c.newMergeScope(right)}
+		}
+		return c.compileTpMinusAndIntersect(left, right, nodeType)
+	}
 	rs := c.newScopeListOnCurrentCN(2, int(n.Stats.BlockNum))
 	rs = c.newScopeListForMinusAndIntersect(rs, left, right, n)
 
@@ -2260,6 +2277,16 @@ func (c *Compile) compileMinusAndIntersect(n *plan.Node, left []*Scope, right []
 			rs[i].setRootOperator(arg)
 			arg.AppendChild(merge1)
 		}
+	case plan.Node_MINUS_ALL:
+		for i := range rs {
+			merge0 := rs[i].RootOp.(*merge.Merge)
+			merge0.WithPartial(0, 1)
+			merge1 := merge.NewArgument().WithPartial(1, 2)
+			arg := minusall.NewArgument()
+			arg.SetAnalyzeControl(c.anal.curNodeIdx, currentFirstFlag)
+			rs[i].setRootOperator(arg)
+			arg.AppendChild(merge1)
+		}
 	}
 	c.anal.isFirst = false
 	return rs
@@ -3419,14 +3446,23 @@ func (c *Compile) compileLock(n *plan.Node, ss []*Scope) ([]*Scope, error) {
 		}
 	}
 
+	limit, err := c.getLockLimit(n)
+	if err != nil {
+		return nil, err
+	}
+	// the rows locked are counted by one lock op if there is a limit.
+	if limit > 0 && !c.IsSingleScope(ss) {
+		ss = []*Scope{c.newMergeScope(ss)}
+	}
+
 	currentFirstFlag := c.anal.isFirst
 	for i := range ss {
-		var err error
 		var lockOpArg *lockop.LockOp
 		lockOpArg, err = constructLockOp(n, c.e)
 		if err != nil {
 			return nil, err
 		}
+		lockOpArg.SetLimit(limit)
 		lockOpArg.SetAnalyzeControl(c.anal.curNodeIdx, currentFirstFlag)
 		ss[i].doSetRootOperator(lockOpArg)
 	}
@@ -3434,6 +3470,40 @@ func (c *Compile) compileLock(n *plan.Node, ss []*Scope) ([]*Scope, error) {
 	return ss, nil
 }
 
+// getLockLimit returns the most rows locked by SKIP LOCKED. The lock node
+// takes the limit and offset of the query if it is above the sort node.
+func (c *Compile) getLockLimit(n *plan.Node) (int64, error) {
+	if n.Limit == nil || !rule.IsConstant(n.Limit, false) {
+		return 0, nil
+	}
+	if n.Offset != nil && !rule.IsConstant(n.Offset, false) {
+		return 0, nil
+	}
+
+	vec, free, err := colexec.GetReadonlyResultFromNoColumnExpression(c.proc, n.Limit)
+	if err != nil {
+		return 0, err
+	}
+	defer free()
+	limit := vector.MustFixedColWithTypeCheck[uint64](vec)[0]
+	if n.Offset != nil {
+		vec, free, err := colexec.GetReadonlyResultFromNoColumnExpression(c.proc, n.Offset)
+		if err != nil {
+			return 0, err
+		}
+		defer free()
+		offset := vector.MustFixedColWithTypeCheck[uint64](vec)[0]
+		if limit+offset < limit {
+			return 0, nil
+		}
+		limit += offset
+	}
+	if limit == 0 || limit > math.MaxInt64 {
+		return 0, nil
+	}
+	return int64(limit), nil
+}
+
 func (c *Compile) compileRecursiveCte(n *plan.Node, curNodeIdx int32) ([]*Scope, error) {
 	receivers := make([]*process.WaitRegister, len(n.SourceStep))
 	for i, step := range n.SourceStep {
@@ -3694,6 +3764,11 @@ func (c *Compile) newScopeListForMinusAndIntersect(rs, left, right []*Scope, n *
 	// construct left
 	left = c.mergeShuffleScopesIfNeeded(left, false)
 	leftMerge := c.newMergeScope(left)
+	if n.Stats.HashmapStats.Shuffle {
+		shuffleArg := constructShuffleArgForSetOp(int32(len(rs)), n)
+		shuffleArg.SetAnalyzeControl(c.anal.curNodeIdx, false)
+		leftMerge.setRootOperator(shuffleArg)
+	}
 	leftDispatch := constructDispatch(0, rs, leftMerge, n, false)
 	leftDispatch.SetAnalyzeControl(c.anal.curNodeIdx, false)
 	leftMerge.setRootOperator(leftDispatch)
@@ -3702,6 +3777,11 @@ func (c *Compile) newScopeListForMinusAndIntersect(rs, left, right []*Scope, n *
 	// construct right
 	right = c.mergeShuffleScopesIfNeeded(right, false)
 	rightMerge := c.newMergeScope(right)
+	if n.Stats.HashmapStats.Shuffle {
+		shuffleArg := constructShuffleArgForSetOp(int32(len(rs)), n)
+		shuffleArg.SetAnalyzeControl(c.anal.curNodeIdx, false)
+		rightMerge.setRootOperator(shuffleArg)
+	}
 	rightDispatch := constructDispatch(1, rs, rightMerge, n, false)
 	leftDispatch.SetAnalyzeControl(c.anal.curNodeIdx, false)
 	rightMerge.setRootOperator(rightDispatch)
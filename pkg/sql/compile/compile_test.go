@@ -120,6 +120,18 @@ func (w *Ws) RollbackLastStatement(ctx context.Context) error {
 	return nil
 }
 
+func (w *Ws) Savepoint(ctx context.Context, name string) error {
+	return nil
+}
+
+func (w *Ws) RollbackToSavepoint(ctx context.Context, name string) error {
+	return nil
+}
+
+func (w *Ws) ReleaseSavepoint(ctx context.Context, name string) error {
+	return nil
+}
+
 func (w *Ws) Commit(ctx context.Context) ([]txn.TxnRequest, error) {
 	return nil, nil
 }
@@ -234,6 +234,11 @@ func (s *Scope) DropDatabase(c *Compile) error {
 	}
 	// 4. delete retention info
 	err = c.runSql(fmt.Sprintf(deleteMoRetentionWithDatabaseNameFormat, dbName))
+	if err != nil && !moerr.IsMoErrCode(err, moerr.ErrNoSuchTable) {
+		return err
+	}
+	// 5. delete events, their cron tasks are removed the next time they are triggered
+	err = c.runSql(fmt.Sprintf(deleteMoEventsWithDatabaseNameFormat, dbName))
 	if moerr.IsMoErrCode(err, moerr.ErrNoSuchTable) {
 		return nil
 	}
@@ -440,6 +445,7 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 		So, we only use ForeignKeyDef.Name that is no empty.
 	*/
 	oldFkNames := make(map[string]bool)
+	var checks []*plan.CheckDef
 	for _, ct := range oldCt.Cts {
 		switch t := ct.(type) {
 		case *engine.ForeignKeyDef:
@@ -448,6 +454,8 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 					oldFkNames[fkey.Name] = true
 				}
 			}
+		case *engine.CheckDef:
+			checks = t.Checks
 		}
 	}
 
@@ -514,6 +522,18 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 				}
 				// Avoid modifying slice directly during iteration
 				tableDef.Indexes = notDroppedIndex
+			} else if alterTableDrop.Typ == plan.AlterTableDrop_CHECK {
+				alterKinds = addAlterKind(alterKinds, api.AlterKind_UpdateConstraint)
+				remainingChecks := make([]*plan.CheckDef, 0, len(checks))
+				for _, check := range checks {
+					if check.Name != constraintName {
+						remainingChecks = append(remainingChecks, check)
+					}
+				}
+				if len(remainingChecks) == len(checks) {
+					return moerr.NewErrCantDropFieldOrKey(c.proc.Ctx, constraintName)
+				}
+				checks = remainingChecks
 			} else if alterTableDrop.Typ == plan.AlterTableDrop_COLUMN {
 				alterKinds = append(alterKinds, api.AlterKind_DropColumn)
 				var idx int
@@ -575,8 +595,8 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 				} else if !indexDef.Unique && catalog.IsFullTextIndexAlgo(indexDef.IndexAlgo) {
 					// 3. FullText index
 					err = s.handleFullTextIndexTable(c, dbSource, indexDef, qry.Database, tableDef, indexInfo)
-				} else if !indexDef.Unique && catalog.IsIvfIndexAlgo(indexDef.IndexAlgo) {
-					// 4. IVF indexDefs are aggregated and handled later
+				} else if !indexDef.Unique && (catalog.IsIvfIndexAlgo(indexDef.IndexAlgo) || catalog.IsHnswIndexAlgo(indexDef.IndexAlgo)) {
+					// 4. IVF and HNSW indexDefs are aggregated and handled later
 					if _, ok := multiTableIndexes[indexDef.IndexName]; !ok {
 						multiTableIndexes[indexDef.IndexName] = &MultiTableIndex{
 							IndexAlgo: catalog.ToLower(indexDef.IndexAlgo),
@@ -594,6 +614,8 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 				switch multiTableIndex.IndexAlgo { // no need for catalog.ToLower() here
 				case catalog.MoIndexIvfFlatAlgo.ToString():
 					err = s.handleVectorIvfFlatIndex(c, dbSource, multiTableIndex.IndexDefs, qry.Database, tableDef, indexInfo)
+				case catalog.MoIndexHnswAlgo.ToString():
+					err = s.handleVectorHnswIndex(c, dbSource, multiTableIndex.IndexDefs, qry.Database, tableDef, indexInfo)
 				}
 
 				if err != nil {
@@ -661,6 +683,8 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 					switch catalog.ToLower(indexAlgo) {
 					case catalog.MoIndexIvfFlatAlgo.ToString():
 						newAlgoParamsMap[catalog.IndexAlgoParamLists] = fmt.Sprintf("%d", tableAlterIndex.IndexAlgoParamList)
+					case catalog.MoIndexHnswAlgo.ToString():
+						// the graph is rebuilt with the same params
 					default:
 						return moerr.NewInternalError(c.proc.Ctx, "invalid index algo type for alter reindex")
 					}
@@ -702,12 +726,35 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 				switch multiTableIndex.IndexAlgo {
 				case catalog.MoIndexIvfFlatAlgo.ToString():
 					err = s.handleVectorIvfFlatIndex(c, dbSource, multiTableIndex.IndexDefs, qry.Database, tableDef, nil)
+				case catalog.MoIndexHnswAlgo.ToString():
+					err = s.handleVectorHnswIndex(c, dbSource, multiTableIndex.IndexDefs, qry.Database, tableDef, nil)
 				}
 
 				if err != nil {
 					return err
 				}
 			}
+		case *plan.AlterTable_Action_AddCheck:
+			alterKinds = addAlterKind(alterKinds, api.AlterKind_UpdateConstraint)
+			checks = append(checks, act.AddCheck)
+		case *plan.AlterTable_Action_AlterCheck:
+			alterKinds = addAlterKind(alterKinds, api.AlterKind_UpdateConstraint)
+			found := false
+			for i, check := range checks {
+				if check.Name == act.AlterCheck.Name {
+					checks[i] = &plan.CheckDef{
+						Name:        check.Name,
+						Check:       check.Check,
+						NotEnforced: !act.AlterCheck.Enforced,
+						ExprStr:     check.ExprStr,
+					}
+					found = true
+					break
+				}
+			}
+			if !found {
+				return moerr.NewInvalidInputf(c.proc.Ctx, "check constraint '%s' is not found in the table", act.AlterCheck.Name)
+			}
 		case *plan.AlterTable_Action_AlterComment:
 			alterKinds = addAlterKind(alterKinds, api.AlterKind_UpdateComment)
 			comment = act.AlterComment.NewComment
@@ -757,6 +804,7 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 	// reset origin table's constraint
 	originHasFkDef := false
 	originHasIndexDef := false
+	originHasCheckDef := false
 	for _, ct := range oldCt.Cts {
 		switch t := ct.(type) {
 		case *engine.ForeignKeyDef:
@@ -800,8 +848,19 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 			newCt.Cts = append(newCt.Cts, t)
 		case *engine.PrimaryKeyDef:
 			newCt.Cts = append(newCt.Cts, t)
+		case *engine.CheckDef:
+			originHasCheckDef = true
+			t.Checks = checks
+			newCt.Cts = append(newCt.Cts, t)
+		case *engine.GeneratedColDef:
+			newCt.Cts = append(newCt.Cts, t)
 		}
 	}
+	if !originHasCheckDef && len(checks) != 0 {
+		newCt.Cts = append(newCt.Cts, &engine.CheckDef{
+			Checks: checks,
+		})
+	}
 	if !originHasFkDef {
 		newCt.Cts = append(newCt.Cts, &engine.ForeignKeyDef{
 			Fkeys: newFkeys,
@@ -1320,6 +1379,14 @@ func (s *Scope) CreateTable(c *Compile) error {
 				catalog.SystemSI_IVFFLAT_TblCol_Centroids_id,
 				catalog.SystemSI_IVFFLAT_TblCol_Centroids_centroid,
 			)
+
+		case catalog.SystemSI_HNSW_TblType_Metadata:
+			initSQL = fmt.Sprintf("insert into `%s`.`%s` (`%s`, `%s`) VALUES('version', '0');",
+				qry.Database,
+				def.Name,
+				catalog.SystemSI_HNSW_TblCol_Metadata_key,
+				catalog.SystemSI_HNSW_TblCol_Metadata_val,
+			)
 		}
 		err = c.runSql(initSQL)
 		if err != nil {
@@ -1704,8 +1771,8 @@ func (s *Scope) CreateIndex(c *Compile) error {
 		} else if !indexDef.Unique && catalog.IsMasterIndexAlgo(indexAlgo) {
 			// 3. Master index
 			err = s.handleMasterIndexTable(c, dbSource, indexDef, qry.Database, originalTableDef, indexInfo)
-		} else if !indexDef.Unique && catalog.IsIvfIndexAlgo(indexAlgo) {
-			// 4. IVF indexDefs are aggregated and handled later
+		} else if !indexDef.Unique && (catalog.IsIvfIndexAlgo(indexAlgo) || catalog.IsHnswIndexAlgo(indexAlgo)) {
+			// 4. IVF and HNSW indexDefs are aggregated and handled later
 			if _, ok := multiTableIndexes[indexDef.IndexName]; !ok {
 				multiTableIndexes[indexDef.IndexName] = &MultiTableIndex{
 					IndexAlgo: catalog.ToLower(indexDef.IndexAlgo),
@@ -1726,6 +1793,8 @@ func (s *Scope) CreateIndex(c *Compile) error {
 		switch multiTableIndex.IndexAlgo {
 		case catalog.MoIndexIvfFlatAlgo.ToString():
 			err = s.handleVectorIvfFlatIndex(c, dbSource, multiTableIndex.IndexDefs, qry.Database, originalTableDef, indexInfo)
+		case catalog.MoIndexHnswAlgo.ToString():
+			err = s.handleVectorHnswIndex(c, dbSource, multiTableIndex.IndexDefs, qry.Database, originalTableDef, indexInfo)
 		}
 
 		if err != nil {
@@ -1876,6 +1945,40 @@ func (s *Scope) handleVectorIvfFlatIndex(c *Compile, dbSource engine.Database, i
 
 }
 
+func (s *Scope) handleVectorHnswIndex(c *Compile, dbSource engine.Database, indexDefs map[string]*plan.IndexDef, qryDatabase string, originalTableDef *plan.TableDef, indexInfo *plan.CreateTable) error {
+	if ok, err := s.isExperimentalEnabled(c, hnswIndexFlag); err != nil {
+		return err
+	} else if !ok {
+		return moerr.NewInternalErrorNoCtx("HNSW index is not enabled")
+	}
+
+	// 1. static check
+	if len(indexDefs) != 2 {
+		return moerr.NewInternalErrorNoCtx("invalid hnsw index table definition")
+	} else if len(indexDefs[catalog.SystemSI_HNSW_TblType_Metadata].Parts) != 1 {
+		return moerr.NewInternalErrorNoCtx("invalid hnsw index table definition")
+	}
+
+	// 2. create hidden tables
+	if indexInfo != nil {
+		for _, table := range indexInfo.GetIndexTables() {
+			if err := indexTableBuild(c, table, dbSource); err != nil {
+				return err
+			}
+		}
+	}
+
+	// 3. bump the version in meta table
+	version, err := s.handleHnswIndexMetaTable(c, indexDefs[catalog.SystemSI_HNSW_TblType_Metadata], qryDatabase)
+	if err != nil {
+		return err
+	}
+
+	// 4. build the graph and persist it in storage table
+	return s.handleHnswIndexStorageTable(c, indexDefs[catalog.SystemSI_HNSW_TblType_Storage], qryDatabase, originalTableDef,
+		indexDefs[catalog.SystemSI_HNSW_TblType_Metadata].IndexTableName, version)
+}
+
 func (s *Scope) DropIndex(c *Compile) error {
 	if s.ScopeAnalyzer == nil {
 		s.ScopeAnalyzer = NewScopeAnalyzer()
@@ -2664,6 +2767,28 @@ func planDefsToExeDefs(tableDef *plan.TableDef) ([]engine.TableDef, error) {
 		})
 	}
 
+	if len(tableDef.Checks) > 0 {
+		c.Cts = append(c.Cts, &engine.CheckDef{
+			Checks: tableDef.Checks,
+		})
+	}
+
+	var generated []*plan.GeneratedColDef
+	for _, col := range tableDef.Cols {
+		if col.Generated != nil {
+			// the column may have been renamed by ALTER TABLE, the table
+			// definition may be shared, so rename a copy
+			def := plan2.DeepCopyGeneratedColDef(col.Generated)
+			def.Name = col.Name
+			generated = append(generated, def)
+		}
+	}
+	if len(generated) > 0 {
+		c.Cts = append(c.Cts, &engine.GeneratedColDef{
+			Cols: generated,
+		})
+	}
+
 	if len(c.Cts) > 0 {
 		exeDefs = append(exeDefs, c)
 	}
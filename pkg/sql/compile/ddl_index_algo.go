@@ -15,20 +15,25 @@
 package compile
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/matrixorigin/matrixone/pkg/vectorindex/hnsw"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 const (
 	ivfFlatIndexFlag  = "experimental_ivf_index"
 	fulltextIndexFlag = "experimental_fulltext_index"
+	hnswIndexFlag     = "experimental_hnsw_index"
 )
 
 func (s *Scope) handleUniqueIndexTable(c *Compile, dbSource engine.Database,
@@ -454,3 +459,150 @@ func (s *Scope) handleIvfIndexDeleteOldEntries(c *Compile,
 
 	return nil
 }
+
+func (s *Scope) handleHnswIndexMetaTable(c *Compile, indexDef *plan.IndexDef, qryDatabase string) (int64, error) {
+
+	/*
+		The meta table contains the version of the graph in the storage table and `build_ts`, the snapshot
+		the graph was built from. Changes committed after `build_ts` are applied to the graph when it is loaded.
+		The version number is incremented monotonically for each re-index.
+	*/
+
+	insertSQL := fmt.Sprintf("insert into `%s`.`%s` (`%s`, `%s`) values('version', '0')"+
+		"ON DUPLICATE KEY UPDATE `%s` = CAST( (CAST(`%s` AS BIGINT) + 1) AS CHAR);",
+		qryDatabase,
+		indexDef.IndexTableName,
+		catalog.SystemSI_HNSW_TblCol_Metadata_key,
+		catalog.SystemSI_HNSW_TblCol_Metadata_val,
+
+		catalog.SystemSI_HNSW_TblCol_Metadata_val,
+		catalog.SystemSI_HNSW_TblCol_Metadata_val,
+	)
+	if err := c.runSql(insertSQL); err != nil {
+		return 0, err
+	}
+
+	versionSQL := fmt.Sprintf("select CAST(`%s` AS BIGINT) from `%s`.`%s` where `%s` = 'version';",
+		catalog.SystemSI_HNSW_TblCol_Metadata_val,
+		qryDatabase,
+		indexDef.IndexTableName,
+		catalog.SystemSI_HNSW_TblCol_Metadata_key,
+	)
+	rs, err := c.runSqlWithResult(versionSQL, NoAccountId)
+	if err != nil {
+		return 0, err
+	}
+	defer rs.Close()
+
+	var version int64
+	rs.ReadRows(func(_ int, cols []*vector.Vector) bool {
+		version = executor.GetFixedRows[int64](cols[0])[0]
+		return false
+	})
+	return version, nil
+}
+
+func (s *Scope) handleHnswIndexStorageTable(c *Compile, indexDef *plan.IndexDef, qryDatabase string, originalTableDef *plan.TableDef,
+	metadataTableName string, version int64) error {
+
+	// 1. build the graph from the rows visible to the snapshot of the txn
+	algoParams, err := catalog.IndexParamsStringToMap(indexDef.IndexAlgoParams)
+	if err != nil {
+		return err
+	}
+	cfg, err := hnsw.ConfigFromParams(algoParams)
+	if err != nil {
+		return err
+	}
+	idx, err := hnsw.NewIndex(cfg)
+	if err != nil {
+		return err
+	}
+
+	var originalTblPkColMaySerial string
+	if originalTableDef.Pkey.PkeyColName == catalog.CPrimaryKeyColName {
+		pkCols := make([]string, len(originalTableDef.Pkey.Names))
+		for i, part := range originalTableDef.Pkey.Names {
+			pkCols[i] = fmt.Sprintf("`%s`", part)
+		}
+		originalTblPkColMaySerial = fmt.Sprintf("serial(%s)", strings.Join(pkCols, ","))
+	} else {
+		originalTblPkColMaySerial = fmt.Sprintf("`%s`", originalTableDef.Pkey.PkeyColName)
+	}
+
+	buildTS := types.TimestampToTS(c.proc.GetTxnOperator().SnapshotTS())
+	selectSQL := fmt.Sprintf("select %s, `%s` from `%s`.`%s` where `%s` is not null;",
+		originalTblPkColMaySerial,
+		indexDef.Parts[0],
+		qryDatabase,
+		originalTableDef.Name,
+		indexDef.Parts[0],
+	)
+	rs, err := c.runSqlWithResult(selectSQL, NoAccountId)
+	if err != nil {
+		return err
+	}
+	rs.ReadRows(func(rows int, cols []*vector.Vector) bool {
+		for i := 0; i < rows; i++ {
+			var vec []float32
+			if vec, err = hnsw.GetVector(cols[1], i); err != nil {
+				return false
+			}
+			if err = idx.Add(hnsw.GetKey(cols[0], i), vec); err != nil {
+				return false
+			}
+		}
+		return true
+	})
+	rs.Close()
+	if err != nil {
+		return err
+	}
+
+	// 2. write the serialized graph to the storage table, a few chunks per statement
+	data := idx.Marshal()
+	const chunksPerInsert = 16
+	for chunk := 0; chunk*hnsw.ChunkSize < len(data); chunk += chunksPerInsert {
+		var insertSQL strings.Builder
+		insertSQL.WriteString(fmt.Sprintf("insert into `%s`.`%s` (`%s`, `%s`, `%s`) values ",
+			qryDatabase,
+			indexDef.IndexTableName,
+			catalog.SystemSI_HNSW_TblCol_Storage_version,
+			catalog.SystemSI_HNSW_TblCol_Storage_id,
+			catalog.SystemSI_HNSW_TblCol_Storage_data,
+		))
+		for i := chunk; i < chunk+chunksPerInsert && i*hnsw.ChunkSize < len(data); i++ {
+			if i > chunk {
+				insertSQL.WriteString(", ")
+			}
+			end := min((i+1)*hnsw.ChunkSize, len(data))
+			insertSQL.WriteString(fmt.Sprintf("(%d, %d, unhex('%s'))", version, i, hex.EncodeToString(data[i*hnsw.ChunkSize:end])))
+		}
+		if err = c.runSql(insertSQL.String()); err != nil {
+			return err
+		}
+	}
+
+	// 3. prune the graphs of older versions and record the snapshot the graph was built from
+	pruneSQL := fmt.Sprintf("DELETE FROM `%s`.`%s` WHERE `%s` < %d;",
+		qryDatabase,
+		indexDef.IndexTableName,
+		catalog.SystemSI_HNSW_TblCol_Storage_version,
+		version,
+	)
+	if err = c.runSql(pruneSQL); err != nil {
+		return err
+	}
+
+	return c.runSql(fmt.Sprintf("INSERT INTO `%s`.`%s` (`%s`, `%s`) "+
+		" VALUES ('build_ts', '%s') "+
+		" ON DUPLICATE KEY UPDATE `%s` = '%s';",
+		qryDatabase,
+		metadataTableName,
+		catalog.SystemSI_HNSW_TblCol_Metadata_key,
+		catalog.SystemSI_HNSW_TblCol_Metadata_val,
+		buildTS.ToString(),
+		catalog.SystemSI_HNSW_TblCol_Metadata_val,
+		buildTS.ToString(),
+	))
+}
@@ -19,9 +19,11 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -76,3 +78,30 @@ func Test_lockIndexTable(t *testing.T) {
 		})
 	}
 }
+
+func Test_planDefsToExeDefsGeneratedCol(t *testing.T) {
+	generated := &plan.GeneratedColDef{Name: "b", ExprStr: "a + 1"}
+	tableDef := &plan.TableDef{
+		Cols: []*plan.ColDef{
+			{Name: "a"},
+			// renamed by ALTER TABLE
+			{Name: "c", Generated: generated},
+		},
+	}
+	defs, err := planDefsToExeDefs(tableDef)
+	require.NoError(t, err)
+	var exeGenerated []*plan.GeneratedColDef
+	for _, def := range defs {
+		if c, ok := def.(*engine.ConstraintDef); ok {
+			for _, ct := range c.Cts {
+				if g, ok := ct.(*engine.GeneratedColDef); ok {
+					exeGenerated = g.Cols
+				}
+			}
+		}
+	}
+	require.Equal(t, 1, len(exeGenerated))
+	require.Equal(t, "c", exeGenerated[0].Name)
+	// the shared table definition is not changed
+	require.Equal(t, "b", generated.Name)
+}
@@ -69,6 +69,7 @@ var debugInstructionNames = map[vm.OpType]string{
 	vm.Minus:                   "minus",
 	vm.Intersect:               "intersect",
 	vm.IntersectAll:            "intersect all",
+	vm.MinusAll:                "minus all",
 	vm.UnionAll:                "union all",
 	vm.HashBuild:               "hash build",
 	vm.ShuffleBuild:            "shuffle build",
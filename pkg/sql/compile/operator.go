@@ -17,6 +17,7 @@ package compile
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
@@ -26,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/lock"
 	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
@@ -61,6 +63,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergerecursive"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergetop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/minus"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/minusall"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/multi_update"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/offset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/onduplicatekey"
@@ -356,6 +359,10 @@ func dupOperator(sourceOp vm.Operator, index int, maxParallel int) vm.Operator {
 		op := intersectall.NewArgument()
 		op.SetInfo(&info)
 		return op
+	case vm.MinusAll:
+		op := minusall.NewArgument()
+		op.SetInfo(&info)
+		return op
 	case vm.Merge:
 		t := sourceOp.(*merge.Merge)
 		op := merge.NewArgument()
@@ -750,9 +757,9 @@ func constructLockOp(n *plan.Node, eng engine.Engine) (*lockop.LockOp, error) {
 	for _, target := range n.LockTargets {
 		typ := plan2.MakeTypeByPlan2Type(target.PrimaryColTyp)
 		if target.IsPartitionTable {
-			arg.AddLockTargetWithPartition(target.GetPartitionTableIds(), target.GetPrimaryColIdxInBat(), typ, target.GetRefreshTsIdxInBat(), target.GetLockRows(), target.GetLockTableAtTheEnd(), target.GetFilterColIdxInBat())
+			arg.AddLockTargetWithPartitionAndMode(target.GetPartitionTableIds(), target.GetMode(), target.GetPrimaryColIdxInBat(), typ, target.GetRefreshTsIdxInBat(), target.GetLockRows(), target.GetLockTableAtTheEnd(), target.GetFilterColIdxInBat())
 		} else {
-			arg.AddLockTarget(target.GetTableId(), target.GetPrimaryColIdxInBat(), typ, target.GetRefreshTsIdxInBat(), target.GetLockRows(), target.GetLockTableAtTheEnd())
+			arg.AddLockTargetWithMode(target.GetTableId(), target.GetMode(), target.GetPrimaryColIdxInBat(), typ, target.GetRefreshTsIdxInBat(), target.GetLockRows(), target.GetLockTableAtTheEnd())
 		}
 
 	}
@@ -760,10 +767,20 @@ func constructLockOp(n *plan.Node, eng engine.Engine) (*lockop.LockOp, error) {
 		if target.LockTable {
 			if target.IsPartitionTable {
 				for _, pTblId := range target.PartitionTableIds {
-					arg.LockTable(pTblId, false)
+					arg.LockTableWithMode(pTblId, target.GetMode(), false)
+				}
+			} else {
+				arg.LockTableWithMode(target.TableId, target.GetMode(), false)
+			}
+		}
+		if target.WaitPolicy != lock.WaitPolicy_Wait || target.WaitTimeout > 0 {
+			waitTimeout := time.Duration(target.WaitTimeout)
+			if target.IsPartitionTable {
+				for _, pTblId := range target.PartitionTableIds {
+					arg.SetWaitPolicy(pTblId, target.WaitPolicy, waitTimeout)
 				}
 			} else {
-				arg.LockTable(target.TableId, false)
+				arg.SetWaitPolicy(target.TableId, target.WaitPolicy, waitTimeout)
 			}
 		}
 	}
@@ -1487,6 +1504,14 @@ func constructShuffleArgForGroup(ss []*Scope, node *plan.Node) *shuffle.Shuffle
 	return arg
 }
 
+func constructShuffleArgForSetOp(bucketNum int32, node *plan.Node) *shuffle.Shuffle {
+	arg := shuffle.NewArgument()
+	arg.ShuffleColIdx = node.Stats.HashmapStats.ShuffleColIdx
+	arg.ShuffleType = int32(node.Stats.HashmapStats.ShuffleType)
+	arg.BucketNum = bucketNum
+	return arg
+}
+
 // cross-cn dispath  will send same batch to all register
 func constructDispatch(idx int, target []*Scope, source *Scope, node *plan.Node, left bool) *dispatch.Dispatch {
 	hasRemote, arg := constructDispatchLocalAndRemote(idx, target, source)
@@ -1656,6 +1681,7 @@ func constructHashBuild(op vm.Operator, proc *process.Process, mcpu int32) *hash
 			ret.NeedAllocateSels = true
 		}
 		ret.JoinMapTag = arg.JoinMapTag
+		ret.CanSpill = true
 
 	case vm.Join:
 		arg := op.(*join.InnerJoin)
@@ -1680,6 +1706,7 @@ func constructHashBuild(op vm.Operator, proc *process.Process, mcpu int32) *hash
 			ret.RuntimeFilterSpec = arg.RuntimeFilterSpecs[0]
 		}
 		ret.JoinMapTag = arg.JoinMapTag
+		ret.CanSpill = true
 
 	case vm.Left:
 		arg := op.(*left.LeftJoin)
@@ -1692,6 +1719,7 @@ func constructHashBuild(op vm.Operator, proc *process.Process, mcpu int32) *hash
 			ret.RuntimeFilterSpec = arg.RuntimeFilterSpecs[0]
 		}
 		ret.JoinMapTag = arg.JoinMapTag
+		ret.CanSpill = true
 
 	case vm.Right:
 		arg := op.(*right.RightJoin)
@@ -1704,6 +1732,7 @@ func constructHashBuild(op vm.Operator, proc *process.Process, mcpu int32) *hash
 			ret.RuntimeFilterSpec = arg.RuntimeFilterSpecs[0]
 		}
 		ret.JoinMapTag = arg.JoinMapTag
+		ret.CanSpill = true
 
 	case vm.RightSemi:
 		arg := op.(*rightsemi.RightSemi)
@@ -1716,6 +1745,7 @@ func constructHashBuild(op vm.Operator, proc *process.Process, mcpu int32) *hash
 			ret.RuntimeFilterSpec = arg.RuntimeFilterSpecs[0]
 		}
 		ret.JoinMapTag = arg.JoinMapTag
+		ret.CanSpill = true
 
 	case vm.RightAnti:
 		arg := op.(*rightanti.RightAnti)
@@ -1728,6 +1758,7 @@ func constructHashBuild(op vm.Operator, proc *process.Process, mcpu int32) *hash
 			ret.RuntimeFilterSpec = arg.RuntimeFilterSpecs[0]
 		}
 		ret.JoinMapTag = arg.JoinMapTag
+		ret.CanSpill = true
 
 	case vm.Semi:
 		arg := op.(*semi.SemiJoin)
@@ -1745,6 +1776,7 @@ func constructHashBuild(op vm.Operator, proc *process.Process, mcpu int32) *hash
 			ret.RuntimeFilterSpec = arg.RuntimeFilterSpecs[0]
 		}
 		ret.JoinMapTag = arg.JoinMapTag
+		ret.CanSpill = true
 
 	case vm.Single:
 		arg := op.(*single.SingleJoin)
@@ -1789,6 +1821,7 @@ func constructHashBuild(op vm.Operator, proc *process.Process, mcpu int32) *hash
 			ret.RuntimeFilterSpec = arg.RuntimeFilterSpecs[0]
 		}
 		ret.JoinMapTag = arg.JoinMapTag
+		ret.CanSpill = true
 
 	default:
 		ret.Release()
@@ -1817,6 +1850,7 @@ func constructShuffleBuild(op vm.Operator, proc *process.Process) *shufflebuild.
 			ret.RuntimeFilterSpec = plan2.DeepCopyRuntimeFilterSpec(arg.RuntimeFilterSpecs[0])
 		}
 		ret.JoinMapTag = arg.JoinMapTag
+		ret.CanSpill = true
 		ret.ShuffleIdx = arg.ShuffleIdx
 
 	case vm.Join:
@@ -1841,6 +1875,7 @@ func constructShuffleBuild(op vm.Operator, proc *process.Process) *shufflebuild.
 			ret.RuntimeFilterSpec = plan2.DeepCopyRuntimeFilterSpec(arg.RuntimeFilterSpecs[0])
 		}
 		ret.JoinMapTag = arg.JoinMapTag
+		ret.CanSpill = true
 		ret.ShuffleIdx = arg.ShuffleIdx
 
 	case vm.Left:
@@ -1853,6 +1888,7 @@ func constructShuffleBuild(op vm.Operator, proc *process.Process) *shufflebuild.
 			ret.RuntimeFilterSpec = plan2.DeepCopyRuntimeFilterSpec(arg.RuntimeFilterSpecs[0])
 		}
 		ret.JoinMapTag = arg.JoinMapTag
+		ret.CanSpill = true
 		ret.ShuffleIdx = arg.ShuffleIdx
 
 	case vm.Right:
@@ -1865,6 +1901,7 @@ func constructShuffleBuild(op vm.Operator, proc *process.Process) *shufflebuild.
 			ret.RuntimeFilterSpec = plan2.DeepCopyRuntimeFilterSpec(arg.RuntimeFilterSpecs[0])
 		}
 		ret.JoinMapTag = arg.JoinMapTag
+		ret.CanSpill = true
 		ret.ShuffleIdx = arg.ShuffleIdx
 
 	case vm.RightSemi:
@@ -1877,6 +1914,7 @@ func constructShuffleBuild(op vm.Operator, proc *process.Process) *shufflebuild.
 			ret.RuntimeFilterSpec = plan2.DeepCopyRuntimeFilterSpec(arg.RuntimeFilterSpecs[0])
 		}
 		ret.JoinMapTag = arg.JoinMapTag
+		ret.CanSpill = true
 		ret.ShuffleIdx = arg.ShuffleIdx
 
 	case vm.RightAnti:
@@ -1889,6 +1927,7 @@ func constructShuffleBuild(op vm.Operator, proc *process.Process) *shufflebuild.
 			ret.RuntimeFilterSpec = plan2.DeepCopyRuntimeFilterSpec(arg.RuntimeFilterSpecs[0])
 		}
 		ret.JoinMapTag = arg.JoinMapTag
+		ret.CanSpill = true
 		ret.ShuffleIdx = arg.ShuffleIdx
 
 	case vm.Semi:
@@ -1906,6 +1945,7 @@ func constructShuffleBuild(op vm.Operator, proc *process.Process) *shufflebuild.
 			ret.RuntimeFilterSpec = plan2.DeepCopyRuntimeFilterSpec(arg.RuntimeFilterSpecs[0])
 		}
 		ret.JoinMapTag = arg.JoinMapTag
+		ret.CanSpill = true
 		ret.ShuffleIdx = arg.ShuffleIdx
 
 	case vm.DedupJoin:
@@ -1921,6 +1961,7 @@ func constructShuffleBuild(op vm.Operator, proc *process.Process) *shufflebuild.
 			ret.RuntimeFilterSpec = plan2.DeepCopyRuntimeFilterSpec(arg.RuntimeFilterSpecs[0])
 		}
 		ret.JoinMapTag = arg.JoinMapTag
+		ret.CanSpill = true
 		ret.ShuffleIdx = arg.ShuffleIdx
 
 	default:
@@ -16,6 +16,7 @@ package compile
 
 import (
 	"fmt"
+	"time"
 	"unsafe"
 
 	"github.com/google/uuid"
@@ -54,6 +55,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergerecursive"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergetop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/minus"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/minusall"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/multi_update"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/offset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/onduplicatekey"
@@ -468,6 +470,7 @@ func convertToPipelineInstruction(op vm.Operator, proc *process.Process, ctx *sc
 	case *lockop.LockOp:
 		in.LockOp = &pipeline.LockOp{
 			Targets: t.CopyToPipelineTarget(),
+			Limit:   t.GetLimit(),
 		}
 	case *preinsertunique.PreInsertUnique:
 		in.PreInsertUnique = &pipeline.PreInsertUnique{
@@ -694,6 +697,8 @@ func convertToPipelineInstruction(op vm.Operator, proc *process.Process, ctx *sc
 		in.Anti = &pipeline.AntiJoin{}
 	case *intersectall.IntersectAll:
 		in.Anti = &pipeline.AntiJoin{}
+	case *minusall.MinusAll:
+		in.Anti = &pipeline.AntiJoin{}
 	case *merge.Merge:
 		in.Merge = &pipeline.Merge{
 			SinkScan: t.SinkScan,
@@ -944,13 +949,15 @@ func convertToVmOperator(opr *pipeline.Instruction, ctx *scopeContext, eng engin
 		lockArg := lockop.NewArgumentByEngine(eng)
 		for _, target := range t.Targets {
 			typ := plan2.MakeTypeByPlan2Type(target.PrimaryColTyp)
-			lockArg.AddLockTarget(target.GetTableId(), target.GetPrimaryColIdxInBat(), typ, target.GetRefreshTsIdxInBat(), target.GetLockRows(), target.GetLockTableAtTheEnd())
+			lockArg.AddLockTargetWithMode(target.GetTableId(), target.GetMode(), target.GetPrimaryColIdxInBat(), typ, target.GetRefreshTsIdxInBat(), target.GetLockRows(), target.GetLockTableAtTheEnd())
 		}
 		for _, target := range t.Targets {
 			if target.LockTable {
-				lockArg.LockTable(target.TableId, target.ChangeDef)
+				lockArg.LockTableWithMode(target.TableId, target.GetMode(), target.ChangeDef)
 			}
+			lockArg.SetWaitPolicy(target.TableId, target.GetWaitPolicy(), time.Duration(target.GetWaitTimeout()))
 		}
+		lockArg.SetLimit(t.GetLimit())
 		op = lockArg
 	case vm.PreInsertUnique:
 		t := opr.GetPreInsertUnique()
@@ -1210,9 +1217,11 @@ func convertToVmOperator(opr *pipeline.Instruction, ctx *scopeContext, eng engin
 	case vm.Intersect:
 		op = intersect.NewArgument()
 	case vm.IntersectAll:
-		op = intersect.NewArgument()
+		op = intersectall.NewArgument()
 	case vm.Minus:
 		op = minus.NewArgument()
+	case vm.MinusAll:
+		op = minusall.NewArgument()
 	case vm.Connector:
 		t := opr.GetConnect()
 		op = connector.NewArgument().
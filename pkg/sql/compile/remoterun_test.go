@@ -72,6 +72,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergerecursive"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergetop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/minus"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/minusall"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/offset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/onduplicatekey"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
@@ -228,6 +229,7 @@ func Test_convertToPipelineInstruction(t *testing.T) {
 		&intersect.Intersect{},
 		&minus.Minus{},
 		&intersectall.IntersectAll{},
+		&minusall.MinusAll{},
 		&merge.Merge{},
 		&mergerecursive.MergeRecursive{},
 		&mergegroup.MergeGroup{},
@@ -307,6 +309,7 @@ func Test_convertToVmInstruction(t *testing.T) {
 		{Op: int32(vm.Intersect), Anti: &pipeline.AntiJoin{}},
 		{Op: int32(vm.IntersectAll), Anti: &pipeline.AntiJoin{}},
 		{Op: int32(vm.Minus), Anti: &pipeline.AntiJoin{}},
+		{Op: int32(vm.MinusAll), Anti: &pipeline.AntiJoin{}},
 		{Op: int32(vm.Connector), Connect: &pipeline.Connector{}},
 		{Op: int32(vm.Merge), Merge: &pipeline.Merge{}},
 		{Op: int32(vm.MergeRecursive)},
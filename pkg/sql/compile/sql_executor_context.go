@@ -100,6 +100,10 @@ func (c *compilerContext) CheckTimeStampValid(ts int64) (bool, error) {
 	panic("not supported in internal sql executor")
 }
 
+func (c *compilerContext) CheckTableChangesRetained(dbName, tableName string, ts int64) (bool, error) {
+	panic("not supported in internal sql executor")
+}
+
 func (c *compilerContext) SetQueryingSubscription(meta *plan.SubscriptionMeta) {
 	panic("not supported in internal sql executor")
 }
@@ -458,6 +462,8 @@ func (c *compilerContext) getTableDef(
 	var primarykey *plan.PrimaryKeyDef
 	var indexes []*plan.IndexDef
 	var refChildTbls []uint64
+	var checks []*planpb.CheckDef
+	var generated []*planpb.GeneratedColDef
 	var subscriptionName string
 
 	for _, def := range engineDefs {
@@ -523,6 +529,10 @@ func (c *compilerContext) getTableDef(
 					foreignKeys = k.Fkeys
 				case *engine.RefChildTableDef:
 					refChildTbls = k.Tables
+				case *engine.CheckDef:
+					checks = k.Checks
+				case *engine.GeneratedColDef:
+					generated = k.Cols
 				case *engine.PrimaryKeyDef:
 					primarykey = k.Pkey
 				}
@@ -555,6 +565,7 @@ func (c *compilerContext) getTableDef(
 		})
 	}
 
+	plan.SetGeneratedColDefs(cols, generated)
 	if primarykey != nil && primarykey.PkeyColName == catalog.CPrimaryKeyColName {
 		//cols = append(cols, plan.MakeHiddenColDefByName(catalog.CPrimaryKeyColName))
 		primarykey.CompPkeyCol = plan.GetColDefFromTable(cols, catalog.CPrimaryKeyColName)
@@ -585,6 +596,7 @@ func (c *compilerContext) getTableDef(
 		Partition:    partitionInfo,
 		Fkeys:        foreignKeys,
 		RefChildTbls: refChildTbls,
+		Checks:       checks,
 		ClusterBy:    clusterByDef,
 		Indexes:      indexes,
 		Version:      schemaVersion,
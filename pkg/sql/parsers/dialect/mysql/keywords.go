@@ -28,6 +28,7 @@ func init() {
 		"all":                        ALL,
 		"alter":                      ALTER,
 		"algorithm":                  ALGORITHM,
+		"always":                     ALWAYS,
 		"analyze":                    ANALYZE,
 		"phyplan":                    PHYPLAN,
 		"and":                        AND,
@@ -58,6 +59,7 @@ func init() {
 		"by":                         BY,
 		"btree":                      BTREE,
 		"ivfflat":                    IVFFLAT,
+		"hnsw":                       HNSW,
 		"bit_or":                     BIT_OR,
 		"bit_and":                    BIT_AND,
 		"call":                       CALL,
@@ -87,10 +89,10 @@ func init() {
 		"committed":                  COMMITTED,
 		"commit":                     COMMIT,
 		"compact":                    COMPACT,
-		"condition":                  UNUSED,
+		"condition":                  CONDITION,
 		"constraint":                 CONSTRAINT,
 		"consistent":                 CONSISTENT,
-		"continue":                   UNUSED,
+		"continue":                   CONTINUE,
 		"connection":                 CONNECTION,
 		"connect":                    CONNECT,
 		"convert":                    CONVERT,
@@ -120,7 +122,7 @@ func init() {
 		"current_user":               CURRENT_USER,
 		"current_role":               CURRENT_ROLE,
 		"curtime":                    CURTIME,
-		"cursor":                     UNUSED,
+		"cursor":                     CURSOR,
 		"daemon":                     DAEMON,
 		"database":                   DATABASE,
 		"databases":                  DATABASES,
@@ -173,7 +175,7 @@ func init() {
 		"escape":                     ESCAPE,
 		"escaped":                    ESCAPED,
 		"exists":                     EXISTS,
-		"exit":                       UNUSED,
+		"exit":                       EXIT,
 		"explain":                    EXPLAIN,
 		"expansion":                  EXPANSION,
 		"extended":                   EXTENDED,
@@ -185,7 +187,7 @@ func init() {
 		"events":                     EVENTS,
 		"engines":                    ENGINES,
 		"false":                      FALSE,
-		"fetch":                      UNUSED,
+		"fetch":                      FETCH,
 		"first":                      FIRST,
 		"after":                      AFTER,
 		"float":                      FLOAT_TYPE,
@@ -202,7 +204,7 @@ func init() {
 		"fields":                     FIELDS,
 		"file":                       FILE,
 		"fixed":                      FIXED,
-		"generated":                  UNUSED,
+		"generated":                  GENERATED,
 		"geometry":                   GEOMETRY,
 		"geometrycollection":         GEOMETRYCOLLECTION,
 		"get":                        UNUSED,
@@ -256,6 +258,7 @@ func init() {
 		"kill":                       KILL,
 		"language":                   LANGUAGE,
 		"last":                       LAST,
+		"lateral":                    LATERAL,
 		"leading":                    LEADING,
 		"leave":                      LEAVE,
 		"left":                       LEFT,
@@ -266,6 +269,14 @@ func init() {
 		"list":                       LIST,
 		"lists":                      LISTS,
 		"op_type":                    OP_TYPE,
+		"m":                          M,
+		"ef_construction":            EF_CONSTRUCTION,
+		"ef_search":                  EF_SEARCH,
+		"analyzer":                   ANALYZER,
+		"stopwords":                  STOPWORDS,
+		"scorer":                     SCORER,
+		"bm25_k1":                    BM25_K1,
+		"bm25_b":                     BM25_B,
 		"reindex":                    REINDEX,
 		"limit":                      LIMIT,
 		"linear":                     LINEAR,
@@ -389,7 +400,7 @@ func init() {
 		"replicas":                   REPLICAS,
 		"replication":                REPLICATION,
 		"require":                    REQUIRE,
-		"resignal":                   UNUSED,
+		"resignal":                   RESIGNAL,
 		"restrict":                   RESTRICT,
 		"resume":                     RESUME,
 		"recursive":                  RECURSIVE,
@@ -401,6 +412,7 @@ func init() {
 		"right":                      RIGHT,
 		"rlike":                      REGEXP,
 		"rollback":                   ROLLBACK,
+		"savepoint":                  SAVEPOINT,
 		"role":                       ROLE,
 		"routine":                    ROUTINE,
 		"row":                        ROW,
@@ -422,16 +434,16 @@ func init() {
 		"share":                      SHARE,
 		"show":                       SHOW,
 		"shutdown":                   SHUTDOWN,
-		"signal":                     UNUSED,
+		"signal":                     SIGNAL,
 		"signed":                     SIGNED,
 		"simple":                     SIMPLE,
 		"smallint":                   SMALLINT,
 		"spatial":                    SPATIAL,
 		"specific":                   UNUSED,
 		"sql":                        SQL,
-		"sqlexception":               UNUSED,
-		"sqlstate":                   UNUSED,
-		"sqlwarning":                 UNUSED,
+		"sqlexception":               SQLEXCEPTION,
+		"sqlstate":                   SQLSTATE,
+		"sqlwarning":                 SQLWARNING,
 		"sql_big_result":             SQL_BIG_RESULT,
 		"sql_cache":                  SQL_CACHE,
 		"sql_calc_found_rows":        UNUSED,
@@ -447,7 +459,7 @@ func init() {
 		"stats_auto_recalc":          STATS_AUTO_RECALC,
 		"stats_persistent":           STATS_PERSISTENT,
 		"stats_sample_pages":         STATS_SAMPLE_PAGES,
-		"stored":                     UNUSED,
+		"stored":                     STORED,
 		"storage":                    STORAGE,
 		"stores":                     STORES,
 		"straight_join":              STRAIGHT_JOIN,
@@ -499,7 +511,7 @@ func init() {
 		"varchar":                    VARCHAR,
 		"varcharacter":               UNUSED,
 		"varying":                    UNUSED,
-		"virtual":                    UNUSED,
+		"virtual":                    VIRTUAL,
 		"view":                       VIEW,
 		"visible":                    VISIBLE,
 		"week":                       WEEK,
@@ -514,6 +526,23 @@ func init() {
 		"work":                       WORK,
 		"xor":                        XOR,
 		"x509":                       X509,
+		"xa":                         XA,
+		"xid":                        XID,
+		"one":                        ONE,
+		"phase":                      PHASE,
+		"recover":                    RECOVER,
+		"migrate":                    MIGRATE,
+		"schedule":                   SCHEDULE,
+		"every":                      EVERY,
+		"at":                         AT,
+		"starts":                     STARTS,
+		"ends":                       ENDS,
+		"completion":                 COMPLETION,
+		"preserve":                   PRESERVE,
+		"close":                      CLOSE,
+		"found":                      FOUND,
+		"message_text":               MESSAGE_TEXT,
+		"mysql_errno":                MYSQL_ERRNO,
 		"year":                       YEAR,
 		"zerofill":                   ZEROFILL,
 		"zonemap":                    ZONEMAP,
@@ -573,6 +602,19 @@ func init() {
 		"prepare":                    PREPARE,
 		"deallocate":                 DEALLOCATE,
 		"dense_rank":                 DENSE_RANK,
+		"ntile":                      NTILE,
+		"percent_rank":               PERCENT_RANK,
+		"cume_dist":                  CUME_DIST,
+		"lag":                        LAG,
+		"lead":                       LEAD,
+		"first_value":                FIRST_VALUE,
+		"last_value":                 LAST_VALUE,
+		"nth_value":                  NTH_VALUE,
+		"respect":                    RESPECT,
+		"nowait":                     NOWAIT,
+		"wait":                       WAIT,
+		"skip":                       SKIP,
+		"locked":                     LOCKED,
 		"reset":                      RESET,
 		"intersect":                  INTERSECT,
 		"minus":                      MINUS,
@@ -628,6 +670,9 @@ func init() {
 		"credentials":                CREDENTIALS,
 		"vecf32":                     VECF32,
 		"vecf64":                     VECF64,
+		"vecf16":                     VECF16,
+		"veci8":                      VECI8,
+		"vecbit":                     VECBIT,
 		"backup":                     BACKUP,
 		"filesystem":                 FILESYSTEM,
 		"handler":                    HANDLER,
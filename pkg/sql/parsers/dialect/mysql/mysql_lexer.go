@@ -114,13 +114,19 @@ type Lexer struct {
 	stmts      []tree.Statement
 	paramIndex int
 	lower      int64
+
+	// lastToken is the type of the last scanned token
+	lastToken int
+	// eventBodyPos is where the body of CREATE/ALTER EVENT starts, -1 if it is not marked.
+	eventBodyPos int
 }
 
 func NewLexer(dialectType dialect.DialectType, sql string, lower int64) *Lexer {
 	return &Lexer{
-		scanner:    NewScanner(dialectType, sql),
-		paramIndex: 0,
-		lower:      lower,
+		scanner:      NewScanner(dialectType, sql),
+		paramIndex:   0,
+		lower:        lower,
+		eventBodyPos: -1,
 	}
 }
 
@@ -129,6 +135,8 @@ func (l *Lexer) setScanner(s *Scanner, lower int64) {
 	l.stmts = nil
 	l.paramIndex = 0
 	l.lower = lower
+	l.lastToken = 0
+	l.eventBodyPos = -1
 }
 
 func (l *Lexer) GetParamIndex() int {
@@ -139,6 +147,7 @@ func (l *Lexer) GetParamIndex() int {
 func (l *Lexer) Lex(lval *yySymType) int {
 	typ, str := l.scanner.Scan()
 	l.scanner.LastToken = str
+	l.lastToken = typ
 
 	switch typ {
 	case INTEGRAL:
@@ -151,6 +160,35 @@ func (l *Lexer) Lex(lval *yySymType) int {
 	return typ
 }
 
+// markEventBody marks the start of the body of CREATE/ALTER EVENT. It is called when the DO
+// before the body is reduced, which is done without scanning the lookahead, so the body starts
+// at the current position.
+func (l *Lexer) markEventBody() {
+	l.eventBodyPos = l.scanner.Pos
+}
+
+// eventBodySql returns the original text of the body of CREATE/ALTER EVENT. It must be called
+// when the body is reduced, the body is the last part of the statement, so the last scanned token
+// is either the end of the body or the ';' or EOF that follows it.
+func (l *Lexer) eventBodySql() string {
+	if l.eventBodyPos < 0 {
+		return ""
+	}
+	end := l.scanner.Pos
+	if l.lastToken == ';' || l.lastToken == 0 {
+		end = l.scanner.PrePos
+	}
+	if end > len(l.scanner.buf) {
+		end = len(l.scanner.buf)
+	}
+	if end < l.eventBodyPos {
+		return ""
+	}
+	body := strings.TrimSpace(l.scanner.buf[l.eventBodyPos:end])
+	l.eventBodyPos = -1
+	return body
+}
+
 func (l *Lexer) GetDbOrTblName(origin string) string {
 	if l.lower == 1 {
 		return strings.ToLower(origin)
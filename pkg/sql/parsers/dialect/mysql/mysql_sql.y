@@ -16,7 +16,10 @@
 package mysql
 
 import (
+    "encoding/hex"
     "fmt"
+    "math"
+    "strconv"
     "strings"
 
     "github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -98,6 +101,8 @@ import (
     lengthScaleOpt tree.LengthScaleOpt
     tuple *tree.Tuple
     funcType tree.FuncType
+    nullTreatment tree.NullTreatment
+    fromFirstLast tree.FromFirstLast
 
     columnAttribute tree.ColumnAttribute
     columnAttributes []tree.ColumnAttribute
@@ -130,6 +135,18 @@ import (
     updateExpr *tree.UpdateExpr
     updateExprs tree.UpdateExprs
     completionType tree.CompletionType
+    xid *tree.XID
+    eventName *tree.EventName
+    eventSchedule *tree.EventSchedule
+    eventCompletion tree.EventCompletion
+    eventStatus tree.EventStatus
+    eventComment tree.EventComment
+    alterEvent *tree.AlterEvent
+    conditionValue *tree.ConditionValue
+    conditionValues []*tree.ConditionValue
+    handlerAction tree.HandlerAction
+    signalInfo *tree.SignalInfo
+    signalInfos []*tree.SignalInfo
     varAssignmentExpr *tree.VarAssignmentExpr
     varAssignmentExprs []*tree.VarAssignmentExpr
     setRole *tree.SetRole
@@ -279,6 +296,7 @@ import (
 %token <str> INSTANT INPLACE COPY DISABLE ENABLE UNDEFINED MERGE TEMPTABLE DEFINER INVOKER SQL SECURITY CASCADED
 %token <str> VALUES
 %token <str> NEXT VALUE SHARE MODE
+%token <str> NOWAIT WAIT SKIP LOCKED
 %token <str> SQL_NO_CACHE SQL_CACHE
 %left <str> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE CROSS_L2 APPLY DEDUP
 %nonassoc LOWER_THAN_ON
@@ -318,7 +336,8 @@ import (
 
 // Transaction
 %token <str> BEGIN START TRANSACTION COMMIT ROLLBACK WORK CONSISTENT SNAPSHOT
-%token <str> CHAIN NO RELEASE PRIORITY QUICK
+%token <str> CHAIN NO RELEASE PRIORITY QUICK SAVEPOINT
+%token <str> XA ONE PHASE RECOVER MIGRATE XID
 
 // Type
 %token <str> BIT TINYINT SMALLINT MEDIUMINT INT INTEGER BIGINT INTNUM
@@ -326,7 +345,7 @@ import (
 %token <str> TIME TIMESTAMP DATETIME YEAR
 %token <str> CHAR VARCHAR BOOL CHARACTER VARBINARY NCHAR
 %token <str> TEXT TINYTEXT MEDIUMTEXT LONGTEXT DATALINK
-%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM UUID VECF32 VECF64
+%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM UUID VECF32 VECF64 VECF16 VECI8 VECBIT
 %token <str> GEOMETRY POINT LINESTRING POLYGON GEOMETRYCOLLECTION MULTIPOINT MULTILINESTRING MULTIPOLYGON
 %token <str> INT1 INT2 INT3 INT4 INT8 S3OPTION STAGEOPTION
 
@@ -344,6 +363,7 @@ import (
 %token <str> MAX_ROWS MIN_ROWS PACK_KEYS ROW_FORMAT STATS_AUTO_RECALC STATS_PERSISTENT STATS_SAMPLE_PAGES
 %token <str> DYNAMIC COMPRESSED REDUNDANT COMPACT FIXED COLUMN_FORMAT AUTO_RANDOM ENGINE_ATTRIBUTE SECONDARY_ENGINE_ATTRIBUTE INSERT_METHOD
 %token <str> RESTRICT CASCADE ACTION PARTIAL SIMPLE CHECK ENFORCED
+%token <str> GENERATED ALWAYS STORED VIRTUAL
 %token <str> RANGE LIST ALGORITHM LINEAR PARTITIONS SUBPARTITION SUBPARTITIONS CLUSTER
 %token <str> TYPE ANY SOME EXTERNAL LOCALFILE URL
 %token <str> PREPARE DEALLOCATE RESET
@@ -360,8 +380,9 @@ import (
 %token <str> PROPERTIES
 
 // Secondary Index
-%token <str> PARSER VISIBLE INVISIBLE BTREE HASH RTREE BSI IVFFLAT MASTER
-%token <str> ZONEMAP LEADING BOTH TRAILING UNKNOWN LISTS OP_TYPE REINDEX
+%token <str> PARSER VISIBLE INVISIBLE BTREE HASH RTREE BSI IVFFLAT MASTER HNSW
+%token <str> ZONEMAP LEADING BOTH TRAILING UNKNOWN LISTS OP_TYPE REINDEX M EF_CONSTRUCTION EF_SEARCH
+%token <str> ANALYZER STOPWORDS SCORER BM25_K1 BM25_B
 
 
 // Alter
@@ -376,6 +397,13 @@ import (
 %token <str> REVOKE FUNCTION PRIVILEGES TABLESPACE EXECUTE SUPER GRANT OPTION REFERENCES REPLICATION
 %token <str> SLAVE CLIENT USAGE RELOAD FILE TEMPORARY ROUTINE EVENT SHUTDOWN
 
+// Event
+%token <str> SCHEDULE EVERY AT STARTS ENDS COMPLETION PRESERVE
+
+// Stored procedure
+%token <str> CURSOR CONTINUE EXIT FETCH CLOSE CONDITION SIGNAL RESIGNAL
+%token <str> SQLSTATE SQLWARNING SQLEXCEPTION FOUND MESSAGE_TEXT MYSQL_ERRNO
+
 // Type Modifiers
 %token <str> NULLX AUTO_INCREMENT APPROXNUM SIGNED UNSIGNED ZEROFILL ENGINES LOW_CARDINALITY AUTOEXTEND_SIZE
 
@@ -421,7 +449,7 @@ import (
 %token <str> SQL_TSI_SECOND SQL_TSI_MINUTE
 
 // With
-%token <str> RECURSIVE CONFIG DRAINER
+%token <str> RECURSIVE CONFIG DRAINER LATERAL
 
 // Source
 %token <str> SOURCE STREAM HEADERS CONNECTOR CONNECTORS DAEMON PAUSE CANCEL TASK RESUME
@@ -440,6 +468,7 @@ import (
 %token <str> STDDEV_POP STDDEV_SAMP SUBDATE SUBSTR SUBSTRING SUM SYSDATE
 %token <str> SYSTEM_USER TRANSLATE TRIM VARIANCE VAR_POP VAR_SAMP AVG RANK ROW_NUMBER
 %token <str> DENSE_RANK BIT_CAST
+%token <str> NTILE PERCENT_RANK CUME_DIST LAG LEAD FIRST_VALUE LAST_VALUE NTH_VALUE RESPECT
 %token <str> BITMAP_BIT_POSITION BITMAP_BUCKET_NUMBER BITMAP_COUNT BITMAP_CONSTRUCT_AGG BITMAP_OR_AGG
 
 // Sequence function
@@ -510,7 +539,7 @@ import (
 %type <statement> show_variables_stmt show_status_stmt show_index_stmt
 %type <statement> show_servers_stmt show_connectors_stmt show_logservice_replicas_stmt show_logservice_stores_stmt show_logservice_settings_stmt
 %type <statement> alter_account_stmt alter_user_stmt alter_view_stmt update_stmt use_stmt update_no_with_stmt alter_database_config_stmt alter_table_stmt rename_stmt
-%type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
+%type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt savepoint_stmt release_savepoint_stmt xa_stmt
 %type <statement> explain_stmt explainable_stmt
 %type <statement> set_stmt set_variable_stmt set_password_stmt set_role_stmt set_default_role_stmt set_transaction_stmt set_connection_id_stmt set_logservice_non_voting_replica_num
 %type <statement> lock_stmt lock_table_stmt unlock_table_stmt
@@ -520,7 +549,13 @@ import (
 %type <statement> prepare_stmt prepareable_stmt deallocate_stmt execute_stmt reset_stmt
 %type <statement> replace_stmt
 %type <statement> do_stmt
-%type <statement> declare_stmt
+%type <statement> declare_stmt declare_cursor_stmt declare_condition_stmt declare_handler_stmt
+%type <statement> open_cursor_stmt fetch_cursor_stmt close_cursor_stmt signal_stmt
+%type <conditionValue> sqlstate_condition condition_value handler_condition signal_condition signal_condition_opt
+%type <conditionValues> handler_condition_list
+%type <handlerAction> handler_action
+%type <signalInfo> signal_info
+%type <signalInfos> signal_info_list signal_info_opt
 %type <statement> values_stmt
 %type <statement> call_stmt
 %type <statement> mo_dump_stmt
@@ -533,7 +568,7 @@ import (
 %type <exportParm> export_data_param_opt
 %type <loadParam> load_param_opt load_param_opt_2
 %type <tailParam> tail_param_opt
-%type <str> json_type_opt
+%type <str> json_type_opt export_format_opt index_option_number
 
 // case statement
 %type <statement> case_stmt
@@ -550,6 +585,14 @@ import (
 %type <statement> loop_stmt iterate_stmt leave_stmt repeat_stmt while_stmt
 %type <statement> create_publication_stmt drop_publication_stmt alter_publication_stmt show_publications_stmt show_subscriptions_stmt
 %type <statement> create_stage_stmt drop_stage_stmt alter_stage_stmt
+%type <statement> create_event_stmt drop_event_stmt alter_event_stmt event_body event_body_opt
+%type <str> event_do
+%type <eventName> event_name event_rename_opt
+%type <eventSchedule> event_schedule
+%type <eventCompletion> event_completion_opt
+%type <eventStatus> event_status_opt
+%type <eventComment> event_comment_opt
+%type <alterEvent> alter_event_on_opt
 %type <statement> create_snapshot_stmt drop_snapshot_stmt
 %type <statement> create_pitr_stmt drop_pitr_stmt show_pitr_stmt alter_pitr_stmt restore_pitr_stmt
 %type <str> urlparams
@@ -639,6 +682,8 @@ import (
 %type <funcExpr> function_call_nonkeyword
 %type <funcExpr> function_call_aggregate
 %type <funcExpr> function_call_window
+%type <nullTreatment> null_treatment_opt
+%type <fromFirstLast> from_first_last_opt
 %type <expr> sample_function_expr
 
 %type <unresolvedName> column_name column_name_unresolved normal_ident
@@ -694,8 +739,11 @@ import (
 %type <updateExpr> update_value
 %type <updateExprs> update_list on_duplicate_key_update_opt
 %type <completionType> completion_type
+%type <xid> xid
+%type <str> xid_string
+%type <item> xid_format_id
 %type <str> password_opt
-%type <boolVal> grant_option_opt enforce enforce_opt
+%type <boolVal> grant_option_opt enforce enforce_opt generated_stored_opt
 
 %type <varAssignmentExpr> var_assignment
 %type <varAssignmentExprs> var_assignment_list
@@ -884,6 +932,13 @@ block_type_stmt:
 |   leave_stmt
 |   normal_stmt
 |   declare_stmt
+|   declare_cursor_stmt
+|   declare_condition_stmt
+|   declare_handler_stmt
+|   open_cursor_stmt
+|   fetch_cursor_stmt
+|   close_cursor_stmt
+|   signal_stmt
     {
         $$ = $1
     }
@@ -1358,6 +1413,14 @@ while_stmt:
             Body: $4,
         }
     }
+|   ident ':' WHILE expression DO stmt_list_return END WHILE
+    {
+        $$ = &tree.WhileStmt{
+            Name: tree.Identifier($1.Compare()),
+            Cond: $4,
+            Body: $6,
+        }
+    }
 |   ident ':' WHILE expression DO stmt_list_return END WHILE ident
     {
         $$ = &tree.WhileStmt{
@@ -1376,6 +1439,14 @@ repeat_stmt:
             Cond: $4,
         }
     }
+|    ident ':' REPEAT stmt_list_return UNTIL expression END REPEAT
+    {
+        $$ = &tree.RepeatStmt{
+            Name: tree.Identifier($1.Compare()),
+            Body: $4,
+            Cond: $6,
+        }
+    }
 |    ident ':' REPEAT stmt_list_return UNTIL expression END REPEAT ident
     {
         $$ = &tree.RepeatStmt{
@@ -1393,6 +1464,13 @@ loop_stmt:
             Body: $2,
         }
     }
+|   ident ':' LOOP stmt_list_return END LOOP
+    {
+        $$ = &tree.LoopStmt{
+            Name: tree.Identifier($1.Compare()),
+            Body: $4,
+        }
+    }
 |   ident ':' LOOP stmt_list_return END LOOP ident
     {
         $$ = &tree.LoopStmt{
@@ -2681,12 +2759,159 @@ transaction_stmt:
     begin_stmt
 |   commit_stmt
 |   rollback_stmt
+|   savepoint_stmt
+|   release_savepoint_stmt
+|   xa_stmt
+
+// the lexer returns SPBEGIN for the BEGIN followed by a xid
+xa_stmt:
+    XA START xid
+    {
+        $$ = &tree.XAStart{Xid: *$3}
+    }
+|   XA SPBEGIN xid
+    {
+        $$ = &tree.XAStart{Xid: *$3}
+    }
+|   XA START xid JOIN
+    {
+        $$ = &tree.XAStart{Xid: *$3, Join: true}
+    }
+|   XA SPBEGIN xid JOIN
+    {
+        $$ = &tree.XAStart{Xid: *$3, Join: true}
+    }
+|   XA START xid RESUME
+    {
+        $$ = &tree.XAStart{Xid: *$3, Resume: true}
+    }
+|   XA SPBEGIN xid RESUME
+    {
+        $$ = &tree.XAStart{Xid: *$3, Resume: true}
+    }
+|   XA END xid
+    {
+        $$ = &tree.XAEnd{Xid: *$3}
+    }
+|   XA END xid SUSPEND
+    {
+        $$ = &tree.XAEnd{Xid: *$3, Suspend: true}
+    }
+|   XA END xid SUSPEND FOR MIGRATE
+    {
+        $$ = &tree.XAEnd{Xid: *$3, Suspend: true, ForMigrate: true}
+    }
+|   XA PREPARE xid
+    {
+        $$ = &tree.XAPrepare{Xid: *$3}
+    }
+|   XA COMMIT xid
+    {
+        $$ = &tree.XACommit{Xid: *$3}
+    }
+|   XA COMMIT xid ONE PHASE
+    {
+        $$ = &tree.XACommit{Xid: *$3, OnePhase: true}
+    }
+|   XA ROLLBACK xid
+    {
+        $$ = &tree.XARollback{Xid: *$3}
+    }
+|   XA RECOVER
+    {
+        $$ = &tree.XARecover{}
+    }
+|   XA RECOVER CONVERT XID
+    {
+        $$ = &tree.XARecover{ConvertXid: true}
+    }
+
+xid:
+    xid_string
+    {
+        $$ = &tree.XID{Gtrid: []byte($1), FormatID: tree.DefaultXAFormatID}
+    }
+|   xid_string ',' xid_string
+    {
+        $$ = &tree.XID{Gtrid: []byte($1), Bqual: []byte($3), FormatID: tree.DefaultXAFormatID}
+    }
+|   xid_string ',' xid_string ',' xid_format_id
+    {
+        $$ = &tree.XID{Gtrid: []byte($1), Bqual: []byte($3), FormatID: $5.(uint64)}
+    }
+
+xid_string:
+    STRING
+|   HEXNUM
+    {
+        v := strings.TrimPrefix(strings.ToLower($1), "0x")
+        if len(v)%2 != 0 {
+            v = "0" + v
+        }
+        b, err := hex.DecodeString(v)
+        if err != nil {
+            yylex.Error(fmt.Sprintf("invalid xid %s", $1))
+            goto ret1
+        }
+        $$ = string(b)
+    }
+
+xid_format_id:
+    INTEGRAL
+    {
+        switch v := $1.(type) {
+        case uint64:
+            $$ = v
+        case int64:
+            $$ = uint64(v)
+        default:
+            yylex.Error("parse integral fail")
+            goto ret1
+        }
+    }
+|   HEXNUM
+    {
+        v, err := strconv.ParseUint(strings.TrimPrefix(strings.ToLower($1), "0x"), 16, 64)
+        if err != nil {
+            yylex.Error(fmt.Sprintf("invalid xid format id %s", $1))
+            goto ret1
+        }
+        $$ = v
+    }
 
 rollback_stmt:
     ROLLBACK completion_type
     {
         $$ = &tree.RollbackTransaction{Type: $2}
     }
+|   ROLLBACK TO ident
+    {
+        $$ = tree.NewRollbackToSavepoint(tree.Identifier($3.Compare()))
+    }
+|   ROLLBACK TO SAVEPOINT ident
+    {
+        $$ = tree.NewRollbackToSavepoint(tree.Identifier($4.Compare()))
+    }
+|   ROLLBACK WORK TO ident
+    {
+        $$ = tree.NewRollbackToSavepoint(tree.Identifier($4.Compare()))
+    }
+|   ROLLBACK WORK TO SAVEPOINT ident
+    {
+        $$ = tree.NewRollbackToSavepoint(tree.Identifier($5.Compare()))
+    }
+
+savepoint_stmt:
+    SAVEPOINT ident
+    {
+        $$ = tree.NewSavepoint(tree.Identifier($2.Compare()))
+    }
+
+release_savepoint_stmt:
+    RELEASE SAVEPOINT ident
+    {
+        $$ = tree.NewReleaseSavepoint(tree.Identifier($3.Compare()))
+    }
 
 commit_stmt:
     COMMIT completion_type
@@ -2999,6 +3224,24 @@ explain_stmt:
     {
         $$ = tree.NewExplainStmt($2, "text")
     }
+|   explain_sym FORMAT '=' explain_foramt_value explainable_stmt
+    {
+        explainStmt := tree.NewExplainStmt($5, $4)
+        optionElem := tree.MakeOptionElem("format", $4)
+        options := tree.MakeOptions(optionElem)
+        explainStmt.Options = options
+        $$ = explainStmt
+    }
+|   explain_sym ANALYZE FORMAT '=' explain_foramt_value explainable_stmt
+    {
+        explainStmt := tree.NewExplainAnalyze($6, $5)
+        optionElem1 := tree.MakeOptionElem("analyze", "NULL")
+        optionElem2 := tree.MakeOptionElem("format", $5)
+        options := tree.MakeOptions(optionElem1)
+        options = append(options, optionElem2)
+        explainStmt.Options = options
+        $$ = explainStmt
+    }
 |   explain_sym VERBOSE explainable_stmt
     {
         explainStmt := tree.NewExplainStmt($3, "text")
@@ -3109,6 +3352,7 @@ explain_option_key:
 explain_foramt_value:
     JSON
 |   TEXT
+|   ID
 
 
 prepare_sym:
@@ -3212,6 +3456,7 @@ alter_stmt:
 |   alter_stage_stmt
 |   alter_sequence_stmt
 |   alter_pitr_stmt
+|   alter_event_stmt
 |   rename_stmt
 // |    alter_ddl_stmt
 
@@ -3660,6 +3905,12 @@ alter_table_drop:
                 Name: tree.Identifier($2.Compare()),
             }
         }
+|   CHECK ident
+    {
+        var dropType = tree.AlterTableDropCheck;
+        var name = tree.Identifier($2.Compare());
+        $$ = tree.NewAlterOptionDrop(dropType, name);
+    }
 |   PRIMARY KEY
     {
         var dropType = tree.AlterTableDropPrimaryKey;
@@ -3686,17 +3937,27 @@ alter_table_alter:
         var name = tree.Identifier($2.Compare())
         $$ = tree.NewAlterOptionAlterReIndex(name, keyType, algoParamList)
     }
+|   REINDEX ident HNSW
+    {
+        var keyType = tree.INDEX_TYPE_HNSW
+        var name = tree.Identifier($2.Compare())
+        $$ = tree.NewAlterOptionAlterReIndex(name, keyType, 0)
+    }
 |   CHECK ident enforce
     {
         var checkType = $1
         var enforce = $3
-        $$ = tree.NewAlterOptionAlterCheck(checkType, enforce)
+        opt := tree.NewAlterOptionAlterCheck(checkType, enforce)
+        opt.Name = tree.Identifier($2.Compare())
+        $$ = opt
     }
 |   CONSTRAINT ident enforce
     {
         var checkType = $1
         var enforce = $3
-        $$ = tree.NewAlterOptionAlterCheck(checkType, enforce)
+        opt := tree.NewAlterOptionAlterCheck(checkType, enforce)
+        opt.Name = tree.Identifier($2.Compare())
+        $$ = opt
     }
 
 visibility:
@@ -4525,6 +4786,7 @@ drop_ddl_stmt:
 |   drop_snapshot_stmt
 |   drop_pitr_stmt
 |   drop_cdc_stmt
+|   drop_event_stmt
 
 drop_sequence_stmt:
     DROP SEQUENCE exists_opt table_name_list
@@ -5042,7 +5304,7 @@ export_data_param_opt:
     {
         $$ = nil
     }
-|   INTO OUTFILE STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt
+|   INTO OUTFILE STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt export_format_opt
     {
         $$ = &tree.ExportParam{
             Outfile:    true,
@@ -5052,6 +5314,7 @@ export_data_param_opt:
             Header:     $6,
             MaxFileSize:uint64($7)*1024,
             ForceQuote: $8,
+            FileFormat: $9,
         }
     }
 
@@ -5175,6 +5438,20 @@ force_quote_opt:
     }
 
 
+export_format_opt:
+    {
+        $$ = tree.CSV
+    }
+|   FORMAT STRING
+    {
+        str := strings.ToLower($2)
+        if str != tree.CSV && str != tree.JSONLINE && str != tree.PARQUET {
+            yylex.Error("error export format")
+            goto ret1
+        }
+        $$ = str
+    }
+
 force_quote_list:
     ident
     {
@@ -5446,6 +5723,50 @@ select_lock_opt:
             LockType:tree.SelectLockForUpdate,
         }
     }
+|   FOR UPDATE NOWAIT
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForUpdateNoWait,
+        }
+    }
+|   FOR UPDATE WAIT INTEGRAL
+    {
+        var waitSec uint64
+        switch v := $4.(type) {
+        case int64:
+            waitSec = uint64(v)
+        case uint64:
+            waitSec = v
+        }
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForUpdateWaitN,
+            WaitSec: waitSec,
+        }
+    }
+|   FOR UPDATE SKIP LOCKED
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForUpdateSkipLocked,
+        }
+    }
+|   FOR SHARE
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForShare,
+        }
+    }
+|   FOR SHARE NOWAIT
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForShareNoWait,
+        }
+    }
+|   FOR SHARE SKIP LOCKED
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForShareSkipLocked,
+        }
+    }
 
 select_with_parens:
     '(' select_no_parens ')'
@@ -6038,6 +6359,27 @@ table_factor:
             },
         }
     }
+|   LATERAL table_subquery as_opt_id column_list_opt
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $2,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($3),
+                Cols: $4,
+            },
+            Lateral: true,
+        }
+    }
+|   LATERAL table_function as_opt_id
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $2,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($3),
+            },
+            Lateral: true,
+        }
+    }
 |   table_function as_opt_id
     {
         if $2 != "" {
@@ -6236,6 +6578,7 @@ create_stmt:
 |   create_snapshot_stmt
 |   create_pitr_stmt
 |   create_cdc_stmt
+|   create_event_stmt
 
 create_ddl_stmt:
     create_table_stmt
@@ -6953,84 +7296,241 @@ drop_publication_stmt:
         $$ = tree.NewDropPublication(ifExists, name)
     }
 
-drop_stage_stmt:
-    DROP STAGE exists_opt ident
+create_event_stmt:
+    CREATE EVENT not_exists_opt event_name ON SCHEDULE event_schedule event_completion_opt event_status_opt event_comment_opt event_do event_body
     {
-        var ifNotExists = $3
-        var name = tree.Identifier($4.Compare())
-        $$ = tree.NewDropStage(ifNotExists, name)
+        $$ = &tree.CreateEvent{
+            IfNotExists: $3,
+            Name: $4,
+            Schedule: $7,
+            Completion: $8,
+            Status: $9,
+            Comment: $10,
+            Body: $12,
+            BodySql: yylex.(*Lexer).eventBodySql(),
+        }
     }
 
-drop_snapshot_stmt:
-   DROP SNAPSHOT exists_opt ident
-   {
-        var ifExists = $3
-        var name = tree.Identifier($4.Compare())
-        $$ = tree.NewDropSnapShot(ifExists, name)
+alter_event_stmt:
+    ALTER EVENT event_name alter_event_on_opt event_rename_opt event_status_opt event_comment_opt event_body_opt
+    {
+        alter := $4
+        alter.Name = $3
+        alter.Rename = $5
+        alter.Status = $6
+        alter.Comment = $7
+        if $8 != nil {
+            alter.Body = $8
+            alter.BodySql = yylex.(*Lexer).eventBodySql()
+        }
+        $$ = alter
     }
 
-drop_pitr_stmt:
-   DROP PITR exists_opt ident
-   {
-       var ifExists = $3
-       var name = tree.Identifier($4.Compare())
-       $$ = tree.NewDropPitr(ifExists, name)
-   }
+drop_event_stmt:
+    DROP EVENT exists_opt event_name
+    {
+        $$ = &tree.DropEvent{
+            IfExists: $3,
+            Name: $4,
+        }
+    }
 
-account_role_name:
+event_name:
     ident
     {
-   		$$ = $1.Compare()
+        prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+        $$ = tree.NewEventName(tree.Identifier($1.Compare()), prefix)
+    }
+|   ident '.' ident
+    {
+        dbName := yylex.(*Lexer).GetDbOrTblName($1.Origin())
+        prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(dbName), ExplicitSchema: true}
+        $$ = tree.NewEventName(tree.Identifier($3.Compare()), prefix)
     }
 
-user_comment_or_attribute_opt:
+event_schedule:
+    AT expression
     {
-        var Exist = false
-        var IsComment bool
-        var Str string
-        $$ = *tree.NewAccountCommentOrAttribute(
-            Exist,
-            IsComment,
-            Str,
-        )
+        $$ = &tree.EventSchedule{At: $2}
+    }
+|   EVERY expression time_unit
+    {
+        $$ = &tree.EventSchedule{Every: $2, Unit: $3}
+    }
+|   EVERY expression time_unit STARTS expression
+    {
+        $$ = &tree.EventSchedule{Every: $2, Unit: $3, Starts: $5}
+    }
+|   EVERY expression time_unit ENDS expression
+    {
+        $$ = &tree.EventSchedule{Every: $2, Unit: $3, Ends: $5}
+    }
+|   EVERY expression time_unit STARTS expression ENDS expression
+    {
+        $$ = &tree.EventSchedule{Every: $2, Unit: $3, Starts: $5, Ends: $7}
+    }
 
+event_completion_opt:
+    {
+        $$ = tree.EventCompletionUnset
     }
-|   COMMENT_KEYWORD STRING
+|   ON COMPLETION PRESERVE
     {
-        var Exist = true
-        var IsComment = true
-        var Str = $2
-        $$ = *tree.NewAccountCommentOrAttribute(
-            Exist,
-            IsComment,
-            Str,
-        )
+        $$ = tree.EventCompletionPreserve
     }
-|   ATTRIBUTE STRING
+|   ON COMPLETION NOT PRESERVE
     {
-        var Exist = true
-        var IsComment = false
-        var Str = $2
-        $$ = *tree.NewAccountCommentOrAttribute(
-            Exist,
-            IsComment,
-            Str,
-        )
+        $$ = tree.EventCompletionNotPreserve
     }
 
-//conn_options:
-//    {
-//        $$ = nil
-//    }
-//|   WITH conn_option_list
-//    {
-//        $$ = $2
-//    }
-//
-//conn_option_list:
-//    conn_option
-//    {
-//        $$ = []tree.ResourceOption{$1}
+// ON SCHEDULE and ON COMPLETION of ALTER EVENT are both optional, so they are
+// parsed together to avoid the conflict on ON.
+alter_event_on_opt:
+    {
+        $$ = &tree.AlterEvent{}
+    }
+|   ON SCHEDULE event_schedule event_completion_opt
+    {
+        $$ = &tree.AlterEvent{Schedule: $3, Completion: $4}
+    }
+|   ON COMPLETION PRESERVE
+    {
+        $$ = &tree.AlterEvent{Completion: tree.EventCompletionPreserve}
+    }
+|   ON COMPLETION NOT PRESERVE
+    {
+        $$ = &tree.AlterEvent{Completion: tree.EventCompletionNotPreserve}
+    }
+
+event_rename_opt:
+    {
+        $$ = nil
+    }
+|   RENAME TO event_name
+    {
+        $$ = $3
+    }
+
+event_status_opt:
+    {
+        $$ = tree.EventStatusUnset
+    }
+|   ENABLE
+    {
+        $$ = tree.EventStatusEnable
+    }
+|   DISABLE
+    {
+        $$ = tree.EventStatusDisable
+    }
+
+event_comment_opt:
+    {
+        $$ = tree.EventComment{}
+    }
+|   COMMENT_KEYWORD STRING
+    {
+        $$ = tree.EventComment{Exist: true, Comment: $2}
+    }
+
+event_body_opt:
+    {
+        $$ = nil
+    }
+|   event_do event_body
+    {
+        $$ = $2
+    }
+
+// event_do marks where the body starts, the body is saved as it is written.
+event_do:
+    DO
+    {
+        yylex.(*Lexer).markEventBody()
+        $$ = $1
+    }
+
+event_body:
+    normal_stmt
+
+drop_stage_stmt:
+    DROP STAGE exists_opt ident
+    {
+        var ifNotExists = $3
+        var name = tree.Identifier($4.Compare())
+        $$ = tree.NewDropStage(ifNotExists, name)
+    }
+
+drop_snapshot_stmt:
+   DROP SNAPSHOT exists_opt ident
+   {
+        var ifExists = $3
+        var name = tree.Identifier($4.Compare())
+        $$ = tree.NewDropSnapShot(ifExists, name)
+    }
+
+drop_pitr_stmt:
+   DROP PITR exists_opt ident
+   {
+       var ifExists = $3
+       var name = tree.Identifier($4.Compare())
+       $$ = tree.NewDropPitr(ifExists, name)
+   }
+
+account_role_name:
+    ident
+    {
+   		$$ = $1.Compare()
+    }
+
+user_comment_or_attribute_opt:
+    {
+        var Exist = false
+        var IsComment bool
+        var Str string
+        $$ = *tree.NewAccountCommentOrAttribute(
+            Exist,
+            IsComment,
+            Str,
+        )
+
+    }
+|   COMMENT_KEYWORD STRING
+    {
+        var Exist = true
+        var IsComment = true
+        var Str = $2
+        $$ = *tree.NewAccountCommentOrAttribute(
+            Exist,
+            IsComment,
+            Str,
+        )
+    }
+|   ATTRIBUTE STRING
+    {
+        var Exist = true
+        var IsComment = false
+        var Str = $2
+        $$ = *tree.NewAccountCommentOrAttribute(
+            Exist,
+            IsComment,
+            Str,
+        )
+    }
+
+//conn_options:
+//    {
+//        $$ = nil
+//    }
+//|   WITH conn_option_list
+//    {
+//        $$ = $2
+//    }
+//
+//conn_option_list:
+//    conn_option
+//    {
+//        $$ = []tree.ResourceOption{$1}
 //    }
 //|   conn_option_list conn_option
 //    {
@@ -7329,6 +7829,22 @@ index_option_list:
 	      opt1.AlgoParamList = opt2.AlgoParamList
 	    } else if len(opt2.AlgoParamVectorOpType) > 0 {
 	      opt1.AlgoParamVectorOpType = opt2.AlgoParamVectorOpType
+	    } else if opt2.AlgoParamM > 0 {
+	      opt1.AlgoParamM = opt2.AlgoParamM
+	    } else if opt2.AlgoParamEfConstruction > 0 {
+	      opt1.AlgoParamEfConstruction = opt2.AlgoParamEfConstruction
+	    } else if opt2.AlgoParamEfSearch > 0 {
+	      opt1.AlgoParamEfSearch = opt2.AlgoParamEfSearch
+	    } else if len(opt2.AlgoParamAnalyzer) > 0 {
+	      opt1.AlgoParamAnalyzer = opt2.AlgoParamAnalyzer
+	    } else if len(opt2.AlgoParamStopwords) > 0 {
+	      opt1.AlgoParamStopwords = opt2.AlgoParamStopwords
+	    } else if len(opt2.AlgoParamScorer) > 0 {
+	      opt1.AlgoParamScorer = opt2.AlgoParamScorer
+	    } else if len(opt2.AlgoParamBM25K1) > 0 {
+	      opt1.AlgoParamBM25K1 = opt2.AlgoParamBM25K1
+	    } else if len(opt2.AlgoParamBM25B) > 0 {
+	      opt1.AlgoParamBM25B = opt2.AlgoParamBM25B
 	    }
             $$ = opt1
         }
@@ -7359,6 +7875,72 @@ index_option:
         io.AlgoParamVectorOpType = $2
         $$ = io
     }
+|   M equal_opt INTEGRAL
+    {
+    	val := int64($3.(int64))
+    	if val <= 1 {
+    		yylex.Error("M should be greater than 1")
+    		return 1
+    	}
+
+	io := tree.NewIndexOption()
+	io.AlgoParamM = val
+	$$ = io
+    }
+|   EF_CONSTRUCTION equal_opt INTEGRAL
+    {
+    	val := int64($3.(int64))
+    	if val <= 0 {
+    		yylex.Error("EF_CONSTRUCTION should be greater than 0")
+    		return 1
+    	}
+
+	io := tree.NewIndexOption()
+	io.AlgoParamEfConstruction = val
+	$$ = io
+    }
+|   EF_SEARCH equal_opt INTEGRAL
+    {
+    	val := int64($3.(int64))
+    	if val <= 0 {
+    		yylex.Error("EF_SEARCH should be greater than 0")
+    		return 1
+    	}
+
+	io := tree.NewIndexOption()
+	io.AlgoParamEfSearch = val
+	$$ = io
+    }
+|   ANALYZER STRING
+    {
+        io := tree.NewIndexOption()
+        io.AlgoParamAnalyzer = $2
+        $$ = io
+    }
+|   STOPWORDS STRING
+    {
+        io := tree.NewIndexOption()
+        io.AlgoParamStopwords = $2
+        $$ = io
+    }
+|   SCORER STRING
+    {
+        io := tree.NewIndexOption()
+        io.AlgoParamScorer = $2
+        $$ = io
+    }
+|   BM25_K1 equal_opt index_option_number
+    {
+        io := tree.NewIndexOption()
+        io.AlgoParamBM25K1 = $3
+        $$ = io
+    }
+|   BM25_B equal_opt index_option_number
+    {
+        io := tree.NewIndexOption()
+        io.AlgoParamBM25B = $3
+        $$ = io
+    }
 |   COMMENT_KEYWORD STRING
     {
         io := tree.NewIndexOption()
@@ -7384,6 +7966,16 @@ index_option:
         $$ = io
     }
 
+index_option_number:
+    INTEGRAL
+    {
+        $$ = fmt.Sprintf("%v", $1)
+    }
+|   FLOAT
+    {
+        $$ = fmt.Sprintf("%v", $1)
+    }
+
 index_column_list:
     index_column
     {
@@ -7435,6 +8027,10 @@ using_opt:
     {
 	$$ = tree.INDEX_TYPE_IVFFLAT
     }
+|   USING HNSW
+    {
+	$$ = tree.INDEX_TYPE_HNSW
+    }
 |   USING MASTER
     {
 	$$ = tree.INDEX_TYPE_MASTER
@@ -8807,6 +9403,8 @@ index_def:
             	keyTyp = tree.INDEX_TYPE_BTREE
 	    case "ivfflat":
 		keyTyp = tree.INDEX_TYPE_IVFFLAT
+	    case "hnsw":
+		keyTyp = tree.INDEX_TYPE_HNSW
 	    case "master":
 	    	keyTyp = tree.INDEX_TYPE_MASTER
             case "hash":
@@ -8846,6 +9444,8 @@ index_def:
 		keyTyp = tree.INDEX_TYPE_BTREE
 	     case "ivfflat":
 		keyTyp = tree.INDEX_TYPE_IVFFLAT
+	     case "hnsw":
+		keyTyp = tree.INDEX_TYPE_HNSW
 	     case "master":
         	keyTyp = tree.INDEX_TYPE_MASTER
 	     case "hash":
@@ -8886,6 +9486,8 @@ constaint_def:
                 v.ConstraintSymbol = $1
             case *tree.UniqueIndex:
                 v.ConstraintSymbol = $1
+            case *tree.CheckIndex:
+                v.Name = $1
             }
         }
         $$ = $2
@@ -8975,7 +9577,7 @@ constraint_elem:
 
 enforce_opt:
     {
-        $$ = false
+        $$ = true
     }
 |    enforce
 
@@ -9018,6 +9620,7 @@ index_type:
 |   RTREE
 |   ZONEMAP
 |   IVFFLAT
+|   HNSW
 |   MASTER
 |   BSI
 
@@ -9174,7 +9777,7 @@ column_attribute_elem:
     }
 |   constraint_keyword_opt CHECK '(' expression ')'
     {
-        $$ = tree.NewAttributeCheckConstraint($4, false, $1)
+        $$ = tree.NewAttributeCheckConstraint($4, true, $1)
     }
 |   constraint_keyword_opt CHECK '(' expression ')' enforce
     {
@@ -9218,6 +9821,28 @@ column_attribute_elem:
 	{
 		$$ = tree.NewAttributeHeaders()
 	}
+|   generated_always_opt AS '(' expression ')' generated_stored_opt
+    {
+        $$ = tree.NewAttributeGeneratedAlways($4, $6)
+    }
+
+generated_always_opt:
+    {}
+|   GENERATED ALWAYS
+    {}
+
+generated_stored_opt:
+    {
+        $$ = false
+    }
+|   VIRTUAL
+    {
+        $$ = false
+    }
+|   STORED
+    {
+        $$ = true
+    }
 
 enforce:
     ENFORCED
@@ -9665,6 +10290,116 @@ function_call_window:
             WindowSpec: $4,
         }
     }
+|	PERCENT_RANK '(' ')' window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            WindowSpec: $4,
+        }
+    }
+|	CUME_DIST '(' ')' window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            WindowSpec: $4,
+        }
+    }
+|	NTILE '(' expression ')' window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: tree.Exprs{$3},
+            WindowSpec: $5,
+        }
+    }
+|	LAG '(' expression_list ')' null_treatment_opt window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: $3,
+            NullTreatment: $5,
+            WindowSpec: $6,
+        }
+    }
+|	LEAD '(' expression_list ')' null_treatment_opt window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: $3,
+            NullTreatment: $5,
+            WindowSpec: $6,
+        }
+    }
+|	FIRST_VALUE '(' expression ')' null_treatment_opt window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: tree.Exprs{$3},
+            NullTreatment: $5,
+            WindowSpec: $6,
+        }
+    }
+|	LAST_VALUE '(' expression ')' null_treatment_opt window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: tree.Exprs{$3},
+            NullTreatment: $5,
+            WindowSpec: $6,
+        }
+    }
+|	NTH_VALUE '(' expression ',' expression ')' from_first_last_opt null_treatment_opt window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: tree.Exprs{$3, $5},
+            FromFirstLast: $7,
+            NullTreatment: $8,
+            WindowSpec: $9,
+        }
+    }
+
+null_treatment_opt:
+    {
+        $$ = tree.NULL_TREATMENT_DEFAULT
+    }
+|   RESPECT NULLS
+    {
+        $$ = tree.NULL_TREATMENT_RESPECT
+    }
+|   IGNORE NULLS
+    {
+        $$ = tree.NULL_TREATMENT_IGNORE
+    }
+
+from_first_last_opt:
+    {
+        $$ = tree.FROM_FIRST_LAST_DEFAULT
+    }
+|   FROM FIRST
+    {
+        $$ = tree.FROM_FIRST
+    }
+|   FROM LAST
+    {
+        $$ = tree.FROM_LAST
+    }
 
 sample_function_expr:
     SAMPLE '(' '*' ',' INTEGRAL ROWS ')'
@@ -11864,6 +12599,45 @@ char_type:
             },
         }
     }
+|   VECF16 length_option_opt
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+                Family: tree.ArrayFamily,
+                Locale: &locale,
+                FamilyString: $1,
+                DisplayWith: $2,
+                Oid:uint32(defines.MYSQL_TYPE_VARCHAR),
+            },
+        }
+    }
+|   VECI8 length_option_opt
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+                Family: tree.ArrayFamily,
+                Locale: &locale,
+                FamilyString: $1,
+                DisplayWith: $2,
+                Oid:uint32(defines.MYSQL_TYPE_VARCHAR),
+            },
+        }
+    }
+|   VECBIT length_option_opt
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+                Family: tree.ArrayFamily,
+                Locale: &locale,
+                FamilyString: $1,
+                DisplayWith: $2,
+                Oid:uint32(defines.MYSQL_TYPE_VARCHAR),
+            },
+        }
+    }
 | ENUM '(' enum_values ')'
     {
         locale := ""
@@ -11931,6 +12705,220 @@ declare_stmt:
         }
     }
 
+declare_cursor_stmt:
+    DECLARE ident CURSOR FOR select_stmt
+    {
+        $$ = &tree.DeclareCursor{
+            Name: tree.Identifier($2.Compare()),
+            Select: $5,
+        }
+    }
+
+declare_condition_stmt:
+    DECLARE ident CONDITION FOR condition_value
+    {
+        $$ = &tree.DeclareCondition{
+            Name: tree.Identifier($2.Compare()),
+            Value: $5,
+        }
+    }
+
+declare_handler_stmt:
+    DECLARE handler_action HANDLER FOR handler_condition_list block_type_stmt
+    {
+        $$ = &tree.DeclareHandler{
+            Action: $2,
+            Conditions: $5,
+            Body: $6,
+        }
+    }
+
+handler_action:
+    CONTINUE
+    {
+        $$ = tree.HANDLER_CONTINUE
+    }
+|   EXIT
+    {
+        $$ = tree.HANDLER_EXIT
+    }
+
+handler_condition_list:
+    handler_condition
+    {
+        $$ = []*tree.ConditionValue{$1}
+    }
+|   handler_condition_list ',' handler_condition
+    {
+        $$ = append($1, $3)
+    }
+
+handler_condition:
+    condition_value
+|   ident
+    {
+        $$ = &tree.ConditionValue{
+            Type: tree.CONDITION_NAME,
+            Name: tree.Identifier($1.Compare()),
+        }
+    }
+|   SQLWARNING
+    {
+        $$ = &tree.ConditionValue{Type: tree.CONDITION_SQLWARNING}
+    }
+|   NOT FOUND
+    {
+        $$ = &tree.ConditionValue{Type: tree.CONDITION_NOT_FOUND}
+    }
+|   SQLEXCEPTION
+    {
+        $$ = &tree.ConditionValue{Type: tree.CONDITION_SQLEXCEPTION}
+    }
+
+condition_value:
+    sqlstate_condition
+|   INTEGRAL
+    {
+        var errno uint64
+        switch v := $1.(type) {
+        case uint64:
+            errno = v
+        case int64:
+            errno = uint64(v)
+        }
+        if errno == 0 || errno > math.MaxUint16 {
+            yylex.Error("invalid condition value")
+            goto ret1
+        }
+        $$ = &tree.ConditionValue{
+            Type: tree.CONDITION_ERRNO,
+            Errno: uint16(errno),
+        }
+    }
+
+sqlstate_condition:
+    SQLSTATE STRING
+    {
+        $$ = &tree.ConditionValue{
+            Type: tree.CONDITION_SQLSTATE,
+            SqlState: $2,
+        }
+    }
+|   SQLSTATE VALUE STRING
+    {
+        $$ = &tree.ConditionValue{
+            Type: tree.CONDITION_SQLSTATE,
+            SqlState: $3,
+        }
+    }
+
+open_cursor_stmt:
+    OPEN ident
+    {
+        $$ = &tree.OpenCursor{
+            Name: tree.Identifier($2.Compare()),
+        }
+    }
+
+fetch_cursor_stmt:
+    FETCH ident INTO var_name_list
+    {
+        $$ = &tree.FetchCursor{
+            Name: tree.Identifier($2.Compare()),
+            Variables: $4,
+        }
+    }
+|   FETCH FROM ident INTO var_name_list
+    {
+        $$ = &tree.FetchCursor{
+            Name: tree.Identifier($3.Compare()),
+            Variables: $5,
+        }
+    }
+|   FETCH NEXT FROM ident INTO var_name_list
+    {
+        $$ = &tree.FetchCursor{
+            Name: tree.Identifier($4.Compare()),
+            Variables: $6,
+        }
+    }
+
+close_cursor_stmt:
+    CLOSE ident
+    {
+        $$ = &tree.CloseCursor{
+            Name: tree.Identifier($2.Compare()),
+        }
+    }
+
+signal_stmt:
+    SIGNAL signal_condition signal_info_opt
+    {
+        $$ = &tree.Signal{
+            Condition: $2,
+            Infos: $3,
+        }
+    }
+|   RESIGNAL signal_condition_opt signal_info_opt
+    {
+        $$ = &tree.Signal{
+            Resignal: true,
+            Condition: $2,
+            Infos: $3,
+        }
+    }
+
+signal_condition_opt:
+    {
+        $$ = nil
+    }
+|   signal_condition
+
+signal_condition:
+    sqlstate_condition
+|   ident
+    {
+        $$ = &tree.ConditionValue{
+            Type: tree.CONDITION_NAME,
+            Name: tree.Identifier($1.Compare()),
+        }
+    }
+
+signal_info_opt:
+    {
+        $$ = nil
+    }
+|   SET signal_info_list
+    {
+        $$ = $2
+    }
+
+signal_info_list:
+    signal_info
+    {
+        $$ = []*tree.SignalInfo{$1}
+    }
+|   signal_info_list ',' signal_info
+    {
+        $$ = append($1, $3)
+    }
+
+signal_info:
+    MESSAGE_TEXT '=' expression
+    {
+        $$ = &tree.SignalInfo{
+            Item: tree.SIGNAL_MESSAGE_TEXT,
+            Value: $3,
+        }
+    }
+|   MYSQL_ERRNO '=' expression
+    {
+        $$ = &tree.SignalInfo{
+            Item: tree.SIGNAL_MYSQL_ERRNO,
+            Value: $3,
+        }
+    }
+
 spatial_type:
     GEOMETRY
     {
@@ -12273,6 +13261,10 @@ equal_opt:
 
 non_reserved_keyword:
     ACCOUNT
+|   CLOSE
+|   FOUND
+|   MESSAGE_TEXT
+|   MYSQL_ERRNO
 |   ACCOUNTS
 |   AGAINST
 |   AVG_ROW_LENGTH
@@ -12329,6 +13321,10 @@ non_reserved_keyword:
 |   ERRORS
 |   ENFORCED
 |	ENABLE
+|   GENERATED
+|   ALWAYS
+|   STORED
+|   VIRTUAL
 |   FORMAT
 |   FLOAT_TYPE
 |   FULL
@@ -12346,9 +13342,20 @@ non_reserved_keyword:
 |   JSON
 |   VECF32
 |   VECF64
+|   VECF16
+|   VECI8
+|   VECBIT
 |   KEY_BLOCK_SIZE
 |   LISTS
 |   OP_TYPE
+|   M
+|   EF_CONSTRUCTION
+|   EF_SEARCH
+|   ANALYZER
+|   STOPWORDS
+|   SCORER
+|   BM25_K1
+|   BM25_B
 |   KEYS
 |   LANGUAGE
 |   LESS
@@ -12412,6 +13419,7 @@ non_reserved_keyword:
 |   ROW_FORMAT
 |   ROLLBACK
 |   RESTRICT
+|   SAVEPOINT
 |   SESSION
 |   SETTINGS
 |   SERIALIZABLE
@@ -12454,6 +13462,19 @@ non_reserved_keyword:
 |   WARNINGS
 |   WORK
 |   X509
+|   XA
+|   XID
+|   ONE
+|   PHASE
+|   RECOVER
+|   MIGRATE
+|   SCHEDULE
+|   EVERY
+|   AT
+|   STARTS
+|   ENDS
+|   COMPLETION
+|   PRESERVE
 |   ZEROFILL
 |   YEAR
 |   TYPE
@@ -12511,6 +13532,7 @@ non_reserved_keyword:
 |	BOOLEAN
 |   BTREE
 |	IVFFLAT
+|	HNSW
 |	MASTER
 |	COALESCE
 |	CONNECT
@@ -12627,6 +13649,19 @@ non_reserved_keyword:
 |   GROUPING
 |   SETS
 |   CUBE
+|   NTILE
+|   PERCENT_RANK
+|   CUME_DIST
+|   LAG
+|   LEAD
+|   FIRST_VALUE
+|   LAST_VALUE
+|   NTH_VALUE
+|   RESPECT
+|   NOWAIT
+|   WAIT
+|   SKIP
+|   LOCKED
 
 
 func_not_keyword:
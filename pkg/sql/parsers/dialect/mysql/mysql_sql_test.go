@@ -121,6 +121,9 @@ var (
 	}, {
 		input:  "alter table t1 alter reindex idx1 IVFFLAT lists = 5",
 		output: "alter table t1 alter reindex idx1 ivfflat lists = 5",
+	}, {
+		input:  "alter table t1 alter reindex idx1 HNSW",
+		output: "alter table t1 alter reindex idx1 hnsw",
 	}, {
 		input:  "create connector for s with (\"type\"='kafka', \"topic\"= 'user', \"partition\" = '1', \"value\"= 'json', \"bootstrap.servers\" = '127.0.0.1:62610');",
 		output: "create connector for s with (type = kafka, topic = user, partition = 1, value = json, bootstrap.servers = 127.0.0.1:62610)",
@@ -151,6 +154,21 @@ var (
 	}, {
 		input:  "select dense_rank() over (partition by col1, col2 order by col3 desc range unbounded preceding) from t1",
 		output: "select dense_rank() over (partition by col1, col2 order by col3 desc range unbounded preceding) from t1",
+	}, {
+		input:  "select ntile(4) over (order by a), percent_rank() over (order by a), cume_dist() over (order by a) from t1",
+		output: "select ntile(4) over (order by a), percent_rank() over (order by a), cume_dist() over (order by a) from t1",
+	}, {
+		input:  "select lag(a) over (order by b), lead(a, 2, 0) ignore nulls over (partition by c order by b) from t1",
+		output: "select lag(a) over (order by b), lead(a, 2, 0) ignore nulls over (partition by c order by b) from t1",
+	}, {
+		input:  "select first_value(a) respect nulls over (), last_value(a) IGNORE NULLS over (order by b rows between unbounded preceding and unbounded following) from t1",
+		output: "select first_value(a) respect nulls over (), last_value(a) ignore nulls over (order by b rows between unbounded preceding and unbounded following) from t1",
+	}, {
+		input:  "select nth_value(a, 2) from first ignore nulls over (order by b) from t1",
+		output: "select nth_value(a, 2) from first ignore nulls over (order by b) from t1",
+	}, {
+		input:  "select lead, lag from t1 where first_value = 1",
+		output: "select lead, lag from t1 where first_value = 1",
 	}, {
 		input:  "select day_key,day_date,day,month,quarter,year,week,day_of_week from bi_date where 1=2;",
 		output: "select day_key, day_date, day, month, quarter, year, week, day_of_week from bi_date where 1 = 2",
@@ -227,6 +245,27 @@ var (
 	}, {
 		input:  "SELECT * FROM kv WHERE k = 1 FOR UPDATE",
 		output: "select * from kv where k = 1 for update",
+	}, {
+		input:  "SELECT * FROM kv WHERE k = 1 FOR UPDATE NOWAIT",
+		output: "select * from kv where k = 1 for update nowait",
+	}, {
+		input:  "SELECT * FROM kv WHERE k = 1 FOR UPDATE WAIT 5",
+		output: "select * from kv where k = 1 for update wait 5",
+	}, {
+		input:  "SELECT * FROM jobs WHERE state = 0 ORDER BY id LIMIT 1 FOR UPDATE SKIP LOCKED",
+		output: "select * from jobs where state = 0 order by id limit 1 for update skip locked",
+	}, {
+		input:  "SELECT * FROM kv WHERE k = 1 FOR SHARE",
+		output: "select * from kv where k = 1 for share",
+	}, {
+		input:  "SELECT * FROM kv WHERE k = 1 FOR SHARE NOWAIT",
+		output: "select * from kv where k = 1 for share nowait",
+	}, {
+		input:  "SELECT * FROM kv WHERE k = 1 FOR SHARE SKIP LOCKED",
+		output: "select * from kv where k = 1 for share skip locked",
+	}, {
+		input:  "select nowait, wait, skip, locked from t",
+		output: "select nowait, wait, skip, locked from t",
 	}, {
 		input: "select a from t1 use index(b)",
 	}, {
@@ -798,6 +837,14 @@ var (
 			output: "create table t (a int) properties(a = b)",
 		}, {
 			input: "create table t (a int, b char, check (1 + 1) enforced)",
+		}, {
+			input:  "create table t (a int, b int, constraint c1 check (a > b), check (b > 0) not enforced)",
+			output: "create table t (a int, b int, constraint c1 check (a > b) enforced, check (b > 0) not enforced)",
+		}, {
+			input:  "create table t (a json, b varchar(10) generated always as (json_unquote(json_extract(a, '$.b'))) stored, c int as (length(b)) not null, key(c))",
+			output: "create table t (a json, b varchar(10) generated always as (json_unquote(json_extract(a, $.b))) stored, c int generated always as (length(b)) virtual not null, index (c))",
+		}, {
+			input: "create table t (stored int, virtual int, generated int, always int)",
 		}, {
 			input: "create table t (a int, b char, foreign key sdf (a, b) references b(a asc, b desc))",
 		}, {
@@ -1370,6 +1417,90 @@ var (
 		}, {
 			input:  "rollback and chain no release",
 			output: "rollback",
+		}, {
+			input: "savepoint sp1",
+		}, {
+			input:  "SAVEPOINT `Sp 1`",
+			output: "savepoint sp 1",
+		}, {
+			input: "rollback to savepoint sp1",
+		}, {
+			input:  "rollback to sp1",
+			output: "rollback to savepoint sp1",
+		}, {
+			input:  "rollback work to savepoint sp1",
+			output: "rollback to savepoint sp1",
+		}, {
+			input:  "rollback work to sp1",
+			output: "rollback to savepoint sp1",
+		}, {
+			input:  "rollback to savepoint",
+			output: "rollback to savepoint savepoint",
+		}, {
+			input: "release savepoint sp1",
+		}, {
+			input:  "release savepoint savepoint",
+			output: "release savepoint savepoint",
+		}, {
+			input:  "create table savepoint (savepoint int)",
+			output: "create table savepoint (savepoint int)",
+		}, {
+			input: "xa start 'xid1'",
+		}, {
+			input:  "XA BEGIN 'xid1', 'b1'",
+			output: "xa start 'xid1', 'b1'",
+		}, {
+			input: "xa start 'xid1', 'b1', 3 join",
+		}, {
+			input: "xa start 'xid1' resume",
+		}, {
+			input:  "xa start 0x677472696431,0x62,0x1",
+			output: "xa start 'gtrid1', 'b'",
+		}, {
+			input:  "xa start X'00ff', '', 0x10",
+			output: "xa start 0x00ff, '', 16",
+		}, {
+			input: "xa end 'xid1'",
+		}, {
+			input: "xa end 'xid1' suspend",
+		}, {
+			input: "xa end 'xid1' suspend for migrate",
+		}, {
+			input: "xa prepare 'xid1'",
+		}, {
+			input: "xa commit 'xid1'",
+		}, {
+			input: "xa commit 'xid1' one phase",
+		}, {
+			input: "xa rollback 'xid1'",
+		}, {
+			input: "xa recover",
+		}, {
+			input: "xa recover convert xid",
+		}, {
+			input:  "create table xa (xid int, one int, phase int, recover int, migrate int)",
+			output: "create table xa (xid int, one int, phase int, recover int, migrate int)",
+		}, {
+			input: "create event e1 on schedule every 1 hour do insert into t1 select count(*) from t2",
+		}, {
+			input:  "CREATE EVENT IF NOT EXISTS db1.e1 ON SCHEDULE EVERY 10 MINUTE STARTS '2024-01-01 00:00:00' ENDS '2025-01-01 00:00:00' ON COMPLETION PRESERVE DISABLE COMMENT 'rollup' DO delete from t1 where a < 10",
+			output: "create event if not exists db1.e1 on schedule every 10 minute starts 2024-01-01 00:00:00 ends 2025-01-01 00:00:00 on completion preserve disable comment 'rollup' do delete from t1 where a < 10",
+		}, {
+			input:  "create event e1 on schedule at current_timestamp + interval 1 day on completion not preserve enable do update t1 set a = 1",
+			output: "create event e1 on schedule at current_timestamp() + interval(1, day) on completion not preserve enable do update t1 set a = 1",
+		}, {
+			input: "alter event e1 on schedule every 2 day",
+		}, {
+			input: "alter event db1.e1 on completion not preserve rename to db1.e2 disable comment 'x' do delete from t1",
+		}, {
+			input: "alter event e1 enable",
+		}, {
+			input: "drop event e1",
+		}, {
+			input: "drop event if exists db1.e1",
+		}, {
+			input:  "create table schedule (every int, at int, starts int, ends int, completion int, preserve int)",
+			output: "create table schedule (every int, at int, starts int, ends int, completion int, preserve int)",
 		}, {
 			input:  "commit and chain no release",
 			output: "commit",
@@ -1408,6 +1539,15 @@ var (
 		}, {
 			input:  "explain (format text) select a from A",
 			output: "explain (format text) select a from a",
+		}, {
+			input:  "explain (format dot) select a from A",
+			output: "explain (format dot) select a from a",
+		}, {
+			input:  "explain format = json select a from A",
+			output: "explain (format json) select a from a",
+		}, {
+			input:  "explain analyze format = dot select a from A",
+			output: "explain (analyze,format dot) select a from a",
 		}, {
 			input:  "explain analyze select * from t",
 			output: "explain (analyze) select * from t",
@@ -1511,6 +1651,21 @@ var (
 		}, {
 			input:  "create index idx using ivfflat on A (a) LISTS 10 op_type 'vector_l2_ops'",
 			output: "create index idx using ivfflat on a (a) LISTS 10 OP_TYPE vector_l2_ops ",
+		}, {
+			input:  "create index idx using hnsw on A (a)",
+			output: "create index idx using hnsw on a (a)",
+		}, {
+			input:  "create index idx using hnsw on A (a) M 16 EF_CONSTRUCTION = 200 ef_search 40 op_type 'vector_cosine_ops'",
+			output: "create index idx using hnsw on a (a) M 16 EF_CONSTRUCTION 200 EF_SEARCH 40 OP_TYPE vector_cosine_ops ",
+		}, {
+			input:  "create table t (id int primary key, m int, v vecf32(3), key idx using hnsw (v) m = 8 op_type 'vector_ip_ops')",
+			output: "create table t (id int primary key, m int, v vecf32(3), index idx using hnsw (v)  M 8 OP_TYPE vector_ip_ops )",
+		}, {
+			input:  "create fulltext index ftidx on t (body) with parser ngram analyzer 'english' stopwords 'a, the' scorer 'bm25' bm25_k1 1.5 bm25_b = 0.5",
+			output: "create fulltext index ftidx on t (body) with parser ngram ANALYZER english STOPWORDS a, the SCORER bm25 BM25_K1 1.5 BM25_B 0.5 ",
+		}, {
+			input:  "create table t (id int primary key, body text, fulltext ftidx (body) scorer 'bm25' bm25_k1 = 2)",
+			output: "create table t (id int primary key, body text, fulltext ftidx (body)  SCORER bm25 BM25_K1 2 )",
 		}, {
 			input: "create index idx1 on a (a)",
 		}, {
@@ -1621,6 +1776,18 @@ var (
 			input: "select * from t intersect all select c from t1",
 		}, {
 			input: "select * from t intersect distinct select c from t1",
+		}, {
+			input:  "select * from t1, lateral (select b from t2 where t2.a = t1.a) as d",
+			output: "select * from t1 cross join lateral (select b from t2 where t2.a = t1.a) as d",
+		}, {
+			input:  "select * from t1 cross join lateral (select count(*) from t2 where t2.a = t1.a) d (c)",
+			output: "select * from t1 cross join lateral (select count(*) from t2 where t2.a = t1.a) as d(c)",
+		}, {
+			input:  "select * from t1 left join lateral (select b from t2 where t2.a = t1.a) as d on true",
+			output: "select * from t1 left join lateral (select b from t2 where t2.a = t1.a) as d on true",
+		}, {
+			input:  "select * from t1 join lateral unnest(t1.j, '$') as u",
+			output: "select * from t1 inner join lateral unnest(t1.j, $) as u",
 		}, {
 			input: "select * from t minus all select c from t1",
 		}, {
@@ -1676,6 +1843,12 @@ var (
 		}, {
 			input:  "select $ from t into outfile '/Users/tmp/test' FIELDS TERMINATED BY ',' LINES TERMINATED BY '\n' header 'FALSE' MAX_FILE_SIZE 100 FORCE_QUOTE (a, b)",
 			output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header false max_file_size 102400 force_quote a, b",
+		}, {
+			input:  "select $ from t into outfile '/Users/tmp/test' MAX_FILE_SIZE 100 FORMAT 'PARQUET'",
+			output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true max_file_size 102400 format 'parquet'",
+		}, {
+			input:  "select $ from t into outfile '/Users/tmp/test' format 'jsonline'",
+			output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true format 'jsonline'",
 		}, {
 			input: "drop prepare stmt_name1",
 		}, {
@@ -2321,6 +2494,17 @@ var (
 		{
 			input: "alter table tbl1 drop foreign key fk_name",
 		},
+		{
+			input: "alter table tbl1 drop check chk_name",
+		},
+		{
+			input:  "alter table tbl1 add constraint chk_name check (a > 0)",
+			output: "alter table tbl1 add constraint chk_name check (a > 0) enforced",
+		},
+		{
+			input:  "alter table tbl1 alter check chk_name not enforced",
+			output: "alter table tbl1 alter check chk_name not enforce",
+		},
 		{
 			input: "alter table tbl1 add foreign key sdf (a, b) references b(a asc, b desc)",
 		},
@@ -2844,10 +3028,10 @@ var (
 			output: "alter table t1 charset = WITH",
 		}, {
 			input:  "alter table t1 alter CHECK a ENFORCED",
-			output: "alter table t1 alter CHECK enforce",
+			output: "alter table t1 alter CHECK a enforce",
 		}, {
 			input:  "alter table t1 alter CONSTRAINT a NOT ENFORCED",
-			output: "alter table t1 alter CONSTRAINT not enforce",
+			output: "alter table t1 alter CONSTRAINT a not enforce",
 		}, {
 			input:  "create SQL SECURITY DEFINER VIEW t2 as select * from t1",
 			output: "create view t2 as select * from t1",
@@ -3246,9 +3430,89 @@ var (
 					END WHILE;
 				END`,
 		output: "begin declare v1 int default 5; while v1 > 0 do set v1 = v1 - 1; end while; end",
+	}, {
+		input: `BEGIN
+					DECLARE done INT DEFAULT FALSE;
+					DECLARE a INT;
+					DECLARE cur1 CURSOR FOR SELECT id FROM t1 WHERE id > 1;
+					DECLARE CONTINUE HANDLER FOR NOT FOUND SET done = TRUE;
+					OPEN cur1;
+					read_loop: LOOP
+						FETCH cur1 INTO a;
+						FETCH NEXT FROM cur1 INTO a;
+						IF done THEN
+							LEAVE read_loop;
+						END IF;
+					END LOOP read_loop;
+					CLOSE cur1;
+				END`,
+		output: "begin declare done int default false; declare a int default null; declare cur1 cursor for select id from t1 where id > 1; declare continue handler for not found set done = true; open cur1; read_loop: loop fetch cur1 into a; fetch cur1 into a; if done then leave read_loop; end if; end loop read_loop; close cur1; end",
+	}, {
+		input:  "begin l1: loop leave l1; end loop; l2: while 1 do leave l2; end while; l3: repeat leave l3; until 1 end repeat; end",
+		output: "begin l1: loop leave l1; end loop l1; l2: while 1 do leave l2; end while l2; l3: repeat leave l3; until 1 end repeat l3; end",
+	}, {
+		input: `BEGIN
+					DECLARE no_table CONDITION FOR SQLSTATE '42S02';
+					DECLARE dup CONDITION FOR 1062;
+					DECLARE EXIT HANDLER FOR no_table, dup, SQLSTATE VALUE '23000', 1146
+					BEGIN
+						RESIGNAL SET MESSAGE_TEXT = 'failed';
+					END;
+					DECLARE CONTINUE HANDLER FOR SQLWARNING, SQLEXCEPTION BEGIN END;
+					SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'custom error', MYSQL_ERRNO = 5001;
+					SIGNAL no_table;
+					RESIGNAL;
+				END`,
+		output: "begin declare no_table condition for sqlstate '42S02'; declare dup condition for 1062; declare exit handler for no_table, dup, sqlstate '23000', 1146 begin resignal set message_text = failed; end; declare continue handler for sqlwarning, sqlexception begin end; signal sqlstate '45000' set message_text = custom error, mysql_errno = 5001; signal no_table; resignal; end",
+	}}
+)
+
+var (
+	eventBodySQL = []struct {
+		input string
+		body  []string
+	}{{
+		input: "create event e1 on schedule every 1 hour do  insert into t1 select 'do', count(*) from t2 ;",
+		body:  []string{"insert into t1 select 'do', count(*) from t2"},
+	}, {
+		input: "create event e1 on schedule every 1 hour do delete from t1; drop event e1; alter event e1 enable do delete from `t1`/* done */",
+		body:  []string{"delete from t1", "", "delete from `t1`/* done */"},
+	}, {
+		input: "alter event e1 disable",
+		body:  []string{""},
+	}, {
+		input: "create event do on schedule at '2024-01-01' do do sleep(1)",
+		body:  []string{"do sleep(1)"},
 	}}
 )
 
+func TestEventBodySql(t *testing.T) {
+	ctx := context.TODO()
+	for _, tcase := range eventBodySQL {
+		asts, err := Parse(ctx, tcase.input, 1)
+		if err != nil {
+			t.Errorf("Parse(%q) err: %v", tcase.input, err)
+			continue
+		}
+		if len(asts) != len(tcase.body) {
+			t.Errorf("Parse(%q) got %d statements", tcase.input, len(asts))
+			continue
+		}
+		for i, ast := range asts {
+			var body string
+			switch st := ast.(type) {
+			case *tree.CreateEvent:
+				body = st.BodySql
+			case *tree.AlterEvent:
+				body = st.BodySql
+			}
+			if body != tcase.body[i] {
+				t.Errorf("Event body of %q failed. \nExpected/Got:\n%s\n%s", tcase.input, tcase.body[i], body)
+			}
+		}
+	}
+}
+
 func TestMulti(t *testing.T) {
 	ctx := context.TODO()
 	for _, tcase := range multiSQL {
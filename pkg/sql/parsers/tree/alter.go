@@ -772,6 +772,7 @@ func (node *AlterOptionAlterReIndex) reset() {
 type AlterOptionAlterCheck struct {
 	alterOptionImpl
 	Type    string
+	Name    Identifier
 	Enforce bool
 }
 
@@ -787,6 +788,10 @@ func (node *AlterOptionAlterCheck) Free() { reuse.Free[AlterOptionAlterCheck](no
 func (node *AlterOptionAlterCheck) Format(ctx *FmtCtx) {
 	ctx.WriteString("alter ")
 	ctx.WriteString(node.Type + " ")
+	if node.Name != "" {
+		node.Name.Format(ctx)
+		ctx.WriteByte(' ')
+	}
 	if node.Enforce {
 		ctx.WriteString("enforce")
 	} else {
@@ -852,6 +857,7 @@ const (
 	AlterTableDropKey
 	AlterTableDropPrimaryKey
 	AlterTableDropForeignKey
+	AlterTableDropCheck
 )
 
 type AlterOptionDrop struct {
@@ -886,6 +892,9 @@ func (node *AlterOptionDrop) Format(ctx *FmtCtx) {
 	case AlterTableDropForeignKey:
 		ctx.WriteString("foreign key ")
 		node.Name.Format(ctx)
+	case AlterTableDropCheck:
+		ctx.WriteString("check ")
+		node.Name.Format(ctx)
 	}
 }
 
@@ -1737,7 +1737,14 @@ type AttributeGeneratedAlways struct {
 }
 
 func (node *AttributeGeneratedAlways) Format(ctx *FmtCtx) {
+	ctx.WriteString("generated always as (")
 	node.Expr.Format(ctx)
+	ctx.WriteString(")")
+	if node.Stored {
+		ctx.WriteString(" stored")
+	} else {
+		ctx.WriteString(" virtual")
+	}
 }
 
 func (node AttributeGeneratedAlways) TypeName() string { return "tree.AttributeGeneratedAlways" }
@@ -2020,6 +2027,8 @@ func (it IndexType) ToString() string {
 		return "master"
 	case INDEX_TYPE_FULLTEXT:
 		return "fulltext"
+	case INDEX_TYPE_HNSW:
+		return "hnsw"
 	case INDEX_TYPE_INVALID:
 		return ""
 	default:
@@ -2037,6 +2046,7 @@ const (
 	INDEX_TYPE_IVFFLAT
 	INDEX_TYPE_MASTER
 	INDEX_TYPE_FULLTEXT
+	INDEX_TYPE_HNSW
 )
 
 type VisibleType int
@@ -2069,13 +2079,24 @@ type IndexOption struct {
 	SecondaryEngineAttribute string
 	AlgoParamList            int64
 	AlgoParamVectorOpType    string
+	AlgoParamM               int64
+	AlgoParamEfConstruction  int64
+	AlgoParamEfSearch        int64
+	AlgoParamAnalyzer        string
+	AlgoParamStopwords       string
+	AlgoParamScorer          string
+	AlgoParamBM25K1          string
+	AlgoParamBM25B           string
 }
 
 // Must follow the following sequence when test
 func (node *IndexOption) Format(ctx *FmtCtx) {
 	if node.KeyBlockSize != 0 || node.ParserName != "" ||
 		node.Comment != "" || node.Visible != VISIBLE_TYPE_INVALID ||
-		node.AlgoParamList != 0 || node.AlgoParamVectorOpType != "" {
+		node.AlgoParamList != 0 || node.AlgoParamVectorOpType != "" ||
+		node.AlgoParamM != 0 || node.AlgoParamEfConstruction != 0 || node.AlgoParamEfSearch != 0 ||
+		node.AlgoParamAnalyzer != "" || node.AlgoParamStopwords != "" || node.AlgoParamScorer != "" ||
+		node.AlgoParamBM25K1 != "" || node.AlgoParamBM25B != "" {
 		ctx.WriteByte(' ')
 	}
 	if node.KeyBlockSize != 0 {
@@ -2098,11 +2119,51 @@ func (node *IndexOption) Format(ctx *FmtCtx) {
 		ctx.WriteString(strconv.FormatInt(node.AlgoParamList, 10))
 		ctx.WriteByte(' ')
 	}
+	if node.AlgoParamM != 0 {
+		ctx.WriteString("M ")
+		ctx.WriteString(strconv.FormatInt(node.AlgoParamM, 10))
+		ctx.WriteByte(' ')
+	}
+	if node.AlgoParamEfConstruction != 0 {
+		ctx.WriteString("EF_CONSTRUCTION ")
+		ctx.WriteString(strconv.FormatInt(node.AlgoParamEfConstruction, 10))
+		ctx.WriteByte(' ')
+	}
+	if node.AlgoParamEfSearch != 0 {
+		ctx.WriteString("EF_SEARCH ")
+		ctx.WriteString(strconv.FormatInt(node.AlgoParamEfSearch, 10))
+		ctx.WriteByte(' ')
+	}
 	if node.AlgoParamVectorOpType != "" {
 		ctx.WriteString("OP_TYPE ")
 		ctx.WriteString(node.AlgoParamVectorOpType)
 		ctx.WriteByte(' ')
 	}
+	if node.AlgoParamAnalyzer != "" {
+		ctx.WriteString("ANALYZER ")
+		ctx.WriteString(node.AlgoParamAnalyzer)
+		ctx.WriteByte(' ')
+	}
+	if node.AlgoParamStopwords != "" {
+		ctx.WriteString("STOPWORDS ")
+		ctx.WriteString(node.AlgoParamStopwords)
+		ctx.WriteByte(' ')
+	}
+	if node.AlgoParamScorer != "" {
+		ctx.WriteString("SCORER ")
+		ctx.WriteString(node.AlgoParamScorer)
+		ctx.WriteByte(' ')
+	}
+	if node.AlgoParamBM25K1 != "" {
+		ctx.WriteString("BM25_K1 ")
+		ctx.WriteString(node.AlgoParamBM25K1)
+		ctx.WriteByte(' ')
+	}
+	if node.AlgoParamBM25B != "" {
+		ctx.WriteString("BM25_B ")
+		ctx.WriteString(node.AlgoParamBM25B)
+		ctx.WriteByte(' ')
+	}
 	if node.Visible != VISIBLE_TYPE_INVALID {
 		ctx.WriteString(node.Visible.ToString())
 	}
@@ -2450,16 +2511,24 @@ func NewFullTextIndex(k []*KeyPart, n string, e bool, io *IndexOption) *FullText
 
 type CheckIndex struct {
 	tableDefImpl
+	Name     string
 	Expr     Expr
 	Enforced bool
 }
 
 func (node *CheckIndex) Format(ctx *FmtCtx) {
+	if node.Name != "" {
+		ctx.WriteString("constraint ")
+		ctx.WriteString(node.Name)
+		ctx.WriteByte(' ')
+	}
 	ctx.WriteString("check (")
 	node.Expr.Format(ctx)
 	ctx.WriteByte(')')
 	if node.Enforced {
 		ctx.WriteString(" enforced")
+	} else {
+		ctx.WriteString(" not enforced")
 	}
 }
 
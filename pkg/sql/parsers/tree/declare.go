@@ -14,6 +14,8 @@
 
 package tree
 
+import "strconv"
+
 // Declare statement
 type Declare struct {
 	statementImpl
@@ -34,3 +36,114 @@ func (node *Declare) Format(ctx *FmtCtx) {
 
 func (node *Declare) GetStatementType() string { return "Declare" }
 func (node *Declare) GetQueryType() string     { return QueryTypeOth }
+
+// DeclareCursor statement
+type DeclareCursor struct {
+	statementImpl
+	Name   Identifier
+	Select *Select
+}
+
+func (node *DeclareCursor) Format(ctx *FmtCtx) {
+	ctx.WriteString("declare ")
+	ctx.WriteString(string(node.Name))
+	ctx.WriteString(" cursor for ")
+	node.Select.Format(ctx)
+}
+
+func (node *DeclareCursor) GetStatementType() string { return "Declare Cursor" }
+func (node *DeclareCursor) GetQueryType() string     { return QueryTypeOth }
+
+type ConditionType int
+
+const (
+	CONDITION_SQLSTATE ConditionType = iota
+	CONDITION_ERRNO
+	CONDITION_NAME
+	CONDITION_SQLWARNING
+	CONDITION_NOT_FOUND
+	CONDITION_SQLEXCEPTION
+)
+
+// ConditionValue is a condition of a handler, a named condition or a SIGNAL
+type ConditionValue struct {
+	Type     ConditionType
+	SqlState string
+	Errno    uint16
+	Name     Identifier
+}
+
+func (node *ConditionValue) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case CONDITION_SQLSTATE:
+		ctx.WriteString("sqlstate '")
+		ctx.WriteString(node.SqlState)
+		ctx.WriteByte('\'')
+	case CONDITION_ERRNO:
+		ctx.WriteString(strconv.FormatUint(uint64(node.Errno), 10))
+	case CONDITION_NAME:
+		ctx.WriteString(string(node.Name))
+	case CONDITION_SQLWARNING:
+		ctx.WriteString("sqlwarning")
+	case CONDITION_NOT_FOUND:
+		ctx.WriteString("not found")
+	case CONDITION_SQLEXCEPTION:
+		ctx.WriteString("sqlexception")
+	}
+}
+
+// DeclareCondition statement
+type DeclareCondition struct {
+	statementImpl
+	Name  Identifier
+	Value *ConditionValue
+}
+
+func (node *DeclareCondition) Format(ctx *FmtCtx) {
+	ctx.WriteString("declare ")
+	ctx.WriteString(string(node.Name))
+	ctx.WriteString(" condition for ")
+	node.Value.Format(ctx)
+}
+
+func (node *DeclareCondition) GetStatementType() string { return "Declare Condition" }
+func (node *DeclareCondition) GetQueryType() string     { return QueryTypeOth }
+
+type HandlerAction int
+
+const (
+	HANDLER_CONTINUE HandlerAction = iota
+	HANDLER_EXIT
+)
+
+// DeclareHandler statement
+type DeclareHandler struct {
+	statementImpl
+	Action     HandlerAction
+	Conditions []*ConditionValue
+	Body       Statement
+}
+
+func (node *DeclareHandler) Format(ctx *FmtCtx) {
+	ctx.WriteString("declare ")
+	switch node.Action {
+	case HANDLER_CONTINUE:
+		ctx.WriteString("continue")
+	case HANDLER_EXIT:
+		ctx.WriteString("exit")
+	}
+	ctx.WriteString(" handler for ")
+	for i, c := range node.Conditions {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		c.Format(ctx)
+	}
+	if node.Body != nil {
+		ctx.WriteByte(' ')
+		node.Body.Format(ctx)
+	}
+}
+
+func (node *DeclareHandler) GetStatementType() string { return "Declare Handler" }
+func (node *DeclareHandler) GetQueryType() string     { return QueryTypeOth }
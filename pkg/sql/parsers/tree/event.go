@@ -0,0 +1,217 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import (
+	"strings"
+)
+
+// EventName is the name of a scheduled event, optionally qualified by its database.
+type EventName struct {
+	Name objName
+}
+
+func NewEventName(name Identifier, prefix ObjectNamePrefix) *EventName {
+	return &EventName{
+		Name: objName{
+			ObjectName:       name,
+			ObjectNamePrefix: prefix,
+		},
+	}
+}
+
+func (node *EventName) Format(ctx *FmtCtx) {
+	if node.Name.ExplicitSchema {
+		ctx.WriteString(string(node.Name.SchemaName))
+		ctx.WriteByte('.')
+	}
+	ctx.WriteString(string(node.Name.ObjectName))
+}
+
+// EventSchedule is the ON SCHEDULE clause of an event. Either At or Every is set.
+type EventSchedule struct {
+	// At is the time of a one-time event
+	At Expr
+	// Every and Unit are the interval of a recurring event
+	Every Expr
+	Unit  string
+	// Starts and Ends optionally bound a recurring event
+	Starts Expr
+	Ends   Expr
+}
+
+func (node *EventSchedule) Format(ctx *FmtCtx) {
+	ctx.WriteString("on schedule ")
+	if node.At != nil {
+		ctx.WriteString("at ")
+		node.At.Format(ctx)
+		return
+	}
+	ctx.WriteString("every ")
+	node.Every.Format(ctx)
+	ctx.WriteByte(' ')
+	ctx.WriteString(strings.ToLower(node.Unit))
+	if node.Starts != nil {
+		ctx.WriteString(" starts ")
+		node.Starts.Format(ctx)
+	}
+	if node.Ends != nil {
+		ctx.WriteString(" ends ")
+		node.Ends.Format(ctx)
+	}
+}
+
+// EventCompletion is the ON COMPLETION clause of an event.
+type EventCompletion int
+
+const (
+	EventCompletionUnset EventCompletion = iota
+	EventCompletionPreserve
+	EventCompletionNotPreserve
+)
+
+func (node EventCompletion) Format(ctx *FmtCtx) {
+	switch node {
+	case EventCompletionPreserve:
+		ctx.WriteString(" on completion preserve")
+	case EventCompletionNotPreserve:
+		ctx.WriteString(" on completion not preserve")
+	}
+}
+
+// EventStatus is the ENABLE/DISABLE clause of an event.
+type EventStatus int
+
+const (
+	EventStatusUnset EventStatus = iota
+	EventStatusEnable
+	EventStatusDisable
+)
+
+func (node EventStatus) Format(ctx *FmtCtx) {
+	switch node {
+	case EventStatusEnable:
+		ctx.WriteString(" enable")
+	case EventStatusDisable:
+		ctx.WriteString(" disable")
+	}
+}
+
+// EventComment is the COMMENT clause of an event.
+type EventComment struct {
+	Exist   bool
+	Comment string
+}
+
+func (node EventComment) Format(ctx *FmtCtx) {
+	if node.Exist {
+		ctx.WriteString(" comment ")
+		ctx.WriteString("'" + node.Comment + "'")
+	}
+}
+
+// CreateEvent is CREATE EVENT [IF NOT EXISTS] name ON SCHEDULE schedule
+// [ON COMPLETION [NOT] PRESERVE] [ENABLE | DISABLE] [COMMENT 'comment'] DO body
+type CreateEvent struct {
+	statementImpl
+	IfNotExists bool
+	Name        *EventName
+	Schedule    *EventSchedule
+	Completion  EventCompletion
+	Status      EventStatus
+	Comment     EventComment
+	Body        Statement
+	// BodySql is the original text of the body, which is what the event runs
+	BodySql string
+}
+
+func (node *CreateEvent) Format(ctx *FmtCtx) {
+	ctx.WriteString("create event ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteByte(' ')
+	node.Schedule.Format(ctx)
+	node.Completion.Format(ctx)
+	node.Status.Format(ctx)
+	node.Comment.Format(ctx)
+	ctx.WriteString(" do ")
+	node.Body.Format(ctx)
+}
+
+func (node *CreateEvent) GetStatementType() string { return "Create Event" }
+func (node *CreateEvent) GetQueryType() string     { return QueryTypeDDL }
+
+func (node *CreateEvent) TypeName() string { return "tree.CreateEvent" }
+
+// AlterEvent is ALTER EVENT name [ON SCHEDULE schedule] [ON COMPLETION [NOT] PRESERVE]
+// [RENAME TO new_name] [ENABLE | DISABLE] [COMMENT 'comment'] [DO body]
+type AlterEvent struct {
+	statementImpl
+	Name       *EventName
+	Schedule   *EventSchedule
+	Completion EventCompletion
+	Rename     *EventName
+	Status     EventStatus
+	Comment    EventComment
+	Body       Statement
+	BodySql    string
+}
+
+func (node *AlterEvent) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter event ")
+	node.Name.Format(ctx)
+	if node.Schedule != nil {
+		ctx.WriteByte(' ')
+		node.Schedule.Format(ctx)
+	}
+	node.Completion.Format(ctx)
+	if node.Rename != nil {
+		ctx.WriteString(" rename to ")
+		node.Rename.Format(ctx)
+	}
+	node.Status.Format(ctx)
+	node.Comment.Format(ctx)
+	if node.Body != nil {
+		ctx.WriteString(" do ")
+		node.Body.Format(ctx)
+	}
+}
+
+func (node *AlterEvent) GetStatementType() string { return "Alter Event" }
+func (node *AlterEvent) GetQueryType() string     { return QueryTypeDDL }
+
+func (node *AlterEvent) TypeName() string { return "tree.AlterEvent" }
+
+// DropEvent is DROP EVENT [IF EXISTS] name
+type DropEvent struct {
+	statementImpl
+	IfExists bool
+	Name     *EventName
+}
+
+func (node *DropEvent) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop event ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+}
+
+func (node *DropEvent) GetStatementType() string { return "Drop Event" }
+func (node *DropEvent) GetQueryType() string     { return QueryTypeDDL }
+
+func (node *DropEvent) TypeName() string { return "tree.DropEvent" }
@@ -916,6 +916,46 @@ const (
 	AGG_TYPE_GENERAL
 )
 
+// NullTreatment specifies whether the value window functions skip the null values.
+type NullTreatment int
+
+const (
+	NULL_TREATMENT_DEFAULT NullTreatment = iota
+	NULL_TREATMENT_RESPECT
+	NULL_TREATMENT_IGNORE
+)
+
+func (node NullTreatment) ToString() string {
+	switch node {
+	case NULL_TREATMENT_RESPECT:
+		return "respect nulls"
+	case NULL_TREATMENT_IGNORE:
+		return "ignore nulls"
+	default:
+		return ""
+	}
+}
+
+// FromFirstLast specifies the direction nth_value counts the rows from.
+type FromFirstLast int
+
+const (
+	FROM_FIRST_LAST_DEFAULT FromFirstLast = iota
+	FROM_FIRST
+	FROM_LAST
+)
+
+func (node FromFirstLast) ToString() string {
+	switch node {
+	case FROM_FIRST:
+		return "from first"
+	case FROM_LAST:
+		return "from last"
+	default:
+		return ""
+	}
+}
+
 // the common interface to UnresolvedName and QualifiedFunctionName.
 type FunctionReference interface {
 	fmt.Stringer
@@ -951,6 +991,10 @@ type FuncExpr struct {
 	WindowSpec *WindowSpec
 
 	OrderBy OrderBy
+
+	// FromFirstLast and NullTreatment are only used by the value window functions.
+	FromFirstLast FromFirstLast
+	NullTreatment NullTreatment
 }
 
 func (node *FuncExpr) Format(ctx *FmtCtx) {
@@ -977,6 +1021,15 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 
 	ctx.WriteByte(')')
 
+	if node.FromFirstLast != FROM_FIRST_LAST_DEFAULT {
+		ctx.WriteString(" ")
+		ctx.WriteString(node.FromFirstLast.ToString())
+	}
+	if node.NullTreatment != NULL_TREATMENT_DEFAULT {
+		ctx.WriteString(" ")
+		ctx.WriteString(node.NullTreatment.ToString())
+	}
+
 	if node.WindowSpec != nil {
 		ctx.WriteString(" ")
 		node.WindowSpec.Format(ctx)
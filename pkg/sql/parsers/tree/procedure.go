@@ -123,6 +123,9 @@ type ProcedureArgForMarshal struct {
 	Name      *UnresolvedName
 	Type      ResolvableTypeReference
 	InOutType InOutArgType
+	// Position is the position of the argument in the declaration, it is
+	// zero for all the arguments of the procedures created without it.
+	Position int
 }
 
 type ProcedureName struct {
@@ -596,9 +596,15 @@ type AliasedTableExpr struct {
 	Expr       TableExpr
 	As         AliasClause
 	IndexHints []*IndexHint
+	// Lateral is set for a LATERAL derived table or table function,
+	// which can reference columns of the preceding FROM items.
+	Lateral bool
 }
 
 func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
+	if node.Lateral {
+		ctx.WriteString("lateral ")
+	}
 	node.Expr.Format(ctx)
 	if node.As.Alias != "" {
 		ctx.WriteString(" as ")
@@ -14,6 +14,8 @@
 
 package tree
 
+import "strconv"
+
 // SelectLockType is the lock type for SelectStmt.
 type SelectLockType int
 
@@ -60,4 +62,8 @@ type SelectLockInfo struct {
 
 func (node *SelectLockInfo) Format(ctx *FmtCtx) {
 	ctx.WriteString(node.LockType.String())
+	if node.LockType == SelectLockForUpdateWaitN {
+		ctx.WriteByte(' ')
+		ctx.WriteString(strconv.FormatUint(node.WaitSec, 10))
+	}
 }
@@ -0,0 +1,71 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+type SignalInfoItem int
+
+const (
+	SIGNAL_MESSAGE_TEXT SignalInfoItem = iota
+	SIGNAL_MYSQL_ERRNO
+)
+
+// SignalInfo is an item of the SET clause of SIGNAL and RESIGNAL
+type SignalInfo struct {
+	Item  SignalInfoItem
+	Value Expr
+}
+
+func (node *SignalInfo) Format(ctx *FmtCtx) {
+	switch node.Item {
+	case SIGNAL_MESSAGE_TEXT:
+		ctx.WriteString("message_text")
+	case SIGNAL_MYSQL_ERRNO:
+		ctx.WriteString("mysql_errno")
+	}
+	ctx.WriteString(" = ")
+	node.Value.Format(ctx)
+}
+
+// Signal statement, it is a RESIGNAL if Resignal is true, whose condition
+// is optional.
+type Signal struct {
+	statementImpl
+	Resignal  bool
+	Condition *ConditionValue
+	Infos     []*SignalInfo
+}
+
+func (node *Signal) Format(ctx *FmtCtx) {
+	if node.Resignal {
+		ctx.WriteString("resignal")
+	} else {
+		ctx.WriteString("signal")
+	}
+	if node.Condition != nil {
+		ctx.WriteByte(' ')
+		node.Condition.Format(ctx)
+	}
+	for i, info := range node.Infos {
+		if i == 0 {
+			ctx.WriteString(" set ")
+		} else {
+			ctx.WriteString(", ")
+		}
+		info.Format(ctx)
+	}
+}
+
+func (node *Signal) GetStatementType() string { return "Signal" }
+func (node *Signal) GetQueryType() string     { return QueryTypeOth }
@@ -52,7 +52,7 @@ const (
 	// statement: Grant, Revoke
 	// CreateAccount, CreateUser, CreateRole, AlterAccount, AlterUser, DropAccount, DropUser, DropRole
 	QueryTypeDCL = "DCL"
-	// QueryTypeTCL (Transaction Control Language): BeginTransaction, RollbackTransaction, CommitTransaction, Savepoint(Not Support)
+	// QueryTypeTCL (Transaction Control Language): BeginTransaction, RollbackTransaction, CommitTransaction, Savepoint, RollbackToSavepoint, ReleaseSavepoint, XA statements
 	QueryTypeTCL = "TCL"
 	// QueryTypeOth (Other.)
 	// statement: AnalyzeStmt(Not Support), ExplainStmt, ExplainAnalyze, ExplainFor,
@@ -168,6 +168,42 @@ func (node *RollbackTransaction) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
 
+func (node *Savepoint) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *RollbackToSavepoint) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *ReleaseSavepoint) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *XAStart) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *XAEnd) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *XAPrepare) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *XACommit) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *XARollback) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *XARecover) StmtKind() StmtKind {
+	return compositeResRowType
+}
+
 func (node *CreatePublication) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
@@ -196,6 +232,18 @@ func (node *AlterStage) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
 
+func (node *CreateEvent) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *AlterEvent) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *DropEvent) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
 func (node *CreateAccount) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
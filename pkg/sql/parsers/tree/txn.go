@@ -14,6 +14,13 @@
 
 package tree
 
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+)
+
 // the read and write mode for a transaction.
 type ReadWriteMode int
 
@@ -103,3 +110,202 @@ func (node *RollbackTransaction) GetQueryType() string     { return QueryTypeTCL
 func NewRollbackTransaction(t CompletionType) *RollbackTransaction {
 	return &RollbackTransaction{Type: t}
 }
+
+// Savepoint statement
+type Savepoint struct {
+	statementImpl
+	Name Identifier
+}
+
+func (node *Savepoint) Format(ctx *FmtCtx) {
+	ctx.WriteString("savepoint ")
+	node.Name.Format(ctx)
+}
+
+func (node *Savepoint) GetStatementType() string { return "Savepoint" }
+func (node *Savepoint) GetQueryType() string     { return QueryTypeTCL }
+
+func NewSavepoint(name Identifier) *Savepoint {
+	return &Savepoint{Name: name}
+}
+
+// Rollback to savepoint statement
+type RollbackToSavepoint struct {
+	statementImpl
+	Name Identifier
+}
+
+func (node *RollbackToSavepoint) Format(ctx *FmtCtx) {
+	ctx.WriteString("rollback to savepoint ")
+	node.Name.Format(ctx)
+}
+
+func (node *RollbackToSavepoint) GetStatementType() string { return "Rollback To Savepoint" }
+func (node *RollbackToSavepoint) GetQueryType() string     { return QueryTypeTCL }
+
+func NewRollbackToSavepoint(name Identifier) *RollbackToSavepoint {
+	return &RollbackToSavepoint{Name: name}
+}
+
+// Release savepoint statement
+type ReleaseSavepoint struct {
+	statementImpl
+	Name Identifier
+}
+
+func (node *ReleaseSavepoint) Format(ctx *FmtCtx) {
+	ctx.WriteString("release savepoint ")
+	node.Name.Format(ctx)
+}
+
+func (node *ReleaseSavepoint) GetStatementType() string { return "Release Savepoint" }
+func (node *ReleaseSavepoint) GetQueryType() string     { return QueryTypeTCL }
+
+func NewReleaseSavepoint(name Identifier) *ReleaseSavepoint {
+	return &ReleaseSavepoint{Name: name}
+}
+
+// XID is the identifier of a XA transaction branch.
+type XID struct {
+	Gtrid    []byte
+	Bqual    []byte
+	FormatID uint64
+}
+
+// DefaultXAFormatID is the formatID of the xid without formatID.
+const DefaultXAFormatID = 1
+
+func (node *XID) Format(ctx *FmtCtx) {
+	formatXIDString(ctx, node.Gtrid)
+	if len(node.Bqual) == 0 && node.FormatID == DefaultXAFormatID {
+		return
+	}
+	ctx.WriteString(", ")
+	formatXIDString(ctx, node.Bqual)
+	if node.FormatID != DefaultXAFormatID {
+		ctx.WriteString(fmt.Sprintf(", %d", node.FormatID))
+	}
+}
+
+// formatXIDString writes the printable part of the xid as a string literal,
+// and the others as a hex literal.
+func formatXIDString(ctx *FmtCtx, v []byte) {
+	for _, c := range v {
+		if c < ' ' || c > '~' || c == '\'' || c == '\\' {
+			ctx.WriteString("0x" + hex.EncodeToString(v))
+			return
+		}
+	}
+	ctx.WriteString("'" + string(v) + "'")
+}
+
+func (node *XID) String() string {
+	ctx := NewFmtCtx(dialect.MYSQL)
+	node.Format(ctx)
+	return ctx.String()
+}
+
+// XA START statement
+type XAStart struct {
+	statementImpl
+	Xid    XID
+	Join   bool
+	Resume bool
+}
+
+func (node *XAStart) Format(ctx *FmtCtx) {
+	ctx.WriteString("xa start ")
+	node.Xid.Format(ctx)
+	if node.Join {
+		ctx.WriteString(" join")
+	} else if node.Resume {
+		ctx.WriteString(" resume")
+	}
+}
+
+func (node *XAStart) GetStatementType() string { return "XA Start" }
+func (node *XAStart) GetQueryType() string     { return QueryTypeTCL }
+
+// XA END statement
+type XAEnd struct {
+	statementImpl
+	Xid        XID
+	Suspend    bool
+	ForMigrate bool
+}
+
+func (node *XAEnd) Format(ctx *FmtCtx) {
+	ctx.WriteString("xa end ")
+	node.Xid.Format(ctx)
+	if node.Suspend {
+		ctx.WriteString(" suspend")
+		if node.ForMigrate {
+			ctx.WriteString(" for migrate")
+		}
+	}
+}
+
+func (node *XAEnd) GetStatementType() string { return "XA End" }
+func (node *XAEnd) GetQueryType() string     { return QueryTypeTCL }
+
+// XA PREPARE statement
+type XAPrepare struct {
+	statementImpl
+	Xid XID
+}
+
+func (node *XAPrepare) Format(ctx *FmtCtx) {
+	ctx.WriteString("xa prepare ")
+	node.Xid.Format(ctx)
+}
+
+func (node *XAPrepare) GetStatementType() string { return "XA Prepare" }
+func (node *XAPrepare) GetQueryType() string     { return QueryTypeTCL }
+
+// XA COMMIT statement
+type XACommit struct {
+	statementImpl
+	Xid      XID
+	OnePhase bool
+}
+
+func (node *XACommit) Format(ctx *FmtCtx) {
+	ctx.WriteString("xa commit ")
+	node.Xid.Format(ctx)
+	if node.OnePhase {
+		ctx.WriteString(" one phase")
+	}
+}
+
+func (node *XACommit) GetStatementType() string { return "XA Commit" }
+func (node *XACommit) GetQueryType() string     { return QueryTypeTCL }
+
+// XA ROLLBACK statement
+type XARollback struct {
+	statementImpl
+	Xid XID
+}
+
+func (node *XARollback) Format(ctx *FmtCtx) {
+	ctx.WriteString("xa rollback ")
+	node.Xid.Format(ctx)
+}
+
+func (node *XARollback) GetStatementType() string { return "XA Rollback" }
+func (node *XARollback) GetQueryType() string     { return QueryTypeTCL }
+
+// XA RECOVER statement
+type XARecover struct {
+	statementImpl
+	ConvertXid bool
+}
+
+func (node *XARecover) Format(ctx *FmtCtx) {
+	ctx.WriteString("xa recover")
+	if node.ConvertXid {
+		ctx.WriteString(" convert xid")
+	}
+}
+
+func (node *XARecover) GetStatementType() string { return "XA Recover" }
+func (node *XARecover) GetQueryType() string     { return QueryTypeTCL }
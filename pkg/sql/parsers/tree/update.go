@@ -496,6 +496,8 @@ type ExportParam struct {
 	ForceQuote []string
 	// stage filename path
 	StageFilePath string
+	// FileFormat is csv, jsonline or parquet
+	FileFormat string
 }
 
 func (ep *ExportParam) Format(ctx *FmtCtx) {
@@ -539,6 +541,11 @@ func (ep *ExportParam) format(ctx *FmtCtx, withOutfile bool) {
 			prefix = ", "
 		}
 	}
+	if ep.FileFormat != "" && ep.FileFormat != CSV {
+		ctx.WriteString(" format '")
+		ctx.WriteString(ep.FileFormat)
+		ctx.WriteByte('\'')
+	}
 }
 
 var _ LoadColumn = &UnresolvedName{}
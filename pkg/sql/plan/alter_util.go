@@ -100,6 +100,16 @@ func checkIsDroppableColumn(tableDef *TableDef, colName string, ctx CompilerCont
 	if err != nil {
 		return err
 	}
+	// We do not support drop column that referenced by check constraints.
+	err = checkAlterColumnWithCheck(ctx.GetContext(), tableDef, colName)
+	if err != nil {
+		return err
+	}
+	// We do not support drop column that referenced by generated columns.
+	err = checkAlterColumnWithGenerated(ctx.GetContext(), tableDef, colName)
+	if err != nil {
+		return err
+	}
 	// We do not support drop column that contain foreign key columns now.
 	err = checkAlterColumnWithForeignKey(colName, tableDef.RefChildTbls, tableDef.Fkeys, ctx)
 	if err != nil {
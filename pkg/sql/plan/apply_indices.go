@@ -16,6 +16,7 @@ package plan
 
 import (
 	"fmt"
+	"math"
 	"sort"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
@@ -167,6 +168,12 @@ func getColSeqFromColDef(tblCol *plan.ColDef) string {
 }
 
 func (builder *QueryBuilder) applyIndicesForProject(nodeID int32, projNode *plan.Node, colRefCnt map[[2]int32]int, idxColMap map[[2]int32]*plan.Expr) int32 {
+	// Hybrid Search
+	// SELECT id FROM tbl ORDER BY rrf_score(MATCH(body) AGAINST('red'), l2_distance(embedding, "[1,2,3]")) DESC LIMIT 10;
+	if builder.applyIndicesForSortUsingHybridSearch(nodeID, projNode) {
+		return nodeID
+	}
+
 	// FullText
 	{
 		// support the followings:
@@ -243,7 +250,7 @@ func (builder *QueryBuilder) applyIndicesForProject(nodeID int32, projNode *plan
 		// 1.a if there are no table scans with multi-table indexes, skip
 		multiTableIndexes := make(map[string]*MultiTableIndex)
 		for _, indexDef := range scanNode.TableDef.Indexes {
-			if catalog.IsIvfIndexAlgo(indexDef.IndexAlgo) {
+			if catalog.IsIvfIndexAlgo(indexDef.IndexAlgo) || catalog.IsHnswIndexAlgo(indexDef.IndexAlgo) {
 				if _, ok := multiTableIndexes[indexDef.IndexName]; !ok {
 					multiTableIndexes[indexDef.IndexName] = &MultiTableIndex{
 						IndexAlgo: catalog.ToLower(indexDef.IndexAlgo),
@@ -321,6 +328,25 @@ func (builder *QueryBuilder) applyIndicesForProject(nodeID int32, projNode *plan
 					distanceFunctionIndexed = true
 					multiTableIndexWithSortDistFn = multiTableIndex
 				}
+			case catalog.MoIndexHnswAlgo.ToString():
+				idxDef0 := multiTableIndex.IndexDefs[catalog.SystemSI_HNSW_TblType_Metadata]
+				storedParams, err := catalog.IndexParamsStringToMap(idxDef0.IndexAlgoParams)
+				if err != nil {
+					continue
+				}
+
+				// if index is not the order by column, skip
+				if scanNode.TableDef.Name2ColIndex[idxDef0.Parts[0]] != colPosOrderBy {
+					continue
+				}
+
+				// the index must be of the same distance function in order by, and the query must be a
+				// top-k search the graph can answer.
+				if storedParams[catalog.IndexAlgoParamOpType] == distFuncOpTypes[distFnExpr.Func.ObjName] &&
+					canApplyHnswIndex(sortNode, scanNode) {
+					distanceFunctionIndexed = true
+					multiTableIndexWithSortDistFn = multiTableIndex
+				}
 			}
 			if distanceFunctionIndexed {
 				break
@@ -330,8 +356,23 @@ func (builder *QueryBuilder) applyIndicesForProject(nodeID int32, projNode *plan
 			goto END0
 		}
 
+		// 1.e pick how the filters of the scan are combined with the search by their selectivity. A
+		// selective filter leaves too few rows in the candidates of the index: IVFFLAT can not restrict the
+		// probed centroids to the filtered rows, so the exact plan is kept.
+		strategy, overFetch := chooseVectorFilterStrategy(scanNode)
+		isHnsw := multiTableIndexWithSortDistFn.IndexAlgo == catalog.MoIndexHnswAlgo.ToString()
+		if strategy == vectorBruteForce || (strategy == vectorPreFilter && !isHnsw) {
+			goto END0
+		}
+
+		if isHnsw {
+			builder.applyIndicesForSortUsingHnswIndex(nodeID, sortNode, scanNode, multiTableIndexWithSortDistFn,
+				strategy, overFetch)
+			return nodeID
+		}
+
 		newSortNode := builder.applyIndicesForSortUsingVectorIndex(nodeID, projNode, sortNode, scanNode,
-			colRefCnt, idxColMap, multiTableIndexWithSortDistFn, colPosOrderBy)
+			colRefCnt, idxColMap, multiTableIndexWithSortDistFn, colPosOrderBy, uint64(math.Ceil(overFetch)))
 
 		// TODO: consult with nitao and aungr
 		projNode.Children[0] = newSortNode
@@ -234,6 +234,9 @@ func (builder *QueryBuilder) applyJoinFullTextIndices(nodeID int32, projNode *pl
 		exprs = append(exprs, tree.NewNumVal[string](idxtblname, idxtblname, false, tree.P_char))
 		exprs = append(exprs, tree.NewNumVal[string](pattern, pattern, false, tree.P_char))
 		exprs = append(exprs, tree.NewNumVal[int64](mode, strconv.FormatInt(mode, 10), false, tree.P_int64))
+		if idxdef.IndexAlgoParams != "" {
+			exprs = append(exprs, tree.NewNumVal[string](idxdef.IndexAlgoParams, idxdef.IndexAlgoParams, false, tree.P_char))
+		}
 
 		name := tree.NewUnresolvedName(fulltext_func)
 
@@ -0,0 +1,389 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+const (
+	hybrid_search_func_name = "hybrid_search"
+
+	// hybridRRFDefaultK is the k of reciprocal rank fusion when rrf_score() has no third argument.
+	hybridRRFDefaultK = 60
+	// hybridMinDepth is the least number of rows ranked by each of the fulltext and vector searches.
+	hybridMinDepth = 100
+)
+
+var hybridSearchColDefs = []*plan.ColDef{
+	// pk type should be same as the primary key of the source table
+	{
+		Name: "pk",
+		Typ: plan.Type{
+			Id:          int32(types.T_any),
+			NotNullable: false,
+		},
+	},
+	{
+		Name: "score",
+		Typ: plan.Type{
+			Id:          int32(types.T_float64),
+			NotNullable: false,
+			Width:       8,
+		},
+	},
+}
+
+// applyIndicesForSortUsingHybridSearch ranks the rows of the table by both a fulltext index and a vector
+// distance, e.g.
+//
+// SELECT id FROM src ORDER BY rrf_score(MATCH(body) AGAINST('red'), l2_distance(emb, '[1,2,3]')) DESC LIMIT 10;
+//
+//	SORT (order by hybrid_search.score DESC limit 10)
+//	  └── JOIN src.pk = hybrid_search.pk
+//	        ├── TABLE_SCAN src
+//	        └── FUNCTION_SCAN hybrid_search(params, literal)
+//
+// When the sort key is an alias of rrf_score() in the select list, the JOIN is the child of the project
+// below the sort instead:
+//
+//	SORT (order by s DESC limit 10)
+//	  └── PROJECT (hybrid_search.score as s)
+//	        └── JOIN src.pk = hybrid_search.pk
+//	              ├── TABLE_SCAN src
+//	              └── FUNCTION_SCAN hybrid_search(params, literal)
+//
+// Each search ranks max(2*limit, hybridMinDepth) rows. The filters of the scan are added to the WHERE of the
+// vector search, which ranks more rows only if some filter can't be pushed down. rrf_score() in the sort and
+// project lists is replaced by the fused score. It returns false if the query is not of this form.
+func (builder *QueryBuilder) applyIndicesForSortUsingHybridSearch(nodeID int32, projNode *plan.Node) bool {
+	sortNode := builder.resolveSortNode(projNode, 1)
+	if sortNode == nil || len(sortNode.OrderBy) != 1 || sortNode.OrderBy[0].Flag&plan.OrderBySpec_DESC == 0 {
+		return false
+	}
+	limit, ok := hnswSearchLimit(sortNode)
+	if !ok {
+		return false
+	}
+
+	// the scan is either the child of the sort, or the child of the project below the sort when the
+	// sort key is the alias of rrf_score() in the select list.
+	parentNode, rrfExpr := sortNode, sortNode.OrderBy[0].Expr
+	if col := rrfExpr.GetCol(); col != nil {
+		childNode := builder.qry.Nodes[sortNode.Children[0]]
+		if childNode.NodeType != plan.Node_PROJECT || len(childNode.Children) != 1 ||
+			col.RelPos != childNode.BindingTags[0] {
+			return false
+		}
+		parentNode, rrfExpr = childNode, childNode.ProjectList[col.ColPos]
+	}
+	rrfFn := rrfExpr.GetF()
+	if rrfFn == nil || rrfFn.Func.ObjName != "rrf_score" {
+		return false
+	}
+	scanNode := builder.qry.Nodes[parentNode.Children[0]]
+	if scanNode.NodeType != plan.Node_TABLE_SCAN || scanNode.TableDef.Pkey == nil {
+		return false
+	}
+
+	// rrf_score(fulltext_match(pattern, mode, cols...), dist_fn(col, const) [, k])
+	ftFn := rrfFn.Args[0].GetF()
+	if ftFn == nil || ftFn.Func.ObjName != "fulltext_match" {
+		return false
+	}
+	idxDef := builder.findMatchFullTextIndex(ftFn, scanNode)
+	if idxDef == nil {
+		return false
+	}
+	distFn := rrfFn.Args[1].GetF()
+	if distFn == nil {
+		return false
+	}
+	if _, ok := distFuncOpTypes[distFn.Func.ObjName]; !ok {
+		return false
+	}
+	query, vecCol := distFn.Args[1], distFn.Args[0]
+	if isRuntimeConstExpr(vecCol) && query.GetCol() != nil {
+		query, vecCol = vecCol, query
+	}
+	if !isRuntimeConstExpr(query) || vecCol.GetCol() == nil {
+		return false
+	}
+	k := int64(hybridRRFDefaultK)
+	if len(rrfFn.Args) == 3 {
+		lit := rrfFn.Args[2].GetLit()
+		if lit == nil || lit.GetI64Val() <= 0 {
+			return false
+		}
+		k = lit.GetI64Val()
+	}
+
+	// the filters of the scan are pushed into the vector search, it has to over-fetch only when some of
+	// them can't be written as SQL.
+	depth := max(2*limit, hybridMinDepth)
+	filter, pushed := hybridFilterSql(scanNode)
+	if !pushed {
+		switch strategy, overFetch := chooseVectorFilterStrategy(scanNode); strategy {
+		case vectorPostFilter:
+			depth = int64(math.Ceil(float64(depth) * overFetch))
+		case vectorBruteForce, vectorPreFilter:
+			depth *= vectorMaxOverFetch
+		}
+	}
+
+	bindCtx := builder.ctxByNode[nodeID]
+	pkName := scanNode.TableDef.Pkey.PkeyColName
+	pkPos := scanNode.TableDef.Name2ColIndex[pkName]
+	pkType := scanNode.TableDef.Cols[pkPos].Typ
+
+	// 1. hybrid_search(params, literal)
+	params, _ := json.Marshal(fulltext.HybridSearchParam{
+		SrcTable:   fmt.Sprintf("`%s`.`%s`", hybridEscapeIdent(scanNode.ObjRef.SchemaName), hybridEscapeIdent(scanNode.TableDef.Name)),
+		IndexTable: fmt.Sprintf("`%s`.`%s`", hybridEscapeIdent(scanNode.ObjRef.SchemaName), hybridEscapeIdent(idxDef.IndexTableName)),
+		Pattern:    ftFn.Args[0].GetLit().GetSval(),
+		Mode:       ftFn.Args[1].GetLit().GetI64Val(),
+		Params:     idxDef.IndexAlgoParams,
+		PkCol:      pkName,
+		VecCol:     scanNode.TableDef.Cols[vecCol.GetCol().ColPos].Name,
+		DistFunc:   distFn.Func.ObjName,
+		Desc:       distFn.Func.ObjName == "inner_product",
+		K:          k,
+		Depth:      depth,
+		Filter:     filter,
+	})
+
+	colDefs := _getColDefs(hybridSearchColDefs)
+	// set type to source table primary key
+	colDefs[0].Typ = plan.Type{
+		Id:    pkType.Id,
+		Width: pkType.Width,
+		Scale: pkType.Scale,
+	}
+	searchTag := builder.genNewTag()
+	searchId := builder.appendNode(&plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name:  hybrid_search_func_name,
+				Param: params,
+			},
+			Cols: colDefs,
+		},
+		BindingTags:     []int32{searchTag},
+		TblFuncExprList: []*plan.Expr{DeepCopyExpr(query)},
+		Children: []int32{builder.appendNode(&plan.Node{
+			NodeType: plan.Node_VALUE_SCAN,
+		}, bindCtx)},
+	}, bindCtx)
+
+	// 2. JOIN src and hybrid_search on src.pk = hybrid_search.pk
+	joinCond, _ := BindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*Expr{
+		{
+			Typ: pkType,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: scanNode.BindingTags[0],
+					ColPos: pkPos, // src.pk
+				},
+			},
+		},
+		{
+			Typ: colDefs[0].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: searchTag,
+					ColPos: 0, // hybrid_search.pk
+				},
+			},
+		},
+	})
+	joinId := builder.appendNode(&plan.Node{
+		NodeType: plan.Node_JOIN,
+		JoinType: plan.Node_INNER,
+		Children: []int32{scanNode.NodeId, searchId},
+		OnList:   []*Expr{joinCond},
+	}, bindCtx)
+	parentNode.Children[0] = joinId
+
+	// 3. replace rrf_score() with hybrid_search.score
+	scoreCol := &Expr{
+		Typ: colDefs[1].Typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: searchTag,
+				ColPos: 1, // hybrid_search.score
+			},
+		},
+	}
+	rrfStr := rrfExpr.String()
+	if parentNode == sortNode {
+		sortNode.OrderBy[0].Expr = scoreCol
+		parentNode = projNode
+	}
+	for i, expr := range parentNode.ProjectList {
+		if expr.String() == rrfStr {
+			parentNode.ProjectList[i] = DeepCopyExpr(scoreCol)
+		}
+	}
+
+	return true
+}
+
+var hybridStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `''`)
+
+func hybridEscapeIdent(name string) string {
+	return strings.ReplaceAll(name, "`", "``")
+}
+
+// hybridFilterSql returns the filters of the scan as the SQL of a WHERE clause on the source table, and
+// false if some of them are not written, so that the vector search has to rank more rows.
+func hybridFilterSql(scanNode *plan.Node) (string, bool) {
+	conds := make([]string, 0, len(scanNode.FilterList))
+	pushed := true
+	for _, expr := range scanNode.FilterList {
+		if cond, ok := hybridExprSql(expr, scanNode); ok {
+			conds = append(conds, cond)
+		} else {
+			pushed = false
+		}
+	}
+	return strings.Join(conds, " AND "), pushed
+}
+
+var hybridBinaryOps = map[string]string{
+	"=": "=", "<>": "<>", "<": "<", "<=": "<=", ">": ">", ">=": ">=",
+	"+": "+", "-": "-", "*": "*", "/": "/", "div": "DIV", "%": "%",
+	"and": "AND", "or": "OR", "like": "LIKE",
+}
+
+// hybridExprSql writes a filter of the scan as SQL. Only columns of the scan, literals, comparisons, logical
+// and arithmetic operators, IN, IS [NOT] NULL and CAST are written, other expressions return false.
+func hybridExprSql(expr *plan.Expr, scanNode *plan.Node) (string, bool) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		if e.Col.RelPos != scanNode.BindingTags[0] || int(e.Col.ColPos) >= len(scanNode.TableDef.Cols) {
+			return "", false
+		}
+		return "`" + hybridEscapeIdent(scanNode.TableDef.Cols[e.Col.ColPos].Name) + "`", true
+
+	case *plan.Expr_Lit:
+		return hybridLiteralSql(e.Lit, expr.Typ)
+
+	case *plan.Expr_List:
+		items := make([]string, len(e.List.List))
+		for i, item := range e.List.List {
+			str, ok := hybridExprSql(item, scanNode)
+			if !ok {
+				return "", false
+			}
+			items[i] = str
+		}
+		return "(" + strings.Join(items, ", ") + ")", true
+
+	case *plan.Expr_F:
+		name, args := e.F.Func.ObjName, e.F.Args
+		strs := make([]string, len(args))
+		for i, arg := range args {
+			if name == "cast" && i == 1 {
+				continue
+			}
+			str, ok := hybridExprSql(arg, scanNode)
+			if !ok {
+				return "", false
+			}
+			strs[i] = str
+		}
+		if op, ok := hybridBinaryOps[name]; ok && len(args) == 2 {
+			return fmt.Sprintf("(%s %s %s)", strs[0], op, strs[1]), true
+		}
+		switch {
+		case name == "not" && len(args) == 1:
+			return fmt.Sprintf("(NOT %s)", strs[0]), true
+		case name == "isnull" && len(args) == 1:
+			return fmt.Sprintf("(%s IS NULL)", strs[0]), true
+		case name == "isnotnull" && len(args) == 1:
+			return fmt.Sprintf("(%s IS NOT NULL)", strs[0]), true
+		case name == "in" && len(args) == 2 && args[1].GetList() != nil:
+			return fmt.Sprintf("(%s IN %s)", strs[0], strs[1]), true
+		case name == "not_in" && len(args) == 2 && args[1].GetList() != nil:
+			return fmt.Sprintf("(%s NOT IN %s)", strs[0], strs[1]), true
+		case name == "cast" && len(args) == 2:
+			if types.T(args[1].Typ.Id) == types.T_enum {
+				return "", false
+			}
+			return fmt.Sprintf("CAST(%s AS %s)", strs[0], FormatColType(args[1].Typ)), true
+		}
+	}
+	return "", false
+}
+
+// hybridLiteralSql writes a literal as a quoted string cast to its type, so that the text of the value is
+// the only thing taken from the query.
+func hybridLiteralSql(lit *plan.Literal, typ plan.Type) (string, bool) {
+	if lit.Isnull {
+		return "NULL", true
+	}
+	var str string
+	switch v := lit.Value.(type) {
+	case *plan.Literal_I8Val:
+		str = fmt.Sprint(v.I8Val)
+	case *plan.Literal_I16Val:
+		str = fmt.Sprint(v.I16Val)
+	case *plan.Literal_I32Val:
+		str = fmt.Sprint(v.I32Val)
+	case *plan.Literal_I64Val:
+		str = fmt.Sprint(v.I64Val)
+	case *plan.Literal_U8Val:
+		str = fmt.Sprint(v.U8Val)
+	case *plan.Literal_U16Val:
+		str = fmt.Sprint(v.U16Val)
+	case *plan.Literal_U32Val:
+		str = fmt.Sprint(v.U32Val)
+	case *plan.Literal_U64Val:
+		str = fmt.Sprint(v.U64Val)
+	case *plan.Literal_Fval:
+		str = strconv.FormatFloat(float64(v.Fval), 'g', -1, 32)
+	case *plan.Literal_Dval:
+		str = strconv.FormatFloat(v.Dval, 'g', -1, 64)
+	case *plan.Literal_Bval:
+		str = strconv.FormatBool(v.Bval)
+	case *plan.Literal_Sval:
+		str = v.Sval
+	case *plan.Literal_Dateval:
+		str = types.Date(v.Dateval).String()
+	case *plan.Literal_Datetimeval:
+		str = types.Datetime(v.Datetimeval).String2(typ.Scale)
+	case *plan.Literal_Decimal64Val:
+		str = types.Decimal64(v.Decimal64Val.A).Format(typ.Scale)
+	case *plan.Literal_Decimal128Val:
+		str = types.Decimal128{B0_63: uint64(v.Decimal128Val.A), B64_127: uint64(v.Decimal128Val.B)}.Format(typ.Scale)
+	default:
+		return "", false
+	}
+	if t := types.T(typ.Id); t == types.T_enum || t == types.T_any {
+		return "", false
+	}
+	return fmt.Sprintf("CAST('%s' AS %s)", hybridStringEscaper.Replace(str), FormatColType(typ)), true
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHybridFilterSql(t *testing.T) {
+	ctx := context.Background()
+	col := func(pos int32, typ types.T) *plan.Expr {
+		return &plan.Expr{
+			Typ:  plan.Type{Id: int32(typ)},
+			Expr: &plan.Expr_Col{Col: &plan.ColRef{RelPos: 1, ColPos: pos}},
+		}
+	}
+	fn := func(name string, args ...*plan.Expr) *plan.Expr {
+		expr, err := BindFuncExprImplByPlanExpr(ctx, name, args)
+		require.NoError(t, err)
+		return expr
+	}
+	scanNode := &plan.Node{
+		BindingTags: []int32{1},
+		TableDef: &plan.TableDef{Cols: []*plan.ColDef{
+			{Name: "id"},
+			{Name: "na`me"},
+		}},
+	}
+
+	scanNode.FilterList = []*plan.Expr{
+		fn(">", col(0, types.T_int64), makePlan2Int64ConstExprWithType(10)),
+		fn("=", col(1, types.T_varchar), makePlan2StringConstExprWithType(`x' OR '1'='1`)),
+	}
+	filter, pushed := hybridFilterSql(scanNode)
+	require.True(t, pushed)
+	require.Equal(t, "(`id` > CAST('10' AS BIGINT)) AND (`na``me` = CAST('x'' OR ''1''=''1' AS VARCHAR(12)))", filter)
+
+	// abs() is not written, the other filter is still pushed down
+	scanNode.FilterList = append(scanNode.FilterList, fn(">", fn("abs", col(0, types.T_int64)), makePlan2Int64ConstExprWithType(1)))
+	filter, pushed = hybridFilterSql(scanNode)
+	require.False(t, pushed)
+	require.Contains(t, filter, "`id` > CAST('10' AS BIGINT)")
+	require.NotContains(t, filter, "abs")
+
+	// column of another table
+	_, ok := hybridExprSql(&plan.Expr{Expr: &plan.Expr_Col{Col: &plan.ColRef{RelPos: 2}}}, scanNode)
+	require.False(t, ok)
+}
@@ -15,9 +15,13 @@
 package plan
 
 import (
+	"encoding/json"
+	"math"
+
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vectorindex/hnsw"
 )
 
 var (
@@ -34,22 +38,69 @@ var (
 			2. Nodes that require BindingTags: TableScan, Project
 	*/
 	distFuncOpTypes = map[string]string{
-		"l2_distance":     "vector_l2_ops",
-		"inner_product":   "vector_ip_ops",
-		"cosine_distance": "vector_cosine_ops",
+		"l2_distance":      "vector_l2_ops",
+		"inner_product":    "vector_ip_ops",
+		"cosine_distance":  "vector_cosine_ops",
+		"hamming_distance": "vector_hamming_ops",
 	}
 	distFuncInternalDistFunc = map[string]string{
-		"l2_distance":     "l2_distance_sq",
-		"inner_product":   "spherical_distance",
-		"cosine_distance": "spherical_distance",
+		"l2_distance":      "l2_distance_sq",
+		"inner_product":    "spherical_distance",
+		"cosine_distance":  "spherical_distance",
+		"hamming_distance": "hamming_distance",
 	}
 	textType = types.T_text.ToType() // return type of @probe_limit
 )
 
+const (
+	// A filtered vector search is done by brute force, without the index, when the filters of the scan are
+	// expected to return no more rows than vectorBruteForceRows.
+	vectorBruteForceRows = 8192
+	// Below vectorPreFilterSelectivity, the filters are applied before the index is searched. Above, the
+	// index is searched first, and over-fetches by 1/selectivity*vectorOverFetchMargin (at most
+	// vectorMaxOverFetch times the limit) to make up for the rows removed by the filters.
+	vectorPreFilterSelectivity = 0.05
+	vectorOverFetchMargin      = 1.5
+	vectorMaxOverFetch         = 64
+)
+
+// vectorFilterStrategy is how the filters of the scan are combined with a top-k vector search.
+type vectorFilterStrategy int
+
+const (
+	vectorNoFilter vectorFilterStrategy = iota
+	// vectorBruteForce sorts the filtered rows by the exact distance, the index is not used.
+	vectorBruteForce
+	// vectorPreFilter searches the index among the rows that pass the filters only.
+	vectorPreFilter
+	// vectorPostFilter searches the index for more candidates than the limit and then filters them.
+	vectorPostFilter
+)
+
+// chooseVectorFilterStrategy picks the strategy from the estimated selectivity of the filters of the scan.
+// The second result is the over-fetch factor of vectorPostFilter, and 1 otherwise.
+func chooseVectorFilterStrategy(scanNode *plan.Node) (vectorFilterStrategy, float64) {
+	if len(scanNode.FilterList) == 0 {
+		return vectorNoFilter, 1
+	}
+	stats := scanNode.Stats
+	if stats == nil {
+		return vectorPostFilter, vectorOverFetchMargin
+	}
+	if stats.Outcnt <= vectorBruteForceRows {
+		return vectorBruteForce, 1
+	}
+	if stats.Selectivity < vectorPreFilterSelectivity {
+		return vectorPreFilter, 1
+	}
+	return vectorPostFilter, math.Min(vectorOverFetchMargin/math.Max(stats.Selectivity, 1e-6), vectorMaxOverFetch)
+}
+
 // You replace Sort Node with a new Project Node
+// probeFactor multiplies @probe_limit, to find enough rows that pass the filters of the scan.
 func (builder *QueryBuilder) applyIndicesForSortUsingVectorIndex(nodeID int32, projNode, sortNode, scanNode *plan.Node,
 	colRefCnt map[[2]int32]int, idxColMap map[[2]int32]*plan.Expr, multiTableIndexWithSortDistFn *MultiTableIndex,
-	colPosOrderBy int32) int32 {
+	colPosOrderBy int32, probeFactor uint64) int32 {
 
 	var pkPos = scanNode.TableDef.Name2ColIndex[scanNode.TableDef.Pkey.PkeyColName] //TODO: watch out.
 
@@ -100,7 +151,8 @@ func (builder *QueryBuilder) applyIndicesForSortUsingVectorIndex(nodeID int32, p
 	metaForCurrVersion1, castMetaValueColToBigInt, _ := makeMetaTblScanWhereKeyEqVersionAndCastVersion(builder, builder.ctxByNode[nodeID],
 		idxTableDefs, idxObjRefs, idxTags, "meta")
 	centroidsForCurrVersionAndProbeLimit, _ := makeCentroidsSingleJoinMetaOnCurrVersionOrderByL2Dist(builder,
-		builder.ctxByNode[nodeID], idxTableDefs, idxObjRefs, idxTags, metaForCurrVersion1, distFnExpr, sortDirection, castMetaValueColToBigInt)
+		builder.ctxByNode[nodeID], idxTableDefs, idxObjRefs, idxTags, metaForCurrVersion1, distFnExpr, sortDirection, castMetaValueColToBigInt,
+		probeFactor)
 
 	// 2.c Create Entries Node
 	entriesTblScan, _ := makeEntriesTblScan(builder, builder.ctxByNode[nodeID], idxTableDefs, idxObjRefs, idxTags)
@@ -195,6 +247,180 @@ func (builder *QueryBuilder) applyIndicesForSortUsingVectorIndex(nodeID int32, p
 	}
 }
 
+// canApplyHnswIndex checks that the sort node is a top-k search the HNSW graph can answer:
+// ORDER BY l2_distance/cosine_distance ASC or inner_product DESC, with a constant LIMIT, on a scan
+// without time travel.
+func canApplyHnswIndex(sortNode, scanNode *plan.Node) bool {
+	if _, ok := hnswSearchLimit(sortNode); !ok {
+		return false
+	}
+	if scanNode.ScanSnapshot != nil && scanNode.ScanSnapshot.TS != nil && !scanNode.ScanSnapshot.TS.IsEmpty() {
+		return false
+	}
+
+	desc := sortNode.OrderBy[0].Flag&plan.OrderBySpec_DESC != 0
+	if sortNode.OrderBy[0].Expr.GetF().Func.ObjName == "inner_product" {
+		return desc
+	}
+	return !desc
+}
+
+// hnswSearchLimit returns LIMIT + OFFSET of the sort node, which is the number of rows the graph has to find.
+func hnswSearchLimit(sortNode *plan.Node) (int64, bool) {
+	getU64 := func(expr *plan.Expr) (uint64, bool) {
+		if lit := expr.GetLit(); lit != nil {
+			if c, ok := lit.Value.(*plan.Literal_U64Val); ok {
+				return c.U64Val, true
+			}
+		}
+		return 0, false
+	}
+
+	if sortNode.Limit == nil {
+		return 0, false
+	}
+	limit, ok := getU64(sortNode.Limit)
+	if !ok {
+		return 0, false
+	}
+	if sortNode.Offset != nil {
+		offset, ok := getU64(sortNode.Offset)
+		if !ok {
+			return 0, false
+		}
+		limit += offset
+	}
+	if limit == 0 || limit > math.MaxInt32 {
+		return 0, false
+	}
+	return int64(limit), true
+}
+
+// applyIndicesForSortUsingHnswIndex joins the table with the candidates found by the HNSW graph on the primary key:
+//
+//	SORT (order by dist_fn(col, literal) limit k)
+//	  └── JOIN tbl.pk = hnsw_search.pk
+//	        ├── TABLE_SCAN tbl
+//	        └── FUNCTION_SCAN hnsw_search(params, literal)
+//
+// The sort node is kept, so the candidates are re-ranked with the exact distance function. With
+// vectorPostFilter, the graph returns overFetch times more candidates. With vectorPreFilter, hnsw_search
+// reads the primary keys of a second scan of the table with the same filters, and searches among them only:
+//
+//	FUNCTION_SCAN hnsw_search(params, literal, tbl.pk)
+//	  └── TABLE_SCAN tbl
+func (builder *QueryBuilder) applyIndicesForSortUsingHnswIndex(nodeID int32, sortNode, scanNode *plan.Node,
+	multiTableIndex *MultiTableIndex, strategy vectorFilterStrategy, overFetch float64) {
+
+	bindCtx := builder.ctxByNode[nodeID]
+	distFnExpr := sortNode.OrderBy[0].Expr.GetF()
+	limit, _ := hnswSearchLimit(sortNode)
+	if strategy == vectorPostFilter {
+		limit = int64(math.Ceil(float64(limit) * overFetch))
+	}
+
+	metaDef := multiTableIndex.IndexDefs[catalog.SystemSI_HNSW_TblType_Metadata]
+	storageDef := multiTableIndex.IndexDefs[catalog.SystemSI_HNSW_TblType_Storage]
+	pkName := scanNode.TableDef.Pkey.PkeyColName
+	pkPos := scanNode.TableDef.Name2ColIndex[pkName]
+	pkType := scanNode.TableDef.Cols[pkPos].Typ
+
+	// 1. hnsw_search(params, literal)
+	params, _ := json.Marshal(hnsw.SearchParam{
+		DbName:       scanNode.ObjRef.SchemaName,
+		SrcTable:     scanNode.TableDef.Name,
+		MetaTable:    metaDef.IndexTableName,
+		StorageTable: storageDef.IndexTableName,
+		PkCol:        pkName,
+		VecCol:       metaDef.Parts[0],
+		AlgoParams:   metaDef.IndexAlgoParams,
+		Limit:        limit,
+		Filtered:     strategy == vectorPreFilter,
+	})
+	args := []*plan.Expr{DeepCopyExpr(distFnExpr.Args[1])}
+	var childId int32
+	if strategy == vectorPreFilter {
+		childId = builder.copyScanNodeWithNewTag(bindCtx, scanNode)
+		args = append(args, &plan.Expr{
+			Typ: pkType,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: builder.qry.Nodes[childId].BindingTags[0],
+					ColPos: pkPos, // tbl.pk of the copy
+				},
+			},
+		})
+	} else {
+		childId = builder.appendNode(&plan.Node{
+			NodeType: plan.Node_VALUE_SCAN,
+		}, bindCtx)
+	}
+	searchId := builder.makeHnswSearchNode(bindCtx, params, pkType, args, childId)
+	searchNode := builder.qry.Nodes[searchId]
+
+	// 2. JOIN tbl and hnsw_search on tbl.pk = hnsw_search.pk
+	joinCond, _ := BindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*Expr{
+		{
+			Typ: pkType,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: scanNode.BindingTags[0],
+					ColPos: pkPos, // tbl.pk
+				},
+			},
+		},
+		{
+			Typ: searchNode.TableDef.Cols[0].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: searchNode.BindingTags[0],
+					ColPos: 0, // hnsw_search.pk
+				},
+			},
+		},
+	})
+	joinId := builder.appendNode(&plan.Node{
+		NodeType: plan.Node_JOIN,
+		JoinType: plan.Node_INNER,
+		Children: []int32{scanNode.NodeId, searchId},
+		OnList:   []*Expr{joinCond},
+	}, bindCtx)
+
+	sortNode.Children[0] = joinId
+}
+
+// copyScanNodeWithNewTag appends a copy of the table scan, filters included, which is bound to a new tag.
+func (builder *QueryBuilder) copyScanNodeWithNewTag(bindCtx *BindContext, scanNode *plan.Node) int32 {
+	oldTag, newTag := scanNode.BindingTags[0], builder.genNewTag()
+	node := DeepCopyNode(scanNode)
+	node.BindingTags = []int32{newTag}
+	for _, expr := range node.FilterList {
+		replaceColRefTag(expr, oldTag, newTag)
+	}
+	for _, expr := range node.BlockFilterList {
+		replaceColRefTag(expr, oldTag, newTag)
+	}
+	builder.addNameByColRef(newTag, node.TableDef)
+	return builder.appendNode(node, bindCtx)
+}
+
+func replaceColRefTag(expr *plan.Expr, oldTag, newTag int32) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		if e.Col.RelPos == oldTag {
+			e.Col.RelPos = newTag
+		}
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			replaceColRefTag(arg, oldTag, newTag)
+		}
+	case *plan.Expr_List:
+		for _, arg := range e.List.List {
+			replaceColRefTag(arg, oldTag, newTag)
+		}
+	}
+}
+
 func makeMetaTblScanWhereKeyEqVersionAndCastVersion(builder *QueryBuilder, bindCtx *BindContext,
 	indexTableDefs []*TableDef, idxRefs []*ObjectRef, idxTags map[string]int32, prefix string) (int32, *Expr, error) {
 
@@ -223,7 +449,8 @@ func makeMetaTblScanWhereKeyEqVersionAndCastVersion(builder *QueryBuilder, bindC
 
 func makeCentroidsSingleJoinMetaOnCurrVersionOrderByL2Dist(builder *QueryBuilder, bindCtx *BindContext,
 	indexTableDefs []*TableDef, idxRefs []*ObjectRef, idxTags map[string]int32,
-	metaTableScanId int32, distFnExpr *plan.Function, sortDirection plan.OrderBySpec_OrderByFlag, castMetaValueColToBigInt *Expr) (int32, error) {
+	metaTableScanId int32, distFnExpr *plan.Function, sortDirection plan.OrderBySpec_OrderByFlag, castMetaValueColToBigInt *Expr,
+	probeFactor uint64) (int32, error) {
 
 	// 1. Scan <version, centroid_id, centroid> from centroids table
 	centroidsScanId, scanCols, _ := makeHiddenTblScanWithBindingTag(builder, bindCtx, indexTableDefs[1], idxRefs[1],
@@ -304,6 +531,17 @@ func makeCentroidsSingleJoinMetaOnCurrVersionOrderByL2Dist(builder *QueryBuilder
 		return -1, err
 	}
 
+	// 4.5 @probe_limit * probe_factor
+	if probeFactor > 1 {
+		ifNullLimitExpr, err = BindFuncExprImplByPlanExpr(builder.GetContext(), "*", []*plan.Expr{
+			ifNullLimitExpr,
+			makePlan2Uint64ConstExprWithType(probeFactor),
+		})
+		if err != nil {
+			return -1, err
+		}
+	}
+
 	sortCentroidsByL2DistanceId := builder.appendNode(&plan.Node{
 		NodeType: plan.Node_SORT,
 		Children: []int32{joinMetaAndCentroidsId},
@@ -0,0 +1,53 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChooseVectorFilterStrategy(t *testing.T) {
+	filter := []*plan.Expr{makePlan2BoolConstExprWithType(true)}
+
+	strategy, factor := chooseVectorFilterStrategy(&plan.Node{})
+	require.Equal(t, vectorNoFilter, strategy)
+	require.Equal(t, 1.0, factor)
+
+	strategy, _ = chooseVectorFilterStrategy(&plan.Node{
+		FilterList: filter,
+		Stats:      &plan.Stats{Outcnt: 500, Selectivity: 0.5, TableCnt: 1000},
+	})
+	require.Equal(t, vectorBruteForce, strategy)
+
+	strategy, _ = chooseVectorFilterStrategy(&plan.Node{
+		FilterList: filter,
+		Stats:      &plan.Stats{Outcnt: 50000, Selectivity: 0.01, TableCnt: 5000000},
+	})
+	require.Equal(t, vectorPreFilter, strategy)
+
+	strategy, factor = chooseVectorFilterStrategy(&plan.Node{
+		FilterList: filter,
+		Stats:      &plan.Stats{Outcnt: 100000, Selectivity: 0.1, TableCnt: 1000000},
+	})
+	require.Equal(t, vectorPostFilter, strategy)
+	require.InDelta(t, 15, factor, 1e-9)
+
+	strategy, factor = chooseVectorFilterStrategy(&plan.Node{FilterList: filter})
+	require.Equal(t, vectorPostFilter, strategy)
+	require.Equal(t, vectorOverFetchMargin, factor)
+}
@@ -311,6 +311,7 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 	relPos := NotFound
 	colPos := NotFound
 	var typ *plan.Type
+	var rewritten *plan.Expr
 	localErrCtx := errutil.ContextWithNoReport(b.GetContext(), true)
 
 	if len(table) == 0 {
@@ -320,6 +321,7 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 				colPos = binding.colIdByName[col]
 				typ = DeepCopyType(binding.types[colPos])
 				table = binding.table
+				rewritten = binding.rewrittenColumn(colPos)
 			} else {
 				return nil, moerr.NewInvalidInputf(b.GetContext(), "ambiguous column reference '%v'", name)
 			}
@@ -335,6 +337,7 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 			if colPos != NotFound {
 				typ = DeepCopyType(binding.types[colPos])
 				relPos = binding.tag
+				rewritten = binding.rewrittenColumn(colPos)
 			} else {
 				err = moerr.NewInvalidInputf(localErrCtx, "column '%s' does not exist", name)
 			}
@@ -385,7 +388,9 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 			Typ: *typ,
 		}
 
-		if depth == 0 {
+		if depth == 0 && rewritten != nil {
+			expr = DeepCopyExpr(rewritten)
+		} else if depth == 0 {
 			expr.Expr = &plan.Expr_Col{
 				Col: &plan.ColRef{
 					RelPos: relPos,
@@ -393,6 +398,10 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 					Name:   col,
 				},
 			}
+		} else if value, ok := b.ctx.lateralConsts[[2]int32{relPos, colPos}]; ok {
+			// the constant left side of a LATERAL join is bound as its value
+			expr = DeepCopyExpr(value)
+			b.ctx.addLateralRef(relPos, colPos)
 		} else {
 			expr.Expr = &plan.Expr_Corr{
 				Corr: &plan.CorrColRef{
@@ -401,6 +410,9 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 					Depth:  depth,
 				},
 			}
+			if b.ctx.lateralLeft {
+				b.ctx.addLateralRef(relPos, colPos)
+			}
 		}
 		if err != nil {
 			errutil.ReportError(b.GetContext(), err)
@@ -424,6 +436,9 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 
 	if err == nil {
 		b.ctx.isCorrelated = true
+		if b.ctx.lateralLeft {
+			b.ctx.lateralOuterRef = true
+		}
 	}
 
 	return
@@ -1111,6 +1126,13 @@ func (b *baseBinder) bindFuncExprImplByAstExpr(name string, astArgs []tree.Expr,
 		}
 	}
 
+	if name == "fulltext_snippet" || name == "fulltext_highlight" {
+		var err error
+		if args, err = b.bindFullTextHighlightArgs(name, args); err != nil {
+			return nil, err
+		}
+	}
+
 	if b.builder != nil {
 		e, err := bindFuncExprAndConstFold(b.GetContext(), b.builder.compCtx.GetProcess(), name, args)
 		if err == nil {
@@ -101,6 +101,12 @@ func (builder *QueryBuilder) appendDedupAndMultiUpdateNodesForBindInsert(
 	selectNode := builder.qry.Nodes[lastNodeID]
 	selectNodeTag := selectNode.BindingTags[0]
 	partitionExprIdx := int32(len(selectNode.ProjectList) - 1)
+
+	// validate the CHECK constraints on the rows to insert
+	lastNodeID, err = builder.appendCheckConstraintNode(bindCtx, dmlCtx.tableDefs[0], lastNodeID, selectNodeTag, colName2Idx)
+	if err != nil {
+		return 0, err
+	}
 	idxObjRefs := make([][]*plan.ObjectRef, len(dmlCtx.tableDefs))
 	idxTableDefs := make([][]*plan.TableDef, len(dmlCtx.tableDefs))
 
@@ -517,6 +523,9 @@ func (builder *QueryBuilder) initInsertStmt(bindCtx *BindContext, stmt *tree.Ins
 	if insertColumns, err = builder.getInsertColsFromStmt(stmt, tableDef); err != nil {
 		return 0, nil, nil, err
 	}
+	if err = checkInsertGeneratedCols(builder.GetContext(), tableDef, insertColumns, stmt.Rows); err != nil {
+		return 0, nil, nil, err
+	}
 
 	var astSelect *tree.Select
 	switch selectImpl := stmt.Rows.Select.(type) {
@@ -643,6 +652,7 @@ func (builder *QueryBuilder) appendNodesForInsertStmt(
 	)
 
 	columnIsNull := make(map[string]bool)
+	colName2Proj1 := make(map[string]int)
 
 	for i, col := range tableDef.Cols {
 		if oldExpr, exists := insertColToExpr[col.Name]; exists {
@@ -655,6 +665,7 @@ func (builder *QueryBuilder) appendNodesForInsertStmt(
 					},
 				},
 			})
+			colName2Proj1[col.Name] = len(projList1)
 			projList1 = append(projList1, oldExpr)
 		} else if col.Name == catalog.Row_ID {
 			continue
@@ -701,7 +712,7 @@ func (builder *QueryBuilder) appendNodesForInsertStmt(
 				return 0, nil, nil, err
 			}
 
-			if !col.Typ.AutoIncr {
+			if !col.Typ.AutoIncr && col.Generated == nil {
 				if lit := defExpr.GetLit(); lit != nil {
 					if lit.Isnull {
 						columnIsNull[col.Name] = true
@@ -718,12 +729,17 @@ func (builder *QueryBuilder) appendNodesForInsertStmt(
 					},
 				},
 			})
+			colName2Proj1[col.Name] = len(projList1)
 			projList1 = append(projList1, defExpr)
 		}
 
 		colName2Idx[tableDef.Name+"."+col.Name] = int32(i)
 	}
 
+	if err := fillGeneratedColExprs(builder.GetContext(), tableDef, projList1, colName2Proj1); err != nil {
+		return 0, nil, nil, err
+	}
+
 	skipUniqueIdx := make([]bool, len(tableDef.Indexes))
 	pkName := tableDef.Pkey.PkeyColName
 	pkPos := tableDef.Name2ColIndex[pkName]
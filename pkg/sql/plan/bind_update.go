@@ -215,6 +215,28 @@ func (builder *QueryBuilder) bindUpdate(stmt *tree.Update, bindCtx *BindContext)
 	}
 
 	selectNodeTag := selectNode.BindingTags[0]
+
+	// validate the CHECK constraints on the new rows
+	for i, alias := range dmlCtx.aliases {
+		tableDef := dmlCtx.tableDefs[i]
+		if len(dmlCtx.updateCol2Expr[i]) == 0 || len(tableDef.Checks) == 0 {
+			continue
+		}
+
+		checkColPos := make(map[string]int32, len(tableDef.Cols))
+		for _, col := range tableDef.Cols {
+			colPos, ok := updateColName2Idx[alias+"."+col.Name]
+			if !ok {
+				colPos = colName2Idx[alias+"."+col.Name]
+			}
+			checkColPos[tableDef.Name+"."+col.Name] = colPos
+		}
+		lastNodeID, err = builder.appendCheckConstraintNode(bindCtx, tableDef, lastNodeID, selectNodeTag, checkColPos)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	idxScanNodes := make([][]*plan.Node, len(dmlCtx.tableDefs))
 	idxNeedUpdate := make([][]bool, len(dmlCtx.tableDefs))
 
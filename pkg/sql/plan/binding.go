@@ -52,3 +52,13 @@ func NewBinding(tag, nodeID int32, db, table string, tableID uint64, cols []stri
 
 	return binding
 }
+
+// rewrittenColumn returns the expression which replaces a reference to the column
+// at colPos, or nil if the column is read as it is.
+func (b *Binding) rewrittenColumn(colPos int32) *plan.Expr {
+	if expr, ok := b.generated[colPos]; ok {
+		// the VIRTUAL generated column is computed from the other columns
+		return expr
+	}
+	return b.lateralCounts[colPos]
+}
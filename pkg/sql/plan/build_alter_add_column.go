@@ -57,6 +57,11 @@ func AddColumn(ctx CompilerContext, alterPlan *plan.AlterTable, spec *tree.Alter
 	if err = handleAddColumnPosition(ctx.GetContext(), tableDef, newCol, spec.Position); err != nil {
 		return err
 	}
+	if newCol.Generated != nil {
+		if err = checkAddedGeneratedColOrder(ctx.GetContext(), tableDef, newCol); err != nil {
+			return err
+		}
+	}
 
 	if !newCol.Default.NullAbility && len(newCol.Default.OriginString) == 0 {
 		alterCtx.alterColMap[newCol.Name] = selectExpr{
@@ -106,6 +111,7 @@ func buildAddColumnAndConstraint(ctx CompilerContext, alterPlan *plan.AlterTable
 
 	hasDefaultValue := false
 	auto_incr := false
+	var generated *tree.AttributeGeneratedAlways
 	for _, attr := range specNewColumn.Attributes {
 		switch attribute := attr.(type) {
 		case *tree.AttributePrimaryKey, *tree.AttributeKey:
@@ -173,6 +179,11 @@ func buildAddColumnAndConstraint(ctx CompilerContext, alterPlan *plan.AlterTable
 				return nil, err
 			}
 			newCol.OnUpdate = onUpdateExpr
+		case *tree.AttributeGeneratedAlways:
+			if err = checkGeneratedColAttrs(ctx.GetContext(), specNewColumn); err != nil {
+				return nil, err
+			}
+			generated = attribute
 			//default:
 			//	return nil, moerr.NewNotSupported(ctx.GetContext(), "unsupport column definition %v", attribute)
 		}
@@ -195,6 +206,17 @@ func buildAddColumnAndConstraint(ctx CompilerContext, alterPlan *plan.AlterTable
 		}
 		newCol.Default = defaultValue
 	}
+
+	// the generated column is computed for the existing rows by the insert
+	// copying them, see buildAlterTableCopy
+	if generated != nil {
+		if !generated.Stored && newCol.Primary {
+			return nil, moerr.NewNotSupportedf(ctx.GetContext(), "defining a virtual generated column '%s' as primary key", newColNameOrigin)
+		}
+		if newCol.Generated, err = buildGeneratedColDef(ctx, alterPlan.CopyTableDef, newCol, generated); err != nil {
+			return nil, err
+		}
+	}
 	return newCol, nil
 }
 
@@ -207,7 +229,7 @@ func checkAddColumnType(ctx context.Context, colType *plan.Type, columnName stri
 		}
 	}
 
-	if colType.Id == int32(types.T_array_float32) || colType.Id == int32(types.T_array_float64) {
+	if types.T(colType.Id).IsVector() {
 		if colType.GetWidth() > types.MaxArrayDimension {
 			return moerr.NewInvalidInputf(ctx, "vector width (%d) is too long", colType.GetWidth())
 		}
@@ -334,6 +356,12 @@ func DropColumn(ctx CompilerContext, alterPlan *plan.AlterTable, colName string,
 	if err := checkDropColumnWithForeignKey(ctx, tableDef, col); err != nil {
 		return err
 	}
+	if err := checkAlterColumnWithCheck(ctx.GetContext(), tableDef, colName); err != nil {
+		return err
+	}
+	if err := checkAlterColumnWithGenerated(ctx.GetContext(), tableDef, colName); err != nil {
+		return err
+	}
 	if err := checkVisibleColumnCnt(ctx.GetContext(), tableDef, 0, 1); err != nil {
 		return err
 	}
@@ -404,6 +432,12 @@ func handleDropColumnWithIndex(ctx context.Context, colName string, tbInfo *Tabl
 					// remove 3 index records: metadata, centroids, entries
 					tbInfo.Indexes = append(tbInfo.Indexes[:i], tbInfo.Indexes[i+3:]...)
 				}
+			case catalog.MoIndexHnswAlgo.ToString():
+				// hnsw index
+				if len(indexInfo.Parts) == 0 {
+					// remove 2 index records: metadata, storage
+					tbInfo.Indexes = append(tbInfo.Indexes[:i], tbInfo.Indexes[i+2:]...)
+				}
 			case catalog.MOIndexMasterAlgo.ToString():
 				if len(indexInfo.Parts) == 0 {
 					// TODO: verify this
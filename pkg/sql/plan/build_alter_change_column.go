@@ -55,6 +55,14 @@ func ChangeColumn(ctx CompilerContext, alterPlan *plan.AlterTable, spec *tree.Al
 	if newColName != oldColName && FindColumn(tableDef.Cols, newColName) != nil {
 		return moerr.NewErrDupFieldName(ctx.GetContext(), newColName)
 	}
+	if newColName != oldColName {
+		if err := checkAlterColumnWithCheck(ctx.GetContext(), tableDef, oldColName); err != nil {
+			return err
+		}
+	}
+	if err := checkAlterColumnWithGenerated(ctx.GetContext(), tableDef, oldColName); err != nil {
+		return err
+	}
 
 	//change the name of the column in the foreign key constraint
 	if newColNameOrigin != oldColNameOrigin {
@@ -51,6 +51,9 @@ func ModifyColumn(ctx CompilerContext, alterPlan *plan.AlterTable, spec *tree.Al
 	if err != nil {
 		return err
 	}
+	if err = checkAlterColumnWithGenerated(ctx.GetContext(), tableDef, colName); err != nil {
+		return err
+	}
 
 	// Check new column foreign key constraints
 	if err = CheckModifyColumnForeignkeyConstraint(ctx, tableDef, col, newCol); err != nil {
@@ -60,6 +60,12 @@ func RenameColumn(ctx CompilerContext, alterPlan *plan.AlterTable, spec *tree.Al
 		if FindColumn(tableDef.Cols, newColName) != nil {
 			return moerr.NewErrDupFieldName(ctx.GetContext(), newColNameOrigin)
 		}
+		if err := checkAlterColumnWithCheck(ctx.GetContext(), tableDef, oldColName); err != nil {
+			return err
+		}
+		if err := checkAlterColumnWithGenerated(ctx.GetContext(), tableDef, oldColName); err != nil {
+			return err
+		}
 
 		// If the column name of the table changes, it is necessary to check if it is associated
 		// with the index key. If it is an index key column, column name replacement is required.
@@ -149,6 +149,12 @@ func buildAlterTableCopy(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, err
 	}
 	alterTablePlan.CreateTmpTableSql = createTmpDdl
 
+	// the generated columns of the copy table are computed again by the insert
+	for _, col := range copyTableDef.Cols {
+		if col.Generated != nil {
+			delete(alterTableCtx.alterColMap, col.Name)
+		}
+	}
 	insertTmpDml, err := buildAlterInsertDataSQL(ctx, alterTableCtx)
 	if err != nil {
 		return nil, err
@@ -427,7 +433,7 @@ func buildNotNullColumnVal(col *ColDef) string {
 	} else if col.Typ.Id == int32(types.T_enum) {
 		enumvalues := strings.Split(col.Typ.Enumvalues, ",")
 		defaultValue = enumvalues[0]
-	} else if col.Typ.Id == int32(types.T_array_float32) || col.Typ.Id == int32(types.T_array_float64) {
+	} else if types.T(col.Typ.Id).IsVector() {
 		if col.Typ.Width > 0 {
 			zerosWithCommas := strings.Repeat("0,", int(col.Typ.Width)-1)
 			arrayAsString := zerosWithCommas + "0" // final zero
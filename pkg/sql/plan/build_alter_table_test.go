@@ -14,7 +14,11 @@
 
 package plan
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
 
 func TestAlterTable1(t *testing.T) {
 	//sql := "ALTER TABLE t1 ADD (d TIMESTAMP, e INT not null);"
@@ -40,3 +44,24 @@ func TestAlterTableAddColumns(t *testing.T) {
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 }
+
+func TestAlterTableAddGeneratedColumn(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// CREATE TABLE t1 (a INTEGER, b CHAR(10));
+	logicPlan, err := runOneStmt(mock, t, "ALTER TABLE t1 ADD c INT AS (a + 1) STORED")
+	require.NoError(t, err)
+	alterTable := logicPlan.GetDdl().GetAlterTable()
+	col := FindColumn(alterTable.CopyTableDef.Cols, "c")
+	require.NotNil(t, col)
+	require.True(t, col.Generated.Stored)
+	// the generated column is computed by the insert copying the rows
+	require.NotContains(t, alterTable.InsertTmpDataSql, "`c`")
+
+	sqls := []string{
+		"ALTER TABLE t1 ADD c INT AS (c + 1)",
+		"ALTER TABLE t1 ADD c INT AS (a + 1) DEFAULT 1",
+		"ALTER TABLE t1 ADD c DOUBLE AS (rand())",
+		"ALTER TABLE t1 ADD c INT AS (a + 1) VIRTUAL PRIMARY KEY",
+	}
+	runTestShouldError(mock, t, sqls)
+}
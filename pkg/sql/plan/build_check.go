@@ -0,0 +1,310 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+)
+
+// checkInfo is a CHECK constraint of the CREATE TABLE statement. column is
+// set for the constraint declared as a column attribute, which may only refer
+// to that column.
+type checkInfo struct {
+	def    *tree.CheckIndex
+	column string
+}
+
+// buildCheckDefs binds the CHECK constraints of a new table and appends them
+// to the table definition.
+func buildCheckDefs(ctx CompilerContext, tableDef *TableDef, infos []checkInfo) error {
+	for _, info := range infos {
+		check, err := buildCheckDef(ctx, tableDef, info.def, info.column)
+		if err != nil {
+			return err
+		}
+		tableDef.Checks = append(tableDef.Checks, check)
+	}
+	return nil
+}
+
+// buildCheckDef binds the CHECK constraint against the columns of the table.
+// The bound expression keeps the column names, it is remapped onto the rows
+// of the DML plans by getRemapCheckExpr.
+func buildCheckDef(ctx CompilerContext, tableDef *TableDef, def *tree.CheckIndex, column string) (*plan.CheckDef, error) {
+	name := def.Name
+	if name == "" {
+		name = genCheckName(tableDef)
+	}
+	for _, check := range tableDef.Checks {
+		if check.Name == name {
+			return nil, moerr.NewInvalidInputf(ctx.GetContext(), "duplicate check constraint name '%s'", name)
+		}
+	}
+
+	builder, bindContext, err := newTableBindContext(ctx, tableDef)
+	if err != nil {
+		return nil, err
+	}
+	expr, err := NewCheckBinder(builder, bindContext).BindExpr(def.Expr, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	if expr.Typ.Id != int32(types.T_bool) {
+		expr, err = forceCastExpr(ctx.GetContext(), expr, plan.Type{Id: int32(types.T_bool)})
+		if err != nil {
+			return nil, moerr.NewInvalidInputf(ctx.GetContext(), "an expression of non-boolean type specified to check constraint '%s'", name)
+		}
+	}
+	if err = checkCheckExpr(ctx.GetContext(), expr, tableDef, name, column); err != nil {
+		return nil, err
+	}
+
+	return &plan.CheckDef{
+		Name:        name,
+		Check:       expr,
+		NotEnforced: !def.Enforced,
+		ExprStr:     tree.StringWithOpts(def.Expr, dialect.MYSQL, tree.WithQuoteString(true)),
+	}, nil
+}
+
+// newTableBindContext makes a bind context where the columns of the table are
+// referred by their names, for the expressions kept in the table definition.
+func newTableBindContext(ctx CompilerContext, tableDef *TableDef) (*QueryBuilder, *BindContext, error) {
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx, false, false)
+	bindContext := NewBindContext(builder, nil)
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		TableDef:    tableDef,
+		BindingTags: []int32{builder.genNewTag()},
+	}, bindContext)
+	if err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext); err != nil {
+		return nil, nil, err
+	}
+	return builder, bindContext, nil
+}
+
+// genCheckName generates the name of an anonymous CHECK constraint like MySQL
+// does, i.e. <table>_chk_<n>.
+func genCheckName(tableDef *TableDef) string {
+	names := make(map[string]bool, len(tableDef.Checks))
+	for _, check := range tableDef.Checks {
+		names[check.Name] = true
+	}
+	for i := 1; ; i++ {
+		name := fmt.Sprintf("%s_chk_%d", tableDef.Name, i)
+		if !names[name] {
+			return name
+		}
+	}
+}
+
+// checkCheckExpr rejects the functions and columns which are not allowed in
+// a CHECK constraint.
+func checkCheckExpr(ctx context.Context, expr *Expr, tableDef *TableDef, name string, column string) error {
+	switch ne := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		if column != "" && ne.Col.Name != column {
+			return moerr.NewInvalidInputf(ctx, "column check constraint '%s' references other column", name)
+		}
+		for _, col := range tableDef.Cols {
+			if col.Name == ne.Col.Name && col.Typ.AutoIncr {
+				return moerr.NewInvalidInputf(ctx, "check constraint '%s' cannot refer to an auto-increment column", name)
+			}
+		}
+
+	case *plan.Expr_F:
+		if f, exists := function.GetFunctionByIdWithoutError(ne.F.Func.GetObj()); exists &&
+			(f.CannotFold() || f.IsRealTimeRelated()) {
+			return moerr.NewInvalidInputf(ctx, "an expression of check constraint '%s' contains disallowed function: %s", name, ne.F.Func.ObjName)
+		}
+		for _, arg := range ne.F.Args {
+			if err := checkCheckExpr(ctx, arg, tableDef, name, column); err != nil {
+				return err
+			}
+		}
+
+	case *plan.Expr_List:
+		for _, arg := range ne.List.List {
+			if err := checkCheckExpr(ctx, arg, tableDef, name, column); err != nil {
+				return err
+			}
+		}
+
+	case *plan.Expr_P, *plan.Expr_V, *plan.Expr_Sub:
+		return moerr.NewInvalidInputf(ctx, "an expression of check constraint '%s' is not allowed", name)
+	}
+	return nil
+}
+
+// getCheckCols collects the names of the columns referenced by the CHECK
+// constraint into colNameMap.
+func getCheckCols(expr *Expr, colNameMap map[string]bool) {
+	switch ne := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		colNameMap[ne.Col.Name] = true
+	case *plan.Expr_F:
+		for _, arg := range ne.F.Args {
+			getCheckCols(arg, colNameMap)
+		}
+	case *plan.Expr_List:
+		for _, arg := range ne.List.List {
+			getCheckCols(arg, colNameMap)
+		}
+	}
+}
+
+// checkAlterColumnWithCheck rejects dropping or renaming the column which is
+// referenced by a CHECK constraint.
+func checkAlterColumnWithCheck(ctx context.Context, tableDef *TableDef, colName string) error {
+	for _, check := range tableDef.Checks {
+		cols := make(map[string]bool)
+		getCheckCols(check.Check, cols)
+		if cols[colName] {
+			return moerr.NewInvalidInputf(ctx, "check constraint '%s' uses column '%s', hence column cannot be dropped or renamed", check.Name, colName)
+		}
+	}
+	return nil
+}
+
+// genSqlForCheckConstraint generates the sql validating the existing rows of
+// the table against the CHECK constraint added or enforced by ALTER TABLE.
+func genSqlForCheckConstraint(dbName, tableName string, check *plan.CheckDef) string {
+	msg := fmt.Sprintf("Check constraint '%s' is violated.", check.Name)
+	return fmt.Sprintf("select assert(count(*) = 0, '%s') from `%s`.`%s` where not (%s)",
+		sqlStringEscaper.Replace(msg), sqlIdentEscaper.Replace(dbName), sqlIdentEscaper.Replace(tableName), check.ExprStr)
+}
+
+var (
+	sqlStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `''`)
+	sqlIdentEscaper  = strings.NewReplacer("`", "``")
+)
+
+// getRemapCheckExpr remaps the CHECK constraint onto the written rows, where
+// the generated columns which are not written are NULL.
+func getRemapCheckExpr(check *plan.CheckDef, tableDef *TableDef, relPos int32, colPosMap map[string]int32, containTableName bool) (*Expr, error) {
+	expr := expandVirtualColRefs(tableDef, DeepCopyExpr(check.Check))
+	if err := remapCheckExprColRef(expr, tableDef.Name, relPos, colPosMap, containTableName); err != nil {
+		return nil, err
+	}
+	return expr, nil
+}
+
+func remapCheckExprColRef(expr *Expr, tableName string, relPos int32, colPosMap map[string]int32, containTableName bool) error {
+	switch ne := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		colName := ne.Col.Name
+		if containTableName {
+			colName = tableName + "." + colName
+		}
+		colPos, ok := colPosMap[colName]
+		if !ok {
+			return moerr.NewInternalErrorNoCtxf("can not find col %s in check constraint", ne.Col.Name)
+		}
+		ne.Col.RelPos = relPos
+		ne.Col.ColPos = colPos
+
+	case *plan.Expr_F:
+		for _, arg := range ne.F.Args {
+			if err := remapCheckExprColRef(arg, tableName, relPos, colPosMap, containTableName); err != nil {
+				return err
+			}
+		}
+
+	case *plan.Expr_List:
+		for _, arg := range ne.List.List {
+			if err := remapCheckExprColRef(arg, tableName, relPos, colPosMap, containTableName); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// makeCheckFilters makes an assert() for every enforced CHECK constraint of
+// the table, which fails the statement once a written row makes the
+// constraint false. Rows making it unknown(NULL) are allowed.
+func makeCheckFilters(ctx context.Context, tableDef *TableDef, relPos int32, colPosMap map[string]int32, containTableName bool) ([]*Expr, error) {
+	var filters []*Expr
+	for _, check := range tableDef.Checks {
+		if check.NotEnforced {
+			continue
+		}
+		expr, err := getRemapCheckExpr(check, tableDef, relPos, colPosMap, containTableName)
+		if err != nil {
+			return nil, err
+		}
+		cond, err := BindFuncExprImplByPlanExpr(ctx, "isnotfalse", []*Expr{expr})
+		if err != nil {
+			return nil, err
+		}
+		errExpr := makePlan2StringConstExprWithType(fmt.Sprintf("Check constraint '%s' is violated.", check.Name))
+		filter, err := BindFuncExprImplByPlanExpr(ctx, "assert", []*Expr{cond, errExpr})
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// appendCheckConstraintNode appends a filter validating the CHECK constraints
+// for the rows bound by the node with tag relPos. colName2Idx is keyed by
+// "table.column".
+func (builder *QueryBuilder) appendCheckConstraintNode(
+	bindCtx *BindContext,
+	tableDef *TableDef,
+	lastNodeID int32,
+	relPos int32,
+	colName2Idx map[string]int32,
+) (int32, error) {
+	filters, err := makeCheckFilters(builder.GetContext(), tableDef, relPos, colName2Idx, true)
+	if err != nil || len(filters) == 0 {
+		return lastNodeID, err
+	}
+	return builder.appendNode(&plan.Node{
+		NodeType:   plan.Node_FILTER,
+		Children:   []int32{lastNodeID},
+		FilterList: filters,
+	}, bindCtx), nil
+}
+
+// appendCheckConstraintPlan is appendCheckConstraintNode for the DML plans
+// built after createQuery(), whose nodes refer to the columns of their child
+// by position. The rows of the last node are laid out as tableDef.Cols.
+func appendCheckConstraintPlan(builder *QueryBuilder, bindCtx *BindContext, tableDef *TableDef, lastNodeId int32) (int32, error) {
+	colPosMap := make(map[string]int32, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		colPosMap[col.Name] = int32(i)
+	}
+	filters, err := makeCheckFilters(builder.GetContext(), tableDef, 0, colPosMap, false)
+	if err != nil || len(filters) == 0 {
+		return lastNodeId, err
+	}
+	return builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_FILTER,
+		Children:    []int32{lastNodeId},
+		FilterList:  filters,
+		ProjectList: getProjectionByLastNode(builder, lastNodeId),
+	}, bindCtx), nil
+}
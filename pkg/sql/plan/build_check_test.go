@@ -0,0 +1,149 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	moruntime "github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/stretchr/testify/require"
+)
+
+func setupCheckTestRuntime() {
+	rt := moruntime.DefaultRuntime()
+	moruntime.SetupServiceBasedRuntime("", rt)
+	rt.SetGlobalVariables(moruntime.InternalSQLExecutor, executor.NewMemExecutor(func(sql string) (executor.Result, error) {
+		return executor.Result{}, nil
+	}))
+}
+
+func TestBuildCheckConstraint(t *testing.T) {
+	setupCheckTestRuntime()
+	mock := NewMockOptimizer(false)
+	tableDef, err := buildTestCreateTableStmt(mock, `create table t1 (
+		a int check (a > 0),
+		b int,
+		c varchar(10),
+		constraint chk_ab check (a > b),
+		check (c like 'x%') not enforced
+	)`)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(tableDef.Checks))
+	require.Equal(t, "t1_chk_1", tableDef.Checks[0].Name)
+	require.Equal(t, "a > 0", tableDef.Checks[0].ExprStr)
+	require.False(t, tableDef.Checks[0].NotEnforced)
+	require.Equal(t, "chk_ab", tableDef.Checks[1].Name)
+	require.Equal(t, "t1_chk_2", tableDef.Checks[2].Name)
+	require.True(t, tableDef.Checks[2].NotEnforced)
+
+	var snapshot *plan.Snapshot
+	showSQL, _, err := ConstructCreateTableSQL(&mock.ctxt, tableDef, snapshot, false)
+	require.NoError(t, err)
+	require.Contains(t, showSQL, "CONSTRAINT `chk_ab` CHECK (a > b)")
+	require.Contains(t, showSQL, "CONSTRAINT `t1_chk_2` CHECK (c like \"x%\") NOT ENFORCED")
+
+	// the not enforced constraint is not validated
+	filters, err := makeCheckFilters(mock.CurrentContext().GetContext(), tableDef, 0,
+		map[string]int32{"a": 0, "b": 1, "c": 2}, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(filters))
+	for _, filter := range filters {
+		require.True(t, containsAssert(filter))
+	}
+	_, err = makeCheckFilters(mock.CurrentContext().GetContext(), tableDef, 0,
+		map[string]int32{"a": 0}, false)
+	require.Error(t, err)
+
+	require.Error(t, checkAlterColumnWithCheck(mock.CurrentContext().GetContext(), tableDef, "b"))
+	require.NoError(t, checkAlterColumnWithCheck(mock.CurrentContext().GetContext(), tableDef, "d"))
+}
+
+func TestBuildCheckConstraintInList(t *testing.T) {
+	setupCheckTestRuntime()
+	mock := NewMockOptimizer(false)
+	tableDef, err := buildTestCreateTableStmt(mock, "create table t1 (a int, b int, check (a in (b, 1, 2)))")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tableDef.Checks))
+
+	filters, err := makeCheckFilters(mock.CurrentContext().GetContext(), tableDef, 0,
+		map[string]int32{"a": 0, "b": 1}, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(filters))
+
+	// the columns inside an IN list are checked and remapped as well
+	ctx := mock.CurrentContext().GetContext()
+	list := &Expr{
+		Expr: &plan.Expr_List{
+			List: &plan.ExprList{
+				List: []*Expr{
+					{Expr: &plan.Expr_Col{Col: &plan.ColRef{Name: "b"}}},
+				},
+			},
+		},
+	}
+	require.Error(t, checkCheckExpr(ctx, list, tableDef, "chk", "a"))
+	require.NoError(t, checkCheckExpr(ctx, list, tableDef, "chk", ""))
+	require.Error(t, remapCheckExprColRef(list, "t1", 0, map[string]int32{"a": 0}, false))
+	require.NoError(t, remapCheckExprColRef(list, "t1", 2, map[string]int32{"a": 0, "b": 1}, false))
+	col := list.GetList().List[0].GetCol()
+	require.Equal(t, int32(2), col.RelPos)
+	require.Equal(t, int32(1), col.ColPos)
+}
+
+func TestGenSqlForCheckConstraint(t *testing.T) {
+	sql := genSqlForCheckConstraint("d`b", "t`1", &plan.CheckDef{Name: `it's\`, ExprStr: "a > 0"})
+	require.Equal(t, "select assert(count(*) = 0, 'Check constraint ''it''s\\\\'' is violated.') from `d``b`.`t``1` where not (a > 0)", sql)
+}
+
+func TestBuildCheckConstraintError(t *testing.T) {
+	setupCheckTestRuntime()
+	mock := NewMockOptimizer(false)
+	sqls := []string{
+		"create table t1 (a int check (a > b), b int)",
+		"create table t1 (a int, constraint c1 check (a > 0), constraint c1 check (a < 10))",
+		"create table t1 (a int, check (d > 0))",
+		"create table t1 (a int auto_increment, check (a > 0))",
+		"create table t1 (a int, check (a > rand()))",
+		"create table t1 (a datetime, check (a < now()))",
+		"create table t1 (a int, check (a > (select 1)))",
+		"create table t1 (a int, check (sum(a) > 0))",
+		"create table t1 (a int, check (a > @v))",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestBuildAlterTableCheck(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sqls := []string{
+		"alter table emp add constraint chk_sal check (sal > 0)",
+		"alter table emp add check (sal > 0) not enforced",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	logicPlan, err := runOneStmt(mock, t, "alter table emp add constraint chk_sal check (sal > 0)")
+	require.NoError(t, err)
+	alterTable := logicPlan.GetDdl().GetAlterTable()
+	require.Equal(t, "chk_sal", alterTable.Actions[0].GetAddCheck().Name)
+	require.Equal(t, 1, len(alterTable.DetectSqls))
+
+	sqls = []string{
+		"alter table emp drop check chk_sal",
+		"alter table emp alter check chk_sal not enforced",
+		"alter table emp add check (sal > (select 1))",
+	}
+	runTestShouldError(mock, t, sqls)
+}
@@ -178,6 +178,9 @@ func getUpdateTableInfo(ctx CompilerContext, stmt *tree.Update) (*dmlTableInfo,
 		newTblInfo.tableDefs = append(newTblInfo.tableDefs, tblDef)
 		newTblInfo.isClusterTable = append(newTblInfo.isClusterTable, tblInfo.isClusterTable[idx])
 		newTblInfo.alias[alias] = len(newTblInfo.tableDefs) - 1
+		if err = rewriteGeneratedUpdateExprs(ctx.GetContext(), tblDef, alias, columns); err != nil {
+			return nil, err
+		}
 		newTblInfo.updateKeys = append(newTblInfo.updateKeys, columns)
 
 		if !newTblInfo.haveConstraint {
@@ -386,6 +389,9 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 	if insertColumns, err = getInsertColsFromStmt(builder.GetContext(), stmt, tableDef); err != nil {
 		return false, nil, nil, err
 	}
+	if err = checkInsertGeneratedCols(builder.GetContext(), tableDef, insertColumns, stmt.Rows); err != nil {
+		return false, nil, nil, err
+	}
 	if stmt.Columns != nil {
 		syntaxHasColumnNames = true
 	}
@@ -552,7 +558,7 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 					// insert without unique
 					// then need check col is not auto_incr & default is not null
 					col := tableDef.Cols[tableDef.Name2ColIndex[name]]
-					if col.Typ.AutoIncr || (col.Default.Expr != nil && !isNullExpr(col.Default.Expr)) {
+					if col.Typ.AutoIncr || col.Generated != nil || (col.Default.Expr != nil && !isNullExpr(col.Default.Expr)) {
 						withoutUniqueCol = false
 						break
 					}
@@ -602,6 +608,9 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 			projectList = append(projectList, defExpr)
 		}
 	}
+	if err = fillGeneratedColExprs(builder.GetContext(), tableDef, projectList, colToIdx); err != nil {
+		return false, nil, nil, err
+	}
 
 	// append ProjectNode
 	projectCtx := NewBindContext(builder, bindCtx)
@@ -673,8 +682,12 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 			// get update cols
 			updateCols := make(map[string]tree.Expr)
 			for _, updateExpr := range stmt.OnDuplicateUpdate {
-				col := updateExpr.Names[0].ColName()
-				updateCols[col] = updateExpr.Expr
+				updateCols[updateExpr.Names[0].ColName()] = updateExpr.Expr
+			}
+			if err = rewriteGeneratedUpdateExprs(builder.GetContext(), tableDef, "", updateCols); err != nil {
+				return false, nil, nil, err
+			}
+			for col := range updateCols {
 				if _, ok := uniqueColNames[col]; ok {
 					return false, nil, nil, moerr.NewInternalError(builder.GetContext(), "do not support update primary key/unique key for on duplicate key update")
 				}
@@ -1055,7 +1068,7 @@ func MakeInsertValueConstExpr(proc *process.Process, numVal *tree.NumVal, colTyp
 		planType := MakePlan2TypeValue(colType)
 		return MakePlan2Decimal128ExprWithType(num, &planType), err
 	case types.T_char, types.T_varchar, types.T_blob, types.T_binary, types.T_varbinary, types.T_text, types.T_datalink,
-		types.T_array_float32, types.T_array_float64:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		canInsert, num, err := util.SetInsertValueString(proc, numVal, colType)
 		if err != nil || !canInsert {
 			return nil, err
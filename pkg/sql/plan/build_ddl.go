@@ -1057,6 +1057,8 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 	secondaryIndexInfos := make([]*tree.Index, 0)
 	fkDatasOfFKSelfRefer := make([]*FkData, 0)
 	dedupFkName := make(UnorderedSet[string])
+	var checkInfos []checkInfo
+	var generatedInfos []generatedInfo
 	for _, item := range stmt.Defs {
 		switch def := item.(type) {
 		case *tree.ColumnTableDef:
@@ -1070,7 +1072,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 					return moerr.NewInvalidInputf(ctx.GetContext(), "string width (%d) is too long", colType.GetWidth())
 				}
 			}
-			if colType.Id == int32(types.T_array_float32) || colType.Id == int32(types.T_array_float64) {
+			if types.T(colType.Id).IsVector() {
 				if colType.GetWidth() > types.MaxArrayDimension {
 					return moerr.NewInvalidInputf(ctx.GetContext(), "vector width (%d) is too long", colType.GetWidth())
 				}
@@ -1086,6 +1088,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			var pks []string
 			var comment string
 			var auto_incr bool
+			var generated *tree.AttributeGeneratedAlways
 			colName := def.Name.ColName()
 			// only used in error message and ColDef.OriginName
 			colNameOrigin := def.Name.ColNameOrigin()
@@ -1104,7 +1107,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 					if colType.GetId() == int32(types.T_json) {
 						return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in primary key", colNameOrigin))
 					}
-					if colType.GetId() == int32(types.T_array_float32) || colType.GetId() == int32(types.T_array_float64) {
+					if types.T(colType.GetId()).IsVector() {
 						return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in primary key", colNameOrigin))
 					}
 					if colType.GetId() == int32(types.T_enum) {
@@ -1128,6 +1131,23 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 						Name:     colName,
 					})
 					indexs = append(indexs, colName)
+				case *tree.AttributeCheckConstraint:
+					checkInfos = append(checkInfos, checkInfo{
+						def: &tree.CheckIndex{
+							Name:     attribute.Name,
+							Expr:     attribute.Expr,
+							Enforced: attribute.Enforced,
+						},
+						column: colName,
+					})
+				case *tree.AttributeGeneratedAlways:
+					if stmt.IsAsSelect {
+						return moerr.NewNotSupported(ctx.GetContext(), "generated column in create table ... as select statement")
+					}
+					if err = checkGeneratedColAttrs(ctx.GetContext(), def); err != nil {
+						return err
+					}
+					generated = attribute
 				}
 			}
 			if len(pks) > 0 {
@@ -1164,6 +1184,9 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 				OnUpdate:   onUpdateExpr,
 				Comment:    comment,
 			}
+			if generated != nil {
+				generatedInfos = append(generatedInfos, generatedInfo{col: col, def: generated})
+			}
 			// if same name col in asSelectCols, overwrite it; add into colMap && createTable.TableDef.Cols later
 			if idx := slices.IndexFunc(asSelectCols, func(c *ColDef) bool { return c.Name == col.Name }); idx != -1 {
 				asSelectCols[idx] = col
@@ -1277,8 +1300,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 				fkDatasOfFKSelfRefer = append(fkDatasOfFKSelfRefer, fkData)
 			}
 		case *tree.CheckIndex:
-			// unsupport in plan. will support in next version.
-			// return moerr.NewNYI(ctx.GetContext(), "table def: '%v'", def)
+			checkInfos = append(checkInfos, checkInfo{def: def})
 		default:
 			return moerr.NewNYIf(ctx.GetContext(), "table def: '%v'", def)
 		}
@@ -1520,6 +1542,13 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 		return err
 	}
 
+	if err = buildGeneratedColDefs(ctx, createTable.TableDef, generatedInfos); err != nil {
+		return err
+	}
+	if err = buildCheckDefs(ctx, createTable.TableDef, checkInfos); err != nil {
+		return err
+	}
+
 	// build index table
 	if len(uniqueIndexInfos) != 0 {
 		err = buildUniqueIndexTable(createTable, uniqueIndexInfos, colMap, pkeyName, ctx)
@@ -1659,10 +1688,11 @@ func buildFullTextIndexTable(createTable *plan.CreateTable, indexInfos []*tree.F
 		if indexInfo.IndexOption != nil {
 			if indexInfo.IndexOption.ParserName != "" {
 				indexDef.Option = &plan.IndexOption{ParserName: indexInfo.IndexOption.ParserName, NgramTokenSize: int32(3)}
-				indexDef.IndexAlgoParams, err = catalog.IndexParamsToJsonString(indexInfo)
-				if err != nil {
-					return err
-				}
+			}
+			// parser, analyzer, stopwords and scorer
+			indexDef.IndexAlgoParams, err = catalog.IndexParamsToJsonString(indexInfo)
+			if err != nil {
+				return err
 			}
 			if indexInfo.IndexOption.Comment != "" {
 				indexDef.Comment = indexInfo.IndexOption.Comment
@@ -1807,7 +1837,7 @@ func buildUniqueIndexTable(createTable *plan.CreateTable, indexInfos []*tree.Uni
 			if colMap[name].Typ.Id == int32(types.T_json) {
 				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in index", nameOrigin))
 			}
-			if colMap[name].Typ.Id == int32(types.T_array_float32) || colMap[name].Typ.Id == int32(types.T_array_float64) {
+			if types.T(colMap[name].Typ.Id).IsVector() {
 				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in index", nameOrigin))
 			}
 
@@ -1924,6 +1954,8 @@ func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.In
 			indexDef, tableDef, err = buildRegularSecondaryIndexDef(ctx, indexInfo, colMap, pkeyName)
 		case tree.INDEX_TYPE_IVFFLAT:
 			indexDef, tableDef, err = buildIvfFlatSecondaryIndexDef(ctx, indexInfo, colMap, existedIndexes, pkeyName)
+		case tree.INDEX_TYPE_HNSW:
+			indexDef, tableDef, err = buildHnswSecondaryIndexDef(ctx, indexInfo, colMap, existedIndexes)
 		case tree.INDEX_TYPE_MASTER:
 			indexDef, tableDef, err = buildMasterSecondaryIndexDef(ctx, indexInfo, colMap, pkeyName)
 		default:
@@ -2093,7 +2125,7 @@ func buildRegularSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, c
 		if colMap[name].Typ.Id == int32(types.T_json) {
 			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in index", nameOrigin))
 		}
-		if colMap[name].Typ.Id == int32(types.T_array_float32) || colMap[name].Typ.Id == int32(types.T_array_float64) {
+		if types.T(colMap[name].Typ.Id).IsVector() {
 			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in index", nameOrigin))
 		}
 
@@ -2234,9 +2266,18 @@ func buildIvfFlatSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, c
 		if _, ok := colMap[name]; !ok {
 			return nil, nil, moerr.NewInvalidInputf(ctx.GetContext(), "column '%s' is not exist", indexInfo.KeyParts[0].ColName.ColNameOrigin())
 		}
-		if colMap[name].Typ.Id != int32(types.T_array_float32) && colMap[name].Typ.Id != int32(types.T_array_float64) {
+		if !types.T(colMap[name].Typ.Id).IsVector() {
 			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "IVFFLAT only supports VECFXX column types")
 		}
+		isBit := colMap[name].Typ.Id == int32(types.T_array_bit)
+		isHamming := indexInfo.IndexOption != nil &&
+			catalog.ToLower(indexInfo.IndexOption.AlgoParamVectorOpType) == catalog.IndexAlgoParamOpType_hamming
+		if isBit && !isHamming {
+			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "IVFFLAT on VECBIT column only supports op_type 'vector_hamming_ops'")
+		}
+		if !isBit && isHamming {
+			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "op_type 'vector_hamming_ops' only supports VECBIT column")
+		}
 
 		if len(existedIndexes) > 0 {
 			for _, existedIndex := range existedIndexes {
@@ -2523,6 +2564,191 @@ func buildIvfFlatSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, c
 	return indexDefs, tableDefs, nil
 }
 
+func buildHnswSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, colMap map[string]*ColDef, existedIndexes []*plan.IndexDef) ([]*plan.IndexDef, []*TableDef, error) {
+
+	indexParts := make([]string, 1)
+
+	// 0. Validate: We only support 1 column of either VECF32 or VECF64 type
+	{
+		if len(indexInfo.KeyParts) != 1 {
+			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "don't support multi column HNSW vector index")
+		}
+
+		name := indexInfo.KeyParts[0].ColName.ColName()
+		indexParts[0] = name
+
+		if _, ok := colMap[name]; !ok {
+			return nil, nil, moerr.NewInvalidInputf(ctx.GetContext(), "column '%s' is not exist", indexInfo.KeyParts[0].ColName.ColNameOrigin())
+		}
+		if colMap[name].Typ.Id != int32(types.T_array_float32) && colMap[name].Typ.Id != int32(types.T_array_float64) {
+			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "HNSW only supports VECFXX column types")
+		}
+
+		for _, existedIndex := range existedIndexes {
+			if catalog.IsHnswIndexAlgo(existedIndex.IndexAlgo) && existedIndex.Parts[0] == name {
+				return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "Multiple HNSW indexes are not allowed to use the same column")
+			}
+		}
+	}
+
+	indexDefs := make([]*plan.IndexDef, 2)
+	tableDefs := make([]*TableDef, 2)
+
+	// 1. create hnsw `metadata` table
+	{
+		// 1.a tableDefs[0] init
+		indexTableName, err := util.BuildIndexTableName(ctx.GetContext(), false)
+		if err != nil {
+			return nil, nil, err
+		}
+		tableDefs[0] = &TableDef{
+			Name:      indexTableName,
+			TableType: catalog.SystemSI_HNSW_TblType_Metadata,
+			Cols:      make([]*ColDef, 2),
+		}
+
+		// 1.b indexDefs[0] init
+		indexDefs[0], err = CreateIndexDef(indexInfo, indexTableName, catalog.SystemSI_HNSW_TblType_Metadata, indexParts, false)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// 1.c columns: key (PK), val
+		tableDefs[0].Cols[0] = &ColDef{
+			Name: catalog.SystemSI_HNSW_TblCol_Metadata_key,
+			Alg:  plan.CompressType_Lz4,
+			Typ: Type{
+				Id:    int32(types.T_varchar),
+				Width: types.MaxVarcharLen,
+			},
+			Primary: true,
+			Default: &plan.Default{
+				NullAbility:  false,
+				Expr:         nil,
+				OriginString: "",
+			},
+		}
+		tableDefs[0].Cols[1] = &ColDef{
+			Name: catalog.SystemSI_HNSW_TblCol_Metadata_val,
+			Alg:  plan.CompressType_Lz4,
+			Typ: Type{
+				Id:    int32(types.T_varchar),
+				Width: types.MaxVarcharLen,
+			},
+			Default: &plan.Default{
+				NullAbility:  false,
+				Expr:         nil,
+				OriginString: "",
+			},
+		}
+
+		// 1.d PK def
+		tableDefs[0].Pkey = &PrimaryKeyDef{
+			Names:       []string{catalog.SystemSI_HNSW_TblCol_Metadata_key},
+			PkeyColName: catalog.SystemSI_HNSW_TblCol_Metadata_key,
+		}
+
+		properties := []*plan.Property{
+			{
+				Key:   catalog.SystemRelAttr_Kind,
+				Value: catalog.SystemSI_HNSW_TblType_Metadata,
+			},
+		}
+		tableDefs[0].Defs = append(tableDefs[0].Defs, &plan.TableDef_DefType{
+			Def: &plan.TableDef_DefType_Properties{
+				Properties: &plan.PropertiesDef{
+					Properties: properties,
+				},
+			}})
+	}
+
+	// 2. create hnsw `storage` table, which keeps the serialized graph split in chunks
+	{
+		// 2.a tableDefs[1] init
+		indexTableName, err := util.BuildIndexTableName(ctx.GetContext(), false)
+		if err != nil {
+			return nil, nil, err
+		}
+		tableDefs[1] = &TableDef{
+			Name:      indexTableName,
+			TableType: catalog.SystemSI_HNSW_TblType_Storage,
+			Cols:      make([]*ColDef, 4),
+		}
+
+		// 2.b indexDefs[1] init
+		indexDefs[1], err = CreateIndexDef(indexInfo, indexTableName, catalog.SystemSI_HNSW_TblType_Storage, indexParts, false)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// 2.c columns: version, chunk_id, data, PRIMARY KEY (version,chunk_id)
+		tableDefs[1].Cols[0] = &ColDef{
+			Name: catalog.SystemSI_HNSW_TblCol_Storage_version,
+			Alg:  plan.CompressType_Lz4,
+			Typ: plan.Type{
+				Id: int32(types.T_int64),
+			},
+			Default: &plan.Default{
+				NullAbility:  false,
+				Expr:         nil,
+				OriginString: "",
+			},
+		}
+		tableDefs[1].Cols[1] = &ColDef{
+			Name: catalog.SystemSI_HNSW_TblCol_Storage_id,
+			Alg:  plan.CompressType_Lz4,
+			Typ: plan.Type{
+				Id: int32(types.T_int64),
+			},
+			Default: &plan.Default{
+				NullAbility:  false,
+				Expr:         nil,
+				OriginString: "",
+			},
+		}
+		tableDefs[1].Cols[2] = &ColDef{
+			Name: catalog.SystemSI_HNSW_TblCol_Storage_data,
+			Alg:  plan.CompressType_Lz4,
+			Typ: plan.Type{
+				Id: int32(types.T_blob),
+			},
+			Default: &plan.Default{
+				NullAbility:  false,
+				Expr:         nil,
+				OriginString: "",
+			},
+		}
+		tableDefs[1].Cols[3] = MakeHiddenColDefByName(catalog.CPrimaryKeyColName)
+		tableDefs[1].Cols[3].Alg = plan.CompressType_Lz4
+		tableDefs[1].Cols[3].Primary = true
+
+		// 2.d PK def
+		tableDefs[1].Pkey = &PrimaryKeyDef{
+			Names: []string{
+				catalog.SystemSI_HNSW_TblCol_Storage_version,
+				catalog.SystemSI_HNSW_TblCol_Storage_id,
+			},
+			PkeyColName: catalog.CPrimaryKeyColName,
+			CompPkeyCol: tableDefs[1].Cols[3],
+		}
+
+		properties := []*plan.Property{
+			{
+				Key:   catalog.SystemRelAttr_Kind,
+				Value: catalog.SystemSI_HNSW_TblType_Storage,
+			},
+		}
+		tableDefs[1].Defs = append(tableDefs[1].Defs, &plan.TableDef_DefType{
+			Def: &plan.TableDef_DefType_Properties{
+				Properties: &plan.PropertiesDef{
+					Properties: properties,
+				},
+			}})
+	}
+
+	return indexDefs, tableDefs, nil
+}
+
 func CreateIndexDef(indexInfo *tree.Index,
 	indexTableName, indexAlgoTableType string,
 	indexParts []string, isUnique bool) (*plan.IndexDef, error) {
@@ -2565,6 +2791,12 @@ func CreateIndexDef(indexInfo *tree.Index,
 			if err != nil {
 				return nil, err
 			}
+		case catalog.MoIndexHnswAlgo:
+			var err error
+			indexDef.IndexAlgoParams, err = catalog.IndexParamsMapToJsonString(catalog.DefaultHnswIndexAlgoOptions())
+			if err != nil {
+				return nil, err
+			}
 		}
 
 	}
@@ -2652,6 +2884,9 @@ func buildTruncateTable(stmt *tree.TruncateTable, ctx CompilerContext) (*Plan, e
 					}
 				} else if indexdef.TableExist && catalog.IsMasterIndexAlgo(indexdef.IndexAlgo) {
 					truncateTable.IndexTableNames = append(truncateTable.IndexTableNames, indexdef.IndexTableName)
+				} else if indexdef.TableExist && catalog.IsHnswIndexAlgo(indexdef.IndexAlgo) {
+					// Without the stored graph and its metadata, the next search rebuilds the graph from the table.
+					truncateTable.IndexTableNames = append(truncateTable.IndexTableNames, indexdef.IndexTableName)
 				}
 			}
 		}
@@ -2981,6 +3216,9 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 	default:
 		return nil, moerr.NewNotSupportedf(ctx.GetContext(), "statement: '%v'", tree.String(stmt, dialect.MYSQL))
 	}
+	if err := checkIndexOnVirtualCols(ctx.GetContext(), tableDef, stmt.KeyParts); err != nil {
+		return nil, err
+	}
 	colMap := make(map[string]*ColDef)
 	for _, col := range tableDef.Cols {
 		colMap[col.Name] = col
@@ -3289,6 +3527,7 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 	var updateSqls []string
 	uniqueIndexInfos := make([]*tree.UniqueIndex, 0)
 	secondaryIndexInfos := make([]*tree.Index, 0)
+	var checkTableDef *TableDef
 	for i, option := range stmt.Options {
 		switch opt := option.(type) {
 		case *tree.AlterOptionDrop:
@@ -3339,6 +3578,14 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 						break
 					}
 				}
+			case tree.AlterTableDropCheck:
+				alterTableDrop.Typ = plan.AlterTableDrop_CHECK
+				for _, check := range tableDef.Checks {
+					if check.Name == constraintName {
+						name_not_found = false
+						break
+					}
+				}
 			}
 			if name_not_found {
 				return nil, moerr.NewInternalErrorf(ctx.GetContext(), "Can't DROP '%s'; check that column/key exists", constraintName)
@@ -3405,6 +3652,9 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 				if err != nil {
 					return nil, err
 				}
+				if err = checkIndexOnVirtualCols(ctx.GetContext(), tableDef, def.KeyParts); err != nil {
+					return nil, err
+				}
 
 				indexName := def.GetIndexName()
 				constrNames := map[string]bool{}
@@ -3486,6 +3736,9 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 				if err != nil {
 					return nil, err
 				}
+				if err = checkIndexOnVirtualCols(ctx.GetContext(), tableDef, def.KeyParts); err != nil {
+					return nil, err
+				}
 
 				indexName := def.Name
 
@@ -3525,11 +3778,21 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 					},
 				}
 			case *tree.CheckIndex:
+				if checkTableDef == nil {
+					checkTableDef = DeepCopyTableDef(tableDef, true)
+				}
+				check, err := buildCheckDef(ctx, checkTableDef, def, "")
+				if err != nil {
+					return nil, err
+				}
+				checkTableDef.Checks = append(checkTableDef.Checks, check)
+				//the data in the table must be checked against the new constraint.
+				if !check.NotEnforced {
+					detectSqls = append(detectSqls, genSqlForCheckConstraint(databaseName, tableName, check))
+				}
 				alterTable.Actions[i] = &plan.AlterTable_Action{
-					Action: &plan.AlterTable_Action_AlterComment{
-						AlterComment: &plan.AlterTableComment{
-							NewComment: comment,
-						},
+					Action: &plan.AlterTable_Action_AddCheck{
+						AddCheck: check,
 					},
 				}
 			default:
@@ -3575,6 +3838,8 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 					return nil, moerr.NewInternalError(ctx.GetContext(), "lists should be > 0.")
 				}
 				alterTableReIndex.IndexAlgoParamList = opt.AlgoParamList
+			case tree.INDEX_TYPE_HNSW:
+				// the graph is rebuilt with the params it was created with
 			default:
 				return nil, moerr.NewInternalErrorf(ctx.GetContext(), "unsupported index type: %v", opt.KeyType)
 			}
@@ -3640,7 +3905,7 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 				}
 			}
 
-			if colType.Id == int32(types.T_array_float32) || colType.Id == int32(types.T_array_float64) {
+			if types.T(colType.Id).IsVector() {
 				if colType.GetWidth() > types.MaxArrayDimension {
 					return nil, moerr.NewInvalidInputf(ctx.GetContext(), "vector width (%d) is too long", colType.GetWidth())
 				}
@@ -3665,7 +3930,7 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 					if colType.GetId() == int32(types.T_json) {
 						return nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in primary key", colNameOrigin))
 					}
-					if colType.GetId() == int32(types.T_array_float32) || colType.GetId() == int32(types.T_array_float64) {
+					if types.T(colType.GetId()).IsVector() {
 						return nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in primary key", colNameOrigin))
 					}
 					pks = append(pks, colName)
@@ -3681,6 +3946,8 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 					}
 				case *tree.AttributeUnique, *tree.AttributeUniqueKey:
 					return nil, moerr.NewNotSupported(ctx.GetContext(), "unsupport add unique index constraints when adding new column")
+				case *tree.AttributeGeneratedAlways:
+					return nil, moerr.NewNotSupported(ctx.GetContext(), "add generated column")
 					//uniqueIndexInfos = append(uniqueIndexInfos, &tree.UniqueIndex{
 					//	KeyParts: []*tree.KeyPart{
 					//		{
@@ -3747,7 +4014,30 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 			}
 		case *tree.TableOptionAutoIncrement:
 			return nil, moerr.NewInvalidInput(ctx.GetContext(), "Can't set AutoIncr column value.")
-		case *tree.AlterOptionAlterCheck, *tree.TableOptionCharset:
+		case *tree.AlterOptionAlterCheck:
+			constraintName := string(opt.Name)
+			var check *plan.CheckDef
+			for _, c := range tableDef.Checks {
+				if c.Name == constraintName {
+					check = c
+					break
+				}
+			}
+			if check == nil {
+				return nil, moerr.NewInvalidInputf(ctx.GetContext(), "check constraint '%s' is not found in the table", constraintName)
+			}
+			if opt.Enforce && check.NotEnforced {
+				detectSqls = append(detectSqls, genSqlForCheckConstraint(databaseName, tableName, check))
+			}
+			alterTable.Actions[i] = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_AlterCheck{
+					AlterCheck: &plan.AlterTableAlterCheck{
+						Name:     constraintName,
+						Enforced: opt.Enforce,
+					},
+				},
+			}
+		case *tree.TableOptionCharset:
 			alterTable.Actions[i] = &plan.AlterTable_Action{
 				Action: &plan.AlterTable_Action_AlterComment{
 					AlterComment: &plan.AlterTableComment{
@@ -152,7 +152,10 @@ func buildInsertPlans(
 		}
 	}
 
-	// add plan: -> preinsert -> sink
+	// add plan: -> [filter] -> preinsert -> sink
+	if lastNodeId, err = appendCheckConstraintPlan(builder, bindCtx, tableDef, lastNodeId); err != nil {
+		return err
+	}
 	lastNodeId = appendPreInsertNode(builder, bindCtx, objRef, tableDef, lastNodeId, false)
 
 	lastNodeId = appendSinkNode(builder, bindCtx, lastNodeId)
@@ -237,6 +240,10 @@ func buildUpdatePlans(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindC
 		ProjectList: projectList,
 	}
 	lastNodeId = builder.appendNode(projectNode, bindCtx)
+	//append filter node for check constraints
+	if lastNodeId, err = appendCheckConstraintPlan(builder, bindCtx, updatePlanCtx.tableDef, lastNodeId); err != nil {
+		return err
+	}
 	//append preinsert node
 	lastNodeId = appendPreInsertNode(builder, bindCtx, updatePlanCtx.objRef, updatePlanCtx.tableDef, lastNodeId, true)
 
@@ -0,0 +1,496 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"slices"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+)
+
+// A STORED generated column is computed by the insert and update plans and
+// written to the storage. A VIRTUAL one is written as NULL and computed from
+// its expression when it is read, see Binding.generated, unless its values are
+// needed by the write: it is a part of an index or of a foreign key, or it is
+// NOT NULL. Such a column is computed and written like a STORED one, and the
+// indexed one is read from the storage so that its indexes can be used.
+
+// generatedInfo is a GENERATED ALWAYS AS column of the CREATE TABLE statement.
+type generatedInfo struct {
+	col *ColDef
+	def *tree.AttributeGeneratedAlways
+}
+
+// buildGeneratedColDefs binds the expressions of the generated columns of a
+// new table in the order the columns are defined.
+func buildGeneratedColDefs(ctx CompilerContext, tableDef *TableDef, infos []generatedInfo) error {
+	order := make(map[string]int, len(infos))
+	for i, info := range infos {
+		order[info.col.Name] = i
+	}
+
+	for i, info := range infos {
+		if !info.def.Stored && tableDef.Pkey != nil && slices.Contains(tableDef.Pkey.Names, info.col.Name) {
+			return moerr.NewNotSupportedf(ctx.GetContext(), "defining a virtual generated column '%s' as primary key", info.col.OriginName)
+		}
+
+		generated, err := buildGeneratedColDef(ctx, tableDef, info.col, info.def)
+		if err != nil {
+			return err
+		}
+
+		// a generated column may only refer to the generated columns defined before it
+		cols := make(map[string]bool)
+		getCheckCols(generated.Expr, cols)
+		for name := range cols {
+			if j, ok := order[name]; ok && j >= i {
+				return moerr.NewInvalidInputf(ctx.GetContext(), "generated column '%s' cannot refer to itself or a generated column defined after it", info.col.OriginName)
+			}
+		}
+		info.col.Generated = generated
+	}
+	return nil
+}
+
+// checkAddedGeneratedColOrder rejects the generated column added by ALTER
+// TABLE before a generated column it refers to.
+func checkAddedGeneratedColOrder(ctx context.Context, tableDef *TableDef, col *ColDef) error {
+	cols := make(map[string]bool)
+	getCheckCols(col.Generated.Expr, cols)
+	idx := slices.Index(tableDef.Cols, col)
+	for _, c := range tableDef.Cols[idx+1:] {
+		if c.Generated != nil && cols[c.Name] {
+			return moerr.NewInvalidInputf(ctx, "generated column '%s' cannot refer to itself or a generated column defined after it", col.OriginName)
+		}
+	}
+	return nil
+}
+
+// buildGeneratedColDef binds the expression of the generated column against
+// the columns of the table and casts it to the type of the column. Like for
+// the CHECK constraints, the bound expression keeps the column names.
+func buildGeneratedColDef(ctx CompilerContext, tableDef *TableDef, col *ColDef, def *tree.AttributeGeneratedAlways) (*plan.GeneratedColDef, error) {
+	builder, bindContext, err := newTableBindContext(ctx, tableDef)
+	if err != nil {
+		return nil, err
+	}
+	expr, err := NewGeneratedColumnBinder(builder, bindContext).BindExpr(def.Expr, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkGeneratedColExpr(ctx.GetContext(), expr, tableDef, col.OriginName); err != nil {
+		return nil, err
+	}
+	if expr, err = forceCastExpr(ctx.GetContext(), expr, col.Typ); err != nil {
+		return nil, err
+	}
+
+	return &plan.GeneratedColDef{
+		Name:    col.Name,
+		Expr:    expr,
+		ExprStr: tree.StringWithOpts(def.Expr, dialect.MYSQL, tree.WithQuoteString(true)),
+		Stored:  def.Stored,
+	}, nil
+}
+
+// checkGeneratedColExpr rejects the functions and columns which are not
+// allowed in the expression of a generated column.
+func checkGeneratedColExpr(ctx context.Context, expr *Expr, tableDef *TableDef, name string) error {
+	switch ne := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		for _, col := range tableDef.Cols {
+			if col.Name == ne.Col.Name && col.Typ.AutoIncr {
+				return moerr.NewInvalidInputf(ctx, "generated column '%s' cannot refer to an auto-increment column", name)
+			}
+		}
+
+	case *plan.Expr_F:
+		if f, exists := function.GetFunctionByIdWithoutError(ne.F.Func.GetObj()); exists &&
+			(f.CannotFold() || f.IsRealTimeRelated()) {
+			return moerr.NewInvalidInputf(ctx, "expression of generated column '%s' contains a disallowed function: %s", name, ne.F.Func.ObjName)
+		}
+		for _, arg := range ne.F.Args {
+			if err := checkGeneratedColExpr(ctx, arg, tableDef, name); err != nil {
+				return err
+			}
+		}
+
+	case *plan.Expr_List:
+		for _, arg := range ne.List.List {
+			if err := checkGeneratedColExpr(ctx, arg, tableDef, name); err != nil {
+				return err
+			}
+		}
+
+	case *plan.Expr_P, *plan.Expr_V, *plan.Expr_Sub:
+		return moerr.NewInvalidInputf(ctx, "expression of generated column '%s' is not allowed", name)
+	}
+	return nil
+}
+
+// checkGeneratedColAttrs rejects the attributes which conflict with the
+// GENERATED ALWAYS AS attribute of the column.
+func checkGeneratedColAttrs(ctx context.Context, def *tree.ColumnTableDef) error {
+	for _, attr := range def.Attributes {
+		switch attr.(type) {
+		case *tree.AttributeDefault, *tree.AttributeAutoIncrement, *tree.AttributeOnUpdate:
+			return moerr.NewInvalidInputf(ctx, "incorrect usage of %s and generated column '%s'",
+				tree.String(attr, dialect.MYSQL), def.Name.ColNameOrigin())
+		}
+	}
+	return nil
+}
+
+// checkAlterColumnWithGenerated rejects dropping, renaming or modifying the
+// column which is referenced by a generated column.
+func checkAlterColumnWithGenerated(ctx context.Context, tableDef *TableDef, colName string) error {
+	for _, col := range tableDef.Cols {
+		if col.Generated == nil || col.Name == colName {
+			continue
+		}
+		cols := make(map[string]bool)
+		getCheckCols(col.Generated.Expr, cols)
+		if cols[colName] {
+			return moerr.NewInvalidInputf(ctx, "generated column '%s' uses column '%s', hence column cannot be dropped, renamed or modified", col.Name, colName)
+		}
+	}
+	return nil
+}
+
+// isIndexedCol returns true if the column is a part of the primary key or of
+// any index of the table.
+func isIndexedCol(tableDef *TableDef, colName string) bool {
+	if tableDef.Pkey != nil && slices.Contains(tableDef.Pkey.Names, colName) {
+		return true
+	}
+	for _, idx := range tableDef.Indexes {
+		if slices.ContainsFunc(idx.Parts, func(part string) bool {
+			return catalog.ResolveAlias(part) == colName
+		}) {
+			return true
+		}
+	}
+	return false
+}
+
+// isWrittenGeneratedCol returns true if the computed values of the generated
+// column are written to the storage, see the comment at the top of the file.
+func isWrittenGeneratedCol(tableDef *TableDef, col *ColDef) bool {
+	if col.Generated.Stored || !col.Default.GetNullAbility() || isIndexedCol(tableDef, col.Name) {
+		return true
+	}
+	for _, fk := range tableDef.Fkeys {
+		if slices.Contains(fk.Cols, col.ColId) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkIndexOnVirtualCols rejects the new index of the table on a VIRTUAL
+// generated column written as NULL, the existing rows have no values to be
+// indexed.
+func checkIndexOnVirtualCols(ctx context.Context, tableDef *TableDef, keyParts []*tree.KeyPart) error {
+	for _, part := range keyParts {
+		if part.ColName == nil {
+			continue
+		}
+		colName := part.ColName.ColName()
+		for _, col := range tableDef.Cols {
+			if col.Name == colName && col.Generated != nil && !isWrittenGeneratedCol(tableDef, col) {
+				return moerr.NewNotSupportedf(ctx, "adding an index on the virtual generated column '%s', define it as STORED", col.OriginName)
+			}
+		}
+	}
+	return nil
+}
+
+// getVirtualColExprs returns the expressions of the VIRTUAL generated columns
+// of the scanned table which are computed when they are read, keyed by the
+// position of the column. The column refs of the expressions are remapped
+// onto the scan node with the tag.
+func getVirtualColExprs(tableDef *TableDef, tag int32) map[int32]*Expr {
+	var exprs map[int32]*Expr
+	for i, col := range tableDef.Cols {
+		if col.Generated == nil || col.Generated.Stored || isIndexedCol(tableDef, col.Name) {
+			continue
+		}
+		expr := DeepCopyExpr(col.Generated.Expr)
+		if !remapGeneratedColRef(expr, tableDef, tag) {
+			continue
+		}
+		if exprs == nil {
+			exprs = make(map[int32]*Expr)
+		}
+		exprs[int32(i)] = expr
+	}
+	return exprs
+}
+
+// remapGeneratedColRef remaps the column refs of expr onto the columns of the
+// scanned table, it returns false if any of them is not scanned.
+func remapGeneratedColRef(expr *Expr, tableDef *TableDef, tag int32) bool {
+	switch ne := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		idx := slices.IndexFunc(tableDef.Cols, func(col *ColDef) bool {
+			return col.Name == ne.Col.Name
+		})
+		if idx == -1 {
+			return false
+		}
+		ne.Col.RelPos = tag
+		ne.Col.ColPos = int32(idx)
+
+	case *plan.Expr_F:
+		for _, arg := range ne.F.Args {
+			if !remapGeneratedColRef(arg, tableDef, tag) {
+				return false
+			}
+		}
+
+	case *plan.Expr_List:
+		for _, arg := range ne.List.List {
+			if !remapGeneratedColRef(arg, tableDef, tag) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// makeGeneratedColExprs computes the generated columns of the written rows.
+// exprs holds the expressions of the columns of the rows keyed by column name,
+// the ones of the generated columns are replaced by the computed expressions.
+func makeGeneratedColExprs(ctx context.Context, tableDef *TableDef, exprs map[string]*Expr) error {
+	for _, col := range tableDef.Cols {
+		if col.Generated == nil {
+			continue
+		}
+		expr, err := replaceGeneratedColRef(DeepCopyExpr(col.Generated.Expr), exprs)
+		if err != nil {
+			return err
+		}
+		if expr, err = forceCastExpr(ctx, expr, col.Typ); err != nil {
+			return err
+		}
+		exprs[col.Name] = expr
+	}
+	return nil
+}
+
+// fillGeneratedColExprs replaces the expressions of the generated columns in
+// the projection of the written rows by the computed ones, or by NULL for the
+// ones which are not written. colPos maps the column names to their positions
+// in projList.
+func fillGeneratedColExprs(ctx context.Context, tableDef *TableDef, projList []*Expr, colPos map[string]int) error {
+	exprs := make(map[string]*Expr, len(colPos))
+	for name, pos := range colPos {
+		exprs[name] = projList[pos]
+	}
+	if err := makeGeneratedColExprs(ctx, tableDef, exprs); err != nil {
+		return err
+	}
+	for _, col := range tableDef.Cols {
+		if col.Generated == nil {
+			continue
+		}
+		expr := exprs[col.Name]
+		if !isWrittenGeneratedCol(tableDef, col) {
+			var err error
+			if expr, err = forceCastExpr(ctx, makePlan2NullConstExprWithType(), col.Typ); err != nil {
+				return err
+			}
+		}
+		projList[colPos[col.Name]] = expr
+	}
+	return nil
+}
+
+// expandVirtualColRefs replaces the refs to the generated columns which are
+// not written by their expressions, for the expressions evaluated on the
+// written rows, e.g. the CHECK constraints.
+func expandVirtualColRefs(tableDef *TableDef, expr *Expr) *Expr {
+	switch ne := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		for _, col := range tableDef.Cols {
+			if col.Name == ne.Col.Name && col.Generated != nil && !isWrittenGeneratedCol(tableDef, col) {
+				// a generated column only refers to the ones defined before it
+				return expandVirtualColRefs(tableDef, DeepCopyExpr(col.Generated.Expr))
+			}
+		}
+
+	case *plan.Expr_F:
+		for i, arg := range ne.F.Args {
+			ne.F.Args[i] = expandVirtualColRefs(tableDef, arg)
+		}
+
+	case *plan.Expr_List:
+		for i, arg := range ne.List.List {
+			ne.List.List[i] = expandVirtualColRefs(tableDef, arg)
+		}
+	}
+	return expr
+}
+
+func replaceGeneratedColRef(expr *Expr, exprs map[string]*Expr) (*Expr, error) {
+	var err error
+	switch ne := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		e, ok := exprs[ne.Col.Name]
+		if !ok {
+			return nil, moerr.NewInternalErrorNoCtxf("can not find col %s in generated column", ne.Col.Name)
+		}
+		return DeepCopyExpr(e), nil
+
+	case *plan.Expr_F:
+		for i, arg := range ne.F.Args {
+			if ne.F.Args[i], err = replaceGeneratedColRef(arg, exprs); err != nil {
+				return nil, err
+			}
+		}
+
+	case *plan.Expr_List:
+		for i, arg := range ne.List.List {
+			if ne.List.List[i], err = replaceGeneratedColRef(arg, exprs); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return expr, nil
+}
+
+// checkInsertGeneratedCols rejects the values given to the generated columns
+// by the INSERT statement, only DEFAULT is allowed.
+func checkInsertGeneratedCols(ctx context.Context, tableDef *TableDef, insertColumns []string, rows *tree.Select) error {
+	generated := make(map[string]*ColDef)
+	for _, col := range tableDef.Cols {
+		if col.Generated != nil {
+			generated[col.Name] = col
+		}
+	}
+	if len(generated) == 0 {
+		return nil
+	}
+
+	for i, name := range insertColumns {
+		col, ok := generated[name]
+		if !ok {
+			continue
+		}
+		values, isValues := rows.Select.(*tree.ValuesClause)
+		if !isValues {
+			return moerr.NewInvalidInputf(ctx, "the value specified for generated column '%s' in table '%s' is not allowed", col.OriginName, tableDef.Name)
+		}
+		for j, row := range values.Rows {
+			if row == nil {
+				continue
+			}
+			if i >= len(row) {
+				return moerr.NewWrongValueCountOnRow(ctx, j+1)
+			}
+			if _, ok := row[i].(*tree.DefaultVal); !ok {
+				return moerr.NewInvalidInputf(ctx, "the value specified for generated column '%s' in table '%s' is not allowed", col.OriginName, tableDef.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// rewriteGeneratedUpdateExprs adds the written generated columns which depend
+// on the updated columns to updateExprs, the SET expressions keyed by column
+// name. The generation expression is rewritten to refer to the new values of
+// the updated columns and to the current values of the others, which are
+// qualified by alias if it is not empty. The generated columns which are not
+// written keep NULL.
+func rewriteGeneratedUpdateExprs(ctx context.Context, tableDef *TableDef, alias string, updateExprs map[string]tree.Expr) error {
+	for _, col := range tableDef.Cols {
+		if col.Generated == nil {
+			continue
+		}
+		recompute := false
+		if expr, ok := updateExprs[col.Name]; ok {
+			if _, ok = expr.(*tree.DefaultVal); !ok {
+				return moerr.NewInvalidInputf(ctx, "the value specified for generated column '%s' in table '%s' is not allowed", col.OriginName, tableDef.Name)
+			}
+			recompute = true
+		} else {
+			cols := make(map[string]bool)
+			getCheckCols(col.Generated.Expr, cols)
+			for name := range cols {
+				if _, ok = updateExprs[name]; ok {
+					recompute = true
+					break
+				}
+			}
+		}
+		if !recompute || !isWrittenGeneratedCol(tableDef, col) {
+			continue
+		}
+
+		expr, err := parseGeneratedColExpr(ctx, col.Generated)
+		if err != nil {
+			return err
+		}
+		expr, _ = expr.Accept(&generatedExprRewriter{alias: alias, updateExprs: updateExprs})
+		updateExprs[col.Name] = expr
+	}
+	return nil
+}
+
+func parseGeneratedColExpr(ctx context.Context, generated *plan.GeneratedColDef) (tree.Expr, error) {
+	stmt, err := mysql.ParseOne(ctx, "select "+generated.ExprStr, 1)
+	if err != nil {
+		return nil, err
+	}
+	if slt, ok := stmt.(*tree.Select); ok {
+		if clause, ok := slt.Select.(*tree.SelectClause); ok && len(clause.Exprs) == 1 {
+			return clause.Exprs[0].Expr, nil
+		}
+	}
+	return nil, moerr.NewInternalErrorf(ctx, "invalid expression of generated column '%s'", generated.Name)
+}
+
+// generatedExprRewriter replaces the column names of a generation expression
+// for rewriteGeneratedUpdateExprs.
+type generatedExprRewriter struct {
+	alias       string
+	updateExprs map[string]tree.Expr
+}
+
+func (r *generatedExprRewriter) Enter(n tree.Expr) (tree.Expr, bool) {
+	name, ok := n.(*tree.UnresolvedName)
+	if !ok {
+		return n, false
+	}
+	colName := name.ColName()
+	if expr, ok := r.updateExprs[colName]; ok {
+		return tree.NewParentExpr(expr), true
+	}
+	if r.alias == "" {
+		return tree.NewUnresolvedColName(colName), true
+	}
+	return tree.NewUnresolvedName(tree.NewCStr(r.alias, 0), tree.NewCStr(colName, 1)), true
+}
+
+func (r *generatedExprRewriter) Exit(n tree.Expr) (tree.Expr, bool) {
+	return n, true
+}
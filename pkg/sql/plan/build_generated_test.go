@@ -0,0 +1,134 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildGeneratedColumn(t *testing.T) {
+	setupCheckTestRuntime()
+	mock := NewMockOptimizer(false)
+	tableDef, err := buildTestCreateTableStmt(mock, `create table t1 (
+		a int primary key,
+		j json,
+		b varchar(20) generated always as (json_unquote(json_extract(j, '$.b'))) stored,
+		c int as (a + 1) virtual,
+		d int as (length(b)) not null,
+		key idx_d (d)
+	)`)
+	require.NoError(t, err)
+
+	cols := make(map[string]*ColDef)
+	colPos := make(map[string]int32)
+	for i, col := range tableDef.Cols {
+		cols[col.Name] = col
+		colPos[col.Name] = int32(i)
+	}
+	require.Nil(t, cols["a"].Generated)
+	require.True(t, cols["b"].Generated.Stored)
+	require.Equal(t, "json_unquote(json_extract(j, \"$.b\"))", cols["b"].Generated.ExprStr)
+	require.False(t, cols["c"].Generated.Stored)
+	require.False(t, cols["d"].Generated.Stored)
+	require.Equal(t, cols["d"].Typ.Id, cols["d"].Generated.Expr.Typ.Id)
+
+	var snapshot *plan.Snapshot
+	showSQL, _, err := ConstructCreateTableSQL(&mock.ctxt, tableDef, snapshot, false)
+	require.NoError(t, err)
+	require.Contains(t, showSQL, "`b` varchar(20) GENERATED ALWAYS AS (json_unquote(json_extract(j, \"$.b\"))) STORED")
+	require.Contains(t, showSQL, "`c` int GENERATED ALWAYS AS (a + 1) VIRTUAL")
+	require.Contains(t, showSQL, "`d` int GENERATED ALWAYS AS (length(b)) VIRTUAL NOT NULL")
+
+	// only the unindexed virtual column is computed on read
+	exprs := getVirtualColExprs(tableDef, 1)
+	require.Equal(t, 1, len(exprs))
+	require.NotNil(t, exprs[colPos["c"]])
+
+	ctx := mock.CurrentContext().GetContext()
+	require.Error(t, checkAlterColumnWithGenerated(ctx, tableDef, "j"))
+	require.Error(t, checkAlterColumnWithGenerated(ctx, tableDef, "b"))
+	require.NoError(t, checkAlterColumnWithGenerated(ctx, tableDef, "d"))
+
+	// only the unindexed nullable virtual column is not written
+	require.True(t, isWrittenGeneratedCol(tableDef, cols["b"]))
+	require.False(t, isWrittenGeneratedCol(tableDef, cols["c"]))
+	require.True(t, isWrittenGeneratedCol(tableDef, cols["d"]))
+
+	// the written generated columns depending on the updated ones are recomputed
+	updateExprs := map[string]tree.Expr{"a": tree.NewNumVal(int64(2), "2", false, tree.P_int64)}
+	require.NoError(t, rewriteGeneratedUpdateExprs(ctx, tableDef, "t1", updateExprs))
+	require.Equal(t, 1, len(updateExprs))
+	updateExprs = map[string]tree.Expr{"j": tree.NewNumVal("{}", "{}", false, tree.P_char)}
+	require.NoError(t, rewriteGeneratedUpdateExprs(ctx, tableDef, "t1", updateExprs))
+	require.Equal(t, 3, len(updateExprs))
+	require.Equal(t, "length((json_unquote(json_extract(({}), $.b))))", tree.String(updateExprs["d"], dialect.MYSQL))
+
+	updateExprs = map[string]tree.Expr{"c": tree.NewNumVal(int64(2), "2", false, tree.P_int64)}
+	require.Error(t, rewriteGeneratedUpdateExprs(ctx, tableDef, "t1", updateExprs))
+
+	// the unwritten virtual column is written as NULL, and computed by the
+	// expressions evaluated on the written rows
+	projList := make([]*Expr, len(tableDef.Cols))
+	colPosMap := make(map[string]int, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		projList[i] = &Expr{
+			Typ:  col.Typ,
+			Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: int32(i), Name: col.Name}},
+		}
+		colPosMap[col.Name] = i
+	}
+	require.NoError(t, fillGeneratedColExprs(ctx, tableDef, projList, colPosMap))
+	require.True(t, isNullExpr(projList[colPos["c"]].GetF().Args[0]))
+	require.Nil(t, projList[colPos["d"]].GetCol())
+	expanded := expandVirtualColRefs(tableDef, &Expr{Expr: &plan.Expr_Col{Col: &plan.ColRef{Name: "c"}}})
+	usedCols := make(map[string]bool)
+	getCheckCols(expanded, usedCols)
+	require.Equal(t, map[string]bool{"a": true}, usedCols)
+
+	// an index may not be added on the unwritten virtual column
+	require.Error(t, checkIndexOnVirtualCols(ctx, tableDef, []*tree.KeyPart{{ColName: tree.NewUnresolvedColName("c")}}))
+	require.NoError(t, checkIndexOnVirtualCols(ctx, tableDef, []*tree.KeyPart{{ColName: tree.NewUnresolvedColName("d")}}))
+
+	// a row with less values than the insert columns
+	stmt, err := mysql.ParseOne(ctx, "insert into t1 (a, c) values (1, default), (2)", 1)
+	require.NoError(t, err)
+	require.Error(t, checkInsertGeneratedCols(ctx, tableDef, []string{"a", "c"}, stmt.(*tree.Insert).Rows))
+}
+
+func TestBuildGeneratedColumnError(t *testing.T) {
+	setupCheckTestRuntime()
+	mock := NewMockOptimizer(false)
+	sqls := []string{
+		"create table t1 (a int, b int as (c + 1), c int as (a + 1))",
+		"create table t1 (a int, b int as (b + 1))",
+		"create table t1 (a int, b int as (d + 1))",
+		"create table t1 (a int auto_increment primary key, b int as (a + 1))",
+		"create table t1 (a int, b double as (a + rand()))",
+		"create table t1 (a datetime, b datetime as (now()))",
+		"create table t1 (a int, b int as ((select 1)))",
+		"create table t1 (a int, b int as (sum(a)))",
+		"create table t1 (a int, b int as (a + @v))",
+		"create table t1 (a int, b int as (a + 1) default 1)",
+		"create table t1 (a int, b int as (a + 1) virtual primary key)",
+		"create table t1 (a int, b int as (a + 1)) as select 1 as a",
+	}
+	runTestShouldError(mock, t, sqls)
+}
@@ -0,0 +1,552 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+var lateral_subquery_func_name = "lateral_subquery"
+
+// LateralSubqueryAlias is the alias of the derived table run by lateral_subquery.
+const LateralSubqueryAlias = "mo_lateral_subquery"
+
+// LateralSubqueryParam is the param of lateral_subquery, which runs a LATERAL derived
+// table for every row of the left side. The derived table is run as
+//
+//	SELECT mo_lateral_subquery.* FROM (SELECT <arg> AS <col>, ...) AS <table>, ... CROSS JOIN <Sql>
+//
+// where the args are the columns of the left row referenced by the derived table.
+type LateralSubqueryParam struct {
+	Sql    string               `json:"sql"`
+	Tables []string             `json:"tables"`
+	Args   []LateralSubqueryArg `json:"args"`
+}
+
+// LateralSubqueryArg is a column of the left row referenced by the derived table.
+type LateralSubqueryArg struct {
+	// Table is the position of the table of the column in Tables.
+	Table int    `json:"table"`
+	Col   string `json:"col"`
+	Type  string `json:"type"`
+}
+
+func isLateralTable(tbl tree.TableExpr) (*tree.AliasedTableExpr, bool) {
+	aliased, ok := tbl.(*tree.AliasedTableExpr)
+	if !ok || !aliased.Lateral {
+		return nil, false
+	}
+	return aliased, true
+}
+
+// buildLateralJoin builds a join whose right side is a LATERAL derived table or
+// table function, which can reference the columns of the left side.
+// A table function is evaluated for every row of the left side by the APPLY node,
+// while the correlated predicates of a derived table are pulled up into the join
+// condition. A derived table which can't be turned into a join, such as one with
+// LIMIT, is run for every row of the left side by lateral_subquery.
+func (builder *QueryBuilder) buildLateralJoin(tbl *tree.JoinTableExpr, right *tree.AliasedTableExpr, ctx *BindContext) (int32, error) {
+	var joinType plan.Node_JoinType
+	switch tbl.JoinType {
+	case tree.JOIN_TYPE_CROSS, tree.JOIN_TYPE_INNER, tree.JOIN_TYPE_STRAIGHT:
+		joinType = plan.Node_INNER
+	case tree.JOIN_TYPE_LEFT:
+		joinType = plan.Node_LEFT
+	default:
+		return 0, moerr.NewSyntaxError(builder.GetContext(), "INNER or LEFT JOIN must be used for LATERAL references")
+	}
+
+	var onCond tree.Expr
+	switch cond := tbl.Cond.(type) {
+	case nil:
+	case *tree.OnJoinCond:
+		onCond = cond.Expr
+	default:
+		return 0, moerr.NewNYI(builder.GetContext(), "USING clause with LATERAL")
+	}
+
+	leftCtx := NewBindContext(builder, ctx)
+	leftChildID, err := builder.buildTable(tbl.Left, leftCtx, -1, leftCtx)
+	if err != nil {
+		return 0, err
+	}
+	ctx.views = append(ctx.views, leftCtx.views...)
+
+	if _, ok := right.Expr.(*tree.TableFunction); ok {
+		return builder.buildLateralApply(right, joinType, onCond, leftChildID, leftCtx, ctx)
+	}
+
+	// the derived table sees the columns of the left side as the outer query, and
+	// is formatted before it is bound, which rewrites the AST
+	derived := lateralDerivedTable(right)
+	leftCtx.binder = NewTableBinder(builder, leftCtx)
+	leftCtx.lateralLeft = true
+	leftCtx.lateralConsts = builder.lateralConstCols(leftCtx)
+	rightCtx := NewBindContext(builder, leftCtx)
+	rightCtx.isLateral = true
+	rightChildID, err := builder.buildTable(right, rightCtx, -1, nil)
+	if err != nil {
+		if !moerr.IsMoErrCode(err, moerr.ErrNYI) || len(leftCtx.lateralRefs) == 0 {
+			return 0, err
+		}
+		// the correlated columns can't be planned, such as in a subquery deeper than
+		// 1 level, so the derived table is run for every row of the left side
+		if rightCtx, err = builder.bindLateralWithNulls(derived, leftCtx); err != nil {
+			return 0, err
+		}
+		ctx.views = append(ctx.views, rightCtx.views...)
+		return builder.buildLateralSubqueryApply(derived, joinType == plan.Node_LEFT, onCond, leftChildID, leftCtx, rightCtx, ctx)
+	}
+	leftCtx.lateralLeft = false
+	ctx.views = append(ctx.views, rightCtx.views...)
+
+	var joinPreds []*plan.Expr
+	outer := joinType == plan.Node_LEFT
+	subCtx := builder.ctxByNode[rightChildID]
+	if builder.treeHasCorrCol(rightChildID) {
+		var scalarAgg, ok bool
+		rightChildID, joinPreds, scalarAgg, ok, err = builder.decorrelateLateral(rightChildID, subCtx)
+		if err != nil {
+			return 0, err
+		}
+
+		// a scalar aggregation returns one row even if nothing matches the left row
+		if ok && scalarAgg && len(joinPreds) > 0 {
+			if outer && !isTrueCondition(onCond) {
+				return 0, moerr.NewNYI(builder.GetContext(), "ON condition of LEFT JOIN LATERAL with aggregation")
+			}
+			joinType = plan.Node_LEFT
+			ok, err = builder.fixLateralCount(subCtx, rightCtx)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		if !ok {
+			return builder.buildLateralSubqueryApply(derived, outer, onCond, leftChildID, leftCtx, rightCtx, ctx)
+		}
+	}
+
+	err = ctx.mergeContexts(builder.GetContext(), leftCtx, rightCtx)
+	if err != nil {
+		return 0, err
+	}
+
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType: plan.Node_JOIN,
+		Children: []int32{leftChildID, rightChildID},
+		JoinType: joinType,
+		OnList:   joinPreds,
+	}, ctx)
+
+	return builder.appendLateralCondition(nodeID, onCond, outer, ctx)
+}
+
+// buildLateralApply builds an APPLY node running the table function for every row of the left side.
+func (builder *QueryBuilder) buildLateralApply(right *tree.AliasedTableExpr, joinType plan.Node_JoinType, onCond tree.Expr, leftChildID int32, leftCtx, ctx *BindContext) (int32, error) {
+	applyType := plan.Node_CROSSAPPLY
+	if joinType == plan.Node_LEFT {
+		if !isTrueCondition(onCond) {
+			return 0, moerr.NewNYI(builder.GetContext(), "ON condition of LEFT JOIN LATERAL with table function")
+		}
+		applyType = plan.Node_OUTERAPPLY
+	}
+
+	rightCtx := NewBindContext(builder, ctx)
+	rightChildID, err := builder.buildTable(right, rightCtx, leftChildID, leftCtx)
+	if err != nil {
+		return 0, err
+	}
+	builder.qry.Nodes[rightChildID].Children = nil //ignore the child of table_function in apply
+	ctx.views = append(ctx.views, rightCtx.views...)
+
+	err = ctx.mergeContexts(builder.GetContext(), leftCtx, rightCtx)
+	if err != nil {
+		return 0, err
+	}
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:  plan.Node_APPLY,
+		Children:  []int32{leftChildID, rightChildID},
+		ApplyType: applyType,
+	}, ctx)
+
+	return builder.appendLateralCondition(nodeID, onCond, applyType == plan.Node_OUTERAPPLY, ctx)
+}
+
+// appendLateralCondition binds the ON condition of a lateral join. It is put into the
+// join condition of a LEFT join, and into a FILTER node above an inner one.
+func (builder *QueryBuilder) appendLateralCondition(nodeID int32, onCond tree.Expr, outer bool, ctx *BindContext) (int32, error) {
+	binder := NewTableBinder(builder, ctx)
+	binder.allowSubquery = !outer
+	ctx.binder = binder
+	if onCond == nil || isTrueCondition(onCond) {
+		return nodeID, nil
+	}
+
+	conds, err := splitAndBindCondition(onCond, NoAlias, ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if outer {
+		node := builder.qry.Nodes[nodeID]
+		node.OnList = append(node.OnList, conds...)
+		return nodeID, nil
+	}
+
+	return builder.appendSubqueryFilter(nodeID, conds, ctx)
+}
+
+// buildLateralSubqueryApply builds an APPLY node running the LATERAL derived table
+// for every row of the left side, when its correlated columns can't be pulled up into
+// the join condition. The derived table is run by the lateral_subquery table function,
+// which binds the referenced columns of the left row as constants.
+func (builder *QueryBuilder) buildLateralSubqueryApply(derived string, outer bool, onCond tree.Expr, leftChildID int32, leftCtx, rightCtx, ctx *BindContext) (int32, error) {
+	if leftCtx.lateralOuterRef {
+		return 0, moerr.NewNYI(builder.GetContext(), "LATERAL derived table referencing the outer query")
+	}
+	applyType := plan.Node_CROSSAPPLY
+	if outer {
+		if !isTrueCondition(onCond) {
+			return 0, moerr.NewNYI(builder.GetContext(), "ON condition of LEFT JOIN LATERAL with correlated derived table")
+		}
+		applyType = plan.Node_OUTERAPPLY
+	}
+
+	param := LateralSubqueryParam{Sql: derived}
+	args := make([]*plan.Expr, len(leftCtx.lateralRefs))
+	tables := make(map[int32]int)
+	for i, ref := range leftCtx.lateralRefs {
+		binding := leftCtx.bindingByTag[ref[0]]
+		typ := binding.types[ref[1]]
+		args[i] = &plan.Expr{
+			Typ: *typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: ref[0],
+					ColPos: ref[1],
+					Name:   binding.cols[ref[1]],
+				},
+			},
+		}
+
+		table, ok := tables[ref[0]]
+		if !ok {
+			table = len(param.Tables)
+			tables[ref[0]] = table
+			param.Tables = append(param.Tables, binding.table)
+		}
+		param.Args = append(param.Args, LateralSubqueryArg{
+			Table: table,
+			Col:   binding.cols[ref[1]],
+			Type:  FormatColType(*typ),
+		})
+	}
+	params, err := json.Marshal(param)
+	if err != nil {
+		return 0, err
+	}
+
+	// the function scan returns the columns of the derived table under its name
+	binding := rightCtx.bindings[0]
+	colDefs := make([]*plan.ColDef, len(binding.cols))
+	for i, col := range binding.cols {
+		colDefs[i] = &plan.ColDef{
+			Name: col,
+			Typ:  *binding.types[i],
+		}
+	}
+	applyCtx := NewBindContext(builder, ctx)
+	rightChildID := builder.appendNode(&plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name:  lateral_subquery_func_name,
+				Param: params,
+			},
+			Cols: colDefs,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		TblFuncExprList: args,
+	}, applyCtx)
+	if err = builder.addBinding(rightChildID, tree.AliasClause{Alias: tree.Identifier(binding.table)}, applyCtx); err != nil {
+		return 0, err
+	}
+
+	err = ctx.mergeContexts(builder.GetContext(), leftCtx, applyCtx)
+	if err != nil {
+		return 0, err
+	}
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:  plan.Node_APPLY,
+		Children:  []int32{leftChildID, rightChildID},
+		ApplyType: applyType,
+	}, ctx)
+
+	return builder.appendLateralCondition(nodeID, onCond, outer, ctx)
+}
+
+// bindLateralWithNulls binds the LATERAL derived table again with the columns of the
+// left side bound as NULLs of their types, for the columns it returns when it can't be
+// planned with the correlated columns. The referenced columns are recorded again.
+func (builder *QueryBuilder) bindLateralWithNulls(derived string, leftCtx *BindContext) (*BindContext, error) {
+	stmt, err := mysql.ParseOne(builder.GetContext(), "select * from "+derived, builder.compCtx.GetLowerCaseTableNames())
+	if err != nil {
+		return nil, err
+	}
+	table := stmt.(*tree.Select).Select.(*tree.SelectClause).From.Tables[0]
+	if join, ok := table.(*tree.JoinTableExpr); ok && join.Right == nil {
+		table = join.Left
+	}
+
+	consts := leftCtx.lateralConsts
+	leftCtx.lateralConsts = make(map[[2]int32]*plan.Expr)
+	for _, binding := range leftCtx.bindings {
+		for i, typ := range binding.types {
+			if binding.colIsHidden[i] {
+				continue
+			}
+			value, err := forceCastExpr(builder.GetContext(), makePlan2NullConstExprWithType(), *typ)
+			if err != nil {
+				return nil, err
+			}
+			leftCtx.lateralConsts[[2]int32{binding.tag, int32(i)}] = value
+		}
+	}
+	leftCtx.lateralRefs = nil
+	leftCtx.lateralOuterRef = false
+
+	rightCtx := NewBindContext(builder, leftCtx)
+	rightCtx.isLateral = true
+	_, err = builder.buildTable(table, rightCtx, -1, nil)
+	leftCtx.lateralConsts = consts
+	leftCtx.lateralLeft = false
+	return rightCtx, err
+}
+
+// lateralDerivedTable formats a LATERAL derived table as it is run by lateral_subquery.
+func lateralDerivedTable(right *tree.AliasedTableExpr) string {
+	derived := &tree.AliasedTableExpr{
+		Expr: right.Expr,
+		As: tree.AliasClause{
+			Alias: LateralSubqueryAlias,
+			Cols:  right.As.Cols,
+		},
+		Lateral: true,
+	}
+	return tree.StringWithOpts(derived, dialect.MYSQL, tree.WithQuoteString(true))
+}
+
+// lateralConstCols returns the columns of the left side of a LATERAL join which are
+// constants, such as the left row passed to the derived table run by lateral_subquery.
+// They are bound as their values, so that the derived table isn't correlated.
+func (builder *QueryBuilder) lateralConstCols(leftCtx *BindContext) map[[2]int32]*plan.Expr {
+	var consts map[[2]int32]*plan.Expr
+	for _, binding := range leftCtx.bindings {
+		node := builder.qry.Nodes[binding.nodeId]
+		if node.NodeType != plan.Node_PROJECT || len(node.BindingTags) == 0 || node.BindingTags[0] != binding.tag {
+			continue
+		}
+		child := builder.qry.Nodes[node.Children[0]]
+		if child.NodeType != plan.Node_VALUE_SCAN || child.TableDef != nil {
+			continue
+		}
+		if slices.ContainsFunc(node.ProjectList, func(expr *plan.Expr) bool { return !isLiteralOrCast(expr) }) {
+			continue
+		}
+
+		if consts == nil {
+			consts = make(map[[2]int32]*plan.Expr)
+		}
+		for i, expr := range node.ProjectList {
+			consts[[2]int32{binding.tag, int32(i)}] = expr
+		}
+	}
+	return consts
+}
+
+// isLiteralOrCast returns true if the expression is a literal, or a cast of a literal.
+func isLiteralOrCast(expr *plan.Expr) bool {
+	if f := expr.GetF(); f != nil && f.Func.ObjName == "cast" {
+		expr = f.Args[0]
+	}
+	return expr.GetLit() != nil
+}
+
+func (bc *BindContext) addLateralRef(relPos, colPos int32) {
+	ref := [2]int32{relPos, colPos}
+	if !slices.Contains(bc.lateralRefs, ref) {
+		bc.lateralRefs = append(bc.lateralRefs, ref)
+	}
+}
+
+// decorrelateLateral pulls the correlated predicates of a LATERAL derived table up,
+// and returns them as the join condition. scalarAgg is true if the derived table is
+// an aggregation without GROUP BY. ok is false if the derived table can't be turned
+// into a join, and has to be run for every row of the left side.
+func (builder *QueryBuilder) decorrelateLateral(nodeID int32, subCtx *BindContext) (newID int32, joinPreds []*plan.Expr, scalarAgg bool, ok bool, err error) {
+	if !builder.canPullupLateral(nodeID) {
+		return nodeID, nil, false, false, nil
+	}
+
+	nodeID, preds, err := builder.pullupCorrelatedPredicates(nodeID, subCtx)
+	if err != nil {
+		return 0, nil, false, false, err
+	}
+
+	// the COUNT of the left rows without a match is fixed only for the equal predicates
+	scalarAgg = len(subCtx.aggregates) > 0 && len(subCtx.groups) == 0
+	if scalarAgg && builder.findNonEqPred(preds) {
+		return nodeID, nil, false, false, nil
+	}
+
+	filterPreds, joinPreds := decreaseDepthAndDispatch(preds)
+	if len(filterPreds) > 0 || builder.treeHasCorrCol(nodeID) {
+		// correlated deeper than 1 level, or outside the WHERE clause
+		return nodeID, nil, false, false, nil
+	}
+
+	return nodeID, joinPreds, scalarAgg, true, nil
+}
+
+// canPullupLateral returns false if the correlated predicates can't be pulled above
+// a LIMIT, a set operation, a window, the null-supplying side of an outer join, or
+// the HAVING clause of an aggregation without GROUP BY.
+func (builder *QueryBuilder) canPullupLateral(nodeID int32) bool {
+	node := builder.qry.Nodes[nodeID]
+	if !builder.treeHasCorrCol(nodeID) {
+		return true
+	}
+
+	if node.Limit != nil || node.Offset != nil {
+		return false
+	}
+	switch node.NodeType {
+	case plan.Node_UNION, plan.Node_UNION_ALL, plan.Node_INTERSECT, plan.Node_INTERSECT_ALL, plan.Node_MINUS, plan.Node_MINUS_ALL:
+		return false
+
+	case plan.Node_WINDOW:
+		return false
+
+	case plan.Node_JOIN:
+		if node.JoinType != plan.Node_INNER {
+			return false
+		}
+
+	case plan.Node_FILTER:
+		child := builder.qry.Nodes[node.Children[0]]
+		if child.NodeType == plan.Node_AGG && len(child.GroupBy) == 0 {
+			return false
+		}
+	}
+
+	for _, childID := range node.Children {
+		if !builder.canPullupLateral(childID) {
+			return false
+		}
+	}
+	return true
+}
+
+// fixLateralCount makes the COUNT of a scalar aggregation read as 0 instead of NULL,
+// for the left rows which don't match any row of the LATERAL derived table. It returns
+// false if a COUNT is used in an expression, which has to be run for every left row.
+func (builder *QueryBuilder) fixLateralCount(subCtx, rightCtx *BindContext) (bool, error) {
+	if !builder.findAggrCount(subCtx.aggregates) {
+		return true, nil
+	}
+
+	binding := rightCtx.bindingByTag[subCtx.rootTag()]
+	counts := make(map[int32]*plan.Expr)
+	for i := range binding.cols {
+		proj := subCtx.projects[i]
+		if col, ok := proj.Expr.(*plan.Expr_Col); ok && col.Col.RelPos == subCtx.aggregateTag {
+			if !builder.findAggrCount([]*plan.Expr{subCtx.aggregates[col.Col.ColPos]}) {
+				continue
+			}
+
+			colRef := &plan.Expr{
+				Typ: *binding.types[i],
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: binding.tag,
+						ColPos: int32(i),
+						Name:   binding.cols[i],
+					},
+				},
+			}
+			expr, err := BindFuncExprImplByPlanExpr(builder.GetContext(), "coalesce", []*plan.Expr{colRef, makePlan2Int64ConstExprWithType(0)})
+			if err != nil {
+				return false, err
+			}
+			counts[int32(i)] = expr
+		} else if containsTag(proj, subCtx.aggregateTag) {
+			return false, nil
+		}
+	}
+	binding.lateralCounts = counts
+	return true, nil
+}
+
+func (builder *QueryBuilder) treeHasCorrCol(nodeID int32) bool {
+	node := builder.qry.Nodes[nodeID]
+
+	var exprs []*plan.Expr
+	exprs = append(exprs, node.ProjectList...)
+	exprs = append(exprs, node.FilterList...)
+	exprs = append(exprs, node.OnList...)
+	exprs = append(exprs, node.GroupBy...)
+	exprs = append(exprs, node.AggList...)
+	exprs = append(exprs, node.WinSpecList...)
+	exprs = append(exprs, node.TblFuncExprList...)
+	for _, orderBy := range node.OrderBy {
+		exprs = append(exprs, orderBy.Expr)
+	}
+	if node.Limit != nil {
+		exprs = append(exprs, node.Limit)
+	}
+	if node.Offset != nil {
+		exprs = append(exprs, node.Offset)
+	}
+	for _, expr := range exprs {
+		if hasCorrCol(expr) {
+			return true
+		}
+	}
+
+	for _, childID := range node.Children {
+		if builder.treeHasCorrCol(childID) {
+			return true
+		}
+	}
+	return false
+}
+
+func isTrueCondition(cond tree.Expr) bool {
+	if cond == nil {
+		return true
+	}
+	numVal, ok := cond.(*tree.NumVal)
+	return ok && numVal.ValType == tree.P_bool && numVal.Bool()
+}
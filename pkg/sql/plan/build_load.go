@@ -331,6 +331,13 @@ func buildLoad(stmt *tree.Load, ctx CompilerContext, isPrepareStmt bool) (*Plan,
 	if stmt.Param.Parallel && (!noCompress || stmt.Local) {
 		projectNode.ProjectList = makeCastExpr(stmt, fileName, originTableDef, projectNode)
 	}
+	colToPos := make(map[string]int, len(originTableDef.Cols))
+	for i, col := range originTableDef.Cols {
+		colToPos[col.Name] = i
+	}
+	if err = fillGeneratedColExprs(ctx.GetContext(), originTableDef, projectNode.ProjectList, colToPos); err != nil {
+		return nil, err
+	}
 	lastNodeId = builder.appendNode(projectNode, bindCtx)
 	builder.qry.LoadTag = true
 
@@ -98,7 +98,17 @@ func ConstructCreateTableSQL(ctx CompilerContext, tableDef *plan.TableDef, snaps
 		fmt.Fprintf(buf, "  `%s` %s", formatStr(colNameOrigin), typeStr)
 
 		//-------------------------------------------------------------------------------------------------------------
-		if col.Typ.AutoIncr {
+		if col.Generated != nil {
+			buf.WriteString(" GENERATED ALWAYS AS (" + col.Generated.ExprStr + ")")
+			if col.Generated.Stored {
+				buf.WriteString(" STORED")
+			} else {
+				buf.WriteString(" VIRTUAL")
+			}
+			if !col.Default.NullAbility {
+				buf.WriteString(" NOT NULL")
+			}
+		} else if col.Typ.AutoIncr {
 			buf.WriteString(" NOT NULL AUTO_INCREMENT")
 		} else {
 			if !col.Default.NullAbility {
@@ -263,6 +273,16 @@ func ConstructCreateTableSQL(ctx CompilerContext, tableDef *plan.TableDef, snaps
 			formatStr(fk.Name), strings.Join(colOriginNames, "`,`"), fkRefDbTblName, strings.Join(fkColOriginNames, "`,`"), fk.OnDelete.String(), fk.OnUpdate.String())
 	}
 
+	for _, check := range tableDef.Checks {
+		if rowCount != 0 {
+			createStr += ",\n"
+		}
+		createStr += fmt.Sprintf("  CONSTRAINT `%s` CHECK (%s)", formatStr(check.Name), check.ExprStr)
+		if check.NotEnforced {
+			createStr += " NOT ENFORCED"
+		}
+	}
+
 	if rowCount != 0 {
 		createStr += "\n"
 	}
@@ -427,7 +447,7 @@ func FormatColType(colType plan.Type) string {
 	case types.T_bit, types.T_char, types.T_varchar, types.T_binary, types.T_varbinary:
 		suffix = fmt.Sprintf("(%d)", colType.Width)
 
-	case types.T_array_float32, types.T_array_float64:
+	case types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		suffix = fmt.Sprintf("(%d)", colType.Width)
 
 	}
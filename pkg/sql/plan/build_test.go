@@ -18,13 +18,17 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/lock"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -485,12 +489,21 @@ func TestSingleTableSQLBuilder(t *testing.T) {
 		"select date('2022-01-01'), adddate(time'00:00:00', interval 1 day), subdate(time'00:00:00', interval 1 week), '2007-01-01' + interval 1 month, '2007-01-01' -  interval 1 hour",
 		"select 2222332222222223333333333333333333, 0x616263,-10, bit_and(2), bit_or(2), bit_xor(10.1), 'aaa' like '%a',str_to_date('04/31/2004', '%m/%d/%Y'),unix_timestamp(from_unixtime(2147483647))",
 		"select max(n_nationkey) over  (partition by N_REGIONKEY) from nation",
+		"select lag(n_name) over (order by n_nationkey), lead(n_nationkey, 2, 0) ignore nulls over (partition by n_regionkey order by n_nationkey) from nation",
+		"select first_value(n_name) over (order by n_nationkey), last_value(n_name) ignore nulls over (order by n_nationkey rows between unbounded preceding and unbounded following) from nation",
+		"select nth_value(n_name, 2) from first over (partition by n_regionkey order by n_nationkey) from nation",
+		"select ntile(3) over (order by n_nationkey), percent_rank() over (order by n_nationkey), cume_dist() over (partition by n_regionkey order by n_nationkey) from nation",
+		"select count(distinct n_regionkey) over (order by n_nationkey groups between 1 preceding and current row), sum(distinct n_regionkey) over (partition by n_name) from nation",
 		"select * from generate_series(1, 5) g",
 		"prepare stmt1 from select * from nation where n_name like ? or n_nationkey > 10 order by 2 limit '10'",
 
 		"values row(1,1), row(2,2), row(3,3) order by column_0 limit 2",
 		"select * from (values row(1,1), row(2,2), row(3,3)) a (c1, c2)",
 		"prepare stmt1 from select * from nation where n_name like ? or n_nationkey > 10 order by 2 limit '10' for update",
+		"select * from nation where n_nationkey > 10 for update nowait",
+		"select * from nation where n_nationkey > 10 for update wait 5",
+		"select * from nation order by n_nationkey limit 1 for update skip locked",
+		"select * from nation where n_nationkey > 10 for share",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -509,12 +522,77 @@ func TestSingleTableSQLBuilder(t *testing.T) {
 		"SELECT DISTINCT N_NAME FROM NATION GROUP BY N_REGIONKEY", //test distinct with group by
 		"SELECT DISTINCT N_NAME FROM NATION ORDER BY N_REGIONKEY", //test distinct with order by
 		"select count(n_name) from nation limit 10 for update",
+		"select nth_value(n_name, 2) from last over (order by n_nationkey) from nation", // FROM LAST is not supported
+		"select lag(n_name, 1, 2, 3) over (order by n_nationkey) from nation",
+		"select sum(n_nationkey) over (groups between 1 preceding and current row) from nation", // GROUPS requires order by
 		//"select 18446744073709551500",                             //over int64
 		//"select 0xffffffffffffffff",                               //over int64
 	}
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestSelectLockOptions(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	cases := []struct {
+		sql     string
+		mode    lock.LockMode
+		policy  lock.WaitPolicy
+		timeout int64
+	}{
+		{"select * from nation for update", lock.LockMode_Exclusive, lock.WaitPolicy_Wait, 0},
+		{"select * from nation for update nowait", lock.LockMode_Exclusive, lock.WaitPolicy_FastFail, 0},
+		{"select * from nation for update wait 3", lock.LockMode_Exclusive, lock.WaitPolicy_Wait, int64(3 * time.Second)},
+		{"select * from nation for update skip locked", lock.LockMode_Exclusive, lock.WaitPolicy_SkipLocked, 0},
+		{"select * from nation for share", lock.LockMode_Shared, lock.WaitPolicy_Wait, 0},
+		{"select * from nation for share nowait", lock.LockMode_Shared, lock.WaitPolicy_FastFail, 0},
+	}
+	for _, c := range cases {
+		p, err := runOneStmt(mock, t, c.sql)
+		require.NoError(t, err, c.sql)
+		var target *plan.LockTarget
+		for _, node := range p.GetQuery().Nodes {
+			if node.NodeType == plan.Node_LOCK_OP {
+				target = node.LockTargets[0]
+			}
+		}
+		require.NotNil(t, target, c.sql)
+		require.Equal(t, c.mode, target.Mode, c.sql)
+		require.Equal(t, c.policy, target.WaitPolicy, c.sql)
+		require.Equal(t, c.timeout, target.WaitTimeout, c.sql)
+	}
+}
+
+func TestSelectSkipLockedWithLimit(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sqls := []string{
+		"select n_name from nation where n_regionkey > 0 order by n_nationkey limit 2 for update skip locked",
+		"select n_name from nation limit 2 offset 1 for update skip locked",
+	}
+	for _, sql := range sqls {
+		p, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+		qry := p.GetQuery()
+		var lockNode *plan.Node
+		for _, node := range qry.Nodes {
+			if node.NodeType == plan.Node_LOCK_OP {
+				lockNode = node
+			}
+		}
+		// the lock node is above the sort node and takes the limit, so it
+		// locks no more rows than the limit.
+		require.NotNil(t, lockNode, sql)
+		require.NotNil(t, lockNode.Limit, sql)
+		for _, node := range qry.Nodes {
+			if node.NodeType == plan.Node_SORT {
+				require.Nil(t, node.Limit, sql)
+			}
+		}
+	}
+
+	_, err := runOneStmt(mock, t, "select count(*) from nation group by n_regionkey limit 1 for update skip locked")
+	require.Error(t, err)
+}
+
 // test join table plan building
 func TestJoinTableSqlBuilder(t *testing.T) {
 	mock := NewMockOptimizer(false)
@@ -597,6 +675,8 @@ func TestUnionSqlBuilder(t *testing.T) {
 		"with qn (foo, bar) as (select 1 as col, 2 as coll union select 4, 5) select qn1.bar from qn qn1",
 		"select n_name, n_comment from nation union all select n_name, n_comment from nation2",
 		"select n_name from nation intersect all select n_name from nation2",
+		"select n_name from nation minus all select n_name from nation2",
+		"select n_name from nation except all select n_name from nation2 union select n_comment from nation",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -604,7 +684,6 @@ func TestUnionSqlBuilder(t *testing.T) {
 	sqls = []string{
 		"select 1 union select 2, 'a'",
 		"select n_name as a from nation union select n_comment from nation order by n_name",
-		"select n_name from nation minus all select n_name from nation2", // not support
 	}
 	runTestShouldError(mock, t, sqls)
 }
@@ -736,6 +815,10 @@ func TestSubQuery(t *testing.T) {
 				l_partkey = p_partkey
 		);`, //tpch q17
 		"select * from nation where n_regionkey in (select r_regionkey from region) and n_nationkey not in (1,2) and n_nationkey = some (select n_nationkey from nation2)",
+		"select * from NATION join REGION on N_REGIONKEY = R_REGIONKEY and exists (select 1 from CUSTOMER where C_NATIONKEY = N_NATIONKEY)",                               // JOIN ON
+		"select * from NATION join REGION on N_REGIONKEY = (select max(R_REGIONKEY) from REGION r2 where r2.R_NAME = N_NAME)",                                             // JOIN ON
+		"select count(*) from NATION group by (select R_NAME from REGION where R_REGIONKEY = N_REGIONKEY)",                                                                // GROUP BY
+		"select (select R_NAME from REGION where R_REGIONKEY = N_REGIONKEY) r, count(*) from NATION group by (select R_NAME from REGION where R_REGIONKEY = N_REGIONKEY)", // GROUP BY
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -745,10 +828,88 @@ func TestSubQuery(t *testing.T) {
 		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION where R_REGIONKEY < N_REGIONKEY222)", // column not exist
 		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION where R_REGIONKEY < N_REGIONKEY)",    // related
 		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION) for update",                         // not support
+		"select * from NATION left join REGION on N_REGIONKEY = (select max(R_REGIONKEY) from REGION r2)",                   // outer JOIN ON
 	}
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestLateralSqlBuilder(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"select * from NATION, lateral (select R_NAME from REGION where R_REGIONKEY = N_REGIONKEY) r",
+		"select * from NATION cross join lateral (select R_NAME from REGION where R_REGIONKEY = NATION.N_REGIONKEY) r",
+		"select * from NATION join lateral (select R_NAME from REGION where R_REGIONKEY = N_REGIONKEY) r on N_NATIONKEY > 10",
+		"select * from NATION left join lateral (select R_NAME from REGION where R_REGIONKEY = N_REGIONKEY) r on true",
+		"select N_NAME, r.cnt from NATION, lateral (select count(*) cnt from REGION where R_REGIONKEY = N_REGIONKEY) r",
+		"select N_NAME, r.c from NATION, lateral (select R_NAME, count(*) from REGION where R_REGIONKEY = N_REGIONKEY group by R_NAME) r(n, c)",
+		"select N_NAME, r.m from NATION, lateral (select max(R_NAME) m from REGION where R_REGIONKEY = N_REGIONKEY) r",
+		"select * from NATION, lateral (select R_NAME from REGION) r",
+		"select * from NATION, lateral (select * from REGION where R_REGIONKEY < N_REGIONKEY) r",
+		"select * from NATION, REGION, lateral (select C_NAME from CUSTOMER where C_NATIONKEY = N_NATIONKEY and C_CUSTKEY > R_REGIONKEY) c",
+		`select * from NATION, lateral unnest(N_COMMENT) as u`,
+		`select * from NATION cross join lateral unnest(N_COMMENT, '$') as u`,
+		`select * from NATION left join lateral unnest(N_COMMENT) as u on true`,
+		`select * from NATION join lateral unnest(N_COMMENT) as u on u.seq > 1`,
+		// run for every row of the left side
+		"select * from NATION, lateral (select N_NAME) r",
+		"select * from NATION, lateral (select R_NAME from REGION where R_REGIONKEY = N_REGIONKEY limit 1) r",
+		"select * from NATION, lateral (select count(*) from REGION where R_REGIONKEY < N_REGIONKEY) r",
+		"select * from NATION, lateral (select count(*) c from REGION where R_REGIONKEY = N_REGIONKEY having c > 1) r",
+		"select * from NATION, lateral (select R_NAME from REGION where R_REGIONKEY = N_REGIONKEY union select 'a') r",
+		"select * from NATION left join lateral (select R_NAME, row_number() over (order by R_REGIONKEY) from REGION where R_REGIONKEY = N_REGIONKEY) r on true",
+		"select * from NATION, lateral (select count(*) + 1 from REGION where R_REGIONKEY = N_REGIONKEY) r",
+		"select * from NATION, lateral (select * from REGION where R_REGIONKEY in (select C_NATIONKEY from CUSTOMER where C_CUSTKEY = N_NATIONKEY)) r",
+		"select * from (select 1 as a) t, lateral (select R_NAME from REGION where R_REGIONKEY = t.a limit 1) r",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"select * from NATION, (select R_NAME from REGION where R_REGIONKEY = N_REGIONKEY) r",                               // correlated without LATERAL
+		"select * from NATION right join lateral (select R_NAME from REGION where R_REGIONKEY = N_REGIONKEY) r on true",     // RIGHT JOIN
+		"select * from NATION left join lateral (select N_NAME) r on N_NATIONKEY > 1",                                       // ON with correlated derived table
+		"select * from NATION left join lateral (select count(*) c from REGION where R_REGIONKEY = N_REGIONKEY) r on c > 1", // ON with aggregation
+		"select * from NATION left join lateral unnest(N_COMMENT) as u on u.seq > 1",                                        // ON with table function
+		"select * from NATION join lateral (select R_NAME from REGION where R_REGIONKEY = N_REGIONKEY) r using (R_NAME)",    // USING
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestLateralSubqueryApply(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	findFuncScan := func(p *Plan) *plan.Node {
+		for _, node := range p.GetQuery().Nodes {
+			if node.NodeType == plan.Node_FUNCTION_SCAN {
+				return node
+			}
+		}
+		return nil
+	}
+
+	// the derived table with LIMIT is run for every row of the left side
+	p, err := runOneStmt(mock, t, "select * from NATION, lateral (select N_NAME, R_NAME from REGION where R_REGIONKEY = N_REGIONKEY limit 1) r")
+	require.NoError(t, err)
+	node := findFuncScan(p)
+	require.NotNil(t, node)
+	require.Equal(t, lateral_subquery_func_name, node.TableDef.TblFunc.Name)
+	require.Equal(t, 2, len(node.TableDef.Cols))
+	var param LateralSubqueryParam
+	require.NoError(t, json.Unmarshal(node.TableDef.TblFunc.Param, &param))
+	require.Equal(t, []string{"nation"}, param.Tables)
+	require.Equal(t, []LateralSubqueryArg{
+		{Table: 0, Col: "n_regionkey", Type: "INT"},
+		{Table: 0, Col: "n_name", Type: "VARCHAR(25)"},
+	}, param.Args)
+	require.Equal(t, 2, len(node.TblFuncExprList))
+
+	// which is run with the left row as constants, and is planned as a join
+	p, err = runOneStmt(mock, t, "SELECT mo_lateral_subquery.* FROM "+
+		"(SELECT CAST('1' AS INT) AS `n_regionkey`, CAST('a' AS VARCHAR(25)) AS `n_name`) AS `nation` CROSS JOIN "+param.Sql)
+	require.NoError(t, err)
+	require.Nil(t, findFuncScan(p))
+}
+
 func TestMysqlCompatibilityMode(t *testing.T) {
 	mock := NewMockOptimizer(false)
 
@@ -1032,6 +1193,29 @@ func TestBuildUnnest(t *testing.T) {
 	runTestShouldError(mock, t, errSqls)
 }
 
+func TestBuildTableChanges(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// the datetime strings are in the time zone of the session
+	from := time.Now().Add(-time.Minute)
+	sqls := []string{
+		fmt.Sprintf(`select * from table_changes('nation', '%s') as c`, from.Format("2006-01-02 15:04:05")),
+		fmt.Sprintf(`select n_name, _commit_ts, _change_type from table_changes('tpch.nation', %d, '%s') as c where _change_type = 'delete'`,
+			from.UnixNano(), types.BuildTS(from.Add(time.Second).UnixNano(), 1).ToString()),
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+	errSqls := []string{
+		fmt.Sprintf(`select * from table_changes('nation', '%s')`, from.Format("2006-01-02 15:04:05")),
+		`select * from table_changes('nation') as c`,
+		fmt.Sprintf(`select * from table_changes('nation_not_exist', %d) as c`, from.UnixNano()),
+		fmt.Sprintf(`select * from table_changes('nation', %d, %d) as c`, from.UnixNano(), from.Add(-time.Second).UnixNano()),
+		`select * from table_changes('nation', 'not a timestamp') as c`,
+		// no pitr covers the table in the mock
+		`select * from table_changes('nation', '2020-01-01 00:00:00') as c`,
+		`select * from table_changes('nation', n_name) as c`,
+	}
+	runTestShouldError(mock, t, errSqls)
+}
+
 func TestVisitRule(t *testing.T) {
 	sql := "select * from nation where n_nationkey > 10 or n_nationkey=@int_var or abs(-1) > 1"
 	mock := NewMockOptimizer(false)
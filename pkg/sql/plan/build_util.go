@@ -156,7 +156,7 @@ func getTypeFromAst(ctx context.Context, typ tree.ResolvableTypeReference) (plan
 				// create table t1(a char) -> DisplayWith = -1；but get width=1 in MySQL and PgSQL
 				if fstr == "char" || fstr == "binary" {
 					width = 1
-				} else if fstr == "vecf32" || fstr == "vecf64" {
+				} else if fstr == "vecf32" || fstr == "vecf64" || fstr == "vecf16" || fstr == "veci8" || fstr == "vecbit" {
 					width = types.MaxArrayDimension
 				} else {
 					width = types.MaxVarcharLen
@@ -167,13 +167,16 @@ func getTypeFromAst(ctx context.Context, typ tree.ResolvableTypeReference) (plan
 				return plan.Type{}, moerr.NewOutOfRangef(ctx, fstr, " typeLen is over the MaxCharLen: %v", types.MaxCharLen)
 			} else if (fstr == "varchar" || fstr == "varbinary") && width > types.MaxVarcharLen {
 				return plan.Type{}, moerr.NewOutOfRangef(ctx, fstr, " typeLen is over the MaxVarcharLen: %v", types.MaxVarcharLen)
-			} else if fstr == "vecf32" || fstr == "vecf64" {
+			} else if fstr == "vecf32" || fstr == "vecf64" || fstr == "vecf16" || fstr == "veci8" || fstr == "vecbit" {
 				if width > types.MaxArrayDimension {
 					return plan.Type{}, moerr.NewOutOfRangef(ctx, fstr, " typeLen is over the MaxVectorLen : %v", types.MaxArrayDimension)
 				}
 				if width < 1 {
 					return plan.Type{}, moerr.NewOutOfRange(ctx, fstr, " typeLen cannot be less than 1")
 				}
+				if fstr == "vecbit" && n.InternalType.DisplayWith != -1 && width%8 != 0 {
+					return plan.Type{}, moerr.NewOutOfRange(ctx, fstr, " typeLen must be a multiple of 8")
+				}
 			}
 			switch fstr {
 			case "char":
@@ -186,6 +189,12 @@ func getTypeFromAst(ctx context.Context, typ tree.ResolvableTypeReference) (plan
 				return plan.Type{Id: int32(types.T_array_float32), Width: width}, nil
 			case "vecf64":
 				return plan.Type{Id: int32(types.T_array_float64), Width: width}, nil
+			case "vecf16":
+				return plan.Type{Id: int32(types.T_array_float16), Width: width}, nil
+			case "veci8":
+				return plan.Type{Id: int32(types.T_array_int8), Width: width}, nil
+			case "vecbit":
+				return plan.Type{Id: int32(types.T_array_bit), Width: width}, nil
 			}
 			// varbinary
 			return plan.Type{Id: int32(types.T_varbinary), Width: width}, nil
@@ -462,7 +471,7 @@ func getFunctionObjRef(funcID int64, name string) *ObjectRef {
 // }
 
 func getDefaultExpr(ctx context.Context, d *plan.ColDef) (*Expr, error) {
-	if !d.Default.NullAbility && d.Default.Expr == nil && !d.Typ.AutoIncr {
+	if !d.Default.NullAbility && d.Default.Expr == nil && !d.Typ.AutoIncr && d.Generated == nil {
 		return nil, moerr.NewInvalidInputf(ctx, "invalid default value for column '%s'", d.Name)
 	}
 	if d.Default.Expr == nil {
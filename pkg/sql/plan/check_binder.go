@@ -0,0 +1,72 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// NewCheckBinder returns a binder for the expression of a CHECK constraint.
+// See https://dev.mysql.com/doc/refman/8.0/en/create-table-check-constraints.html
+func NewCheckBinder(builder *QueryBuilder, ctx *BindContext) *CheckBinder {
+	b := &CheckBinder{}
+	b.sysCtx = builder.GetContext()
+	b.builder = builder
+	b.ctx = ctx
+	b.impl = b
+	b.target = "check constraint"
+	return b
+}
+
+// NewGeneratedColumnBinder returns a binder for the expression of a generated
+// column, which is restricted like the one of a CHECK constraint.
+// See https://dev.mysql.com/doc/refman/8.0/en/create-table-generated-columns.html
+func NewGeneratedColumnBinder(builder *QueryBuilder, ctx *BindContext) *CheckBinder {
+	b := NewCheckBinder(builder, ctx)
+	b.target = "generated column"
+	return b
+}
+
+func (b *CheckBinder) BindExpr(expr tree.Expr, i int32, isRoot bool) (*plan.Expr, error) {
+	switch expr.(type) {
+	case *tree.VarExpr:
+		return nil, moerr.NewInvalidInputf(b.GetContext(), "variables are not allowed in %s", b.target)
+	case *tree.ParamExpr:
+		return nil, moerr.NewInvalidInputf(b.GetContext(), "parameters are not allowed in %s", b.target)
+	}
+	return b.baseBindExpr(expr, i, isRoot)
+}
+
+func (b *CheckBinder) BindColRef(name *tree.UnresolvedName, i int32, isRoot bool) (*plan.Expr, error) {
+	return b.baseBindColRef(name, i, isRoot)
+}
+
+func (b *CheckBinder) BindAggFunc(funcName string, expr *tree.FuncExpr, i int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxErrorf(b.GetContext(), "aggregate functions not allowed in %s", b.target)
+}
+
+func (b *CheckBinder) BindWinFunc(funcName string, expr *tree.FuncExpr, i int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxErrorf(b.GetContext(), "window functions not allowed in %s", b.target)
+}
+
+func (b *CheckBinder) BindSubquery(subquery *tree.Subquery, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxErrorf(b.GetContext(), "subquery not allowed in %s", b.target)
+}
+
+func (b *CheckBinder) BindTimeWindowFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewInvalidInputf(b.GetContext(), "cannot bind time window functions '%s'", funcName)
+}
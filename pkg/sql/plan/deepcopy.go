@@ -186,6 +186,9 @@ func DeepCopyLockTarget(target *plan.LockTarget) *plan.LockTarget {
 		Block:              target.Block,
 		LockRows:           DeepCopyExpr(target.LockRows),
 		LockTableAtTheEnd:  target.LockTableAtTheEnd,
+		Mode:               target.Mode,
+		WaitPolicy:         target.WaitPolicy,
+		WaitTimeout:        target.WaitTimeout,
 	}
 }
 
@@ -333,6 +336,19 @@ func DeepCopyColDef(col *plan.ColDef) *plan.ColDef {
 		Seqnum:     col.Seqnum,
 		TblName:    col.TblName,
 		DbName:     col.DbName,
+		Generated:  DeepCopyGeneratedColDef(col.Generated),
+	}
+}
+
+func DeepCopyGeneratedColDef(def *plan.GeneratedColDef) *plan.GeneratedColDef {
+	if def == nil {
+		return nil
+	}
+	return &plan.GeneratedColDef{
+		Name:    def.Name,
+		Expr:    DeepCopyExpr(def.Expr),
+		ExprStr: def.ExprStr,
+		Stored:  def.Stored,
 	}
 }
 
@@ -476,8 +492,10 @@ func DeepCopyTableDef(table *plan.TableDef, withCols bool) *plan.TableDef {
 
 	for idx, col := range table.Checks {
 		newTable.Checks[idx] = &plan.CheckDef{
-			Name:  col.Name,
-			Check: DeepCopyExpr(col.Check),
+			Name:        col.Name,
+			Check:       DeepCopyExpr(col.Check),
+			NotEnforced: col.NotEnforced,
+			ExprStr:     col.ExprStr,
 		}
 	}
 
@@ -929,6 +947,7 @@ func DeepCopyExpr(expr *Expr) *Expr {
 				PartitionBy: DeepCopyExprList(item.W.PartitionBy),
 				OrderBy:     os,
 				Name:        item.W.Name,
+				IgnoreNulls: item.W.IgnoreNulls,
 				Frame: &plan.FrameClause{
 					Type: f.Type,
 					Start: &plan.FrameBound{
@@ -123,6 +123,9 @@ func (dmlCtx *DMLContext) ResolveUpdateTables(ctx CompilerContext, stmt *tree.Up
 	dmlCtx.updateCol2Expr = make([]map[string]tree.Expr, len(dmlCtx.tableDefs))
 	for alias, columnMap := range usedTbl {
 		idx := dmlCtx.aliasMap[alias]
+		if err = rewriteGeneratedUpdateExprs(ctx.GetContext(), dmlCtx.tableDefs[idx], alias, columnMap); err != nil {
+			return err
+		}
 		dmlCtx.updateCol2Expr[idx] = columnMap
 	}
 
@@ -0,0 +1,204 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/models"
+)
+
+// ExplainJsonResult is the document returned by `EXPLAIN FORMAT=JSON`.
+// Every step is a graph built by the same model that is used to
+// serialize plans for the statement info, so tools can consume both.
+type ExplainJsonResult struct {
+	Steps []models.Step `json:"steps"`
+}
+
+// explainPlanJson renders the query plan as one JSON document and pushes it
+// into the buffer as a single line.
+func explainPlanJson(ctx context.Context, query *plan.Query, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	steps, err := buildPlanSteps(ctx, query, options)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(&ExplainJsonResult{Steps: steps}, "", "  ")
+	if err != nil {
+		return moerr.NewInternalErrorf(ctx, "failed to serialize plan to json: %v", err)
+	}
+	buffer.PushNewLine(string(data), true, 0)
+	return nil
+}
+
+// buildPlanSteps converts every step of the query into the graph model.
+func buildPlanSteps(ctx context.Context, query *plan.Query, options *ExplainOptions) ([]models.Step, error) {
+	nodes := query.Nodes
+	steps := make([]models.Step, 0, len(query.Steps))
+	for index, rootNodeId := range query.Steps {
+		graphData := models.NewGraphData(len(nodes))
+		if err := PreOrderPlan(ctx, nodes[rootNodeId], nodes, graphData, options); err != nil {
+			return nil, err
+		}
+		if err := graphData.StatisticsGlobalResource(ctx); err != nil {
+			return nil, err
+		}
+		step := models.NewStep(index)
+		step.GraphData = *graphData
+		steps = append(steps, *step)
+	}
+	return steps, nil
+}
+
+// explainPlanDot renders the query plan as a Graphviz digraph. Each node is a
+// box holding the same lines as the text format, edges point from the child
+// to its consumer and carry the estimated (and, with ANALYZE, actual) rows.
+func explainPlanDot(ctx context.Context, query *plan.Query, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	nodes := query.Nodes
+	buffer.PushNewLine("digraph plan {", true, 0)
+	buffer.PushNewLine(`  node [shape=box, fontname="Courier"];`, true, 0)
+	for index, rootNodeID := range query.Steps {
+		indent := "  "
+		if len(query.Steps) > 1 {
+			buffer.PushNewLine(fmt.Sprintf("  subgraph cluster_%d {", index), true, 0)
+			buffer.PushNewLine(fmt.Sprintf("    label=%s;", dotQuote(fmt.Sprintf("Plan %d", index))), true, 0)
+			indent = "    "
+		}
+		if err := dotPlan(ctx, nodes[rootNodeID], nodes, buffer, options, indent); err != nil {
+			return err
+		}
+		if len(query.Steps) > 1 {
+			buffer.PushNewLine("  }", true, 0)
+		}
+	}
+	buffer.PushNewLine("}", true, 0)
+	return nil
+}
+
+func dotPlan(ctx context.Context, node *plan.Node, nodes []*plan.Node, buffer *ExplainDataBuffer, options *ExplainOptions, indent string) error {
+	lines, err := dotNodeLines(ctx, node, options)
+	if err != nil {
+		return err
+	}
+	buffer.PushNewLine(fmt.Sprintf("%s%s [label=%s];", indent, dotNodeName(node), dotLabel(lines)), true, 0)
+
+	for _, childNodeID := range node.Children {
+		index, err := serachNodeIndex(ctx, childNodeID, nodes)
+		if err != nil {
+			return err
+		}
+		child := nodes[index]
+		edge := ""
+		if child.Stats != nil {
+			edge = "rows=" + strconv.FormatFloat(child.Stats.Outcnt, 'f', 0, 64)
+		}
+		if options.Analyze && child.AnalyzeInfo != nil {
+			edge += " actual=" + strconv.FormatInt(child.AnalyzeInfo.OutputRows, 10)
+		}
+		buffer.PushNewLine(fmt.Sprintf("%s%s -> %s [label=%s];", indent, dotNodeName(child), dotNodeName(node), dotQuote(strings.TrimSpace(edge))), true, 0)
+
+		if err = dotPlan(ctx, child, nodes, buffer, options, indent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dotNodeLines(ctx context.Context, node *plan.Node, options *ExplainOptions) ([]string, error) {
+	nodedescImpl := NewNodeDescriptionImpl(node)
+	basicInfo, err := nodedescImpl.GetNodeBasicInfo(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	lines := []string{basicInfo}
+	// the basic info only carries the costs in verbose mode, but the graph is
+	// mostly used to compare estimates, so always show them.
+	if !options.Verbose {
+		buf := bytes.NewBuffer(make([]byte, 0, 128))
+		costDescImpl := &CostDescribeImpl{
+			Stats: node.GetStats(),
+		}
+		if err = costDescImpl.GetDescription(ctx, options, buf); err != nil {
+			return nil, err
+		}
+		lines = append(lines, strings.TrimSpace(buf.String()))
+	}
+	if options.Verbose && node.GetProjectList() != nil {
+		projectInfo, err := nodedescImpl.GetProjectListInfo(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, projectInfo)
+	}
+	if options.Analyze && node.AnalyzeInfo != nil {
+		analyze, err := nodedescImpl.GetActualAnalyzeInfo(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, analyze)
+	}
+	extraInfo, err := nodedescImpl.GetExtraInfo(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return append(lines, extraInfo...), nil
+}
+
+func dotNodeName(node *plan.Node) string {
+	return "n" + strconv.FormatInt(int64(node.NodeId), 10)
+}
+
+// dotLabel joins lines into a left-justified graphviz label.
+func dotLabel(lines []string) string {
+	buf := bytes.NewBuffer(make([]byte, 0, 256))
+	buf.WriteByte('"')
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+		dotEscape(buf, line)
+		buf.WriteString(`\l`)
+	}
+	buf.WriteByte('"')
+	return buf.String()
+}
+
+func dotQuote(s string) string {
+	buf := bytes.NewBuffer(make([]byte, 0, len(s)+2))
+	buf.WriteByte('"')
+	dotEscape(buf, s)
+	buf.WriteByte('"')
+	return buf.String()
+}
+
+func dotEscape(buf *bytes.Buffer, s string) {
+	for _, r := range s {
+		switch r {
+		case '"', '\\':
+			buf.WriteByte('\\')
+			buf.WriteRune(r)
+		case '\n':
+			buf.WriteString(`\l`)
+		default:
+			buf.WriteRune(r)
+		}
+	}
+}
@@ -21,7 +21,6 @@ import (
 	"sort"
 	"strconv"
 
-	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
@@ -151,91 +150,83 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(ctx context.Context, options *Ex
 	}
 
 	// Get Node's operator object info ,such as table, view
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		if options.Verbose {
-			buf.WriteString(fmt.Sprintf("%s[%d]", pname, ndesc.Node.NodeId))
-		} else {
-			buf.WriteString(pname)
-		}
-
-		switch ndesc.Node.NodeType {
-		case plan.Node_VALUE_SCAN:
-			buf.WriteString(" \"*VALUES*\" ")
-		case plan.Node_TABLE_SCAN, plan.Node_EXTERNAL_SCAN, plan.Node_MATERIAL_SCAN, plan.Node_INSERT, plan.Node_SOURCE_SCAN:
-			buf.WriteString(" on ")
-			if ndesc.Node.ObjRef != nil {
-				if ndesc.Node.ParentObjRef == nil || options.CmpContext == nil { // original table
-					buf.WriteString(ndesc.Node.ObjRef.GetSchemaName() + "." + ndesc.Node.ObjRef.GetObjName())
-				} else { // index table, need to get index table name
-					scanSnapshot := ndesc.Node.ScanSnapshot
-					if scanSnapshot == nil {
-						scanSnapshot = &plan.Snapshot{}
-					}
-					_, origTableDef := options.CmpContext.Resolve(ndesc.Node.ParentObjRef.GetSchemaName(), ndesc.Node.ParentObjRef.GetObjName(), scanSnapshot)
-					for i := range origTableDef.Indexes {
-						if origTableDef.Indexes[i].IndexTableName == ndesc.Node.ObjRef.GetObjName() {
-							buf.WriteString(ndesc.Node.ObjRef.GetSchemaName() + "." + origTableDef.Indexes[i].IndexName + "(index)")
-							break
-						}
-					}
+	if options.Verbose {
+		buf.WriteString(fmt.Sprintf("%s[%d]", pname, ndesc.Node.NodeId))
+	} else {
+		buf.WriteString(pname)
+	}
+
+	switch ndesc.Node.NodeType {
+	case plan.Node_VALUE_SCAN:
+		buf.WriteString(" \"*VALUES*\" ")
+	case plan.Node_TABLE_SCAN, plan.Node_EXTERNAL_SCAN, plan.Node_MATERIAL_SCAN, plan.Node_INSERT, plan.Node_SOURCE_SCAN:
+		buf.WriteString(" on ")
+		if ndesc.Node.ObjRef != nil {
+			if ndesc.Node.ParentObjRef == nil || options.CmpContext == nil { // original table
+				buf.WriteString(ndesc.Node.ObjRef.GetSchemaName() + "." + ndesc.Node.ObjRef.GetObjName())
+			} else { // index table, need to get index table name
+				scanSnapshot := ndesc.Node.ScanSnapshot
+				if scanSnapshot == nil {
+					scanSnapshot = &plan.Snapshot{}
 				}
-			} else if ndesc.Node.TableDef != nil {
-				buf.WriteString(ndesc.Node.TableDef.GetName())
-			}
-			if ndesc.Node.Stats.ForceOneCN {
-				buf.WriteString(" [ForceOneCN]")
-			}
-		case plan.Node_FUNCTION_SCAN:
-			buf.WriteString(" on ")
-			if ndesc.Node.TableDef != nil && ndesc.Node.TableDef.TblFunc != nil {
-				buf.WriteString(ndesc.Node.TableDef.TblFunc.Name)
-			}
-		case plan.Node_DELETE:
-			buf.WriteString(" on ")
-			if ndesc.Node.DeleteCtx != nil {
-				ctx := ndesc.Node.DeleteCtx.Ref
-				buf.WriteString(ctx.SchemaName + "." + ctx.ObjName)
-			}
-		case plan.Node_PRE_INSERT:
-			buf.WriteString(" on ")
-			if ndesc.Node.PreInsertCtx != nil {
-				if ndesc.Node.PreInsertCtx.Ref != nil {
-					buf.WriteString(ndesc.Node.PreInsertCtx.Ref.GetSchemaName() + "." + ndesc.Node.PreInsertCtx.Ref.GetObjName())
-				} else if ndesc.Node.PreInsertCtx.TableDef != nil {
-					buf.WriteString(ndesc.Node.TableDef.GetName())
+				_, origTableDef := options.CmpContext.Resolve(ndesc.Node.ParentObjRef.GetSchemaName(), ndesc.Node.ParentObjRef.GetObjName(), scanSnapshot)
+				for i := range origTableDef.Indexes {
+					if origTableDef.Indexes[i].IndexTableName == ndesc.Node.ObjRef.GetObjName() {
+						buf.WriteString(ndesc.Node.ObjRef.GetSchemaName() + "." + origTableDef.Indexes[i].IndexName + "(index)")
+						break
+					}
 				}
 			}
-		case plan.Node_PRE_DELETE:
-			buf.WriteString(" on ")
-			if ndesc.Node.ObjRef != nil {
-				buf.WriteString(ndesc.Node.ObjRef.GetSchemaName() + "." + ndesc.Node.ObjRef.GetObjName())
-			} else if ndesc.Node.TableDef != nil {
+		} else if ndesc.Node.TableDef != nil {
+			buf.WriteString(ndesc.Node.TableDef.GetName())
+		}
+		if ndesc.Node.Stats.ForceOneCN {
+			buf.WriteString(" [ForceOneCN]")
+		}
+	case plan.Node_FUNCTION_SCAN:
+		buf.WriteString(" on ")
+		if ndesc.Node.TableDef != nil && ndesc.Node.TableDef.TblFunc != nil {
+			buf.WriteString(ndesc.Node.TableDef.TblFunc.Name)
+		}
+	case plan.Node_DELETE:
+		buf.WriteString(" on ")
+		if ndesc.Node.DeleteCtx != nil {
+			ctx := ndesc.Node.DeleteCtx.Ref
+			buf.WriteString(ctx.SchemaName + "." + ctx.ObjName)
+		}
+	case plan.Node_PRE_INSERT:
+		buf.WriteString(" on ")
+		if ndesc.Node.PreInsertCtx != nil {
+			if ndesc.Node.PreInsertCtx.Ref != nil {
+				buf.WriteString(ndesc.Node.PreInsertCtx.Ref.GetSchemaName() + "." + ndesc.Node.PreInsertCtx.Ref.GetObjName())
+			} else if ndesc.Node.PreInsertCtx.TableDef != nil {
 				buf.WriteString(ndesc.Node.TableDef.GetName())
 			}
-		case plan.Node_POSTDML:
-			buf.WriteString(" on ")
-			if ndesc.Node.PostDmlCtx != nil && ndesc.Node.PostDmlCtx.Ref != nil {
-				buf.WriteString(ndesc.Node.PostDmlCtx.Ref.GetSchemaName() + "." + ndesc.Node.PostDmlCtx.Ref.GetObjName())
-			}
+		}
+	case plan.Node_PRE_DELETE:
+		buf.WriteString(" on ")
+		if ndesc.Node.ObjRef != nil {
+			buf.WriteString(ndesc.Node.ObjRef.GetSchemaName() + "." + ndesc.Node.ObjRef.GetObjName())
+		} else if ndesc.Node.TableDef != nil {
+			buf.WriteString(ndesc.Node.TableDef.GetName())
+		}
+	case plan.Node_POSTDML:
+		buf.WriteString(" on ")
+		if ndesc.Node.PostDmlCtx != nil && ndesc.Node.PostDmlCtx.Ref != nil {
+			buf.WriteString(ndesc.Node.PostDmlCtx.Ref.GetSchemaName() + "." + ndesc.Node.PostDmlCtx.Ref.GetObjName())
 		}
 	}
 
 	// Get Costs info of Node
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		//result += " (cost=%.2f..%.2f rows=%.0f width=%f)"
-		if options.Verbose {
-			costDescImpl := &CostDescribeImpl{
-				Stats: ndesc.Node.GetStats(),
-			}
-			err := costDescImpl.GetDescription(ctx, options, buf)
-			if err != nil {
-				return "", err
-			}
+	//result += " (cost=%.2f..%.2f rows=%.0f width=%f)"
+	if options.Verbose {
+		costDescImpl := &CostDescribeImpl{
+			Stats: ndesc.Node.GetStats(),
+		}
+		err := costDescImpl.GetDescription(ctx, options, buf)
+		if err != nil {
+			return "", err
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -532,23 +523,17 @@ func (ndesc *NodeDescribeImpl) GetJoinConditionInfo(ctx context.Context, options
 func (ndesc *NodeDescribeImpl) GetPartitionPruneInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	buf := bytes.NewBuffer(make([]byte, 0, 300))
 	buf.WriteString("Hit Partition: ")
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		if ndesc.Node.PartitionPrune != nil {
-			first := true
-			for _, v := range ndesc.Node.PartitionPrune.SelectedPartitions {
-				if !first {
-					buf.WriteString(", ")
-				}
-				first = false
-				buf.WriteString(v.PartitionName)
+	if ndesc.Node.PartitionPrune != nil {
+		first := true
+		for _, v := range ndesc.Node.PartitionPrune.SelectedPartitions {
+			if !first {
+				buf.WriteString(", ")
 			}
-		} else {
-			buf.WriteString("all partitions")
+			first = false
+			buf.WriteString(v.PartitionName)
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
+	} else {
+		buf.WriteString("all partitions")
 	}
 	return buf.String(), nil
 }
@@ -560,60 +545,48 @@ func (ndesc *NodeDescribeImpl) GetInsertDeleteColsInfo(ctx context.Context, opti
 	} else {
 		buf.WriteString("Delete Columns: ")
 	}
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		first := true
-		for _, v := range ndesc.Node.InsertDeleteCols {
-			if !first {
-				buf.WriteString(", ")
-			}
-			first = false
-			err := describeExpr(ctx, v, options, buf)
-			if err != nil {
-				return "", err
-			}
+	first := true
+	for _, v := range ndesc.Node.InsertDeleteCols {
+		if !first {
+			buf.WriteString(", ")
+		}
+		first = false
+		err := describeExpr(ctx, v, options, buf)
+		if err != nil {
+			return "", err
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
 
 func (ndesc *NodeDescribeImpl) GetUpdateCtxInfo(ctx context.Context, options *ExplainOptions) ([]string, error) {
 	var lines []string
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		for _, updateCtx := range ndesc.Node.UpdateCtxList {
-			buf := bytes.NewBuffer(make([]byte, 0, 512))
-			buf.WriteString("Table: " + updateCtx.TableDef.Name)
-			if len(updateCtx.InsertCols) > 0 {
-				buf.WriteString(" Insert Columns: ")
-				first := true
-				for i := range updateCtx.InsertCols {
-					if !first {
-						buf.WriteString(", ")
-					}
-					first = false
-					describeColRef(&updateCtx.InsertCols[i], buf)
+	for _, updateCtx := range ndesc.Node.UpdateCtxList {
+		buf := bytes.NewBuffer(make([]byte, 0, 512))
+		buf.WriteString("Table: " + updateCtx.TableDef.Name)
+		if len(updateCtx.InsertCols) > 0 {
+			buf.WriteString(" Insert Columns: ")
+			first := true
+			for i := range updateCtx.InsertCols {
+				if !first {
+					buf.WriteString(", ")
 				}
+				first = false
+				describeColRef(&updateCtx.InsertCols[i], buf)
 			}
-			if len(updateCtx.DeleteCols) > 0 {
-				buf.WriteString(" Delete Columns: ")
-				first := true
-				for i := range updateCtx.DeleteCols {
-					if !first {
-						buf.WriteString(", ")
-					}
-					first = false
-					describeColRef(&updateCtx.DeleteCols[i], buf)
+		}
+		if len(updateCtx.DeleteCols) > 0 {
+			buf.WriteString(" Delete Columns: ")
+			first := true
+			for i := range updateCtx.DeleteCols {
+				if !first {
+					buf.WriteString(", ")
 				}
+				first = false
+				describeColRef(&updateCtx.DeleteCols[i], buf)
 			}
-			lines = append(lines, buf.String())
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return nil, moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return nil, moerr.NewNYI(ctx, "explain format dot")
+		lines = append(lines, buf.String())
 	}
 	return lines, nil
 }
@@ -621,22 +594,16 @@ func (ndesc *NodeDescribeImpl) GetUpdateCtxInfo(ctx context.Context, options *Ex
 func (ndesc *NodeDescribeImpl) GetFilterConditionInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	buf := bytes.NewBuffer(make([]byte, 0, 512))
 	buf.WriteString("Filter Cond: ")
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		first := true
-		for _, v := range ndesc.Node.FilterList {
-			if !first {
-				buf.WriteString(", ")
-			}
-			first = false
-			err := describeExpr(ctx, v, options, buf)
-			if err != nil {
-				return "", err
-			}
+	first := true
+	for _, v := range ndesc.Node.FilterList {
+		if !first {
+			buf.WriteString(", ")
+		}
+		first = false
+		err := describeExpr(ctx, v, options, buf)
+		if err != nil {
+			return "", err
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -644,22 +611,16 @@ func (ndesc *NodeDescribeImpl) GetFilterConditionInfo(ctx context.Context, optio
 func (ndesc *NodeDescribeImpl) GetBlockFilterConditionInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	buf := bytes.NewBuffer(make([]byte, 0, 300))
 	buf.WriteString("Block Filter Cond: ")
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		first := true
-		for _, v := range ndesc.Node.BlockFilterList {
-			if !first {
-				buf.WriteString(", ")
-			}
-			first = false
-			err := describeExpr(ctx, v, options, buf)
-			if err != nil {
-				return "", err
-			}
+	first := true
+	for _, v := range ndesc.Node.BlockFilterList {
+		if !first {
+			buf.WriteString(", ")
+		}
+		first = false
+		err := describeExpr(ctx, v, options, buf)
+		if err != nil {
+			return "", err
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -670,25 +631,19 @@ func (ndesc *NodeDescribeImpl) GetRuntimeFilteProbeInfo(ctx context.Context, opt
 	}
 	buf := bytes.NewBuffer(make([]byte, 0, 300))
 	buf.WriteString("Runtime Filter Probe: ")
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		first := true
-		for _, v := range ndesc.Node.RuntimeFilterProbeList {
-			if !first {
-				buf.WriteString(", ")
-			}
-			first = false
-			err := describeExpr(ctx, v.Expr, options, buf)
-			if err != nil {
-				return "", err
-			}
-			if v.MatchPrefix {
-				buf.WriteString(" Match Prefix")
-			}
+	first := true
+	for _, v := range ndesc.Node.RuntimeFilterProbeList {
+		if !first {
+			buf.WriteString(", ")
+		}
+		first = false
+		err := describeExpr(ctx, v.Expr, options, buf)
+		if err != nil {
+			return "", err
+		}
+		if v.MatchPrefix {
+			buf.WriteString(" Match Prefix")
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -699,70 +654,52 @@ func (ndesc *NodeDescribeImpl) GetRuntimeFilterBuildInfo(ctx context.Context, op
 	}
 	buf := bytes.NewBuffer(make([]byte, 0, 300))
 	buf.WriteString("Runtime Filter Build: ")
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		first := true
-		for _, v := range ndesc.Node.RuntimeFilterBuildList {
-			if !first {
-				buf.WriteString(", ")
-			}
-			first = false
-			err := describeExpr(ctx, v.Expr, options, buf)
-			if err != nil {
-				return "", err
-			}
+	first := true
+	for _, v := range ndesc.Node.RuntimeFilterBuildList {
+		if !first {
+			buf.WriteString(", ")
+		}
+		first = false
+		err := describeExpr(ctx, v.Expr, options, buf)
+		if err != nil {
+			return "", err
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
 
 func (ndesc *NodeDescribeImpl) GetSendMessageInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	buf := bytes.NewBuffer(make([]byte, 0, 300))
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		first := true
-		for _, v := range ndesc.Node.SendMsgList {
-			if v.GetMsgType() == int32(message.MsgJoinMap) {
-				continue
-			}
-			if first {
-				buf.WriteString("Send Message: ")
-			} else {
-				buf.WriteString(", ")
-			}
-			first = false
-			describeMessage(v, buf)
+	first := true
+	for _, v := range ndesc.Node.SendMsgList {
+		if v.GetMsgType() == int32(message.MsgJoinMap) {
+			continue
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
+		if first {
+			buf.WriteString("Send Message: ")
+		} else {
+			buf.WriteString(", ")
+		}
+		first = false
+		describeMessage(v, buf)
 	}
 	return buf.String(), nil
 }
 
 func (ndesc *NodeDescribeImpl) GetRecvMessageInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	buf := bytes.NewBuffer(make([]byte, 0, 300))
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		first := true
-		for _, v := range ndesc.Node.RecvMsgList {
-			if v.GetMsgType() == int32(message.MsgJoinMap) {
-				continue
-			}
-			if first {
-				buf.WriteString("Recv Message: ")
-			} else {
-				buf.WriteString(", ")
-			}
-			first = false
-			describeMessage(v, buf)
+	first := true
+	for _, v := range ndesc.Node.RecvMsgList {
+		if v.GetMsgType() == int32(message.MsgJoinMap) {
+			continue
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
+		if first {
+			buf.WriteString("Recv Message: ")
+		} else {
+			buf.WriteString(", ")
+		}
+		first = false
+		describeMessage(v, buf)
 	}
 	return buf.String(), nil
 }
@@ -770,22 +707,16 @@ func (ndesc *NodeDescribeImpl) GetRecvMessageInfo(ctx context.Context, options *
 func (ndesc *NodeDescribeImpl) GetGroupByInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	buf := bytes.NewBuffer(make([]byte, 0, 300))
 	buf.WriteString("Group Key: ")
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		first := true
-		for _, v := range ndesc.Node.GetGroupBy() {
-			if !first {
-				buf.WriteString(", ")
-			}
-			first = false
-			err := describeExpr(ctx, v, options, buf)
-			if err != nil {
-				return "", err
-			}
+	first := true
+	for _, v := range ndesc.Node.GetGroupBy() {
+		if !first {
+			buf.WriteString(", ")
+		}
+		first = false
+		err := describeExpr(ctx, v, options, buf)
+		if err != nil {
+			return "", err
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 
 	if ndesc.Node.Stats.HashmapStats != nil && ndesc.Node.Stats.HashmapStats.Shuffle {
@@ -819,22 +750,16 @@ func (ndesc *NodeDescribeImpl) GetGroupByInfo(ctx context.Context, options *Expl
 func (ndesc *NodeDescribeImpl) GetAggregationInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	buf := bytes.NewBuffer(make([]byte, 0, 300))
 	buf.WriteString("Aggregate Functions: ")
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		first := true
-		for _, v := range ndesc.Node.GetAggList() {
-			if !first {
-				buf.WriteString(", ")
-			}
-			first = false
-			err := describeExpr(ctx, v, options, buf)
-			if err != nil {
-				return "", err
-			}
+	first := true
+	for _, v := range ndesc.Node.GetAggList() {
+		if !first {
+			buf.WriteString(", ")
+		}
+		first = false
+		err := describeExpr(ctx, v, options, buf)
+		if err != nil {
+			return "", err
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -847,22 +772,16 @@ func (ndesc *NodeDescribeImpl) GetSampleFuncInfo(ctx context.Context, options *E
 		buf.WriteString(fmt.Sprintf("Sample %d Rows by: ", ndesc.Node.SampleFunc.Rows))
 	}
 
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		first := true
-		for _, v := range ndesc.Node.GetAggList() {
-			if !first {
-				buf.WriteString(", ")
-			}
-			first = false
-			err := describeExpr(ctx, v, options, buf)
-			if err != nil {
-				return "", err
-			}
+	first := true
+	for _, v := range ndesc.Node.GetAggList() {
+		if !first {
+			buf.WriteString(", ")
+		}
+		first = false
+		err := describeExpr(ctx, v, options, buf)
+		if err != nil {
+			return "", err
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -870,22 +789,16 @@ func (ndesc *NodeDescribeImpl) GetSampleFuncInfo(ctx context.Context, options *E
 func (ndesc *NodeDescribeImpl) GetFillColsInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	buf := bytes.NewBuffer(make([]byte, 0, 300))
 	buf.WriteString("Fill Columns: ")
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		first := true
-		for _, v := range ndesc.Node.GetAggList() {
-			if !first {
-				buf.WriteString(", ")
-			}
-			first = false
-			err := describeExpr(ctx, v, options, buf)
-			if err != nil {
-				return "", err
-			}
+	first := true
+	for _, v := range ndesc.Node.GetAggList() {
+		if !first {
+			buf.WriteString(", ")
+		}
+		first = false
+		err := describeExpr(ctx, v, options, buf)
+		if err != nil {
+			return "", err
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -893,51 +806,26 @@ func (ndesc *NodeDescribeImpl) GetFillColsInfo(ctx context.Context, options *Exp
 func (ndesc *NodeDescribeImpl) GetFillModeInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	buf := bytes.NewBuffer(make([]byte, 0, 300))
 	buf.WriteString("Fill Mode: ")
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		switch ndesc.Node.FillType {
-		case plan.Node_NONE:
-			buf.WriteString("None")
-		case plan.Node_LINEAR:
-			buf.WriteString("Linear")
-		case plan.Node_NULL:
-			buf.WriteString("Null")
-		case plan.Node_VALUE:
-			buf.WriteString("Value")
-		case plan.Node_PREV:
-			buf.WriteString("Prev")
-		case plan.Node_NEXT:
-			buf.WriteString("Next")
-		}
-		if len(ndesc.Node.FillVal) > 0 {
-			buf.WriteString(" Fill Value: ")
-			first := true
-			for _, v := range ndesc.Node.GetFillVal() {
-				if !first {
-					buf.WriteString(", ")
-				}
-				first = false
-				err := describeExpr(ctx, v, options, buf)
-				if err != nil {
-					return "", err
-				}
-			}
-		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
+	switch ndesc.Node.FillType {
+	case plan.Node_NONE:
+		buf.WriteString("None")
+	case plan.Node_LINEAR:
+		buf.WriteString("Linear")
+	case plan.Node_NULL:
+		buf.WriteString("Null")
+	case plan.Node_VALUE:
+		buf.WriteString("Value")
+	case plan.Node_PREV:
+		buf.WriteString("Prev")
+	case plan.Node_NEXT:
+		buf.WriteString("Next")
 	}
-	return buf.String(), nil
-}
-
-func (ndesc *NodeDescribeImpl) GetWindowSpectListInfo(ctx context.Context, options *ExplainOptions) (string, error) {
-	buf := bytes.NewBuffer(make([]byte, 0, 300))
-	buf.WriteString("Window Function: ")
-	if options.Format == EXPLAIN_FORMAT_TEXT {
+	if len(ndesc.Node.FillVal) > 0 {
+		buf.WriteString(" Fill Value: ")
 		first := true
-		for _, v := range ndesc.Node.GetWinSpecList() {
+		for _, v := range ndesc.Node.GetFillVal() {
 			if !first {
-				buf.WriteString("\n")
+				buf.WriteString(", ")
 			}
 			first = false
 			err := describeExpr(ctx, v, options, buf)
@@ -945,27 +833,34 @@ func (ndesc *NodeDescribeImpl) GetWindowSpectListInfo(ctx context.Context, optio
 				return "", err
 			}
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
 
-func (ndesc *NodeDescribeImpl) GetOrderByInfo(ctx context.Context, options *ExplainOptions) (string, error) {
+func (ndesc *NodeDescribeImpl) GetWindowSpectListInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	buf := bytes.NewBuffer(make([]byte, 0, 300))
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		buf.WriteString("Sort Key: ")
-		orderByDescImpl := NewOrderByDescribeImpl(ndesc.Node.OrderBy)
-		err := orderByDescImpl.GetDescription(ctx, options, buf)
+	buf.WriteString("Window Function: ")
+	first := true
+	for _, v := range ndesc.Node.GetWinSpecList() {
+		if !first {
+			buf.WriteString("\n")
+		}
+		first = false
+		err := describeExpr(ctx, v, options, buf)
 		if err != nil {
 			return "", err
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
+	}
+	return buf.String(), nil
+}
+
+func (ndesc *NodeDescribeImpl) GetOrderByInfo(ctx context.Context, options *ExplainOptions) (string, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, 300))
+	buf.WriteString("Sort Key: ")
+	orderByDescImpl := NewOrderByDescribeImpl(ndesc.Node.OrderBy)
+	err := orderByDescImpl.GetDescription(ctx, options, buf)
+	if err != nil {
+		return "", err
 	}
 	return buf.String(), nil
 }
@@ -1143,22 +1038,16 @@ func NewExprListDescribeImpl(ExprList []*plan.Expr) *ExprListDescribeImpl {
 
 func (e *ExprListDescribeImpl) GetDescription(ctx context.Context, options *ExplainOptions, buf *bytes.Buffer) error {
 	first := true
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		for _, v := range e.ExprList {
-			if !first {
-				buf.WriteString(", ")
-			}
-			first = false
-			err := describeExpr(ctx, v, options, buf)
-			if err != nil {
-				return err
-				//return result, err
-			}
+	for _, v := range e.ExprList {
+		if !first {
+			buf.WriteString(", ")
+		}
+		first = false
+		err := describeExpr(ctx, v, options, buf)
+		if err != nil {
+			return err
+			//return result, err
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return moerr.NewNYI(ctx, "explain format dot")
 	}
 	return nil
 }
@@ -1176,24 +1065,20 @@ func NewOrderByDescribeImpl(OrderBy []*plan.OrderBySpec) *OrderByDescribeImpl {
 func (o *OrderByDescribeImpl) GetDescription(ctx context.Context, options *ExplainOptions, buf *bytes.Buffer) error {
 	//var result string
 	//buf := bytes.NewBuffer(make([]byte, 0, 120))
-	if options.Format == EXPLAIN_FORMAT_TEXT || options.Format == EXPLAIN_FORMAT_JSON {
-		first := true
-		for _, v := range o.OrderBy {
-			if !first {
-				buf.WriteString(", ")
-			}
-			first = false
-			err := describeExpr(ctx, v.Expr, options, buf)
-			if err != nil {
-				return err
-			}
-
-			flagKey := int32(v.Flag)
-			orderbyFlag := plan.OrderBySpec_OrderByFlag_name[flagKey]
-			buf.WriteString(" " + orderbyFlag)
+	first := true
+	for _, v := range o.OrderBy {
+		if !first {
+			buf.WriteString(", ")
 		}
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return moerr.NewNYI(ctx, "explain format dot")
+		first = false
+		err := describeExpr(ctx, v.Expr, options, buf)
+		if err != nil {
+			return err
+		}
+
+		flagKey := int32(v.Flag)
+		orderbyFlag := plan.OrderBySpec_OrderByFlag_name[flagKey]
+		buf.WriteString(" " + orderbyFlag)
 	}
 	return nil
 }
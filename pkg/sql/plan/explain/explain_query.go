@@ -37,6 +37,8 @@ const (
 	Label_Agg_Functions             = "Aggregate functions"
 	Label_Filter_Conditions         = "Filter conditions"
 	Label_Block_Filter_Conditions   = "Block Filter conditions"
+	Label_Runtime_Filter_Build      = "Runtime filter build"
+	Label_Runtime_Filter_Probe      = "Runtime filter probe"
 	Label_Join_Type                 = "Join type"
 	Label_Join_Conditions           = "Join conditions"
 	Label_Left_NodeId               = "Left node id"
@@ -102,6 +104,13 @@ func NewExplainQueryImpl(query *plan.Query) *ExplainQueryImpl {
 }
 
 func (e *ExplainQueryImpl) ExplainPlan(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	switch options.Format {
+	case EXPLAIN_FORMAT_JSON:
+		return explainPlanJson(ctx, e.QueryPlan, buffer, options)
+	case EXPLAIN_FORMAT_DOT:
+		return explainPlanDot(ctx, e.QueryPlan, buffer, options)
+	}
+
 	nodes := e.QueryPlan.Nodes
 
 	isForest := false
@@ -132,38 +141,19 @@ func (e *ExplainQueryImpl) ExplainPlan(ctx context.Context, buffer *ExplainDataB
 }
 
 func BuildJsonPlan(ctx context.Context, uuid uuid.UUID, options *ExplainOptions, query *plan.Query) *models.ExplainData {
-	nodes := query.Nodes
 	expdata := models.NewExplainData(uuid)
-	for index, rootNodeId := range query.Steps {
-		graphData := models.NewGraphData(len(nodes))
-		err := PreOrderPlan(ctx, nodes[rootNodeId], nodes, graphData, options)
-		if err != nil {
-			var errdata *models.ExplainData
-			if moErr, ok := err.(*moerr.Error); ok {
-				errdata = models.NewExplainDataFail(uuid, moErr.MySQLCode(), moErr.Error())
-			} else {
-				newError := moerr.NewInternalError(ctx, "An error occurred when plan is serialized to json")
-				errdata = models.NewExplainDataFail(uuid, newError.MySQLCode(), newError.Error())
-			}
-			return errdata
+	steps, err := buildPlanSteps(ctx, query, options)
+	if err != nil {
+		var errdata *models.ExplainData
+		if moErr, ok := err.(*moerr.Error); ok {
+			errdata = models.NewExplainDataFail(uuid, moErr.MySQLCode(), moErr.Error())
+		} else {
+			newError := moerr.NewInternalError(ctx, "An error occurred when plan is serialized to json")
+			errdata = models.NewExplainDataFail(uuid, newError.MySQLCode(), newError.Error())
 		}
-		err = graphData.StatisticsGlobalResource(ctx)
-		if err != nil {
-			var errdata *models.ExplainData
-			if moErr, ok := err.(*moerr.Error); ok {
-				errdata = models.NewExplainDataFail(uuid, moErr.MySQLCode(), moErr.Error())
-			} else {
-				newError := moerr.NewInternalError(ctx, "An error occurred when plan is serialized to json")
-				errdata = models.NewExplainDataFail(uuid, newError.MySQLCode(), newError.Error())
-			}
-			return errdata
-		}
-
-		step := models.NewStep(index)
-		step.GraphData = *graphData
-
-		expdata.Steps = append(expdata.Steps, *step)
+		return errdata
 	}
+	expdata.Steps = steps
 	if stats := statistic.StatsInfoFromContext(ctx); stats != nil {
 		expdata.NewPlanStats = *stats
 	}
@@ -337,10 +327,6 @@ func explainStep(ctx context.Context, step *plan.Node, nodes []*plan.Node, setti
 		for _, line := range extraInfo {
 			settings.buffer.PushNewLine(line, false, settings.level)
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return moerr.NewNYI(ctx, "explain format dot")
 	}
 	return nil
 }
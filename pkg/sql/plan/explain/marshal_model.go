@@ -94,4 +94,6 @@ var nodeTypeToNameMap = map[plan2.Node_NodeType]string{
 	plan2.Node_FILL:         "Fill",
 	plan2.Node_PARTITION:    "Partition",
 	plan2.Node_FUZZY_FILTER: "Fuzzy filter",
+	plan2.Node_APPLY:        "Apply",
+	plan2.Node_POSTDML:      "Post DML",
 }
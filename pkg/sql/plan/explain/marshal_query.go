@@ -673,7 +673,29 @@ func (m MarshalNodeImpl) GetNodeLabels(ctx context.Context, options *ExplainOpti
 		})
 	}
 
-	// 3. handle `Limit` and `Offset` label information for all nodes
+	// 3. handle runtime filters sent or received by the node
+	if len(m.node.RuntimeFilterBuildList) > 0 {
+		value, err := GetRuntimeFilterLabelValue(ctx, m.node.RuntimeFilterBuildList, options)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, models.Label{
+			Name:  Label_Runtime_Filter_Build, // "Runtime filter build",
+			Value: value,
+		})
+	}
+	if len(m.node.RuntimeFilterProbeList) > 0 {
+		value, err := GetRuntimeFilterLabelValue(ctx, m.node.RuntimeFilterProbeList, options)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, models.Label{
+			Name:  Label_Runtime_Filter_Probe, // "Runtime filter probe",
+			Value: value,
+		})
+	}
+
+	// 4. handle `Limit` and `Offset` label information for all nodes
 	if m.node.Limit != nil {
 		buf := bytes.NewBuffer(make([]byte, 0, 80))
 		err := describeExpr(ctx, m.node.Limit, options, buf)
@@ -929,6 +951,25 @@ func GetOrderByLabelValue(ctx context.Context, orderbyList []*plan.OrderBySpec,
 	return result, nil
 }
 
+func GetRuntimeFilterLabelValue(ctx context.Context, specs []*plan.RuntimeFilterSpec, options *ExplainOptions) ([]string, error) {
+	result := make([]string, 0, len(specs))
+	buf := bytes.NewBuffer(make([]byte, 0, 200))
+	for _, v := range specs {
+		buf.Reset()
+		if v.Expr != nil {
+			err := describeExpr(ctx, v.Expr, options, buf)
+			if err != nil {
+				return result, err
+			}
+		}
+		if v.MatchPrefix {
+			buf.WriteString(" Match Prefix")
+		}
+		result = append(result, buf.String())
+	}
+	return result, nil
+}
+
 func GetDeleteTableLabelValue(deleteCtx *plan.DeleteCtx) []string {
 	if deleteCtx == nil {
 		return make([]string, 0)
@@ -19,12 +19,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 	plan2 "github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetLabelOrTitle(t *testing.T) {
@@ -265,6 +267,55 @@ func TestDMLToJson(t *testing.T) {
 	buildPlanMarshalTest(mock, t, sqls)
 }
 
+func TestExplainFormatJsonAndDot(t *testing.T) {
+	sqls := []string{
+		"SELECT N_NAME, N_REGIONKEY FROM NATION join REGION on NATION.N_REGIONKEY = REGION.R_REGIONKEY WHERE NATION.N_REGIONKEY > 0",
+		"select n_name from nation intersect all select n_name from nation2",
+		"SELECT N_NAME, MAX(N_REGIONKEY) FROM NATION GROUP BY N_NAME HAVING MAX(N_REGIONKEY) > 10",
+	}
+	ctx := context.TODO()
+	mock := plan.NewMockOptimizer(false)
+	for _, sql := range sqls {
+		pl, err := runSingleSql(mock, t, sql)
+		require.NoError(t, err)
+		query := pl.GetQuery()
+		for _, node := range query.Nodes {
+			node.AnalyzeInfo = &plan2.AnalyzeInfo{
+				InputRows:    12,
+				OutputRows:   7,
+				TimeConsumed: 5,
+			}
+		}
+		impl := NewExplainQueryImpl(query)
+
+		options := &ExplainOptions{Analyze: true, Format: EXPLAIN_FORMAT_JSON}
+		buffer := NewExplainDataBuffer()
+		require.NoError(t, impl.ExplainPlan(ctx, buffer, options))
+		require.Equal(t, 1, len(buffer.Lines))
+		var result ExplainJsonResult
+		require.NoError(t, json.Unmarshal([]byte(buffer.Lines[0]), &result))
+		require.Equal(t, len(query.Steps), len(result.Steps))
+		nodes := result.Steps[0].GraphData.Nodes
+		require.NotEmpty(t, nodes)
+		require.Equal(t, int64(5), nodes[0].TotalStats.Value)
+		require.NotEmpty(t, nodes[0].Statistics.Throughput)
+
+		options.Format = EXPLAIN_FORMAT_DOT
+		buffer = NewExplainDataBuffer()
+		require.NoError(t, impl.ExplainPlan(ctx, buffer, options))
+		dot := buffer.ToString()
+		require.True(t, strings.HasPrefix(dot, "digraph plan {"))
+		require.True(t, strings.HasSuffix(dot, "}\n"))
+		require.Contains(t, dot, "actual=7")
+		require.Contains(t, dot, "->")
+	}
+}
+
+func TestDotEscape(t *testing.T) {
+	require.Equal(t, `"a \"b\" \\c"`, dotQuote(`a "b" \c`))
+	require.Equal(t, `"x\ly\l"`, dotLabel([]string{"x", "", "y"}))
+}
+
 func buildPlanMarshalTest(opt plan.Optimizer, t *testing.T, sqls []string) {
 	ctx := context.TODO()
 	for _, sql := range sqls {
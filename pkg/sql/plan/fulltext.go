@@ -15,8 +15,13 @@
 package plan
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
@@ -72,14 +77,24 @@ var (
 	}
 )
 
-// arg list [source_table_name, index_table_name, search_against, mode]
+// arg list [source_table_name, index_table_name, search_against, mode, (params)]
+// the optional params is the algo params of the index, which is moved to Param
 func (builder *QueryBuilder) buildFullTextIndexScan(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
 
-	if len(exprs) != 4 {
-		return 0, moerr.NewInvalidInput(builder.GetContext(), "Invalid number of arguments (NARGS != 4).")
+	if len(exprs) != 4 && len(exprs) != 5 {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "Invalid number of arguments (NARGS != 4 or 5).")
 	}
 
 	colDefs := _getColDefs(ftIndexColdefs)
+	params := ""
+	if len(exprs) == 5 {
+		lit := exprs[4].GetLit()
+		if lit == nil {
+			return 0, moerr.NewNoConfig(builder.GetContext(), "fifth parameter must be string")
+		}
+		params = lit.GetSval()
+		exprs = exprs[:4]
+	}
 
 	node := &plan.Node{
 		NodeType: plan.Node_FUNCTION_SCAN,
@@ -89,7 +104,7 @@ func (builder *QueryBuilder) buildFullTextIndexScan(tbl *tree.TableFunction, ctx
 			//Name:               tbl.String(),
 			TblFunc: &plan.TableFunction{
 				Name:  fulltext_index_scan_func_name,
-				Param: []byte(""),
+				Param: []byte(params),
 			},
 			Cols: colDefs,
 		},
@@ -153,3 +168,54 @@ func (builder *QueryBuilder) getFullTextParams(fn *tree.FuncExpr) (string, error
 	}
 	return "", moerr.NewNoConfig(builder.GetContext(), "first parameter must be string")
 }
+
+// bindFullTextHighlightArgs fills the optional arguments of
+//
+//	fulltext_highlight(col, pattern [, pre_tag, post_tag])
+//	fulltext_snippet(col, pattern [, pre_tag, post_tag [, fragment_size [, number_of_fragments]]])
+//
+// with the default values, and appends the parser parameters of the fulltext index on col so that the document and
+// the search string are analyzed in the same way as the index.
+func (b *baseBinder) bindFullTextHighlightArgs(name string, args []*Expr) ([]*Expr, error) {
+	maxArgs := 4
+	if name == "fulltext_snippet" {
+		maxArgs = 6
+	}
+	if len(args) < 2 || len(args) == 3 || len(args) > maxArgs {
+		return nil, moerr.NewInvalidArg(b.GetContext(), fmt.Sprintf("number of arguments of %s", name), len(args))
+	}
+
+	defaults := []*Expr{
+		makePlan2StringConstExprWithType(fulltext.HIGHLIGHT_DEFAULT_PRE_TAG),
+		makePlan2StringConstExprWithType(fulltext.HIGHLIGHT_DEFAULT_POST_TAG),
+		makePlan2Int64ConstExprWithType(fulltext.SNIPPET_DEFAULT_FRAGMENT_SIZE),
+		makePlan2Int64ConstExprWithType(fulltext.SNIPPET_DEFAULT_NUMBER_FRAGMENTS),
+	}
+	res := make([]*Expr, 0, maxArgs+1)
+	res = append(res, args...)
+	res = append(res, defaults[len(args)-2:maxArgs-2]...)
+	return append(res, makePlan2StringConstExprWithType(b.findFullTextIndexParams(args[0]))), nil
+}
+
+// findFullTextIndexParams returns the IndexAlgoParams of the fulltext index on the column, or "" if there is none
+func (b *baseBinder) findFullTextIndexParams(expr *Expr) string {
+	col := expr.GetCol()
+	if col == nil || b.builder == nil || b.ctx == nil {
+		return ""
+	}
+	binding, ok := b.ctx.bindingByTag[col.RelPos]
+	if !ok || int(col.ColPos) >= len(binding.cols) {
+		return ""
+	}
+	node := b.builder.qry.Nodes[binding.nodeId]
+	if node.NodeType != plan.Node_TABLE_SCAN || node.TableDef == nil {
+		return ""
+	}
+	for _, idx := range node.TableDef.Indexes {
+		if catalog.IsFullTextIndexAlgo(idx.IndexAlgo) && len(idx.Parts) == 1 &&
+			strings.EqualFold(idx.Parts[0], binding.cols[col.ColPos]) {
+			return idx.IndexAlgoParams
+		}
+	}
+	return ""
+}
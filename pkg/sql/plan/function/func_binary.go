@@ -3013,6 +3013,45 @@ func CosineDistanceArray[T types.RealNumbers](ivecs []*vector.Vector, result vec
 	}, selectList)
 }
 
+// quantizedArrayDistance computes the distance of vecf16 or veci8 vectors after decoding them to float32.
+func quantizedArrayDistance(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList,
+	distFn func(v1, v2 []float32) (float64, error)) error {
+	oid := ivecs[0].GetType().Oid
+	return opBinaryBytesBytesToFixedWithErrorCheck[float64](ivecs, result, proc, length, func(v1, v2 []byte) (out float64, err error) {
+		_v1 := types.QuantizedArrayToFloat32(oid, v1)
+		_v2 := types.QuantizedArrayToFloat32(oid, v2)
+		return distFn(_v1, _v2)
+	}, selectList)
+}
+
+func InnerProductQuantizedArray(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	return quantizedArrayDistance(ivecs, result, proc, length, selectList, moarray.InnerProduct[float32])
+}
+
+func CosineSimilarityQuantizedArray(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	return quantizedArrayDistance(ivecs, result, proc, length, selectList, moarray.CosineSimilarity[float32])
+}
+
+func L2DistanceQuantizedArray(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	return quantizedArrayDistance(ivecs, result, proc, length, selectList, moarray.L2Distance[float32])
+}
+
+func L2DistanceSqQuantizedArray(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	return quantizedArrayDistance(ivecs, result, proc, length, selectList, moarray.L2DistanceSq[float32])
+}
+
+func CosineDistanceQuantizedArray(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	return quantizedArrayDistance(ivecs, result, proc, length, selectList, moarray.CosineDistance[float32])
+}
+
+func HammingDistanceArray(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	return opBinaryBytesBytesToFixedWithErrorCheck[float64](ivecs, result, proc, length, moarray.HammingDistance, selectList)
+}
+
+func JaccardDistanceArray(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	return opBinaryBytesBytesToFixedWithErrorCheck[float64](ivecs, result, proc, length, moarray.JaccardDistance, selectList)
+}
+
 func castBinaryArrayToInt(array []uint8) int64 {
 	var result int64
 	for i, value := range array {
@@ -50,6 +50,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_date, types.T_datetime,
 		types.T_time, types.T_timestamp,
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
 		types.T_datalink,
 	},
 
@@ -298,6 +299,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
 		types.T_datalink,
 	},
 
@@ -345,6 +347,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
 		types.T_datalink,
 	},
 
@@ -362,6 +365,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
 	},
 	types.T_datalink: {
 		types.T_bit,
@@ -378,6 +382,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
 		types.T_datalink,
 	},
 
@@ -406,9 +411,23 @@ var supportedTypeCast = map[types.T][]types.T{
 
 	types.T_array_float32: {
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
 	},
 	types.T_array_float64: {
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
+	},
+	types.T_array_float16: {
+		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
+	},
+	types.T_array_int8: {
+		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
+	},
+	types.T_array_bit: {
+		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
 	},
 }
 
@@ -490,7 +509,8 @@ func NewCast(parameters []*vector.Vector, result vector.FunctionResultWrapper, p
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_datalink:
 		s := vector.GenerateFunctionStrParameter(from)
 		err = strTypeToOthers(proc, s, *toType, result, length, selectList)
-	case types.T_array_float32, types.T_array_float64:
+	case types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		//NOTE: Don't mix T_array and T_varchar.
 		// T_varchar will have "[1,2,3]" string
 		// T_array will have "@@@#@!#@!@#!" binary.
@@ -546,7 +566,8 @@ func scalarNullToOthers(ctx context.Context,
 		return appendNulls[uint64](result, length, selectList)
 	case types.T_char, types.T_varchar, types.T_blob,
 		types.T_binary, types.T_varbinary, types.T_text, types.T_json,
-		types.T_array_float32, types.T_array_float64, types.T_datalink:
+		types.T_array_float32, types.T_array_float64, types.T_datalink,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		return appendNulls[types.Varlena](result, length, selectList)
 	case types.T_float32:
 		return appendNulls[float32](result, length, selectList)
@@ -1615,6 +1636,9 @@ func strTypeToOthers(proc *process.Process,
 		case types.T_array_float64:
 			rs := vector.MustFunctionResult[types.Varlena](result)
 			return blobToArray[float64](ctx, source, rs, length, toType)
+		case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+			rs := vector.MustFunctionResult[types.Varlena](result)
+			return blobToQuantizedArray(ctx, source, rs, length, toType)
 			// NOTE 1: don't add `switch default` and panic here. If `T_blob` to `ARRAY` is not required,
 			// then continue to the `str` to `Other` code.
 			// NOTE 2: don't create a switch T_blob case in NewCast() as
@@ -1697,6 +1721,9 @@ func strTypeToOthers(proc *process.Process,
 	case types.T_array_float64:
 		rs := vector.MustFunctionResult[types.Varlena](result)
 		return strToArray[float64](ctx, source, rs, length, toType)
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		return strToQuantizedArray(ctx, source, rs, length, toType)
 	}
 	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from %s to %s", source.GetType(), toType))
 }
@@ -1724,6 +1751,9 @@ func arrayTypeToOthers(proc *process.Process,
 			return arrayToArray[float64, float64](proc.Ctx, source, rs, length, toType)
 		}
 	}
+	if fromType.Oid.IsVector() && toType.Oid.IsVector() {
+		return arrayToQuantizedArray(proc.Ctx, source, rs, length, toType)
+	}
 
 	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from %s to %s", fromType, toType))
 }
@@ -4446,6 +4476,121 @@ func blobToArray[T types.RealNumbers](
 	return nil
 }
 
+func strToQuantizedArray(
+	_ context.Context,
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int, toType types.Type) error {
+
+	var i uint64
+	var l = uint64(length)
+	for i = 0; i < l; i++ {
+		v, null := from.GetStrValue(i)
+		if null || len(v) == 0 {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+		} else {
+			b, err := types.StringToQuantizedArray(toType.Oid, convertByteSliceToString(v))
+			if err != nil {
+				return err
+			}
+			if err = to.AppendBytes(b, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func blobToQuantizedArray(
+	_ context.Context,
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int, toType types.Type) error {
+
+	var i uint64
+	var l = uint64(length)
+	for i = 0; i < l; i++ {
+		v, null := from.GetStrValue(i)
+		if null || len(v) == 0 {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+		} else {
+			if dim := types.QuantizedArrayDimension(toType.Oid, v); int(toType.Width) != dim {
+				return moerr.NewArrayDefMismatchNoCtx(int(toType.Width), dim)
+			}
+			if toType.Oid == types.T_array_float16 && len(v)%2 != 0 {
+				return moerr.NewInvalidInputNoCtxf("invalid vecf16 value of %d bytes", len(v))
+			}
+
+			if err := to.AppendBytes(v, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// arrayToQuantizedArray casts between vector types when either side is vecf16, veci8 or vecbit.
+// The values are converted by way of float32.
+func arrayToQuantizedArray(
+	_ context.Context,
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int, toType types.Type) error {
+
+	fromType := from.GetType()
+	var i uint64
+	var l = uint64(length)
+	for i = 0; i < l; i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if fromType.Oid == toType.Oid {
+			if err := to.AppendBytes(v, false); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var f32 []float32
+		switch fromType.Oid {
+		case types.T_array_float32:
+			f32 = types.BytesToArray[float32](v)
+		case types.T_array_float64:
+			f64 := types.BytesToArray[float64](v)
+			f32 = make([]float32, len(f64))
+			for j := range f64 {
+				f32[j] = float32(f64[j])
+			}
+		default:
+			f32 = types.QuantizedArrayToFloat32(fromType.Oid, v)
+		}
+
+		var bytes []byte
+		var err error
+		switch toType.Oid {
+		case types.T_array_float32:
+			bytes = types.ArrayToBytes[float32](f32)
+		case types.T_array_float64:
+			cast, _ := moarray.Cast[float32, float64](f32)
+			bytes = types.ArrayToBytes[float64](cast)
+		default:
+			if bytes, err = types.Float32ToQuantizedArray(toType.Oid, f32); err != nil {
+				return err
+			}
+		}
+		if err = to.AppendBytes(bytes, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func arrayToArray[I types.RealNumbers, O types.RealNumbers](
 	_ context.Context,
 	from vector.FunctionParameterWrapper[types.Varlena],
@@ -16,7 +16,10 @@ package function
 
 import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/datalink"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -27,3 +30,90 @@ func fullTextMatch(ivecs []*vector.Vector, result vector.FunctionResultWrapper,
 func fullTextMatchScore(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
 	return moerr.NewNotSupported(proc.Ctx, "MATCH() AGAINST() function cannot be replaced by FULLTEXT INDEX and full table scan with fulltext search is not supported yet.")
 }
+
+func rrfScore(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	return moerr.NewNotSupported(proc.Ctx, "rrf_score() is only supported in ORDER BY ... DESC LIMIT, with a FULLTEXT INDEX for MATCH() AGAINST().")
+}
+
+// fullTextHighlightFn returns fulltext_highlight(doc, pattern, pre_tag, post_tag, params) if snippet is false, and
+// fulltext_snippet(doc, pattern, pre_tag, post_tag, fragment_size, number_of_fragments, params) otherwise.
+// The optional arguments and the parser parameters of the fulltext index are filled by the binder.
+func fullTextHighlightFn(doctyp types.T, snippet bool) executeLogicOfOverload {
+	return func(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		docs := vector.GenerateFunctionStrParameter(ivecs[0])
+		patterns := vector.GenerateFunctionStrParameter(ivecs[1])
+		preTags := vector.GenerateFunctionStrParameter(ivecs[2])
+		postTags := vector.GenerateFunctionStrParameter(ivecs[3])
+		params := vector.GenerateFunctionStrParameter(ivecs[len(ivecs)-1])
+		var sizes, nfragments vector.FunctionParameterWrapper[int64]
+		if snippet {
+			sizes = vector.GenerateFunctionFixedTypeParameter[int64](ivecs[4])
+			nfragments = vector.GenerateFunctionFixedTypeParameter[int64](ivecs[5])
+		}
+
+		// the search string is constant in most cases
+		var h *fulltext.Highlighter
+		var lastPattern, lastParams string
+
+		for i := uint64(0); i < uint64(length); i++ {
+			doc, null1 := docs.GetStrValue(i)
+			pattern, null2 := patterns.GetStrValue(i)
+			preTag, null3 := preTags.GetStrValue(i)
+			postTag, null4 := postTags.GetStrValue(i)
+			param, _ := params.GetStrValue(i)
+			var size, nfragment int64
+			var null5, null6 bool
+			if snippet {
+				size, null5 = sizes.GetValue(i)
+				nfragment, null6 = nfragments.GetValue(i)
+			}
+			if null1 || null2 || null3 || null4 || null5 || null6 {
+				if err := rs.AppendBytes(nil, true); err != nil {
+					return err
+				}
+				continue
+			}
+			if snippet && (size <= 0 || nfragment <= 0) {
+				return moerr.NewInvalidInputf(proc.Ctx, "fragment size and number of fragments of fulltext_snippet must be positive, got %d and %d", size, nfragment)
+			}
+
+			if h == nil || string(pattern) != lastPattern || string(param) != lastParams {
+				var err error
+				lastPattern, lastParams = string(pattern), string(param)
+				if h, err = fulltext.NewHighlighter(lastPattern, lastParams); err != nil {
+					return err
+				}
+			}
+
+			var text string
+			switch doctyp {
+			case types.T_datalink:
+				dl, err := datalink.NewDatalink(string(doc), proc)
+				if err != nil {
+					return err
+				}
+				b, err := dl.GetPlainText(proc)
+				if err != nil {
+					return err
+				}
+				text = string(b)
+			case types.T_json:
+				text = types.DecodeJson(doc).String()
+			default:
+				text = string(doc)
+			}
+
+			var res string
+			if snippet {
+				res = h.Snippet(text, string(preTag), string(postTag), int(size), int(nfragment))
+			} else {
+				res = h.Highlight(text, string(preTag), string(postTag))
+			}
+			if err := rs.AppendBytes([]byte(res), false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FullTextHighlight(t *testing.T) {
+	proc := testutil.NewProcess()
+	tc := tcTemp{
+		info: "test fulltext_highlight(doc, 'running dogs', '<em>', '</em>', params)",
+		inputs: []FunctionTestInput{
+			NewFunctionTestInput(types.T_text.ToType(),
+				[]string{"The dog runs", "no match", ""}, []bool{false, false, true}),
+			NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"running dogs"}, nil),
+			NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"<em>"}, nil),
+			NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"</em>"}, nil),
+			NewFunctionTestConstInput(types.T_varchar.ToType(), []string{`{"parser":"ngram","analyzer":"english"}`}, nil),
+		},
+		expect: NewFunctionTestResult(types.T_text.ToType(), false,
+			[]string{"The <em>dog</em> <em>runs</em>", "no match", ""}, []bool{false, false, true}),
+	}
+	tcc := NewFunctionTestCase(proc, tc.inputs, tc.expect, fEvalFn(fullTextHighlightFn(types.T_text, false)))
+	succeed, info := tcc.Run()
+	require.True(t, succeed, tc.info, info)
+}
+
+func Test_FullTextSnippet(t *testing.T) {
+	proc := testutil.NewProcess()
+	{
+		tc := tcTemp{
+			info: "test fulltext_snippet(doc, 'apple', '[', ']', 20, 2, '')",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"an apple a day keeps the doctor away, but an apple pie does not"}, nil),
+				NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"apple"}, nil),
+				NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"["}, nil),
+				NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"]"}, nil),
+				NewFunctionTestConstInput(types.T_int64.ToType(), []int64{20}, nil),
+				NewFunctionTestConstInput(types.T_int64.ToType(), []int64{2}, nil),
+				NewFunctionTestConstInput(types.T_varchar.ToType(), []string{""}, nil),
+			},
+			expect: NewFunctionTestResult(types.T_text.ToType(), false,
+				[]string{"an [apple] a day ... an [apple] pie"}, nil),
+		}
+		tcc := NewFunctionTestCase(proc, tc.inputs, tc.expect, fEvalFn(fullTextHighlightFn(types.T_varchar, true)))
+		succeed, info := tcc.Run()
+		require.True(t, succeed, tc.info, info)
+	}
+
+	{
+		tc := tcTemp{
+			info: "test fulltext_snippet(doc, 'apple', '[', ']', 0, 2, '')",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{"an apple"}, nil),
+				NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"apple"}, nil),
+				NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"["}, nil),
+				NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"]"}, nil),
+				NewFunctionTestConstInput(types.T_int64.ToType(), []int64{0}, nil),
+				NewFunctionTestConstInput(types.T_int64.ToType(), []int64{2}, nil),
+				NewFunctionTestConstInput(types.T_varchar.ToType(), []string{""}, nil),
+			},
+			expect: NewFunctionTestResult(types.T_text.ToType(), true, []string{""}, nil),
+		}
+		tcc := NewFunctionTestCase(proc, tc.inputs, tc.expect, fEvalFn(fullTextHighlightFn(types.T_varchar, true)))
+		succeed, info := tcc.Run()
+		require.True(t, succeed, tc.info, info)
+	}
+}
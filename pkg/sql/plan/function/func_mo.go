@@ -586,6 +586,8 @@ var (
 		"mo_stages":                   0,
 		"mo_snapshots":                0,
 		"mo_pitr":                     0,
+		catalog.MO_EVENTS:             0,
+		catalog.MO_EVENT_RUNS:         0,
 	}
 )
 
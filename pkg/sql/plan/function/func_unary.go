@@ -213,6 +213,13 @@ func VectorDimsArray[T types.RealNumbers](ivecs []*vector.Vector, result vector.
 	}, selectList)
 }
 
+func VectorDimsQuantizedArray(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	oid := ivecs[0].GetType().Oid
+	return opUnaryBytesToFixed[int64](ivecs, result, proc, length, func(in []byte) (out int64) {
+		return int64(types.QuantizedArrayDimension(oid, in))
+	}, selectList)
+}
+
 func SummationArray[T types.RealNumbers](ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
 	return opUnaryBytesToFixedWithErrorCheck[float64](ivecs, result, proc, length, func(in []byte) (out float64, err error) {
 		_in := types.BytesToArray[T](in)
@@ -397,6 +397,17 @@ const (
 	FULLTEXT_MATCH
 	FULLTEXT_MATCH_SCORE
 
+	// hybrid search function
+	RRF_SCORE
+
+	// bit vector function
+	HAMMING_DISTANCE
+	JACCARD_DISTANCE
+
+	// fulltext snippet function
+	FULLTEXT_SNIPPET
+	FULLTEXT_HIGHLIGHT
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
 	FUNCTION_END_NUMBER
@@ -484,7 +495,16 @@ var functionIdRegister = map[string]int32{
 	"any_value":             ANY_VALUE,
 	"median":                MEDIAN,
 	// count window
-	"rank": RANK,
+	"rank":         RANK,
+	"ntile":        NTILE,
+	"percent_rank": PERCENT_RANK,
+	"cume_dist":    CUME_DIST,
+	// value window
+	"lag":         LAG,
+	"lead":        LEAD,
+	"first_value": FIRST_VALUE,
+	"last_value":  LAST_VALUE,
+	"nth_value":   NTH_VALUE,
 	// builtin
 	// whoever edit this, please follow the lexical order, or come up with a better ordering method
 	// binary functions
@@ -723,4 +743,12 @@ var functionIdRegister = map[string]int32{
 	// match function
 	"fulltext_match":       FULLTEXT_MATCH,
 	"fulltext_match_score": FULLTEXT_MATCH_SCORE,
+
+	"rrf_score": RRF_SCORE,
+
+	"hamming_distance": HAMMING_DISTANCE,
+	"jaccard_distance": JACCARD_DISTANCE,
+
+	"fulltext_snippet":   FULLTEXT_SNIPPET,
+	"fulltext_highlight": FULLTEXT_HIGHLIGHT,
 }
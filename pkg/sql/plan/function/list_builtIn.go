@@ -2075,6 +2075,36 @@ var supportedArrayOperations = []FuncNew{
 					return VectorDimsArray[float64]
 				},
 			},
+			{
+				overloadId: 2,
+				args:       []types.T{types.T_array_float16},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_int64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return VectorDimsQuantizedArray
+				},
+			},
+			{
+				overloadId: 3,
+				args:       []types.T{types.T_array_int8},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_int64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return VectorDimsQuantizedArray
+				},
+			},
+			{
+				overloadId: 4,
+				args:       []types.T{types.T_array_bit},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_int64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return VectorDimsQuantizedArray
+				},
+			},
 		},
 	},
 
@@ -2106,6 +2136,26 @@ var supportedArrayOperations = []FuncNew{
 					return InnerProductArray[float64]
 				},
 			},
+			{
+				overloadId: 2,
+				args:       []types.T{types.T_array_float16, types.T_array_float16},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return InnerProductQuantizedArray
+				},
+			},
+			{
+				overloadId: 3,
+				args:       []types.T{types.T_array_int8, types.T_array_int8},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return InnerProductQuantizedArray
+				},
+			},
 		},
 	},
 
@@ -2137,6 +2187,26 @@ var supportedArrayOperations = []FuncNew{
 					return CosineSimilarityArray[float64]
 				},
 			},
+			{
+				overloadId: 2,
+				args:       []types.T{types.T_array_float16, types.T_array_float16},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return CosineSimilarityQuantizedArray
+				},
+			},
+			{
+				overloadId: 3,
+				args:       []types.T{types.T_array_int8, types.T_array_int8},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return CosineSimilarityQuantizedArray
+				},
+			},
 		},
 	},
 	// function `l2_distance`
@@ -2167,6 +2237,26 @@ var supportedArrayOperations = []FuncNew{
 					return L2DistanceArray[float64]
 				},
 			},
+			{
+				overloadId: 2,
+				args:       []types.T{types.T_array_float16, types.T_array_float16},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return L2DistanceQuantizedArray
+				},
+			},
+			{
+				overloadId: 3,
+				args:       []types.T{types.T_array_int8, types.T_array_int8},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return L2DistanceQuantizedArray
+				},
+			},
 		},
 	},
 	// function `l2_distance_sq`
@@ -2197,6 +2287,26 @@ var supportedArrayOperations = []FuncNew{
 					return L2DistanceSqArray[float64]
 				},
 			},
+			{
+				overloadId: 2,
+				args:       []types.T{types.T_array_float16, types.T_array_float16},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return L2DistanceSqQuantizedArray
+				},
+			},
+			{
+				overloadId: 3,
+				args:       []types.T{types.T_array_int8, types.T_array_int8},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return L2DistanceSqQuantizedArray
+				},
+			},
 		},
 	},
 	// function `cosine_distance`
@@ -2227,6 +2337,66 @@ var supportedArrayOperations = []FuncNew{
 					return CosineDistanceArray[float64]
 				},
 			},
+			{
+				overloadId: 2,
+				args:       []types.T{types.T_array_float16, types.T_array_float16},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return CosineDistanceQuantizedArray
+				},
+			},
+			{
+				overloadId: 3,
+				args:       []types.T{types.T_array_int8, types.T_array_int8},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return CosineDistanceQuantizedArray
+				},
+			},
+		},
+	},
+	// function `hamming_distance`
+	{
+		functionId: HAMMING_DISTANCE,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{types.T_array_bit, types.T_array_bit},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return HammingDistanceArray
+				},
+			},
+		},
+	},
+	// function `jaccard_distance`
+	{
+		functionId: JACCARD_DISTANCE,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{types.T_array_bit, types.T_array_bit},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return JaccardDistanceArray
+				},
+			},
 		},
 	},
 	// function `normalize_l2`
@@ -6735,6 +6905,83 @@ var supportedOthersBuiltIns = []FuncNew{
 
 		Overloads: fulltext_expand_overload(types.T_float32),
 	},
+
+	// function `RRF_SCORE`
+	{
+		functionId: RRF_SCORE,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedDirectlyTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{types.T_bool, types.T_float64},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return rrfScore
+				},
+			},
+			{
+				overloadId: 1,
+				args:       []types.T{types.T_bool, types.T_float64, types.T_int64},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return rrfScore
+				},
+			},
+		},
+	},
+
+	// function `FULLTEXT_SNIPPET`
+	{
+		functionId: FULLTEXT_SNIPPET,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: fulltext_highlight_overload(true),
+	},
+
+	// function `FULLTEXT_HIGHLIGHT`
+	{
+		functionId: FULLTEXT_HIGHLIGHT,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: fulltext_highlight_overload(false),
+	},
+}
+
+// fulltext_snippet and fulltext_highlight support the same types of document as fulltext_match
+func fulltext_highlight_overload(snippet bool) []overload {
+	overloads := make([]overload, 0)
+	for i, t := range []types.T{types.T_varchar, types.T_char, types.T_text, types.T_json, types.T_datalink} {
+		// doc, pattern, pre_tag, post_tag, [fragment_size, number_of_fragments,] params
+		args := []types.T{t, types.T_varchar, types.T_varchar, types.T_varchar}
+		if snippet {
+			args = append(args, types.T_int64, types.T_int64)
+		}
+		args = append(args, types.T_varchar)
+
+		doctyp := t
+		overloads = append(overloads, overload{
+			overloadId: i,
+			args:       args,
+			retType: func(parameters []types.Type) types.Type {
+				return types.T_text.ToType()
+			},
+			newOp: func() executeLogicOfOverload {
+				return fullTextHighlightFn(doctyp, snippet)
+			},
+		})
+	}
+	return overloads
 }
 
 // fulltext_match supports varchar, char and text.  Expand the function signature to all possible combination of input types
@@ -88,4 +88,216 @@ var supportedWindowInNewFramework = []FuncNew{
 			},
 		},
 	},
+	{
+		functionId: NTILE,
+		class:      plan.Function_WIN_ORDER,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 1 {
+				return windowArgsCheck(inputs, types.T_int64.ToType())
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.SingleWindowReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "ntile",
+					aggRegister: agg.RegisterNtile,
+				},
+			},
+		},
+	},
+	{
+		functionId: PERCENT_RANK,
+		class:      plan.Function_WIN_ORDER,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 0 {
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.WindowDistributionReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "percent_rank",
+					aggRegister: agg.RegisterPercentRank,
+				},
+			},
+		},
+	},
+	{
+		functionId: CUME_DIST,
+		class:      plan.Function_WIN_ORDER,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 0 {
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.WindowDistributionReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "cume_dist",
+					aggRegister: agg.RegisterCumeDist,
+				},
+			},
+		},
+	},
+	{
+		functionId: LAG,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			// lag(expr [, offset [, default]])
+			switch len(inputs) {
+			case 1:
+				return newCheckResultWithSuccess(0)
+			case 2:
+				return windowArgsCheck(inputs, inputs[0], types.T_int64.ToType())
+			case 3:
+				typ := inputs[0]
+				if typ.Oid == types.T_any {
+					typ = inputs[2]
+				}
+				return windowArgsCheck(inputs, typ, types.T_int64.ToType(), typ)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.WindowValueReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "lag",
+					aggRegister: agg.RegisterLag,
+				},
+			},
+		},
+	},
+	{
+		functionId: LEAD,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			// lead(expr [, offset [, default]])
+			switch len(inputs) {
+			case 1:
+				return newCheckResultWithSuccess(0)
+			case 2:
+				return windowArgsCheck(inputs, inputs[0], types.T_int64.ToType())
+			case 3:
+				typ := inputs[0]
+				if typ.Oid == types.T_any {
+					typ = inputs[2]
+				}
+				return windowArgsCheck(inputs, typ, types.T_int64.ToType(), typ)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.WindowValueReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "lead",
+					aggRegister: agg.RegisterLead,
+				},
+			},
+		},
+	},
+	{
+		functionId: FIRST_VALUE,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 1 {
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.WindowValueReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "first_value",
+					aggRegister: agg.RegisterFirstValue,
+				},
+			},
+		},
+	},
+	{
+		functionId: LAST_VALUE,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 1 {
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.WindowValueReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "last_value",
+					aggRegister: agg.RegisterLastValue,
+				},
+			},
+		},
+	},
+	{
+		functionId: NTH_VALUE,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 2 {
+				return windowArgsCheck(inputs, inputs[0], types.T_int64.ToType())
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.WindowValueReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "nth_value",
+					aggRegister: agg.RegisterNthValue,
+				},
+			},
+		},
+	},
+}
+
+// windowArgsCheck returns success if the inputs are already the required types,
+// or asks the planner to cast them.
+func windowArgsCheck(inputs []types.Type, required ...types.Type) checkResult {
+	needCast := false
+	for i := range inputs {
+		if inputs[i].Oid != required[i].Oid {
+			needCast = true
+			break
+		}
+	}
+	if !needCast {
+		return newCheckResultWithSuccess(0)
+	}
+	return newCheckResultWithCast(0, required)
 }
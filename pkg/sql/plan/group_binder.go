@@ -50,6 +50,8 @@ func (b *GroupBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*pl
 		}
 	}
 
+	// formatted before it is bound, which rewrites the AST of a subquery
+	astStr := tree.String(astExpr, dialect.MYSQL)
 	expr, err := b.baseBindExpr(astExpr, depth, isRoot)
 	if err != nil {
 		return nil, err
@@ -60,7 +62,6 @@ func (b *GroupBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*pl
 	}
 
 	if isRoot && !b.ctx.isGroupingSet {
-		astStr := tree.String(astExpr, dialect.MYSQL)
 		if _, ok := b.ctx.groupByAst[astStr]; ok {
 			return nil, nil
 		}
@@ -70,7 +71,6 @@ func (b *GroupBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*pl
 	}
 
 	if b.ctx.isGroupingSet {
-		astStr := tree.String(astExpr, dialect.MYSQL)
 		b.ctx.groupingFlag[b.ctx.groupByAst[astStr]] = true
 	}
 
@@ -83,7 +83,8 @@ func (b *GroupBinder) BindColRef(astExpr *tree.UnresolvedName, depth int32, isRo
 		return nil, err
 	}
 
-	if _, ok := expr.Expr.(*plan.Expr_Corr); ok {
+	// the columns referenced by a subquery in GROUP BY are correlated to the subquery
+	if _, ok := expr.Expr.(*plan.Expr_Corr); ok && depth == 0 {
 		return nil, moerr.NewNYI(b.GetContext(), "correlated columns in GROUP BY clause")
 	}
 
@@ -99,7 +100,7 @@ func (b *GroupBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth
 }
 
 func (b *GroupBinder) BindSubquery(astExpr *tree.Subquery, isRoot bool) (*plan.Expr, error) {
-	return nil, moerr.NewNYI(b.GetContext(), "subquery in GROUP BY clause")
+	return b.baseBindSubquery(astExpr, isRoot)
 }
 
 func (b *GroupBinder) BindTimeWindowFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
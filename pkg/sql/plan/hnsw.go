@@ -0,0 +1,130 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vectorindex/hnsw"
+)
+
+var (
+	hnsw_search_func_name = "hnsw_search"
+
+	hnswSearchColDefs = []*plan.ColDef{
+		// pk type should be same as the primary key of the source table
+		{
+			Name: "pk",
+			Typ: plan.Type{
+				Id:          int32(types.T_any),
+				NotNullable: false,
+			},
+		},
+		{
+			Name: "distance",
+			Typ: plan.Type{
+				Id:          int32(types.T_float64),
+				NotNullable: false,
+				Width:       8,
+			},
+		},
+	}
+)
+
+// select * from hnsw_search('{"db": ..., "src": ..., ...}', '[1,2,3]')
+// arg list [params, query_vector]
+func (builder *QueryBuilder) buildHnswSearch(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+
+	if len(exprs) != 2 {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "Invalid number of arguments (NARGS != 2).")
+	}
+
+	params, err := builder.getFullTextParams(tbl.Func)
+	if err != nil {
+		return 0, err
+	}
+
+	var param hnsw.SearchParam
+	if err = json.Unmarshal([]byte(params), &param); err != nil {
+		return 0, moerr.NewInvalidInputf(builder.GetContext(), "invalid hnsw_search params: %s", params)
+	}
+	if param.Filtered {
+		// the keys of a filtered search are given by the plan of the vector index
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "hnsw_search: filtered is not supported")
+	}
+	_, tableDef := builder.compCtx.Resolve(param.DbName, param.SrcTable, nil)
+	if tableDef == nil {
+		return 0, moerr.NewNoSuchTable(builder.GetContext(), param.DbName, param.SrcTable)
+	}
+	var pkCol, vecCol *plan.ColDef
+	for _, col := range tableDef.Cols {
+		switch col.Name {
+		case param.PkCol:
+			pkCol = col
+		case param.VecCol:
+			vecCol = col
+		}
+	}
+	if pkCol == nil {
+		return 0, moerr.NewInvalidInputf(builder.GetContext(), "column '%s' is not exist", param.PkCol)
+	}
+	if vecCol == nil {
+		return 0, moerr.NewInvalidInputf(builder.GetContext(), "column '%s' is not exist", param.VecCol)
+	}
+
+	// the query vector, e.g. a string literal, is cast to the type of the indexed column
+	query := exprs[1]
+	if query.Typ.Id != vecCol.Typ.Id || query.Typ.Width != vecCol.Typ.Width {
+		if query, err = appendCastBeforeExpr(builder.GetContext(), query, vecCol.Typ); err != nil {
+			return 0, err
+		}
+	}
+
+	// the first argument is moved to Param
+	return builder.makeHnswSearchNode(ctx, []byte(params), pkCol.Typ, []*plan.Expr{query}, childId), nil
+}
+
+// makeHnswSearchNode appends the FUNCTION_SCAN of hnsw_search. args are the query vector, followed by the
+// primary key column of the child when the search is filtered.
+func (builder *QueryBuilder) makeHnswSearchNode(ctx *BindContext, params []byte, pkType plan.Type, args []*plan.Expr, childId int32) int32 {
+	colDefs := _getColDefs(hnswSearchColDefs)
+	// set type to source table primary key
+	colDefs[0].Typ = plan.Type{
+		Id:    pkType.Id,
+		Width: pkType.Width,
+		Scale: pkType.Scale,
+	}
+
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name:  hnsw_search_func_name,
+				Param: params,
+			},
+			Cols: colDefs,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		TblFuncExprList: args,
+		Children:        []int32{childId},
+	}
+	return builder.appendNode(node, ctx)
+}
@@ -727,3 +727,16 @@ func GetColDefFromTable(Cols []*ColDef, hidenColName string) *ColDef {
 	}
 	panic("Unable to find target column from predefined table columns")
 }
+
+// SetGeneratedColDefs attaches the generated column definitions kept in the
+// table constraint to their columns.
+func SetGeneratedColDefs(cols []*ColDef, generated []*plan.GeneratedColDef) {
+	for _, g := range generated {
+		for _, col := range cols {
+			if col.Name == g.Name {
+				col.Generated = g
+				break
+			}
+		}
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"strings"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
@@ -1023,6 +1024,11 @@ func (m *MockCompilerContext) CheckTimeStampValid(ts int64) (bool, error) {
 	return false, nil
 }
 
+// CheckTableChangesRetained retains the changes for an hour, no snapshot or pitr in the mock
+func (m *MockCompilerContext) CheckTableChangesRetained(dbName, tableName string, ts int64) (bool, error) {
+	return time.Now().UTC().UnixNano()-ts <= time.Hour.Nanoseconds(), nil
+}
+
 type MockOptimizer struct {
 	ctxt MockCompilerContext
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/rule"
 )
 
@@ -115,8 +116,8 @@ func (b *ProjectionBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr,
 }
 
 func (b *ProjectionBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
-	if astExpr.Type == tree.FUNC_TYPE_DISTINCT {
-		return nil, moerr.NewNYI(b.GetContext(), "DISTINCT in window function")
+	if astExpr.Type == tree.FUNC_TYPE_DISTINCT && !function.GetFunctionIsAggregateByName(funcName) {
+		return nil, moerr.NewSyntaxErrorf(b.GetContext(), "DISTINCT is not allowed in window function %s", funcName)
 	}
 
 	colPos := int32(len(b.ctx.windows))
@@ -131,7 +132,16 @@ func (b *ProjectionBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr,
 	if err != nil {
 		return nil, err
 	}
+	if astExpr.Type == tree.FUNC_TYPE_DISTINCT {
+		if funcName != "max" && funcName != "min" && funcName != "any_value" {
+			w.WindowFunc.GetF().Func.Obj = int64(uint64(w.WindowFunc.GetF().Func.Obj) | function.Distinct)
+		}
+	}
 	w.Name = funcName
+	if astExpr.FromFirstLast == tree.FROM_LAST {
+		return nil, moerr.NewNotSupportedf(b.GetContext(), "FROM LAST in window function '%s'", funcName)
+	}
+	w.IgnoreNulls = astExpr.NullTreatment == tree.NULL_TREATMENT_IGNORE
 	// partition by
 	for _, group := range ws.PartitionBy {
 		expr, err := b.BindExpr(group, depth, isRoot)
@@ -206,6 +216,11 @@ func (b *ProjectionBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr,
 	switch ws.Frame.Type {
 	case tree.Rows:
 		typ = &plan.Type{Id: int32(types.T_uint64)}
+	case tree.Groups:
+		if len(w.OrderBy) == 0 {
+			return nil, moerr.NewParseError(b.GetContext(), "Window '<unnamed window>' with GROUPS frame requires an ORDER BY clause")
+		}
+		typ = &plan.Type{Id: int32(types.T_uint64)}
 	case tree.Range:
 		if len(w.OrderBy) != 1 && isNRange(ws.Frame) {
 			return nil, moerr.NewParseError(b.GetContext(), "Window '<unnamed window>' with RANGE N PRECEDING/FOLLOWING frame requires exactly one ORDER BY expression, of numeric or temporal type")
@@ -219,8 +234,6 @@ func (b *ProjectionBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr,
 		if !t.IsNumericOrTemporal() {
 			return nil, moerr.NewParseError(b.GetContext(), "Window '<unnamed window>' with RANGE N PRECEDING/FOLLOWING frame requires exactly one ORDER BY expression, of numeric or temporal type")
 		}
-	case tree.Groups:
-		return nil, moerr.NewNYI(b.GetContext(), "GROUPS in WINDOW FUNCTION condition")
 	}
 	if ws.Frame.Start.Expr != nil {
 		w.Frame.Start.Val, err = b.makeFrameConstValue(ws.Frame.Start.Expr, typ)
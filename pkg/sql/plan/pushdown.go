@@ -124,15 +124,22 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr,
 
 	case plan.Node_FILTER:
 		canPushdown = filters
+		// assertions must see exactly the rows of the child, e.g. the rows to be
+		// written by a DML, so they are never pushed down
+		var asserts []*plan.Expr
 		for _, filter := range node.FilterList {
+			if containsAssert(filter) {
+				asserts = append(asserts, filter)
+				continue
+			}
 			canPushdown = append(canPushdown, splitPlanConjunction(applyDistributivity(builder.GetContext(), filter))...)
 		}
 
 		childID, cantPushdownChild := builder.pushdownFilters(node.Children[0], canPushdown, separateNonEquiConds)
 
-		if len(cantPushdownChild) > 0 {
+		if len(cantPushdownChild) > 0 || len(asserts) > 0 {
 			node.Children[0] = childID
-			node.FilterList = cantPushdownChild
+			node.FilterList = append(cantPushdownChild, asserts...)
 		} else {
 			nodeID = childID
 		}
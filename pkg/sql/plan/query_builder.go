@@ -1316,25 +1316,16 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, step int32, colRefCnt
 				Expr: &plan.Expr_Lit{Lit: &plan.Literal{Value: &plan.Literal_I64Val{I64Val: 0}}},
 			})
 		} else {
-			internalRemapping := &ColRefRemapping{
-				globalToLocal: make(map[[2]int32][2]int32),
-			}
-
+			// the columns which are not referenced, e.g. the DEFAULT values of the
+			// generated columns, are not projected
 			tag := node.BindingTags[0]
-			for i := range node.TableDef.Cols {
+			for i, col := range node.TableDef.Cols {
 				globalRef := [2]int32{tag, int32(i)}
 				if colRefCnt[globalRef] == 0 {
 					continue
 				}
-				internalRemapping.addColRef(globalRef)
-			}
-
-			for i, col := range node.TableDef.Cols {
-				if colRefCnt[internalRemapping.localToGlobal[i]] == 0 {
-					continue
-				}
 
-				remapping.addColRef(internalRemapping.localToGlobal[i])
+				remapping.addColRef(globalRef)
 
 				node.ProjectList = append(node.ProjectList, &plan.Expr{
 					Typ: col.Typ,
@@ -2451,8 +2442,9 @@ func (builder *QueryBuilder) bindSelect(stmt *tree.Select, ctx *BindContext, isR
 	astLimit := stmt.Limit
 	astTimeWindow := stmt.TimeWindow
 
-	if stmt.SelectLockInfo != nil && stmt.SelectLockInfo.LockType == tree.SelectLockForUpdate {
+	if stmt.SelectLockInfo != nil && stmt.SelectLockInfo.LockType != tree.SelectLockNone {
 		builder.isForUpdate = true
+		builder.selectLockInfo = stmt.SelectLockInfo
 	}
 
 	// strip parentheses
@@ -2558,6 +2550,8 @@ func (builder *QueryBuilder) bindSelect(stmt *tree.Select, ctx *BindContext, isR
 	var resultLen int
 	var havingBinder *HavingBinder
 	var lockNode *plan.Node
+	var lockAfterSort bool
+	var lockFilterTyp plan.Type
 	var notCacheable bool
 	var helpFunc *helpFunc
 	var timeWindowGroup *plan.Expr
@@ -2693,6 +2687,7 @@ func (builder *QueryBuilder) bindSelect(stmt *tree.Select, ctx *BindContext, isR
 				RefreshTsIdxInBat:  -1, //unsupport now
 				LockTableAtTheEnd:  getLockTableAtTheEnd(tableDef),
 			}
+			setSelectLockOptions(lockTarget, builder.selectLockInfo)
 			if tableDef.Partition != nil {
 				partTableIDs, _ := getPartTableIdsAndNames(builder.compCtx, objRef, tableDef)
 				lockTarget.IsPartitionTable = true
@@ -2724,6 +2719,7 @@ func (builder *QueryBuilder) bindSelect(stmt *tree.Select, ctx *BindContext, isR
 				}
 				lockTarget.FilterColIdxInBat = int32(len(projectList))
 				projectList = append(projectList, partitionExpr)
+				lockFilterTyp = partitionExpr.Typ
 				newBindingTag := builder.genNewTag()
 				lockTarget.FilterColRelPos = newBindingTag
 				if binding, ok := ctx.bindingByTable[tableDef.Name]; ok {
@@ -2749,6 +2745,10 @@ func (builder *QueryBuilder) bindSelect(stmt *tree.Select, ctx *BindContext, isR
 
 			if astLimit == nil {
 				nodeID = builder.appendNode(lockNode, ctx)
+			} else if isSkipLocked(builder.selectLockInfo) {
+				// the lock node is put above the sort node later, and locks
+				// no more rows than the limit.
+				lockAfterSort = true
 			}
 		}
 
@@ -3097,7 +3097,7 @@ func (builder *QueryBuilder) bindSelect(stmt *tree.Select, ctx *BindContext, isR
 				}
 			}
 		}
-		if builder.isForUpdate {
+		if builder.isForUpdate && !lockAfterSort {
 			lockNode.Children[0] = nodeID
 			nodeID = builder.appendNode(lockNode, ctx)
 		}
@@ -3122,6 +3122,16 @@ func (builder *QueryBuilder) bindSelect(stmt *tree.Select, ctx *BindContext, isR
 		}
 	}
 
+	// the subqueries in GROUP BY are flattened below the AGG node
+	for i, group := range ctx.groups {
+		if hasSubquery(group) {
+			nodeID, ctx.groups[i], err = builder.flattenSubqueries(nodeID, group, ctx)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	if ctx.forceWindows {
 		ctx.tmpGroups = ctx.groups
 		ctx.windows, _ = ctx.generateForceWinSpecList()
@@ -3331,6 +3341,22 @@ func (builder *QueryBuilder) bindSelect(stmt *tree.Select, ctx *BindContext, isR
 		ctx.projects[i] = proj
 	}
 
+	if lockAfterSort {
+		if len(ctx.groups) > 0 || len(ctx.aggregates) > 0 || ctx.isDistinct {
+			return 0, moerr.NewNotSupported(builder.GetContext(), "SKIP LOCKED with LIMIT on grouped or distinct rows")
+		}
+		// the columns locked are passed through the projection to the lock node
+		// above the sort node.
+		for _, target := range lockNode.LockTargets {
+			target.PrimaryColIdxInBat = appendLockColToProjects(ctx, target.PrimaryColRelPos, target.PrimaryColIdxInBat, target.PrimaryColTyp)
+			target.PrimaryColRelPos = ctx.projectTag
+			if target.IsPartitionTable {
+				target.FilterColIdxInBat = appendLockColToProjects(ctx, target.FilterColRelPos, target.FilterColIdxInBat, lockFilterTyp)
+				target.FilterColRelPos = ctx.projectTag
+			}
+		}
+	}
+
 	nodeID = builder.appendNode(&plan.Node{
 		NodeType:     plan.Node_PROJECT,
 		ProjectList:  ctx.projects,
@@ -3356,6 +3382,13 @@ func (builder *QueryBuilder) bindSelect(stmt *tree.Select, ctx *BindContext, isR
 		}, ctx)
 	}
 
+	// append LOCK_OP node above the sort node, it takes the limit to lock no
+	// more rows than the limit with SKIP LOCKED.
+	if lockAfterSort {
+		lockNode.Children[0] = nodeID
+		nodeID = builder.appendNode(lockNode, ctx)
+	}
+
 	if limitExpr != nil || offsetExpr != nil {
 		node := builder.qry.Nodes[nodeID]
 
@@ -3828,7 +3861,7 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext, p
 		}
 		subCtx := NewBindContext(builder, ctx)
 		nodeID, err = builder.bindSelect(tbl, subCtx, false)
-		if subCtx.isCorrelated {
+		if subCtx.isCorrelated && !ctx.isLateral {
 			return 0, moerr.NewNYI(builder.GetContext(), "correlated subquery in FROM clause")
 		}
 
@@ -4449,6 +4482,9 @@ func (builder *QueryBuilder) addBinding(nodeID int32, alias tree.AliasClause, ct
 
 		binding = NewBinding(tag, nodeID, node.TableDef.DbName, table, node.TableDef.TblId, cols, colIsHidden, types,
 			util.TableIsClusterTable(node.TableDef.TableType), defaultVals)
+		if node.NodeType == plan.Node_TABLE_SCAN {
+			binding.generated = getVirtualColExprs(node.TableDef, tag)
+		}
 	} else {
 		// Subquery
 		subCtx := builder.ctxByNode[nodeID]
@@ -4516,6 +4552,10 @@ func (builder *QueryBuilder) addBinding(nodeID int32, alias tree.AliasClause, ct
 }
 
 func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindContext) (int32, error) {
+	if right, ok := isLateralTable(tbl.Right); ok {
+		return builder.buildLateralJoin(tbl, right, ctx)
+	}
+
 	joinType := plan.Node_INNER
 
 	switch tbl.JoinType {
@@ -4569,7 +4609,9 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 	}, ctx)
 	node := builder.qry.Nodes[nodeID]
 
-	ctx.binder = NewTableBinder(builder, ctx)
+	binder := NewTableBinder(builder, ctx)
+	binder.allowSubquery = joinType == plan.Node_INNER
+	ctx.binder = binder
 
 	switch cond := tbl.Cond.(type) {
 	case *tree.OnJoinCond:
@@ -4577,7 +4619,18 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 		if err != nil {
 			return 0, err
 		}
-		node.OnList = joinConds
+
+		var subqueryConds []*plan.Expr
+		for _, cond := range joinConds {
+			if hasSubquery(cond) {
+				subqueryConds = append(subqueryConds, cond)
+			} else {
+				node.OnList = append(node.OnList, cond)
+			}
+		}
+		if len(subqueryConds) > 0 {
+			return builder.appendSubqueryFilter(nodeID, subqueryConds, ctx)
+		}
 
 	case *tree.UsingJoinCond:
 		if tbl.JoinType == tree.JOIN_TYPE_CROSS_L2 {
@@ -4632,6 +4685,26 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 	return nodeID, nil
 }
 
+// appendSubqueryFilter appends a FILTER node of the conditions above the node, and
+// flattens their subqueries between them. It is used for the ON condition of an inner
+// join, which may contain subqueries.
+func (builder *QueryBuilder) appendSubqueryFilter(nodeID int32, conds []*plan.Expr, ctx *BindContext) (int32, error) {
+	var err error
+	filterList := make([]*plan.Expr, len(conds))
+	for i, cond := range conds {
+		nodeID, filterList[i], err = builder.flattenSubqueries(nodeID, cond, ctx)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return builder.appendNode(&plan.Node{
+		NodeType:   plan.Node_FILTER,
+		Children:   []int32{nodeID},
+		FilterList: filterList,
+	}, ctx), nil
+}
+
 func (builder *QueryBuilder) buildApplyTable(tbl *tree.ApplyTableExpr, ctx *BindContext) (int32, error) {
 	var applyType plan.Node_ApplyType
 
@@ -4724,6 +4797,10 @@ func (builder *QueryBuilder) buildTableFunction(tbl *tree.TableFunction, ctx *Bi
 		nodeId, err = builder.buildFullTextIndexTokenize(tbl, ctx, exprs, childId)
 	case "stage_list":
 		nodeId, err = builder.buildStageList(tbl, ctx, exprs, childId)
+	case "hnsw_search":
+		nodeId, err = builder.buildHnswSearch(tbl, ctx, exprs, childId)
+	case "table_changes":
+		nodeId, err = builder.buildTableChanges(tbl, ctx, exprs, childId)
 	default:
 		err = moerr.NewNotSupportedf(builder.GetContext(), "table function '%s' not supported", id)
 	}
@@ -528,7 +528,8 @@ func GetConstantValue2(proc *process.Process, expr *plan.Expr, vec *vector.Vecto
 				return false, err
 			}
 		case types.T_varchar, types.T_char, types.T_binary, types.T_varbinary, types.T_text,
-			types.T_blob, types.T_datalink, types.T_json, types.T_array_float32, types.T_array_float64:
+			types.T_blob, types.T_datalink, types.T_json, types.T_array_float32, types.T_array_float64,
+			types.T_array_float16, types.T_array_int8, types.T_array_bit:
 			if val, ok := cExpr.Lit.Value.(*plan.Literal_Sval); ok {
 				val := val.Sval
 				err = vector.AppendBytes(vec, []byte(val), false, proc.Mp())
@@ -423,6 +423,28 @@ func determinShuffleForJoin(n *plan.Node, builder *QueryBuilder) {
 	}
 }
 
+// to determine if minus/intersect need to go shuffle. The rows of both
+// children are shuffled by hash of the same column, so that the equal rows
+// meet in the same bucket.
+func determinShuffleForSetOp(n *plan.Node) {
+	// do not shuffle by default
+	n.Stats.HashmapStats.ShuffleColIdx = -1
+
+	if n.Stats.HashmapStats.HashmapSize < threshHoldForShuffleJoin {
+		return
+	}
+	//for now ,only support integer and string type
+	for i, expr := range n.ProjectList {
+		switch types.T(expr.Typ.Id) {
+		case types.T_int64, types.T_int32, types.T_int16, types.T_uint64, types.T_uint32, types.T_uint16, types.T_varchar, types.T_char, types.T_text:
+			n.Stats.HashmapStats.ShuffleColIdx = int32(i)
+			n.Stats.HashmapStats.ShuffleType = plan.ShuffleType_Hash
+			n.Stats.HashmapStats.Shuffle = true
+			return
+		}
+	}
+}
+
 // find mergegroup or mergegroup->filter node
 func dontShuffle(n *plan.Node, builder *QueryBuilder) bool {
 	if n.NodeType == plan.Node_AGG && !n.Stats.HashmapStats.Shuffle {
@@ -605,6 +627,8 @@ func determineShuffleMethod(nodeID int32, builder *QueryBuilder) {
 		determinShuffleForScan(node, builder)
 	case plan.Node_JOIN:
 		determinShuffleForJoin(node, builder)
+	case plan.Node_MINUS, plan.Node_MINUS_ALL, plan.Node_INTERSECT, plan.Node_INTERSECT_ALL:
+		determinShuffleForSetOp(node)
 	default:
 	}
 }
@@ -21,6 +21,7 @@ import (
 	"unsafe"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/stretchr/testify/require"
 )
 
@@ -256,3 +257,22 @@ func TestGetShuffleDop(t *testing.T) {
 	n = GetShuffleDop(16, 1, 1500000)
 	require.Equal(t, 16, n)
 }
+
+func TestDeterminShuffleForSetOp(t *testing.T) {
+	n := &plan.Node{
+		NodeType: plan.Node_MINUS_ALL,
+		Stats:    &plan.Stats{HashmapStats: &plan.HashMapStats{HashmapSize: 1000}},
+		ProjectList: []*plan.Expr{
+			{Typ: plan.Type{Id: int32(types.T_float64)}},
+			{Typ: plan.Type{Id: int32(types.T_varchar)}},
+		},
+	}
+	determinShuffleForSetOp(n)
+	require.False(t, n.Stats.HashmapStats.Shuffle)
+
+	n.Stats.HashmapStats.HashmapSize = threshHoldForShuffleJoin
+	determinShuffleForSetOp(n)
+	require.True(t, n.Stats.HashmapStats.Shuffle)
+	require.Equal(t, plan.ShuffleType_Hash, n.Stats.HashmapStats.ShuffleType)
+	require.Equal(t, int32(1), n.Stats.HashmapStats.ShuffleColIdx)
+}
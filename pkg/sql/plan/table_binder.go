@@ -47,6 +47,9 @@ func (b *TableBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth
 }
 
 func (b *TableBinder) BindSubquery(astExpr *tree.Subquery, isRoot bool) (*plan.Expr, error) {
+	if b.allowSubquery {
+		return b.baseBindSubquery(astExpr, isRoot)
+	}
 	return nil, moerr.NewNYI(b.GetContext(), "subquery in JOIN condition")
 }
 
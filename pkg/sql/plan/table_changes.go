@@ -0,0 +1,212 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+var table_changes_func_name = "table_changes"
+
+const (
+	// TableChangesCommitTSCol and TableChangesTypeCol are appended to the columns of the table by table_changes.
+	TableChangesCommitTSCol = "_commit_ts"
+	TableChangesTypeCol     = "_change_type"
+
+	TableChangesInsert = "insert"
+	TableChangesDelete = "delete"
+)
+
+// TableChangesParam is the param of table_changes, which is resolved when the query is built.
+type TableChangesParam struct {
+	DbName    string `json:"db"`
+	TableName string `json:"table"`
+	// Cols are the columns of the table returned before the commit ts and the change type.
+	Cols []string `json:"cols"`
+	// From and To are the inclusive range of the commit ts. An empty To is the snapshot ts of the txn.
+	From timestamp.Timestamp `json:"from"`
+	To   timestamp.Timestamp `json:"to"`
+}
+
+// buildTableChanges builds
+//
+//	table_changes('db.tbl', from_ts [, to_ts])
+//
+// which returns the rows of the table inserted or deleted by the txns committed in [from_ts, to_ts]. The arguments
+// must be constants. A timestamp is a datetime string in the time zone of the session, a physical time in nanoseconds
+// as the {MO_TS = ...} hint, or a commit ts returned by table_changes.
+func (builder *QueryBuilder) buildTableChanges(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+	if len(exprs) != 2 && len(exprs) != 3 {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "Invalid number of arguments (NARGS != 2 or 3).")
+	}
+
+	lits := make([]*plan.Literal, len(exprs))
+	for i, expr := range exprs {
+		folded, err := ConstantFold(batch.EmptyForConstFoldBatch, DeepCopyExpr(expr), builder.compCtx.GetProcess(), false, true)
+		if err != nil {
+			return 0, err
+		}
+		if lits[i] = folded.GetLit(); lits[i] == nil || lits[i].Isnull {
+			return 0, moerr.NewInvalidInputf(builder.GetContext(), "table_changes: argument %d must be a constant", i+1)
+		}
+	}
+
+	objRef, tableDef, err := builder.resolveTableChangesTable(lits[0].GetSval())
+	if err != nil {
+		return 0, err
+	}
+
+	param := TableChangesParam{
+		DbName:    objRef.SchemaName,
+		TableName: tableDef.Name,
+	}
+	if param.From, err = builder.resolveTableChangesTS(lits[1]); err != nil {
+		return 0, err
+	}
+	if len(lits) == 3 {
+		if param.To, err = builder.resolveTableChangesTS(lits[2]); err != nil {
+			return 0, err
+		}
+		if param.To.Less(param.From) {
+			return 0, moerr.NewInvalidInput(builder.GetContext(), "table_changes: to_ts is earlier than from_ts")
+		}
+	}
+	if err = builder.checkTableChangesRetention(param.DbName, param.TableName, param.From); err != nil {
+		return 0, err
+	}
+
+	colDefs := make([]*plan.ColDef, 0, len(tableDef.Cols)+2)
+	for _, col := range tableDef.Cols {
+		if col.Hidden {
+			continue
+		}
+		if col.Name == TableChangesCommitTSCol || col.Name == TableChangesTypeCol {
+			return 0, moerr.NewNotSupportedf(builder.GetContext(), "table_changes on the table with column '%s'", col.Name)
+		}
+		// the columns of a deleted row whose image is not found are null
+		typ := col.Typ
+		typ.NotNullable = false
+		typ.AutoIncr = false
+		param.Cols = append(param.Cols, col.Name)
+		colDefs = append(colDefs, &plan.ColDef{
+			Name:       col.Name,
+			OriginName: col.OriginName,
+			Typ:        typ,
+		})
+	}
+	tsTyp, typeTyp := types.T_TS.ToType(), types.T_varchar.ToType()
+	colDefs = append(colDefs,
+		&plan.ColDef{Name: TableChangesCommitTSCol, Typ: makePlan2Type(&tsTyp)},
+		&plan.ColDef{Name: TableChangesTypeCol, Typ: makePlan2Type(&typeTyp)},
+	)
+
+	params, err := json.Marshal(param)
+	if err != nil {
+		return 0, err
+	}
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		// the privilege of the table is checked by the ObjRef
+		ObjRef: objRef,
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name:  table_changes_func_name,
+				Param: params,
+			},
+			Cols: colDefs,
+		},
+		BindingTags: []int32{builder.genNewTag()},
+		Children:    []int32{childId},
+	}
+	return builder.appendNode(node, ctx), nil
+}
+
+func (builder *QueryBuilder) resolveTableChangesTable(name string) (*ObjectRef, *TableDef, error) {
+	dbName, tableName := builder.compCtx.DefaultDatabase(), name
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		dbName, tableName = name[:i], name[i+1:]
+	}
+	if builder.compCtx.GetLowerCaseTableNames() == 1 {
+		dbName, tableName = strings.ToLower(dbName), strings.ToLower(tableName)
+	}
+	if dbName == "" {
+		return nil, nil, moerr.NewNoDB(builder.GetContext())
+	}
+
+	objRef, tableDef := builder.compCtx.Resolve(dbName, tableName, nil)
+	if tableDef == nil {
+		return nil, nil, moerr.NewNoSuchTable(builder.GetContext(), dbName, tableName)
+	}
+	if objRef.PubInfo != nil {
+		return nil, nil, moerr.NewNotSupported(builder.GetContext(), "table_changes on the table of a subscription")
+	}
+	// the rows of a cluster table are not filtered by account
+	if tableDef.TableType != catalog.SystemOrdinaryRel {
+		return nil, nil, moerr.NewNotSupportedf(builder.GetContext(), "table_changes on '%s' which is not an ordinary table", tableName)
+	}
+	return objRef, tableDef, nil
+}
+
+func (builder *QueryBuilder) resolveTableChangesTS(lit *plan.Literal) (ts timestamp.Timestamp, err error) {
+	switch v := lit.Value.(type) {
+	case *plan.Literal_Sval:
+		// the datetime string is in the time zone of the session
+		loc := builder.compCtx.GetProcess().GetSessionInfo().TimeZone
+		if loc == nil {
+			loc = time.Local
+		}
+		var t time.Time
+		if t, err = time.ParseInLocation("2006-01-02 15:04:05.999999999", v.Sval, loc); err == nil {
+			ts = timestamp.Timestamp{PhysicalTime: t.UTC().UnixNano()}
+		} else if ts, err = timestamp.ParseTimestamp(v.Sval); err != nil {
+			return ts, moerr.NewInvalidArg(builder.GetContext(), "table_changes timestamp", v.Sval)
+		}
+	case *plan.Literal_I64Val:
+		ts = timestamp.Timestamp{PhysicalTime: v.I64Val}
+	default:
+		return ts, moerr.NewInvalidArg(builder.GetContext(), "table_changes timestamp", lit.String())
+	}
+	if ts.PhysicalTime <= 0 {
+		return ts, moerr.NewInvalidArg(builder.GetContext(), "table_changes timestamp", ts.DebugString())
+	}
+	return ts, nil
+}
+
+// checkTableChangesRetention checks the changes since from are retained, by the GC TTL, a snapshot or a PITR of the
+// table.
+func (builder *QueryBuilder) checkTableChangesRetention(dbName, tableName string, from timestamp.Timestamp) error {
+	valid, err := builder.compCtx.CheckTableChangesRetained(dbName, tableName, from.PhysicalTime)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return moerr.NewInvalidInputf(builder.GetContext(),
+			"table_changes: the changes of %s.%s since %s are not retained, no corresponding snapshot or pitr",
+			dbName, tableName, from.DebugString())
+	}
+	return nil
+}
@@ -125,6 +125,8 @@ type CompilerContext interface {
 
 	ResolveSnapshotWithSnapshotName(snapshotName string) (*Snapshot, error)
 	CheckTimeStampValid(ts int64) (bool, error)
+	// CheckTableChangesRetained checks the changes of the table since ts are retained by the gc ttl, a snapshot or a pitr
+	CheckTableChangesRetained(dbName, tableName string, ts int64) (bool, error)
 
 	//InitExecuteStmtParam replaces the plan of the EXECUTE by the plan generated by the PREPARE.
 	//return
@@ -179,6 +181,7 @@ type QueryBuilder struct {
 	mysqlCompatible       bool
 	haveOnDuplicateKey    bool // if it's a plan contain onduplicate key node, we can not use some optmize rule
 	isForUpdate           bool // if it's a query plan for update
+	selectLockInfo        *tree.SelectLockInfo
 	isRestore             bool
 	isSkipResolveTableDef bool
 	skipStats             bool
@@ -239,6 +242,7 @@ type BindContext struct {
 	sliding                bool
 	isDistinct             bool
 	isCorrelated           bool
+	isLateral              bool
 	hasSingleRow           bool
 	forceWindows           bool
 	isGroupingSet          bool
@@ -293,6 +297,14 @@ type BindContext struct {
 
 	tmpGroups []*plan.Expr
 
+	// for the left side of a LATERAL join: the columns referenced by the derived
+	// table on the right side, whether it references a column of an outer query,
+	// and the constant columns which are bound as their values
+	lateralLeft     bool
+	lateralRefs     [][2]int32
+	lateralOuterRef bool
+	lateralConsts   map[[2]int32]*plan.Expr
+
 	snapshot *Snapshot
 	// all view keys(dbName#viewName)
 	views []string
@@ -348,6 +360,9 @@ type UpdateBinder struct {
 
 type TableBinder struct {
 	baseBinder
+	// subqueries are allowed in the ON condition of an inner join, where they are
+	// flattened above the join
+	allowSubquery bool
 }
 
 type WhereBinder struct {
@@ -382,6 +397,12 @@ type PartitionBinder struct {
 	baseBinder
 }
 
+type CheckBinder struct {
+	baseBinder
+	// what the bound expression is for, used in error messages
+	target string
+}
+
 // SetBinder for 'set @var = expr'
 type SetBinder struct {
 	baseBinder
@@ -418,6 +439,12 @@ type Binding struct {
 	colIdByName    map[string]int32
 	isClusterTable bool
 	defaults       []string
+	// the expressions of the VIRTUAL generated columns computed when they are
+	// read instead of being read from the table, keyed by column position
+	generated map[int32]*plan.Expr
+	// the expressions reading the COUNT columns of a LATERAL derived table as 0
+	// for the left rows without a match, keyed by column position
+	lateralCounts map[int32]*plan.Expr
 }
 
 const (
@@ -67,6 +67,21 @@ func (mr *MockCompilerContext2MockRecorder) CheckSubscriptionValid(subName, accN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSubscriptionValid", reflect.TypeOf((*MockCompilerContext2)(nil).CheckSubscriptionValid), subName, accName, pubName)
 }
 
+// CheckTableChangesRetained mocks base method.
+func (m *MockCompilerContext2) CheckTableChangesRetained(dbName, tableName string, ts int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckTableChangesRetained", dbName, tableName, ts)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckTableChangesRetained indicates an expected call of CheckTableChangesRetained.
+func (mr *MockCompilerContext2MockRecorder) CheckTableChangesRetained(dbName, tableName, ts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckTableChangesRetained", reflect.TypeOf((*MockCompilerContext2)(nil).CheckTableChangesRetained), dbName, tableName, ts)
+}
+
 // CheckTimeStampValid mocks base method.
 func (m *MockCompilerContext2) CheckTimeStampValid(ts int64) (bool, error) {
 	m.ctrl.T.Helper()
@@ -36,6 +36,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/lock"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -263,6 +264,22 @@ func containsTag(expr *plan.Expr, tag int32) bool {
 	return ret
 }
 
+// containsAssert returns true if the expr calls assert(), which raises an error
+// instead of filtering rows out.
+func containsAssert(expr *plan.Expr) bool {
+	if f, ok := expr.Expr.(*plan.Expr_F); ok {
+		if f.F.Func.ObjName == "assert" {
+			return true
+		}
+		for _, arg := range f.F.Args {
+			if containsAssert(arg) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func replaceColRefs(expr *plan.Expr, tag int32, projects []*plan.Expr) *plan.Expr {
 	switch exprImpl := expr.Expr.(type) {
 	case *plan.Expr_F:
@@ -912,7 +929,7 @@ func getUnionSelects(ctx context.Context, stmt *tree.UnionClause, selects *[]tre
 		}
 	case tree.EXCEPT, tree.UT_MINUS:
 		if stmt.All {
-			return moerr.NewNYI(ctx, "EXCEPT/MINUS ALL clause")
+			*unionTypes = append(*unionTypes, plan.Node_MINUS_ALL)
 		} else {
 			*unionTypes = append(*unionTypes, plan.Node_MINUS)
 		}
@@ -2715,3 +2732,58 @@ func getLockTableAtTheEnd(tableDef *TableDef) bool {
 	}
 	return !strings.HasPrefix(tableDef.Name, catalog.IndexTableNamePrefix)
 }
+
+// isSkipLocked returns true if the locking clause is SKIP LOCKED.
+func isSkipLocked(info *tree.SelectLockInfo) bool {
+	if info == nil {
+		return false
+	}
+	return info.LockType == tree.SelectLockForUpdateSkipLocked ||
+		info.LockType == tree.SelectLockForShareSkipLocked
+}
+
+// appendLockColToProjects appends the column locked to the projection, and
+// returns its position in the projection.
+func appendLockColToProjects(ctx *BindContext, relPos, colPos int32, typ plan.Type) int32 {
+	ctx.projects = append(ctx.projects, &plan.Expr{
+		Typ: typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: relPos,
+				ColPos: colPos,
+			},
+		},
+	})
+	return int32(len(ctx.projects) - 1)
+}
+
+// setSelectLockOptions sets the lock mode and wait policy of the lock target
+// by the locking clause of select ... for update/share.
+func setSelectLockOptions(target *plan.LockTarget, info *tree.SelectLockInfo) {
+	if info == nil {
+		return
+	}
+	switch info.LockType {
+	case tree.SelectLockForShare,
+		tree.SelectLockForShareNoWait,
+		tree.SelectLockForShareSkipLocked:
+		target.Mode = lock.LockMode_Shared
+	}
+	switch info.LockType {
+	case tree.SelectLockForUpdateNoWait,
+		tree.SelectLockForShareNoWait:
+		target.WaitPolicy = lock.WaitPolicy_FastFail
+	case tree.SelectLockForUpdateWaitN:
+		target.WaitTimeout = int64(time.Duration(info.WaitSec) * time.Second)
+		if target.WaitTimeout == 0 {
+			// WAIT 0 is the same as NOWAIT
+			target.WaitPolicy = lock.WaitPolicy_FastFail
+		}
+	case tree.SelectLockForUpdateSkipLocked,
+		tree.SelectLockForShareSkipLocked:
+		target.WaitPolicy = lock.WaitPolicy_SkipLocked
+		// the table lock cannot be skipped, so it would block the workers
+		// which found nothing to lock.
+		target.LockTableAtTheEnd = false
+	}
+}
@@ -220,6 +220,12 @@ func SetBytesToAnyVector(ctx context.Context, val string, row int,
 			return err
 		}
 		return vector.SetBytesAt(vec, row, v, proc.Mp())
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		v, err := types.StringToQuantizedArray(vec.GetType().Oid, val)
+		if err != nil {
+			return err
+		}
+		return vector.SetBytesAt(vec, row, v, proc.Mp())
 	case types.T_json:
 		val, err := function.ConvertJsonBytes([]byte(val))
 		if err != nil {
@@ -538,7 +544,7 @@ func SetInsertValueString(proc *process.Process, numVal *tree.NumVal, typ *types
 
 	checkStrLen := func(s string) ([]byte, error) {
 		destLen := int(typ.Width)
-		if typ.Oid != types.T_text && typ.Oid != types.T_datalink && typ.Oid != types.T_binary && destLen != 0 && !typ.Oid.IsArrayRelate() {
+		if typ.Oid != types.T_text && typ.Oid != types.T_datalink && typ.Oid != types.T_binary && destLen != 0 && !typ.Oid.IsVector() {
 			if utf8.RuneCountInString(s) > destLen {
 				return nil, function.FormatCastErrorForInsertValue(proc.Ctx, s, *typ, fmt.Sprintf("Src length %v is larger than Dest length %v", len(s), destLen))
 			}
@@ -551,7 +557,7 @@ func SetInsertValueString(proc *process.Process, numVal *tree.NumVal, typ *types
 		}
 
 		var v []byte
-		if typ.Oid.IsArrayRelate() {
+		if typ.Oid.IsVector() {
 			// Assuming that input s is of type "[1,2,3]"
 
 			switch typ.Oid {
@@ -578,6 +584,18 @@ func SetInsertValueString(proc *process.Process, numVal *tree.NumVal, typ *types
 				}
 
 				v = types.ArrayToBytes[float64](_v)
+
+			case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+				_v, err := types.StringToQuantizedArray(typ.Oid, s)
+				if err != nil {
+					return nil, err
+				}
+
+				if dim := types.QuantizedArrayDimension(typ.Oid, _v); dim != destLen {
+					return nil, moerr.NewArrayDefMismatchNoCtx(int(typ.Width), dim)
+				}
+
+				v = _v
 			default:
 				return nil, moerr.NewInternalErrorNoCtxf("%s is not supported array type", typ.String())
 
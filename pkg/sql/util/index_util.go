@@ -468,7 +468,7 @@ func serialWithCompacted(vs []*vector.Vector, vec *vector.Vector, proc *process.
 				}
 			}
 		case types.T_json, types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_text,
-			types.T_array_float32, types.T_array_float64, types.T_datalink:
+			types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit, types.T_datalink:
 			// NOTE 1: We will consider T_array as bytes here just like JSON, VARBINARY and BLOB.
 			// If not, we need to define arrayType in types/tuple.go as arrayF32TypeCode, arrayF64TypeCode etc
 			// NOTE 2: vs is []string and not []byte. vs[i] is not of form "[1,2,3]". It is binary string of []float32{1,2,3}
@@ -736,7 +736,7 @@ func compactSingleIndexCol(v *vector.Vector, vec *vector.Vector, proc *process.P
 		}
 		err = vector.AppendFixedList(vec, ns, nil, proc.Mp())
 	case types.T_json, types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob,
-		types.T_array_float32, types.T_array_float64:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		s, area := vector.MustVarlenaRawData(v)
 		ns := make([][]byte, 0, len(s))
 		for i := range s {
@@ -930,7 +930,7 @@ func compactPrimaryCol(v *vector.Vector, vec *vector.Vector, bitMap *nulls.Nulls
 		}
 		err = vector.AppendFixedList(vec, ns, nil, proc.Mp())
 	case types.T_json, types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob,
-		types.T_array_float32, types.T_array_float64:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		s, area := vector.MustVarlenaRawData(v)
 		ns := make([][]byte, 0, len(s))
 		for i := range s {
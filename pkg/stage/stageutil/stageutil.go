@@ -33,22 +33,36 @@ import (
 )
 
 func ExpandSubStage(s stage.StageDef, proc *process.Process) (stage.StageDef, error) {
-	if s.Url.Scheme == stage.STAGE_PROTOCOL {
+	if s.Url.Scheme != stage.STAGE_PROTOCOL {
+		return s, nil
+	}
+	return ExpandStageUrl(s.Url, func(stagename string) (stage.StageDef, error) {
+		return StageLoadCatalog(proc, stagename)
+	})
+}
+
+// StageLoader returns the definition of the stage by its name.
+type StageLoader func(stagename string) (stage.StageDef, error)
+
+// ExpandStageUrl resolves the stage url by the stages returned by load until
+// the url is not a stage url.
+func ExpandStageUrl(u *url.URL, load StageLoader) (stage.StageDef, error) {
+	s := stage.StageDef{Url: u}
+	for s.Url.Scheme == stage.STAGE_PROTOCOL {
 		stagename, prefix, query, err := stage.ParseStageUrl(s.Url)
 		if err != nil {
 			return stage.StageDef{}, err
 		}
 
-		res, err := StageLoadCatalog(proc, stagename)
+		res, err := load(stagename)
 		if err != nil {
 			return stage.StageDef{}, err
 		}
 
 		res.Url = res.Url.JoinPath(prefix)
 		res.Url.RawQuery = query
-		return ExpandSubStage(res, proc)
+		s = res
 	}
-
 	return s, nil
 }
 
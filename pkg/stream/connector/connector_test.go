@@ -237,6 +237,11 @@ func (testTS *testTaskService) QueryCronTask(ctx context.Context, condition ...t
 	panic("implement me")
 }
 
+func (testTS *testTaskService) DeleteCronTask(ctx context.Context, condition ...taskservice.Condition) (int, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
 func (testTS *testTaskService) CreateDaemonTask(ctx context.Context, value task.TaskMetadata, details *task.Details) error {
 	//TODO implement me
 	panic("implement me")
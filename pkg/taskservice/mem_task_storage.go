@@ -195,6 +195,27 @@ func (s *memTaskStorage) UpdateCronTask(ctx context.Context, cron task.CronTask,
 	return 2, nil
 }
 
+func (s *memTaskStorage) DeleteCronTask(ctx context.Context, conds ...Condition) (int, error) {
+	c := newConditions(conds...)
+
+	s.Lock()
+	defer s.Unlock()
+
+	n := 0
+	for id, v := range s.cronTasks {
+		if cond, e := (*c)[CondCronTaskId]; e && !cond.eval(v.ID) {
+			continue
+		}
+		if cond, e := (*c)[CondTaskMetadataId]; e && !cond.eval(v.Metadata.ID) {
+			continue
+		}
+		delete(s.cronTasks, id)
+		delete(s.cronTaskIndexes, v.Metadata.ID)
+		n++
+	}
+	return n, nil
+}
+
 func (s *memTaskStorage) AddDaemonTask(ctx context.Context, tasks ...task.DaemonTask) (int, error) {
 	s.Lock()
 	defer s.Unlock()
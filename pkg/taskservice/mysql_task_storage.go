@@ -111,6 +111,8 @@ var (
 
 	getTriggerTimes = "select trigger_times from sys_cron_task where task_metadata_id=?"
 
+	deleteCronTask = "delete from sys_cron_task where 1=1"
+
 	deleteAsyncTask = "delete from sys_async_task where 1=1"
 
 	insertDaemonTask = "insert into sys_daemon_task (" +
@@ -485,6 +487,21 @@ func (m *mysqlTaskStorage) QueryCronTask(ctx context.Context, condition ...Condi
 	return tasks, nil
 }
 
+func (m *mysqlTaskStorage) DeleteCronTask(ctx context.Context, condition ...Condition) (int, error) {
+	if taskFrameworkDisabled() {
+		return 0, nil
+	}
+	exec, err := m.db.ExecContext(ctx, deleteCronTask+buildWhereClause(newConditions(condition...)))
+	if err != nil {
+		return 0, err
+	}
+	affected, err := exec.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
+
 func (m *mysqlTaskStorage) UpdateCronTask(ctx context.Context, cronTask task.CronTask, asyncTask task.AsyncTask) (int, error) {
 	if taskFrameworkDisabled() {
 		return 0, nil
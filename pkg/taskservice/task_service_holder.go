@@ -280,6 +280,23 @@ func (s *refreshableTaskStorage) QueryCronTask(ctx context.Context, c ...Conditi
 	return v, err
 }
 
+func (s *refreshableTaskStorage) DeleteCronTask(ctx context.Context, c ...Condition) (int, error) {
+	var v int
+	var err error
+	s.mu.RLock()
+	lastAddress := s.mu.lastAddress
+	if s.mu.store == nil {
+		err = ErrNotReady
+	} else if err = s.mu.store.PingContext(ctx); err == nil {
+		v, err = s.mu.store.DeleteCronTask(ctx, c...)
+	}
+	s.mu.RUnlock()
+	if err != nil {
+		s.maybeRefresh(lastAddress)
+	}
+	return v, err
+}
+
 func (s *refreshableTaskStorage) UpdateCronTask(ctx context.Context, cronTask task.CronTask, task task.AsyncTask) (int, error) {
 	var v int
 	var err error
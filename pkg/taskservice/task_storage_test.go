@@ -217,6 +217,40 @@ func TestUpdateCronTask(t *testing.T) {
 	}
 }
 
+func TestDeleteCronTaskWithConditions(t *testing.T) {
+	for name, factory := range storages {
+		t.Run(name, func(t *testing.T) {
+			s := factory(t)
+			defer func() {
+				assert.NoError(t, s.Close())
+			}()
+
+			mustAddTestCronTask(t, s, 3,
+				newTestCronTask("t1", "cron1"),
+				newTestCronTask("t2", "cron2"),
+				newTestCronTask("t3", "cron3"))
+			tasks := mustQueryTestCronTask(t, s, 3)
+
+			mustDeleteTestCronTask(t, s, 0, WithTaskMetadataId(EQ, "t4"))
+			mustDeleteTestCronTask(t, s, 1, WithTaskMetadataId(EQ, "t1"))
+			mustQueryTestCronTask(t, s, 2)
+
+			var id uint64
+			for _, v := range tasks {
+				if v.Metadata.ID == "t2" {
+					id = v.ID
+				}
+			}
+			mustDeleteTestCronTask(t, s, 1, WithCronTaskId(EQ, id))
+			mustDeleteTestCronTask(t, s, 1)
+			mustQueryTestCronTask(t, s, 0)
+
+			// the metadata id can be reused after the cron task is deleted
+			mustAddTestCronTask(t, s, 1, newTestCronTask("t1", "cron1"))
+		})
+	}
+}
+
 func TestAddDaemonTask(t *testing.T) {
 	for name, factory := range storages {
 		t.Run(name, func(t *testing.T) {
@@ -359,6 +393,15 @@ func mustDeleteTestAsyncTask(t *testing.T, s TaskStorage, expectUpdated int, con
 	require.Equal(t, expectUpdated, n)
 }
 
+func mustDeleteTestCronTask(t *testing.T, s TaskStorage, expectDeleted int, conds ...Condition) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	n, err := s.DeleteCronTask(ctx, conds...)
+	require.NoError(t, err)
+	require.Equal(t, expectDeleted, n)
+}
+
 func mustAddTestCronTask(t *testing.T, s TaskStorage, expectAdded int, tasks ...task.CronTask) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*1000)
 	defer cancel()
@@ -297,7 +297,11 @@ type taskMetadataIDCond struct {
 }
 
 func (c *taskMetadataIDCond) eval(v any) bool {
-	return false
+	taskMetadataID, ok := v.(string)
+	if !ok {
+		return false
+	}
+	return compare(c.op, taskMetadataID, c.taskMetadataID)
 }
 
 func (c *taskMetadataIDCond) sql() string {
@@ -535,6 +539,8 @@ type TaskService interface {
 	QueryAsyncTask(context.Context, ...Condition) ([]task.AsyncTask, error)
 	// QueryCronTask query cron tasks by conditions
 	QueryCronTask(context.Context, ...Condition) ([]task.CronTask, error)
+	// DeleteCronTask deletes cron tasks by conditions. The tasks already created by the cron tasks are not deleted.
+	DeleteCronTask(context.Context, ...Condition) (int, error)
 
 	// CreateDaemonTask creates a daemon task that will run in background for long time.
 	CreateDaemonTask(ctx context.Context, value task.TaskMetadata, details *task.Details) error
@@ -615,6 +621,8 @@ type TaskStorage interface {
 	// This update must be transactional and needs to be done conditionally
 	// using CronTask.TriggerTimes and the task.Metadata.ID field.
 	UpdateCronTask(context.Context, task.CronTask, task.AsyncTask) (int, error)
+	// DeleteCronTask deletes cron tasks and returns number of successful deleted
+	DeleteCronTask(context.Context, ...Condition) (int, error)
 
 	// AddDaemonTask adds daemon tasks and returns number of successful added.
 	AddDaemonTask(ctx context.Context, tasks ...task.DaemonTask) (int, error)
@@ -43,6 +43,7 @@ var (
 	defaultShardListenAddress    = "0.0.0.0:22003"
 	defaultShardServiceAddress   = "127.0.0.1:22003"
 	defaultZombieTimeout         = time.Hour
+	defaultXATimeout             = time.Minute * 10
 	defaultDiscoveryTimeout      = time.Second * 30
 	defaultHeatbeatInterval      = time.Second
 	defaultConnectTimeout        = time.Second * 30
@@ -162,6 +163,11 @@ type Config struct {
 		// roll back the transaction.
 		ZombieTimeout toml.Duration `toml:"zombie-timeout"`
 
+		// XATimeout A prepared XA transaction branch timeout. The prepared branch holds its
+		// locks and the memory of its writes, if it is not committed or rolled back by
+		// XA COMMIT or XA ROLLBACK within the specified time, the backend will roll it back.
+		XATimeout toml.Duration `toml:"xa-timeout"`
+
 		// Mode. [Optimistic|Pessimistic], default Pessimistic.
 		Mode string `toml:"mode"`
 
@@ -234,6 +240,9 @@ func (c *Config) Validate() error {
 	if c.Txn.ZombieTimeout.Duration == 0 {
 		c.Txn.ZombieTimeout.Duration = defaultZombieTimeout
 	}
+	if c.Txn.XATimeout.Duration == 0 {
+		c.Txn.XATimeout.Duration = defaultXATimeout
+	}
 	if c.HAKeeper.DiscoveryTimeout.Duration == 0 {
 		c.HAKeeper.DiscoveryTimeout.Duration = defaultDiscoveryTimeout
 	}
@@ -350,6 +359,9 @@ func (c *Config) SetDefaultValue() {
 	if c.Txn.ZombieTimeout.Duration == 0 {
 		c.Txn.ZombieTimeout.Duration = defaultZombieTimeout
 	}
+	if c.Txn.XATimeout.Duration == 0 {
+		c.Txn.XATimeout.Duration = defaultXATimeout
+	}
 	if c.HAKeeper.DiscoveryTimeout.Duration == 0 {
 		c.HAKeeper.DiscoveryTimeout.Duration = defaultDiscoveryTimeout
 	}
@@ -29,6 +29,7 @@ func TestValidate(t *testing.T) {
 	assert.Equal(t, c.ServiceAddress, defaultServiceAddress)
 	assert.Equal(t, StorageTAE, c.Txn.Storage.Backend)
 	assert.Equal(t, defaultZombieTimeout, c.Txn.ZombieTimeout.Duration)
+	assert.Equal(t, defaultXATimeout, c.Txn.XATimeout.Duration)
 	assert.Equal(t, defaultDiscoveryTimeout, c.HAKeeper.DiscoveryTimeout.Duration)
 	assert.Equal(t, defaultHeatbeatInterval, c.HAKeeper.HeatbeatInterval.Duration)
 	assert.Equal(t, defaultHeatbeatTimeout, c.HAKeeper.HeatbeatTimeout.Duration)
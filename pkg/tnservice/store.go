@@ -328,6 +328,7 @@ func (s *store) createReplica(shard metadata.TNShard) error {
 						storage,
 						s.sender,
 						s.cfg.Txn.ZombieTimeout.Duration,
+						s.cfg.Txn.XATimeout.Duration,
 						s.lockTableAllocator,
 					),
 				)
@@ -36,6 +36,7 @@ func (s *store) registerRPCHandlers() {
 	s.server.RegisterMethodHandler(txn.TxnMethod_Write, s.handleWrite)
 	s.server.RegisterMethodHandler(txn.TxnMethod_Commit, s.handleCommit)
 	s.server.RegisterMethodHandler(txn.TxnMethod_Rollback, s.handleRollback)
+	s.server.RegisterMethodHandler(txn.TxnMethod_XARecover, s.handleXARecover)
 
 	// request from other TN node
 	s.server.RegisterMethodHandler(txn.TxnMethod_Prepare, s.handlePrepare)
@@ -134,6 +135,16 @@ func (s *store) handleRollback(ctx context.Context, request *txn.TxnRequest, res
 	return r.service.Rollback(ctx, request, response)
 }
 
+func (s *store) handleXARecover(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+	r := s.validTNShard(ctx, request, response)
+	if r == nil {
+		return nil
+	}
+	r.waitStarted()
+	prepareResponse(request, response)
+	return r.service.XARecover(ctx, request, response)
+}
+
 func (s *store) handlePrepare(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
 	r := s.validTNShard(ctx, request, response)
 	if r == nil {
@@ -203,6 +203,9 @@ type txnClient struct {
 		activeTxns map[string]*txnOperator
 		// FIFO queue for ready to active txn
 		waitActiveTxns []*txnOperator
+		// prepared XA txn branches, which hold the locks of the lockservice
+		// until they are committed or rolled back.
+		xaBranches map[string]TxnOverview
 	}
 
 	abortC chan time.Time
@@ -245,6 +248,7 @@ func NewTxnClient(
 	c.mu.state = paused
 	c.mu.cond = sync.NewCond(&c.mu)
 	c.mu.activeTxns = make(map[string]*txnOperator, 100000)
+	c.mu.xaBranches = make(map[string]TxnOverview)
 	for _, opt := range options {
 		opt(c)
 	}
@@ -253,6 +257,9 @@ func NewTxnClient(
 	if err := c.stopper.RunTask(c.handleMarkActiveTxnAborted); err != nil {
 		panic(err)
 	}
+	if err := c.stopper.RunTask(c.checkXABranches); err != nil {
+		panic(err)
+	}
 	return c
 }
 
@@ -567,6 +574,7 @@ func (client *txnClient) closeTxn(event TxnEvent) {
 
 		delete(client.mu.activeTxns, key)
 		client.removeFromLeakCheck(txn.ID)
+		client.maybeAddXABranchLocked(op, event)
 		if !op.opts.options.UserTxn() {
 			return
 		}
@@ -708,6 +716,11 @@ func (client *txnClient) IterTxns(fn func(TxnOverview) bool) {
 			return
 		}
 	}
+	for _, branch := range client.getXABranches() {
+		if !fn(branch) {
+			return
+		}
+	}
 }
 
 func (client *txnClient) getAllTxnOperators() []*txnOperator {
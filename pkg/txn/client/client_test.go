@@ -280,3 +280,63 @@ func TestOpenTxnWithWaitPausedDisabled(t *testing.T) {
 
 	require.Error(t, c.openTxn(op))
 }
+
+func TestXACommitAndRollback(t *testing.T) {
+	RunTxnTests(func(c TxnClient, sender rpc.TxnSender) {
+		ts := sender.(*testTxnSender)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		txnMeta := txn.TxnMeta{
+			ID:         []byte{1},
+			XID:        []byte("xid"),
+			Status:     txn.TxnStatus_Prepared,
+			PreparedTS: newTestTimestamp(10),
+			TNShards:   []metadata.TNShard{{TNShardRecord: metadata.TNShardRecord{ShardID: 1}}},
+		}
+
+		// the XA txn branch is committed at the commit timestamp of the TN
+		commitTS, err := c.XACommit(ctx, txnMeta)
+		require.NoError(t, err)
+		assert.Equal(t, txnMeta.PreparedTS.Next(), commitTS)
+		assert.Equal(t, commitTS, c.GetLatestCommitTS())
+		requests := ts.getLastRequests()
+		require.Equal(t, 1, len(requests))
+		assert.Equal(t, txn.TxnMethod_CommitTNShard, requests[0].Method)
+
+		require.NoError(t, c.XARollback(ctx, txnMeta))
+		requests = ts.getLastRequests()
+		require.Equal(t, 1, len(requests))
+		assert.Equal(t, txn.TxnMethod_RollbackTNShard, requests[0].Method)
+	})
+}
+
+func TestXAPreparedBranchKeptUntilResolved(t *testing.T) {
+	RunTxnTests(func(c TxnClient, sender rpc.TxnSender) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		op, err := c.New(ctx, newTestTimestamp(0))
+		require.NoError(t, err)
+		tc := op.(*txnOperator)
+		tc.mu.txn.TNShards = append(tc.mu.txn.TNShards, metadata.TNShard{TNShardRecord: metadata.TNShardRecord{ShardID: 1}})
+		require.Error(t, tc.XAPrepare(ctx, nil))
+		require.NoError(t, tc.XAPrepare(ctx, []byte("xid")))
+
+		// the prepared branch is still reported as an active txn of the CN
+		var branches []TxnOverview
+		c.IterTxns(func(to TxnOverview) bool {
+			branches = append(branches, to)
+			return true
+		})
+		require.Equal(t, 1, len(branches))
+		assert.Equal(t, tc.mu.txn.ID, branches[0].Meta.ID)
+		assert.Equal(t, txn.TxnStatus_Prepared, branches[0].Meta.Status)
+
+		require.NoError(t, c.XARollback(ctx, branches[0].Meta))
+		c.IterTxns(func(to TxnOverview) bool {
+			assert.Fail(t, "unexpected txn", to.Meta.DebugString())
+			return true
+		})
+	})
+}
@@ -817,7 +817,7 @@ func (tc *txnOperator) doWrite(ctx context.Context, requests []txn.TxnRequest, c
 
 		if tc.needUnlockLocked() {
 			tc.mu.txn.LockTables = tc.mu.lockTables
-			defer tc.unlock(ctx)
+			defer tc.unlockOnCommit(ctx)
 		}
 	}
 
@@ -843,6 +843,13 @@ func (tc *txnOperator) doWrite(ctx context.Context, requests []txn.TxnRequest, c
 	}
 
 	if commit {
+		xaPrepare := len(tc.mu.txn.XID) > 0
+		if xaPrepare {
+			if err := tc.checkXATNShardsLocked(); err != nil {
+				tc.reset.commitErr = err
+				return nil, err
+			}
+		}
 		if len(tc.mu.txn.TNShards) == 0 { // commit no write handled txn
 			tc.mu.txn.Status = txn.TxnStatus_Committed
 			return nil, nil
@@ -855,6 +862,7 @@ func (tc *txnOperator) doWrite(ctx context.Context, requests []txn.TxnRequest, c
 			CommitRequest: &txn.TxnCommitRequest{
 				Payload:       txnReqs,
 				Disable1PCOpt: tc.opts.options.Is1PCDisabled(),
+				XAPrepare:     xaPrepare,
 			}})
 	}
 	if commit && tc.markAbortedLocked() {
@@ -1023,6 +1031,7 @@ func (tc *txnOperator) doSend(
 		defer tc.mu.Unlock()
 	}
 	tc.mu.txn.CommitTS = resp.Txn.CommitTS
+	tc.mu.txn.PreparedTS = resp.Txn.PreparedTS
 	tc.mu.txn.Status = resp.Txn.Status
 	return result, nil
 }
@@ -1165,9 +1174,13 @@ func (tc *txnOperator) checkResponseTxnStatusForCommit(resp txn.TxnResponse) err
 	switch txnMeta.Status {
 	case txn.TxnStatus_Committed, txn.TxnStatus_Aborted:
 		return nil
-	default:
-		panic(moerr.NewInternalErrorNoCtxf("invalid response status for commit, %v", txnMeta.Status))
+	case txn.TxnStatus_Prepared:
+		// the XA txn branch is prepared instead of committed
+		if len(tc.mu.txn.XID) > 0 {
+			return nil
+		}
 	}
+	panic(moerr.NewInternalErrorNoCtxf("invalid response status for commit, %v", txnMeta.Status))
 }
 
 func (tc *txnOperator) checkResponseTxnStatusForRollback(resp txn.TxnResponse) error {
@@ -124,6 +124,34 @@ func TestCommit(t *testing.T) {
 	})
 }
 
+func TestXAPrepare(t *testing.T) {
+	runOperatorTests(t, func(ctx context.Context, tc *txnOperator, ts *testTxnSender) {
+		tc.mu.txn.TNShards = append(tc.mu.txn.TNShards, metadata.TNShard{TNShardRecord: metadata.TNShardRecord{ShardID: 1}})
+		err := tc.XAPrepare(ctx, []byte("xid"))
+		assert.NoError(t, err)
+		assert.Equal(t, txn.TxnStatus_Prepared, tc.mu.txn.Status)
+		assert.Equal(t, tc.mu.txn.SnapshotTS.Next(), tc.mu.txn.PreparedTS)
+
+		requests := ts.getLastRequests()
+		assert.Equal(t, 1, len(requests))
+		assert.Equal(t, txn.TxnMethod_Commit, requests[0].Method)
+		assert.True(t, requests[0].CommitRequest.XAPrepare)
+		assert.Equal(t, []byte("xid"), requests[0].Txn.XID)
+	})
+}
+
+func TestXAPrepareOnMultiTNShards(t *testing.T) {
+	runOperatorTests(t, func(ctx context.Context, tc *txnOperator, ts *testTxnSender) {
+		tc.mu.txn.TNShards = append(tc.mu.txn.TNShards,
+			metadata.TNShard{TNShardRecord: metadata.TNShardRecord{ShardID: 1}},
+			metadata.TNShard{TNShardRecord: metadata.TNShardRecord{ShardID: 2}})
+		err := tc.XAPrepare(ctx, []byte("xid"))
+		assert.Error(t, err)
+		assert.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+		assert.Empty(t, ts.getLastRequests())
+	})
+}
+
 func TestCommitWithNoWrite(t *testing.T) {
 	runOperatorTests(t, func(ctx context.Context, tc *txnOperator, ts *testTxnSender) {
 		err := tc.Commit(ctx)
@@ -93,8 +93,20 @@ func (ts *testTxnSender) Send(ctx context.Context, requests []txn.TxnRequest) (*
 		case txn.TxnMethod_Rollback:
 			resp.Txn.Status = txn.TxnStatus_Aborted
 		case txn.TxnMethod_Commit:
+			if req.CommitRequest != nil && req.CommitRequest.XAPrepare {
+				resp.Txn.PreparedTS = resp.Txn.SnapshotTS.Next()
+				resp.Txn.Status = txn.TxnStatus_Prepared
+				break
+			}
 			resp.Txn.CommitTS = resp.Txn.SnapshotTS.Next()
 			resp.Txn.Status = txn.TxnStatus_Committed
+		case txn.TxnMethod_CommitTNShard:
+			resp.Txn.CommitTS = resp.Txn.PreparedTS.Next()
+			resp.Txn.Status = txn.TxnStatus_Committed
+		case txn.TxnMethod_RollbackTNShard:
+			resp.Txn.Status = txn.TxnStatus_Aborted
+		case txn.TxnMethod_XARecover:
+			resp.XARecoverResponse = &txn.TxnXARecoverResponse{}
 		}
 
 		responses = append(responses, resp)
@@ -92,10 +92,19 @@ type TxnClient interface {
 	RefreshExpressionEnabled() bool
 	// CNBasedConsistencyEnabled return true if cn based consistency feature enabled
 	CNBasedConsistencyEnabled() bool
-	// IterTxns iter all txns
+	// IterTxns iter all txns, including the prepared XA txn branches which
+	// hold the locks of the CN
 	IterTxns(func(TxnOverview) bool)
 	// GetState returns the current state of txn client.
 	GetState() TxnState
+
+	// XARecover returns the prepared XA transaction branches of all TNs.
+	XARecover(ctx context.Context) ([]txn.TxnMeta, error)
+	// XACommit commits the prepared XA transaction branch, and returns the commit
+	// timestamp.
+	XACommit(ctx context.Context, txnMeta txn.TxnMeta) (timestamp.Timestamp, error)
+	// XARollback rollbacks the prepared XA transaction branch.
+	XARollback(ctx context.Context, txnMeta txn.TxnMeta) error
 }
 
 type TxnState struct {
@@ -171,6 +180,10 @@ type TxnOperator interface {
 	Commit(ctx context.Context) error
 	// Rollback the transaction.
 	Rollback(ctx context.Context) error
+	// XAPrepare prepares the transaction as a XA transaction branch with the xid,
+	// instead of commit. The prepared branch is kept by TN until it is committed
+	// or rollbacked by the TxnClient, and the TxnOperator is closed after prepare.
+	XAPrepare(ctx context.Context, xid []byte) error
 
 	// AddLockTable for pessimistic transactions, if the current transaction is successfully
 	// locked, the metadata corresponding to the lockservice needs to be recorded to the txn, and
@@ -275,6 +288,13 @@ type Workspace interface {
 	// RollbackLastStatement rollback the last statement.
 	RollbackLastStatement(ctx context.Context) error
 
+	// Savepoint sets a savepoint between statements, and replaces the existing one with the same name.
+	Savepoint(ctx context.Context, name string) error
+	// RollbackToSavepoint rollback the statements after the savepoint, and removes the savepoints set after it.
+	RollbackToSavepoint(ctx context.Context, name string) error
+	// ReleaseSavepoint removes the savepoint and the savepoints set after it.
+	ReleaseSavepoint(ctx context.Context, name string) error
+
 	UpdateSnapshotWriteOffset()
 	GetSnapshotWriteOffset() int
 
@@ -0,0 +1,269 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/clusterservice"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/txn/rpc"
+	"github.com/matrixorigin/matrixone/pkg/txn/util"
+)
+
+var (
+	// defaultXABranchCheckInterval is the interval to check whether the
+	// prepared XA txn branches holding the locks of the CN are still prepared.
+	defaultXABranchCheckInterval = time.Minute
+)
+
+func (tc *txnOperator) XAPrepare(ctx context.Context, xid []byte) error {
+	if len(xid) == 0 {
+		return moerr.NewInvalidInputNoCtx("XA prepare with empty xid")
+	}
+
+	tc.mu.Lock()
+	tc.mu.txn.XID = xid
+	tc.mu.Unlock()
+	return tc.Commit(ctx)
+}
+
+// unlockOnCommit releases the locks of the txn after commit. The locks of the
+// prepared XA txn branch are kept until the branch is committed or rolled back
+// by XA COMMIT or XA ROLLBACK.
+func (tc *txnOperator) unlockOnCommit(ctx context.Context) {
+	if len(tc.mu.txn.XID) > 0 &&
+		tc.mu.txn.Status == txn.TxnStatus_Prepared {
+		return
+	}
+	tc.unlock(ctx)
+}
+
+// checkXATNShardsLocked checks the TN shards of the XA txn branch. The branch
+// can only be prepared on one TN shard. The read only branch is prepared on a
+// TN shard too, so that it can be found by XA RECOVER.
+func (tc *txnOperator) checkXATNShardsLocked() error {
+	switch len(tc.mu.txn.TNShards) {
+	case 0:
+		shards := getTNShards(tc.sid)
+		if len(shards) == 0 {
+			return moerr.NewTNShardNotFoundNoCtx("", 0)
+		}
+		tc.addPartitionLocked(shards[0])
+	case 1:
+	default:
+		return moerr.NewNotSupportedNoCtx("XA transaction on multiple TN shards")
+	}
+	return nil
+}
+
+func (client *txnClient) XARecover(ctx context.Context) ([]txn.TxnMeta, error) {
+	shards := getTNShards(client.sid)
+	if len(shards) == 0 {
+		return nil, nil
+	}
+
+	requests := make([]txn.TxnRequest, 0, len(shards))
+	for _, shard := range shards {
+		requests = append(requests, txn.TxnRequest{
+			Method:           txn.TxnMethod_XARecover,
+			XARecoverRequest: &txn.TxnXARecoverRequest{TNShard: shard},
+		})
+	}
+	result, err := client.sendXARequests(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Release()
+
+	// the XA txn branch is committed or rolled back on the TN shard it
+	// returned from, which has the address can be reached by the CN.
+	var txns []txn.TxnMeta
+	for i, resp := range result.Responses {
+		for _, txnMeta := range resp.XARecoverResponse.Txns {
+			txnMeta.TNShards = []metadata.TNShard{shards[i]}
+			txns = append(txns, txnMeta)
+		}
+	}
+	return txns, nil
+}
+
+func (client *txnClient) XACommit(ctx context.Context, txnMeta txn.TxnMeta) (timestamp.Timestamp, error) {
+	// the XA txn branch is committed at the commit timestamp assigned by the
+	// TN, the logtail of the branch is collected at commit.
+	txnMeta.CommitTS = timestamp.Timestamp{}
+	util.LogTxnCommit(client.logger, txnMeta)
+	result, err := client.sendXARequests(ctx, []txn.TxnRequest{{
+		Method:               txn.TxnMethod_CommitTNShard,
+		Txn:                  txnMeta,
+		CommitTNShardRequest: &txn.TxnCommitTNShardRequest{TNShard: txnMeta.TNShards[0]},
+	}})
+	if err != nil {
+		return timestamp.Timestamp{}, err
+	}
+	if resp := result.Responses[0]; resp.Txn != nil {
+		txnMeta.CommitTS = resp.Txn.CommitTS
+	}
+	result.Release()
+
+	client.updateLastCommitTS(TxnEvent{Txn: txnMeta})
+	client.releaseXABranch(ctx, txnMeta.ID, txnMeta.CommitTS)
+	return txnMeta.CommitTS, nil
+}
+
+func (client *txnClient) XARollback(ctx context.Context, txnMeta txn.TxnMeta) error {
+	util.LogTxnRollback(client.logger, txnMeta)
+	result, err := client.sendXARequests(ctx, []txn.TxnRequest{{
+		Method:                 txn.TxnMethod_RollbackTNShard,
+		Txn:                    txnMeta,
+		RollbackTNShardRequest: &txn.TxnRollbackTNShardRequest{TNShard: txnMeta.TNShards[0]},
+	}})
+	if err != nil {
+		return err
+	}
+	result.Release()
+
+	client.releaseXABranch(ctx, txnMeta.ID, txnMeta.PreparedTS)
+	return nil
+}
+
+func (client *txnClient) sendXARequests(ctx context.Context, requests []txn.TxnRequest) (*rpc.SendResult, error) {
+	util.LogTxnSendRequests(client.logger, requests)
+	result, err := client.sender.Send(ctx, requests)
+	if err != nil {
+		util.LogTxnSendRequestsFailed(client.logger, requests, err)
+		return nil, err
+	}
+	util.LogTxnReceivedResponses(client.logger, result.Responses)
+
+	for _, resp := range result.Responses {
+		if resp.TxnError != nil {
+			result.Release()
+			return nil, resp.TxnError.UnwrapError()
+		}
+	}
+	return result, nil
+}
+
+// maybeAddXABranchLocked keeps the prepared XA txn branch closed on the CN, the
+// branch is reported as an active txn to the lockservice until it is released,
+// so its locks are not released as the locks of an orphan txn.
+func (client *txnClient) maybeAddXABranchLocked(op *txnOperator, event TxnEvent) {
+	if len(event.Txn.XID) == 0 ||
+		event.Txn.Status != txn.TxnStatus_Prepared ||
+		event.Err != nil {
+		return
+	}
+	client.mu.xaBranches[string(event.Txn.ID)] = TxnOverview{
+		CreateAt: op.reset.createAt,
+		Meta:     event.Txn,
+		UserTxn:  op.opts.options.UserTxn(),
+	}
+}
+
+func (client *txnClient) getXABranches() []TxnOverview {
+	client.mu.RLock()
+	defer client.mu.RUnlock()
+	branches := make([]TxnOverview, 0, len(client.mu.xaBranches))
+	for _, branch := range client.mu.xaBranches {
+		branches = append(branches, branch)
+	}
+	return branches
+}
+
+// releaseXABranch releases the locks of the XA txn branch prepared on the CN
+// with the commit timestamp of the branch.
+func (client *txnClient) releaseXABranch(ctx context.Context, txnID []byte, commitTS timestamp.Timestamp) {
+	client.mu.Lock()
+	branch, ok := client.mu.xaBranches[string(txnID)]
+	delete(client.mu.xaBranches, string(txnID))
+	client.mu.Unlock()
+	if !ok || client.lockService == nil {
+		return
+	}
+	if err := client.lockService.Unlock(ctx, txnID, commitTS); err != nil {
+		client.logger.Error("failed to unlock XA txn branch",
+			util.TxnField(branch.Meta),
+			zap.Error(err))
+	}
+}
+
+// checkXABranches releases the locks of the prepared XA txn branches which are
+// no longer prepared on the TN, they are committed or rolled back on other CNs,
+// or rolled back by the TN for timeout.
+func (client *txnClient) checkXABranches(ctx context.Context) {
+	timer := time.NewTicker(defaultXABranchCheckInterval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			client.doCheckXABranches(ctx)
+		}
+	}
+}
+
+func (client *txnClient) doCheckXABranches(ctx context.Context) {
+	branches := client.getXABranches()
+	if len(branches) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeoutCause(ctx, defaultXABranchCheckInterval, moerr.CauseCheckXABranches)
+	defer cancel()
+	txns, err := client.XARecover(ctx)
+	if err != nil {
+		client.logger.Error("failed to check XA txn branches",
+			zap.Error(moerr.AttachCause(ctx, err)))
+		return
+	}
+	prepared := make(map[string]struct{}, len(txns))
+	for _, txnMeta := range txns {
+		prepared[string(txnMeta.ID)] = struct{}{}
+	}
+	// the commit timestamp of the branch resolved on other CNs is unknown,
+	// it is before the current time.
+	now, _ := client.clock.Now()
+	for _, branch := range branches {
+		if _, ok := prepared[string(branch.Meta.ID)]; !ok {
+			client.releaseXABranch(ctx, branch.Meta.ID, now)
+		}
+	}
+}
+
+// getTNShards returns all the TN shards in the cluster.
+func getTNShards(sid string) []metadata.TNShard {
+	var shards []metadata.TNShard
+	clusterservice.GetMOCluster(sid).GetTNService(
+		clusterservice.NewSelector(),
+		func(tn metadata.TNService) bool {
+			for _, info := range tn.Shards {
+				shards = append(shards, metadata.TNShard{
+					TNShardRecord: metadata.TNShardRecord{ShardID: info.ShardID},
+					ReplicaID:     info.ReplicaID,
+					Address:       tn.TxnServiceAddress,
+				})
+			}
+			return true
+		})
+	return shards
+}
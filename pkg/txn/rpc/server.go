@@ -43,6 +43,7 @@ var methodVersions = map[txn.TxnMethod]int64{
 	txn.TxnMethod_CommitTNShard:   defines.MORPCVersion1,
 	txn.TxnMethod_RollbackTNShard: defines.MORPCVersion1,
 	txn.TxnMethod_GetStatus:       defines.MORPCVersion1,
+	txn.TxnMethod_XARecover:       defines.MORPCVersion4,
 
 	txn.TxnMethod_DEBUG: defines.MORPCVersion1,
 }
@@ -62,9 +62,12 @@ type service struct {
 	// threshold in the map, it is cleaned up.
 	transactions  sync.Map // string(txn.id) -> txnContext
 	zombieTimeout time.Duration
-	pool          sync.Pool
-	recoveryC     chan struct{}
-	txnC          chan txn.TxnMeta
+	// xaTimeout is the timeout of the prepared XA txn branch, which is rolled
+	// back by gcZombieTxn. No timeout if it is 0.
+	xaTimeout time.Duration
+	pool      sync.Pool
+	recoveryC chan struct{}
+	txnC      chan txn.TxnMeta
 }
 
 // NewTxnService create TxnService
@@ -74,6 +77,7 @@ func NewTxnService(
 	storage storage.TxnStorage,
 	sender rpc.TxnSender,
 	zombieTimeout time.Duration,
+	xaTimeout time.Duration,
 	allocator lockservice.LockTableAllocator,
 ) TxnService {
 	logger := util.GetLogger(sid)
@@ -92,6 +96,7 @@ func NewTxnService(
 			shard.ReplicaID),
 			stopper.WithLogger(logger.RawLogger())),
 		zombieTimeout: zombieTimeout,
+		xaTimeout:     xaTimeout,
 		recoveryC:     make(chan struct{}),
 		txnC:          make(chan txn.TxnMeta, 16),
 		allocator:     allocator,
@@ -135,7 +140,7 @@ func (s *service) gcZombieTxn(ctx context.Context) {
 	timer := time.NewTicker(s.zombieTimeout)
 	defer timer.Stop()
 
-	var cleanTxns []txn.TxnMeta
+	var cleanTxns, xaTxns []txn.TxnMeta
 	for {
 		select {
 		case <-ctx.Done():
@@ -144,6 +149,13 @@ func (s *service) gcZombieTxn(ctx context.Context) {
 			s.transactions.Range(func(_, value any) bool {
 				txnCtx := value.(*txnContext)
 				txnMeta := txnCtx.getTxn()
+				// XA txn branch waits for the XA COMMIT or XA ROLLBACK from CN until timeout.
+				if isXATxn(txnMeta) {
+					if s.isXATimeout(txnMeta) {
+						xaTxns = append(xaTxns, txnMeta)
+					}
+					return true
+				}
 				// if a txn is not a distributed txn coordinator, wait coordinator dnshard.
 				if len(txnMeta.TNShards) == 0 ||
 					(len(txnMeta.TNShards) > 0 && s.shard.ShardID != txnMeta.TNShards[0].ShardID) {
@@ -156,6 +168,10 @@ func (s *service) gcZombieTxn(ctx context.Context) {
 				}
 				return true
 			})
+			for _, txnMeta := range xaTxns {
+				s.rollbackTimeoutXATxn(ctx, txnMeta)
+			}
+			xaTxns = xaTxns[:0]
 			for _, txnMeta := range cleanTxns {
 				req := &txn.TxnRequest{
 					Method:          txn.TxnMethod_Rollback,
@@ -216,6 +216,10 @@ func (s *service) Commit(ctx context.Context, request *txn.TxnRequest, response
 		}
 	}
 
+	if request.CommitRequest != nil && request.CommitRequest.XAPrepare {
+		return s.prepareXA(ctx, request, response)
+	}
+
 	txnID := request.Txn.ID
 	txnCtx := s.getTxnContext(txnID)
 	if txnCtx == nil {
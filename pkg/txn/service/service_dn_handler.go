@@ -147,10 +147,16 @@ func (s *service) CommitTNShard(ctx context.Context, request *txn.TxnRequest, re
 	}
 
 	newTxn.CommitTS = request.Txn.CommitTS
-	if _, err := s.storage.Commit(ctx, newTxn); err != nil {
+	cts, err := s.storage.Commit(ctx, newTxn)
+	if err != nil {
 		response.TxnError = txn.WrapError(err, moerr.ErrTAECommit)
 		return nil
 	}
+	// the XA txn branch is committed at the commit timestamp assigned by the
+	// storage, which is after the logtail published while it was prepared.
+	if isXATxn(newTxn) {
+		newTxn.CommitTS = cts
+	}
 	txnCtx.updateTxnLocked(newTxn)
 
 	newTxn.Status = txn.TxnStatus_Committed
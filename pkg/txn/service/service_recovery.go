@@ -17,6 +17,7 @@ package service
 import (
 	"context"
 
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/txn/util"
 	"go.uber.org/zap"
@@ -47,6 +48,10 @@ func (s *service) doRecovery(ctx context.Context) {
 }
 
 func (s *service) addLog(txnMeta txn.TxnMeta) {
+	if isXATxn(txnMeta) {
+		s.addXALog(txnMeta)
+		return
+	}
 	if len(txnMeta.TNShards) <= 1 {
 		return
 	}
@@ -89,12 +94,30 @@ func (s *service) addLog(txnMeta txn.TxnMeta) {
 	}
 }
 
+// addXALog adds the XA txn branch which is prepared before restart, it is kept
+// until the XA COMMIT or XA ROLLBACK.
+func (s *service) addXALog(txnMeta txn.TxnMeta) {
+	switch txnMeta.Status {
+	case txn.TxnStatus_Prepared:
+		s.checkRecoveryStatus(txnMeta)
+		txnMeta.TNShards = []metadata.TNShard{s.shard}
+		if txnCtx, added := s.maybeAddTxn(txnMeta); !added {
+			txnCtx.updateTxn(txnMeta)
+		}
+	case txn.TxnStatus_Committed, txn.TxnStatus_Aborted:
+		s.removeTxn(txnMeta.ID)
+	default:
+		s.logger.Fatal("invalid XA recovery status",
+			util.TxnField(txnMeta))
+	}
+}
+
 func (s *service) end() {
 	defer close(s.recoveryC)
 	s.transactions.Range(func(_, value any) bool {
 		txnCtx := value.(*txnContext)
 		txnMeta := txnCtx.getTxn()
-		if !s.shard.Equal(txnMeta.TNShards[0]) {
+		if isXATxn(txnMeta) || !s.shard.Equal(txnMeta.TNShards[0]) {
 			return true
 		}
 
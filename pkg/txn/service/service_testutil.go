@@ -123,6 +123,7 @@ func NewTestTxnServiceWithLogAndZombieAndLockTableAllocator(
 		NewTestTxnStorage(log, clock),
 		sender,
 		zombie,
+		0,
 		allocator,
 	).(*service)
 }
@@ -193,6 +194,7 @@ func (s *TestSender) AddTxnService(ts TxnService) {
 	s.router[s.getRouteKey(txn.TxnMethod_GetStatus, ts.Shard())] = ts.GetStatus
 	s.router[s.getRouteKey(txn.TxnMethod_CommitTNShard, ts.Shard())] = ts.CommitTNShard
 	s.router[s.getRouteKey(txn.TxnMethod_RollbackTNShard, ts.Shard())] = ts.RollbackTNShard
+	s.router[s.getRouteKey(txn.TxnMethod_XARecover, ts.Shard())] = ts.XARecover
 	s.router[s.getRouteKey(txn.TxnMethod_DEBUG, ts.Shard())] = ts.Debug
 }
 
@@ -289,6 +291,26 @@ func NewTestCommitRequest(wTxn txn.TxnMeta) txn.TxnRequest {
 	}
 }
 
+// NewTestXAPrepareRequest create a commit request which prepares the txn as a XA txn branch
+func NewTestXAPrepareRequest(wTxn txn.TxnMeta, xid []byte) txn.TxnRequest {
+	wTxn.XID = xid
+	return txn.TxnRequest{
+		Method:        txn.TxnMethod_Commit,
+		Txn:           wTxn,
+		CommitRequest: &txn.TxnCommitRequest{XAPrepare: true},
+	}
+}
+
+// NewTestXARecoverRequest create a xa recover request
+func NewTestXARecoverRequest(shard uint64) txn.TxnRequest {
+	return txn.TxnRequest{
+		Method: txn.TxnMethod_XARecover,
+		XARecoverRequest: &txn.TxnXARecoverRequest{
+			TNShard: NewTestTNShard(shard),
+		},
+	}
+}
+
 // NewTestCommitShardRequest create a commit DNShard request
 func NewTestCommitShardRequest(wTxn txn.TxnMeta) txn.TxnRequest {
 	return txn.TxnRequest{
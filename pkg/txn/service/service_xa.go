@@ -0,0 +1,138 @@
+// Copyright 2021 - 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/txn/util"
+)
+
+// prepareXA prepares the txn as a XA txn branch. Unlike the 2PC txn, there is
+// no coordinator for the XA txn branch, the branch is kept in Prepared status
+// until the CN commits or rollbacks it by CommitTNShard or RollbackTNShard.
+func (s *service) prepareXA(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+	if len(request.Txn.XID) == 0 {
+		s.logger.Fatal("prepare XA txn branch with empty xid")
+	}
+
+	// the read only XA txn branch has no write request before prepare.
+	txnCtx, _ := s.maybeAddTxn(request.Txn)
+
+	txnCtx.mu.Lock()
+	defer txnCtx.mu.Unlock()
+
+	newTxn := txnCtx.getTxnLocked()
+	if !bytes.Equal(newTxn.ID, request.Txn.ID) {
+		util.LogTxnNotFoundOn(s.logger, request.Txn, s.shard)
+		response.TxnError = txn.WrapError(moerr.NewTxnNotFound(ctx), 0)
+		return nil
+	}
+
+	response.Txn = &newTxn
+	if newTxn.Status != txn.TxnStatus_Active {
+		util.LogTxnCommitOnInvalidStatus(s.logger, newTxn)
+		response.TxnError = txn.WrapError(moerr.NewTxnNotActive(ctx, ""), 0)
+		return nil
+	}
+
+	newTxn.XID = request.Txn.XID
+	newTxn.TNShards = []metadata.TNShard{s.shard}
+	ts, err := s.storage.Prepare(ctx, newTxn)
+	if err != nil {
+		response.TxnError = txn.WrapError(err, moerr.ErrTAEPrepare)
+		if err := s.storage.Rollback(ctx, newTxn); err != nil {
+			s.logger.Error("rollback failed",
+				util.TxnIDFieldWithID(newTxn.ID),
+				zap.Error(err))
+		}
+		newTxn.Status = txn.TxnStatus_Aborted
+		txnCtx.changeStatusLocked(txn.TxnStatus_Aborted)
+		s.removeTxn(newTxn.ID)
+		s.releaseTxnContext(txnCtx)
+		return nil
+	}
+	newTxn.PreparedTS = ts
+	txnCtx.updateTxnLocked(newTxn)
+
+	newTxn.Status = txn.TxnStatus_Prepared
+	txnCtx.changeStatusLocked(txn.TxnStatus_Prepared)
+	return nil
+}
+
+func (s *service) XARecover(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+	s.waitRecoveryCompleted()
+
+	util.LogTxnHandleRequest(s.logger, request)
+	defer util.LogTxnHandleResult(s.logger, response)
+
+	response.XARecoverResponse = &txn.TxnXARecoverResponse{}
+	if !s.validTNShard(request.GetTargetTN()) {
+		response.TxnError = txn.WrapError(moerr.NewTNShardNotFound(ctx, "", request.GetTargetTN().ShardID), 0)
+		return nil
+	}
+
+	s.transactions.Range(func(_, value any) bool {
+		txnMeta := value.(*txnContext).getTxn()
+		if isXATxn(txnMeta) && txnMeta.Status == txn.TxnStatus_Prepared {
+			response.XARecoverResponse.Txns = append(response.XARecoverResponse.Txns, txnMeta)
+		}
+		return true
+	})
+	return nil
+}
+
+func isXATxn(txnMeta txn.TxnMeta) bool {
+	return len(txnMeta.XID) > 0
+}
+
+// isXATimeout returns true if the XA txn branch is prepared before the timeout.
+// The prepared branch holds its locks and the memory of its writes, so it can
+// not be kept prepared forever.
+func (s *service) isXATimeout(txnMeta txn.TxnMeta) bool {
+	if s.xaTimeout == 0 || txnMeta.Status != txn.TxnStatus_Prepared {
+		return false
+	}
+	return time.Since(time.Unix(0, txnMeta.PreparedTS.PhysicalTime)) > s.xaTimeout
+}
+
+func (s *service) rollbackTimeoutXATxn(ctx context.Context, txnMeta txn.TxnMeta) {
+	s.logger.Warn("rollback timeout XA txn branch",
+		util.TxnField(txnMeta),
+		zap.Duration("timeout", s.xaTimeout))
+	req := &txn.TxnRequest{
+		Method:                 txn.TxnMethod_RollbackTNShard,
+		Txn:                    txnMeta,
+		RollbackTNShardRequest: &txn.TxnRollbackTNShardRequest{TNShard: s.shard},
+	}
+	resp := &txn.TxnResponse{}
+	if err := s.RollbackTNShard(ctx, req, resp); err != nil || resp.TxnError != nil {
+		txnError := ""
+		if resp.TxnError != nil {
+			txnError = resp.TxnError.DebugString()
+		}
+		s.logger.Error("rollback timeout XA txn branch failed",
+			util.TxnField(txnMeta),
+			zap.String("txn-err", txnError),
+			zap.Error(err))
+	}
+}
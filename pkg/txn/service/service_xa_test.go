@@ -0,0 +1,179 @@
+// Copyright 2021 - 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/txn/rpc"
+	"github.com/matrixorigin/matrixone/pkg/txn/storage/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXAPrepareAndCommit(t *testing.T) {
+	sender := NewTestSender()
+	defer func() {
+		assert.NoError(t, sender.Close())
+	}()
+
+	s := NewTestTxnService(t, 1, sender, NewTestClock(1)).(*service)
+	assert.NoError(t, s.Start())
+	defer func() {
+		assert.NoError(t, s.Close(false))
+	}()
+	sender.AddTxnService(s)
+
+	wTxn := NewTestTxn(1, 1, 1)
+	checkResponses(t, writeTestData(t, sender, 1, wTxn, 1, 2))
+	responses := xaPrepareTestTxn(t, sender, wTxn, []byte("xid"))
+	checkResponses(t, responses)
+	require.NotNil(t, responses[0].Txn)
+	assert.Equal(t, txn.TxnStatus_Prepared, responses[0].Txn.Status)
+	assert.False(t, responses[0].Txn.PreparedTS.IsEmpty())
+
+	prepared := xaRecoverTestTxns(t, sender, 1)
+	require.Equal(t, 1, len(prepared))
+	assert.Equal(t, wTxn.ID, prepared[0].ID)
+	assert.Equal(t, []byte("xid"), prepared[0].XID)
+
+	// the XA txn branch is committed at the commit timestamp assigned by the
+	// storage, after the prepare timestamp
+	wTxn = prepared[0]
+	responses = commitShardWriteData(t, sender, wTxn)
+	checkResponses(t, responses)
+	cts := responses[0].Txn.CommitTS
+	assert.True(t, wTxn.PreparedTS.Less(cts))
+	checkData(t, wTxn, s, cts.PhysicalTime, 1, true)
+	checkData(t, wTxn, s, cts.PhysicalTime, 2, true)
+	assert.Empty(t, xaRecoverTestTxns(t, sender, 1))
+}
+
+func TestXAPrepareAndRollback(t *testing.T) {
+	sender := NewTestSender()
+	defer func() {
+		assert.NoError(t, sender.Close())
+	}()
+
+	s := NewTestTxnService(t, 1, sender, NewTestClock(1)).(*service)
+	assert.NoError(t, s.Start())
+	defer func() {
+		assert.NoError(t, s.Close(false))
+	}()
+	sender.AddTxnService(s)
+
+	wTxn := NewTestTxn(1, 1, 1)
+	checkResponses(t, writeTestData(t, sender, 1, wTxn, 1))
+	checkResponses(t, xaPrepareTestTxn(t, sender, wTxn, []byte("xid")))
+
+	prepared := xaRecoverTestTxns(t, sender, 1)
+	require.Equal(t, 1, len(prepared))
+	checkResponses(t, rollbackShardWriteData(t, sender, prepared[0]))
+	checkData(t, wTxn, s, 0, 1, false)
+	assert.Empty(t, xaRecoverTestTxns(t, sender, 1))
+}
+
+func TestXAPrepareCannotBeCleanedAsZombie(t *testing.T) {
+	sender := NewTestSender()
+	defer func() {
+		assert.NoError(t, sender.Close())
+	}()
+
+	s := NewTestTxnServiceWithLogAndZombie(t, 1, sender, NewTestClock(1), nil, time.Millisecond).(*service)
+	assert.NoError(t, s.Start())
+	defer func() {
+		assert.NoError(t, s.Close(false))
+	}()
+	sender.AddTxnService(s)
+
+	wTxn := NewTestTxn(1, 1, 1)
+	checkResponses(t, writeTestData(t, sender, 1, wTxn, 1))
+	checkResponses(t, xaPrepareTestTxn(t, sender, wTxn, []byte("xid")))
+
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, 1, len(xaRecoverTestTxns(t, sender, 1)))
+}
+
+func TestXAPrepareRollbackedAfterTimeout(t *testing.T) {
+	sender := NewTestSender()
+	defer func() {
+		assert.NoError(t, sender.Close())
+	}()
+
+	s := NewTestTxnServiceWithLogAndZombie(t, 1, sender, NewTestClock(1), nil, time.Millisecond).(*service)
+	// the prepare timestamp of the test clock is long before the timeout
+	s.xaTimeout = time.Hour
+	assert.NoError(t, s.Start())
+	defer func() {
+		assert.NoError(t, s.Close(false))
+	}()
+	sender.AddTxnService(s)
+
+	wTxn := NewTestTxn(1, 1, 1)
+	checkResponses(t, writeTestData(t, sender, 1, wTxn, 1))
+	checkResponses(t, xaPrepareTestTxn(t, sender, wTxn, []byte("xid")))
+
+	for len(xaRecoverTestTxns(t, sender, 1)) > 0 {
+		time.Sleep(time.Millisecond * 10)
+	}
+	checkData(t, wTxn, s, 0, 1, false)
+}
+
+func TestRecoveryFromXAPrepared(t *testing.T) {
+	mlog := mem.NewMemLog()
+	wTxn := NewTestTxn(1, 1)
+	wTxn.XID = []byte("xid")
+	wTxn.Status = txn.TxnStatus_Prepared
+	wTxn.PreparedTS = NewTestTimestamp(2)
+	addLog(t, mlog, wTxn, 1)
+
+	sender := NewTestSender()
+	defer func() {
+		assert.NoError(t, sender.Close())
+	}()
+
+	s := NewTestTxnServiceWithLog(t, 1, sender, NewTestClock(2), mlog).(*service)
+	assert.NoError(t, s.Start())
+	defer func() {
+		assert.NoError(t, s.Close(false))
+	}()
+	sender.AddTxnService(s)
+
+	// the prepared XA txn branch waits for the XA COMMIT after recovery
+	prepared := xaRecoverTestTxns(t, sender, 1)
+	require.Equal(t, 1, len(prepared))
+	assert.Equal(t, wTxn.XID, prepared[0].XID)
+	assert.Equal(t, []byte{1}, prepared[0].ID)
+
+	responses := commitShardWriteData(t, sender, prepared[0])
+	checkResponses(t, responses)
+	checkData(t, wTxn, s, responses[0].Txn.CommitTS.PhysicalTime, 1, true)
+}
+
+func xaPrepareTestTxn(t *testing.T, sender rpc.TxnSender, wTxn txn.TxnMeta, xid []byte) []txn.TxnResponse {
+	result, err := sender.Send(context.Background(), []txn.TxnRequest{NewTestXAPrepareRequest(wTxn, xid)})
+	assert.NoError(t, err)
+	return result.Responses
+}
+
+func xaRecoverTestTxns(t *testing.T, sender rpc.TxnSender, shard uint64) []txn.TxnMeta {
+	result, err := sender.Send(context.Background(), []txn.TxnRequest{NewTestXARecoverRequest(shard)})
+	require.NoError(t, err)
+	require.Nil(t, result.Responses[0].TxnError)
+	return result.Responses[0].XARecoverResponse.Txns
+}
@@ -59,6 +59,10 @@ type TxnService interface {
 	// the response is provided by the caller.
 	RollbackTNShard(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error
 
+	// XARecover handle the request from CN to list the prepared XA txn branches in current DNShard. For
+	// reuse, the response is provided by the caller.
+	XARecover(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error
+
 	// Debug handle txn debug request from CN. For reuse, the response is provided by the caller
 	Debug(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error
 }
@@ -215,6 +215,8 @@ func TypeMatch(v any, typ types.T) bool {
 		_, ok = v.([]byte)
 	case types.T_array_float64:
 		_, ok = v.([]byte)
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		_, ok = v.([]byte)
 	case types.T_varbinary:
 		_, ok = v.([]byte)
 	case types.T_json:
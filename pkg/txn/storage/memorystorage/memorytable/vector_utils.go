@@ -100,7 +100,7 @@ func VectorAt(vec *vector.Vector, i int) (value Nullable) {
 		return vectorAtFixed[types.Uuid](vec, i)
 
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text,
-		types.T_array_float32, types.T_array_float64, types.T_datalink:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit, types.T_datalink:
 		if vec.IsConstNull() {
 			value = Nullable{
 				IsNull: true,
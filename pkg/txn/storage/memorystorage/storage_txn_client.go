@@ -110,6 +110,18 @@ func (*StorageTxnClient) Resume()                                { panic("unimpl
 func (*StorageTxnClient) RefreshExpressionEnabled() bool         { panic("unimplemented") }
 func (*StorageTxnClient) CNBasedConsistencyEnabled() bool        { panic("unimplemented") }
 
+func (*StorageTxnClient) XARecover(context.Context) ([]txn.TxnMeta, error) {
+	panic("unimplemented")
+}
+
+func (*StorageTxnClient) XACommit(context.Context, txn.TxnMeta) (timestamp.Timestamp, error) {
+	panic("unimplemented")
+}
+
+func (*StorageTxnClient) XARollback(context.Context, txn.TxnMeta) error {
+	panic("unimplemented")
+}
+
 type StorageTxnOperator struct {
 	storages map[string]*Storage
 	meta     txn.TxnMeta
@@ -255,6 +267,10 @@ func (s *StorageTxnOperator) Rollback(ctx context.Context) error {
 	return nil
 }
 
+func (s *StorageTxnOperator) XAPrepare(ctx context.Context, xid []byte) error {
+	panic("unimplemented")
+}
+
 func (*StorageTxnOperator) Snapshot() (txn.CNTxnSnapshot, error) {
 	panic("unimplemented")
 }
@@ -406,7 +406,7 @@ func GetVectorArrayLen(ctx context.Context, vec *vector.Vector) (int, error) {
 		cols := vector.MustFixedColNoTypeCheck[float64](vec)
 		return len(cols), nil
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_text,
-		types.T_array_float32, types.T_array_float64, types.T_datalink:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit, types.T_datalink:
 		cols := vector.MustFixedColNoTypeCheck[types.Varlena](vec)
 		return len(cols), nil
 	case types.T_json:
@@ -442,6 +442,9 @@ func ValToString(ctx context.Context, vec *vector.Vector, rowIdx int) (string, e
 	case types.T_array_float64:
 		cols, area := vector.MustVarlenaRawData(vec)
 		return types.ArrayToString[float64](types.GetArray[float64](&cols[rowIdx], area)), nil
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		cols, area := vector.MustVarlenaRawData(vec)
+		return types.QuantizedArrayToString(vec.GetType().Oid, cols[rowIdx].GetByteSlice(area)), nil
 	case types.T_json:
 		cols, area := vector.MustVarlenaRawData(vec)
 		val := cols[rowIdx].GetByteSlice(area)
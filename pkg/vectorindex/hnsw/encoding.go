@@ -0,0 +1,249 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hnsw
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+const (
+	magic         = uint32(0x57534e48) // "HNSW"
+	formatVersion = uint16(1)
+
+	// ChunkSize is the size of the pieces the serialized graph is split into before it is
+	// written to the storage table.
+	ChunkSize = 64 * 1024
+)
+
+// ConfigFromParams converts the index algo params stored in mo_indexes to Config.
+func ConfigFromParams(params map[string]string) (Config, error) {
+	cfg := Config{
+		M:              catalog.HnswDefaultM,
+		EfConstruction: catalog.HnswDefaultEfConstruction,
+		EfSearch:       catalog.HnswDefaultEfSearch,
+	}
+	for key, ptr := range map[string]*int{
+		catalog.IndexAlgoParamM:              &cfg.M,
+		catalog.IndexAlgoParamEfConstruction: &cfg.EfConstruction,
+		catalog.IndexAlgoParamEfSearch:       &cfg.EfSearch,
+	} {
+		if val, ok := params[key]; ok {
+			n, err := strconv.Atoi(val)
+			if err != nil {
+				return cfg, moerr.NewInvalidInputNoCtxf("hnsw: invalid %s '%s'", key, val)
+			}
+			*ptr = n
+		}
+	}
+
+	metric, err := MetricFromOpType(params[catalog.IndexAlgoParamOpType])
+	if err != nil {
+		return cfg, err
+	}
+	cfg.Metric = metric
+	return cfg, nil
+}
+
+// MetricFromOpType maps the op_type of the index to the metric of the graph. An empty op_type means l2.
+func MetricFromOpType(opType string) (Metric, error) {
+	switch catalog.ToLower(opType) {
+	case "", catalog.IndexAlgoParamOpType_l2:
+		return MetricL2, nil
+	case catalog.IndexAlgoParamOpType_ip:
+		return MetricIP, nil
+	case catalog.IndexAlgoParamOpType_cos:
+		return MetricCosine, nil
+	default:
+		return MetricL2, moerr.NewInvalidInputNoCtxf("hnsw: invalid op_type '%s'", opType)
+	}
+}
+
+// GetVector reads the i-th vecf32 or vecf64 value of vec as float32.
+func GetVector(vec *vector.Vector, i int) ([]float32, error) {
+	switch vec.GetType().Oid {
+	case types.T_array_float32:
+		return types.BytesToArray[float32](vec.GetBytesAt(i)), nil
+	case types.T_array_float64:
+		return ToFloat32(types.BytesToArray[float64](vec.GetBytesAt(i))), nil
+	default:
+		return nil, moerr.NewInvalidInputNoCtxf("hnsw: unsupported vector type %s", vec.GetType().String())
+	}
+}
+
+// ToFloat32 converts a vecf64 value to the float32 representation of the graph.
+func ToFloat32(v []float64) []float32 {
+	res := make([]float32, len(v))
+	for i := range v {
+		res[i] = float32(v[i])
+	}
+	return res
+}
+
+// GetKey returns a copy of the encoded i-th primary key of vec.
+func GetKey(vec *vector.Vector, i int) []byte {
+	return append([]byte(nil), vec.GetRawBytesAt(i)...)
+}
+
+// AppendKey decodes key and appends it to vec, which must be of the primary key type.
+func AppendKey(vec *vector.Vector, key []byte, mp *mpool.MPool) error {
+	return vector.AppendAny(vec, types.DecodeValue(key, vec.GetType().Oid), false, mp)
+}
+
+// Marshal serializes the graph, tombstones included.
+func (idx *Index) Marshal() []byte {
+	buf := make([]byte, 0, 64+len(idx.nodes)*(idx.dim*4+16+2*idx.cfg.M*4))
+	buf = binary.LittleEndian.AppendUint32(buf, magic)
+	buf = binary.LittleEndian.AppendUint16(buf, formatVersion)
+	buf = append(buf, byte(idx.cfg.Metric))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(idx.cfg.M))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(idx.cfg.EfConstruction))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(idx.cfg.EfSearch))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(idx.dim))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(idx.nodes)))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(idx.entry))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(idx.maxLevel))
+
+	for _, n := range idx.nodes {
+		if n.deleted {
+			buf = append(buf, 1)
+		} else {
+			buf = append(buf, 0)
+		}
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(n.key)))
+		buf = append(buf, n.key...)
+		for _, f := range n.vec {
+			buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(f))
+		}
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(n.friends)))
+		for _, friends := range n.friends {
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(len(friends)))
+			for _, f := range friends {
+				buf = binary.LittleEndian.AppendUint32(buf, f)
+			}
+		}
+	}
+	return buf
+}
+
+// Unmarshal restores a graph serialized by Marshal.
+func Unmarshal(data []byte) (*Index, error) {
+	r := reader{data: data}
+	if r.uint32() != magic {
+		return nil, moerr.NewInternalErrorNoCtx("hnsw: invalid index data")
+	}
+	if v := r.uint16(); v != formatVersion {
+		return nil, moerr.NewInternalErrorNoCtxf("hnsw: unsupported index format version %d", v)
+	}
+	cfg := Config{Metric: Metric(r.byte())}
+	cfg.M = int(r.uint32())
+	cfg.EfConstruction = int(r.uint32())
+	cfg.EfSearch = int(r.uint32())
+	idx, err := NewIndex(cfg)
+	if err != nil {
+		return nil, err
+	}
+	idx.dim = int(r.uint32())
+	count := int(r.uint32())
+	idx.entry = int32(r.uint32())
+	idx.maxLevel = int(r.uint32())
+	if r.err || idx.entry >= int32(count) || (count > 0 && idx.entry < 0) {
+		return nil, moerr.NewInternalErrorNoCtx("hnsw: invalid index data")
+	}
+
+	idx.nodes = make([]*node, count)
+	for i := range idx.nodes {
+		n := &node{deleted: r.byte() == 1}
+		n.key = append([]byte(nil), r.bytes(int(r.uint32()))...)
+		n.vec = make([]float32, idx.dim)
+		for j := range n.vec {
+			n.vec[j] = math.Float32frombits(r.uint32())
+		}
+		levels := int(r.uint32())
+		if r.err || levels > idx.maxLevel+1 {
+			return nil, moerr.NewInternalErrorNoCtx("hnsw: invalid index data")
+		}
+		n.friends = make([][]uint32, levels)
+		for l := range n.friends {
+			cnt := int(r.uint32())
+			if r.err || cnt > 2*cfg.M {
+				return nil, moerr.NewInternalErrorNoCtx("hnsw: invalid index data")
+			}
+			n.friends[l] = make([]uint32, cnt)
+			for j := range n.friends[l] {
+				if n.friends[l][j] = r.uint32(); int(n.friends[l][j]) >= count {
+					return nil, moerr.NewInternalErrorNoCtx("hnsw: invalid index data")
+				}
+			}
+		}
+		if r.err {
+			return nil, moerr.NewInternalErrorNoCtx("hnsw: invalid index data")
+		}
+		idx.nodes[i] = n
+		if !n.deleted {
+			idx.keys[string(n.key)] = uint32(i)
+			idx.live++
+		}
+	}
+	// levels only have to be random, so the state of the generator is not persisted.
+	idx.rng = rand.New(rand.NewSource(randomSeed + int64(count)))
+	return idx, nil
+}
+
+// reader decodes the little endian fields written by Marshal. Reading past the end sets err.
+type reader struct {
+	data []byte
+	off  int
+	err  bool
+}
+
+func (r *reader) bytes(n int) []byte {
+	if r.err || n < 0 || r.off+n > len(r.data) {
+		r.err = true
+		return nil
+	}
+	b := r.data[r.off : r.off+n]
+	r.off += n
+	return b
+}
+
+func (r *reader) byte() byte {
+	if b := r.bytes(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (r *reader) uint16() uint16 {
+	if b := r.bytes(2); b != nil {
+		return binary.LittleEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (r *reader) uint32() uint32 {
+	if b := r.bytes(4); b != nil {
+		return binary.LittleEndian.Uint32(b)
+	}
+	return 0
+}
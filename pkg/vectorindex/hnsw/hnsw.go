@@ -0,0 +1,400 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hnsw
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+	"slices"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const randomSeed = 20240101
+
+// NewIndex creates an empty graph. The dimension is fixed by the first vector added.
+func NewIndex(cfg Config) (*Index, error) {
+	if cfg.M < 2 {
+		return nil, moerr.NewInvalidInputNoCtxf("hnsw: M should be greater than 1, but got %d", cfg.M)
+	}
+	if cfg.EfConstruction <= 0 || cfg.EfSearch <= 0 {
+		return nil, moerr.NewInvalidInputNoCtx("hnsw: ef_construction and ef_search should be greater than 0")
+	}
+	if cfg.Metric > MetricCosine {
+		return nil, moerr.NewInvalidInputNoCtxf("hnsw: invalid metric %d", cfg.Metric)
+	}
+	return &Index{
+		cfg:      cfg,
+		keys:     make(map[string]uint32),
+		entry:    -1,
+		levelMul: 1 / math.Log(float64(cfg.M)),
+		rng:      rand.New(rand.NewSource(randomSeed)),
+	}, nil
+}
+
+// Config returns the parameters the graph was built with.
+func (idx *Index) Config() Config {
+	return idx.cfg
+}
+
+// Len returns the number of live vectors.
+func (idx *Index) Len() int {
+	return idx.live
+}
+
+// Deleted returns the number of tombstones kept in the graph.
+func (idx *Index) Deleted() int {
+	return len(idx.nodes) - idx.live
+}
+
+// Contains reports whether the key has a live vector.
+func (idx *Index) Contains(key []byte) bool {
+	_, ok := idx.keys[string(key)]
+	return ok
+}
+
+// Add inserts the vector of key. If key is already in the graph, its old vector is replaced;
+// adding the same vector again is a no-op, so changes can be replayed safely.
+func (idx *Index) Add(key []byte, vec []float32) error {
+	if len(vec) == 0 {
+		return moerr.NewInvalidInputNoCtx("hnsw: empty vector")
+	}
+	if idx.dim == 0 {
+		idx.dim = len(vec)
+	} else if idx.dim != len(vec) {
+		return moerr.NewInvalidInputNoCtxf("hnsw: vector dimension %d does not match the index dimension %d", len(vec), idx.dim)
+	}
+
+	q := make([]float32, len(vec))
+	copy(q, vec)
+	if idx.cfg.Metric == MetricCosine {
+		normalize(q)
+	}
+	if id, ok := idx.keys[string(key)]; ok {
+		if slices.Equal(idx.nodes[id].vec, q) {
+			return nil
+		}
+		idx.Remove(key)
+	}
+
+	level := idx.randomLevel()
+	id := uint32(len(idx.nodes))
+	n := &node{
+		key:     append([]byte(nil), key...),
+		vec:     q,
+		friends: make([][]uint32, level+1),
+	}
+	idx.nodes = append(idx.nodes, n)
+	idx.keys[string(key)] = id
+	idx.live++
+
+	if idx.entry < 0 {
+		idx.entry = int32(id)
+		idx.maxLevel = level
+		return nil
+	}
+
+	ep := candidate{id: uint32(idx.entry), dist: idx.distance(q, idx.nodes[idx.entry].vec)}
+	for l := idx.maxLevel; l > level; l-- {
+		ep = idx.greedy(q, ep, l)
+	}
+
+	for l := min(level, idx.maxLevel); l >= 0; l-- {
+		cands := idx.searchLayer(q, ep, idx.cfg.EfConstruction, l, nil)
+		neighbours := idx.selectNeighbours(q, cands, idx.cfg.M)
+		n.friends[l] = make([]uint32, 0, len(neighbours))
+		for _, c := range neighbours {
+			n.friends[l] = append(n.friends[l], c.id)
+			idx.connect(c.id, id, l)
+		}
+		ep = cands[0]
+	}
+
+	if level > idx.maxLevel {
+		idx.maxLevel = level
+		idx.entry = int32(id)
+	}
+	return nil
+}
+
+// Remove marks the vector of key as deleted. It returns false if key is not in the graph.
+func (idx *Index) Remove(key []byte) bool {
+	id, ok := idx.keys[string(key)]
+	if !ok {
+		return false
+	}
+	delete(idx.keys, string(key))
+	idx.nodes[id].deleted = true
+	idx.live--
+	return true
+}
+
+// Search returns up to k live vectors closest to query, closest first. ef is the width of the
+// beam search; the configured ef_search is used when it is not positive.
+func (idx *Index) Search(query []float32, k int, ef int) ([]Result, error) {
+	return idx.search(query, k, ef, nil)
+}
+
+// SearchFiltered is Search restricted to the vectors whose key is in keys, so that a filter is applied
+// before the k nearest vectors are picked. The beam search walks through the other nodes like tombstones,
+// and goes on until it has found ef accepted vectors. When keys is not larger than the number of vectors
+// a beam search compares anyway, the accepted vectors are compared one by one instead, which is exact.
+func (idx *Index) SearchFiltered(query []float32, k int, ef int, keys map[string]struct{}) ([]Result, error) {
+	if keys == nil {
+		keys = map[string]struct{}{}
+	}
+	return idx.search(query, k, ef, keys)
+}
+
+func (idx *Index) search(query []float32, k int, ef int, keys map[string]struct{}) ([]Result, error) {
+	if k <= 0 || idx.live == 0 || (keys != nil && len(keys) == 0) {
+		return nil, nil
+	}
+	if len(query) != idx.dim {
+		return nil, moerr.NewInvalidInputNoCtxf("hnsw: query dimension %d does not match the index dimension %d", len(query), idx.dim)
+	}
+	if ef <= 0 {
+		ef = idx.cfg.EfSearch
+	}
+	ef = max(ef, k)
+
+	q := query
+	if idx.cfg.Metric == MetricCosine {
+		q = make([]float32, len(query))
+		copy(q, query)
+		normalize(q)
+	}
+
+	var cands []candidate
+	if keys != nil && len(keys) <= ef*idx.maxFriends(0) {
+		cands = idx.scanKeys(q, keys)
+	} else {
+		skip := func(id uint32) bool { return idx.nodes[id].deleted }
+		if keys != nil {
+			skip = func(id uint32) bool {
+				if idx.nodes[id].deleted {
+					return true
+				}
+				_, ok := keys[string(idx.nodes[id].key)]
+				return !ok
+			}
+		}
+		ep := candidate{id: uint32(idx.entry), dist: idx.distance(q, idx.nodes[idx.entry].vec)}
+		for l := idx.maxLevel; l > 0; l-- {
+			ep = idx.greedy(q, ep, l)
+		}
+		cands = idx.searchLayer(q, ep, ef, 0, skip)
+	}
+	if len(cands) > k {
+		cands = cands[:k]
+	}
+
+	res := make([]Result, len(cands))
+	for i, c := range cands {
+		res[i] = Result{Key: idx.nodes[c.id].key, Distance: c.dist}
+	}
+	return res, nil
+}
+
+// scanKeys computes the distance of query to every live vector of keys, closest first.
+func (idx *Index) scanKeys(q []float32, keys map[string]struct{}) []candidate {
+	cands := make([]candidate, 0, len(keys))
+	for key := range keys {
+		if id, ok := idx.keys[key]; ok {
+			cands = append(cands, candidate{id: id, dist: idx.distance(q, idx.nodes[id].vec)})
+		}
+	}
+	sort.Slice(cands, func(i, j int) bool {
+		if cands[i].dist != cands[j].dist {
+			return cands[i].dist < cands[j].dist
+		}
+		return cands[i].id < cands[j].id
+	})
+	return cands
+}
+
+func (idx *Index) randomLevel() int {
+	r := idx.rng.Float64()
+	if r == 0 {
+		r = math.SmallestNonzeroFloat64
+	}
+	return int(math.Floor(-math.Log(r) * idx.levelMul))
+}
+
+func (idx *Index) maxFriends(level int) int {
+	if level == 0 {
+		return 2 * idx.cfg.M
+	}
+	return idx.cfg.M
+}
+
+// greedy walks to the closest node of layer l, starting from ep.
+func (idx *Index) greedy(q []float32, ep candidate, l int) candidate {
+	for changed := true; changed; {
+		changed = false
+		for _, f := range idx.nodes[ep.id].friends[l] {
+			if d := idx.distance(q, idx.nodes[f].vec); d < ep.dist {
+				ep = candidate{id: f, dist: d}
+				changed = true
+			}
+		}
+	}
+	return ep
+}
+
+// searchLayer is the beam search of width ef on layer l. The result is sorted closest first.
+// All the nodes are walked through, but the ones skip returns true for are not returned.
+func (idx *Index) searchLayer(q []float32, ep candidate, ef int, l int, skip func(id uint32) bool) []candidate {
+	visited := make([]uint64, (len(idx.nodes)+63)/64)
+	visit := func(id uint32) bool {
+		w, b := id/64, uint64(1)<<(id%64)
+		if visited[w]&b != 0 {
+			return false
+		}
+		visited[w] |= b
+		return true
+	}
+	visit(ep.id)
+
+	cands := &minHeap{ep}
+	res := &maxHeap{}
+	if skip == nil || !skip(ep.id) {
+		heap.Push(res, ep)
+	}
+
+	for cands.Len() > 0 {
+		c := heap.Pop(cands).(candidate)
+		if res.Len() >= ef && c.dist > (*res)[0].dist {
+			break
+		}
+		for _, f := range idx.nodes[c.id].friends[l] {
+			if !visit(f) {
+				continue
+			}
+			d := idx.distance(q, idx.nodes[f].vec)
+			if res.Len() < ef || d < (*res)[0].dist {
+				heap.Push(cands, candidate{id: f, dist: d})
+				if skip != nil && skip(f) {
+					continue
+				}
+				heap.Push(res, candidate{id: f, dist: d})
+				if res.Len() > ef {
+					heap.Pop(res)
+				}
+			}
+		}
+	}
+
+	out := make([]candidate, res.Len())
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = heap.Pop(res).(candidate)
+	}
+	return out
+}
+
+// selectNeighbours picks at most m neighbours out of cands (sorted closest first) with the
+// heuristic of the HNSW paper: a candidate is skipped if it is closer to an already selected
+// neighbour than to q, which keeps the edges spread in different directions. The remaining
+// slots are filled with the skipped candidates.
+func (idx *Index) selectNeighbours(q []float32, cands []candidate, m int) []candidate {
+	if len(cands) <= m {
+		return cands
+	}
+	selected := make([]candidate, 0, m)
+	skipped := make([]candidate, 0, len(cands))
+	for _, c := range cands {
+		if len(selected) >= m {
+			break
+		}
+		good := true
+		for _, s := range selected {
+			if idx.distance(idx.nodes[c.id].vec, idx.nodes[s.id].vec) < c.dist {
+				good = false
+				break
+			}
+		}
+		if good {
+			selected = append(selected, c)
+		} else {
+			skipped = append(skipped, c)
+		}
+	}
+	for i := 0; len(selected) < m && i < len(skipped); i++ {
+		selected = append(selected, skipped[i])
+	}
+	return selected
+}
+
+// connect adds the edge from -> to on layer l, and shrinks the neighbour list of from if it
+// overflows.
+func (idx *Index) connect(from, to uint32, l int) {
+	n := idx.nodes[from]
+	n.friends[l] = append(n.friends[l], to)
+	limit := idx.maxFriends(l)
+	if len(n.friends[l]) <= limit {
+		return
+	}
+
+	cands := make([]candidate, len(n.friends[l]))
+	for i, f := range n.friends[l] {
+		cands[i] = candidate{id: f, dist: idx.distance(n.vec, idx.nodes[f].vec)}
+	}
+	sort.Slice(cands, func(i, j int) bool { return cands[i].dist < cands[j].dist })
+	selected := idx.selectNeighbours(n.vec, cands, limit)
+	n.friends[l] = n.friends[l][:0]
+	for _, c := range selected {
+		n.friends[l] = append(n.friends[l], c.id)
+	}
+}
+
+func (idx *Index) distance(a, b []float32) float32 {
+	switch idx.cfg.Metric {
+	case MetricIP:
+		return -dot(a, b)
+	case MetricCosine:
+		return 1 - dot(a, b)
+	default:
+		return l2sq(a, b)
+	}
+}
+
+func dot(a, b []float32) float32 {
+	var s float32
+	for i := range a {
+		s += a[i] * b[i]
+	}
+	return s
+}
+
+func l2sq(a, b []float32) float32 {
+	var s float32
+	for i := range a {
+		d := a[i] - b[i]
+		s += d * d
+	}
+	return s
+}
+
+func normalize(v []float32) {
+	norm := float32(math.Sqrt(float64(dot(v, v))))
+	if norm == 0 {
+		return
+	}
+	for i := range v {
+		v[i] /= norm
+	}
+}
@@ -0,0 +1,369 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hnsw
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func testKey(i int) []byte {
+	return binary.LittleEndian.AppendUint64(nil, uint64(i))
+}
+
+func randomVectors(rng *rand.Rand, n, dim int) [][]float32 {
+	vecs := make([][]float32, n)
+	for i := range vecs {
+		vecs[i] = make([]float32, dim)
+		for j := range vecs[i] {
+			vecs[i][j] = rng.Float32()*2 - 1
+		}
+	}
+	return vecs
+}
+
+func buildIndex(t *testing.T, cfg Config, vecs [][]float32) *Index {
+	idx, err := NewIndex(cfg)
+	require.NoError(t, err)
+	for i, v := range vecs {
+		require.NoError(t, idx.Add(testKey(i), v))
+	}
+	return idx
+}
+
+// bruteForce returns the keys of the k closest live vectors.
+func bruteForce(idx *Index, vecs [][]float32, deleted map[int]bool, q []float32, k int) map[string]bool {
+	qq := append([]float32(nil), q...)
+	if idx.cfg.Metric == MetricCosine {
+		normalize(qq)
+	}
+	type hit struct {
+		i    int
+		dist float32
+	}
+	var hits []hit
+	for i, v := range vecs {
+		if deleted[i] {
+			continue
+		}
+		vv := append([]float32(nil), v...)
+		if idx.cfg.Metric == MetricCosine {
+			normalize(vv)
+		}
+		hits = append(hits, hit{i, idx.distance(qq, vv)})
+	}
+	sort.Slice(hits, func(a, b int) bool { return hits[a].dist < hits[b].dist })
+	res := make(map[string]bool)
+	for i := 0; i < k && i < len(hits); i++ {
+		res[string(testKey(hits[i].i))] = true
+	}
+	return res
+}
+
+func recall(t *testing.T, idx *Index, vecs [][]float32, deleted map[int]bool, queries [][]float32, k int) float64 {
+	found, total := 0, 0
+	for _, q := range queries {
+		res, err := idx.Search(q, k, 0)
+		require.NoError(t, err)
+		expected := bruteForce(idx, vecs, deleted, q, k)
+		for _, r := range res {
+			if expected[string(r.Key)] {
+				found++
+			}
+		}
+		total += len(expected)
+	}
+	return float64(found) / float64(total)
+}
+
+func TestNewIndex(t *testing.T) {
+	_, err := NewIndex(Config{M: 1, EfConstruction: 10, EfSearch: 10})
+	require.Error(t, err)
+	_, err = NewIndex(Config{M: 4, EfConstruction: 0, EfSearch: 10})
+	require.Error(t, err)
+	_, err = NewIndex(Config{M: 4, EfConstruction: 10, EfSearch: 10, Metric: 9})
+	require.Error(t, err)
+
+	idx, err := NewIndex(Config{M: 4, EfConstruction: 10, EfSearch: 10})
+	require.NoError(t, err)
+	res, err := idx.Search([]float32{1, 2}, 3, 0)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	require.Error(t, idx.Add(testKey(0), nil))
+	require.NoError(t, idx.Add(testKey(0), []float32{1, 2}))
+	require.Error(t, idx.Add(testKey(1), []float32{1, 2, 3}))
+	_, err = idx.Search([]float32{1, 2, 3}, 3, 0)
+	require.Error(t, err)
+}
+
+func TestRecall(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	vecs := randomVectors(rng, 2000, 16)
+	queries := randomVectors(rng, 50, 16)
+
+	for _, metric := range []Metric{MetricL2, MetricIP, MetricCosine} {
+		idx := buildIndex(t, Config{M: 16, EfConstruction: 100, EfSearch: 64, Metric: metric}, vecs)
+		require.Equal(t, len(vecs), idx.Len())
+		require.Greater(t, recall(t, idx, vecs, nil, queries, 10), 0.9, "metric %d", metric)
+	}
+}
+
+func TestSearchOrder(t *testing.T) {
+	idx := buildIndex(t, Config{M: 4, EfConstruction: 16, EfSearch: 16}, [][]float32{{0, 0}, {1, 0}, {3, 0}, {6, 0}})
+
+	res, err := idx.Search([]float32{2.9, 0}, 4, 0)
+	require.NoError(t, err)
+	require.Len(t, res, 4)
+	keys := make([][]byte, len(res))
+	for i, r := range res {
+		keys[i] = r.Key
+	}
+	require.Equal(t, [][]byte{testKey(2), testKey(1), testKey(0), testKey(3)}, keys)
+	require.InDelta(t, 0.01, res[0].Distance, 1e-5)
+
+	res, err = idx.Search([]float32{2.9, 0}, 2, 0)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	// the largest inner product comes first
+	idx = buildIndex(t, Config{M: 4, EfConstruction: 16, EfSearch: 16, Metric: MetricIP}, [][]float32{{1, 0}, {5, 0}, {-3, 0}})
+	res, err = idx.Search([]float32{1, 0}, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, testKey(1), res[0].Key)
+	require.InDelta(t, -5, res[0].Distance, 1e-5)
+
+	// cosine ignores the length of the vectors
+	idx = buildIndex(t, Config{M: 4, EfConstruction: 16, EfSearch: 16, Metric: MetricCosine}, [][]float32{{10, 1}, {1, 1}, {0, 5}})
+	res, err = idx.Search([]float32{1, 1}, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, testKey(1), res[0].Key)
+}
+
+func TestSearchFiltered(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	vecs := randomVectors(rng, 2000, 8)
+	queries := randomVectors(rng, 20, 8)
+	idx := buildIndex(t, Config{M: 4, EfConstruction: 64, EfSearch: 16}, vecs)
+
+	filter := func(mod int) (map[string]struct{}, map[int]bool) {
+		keys := make(map[string]struct{})
+		rejected := make(map[int]bool)
+		for i := range vecs {
+			if i%mod == 0 {
+				keys[string(testKey(i))] = struct{}{}
+			} else {
+				rejected[i] = true
+			}
+		}
+		return keys, rejected
+	}
+
+	// 1000 keys are searched in the graph, 100 keys are compared one by one.
+	for _, mod := range []int{2, 20} {
+		keys, rejected := filter(mod)
+		found, total := 0, 0
+		for _, q := range queries {
+			res, err := idx.SearchFiltered(q, 10, 0, keys)
+			require.NoError(t, err)
+			require.Len(t, res, 10)
+			expected := bruteForce(idx, vecs, rejected, q, 10)
+			for _, r := range res {
+				require.Contains(t, keys, string(r.Key))
+				if expected[string(r.Key)] {
+					found++
+				}
+			}
+			total += len(expected)
+			if mod == 20 {
+				require.Equal(t, total, found)
+			}
+		}
+		require.Greater(t, float64(found)/float64(total), 0.9, "mod %d", mod)
+	}
+
+	// deleted keys are not returned, and no key means no result.
+	keys, _ := filter(20)
+	require.True(t, idx.Remove(testKey(0)))
+	res, err := idx.SearchFiltered(vecs[0], 200, 0, keys)
+	require.NoError(t, err)
+	require.Len(t, res, len(keys)-1)
+	res, err = idx.SearchFiltered(vecs[0], 10, 0, nil)
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
+
+func TestRemoveAndUpdate(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	vecs := randomVectors(rng, 1000, 8)
+	queries := randomVectors(rng, 30, 8)
+	idx := buildIndex(t, Config{M: 8, EfConstruction: 64, EfSearch: 64}, vecs)
+
+	deleted := make(map[int]bool)
+	for i := 0; i < len(vecs); i += 3 {
+		require.True(t, idx.Remove(testKey(i)))
+		deleted[i] = true
+	}
+	require.False(t, idx.Remove(testKey(0)))
+	require.False(t, idx.Contains(testKey(0)))
+	require.True(t, idx.Contains(testKey(1)))
+	require.Equal(t, len(vecs)-len(deleted), idx.Len())
+	require.Equal(t, len(deleted), idx.Deleted())
+
+	for _, q := range queries {
+		res, err := idx.Search(q, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, res, 10)
+		for _, r := range res {
+			require.False(t, deleted[int(binary.LittleEndian.Uint64(r.Key))])
+		}
+	}
+	require.Greater(t, recall(t, idx, vecs, deleted, queries, 10), 0.9)
+
+	// updating a key replaces its vector
+	require.NoError(t, idx.Add(testKey(1), []float32{100, 100, 100, 100, 100, 100, 100, 100}))
+	vecs[1] = []float32{100, 100, 100, 100, 100, 100, 100, 100}
+	require.Equal(t, len(vecs)-len(deleted), idx.Len())
+	res, err := idx.Search([]float32{99, 99, 99, 99, 99, 99, 99, 99}, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, testKey(1), res[0].Key)
+
+	// adding the same vector again does not leave a tombstone behind
+	tombstones := idx.Deleted()
+	require.NoError(t, idx.Add(testKey(1), vecs[1]))
+	require.Equal(t, tombstones, idx.Deleted())
+
+	// re-adding a deleted key makes it live again
+	require.NoError(t, idx.Add(testKey(0), []float32{-100, -100, -100, -100, -100, -100, -100, -100}))
+	res, err = idx.Search([]float32{-99, -99, -99, -99, -99, -99, -99, -99}, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, testKey(0), res[0].Key)
+
+	// everything deleted
+	for i := range vecs {
+		idx.Remove(testKey(i))
+	}
+	require.Equal(t, 0, idx.Len())
+	res, err = idx.Search(queries[0], 10, 0)
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
+
+func TestMarshal(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	vecs := randomVectors(rng, 500, 8)
+	idx := buildIndex(t, Config{M: 6, EfConstruction: 32, EfSearch: 20, Metric: MetricCosine}, vecs)
+	for i := 0; i < 50; i++ {
+		idx.Remove(testKey(i))
+	}
+
+	data := idx.Marshal()
+	idx2, err := Unmarshal(data)
+	require.NoError(t, err)
+	require.Equal(t, idx.Config(), idx2.Config())
+	require.Equal(t, idx.Len(), idx2.Len())
+	require.Equal(t, idx.Deleted(), idx2.Deleted())
+	require.Equal(t, data, idx2.Marshal())
+
+	for _, q := range randomVectors(rng, 10, 8) {
+		res1, err := idx.Search(q, 5, 0)
+		require.NoError(t, err)
+		res2, err := idx2.Search(q, 5, 0)
+		require.NoError(t, err)
+		require.Equal(t, res1, res2)
+	}
+
+	// the reloaded graph can still grow
+	require.NoError(t, idx2.Add(testKey(1000), vecs[0]))
+	require.True(t, idx2.Contains(testKey(1000)))
+
+	empty, err := NewIndex(Config{M: 4, EfConstruction: 8, EfSearch: 8})
+	require.NoError(t, err)
+	empty2, err := Unmarshal(empty.Marshal())
+	require.NoError(t, err)
+	require.Equal(t, 0, empty2.Len())
+
+	_, err = Unmarshal(data[:len(data)/2])
+	require.Error(t, err)
+	_, err = Unmarshal([]byte("not an index"))
+	require.Error(t, err)
+}
+
+func TestConfigFromParams(t *testing.T) {
+	cfg, err := ConfigFromParams(map[string]string{})
+	require.NoError(t, err)
+	require.Equal(t, Config{M: catalog.HnswDefaultM, EfConstruction: catalog.HnswDefaultEfConstruction,
+		EfSearch: catalog.HnswDefaultEfSearch, Metric: MetricL2}, cfg)
+
+	cfg, err = ConfigFromParams(map[string]string{
+		catalog.IndexAlgoParamM:              "8",
+		catalog.IndexAlgoParamEfConstruction: "100",
+		catalog.IndexAlgoParamEfSearch:       "30",
+		catalog.IndexAlgoParamOpType:         catalog.IndexAlgoParamOpType_ip,
+	})
+	require.NoError(t, err)
+	require.Equal(t, Config{M: 8, EfConstruction: 100, EfSearch: 30, Metric: MetricIP}, cfg)
+
+	_, err = ConfigFromParams(map[string]string{catalog.IndexAlgoParamM: "x"})
+	require.Error(t, err)
+	_, err = ConfigFromParams(map[string]string{catalog.IndexAlgoParamOpType: "vector_l1_ops"})
+	require.Error(t, err)
+}
+
+func TestVectorHelpers(t *testing.T) {
+	mp := mpool.MustNewZero()
+
+	v32 := vector.NewVec(types.T_array_float32.ToType())
+	require.NoError(t, vector.AppendArray(v32, []float32{1, 2}, false, mp))
+	f, err := GetVector(v32, 0)
+	require.NoError(t, err)
+	require.Equal(t, []float32{1, 2}, f)
+
+	v64 := vector.NewVec(types.T_array_float64.ToType())
+	require.NoError(t, vector.AppendArray(v64, []float64{1.5, 2}, false, mp))
+	f, err = GetVector(v64, 0)
+	require.NoError(t, err)
+	require.Equal(t, []float32{1.5, 2}, f)
+
+	_, err = GetVector(vector.NewVec(types.T_int64.ToType()), 0)
+	require.Error(t, err)
+
+	// keys round trip through their raw bytes
+	ints := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixed(ints, int64(42), false, mp))
+	out := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, AppendKey(out, GetKey(ints, 0), mp))
+	require.Equal(t, int64(42), vector.GetFixedAtNoTypeCheck[int64](out, 0))
+
+	strs := vector.NewVec(types.T_varchar.ToType())
+	require.NoError(t, vector.AppendBytes(strs, []byte("pk"), false, mp))
+	out = vector.NewVec(types.T_varchar.ToType())
+	require.NoError(t, AppendKey(out, GetKey(strs, 0), mp))
+	require.Equal(t, "pk", out.GetStringAt(0))
+
+	v32.Free(mp)
+	v64.Free(mp)
+	ints.Free(mp)
+	strs.Free(mp)
+	out.Free(mp)
+}
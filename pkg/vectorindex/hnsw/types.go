@@ -0,0 +1,138 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hnsw
+
+import (
+	"math/rand"
+)
+
+/*
+HNSW (Hierarchical Navigable Small World) is a graph based approximate nearest neighbour index.
+
+Every vector is a node of a multi-layer graph. The bottom layer contains all the nodes and each upper
+layer contains an exponentially decaying subset of the nodes of the layer below. A search starts from
+the entry point in the top layer, greedily walks to the closest node of each layer and finishes with
+a beam search of width ef on the bottom layer.
+
+The index keeps the vectors as float32, whatever the column type is (vecf32 or vecf64). The result is
+only used to produce candidates, which are re-ranked with the exact distance function by the query plan.
+
+Nodes are never physically removed. A delete (or the old version of an updated row) is kept in the
+graph as a tombstone, so that the neighbourhood of its friends stays navigable, and is skipped in the
+search result.
+*/
+
+// Metric is the distance function the graph is organised by.
+type Metric uint8
+
+const (
+	// MetricL2 is the squared euclidean distance, which has the same order as l2_distance.
+	MetricL2 Metric = iota
+	// MetricIP is the negated inner product, so that the largest inner_product comes first.
+	MetricIP
+	// MetricCosine is the cosine distance. Vectors are normalized when they are added to the graph.
+	MetricCosine
+)
+
+// Config holds the build and search parameters of the graph.
+type Config struct {
+	// M is the number of neighbours of a node in the upper layers. The bottom layer keeps 2*M.
+	M int
+	// EfConstruction is the width of the beam search when a node is added.
+	EfConstruction int
+	// EfSearch is the default width of the beam search of a query.
+	EfSearch int
+	Metric   Metric
+}
+
+// Result is a search hit.
+type Result struct {
+	// Key is the encoded primary key of the row.
+	Key      []byte
+	Distance float32
+}
+
+// SearchParam is the parameter of the hnsw_search table function, built by the planner.
+type SearchParam struct {
+	DbName       string `json:"db"`
+	SrcTable     string `json:"src"`
+	MetaTable    string `json:"meta"`
+	StorageTable string `json:"storage"`
+	PkCol        string `json:"pk"`
+	VecCol       string `json:"vec"`
+	// AlgoParams is the IndexAlgoParams json of the index.
+	AlgoParams string `json:"params"`
+	// Limit is the number of rows the query needs. The search returns max(Limit, ef_search) candidates.
+	Limit int64 `json:"limit"`
+	// Filtered means the primary keys of the rows that pass the filters of the query are the second
+	// argument, and only those rows are searched (pre-filtering).
+	Filtered bool `json:"filtered,omitempty"`
+}
+
+type node struct {
+	key []byte
+	vec []float32
+	// friends[l] is the neighbour list of the node on layer l. The level of the node is len(friends)-1.
+	friends [][]uint32
+	deleted bool
+}
+
+// Index is an in-memory HNSW graph. It is not safe for concurrent use.
+type Index struct {
+	cfg      Config
+	dim      int
+	nodes    []*node
+	keys     map[string]uint32
+	entry    int32
+	maxLevel int
+	live     int
+	levelMul float64
+	rng      *rand.Rand
+}
+
+type candidate struct {
+	id   uint32
+	dist float32
+}
+
+// minHeap pops the closest candidate first.
+type minHeap []candidate
+
+func (h minHeap) Len() int           { return len(h) }
+func (h minHeap) Less(i, j int) bool { return h[i].dist < h[j].dist }
+func (h minHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *minHeap) Push(x any)        { *h = append(*h, x.(candidate)) }
+func (h *minHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// maxHeap pops the furthest candidate first.
+type maxHeap []candidate
+
+func (h maxHeap) Len() int           { return len(h) }
+func (h maxHeap) Less(i, j int) bool { return h[i].dist > h[j].dist }
+func (h maxHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *maxHeap) Push(x any)        { *h = append(*h, x.(candidate)) }
+func (h *maxHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
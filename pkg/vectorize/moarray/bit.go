@@ -0,0 +1,51 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moarray
+
+import (
+	"math/bits"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// These functions work on the packed bits of vecbit, 8 elements per byte.
+
+// HammingDistance returns the number of elements that differ between two bit vectors.
+func HammingDistance(v1, v2 []byte) (float64, error) {
+	if len(v1) != len(v2) {
+		return 0, moerr.NewArrayInvalidOpNoCtx(len(v1)*8, len(v2)*8)
+	}
+	var count int
+	for i := range v1 {
+		count += bits.OnesCount8(v1[i] ^ v2[i])
+	}
+	return float64(count), nil
+}
+
+// JaccardDistance returns 1 - |v1 AND v2| / |v1 OR v2| of two bit vectors. It is 0 if both vectors are zero.
+func JaccardDistance(v1, v2 []byte) (float64, error) {
+	if len(v1) != len(v2) {
+		return 0, moerr.NewArrayInvalidOpNoCtx(len(v1)*8, len(v2)*8)
+	}
+	var intersection, union int
+	for i := range v1 {
+		intersection += bits.OnesCount8(v1[i] & v2[i])
+		union += bits.OnesCount8(v1[i] | v2[i])
+	}
+	if union == 0 {
+		return 0, nil
+	}
+	return 1 - float64(intersection)/float64(union), nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moarray
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHammingDistance(t *testing.T) {
+	tests := []struct {
+		name    string
+		v1, v2  []byte
+		want    float64
+		wantErr bool
+	}{
+		{name: "equal", v1: []byte{0b10110010}, v2: []byte{0b10110010}, want: 0},
+		{name: "one byte", v1: []byte{0b10110010}, v2: []byte{0b00110011}, want: 2},
+		{name: "two bytes", v1: []byte{0xff, 0x00}, v2: []byte{0x00, 0xff}, want: 16},
+		{name: "dimension mismatch", v1: []byte{0xff}, v2: []byte{0xff, 0x00}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := HammingDistance(tt.v1, tt.v2)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestJaccardDistance(t *testing.T) {
+	tests := []struct {
+		name    string
+		v1, v2  []byte
+		want    float64
+		wantErr bool
+	}{
+		{name: "equal", v1: []byte{0b10110010}, v2: []byte{0b10110010}, want: 0},
+		{name: "disjoint", v1: []byte{0b11110000}, v2: []byte{0b00001111}, want: 1},
+		{name: "half", v1: []byte{0b11000000}, v2: []byte{0b10100000}, want: 1 - 1.0/3},
+		{name: "both zero", v1: []byte{0, 0}, v2: []byte{0, 0}, want: 0},
+		{name: "dimension mismatch", v1: []byte{0xff}, v2: []byte{0xff, 0x00}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := JaccardDistance(tt.v1, tt.v2)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.InDelta(t, tt.want, got, 1e-12)
+		})
+	}
+}
@@ -688,6 +688,8 @@ func getTableDef(tblItem *TableItem, coldefs []engine.TableDef) (*plan.TableDef,
 	var primarykey *plan.PrimaryKeyDef
 	var indexes []*plan.IndexDef
 	var refChildTbls []uint64
+	var checks []*plan.CheckDef
+	var generated []*plan.GeneratedColDef
 
 	tableDef := make([]engine.TableDef, 0)
 
@@ -779,6 +781,10 @@ func getTableDef(tblItem *TableItem, coldefs []engine.TableDef) (*plan.TableDef,
 				foreignKeys = k.Fkeys
 			case *engine.RefChildTableDef:
 				refChildTbls = k.Tables
+			case *engine.CheckDef:
+				checks = k.Checks
+			case *engine.GeneratedColDef:
+				generated = k.Cols
 			case *engine.PrimaryKeyDef:
 				primarykey = k.Pkey
 			case *engine.StreamConfigsDef:
@@ -828,6 +834,7 @@ func getTableDef(tblItem *TableItem, coldefs []engine.TableDef) (*plan.TableDef,
 		})
 	}
 
+	plan2.SetGeneratedColDefs(cols, generated)
 	if primarykey != nil && primarykey.PkeyColName == catalog.CPrimaryKeyColName {
 		primarykey.CompPkeyCol = plan2.GetColDefFromTable(cols, catalog.CPrimaryKeyColName)
 	}
@@ -849,6 +856,7 @@ func getTableDef(tblItem *TableItem, coldefs []engine.TableDef) (*plan.TableDef,
 		Partition:     partitionInfo,
 		Fkeys:         foreignKeys,
 		RefChildTbls:  refChildTbls,
+		Checks:        checks,
 		ClusterBy:     clusterByDef,
 		Indexes:       indexes,
 		Version:       tblItem.Version,
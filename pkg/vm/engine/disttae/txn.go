@@ -460,6 +460,9 @@ func (txn *Transaction) dumpBatchLocked(ctx context.Context, offset int) error {
 	dumpAll := offset < 0
 	if dumpAll {
 		offset = 0
+	} else {
+		// the writes before the savepoint are kept for rolling back to it
+		offset = max(offset, txn.savepointOffsetLocked())
 	}
 
 	if !dumpAll {
@@ -1003,8 +1006,11 @@ func (txn *Transaction) mergeTxnWorkspaceLocked(ctx context.Context) error {
 	txn.restoreTxnTableFunc = txn.restoreTxnTableFunc[:0]
 
 	if len(txn.batchSelectList) > 0 {
-		for _, e := range txn.writes {
+		for i, e := range txn.writes {
 			if sels, ok := txn.batchSelectList[e.bat]; ok {
+				if err := txn.keepOriginLocked(i); err != nil {
+					return err
+				}
 				txn.approximateInMemInsertCnt -= e.bat.RowCount() - len(sels)
 				e.bat.Shrink(sels, false)
 				delete(txn.batchSelectList, e.bat)
@@ -1014,6 +1020,9 @@ func (txn *Transaction) mergeTxnWorkspaceLocked(ctx context.Context) error {
 	if len(txn.tablesInVain) > 0 {
 		for i, e := range txn.writes {
 			if _, ok := txn.tablesInVain[e.tableId]; e.bat != nil && ok {
+				if err := txn.keepOriginLocked(i); err != nil {
+					return err
+				}
 				e.bat.Clean(txn.proc.GetMPool())
 				txn.writes[i].bat = nil
 			}
@@ -1118,6 +1127,11 @@ func (txn *Transaction) compactionBlksLocked(ctx context.Context) error {
 			entry.bat.Attrs[0] != catalog.BlockMeta_MetaLoc {
 			continue
 		}
+		if len(compactedEntries[entry.bat]) > 0 {
+			if err := txn.keepOriginLocked(i); err != nil {
+				return err
+			}
+		}
 		entry.bat.Shrink(compactedEntries[entry.bat], true)
 		if entry.bat.RowCount() == 0 {
 			txn.writes[i].bat.Clean(txn.proc.GetMPool())
@@ -1398,6 +1412,7 @@ func (txn *Transaction) delTransaction() {
 		}
 		txn.writes[i].bat.Clean(txn.proc.Mp())
 	}
+	txn.cleanSavepoints()
 	txn.CleanToFreeBatches()
 	txn.tableCache = nil
 	txn.tableOps = nil
@@ -0,0 +1,248 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/lockservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"go.uber.org/zap"
+)
+
+// savepoint is a position in the workspace that the txn can be rolled back to.
+//
+// A savepoint is set between statements, so the writes before it are txn.writes[:offset] and the statements before
+// it are the first statementID ones.  Rollback to the savepoint is the rollback of the statements after it, like
+// RollbackLastStatement does for one statement.  The writes before the savepoint are kept as they are, except that
+// merging the workspace may modify them in place, e.g. shrinking an insert batch for the rows deleted later.  The
+// original batches of these writes are kept in the latest savepoint before modifying, see keepOriginLocked.
+//
+// The locks held by the txn at the savepoint are recorded as a mark of the lockservice, the locks acquired after it
+// are released by the rollback to the savepoint.
+type savepoint struct {
+	name        string
+	statementID int
+	offset      int
+	locks       lockservice.TxnLockMark
+	// index of txn.writes -> the batch before it was modified after the savepoint
+	origins map[int]*batch.Batch
+}
+
+// Savepoint sets a savepoint with the name in the workspace.  The existing savepoint with the same name is replaced.
+func (txn *Transaction) Savepoint(ctx context.Context, name string) error {
+	txn.Lock()
+	defer txn.Unlock()
+
+	if i := txn.findSavepointLocked(name); i >= 0 {
+		txn.removeSavepointLocked(i)
+	}
+	sp := &savepoint{
+		name:        strings.ToLower(name),
+		statementID: txn.statementID,
+		offset:      len(txn.writes),
+	}
+	if ls := txn.lockService(); ls != nil {
+		sp.locks = ls.MarkTxnLocks(txn.op.Txn().ID)
+	}
+	txn.savepoints = append(txn.savepoints, sp)
+	return nil
+}
+
+// RollbackToSavepoint discards the writes and table operations after the savepoint and releases the locks acquired
+// after it, the savepoints set after it are removed and itself is kept.
+func (txn *Transaction) RollbackToSavepoint(ctx context.Context, name string) error {
+	txn.op.EnterRollbackStmt()
+	defer txn.op.ExitRollbackStmt()
+
+	txn.Lock()
+	defer txn.Unlock()
+
+	i := txn.findSavepointLocked(name)
+	if i < 0 {
+		return moerr.NewSavepointNotExist(ctx, name)
+	}
+	sp := txn.savepoints[i]
+
+	beforeEntries := len(txn.writes)
+	defer func() {
+		logutil.Info(
+			"RollbackToSavepoint",
+			zap.String("txn", hex.EncodeToString(txn.op.Txn().ID)),
+			zap.String("savepoint", sp.name),
+			zap.Int("before", beforeEntries),
+			zap.Int("after", len(txn.writes)),
+		)
+	}()
+
+	txn.rollbackCount++
+	txn.clearTableCache()
+	for txn.statementID > sp.statementID {
+		txn.rollbackTableOpLocked()
+		txn.statementID--
+	}
+
+	// restore the writes before the savepoint, the later savepoints keep the later versions.
+	for j := len(txn.savepoints) - 1; j >= i; j-- {
+		for idx, origin := range txn.savepoints[j].origins {
+			if idx < sp.offset {
+				txn.restoreOriginLocked(idx, origin)
+			} else {
+				origin.Clean(txn.proc.Mp())
+			}
+		}
+		txn.savepoints[j].origins = nil
+	}
+	txn.savepoints = txn.savepoints[:i+1]
+
+	txn.truncateWritesLocked(sp.offset)
+	if len(txn.offsets) > txn.statementID {
+		txn.offsets = txn.offsets[:txn.statementID]
+	}
+	txn.snapshotWriteOffset = min(txn.snapshotWriteOffset, len(txn.writes))
+	txn.rollbackTransferLocked()
+
+	for b := range txn.batchSelectList {
+		delete(txn.batchSelectList, b)
+	}
+	txn.CleanToFreeBatches()
+	txn.deletedBlocks.clean()
+
+	if ls := txn.lockService(); ls != nil {
+		return ls.UnlockAfterMark(ctx, txn.op.Txn().ID, sp.locks)
+	}
+	return nil
+}
+
+// ReleaseSavepoint removes the savepoint and the savepoints set after it, the writes are not changed.
+func (txn *Transaction) ReleaseSavepoint(ctx context.Context, name string) error {
+	txn.Lock()
+	defer txn.Unlock()
+
+	i := txn.findSavepointLocked(name)
+	if i < 0 {
+		return moerr.NewSavepointNotExist(ctx, name)
+	}
+	for j := len(txn.savepoints) - 1; j >= i; j-- {
+		txn.removeSavepointLocked(j)
+	}
+	return nil
+}
+
+func (txn *Transaction) findSavepointLocked(name string) int {
+	name = strings.ToLower(name)
+	for i := len(txn.savepoints) - 1; i >= 0; i-- {
+		if txn.savepoints[i].name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// removeSavepointLocked removes the i-th savepoint.  The original batches kept by it are handed over to the previous
+// savepoint if they are still needed by it, i.e. the writes are before the previous one and modified after it.
+func (txn *Transaction) removeSavepointLocked(i int) {
+	sp := txn.savepoints[i]
+	var prev *savepoint
+	if i > 0 {
+		prev = txn.savepoints[i-1]
+	}
+	for idx, origin := range sp.origins {
+		if prev == nil || idx >= prev.offset {
+			origin.Clean(txn.proc.Mp())
+			continue
+		}
+		if _, ok := prev.origins[idx]; ok {
+			// prev keeps an older version
+			origin.Clean(txn.proc.Mp())
+			continue
+		}
+		if prev.origins == nil {
+			prev.origins = make(map[int]*batch.Batch)
+		}
+		prev.origins[idx] = origin
+	}
+	sp.origins = nil
+	txn.savepoints = append(txn.savepoints[:i], txn.savepoints[i+1:]...)
+}
+
+// keepOriginLocked keeps a copy of the batch of txn.writes[idx] before it is modified in place, if the write is
+// before the latest savepoint and it is the first modification after the savepoint.
+func (txn *Transaction) keepOriginLocked(idx int) error {
+	if len(txn.savepoints) == 0 {
+		return nil
+	}
+	sp := txn.savepoints[len(txn.savepoints)-1]
+	if idx >= sp.offset || txn.writes[idx].bat == nil {
+		return nil
+	}
+	if _, ok := sp.origins[idx]; ok {
+		return nil
+	}
+	origin, err := txn.writes[idx].bat.Dup(txn.proc.Mp())
+	if err != nil {
+		return err
+	}
+	if sp.origins == nil {
+		sp.origins = make(map[int]*batch.Batch)
+	}
+	sp.origins[idx] = origin
+	return nil
+}
+
+// restoreOriginLocked replaces the batch of txn.writes[idx] with the original one.  The workspace size is not
+// changed, as the in place modifications do not change it either.
+func (txn *Transaction) restoreOriginLocked(idx int, origin *batch.Batch) {
+	if bat := txn.writes[idx].bat; bat != nil {
+		// the uncommitted blocks of CN refer to the batch of block infos.
+		for blkID, pos := range txn.cnBlkId_Pos {
+			if pos.bat == bat {
+				pos.bat = origin
+				txn.cnBlkId_Pos[blkID] = pos
+			}
+		}
+		bat.Clean(txn.proc.Mp())
+	}
+	txn.writes[idx].bat = origin
+}
+
+// savepointOffsetLocked returns the offset of the latest savepoint, the writes before it should not be dumped.
+func (txn *Transaction) savepointOffsetLocked() int {
+	if len(txn.savepoints) == 0 {
+		return 0
+	}
+	return txn.savepoints[len(txn.savepoints)-1].offset
+}
+
+// lockService returns the lockservice holding the locks of the txn, nil if there is not one.
+func (txn *Transaction) lockService() lockservice.LockService {
+	if txn.engine == nil || txn.op == nil {
+		return nil
+	}
+	return txn.engine.ls
+}
+
+func (txn *Transaction) cleanSavepoints() {
+	for _, sp := range txn.savepoints {
+		for _, origin := range sp.origins {
+			origin.Clean(txn.proc.Mp())
+		}
+	}
+	txn.savepoints = nil
+}
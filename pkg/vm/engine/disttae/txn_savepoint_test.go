@@ -0,0 +1,133 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func newSavepointTestTxn(writes int) (*Transaction, *mpool.MPool) {
+	m := mpool.MustNewZero()
+	txn := &Transaction{
+		proc: testutil.NewProcessWithMPool("", m),
+	}
+	for i := 0; i < writes; i++ {
+		bat := testutil.NewBatch([]types.Type{types.T_int64.ToType()}, true, 10, m)
+		txn.writes = append(txn.writes, Entry{bat: bat})
+	}
+	return txn, m
+}
+
+func cleanSavepointTestTxn(txn *Transaction) {
+	txn.cleanSavepoints()
+	for _, e := range txn.writes {
+		if e.bat != nil {
+			e.bat.Clean(txn.proc.Mp())
+		}
+	}
+	txn.writes = nil
+}
+
+func TestSavepoint(t *testing.T) {
+	ctx := context.Background()
+	txn, m := newSavepointTestTxn(1)
+
+	require.NoError(t, txn.Savepoint(ctx, "a"))
+	txn.statementID = 2
+	txn.writes = append(txn.writes, Entry{})
+	require.NoError(t, txn.Savepoint(ctx, "B"))
+	require.Equal(t, 2, len(txn.savepoints))
+	require.Equal(t, 1, txn.findSavepointLocked("b"))
+	require.Equal(t, 2, txn.savepointOffsetLocked())
+
+	// the savepoint with the same name is replaced
+	txn.statementID = 3
+	require.NoError(t, txn.Savepoint(ctx, "A"))
+	require.Equal(t, 2, len(txn.savepoints))
+	require.Equal(t, "b", txn.savepoints[0].name)
+	require.Equal(t, "a", txn.savepoints[1].name)
+	require.Equal(t, 3, txn.savepoints[1].statementID)
+
+	err := txn.ReleaseSavepoint(ctx, "c")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist))
+
+	// release removes the later savepoints too
+	require.NoError(t, txn.ReleaseSavepoint(ctx, "b"))
+	require.Equal(t, 0, len(txn.savepoints))
+	require.Equal(t, 0, txn.savepointOffsetLocked())
+
+	cleanSavepointTestTxn(txn)
+	require.Equal(t, int64(0), m.CurrNB())
+}
+
+func TestSavepointKeepOrigin(t *testing.T) {
+	ctx := context.Background()
+	txn, m := newSavepointTestTxn(3)
+
+	// no savepoint, nothing to keep
+	require.NoError(t, txn.keepOriginLocked(0))
+
+	require.NoError(t, txn.Savepoint(ctx, "a"))
+	txn.savepoints[0].offset = 2
+	require.NoError(t, txn.keepOriginLocked(0))
+	require.NoError(t, txn.keepOriginLocked(1))
+	// the writes after the savepoint are not kept
+	require.NoError(t, txn.keepOriginLocked(2))
+	require.Equal(t, 2, len(txn.savepoints[0].origins))
+
+	// only the first modification is kept
+	origin := txn.savepoints[0].origins[0]
+	txn.writes[0].bat.Shrink([]int64{0}, false)
+	require.NoError(t, txn.keepOriginLocked(0))
+	require.Equal(t, origin, txn.savepoints[0].origins[0])
+	require.Equal(t, 10, origin.RowCount())
+
+	txn.restoreOriginLocked(0, origin)
+	delete(txn.savepoints[0].origins, 0)
+	require.Equal(t, 10, txn.writes[0].bat.RowCount())
+
+	cleanSavepointTestTxn(txn)
+	require.Equal(t, int64(0), m.CurrNB())
+}
+
+func TestSavepointRemoveOrigins(t *testing.T) {
+	ctx := context.Background()
+	txn, m := newSavepointTestTxn(3)
+
+	require.NoError(t, txn.Savepoint(ctx, "a"))
+	txn.savepoints[0].offset = 1
+	require.NoError(t, txn.Savepoint(ctx, "b"))
+	txn.savepoints[1].offset = 3
+	require.NoError(t, txn.keepOriginLocked(0))
+	require.NoError(t, txn.keepOriginLocked(1))
+	require.NoError(t, txn.keepOriginLocked(2))
+
+	// the origin of writes[0] is handed over to a, the others are after a
+	origin := txn.savepoints[1].origins[0]
+	require.NoError(t, txn.ReleaseSavepoint(ctx, "b"))
+	require.Equal(t, 1, len(txn.savepoints))
+	require.Equal(t, 1, len(txn.savepoints[0].origins))
+	require.Equal(t, origin, txn.savepoints[0].origins[0])
+
+	cleanSavepointTestTxn(txn)
+	require.Equal(t, int64(0), m.CurrNB())
+}
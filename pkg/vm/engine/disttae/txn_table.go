@@ -1069,6 +1069,8 @@ func (tbl *txnTable) GetTableDef(ctx context.Context) *plan.TableDef {
 		var primarykey *plan.PrimaryKeyDef
 		var indexes []*plan.IndexDef
 		var refChildTbls []uint64
+		var checks []*plan.CheckDef
+		var generated []*plan.GeneratedColDef
 		var hasRowId bool
 
 		i := int32(0)
@@ -1148,6 +1150,10 @@ func (tbl *txnTable) GetTableDef(ctx context.Context) *plan.TableDef {
 					foreignKeys = k.Fkeys
 				case *engine.RefChildTableDef:
 					refChildTbls = k.Tables
+				case *engine.CheckDef:
+					checks = k.Checks
+				case *engine.GeneratedColDef:
+					generated = k.Cols
 				case *engine.PrimaryKeyDef:
 					primarykey = k.Pkey
 				case *engine.StreamConfigsDef:
@@ -1180,6 +1186,7 @@ func (tbl *txnTable) GetTableDef(ctx context.Context) *plan.TableDef {
 			})
 		}
 
+		plan2.SetGeneratedColDefs(cols, generated)
 		if primarykey != nil && primarykey.PkeyColName == catalog.CPrimaryKeyColName {
 			primarykey.CompPkeyCol = plan2.GetColDefFromTable(cols, catalog.CPrimaryKeyColName)
 		}
@@ -1205,6 +1212,7 @@ func (tbl *txnTable) GetTableDef(ctx context.Context) *plan.TableDef {
 			Partition:     partitionInfo,
 			Fkeys:         foreignKeys,
 			RefChildTbls:  refChildTbls,
+			Checks:        checks,
 			ClusterBy:     clusterByDef,
 			Indexes:       indexes,
 			Version:       tbl.version,
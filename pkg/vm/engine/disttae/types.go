@@ -307,6 +307,8 @@ type Transaction struct {
 	statementID int
 	//offsets of the txn.writes for statements in a txn.
 	offsets []int
+	// savepoints of the txn in the order of creation, see Savepoint.
+	savepoints []*savepoint
 	//for RC isolation, the txn's snapshot TS for each statement.
 
 	transfer struct {
@@ -729,31 +731,11 @@ func (txn *Transaction) RollbackLastStatement(ctx context.Context) error {
 		txn.rollbackTableOpLocked()
 
 		txn.statementID--
-		end := txn.offsets[txn.statementID]
-		if err := txn.gcObjs(end); err != nil {
-			panic("to gc objects generated by CN failed")
-		}
-		for i := end; i < len(txn.writes); i++ {
-			if txn.writes[i].bat == nil {
-				continue
-			}
-			txn.workspaceSize -= uint64(txn.writes[i].bat.Size())
-			txn.writes[i].bat.Clean(txn.proc.Mp())
-		}
-		txn.writes = txn.writes[:end]
+		txn.truncateWritesLocked(txn.offsets[txn.statementID])
 		txn.offsets = txn.offsets[:txn.statementID]
 
 		// transfer stuff
-		if txn.op.Txn().IsRCIsolation() {
-			txn.transfer.timestamps = txn.transfer.timestamps[:txn.statementID]
-
-			if txn.statementID == 0 {
-				txn.transfer.pendingTransfer = false
-				txn.transfer.lastTransferred = types.TS{}
-			} else if txn.transfer.timestamps[txn.statementID-1].Less(txn.transfer.lastTransferred.ToTimestamp()) {
-				txn.transfer.lastTransferred = types.TimestampToTS(txn.transfer.timestamps[txn.statementID-1])
-			}
-		}
+		txn.rollbackTransferLocked()
 	}
 	// rollback current statement's writes info
 	for b := range txn.batchSelectList {
@@ -775,6 +757,37 @@ func (txn *Transaction) RollbackLastStatement(ctx context.Context) error {
 	txn.incrStatementCalled = false
 	return nil
 }
+
+// truncateWritesLocked removes the writes from end, and gc the objects generated by CN for them.
+func (txn *Transaction) truncateWritesLocked(end int) {
+	if err := txn.gcObjs(end); err != nil {
+		panic("to gc objects generated by CN failed")
+	}
+	for i := end; i < len(txn.writes); i++ {
+		if txn.writes[i].bat == nil {
+			continue
+		}
+		txn.workspaceSize -= uint64(txn.writes[i].bat.Size())
+		txn.writes[i].bat.Clean(txn.proc.Mp())
+	}
+	txn.writes = txn.writes[:end]
+}
+
+// rollbackTransferLocked drops the snapshot timestamps of the statements after txn.statementID in RC mode.
+func (txn *Transaction) rollbackTransferLocked() {
+	if !txn.op.Txn().IsRCIsolation() {
+		return
+	}
+	txn.transfer.timestamps = txn.transfer.timestamps[:txn.statementID]
+
+	if txn.statementID == 0 {
+		txn.transfer.pendingTransfer = false
+		txn.transfer.lastTransferred = types.TS{}
+	} else if txn.transfer.timestamps[txn.statementID-1].Less(txn.transfer.lastTransferred.ToTimestamp()) {
+		txn.transfer.lastTransferred = types.TimestampToTS(txn.transfer.timestamps[txn.statementID-1])
+	}
+}
+
 func (txn *Transaction) resetSnapshot() error {
 	txn.tableCache.Range(func(key, value interface{}) bool {
 		value.(*txnTableDelegate).origin.resetSnapshot()
@@ -385,6 +385,10 @@ func (o *testOperator) Rollback(ctx context.Context) error {
 	return nil
 }
 
+func (o *testOperator) XAPrepare(ctx context.Context, xid []byte) error {
+	return nil
+}
+
 func (o *testOperator) Snapshot() (txn.CNTxnSnapshot, error) {
 	return txn.CNTxnSnapshot{}, nil
 }
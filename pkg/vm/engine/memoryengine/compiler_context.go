@@ -98,6 +98,11 @@ func (c *CompilerContext) CheckTimeStampValid(ts int64) (bool, error) {
 	panic("implement me")
 }
 
+func (c *CompilerContext) CheckTableChangesRetained(dbName, tableName string, ts int64) (bool, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
 func (c *CompilerContext) SetQueryingSubscription(meta *plan.SubscriptionMeta) {
 	//TODO implement me
 	panic("implement me")
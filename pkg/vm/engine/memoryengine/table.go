@@ -268,6 +268,8 @@ func (t *Table) GetTableDef(ctx context.Context) *plan.TableDef {
 	var primarykey *plan2.PrimaryKeyDef
 	var indexes []*plan2.IndexDef
 	var refChildTbls []uint64
+	var checks []*plan.CheckDef
+	var generated []*plan.GeneratedColDef
 
 	for _, def := range engineDefs {
 		if attr, ok := def.(*engine.AttributeDef); ok {
@@ -325,6 +327,10 @@ func (t *Table) GetTableDef(ctx context.Context) *plan.TableDef {
 					foreignKeys = k.Fkeys
 				case *engine.RefChildTableDef:
 					refChildTbls = k.Tables
+				case *engine.CheckDef:
+					checks = k.Checks
+				case *engine.GeneratedColDef:
+					generated = k.Cols
 				case *engine.PrimaryKeyDef:
 					primarykey = k.Pkey
 				case *engine.StreamConfigsDef:
@@ -359,6 +365,7 @@ func (t *Table) GetTableDef(ctx context.Context) *plan.TableDef {
 		})
 	}
 
+	plan2.SetGeneratedColDefs(cols, generated)
 	if primarykey != nil && primarykey.PkeyColName == catalog.CPrimaryKeyColName {
 		primarykey.CompPkeyCol = plan2.GetColDefFromTable(cols, catalog.CPrimaryKeyColName)
 	}
@@ -380,6 +387,7 @@ func (t *Table) GetTableDef(ctx context.Context) *plan.TableDef {
 		Partition:    partitionInfo,
 		Fkeys:        foreignKeys,
 		RefChildTbls: refChildTbls,
+		Checks:       checks,
 		ClusterBy:    clusterByDef,
 		Indexes:      indexes,
 		Version:      schemaVersion,
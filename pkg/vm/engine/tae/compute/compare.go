@@ -121,6 +121,8 @@ func Compare(a, b []byte, t types.T, scale1, scale2 int32) int {
 		return compareArrayFromBytes[float32](a, b)
 	case types.T_array_float64:
 		return compareArrayFromBytes[float64](a, b)
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		return types.CompareQuantizedArray(t, a, b)
 	case types.T_any:
 		return 0
 	default:
@@ -192,6 +194,8 @@ func CompareGeneric(a, b any, t types.T) int {
 		return compareArrayFromBytes[float32](a.([]byte), b.([]byte))
 	case types.T_array_float64:
 		return compareArrayFromBytes[float64](a.([]byte), b.([]byte))
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		return types.CompareQuantizedArray(t, a.([]byte), b.([]byte))
 	case types.T_any:
 		return 0
 	default:
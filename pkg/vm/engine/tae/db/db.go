@@ -73,6 +73,9 @@ type DB struct {
 	DBLocker io.Closer
 
 	Closed *atomic.Value
+
+	// xaBranches are the prepared XA txn branches found in replay
+	xaBranches []*txnbase.XAPrepareCmd
 }
 
 func (db *DB) GetUsageMemo() *logtail.TNUsageMemo {
@@ -213,6 +216,9 @@ func (db *DB) Replay(dataFactory *tables.DataFactory, maxTs types.TS, lsn uint64
 	replayer := newReplayer(dataFactory, db, maxTs, lsn, valid)
 	replayer.OnTimeStamp(maxTs)
 	replayer.Replay()
+	for _, branch := range replayer.xaBranches {
+		db.xaBranches = append(db.xaBranches, branch)
+	}
 
 	err := db.TxnMgr.Init(replayer.GetMaxTS())
 
@@ -231,6 +237,7 @@ func (db *DB) Close() error {
 		panic(err)
 	}
 	db.Closed.Store(ErrClosed)
+	db.abandonXABranches()
 	db.GCManager.Stop()
 	db.BGScanner.Stop()
 	db.BGCheckpointRunner.Stop()
@@ -51,10 +51,17 @@ type Replayer struct {
 	ckpedTS       types.TS
 	wg            sync.WaitGroup
 	applyDuration time.Duration
-	txnCmdChan    chan *txnbase.TxnCmd
+	txnCmdChan    chan txnif.TxnCmd
 	readCount     int
 	applyCount    int
 
+	// xaCmds buffers the txn entries of the prepared XA txn branches, they
+	// are applied when the committed state of the branch is replayed, or
+	// replayed as prepared txns at the end of the replay.
+	xaCmds map[string][]*txnbase.TxnCmd
+	// xaBranches are the prepared XA txn branches without final state.
+	xaBranches map[string]*txnbase.XAPrepareCmd
+
 	lsn            uint64
 	enableLSNCheck bool
 }
@@ -68,7 +75,9 @@ func newReplayer(dataFactory *tables.DataFactory, db *DB, ckpedTS types.TS, lsn
 		// for ckp version less than 7, lsn is always 0 and lsnCheck is disable
 		enableLSNCheck: enableLSNCheck,
 		wg:             sync.WaitGroup{},
-		txnCmdChan:     make(chan *txnbase.TxnCmd, 100),
+		txnCmdChan:     make(chan txnif.TxnCmd, 100),
+		xaCmds:         make(map[string][]*txnbase.TxnCmd),
+		xaBranches:     make(map[string]*txnbase.XAPrepareCmd),
 	}
 }
 
@@ -119,6 +128,7 @@ func (replayer *Replayer) Replay() {
 	replayer.txnCmdChan <- txnbase.NewLastTxnCmd()
 	close(replayer.txnCmdChan)
 	replayer.wg.Wait()
+	replayer.replayXABranches()
 	replayer.postReplayWal()
 	logutil.Info("open-tae", common.OperationField("replay"),
 		common.OperandField("wal"),
@@ -132,34 +142,117 @@ func (replayer *Replayer) OnReplayEntry(group uint32, lsn uint64, payload []byte
 	if group != wal.GroupPrepare && group != wal.GroupC {
 		return
 	}
-	if !replayer.checkLSN(lsn) {
+	// GroupC has its own lsn sequence, which is not tracked by checkpoints.
+	// It only holds the states of the 2PC txns.
+	if group == wal.GroupPrepare && !replayer.checkLSN(lsn) {
 		return
 	}
 	head := objectio.DecodeIOEntryHeader(payload)
-	if head.Version < txnbase.IOET_WALTxnEntry_V4 {
+	switch head.Type {
+	case txnbase.IOET_WALTxnEntry:
+		if head.Version < txnbase.IOET_WALTxnEntry_V4 {
+			return
+		}
+	case txnbase.IOET_WALTxnCommand_TxnState, txnbase.IOET_WALTxnCommand_XAPrepare:
+	default:
 		return
 	}
 	codec := objectio.GetIOEntryCodec(*head)
 	entry, err := codec.Decode(payload[4:])
-	txnCmd := entry.(*txnbase.TxnCmd)
-	txnCmd.Lsn = lsn
 	if err != nil {
 		panic(err)
 	}
-	replayer.txnCmdChan <- txnCmd
+	if txnCmd, ok := entry.(*txnbase.TxnCmd); ok {
+		txnCmd.Lsn = lsn
+	}
+	replayer.txnCmdChan <- entry.(txnif.TxnCmd)
 }
 func (replayer *Replayer) applyTxnCmds() {
 	defer replayer.wg.Done()
 	for {
-		txnCmd := <-replayer.txnCmdChan
-		if txnCmd.IsLastCmd() {
-			break
-		}
+		cmd := <-replayer.txnCmdChan
 		t0 := time.Now()
-		replayer.OnReplayTxn(txnCmd, txnCmd.Lsn)
-		txnCmd.Close()
+		switch txnCmd := cmd.(type) {
+		case *txnbase.TxnCmd:
+			if txnCmd.IsLastCmd() {
+				return
+			}
+			if len(txnCmd.XID) > 0 {
+				replayer.onReplayXATxn(txnCmd)
+			} else {
+				replayer.OnReplayTxn(txnCmd, txnCmd.Lsn)
+				txnCmd.Close()
+			}
+		case *txnbase.TxnStateCmd:
+			replayer.onReplayTxnState(txnCmd)
+		case *txnbase.XAPrepareCmd:
+			replayer.xaBranches[txnCmd.ID] = txnCmd
+		}
 		replayer.applyDuration += time.Since(t0)
+	}
+}
 
+// onReplayXATxn buffers the txn entry of the prepared XA txn branch until the
+// final state of the branch is replayed.
+func (replayer *Replayer) onReplayXATxn(txnCmd *txnbase.TxnCmd) {
+	replayer.readCount++
+	if txnCmd.PrepareTS.LT(&replayer.maxTs) {
+		txnCmd.Close()
+		return
+	}
+	replayer.OnTimeStamp(txnCmd.PrepareTS)
+	replayer.xaCmds[txnCmd.ID] = append(replayer.xaCmds[txnCmd.ID], txnCmd)
+}
+
+// replayXABranches replays the XA txn branches without final state as the
+// prepared txns, which are committed or rollbacked by the XA COMMIT or XA
+// ROLLBACK after recovery. The txn entries without XAPrepareCmd are dropped,
+// the branches failed to prepare.
+func (replayer *Replayer) replayXABranches() {
+	for id, cmds := range replayer.xaCmds {
+		branch := replayer.xaBranches[id]
+		if branch == nil || !cmds[0].PrepareTS.EQ(&branch.PrepareTS) {
+			for _, cmd := range cmds {
+				cmd.Close()
+			}
+			delete(replayer.xaBranches, id)
+			continue
+		}
+		// The split txn entries of the branch are replayed as one txn, the
+		// cmds are closed when the branch is committed or rollbacked.
+		txnCmd := cmds[0]
+		for _, cmd := range cmds[1:] {
+			txnCmd.Cmds = append(txnCmd.Cmds, cmd.Cmds...)
+		}
+		replayer.applyCount++
+		replayer.replayTxn(txnCmd, txnCmd.Lsn)
+	}
+	replayer.xaCmds = nil
+}
+
+func (replayer *Replayer) onReplayTxnState(cmd *txnbase.TxnStateCmd) {
+	cmds := replayer.xaCmds[cmd.ID]
+	delete(replayer.xaCmds, cmd.ID)
+	delete(replayer.xaBranches, cmd.ID)
+	for _, txnCmd := range cmds {
+		replayer.applyCount++
+		if cmd.State == txnif.TxnStateCommitted {
+			// The committed XA txn branch is replayed as a 1PC txn, it is
+			// committed at the commit timestamp assigned by XA COMMIT.
+			txnCmd.XID = nil
+			txnCmd.Participants = nil
+			txnCmd.PrepareTS = cmd.CommitTs
+			txnCmd.CommitTS = cmd.CommitTs
+			replayer.replayTxn(txnCmd, txnCmd.Lsn)
+			txnCmd.Close()
+			continue
+		}
+		// The rollbacked XA txn branch is replayed as a prepared txn and
+		// rollbacked without logging, the rows appended by it are kept in
+		// the memory nodes as aborted rows, like before restart.
+		if err := replayer.replayTxn(txnCmd, txnCmd.Lsn).Abandon(); err != nil {
+			panic(err)
+		}
 	}
 }
 func (replayer *Replayer) GetMaxTS() types.TS {
@@ -185,7 +278,6 @@ func (replayer *Replayer) checkLSN(lsn uint64) (needReplay bool) {
 	panic(fmt.Sprintf("invalid lsn %d, current lsn %d", lsn, replayer.lsn))
 }
 func (replayer *Replayer) OnReplayTxn(cmd txnif.TxnCmd, lsn uint64) {
-	var err error
 	replayer.readCount++
 	txnCmd := cmd.(*txnbase.TxnCmd)
 	// If WAL entry splits, they share same prepareTS
@@ -193,6 +285,11 @@ func (replayer *Replayer) OnReplayTxn(cmd txnif.TxnCmd, lsn uint64) {
 		return
 	}
 	replayer.applyCount++
+	replayer.replayTxn(txnCmd, lsn)
+}
+
+func (replayer *Replayer) replayTxn(txnCmd *txnbase.TxnCmd, lsn uint64) *txnbase.Txn {
+	var err error
 	txn := txnimpl.MakeReplayTxn(replayer.db.Runtime.Options.Ctx, replayer.db.TxnMgr, txnCmd.TxnCtx, lsn,
 		txnCmd, replayer, replayer.db.Catalog, replayer.DataFactory, replayer.db.Wal)
 	if err = replayer.db.TxnMgr.OnReplayTxn(txn); err != nil {
@@ -207,4 +304,5 @@ func (replayer *Replayer) OnReplayTxn(cmd txnif.TxnCmd, lsn uint64) {
 			panic(err)
 		}
 	}
+	return txn
 }
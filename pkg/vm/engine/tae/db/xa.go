@@ -0,0 +1,71 @@
+// Copyright 2021 - 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnimpl"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+)
+
+// LogXAPrepare appends the XAPrepareCmd of a prepared XA txn branch into the
+// wal and waits it synced. The branch is replayed as a prepared txn in
+// recovery, if it is not committed or rollbacked before restart.
+func (db *DB) LogXAPrepare(id string, xid []byte, pts types.TS) (err error) {
+	cmd := txnbase.NewXAPrepareCmd(id, xid, pts)
+	var buf []byte
+	if buf, err = cmd.MarshalBinary(); err != nil {
+		return
+	}
+	logEntry := entry.GetBase()
+	logEntry.SetType(txnimpl.IOET_WALEntry_TxnRecord)
+	if err = logEntry.SetPayload(buf); err != nil {
+		return
+	}
+	logEntry.SetInfo(&entry.Info{Group: wal.GroupC})
+	if _, err = db.Wal.AppendEntry(wal.GroupC, logEntry); err != nil {
+		return
+	}
+	if err = logEntry.WaitDone(); err != nil {
+		return
+	}
+	logEntry.Free()
+	return
+}
+
+// TakeXABranches returns the prepared XA txn branches found in replay. The
+// branches are returned only once.
+func (db *DB) TakeXABranches() []*txnbase.XAPrepareCmd {
+	branches := db.xaBranches
+	db.xaBranches = nil
+	return branches
+}
+
+// abandonXABranches rollbacks the prepared XA txn branches in memory, the
+// prepared branch blocks the logtail and checkpoint, which makes the close
+// never returns. The branches are replayed from the wal after restart.
+func (db *DB) abandonXABranches() {
+	db.TxnMgr.IDMap.Range(func(_, value any) bool {
+		txn := value.(txnif.AsyncTxn)
+		if len(txn.GetXID()) > 0 &&
+			txn.GetTxnState(false) == txnif.TxnStatePrepared {
+			_ = txn.Abandon()
+		}
+		return true
+	})
+}
@@ -64,6 +64,7 @@ type TxnReader interface {
 
 	GetPrepareTS() types.TS
 	GetParticipants() []uint64
+	GetXID() []byte
 	GetSnapshotTS() types.TS
 	SetSnapshotTS(types.TS)
 	SetStartTS(types.TS)
@@ -114,9 +115,11 @@ type TxnChanger interface {
 	Committing() error
 	Commit(ctx context.Context) error
 	Rollback(ctx context.Context) error
+	Abandon() error
 	SetCommitTS(cts types.TS) error
 	SetDedupType(skip DedupPolicy)
 	SetParticipants(ids []uint64) error
+	SetXID(xid []byte) error
 	SetError(error)
 
 	CommittingInRecovery() error
@@ -306,6 +309,7 @@ type TxnStore interface {
 	LogTxnState(sync bool) (entry.Entry, error)
 	DoneWaitEvent(cnt int)
 	AddWaitEvent(cnt int)
+	WaitEvent()
 
 	IsReadonly() bool
 	IncreateWriteCnt() int
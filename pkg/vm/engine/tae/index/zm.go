@@ -158,7 +158,7 @@ func (zm ZM) IsString() bool {
 }
 
 func (zm ZM) IsArray() bool {
-	return zm.GetType().IsArrayRelate()
+	return zm.GetType().IsVector()
 }
 
 func (zm ZM) Valid() bool {
@@ -444,6 +444,8 @@ func (zm ZM) getValue(buf []byte) any {
 		return types.BytesToArray[float32](buf)
 	case types.T_array_float64:
 		return types.BytesToArray[float64](buf)
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		return types.QuantizedArrayToFloat32(zm.GetType(), buf)
 	}
 	panic(fmt.Sprintf("unsupported type: %v", zm.GetType()))
 }
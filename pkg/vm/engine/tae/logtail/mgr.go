@@ -152,7 +152,7 @@ func (mgr *Manager) Start() {
 func (mgr *Manager) generateLogtailWithTxn(txn *txnWithLogtails) {
 	callback := mgr.logtailCallback.Load()
 	if callback != nil {
-		to := txn.txn.GetPrepareTS()
+		to := logtailTS(txn.txn)
 		var from types.TS
 		if mgr.previousSaveTS.IsEmpty() {
 			from = to
@@ -180,10 +180,30 @@ func (mgr *Manager) OnEndPrePrepare(txn txnif.AsyncTxn) {
 	mgr.table.AddTxn(txn)
 }
 func (mgr *Manager) OnEndPrepareWAL(txn txnif.AsyncTxn) {
+	// The logtail of the XA txn branch is collected when it is committed with
+	// a new commit timestamp, so the prepared branch does not block the logtail
+	// of the txns committed after it until XA COMMIT or XA ROLLBACK.
+	if isPreparingXABranch(txn) {
+		return
+	}
 	txn.GetStore().AddWaitEvent(1)
 	mgr.collectLogtailQueue.Enqueue(txn)
 }
 
+func isPreparingXABranch(txn txnif.AsyncTxn) bool {
+	return len(txn.GetXID()) > 0 &&
+		txn.GetTxnState(false) == txnif.TxnStatePreparing
+}
+
+// logtailTS returns the timestamp of the logtail of the txn, the logtail of
+// the XA txn branch is collected at commit with the commit timestamp.
+func logtailTS(txn txnif.AsyncTxn) types.TS {
+	if len(txn.GetXID()) > 0 {
+		return txn.GetCommitTS()
+	}
+	return txn.GetPrepareTS()
+}
+
 // GetReader get a snapshot of all txn prepared between from and to.
 func (mgr *Manager) GetReader(from, to types.TS) *Reader {
 	return &Reader{
@@ -56,6 +56,7 @@ type TxnLogtailRespBuilder struct {
 	currentAccID              uint32
 	currentPkSeqnum           uint32
 	txn                       txnif.AsyncTxn
+	ts                        types.TS
 
 	batches []*containers.Batch
 
@@ -90,6 +91,7 @@ func (b *TxnLogtailRespBuilder) CollectLogtail(txn txnif.AsyncTxn) (*[]logtail.T
 	}()
 
 	b.txn = txn
+	b.ts = logtailTS(txn)
 	txn.GetStore().ObserveTxn(
 		b.visitDatabase,
 		b.visitTable,
@@ -116,14 +118,14 @@ func (b *TxnLogtailRespBuilder) visitObject(iobj any) {
 		if b.batches[batchIdx] == nil {
 			b.batches[batchIdx] = makeRespBatchFromSchema(ObjectInfoSchema, common.LogtailAllocator)
 		}
-		visitObject(b.batches[batchIdx], obj, node, true, true, b.txn.GetPrepareTS())
+		visitObject(b.batches[batchIdx], obj, node, true, true, b.ts)
 		return
 	}
 
 	if b.batches[batchIdx] == nil {
 		b.batches[batchIdx] = makeRespBatchFromSchema(ObjectInfoSchema, common.LogtailAllocator)
 	}
-	visitObject(b.batches[batchIdx], obj, node, false, true, b.txn.GetPrepareTS())
+	visitObject(b.batches[batchIdx], obj, node, false, true, b.ts)
 }
 
 func (b *TxnLogtailRespBuilder) visitAppend(ibat any, isTombstone bool) {
@@ -146,7 +148,7 @@ func (b *TxnLogtailRespBuilder) visitAppendTombstone(src *containers.BatchWithVe
 	commitVec := b.rt.VectorPool.Small.GetVector(&tsType)
 	commitVec.PreExtend(src.Length())
 	for i := 0; i < src.Length(); i++ {
-		commitVec.Append(b.txn.GetPrepareTS(), false)
+		commitVec.Append(b.ts, false)
 	}
 	mybat.AddVector(objectio.DefaultCommitTS_Attr, commitVec)
 	mybat.AddVector(
@@ -176,7 +178,7 @@ func (b *TxnLogtailRespBuilder) visitAppendData(src *containers.BatchWithVersion
 	commitVec := b.rt.VectorPool.Small.GetVector(&tsType)
 	commitVec.PreExtend(src.Length())
 	for i := 0; i < src.Length(); i++ {
-		commitVec.Append(b.txn.GetPrepareTS(), false)
+		commitVec.Append(b.ts, false)
 	}
 	mybat.AddVector(objectio.DefaultCommitTS_Attr, commitVec)
 
@@ -221,7 +223,7 @@ func (b *TxnLogtailRespBuilder) buildLogtailEntry(tid, dbid uint64, tableName, d
 	common.DoIfDebugEnabled(func() {
 		logutil.Debugf(
 			"[logtail] from table %d-%s, delete %v, batch length %d @%s",
-			tid, tableName, entryType, bat.Length(), b.txn.GetPrepareTS().ToString(),
+			tid, tableName, entryType, bat.Length(), b.ts.ToString(),
 		)
 	})
 	if err != nil {
@@ -242,7 +244,7 @@ func (b *TxnLogtailRespBuilder) buildLogtailEntry(tid, dbid uint64, tableName, d
 		DatabaseName: dbName,
 		Bat:          apiBat,
 	}
-	ts := b.txn.GetPrepareTS().ToTimestamp()
+	ts := b.ts.ToTimestamp()
 	tableID := &api.TableID{
 		AccId:         b.currentAccID,
 		DbId:          dbid,
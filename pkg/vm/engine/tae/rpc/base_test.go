@@ -36,6 +36,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/require"
 )
 
 const ModuleName = "TAEHANDLE"
@@ -162,6 +163,22 @@ func mockTAEHandle(ctx context.Context, t *testing.T, opts *options.Options) *mo
 	return mh
 }
 
+// restartTAEHandle closes the handle and opens a new handle on the same dir
+func restartTAEHandle(ctx context.Context, t *testing.T, h *mockHandle, opts *options.Options) *mockHandle {
+	dir := h.db.Dir
+	require.NoError(t, h.HandleClose(ctx))
+	tae, err := db.Open(ctx, dir, opts)
+	require.NoError(t, err)
+	mh := &mockHandle{
+		m: h.m,
+	}
+	mh.Handle = &Handle{
+		db: tae,
+	}
+	mh.Handle.txnCtxs = common.NewMap[string, *txnContext](runtime.GOMAXPROCS(0))
+	return mh
+}
+
 func mock1PCTxn(db *db.DB) *txn.TxnMeta {
 	txnMeta := &txn.TxnMeta{}
 	txnMeta.ID = db.TxnMgr.IdAlloc.Alloc()
@@ -79,6 +79,9 @@ type txnContext struct {
 	deadline time.Time
 	meta     txn.TxnMeta
 	reqs     []any
+	// writes are the pre-commit write requests of the txn, they are recorded
+	// into wal if the txn is prepared as a XA txn branch.
+	writes []*api.PrecommitWriteCmd
 }
 
 //#region Open
@@ -398,6 +401,9 @@ func (h *Handle) HandlePreCommitWrite(
 			return moerr.NewNYIf(ctx, "pre commit write type: %T", cmds)
 		}
 	}
+	if txnCtx, ok := h.txnCtxs.Load(util.UnsafeBytesToString(meta.GetID())); ok {
+		txnCtx.writes = append(txnCtx.writes, req)
+	}
 	//evaluate all the txn requests.
 	return h.TryPrefetchTxn(ctx, &meta)
 }
@@ -16,12 +16,17 @@ package rpc
 
 import (
 	"context"
+	"encoding/hex"
+
+	"go.uber.org/zap"
 
 	"github.com/matrixorigin/matrixone/pkg/common/util"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 )
 
 ///
@@ -46,8 +51,14 @@ func (h *Handle) HandlePrepare(
 	ctx context.Context,
 	meta txn.TxnMeta) (pts timestamp.Timestamp, err error) {
 	txnCtx, ok := h.txnCtxs.Load(util.UnsafeBytesToString(meta.GetID()))
-	var txn txnif.AsyncTxn
+	var (
+		txn      txnif.AsyncTxn
+		releaseF []func()
+	)
 	defer func() {
+		for _, f := range releaseF {
+			f()
+		}
 		if ok {
 			//delete the txn's context.
 			h.txnCtxs.Delete(util.UnsafeBytesToString(meta.GetID()))
@@ -60,7 +71,15 @@ func (h *Handle) HandlePrepare(
 		if err != nil {
 			return
 		}
-		h.handleRequests(ctx, txn, txnCtx)
+		if releaseF, _, err = h.handleRequests(ctx, txn, txnCtx); err != nil {
+			return
+		}
+	} else if len(meta.XID) > 0 {
+		//the XA txn branch without any write is prepared as an empty txn
+		if _, err = h.db.GetOrCreateTxnWithMeta(nil, meta.GetID(),
+			types.TimestampToTS(meta.GetSnapshotTS())); err != nil {
+			return
+		}
 	}
 	txn, err = h.db.GetTxnByID(meta.GetID())
 	if err != nil {
@@ -71,18 +90,76 @@ func (h *Handle) HandlePrepare(
 		participants = append(participants, shard.GetShardID())
 	}
 	txn.SetParticipants(participants)
+	if len(meta.XID) > 0 {
+		txn.SetXID(meta.XID)
+	}
 	var ts types.TS
 	ts, err = txn.Prepare(ctx)
 	pts = ts.ToTimestamp()
+	if err == nil && len(meta.XID) > 0 {
+		err = h.logXAPrepare(ctx, txn, meta, ts)
+	}
 	return
 }
 
+// logXAPrepare records the prepared XA txn branch, the branch can not be
+// recovered after restart without the record, so it is rollbacked if the
+// record is failed to write.
+func (h *Handle) logXAPrepare(
+	ctx context.Context,
+	txn txnif.AsyncTxn,
+	meta txn.TxnMeta,
+	pts types.TS) (err error) {
+	if err = h.db.LogXAPrepare(txn.GetID(), meta.XID, pts); err != nil {
+		_ = txn.Rollback(ctx)
+	}
+	return
+}
+
+// HandleStartRecovery feeds the XA txn branches found in replay into ch.
 func (h *Handle) HandleStartRecovery(
 	ctx context.Context,
 	ch chan txn.TxnMeta) {
-	//panic(moerr.NewNYI("HandleStartRecovery is not implemented yet"))
-	//TODO:: 1.  Get the 2PC transactions which be in prepared or
-	//           committing state from txn engine's recovery.
-	//       2.  Feed these transaction into ch.
-	close(ch)
+	defer close(ch)
+	//TODO:: Get the 2PC transactions which be in prepared or committing
+	//       state from txn engine's recovery.
+	for _, branch := range h.db.TakeXABranches() {
+		meta, err := h.recoverXABranch(ctx, branch)
+		if err != nil {
+			logutil.Error(
+				"TAE-RECOVER-XA-BRANCH",
+				zap.String("txn", hex.EncodeToString([]byte(branch.ID))),
+				zap.String("xid", hex.EncodeToString(branch.XID)),
+				zap.Error(err),
+			)
+			continue
+		}
+		ch <- meta
+	}
+}
+
+// recoverXABranch returns the meta of the XA txn branch found in replay. The
+// branch with writes is replayed from wal as a prepared txn with its prepare
+// timestamp. The read only branch has no txn entry in wal, so it is prepared
+// as an empty txn again.
+func (h *Handle) recoverXABranch(
+	ctx context.Context,
+	branch *txnbase.XAPrepareCmd) (meta txn.TxnMeta, err error) {
+	meta = txn.TxnMeta{
+		ID:  []byte(branch.ID),
+		XID: branch.XID,
+	}
+	if replayed, e := h.db.GetTxnByID(meta.ID); e == nil {
+		meta.Status = txn.TxnStatus_Prepared
+		meta.SnapshotTS = replayed.GetStartTS().ToTimestamp()
+		meta.PreparedTS = replayed.GetPrepareTS().ToTimestamp()
+		return
+	}
+	meta.Status = txn.TxnStatus_Active
+	meta.SnapshotTS = h.db.TxnMgr.Now().ToTimestamp()
+	if meta.PreparedTS, err = h.HandlePrepare(ctx, meta); err != nil {
+		return
+	}
+	meta.Status = txn.TxnStatus_Prepared
+	return
 }
@@ -16,6 +16,7 @@ package rpc
 
 import (
 	"context"
+	"sort"
 	"strconv"
 	"sync"
 	"testing"
@@ -28,7 +29,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
@@ -1898,3 +1901,169 @@ func TestApplyDeltaloc(t *testing.T) {
 	}
 	assert.NoError(t, txn0.Commit(context.Background()))
 }
+
+func TestHandle_XAPrepareAndRecover(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	ctx := context.Background()
+	opts := config.WithLongScanAndCKPOpts(nil)
+	handle := mockTAEHandle(ctx, t, opts)
+	schema := catalog.MockSchemaAll(2, 1)
+	schema.Name = "tbtest"
+	dbName := "dbtest"
+	dbH, tbH := testutil.CreateRelation(t, handle.db, dbName, schema, true)
+	dbID, tbID := dbH.GetID(), tbH.ID()
+
+	bats := catalog.MockBatch(schema, 40).Split(4)
+	xaPrepare := func(xid string, bat *containers.Batch) *txn.TxnMeta {
+		entry, err := makePBEntry(INSERT, dbID, tbID, dbName, schema.Name, "", containers.ToCNBatch(bat))
+		require.NoError(t, err)
+		meta := mock1PCTxn(handle.db)
+		meta.XID = []byte(xid)
+		require.NoError(t, handle.handleCmds(ctx, meta, []txnCommand{
+			{typ: CmdPreCommitWrite, cmd: api.PrecommitWriteCmd{EntryList: []*api.Entry{entry}}},
+			{typ: CmdPrepare},
+		}))
+		require.False(t, meta.PreparedTS.IsEmpty())
+		return meta
+	}
+	recoverBranches := func() []txn.TxnMeta {
+		ch := make(chan txn.TxnMeta)
+		go handle.HandleStartRecovery(ctx, ch)
+		var recovered []txn.TxnMeta
+		for meta := range ch {
+			recovered = append(recovered, meta)
+		}
+		return recovered
+	}
+	checkRows := func(expect int) {
+		txn, rel := testutil.GetRelation(t, 0, handle.db, dbName, schema.Name)
+		testutil.CheckAllColRowsByScan(t, rel, expect, true)
+		require.NoError(t, txn.Commit(ctx))
+	}
+
+	// the prepared branch blocks the other txns on the same table, resolve
+	// it before the next one.
+	committed := xaPrepare("xa-committed", bats[0])
+	require.NoError(t, handle.handleCmds(ctx, committed, []txnCommand{{typ: CmdCommit}}))
+	pending := xaPrepare("xa-pending", bats[1])
+	readonly := mock1PCTxn(handle.db)
+	readonly.XID = []byte("xa-readonly")
+	require.NoError(t, handle.handleCmds(ctx, readonly, []txnCommand{{typ: CmdPrepare}}))
+
+	// the pending branches are replayed with the prepare timestamp after restart
+	handle = restartTAEHandle(ctx, t, handle, opts)
+	recovered := recoverBranches()
+	require.Equal(t, 2, len(recovered))
+	sort.Slice(recovered, func(i, j int) bool {
+		return string(recovered[i].XID) < string(recovered[j].XID)
+	})
+	require.Equal(t, pending.ID, recovered[0].ID)
+	require.Equal(t, pending.XID, recovered[0].XID)
+	require.Equal(t, pending.PreparedTS, recovered[0].PreparedTS)
+	require.Equal(t, txn.TxnStatus_Prepared, recovered[0].Status)
+	require.Equal(t, readonly.ID, recovered[1].ID)
+	require.Equal(t, txn.TxnStatus_Prepared, recovered[1].Status)
+
+	meta := recovered[0]
+	require.NoError(t, handle.handleCmds(ctx, &meta, []txnCommand{{typ: CmdCommit}}))
+	require.NoError(t, handle.handleCmds(ctx, &recovered[1], []txnCommand{{typ: CmdRollback}}))
+	checkRows(20)
+
+	rollbacked := xaPrepare("xa-rollbacked", bats[2])
+	require.NoError(t, handle.handleCmds(ctx, rollbacked, []txnCommand{{typ: CmdRollback}}))
+	checkRows(20)
+
+	// the replayed branch is rollbacked after restart
+	pending = xaPrepare("xa-pending-rollbacked", bats[3])
+	handle = restartTAEHandle(ctx, t, handle, opts)
+	recovered = recoverBranches()
+	require.Equal(t, 1, len(recovered))
+	require.Equal(t, pending.PreparedTS, recovered[0].PreparedTS)
+	require.NoError(t, handle.handleCmds(ctx, &recovered[0], []txnCommand{{typ: CmdRollback}}))
+	checkRows(20)
+
+	// the final states of the replayed branches are logged
+	handle = restartTAEHandle(ctx, t, handle, opts)
+	defer handle.HandleClose(ctx)
+	require.Empty(t, recoverBranches())
+	checkRows(20)
+}
+
+func TestHandle_XAPreparedNotBlockLogtail(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	ctx := context.Background()
+	opts := config.WithLongScanAndCKPOpts(nil)
+	handle := mockTAEHandle(ctx, t, opts)
+	defer handle.HandleClose(ctx)
+	dbName := "dbtest"
+	xaSchema := catalog.MockSchemaAll(2, 1)
+	xaSchema.Name = "xatbl"
+	schema := catalog.MockSchemaAll(2, 1)
+	schema.Name = "tbl"
+	dbH, xaTbH := testutil.CreateRelation(t, handle.db, dbName, xaSchema, true)
+	_, tbH := testutil.CreateRelation(t, handle.db, dbName, schema, false)
+
+	var mu sync.Mutex
+	published := make(map[uint64][]timestamp.Timestamp)
+	require.NoError(t, handle.db.LogtailMgr.RegisterCallback(
+		func(from, to timestamp.Timestamp, closeCB func(), tails ...logtail.TableLogtail) error {
+			defer closeCB()
+			mu.Lock()
+			defer mu.Unlock()
+			for _, tail := range tails {
+				published[tail.Table.TbId] = append(published[tail.Table.TbId], *tail.Ts)
+			}
+			return nil
+		}))
+	isPublished := func(tid uint64, ts timestamp.Timestamp) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, pts := range published[tid] {
+			if pts.Equal(ts) {
+				return true
+			}
+		}
+		return false
+	}
+	write := func(meta *txn.TxnMeta, schema *catalog.Schema, tid uint64) {
+		bat := catalog.MockBatch(schema, 10)
+		defer bat.Close()
+		entry, err := makePBEntry(INSERT, dbH.GetID(), tid, dbName, schema.Name, "", containers.ToCNBatch(bat))
+		require.NoError(t, err)
+		require.NoError(t, handle.handleCmds(ctx, meta, []txnCommand{
+			{typ: CmdPreCommitWrite, cmd: api.PrecommitWriteCmd{EntryList: []*api.Entry{entry}}},
+		}))
+	}
+
+	branch := mock1PCTxn(handle.db)
+	branch.XID = []byte("xa-branch")
+	write(branch, xaSchema, xaTbH.ID())
+	require.NoError(t, handle.handleCmds(ctx, branch, []txnCommand{{typ: CmdPrepare}}))
+
+	// the logtail of the autocommit txn is published while the branch is
+	// prepared
+	autocommit := mock1PCTxn(handle.db)
+	write(autocommit, schema, tbH.ID())
+	cts, err := handle.HandleCommit(ctx, autocommit)
+	require.NoError(t, err)
+	testutils.WaitExpect(4000, func() bool {
+		return isPublished(tbH.ID(), cts)
+	})
+	require.True(t, isPublished(tbH.ID(), cts))
+	mu.Lock()
+	require.Empty(t, published[xaTbH.ID()])
+	mu.Unlock()
+
+	// the branch is committed after the published logtail
+	xaCTS, err := handle.HandleCommit(ctx, branch)
+	require.NoError(t, err)
+	require.True(t, cts.Less(xaCTS))
+	testutils.WaitExpect(4000, func() bool {
+		return isPublished(xaTbH.ID(), xaCTS)
+	})
+	require.True(t, isPublished(xaTbH.ID(), xaCTS))
+
+	txn, rel := testutil.GetRelation(t, 0, handle.db, dbName, xaSchema.Name)
+	testutil.CheckAllColRowsByScan(t, rel, 10, true)
+	require.NoError(t, txn.Commit(ctx))
+}
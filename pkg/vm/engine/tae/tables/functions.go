@@ -67,6 +67,9 @@ var getDuplicatedRowIDNABlkFunctions = map[types.T]any{
 
 	types.T_array_float32: getDuplicatedRowIDsNABlkBytesFunc,
 	types.T_array_float64: getDuplicatedRowIDsNABlkBytesFunc,
+	types.T_array_float16: getDuplicatedRowIDsNABlkBytesFunc,
+	types.T_array_int8:    getDuplicatedRowIDsNABlkBytesFunc,
+	types.T_array_bit:     getDuplicatedRowIDsNABlkBytesFunc,
 }
 
 var getRowIDAlkFunctions = map[types.T]any{
@@ -106,6 +109,9 @@ var getRowIDAlkFunctions = map[types.T]any{
 
 	types.T_array_float32: getDuplicatedRowIDABlkBytesFunc,
 	types.T_array_float64: getDuplicatedRowIDABlkBytesFunc,
+	types.T_array_float16: getDuplicatedRowIDABlkBytesFunc,
+	types.T_array_int8:    getDuplicatedRowIDABlkBytesFunc,
+	types.T_array_bit:     getDuplicatedRowIDABlkBytesFunc,
 }
 var containsNABlkFunctions = map[types.T]any{
 	types.T_Rowid: containsNABlkFuncFactory(types.CompareRowidRowidAligned),
@@ -28,20 +28,24 @@ import (
 )
 
 const (
-	IOET_WALTxnEntry            uint16 = 3000
-	IOET_WALTxnCommand_Composed uint16 = 3001
-	IOET_WALTxnCommand_TxnState uint16 = 3002
-
-	IOET_WALTxnEntry_V1            uint16 = 1
-	IOET_WALTxnEntry_V2            uint16 = 2
-	IOET_WALTxnEntry_V3            uint16 = 3
-	IOET_WALTxnEntry_V4            uint16 = 4
-	IOET_WALTxnCommand_Composed_V1 uint16 = 1
-	IOET_WALTxnCommand_TxnState_V1 uint16 = 1
-
-	IOET_WALTxnEntry_CurrVer            = IOET_WALTxnEntry_V4
-	IOET_WALTxnCommand_Composed_CurrVer = IOET_WALTxnCommand_Composed_V1
-	IOET_WALTxnCommand_TxnState_CurrVer = IOET_WALTxnCommand_TxnState_V1
+	IOET_WALTxnEntry             uint16 = 3000
+	IOET_WALTxnCommand_Composed  uint16 = 3001
+	IOET_WALTxnCommand_TxnState  uint16 = 3002
+	IOET_WALTxnCommand_XAPrepare uint16 = 3003
+
+	IOET_WALTxnEntry_V1             uint16 = 1
+	IOET_WALTxnEntry_V2             uint16 = 2
+	IOET_WALTxnEntry_V3             uint16 = 3
+	IOET_WALTxnEntry_V4             uint16 = 4
+	IOET_WALTxnEntry_V5             uint16 = 5
+	IOET_WALTxnCommand_Composed_V1  uint16 = 1
+	IOET_WALTxnCommand_TxnState_V1  uint16 = 1
+	IOET_WALTxnCommand_XAPrepare_V1 uint16 = 1
+
+	IOET_WALTxnEntry_CurrVer             = IOET_WALTxnEntry_V5
+	IOET_WALTxnCommand_Composed_CurrVer  = IOET_WALTxnCommand_Composed_V1
+	IOET_WALTxnCommand_TxnState_CurrVer  = IOET_WALTxnCommand_TxnState_V1
+	IOET_WALTxnCommand_XAPrepare_CurrVer = IOET_WALTxnCommand_XAPrepare_V1
 
 	// CmdBufReserved is reserved size of cmd buffer, mainly the size of TxnCtx.Memo.
 	// ComposedCmd.CmdBufLimit is the max buffer size that could be sent out to log-service.
@@ -89,6 +93,18 @@ func init() {
 			return txnEntry, err
 		},
 	)
+	objectio.RegisterIOEnrtyCodec(
+		objectio.IOEntryHeader{
+			Type:    IOET_WALTxnEntry,
+			Version: IOET_WALTxnEntry_V5,
+		},
+		nil,
+		func(b []byte) (any, error) {
+			txnEntry := NewEmptyTxnCmd()
+			err := txnEntry.UnmarshalBinaryWithVersion(b, IOET_WALTxnEntry_V5)
+			return txnEntry, err
+		},
+	)
 	objectio.RegisterIOEnrtyCodec(
 		objectio.IOEntryHeader{
 			Type:    IOET_WALTxnCommand_Composed,
@@ -113,6 +129,18 @@ func init() {
 			return txnEntry, err
 		},
 	)
+	objectio.RegisterIOEnrtyCodec(
+		objectio.IOEntryHeader{
+			Type:    IOET_WALTxnCommand_XAPrepare,
+			Version: IOET_WALTxnCommand_XAPrepare_V1,
+		},
+		nil,
+		func(b []byte) (any, error) {
+			txnEntry := NewEmptyXAPrepareCmd()
+			err := txnEntry.UnmarshalBinary(b)
+			return txnEntry, err
+		},
+	)
 }
 
 type BaseCmd struct{}
@@ -135,6 +163,15 @@ type TxnStateCmd struct {
 	CommitTs types.TS
 }
 
+// XAPrepareCmd records the xid and the prepare timestamp of a prepared XA
+// transaction branch. The branch is replayed as a prepared txn from its txn
+// entries in recovery, until a TxnStateCmd of the branch is found.
+type XAPrepareCmd struct {
+	ID        string
+	XID       []byte
+	PrepareTS types.TS
+}
+
 type ComposedCmd struct {
 	Cmds []txnif.TxnCmd
 
@@ -262,6 +299,90 @@ func (c *TxnStateCmd) VerboseString() string {
 func (c *TxnStateCmd) Close() {
 }
 
+func NewEmptyXAPrepareCmd() *XAPrepareCmd {
+	return &XAPrepareCmd{}
+}
+
+func NewXAPrepareCmd(id string, xid []byte, pts types.TS) *XAPrepareCmd {
+	return &XAPrepareCmd{
+		ID:        id,
+		XID:       xid,
+		PrepareTS: pts,
+	}
+}
+
+func (c *XAPrepareCmd) WriteTo(w io.Writer) (n int64, err error) {
+	t := c.GetType()
+	if _, err = w.Write(types.EncodeUint16(&t)); err != nil {
+		return
+	}
+	n += 2
+	ver := IOET_WALTxnCommand_XAPrepare_CurrVer
+	if _, err = w.Write(types.EncodeUint16(&ver)); err != nil {
+		return
+	}
+	n += 2
+	var sn int64
+	if sn, err = objectio.WriteString(c.ID, w); err != nil {
+		return
+	}
+	n += sn
+	if sn, err = objectio.WriteBytes(c.XID, w); err != nil {
+		return
+	}
+	n += sn
+	if _, err = w.Write(c.PrepareTS[:]); err != nil {
+		return
+	}
+	n += types.TxnTsSize
+	return
+}
+
+func (c *XAPrepareCmd) ReadFrom(r io.Reader) (n int64, err error) {
+	var sn int64
+	if c.ID, sn, err = objectio.ReadString(r); err != nil {
+		return
+	}
+	n += sn
+	if c.XID, sn, err = objectio.ReadBytes(r); err != nil {
+		return
+	}
+	n += sn
+	if _, err = r.Read(c.PrepareTS[:]); err != nil {
+		return
+	}
+	n += types.TxnTsSize
+	return
+}
+func (c *XAPrepareCmd) MarshalBinary() (buf []byte, err error) {
+	var bbuf bytes.Buffer
+	if _, err = c.WriteTo(&bbuf); err != nil {
+		return
+	}
+	buf = bbuf.Bytes()
+	return
+}
+func (c *XAPrepareCmd) ApplyCommit()                  {}
+func (c *XAPrepareCmd) ApplyRollback()                {}
+func (c *XAPrepareCmd) SetReplayTxn(_ txnif.AsyncTxn) {}
+func (c *XAPrepareCmd) UnmarshalBinary(buf []byte) (err error) {
+	bbuf := bytes.NewBuffer(buf)
+	_, err = c.ReadFrom(bbuf)
+	return err
+}
+func (c *XAPrepareCmd) GetType() uint16 { return IOET_WALTxnCommand_XAPrepare }
+func (c *XAPrepareCmd) Desc() string {
+	return fmt.Sprintf("Tid=%X,Xid=%X,Pts=%s", c.ID, c.XID, c.PrepareTS.ToString())
+}
+func (c *XAPrepareCmd) String() string {
+	return fmt.Sprintf("Tid=%X,Xid=%X,Pts=%s", c.ID, c.XID, c.PrepareTS.ToString())
+}
+func (c *XAPrepareCmd) VerboseString() string {
+	return fmt.Sprintf("Tid=%X,Xid=%X,Pts=%s", c.ID, c.XID, c.PrepareTS.ToString())
+}
+func (c *XAPrepareCmd) Close() {
+}
+
 func NewTxnCmd(maxMessageSize uint64) *TxnCmd {
 	return &TxnCmd{
 		ComposedCmd: NewComposedCmd(maxMessageSize),
@@ -294,6 +415,7 @@ func (c *TxnCmd) SetTxn(txn txnif.AsyncTxn) {
 	c.StartTS = txn.GetStartTS()
 	c.PrepareTS = txn.GetPrepareTS()
 	c.Participants = txn.GetParticipants()
+	c.XID = txn.GetXID()
 	c.Memo = txn.GetMemo()
 }
 func (c *TxnCmd) WriteTo(w io.Writer) (n int64, err error) {
@@ -346,6 +468,10 @@ func (c *TxnCmd) WriteTo(w io.Writer) (n int64, err error) {
 		return
 	}
 	n += sn
+	if sn, err = objectio.WriteBytes(c.XID, w); err != nil {
+		return
+	}
+	n += sn
 	return
 }
 func (c *TxnCmd) ReadFromWithVersion(r io.Reader, ver uint16) (n int64, err error) {
@@ -370,7 +496,7 @@ func (c *TxnCmd) ReadFromWithVersion(r io.Reader, ver uint16) (n int64, err erro
 		return
 	}
 	n += 4
-	c.Participants = make([]uint64, num)
+	c.Participants = make([]uint64, 0, num)
 	for i := 0; i < int(num); i++ {
 		id := uint64(0)
 		if _, err = r.Read(types.EncodeUint64(&id)); err != nil {
@@ -392,6 +518,12 @@ func (c *TxnCmd) ReadFromWithVersion(r io.Reader, ver uint16) (n int64, err erro
 		return
 	}
 	n += sn
+	if ver >= IOET_WALTxnEntry_V5 {
+		if c.XID, sn, err = objectio.ReadBytes(r); err != nil {
+			return
+		}
+		n += sn
+	}
 	return
 }
 
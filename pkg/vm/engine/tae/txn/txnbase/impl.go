@@ -113,6 +113,15 @@ func (txn *Txn) rollback2PC(ctx context.Context) (err error) {
 		_ = txn.ApplyRollback()
 		txn.DoneWithErr(nil, true)
 
+		// Skip logging for the replayed txn, except the XA txn branch which is
+		// rollbacked by XA ROLLBACK after replay
+		if !txn.IsReplay() || len(txn.GetXID()) > 0 {
+			//Append rollbacked log entry, and wait it synced.
+			if _, err = txn.LogTxnState(true); err != nil {
+				panic(err)
+			}
+		}
+
 	default:
 		logutil.Warnf("unexpected txn state : %s", txnif.TxnStrState(state))
 		return moerr.NewTAERollbackNoCtxf("unexpected txn status : %s", txnif.TxnStrState(state))
@@ -123,6 +132,31 @@ func (txn *Txn) rollback2PC(ctx context.Context) (err error) {
 	return txn.GetError()
 }
 
+// commitXA commits the prepared XA txn branch. The branch is assigned a new
+// commit timestamp by the TxnManager in order with the other txns, and its
+// logtail is collected at commit, so a prepared branch never holds back the
+// logtail of the txns committed after it.
+func (txn *Txn) commitXA(ctx context.Context) (err error) {
+	txn.Add(1)
+	if err = txn.Mgr.OnOpTxn(&OpTxn{
+		ctx: ctx,
+		Txn: txn,
+		Op:  OpCommitXA,
+	}); err != nil {
+		txn.WaitGroup.Done()
+		return
+	}
+	txn.Wait()
+
+	//Append committed log entry ,and wait it synced.
+	if _, err = txn.LogTxnState(true); err != nil {
+		panic(err)
+	}
+	txn.Mgr.DeleteTxn(txn.GetID())
+
+	return txn.GetError()
+}
+
 func (txn *Txn) commit2PC(inRecovery bool) (err error) {
 	state := txn.GetTxnState(false)
 	txn.Mgr.OnCommitTxn(txn)
@@ -63,6 +63,7 @@ func (store *NoopTxnStore) PrepareWAL() error                    { return nil }
 
 func (store *NoopTxnStore) DoneWaitEvent(cnt int)                                  {}
 func (store *NoopTxnStore) AddWaitEvent(cnt int)                                   {}
+func (store *NoopTxnStore) WaitEvent()                                             {}
 func (store *NoopTxnStore) AddTxnEntry(t txnif.TxnEntryType, entry txnif.TxnEntry) {}
 
 func (store *NoopTxnStore) CreateRelation(dbId uint64, def any) (rel handle.Relation, err error) {
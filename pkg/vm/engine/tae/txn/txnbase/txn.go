@@ -37,6 +37,8 @@ const (
 	OpRollback
 	OpPrepare
 	OpCommitting
+	// OpCommitXA is the commit of a prepared XA txn branch
+	OpCommitXA
 	OpInvalid
 )
 
@@ -212,7 +214,9 @@ func (txn *Txn) Rollback(ctx context.Context) (err error) {
 		err = moerr.NewTxnNotFoundNoCtx()
 		return
 	}
-	if txn.Store.IsReadonly() {
+	// the prepared readonly txn, such as a XA txn branch without any write,
+	// must be finished as a 2PC txn
+	if txn.Store.IsReadonly() && txn.GetTxnState(false) != txnif.TxnStatePrepared {
 		err = txn.Mgr.DeleteTxn(txn.GetID())
 		return
 	}
@@ -224,6 +228,19 @@ func (txn *Txn) Rollback(ctx context.Context) (err error) {
 	return txn.rollback1PC(ctx)
 }
 
+// Abandon rollbacks a prepared 2PC transaction in memory without logging its
+// final state, so the transaction is still prepared after restart.
+func (txn *Txn) Abandon() (err error) {
+	state := txn.GetTxnState(false)
+	if state != txnif.TxnStatePrepared {
+		return moerr.NewTAERollbackNoCtxf("unexpected txn status : %s", txnif.TxnStrState(state))
+	}
+	_ = txn.ApplyRollback()
+	txn.DoneWithErr(nil, true)
+	txn.Mgr.DeleteTxn(txn.GetID())
+	return
+}
+
 // Committing is used to record a "committing" status for coordinator.
 // Notice that txn must commit successfully once committing message arrives, since Preparing
 // had already succeeded.
@@ -285,13 +302,17 @@ func (txn *Txn) doCommit(ctx context.Context, inRecovery bool) (err error) {
 		err = moerr.NewTxnNotFoundNoCtx()
 		return
 	}
-	// Skip readonly txn
-	if txn.Store.IsReadonly() {
+	// Skip readonly txn, unless it is prepared
+	if txn.Store.IsReadonly() && txn.GetTxnState(false) != txnif.TxnStatePrepared {
 		txn.Mgr.DeleteTxn(txn.GetID())
 		return nil
 	}
 
 	if txn.Is2PC() {
+		if len(txn.GetXID()) > 0 && !inRecovery &&
+			txn.GetTxnState(false) == txnif.TxnStatePrepared {
+			return txn.commitXA(ctx)
+		}
 		return txn.commit2PC(inRecovery)
 	}
 
@@ -479,5 +500,5 @@ func (txn *Txn) LogTxnEntry(dbId, tableId uint64, entry txnif.TxnEntry, readedOb
 }
 
 func (txn *Txn) LogTxnState(sync bool) (logEntry entry.Entry, err error) {
-	return
+	return txn.Store.LogTxnState(sync)
 }
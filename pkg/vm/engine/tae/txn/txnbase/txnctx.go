@@ -48,6 +48,10 @@ type TxnCtx struct {
 	State        txnif.TxnState
 	Participants []uint64
 
+	// XID is the xid of the XA transaction branch. A txn with XID is always
+	// prepared and then committed or rollbacked like a 2PC participant.
+	XID []byte
+
 	// Memo is not thread-safe
 	// It will be readonly when txn state is not txnif.TxnStateActive
 	Memo *txnif.TxnMemo
@@ -83,7 +87,7 @@ func (ctx *TxnCtx) GetMemo() *txnif.TxnMemo {
 	return ctx.Memo
 }
 
-func (ctx *TxnCtx) Is2PC() bool { return len(ctx.Participants) > 1 }
+func (ctx *TxnCtx) Is2PC() bool { return len(ctx.Participants) > 1 || len(ctx.XID) > 0 }
 
 func (ctx *TxnCtx) GetCtx() []byte {
 	return ctx.IDCtx
@@ -160,6 +164,19 @@ func (ctx *TxnCtx) SetParticipants(ids []uint64) (err error) {
 	return
 }
 
+func (ctx *TxnCtx) GetXID() []byte {
+	ctx.RLock()
+	defer ctx.RUnlock()
+	return ctx.XID
+}
+
+func (ctx *TxnCtx) SetXID(xid []byte) (err error) {
+	ctx.Lock()
+	defer ctx.Unlock()
+	ctx.XID = xid
+	return
+}
+
 func (ctx *TxnCtx) GetPrepareTS() types.TS {
 	ctx.RLock()
 	defer ctx.RUnlock()
@@ -350,6 +350,22 @@ func (mgr *TxnManager) onBindPrepareTimeStamp(op *OpTxn) (ts types.TS) {
 	return
 }
 
+// onBindCommitTimeStamp allocates a new commit timestamp for the prepared XA
+// txn branch, which is after the logtail published before.
+func (mgr *TxnManager) onBindCommitTimeStamp(op *OpTxn) {
+	mgr.ts.mu.Lock()
+	defer mgr.ts.mu.Unlock()
+
+	ts := mgr.ts.allocator.Alloc()
+	if !mgr.prevPrepareTS.IsEmpty() {
+		if ts.LT(&mgr.prevPrepareTS) {
+			panic(fmt.Sprintf("timestamp rollback current %v, previous %v", ts.ToString(), mgr.prevPrepareTS.ToString()))
+		}
+	}
+	mgr.prevPrepareTS = ts
+	_ = op.Txn.SetCommitTS(ts)
+}
+
 func (mgr *TxnManager) onPrepare(op *OpTxn, ts types.TS) {
 	//assign txn's prepare timestamp to TxnMvccNode.
 	mgr.onPreparCommit(op.Txn)
@@ -419,6 +435,21 @@ func (mgr *TxnManager) OnCommitTxn(txn txnif.AsyncTxn) {
 		}
 	}
 }
+
+// onXACommitted applies the commit of the prepared XA txn branch after its
+// logtail is collected, the store of the txn is closed by ApplyCommit.
+func (mgr *TxnManager) onXACommitted(op *OpTxn) {
+	op.Txn.GetStore().WaitEvent()
+	if err := op.Txn.ApplyCommit(); err != nil {
+		panic(err)
+	}
+	mgr.OnCommitTxn(op.Txn)
+	// Here to change the txn state and
+	// broadcast the commit event to all waiting threads
+	_ = op.Txn.WaitDone(nil, false)
+	op.Txn.(*Txn).WaitGroup.Done()
+}
+
 func (mgr *TxnManager) on2PCPrepared(op *OpTxn) {
 	var err error
 	var isAbort bool
@@ -452,6 +483,16 @@ func (mgr *TxnManager) dequeuePreparing(items ...any) {
 		store := op.Txn.GetStore()
 		store.TriggerTrace(txnif.TracePreparing)
 
+		// The prepared XA txn branch is assigned a commit timestamp here in
+		// order with the prepare timestamps of the other txns.
+		if op.Op == OpCommitXA {
+			mgr.onBindCommitTimeStamp(op)
+			if err := mgr.EnqueueFlushing(op); err != nil {
+				panic(err)
+			}
+			continue
+		}
+
 		// Idempotent check
 		if state := op.Txn.GetTxnState(false); state != txnif.TxnStateActive {
 			op.Txn.WaitDone(moerr.NewTxnNotActiveNoCtx(txnif.TxnStrState(state)), false)
@@ -505,7 +546,10 @@ func (mgr *TxnManager) onPrepareWAL(items ...any) {
 		store.TriggerTrace(txnif.TracePrepareWal)
 		var t1, t2, t3, t4, t5 time.Time
 		t1 = time.Now()
-		if op.Txn.GetError() == nil && op.Op == OpCommit || op.Op == OpPrepare {
+		if op.Op == OpCommitXA {
+			// the txn entries of the XA txn branch are logged at prepare
+			mgr.CommitListener.OnEndPrepareWAL(op.Txn)
+		} else if op.Txn.GetError() == nil && op.Op == OpCommit || op.Op == OpPrepare {
 			if err := op.Txn.PrepareWAL(); err != nil {
 				panic(err)
 			}
@@ -559,6 +603,10 @@ func (mgr *TxnManager) dequeuePrepared(items ...any) {
 		store := op.Txn.GetStore()
 		store.TriggerTrace(txnif.TracePrepared)
 		mgr.workers.Submit(func() {
+			if op.Op == OpCommitXA {
+				mgr.onXACommitted(op)
+				return
+			}
 			//Notice that WaitPrepared do nothing when op is OpRollback
 			if err := op.Txn.WaitPrepared(op.ctx); err != nil {
 				// v0.6 TODO: Error handling
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/updates"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
@@ -30,6 +31,7 @@ import (
 
 type replayTxnStore struct {
 	txnbase.NoopTxnStore
+	txn         *txnbase.Txn
 	Cmd         *txnbase.TxnCmd
 	Observer    wal.ReplayObserver
 	catalog     *catalog.Catalog
@@ -65,6 +67,7 @@ func MakeReplayTxn(
 		store.prepareRollback,
 		store.applyCommit,
 		store.applyRollback)
+	store.txn = txn
 	return txn
 }
 func (store *replayTxnStore) GetContext() context.Context {
@@ -86,14 +89,30 @@ func (store *replayTxnStore) prepareCommit(txn txnif.AsyncTxn) (err error) {
 
 func (store *replayTxnStore) applyCommit(txn txnif.AsyncTxn) (err error) {
 	store.Cmd.ApplyCommit()
+	store.closeXACmd(txn)
 	return
 }
 
 func (store *replayTxnStore) applyRollback(txn txnif.AsyncTxn) (err error) {
 	store.Cmd.ApplyRollback()
+	store.closeXACmd(txn)
 	return
 }
 
+// closeXACmd closes the cmd of the prepared XA txn branch, which is kept until
+// the branch is committed or rollbacked after replay.
+func (store *replayTxnStore) closeXACmd(txn txnif.AsyncTxn) {
+	if len(txn.GetXID()) > 0 {
+		store.Cmd.Close()
+	}
+}
+
+// LogTxnState logs the final state of the prepared XA txn branch replayed from
+// wal, which is committed or rollbacked after replay.
+func (store *replayTxnStore) LogTxnState(sync bool) (logEntry entry.Entry, err error) {
+	return logTxnState(store.wal, store.txn, sync)
+}
+
 func (store *replayTxnStore) prepareRollback(txn txnif.AsyncTxn) (err error) {
 	panic(moerr.NewInternalErrorNoCtxf("cannot prepareRollback rollback replay txn: %s",
 		txn.String()))
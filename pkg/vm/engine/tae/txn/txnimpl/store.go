@@ -231,10 +231,14 @@ func (store *txnStore) LogTxnEntry(dbId uint64, tableId uint64, entry txnif.TxnE
 }
 
 func (store *txnStore) LogTxnState(sync bool) (logEntry entry.Entry, err error) {
+	return logTxnState(store.driver, store.txn, sync)
+}
+
+func logTxnState(driver wal.Driver, txn txnif.TxnReader, sync bool) (logEntry entry.Entry, err error) {
 	cmd := txnbase.NewTxnStateCmd(
-		store.txn.GetID(),
-		store.txn.GetTxnState(false),
-		store.txn.GetCommitTS(),
+		txn.GetID(),
+		txn.GetTxnState(false),
+		txn.GetCommitTS(),
 	)
 	var buf []byte
 	if buf, err = cmd.MarshalBinary(); err != nil {
@@ -250,7 +254,7 @@ func (store *txnStore) LogTxnState(sync bool) (logEntry entry.Entry, err error)
 	}
 	logEntry.SetInfo(info)
 	var lsn uint64
-	lsn, err = store.driver.AppendEntry(wal.GroupC, logEntry)
+	lsn, err = driver.AppendEntry(wal.GroupC, logEntry)
 	if err != nil {
 		return
 	}
@@ -543,6 +547,9 @@ func (store *txnStore) AddWaitEvent(cnt int) {
 func (store *txnStore) DoneWaitEvent(cnt int) {
 	store.wg.Add(-cnt)
 }
+func (store *txnStore) WaitEvent() {
+	store.wg.Wait()
+}
 func (store *txnStore) DropDatabaseByID(id uint64) (h handle.Database, err error) {
 	hasNewEntry, meta, err := store.catalog.DropDBEntryByID(id, store.txn)
 	if err != nil {
@@ -516,6 +516,10 @@ func (ml *mockLockService) Unlock(ctx context.Context, txnID []byte,
 	commitTS timestamp.Timestamp, mutations ...lock.ExtraMutation) error {
 	return nil
 }
+func (ml *mockLockService) MarkTxnLocks(txnID []byte) lockservice.TxnLockMark { return nil }
+func (ml *mockLockService) UnlockAfterMark(ctx context.Context, txnID []byte, mark lockservice.TxnLockMark) error {
+	return nil
+}
 func (ml *mockLockService) IsOrphanTxn(context.Context, []byte) (bool, error) { return false, nil }
 func (ml *mockLockService) Close() error                                      { return nil }
 func (ml *mockLockService) GetWaitingList(ctx context.Context, txnID []byte) (bool, []lock.WaitTxn, error) {
@@ -130,6 +130,9 @@ func (ts *TestTxnStorage) RollbackTNShard(ctx context.Context, request *txn.TxnR
 func (ts *TestTxnStorage) Debug(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
 	return nil
 }
+func (ts *TestTxnStorage) XARecover(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+	return nil
+}
 
 func NewTestTAEEngine(
 	ctx context.Context, moduleName string, t *testing.T,
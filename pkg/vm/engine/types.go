@@ -170,6 +170,14 @@ type StreamConfigsDef struct {
 	Configs []*plan.Property
 }
 
+type CheckDef struct {
+	Checks []*plan.CheckDef
+}
+
+type GeneratedColDef struct {
+	Cols []*plan.GeneratedColDef
+}
+
 type TableDef interface {
 	tableDef()
 
@@ -306,6 +314,8 @@ const (
 	ForeignKey
 	PrimaryKey
 	StreamConfig
+	Check
+	GeneratedCol
 )
 
 type EngineType int8
@@ -398,6 +408,40 @@ func (def *ConstraintDef) MarshalBinary() (data []byte, err error) {
 				}
 				buf.Write(bytes)
 			}
+		case *CheckDef:
+			if err := binary.Write(buf, binary.BigEndian, Check); err != nil {
+				return nil, err
+			}
+			if err := binary.Write(buf, binary.BigEndian, uint64(len(def.Checks))); err != nil {
+				return nil, err
+			}
+			for _, c := range def.Checks {
+				bytes, err := c.Marshal()
+				if err != nil {
+					return nil, err
+				}
+				if err := binary.Write(buf, binary.BigEndian, uint64(len(bytes))); err != nil {
+					return nil, err
+				}
+				buf.Write(bytes)
+			}
+		case *GeneratedColDef:
+			if err := binary.Write(buf, binary.BigEndian, GeneratedCol); err != nil {
+				return nil, err
+			}
+			if err := binary.Write(buf, binary.BigEndian, uint64(len(def.Cols))); err != nil {
+				return nil, err
+			}
+			for _, c := range def.Cols {
+				bytes, err := c.Marshal()
+				if err != nil {
+					return nil, err
+				}
+				if err := binary.Write(buf, binary.BigEndian, uint64(len(bytes))); err != nil {
+					return nil, err
+				}
+				buf.Write(bytes)
+			}
 		}
 	}
 	return buf.Bytes(), nil
@@ -483,6 +527,40 @@ func (def *ConstraintDef) UnmarshalBinary(data []byte) error {
 				configs[i] = config
 			}
 			def.Cts = append(def.Cts, &StreamConfigsDef{configs})
+		case Check:
+			length = binary.BigEndian.Uint64(data[l : l+8])
+			l += 8
+			checks := make([]*plan.CheckDef, length)
+
+			for i := 0; i < int(length); i++ {
+				dataLength := binary.BigEndian.Uint64(data[l : l+8])
+				l += 8
+				check := &plan.CheckDef{}
+				err := check.Unmarshal(data[l : l+int(dataLength)])
+				if err != nil {
+					return err
+				}
+				l += int(dataLength)
+				checks[i] = check
+			}
+			def.Cts = append(def.Cts, &CheckDef{checks})
+		case GeneratedCol:
+			length = binary.BigEndian.Uint64(data[l : l+8])
+			l += 8
+			cols := make([]*plan.GeneratedColDef, length)
+
+			for i := 0; i < int(length); i++ {
+				dataLength := binary.BigEndian.Uint64(data[l : l+8])
+				l += 8
+				col := &plan.GeneratedColDef{}
+				err := col.Unmarshal(data[l : l+int(dataLength)])
+				if err != nil {
+					return err
+				}
+				l += int(dataLength)
+				cols[i] = col
+			}
+			def.Cts = append(def.Cts, &GeneratedColDef{cols})
 		}
 	}
 	return nil
@@ -514,9 +592,35 @@ func (def *ConstraintPB) FromPBVersion() Constraint {
 	if r := def.GetStreamConfigsDef(); r != nil {
 		return r
 	}
+	if r := def.GetCheckDef(); r != nil {
+		return r
+	}
+	if r := def.GetGeneratedColDef(); r != nil {
+		return r
+	}
 	panic("no corresponding type")
 }
 
+// get the check constraints in the constraint, and return null if there is no check
+func (def *ConstraintDef) GetCheckDef() *CheckDef {
+	for _, ct := range def.Cts {
+		if ctVal, ok := ct.(*CheckDef); ok {
+			return ctVal
+		}
+	}
+	return nil
+}
+
+// get the generated columns in the constraint, and return null if there is no generated column
+func (def *ConstraintDef) GetGeneratedColDef() *GeneratedColDef {
+	for _, ct := range def.Cts {
+		if ctVal, ok := ct.(*GeneratedColDef); ok {
+			return ctVal
+		}
+	}
+	return nil
+}
+
 // get the primary key definition in the constraint, and return null if there is no primary key
 func (def *ConstraintDef) GetPrimaryKeyDef() *PrimaryKeyDef {
 	for _, ct := range def.Cts {
@@ -540,6 +644,8 @@ func (*PrimaryKeyDef) constraint()    {}
 func (*RefChildTableDef) constraint() {}
 func (*IndexDef) constraint()         {}
 func (*StreamConfigsDef) constraint() {}
+func (*CheckDef) constraint()         {}
+func (*GeneratedColDef) constraint()  {}
 
 func (def *ForeignKeyDef) ToPBVersion() ConstraintPB {
 	return ConstraintPB{
@@ -578,6 +684,22 @@ func (def *StreamConfigsDef) ToPBVersion() ConstraintPB {
 	}
 }
 
+func (def *CheckDef) ToPBVersion() ConstraintPB {
+	return ConstraintPB{
+		Ct: &ConstraintPB_CheckDef{
+			CheckDef: def,
+		},
+	}
+}
+
+func (def *GeneratedColDef) ToPBVersion() ConstraintPB {
+	return ConstraintPB{
+		Ct: &ConstraintPB_GeneratedColDef{
+			GeneratedColDef: def,
+		},
+	}
+}
+
 type TombstoneType uint8
 
 const (
@@ -62,6 +62,13 @@ func (js *JoinSels) GetSels(k int32) []int32 {
 	return js.sels[i][j]
 }
 
+// SpilledJoinMap is the build side of a grace hash join, which is
+// partitioned to the local disk because it exceeds the memory budget.
+type SpilledJoinMap interface {
+	// Free removes the spilled partitions.
+	Free()
+}
+
 // JoinMap is used for join
 type JoinMap struct {
 	runtimeFilter_In bool
@@ -73,6 +80,7 @@ type JoinMap struct {
 	mpool            *mpool.MPool
 	multiSels        JoinSels
 	batches          []*batch.Batch
+	spilled          SpilledJoinMap
 	//ignoreRows       *bitmap.Bitmap
 }
 
@@ -87,6 +95,23 @@ func NewJoinMap(sels JoinSels, ihm *hashmap.IntHashMap, shm *hashmap.StrHashMap,
 	}
 }
 
+// NewSpilledJoinMap returns a join map without hashmap, the join operators
+// read the spilled build side and join it partition by partition.
+func NewSpilledJoinMap(spilled SpilledJoinMap, m *mpool.MPool) *JoinMap {
+	return &JoinMap{
+		spilled: spilled,
+		mpool:   m,
+		valid:   true,
+	}
+}
+
+func (jm *JoinMap) GetSpilled() SpilledJoinMap {
+	if jm == nil {
+		return nil
+	}
+	return jm.spilled
+}
+
 func (jm *JoinMap) GetBatches() []*batch.Batch {
 	if jm == nil {
 		return nil
@@ -165,6 +190,10 @@ func (jm *JoinMap) FreeMemory() {
 		jm.batches[i].Clean(jm.mpool)
 	}
 	jm.batches = nil
+	if jm.spilled != nil {
+		jm.spilled.Free()
+		jm.spilled = nil
+	}
 	jm.valid = false
 }
 
@@ -49,6 +49,7 @@ type Analyzer interface {
 	AddWaitLockTime(t time.Time)
 	AddS3RequestCount(counter *perfcounter.CounterSet)
 	AddDiskIO(counter *perfcounter.CounterSet)
+	Spill(size int64)
 
 	GetOpCounterSet() *perfcounter.CounterSet
 	GetOpStats() *OperatorStats
@@ -276,6 +277,15 @@ func (opAlyzr *operatorAnalyzer) AddDiskIO(counter *perfcounter.CounterSet) {
 	opAlyzr.opStats.DiskIO += counter.FileService.FileWithChecksum.Write.Load()
 }
 
+// Spill records the bytes written to the disk when the operator exceeds
+// its memory budget
+func (opAlyzr *operatorAnalyzer) Spill(size int64) {
+	if opAlyzr.opStats == nil {
+		panic("operatorAnalyzer.Spill: operatorAnalyzer.opStats is nil")
+	}
+	opAlyzr.opStats.SpillSize += size
+}
+
 func (opAlyzr *operatorAnalyzer) GetOpStats() *OperatorStats {
 	if opAlyzr.opStats == nil {
 		panic("operatorAnalyzer.GetOpStats(): operatorAnalyzer.opStats is nil")
@@ -305,6 +315,7 @@ type OperatorStats struct {
 	InputBlocks      int64                `json:"-"`
 	ScanBytes        int64                `json:"-"`
 	DiskIO           int64                `json:"DiskIO,omitempty"`
+	SpillSize        int64                `json:"SpillSize,omitempty"`
 	OperatorMetrics  map[MetricType]int64 `json:"OperatorMetrics,omitempty"`
 }
 
@@ -352,6 +363,9 @@ func (ps *OperatorStats) String() string {
 			metricsStr += fmt.Sprintf("%s:%dns ", metricName, v)
 		}
 	}
+	if ps.SpillSize > 0 {
+		metricsStr += fmt.Sprintf(" SpillSize:%dbytes", ps.SpillSize)
+	}
 
 	return fmt.Sprintf(" CallNum:%d "+
 		"TimeCost:%dns "+
@@ -87,6 +87,7 @@ func NewTopProcess(
 		UnixTime:       time.Now().UnixNano(),
 		PostDmlSqlList: threadsafe.NewSlice[string](),
 		StageCache:     threadsafe.NewMap[string, stage.StageDef](),
+		queryMemory:    &QueryMemory{},
 	}
 
 	proc := &Process{
@@ -300,6 +300,35 @@ type BaseProcess struct {
 
 	// stage cache to avoid to run same stage SQL repeatedly
 	StageCache *threadsafe.Map[string, stage.StageDef]
+
+	// queryMemory is the memory held by the operators of the query which can spill.
+	queryMemory *QueryMemory
+}
+
+// QueryMemory is the memory held by the operators of a query which can spill
+// to the disk, all of them share the memory budget of the query.
+type QueryMemory struct {
+	used atomic.Int64
+	// operators is the number of operators holding memory.
+	operators atomic.Int64
+}
+
+// Update changes the memory held by an operator from oldSize to newSize, and
+// returns the memory held by the query and the number of operators holding
+// memory.
+func (m *QueryMemory) Update(oldSize, newSize int64) (used int64, operators int64) {
+	operators = m.operators.Load()
+	if oldSize == 0 && newSize > 0 {
+		operators = m.operators.Add(1)
+	} else if oldSize > 0 && newSize == 0 {
+		operators = m.operators.Add(-1)
+	}
+	return m.used.Add(newSize - oldSize), operators
+}
+
+// Used returns the memory held by the query.
+func (m *QueryMemory) Used() int64 {
+	return m.used.Load()
 }
 
 // Process contains context used in query execution
@@ -388,6 +417,11 @@ func (proc *Process) SetResolveVariableFunc(f func(varName string, isSystemVar,
 	proc.Base.resolveVariableFunc = f
 }
 
+// GetQueryMemory returns the memory held by the operators of the query which can spill.
+func (proc *Process) GetQueryMemory() *QueryMemory {
+	return proc.Base.queryMemory
+}
+
 func (proc *Process) GetResolveVariableFunc() func(varName string, isSystemVar, isGlobalVar bool) (interface{}, error) {
 	return proc.Base.resolveVariableFunc
 }
@@ -109,6 +109,7 @@ const (
 	Mock
 	Apply
 	PostDml
+	MinusAll
 )
 
 var OperatorToStrMap map[OpType]string
@@ -182,6 +183,7 @@ func init() {
 		Mock:                    "Mock",
 		Apply:                   "Apply",
 		PostDml:                 "PostDml",
+		MinusAll:                "MinusAll",
 	}
 
 	// Initialize StrToOperatorMap
@@ -351,6 +353,8 @@ func (op OpType) String() string {
 		return "Apply"
 	case PostDml:
 		return "PostDml"
+	case MinusAll:
+		return "MinusAll"
 	default:
 		return "Unknown"
 	}